@@ -42,9 +42,23 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		Templates: DefaultWebhookTemplatesConfig,
 		Target:    "direct",
 		Timeout:   5 * time.Second,
-		QueueSize: 16,
+		Queue: applicationserver.WebhooksQueueConfig{
+			BufferSize: 100000,
+		},
 		Workers:   16,
 		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
+		Retry: web.RetryConfig{
+			MaxAttempts:    5,
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     10 * time.Minute,
+		},
+		RetryQueue: applicationserver.WebhooksQueueConfig{
+			BufferSize: 100000,
+		},
+		FailedDeliveries: applicationserver.WebhooksFailedDeliveriesConfig{
+			Limit: 1000,
+			TTL:   7 * 24 * time.Hour,
+		},
 	},
 	EndDeviceFetcher: applicationserver.EndDeviceFetcherConfig{
 		Cache: applicationserver.EndDeviceFetcherCacheConfig{
@@ -57,8 +71,10 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	},
 	PubSub: applicationserver.PubSubConfig{
 		Providers: map[string]string{
-			"mqtt": "enabled",
-			"nats": "enabled",
+			"mqtt":  "enabled",
+			"nats":  "enabled",
+			"kafka": "enabled",
+			"amqp":  "enabled",
 		},
 	},
 }
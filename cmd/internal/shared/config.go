@@ -91,6 +91,10 @@ var DefaultRedisConfig = redis.Config{
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = config.Events{
 	Backend: "internal",
+	Store: config.EventsStore{
+		TTL:         10 * time.Minute,
+		EntityCount: 100,
+	},
 }
 
 // DefaultBlobConfig is the default config for the blob store.
@@ -16,26 +16,44 @@ package shared
 
 import (
 	"context"
-	"fmt"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/cloud"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/redis"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	_ "gocloud.dev/pubsub/awssnssqs" // AWS backend for PubSub.
 	_ "gocloud.dev/pubsub/gcppubsub" // GCP backend for PubSub.
 )
 
+var (
+	errEventsStoreNotSupported = errors.DefineInvalidArgument("events_store_not_supported", "events store not supported by events backend `{backend}`")
+	errUnknownEventsBackend    = errors.DefineInvalidArgument("unknown_events_backend", "unknown events backend `{backend}`")
+)
+
 // InitializeEvents initializes the event system.
 func InitializeEvents(ctx context.Context, taskStarter component.TaskStarter, conf config.ServiceBase) error {
 	switch conf.Events.Backend {
 	case "internal":
+		if conf.Events.Store.Enable {
+			store := events.NewMemoryStore(conf.Events.Store.TTL, conf.Events.Store.EntityCount)
+			events.SetDefaultPubSub(events.WithStore(ctx, events.DefaultPubSub(), store, events.DefaultStoreBufferSize))
+		}
 		return nil // this is the default.
 	case "redis":
-		events.SetDefaultPubSub(redis.NewPubSub(ctx, taskStarter, conf.Events.Redis))
+		var ps events.PubSub = redis.NewPubSub(ctx, taskStarter, conf.Events.Redis)
+		if conf.Events.Store.Enable {
+			store := redis.NewStore(ttnredis.New(&conf.Events.Redis), conf.Events.Store.TTL, conf.Events.Store.EntityCount)
+			ps = events.WithStore(ctx, ps, store, events.DefaultStoreBufferSize)
+		}
+		events.SetDefaultPubSub(ps)
 		return nil
 	case "cloud":
+		if conf.Events.Store.Enable {
+			return errEventsStoreNotSupported.WithAttributes("backend", conf.Events.Backend)
+		}
 		ps, err := cloud.NewPubSub(ctx, taskStarter, conf.Events.Cloud.PublishURL, conf.Events.Cloud.SubscribeURL)
 		if err != nil {
 			return err
@@ -43,6 +61,6 @@ func InitializeEvents(ctx context.Context, taskStarter component.TaskStarter, co
 		events.SetDefaultPubSub(ps)
 		return nil
 	default:
-		return fmt.Errorf("unknown events backend: %s", conf.Events.Backend)
+		return errUnknownEventsBackend.WithAttributes("backend", conf.Events.Backend)
 	}
 }
@@ -50,6 +50,9 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		PublicAddress:    fmt.Sprintf("%s:1882", shared.DefaultPublicHost),
 		PublicTLSAddress: fmt.Sprintf("%s:8882", shared.DefaultPublicHost),
 	},
+	MQTTChirpStack: gatewayserver.MQTTChirpStackConfig{
+		Marshaler: "protobuf",
+	},
 	BasicStation: gatewayserver.BasicStationConfig{
 		Config:                 ws.DefaultConfig,
 		MaxValidRoundTripDelay: 10 * time.Second,
@@ -57,4 +60,22 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		ListenTLS:              ":8887",
 	},
 	UpdateConnectionStatsDebounceTime: 3 * time.Second,
+	StatsHistory: gatewayserver.StatsHistoryConfig{
+		Enable:                    false,
+		BucketDuration:            5 * time.Minute,
+		Retention:                 7 * 24 * time.Hour,
+		DownsampledBucketDuration: time.Hour,
+		DownsampledRetention:      90 * 24 * time.Hour,
+	},
+	Spectrum: gatewayserver.SpectrumConfig{
+		Enable:          false,
+		Window:          time.Hour,
+		MaxObservations: 100000,
+		Metrics:         false,
+	},
+	ConnectionRegistry: gatewayserver.ConnectionRegistryConfig{
+		Enable:            false,
+		TTL:               30 * time.Second,
+		HeartbeatInterval: 10 * time.Second,
+	},
 }
@@ -71,4 +71,7 @@ func init() {
 	DefaultIdentityServerConfig.UserRights.CreateClients = true
 	DefaultIdentityServerConfig.UserRights.CreateGateways = true
 	DefaultIdentityServerConfig.UserRights.CreateOrganizations = true
+	DefaultIdentityServerConfig.Alerting.EvaluationInterval = time.Minute
+	DefaultIdentityServerConfig.Alerting.ReconcileInterval = 10 * time.Minute
+	DefaultIdentityServerConfig.Alerting.WebhookTimeout = 10 * time.Second
 }
@@ -0,0 +1,352 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	selectAlertRuleFlags    = util.FieldMaskFlags(&ttnpb.AlertRule{})
+	setAlertRuleFlags       = alertRuleFieldFlags()
+	gatewayOfflineFlags     = util.FieldFlags(&ttnpb.AlertRule_GatewayOfflineCondition{}, "gateway_offline")
+	uplinkRateDropFlags     = util.FieldFlags(&ttnpb.AlertRule_UplinkRateDropCondition{}, "uplink_rate_drop")
+	txAckFailureFlags       = util.FieldFlags(&ttnpb.AlertRule_TxAckFailureCondition{}, "tx_ack_failure")
+	deviceNotSeenFlags      = util.FieldFlags(&ttnpb.AlertRule_DeviceNotSeenCondition{}, "device_not_seen")
+	selectAllAlertRuleFlags = util.SelectAllFlagSet("alert rule")
+)
+
+// alertRuleFieldFlags returns the flags for the fields of the alert rule,
+// except for the condition, which is set with alertRuleConditionFlags.
+func alertRuleFieldFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	util.FieldFlags(&ttnpb.AlertRule{}).VisitAll(func(flag *pflag.Flag) {
+		if !strings.HasPrefix(flag.Name, "condition.") {
+			flagSet.AddFlag(flag)
+		}
+	})
+	return flagSet
+}
+
+func alertRuleIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("rule-id", "", "")
+	return flagSet
+}
+
+func alertRuleConditionFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("gateway-offline", false, "alert when the gateway is offline")
+	flagSet.AddFlagSet(gatewayOfflineFlags)
+	flagSet.Bool("uplink-rate-drop", false, "alert when the uplink rate drops")
+	flagSet.AddFlagSet(uplinkRateDropFlags)
+	flagSet.Bool("tx-ack-failure", false, "alert when the gateway fails to transmit downlinks")
+	flagSet.AddFlagSet(txAckFailureFlags)
+	flagSet.Bool("device-not-seen", false, "alert when end devices are not seen")
+	flagSet.AddFlagSet(deviceNotSeenFlags)
+	return flagSet
+}
+
+var (
+	errNoAlertRuleID        = errors.DefineInvalidArgument("no_alert_rule_id", "no alert rule ID set")
+	errNoAlertRuleCondition = errors.DefineInvalidArgument("no_alert_rule_condition", "no alert rule condition set")
+)
+
+// getAlertRuleID returns the alert rule identifiers from the first two
+// arguments, which are the entity ID and the rule ID, or from the flags.
+func getAlertRuleID(cmd *cobra.Command, args []string, getID func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error)) (*ttnpb.AlertRuleIdentifiers, error) {
+	ruleID, _ := cmd.Flags().GetString("rule-id")
+	switch len(args) {
+	case 0, 1:
+	case 2:
+		ruleID = args[1]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		ruleID = args[1]
+	}
+	if len(args) > 1 {
+		args = args[:1]
+	}
+	entityIDs, err := getID(cmd, args)
+	if err != nil {
+		return nil, err
+	}
+	if ruleID == "" {
+		return nil, errNoAlertRuleID
+	}
+	ids := &ttnpb.AlertRuleIdentifiers{RuleID: ruleID}
+	switch id := entityIDs.Identifiers().(type) {
+	case *ttnpb.GatewayIdentifiers:
+		ids.GatewayIDs = id
+	case *ttnpb.ApplicationIdentifiers:
+		ids.ApplicationIDs = id
+	default:
+		panic(fmt.Errorf("no alert rules in %T", id))
+	}
+	return ids, nil
+}
+
+// setAlertRuleCondition sets the condition of the alert rule from the
+// condition flags, and returns whether the condition was set.
+func setAlertRuleCondition(flagSet *pflag.FlagSet, rule *ttnpb.AlertRule) (bool, error) {
+	if gatewayOffline, _ := flagSet.GetBool("gateway-offline"); gatewayOffline {
+		cond := rule.GetGatewayOffline()
+		if cond == nil {
+			cond = &ttnpb.AlertRule_GatewayOfflineCondition{}
+		}
+		if err := util.SetFields(cond, gatewayOfflineFlags, "gateway_offline"); err != nil {
+			return false, err
+		}
+		rule.Condition = &ttnpb.AlertRule_GatewayOffline{GatewayOffline: cond}
+		return true, nil
+	}
+	if uplinkRateDrop, _ := flagSet.GetBool("uplink-rate-drop"); uplinkRateDrop {
+		cond := rule.GetUplinkRateDrop()
+		if cond == nil {
+			cond = &ttnpb.AlertRule_UplinkRateDropCondition{}
+		}
+		if err := util.SetFields(cond, uplinkRateDropFlags, "uplink_rate_drop"); err != nil {
+			return false, err
+		}
+		rule.Condition = &ttnpb.AlertRule_UplinkRateDrop{UplinkRateDrop: cond}
+		return true, nil
+	}
+	if txAckFailure, _ := flagSet.GetBool("tx-ack-failure"); txAckFailure {
+		cond := rule.GetTxAckFailure()
+		if cond == nil {
+			cond = &ttnpb.AlertRule_TxAckFailureCondition{}
+		}
+		if err := util.SetFields(cond, txAckFailureFlags, "tx_ack_failure"); err != nil {
+			return false, err
+		}
+		rule.Condition = &ttnpb.AlertRule_TxAckFailure{TxAckFailure: cond}
+		return true, nil
+	}
+	if deviceNotSeen, _ := flagSet.GetBool("device-not-seen"); deviceNotSeen {
+		cond := rule.GetDeviceNotSeen()
+		if cond == nil {
+			cond = &ttnpb.AlertRule_DeviceNotSeenCondition{}
+		}
+		if err := util.SetFields(cond, deviceNotSeenFlags, "device_not_seen"); err != nil {
+			return false, err
+		}
+		rule.Condition = &ttnpb.AlertRule_DeviceNotSeen{DeviceNotSeen: cond}
+		return true, nil
+	}
+	return false, nil
+}
+
+func alertRuleCommands(entity string, getID func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert-rules",
+		Aliases: []string{"alert-rule", "alerts"},
+		Short:   fmt.Sprintf("Manage %s alert rules", entity),
+	}
+	list := &cobra.Command{
+		Use:     fmt.Sprintf("list [%s-id]", entity),
+		Aliases: []string{"ls"},
+		Short:   fmt.Sprintf("List %s alert rules", entity),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entityIDs, err := getID(cmd, args)
+			if err != nil {
+				return err
+			}
+			paths := util.SelectFieldMask(cmd.Flags(), selectAlertRuleFlags)
+			paths = ttnpb.AllowedFields(paths, ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.AlertRuleRegistry/List"].Allowed)
+
+			req := &ttnpb.ListAlertRulesRequest{
+				FieldMask: types.FieldMask{Paths: paths},
+			}
+			switch id := entityIDs.Identifiers().(type) {
+			case *ttnpb.GatewayIdentifiers:
+				req.GatewayIDs = id
+			case *ttnpb.ApplicationIdentifiers:
+				req.ApplicationIDs = id
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			req.Limit, req.Page = limit, page
+			res, err := ttnpb.NewAlertRuleRegistryClient(is).List(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Rules)
+		},
+	}
+	get := &cobra.Command{
+		Use:     fmt.Sprintf("get [%s-id] [rule-id]", entity),
+		Aliases: []string{"info"},
+		Short:   fmt.Sprintf("Get a %s alert rule", entity),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleID, err := getAlertRuleID(cmd, args, getID)
+			if err != nil {
+				return err
+			}
+			paths := util.SelectFieldMask(cmd.Flags(), selectAlertRuleFlags)
+			paths = ttnpb.AllowedFields(paths, ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.AlertRuleRegistry/Get"].Allowed)
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAlertRuleRegistryClient(is).Get(ctx, &ttnpb.GetAlertRuleRequest{
+				AlertRuleIdentifiers: *ruleID,
+				FieldMask:            types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	create := &cobra.Command{
+		Use:     fmt.Sprintf("create [%s-id] [rule-id]", entity),
+		Aliases: []string{"add", "register"},
+		Short:   fmt.Sprintf("Create a %s alert rule", entity),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleID, err := getAlertRuleID(cmd, args, getID)
+			if err != nil {
+				return err
+			}
+			rule := &ttnpb.AlertRule{AlertRuleIdentifiers: *ruleID}
+			if err = util.SetFields(rule, setAlertRuleFlags); err != nil {
+				return err
+			}
+			ok, err := setAlertRuleCondition(cmd.Flags(), rule)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errNoAlertRuleCondition
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAlertRuleRegistryClient(is).Create(ctx, &ttnpb.CreateAlertRuleRequest{
+				AlertRule: *rule,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	set := &cobra.Command{
+		Use:     fmt.Sprintf("set [%s-id] [rule-id]", entity),
+		Aliases: []string{"update"},
+		Short:   fmt.Sprintf("Set the properties of a %s alert rule", entity),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleID, err := getAlertRuleID(cmd, args, getID)
+			if err != nil {
+				return err
+			}
+			paths := util.UpdateFieldMask(cmd.Flags(), setAlertRuleFlags)
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			rule, err := ttnpb.NewAlertRuleRegistryClient(is).Get(ctx, &ttnpb.GetAlertRuleRequest{
+				AlertRuleIdentifiers: *ruleID,
+				FieldMask:            types.FieldMask{Paths: []string{"condition"}},
+			})
+			if err != nil {
+				return err
+			}
+			rule.AlertRuleIdentifiers = *ruleID
+			if err = util.SetFields(rule, setAlertRuleFlags); err != nil {
+				return err
+			}
+			ok, err := setAlertRuleCondition(cmd.Flags(), rule)
+			if err != nil {
+				return err
+			}
+			if ok {
+				paths = append(paths, "condition")
+			}
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, won't update anything")
+				return nil
+			}
+
+			res, err := ttnpb.NewAlertRuleRegistryClient(is).Update(ctx, &ttnpb.UpdateAlertRuleRequest{
+				AlertRule: *rule,
+				FieldMask: types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	remove := &cobra.Command{
+		Use:     fmt.Sprintf("delete [%s-id] [rule-id]", entity),
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   fmt.Sprintf("Delete a %s alert rule", entity),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleID, err := getAlertRuleID(cmd, args, getID)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewAlertRuleRegistryClient(is).Delete(ctx, ruleID)
+			return err
+		},
+	}
+	list.Flags().AddFlagSet(selectAlertRuleFlags)
+	list.Flags().AddFlagSet(selectAllAlertRuleFlags)
+	list.Flags().AddFlagSet(paginationFlags())
+	cmd.AddCommand(list)
+	get.Flags().AddFlagSet(alertRuleIDFlags())
+	get.Flags().AddFlagSet(selectAlertRuleFlags)
+	get.Flags().AddFlagSet(selectAllAlertRuleFlags)
+	cmd.AddCommand(get)
+	create.Flags().AddFlagSet(alertRuleIDFlags())
+	create.Flags().AddFlagSet(setAlertRuleFlags)
+	create.Flags().AddFlagSet(alertRuleConditionFlags())
+	cmd.AddCommand(create)
+	set.Flags().AddFlagSet(alertRuleIDFlags())
+	set.Flags().AddFlagSet(setAlertRuleFlags)
+	set.Flags().AddFlagSet(alertRuleConditionFlags())
+	cmd.AddCommand(set)
+	remove.Flags().AddFlagSet(alertRuleIDFlags())
+	cmd.AddCommand(remove)
+	return cmd
+}
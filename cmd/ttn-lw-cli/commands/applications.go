@@ -252,6 +252,13 @@ var (
 		}
 		return appID.EntityIdentifiers(), nil
 	})
+	applicationsAlertRulesCommand = alertRuleCommands("application", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		appID := getApplicationID(cmd.Flags(), args)
+		if appID == nil {
+			return nil, errNoApplicationID
+		}
+		return appID.EntityIdentifiers(), nil
+	})
 	applicationsPurgeCommand = &cobra.Command{
 		Use:     "purge [application-id]",
 		Aliases: []string{"permanent-delete", "hard-delete"},
@@ -311,6 +318,8 @@ func init() {
 	applicationsCommand.AddCommand(applicationsDeleteCommand)
 	applicationsContactInfoCommand.PersistentFlags().AddFlagSet(applicationIDFlags())
 	applicationsCommand.AddCommand(applicationsContactInfoCommand)
+	applicationsAlertRulesCommand.PersistentFlags().AddFlagSet(applicationIDFlags())
+	applicationsCommand.AddCommand(applicationsAlertRulesCommand)
 	applicationsPurgeCommand.Flags().AddFlagSet(applicationIDFlags())
 	applicationsPurgeCommand.Flags().AddFlagSet(forceFlags())
 	applicationsCommand.AddCommand(applicationsPurgeCommand)
@@ -34,6 +34,8 @@ var (
 	setApplicationPubSubFlags            = util.FieldFlags(&ttnpb.ApplicationPubSub{})
 	natsProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
 	mqttProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	kafkaProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_KafkaProvider{}, "kafka")
+	amqpProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_AMQPProvider{}, "amqp")
 	awsiotProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider{}, "aws_iot")
 	awsiotDefaultIntegrationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider_DefaultIntegration{}, "aws_iot", "deployment", "default")
 
@@ -56,6 +58,16 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-ca", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-cert", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-key", ""))
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("kafka.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-key", ""))
+	flagSet.Bool("amqp", false, "use the AMQP provider")
+	flagSet.AddFlagSet(amqpProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("amqp.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("amqp.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("amqp.tls-client-key", ""))
 	flagSet.Bool("aws-iot", false, "use the AWS IoT provider")
 	flagSet.AddFlagSet(awsiotProviderApplicationPubSubFlags)
 	flagSet.AddFlagSet(awsiotDefaultIntegrationPubSubFlags)
@@ -271,6 +283,78 @@ var (
 				}
 			}
 
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				if useTLS, _ := cmd.Flags().GetBool("kafka.use-tls"); useTLS {
+					for _, name := range []string{
+						"kafka.tls-ca",
+						"kafka.tls-client-cert",
+						"kafka.tls-client-key",
+					} {
+						// The CA and client certificate are optional.
+						if fileName, _ := cmd.Flags().GetString(name + "-local-file"); fileName == "" {
+							continue
+						}
+						data, err := getDataBytes(name, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						err = cmd.Flags().Set(name, hex.EncodeToString(data))
+						if err != nil {
+							return err
+						}
+					}
+				}
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetKafka(), kafkaProviderApplicationPubSubFlags, "kafka"); err != nil {
+					return err
+				}
+			}
+
+			if amqp, _ := cmd.Flags().GetBool("amqp"); amqp {
+				if useTLS, _ := cmd.Flags().GetBool("amqp.use-tls"); useTLS {
+					for _, name := range []string{
+						"amqp.tls-ca",
+						"amqp.tls-client-cert",
+						"amqp.tls-client-key",
+					} {
+						// The CA and client certificate are optional.
+						if fileName, _ := cmd.Flags().GetString(name + "-local-file"); fileName == "" {
+							continue
+						}
+						data, err := getDataBytes(name, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						err = cmd.Flags().Set(name, hex.EncodeToString(data))
+						if err != nil {
+							return err
+						}
+					}
+				}
+				if pubsub.GetAMQP() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_AMQP{
+						AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), amqpProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetAMQP(), amqpProviderApplicationPubSubFlags, "amqp"); err != nil {
+					return err
+				}
+			}
+
 			if awsiot, _ := cmd.Flags().GetBool("aws-iot"); awsiot {
 				if pubsub.GetAWSIoT() == nil {
 					paths = append(paths, "provider")
@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"encoding/hex"
 	"os"
 	"strings"
 
@@ -71,6 +72,24 @@ func getApplicationWebhookID(flagSet *pflag.FlagSet, args []string) (*ttnpb.Appl
 	}, nil
 }
 
+func deliveryIDsFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("delivery-ids", nil, "IDs of the deliveries (default all)")
+	return flagSet
+}
+
+func getApplicationWebhookFailedDeliveriesRequest(flagSet *pflag.FlagSet, args []string) (*ttnpb.ApplicationWebhookFailedDeliveriesRequest, error) {
+	webhookID, err := getApplicationWebhookID(flagSet, args)
+	if err != nil {
+		return nil, err
+	}
+	deliveryIDs, _ := flagSet.GetStringSlice("delivery-ids")
+	return &ttnpb.ApplicationWebhookFailedDeliveriesRequest{
+		ApplicationWebhookIdentifiers: *webhookID,
+		DeliveryIDs:                   deliveryIDs,
+	}, nil
+}
+
 func headersFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.StringSlice("headers", nil, "key=value")
@@ -175,6 +194,22 @@ var (
 			if err != nil {
 				return err
 			}
+			for _, name := range []string{
+				"tls-ca",
+				"tls-client-cert",
+				"tls-client-key",
+			} {
+				if fileName, _ := cmd.Flags().GetString(name + "-local-file"); fileName == "" {
+					continue
+				}
+				data, err := getDataBytes(name, cmd.Flags())
+				if err != nil {
+					return err
+				}
+				if err = cmd.Flags().Set(name, hex.EncodeToString(data)); err != nil {
+					return err
+				}
+			}
 			paths := util.UpdateFieldMask(cmd.Flags(), setApplicationWebhookFlags, headersFlags())
 
 			var webhook ttnpb.ApplicationWebhook
@@ -222,6 +257,68 @@ var (
 			return nil
 		},
 	}
+	applicationsWebhooksFailedDeliveriesCommand = &cobra.Command{
+		Use:     "failed-deliveries",
+		Aliases: []string{"failed"},
+		Short:   "Application webhook failed deliveries commands",
+	}
+	applicationsWebhooksFailedDeliveriesListCommand = &cobra.Command{
+		Use:     "list [application-id] [webhook-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the failed deliveries of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getApplicationWebhookFailedDeliveriesRequest(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListFailedDeliveries(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhooksFailedDeliveriesReplayCommand = &cobra.Command{
+		Use:   "replay [application-id] [webhook-id]",
+		Short: "Replay the failed deliveries of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getApplicationWebhookFailedDeliveriesRequest(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationWebhookRegistryClient(as).ReplayFailedDeliveries(ctx, req)
+			return err
+		},
+	}
+	applicationsWebhooksFailedDeliveriesPurgeCommand = &cobra.Command{
+		Use:     "purge [application-id] [webhook-id]",
+		Aliases: []string{"clear"},
+		Short:   "Purge the failed deliveries of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getApplicationWebhookFailedDeliveriesRequest(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationWebhookRegistryClient(as).PurgeFailedDeliveries(ctx, req)
+			return err
+		},
+	}
 )
 
 func init() {
@@ -237,8 +334,21 @@ func init() {
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(setApplicationWebhookFlags)
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(headersFlags())
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(dataFlags("tls-ca", ""))
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(dataFlags("tls-client-cert", ""))
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(dataFlags("tls-client-key", ""))
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
+	applicationsWebhooksFailedDeliveriesListCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksFailedDeliveriesListCommand.Flags().AddFlagSet(deliveryIDsFlags())
+	applicationsWebhooksFailedDeliveriesCommand.AddCommand(applicationsWebhooksFailedDeliveriesListCommand)
+	applicationsWebhooksFailedDeliveriesReplayCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksFailedDeliveriesReplayCommand.Flags().AddFlagSet(deliveryIDsFlags())
+	applicationsWebhooksFailedDeliveriesCommand.AddCommand(applicationsWebhooksFailedDeliveriesReplayCommand)
+	applicationsWebhooksFailedDeliveriesPurgeCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksFailedDeliveriesPurgeCommand.Flags().AddFlagSet(deliveryIDsFlags())
+	applicationsWebhooksFailedDeliveriesCommand.AddCommand(applicationsWebhooksFailedDeliveriesPurgeCommand)
+	applicationsWebhooksCommand.AddCommand(applicationsWebhooksFailedDeliveriesCommand)
 	applicationsCommand.AddCommand(applicationsWebhooksCommand)
 }
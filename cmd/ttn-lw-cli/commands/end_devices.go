@@ -871,6 +871,28 @@ var (
 			return deleteEndDevice(ctx, devID)
 		},
 	}
+	endDevicesLinkQualityCommand = &cobra.Command{
+		Use:     "get-link-quality [application-id] [device-id]",
+		Aliases: []string{"link-quality"},
+		Short:   "Get link quality stats of an end device",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceLinkQualityClient(ns).Get(ctx, devID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	endDevicesClaimCommand = &cobra.Command{
 		Use:   "claim [application-id]",
 		Short: "Claim an end device (EXPERIMENTAL)",
@@ -895,7 +917,12 @@ valid by specifying --invalidate-authentication-code=false.
 As part of claiming, you can optionally provide the target NetID, Network Server
 KEK label and Application Server ID and KEK label. The Network Server and
 Application Server addresses will be taken from the CLI configuration. These
-values will be stored in the Join Server.`,
+values will be stored in the Join Server.
+
+If the device is not registered on a Network Server, provide the frequency plan
+ID with --target-frequency-plan-id. The LoRaWAN versions are taken from the
+device profile, unless provided with --target-lorawan-version and
+--target-lorawan-phy-version.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			targetAppID := getApplicationID(cmd.Flags(), args)
 			if targetAppID == nil {
@@ -957,6 +984,17 @@ values will be stored in the Join Server.`,
 				req.TargetNetworkServerAddress = config.NetworkServerGRPCAddress
 			}
 			req.TargetNetworkServerKEKLabel, _ = cmd.Flags().GetString("target-network-server-kek-label")
+			req.TargetFrequencyPlanID, _ = cmd.Flags().GetString("target-frequency-plan-id")
+			if macVersion, _ := cmd.Flags().GetString("target-lorawan-version"); macVersion != "" {
+				if err := req.TargetLoRaWANVersion.UnmarshalText([]byte(macVersion)); err != nil {
+					return err
+				}
+			}
+			if phyVersion, _ := cmd.Flags().GetString("target-lorawan-phy-version"); phyVersion != "" {
+				if err := req.TargetLoRaWANPHYVersion.UnmarshalText([]byte(phyVersion)); err != nil {
+					return err
+				}
+			}
 			if config.ApplicationServerEnabled {
 				req.TargetApplicationServerAddress = config.ApplicationServerGRPCAddress
 			}
@@ -1255,6 +1293,8 @@ func init() {
 	endDevicesCommand.AddCommand(endDevicesResetCommand)
 	endDevicesDeleteCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesCommand.AddCommand(endDevicesDeleteCommand)
+	endDevicesLinkQualityCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesCommand.AddCommand(endDevicesLinkQualityCommand)
 	endDevicesClaimCommand.Flags().AddFlagSet(applicationIDFlags())
 	endDevicesClaimCommand.Flags().String("source-join-eui", "", "(hex)")
 	endDevicesClaimCommand.Flags().String("source-dev-eui", "", "(hex)")
@@ -1262,6 +1302,9 @@ func init() {
 	endDevicesClaimCommand.Flags().String("target-device-id", "", "")
 	endDevicesClaimCommand.Flags().String("target-net-id", "", "(hex)")
 	endDevicesClaimCommand.Flags().String("target-network-server-kek-label", "", "")
+	endDevicesClaimCommand.Flags().String("target-frequency-plan-id", "", "")
+	endDevicesClaimCommand.Flags().String("target-lorawan-version", "", "")
+	endDevicesClaimCommand.Flags().String("target-lorawan-phy-version", "", "")
 	endDevicesClaimCommand.Flags().String("target-application-server-kek-label", "", "")
 	endDevicesClaimCommand.Flags().String("target-application-server-id", "", "")
 	endDevicesClaimCommand.Flags().Bool("invalidate-authentication-code", true, "invalidate the claim authentication code to block subsequent claiming attempts")
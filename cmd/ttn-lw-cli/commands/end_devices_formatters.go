@@ -0,0 +1,215 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var payloadFormatterVersionIDsFlags = util.FieldFlags(&ttnpb.EndDeviceVersionIdentifiers{}, "version_ids")
+
+func payloadFormatterFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("formatter", "", "payload formatter (JAVASCRIPT|CAYENNELPP|REPOSITORY)")
+	flagSet.String("parameter", "", "payload formatter parameter")
+	flagSet.AddFlagSet(dataFlags("parameter", "payload formatter parameter"))
+	flagSet.Uint32("f-port", 1, "")
+	flagSet.AddFlagSet(payloadFormatterVersionIDsFlags)
+	return flagSet
+}
+
+type payloadFormatterArgs struct {
+	ids        ttnpb.EndDeviceIdentifiers
+	versionIDs *ttnpb.EndDeviceVersionIdentifiers
+	formatter  ttnpb.PayloadFormatter
+	parameter  string
+	fPort      uint32
+}
+
+func getPayloadFormatterArgs(flagSet *pflag.FlagSet, args []string) (*payloadFormatterArgs, error) {
+	devID, err := getEndDeviceID(flagSet, args, true)
+	if err != nil {
+		return nil, err
+	}
+	res := &payloadFormatterArgs{
+		ids: *devID,
+	}
+	formatter, _ := flagSet.GetString("formatter")
+	if err := res.formatter.UnmarshalText([]byte(strings.ToUpper(formatter))); err != nil {
+		return nil, err
+	}
+	if b, err := getDataBytes("parameter", flagSet); err == nil {
+		res.parameter = string(b)
+	} else {
+		res.parameter, _ = flagSet.GetString("parameter")
+	}
+	res.fPort, _ = flagSet.GetUint32("f-port")
+	if paths := util.UpdateFieldMask(flagSet, payloadFormatterVersionIDsFlags); len(paths) > 0 {
+		var versionIDs ttnpb.EndDeviceVersionIdentifiers
+		if err := util.SetFields(&versionIDs, payloadFormatterVersionIDsFlags, "version_ids"); err != nil {
+			return nil, err
+		}
+		res.versionIDs = &versionIDs
+	}
+	return res, nil
+}
+
+func getFRMPayload(flagSet *pflag.FlagSet) ([]byte, error) {
+	frmPayload, _ := flagSet.GetString("frm-payload")
+	return hex.DecodeString(frmPayload)
+}
+
+var (
+	endDevicesFormattersCommand = &cobra.Command{
+		Use:     "formatters",
+		Aliases: []string{"formatter"},
+		Short:   "Test payload formatters",
+		Long: `Test payload formatters
+
+The payload formatters run on the Application Server with the given input only;
+the end device is not affected.`,
+	}
+	endDevicesFormattersDecodeUplinkCommand = &cobra.Command{
+		Use:   "decode-uplink [application-id] [device-id]",
+		Short: "Decode an uplink frame payload",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formatterArgs, err := getPayloadFormatterArgs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			frmPayload, err := getFRMPayload(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsPayloadFormattersClient(as).DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+				EndDeviceIdentifiers: formatterArgs.ids,
+				VersionIDs:           formatterArgs.versionIDs,
+				Formatter:            formatterArgs.formatter,
+				Parameter:            formatterArgs.parameter,
+				FPort:                formatterArgs.fPort,
+				FRMPayload:           frmPayload,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesFormattersEncodeDownlinkCommand = &cobra.Command{
+		Use:   "encode-downlink [application-id] [device-id]",
+		Short: "Encode a downlink decoded payload",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formatterArgs, err := getPayloadFormatterArgs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			var decodedPayload types.Struct
+			if r, err := getDataReader("decoded-payload", cmd.Flags()); err == nil {
+				if err := jsonpb.TTN().NewDecoder(r).Decode(&decodedPayload); err != nil {
+					return err
+				}
+			} else {
+				s, _ := cmd.Flags().GetString("decoded-payload")
+				if err := jsonpb.TTN().NewDecoder(strings.NewReader(s)).Decode(&decodedPayload); err != nil {
+					return err
+				}
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsPayloadFormattersClient(as).EncodeDownlink(ctx, &ttnpb.EncodeDownlinkRequest{
+				EndDeviceIdentifiers: formatterArgs.ids,
+				VersionIDs:           formatterArgs.versionIDs,
+				Formatter:            formatterArgs.formatter,
+				Parameter:            formatterArgs.parameter,
+				FPort:                formatterArgs.fPort,
+				DecodedPayload:       &decodedPayload,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesFormattersDecodeDownlinkCommand = &cobra.Command{
+		Use:   "decode-downlink [application-id] [device-id]",
+		Short: "Decode a downlink frame payload",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formatterArgs, err := getPayloadFormatterArgs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			frmPayload, err := getFRMPayload(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsPayloadFormattersClient(as).DecodeDownlink(ctx, &ttnpb.DecodeDownlinkRequest{
+				EndDeviceIdentifiers: formatterArgs.ids,
+				VersionIDs:           formatterArgs.versionIDs,
+				Formatter:            formatterArgs.formatter,
+				Parameter:            formatterArgs.parameter,
+				FPort:                formatterArgs.fPort,
+				FRMPayload:           frmPayload,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	endDevicesFormattersDecodeUplinkCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesFormattersDecodeUplinkCommand.Flags().AddFlagSet(payloadFormatterFlags())
+	endDevicesFormattersDecodeUplinkCommand.Flags().String("frm-payload", "", "(hex)")
+	endDevicesFormattersCommand.AddCommand(endDevicesFormattersDecodeUplinkCommand)
+	endDevicesFormattersEncodeDownlinkCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesFormattersEncodeDownlinkCommand.Flags().AddFlagSet(payloadFormatterFlags())
+	endDevicesFormattersEncodeDownlinkCommand.Flags().String("decoded-payload", "", "(JSON)")
+	endDevicesFormattersEncodeDownlinkCommand.Flags().AddFlagSet(dataFlags("decoded-payload", "decoded payload (JSON)"))
+	endDevicesFormattersCommand.AddCommand(endDevicesFormattersEncodeDownlinkCommand)
+	endDevicesFormattersDecodeDownlinkCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesFormattersDecodeDownlinkCommand.Flags().AddFlagSet(payloadFormatterFlags())
+	endDevicesFormattersDecodeDownlinkCommand.Flags().String("frm-payload", "", "(hex)")
+	endDevicesFormattersCommand.AddCommand(endDevicesFormattersDecodeDownlinkCommand)
+	endDevicesCommand.AddCommand(endDevicesFormattersCommand)
+}
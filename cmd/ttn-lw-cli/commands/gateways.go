@@ -408,6 +408,70 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	gatewaysConnectionStatsHistory = &cobra.Command{
+		Use:     "get-connection-stats-history [gateway-id]",
+		Aliases: []string{"connection-stats-history", "cnx-stats-history", "stats-history"},
+		Short:   "Get historical connection stats for a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.GetGatewayConnectionStatsHistoryRequest{
+				GatewayIdentifiers: *gtwID,
+			}
+			if req.After, err = getTimestampFlags(cmd.Flags(), "after"); err != nil {
+				return err
+			}
+			if req.Before, err = getTimestampFlags(cmd.Flags(), "before"); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("bucket-duration") {
+				d, _ := cmd.Flags().GetDuration("bucket-duration")
+				req.BucketDuration = &d
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).GetGatewayConnectionStatsHistory(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysSpectrumStats = &cobra.Command{
+		Use:     "get-spectrum-stats [gateway-id]",
+		Aliases: []string{"spectrum-stats"},
+		Short:   "Get spectrum and channel occupancy stats for a connected gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.GetGatewaySpectrumStatsRequest{
+				GatewayIdentifiers: *gtwID,
+			}
+			if cmd.Flags().Changed("window") {
+				d, _ := cmd.Flags().GetDuration("window")
+				req.Window = &d
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).GetGatewaySpectrumStats(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	gatewaysContactInfoCommand = contactInfoCommands("gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		gtwID, err := getGatewayID(cmd.Flags(), args, true)
 		if err != nil {
@@ -415,6 +479,13 @@ var (
 		}
 		return gtwID.EntityIdentifiers(), nil
 	})
+	gatewaysAlertRulesCommand = alertRuleCommands("gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return nil, err
+		}
+		return gtwID.EntityIdentifiers(), nil
+	})
 	gatewaysPurgeCommand = &cobra.Command{
 		Use:     "purge [gateway-id]",
 		Aliases: []string{"permanent-delete", "hard-delete"},
@@ -482,8 +553,18 @@ func init() {
 	gatewaysCommand.AddCommand(gatewaysDeleteCommand)
 	gatewaysConnectionStats.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("after", "only return time buckets that start at or after the specified timestamp"))
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("before", "only return time buckets that start before the specified timestamp"))
+	gatewaysConnectionStatsHistory.Flags().Duration("bucket-duration", 0, "duration of the returned time buckets")
+	gatewaysCommand.AddCommand(gatewaysConnectionStatsHistory)
+	gatewaysSpectrumStats.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysSpectrumStats.Flags().Duration("window", 0, "duration of the window over which the stats are aggregated")
+	gatewaysCommand.AddCommand(gatewaysSpectrumStats)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysContactInfoCommand)
+	gatewaysAlertRulesCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysAlertRulesCommand)
 	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysPurgeCommand.Flags().AddFlagSet(forceFlags())
 	gatewaysCommand.AddCommand(gatewaysPurgeCommand)
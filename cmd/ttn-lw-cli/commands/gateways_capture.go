@@ -0,0 +1,172 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errInvalidCaptureFormat = errors.DefineInvalidArgument("invalid_capture_format", "invalid capture format `{format}`")
+
+// captureFormats maps the capture formats to their file extensions.
+var captureFormats = map[ttnpb.CaptureFormat]string{
+	ttnpb.CAPTURE_FORMAT_PCAPNG: "pcapng",
+	ttnpb.CAPTURE_FORMAT_PCAP:   "pcap",
+	ttnpb.CAPTURE_FORMAT_NDJSON: "ndjson",
+}
+
+func getCaptureFormat(s string) (ttnpb.CaptureFormat, error) {
+	for format, name := range captureFormats {
+		if strings.EqualFold(s, name) {
+			return format, nil
+		}
+	}
+	return 0, errInvalidCaptureFormat.WithAttributes("format", s)
+}
+
+var (
+	gatewaysCaptureCommand = &cobra.Command{
+		Use:   "capture",
+		Short: "Capture gateway traffic",
+		Long: `Capture gateway traffic
+
+The Gateway Server records the raw frames of the gateway frontend and the
+decoded messages of a connected gateway. Captures can be downloaded as pcapng
+or PCAP files with the LoRaTap link type, or as newline-delimited JSON.`,
+		Example: `To capture the traffic of a gateway for 5 minutes:
+  ttn-lw-cli gateways capture start gtw1 --duration 5m
+  ttn-lw-cli gateways capture download gtw1 --format pcapng`,
+	}
+	gatewaysCaptureStartCommand = &cobra.Command{
+		Use:   "start [gateway-id]",
+		Short: "Start capturing the traffic of a connected gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.StartGatewayCaptureRequest{
+				GatewayIdentifiers: *gtwID,
+			}
+			if cmd.Flags().Changed("duration") {
+				d, _ := cmd.Flags().GetDuration("duration")
+				req.Duration = &d
+			}
+			req.MaxPackets, _ = cmd.Flags().GetUint32("max-packets")
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).StartGatewayCapture(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCaptureStopCommand = &cobra.Command{
+		Use:   "stop [gateway-id]",
+		Short: "Stop capturing the traffic of a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).StopGatewayCapture(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCaptureDownloadCommand = &cobra.Command{
+		Use:   "download [gateway-id]",
+		Short: "Download the captured traffic of a gateway",
+		Long: `Download the captured traffic of a gateway
+
+The capture is written to the output file, which defaults to the gateway ID
+with the extension of the format. Use "-" to write the capture to the
+standard output.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			formatName, _ := cmd.Flags().GetString("format")
+			format, err := getCaptureFormat(formatName)
+			if err != nil {
+				return err
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).DownloadGatewayCapture(ctx, &ttnpb.DownloadGatewayCaptureRequest{
+				GatewayIdentifiers: *gtwID,
+				Format:             format,
+			})
+			if err != nil {
+				return err
+			}
+
+			filename, _ := cmd.Flags().GetString("output-file")
+			if filename == "-" {
+				_, err := os.Stdout.Write(res.Data)
+				return err
+			}
+			if filename == "" {
+				filename = gtwID.GatewayID + "." + captureFormats[format]
+			}
+			if err := ioutil.WriteFile(filename, res.Data, 0o644); err != nil {
+				return err
+			}
+			logger.WithFields(log.Fields(
+				"filename", filename,
+				"packet_count", res.Capture.GetPacketCount(),
+			)).Info("Downloaded capture")
+			return nil
+		},
+	}
+)
+
+func init() {
+	gatewaysCaptureStartCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureStartCommand.Flags().Duration("duration", 0, "maximum duration of the capture (default 10m, maximum 1h)")
+	gatewaysCaptureStartCommand.Flags().Uint32("max-packets", 0, "maximum number of packets to capture (default 1000)")
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureStartCommand)
+	gatewaysCaptureStopCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureStopCommand)
+	gatewaysCaptureDownloadCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureDownloadCommand.Flags().String("format", "pcapng", "capture format (pcapng, pcap or ndjson)")
+	gatewaysCaptureDownloadCommand.Flags().String("output-file", "", "file to write the capture to")
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureDownloadCommand)
+	gatewaysCommand.AddCommand(gatewaysCaptureCommand)
+}
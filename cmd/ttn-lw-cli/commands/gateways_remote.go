@@ -0,0 +1,151 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	stdio "io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errNoCommand = errors.DefineInvalidArgument("no_command", "no command set")
+
+var (
+	gatewaysExecCommand = &cobra.Command{
+		Use:   "exec [gateway-id]",
+		Short: "Execute a command on a connected gateway",
+		Long: `Execute a command on a connected gateway
+
+The command is executed on the gateway without waiting for it to complete.
+Remote commands are only supported by gateways that are connected with the
+LoRa Basics Station LNS protocol.`,
+		Example: `To reboot a gateway:
+  ttn-lw-cli gateways exec gtw1 --command reboot
+
+To run a command with arguments:
+  ttn-lw-cli gateways exec gtw1 --command logger --arguments "-t,station,hello"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			command, _ := cmd.Flags().GetString("command")
+			if command == "" {
+				return errNoCommand.New()
+			}
+			arguments, _ := cmd.Flags().GetStringSlice("arguments")
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGsClient(gs).ExecuteGatewayCommand(ctx, &ttnpb.ExecuteGatewayCommandRequest{
+				GatewayIdentifiers: *gtwID,
+				Command:            command,
+				Arguments:          arguments,
+			})
+			return err
+		},
+	}
+	gatewaysRemoteShellCommand = &cobra.Command{
+		Use:     "remote-shell [gateway-id]",
+		Aliases: []string{"shell", "rmtsh"},
+		Short:   "Open a remote shell on a connected gateway",
+		Long: `Open a remote shell on a connected gateway
+
+The standard input is sent to the remote shell and the output of the remote
+shell is written to the standard output. The session is closed when the
+standard input is closed. Remote shells are only supported by gateways that
+are connected with the LoRa Basics Station LNS protocol.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			user, _ := cmd.Flags().GetString("user")
+			term, _ := cmd.Flags().GetString("term")
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := ttnpb.NewGsClient(gs).RemoteShell(ctx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&ttnpb.GatewayRemoteShellRequest{
+				GatewayIDs: gtwID,
+				User:       user,
+				Term:       term,
+			}); err != nil {
+				return err
+			}
+
+			go func() {
+				buf := make([]byte, 1024)
+				for {
+					n, err := os.Stdin.Read(buf)
+					if n > 0 {
+						data := make([]byte, n)
+						copy(data, buf[:n])
+						if err := stream.Send(&ttnpb.GatewayRemoteShellRequest{
+							Data: data,
+						}); err != nil {
+							return
+						}
+					}
+					if err != nil {
+						if err != stdio.EOF {
+							logger.WithError(err).Warn("Failed to read input")
+						}
+						stream.CloseSend()
+						return
+					}
+				}
+			}()
+
+			for {
+				res, err := stream.Recv()
+				if err != nil {
+					if err == stdio.EOF {
+						return nil
+					}
+					return err
+				}
+				if _, err := os.Stdout.Write(res.Data); err != nil {
+					return err
+				}
+			}
+		},
+	}
+)
+
+func init() {
+	gatewaysExecCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysExecCommand.Flags().String("command", "", "command to execute on the gateway")
+	gatewaysExecCommand.Flags().StringSlice("arguments", nil, "arguments of the command")
+	gatewaysCommand.AddCommand(gatewaysExecCommand)
+	gatewaysRemoteShellCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysRemoteShellCommand.Flags().String("user", "", "user to run the remote shell as on the gateway")
+	gatewaysRemoteShellCommand.Flags().String("term", "", "terminal type of the remote shell")
+	gatewaysCommand.AddCommand(gatewaysRemoteShellCommand)
+}
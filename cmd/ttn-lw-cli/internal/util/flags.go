@@ -337,7 +337,7 @@ func addField(fs *pflag.FlagSet, name string, t reflect.Type, maskOnly bool) {
 
 		case "go.thethings.network/lorawan-stack/v3/pkg/types":
 			switch el.Name() {
-			case "DevAddrPrefix":
+			case "DevAddrPrefix", "EUI64Prefix", "NetID":
 				fs.StringSlice(name, nil, "")
 				return
 			}
@@ -0,0 +1,66 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/spf13/cobra"
+	storagesql "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/sql"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
+)
+
+var (
+	asDBCommand = &cobra.Command{
+		Use:   "as-db",
+		Short: "Manage the Application Server database",
+	}
+	asDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Application Server storage integration database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger.Info("Connecting to storage integration database...")
+			db, err := gormutil.Open(ctx, config.AS.Packages.Storage.DatabaseURI)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if dbVersion, ok := db.Get("db:version"); ok {
+				logger.Infof("Detected database %s", dbVersion)
+			}
+
+			logger.Info("Initializing database...")
+			if err = gormutil.Initialize(db); err != nil {
+				return err
+			}
+
+			err = gormutil.Transact(ctx, db, func(db *gorm.DB) error {
+				logger.Info("Migrating table structure...")
+				return storagesql.AutoMigrate(db).Error
+			})
+			if err != nil {
+				return err
+			}
+
+			logger.Info("Successfully migrated")
+			return nil
+		},
+	}
+)
+
+func init() {
+	Root.AddCommand(asDBCommand)
+	asDBCommand.AddCommand(asDBMigrateCommand)
+}
@@ -19,6 +19,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	shared_applicationserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/applicationserver"
 	shared_console "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/console"
+	shared_deviceclaimingserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/deviceclaimingserver"
 	shared_devicerepository "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/devicerepository"
 	shared_devicetemplateconverter "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/devicetemplateconverter"
 	shared_gatewayconfigurationserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/gatewayconfigurationserver"
@@ -31,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	conf "go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
@@ -56,6 +58,7 @@ type Config struct {
 	QRG              qrcodegenerator.Config            `name:"qrg"`
 	PBA              packetbrokeragent.Config          `name:"pba"`
 	DR               devicerepository.Config           `name:"dr"`
+	DCS              deviceclaimingserver.Config       `name:"dcs"`
 	OutputFormat     string                            `name:"output-format" yaml:"output-format" description:"Output format"`
 }
 
@@ -73,6 +76,7 @@ var DefaultConfig = Config{
 	QRG:         shared_qrcodegenerator.DefaultQRCodeGeneratorConfig,
 	PBA:         shared_packetbrokeragent.DefaultPacketBrokerAgentConfig,
 	DR:          shared_devicerepository.DefaultDeviceRepositoryConfig,
+	DCS:         shared_deviceclaimingserver.DefaultDeviceClaimingServerConfig,
 
 	OutputFormat: "json",
 }
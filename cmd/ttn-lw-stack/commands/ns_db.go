@@ -20,12 +20,15 @@ import (
 
 	"github.com/go-redis/redis/v8"
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
 	"github.com/spf13/cobra"
 	"github.com/vmihailenco/msgpack/v5"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	nssql "go.thethings.network/lorawan-stack/v3/pkg/networkserver/sql"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
 )
 
 var (
@@ -87,6 +90,9 @@ var (
 		Use:   "migrate",
 		Short: "Migrate Network Server data",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.NS.DeviceRegistry.Backend == "sql" {
+				return migrateNetworkServerSQLDeviceRegistry()
+			}
 			if config.Redis.IsZero() {
 				panic("Only Redis is supported by this command")
 			}
@@ -447,6 +453,35 @@ var (
 	}
 )
 
+func migrateNetworkServerSQLDeviceRegistry() error {
+	logger.Info("Connecting to device registry database...")
+	db, err := gormutil.Open(ctx, config.NS.DeviceRegistry.DatabaseURI)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if dbVersion, ok := db.Get("db:version"); ok {
+		logger.Infof("Detected database %s", dbVersion)
+	}
+
+	logger.Info("Initializing database...")
+	if err = gormutil.Initialize(db); err != nil {
+		return err
+	}
+
+	err = gormutil.Transact(ctx, db, func(db *gorm.DB) error {
+		logger.Info("Migrating table structure...")
+		return nssql.AutoMigrate(db).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Successfully migrated")
+	return nil
+}
+
 func init() {
 	Root.AddCommand(nsDBCommand)
 	nsDBCommand.AddCommand(nsDBPruneCommand)
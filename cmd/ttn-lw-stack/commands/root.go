@@ -40,6 +40,7 @@ var (
 	name   = "ttn-lw-stack"
 	mgr    = conf.InitializeWithDefaults(name, "ttn_lw", DefaultConfig,
 		conf.WithDeprecatedFlag("interop.sender-client-cas", "use interop.sender-client-ca sub-fields instead"),
+		conf.WithDeprecatedFlag("as.webhooks.queue-size", "use as.webhooks.queue.buffer-size instead"),
 	)
 	config = new(Config)
 
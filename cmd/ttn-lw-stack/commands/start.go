@@ -27,11 +27,14 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
+	asioapstoragesql "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/sql"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -45,10 +48,12 @@ import (
 	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	nssql "go.thethings.network/lorawan-stack/v3/pkg/networkserver/sql"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/web"
 )
 
@@ -71,7 +76,7 @@ func NewNetworkServerDownlinkTaskRedis(conf Config) *redis.Client {
 var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dr|dcs|all]... [flags]",
 	Short: "Start The Things Stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var start struct {
@@ -86,6 +91,7 @@ var startCommand = &cobra.Command{
 			QRCodeGenerator            bool
 			PacketBrokerAgent          bool
 			DeviceRepository           bool
+			DeviceClaimingServer       bool
 		}
 		startDefault := len(args) == 0
 		for _, arg := range args {
@@ -120,6 +126,8 @@ var startCommand = &cobra.Command{
 				start.PacketBrokerAgent = true
 			case "dr":
 				start.DeviceRepository = true
+			case "dcs", "deviceclaimingserver":
+				start.DeviceClaimingServer = true
 			case "all":
 				start.IdentityServer = true
 				start.GatewayServer = true
@@ -132,6 +140,7 @@ var startCommand = &cobra.Command{
 				start.QRCodeGenerator = true
 				start.PacketBrokerAgent = true
 				start.DeviceRepository = true
+				start.DeviceClaimingServer = true
 			default:
 				return errUnknownComponent.WithAttributes("component", arg)
 			}
@@ -218,6 +227,16 @@ var startCommand = &cobra.Command{
 					Redis: redis.New(config.Cache.Redis.WithNamespace("gs", "cache", "connstats")),
 				}
 			}
+			if config.GS.StatsHistory.Enable {
+				config.GS.StatsHistory.Registry = &gsredis.GatewayConnectionStatsHistoryRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("gs", "connstats", "history")),
+				}
+			}
+			if config.GS.ConnectionRegistry.Enable {
+				config.GS.ConnectionRegistry.Registry = &gsredis.GatewayConnectionRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("gs", "connections")),
+				}
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -243,14 +262,27 @@ var startCommand = &cobra.Command{
 			}
 			defer applicationUplinkQueue.Close(ctx)
 			config.NS.ApplicationUplinkQueue.Queue = applicationUplinkQueue
-			devices := &nsredis.DeviceRegistry{
-				Redis:   NewNetworkServerDeviceRegistryRedis(*config),
-				LockTTL: time.Second,
-			}
-			if err := devices.Init(ctx); err != nil {
+			if err := config.NS.DeviceRegistry.Validate(); err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
 			}
-			config.NS.Devices = devices
+			switch config.NS.DeviceRegistry.Backend {
+			case "redis":
+				devices := &nsredis.DeviceRegistry{
+					Redis:   NewNetworkServerDeviceRegistryRedis(*config),
+					LockTTL: time.Second,
+				}
+				if err := devices.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = devices
+			case "sql":
+				db, err := gormutil.Open(ctx, config.NS.DeviceRegistry.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				defer db.Close()
+				config.NS.Devices = nssql.NewDeviceRegistry(db)
+			}
 			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
 			}
@@ -287,9 +319,42 @@ var startCommand = &cobra.Command{
 			config.AS.Packages.Registry = &asioapredis.ApplicationPackagesRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages")),
 			}
+			if config.AS.Packages.Storage.DatabaseURI != "" {
+				db, err := gormutil.Open(ctx, config.AS.Packages.Storage.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				defer db.Close()
+				config.AS.Packages.Storage.Store = asioapstoragesql.New(db)
+			}
 			if config.AS.Webhooks.Target != "" {
 				config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{
-					Redis: redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
+					Redis:           redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
+					KeyVault:        c.KeyVault,
+					EncryptionKeyID: config.AS.Webhooks.EncryptionKeyID,
+				}
+				webhookQueue := asiowebredis.NewDeliveryQueue(
+					redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "queue")),
+					config.AS.Webhooks.RequestQueue().MaxLen(), "as", redisConsumerID,
+				)
+				if err := webhookQueue.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				defer webhookQueue.Close(ctx)
+				config.AS.Webhooks.Queue.Queue = webhookQueue
+				webhookRetries := asiowebredis.NewDeliveryQueue(
+					redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "retries")),
+					config.AS.Webhooks.RetryQueue.MaxLen(), "as", redisConsumerID,
+				)
+				if err := webhookRetries.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				defer webhookRetries.Close(ctx)
+				config.AS.Webhooks.RetryQueue.Queue = webhookRetries
+				config.AS.Webhooks.FailedDeliveries.Store = &asiowebredis.DeadLetterStore{
+					Redis: redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "failed")),
+					Limit: config.AS.Webhooks.FailedDeliveries.Limit,
+					TTL:   config.AS.Webhooks.FailedDeliveries.TTL,
 				}
 			}
 			fetcher, err := config.AS.EndDeviceFetcher.NewFetcher(c)
@@ -297,6 +362,7 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
 			config.AS.EndDeviceFetcher.Fetcher = fetcher
+			config.AS.Packages.Geolocation.Locations = applicationserver.NewRegistryEndDeviceLocationSetter(c)
 			as, err := applicationserver.New(c, &config.AS)
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
@@ -390,6 +456,18 @@ var startCommand = &cobra.Command{
 			_ = dr
 		}
 
+		if start.DeviceClaimingServer {
+			logger.Info("Setting up Device Claiming Server")
+			config.DCS.AuthorizedApplications = &dcsredis.AuthorizedApplicationRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("dcs", "authorized-applications")),
+			}
+			dcs, err := deviceclaimingserver.New(c, &config.DCS)
+			if err != nil {
+				return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
+			}
+			_ = dcs
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
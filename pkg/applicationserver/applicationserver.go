@@ -30,8 +30,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/amqp"  // The AMQP integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka" // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"  // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats"  // The NATS integration provider
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -53,7 +55,7 @@ import (
 
 // ApplicationServer implements the Application Server component.
 //
-// The Application Server exposes the As, AppAs and AsEndDeviceRegistry services.
+// The Application Server exposes the As, AppAs, AsEndDeviceRegistry and AsPayloadFormatters services.
 type ApplicationServer struct {
 	*component.Component
 	ctx context.Context
@@ -72,8 +74,9 @@ type ApplicationServer struct {
 	localDistributor   distribution.Distributor
 
 	grpc struct {
-		asDevices asEndDeviceRegistryServer
-		appAs     ttnpb.AppAsServer
+		asDevices    asEndDeviceRegistryServer
+		asFormatters asPayloadFormattersServer
+		appAs        ttnpb.AppAsServer
 	}
 
 	interopClient InteropClient
@@ -135,6 +138,9 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		AS:       as,
 		kekLabel: conf.DeviceKEKLabel,
 	}
+	as.grpc.asFormatters = asPayloadFormattersServer{
+		AS: as,
+	}
 	as.grpc.appAs = iogrpc.New(as, iogrpc.WithMQTTConfigProvider(as))
 
 	ctx, cancel := context.WithCancel(as.Context())
@@ -218,9 +224,10 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsServer(s, as)
 	ttnpb.RegisterNsAsServer(s, as)
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
+	ttnpb.RegisterAsPayloadFormattersServer(s, as.grpc.asFormatters)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates, as.webhooks.FailedDeliveries()))
 	}
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, as.pubsub)
@@ -231,6 +238,7 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 func (as *ApplicationServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterAsHandler(as.Context(), s, conn)
 	ttnpb.RegisterAsEndDeviceRegistryHandler(as.Context(), s, conn)
+	ttnpb.RegisterAsPayloadFormattersHandler(as.Context(), s, conn)
 	ttnpb.RegisterAppAsHandler(as.Context(), s, conn)
 	if as.webhooks != nil {
 		ttnpb.RegisterApplicationWebhookRegistryHandler(as.Context(), s, conn)
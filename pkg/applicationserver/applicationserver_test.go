@@ -271,10 +271,9 @@ func TestApplicationServer(t *testing.T) {
 			Listen: ":1883",
 		},
 		Webhooks: applicationserver.WebhooksConfig{
-			Registry:  webhookRegistry,
-			Target:    "direct",
-			Timeout:   Timeout,
-			QueueSize: 1,
+			Registry: webhookRegistry,
+			Target:   "direct",
+			Timeout:  Timeout,
 		},
 		PubSub: applicationserver.PubSubConfig{
 			Registry: pubsubRegistry,
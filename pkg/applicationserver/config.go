@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"math"
 	"net/http"
 	"time"
 
@@ -23,7 +24,12 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	clocksyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/clocksync/v1"
+	fragmentationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fragmentation/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/geolocation"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
+	multicastsetupv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/multicastsetup/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -85,13 +91,58 @@ var (
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
-	Templates web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
-	Downlinks web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	Registry         web.WebhookRegistry            `name:"-"`
+	Target           string                         `name:"target" description:"Target of the integration (direct)"`
+	Timeout          time.Duration                  `name:"timeout" description:"Wait timeout of the target to process the request"`
+	Queue            WebhooksQueueConfig            `name:"queue" description:"The queue of requests that are processed by the workers"`
+	QueueSize        int                            `name:"queue-size" description:"Number of requests to queue; deprecated - use queue.buffer-size instead"`
+	Workers          int                            `name:"workers" description:"Number of workers to process requests"`
+	Templates        web.TemplatesConfig            `name:"templates" description:"The store of the webhook templates"`
+	Downlinks        web.DownlinksConfig            `name:"downlink" description:"The downlink queue operations configuration"`
+	Retry            web.RetryConfig                `name:"retry" description:"The default retry policy of failed deliveries"`
+	RetryQueue       WebhooksQueueConfig            `name:"retry-queue" description:"The queue of failed deliveries that are scheduled for retry"`
+	FailedDeliveries WebhooksFailedDeliveriesConfig `name:"failed-deliveries" description:"Retention of deliveries that exhausted their retry attempts"`
+	EncryptionKeyID  string                         `name:"encryption-key-id" description:"ID of the key used to encrypt webhook secrets at rest"`
+}
+
+// WebhooksQueueConfig defines the configuration of a queue of webhook deliveries.
+// If Queue is nil, the deliveries are queued in memory.
+type WebhooksQueueConfig struct {
+	Queue      web.DeliveryQueue `name:"-"`
+	BufferSize uint64            `name:"buffer-size" description:"Approximate maximum number of deliveries in the queue; the oldest deliveries are dropped when the queue is full"`
+}
+
+// MaxLen returns the buffer size as maximum length of the queue.
+func (c WebhooksQueueConfig) MaxLen() int64 {
+	if c.BufferSize > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(c.BufferSize)
+}
+
+// NewQueue returns Queue, or a new in-memory queue if Queue is nil.
+func (c WebhooksQueueConfig) NewQueue() web.DeliveryQueue {
+	if c.Queue != nil {
+		return c.Queue
+	}
+	return web.NewMemoryDeliveryQueue(c.MaxLen())
+}
+
+// RequestQueue returns the configuration of the queue of requests.
+// The deprecated QueueSize is used as buffer size if it is set.
+func (c WebhooksConfig) RequestQueue() WebhooksQueueConfig {
+	queue := c.Queue
+	if c.QueueSize > 0 {
+		queue.BufferSize = uint64(c.QueueSize)
+	}
+	return queue
+}
+
+// WebhooksFailedDeliveriesConfig defines the retention of webhook deliveries that exhausted their retry attempts.
+type WebhooksFailedDeliveriesConfig struct {
+	Store web.DeadLetterStore `name:"-"`
+	Limit int64               `name:"limit" description:"Maximum number of failed deliveries to retain per webhook"`
+	TTL   time.Duration       `name:"ttl" description:"Time to retain failed deliveries"`
 }
 
 // DistributionConfig contains the upstream traffic distribution configuration of the Application Server.
@@ -130,6 +181,12 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 	if status, ok := c.Providers["awsiot"]; ok {
 		providers.AWSIoT = toStatus(status)
 	}
+	if status, ok := c.Providers["kafka"]; ok {
+		providers.Kafka = toStatus(status)
+	}
+	if status, ok := c.Providers["amqp"]; ok {
+		providers.AMQP = toStatus(status)
+	}
 	return &ttnpb.AsConfiguration_PubSub{
 		Providers: providers,
 	}
@@ -138,7 +195,9 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 // ApplicationPackagesConfig contains application packages associations configuration.
 type ApplicationPackagesConfig struct {
 	packages.Config `name:",squash"`
-	Registry        packages.Registry `name:"-"`
+	Registry        packages.Registry  `name:"-"`
+	Storage         storage.Config     `name:"storage" description:"Storage integration configuration"`
+	Geolocation     geolocation.Config `name:"-"`
 }
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
@@ -153,6 +212,7 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 			Client: &http.Client{
 				Timeout: c.Timeout,
 			},
+			Context: ctx,
 		}
 	default:
 		return nil, errWebhooksTarget.WithAttributes("target", c.Target)
@@ -160,21 +220,26 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry.New()
 	}
-	if c.QueueSize > 0 || c.Workers > 0 {
-		target = &web.QueuedSink{
-			Target:  target,
-			Queue:   make(chan *http.Request, c.QueueSize),
-			Workers: c.Workers,
-		}
+	retry := &web.RetrySink{
+		Target:      target,
+		Queue:       c.RetryQueue.NewQueue(),
+		DeadLetters: c.FailedDeliveries.Store,
+		Registry:    c.Registry,
+		Config:      c.Retry,
+		Workers:     c.Workers,
 	}
-	if controllable, ok := target.(web.ControllableSink); ok {
-		go func() {
-			if err := controllable.Run(ctx); err != nil && !errors.IsCanceled(err) {
-				log.FromContext(ctx).WithError(err).Error("Webhooks target sink failed")
-			}
-		}()
+	queued := &web.QueuedSink{
+		Target:   retry,
+		Queue:    c.RequestQueue().NewQueue(),
+		Registry: c.Registry,
+		Workers:  c.Workers,
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, target, c.Downlinks)
+	go func() {
+		if err := queued.Run(ctx); err != nil && !errors.IsCanceled(err) {
+			log.FromContext(ctx).WithError(err).Error("Webhooks target sink failed")
+		}
+	}()
+	return web.NewWebhooks(ctx, server, c.Registry, queued, c.Downlinks)
 }
 
 // NewPubSub returns a new pubsub.PubSub based on the configuration.
@@ -202,6 +267,25 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	loradmsHandler := loraclouddevicemanagementv1.New(server, c.Registry)
 	handlers[loradmsHandler.Package().Name] = loradmsHandler
 
+	// Initialize LoRaWAN application layer package handlers
+	for _, handler := range []packages.ApplicationPackageHandler{
+		clocksyncv1.New(server),
+		multicastsetupv1.New(server, c.Registry),
+		fragmentationv1.New(server, c.Registry),
+	} {
+		handlers[handler.Package().Name] = handler
+	}
+
+	// Initialize the LoRa geolocation package handler
+	geolocationHandler := geolocation.New(server, c.Geolocation)
+	handlers[geolocationHandler.Package().Name] = geolocationHandler
+
+	// Initialize the storage integration package handler
+	if c.Storage.Store != nil {
+		storageHandler := storage.New(ctx, server, c.Storage)
+		handlers[storageHandler.Package().Name] = storageHandler
+	}
+
 	return packages.New(ctx, server, c.Registry, handlers)
 }
 
@@ -0,0 +1,135 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/bluele/gcache"
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/geolocation"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+const (
+	// locationChangeDistance is the horizontal distance in meters within which a location is considered unchanged.
+	locationChangeDistance = 10.0
+	// locationChangeAltitude is the altitude difference in meters within which a location is considered unchanged.
+	locationChangeAltitude = 10
+
+	locationCacheSize = 4096
+	locationCacheTTL  = time.Hour
+)
+
+// locationChanged returns whether the location changed beyond the distance and altitude thresholds.
+func locationChanged(from, to ttnpb.Location) bool {
+	if from.Source != to.Source {
+		return true
+	}
+	if d := from.Altitude - to.Altitude; d > locationChangeAltitude || d < -locationChangeAltitude {
+		return true
+	}
+	// Use an equirectangular approximation of the distance, which is accurate for small distances.
+	const earthRadius = 6371008.8
+	x := (to.Longitude - from.Longitude) * math.Pi / 180 * math.Cos((from.Latitude+to.Latitude)/2*math.Pi/180)
+	y := (to.Latitude - from.Latitude) * math.Pi / 180
+	return math.Hypot(x, y)*earthRadius > locationChangeDistance
+}
+
+// endDeviceLocationSetter sets end device locations in the Entity Registry.
+type endDeviceLocationSetter struct {
+	c *component.Component
+}
+
+// NewRegistryEndDeviceLocationSetter returns a new geolocation.LocationSetter that sets end device locations in the
+// Entity Registry. Locations that did not change since they were last set are not set again.
+func NewRegistryEndDeviceLocationSetter(c *component.Component) geolocation.LocationSetter {
+	return NewCachedEndDeviceLocationSetter(
+		&endDeviceLocationSetter{c},
+		gcache.New(locationCacheSize).LRU().Expiration(locationCacheTTL).Build(),
+	)
+}
+
+// SetLocation implements the geolocation.LocationSetter interface.
+// The location of the given service is set, while the locations of other services are retained. As the Entity
+// Registry updates the locations of an end device as a whole, the locations are retrieved first. If the stored
+// location of the service did not change, the locations are not updated.
+func (s *endDeviceLocationSetter) SetLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, service string, location ttnpb.Location, apiKey string) error {
+	cc, err := s.c.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, ids)
+	if err != nil {
+		return err
+	}
+	callOpt := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     apiKey,
+		AllowInsecure: s.c.AllowInsecureForCredentials(),
+	})
+	cl := ttnpb.NewEndDeviceRegistryClient(cc)
+	dev, err := cl.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{"locations"},
+		},
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+	if stored, ok := dev.Locations[service]; ok && stored != nil && !locationChanged(*stored, location) {
+		return nil
+	}
+	if dev.Locations == nil {
+		dev.Locations = make(map[string]*ttnpb.Location, 1)
+	}
+	dev.Locations[service] = &location
+	_, err = cl.Update(ctx, &ttnpb.UpdateEndDeviceRequest{
+		EndDevice: *dev,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{"locations"},
+		},
+	}, callOpt)
+	return err
+}
+
+type cachedEndDeviceLocationSetter struct {
+	setter geolocation.LocationSetter
+	cache  gcache.Cache
+}
+
+// NewCachedEndDeviceLocationSetter wraps a geolocation.LocationSetter with a local cache of the locations that are set.
+// Locations that did not change beyond a threshold since they were last set by the service are not set again, until
+// the cached location expires.
+func NewCachedEndDeviceLocationSetter(setter geolocation.LocationSetter, cache gcache.Cache) geolocation.LocationSetter {
+	return &cachedEndDeviceLocationSetter{setter, cache}
+}
+
+// SetLocation implements the geolocation.LocationSetter interface.
+func (s *cachedEndDeviceLocationSetter) SetLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, service string, location ttnpb.Location, apiKey string) error {
+	key := fmt.Sprintf("%s:%s", unique.ID(ctx, ids), service)
+	if cached, err := s.cache.Get(key); err == nil && !locationChanged(cached.(ttnpb.Location), location) {
+		return nil
+	}
+	if err := s.setter.SetLocation(ctx, ids, service, location, apiKey); err != nil {
+		return err
+	}
+	s.cache.Set(key, location)
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bluele/gcache"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockLocationSetter struct {
+	numCalls int
+}
+
+func (s *mockLocationSetter) SetLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, service string, location ttnpb.Location, apiKey string) error {
+	s.numCalls++
+	return nil
+}
+
+func TestEndDeviceLocationSetter(t *testing.T) {
+	t.Run("Cache", func(t *testing.T) {
+		s := &mockLocationSetter{}
+
+		fakeClock := gcache.NewFakeClock()
+		cache := gcache.New(-1).Clock(fakeClock).Expiration(time.Hour).Build()
+
+		dev1 := ttnpb.EndDeviceIdentifiers{
+			DeviceID: "dev1",
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+		}
+		dev2 := ttnpb.EndDeviceIdentifiers{
+			DeviceID: "dev2",
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app2",
+			},
+		}
+		location := ttnpb.Location{
+			Latitude:  52.3740,
+			Longitude: 4.8897,
+			Altitude:  10,
+			Accuracy:  100,
+			Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+		}
+
+		cs := applicationserver.NewCachedEndDeviceLocationSetter(s, cache)
+		setLocation := func(ids ttnpb.EndDeviceIdentifiers, service string, location ttnpb.Location) {
+			if err := cs.SetLocation(test.Context(), ids, service, location, "key"); err != nil {
+				t.Fatalf("Failed to set location: %s", err)
+			}
+		}
+
+		t.Run("Cold", func(t *testing.T) {
+			a := assertions.New(t)
+			setLocation(dev1, "geolocation", location)
+			a.So(s.numCalls, should.Equal, 1)
+			setLocation(dev1, "geolocation", location)
+			a.So(s.numCalls, should.Equal, 1)
+		})
+
+		t.Run("WithinThreshold", func(t *testing.T) {
+			a := assertions.New(t)
+			moved := location
+			moved.Latitude += 0.00005 // About 5.5 meters.
+			moved.Altitude += 5
+			moved.Accuracy = 50
+			setLocation(dev1, "geolocation", moved)
+			a.So(s.numCalls, should.Equal, 1)
+		})
+
+		t.Run("BeyondThreshold", func(t *testing.T) {
+			a := assertions.New(t)
+			moved := location
+			moved.Longitude += 0.001 // About 68 meters.
+			setLocation(dev1, "geolocation", moved)
+			a.So(s.numCalls, should.Equal, 2)
+			setLocation(dev1, "geolocation", moved)
+			a.So(s.numCalls, should.Equal, 2)
+
+			moved.Altitude += 20
+			setLocation(dev1, "geolocation", moved)
+			a.So(s.numCalls, should.Equal, 3)
+
+			moved.Source = ttnpb.SOURCE_LORA_TDOA_GEOLOCATION
+			setLocation(dev1, "geolocation", moved)
+			a.So(s.numCalls, should.Equal, 4)
+		})
+
+		t.Run("Expire", func(t *testing.T) {
+			a := assertions.New(t)
+			fakeClock.Advance(2 * time.Hour)
+			setLocation(dev1, "geolocation", location)
+			a.So(s.numCalls, should.Equal, 5)
+			setLocation(dev1, "geolocation", location)
+			a.So(s.numCalls, should.Equal, 5)
+		})
+
+		t.Run("OtherDevice", func(t *testing.T) {
+			a := assertions.New(t)
+			setLocation(dev2, "geolocation", location)
+			a.So(s.numCalls, should.Equal, 6)
+		})
+
+		t.Run("OtherService", func(t *testing.T) {
+			a := assertions.New(t)
+			setLocation(dev1, "other", location)
+			a.So(s.numCalls, should.Equal, 7)
+		})
+	})
+}
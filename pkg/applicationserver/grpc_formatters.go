@@ -0,0 +1,122 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errPayloadFormatter = errors.Define("payload_formatter", "payload formatter failed")
+
+// asPayloadFormattersServer runs payload formatters on the given input, without affecting end devices or messages.
+type asPayloadFormattersServer struct {
+	AS *ApplicationServer
+}
+
+func (s asPayloadFormattersServer) formatter(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, formatter ttnpb.PayloadFormatter) (messageprocessors.PayloadEncodeDecoder, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	mp, ok := s.AS.formatters[formatter]
+	if !ok {
+		return nil, errFormatterNotConfigured.WithAttributes("formatter", formatter)
+	}
+	return mp, nil
+}
+
+// runPayloadFormatter calls f and returns its execution time and the details of the error it returned, if any.
+func runPayloadFormatter(f func() error) (time.Duration, *ttnpb.ErrorDetails) {
+	start := time.Now()
+	err := f()
+	executionTime := time.Since(start)
+	if err == nil {
+		return executionTime, nil
+	}
+	if ttnErr, ok := errors.From(err); ok {
+		return executionTime, ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return executionTime, ttnpb.ErrorDetailsToProto(errPayloadFormatter.WithCause(err))
+}
+
+// DecodeUplink implements ttnpb.AsPayloadFormattersServer.
+func (s asPayloadFormattersServer) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+	mp, err := s.formatter(ctx, req.EndDeviceIdentifiers, req.Formatter)
+	if err != nil {
+		return nil, err
+	}
+	msg := &ttnpb.ApplicationUplink{
+		FPort:      req.FPort,
+		FRMPayload: req.FRMPayload,
+	}
+	res := &ttnpb.DecodeUplinkResponse{}
+	res.ExecutionTime, res.Error = runPayloadFormatter(func() error {
+		return mp.DecodeUplink(ctx, req.EndDeviceIdentifiers, req.VersionIDs, msg, req.Parameter)
+	})
+	if res.Error == nil {
+		res.DecodedPayload = msg.DecodedPayload
+		res.NormalizedPayload = msg.NormalizedPayload
+		res.NormalizedPayloadWarnings = msg.NormalizedPayloadWarnings
+	}
+	res.DecodedPayloadWarnings = msg.DecodedPayloadWarnings
+	return res, nil
+}
+
+// EncodeDownlink implements ttnpb.AsPayloadFormattersServer.
+func (s asPayloadFormattersServer) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error) {
+	mp, err := s.formatter(ctx, req.EndDeviceIdentifiers, req.Formatter)
+	if err != nil {
+		return nil, err
+	}
+	msg := &ttnpb.ApplicationDownlink{
+		FPort:          req.FPort,
+		DecodedPayload: req.DecodedPayload,
+	}
+	res := &ttnpb.EncodeDownlinkResponse{}
+	res.ExecutionTime, res.Error = runPayloadFormatter(func() error {
+		return mp.EncodeDownlink(ctx, req.EndDeviceIdentifiers, req.VersionIDs, msg, req.Parameter)
+	})
+	if res.Error == nil {
+		res.FRMPayload, res.FPort = msg.FRMPayload, msg.FPort
+	}
+	res.DecodedPayloadWarnings = msg.DecodedPayloadWarnings
+	return res, nil
+}
+
+// DecodeDownlink implements ttnpb.AsPayloadFormattersServer.
+func (s asPayloadFormattersServer) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+	mp, err := s.formatter(ctx, req.EndDeviceIdentifiers, req.Formatter)
+	if err != nil {
+		return nil, err
+	}
+	msg := &ttnpb.ApplicationDownlink{
+		FPort:      req.FPort,
+		FRMPayload: req.FRMPayload,
+	}
+	res := &ttnpb.DecodeDownlinkResponse{}
+	res.ExecutionTime, res.Error = runPayloadFormatter(func() error {
+		return mp.DecodeDownlink(ctx, req.EndDeviceIdentifiers, req.VersionIDs, msg, req.Parameter)
+	})
+	if res.Error == nil {
+		res.DecodedPayload = msg.DecodedPayload
+	}
+	res.DecodedPayloadWarnings = msg.DecodedPayloadWarnings
+	return res, nil
+}
@@ -0,0 +1,210 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPayloadFormatters(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-application",
+		},
+		DeviceID: "foo-device",
+	}
+
+	as := test.Must(New(
+		componenttest.NewComponent(t, &component.Config{}),
+		&Config{
+			Links: &MockLinkRegistry{
+				GetFunc: func(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.ApplicationLink, error) {
+					return nil, errNotFound
+				},
+			},
+			Devices: &MockDeviceRegistry{
+				GetFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+					test.MustTFromContext(ctx).Errorf("GetFunc must not be called")
+					return nil, errNotFound
+				},
+			},
+		})).(*ApplicationServer)
+	as.AddContextFiller(func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), ids.ApplicationIdentifiers): ttnpb.RightsFrom(
+					ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+				),
+			},
+		})
+	})
+	as.AddContextFiller(func(ctx context.Context) context.Context {
+		ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+		_ = cancel
+		return ctx
+	})
+	as.AddContextFiller(func(ctx context.Context) context.Context {
+		return test.ContextWithTB(ctx, t)
+	})
+	componenttest.StartComponent(t, as.Component)
+	defer as.Close()
+
+	ctx := as.FillContext(test.Context())
+	client := ttnpb.NewAsPayloadFormattersClient(as.LoopbackConn())
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := client.DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: "bar-application",
+				},
+				DeviceID: "foo-device",
+			},
+			Formatter:  ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			FPort:      1,
+			FRMPayload: []byte{0x01, 0x67, 0x01, 0x10},
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("FormatterNotConfigured", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := client.DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+			EndDeviceIdentifiers: ids,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			FPort:                1,
+		})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+
+	t.Run("DecodeUplink", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := client.DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+			EndDeviceIdentifiers: ids,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			Parameter: `function decodeUplink(input) {
+				return {
+					data: { sum: input.bytes[0] + input.bytes[1], port: input.fPort },
+					warnings: ["test warning"]
+				};
+			}
+			function normalizeUplink(input) {
+				return { data: { air: { temperature: input.data.sum } } };
+			}`,
+			FPort:      42,
+			FRMPayload: []byte{0x01, 0x02},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res.Error, should.BeNil)
+		a.So(res.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"sum":  {Kind: &pbtypes.Value_NumberValue{NumberValue: 3}},
+				"port": {Kind: &pbtypes.Value_NumberValue{NumberValue: 42}},
+			},
+		})
+		a.So(res.DecodedPayloadWarnings, should.Resemble, []string{"test warning"})
+		a.So(res.NormalizedPayload, should.Resemble, []*pbtypes.Struct{
+			{
+				Fields: map[string]*pbtypes.Value{
+					"air": {Kind: &pbtypes.Value_StructValue{StructValue: &pbtypes.Struct{
+						Fields: map[string]*pbtypes.Value{
+							"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: 3}},
+						},
+					}}},
+				},
+			},
+		})
+		a.So(res.ExecutionTime, should.BeGreaterThan, time.Duration(0))
+	})
+
+	t.Run("DecodeUplinkErrors", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := client.DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+			EndDeviceIdentifiers: ids,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			Parameter: `function decodeUplink(input) {
+				return { errors: ["invalid payload"] };
+			}`,
+			FPort:      1,
+			FRMPayload: []byte{0x01},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if a.So(res.Error, should.NotBeNil) {
+			a.So(res.Error.Name, should.Equal, "output_errors")
+		}
+		a.So(res.DecodedPayload, should.BeNil)
+	})
+
+	t.Run("EncodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := client.EncodeDownlink(ctx, &ttnpb.EncodeDownlinkRequest{
+			EndDeviceIdentifiers: ids,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			FPort:                1,
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"value_2": {Kind: &pbtypes.Value_NumberValue{NumberValue: -50.51}},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res.Error, should.BeNil)
+		a.So(res.FRMPayload, should.Resemble, []byte{2, 236, 69})
+		a.So(res.FPort, should.Equal, uint32(1))
+	})
+
+	t.Run("DecodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := client.DecodeDownlink(ctx, &ttnpb.DecodeDownlinkRequest{
+			EndDeviceIdentifiers: ids,
+			Formatter:            ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			Parameter: `function decodeDownlink(input) {
+				return { data: { value: input.bytes[0] } };
+			}`,
+			FPort:      1,
+			FRMPayload: []byte{0x2a},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res.Error, should.BeNil)
+		a.So(res.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"value": {Kind: &pbtypes.Value_NumberValue{NumberValue: 42}},
+			},
+		})
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clocksyncv1
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	packageVersionCID           = 0x00
+	appTimeCID                  = 0x01
+	deviceAppTimePeriodicityCID = 0x02
+)
+
+// PackageVersionAns is the answer of the end device to a PackageVersionReq.
+type PackageVersionAns struct {
+	PackageIdentifier uint8 `json:"package_identifier"`
+	PackageVersion    uint8 `json:"package_version"`
+}
+
+// AppTimeReq is the request of the end device to synchronize its clock.
+type AppTimeReq struct {
+	// DeviceTime is the time of the end device in seconds since the GPS epoch, modulo 2^32.
+	DeviceTime  uint32 `json:"device_time"`
+	AnsRequired bool   `json:"ans_required"`
+	TokenReq    uint8  `json:"token_req"`
+}
+
+// AppTimeAns is the answer to an AppTimeReq.
+type AppTimeAns struct {
+	// TimeCorrection is the correction in seconds that the end device should apply to its clock.
+	TimeCorrection int32 `json:"time_correction"`
+	TokenAns       uint8 `json:"token_ans"`
+}
+
+// DeviceAppTimePeriodicityAns is the answer of the end device to a DeviceAppTimePeriodicityReq.
+type DeviceAppTimePeriodicityAns struct {
+	NotSupported bool   `json:"not_supported"`
+	Time         uint32 `json:"time"`
+}
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "command `{cid}` must be {length} bytes long")
+)
+
+// decodeUplink decodes the commands in the given uplink payload.
+func decodeUplink(b []byte) ([]interface{}, error) {
+	var cmds []interface{}
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		var n int
+		switch cid {
+		case packageVersionCID:
+			n = 2
+		case appTimeCID:
+			n = 5
+		case deviceAppTimePeriodicityCID:
+			n = 5
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b) < n {
+			return nil, errCommandLength.WithAttributes("cid", cid, "length", n)
+		}
+		switch cid {
+		case packageVersionCID:
+			cmds = append(cmds, &PackageVersionAns{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			})
+		case appTimeCID:
+			cmds = append(cmds, &AppTimeReq{
+				DeviceTime:  binary.LittleEndian.Uint32(b[0:4]),
+				AnsRequired: b[4]&0x10 != 0,
+				TokenReq:    b[4] & 0x0f,
+			})
+		case deviceAppTimePeriodicityCID:
+			cmds = append(cmds, &DeviceAppTimePeriodicityAns{
+				NotSupported: b[0]&0x01 != 0,
+				Time:         binary.LittleEndian.Uint32(b[1:5]),
+			})
+		}
+		b = b[n:]
+	}
+	return cmds, nil
+}
+
+// appendAppTimeAns appends the encoded AppTimeAns to b.
+func appendAppTimeAns(b []byte, ans AppTimeAns) []byte {
+	b = append(b, appTimeCID, 0, 0, 0, 0, ans.TokenAns&0x0f)
+	binary.LittleEndian.PutUint32(b[len(b)-5:], uint32(ans.TimeCorrection))
+	return b
+}
@@ -0,0 +1,86 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clocksyncv1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecodeUplink(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Commands []interface{}
+		Error    error
+	}{
+		{
+			Name:    "Empty",
+			Payload: []byte{},
+		},
+		{
+			Name:    "PackageVersionAns/AppTimeReq",
+			Payload: []byte{0x00, 0x01, 0x01, 0x01, 0x04, 0x03, 0x02, 0x01, 0x13},
+			Commands: []interface{}{
+				&PackageVersionAns{PackageIdentifier: 1, PackageVersion: 1},
+				&AppTimeReq{DeviceTime: 0x01020304, AnsRequired: true, TokenReq: 3},
+			},
+		},
+		{
+			Name:    "DeviceAppTimePeriodicityAns",
+			Payload: []byte{0x02, 0x01, 0x04, 0x03, 0x02, 0x01},
+			Commands: []interface{}{
+				&DeviceAppTimePeriodicityAns{NotSupported: true, Time: 0x01020304},
+			},
+		},
+		{
+			Name:    "UnknownCommand",
+			Payload: []byte{0x7f},
+			Error:   errUnknownCommand,
+		},
+		{
+			Name:    "ShortCommand",
+			Payload: []byte{0x01, 0x04, 0x03},
+			Error:   errCommandLength,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := decodeUplink(tc.Payload)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+func TestAppTimeAns(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(appendAppTimeAns(nil, AppTimeAns{TimeCorrection: -2, TokenAns: 3}), should.Resemble, []byte{0x01, 0xfe, 0xff, 0xff, 0xff, 0x03})
+
+	now := time.Unix(1600000000, 0).UTC()
+	deviceTime := uint32(gpstime.ToGPS(now) / time.Second)
+	a.So(timeCorrection(now, deviceTime), should.Equal, int32(0))
+	a.So(timeCorrection(now, deviceTime-10), should.Equal, int32(10))
+	a.So(timeCorrection(now.Add(-5*time.Second), deviceTime), should.Equal, int32(-5))
+}
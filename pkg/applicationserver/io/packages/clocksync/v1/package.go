@@ -0,0 +1,138 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clocksyncv1 implements the LoRaWAN Application Layer Clock Synchronization v1.0.0 application package.
+package clocksyncv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const (
+	packageName = "lorawan-clock-sync-v1"
+
+	// DefaultFPort is the default FPort of the clock synchronization package.
+	DefaultFPort = 202
+)
+
+// ClockSyncPackage is the LoRaWAN Application Layer Clock Synchronization application package.
+type ClockSyncPackage struct {
+	server io.Server
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         packageName,
+		DefaultFPort: DefaultFPort,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/clocksync/v1")
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+	fPort := def.GetFPort()
+	if assoc != nil {
+		fPort = assoc.FPort
+	}
+	if msg.FPort != fPort {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+
+	cmds, err := decodeUplink(msg.FRMPayload)
+	if err != nil {
+		return err
+	}
+	var res []byte
+	for _, cmd := range cmds {
+		req, ok := cmd.(*AppTimeReq)
+		if !ok {
+			continue
+		}
+		ans := AppTimeAns{
+			TimeCorrection: timeCorrection(uplinkTime(msg), req.DeviceTime),
+			TokenAns:       req.TokenReq,
+		}
+		log.FromContext(ctx).WithField("time_correction", ans.TimeCorrection).Debug("Received clock synchronization request")
+		if req.AnsRequired || ans.TimeCorrection != 0 {
+			res = appendAppTimeAns(res, ans)
+		}
+	}
+	if len(res) > 0 {
+		if err := p.server.DownlinkQueuePush(ctx, up.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+			FPort:      fPort,
+			FRMPayload: res,
+		}}); err != nil {
+			return err
+		}
+	}
+	return packages.PublishServiceData(ctx, p.server, up, packageName, map[string]interface{}{
+		"commands": cmds,
+	})
+}
+
+// uplinkTime returns the time at which the uplink was received. The gateway time is preferred
+// over the time at which the Application Server received the uplink, as it is more accurate.
+func uplinkTime(msg *ttnpb.ApplicationUplink) time.Time {
+	for _, md := range msg.RxMetadata {
+		if md.Time != nil {
+			return *md.Time
+		}
+	}
+	return msg.ReceivedAt
+}
+
+// timeCorrection returns the correction in seconds that the end device should apply to its clock,
+// given its device time in seconds since the GPS epoch, modulo 2^32.
+func timeCorrection(t time.Time, deviceTime uint32) int32 {
+	return int32(uint32(gpstime.ToGPS(t)/time.Second) - deviceTime)
+}
+
+// New instantiates the LoRaWAN Application Layer Clock Synchronization package.
+func New(server io.Server) packages.ApplicationPackageHandler {
+	return &ClockSyncPackage{
+		server: server,
+	}
+}
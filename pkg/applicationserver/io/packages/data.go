@@ -0,0 +1,131 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// StateField is the association data field in which application packages store the state of the end device.
+const StateField = "state"
+
+// DecodeData decodes the given association data into v, which must be a pointer to a struct with JSON tags.
+// Fields of v that are not present in the data are left untouched, such that device-specific
+// association data can be decoded over the default association data.
+func DecodeData(st *types.Struct, v interface{}) error {
+	if st == nil {
+		return nil
+	}
+	b, err := jsonpb.TTN().Marshal(st)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// EncodeData encodes v as association data.
+func EncodeData(v interface{}) (*types.Struct, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var st types.Struct
+	if err := jsonpb.TTN().Unmarshal(b, &st); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+var errPackageMismatch = errors.DefineFailedPrecondition("package_mismatch", "association uses package `{name}`")
+
+// UpdateAssociationState decodes the state stored in the association data into state, calls f and stores
+// the updated state in the association data. The other fields of the association data are left untouched.
+// If the association does not exist, it is created with the given package name.
+func UpdateAssociationState(ctx context.Context, registry AssociationRegistry, ids ttnpb.ApplicationPackageAssociationIdentifiers, packageName string, state interface{}, f func() error) error {
+	_, err := registry.SetAssociation(ctx, ids, []string{"data", "package_name"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			sets := []string{"data"}
+			if assoc == nil {
+				assoc = &ttnpb.ApplicationPackageAssociation{
+					ApplicationPackageAssociationIdentifiers: ids,
+					PackageName:                              packageName,
+				}
+				sets = append(sets,
+					"ids.end_device_ids",
+					"ids.f_port",
+					"package_name",
+				)
+			} else if assoc.PackageName != packageName {
+				return nil, nil, errPackageMismatch.WithAttributes("name", assoc.PackageName)
+			}
+			if v, ok := assoc.Data.GetFields()[StateField]; ok {
+				if err := DecodeData(v.GetStructValue(), state); err != nil {
+					return nil, nil, err
+				}
+			}
+			if err := f(); err != nil {
+				return nil, nil, err
+			}
+			st, err := EncodeData(state)
+			if err != nil {
+				return nil, nil, err
+			}
+			if assoc.Data == nil {
+				assoc.Data = &types.Struct{}
+			}
+			if assoc.Data.Fields == nil {
+				assoc.Data.Fields = make(map[string]*types.Value)
+			}
+			assoc.Data.Fields[StateField] = &types.Value{
+				Kind: &types.Value_StructValue{
+					StructValue: st,
+				},
+			}
+			return assoc, sets, nil
+		},
+	)
+	return err
+}
+
+// PublishServiceData publishes data as service data of the given service, in response to the upstream message.
+func PublishServiceData(ctx context.Context, server io.Server, up *ttnpb.ApplicationUp, service string, data interface{}) error {
+	st, err := EncodeData(data)
+	if err != nil {
+		return err
+	}
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIDs, fmt.Sprintf("as:packages:%s:%s", service, events.NewCorrelationID()))...)
+	now := time.Now().UTC()
+	return server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: up.EndDeviceIdentifiers,
+		CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:           &now,
+		Up: &ttnpb.ApplicationUp_ServiceData{
+			ServiceData: &ttnpb.ApplicationServiceData{
+				Data:    st,
+				Service: service,
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+)
+
+type packageData struct {
+	FragIndex      uint8 `json:"frag_index"`
+	McGroupBitMask uint8 `json:"mc_group_bit_mask"`
+	FragSize       uint8 `json:"frag_size"`
+	// Redundancy is the number of redundancy fragments that are sent after the uncoded fragments.
+	Redundancy    uint16 `json:"redundancy"`
+	BlockAckDelay uint8  `json:"block_ack_delay"`
+	Descriptor    uint32 `json:"descriptor"`
+	// Data is the data block to transport, for example a firmware image.
+	Data []byte `json:"data,omitempty"`
+	// MulticastDeviceID is the ID of the multicast end device of the application, over which the fragments are sent.
+	MulticastDeviceID string `json:"multicast_device_id"`
+	// SessionTime is the time from which the fragments are queued on the multicast end device.
+	// If not set, the fragments are queued once an end device set up the fragmentation session.
+	SessionTime *time.Time `json:"session_time,omitempty"`
+}
+
+// session returns the identifier of the fragmentation session, which changes when the configuration changes.
+func (d packageData) session() string {
+	h := sha256.New()
+	var b [10]byte
+	b[0] = d.FragIndex
+	b[1] = d.McGroupBitMask
+	b[2] = d.FragSize
+	binary.LittleEndian.PutUint16(b[3:5], d.Redundancy)
+	b[5] = d.BlockAckDelay
+	binary.LittleEndian.PutUint32(b[6:10], d.Descriptor)
+	h.Write(b[:])
+	h.Write(d.Data)
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// deviceState is the state of the fragmentation session on a unicast end device.
+type deviceState struct {
+	Session   string                `json:"session,omitempty"`
+	SetupAns  *FragSessionSetupAns  `json:"setup_ans,omitempty"`
+	StatusAns *FragSessionStatusAns `json:"status_ans,omitempty"`
+}
+
+// multicastState is the state of the fragmentation session on the multicast end device.
+type multicastState struct {
+	Session  string     `json:"session,omitempty"`
+	QueuedAt *time.Time `json:"queued_at,omitempty"`
+	NbFrag   int        `json:"nb_frag,omitempty"`
+}
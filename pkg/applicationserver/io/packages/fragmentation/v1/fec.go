@@ -0,0 +1,92 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+// maxFragments is the maximum number of fragments, including redundancy fragments, in a fragmentation session.
+const maxFragments = 1<<14 - 1
+
+var (
+	errNoData           = errors.DefineInvalidArgument("no_data", "no data to fragment")
+	errFragSize         = errors.DefineInvalidArgument("frag_size", "invalid fragment size `{frag_size}`")
+	errTooManyFragments = errors.DefineInvalidArgument("too_many_fragments", "number of fragments `{count}` exceeds maximum of `{max}`")
+)
+
+// prbs23 is the pseudo-random binary sequence generator used to generate the parity check matrix.
+func prbs23(x uint32) uint32 {
+	b0 := x & 1
+	b1 := (x & 0x20) >> 5
+	return x>>1 + (b0^b1)<<22
+}
+
+func isPowerOfTwo(n int) bool {
+	return n != 0 && n&(n-1) == 0
+}
+
+// matrixLine returns line n, starting from 1, of the parity check matrix of m uncoded fragments.
+func matrixLine(n, m int) []bool {
+	line := make([]bool, m)
+	mm := 0
+	if isPowerOfTwo(m) {
+		mm = 1
+	}
+	x := uint32(1 + 1001*n)
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(m+mm))
+		}
+		line[r] = true
+	}
+	return line
+}
+
+// fragment splits data in fragments of fragSize bytes, followed by the given number of redundancy fragments.
+// The last uncoded fragment is padded with zeros. fragment returns the fragments and the number of padding bytes.
+func fragment(data []byte, fragSize, redundancy int) ([][]byte, int, error) {
+	if len(data) == 0 {
+		return nil, 0, errNoData.New()
+	}
+	if fragSize <= 0 || fragSize > 0xff {
+		return nil, 0, errFragSize.WithAttributes("frag_size", fragSize)
+	}
+	m := (len(data) + fragSize - 1) / fragSize
+	if m+redundancy > maxFragments {
+		return nil, 0, errTooManyFragments.WithAttributes("count", m+redundancy, "max", maxFragments)
+	}
+	padding := m*fragSize - len(data)
+	padded := make([]byte, m*fragSize)
+	copy(padded, data)
+
+	frags := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		frags = append(frags, padded[i*fragSize:(i+1)*fragSize])
+	}
+	for n := 1; n <= redundancy; n++ {
+		frag := make([]byte, fragSize)
+		for i, ok := range matrixLine(n, m) {
+			if !ok {
+				continue
+			}
+			for j := range frag {
+				frag[j] ^= frags[i][j]
+			}
+		}
+		frags = append(frags, frag)
+	}
+	return frags, padding, nil
+}
@@ -0,0 +1,129 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// reconstruct reconstructs the uncoded fragments from the received fragments using Gaussian elimination over GF(2).
+// The keys of the received fragments are the fragment numbers, starting from 1.
+func reconstruct(received map[int][]byte, m, fragSize int) ([][]byte, bool) {
+	type row struct {
+		coeffs []bool
+		data   []byte
+	}
+	var rows []row
+	for n, frag := range received {
+		coeffs := make([]bool, m)
+		if n <= m {
+			coeffs[n-1] = true
+		} else {
+			coeffs = matrixLine(n-m, m)
+		}
+		rows = append(rows, row{coeffs, append([]byte(nil), frag...)})
+	}
+	res := make([][]byte, m)
+	for col := 0; col < m; col++ {
+		pivot := -1
+		for i := col; i < len(rows); i++ {
+			if rows[i].coeffs[col] {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			return nil, false
+		}
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+		for i := range rows {
+			if i == col || !rows[i].coeffs[col] {
+				continue
+			}
+			for j := range rows[i].coeffs {
+				rows[i].coeffs[j] = rows[i].coeffs[j] != rows[col].coeffs[j]
+			}
+			for j := range rows[i].data {
+				rows[i].data[j] ^= rows[col].data[j]
+			}
+		}
+	}
+	for i := range res {
+		res[i] = rows[i].data
+	}
+	return res, true
+}
+
+func TestMatrixLine(t *testing.T) {
+	a := assertions.New(t)
+	for _, m := range []int{2, 3, 8, 10, 100} {
+		for n := 1; n <= 10; n++ {
+			line := matrixLine(n, m)
+			a.So(line, should.HaveLength, m)
+			a.So(matrixLine(n, m), should.Resemble, line)
+			var count int
+			for _, ok := range line {
+				if ok {
+					count++
+				}
+			}
+			a.So(count >= 1 && count <= m/2, should.BeTrue)
+		}
+	}
+}
+
+func TestFragment(t *testing.T) {
+	a := assertions.New(t)
+
+	_, _, err := fragment(nil, 10, 1)
+	a.So(err, should.HaveSameErrorDefinitionAs, errNoData)
+	_, _, err = fragment([]byte{0x01}, 0, 1)
+	a.So(err, should.HaveSameErrorDefinitionAs, errFragSize)
+	_, _, err = fragment(make([]byte, maxFragments+1), 1, 0)
+	a.So(err, should.HaveSameErrorDefinitionAs, errTooManyFragments)
+
+	data := make([]byte, 995)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	const fragSize, redundancy = 10, 30
+	frags, padding, err := fragment(data, fragSize, redundancy)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(padding, should.Equal, 5)
+	a.So(frags, should.HaveLength, 100+redundancy)
+	a.So(bytes.Join(frags[:100], nil), should.Resemble, append(data, make([]byte, padding)...))
+	for _, frag := range frags {
+		a.So(frag, should.HaveLength, fragSize)
+	}
+
+	// Lose every tenth uncoded fragment and reconstruct the data from the redundancy fragments.
+	received := make(map[int][]byte)
+	for i, frag := range frags {
+		if i < 100 && i%10 == 0 {
+			continue
+		}
+		received[i+1] = frag
+	}
+	res, ok := reconstruct(received, 100, fragSize)
+	if a.So(ok, should.BeTrue) {
+		a.So(bytes.Join(res, nil)[:len(data)], should.Resemble, data)
+	}
+}
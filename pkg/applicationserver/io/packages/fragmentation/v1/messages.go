@@ -0,0 +1,171 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	packageVersionCID    = 0x00
+	fragSessionStatusCID = 0x01
+	fragSessionSetupCID  = 0x02
+	fragSessionDeleteCID = 0x03
+	dataFragmentCID      = 0x08
+)
+
+// PackageVersionAns is the answer of the end device to a PackageVersionReq.
+type PackageVersionAns struct {
+	PackageIdentifier uint8 `json:"package_identifier"`
+	PackageVersion    uint8 `json:"package_version"`
+}
+
+// FragSessionSetupReq is the request to set up a fragmentation session on the end device.
+type FragSessionSetupReq struct {
+	FragIndex      uint8
+	McGroupBitMask uint8
+	NbFrag         uint16
+	FragSize       uint8
+	BlockAckDelay  uint8
+	Padding        uint8
+	Descriptor     uint32
+}
+
+// FragSessionSetupAns is the answer of the end device to a FragSessionSetupReq.
+type FragSessionSetupAns struct {
+	FragIndex                    uint8 `json:"frag_index"`
+	EncodingUnsupported          bool  `json:"encoding_unsupported"`
+	NotEnoughMemory              bool  `json:"not_enough_memory"`
+	FragSessionIndexNotSupported bool  `json:"frag_session_index_not_supported"`
+	WrongDescriptor              bool  `json:"wrong_descriptor"`
+}
+
+// OK returns whether the end device accepted the fragmentation session.
+func (ans FragSessionSetupAns) OK() bool {
+	return !ans.EncodingUnsupported && !ans.NotEnoughMemory && !ans.FragSessionIndexNotSupported && !ans.WrongDescriptor
+}
+
+// FragSessionStatusReq is the request for the status of a fragmentation session on the end device.
+type FragSessionStatusReq struct {
+	FragIndex uint8
+	// Participants indicates whether all end devices should answer, or only the end devices that are missing fragments.
+	Participants bool
+}
+
+// FragSessionStatusAns is the answer of the end device to a FragSessionStatusReq.
+type FragSessionStatusAns struct {
+	FragIndex      uint8  `json:"frag_index"`
+	NbFragReceived uint16 `json:"nb_frag_received"`
+	// MissingFrag is the number of fragments that the end device is missing to reconstruct the data block.
+	MissingFrag           uint8 `json:"missing_frag"`
+	NotEnoughMatrixMemory bool  `json:"not_enough_matrix_memory"`
+}
+
+// FragSessionDeleteAns is the answer of the end device to a FragSessionDeleteReq.
+type FragSessionDeleteAns struct {
+	FragIndex           uint8 `json:"frag_index"`
+	SessionDoesNotExist bool  `json:"session_does_not_exist"`
+}
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "command `{cid}` must be {length} bytes long")
+)
+
+// decodeUplink decodes the answers in the given uplink payload.
+func decodeUplink(b []byte) ([]interface{}, error) {
+	var cmds []interface{}
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		var n int
+		switch cid {
+		case packageVersionCID:
+			n = 2
+		case fragSessionStatusCID:
+			n = 4
+		case fragSessionSetupCID, fragSessionDeleteCID:
+			n = 1
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b) < n {
+			return nil, errCommandLength.WithAttributes("cid", cid, "length", n)
+		}
+		switch cid {
+		case packageVersionCID:
+			cmds = append(cmds, &PackageVersionAns{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			})
+		case fragSessionStatusCID:
+			receivedAndIndex := binary.LittleEndian.Uint16(b[0:2])
+			cmds = append(cmds, &FragSessionStatusAns{
+				FragIndex:             uint8(receivedAndIndex >> 14),
+				NbFragReceived:        receivedAndIndex & 0x3fff,
+				MissingFrag:           b[2],
+				NotEnoughMatrixMemory: b[3]&0x01 != 0,
+			})
+		case fragSessionSetupCID:
+			cmds = append(cmds, &FragSessionSetupAns{
+				FragIndex:                    b[0] >> 6,
+				EncodingUnsupported:          b[0]&0x01 != 0,
+				NotEnoughMemory:              b[0]&0x02 != 0,
+				FragSessionIndexNotSupported: b[0]&0x04 != 0,
+				WrongDescriptor:              b[0]&0x08 != 0,
+			})
+		case fragSessionDeleteCID:
+			cmds = append(cmds, &FragSessionDeleteAns{
+				FragIndex:           b[0] & 0x03,
+				SessionDoesNotExist: b[0]&0x04 != 0,
+			})
+		}
+		b = b[n:]
+	}
+	return cmds, nil
+}
+
+// appendFragSessionSetupReq appends the encoded FragSessionSetupReq to b.
+func appendFragSessionSetupReq(b []byte, req FragSessionSetupReq) []byte {
+	b = append(b,
+		fragSessionSetupCID,
+		(req.FragIndex&0x03)<<4|req.McGroupBitMask&0x0f,
+		byte(req.NbFrag), byte(req.NbFrag>>8),
+		req.FragSize,
+		req.BlockAckDelay&0x07,
+		req.Padding,
+		0, 0, 0, 0,
+	)
+	binary.LittleEndian.PutUint32(b[len(b)-4:], req.Descriptor)
+	return b
+}
+
+// appendFragSessionStatusReq appends the encoded FragSessionStatusReq to b.
+func appendFragSessionStatusReq(b []byte, req FragSessionStatusReq) []byte {
+	param := (req.FragIndex & 0x03) << 1
+	if req.Participants {
+		param |= 0x01
+	}
+	return append(b, fragSessionStatusCID, param)
+}
+
+// appendDataFragment appends the encoded DataFragment with fragment number n, starting from 1, to b.
+func appendDataFragment(b []byte, fragIndex uint8, n uint16, frag []byte) []byte {
+	indexAndN := uint16(fragIndex&0x03)<<14 | n&0x3fff
+	b = append(b, dataFragmentCID, byte(indexAndN), byte(indexAndN>>8))
+	return append(b, frag...)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecodeUplink(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Commands []interface{}
+		Error    error
+	}{
+		{
+			Name:    "Empty",
+			Payload: []byte{},
+		},
+		{
+			Name:    "PackageVersionAns",
+			Payload: []byte{0x00, 0x03, 0x01},
+			Commands: []interface{}{
+				&PackageVersionAns{PackageIdentifier: 3, PackageVersion: 1},
+			},
+		},
+		{
+			Name:    "FragSessionSetupAns/FragSessionStatusAns",
+			Payload: []byte{0x02, 0x44, 0x01, 0x2a, 0x40, 0x05, 0x01},
+			Commands: []interface{}{
+				&FragSessionSetupAns{FragIndex: 1, FragSessionIndexNotSupported: true},
+				&FragSessionStatusAns{FragIndex: 1, NbFragReceived: 42, MissingFrag: 5, NotEnoughMatrixMemory: true},
+			},
+		},
+		{
+			Name:    "FragSessionDeleteAns",
+			Payload: []byte{0x03, 0x06},
+			Commands: []interface{}{
+				&FragSessionDeleteAns{FragIndex: 2, SessionDoesNotExist: true},
+			},
+		},
+		{
+			Name:    "UnknownCommand",
+			Payload: []byte{0x7f},
+			Error:   errUnknownCommand,
+		},
+		{
+			Name:    "ShortCommand",
+			Payload: []byte{0x01, 0x2a, 0x40},
+			Error:   errCommandLength,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := decodeUplink(tc.Payload)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+func TestEncodeRequests(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(appendFragSessionSetupReq(nil, FragSessionSetupReq{
+		FragIndex:      1,
+		McGroupBitMask: 0x03,
+		NbFrag:         0x0123,
+		FragSize:       50,
+		BlockAckDelay:  2,
+		Padding:        7,
+		Descriptor:     0x04030201,
+	}), should.Resemble, []byte{0x02, 0x13, 0x23, 0x01, 0x32, 0x02, 0x07, 0x01, 0x02, 0x03, 0x04})
+
+	a.So(appendFragSessionStatusReq(nil, FragSessionStatusReq{
+		FragIndex:    2,
+		Participants: true,
+	}), should.Resemble, []byte{0x01, 0x05})
+
+	a.So(appendDataFragment([]byte{0xff}, 1, 0x0102, []byte{0xaa, 0xbb}), should.Resemble, []byte{0xff, 0x08, 0x02, 0x41, 0xaa, 0xbb})
+}
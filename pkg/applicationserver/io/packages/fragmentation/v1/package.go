@@ -0,0 +1,302 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fragmentationv1 implements the LoRaWAN Fragmented Data Block Transport v1.0.0 application package.
+package fragmentationv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const (
+	packageName = "lorawan-fragmented-data-block-transport-v1"
+
+	// DefaultFPort is the default FPort of the fragmented data block transport package.
+	DefaultFPort = 201
+)
+
+// FragmentationPackage is the LoRaWAN Fragmented Data Block Transport application package.
+// It sets up the fragmentation session on the associated unicast end devices, queues the fragments
+// of the data block on the multicast end device and requests the fragmentation session status
+// of the unicast end devices once all fragments are sent.
+// Requests are sent in response to uplink messages until the end device answered them.
+type FragmentationPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         packageName,
+		DefaultFPort: DefaultFPort,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var (
+	errNoAssociation     = errors.DefineInternal("no_association", "no association available")
+	errNoMulticastDevice = errors.DefineInvalidArgument("no_multicast_device", "no multicast end device configured")
+)
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fragmentation/v1")
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+
+	data, fPort, err := mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+	if data.MulticastDeviceID == "" {
+		return errNoMulticastDevice.New()
+	}
+	session := data.session()
+	var state deviceState
+	if assoc != nil {
+		if err := packages.DecodeData(assoc.Data.GetFields()[packages.StateField].GetStructValue(), &state); err != nil {
+			return err
+		}
+	}
+
+	if msg.FPort == fPort && len(msg.FRMPayload) > 0 {
+		cmds, err := decodeUplink(msg.FRMPayload)
+		if err != nil {
+			return err
+		}
+		state = deviceState{}
+		if err := packages.UpdateAssociationState(ctx, p.registry, ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIdentifiers: up.EndDeviceIdentifiers,
+			FPort:                fPort,
+		}, packageName, &state, func() error {
+			applyAnswers(data, session, &state, cmds...)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := packages.PublishServiceData(ctx, p.server, up, packageName, map[string]interface{}{
+			"commands": cmds,
+		}); err != nil {
+			return err
+		}
+	}
+
+	req, err := p.nextRequest(ctx, up.EndDeviceIdentifiers.ApplicationIdentifiers, data, session, state, fPort)
+	if err != nil || req == nil {
+		return err
+	}
+	queued, err := hasQueuedDownlink(ctx, p.server, up.EndDeviceIdentifiers, fPort)
+	if err != nil || queued {
+		return err
+	}
+	return p.server.DownlinkQueuePush(ctx, up.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+		FPort:      fPort,
+		FRMPayload: req,
+	}})
+}
+
+// applyAnswers updates the state with the answers of the end device.
+func applyAnswers(data packageData, session string, state *deviceState, cmds ...interface{}) {
+	for _, cmd := range cmds {
+		switch ans := cmd.(type) {
+		case *FragSessionSetupAns:
+			if ans.FragIndex != data.FragIndex {
+				continue
+			}
+			*state = deviceState{
+				Session:  session,
+				SetupAns: ans,
+			}
+		case *FragSessionStatusAns:
+			if ans.FragIndex != data.FragIndex || state.Session != session {
+				continue
+			}
+			state.StatusAns = ans
+		}
+	}
+}
+
+func hasQueuedDownlink(ctx context.Context, server io.Server, ids ttnpb.EndDeviceIdentifiers, fPort uint32) (bool, error) {
+	queue, err := server.DownlinkQueueList(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+	for _, down := range queue {
+		if down.FPort == fPort {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nextRequest returns the next encoded request for the end device with the given state, if any.
+// If the end device has set up the fragmentation session, nextRequest queues the fragments
+// on the multicast end device.
+func (p *FragmentationPackage) nextRequest(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers, data packageData, session string, state deviceState, fPort uint32) ([]byte, error) {
+	if state.Session != session || state.SetupAns == nil {
+		if len(data.Data) == 0 {
+			return nil, errNoData.New()
+		}
+		if data.FragSize == 0 {
+			return nil, errFragSize.WithAttributes("frag_size", data.FragSize)
+		}
+		nbFrag := (len(data.Data) + int(data.FragSize) - 1) / int(data.FragSize)
+		return appendFragSessionSetupReq(nil, FragSessionSetupReq{
+			FragIndex:      data.FragIndex,
+			McGroupBitMask: data.McGroupBitMask,
+			NbFrag:         uint16(nbFrag),
+			FragSize:       data.FragSize,
+			BlockAckDelay:  data.BlockAckDelay,
+			Padding:        uint8(nbFrag*int(data.FragSize) - len(data.Data)),
+			Descriptor:     data.Descriptor,
+		}), nil
+	}
+	if !state.SetupAns.OK() || state.StatusAns != nil {
+		return nil, nil
+	}
+	if data.SessionTime != nil && time.Now().Before(*data.SessionTime) {
+		return nil, nil
+	}
+	mcIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appIDs,
+		DeviceID:               data.MulticastDeviceID,
+	}
+	if err := p.queueFragments(ctx, mcIDs, data, session, fPort); err != nil {
+		return nil, err
+	}
+	sending, err := hasQueuedDownlink(ctx, p.server, mcIDs, fPort)
+	if err != nil || sending {
+		return nil, err
+	}
+	return appendFragSessionStatusReq(nil, FragSessionStatusReq{
+		FragIndex:    data.FragIndex,
+		Participants: true,
+	}), nil
+}
+
+// queueFragments queues the fragments of the data block on the multicast end device, unless they are already queued.
+func (p *FragmentationPackage) queueFragments(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, data packageData, session string, fPort uint32) error {
+	assocIDs := ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIdentifiers: ids,
+		FPort:                fPort,
+	}
+	assoc, err := p.registry.GetAssociation(ctx, assocIDs, []string{"data"})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	var state multicastState
+	if assoc != nil {
+		if err := packages.DecodeData(assoc.Data.GetFields()[packages.StateField].GetStructValue(), &state); err != nil {
+			return err
+		}
+	}
+	if state.Session == session {
+		return nil
+	}
+
+	frags, _, err := fragment(data.Data, int(data.FragSize), int(data.Redundancy))
+	if err != nil {
+		return err
+	}
+	var queue bool
+	state = multicastState{}
+	if err := packages.UpdateAssociationState(ctx, p.registry, assocIDs, packageName, &state, func() error {
+		if state.Session == session {
+			return nil
+		}
+		now := time.Now().UTC()
+		state = multicastState{
+			Session:  session,
+			QueuedAt: &now,
+			NbFrag:   len(frags),
+		}
+		queue = true
+		return nil
+	}); err != nil || !queue {
+		return err
+	}
+
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(frags))
+	for i, frag := range frags {
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FRMPayload: appendDataFragment(nil, data.FragIndex, uint16(i+1), frag),
+		})
+	}
+	log.FromContext(ctx).WithField("count", len(downlinks)).Info("Queue data fragments")
+	if err := p.server.DownlinkQueuePush(ctx, ids, downlinks); err != nil {
+		// Reset the state, such that the fragments are queued on the next attempt.
+		state = multicastState{}
+		if resetErr := packages.UpdateAssociationState(ctx, p.registry, assocIDs, packageName, &state, func() error {
+			state = multicastState{}
+			return nil
+		}); resetErr != nil {
+			log.FromContext(ctx).WithError(resetErr).Warn("Failed to reset fragmentation session state")
+		}
+		return err
+	}
+	return nil
+}
+
+func mergePackageData(def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation) (packageData, uint32, error) {
+	var data packageData
+	var fPort uint32
+	if def != nil {
+		if err := packages.DecodeData(def.Data, &data); err != nil {
+			return packageData{}, 0, err
+		}
+		fPort = def.FPort
+	}
+	if assoc != nil {
+		if err := packages.DecodeData(assoc.Data, &data); err != nil {
+			return packageData{}, 0, err
+		}
+		fPort = assoc.FPort
+	}
+	return data, fPort, nil
+}
+
+// New instantiates the LoRaWAN Fragmented Data Block Transport package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &FragmentationPackage{
+		server:   server,
+		registry: registry,
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geolocation
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// Solver modes.
+const (
+	// modeAuto solves by TDOA if enough gateways provide fine timestamps, and by RSSI otherwise.
+	modeAuto = "auto"
+	// modeTDOA only solves by TDOA.
+	modeTDOA = "tdoa"
+	// modeRSSI only solves by RSSI.
+	modeRSSI = "rssi"
+)
+
+type packageData struct {
+	apiKey string
+	mode   string
+}
+
+const (
+	apiKeyField = "api_key"
+	modeField   = "mode"
+)
+
+var (
+	errInvalidFieldType = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidMode      = errors.DefineInvalidArgument("invalid_mode", "invalid mode `{mode}`")
+)
+
+func (d *packageData) toStruct() *types.Struct {
+	var st types.Struct
+	st.Fields = make(map[string]*types.Value)
+	if d.apiKey != "" {
+		st.Fields[apiKeyField] = &types.Value{
+			Kind: &types.Value_StringValue{
+				StringValue: d.apiKey,
+			},
+		}
+	}
+	if d.mode != "" {
+		st.Fields[modeField] = &types.Value{
+			Kind: &types.Value_StringValue{
+				StringValue: d.mode,
+			},
+		}
+	}
+	return &st
+}
+
+func (d *packageData) fromStruct(st *types.Struct) error {
+	fields := st.GetFields()
+	if value, ok := fields[apiKeyField]; ok {
+		stringValue, ok := value.GetKind().(*types.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes(
+				"field", apiKeyField,
+				"type", fmt.Sprintf("%T", value.GetKind()),
+			)
+		}
+		d.apiKey = stringValue.StringValue
+	}
+	if value, ok := fields[modeField]; ok {
+		stringValue, ok := value.GetKind().(*types.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes(
+				"field", modeField,
+				"type", fmt.Sprintf("%T", value.GetKind()),
+			)
+		}
+		switch stringValue.StringValue {
+		case modeAuto, modeTDOA, modeRSSI:
+		default:
+			return errInvalidMode.WithAttributes("mode", stringValue.StringValue)
+		}
+		d.mode = stringValue.StringValue
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geolocation implements the LoRa geolocation application package.
+// The package locates end devices using the metadata of the gateways that received an uplink message, by time
+// difference of arrival (TDOA) if the gateways provide fine timestamps, or by an RSSI weighted centroid otherwise.
+package geolocation
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// LocationSetter sets the locations of end devices.
+type LocationSetter interface {
+	// SetLocation sets the location of the end device by the given service.
+	// The request is authenticated with the given API key.
+	SetLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, service string, location ttnpb.Location, apiKey string) error
+}
+
+// Config contains configuration options for the geolocation package.
+type Config struct {
+	Locations LocationSetter `name:"-"`
+}
@@ -0,0 +1,213 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geolocation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const packageName = "lora-geolocation"
+
+// GeolocationPackage is the LoRa geolocation application package.
+type GeolocationPackage struct {
+	server    io.Server
+	locations LocationSetter
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name: packageName,
+	}
+}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+// The package solves the location of uplink messages on any FPort.
+func (p *GeolocationPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/geolocation")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+
+	data, err := mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+	ms := uplinkMeasurements(msg.RxMetadata)
+	var location *ttnpb.Location
+	switch data.mode {
+	case modeTDOA:
+		location, err = solveTDOA(ms)
+	case modeRSSI:
+		location, err = solveRSSI(ms)
+	default:
+		location, err = solveTDOA(ms)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to solve location by TDOA, fall back to RSSI")
+			location, err = solveRSSI(ms)
+		}
+	}
+	if err != nil {
+		if errors.Resemble(err, errNotEnoughGateways) {
+			logger.WithError(err).Debug("Not enough gateways to solve location")
+			return nil
+		}
+		return err
+	}
+	logger.WithFields(log.Fields(
+		"source", location.Source,
+		"accuracy", location.Accuracy,
+		"gateways", len(ms),
+	)).Debug("Solved location")
+
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIDs, fmt.Sprintf("as:packages:geolocation:%s", events.NewCorrelationID()))...)
+	if p.locations != nil && data.apiKey != "" {
+		if err := p.locations.SetLocation(ctx, up.EndDeviceIdentifiers, packageName, *location, data.apiKey); err != nil {
+			logger.WithError(err).Warn("Failed to set end device location")
+			return err
+		}
+	}
+	now := time.Now().UTC()
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: up.EndDeviceIdentifiers,
+		CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:           &now,
+		Up: &ttnpb.ApplicationUp_LocationSolved{
+			LocationSolved: &ttnpb.ApplicationLocation{
+				Service:  packageName,
+				Location: *location,
+				Attributes: map[string]string{
+					"gateways": strconv.Itoa(len(ms)),
+				},
+			},
+		},
+	})
+}
+
+// uplinkMeasurements returns the measurements of the gateway antennas with a known location.
+// The metadata is deduplicated by gateway antenna. A reception with a fine timestamp is preferred, otherwise the
+// reception with the highest RSSI is used.
+func uplinkMeasurements(mds []*ttnpb.RxMetadata) []measurement {
+	ms := make([]measurement, 0, len(mds))
+	indices := make(map[string]int, len(mds))
+	for _, md := range mds {
+		if md.Location == nil || md.Location.Latitude == 0 && md.Location.Longitude == 0 {
+			continue
+		}
+		rssi := md.ChannelRSSI
+		if rssi == 0 {
+			rssi = md.RSSI
+		}
+		m := measurement{
+			latitude:      md.Location.Latitude,
+			longitude:     md.Location.Longitude,
+			rssi:          float64(rssi),
+			fineTimestamp: md.FineTimestamp,
+		}
+		key := gatewayAntennaKey(md)
+		i, ok := indices[key]
+		if !ok {
+			indices[key] = len(ms)
+			ms = append(ms, m)
+			continue
+		}
+		if prev := ms[i]; prev.fineTimestamp == 0 && (m.fineTimestamp > 0 || m.rssi > prev.rssi) {
+			ms[i] = m
+		}
+	}
+	return ms
+}
+
+// gatewayAntennaKey returns the key that identifies the gateway antenna of the metadata.
+// Gateways of Packet Broker Forwarders are identified by the Forwarder and the gateway EUI.
+func gatewayAntennaKey(md *ttnpb.RxMetadata) string {
+	var eui string
+	if md.EUI != nil {
+		eui = md.EUI.String()
+	}
+	if pb := md.PacketBroker; pb != nil {
+		return fmt.Sprintf("%s/%s/%s/%s/%d", pb.ForwarderNetID, pb.ForwarderTenantID, pb.ForwarderClusterID, eui, md.AntennaIndex)
+	}
+	return fmt.Sprintf("%s/%s/%d", md.GatewayID, eui, md.AntennaIndex)
+}
+
+func mergePackageData(def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation) (*packageData, error) {
+	var defaultData, associationData packageData
+	if def != nil {
+		if err := defaultData.fromStruct(def.Data); err != nil {
+			return nil, err
+		}
+	}
+	if assoc != nil {
+		if err := associationData.fromStruct(assoc.Data); err != nil {
+			return nil, err
+		}
+	}
+	merged := packageData{
+		mode: modeAuto,
+	}
+	for _, data := range []*packageData{
+		&defaultData,
+		&associationData,
+	} {
+		if data.apiKey != "" {
+			merged.apiKey = data.apiKey
+		}
+		if data.mode != "" {
+			merged.mode = data.mode
+		}
+	}
+	return &merged, nil
+}
+
+// New instantiates the LoRa geolocation package.
+func New(server io.Server, conf Config) packages.ApplicationPackageHandler {
+	return &GeolocationPackage{
+		server:    server,
+		locations: conf.Locations,
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geolocation
+
+import (
+	"context"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockServer struct {
+	io.Server
+	ups []*ttnpb.ApplicationUp
+}
+
+func (s *mockServer) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+type setLocationRequest struct {
+	ids      ttnpb.EndDeviceIdentifiers
+	service  string
+	location ttnpb.Location
+	apiKey   string
+}
+
+type mockLocationSetter struct {
+	requests []setLocationRequest
+}
+
+func (s *mockLocationSetter) SetLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, service string, location ttnpb.Location, apiKey string) error {
+	s.requests = append(s.requests, setLocationRequest{ids, service, location, apiKey})
+	return nil
+}
+
+func rxMetadata(ms []measurement) []*ttnpb.RxMetadata {
+	mds := make([]*ttnpb.RxMetadata, len(ms))
+	for i, m := range ms {
+		eui := types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, byte(i + 1)}
+		mds[i] = &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: "gtw",
+				EUI:       &eui,
+			},
+			RSSI:          float32(m.rssi),
+			ChannelRSSI:   float32(m.rssi),
+			FineTimestamp: m.fineTimestamp,
+			Location: &ttnpb.Location{
+				Latitude:  m.latitude,
+				Longitude: m.longitude,
+				Source:    ttnpb.SOURCE_REGISTRY,
+			},
+		}
+	}
+	return mds
+}
+
+func TestHandleUp(t *testing.T) {
+	const latitude, longitude = 52.3676, 4.9041
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app"},
+		DeviceID:               "dev",
+	}
+	mds := rxMetadata(tdoaMeasurements(latitude, longitude, 123456789,
+		[2]float64{-2000, -1000},
+		[2]float64{2500, -500},
+		[2]float64{300, 3000},
+	))
+	// Duplicate reception of the first gateway without fine timestamp and a gateway without location.
+	duplicate := *mds[0]
+	duplicate.FineTimestamp = 0
+	duplicate.RSSI, duplicate.ChannelRSSI = -30, -30
+	mds = append(mds, &duplicate, &ttnpb.RxMetadata{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "no-location"},
+		RSSI:               -20,
+	})
+	up := func(mds []*ttnpb.RxMetadata) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			CorrelationIDs:       []string{"test"},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      1,
+					RxMetadata: mds,
+				},
+			},
+		}
+	}
+	assoc := func(fields map[string]string) *ttnpb.ApplicationPackageAssociation {
+		st := &pbtypes.Struct{Fields: make(map[string]*pbtypes.Value)}
+		for k, v := range fields {
+			st.Fields[k] = &pbtypes.Value{Kind: &pbtypes.Value_StringValue{StringValue: v}}
+		}
+		return &ttnpb.ApplicationPackageAssociation{
+			PackageName: packageName,
+			Data:        st,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Association    *ttnpb.ApplicationPackageAssociation
+		Up             *ttnpb.ApplicationUp
+		Source         ttnpb.LocationSource
+		Gateways       string
+		SetLocation    bool
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:        "TDOA",
+			Association: assoc(map[string]string{"api_key": "secret"}),
+			Up:          up(mds),
+			Source:      ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+			Gateways:    "3",
+			SetLocation: true,
+		},
+		{
+			Name:        "RSSI",
+			Association: assoc(map[string]string{"mode": "rssi"}),
+			Up:          up(mds),
+			Source:      ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+			Gateways:    "3",
+		},
+		{
+			Name:        "FallbackToRSSI",
+			Association: assoc(nil),
+			Up:          up(mds[1:3]),
+			Source:      ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+			Gateways:    "2",
+		},
+		{
+			Name:        "NotEnoughGateways",
+			Association: assoc(map[string]string{"mode": "tdoa"}),
+			Up:          up(mds[1:3]),
+		},
+		{
+			Name:        "NoUplink",
+			Association: assoc(nil),
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_JoinAccept{
+					JoinAccept: &ttnpb.ApplicationJoinAccept{},
+				},
+			},
+		},
+		{
+			Name:           "InvalidMode",
+			Association:    assoc(map[string]string{"mode": "gps"}),
+			Up:             up(mds),
+			ErrorAssertion: errInvalidMode.Is,
+		},
+		{
+			Name:           "NoAssociation",
+			Up:             up(mds),
+			ErrorAssertion: errNoAssociation.Is,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			server := &mockServer{}
+			locations := &mockLocationSetter{}
+			p := New(server, Config{Locations: locations})
+
+			err := p.HandleUp(ctx, nil, tc.Association, tc.Up)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				a.So(server.ups, should.BeEmpty)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if tc.Source == ttnpb.SOURCE_UNKNOWN {
+				a.So(server.ups, should.BeEmpty)
+				a.So(locations.requests, should.BeEmpty)
+				return
+			}
+			if !a.So(server.ups, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			solved := server.ups[0].GetLocationSolved()
+			if !a.So(solved, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(server.ups[0].EndDeviceIdentifiers, should.Resemble, ids)
+			a.So(server.ups[0].CorrelationIDs, should.Contain, "test")
+			a.So(solved.Service, should.Equal, packageName)
+			a.So(solved.Location.Source, should.Equal, tc.Source)
+			a.So(solved.Attributes["gateways"], should.Equal, tc.Gateways)
+			if tc.Source == ttnpb.SOURCE_LORA_TDOA_GEOLOCATION {
+				a.So(solved.Location.Latitude, should.AlmostEqual, latitude, 1e-4)
+				a.So(solved.Location.Longitude, should.AlmostEqual, longitude, 1e-4)
+			}
+			if !tc.SetLocation {
+				a.So(locations.requests, should.BeEmpty)
+				return
+			}
+			a.So(locations.requests, should.Resemble, []setLocationRequest{
+				{
+					ids:      ids,
+					service:  packageName,
+					location: solved.Location,
+					apiKey:   "secret",
+				},
+			})
+		})
+	}
+}
+
+func TestPackageData(t *testing.T) {
+	a := assertions.New(t)
+
+	data := &packageData{
+		apiKey: "secret",
+		mode:   modeTDOA,
+	}
+	var decoded packageData
+	a.So(decoded.fromStruct(data.toStruct()), should.BeNil)
+	a.So(decoded, should.Resemble, *data)
+
+	st := data.toStruct()
+	st.Fields[modeField] = &pbtypes.Value{Kind: &pbtypes.Value_NumberValue{NumberValue: 1}}
+	a.So(errInvalidFieldType.Is(decoded.fromStruct(st)), should.BeTrue)
+}
@@ -0,0 +1,276 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geolocation
+
+import (
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// speedOfLight is the speed of light in meters per second.
+	speedOfLight = 299792458.0
+	// earthRadius is the mean radius of the earth in meters.
+	earthRadius = 6371008.8
+
+	// fineTimestampAccuracy is the assumed accuracy of gateway fine timestamps.
+	fineTimestampAccuracy = 100 * time.Nanosecond
+
+	// minTDOAGateways is the minimum number of gateways with fine timestamps to solve by TDOA.
+	minTDOAGateways = 3
+	// minRSSIGateways is the minimum number of gateways to solve by RSSI.
+	minRSSIGateways = 2
+
+	// maxTDOAIterations is the maximum number of iterations of the TDOA solver.
+	maxTDOAIterations = 100
+	// tdoaConvergence is the step size in meters below which the TDOA solver has converged.
+	tdoaConvergence = 0.01
+	// maxTDOAStep is the maximum step size in meters of the TDOA solver.
+	maxTDOAStep = 10000.0
+	// maxSolutionDistance is the maximum distance in meters of a solution to the nearest gateway.
+	maxSolutionDistance = 100000.0
+)
+
+var (
+	errNotEnoughGateways = errors.DefineFailedPrecondition(
+		"not_enough_gateways",
+		"`{count}` gateways available to solve by `{method}`, need at least `{min}`",
+	)
+	errInconsistentTimestamps = errors.DefineFailedPrecondition(
+		"inconsistent_timestamps",
+		"fine timestamps inconsistent with gateway locations",
+	)
+	errNoSolution = errors.DefineAborted("no_solution", "no location solution found")
+)
+
+// measurement is the reception of an uplink message by a gateway antenna.
+type measurement struct {
+	latitude, longitude float64
+	rssi                float64
+	// fineTimestamp is the fine timestamp of the reception in nanoseconds within the second.
+	// The value is zero if the reception does not have a fine timestamp.
+	fineTimestamp uint64
+}
+
+// projection is a local equirectangular projection to meters around a reference point.
+type projection struct {
+	latitude, longitude, cosLatitude float64
+}
+
+func newProjection(latitude, longitude float64) projection {
+	return projection{
+		latitude:    latitude,
+		longitude:   longitude,
+		cosLatitude: math.Cos(latitude * math.Pi / 180),
+	}
+}
+
+func (p projection) toXY(latitude, longitude float64) (x, y float64) {
+	x = (longitude - p.longitude) * math.Pi / 180 * earthRadius * p.cosLatitude
+	y = (latitude - p.latitude) * math.Pi / 180 * earthRadius
+	return
+}
+
+func (p projection) fromXY(x, y float64) (latitude, longitude float64) {
+	latitude = p.latitude + y/earthRadius*180/math.Pi
+	longitude = p.longitude + x/(earthRadius*p.cosLatitude)*180/math.Pi
+	return
+}
+
+// rssiWeights returns the weights of the measurements, proportional to the received signal amplitude.
+func rssiWeights(ms []measurement) []float64 {
+	maxRSSI := math.Inf(-1)
+	for _, m := range ms {
+		maxRSSI = math.Max(maxRSSI, m.rssi)
+	}
+	ws := make([]float64, len(ms))
+	for i, m := range ms {
+		ws[i] = math.Pow(10, (m.rssi-maxRSSI)/20)
+	}
+	return ws
+}
+
+// centroid returns the weighted centroid of the given points.
+func centroid(xs, ys, ws []float64) (x, y float64) {
+	var sum float64
+	for i := range xs {
+		x += ws[i] * xs[i]
+		y += ws[i] * ys[i]
+		sum += ws[i]
+	}
+	return x / sum, y / sum
+}
+
+// solveRSSI solves the location as the RSSI weighted centroid of the gateways.
+// The accuracy is the weighted root mean square distance of the gateways to the centroid.
+func solveRSSI(ms []measurement) (*ttnpb.Location, error) {
+	if len(ms) < minRSSIGateways {
+		return nil, errNotEnoughGateways.WithAttributes(
+			"count", len(ms),
+			"method", "RSSI",
+			"min", minRSSIGateways,
+		)
+	}
+	proj := newProjection(ms[0].latitude, ms[0].longitude)
+	xs, ys := make([]float64, len(ms)), make([]float64, len(ms))
+	for i, m := range ms {
+		xs[i], ys[i] = proj.toXY(m.latitude, m.longitude)
+	}
+	ws := rssiWeights(ms)
+	x, y := centroid(xs, ys, ws)
+	var sumSq, sumW float64
+	for i := range ms {
+		sumSq += ws[i] * (math.Pow(xs[i]-x, 2) + math.Pow(ys[i]-y, 2))
+		sumW += ws[i]
+	}
+	latitude, longitude := proj.fromXY(x, y)
+	return &ttnpb.Location{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Accuracy:  int32(math.Ceil(math.Sqrt(sumSq / sumW))),
+		Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+	}, nil
+}
+
+// solveTDOA solves the location by multilateration of the time differences of arrival at the gateways that provide
+// fine timestamps. The solver minimizes the range difference residuals with the Gauss-Newton method from multiple start
+// positions, and returns the solution with the smallest residuals.
+// The accuracy combines the root mean square residual and the fine timestamp accuracy.
+func solveTDOA(ms []measurement) (*ttnpb.Location, error) {
+	timed := make([]measurement, 0, len(ms))
+	for _, m := range ms {
+		if m.fineTimestamp > 0 {
+			timed = append(timed, m)
+		}
+	}
+	if len(timed) < minTDOAGateways {
+		return nil, errNotEnoughGateways.WithAttributes(
+			"count", len(timed),
+			"method", "TDOA",
+			"min", minTDOAGateways,
+		)
+	}
+	n := len(timed)
+	proj := newProjection(timed[0].latitude, timed[0].longitude)
+	xs, ys := make([]float64, n), make([]float64, n)
+	for i, m := range timed {
+		xs[i], ys[i] = proj.toXY(m.latitude, m.longitude)
+	}
+	// Range differences to the reference gateway. Fine timestamps wrap every second.
+	ds := make([]float64, n)
+	for i := 1; i < n; i++ {
+		dt := int64(timed[i].fineTimestamp) - int64(timed[0].fineTimestamp)
+		switch {
+		case dt > int64(time.Second/2):
+			dt -= int64(time.Second)
+		case dt < -int64(time.Second/2):
+			dt += int64(time.Second)
+		}
+		ds[i] = speedOfLight * time.Duration(dt).Seconds()
+		if baseline := math.Hypot(xs[i]-xs[0], ys[i]-ys[0]); math.Abs(ds[i]) > baseline+speedOfLight*fineTimestampAccuracy.Seconds() {
+			return nil, errInconsistentTimestamps.New()
+		}
+	}
+
+	cx, cy := centroid(xs, ys, rssiWeights(timed))
+	// Start from the centroid and from beyond each gateway, as the solver may converge to a local minimum if the end
+	// device is outside of the area covered by the gateways.
+	starts := [][2]float64{{cx, cy}}
+	for i := range xs {
+		starts = append(starts, [2]float64{2*xs[i] - cx, 2*ys[i] - cy})
+	}
+	var (
+		x, y      float64
+		bestSumSq = math.Inf(1)
+	)
+	for _, start := range starts {
+		sx, sy, sumSq, ok := solveTDOAFrom(xs, ys, ds, start[0], start[1])
+		if ok && sumSq < bestSumSq {
+			x, y, bestSumSq = sx, sy, sumSq
+		}
+	}
+	if math.IsInf(bestSumSq, 1) {
+		return nil, errNoSolution.New()
+	}
+	nearest := math.Inf(1)
+	for i := range xs {
+		nearest = math.Min(nearest, math.Hypot(x-xs[i], y-ys[i]))
+	}
+	if nearest > maxSolutionDistance {
+		return nil, errNoSolution.New()
+	}
+
+	timingAccuracy := speedOfLight * fineTimestampAccuracy.Seconds()
+	latitude, longitude := proj.fromXY(x, y)
+	return &ttnpb.Location{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Accuracy:  int32(math.Ceil(math.Sqrt(bestSumSq/float64(n-1) + timingAccuracy*timingAccuracy))),
+		Source:    ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+	}, nil
+}
+
+// tdoaResiduals returns the range difference residuals at the given position, and their partial derivatives.
+// The first gateway is the reference gateway of the range differences.
+func tdoaResiduals(xs, ys, ds []float64, x, y float64) (rs, jxs, jys []float64) {
+	n := len(xs)
+	rs, jxs, jys = make([]float64, n-1), make([]float64, n-1), make([]float64, n-1)
+	r0 := math.Max(math.Hypot(x-xs[0], y-ys[0]), 1)
+	for i := 1; i < n; i++ {
+		ri := math.Max(math.Hypot(x-xs[i], y-ys[i]), 1)
+		rs[i-1] = ri - r0 - ds[i]
+		jxs[i-1] = (x-xs[i])/ri - (x-xs[0])/r0
+		jys[i-1] = (y-ys[i])/ri - (y-ys[0])/r0
+	}
+	return
+}
+
+// solveTDOAFrom minimizes the range difference residuals with the Gauss-Newton method from the given start position.
+// It returns the position and the sum of squared residuals, and false if the solver did not converge.
+func solveTDOAFrom(xs, ys, ds []float64, x, y float64) (float64, float64, float64, bool) {
+	for iteration := 0; iteration < maxTDOAIterations; iteration++ {
+		rs, jxs, jys := tdoaResiduals(xs, ys, ds, x, y)
+		var a, b, d, gx, gy float64
+		for i := range rs {
+			a += jxs[i] * jxs[i]
+			b += jxs[i] * jys[i]
+			d += jys[i] * jys[i]
+			gx += jxs[i] * rs[i]
+			gy += jys[i] * rs[i]
+		}
+		det := a*d - b*b
+		if math.Abs(det) < 1e-12 {
+			return 0, 0, 0, false
+		}
+		dx, dy := -(d*gx-b*gy)/det, -(a*gy-b*gx)/det
+		step := math.Hypot(dx, dy)
+		if step > maxTDOAStep {
+			dx, dy = dx/step*maxTDOAStep, dy/step*maxTDOAStep
+		}
+		x, y = x+dx, y+dy
+		if step < tdoaConvergence {
+			rs, _, _ := tdoaResiduals(xs, ys, ds, x, y)
+			var sumSq float64
+			for _, r := range rs {
+				sumSq += r * r
+			}
+			return x, y, sumSq, true
+		}
+	}
+	return 0, 0, 0, false
+}
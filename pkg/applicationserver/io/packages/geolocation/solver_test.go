@@ -0,0 +1,163 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geolocation
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// tdoaMeasurements returns the measurements of gateways at the given offsets in meters to the device location, with the
+// fine timestamps of an uplink message transmitted at the given nanosecond within the second.
+func tdoaMeasurements(latitude, longitude float64, transmitAt int64, offsets ...[2]float64) []measurement {
+	proj := newProjection(latitude, longitude)
+	ms := make([]measurement, len(offsets))
+	for i, offset := range offsets {
+		lat, lng := proj.fromXY(offset[0], offset[1])
+		distance := math.Hypot(offset[0], offset[1])
+		arrival := transmitAt + int64(distance/speedOfLight*float64(time.Second))
+		ms[i] = measurement{
+			latitude:      lat,
+			longitude:     lng,
+			rssi:          -60 - distance/100,
+			fineTimestamp: uint64(arrival % int64(time.Second)),
+		}
+	}
+	return ms
+}
+
+func TestSolveTDOA(t *testing.T) {
+	const latitude, longitude = 52.3676, 4.9041
+	proj := newProjection(latitude, longitude)
+
+	for _, tc := range []struct {
+		Name         string
+		Measurements []measurement
+		// MaxError is the maximum distance in meters of the solution to the device location.
+		MaxError       float64
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "ThreeGateways",
+			MaxError: 1,
+			Measurements: tdoaMeasurements(latitude, longitude, 123456789,
+				[2]float64{-2000, -1000},
+				[2]float64{2500, -500},
+				[2]float64{300, 3000},
+			),
+		},
+		{
+			Name:     "FourGateways",
+			MaxError: 1,
+			Measurements: tdoaMeasurements(latitude, longitude, 500000000,
+				[2]float64{-2000, -1000},
+				[2]float64{2500, -500},
+				[2]float64{300, 3000},
+				[2]float64{-4000, 5000},
+			),
+		},
+		{
+			Name:     "SecondRollover",
+			MaxError: 1,
+			Measurements: tdoaMeasurements(latitude, longitude, 999990000,
+				[2]float64{-2000, -1000},
+				[2]float64{2500, -500},
+				[2]float64{300, 3000},
+				[2]float64{-4000, 5000},
+			),
+		},
+		{
+			Name: "DeviceOutsideGateways",
+			// The geometry dilutes the precision of the fine timestamps.
+			MaxError: 10,
+			Measurements: tdoaMeasurements(latitude, longitude, 42,
+				[2]float64{1000, 1000},
+				[2]float64{3000, 1500},
+				[2]float64{2000, 4000},
+				[2]float64{4500, 3500},
+			),
+		},
+		{
+			Name: "NotEnoughGateways",
+			Measurements: tdoaMeasurements(latitude, longitude, 42,
+				[2]float64{1000, 1000},
+				[2]float64{3000, 1500},
+			),
+			ErrorAssertion: errNotEnoughGateways.Is,
+		},
+		{
+			Name: "InconsistentTimestamps",
+			Measurements: func() []measurement {
+				ms := tdoaMeasurements(latitude, longitude, 42,
+					[2]float64{-2000, -1000},
+					[2]float64{2500, -500},
+					[2]float64{300, 3000},
+				)
+				ms[1].fineTimestamp += uint64(100 * time.Microsecond)
+				return ms
+			}(),
+			ErrorAssertion: errInconsistentTimestamps.Is,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			loc, err := solveTDOA(tc.Measurements)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			x, y := proj.toXY(loc.Latitude, loc.Longitude)
+			a.So(math.Hypot(x, y), should.BeLessThan, tc.MaxError)
+			a.So(loc.Source, should.Equal, ttnpb.SOURCE_LORA_TDOA_GEOLOCATION)
+			a.So(loc.Accuracy, should.BeGreaterThan, 0)
+		})
+	}
+}
+
+func TestSolveRSSI(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := solveRSSI([]measurement{{latitude: 52, longitude: 4, rssi: -80}})
+	a.So(errNotEnoughGateways.Is(err), should.BeTrue)
+
+	loc, err := solveRSSI([]measurement{
+		{latitude: 52.01, longitude: 4, rssi: -90},
+		{latitude: 51.99, longitude: 4, rssi: -90},
+		{latitude: 52, longitude: 4.01, rssi: -90},
+		{latitude: 52, longitude: 3.99, rssi: -90},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(loc.Latitude, should.AlmostEqual, 52, 1e-6)
+		a.So(loc.Longitude, should.AlmostEqual, 4, 1e-6)
+		a.So(loc.Source, should.Equal, ttnpb.SOURCE_LORA_RSSI_GEOLOCATION)
+		a.So(loc.Accuracy, should.BeBetween, 600, 1200)
+	}
+
+	loc, err = solveRSSI([]measurement{
+		{latitude: 52.01, longitude: 4, rssi: -60},
+		{latitude: 51.99, longitude: 4, rssi: -120},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(loc.Latitude, should.BeGreaterThan, 52.009)
+	}
+}
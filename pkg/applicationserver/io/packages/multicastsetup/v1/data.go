@@ -0,0 +1,83 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// sessionData is the configuration of a multicast session.
+type sessionData struct {
+	// Class is the class of the session, which is either B or C.
+	Class string    `json:"class"`
+	Time  time.Time `json:"time"`
+	// TimeOut is the maximum duration of the session. For class C sessions, the duration is 2^TimeOut seconds.
+	// For class B sessions, the duration is 2^TimeOut beacon periods.
+	TimeOut             uint8  `json:"time_out"`
+	Frequency           uint64 `json:"frequency"`
+	DataRateIndex       uint8  `json:"data_rate_index"`
+	PingSlotPeriodicity uint8  `json:"ping_slot_periodicity,omitempty"`
+}
+
+// groupData is the configuration of a multicast group.
+type groupData struct {
+	ID     uint8         `json:"id"`
+	McAddr types.DevAddr `json:"mc_addr"`
+	// McKeyEncrypted is the multicast key, encrypted with the McKEKey of the end device.
+	McKeyEncrypted types.AES128Key `json:"mc_key_encrypted"`
+	MinMcFCnt      uint32          `json:"min_mc_fcnt"`
+	MaxMcFCnt      uint32          `json:"max_mc_fcnt"`
+	Session        *sessionData    `json:"session,omitempty"`
+}
+
+type packageData struct {
+	Groups []groupData `json:"groups,omitempty"`
+}
+
+// groupState is the state of a multicast group on the end device.
+type groupState struct {
+	// McAddr is the address of the multicast group that the end device has set up.
+	McAddr   *types.DevAddr   `json:"mc_addr,omitempty"`
+	SetupAns *McGroupSetupAns `json:"setup_ans,omitempty"`
+	// SessionTime is the start time of the multicast session that the end device has set up.
+	SessionTime *time.Time    `json:"session_time,omitempty"`
+	SessionAns  *McSessionAns `json:"session_ans,omitempty"`
+}
+
+type packageState struct {
+	Groups map[uint8]*groupState `json:"groups,omitempty"`
+}
+
+func (d packageData) group(id uint8) (groupData, bool) {
+	for _, g := range d.Groups {
+		if g.ID == id {
+			return g, true
+		}
+	}
+	return groupData{}, false
+}
+
+// beaconPeriod is the duration of the class B beacon period.
+const beaconPeriod = 128 * time.Second
+
+// gpsSeconds returns t as seconds since the GPS epoch, modulo 2^32. The result is rounded up to a multiple of r.
+func gpsSeconds(t time.Time, r time.Duration) uint32 {
+	d := gpstime.ToGPS(t)
+	d = (d + r - 1) / r * r
+	return uint32(d / time.Second)
+}
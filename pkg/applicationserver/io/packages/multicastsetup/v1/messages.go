@@ -0,0 +1,252 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+const (
+	packageVersionCID     = 0x00
+	mcGroupStatusCID      = 0x01
+	mcGroupSetupCID       = 0x02
+	mcGroupDeleteCID      = 0x03
+	mcClassCSessionCID    = 0x04
+	mcClassBSessionCID    = 0x05
+	mcSessionAnsErrorMask = 0x1c
+)
+
+// PackageVersionAns is the answer of the end device to a PackageVersionReq.
+type PackageVersionAns struct {
+	PackageIdentifier uint8 `json:"package_identifier"`
+	PackageVersion    uint8 `json:"package_version"`
+}
+
+// McGroupStatus is the status of a multicast group in a McGroupStatusAns.
+type McGroupStatus struct {
+	McGroupID uint8         `json:"mc_group_id"`
+	McAddr    types.DevAddr `json:"mc_addr"`
+}
+
+// McGroupStatusAns is the answer of the end device to a McGroupStatusReq.
+type McGroupStatusAns struct {
+	NbTotalGroups uint8           `json:"nb_total_groups"`
+	Groups        []McGroupStatus `json:"groups,omitempty"`
+}
+
+// McGroupSetupReq is the request to set up a multicast group on the end device.
+type McGroupSetupReq struct {
+	McGroupID      uint8
+	McAddr         types.DevAddr
+	McKeyEncrypted types.AES128Key
+	MinMcFCnt      uint32
+	MaxMcFCnt      uint32
+}
+
+// McGroupSetupAns is the answer of the end device to a McGroupSetupReq.
+type McGroupSetupAns struct {
+	McGroupID uint8 `json:"mc_group_id"`
+	IDError   bool  `json:"id_error"`
+}
+
+// McGroupDeleteAns is the answer of the end device to a McGroupDeleteReq.
+type McGroupDeleteAns struct {
+	McGroupID        uint8 `json:"mc_group_id"`
+	McGroupUndefined bool  `json:"mc_group_undefined"`
+}
+
+// McClassCSessionReq is the request to set up a class C multicast session on the end device.
+type McClassCSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start of the session in seconds since the GPS epoch, modulo 2^32.
+	SessionTime uint32
+	// SessionTimeOut is the maximum duration of the session, which is 2^SessionTimeOut seconds.
+	SessionTimeOut uint8
+	// DLFrequency is the downlink frequency in Hz.
+	DLFrequency uint64
+	DR          uint8
+}
+
+// McClassBSessionReq is the request to set up a class B multicast session on the end device.
+type McClassBSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start of the session in seconds since the GPS epoch, modulo 2^32.
+	// It is a multiple of the beacon period, which is 128 seconds.
+	SessionTime uint32
+	// SessionTimeOut is the maximum duration of the session, which is 2^SessionTimeOut beacon periods.
+	SessionTimeOut uint8
+	// Periodicity is the ping slot periodicity of the session.
+	Periodicity uint8
+	// DLFrequency is the downlink frequency in Hz.
+	DLFrequency uint64
+	DR          uint8
+}
+
+// McSessionAns is the answer of the end device to a McClassCSessionReq or McClassBSessionReq.
+type McSessionAns struct {
+	McGroupID        uint8 `json:"mc_group_id"`
+	DRError          bool  `json:"dr_error"`
+	FreqError        bool  `json:"freq_error"`
+	McGroupUndefined bool  `json:"mc_group_undefined"`
+	// TimeToStart is the time in seconds until the session starts. It is only set if there are no errors.
+	TimeToStart *uint32 `json:"time_to_start,omitempty"`
+}
+
+// OK returns whether the end device accepted the session.
+func (ans McSessionAns) OK() bool {
+	return !ans.DRError && !ans.FreqError && !ans.McGroupUndefined
+}
+
+// McClassCSessionAns is the answer of the end device to a McClassCSessionReq.
+type McClassCSessionAns struct {
+	McSessionAns
+}
+
+// McClassBSessionAns is the answer of the end device to a McClassBSessionReq.
+type McClassBSessionAns struct {
+	McSessionAns
+}
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "command `{cid}` must be at least {length} bytes long")
+)
+
+func decodeMcSessionAns(b []byte) McSessionAns {
+	ans := McSessionAns{
+		McGroupID:        b[0] & 0x03,
+		DRError:          b[0]&0x04 != 0,
+		FreqError:        b[0]&0x08 != 0,
+		McGroupUndefined: b[0]&0x10 != 0,
+	}
+	if !ans.OK() || len(b) < 4 {
+		return ans
+	}
+	timeToStart := uint32(b[1]) | uint32(b[2])<<8 | uint32(b[3])<<16
+	ans.TimeToStart = &timeToStart
+	return ans
+}
+
+// decodeUplink decodes the answers in the given uplink payload.
+func decodeUplink(b []byte) ([]interface{}, error) {
+	var cmds []interface{}
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		var n int
+		switch cid {
+		case packageVersionCID:
+			n = 2
+		case mcGroupStatusCID:
+			n = 1
+			if len(b) >= 1 {
+				n += 5 * bits.OnesCount8(b[0]&0x0f)
+			}
+		case mcGroupSetupCID, mcGroupDeleteCID:
+			n = 1
+		case mcClassCSessionCID, mcClassBSessionCID:
+			n = 1
+			if len(b) >= 1 && b[0]&mcSessionAnsErrorMask == 0 {
+				n = 4
+			}
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b) < n {
+			return nil, errCommandLength.WithAttributes("cid", cid, "length", n)
+		}
+		switch cid {
+		case packageVersionCID:
+			cmds = append(cmds, &PackageVersionAns{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			})
+		case mcGroupStatusCID:
+			ans := &McGroupStatusAns{
+				NbTotalGroups: (b[0] >> 4) & 0x07,
+			}
+			for i := 1; i < n; i += 5 {
+				var addr types.DevAddr
+				copyReverse(addr[:], b[i+1:i+5])
+				ans.Groups = append(ans.Groups, McGroupStatus{
+					McGroupID: b[i] & 0x03,
+					McAddr:    addr,
+				})
+			}
+			cmds = append(cmds, ans)
+		case mcGroupSetupCID:
+			cmds = append(cmds, &McGroupSetupAns{
+				McGroupID: b[0] & 0x03,
+				IDError:   b[0]&0x04 != 0,
+			})
+		case mcGroupDeleteCID:
+			cmds = append(cmds, &McGroupDeleteAns{
+				McGroupID:        b[0] & 0x03,
+				McGroupUndefined: b[0]&0x04 != 0,
+			})
+		case mcClassCSessionCID:
+			cmds = append(cmds, &McClassCSessionAns{decodeMcSessionAns(b[:n])})
+		case mcClassBSessionCID:
+			cmds = append(cmds, &McClassBSessionAns{decodeMcSessionAns(b[:n])})
+		}
+		b = b[n:]
+	}
+	return cmds, nil
+}
+
+func copyReverse(dst, src []byte) {
+	for i := range src {
+		dst[len(src)-1-i] = src[i]
+	}
+}
+
+// appendMcGroupSetupReq appends the encoded McGroupSetupReq to b.
+func appendMcGroupSetupReq(b []byte, req McGroupSetupReq) []byte {
+	b = append(b, mcGroupSetupCID, req.McGroupID&0x03)
+	b = append(b, make([]byte, 4)...)
+	copyReverse(b[len(b)-4:], req.McAddr[:])
+	b = append(b, req.McKeyEncrypted[:]...)
+	b = append(b, make([]byte, 8)...)
+	binary.LittleEndian.PutUint32(b[len(b)-8:], req.MinMcFCnt)
+	binary.LittleEndian.PutUint32(b[len(b)-4:], req.MaxMcFCnt)
+	return b
+}
+
+func appendFrequency(b []byte, freq uint64) []byte {
+	freq /= 100
+	return append(b, byte(freq), byte(freq>>8), byte(freq>>16))
+}
+
+// appendMcClassCSessionReq appends the encoded McClassCSessionReq to b.
+func appendMcClassCSessionReq(b []byte, req McClassCSessionReq) []byte {
+	b = append(b, mcClassCSessionCID, req.McGroupID&0x03, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(b[len(b)-4:], req.SessionTime)
+	b = append(b, req.SessionTimeOut&0x0f)
+	b = appendFrequency(b, req.DLFrequency)
+	return append(b, req.DR)
+}
+
+// appendMcClassBSessionReq appends the encoded McClassBSessionReq to b.
+func appendMcClassBSessionReq(b []byte, req McClassBSessionReq) []byte {
+	b = append(b, mcClassBSessionCID, req.McGroupID&0x03, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(b[len(b)-4:], req.SessionTime)
+	b = append(b, (req.Periodicity&0x07)<<4|req.SessionTimeOut&0x0f)
+	b = appendFrequency(b, req.DLFrequency)
+	return append(b, req.DR)
+}
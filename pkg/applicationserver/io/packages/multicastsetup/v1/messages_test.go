@@ -0,0 +1,129 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecodeUplink(t *testing.T) {
+	timeToStart := uint32(16)
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Commands []interface{}
+		Error    error
+	}{
+		{
+			Name:    "Empty",
+			Payload: []byte{},
+		},
+		{
+			Name:    "PackageVersionAns",
+			Payload: []byte{0x00, 0x02, 0x01},
+			Commands: []interface{}{
+				&PackageVersionAns{PackageIdentifier: 2, PackageVersion: 1},
+			},
+		},
+		{
+			Name:    "McGroupStatusAns",
+			Payload: []byte{0x01, 0x22, 0x01, 0x04, 0x03, 0x02, 0x01},
+			Commands: []interface{}{
+				&McGroupStatusAns{
+					NbTotalGroups: 2,
+					Groups: []McGroupStatus{
+						{McGroupID: 1, McAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04}},
+					},
+				},
+			},
+		},
+		{
+			Name:    "McGroupSetupAns/McGroupDeleteAns",
+			Payload: []byte{0x02, 0x05, 0x03, 0x02},
+			Commands: []interface{}{
+				&McGroupSetupAns{McGroupID: 1, IDError: true},
+				&McGroupDeleteAns{McGroupID: 2},
+			},
+		},
+		{
+			Name:    "McClassCSessionAns/McClassBSessionAns",
+			Payload: []byte{0x04, 0x01, 0x10, 0x00, 0x00, 0x05, 0x09},
+			Commands: []interface{}{
+				&McClassCSessionAns{McSessionAns{McGroupID: 1, TimeToStart: &timeToStart}},
+				&McClassBSessionAns{McSessionAns{McGroupID: 1, FreqError: true}},
+			},
+		},
+		{
+			Name:    "UnknownCommand",
+			Payload: []byte{0x7f},
+			Error:   errUnknownCommand,
+		},
+		{
+			Name:    "ShortCommand",
+			Payload: []byte{0x04, 0x01, 0x10},
+			Error:   errCommandLength,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := decodeUplink(tc.Payload)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+func TestEncodeRequests(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(appendMcGroupSetupReq(nil, McGroupSetupReq{
+		McGroupID:      1,
+		McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+		McKeyEncrypted: types.AES128Key{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19},
+		MinMcFCnt:      1,
+		MaxMcFCnt:      0x100,
+	}), should.Resemble, []byte{
+		0x02, 0x01,
+		0x04, 0x03, 0x02, 0x01,
+		0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19,
+		0x01, 0x00, 0x00, 0x00,
+		0x00, 0x01, 0x00, 0x00,
+	})
+
+	a.So(appendMcClassCSessionReq(nil, McClassCSessionReq{
+		McGroupID:      0,
+		SessionTime:    0x01020304,
+		SessionTimeOut: 5,
+		DLFrequency:    869525000,
+		DR:             0,
+	}), should.Resemble, []byte{0x04, 0x00, 0x04, 0x03, 0x02, 0x01, 0x05, 0xd2, 0xad, 0x84, 0x00})
+
+	a.So(appendMcClassBSessionReq(nil, McClassBSessionReq{
+		McGroupID:      2,
+		SessionTime:    0x01020380,
+		SessionTimeOut: 3,
+		Periodicity:    4,
+		DLFrequency:    869525000,
+		DR:             3,
+	}), should.Resemble, []byte{0x05, 0x02, 0x80, 0x03, 0x02, 0x01, 0x43, 0xd2, 0xad, 0x84, 0x03})
+}
@@ -0,0 +1,251 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicastsetupv1 implements the LoRaWAN Remote Multicast Setup v1.0.0 application package.
+package multicastsetupv1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const (
+	packageName = "lorawan-remote-multicast-setup-v1"
+
+	// DefaultFPort is the default FPort of the remote multicast setup package.
+	DefaultFPort = 200
+)
+
+// MulticastSetupPackage is the LoRaWAN Remote Multicast Setup application package.
+// It sets up the configured multicast groups and sessions on the associated end devices.
+// Requests are sent in response to uplink messages until the end device answered them.
+type MulticastSetupPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         packageName,
+		DefaultFPort: DefaultFPort,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var (
+	errNoAssociation = errors.DefineInternal("no_association", "no association available")
+	errSessionClass  = errors.DefineInvalidArgument("session_class", "invalid class `{class}` of session of multicast group `{id}`")
+)
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/multicastsetup/v1")
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+
+	data, fPort, err := mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+	var state packageState
+	if assoc != nil {
+		if err := packages.DecodeData(assoc.Data.GetFields()[packages.StateField].GetStructValue(), &state); err != nil {
+			return err
+		}
+	}
+
+	if msg.FPort == fPort && len(msg.FRMPayload) > 0 {
+		cmds, err := decodeUplink(msg.FRMPayload)
+		if err != nil {
+			return err
+		}
+		state = packageState{}
+		if err := packages.UpdateAssociationState(ctx, p.registry, ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIdentifiers: up.EndDeviceIdentifiers,
+			FPort:                fPort,
+		}, packageName, &state, func() error {
+			applyAnswers(data, &state, cmds...)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := packages.PublishServiceData(ctx, p.server, up, packageName, map[string]interface{}{
+			"commands": cmds,
+		}); err != nil {
+			return err
+		}
+	}
+
+	reqs, err := pendingRequests(data, state, time.Now())
+	if err != nil || len(reqs) == 0 {
+		return err
+	}
+	queue, err := p.server.DownlinkQueueList(ctx, up.EndDeviceIdentifiers)
+	if err != nil {
+		return err
+	}
+	for _, down := range queue {
+		if down.FPort == fPort {
+			log.FromContext(ctx).Debug("Requests already queued, skip")
+			return nil
+		}
+	}
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(reqs))
+	for _, req := range reqs {
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FRMPayload: req,
+		})
+	}
+	log.FromContext(ctx).WithField("count", len(downlinks)).Debug("Queue multicast setup requests")
+	return p.server.DownlinkQueuePush(ctx, up.EndDeviceIdentifiers, downlinks)
+}
+
+// applyAnswers updates the state with the answers of the end device.
+func applyAnswers(data packageData, state *packageState, cmds ...interface{}) {
+	if state.Groups == nil {
+		state.Groups = make(map[uint8]*groupState)
+	}
+	for _, cmd := range cmds {
+		switch ans := cmd.(type) {
+		case *McGroupSetupAns:
+			g, ok := data.group(ans.McGroupID)
+			if !ok {
+				continue
+			}
+			state.Groups[ans.McGroupID] = &groupState{
+				McAddr:   &g.McAddr,
+				SetupAns: ans,
+			}
+		case *McGroupDeleteAns:
+			delete(state.Groups, ans.McGroupID)
+		case *McClassCSessionAns:
+			applySessionAnswer(data, state, ans.McSessionAns)
+		case *McClassBSessionAns:
+			applySessionAnswer(data, state, ans.McSessionAns)
+		}
+	}
+}
+
+func applySessionAnswer(data packageData, state *packageState, ans McSessionAns) {
+	g, ok := data.group(ans.McGroupID)
+	if !ok || g.Session == nil {
+		return
+	}
+	st, ok := state.Groups[ans.McGroupID]
+	if !ok {
+		return
+	}
+	st.SessionTime = &g.Session.Time
+	st.SessionAns = &ans
+}
+
+// pendingRequests returns the encoded requests that the end device has not answered yet.
+// Sessions that have started before now are not requested.
+func pendingRequests(data packageData, state packageState, now time.Time) ([][]byte, error) {
+	var reqs [][]byte
+	for _, g := range data.Groups {
+		st := state.Groups[g.ID]
+		if st == nil || st.SetupAns == nil || st.McAddr == nil || !st.McAddr.Equal(g.McAddr) {
+			reqs = append(reqs, appendMcGroupSetupReq(nil, McGroupSetupReq{
+				McGroupID:      g.ID,
+				McAddr:         g.McAddr,
+				McKeyEncrypted: g.McKeyEncrypted,
+				MinMcFCnt:      g.MinMcFCnt,
+				MaxMcFCnt:      g.MaxMcFCnt,
+			}))
+			continue
+		}
+		if st.SetupAns.IDError || g.Session == nil || !g.Session.Time.After(now) {
+			continue
+		}
+		if st.SessionTime != nil && st.SessionTime.Equal(g.Session.Time) {
+			continue
+		}
+		switch strings.ToUpper(g.Session.Class) {
+		case "B":
+			reqs = append(reqs, appendMcClassBSessionReq(nil, McClassBSessionReq{
+				McGroupID:      g.ID,
+				SessionTime:    gpsSeconds(g.Session.Time, beaconPeriod),
+				SessionTimeOut: g.Session.TimeOut,
+				Periodicity:    g.Session.PingSlotPeriodicity,
+				DLFrequency:    g.Session.Frequency,
+				DR:             g.Session.DataRateIndex,
+			}))
+		case "C":
+			reqs = append(reqs, appendMcClassCSessionReq(nil, McClassCSessionReq{
+				McGroupID:      g.ID,
+				SessionTime:    gpsSeconds(g.Session.Time, time.Second),
+				SessionTimeOut: g.Session.TimeOut,
+				DLFrequency:    g.Session.Frequency,
+				DR:             g.Session.DataRateIndex,
+			}))
+		default:
+			return nil, errSessionClass.WithAttributes("class", g.Session.Class, "id", g.ID)
+		}
+	}
+	return reqs, nil
+}
+
+func mergePackageData(def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation) (packageData, uint32, error) {
+	var data packageData
+	var fPort uint32
+	if def != nil {
+		if err := packages.DecodeData(def.Data, &data); err != nil {
+			return packageData{}, 0, err
+		}
+		fPort = def.FPort
+	}
+	if assoc != nil {
+		if err := packages.DecodeData(assoc.Data, &data); err != nil {
+			return packageData{}, 0, err
+		}
+		fPort = assoc.FPort
+	}
+	return data, fPort, nil
+}
+
+// New instantiates the LoRaWAN Remote Multicast Setup package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &MulticastSetupPackage{
+		server:   server,
+		registry: registry,
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPendingRequests(t *testing.T) {
+	a := assertions.New(t)
+
+	now := time.Unix(1600000000, 0).UTC()
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	data := packageData{
+		Groups: []groupData{
+			{
+				ID:        1,
+				McAddr:    mcAddr,
+				MaxMcFCnt: 0xffff,
+				Session: &sessionData{
+					Class:         "C",
+					Time:          now.Add(time.Hour),
+					TimeOut:       8,
+					Frequency:     869525000,
+					DataRateIndex: 3,
+				},
+			},
+		},
+	}
+	setupReq := appendMcGroupSetupReq(nil, McGroupSetupReq{
+		McGroupID: 1,
+		McAddr:    mcAddr,
+		MaxMcFCnt: 0xffff,
+	})
+	sessionReq := appendMcClassCSessionReq(nil, McClassCSessionReq{
+		McGroupID:      1,
+		SessionTime:    gpsSeconds(now.Add(time.Hour), time.Second),
+		SessionTimeOut: 8,
+		DLFrequency:    869525000,
+		DR:             3,
+	})
+
+	// The group is not set up yet.
+	var state packageState
+	reqs, err := pendingRequests(data, state, now)
+	a.So(err, should.BeNil)
+	a.So(reqs, should.Resemble, [][]byte{setupReq})
+
+	// The group is set up, but the session is not.
+	applyAnswers(data, &state, &McGroupSetupAns{McGroupID: 1})
+	reqs, err = pendingRequests(data, state, now)
+	a.So(err, should.BeNil)
+	a.So(reqs, should.Resemble, [][]byte{sessionReq})
+
+	// The group and the session are set up.
+	applyAnswers(data, &state, &McClassCSessionAns{McSessionAns{McGroupID: 1}})
+	reqs, err = pendingRequests(data, state, now)
+	a.So(err, should.BeNil)
+	a.So(reqs, should.BeEmpty)
+
+	// The session has started already.
+	next := data
+	next.Groups = []groupData{data.Groups[0]}
+	session := *next.Groups[0].Session
+	session.Time = now.Add(-time.Minute)
+	next.Groups[0].Session = &session
+	reqs, err = pendingRequests(next, state, now)
+	a.So(err, should.BeNil)
+	a.So(reqs, should.BeEmpty)
+
+	// The multicast address changed.
+	next.Groups[0].McAddr = types.DevAddr{0x04, 0x03, 0x02, 0x01}
+	reqs, err = pendingRequests(next, state, now)
+	a.So(err, should.BeNil)
+	a.So(reqs, should.HaveLength, 1)
+
+	// The group is deleted from the end device.
+	applyAnswers(data, &state, &McGroupDeleteAns{McGroupID: 1})
+	a.So(state.Groups, should.BeEmpty)
+
+	// The session class is invalid.
+	session.Class = "A"
+	session.Time = now.Add(time.Hour)
+	applyAnswers(data, &state, &McGroupSetupAns{McGroupID: 1})
+	next.Groups[0].McAddr = mcAddr
+	_, err = pendingRequests(next, state, now)
+	a.So(err, should.HaveSameErrorDefinitionAs, errSessionClass)
+}
@@ -0,0 +1,167 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const packageName = "storage-integration"
+
+// DefaultPruneInterval is the default interval at which expired upstream messages are deleted.
+const DefaultPruneInterval = time.Hour
+
+// StoragePackage is the storage integration application package.
+type StoragePackage struct {
+	ctx    context.Context
+	server io.Server
+	store  Store
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name: packageName,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationUpStorageServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationUpStorageHandler(p.ctx, s, conn)
+}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	if UpType(up) == "" {
+		return nil
+	}
+	if err := p.store.Store(ctx, up); err != nil {
+		registerStoreFail(ctx, up.EndDeviceIdentifiers, err)
+		return err
+	}
+	return nil
+}
+
+var errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "exactly one of application or end device identifiers must be set")
+
+// GetStoredApplicationUp implements ttnpb.ApplicationUpStorageServer.
+func (p *StoragePackage) GetStoredApplicationUp(req *ttnpb.GetStoredApplicationUpRequest, stream ttnpb.ApplicationUpStorage_GetStoredApplicationUpServer) error {
+	ctx := stream.Context()
+	var appIDs ttnpb.ApplicationIdentifiers
+	switch {
+	case req.ApplicationIDs != nil && req.EndDeviceIDs == nil:
+		appIDs = *req.ApplicationIDs
+	case req.ApplicationIDs == nil && req.EndDeviceIDs != nil:
+		appIDs = req.EndDeviceIDs.ApplicationIdentifiers
+	default:
+		return errInvalidIdentifiers.New()
+	}
+	if err := rights.RequireApplication(ctx, appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return err
+	}
+	return p.store.Range(ctx, req, func(up *ttnpb.ApplicationUp) error {
+		if paths := maskPaths(req.FieldMask.Paths, UpType(up)); len(paths) > 0 {
+			res := &ttnpb.ApplicationUp{}
+			if err := res.SetFields(up, paths...); err != nil {
+				return err
+			}
+			up = res
+		}
+		return stream.Send(up)
+	})
+}
+
+// maskPaths returns the paths that apply to upstream messages of the given type.
+// The paths of the other upstream message types are omitted, since only one of them can be set.
+func maskPaths(paths []string, upType string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if path == "up" || !strings.HasPrefix(path, "up.") {
+			res = append(res, path)
+			continue
+		}
+		if sub := strings.TrimPrefix(path, "up."); sub == upType || strings.HasPrefix(sub, upType+".") {
+			res = append(res, path)
+		}
+	}
+	return res
+}
+
+func (p *StoragePackage) prune(ctx context.Context, retention time.Duration) {
+	n, err := p.store.DeleteBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to delete expired upstream messages")
+		return
+	}
+	if n > 0 {
+		log.FromContext(ctx).WithField("count", n).Debug("Deleted expired upstream messages")
+	}
+}
+
+func (p *StoragePackage) runPrune(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.prune(ctx, retention)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// New instantiates the storage integration package.
+// If the retention in the configuration is positive, expired upstream messages are deleted periodically until ctx is done.
+func New(ctx context.Context, server io.Server, conf Config) packages.ApplicationPackageHandler {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/storage")
+	p := &StoragePackage{
+		ctx:    ctx,
+		server: server,
+		store:  conf.Store,
+	}
+	if conf.Retention > 0 {
+		interval := conf.PruneInterval
+		if interval <= 0 {
+			interval = DefaultPruneInterval
+		}
+		go p.runPrune(ctx, conf.Retention, interval)
+	}
+	return p
+}
@@ -0,0 +1,325 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestUpType(t *testing.T) {
+	a := assertions.New(t)
+
+	up := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{FPort: 42},
+		},
+	}
+	a.So(UpType(up), should.Equal, "uplink_message")
+	fPort, ok := UpFPort(up)
+	a.So(ok, should.BeTrue)
+	a.So(fPort, should.Equal, 42)
+
+	up = &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{},
+		},
+	}
+	a.So(UpType(up), should.Equal, "join_accept")
+	_, ok = UpFPort(up)
+	a.So(ok, should.BeFalse)
+
+	a.So(UpType(&ttnpb.ApplicationUp{}), should.BeEmpty)
+}
+
+func TestMaskPaths(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(maskPaths(nil, "uplink_message"), should.BeNil)
+
+	paths := []string{
+		"correlation_ids",
+		"end_device_ids",
+		"up.downlink_ack.f_port",
+		"up.join_accept",
+		"up.uplink_message.decoded_payload",
+		"up.uplink_message.f_port",
+	}
+	a.So(maskPaths(paths, "uplink_message"), should.Resemble, []string{
+		"correlation_ids",
+		"end_device_ids",
+		"up.uplink_message.decoded_payload",
+		"up.uplink_message.f_port",
+	})
+	a.So(maskPaths(paths, "join_accept"), should.Resemble, []string{
+		"correlation_ids",
+		"end_device_ids",
+		"up.join_accept",
+	})
+	a.So(maskPaths([]string{"up"}, "downlink_ack"), should.Resemble, []string{"up"})
+}
+
+type mockStore struct {
+	ups  []*ttnpb.ApplicationUp
+	err  error
+	reqs []*ttnpb.GetStoredApplicationUpRequest
+}
+
+func (s *mockStore) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+func (s *mockStore) Range(ctx context.Context, req *ttnpb.GetStoredApplicationUpRequest, f func(*ttnpb.ApplicationUp) error) error {
+	s.reqs = append(s.reqs, req)
+	if s.err != nil {
+		return s.err
+	}
+	for _, up := range s.ups {
+		if err := f(up); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *mockStore) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	return 0, s.err
+}
+
+type mockStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ups []*ttnpb.ApplicationUp
+}
+
+func (s *mockStream) Context() context.Context { return s.ctx }
+
+func (s *mockStream) Send(up *ttnpb.ApplicationUp) error {
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+var (
+	testAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	testDevIDs = ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: testAppIDs, DeviceID: "test-dev"}
+)
+
+func TestHandleUp(t *testing.T) {
+	uplink := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: testDevIDs,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{FPort: 42},
+		},
+	}
+	def := &ttnpb.ApplicationPackageDefaultAssociation{}
+	assoc := &ttnpb.ApplicationPackageAssociation{}
+
+	t.Run("NoAssociation", func(t *testing.T) {
+		a, ctx := test.New(t)
+		store := &mockStore{}
+		p := &StoragePackage{ctx: ctx, store: store}
+		err := p.HandleUp(ctx, nil, nil, uplink)
+		a.So(err, should.HaveSameErrorDefinitionAs, errNoAssociation)
+		a.So(store.ups, should.BeEmpty)
+	})
+
+	t.Run("DefaultAssociation", func(t *testing.T) {
+		a, ctx := test.New(t)
+		store := &mockStore{}
+		p := &StoragePackage{ctx: ctx, store: store}
+		a.So(p.HandleUp(ctx, def, nil, uplink), should.BeNil)
+		a.So(store.ups, should.Resemble, []*ttnpb.ApplicationUp{uplink})
+	})
+
+	t.Run("Association", func(t *testing.T) {
+		a, ctx := test.New(t)
+		store := &mockStore{}
+		p := &StoragePackage{ctx: ctx, store: store}
+		a.So(p.HandleUp(ctx, nil, assoc, uplink), should.BeNil)
+		a.So(store.ups, should.Resemble, []*ttnpb.ApplicationUp{uplink})
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		a, ctx := test.New(t)
+		store := &mockStore{}
+		p := &StoragePackage{ctx: ctx, store: store}
+		a.So(p.HandleUp(ctx, def, assoc, &ttnpb.ApplicationUp{EndDeviceIdentifiers: testDevIDs}), should.BeNil)
+		a.So(store.ups, should.BeEmpty)
+	})
+
+	t.Run("StoreFailure", func(t *testing.T) {
+		a, ctx := test.New(t)
+		errStore := errors.DefineUnavailable("test_store", "test store")
+		store := &mockStore{err: errStore.New()}
+		p := &StoragePackage{ctx: ctx, store: store}
+		err := p.HandleUp(ctx, def, assoc, uplink)
+		a.So(err, should.HaveSameErrorDefinitionAs, errStore)
+	})
+}
+
+func TestGetStoredApplicationUp(t *testing.T) {
+	ups := []*ttnpb.ApplicationUp{
+		{
+			EndDeviceIdentifiers: testDevIDs,
+			CorrelationIDs:       []string{"test"},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      42,
+					FRMPayload: []byte{0x01, 0x02},
+				},
+			},
+		},
+		{
+			EndDeviceIdentifiers: testDevIDs,
+			CorrelationIDs:       []string{"test"},
+			Up: &ttnpb.ApplicationUp_JoinAccept{
+				JoinAccept: &ttnpb.ApplicationJoinAccept{
+					SessionKeyID: []byte{0x11, 0x22},
+				},
+			},
+		},
+	}
+	withRights := func(ctx context.Context, rs ...ttnpb.Right) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(ctx, testAppIDs): ttnpb.RightsFrom(rs...),
+			},
+		})
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		Request        *ttnpb.GetStoredApplicationUpRequest
+		ErrorAssertion func(error) bool
+		Expected       []*ttnpb.ApplicationUp
+	}{
+		{
+			Name: "NoIdentifiers",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return withRights(ctx, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+			},
+			Request:        &ttnpb.GetStoredApplicationUpRequest{},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "BothIdentifiers",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return withRights(ctx, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+			},
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &testAppIDs,
+				EndDeviceIDs:   &testDevIDs,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "PermissionDenied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return withRights(ctx, ttnpb.RIGHT_APPLICATION_INFO)
+			},
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &testAppIDs,
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "EndDevicePermissionDenied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return withRights(ctx)
+			},
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIDs: &testDevIDs,
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "Application",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return withRights(ctx, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+			},
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &testAppIDs,
+			},
+			Expected: ups,
+		},
+		{
+			Name: "EndDeviceFieldMask",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return withRights(ctx, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+			},
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIDs: &testDevIDs,
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"end_device_ids",
+						"up.join_accept.session_key_id",
+						"up.uplink_message.f_port",
+					},
+				},
+			},
+			Expected: []*ttnpb.ApplicationUp{
+				{
+					EndDeviceIdentifiers: testDevIDs,
+					Up: &ttnpb.ApplicationUp_UplinkMessage{
+						UplinkMessage: &ttnpb.ApplicationUplink{
+							FPort: 42,
+						},
+					},
+				},
+				{
+					EndDeviceIdentifiers: testDevIDs,
+					Up: &ttnpb.ApplicationUp_JoinAccept{
+						JoinAccept: &ttnpb.ApplicationJoinAccept{
+							SessionKeyID: []byte{0x11, 0x22},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a, ctx := test.New(t)
+			store := &mockStore{ups: ups}
+			p := &StoragePackage{ctx: ctx, store: store}
+			stream := &mockStream{ctx: tc.ContextFunc(ctx)}
+			err := p.GetStoredApplicationUp(tc.Request, stream)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				a.So(store.reqs, should.BeEmpty)
+				a.So(stream.ups, should.BeEmpty)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(store.reqs, should.Resemble, []*ttnpb.GetStoredApplicationUpRequest{tc.Request})
+			a.So(stream.ups, should.Resemble, tc.Expected)
+		})
+	}
+}
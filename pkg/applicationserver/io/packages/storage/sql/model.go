@@ -0,0 +1,66 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// ApplicationUp is the database model of a stored upstream message.
+type ApplicationUp struct {
+	ID string `gorm:"type:UUID;primary_key;default:gen_random_uuid()"`
+
+	ApplicationID string    `gorm:"type:VARCHAR(36);not null;index:application_up_application_index;index:application_up_device_index"`
+	DeviceID      string    `gorm:"type:VARCHAR(36);not null;index:application_up_device_index"`
+	Type          string    `gorm:"type:VARCHAR(32);not null"`
+	FPort         *uint32   `gorm:"type:INTEGER"`
+	ReceivedAt    time.Time `gorm:"not null;index:application_up_received_at_index"`
+
+	Data []byte `gorm:"type:BYTEA;not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (ApplicationUp) TableName() string { return "application_ups" }
+
+func (m *ApplicationUp) fromPB(up *ttnpb.ApplicationUp) error {
+	data, err := up.Marshal()
+	if err != nil {
+		return err
+	}
+	m.ApplicationID = up.ApplicationID
+	m.DeviceID = up.DeviceID
+	m.Type = storage.UpType(up)
+	if fPort, ok := storage.UpFPort(up); ok {
+		m.FPort = &fPort
+	}
+	if up.ReceivedAt != nil {
+		m.ReceivedAt = up.ReceivedAt.UTC()
+	} else {
+		m.ReceivedAt = time.Now().UTC()
+	}
+	m.Data = data
+	return nil
+}
+
+func (m ApplicationUp) toPB() (*ttnpb.ApplicationUp, error) {
+	up := &ttnpb.ApplicationUp{}
+	if err := up.Unmarshal(m.Data); err != nil {
+		return nil, err
+	}
+	return up, nil
+}
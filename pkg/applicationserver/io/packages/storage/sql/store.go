@@ -0,0 +1,146 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements the storage integration store on top of an SQL database.
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
+)
+
+var errDatabase = errors.DefineInternal("database", "database error")
+
+func convertError(err error) error {
+	switch err {
+	case nil, context.Canceled, context.DeadlineExceeded:
+		return err
+	}
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnErr
+	}
+	return errDatabase.WithCause(err)
+}
+
+// AutoMigrate automatically migrates the schema of the storage integration tables.
+func AutoMigrate(db *gorm.DB) *gorm.DB {
+	return db.AutoMigrate(&ApplicationUp{})
+}
+
+// Store is an SQL implementation of storage.Store.
+type Store struct {
+	DB *gorm.DB
+}
+
+// New returns a new SQL store on top of the given database connection.
+func New(db *gorm.DB) storage.Store {
+	return &Store{DB: db}
+}
+
+// Store implements storage.Store.
+func (s *Store) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	var model ApplicationUp
+	if err := model.fromPB(up); err != nil {
+		return err
+	}
+	return convertError(gormutil.Transact(ctx, s.DB, func(db *gorm.DB) error {
+		return db.Create(&model).Error
+	}))
+}
+
+// Range implements storage.Store.
+func (s *Store) Range(ctx context.Context, req *ttnpb.GetStoredApplicationUpRequest, f func(*ttnpb.ApplicationUp) error) error {
+	// fFailed is true if f returned an error, which is returned as is.
+	var fFailed bool
+	err := gormutil.Transact(ctx, s.DB, func(db *gorm.DB) error {
+		query := db.Model(&ApplicationUp{})
+		switch {
+		case req.EndDeviceIDs != nil:
+			query = query.Where(&ApplicationUp{
+				ApplicationID: req.EndDeviceIDs.ApplicationID,
+				DeviceID:      req.EndDeviceIDs.DeviceID,
+			})
+		case req.ApplicationIDs != nil:
+			query = query.Where(&ApplicationUp{
+				ApplicationID: req.ApplicationIDs.ApplicationID,
+			})
+		}
+		if req.Type != "" {
+			query = query.Where("type = ?", req.Type)
+		}
+		if req.FPort != nil {
+			query = query.Where("f_port = ?", req.FPort.Value)
+		}
+		if req.After != nil {
+			query = query.Where("received_at > ?", req.After.UTC())
+		}
+		if req.Before != nil {
+			query = query.Where("received_at < ?", req.Before.UTC())
+		}
+		if req.Order == "-received_at" {
+			query = query.Order("received_at DESC")
+		} else {
+			query = query.Order("received_at ASC")
+		}
+		if req.Limit != nil {
+			query = query.Limit(req.Limit.Value)
+		}
+		rows, err := query.Select("data").Rows()
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var model ApplicationUp
+			if err := db.ScanRows(rows, &model); err != nil {
+				return err
+			}
+			up, err := model.toPB()
+			if err != nil {
+				return err
+			}
+			if err := f(up); err != nil {
+				fFailed = true
+				return err
+			}
+		}
+		return rows.Err()
+	})
+	if fFailed {
+		return err
+	}
+	return convertError(err)
+}
+
+// DeleteBefore implements storage.Store.
+func (s *Store) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	var n int64
+	if err := gormutil.Transact(ctx, s.DB, func(db *gorm.DB) error {
+		res := db.Where("received_at < ?", t.UTC()).Delete(&ApplicationUp{})
+		n = res.RowsAffected
+		return res.Error
+	}); err != nil {
+		return 0, convertError(err)
+	}
+	return n, nil
+}
@@ -0,0 +1,251 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/sql"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ storage.Store = &Store{}
+
+func newTestDB(ctx context.Context, t *testing.T) *gorm.DB {
+	dbAddress := os.Getenv("SQL_DB_ADDRESS")
+	if dbAddress == "" {
+		dbAddress = "localhost:26257"
+	}
+	dbName := os.Getenv("TEST_DATABASE_NAME")
+	if dbName == "" {
+		dbName = "ttn_lorawan_as_storage_test"
+	}
+	dbAuth := os.Getenv("SQL_DB_AUTH")
+	if dbAuth == "" {
+		dbAuth = "root"
+	}
+	db, err := gormutil.Open(ctx, fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName))
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", test.FormatError(err))
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := gormutil.Initialize(db); err != nil {
+		t.Fatalf("Failed to initialize database: %s", test.FormatError(err))
+	}
+	if err := AutoMigrate(db).Error; err != nil {
+		t.Fatalf("Failed to migrate database: %s", test.FormatError(err))
+	}
+	if dbKind, ok := db.Get("db:kind"); ok && dbKind == "CockroachDB" {
+		if err := db.Exec("SET SQL_SAFE_UPDATES = FALSE").Error; err != nil {
+			t.Fatalf("Failed to disable safe updates: %s", test.FormatError(err))
+		}
+	}
+	if err := db.Delete(&ApplicationUp{}).Error; err != nil {
+		t.Fatalf("Failed to clear database: %s", test.FormatError(err))
+	}
+	return db
+}
+
+func TestStore(t *testing.T) {
+	a, ctx := test.New(t)
+	s := New(newTestDB(ctx, t))
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	otherAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"}
+	dev1IDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "test-dev-1"}
+	dev2IDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "test-dev-2"}
+	otherDevIDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: otherAppIDs, DeviceID: "test-dev-1"}
+
+	start := time.Now().UTC().Truncate(time.Millisecond)
+	at := func(i int) *time.Time {
+		t := start.Add(time.Duration(i) * time.Minute)
+		return &t
+	}
+	uplink := func(ids ttnpb.EndDeviceIdentifiers, fPort uint32, receivedAt *time.Time) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			ReceivedAt:           receivedAt,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      fPort,
+					FRMPayload: []byte{0x01, 0x02, 0x03},
+				},
+			},
+		}
+	}
+	joinAccept := func(ids ttnpb.EndDeviceIdentifiers, receivedAt *time.Time) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			ReceivedAt:           receivedAt,
+			Up: &ttnpb.ApplicationUp_JoinAccept{
+				JoinAccept: &ttnpb.ApplicationJoinAccept{
+					SessionKeyID: []byte{0x11, 0x22},
+				},
+			},
+		}
+	}
+
+	ups := []*ttnpb.ApplicationUp{
+		joinAccept(dev1IDs, at(0)),
+		uplink(dev1IDs, 1, at(1)),
+		uplink(dev2IDs, 2, at(2)),
+		uplink(dev1IDs, 2, at(3)),
+		uplink(otherDevIDs, 1, at(4)),
+	}
+	for _, up := range ups {
+		if !a.So(s.Store(ctx, up), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Request  *ttnpb.GetStoredApplicationUpRequest
+		Expected []*ttnpb.ApplicationUp
+	}{
+		{
+			Name: "Application",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+			},
+			Expected: ups[:4],
+		},
+		{
+			Name: "EndDevice",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIDs: &dev1IDs,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[0], ups[1], ups[3]},
+		},
+		{
+			Name: "OtherApplication",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIDs: &otherDevIDs,
+			},
+			Expected: ups[4:],
+		},
+		{
+			Name: "Type",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				Type:           "join_accept",
+			},
+			Expected: ups[:1],
+		},
+		{
+			Name: "FPort",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				FPort:          &pbtypes.UInt32Value{Value: 2},
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[2], ups[3]},
+		},
+		{
+			Name: "AfterBefore",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				After:          at(0),
+				Before:         at(3),
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[1], ups[2]},
+		},
+		{
+			Name: "OrderDescending",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				Order:          "-received_at",
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[3], ups[2], ups[1], ups[0]},
+		},
+		{
+			Name: "Limit",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				Order:          "-received_at",
+				Limit:          &pbtypes.UInt32Value{Value: 2},
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[3], ups[2]},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			var res []*ttnpb.ApplicationUp
+			err := s.Range(ctx, tc.Request, func(up *ttnpb.ApplicationUp) error {
+				res = append(res, up)
+				return nil
+			})
+			a.So(err, should.BeNil)
+			a.So(res, should.Resemble, tc.Expected)
+		})
+	}
+
+	t.Run("Stop", func(t *testing.T) {
+		a := assertions.New(t)
+		errStop := errors.New("stop")
+		var n int
+		err := s.Range(ctx, &ttnpb.GetStoredApplicationUpRequest{
+			ApplicationIDs: &appIDs,
+		}, func(*ttnpb.ApplicationUp) error {
+			n++
+			return errStop
+		})
+		a.So(err, should.Equal, errStop)
+		a.So(n, should.Equal, 1)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := s.Range(ctx, &ttnpb.GetStoredApplicationUpRequest{
+			ApplicationIDs: &appIDs,
+		}, func(*ttnpb.ApplicationUp) error {
+			return nil
+		})
+		a.So(errors.IsCanceled(err), should.BeTrue)
+		n, err := s.DeleteBefore(ctx, *at(2))
+		a.So(errors.IsCanceled(err), should.BeTrue)
+		a.So(n, should.Equal, 0)
+	})
+
+	t.Run("DeleteBefore", func(t *testing.T) {
+		a := assertions.New(t)
+		n, err := s.DeleteBefore(ctx, *at(2))
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 2)
+
+		var res []*ttnpb.ApplicationUp
+		err = s.Range(ctx, &ttnpb.GetStoredApplicationUpRequest{
+			ApplicationIDs: &appIDs,
+		}, func(up *ttnpb.ApplicationUp) error {
+			res = append(res, up)
+			return nil
+		})
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, []*ttnpb.ApplicationUp{ups[2], ups[3]})
+	})
+}
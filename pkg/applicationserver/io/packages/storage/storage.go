@@ -0,0 +1,80 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage implements the storage integration application package.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Store is a store for application upstream messages.
+type Store interface {
+	// Store persists the given upstream message.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range calls f for each stored upstream message that matches the request, in the requested order.
+	// The field mask of the request is not applied by the store.
+	// If f returns an error, the iteration stops and the error is returned.
+	Range(ctx context.Context, req *ttnpb.GetStoredApplicationUpRequest, f func(*ttnpb.ApplicationUp) error) error
+	// DeleteBefore deletes the upstream messages that were received before the given time.
+	// It returns the number of deleted messages.
+	DeleteBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+// Config contains configuration options for the storage integration.
+type Config struct {
+	Store         Store         `name:"-"`
+	DatabaseURI   string        `name:"database-uri" description:"Database connection URI of the storage integration"`
+	Retention     time.Duration `name:"retention" description:"Duration for which upstream messages are stored (0 means forever)"`
+	PruneInterval time.Duration `name:"prune-interval" description:"Interval at which expired upstream messages are deleted"`
+}
+
+// UpType returns the type of the given upstream message, as used in GetStoredApplicationUpRequest.
+func UpType(up *ttnpb.ApplicationUp) string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return "downlink_queue_invalidated"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	case *ttnpb.ApplicationUp_ServiceData:
+		return "service_data"
+	default:
+		return ""
+	}
+}
+
+// UpFPort returns the FPort of the given upstream message, if it is an uplink message.
+func UpFPort(up *ttnpb.ApplicationUp) (uint32, bool) {
+	if msg := up.GetUplinkMessage(); msg != nil {
+		return msg.FPort, true
+	}
+	return 0, false
+}
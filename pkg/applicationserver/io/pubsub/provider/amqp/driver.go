@@ -0,0 +1,347 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"sync"
+
+	"github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	exchange string
+	key      string
+
+	mu      sync.RWMutex
+	channel Channel
+}
+
+var errNilChannel = errors.DefineInvalidArgument("nil_channel", "channel is nil")
+
+// OpenTopic returns a *pubsub.Topic that publishes to the given exchange with the given routing key.
+func OpenTopic(channel Channel, exchange, key string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(channel, exchange, key)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(channel Channel, exchange, key string) (*topic, error) {
+	if channel == nil {
+		return nil, errNilChannel.New()
+	}
+	return &topic{
+		channel:  channel,
+		exchange: exchange,
+		key:      key,
+	}, nil
+}
+
+// setChannel sets the channel on which the topic publishes.
+func (t *topic) setChannel(channel Channel) {
+	t.mu.Lock()
+	t.channel = channel
+	t.mu.Unlock()
+}
+
+func (t *topic) getChannel() Channel {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.channel
+}
+
+var errPublishFailed = errors.Define("publish_failed", "publish to AMQP exchange failed")
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil {
+		return errNilChannel.New()
+	}
+	channel := t.getChannel()
+	if channel == nil {
+		return errNilChannel.New()
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pub := encodeMessage(msg)
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**amqp.Publishing)
+				if !ok {
+					return false
+				}
+				*p = &pub
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if err := channel.Publish(t.exchange, t.key, false, false, pub); err != nil {
+			return errPublishFailed.WithCause(err)
+		}
+	}
+	return nil
+}
+
+// encodeMessage encodes the message in an AMQP publishing. The metadata is encoded in the headers.
+func encodeMessage(dm *driver.Message) amqp.Publishing {
+	pub := amqp.Publishing{
+		DeliveryMode: amqp.Persistent,
+		Body:         dm.Body,
+	}
+	if len(dm.Metadata) > 0 {
+		pub.Headers = make(amqp.Table, len(dm.Metadata))
+		for k, v := range dm.Metadata {
+			pub.Headers[k] = v
+		}
+	}
+	return pub
+}
+
+func decodeMessage(d amqp.Delivery) *driver.Message {
+	dm := &driver.Message{
+		Body:  d.Body,
+		AckID: d,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(*amqp.Delivery)
+			if !ok {
+				return false
+			}
+			*p = d
+			return true
+		},
+	}
+	if len(d.Headers) > 0 {
+		dm.Metadata = make(map[string]string, len(d.Headers))
+		for k, v := range d.Headers {
+			if s, ok := v.(string); ok {
+				dm.Metadata[k] = s
+			}
+		}
+	}
+	return dm
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	c, ok := i.(*Channel)
+	if !ok {
+		return false
+	}
+	*c = t.getChannel()
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (*topic) Close() error { return nil }
+
+type subscription struct {
+	exchange string
+	queue    string
+
+	mu         sync.RWMutex
+	channel    Channel
+	deliveries <-chan amqp.Delivery
+	// consumed is closed when the subscription consumes on a new channel.
+	consumed chan struct{}
+}
+
+var (
+	errDeclareQueue = errors.Define("declare_queue", "declare AMQP queue `{queue}`")
+	errBindQueue    = errors.Define("bind_queue", "bind AMQP queue `{queue}` to exchange `{exchange}`")
+	errConsumeQueue = errors.Define("consume_queue", "consume AMQP queue `{queue}`")
+)
+
+// OpenSubscription returns a *pubsub.Subscription that consumes the queue with the given name on the given channel.
+// The durable queue is declared if it does not exist yet. If the exchange is not empty, the queue is bound to the
+// exchange with its name as routing key.
+// The channel is closed when the subscription shuts down.
+func OpenSubscription(channel Channel, exchange, queue string) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscription(channel, exchange, queue)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+func openDriverSubscription(channel Channel, exchange, queue string) (*subscription, error) {
+	s := &subscription{
+		exchange: exchange,
+		queue:    queue,
+		consumed: make(chan struct{}),
+	}
+	if err := s.consume(channel); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// consume declares, binds and consumes the queue of the subscription on the given channel.
+// Pending receives of deliveries on the previous channel continue on the given channel.
+func (s *subscription) consume(channel Channel) error {
+	if channel == nil {
+		return errNilChannel.New()
+	}
+	if _, err := channel.QueueDeclare(s.queue, true, false, false, false, nil); err != nil {
+		return errDeclareQueue.WithAttributes("queue", s.queue).WithCause(err)
+	}
+	if s.exchange != "" {
+		if err := channel.QueueBind(s.queue, s.queue, s.exchange, false, nil); err != nil {
+			return errBindQueue.WithAttributes("queue", s.queue, "exchange", s.exchange).WithCause(err)
+		}
+	}
+	deliveries, err := channel.Consume(s.queue, "", false, false, false, false, nil)
+	if err != nil {
+		return errConsumeQueue.WithAttributes("queue", s.queue).WithCause(err)
+	}
+	s.mu.Lock()
+	s.channel = channel
+	s.deliveries = deliveries
+	close(s.consumed)
+	s.consumed = make(chan struct{})
+	s.mu.Unlock()
+	return nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+// We always return one message at a time, since the deliveries are pushed one by one by the server.
+// If the channel closes, ReceiveBatch waits until the subscription consumes on a new channel.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil {
+		return nil, errNilChannel.New()
+	}
+	s.mu.RLock()
+	channel, deliveries, consumed := s.channel, s.deliveries, s.consumed
+	s.mu.RUnlock()
+	if channel == nil {
+		return nil, errNilChannel.New()
+	}
+	if maxMessages <= 0 {
+		return nil, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case d, ok := <-deliveries:
+		if ok {
+			return []*driver.Message{decodeMessage(d)}, nil
+		}
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-consumed:
+		return nil, nil
+	}
+}
+
+// SendAcks implements driver.Subscription.
+// Deliveries on closed channels cannot be acknowledged; the server delivers them again.
+func (*subscription) SendAcks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		if err := id.(amqp.Delivery).Ack(false); err != nil && err != amqp.ErrClosed {
+			return err
+		}
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return true }
+
+// SendNacks implements driver.Subscription.
+// The messages are requeued, so that they are delivered again.
+// Deliveries on closed channels are requeued by the server.
+func (*subscription) SendNacks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		if err := id.(amqp.Delivery).Nack(false, true); err != nil && err != amqp.ErrClosed {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	c, ok := i.(*Channel)
+	if !ok {
+		return false
+	}
+	s.mu.RLock()
+	*c = s.channel
+	s.mu.RUnlock()
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	channel := s.channel
+	s.mu.RUnlock()
+	if channel == nil {
+		return nil
+	}
+	return channel.Close()
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if errors.Resemble(err, errNilChannel) {
+		return gcerrors.NotFound
+	}
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case amqp.ErrClosed:
+		return gcerrors.NotFound
+	default:
+		return gcerrors.Unknown
+	}
+}
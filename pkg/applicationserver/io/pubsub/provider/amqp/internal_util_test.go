@@ -0,0 +1,355 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"sync"
+
+	"github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+// memoryServer is an in-process stand-in for an AMQP server with direct exchanges.
+// Messages that are not acknowledged are requeued when they are negatively acknowledged or when the channel closes.
+type memoryServer struct {
+	mu       sync.Mutex
+	queues   map[string]*memoryQueue
+	bindings map[string]map[string][]string
+	conns    []*memoryConn
+	dials    int
+	refuse   int
+}
+
+type memoryQueue struct {
+	name      string
+	messages  []amqp.Publishing
+	consumers []*memoryConsumer
+}
+
+func newMemoryServer() *memoryServer {
+	return &memoryServer{
+		queues:   make(map[string]*memoryQueue),
+		bindings: make(map[string]map[string][]string),
+	}
+}
+
+var errConnectionRefused = errors.DefineUnavailable("connection_refused", "connection refused")
+
+// Dial opens a connection to the server.
+func (s *memoryServer) Dial() (Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dials++
+	if s.refuse > 0 {
+		s.refuse--
+		return nil, errConnectionRefused.New()
+	}
+	c := &memoryConn{server: s}
+	s.conns = append(s.conns, c)
+	return c, nil
+}
+
+// disconnect closes the connections to the server unexpectedly.
+// The next refuse dials fail.
+func (s *memoryServer) disconnect(refuse int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refuse = refuse
+	for _, c := range s.conns {
+		c.close(&amqp.Error{Code: amqp.ConnectionForced, Reason: "disconnect"})
+	}
+	s.conns = nil
+}
+
+// channel returns a channel of a client that stays connected to the server.
+func (s *memoryServer) channel() Channel {
+	return &memoryChannel{server: s}
+}
+
+// dialCount returns the number of dials to the server.
+func (s *memoryServer) dialCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dials
+}
+
+type memoryConn struct {
+	server   *memoryServer
+	channels []*memoryChannel
+	notify   []chan *amqp.Error
+	closed   bool
+}
+
+// Channel implements Conn.
+func (c *memoryConn) Channel() (Channel, error) {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	if c.closed {
+		return nil, amqp.ErrClosed
+	}
+	ch := &memoryChannel{server: c.server}
+	c.channels = append(c.channels, ch)
+	return ch, nil
+}
+
+// NotifyClose implements Conn.
+func (c *memoryConn) NotifyClose(ch chan *amqp.Error) chan *amqp.Error {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	if c.closed {
+		close(ch)
+		return ch
+	}
+	c.notify = append(c.notify, ch)
+	return ch
+}
+
+// Close implements Conn.
+func (c *memoryConn) Close() error {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	return c.close(nil)
+}
+
+// close closes the connection and its channels. If err is not nil, it is sent to the close listeners.
+// The caller must hold the server lock.
+func (c *memoryConn) close(err *amqp.Error) error {
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	c.closed = true
+	for _, ch := range c.channels {
+		ch.close()
+	}
+	for _, ch := range c.notify {
+		if err != nil {
+			ch <- err
+		}
+		close(ch)
+	}
+	return nil
+}
+
+func (s *memoryServer) declare(name string) *memoryQueue {
+	q, ok := s.queues[name]
+	if !ok {
+		q = &memoryQueue{name: name}
+		s.queues[name] = q
+	}
+	return q
+}
+
+// route routes the message to the queues. The default exchange routes to the queue with the routing key as name.
+func (s *memoryServer) route(exchange, key string, msg amqp.Publishing) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var queues []string
+	if exchange == "" {
+		queues = []string{key}
+	} else {
+		queues = s.bindings[exchange][key]
+	}
+	for _, name := range queues {
+		q, ok := s.queues[name]
+		if !ok {
+			continue
+		}
+		q.messages = append(q.messages, msg)
+		q.dispatch()
+	}
+}
+
+// dispatch delivers the queued messages to the first consumer of the queue.
+func (q *memoryQueue) dispatch() {
+	if len(q.consumers) == 0 {
+		return
+	}
+	c := q.consumers[0]
+	for _, msg := range q.messages {
+		c.deliver(q, msg)
+	}
+	q.messages = nil
+}
+
+// get returns and removes the queued messages.
+func (s *memoryServer) get(queue string) []amqp.Publishing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	q, ok := s.queues[queue]
+	if !ok {
+		return nil
+	}
+	msgs := q.messages
+	q.messages = nil
+	return msgs
+}
+
+type memoryConsumer struct {
+	channel *memoryChannel
+	ch      chan amqp.Delivery
+	pending chan amqp.Delivery
+}
+
+func (c *memoryConsumer) deliver(q *memoryQueue, msg amqp.Publishing) {
+	c.channel.tag++
+	d := amqp.Delivery{
+		Acknowledger: c.channel,
+		Headers:      msg.Headers,
+		DeliveryMode: msg.DeliveryMode,
+		DeliveryTag:  c.channel.tag,
+		RoutingKey:   q.name,
+		Body:         msg.Body,
+	}
+	c.channel.unacked[d.DeliveryTag] = unacked{queue: q, msg: msg}
+	c.pending <- d
+}
+
+type unacked struct {
+	queue *memoryQueue
+	msg   amqp.Publishing
+}
+
+type memoryChannel struct {
+	server  *memoryServer
+	tag     uint64
+	unacked map[uint64]unacked
+	closed  bool
+}
+
+// Publish implements Channel.
+func (c *memoryChannel) Publish(exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	c.server.mu.Lock()
+	closed := c.closed
+	c.server.mu.Unlock()
+	if closed {
+		return amqp.ErrClosed
+	}
+	c.server.route(exchange, key, msg)
+	return nil
+}
+
+// QueueDeclare implements Channel.
+func (c *memoryChannel) QueueDeclare(name string, _, _, _, _ bool, _ amqp.Table) (amqp.Queue, error) {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	q := c.server.declare(name)
+	return amqp.Queue{
+		Name:      name,
+		Messages:  len(q.messages),
+		Consumers: len(q.consumers),
+	}, nil
+}
+
+// QueueBind implements Channel.
+func (c *memoryChannel) QueueBind(name, key, exchange string, _ bool, _ amqp.Table) error {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	if c.server.bindings[exchange] == nil {
+		c.server.bindings[exchange] = make(map[string][]string)
+	}
+	c.server.bindings[exchange][key] = append(c.server.bindings[exchange][key], name)
+	return nil
+}
+
+// Consume implements Channel.
+func (c *memoryChannel) Consume(queue, _ string, _, _, _, _ bool, _ amqp.Table) (<-chan amqp.Delivery, error) {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	q := c.server.declare(queue)
+	if c.unacked == nil {
+		c.unacked = make(map[uint64]unacked)
+	}
+	consumer := &memoryConsumer{
+		channel: c,
+		ch:      make(chan amqp.Delivery),
+		pending: make(chan amqp.Delivery, 64),
+	}
+	go func() {
+		defer close(consumer.ch)
+		for d := range consumer.pending {
+			consumer.ch <- d
+		}
+	}()
+	q.consumers = append(q.consumers, consumer)
+	q.dispatch()
+	return consumer.ch, nil
+}
+
+// Close implements Channel.
+func (c *memoryChannel) Close() error {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	return c.close()
+}
+
+// close closes the channel and requeues the unacknowledged messages. The caller must hold the server lock.
+func (c *memoryChannel) close() error {
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	c.closed = true
+	for _, q := range c.server.queues {
+		for i, consumer := range q.consumers {
+			if consumer.channel == c {
+				q.consumers = append(q.consumers[:i], q.consumers[i+1:]...)
+				close(consumer.pending)
+				break
+			}
+		}
+	}
+	for tag, u := range c.unacked {
+		u.queue.messages = append(u.queue.messages, u.msg)
+		delete(c.unacked, tag)
+	}
+	return nil
+}
+
+// Ack implements amqp.Acknowledger.
+func (c *memoryChannel) Ack(tag uint64, _ bool) error {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	delete(c.unacked, tag)
+	return nil
+}
+
+// Nack implements amqp.Acknowledger.
+func (c *memoryChannel) Nack(tag uint64, _ bool, requeue bool) error {
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	u, ok := c.unacked[tag]
+	if !ok {
+		return nil
+	}
+	delete(c.unacked, tag)
+	if requeue {
+		u.queue.messages = append(u.queue.messages, u.msg)
+		u.queue.dispatch()
+	}
+	return nil
+}
+
+// Reject implements amqp.Acknowledger.
+func (c *memoryChannel) Reject(tag uint64, requeue bool) error {
+	return c.Nack(tag, false, requeue)
+}
@@ -0,0 +1,400 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amqp implements the AMQP 0-9-1 provider using the amqp driver.
+package amqp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+// Channel is an AMQP channel.
+// *amqp.Channel implements Channel.
+type Channel interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	Close() error
+}
+
+// Conn is an AMQP connection.
+type Conn interface {
+	Channel() (Channel, error)
+	// NotifyClose registers a listener for the closure of the connection.
+	// The error is sent on the channel if the connection closes unexpectedly.
+	// The channel is closed when the connection closes.
+	NotifyClose(chan *amqp.Error) chan *amqp.Error
+	Close() error
+}
+
+type amqpConn struct {
+	*amqp.Connection
+}
+
+// Channel implements Conn.
+func (c amqpConn) Channel() (Channel, error) {
+	ch, err := c.Connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// Dial opens a connection to the AMQP server at the given URL.
+// The username and password in the URL are used for SASL PLAIN authentication.
+func Dial(serverURL string, tlsConfig *tls.Config) (Conn, error) {
+	conn, err := amqp.DialConfig(serverURL, amqp.Config{
+		TLSClientConfig: tlsConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return amqpConn{conn}, nil
+}
+
+type impl struct {
+}
+
+var errConnectFailed = errors.Define("connect_failed", "connection to AMQP server failed")
+
+// OpenConnection implements provider.Provider using the AMQP driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (pc *provider.Connection, err error) {
+	provider, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AMQP)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+
+	serverURL := provider.AMQP.ServerURL
+	var tlsConfig *tls.Config
+	if provider.AMQP.UseTLS {
+		if tlsConfig, err = tlsconfig.New(provider.AMQP.TLSCA, provider.AMQP.TLSClientCert, provider.AMQP.TLSClientKey); err != nil {
+			return nil, err
+		}
+		if serverURL, err = adaptURLScheme(serverURL); err != nil {
+			return nil, err
+		}
+	}
+	settings := Settings{
+		Dial: func() (Conn, error) {
+			return Dial(serverURL, tlsConfig)
+		},
+		Exchange: provider.AMQP.Exchange,
+	}
+	return OpenConnection(ctx, settings, target)
+}
+
+// DefaultReconnectIntervals are the default intervals between attempts to reconnect to the AMQP server.
+var DefaultReconnectIntervals = component.DialTaskBackoffIntervals[:]
+
+const reconnectJitter = component.DefaultTaskBackoffJitter
+
+// Settings configure the AMQP client.
+type Settings struct {
+	// Dial opens a connection to the AMQP server.
+	// Dial is called again to reconnect when the connection closes unexpectedly.
+	Dial func() (Conn, error)
+	// Exchange is the exchange to which messages are published, and to which the downlink queues are bound.
+	// If empty, the default exchange is used.
+	Exchange string
+	// ReconnectIntervals are the intervals between consecutive attempts to reconnect.
+	// The last interval is repeated until the connection succeeds.
+	// If empty, DefaultReconnectIntervals are used.
+	ReconnectIntervals []time.Duration
+}
+
+type connection struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	dial      func() (Conn, error)
+	intervals []time.Duration
+
+	topics        []*topic
+	subscriptions []*subscription
+
+	mu   sync.Mutex
+	conn Conn
+}
+
+// Shutdown implements provider.Shutdowner.
+// Closing the connection closes all its channels.
+func (c *connection) Shutdown(_ context.Context) error {
+	c.cancel()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.Close()
+}
+
+// setConn sets the current connection, unless the connection shut down.
+func (c *connection) setConn(conn Conn) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	c.conn = conn
+	return nil
+}
+
+// reopen opens the channels of the topics and subscriptions on the given connection.
+func (c *connection) reopen(conn Conn) error {
+	publishChannel, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	for _, t := range c.topics {
+		t.setChannel(publishChannel)
+	}
+	for _, s := range c.subscriptions {
+		ch, err := conn.Channel()
+		if err != nil {
+			return err
+		}
+		if err := s.consume(ch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconnect dials the AMQP server until the topics and subscriptions are opened on a new connection.
+// It returns the close notifications of the new connection.
+func (c *connection) reconnect() (chan *amqp.Error, error) {
+	logger := log.FromContext(c.ctx)
+	for attempt := 0; ; attempt++ {
+		interval := c.intervals[len(c.intervals)-1]
+		if attempt < len(c.intervals) {
+			interval = c.intervals[attempt]
+		}
+		select {
+		case <-c.ctx.Done():
+			return nil, c.ctx.Err()
+		case <-time.After(random.Jitter(interval, reconnectJitter)):
+		}
+		logger.Info("Reconnect to AMQP server")
+		conn, err := c.dial()
+		if err != nil {
+			logger.WithError(err).Warn("Failed to reconnect to AMQP server")
+			continue
+		}
+		closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		if err := c.reopen(conn); err != nil {
+			logger.WithError(err).Warn("Failed to open channels on AMQP server")
+			conn.Close()
+			continue
+		}
+		if err := c.setConn(conn); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		logger.Info("Connected to AMQP server")
+		return closed, nil
+	}
+}
+
+// run reconnects when the connection closes unexpectedly, until the connection shuts down.
+func (c *connection) run(closed chan *amqp.Error) {
+	logger := log.FromContext(c.ctx)
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case err, ok := <-closed:
+			if !ok {
+				// The connection was closed by Shutdown.
+				return
+			}
+			logger.WithError(err).Warn("Disconnected from AMQP server")
+		}
+		var err error
+		if closed, err = c.reconnect(); err != nil {
+			return
+		}
+	}
+}
+
+// OpenConnection dials the AMQP server and opens the AMQP channels of the topics and subscriptions.
+// When the connection closes unexpectedly, the AMQP server is dialed again with backoff and the channels are
+// reopened, so that the topics and subscriptions remain usable.
+// The connection is closed when the returned connection shuts down.
+func OpenConnection(ctx context.Context, settings Settings, topics provider.Topics) (pc *provider.Connection, err error) {
+	dialed, err := settings.Dial()
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	closed := dialed.NotifyClose(make(chan *amqp.Error, 1))
+	intervals := settings.ReconnectIntervals
+	if len(intervals) == 0 {
+		intervals = DefaultReconnectIntervals
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	conn := &connection{
+		ctx:       ctx,
+		cancel:    cancel,
+		dial:      settings.Dial,
+		intervals: intervals,
+		conn:      dialed,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	publishChannel, err := dialed.Channel()
+	if err != nil {
+		return nil, err
+	}
+	pc = &provider.Connection{
+		ProviderConnection: conn,
+	}
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueueInvalidated,
+			message: topics.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &pc.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		dt, err := openDriverTopic(
+			publishChannel,
+			settings.Exchange,
+			combineRoutingKeys(topics.GetBaseTopic(), t.message.GetTopic()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		conn.topics = append(conn.topics, dt)
+		*t.topic = pubsub.NewTopic(dt, nil)
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		// Each subscription consumes on its own channel, so that a slow consumer does not block the other.
+		ch, err := dialed.Channel()
+		if err != nil {
+			return nil, err
+		}
+		ds, err := openDriverSubscription(
+			ch,
+			settings.Exchange,
+			combineRoutingKeys(topics.GetBaseTopic(), s.message.GetTopic()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		conn.subscriptions = append(conn.subscriptions, ds)
+		*s.subscription = pubsub.NewSubscription(ds, nil, nil)
+	}
+	go conn.run(closed)
+	return pc, nil
+}
+
+func combineRoutingKeys(k1, k2 string) string {
+	k1 = strings.Trim(k1, ".")
+	k2 = strings.Trim(k2, ".")
+	if k1 == "" {
+		return k2
+	}
+	if k2 == "" {
+		return k1
+	}
+	return fmt.Sprintf("%s.%s", k1, k2)
+}
+
+func adaptURLScheme(initial string) (string, error) {
+	u, err := url.Parse(initial)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "amqp" {
+		u.Scheme = "amqps"
+	}
+	return u.String(), nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AMQP{}, impl{})
+}
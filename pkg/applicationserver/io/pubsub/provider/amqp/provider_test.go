@@ -0,0 +1,410 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+type allEnabled struct {
+}
+
+// Enabled implements provider.Enabler.
+func (e *allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_AMQP{
+			AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		DownlinkQueueInvalidated: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.invalidated",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+		ServiceData: &ttnpb.ApplicationPubSub_Message{
+			Topic: "service.data",
+		},
+	}
+
+	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_AMQP{},
+	})
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - no server provided.
+	{
+		conn, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	for _, exchange := range []string{"", "ttn"} {
+		name := "DefaultExchange"
+		if exchange != "" {
+			name = "Exchange"
+		}
+		t.Run(name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			server := newMemoryServer()
+			conn, err := OpenConnection(ctx, Settings{
+				Dial:     server.Dial,
+				Exchange: exchange,
+			}, pb)
+			a.So(conn, should.NotBeNil)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer conn.Shutdown(ctx)
+
+			publisher := server.channel()
+
+			t.Run("Downstream", func(t *testing.T) {
+				for _, tc := range []struct {
+					name          string
+					routingKey    string
+					subscription  *pubsub.Subscription
+					expectMessage bool
+				}{
+					{
+						name:          "ValidPush",
+						routingKey:    "app1.ps1.downlink.push",
+						subscription:  conn.Subscriptions.Push,
+						expectMessage: true,
+					},
+					{
+						name:          "ValidReplace",
+						routingKey:    "app1.ps1.downlink.replace",
+						subscription:  conn.Subscriptions.Replace,
+						expectMessage: true,
+					},
+					{
+						name:          "InvalidPush",
+						routingKey:    "foo.bar",
+						subscription:  conn.Subscriptions.Push,
+						expectMessage: false,
+					},
+					{
+						name:          "InvalidReplace",
+						routingKey:    "bar.foo",
+						subscription:  conn.Subscriptions.Replace,
+						expectMessage: false,
+					},
+				} {
+					t.Run(tc.name, func(t *testing.T) {
+						a := assertions.New(t)
+
+						err := publisher.Publish(exchange, tc.routingKey, false, false, amqp.Publishing{
+							Headers: amqp.Table{
+								"foo": "bar",
+							},
+							Body: []byte("foobar"),
+						})
+						if !a.So(err, should.BeNil) {
+							t.FailNow()
+						}
+
+						ctx, cancel := context.WithTimeout(ctx, timeout)
+						defer cancel()
+
+						msg, err := tc.subscription.Receive(ctx)
+						if tc.expectMessage {
+							a.So(err, should.BeNil)
+
+							if a.So(msg, should.NotBeNil) {
+								a.So(msg.Body, should.Resemble, []byte("foobar"))
+								a.So(msg.Metadata, should.Resemble, map[string]string{"foo": "bar"})
+							}
+						} else if err == nil {
+							t.Fatal("Unexpected message received")
+						}
+						if msg != nil {
+							msg.Ack()
+						}
+					})
+				}
+			})
+
+			t.Run("Upstream", func(t *testing.T) {
+				for _, tc := range []struct {
+					name       string
+					routingKey string
+					topic      *pubsub.Topic
+				}{
+					{
+						name:       "ValidUplink",
+						routingKey: "app1.ps1.uplink.message",
+						topic:      conn.Topics.UplinkMessage,
+					},
+					{
+						name:       "ValidJoinAccept",
+						routingKey: "app1.ps1.join.accept",
+						topic:      conn.Topics.JoinAccept,
+					},
+					{
+						name:       "ValidDownlinkAck",
+						routingKey: "app1.ps1.downlink.ack",
+						topic:      conn.Topics.DownlinkAck,
+					},
+					{
+						name:       "ValidDownlinkNack",
+						routingKey: "app1.ps1.downlink.nack",
+						topic:      conn.Topics.DownlinkNack,
+					},
+					{
+						name:       "ValidDownlinkSent",
+						routingKey: "app1.ps1.downlink.sent",
+						topic:      conn.Topics.DownlinkSent,
+					},
+					{
+						name:       "ValidDownlinkFailed",
+						routingKey: "app1.ps1.downlink.failed",
+						topic:      conn.Topics.DownlinkFailed,
+					},
+					{
+						name:       "ValidDownlinkQueued",
+						routingKey: "app1.ps1.downlink.queued",
+						topic:      conn.Topics.DownlinkQueued,
+					},
+					{
+						name:       "ValidDownlinkQueueInvalidated",
+						routingKey: "app1.ps1.downlink.invalidated",
+						topic:      conn.Topics.DownlinkQueueInvalidated,
+					},
+					{
+						name:       "ValidLocationSolved",
+						routingKey: "app1.ps1.location.solved",
+						topic:      conn.Topics.LocationSolved,
+					},
+					{
+						name:       "ValidServiceData",
+						routingKey: "app1.ps1.service.data",
+						topic:      conn.Topics.ServiceData,
+					},
+				} {
+					t.Run(tc.name, func(t *testing.T) {
+						a := assertions.New(t)
+
+						// The application declares and binds the queue on which it consumes the messages.
+						queue := "app." + tc.routingKey
+						if exchange == "" {
+							queue = tc.routingKey
+						}
+						_, err := publisher.QueueDeclare(queue, true, false, false, false, nil)
+						if !a.So(err, should.BeNil) {
+							t.FailNow()
+						}
+						if exchange != "" {
+							if !a.So(publisher.QueueBind(queue, tc.routingKey, exchange, false, nil), should.BeNil) {
+								t.FailNow()
+							}
+						}
+
+						ctx, cancel := context.WithTimeout(ctx, timeout)
+						defer cancel()
+
+						err = tc.topic.Send(ctx, &pubsub.Message{
+							Body: []byte("foobar"),
+							Metadata: map[string]string{
+								"foo": "bar",
+							},
+						})
+						if !a.So(err, should.BeNil) {
+							t.FailNow()
+						}
+
+						msgs := server.get(queue)
+						if !a.So(msgs, should.HaveLength, 1) {
+							t.FailNow()
+						}
+						a.So(msgs[0].Body, should.Resemble, []byte("foobar"))
+						a.So(msgs[0].Headers, should.Resemble, amqp.Table{"foo": "bar"})
+						a.So(msgs[0].DeliveryMode, should.Equal, amqp.Persistent)
+					})
+				}
+			})
+
+			t.Run("Redelivery", func(t *testing.T) {
+				a := assertions.New(t)
+
+				err := publisher.Publish(exchange, "app1.ps1.downlink.push", false, false, amqp.Publishing{
+					Body: []byte("bar"),
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				// Negatively acknowledged messages are delivered again.
+				msg, err := conn.Subscriptions.Push.Receive(ctx)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(msg.Body, should.Resemble, []byte("bar"))
+				msg.Nack()
+
+				msg, err = conn.Subscriptions.Push.Receive(ctx)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(msg.Body, should.Resemble, []byte("bar"))
+				msg.Ack()
+			})
+		})
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+	}
+
+	server := newMemoryServer()
+	conn, err := OpenConnection(ctx, Settings{
+		Dial:               server.Dial,
+		ReconnectIntervals: []time.Duration{test.Delay, 2 * test.Delay},
+	}, pb)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Shutdown(ctx)
+
+	// The connection is lost and the first two attempts to reconnect fail.
+	server.disconnect(2)
+
+	publisher := server.channel()
+	_, err = publisher.QueueDeclare("app1.ps1.uplink.message", true, false, false, false, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	t.Run("Downstream", func(t *testing.T) {
+		a := assertions.New(t)
+
+		err := publisher.Publish("", "app1.ps1.downlink.push", false, false, amqp.Publishing{
+			Body: []byte("foo"),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		// The subscription consumes the queue again after reconnecting.
+		msg, err := conn.Subscriptions.Push.Receive(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.Body, should.Resemble, []byte("foo"))
+		msg.Ack()
+	})
+
+	t.Run("Upstream", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		// The topic publishes on the reconnected channel.
+		err := conn.Topics.UplinkMessage.Send(ctx, &pubsub.Message{
+			Body: []byte("bar"),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msgs := server.get("app1.ps1.uplink.message")
+		if a.So(msgs, should.HaveLength, 1) {
+			a.So(msgs[0].Body, should.Resemble, []byte("bar"))
+		}
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// One dial to connect and three dials to reconnect.
+		a.So(server.dialCount(), should.Equal, 4)
+
+		a.So(conn.Shutdown(ctx), should.BeNil)
+
+		// The connection does not reconnect after it shuts down.
+		server.disconnect(0)
+		time.Sleep(10 * test.Delay)
+		a.So(server.dialCount(), should.Equal, 4)
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	producer Producer
+	topic    string
+}
+
+var errNilProducer = errors.DefineInvalidArgument("nil_producer", "producer is nil")
+
+// OpenTopic returns a *pubsub.Topic that publishes to the given Kafka topic with the given producer.
+func OpenTopic(producer Producer, topicName string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(producer, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(producer Producer, topicName string) (driver.Topic, error) {
+	if producer == nil {
+		return nil, errNilProducer.New()
+	}
+	return &topic{
+		producer: producer,
+		topic:    topicName,
+	}, nil
+}
+
+var errPublishFailed = errors.Define("publish_failed", "publish to Kafka topic failed")
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.producer == nil {
+		return errNilProducer.New()
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pm := encodeMessage(t.topic, msg)
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**sarama.ProducerMessage)
+				if !ok {
+					return false
+				}
+				*p = pm
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if _, _, err := t.producer.SendMessage(pm); err != nil {
+			return errPublishFailed.WithCause(err)
+		}
+	}
+	return nil
+}
+
+// encodeMessage encodes the message in a Kafka message. The metadata is encoded in the record headers.
+func encodeMessage(topic string, dm *driver.Message) *sarama.ProducerMessage {
+	pm := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(dm.Body),
+	}
+	if len(dm.Metadata) > 0 {
+		pm.Headers = make([]sarama.RecordHeader, 0, len(dm.Metadata))
+		for k, v := range dm.Metadata {
+			pm.Headers = append(pm.Headers, sarama.RecordHeader{
+				Key:   []byte(k),
+				Value: []byte(v),
+			})
+		}
+	}
+	return pm
+}
+
+func decodeMessage(msg *sarama.ConsumerMessage) *driver.Message {
+	dm := &driver.Message{
+		Body:  msg.Value,
+		AckID: -1,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(**sarama.ConsumerMessage)
+			if !ok {
+				return false
+			}
+			*p = msg
+			return true
+		},
+	}
+	if len(msg.Headers) > 0 {
+		dm.Metadata = make(map[string]string, len(msg.Headers))
+		for _, h := range msg.Headers {
+			if h == nil {
+				continue
+			}
+			dm.Metadata[string(h.Key)] = string(h.Value)
+		}
+	}
+	return dm
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	p, ok := i.(*Producer)
+	if !ok {
+		return false
+	}
+	*p = t.producer
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (*topic) Close() error { return nil }
+
+type subscription struct {
+	topic    string
+	messages <-chan *sarama.ConsumerMessage
+}
+
+// subscriptionQueueSize is the size of the subscription channel buffer.
+const subscriptionQueueSize = 16
+
+var errNilMessages = errors.DefineInvalidArgument("nil_messages", "messages channel is nil")
+
+// OpenSubscription returns a *pubsub.Subscription that receives the messages of the given Kafka topic from the
+// given channel. The messages are consumed from Kafka by the consumer group of the connection.
+func OpenSubscription(messages <-chan *sarama.ConsumerMessage, topicName string) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscription(messages, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+func openDriverSubscription(messages <-chan *sarama.ConsumerMessage, topicName string) (driver.Subscription, error) {
+	if messages == nil {
+		return nil, errNilMessages.New()
+	}
+	return &subscription{
+		topic:    topicName,
+		messages: messages,
+	}, nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+// We always return one message at a time, since the messages are forwarded one by one by the consumer group.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil || s.messages == nil {
+		return nil, errNilMessages.New()
+	}
+	if maxMessages <= 0 {
+		return nil, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg, ok := <-s.messages:
+		if !ok {
+			return nil, nil
+		}
+		return []*driver.Message{decodeMessage(msg)}, nil
+	}
+}
+
+// SendAcks implements driver.Subscription.
+// The messages are marked as consumed by the consumer group when they are received.
+func (*subscription) SendAcks(context.Context, []driver.AckID) error { return nil }
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (*subscription) As(i interface{}) bool { return false }
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (*subscription) Close() error { return nil }
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if errors.Resemble(err, errNilProducer) || errors.Resemble(err, errNilMessages) {
+		return gcerrors.NotFound
+	}
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case sarama.ErrClosedClient, sarama.ErrNotConnected, sarama.ErrOutOfBrokers:
+		return gcerrors.NotFound
+	case sarama.ErrMessageSizeTooLarge, sarama.ErrInvalidTopic:
+		return gcerrors.InvalidArgument
+	default:
+		return gcerrors.Unknown
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+// memoryCluster is an in-process stand-in for a Kafka cluster.
+// Each topic has a single partition, and consumer groups start consuming at the last marked offset.
+type memoryCluster struct {
+	mu      sync.Mutex
+	topics  map[string][]*sarama.ConsumerMessage
+	offsets map[string]map[string]int64
+	notify  chan struct{}
+}
+
+func newMemoryCluster() *memoryCluster {
+	return &memoryCluster{
+		topics:  make(map[string][]*sarama.ConsumerMessage),
+		offsets: make(map[string]map[string]int64),
+		notify:  make(chan struct{}),
+	}
+}
+
+// NewProducer implements Cluster.
+func (c *memoryCluster) NewProducer(*sarama.Config) (Producer, error) {
+	return &memoryProducer{cluster: c}, nil
+}
+
+// NewConsumerGroup implements Cluster.
+func (c *memoryCluster) NewConsumerGroup(groupID string, _ *sarama.Config) (ConsumerGroup, error) {
+	c.mu.Lock()
+	if _, ok := c.offsets[groupID]; !ok {
+		c.offsets[groupID] = make(map[string]int64)
+	}
+	c.mu.Unlock()
+	return &memoryConsumerGroup{
+		cluster: c,
+		groupID: groupID,
+	}, nil
+}
+
+func (c *memoryCluster) produce(msg *sarama.ProducerMessage) (int32, int64, error) {
+	var value []byte
+	if msg.Value != nil {
+		var err error
+		if value, err = msg.Value.Encode(); err != nil {
+			return 0, 0, err
+		}
+	}
+	headers := make([]*sarama.RecordHeader, len(msg.Headers))
+	for i := range msg.Headers {
+		headers[i] = &msg.Headers[i]
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	offset := int64(len(c.topics[msg.Topic]))
+	c.topics[msg.Topic] = append(c.topics[msg.Topic], &sarama.ConsumerMessage{
+		Headers: headers,
+		Value:   value,
+		Topic:   msg.Topic,
+		Offset:  offset,
+	})
+	close(c.notify)
+	c.notify = make(chan struct{})
+	return 0, offset, nil
+}
+
+// messages returns the messages in the given topic.
+func (c *memoryCluster) messages(topic string) []*sarama.ConsumerMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*sarama.ConsumerMessage(nil), c.topics[topic]...)
+}
+
+type memoryProducer struct {
+	cluster *memoryCluster
+}
+
+// SendMessage implements Producer.
+func (p *memoryProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	return p.cluster.produce(msg)
+}
+
+// Close implements Producer.
+func (*memoryProducer) Close() error { return nil }
+
+type memoryConsumerGroup struct {
+	cluster *memoryCluster
+	groupID string
+}
+
+// Consume implements ConsumerGroup.
+func (g *memoryConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	session := &memorySession{
+		ctx:   ctx,
+		group: g,
+	}
+	if err := handler.Setup(session); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	for _, topic := range topics {
+		claim := &memoryClaim{
+			topic:    topic,
+			messages: make(chan *sarama.ConsumerMessage),
+		}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			g.feed(ctx, claim)
+		}()
+		go func() {
+			defer wg.Done()
+			handler.ConsumeClaim(session, claim)
+		}()
+	}
+	wg.Wait()
+	return handler.Cleanup(session)
+}
+
+func (g *memoryConsumerGroup) feed(ctx context.Context, claim *memoryClaim) {
+	c := g.cluster
+	c.mu.Lock()
+	offset := c.offsets[g.groupID][claim.topic]
+	c.mu.Unlock()
+	for {
+		c.mu.Lock()
+		var msgs []*sarama.ConsumerMessage
+		if offset < int64(len(c.topics[claim.topic])) {
+			msgs = c.topics[claim.topic][offset:]
+		}
+		notify := c.notify
+		c.mu.Unlock()
+		for _, msg := range msgs {
+			select {
+			case <-ctx.Done():
+				return
+			case claim.messages <- msg:
+				offset++
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-notify:
+		}
+	}
+}
+
+// Close implements ConsumerGroup.
+func (*memoryConsumerGroup) Close() error { return nil }
+
+type memorySession struct {
+	sarama.ConsumerGroupSession
+	ctx   context.Context
+	group *memoryConsumerGroup
+}
+
+// Context implements sarama.ConsumerGroupSession.
+func (s *memorySession) Context() context.Context { return s.ctx }
+
+// MarkMessage implements sarama.ConsumerGroupSession.
+func (s *memorySession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	c := s.group.cluster
+	c.mu.Lock()
+	c.offsets[s.group.groupID][msg.Topic] = msg.Offset + 1
+	c.mu.Unlock()
+}
+
+type memoryClaim struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+}
+
+// Topic implements sarama.ConsumerGroupClaim.
+func (c *memoryClaim) Topic() string { return c.topic }
+
+// Partition implements sarama.ConsumerGroupClaim.
+func (*memoryClaim) Partition() int32 { return 0 }
+
+// InitialOffset implements sarama.ConsumerGroupClaim.
+func (*memoryClaim) InitialOffset() int64 { return 0 }
+
+// HighWaterMarkOffset implements sarama.ConsumerGroupClaim.
+func (*memoryClaim) HighWaterMarkOffset() int64 { return 0 }
+
+// Messages implements sarama.ConsumerGroupClaim.
+func (c *memoryClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
@@ -0,0 +1,383 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Kafka provider using the kafka driver.
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+// consumeRetryInterval is the interval between attempts to join the consumer group.
+var consumeRetryInterval = (1 << 2) * time.Second
+
+// Producer produces messages to Kafka topics.
+// sarama.SyncProducer implements Producer.
+type Producer interface {
+	SendMessage(msg *sarama.ProducerMessage) (partition int32, offset int64, err error)
+	Close() error
+}
+
+// ConsumerGroup consumes Kafka topics as a member of a consumer group.
+// sarama.ConsumerGroup implements ConsumerGroup.
+type ConsumerGroup interface {
+	Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error
+	Close() error
+}
+
+// Cluster creates producers and consumer groups for a Kafka cluster.
+type Cluster interface {
+	NewProducer(config *sarama.Config) (Producer, error)
+	NewConsumerGroup(groupID string, config *sarama.Config) (ConsumerGroup, error)
+}
+
+// Brokers is a Cluster that connects to the given bootstrap brokers.
+type Brokers []string
+
+// NewProducer implements Cluster.
+func (b Brokers) NewProducer(config *sarama.Config) (Producer, error) {
+	producer, err := sarama.NewSyncProducer(b, config)
+	if err != nil {
+		return nil, err
+	}
+	return producer, nil
+}
+
+// NewConsumerGroup implements Cluster.
+func (b Brokers) NewConsumerGroup(groupID string, config *sarama.Config) (ConsumerGroup, error) {
+	group, err := sarama.NewConsumerGroup(b, groupID, config)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+type impl struct {
+}
+
+var (
+	errConnectFailed        = errors.Define("connect_failed", "connection to Kafka cluster failed")
+	errInvalidSASLMechanism = errors.DefineInvalidArgument("invalid_sasl_mechanism", "invalid SASL mechanism `{mechanism}`")
+)
+
+// OpenConnection implements provider.Provider using the Kafka driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (pc *provider.Connection, err error) {
+	provider, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+
+	config := newConfig()
+	if provider.Kafka.UseTLS {
+		tlsConfig, err := tlsconfig.New(provider.Kafka.TLSCA, provider.Kafka.TLSClientCert, provider.Kafka.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if sasl := provider.Kafka.SASL; sasl != nil {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = sasl.Username
+		config.Net.SASL.Password = sasl.Password
+		switch sasl.Mechanism {
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{HashGeneratorFcn: scram.SHA256}
+			}
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{HashGeneratorFcn: sha512Generator}
+			}
+		default:
+			return nil, errInvalidSASLMechanism.WithAttributes("mechanism", sasl.Mechanism.String())
+		}
+	}
+	settings := Settings{
+		Cluster: Brokers(provider.Kafka.Brokers),
+		Config:  config,
+		GroupID: provider.Kafka.ConsumerGroupID,
+	}
+	return OpenConnection(ctx, settings, target)
+}
+
+func newConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	// Record headers, which carry the message metadata, require Kafka 0.11 or later.
+	config.Version = sarama.V1_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	return config
+}
+
+// Settings configure the Kafka client.
+type Settings struct {
+	Cluster Cluster
+	Config  *sarama.Config
+	// GroupID is the consumer group used for the downlink subscriptions.
+	// If empty, the group is derived from the base topic.
+	GroupID string
+}
+
+type connection struct {
+	producer Producer
+	consumer *consumer
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	var err error
+	if c.consumer != nil {
+		err = c.consumer.Close()
+	}
+	if pErr := c.producer.Close(); pErr != nil && err == nil {
+		err = pErr
+	}
+	return err
+}
+
+// OpenConnection opens a Kafka connection using the given settings.
+func OpenConnection(ctx context.Context, settings Settings, topics provider.Topics) (pc *provider.Connection, err error) {
+	config := settings.Config
+	if config == nil {
+		config = newConfig()
+	}
+	producer, err := settings.Cluster.NewProducer(config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	conn := &connection{
+		producer: producer,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	pc = &provider.Connection{
+		ProviderConnection: conn,
+	}
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueueInvalidated,
+			message: topics.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &pc.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = OpenTopic(
+			producer,
+			combineTopics(topics.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	subscriptions := make(map[string]chan *sarama.ConsumerMessage)
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		topic := combineTopics(topics.GetBaseTopic(), s.message.GetTopic())
+		ch, ok := subscriptions[topic]
+		if !ok {
+			ch = make(chan *sarama.ConsumerMessage, subscriptionQueueSize)
+			subscriptions[topic] = ch
+		}
+		if *s.subscription, err = OpenSubscription(ch, topic); err != nil {
+			return nil, err
+		}
+	}
+	if len(subscriptions) > 0 {
+		groupID := settings.GroupID
+		if groupID == "" {
+			groupID = combineTopics(topics.GetBaseTopic(), "ttn-lw-as")
+		}
+		group, err := settings.Cluster.NewConsumerGroup(groupID, config)
+		if err != nil {
+			return nil, errConnectFailed.WithCause(err)
+		}
+		conn.consumer = startConsumer(ctx, group, subscriptions)
+	}
+	return pc, nil
+}
+
+// consumer consumes the downlink topics as a member of a consumer group, and forwards the messages to the
+// subscriptions of their topic.
+type consumer struct {
+	group         ConsumerGroup
+	subscriptions map[string]chan *sarama.ConsumerMessage
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func startConsumer(ctx context.Context, group ConsumerGroup, subscriptions map[string]chan *sarama.ConsumerMessage) *consumer {
+	ctx, cancel := context.WithCancel(ctx)
+	c := &consumer{
+		group:         group,
+		subscriptions: subscriptions,
+		cancel:        cancel,
+	}
+	topics := make([]string, 0, len(subscriptions))
+	for topic := range subscriptions {
+		topics = append(topics, topic)
+	}
+	logger := log.FromContext(ctx).WithField("topics", topics)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for {
+			// Consume returns when the consumer group rebalances, so it is called in a loop.
+			if err := group.Consume(ctx, topics, c); err != nil && ctx.Err() == nil {
+				logger.WithError(err).Warn("Failed to consume Kafka topics")
+				select {
+				case <-ctx.Done():
+				case <-time.After(consumeRetryInterval):
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	return c
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (*consumer) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (*consumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+// Messages are marked as consumed when they are handed over to the subscription.
+func (c *consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ch, ok := c.subscriptions[claim.Topic()]
+	if !ok {
+		return nil
+	}
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			select {
+			case <-session.Context().Done():
+				return nil
+			case ch <- msg:
+			}
+			session.MarkMessage(msg, "")
+		}
+	}
+}
+
+// Close stops consuming and leaves the consumer group.
+func (c *consumer) Close() error {
+	c.cancel()
+	err := c.group.Close()
+	c.wg.Wait()
+	return err
+}
+
+func combineTopics(t1, t2 string) string {
+	t1 = strings.Trim(t1, ".")
+	t2 = strings.Trim(t2, ".")
+	if t1 == "" {
+		return t2
+	}
+	if t2 == "" {
+		return t1
+	}
+	return fmt.Sprintf("%s.%s", t1, t2)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
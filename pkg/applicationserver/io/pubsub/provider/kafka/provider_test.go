@@ -0,0 +1,320 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+type allEnabled struct {
+}
+
+// Enabled implements provider.Enabler.
+func (e *allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_Kafka{
+			Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		DownlinkQueueInvalidated: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.invalidated",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+		ServiceData: &ttnpb.ApplicationPubSub_Message{
+			Topic: "service.data",
+		},
+	}
+
+	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_Kafka{},
+	})
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - invalid CA provided.
+	{
+		pb := *pb
+		pb.Provider = &ttnpb.ApplicationPubSub_Kafka{
+			Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				UseTLS:  true,
+				TLSCA:   []byte("invalid"),
+			},
+		}
+		conn, err := impl.OpenConnection(ctx, &pb, &allEnabled{})
+		a.So(conn, should.BeNil)
+		a.So(err, should.HaveSameErrorDefinitionAs, tlsconfig.ErrInvalidCAPEMData)
+	}
+
+	cluster := newMemoryCluster()
+	conn, err := OpenConnection(ctx, Settings{
+		Cluster: cluster,
+	}, pb)
+	a.So(conn, should.NotBeNil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Shutdown(ctx)
+
+	t.Run("Downstream", func(t *testing.T) {
+		for _, tc := range []struct {
+			name          string
+			topicName     string
+			subscription  *pubsub.Subscription
+			expectMessage bool
+		}{
+			{
+				name:          "ValidPush",
+				topicName:     "app1.ps1.downlink.push",
+				subscription:  conn.Subscriptions.Push,
+				expectMessage: true,
+			},
+			{
+				name:          "ValidReplace",
+				topicName:     "app1.ps1.downlink.replace",
+				subscription:  conn.Subscriptions.Replace,
+				expectMessage: true,
+			},
+			{
+				name:          "InvalidPush",
+				topicName:     "foo.bar",
+				subscription:  conn.Subscriptions.Push,
+				expectMessage: false,
+			},
+			{
+				name:          "InvalidReplace",
+				topicName:     "bar.foo",
+				subscription:  conn.Subscriptions.Replace,
+				expectMessage: false,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				a := assertions.New(t)
+
+				_, _, err := cluster.produce(&sarama.ProducerMessage{
+					Topic: tc.topicName,
+					Value: sarama.StringEncoder("foobar"),
+					Headers: []sarama.RecordHeader{
+						{
+							Key:   []byte("foo"),
+							Value: []byte("bar"),
+						},
+					},
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				msg, err := tc.subscription.Receive(ctx)
+				if tc.expectMessage {
+					a.So(err, should.BeNil)
+
+					if a.So(msg, should.NotBeNil) {
+						a.So(msg.Body, should.Resemble, []byte("foobar"))
+						a.So(msg.Metadata, should.Resemble, map[string]string{"foo": "bar"})
+					}
+				} else if err == nil {
+					t.Fatal("Unexpected message received")
+				}
+				if msg != nil {
+					msg.Ack()
+				}
+			})
+		}
+	})
+
+	t.Run("Upstream", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			topicName string
+			topic     *pubsub.Topic
+		}{
+			{
+				name:      "ValidUplink",
+				topicName: "app1.ps1.uplink.message",
+				topic:     conn.Topics.UplinkMessage,
+			},
+			{
+				name:      "ValidJoinAccept",
+				topicName: "app1.ps1.join.accept",
+				topic:     conn.Topics.JoinAccept,
+			},
+			{
+				name:      "ValidDownlinkAck",
+				topicName: "app1.ps1.downlink.ack",
+				topic:     conn.Topics.DownlinkAck,
+			},
+			{
+				name:      "ValidDownlinkNack",
+				topicName: "app1.ps1.downlink.nack",
+				topic:     conn.Topics.DownlinkNack,
+			},
+			{
+				name:      "ValidDownlinkSent",
+				topicName: "app1.ps1.downlink.sent",
+				topic:     conn.Topics.DownlinkSent,
+			},
+			{
+				name:      "ValidDownlinkFailed",
+				topicName: "app1.ps1.downlink.failed",
+				topic:     conn.Topics.DownlinkFailed,
+			},
+			{
+				name:      "ValidDownlinkQueued",
+				topicName: "app1.ps1.downlink.queued",
+				topic:     conn.Topics.DownlinkQueued,
+			},
+			{
+				name:      "ValidDownlinkQueueInvalidated",
+				topicName: "app1.ps1.downlink.invalidated",
+				topic:     conn.Topics.DownlinkQueueInvalidated,
+			},
+			{
+				name:      "ValidLocationSolved",
+				topicName: "app1.ps1.location.solved",
+				topic:     conn.Topics.LocationSolved,
+			},
+			{
+				name:      "ValidServiceData",
+				topicName: "app1.ps1.service.data",
+				topic:     conn.Topics.ServiceData,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				a := assertions.New(t)
+
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				err := tc.topic.Send(ctx, &pubsub.Message{
+					Body: []byte("foobar"),
+					Metadata: map[string]string{
+						"foo": "bar",
+					},
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+
+				msgs := cluster.messages(tc.topicName)
+				if !a.So(msgs, should.HaveLength, 1) {
+					t.FailNow()
+				}
+				a.So(msgs[0].Value, should.Resemble, []byte("foobar"))
+				if a.So(msgs[0].Headers, should.HaveLength, 1) {
+					a.So(msgs[0].Headers[0].Key, should.Resemble, []byte("foo"))
+					a.So(msgs[0].Headers[0].Value, should.Resemble, []byte("bar"))
+				}
+			})
+		}
+	})
+
+	t.Run("ConsumerGroup", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// The consumed offsets are marked in the consumer group, so that a new connection continues from there.
+		a.So(conn.Shutdown(ctx), should.BeNil)
+		conn, err := OpenConnection(ctx, Settings{
+			Cluster: cluster,
+		}, pb)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		defer conn.Shutdown(ctx)
+
+		_, _, err = cluster.produce(&sarama.ProducerMessage{
+			Topic: "app1.ps1.downlink.push",
+			Value: sarama.StringEncoder("bar"),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		msg, err := conn.Subscriptions.Push.Receive(ctx)
+		if a.So(err, should.BeNil) {
+			a.So(msg.Body, should.Resemble, []byte("bar"))
+			msg.Ack()
+		}
+	})
+}
+
+func TestCombineTopics(t *testing.T) {
+	for _, tc := range []struct {
+		t1, t2, expected string
+	}{
+		{"app1.ps1", "uplink", "app1.ps1.uplink"},
+		{"app1.ps1.", ".uplink", "app1.ps1.uplink"},
+		{"", "uplink", "uplink"},
+		{"app1.ps1", "", "app1.ps1"},
+	} {
+		assertions.New(t).So(combineTopics(tc.t1, tc.t2), should.Equal, tc.expected)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	mqtt_topic "github.com/TheThingsIndustries/mystique/pkg/topic"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/tlsconfig"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -61,7 +62,7 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler
 	var tlsConfig *tls.Config
 	if provider.MQTT.UseTLS {
 		var err error
-		tlsConfig, err = createTLSConfig(provider.MQTT.TLSCA, provider.MQTT.TLSClientCert, provider.MQTT.TLSClientKey)
+		tlsConfig, err = tlsconfig.New(provider.MQTT.TLSCA, provider.MQTT.TLSClientCert, provider.MQTT.TLSClientKey)
 		if err != nil {
 			return nil, err
 		}
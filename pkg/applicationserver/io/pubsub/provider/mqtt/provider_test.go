@@ -25,6 +25,7 @@ import (
 	paho_mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/tlsconfig"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
@@ -54,9 +55,9 @@ func TestOpenConnection(t *testing.T) {
 	serverKey, err := ioutil.ReadFile("testdata/serverkey.pem")
 	a.So(err, should.BeNil)
 
-	clientTLSConfig, err := createTLSConfig(ca, clientCert, clientKey)
+	clientTLSConfig, err := tlsconfig.New(ca, clientCert, clientKey)
 	a.So(err, should.BeNil)
-	serverTLSConfig, err := createTLSConfig(ca, serverCert, serverKey)
+	serverTLSConfig, err := tlsconfig.New(ca, serverCert, serverKey)
 	a.So(err, should.BeNil)
 
 	lis, tlsLis, err := startMQTTServer(ctx, serverTLSConfig)
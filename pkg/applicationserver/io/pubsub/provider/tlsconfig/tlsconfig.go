@@ -0,0 +1,56 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tlsconfig creates the TLS configuration of pub/sub providers from PEM encoded data.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	// ErrInvalidCAPEMData is returned when the CA PEM data contains no valid certificates.
+	ErrInvalidCAPEMData = errors.DefineInvalidArgument("ca_pem_data", "CA PEM data is invalid")
+	// ErrInvalidClientCertificate is returned when the client certificate or key is invalid.
+	ErrInvalidClientCertificate = errors.DefineInvalidArgument("client_certificate", "client certificate or key is invalid")
+)
+
+// New returns the TLS configuration with the given CA and client certificate.
+// If no CA is provided, the system-wide CA pool is used.
+// The client certificate is optional, since the server may authenticate clients otherwise.
+func New(caPEM []byte, certPEM []byte, keyPEM []byte) (*tls.Config, error) {
+	// Change the CA certificate pool only if a CA has been provided.
+	// This allows the system-wide CA pool to be used.
+	var certPool *x509.CertPool
+	if len(caPEM) != 0 {
+		certPool = x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caPEM) {
+			return nil, ErrInvalidCAPEMData.New()
+		}
+	}
+	config := &tls.Config{
+		RootCAs: certPool,
+	}
+	if len(certPEM) != 0 || len(keyPEM) != 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, ErrInvalidClientCertificate.WithCause(err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
@@ -60,6 +60,10 @@ func providerTypeFromString(s string) (reflect.Type, error) {
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_MQTT{}), nil
 	case "nats":
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_NATS{}), nil
+	case "kafka":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Kafka{}), nil
+	case "amqp":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_AMQP{}), nil
 	default:
 		return nil, errInvalidProviderType.WithAttributes("type", s)
 	}
@@ -0,0 +1,233 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	signatureHeader = "X-Webhook-Signature"
+	timestampHeader = "X-Webhook-Timestamp"
+)
+
+var (
+	errInvalidCAPEMData      = errors.DefineInvalidArgument("invalid_ca_pem_data", "invalid CA PEM data")
+	errInvalidClientCertData = errors.DefineInvalidArgument("invalid_client_cert_data", "invalid client certificate or key PEM data")
+	errFetchOAuth2Token      = errors.DefineUnavailable("fetch_oauth2_token", "fetch OAuth 2.0 token")
+	errReadRequestBody       = errors.DefineInternal("read_request_body", "read request body")
+)
+
+// Signature returns the hex encoded HMAC-SHA256 signature of the timestamp and the body, separated by a dot.
+// Receivers of webhook requests can use this to verify the X-Webhook-Signature header.
+func Signature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(part)))
+		h.Write(l[:])
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func newTLSConfig(caPEM, certPEM, keyPEM []byte) (*tls.Config, error) {
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	// Change the CA certificate pool only if a CA has been provided.
+	// This allows the system-wide CA pool to be used.
+	if len(caPEM) > 0 {
+		conf.RootCAs = x509.NewCertPool()
+		if !conf.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errInvalidCAPEMData.New()
+		}
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, errInvalidClientCertData.WithCause(err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
+}
+
+const (
+	authCacheSize = 4096
+	authCacheTTL  = time.Hour
+)
+
+// webhookAuth contains the HTTP client and OAuth 2.0 token source of a webhook.
+type webhookAuth struct {
+	fingerprint string
+	client      *http.Client
+	tokenSource oauth2.TokenSource
+	transport   *http.Transport
+}
+
+// close closes the idle connections of the dedicated transport of the webhook, if any.
+func (a *webhookAuth) close() {
+	if a.transport != nil {
+		a.transport.CloseIdleConnections()
+	}
+}
+
+func authFingerprint(hook *ttnpb.ApplicationWebhook) string {
+	parts := [][]byte{hook.TLSCA, hook.TLSClientCert, hook.TLSClientKey}
+	if creds := hook.OAuth2ClientCredentials; creds != nil {
+		parts = append(parts, []byte(creds.TokenURL), []byte(creds.ClientID), []byte(creds.ClientSecret))
+		for _, scope := range creds.Scopes {
+			parts = append(parts, []byte(scope))
+		}
+	}
+	return fingerprint(parts...)
+}
+
+func (s *HTTPClientSink) newAuth(hook *ttnpb.ApplicationWebhook, fingerprint string) (*webhookAuth, error) {
+	auth := &webhookAuth{
+		fingerprint: fingerprint,
+		client:      s.Client,
+	}
+	// Webhooks with TLS settings use a dedicated client.
+	if len(hook.TLSCA) > 0 || len(hook.TLSClientCert) > 0 || len(hook.TLSClientKey) > 0 {
+		tlsConfig, err := newTLSConfig(hook.TLSCA, hook.TLSClientCert, hook.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		transport, ok := s.Transport.(*http.Transport)
+		if !ok {
+			transport = http.DefaultTransport.(*http.Transport)
+		}
+		auth.transport = transport.Clone()
+		auth.transport.TLSClientConfig = tlsConfig
+		auth.client = &http.Client{
+			Transport:     auth.transport,
+			CheckRedirect: s.CheckRedirect,
+			Jar:           s.Jar,
+			Timeout:       s.Timeout,
+		}
+	}
+	if creds := hook.OAuth2ClientCredentials; creds != nil {
+		config := clientcredentials.Config{
+			ClientID:     creds.ClientID,
+			ClientSecret: creds.ClientSecret,
+			TokenURL:     creds.TokenURL,
+			Scopes:       creds.Scopes,
+		}
+		// The token source refreshes the token as-needed, using the HTTP client of the webhook.
+		auth.tokenSource = config.TokenSource(context.WithValue(s.Context, oauth2.HTTPClient, auth.client))
+	}
+	return auth, nil
+}
+
+// auth returns the HTTP client and OAuth 2.0 token source of the webhook.
+// They are cached by webhook ID, so that connections and tokens are reused across requests. The cache is bounded
+// and entries expire, and the entry of a webhook is replaced when its TLS or OAuth 2.0 settings change.
+func (s *HTTPClientSink) auth(ctx context.Context, hook *ttnpb.ApplicationWebhook) (*webhookAuth, error) {
+	if len(hook.TLSCA) == 0 && len(hook.TLSClientCert) == 0 && len(hook.TLSClientKey) == 0 &&
+		hook.OAuth2ClientCredentials == nil {
+		return &webhookAuth{client: s.Client}, nil
+	}
+	s.authOnce.Do(func() {
+		s.authCache = gcache.New(authCacheSize).LRU().
+			Expiration(authCacheTTL).
+			EvictedFunc(func(_, value interface{}) {
+				value.(*webhookAuth).close()
+			}).
+			Build()
+	})
+	key := fmt.Sprintf("%s:%s", unique.ID(ctx, hook.ApplicationIdentifiers), hook.WebhookID)
+	fingerprint := authFingerprint(hook)
+	s.authMu.Lock()
+	defer s.authMu.Unlock()
+	var stale *webhookAuth
+	if value, err := s.authCache.Get(key); err == nil {
+		auth := value.(*webhookAuth)
+		if auth.fingerprint == fingerprint {
+			return auth, nil
+		}
+		stale = auth
+	}
+	auth, err := s.newAuth(hook, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCache.Set(key, auth); err != nil {
+		return nil, err
+	}
+	if stale != nil {
+		stale.close()
+	}
+	return auth, nil
+}
+
+// authenticate returns a copy of the request with the outbound authentication schemes of the webhook applied.
+// The original request is not modified, so that the headers are computed again when the request is retried.
+func (s *HTTPClientSink) authenticate(req *http.Request, auth *webhookAuth, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
+	if hook.Signing == nil && auth.tokenSource == nil {
+		return req, nil
+	}
+	req = req.Clone(req.Context())
+	if auth.tokenSource != nil {
+		token, err := auth.tokenSource.Token()
+		if err != nil {
+			return nil, errFetchOAuth2Token.WithCause(err)
+		}
+		token.SetAuthHeader(req)
+	}
+	if signing := hook.Signing; signing != nil {
+		var body []byte
+		if req.GetBody != nil {
+			rc, err := req.GetBody()
+			if err != nil {
+				return nil, errReadRequestBody.WithCause(err)
+			}
+			defer rc.Close()
+			if body, err = ioutil.ReadAll(rc); err != nil {
+				return nil, errReadRequestBody.WithCause(err)
+			}
+		}
+		timestamp := time.Now().Unix()
+		req.Header.Set(timestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(signatureHeader, Signature(signing.Secret, timestamp, body))
+	}
+	return req, nil
+}
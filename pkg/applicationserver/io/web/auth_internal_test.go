@@ -0,0 +1,193 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHTTPClientSinkAuthentication(t *testing.T) {
+	var tokenRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"token","token_type":"bearer","expires_in":3600}`)
+	})
+	reqCh := make(chan *http.Request, 1)
+	bodyCh := make(chan []byte, 1)
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		reqCh <- r
+		bodyCh <- body
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	hook := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			WebhookID:              "test-hook",
+		},
+		Signing: &ttnpb.ApplicationWebhook_Signing{
+			Secret: "0123456789abcdef",
+		},
+		OAuth2ClientCredentials: &ttnpb.ApplicationWebhook_OAuth2ClientCredentials{
+			TokenURL:     srv.URL + "/token",
+			ClientID:     "client",
+			ClientSecret: "secret",
+		},
+	}
+	sink := &HTTPClientSink{
+		Client:  srv.Client(),
+		Context: test.Context(),
+	}
+
+	for i := 0; i < 2; i++ {
+		a := assertions.New(t)
+		ctx := withDeliveryInfo(test.Context(), deliveryInfo{hook: hook})
+		body := []byte(`{"foo":"bar"}`)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/hook", bytes.NewReader(body))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if !a.So(sink.Process(req), should.BeNil) {
+			t.FailNow()
+		}
+		received, receivedBody := <-reqCh, <-bodyCh
+		a.So(receivedBody, should.Resemble, body)
+		a.So(received.Header.Get("Authorization"), should.Equal, "Bearer token")
+		timestamp, err := strconv.ParseInt(received.Header.Get(timestampHeader), 10, 64)
+		a.So(err, should.BeNil)
+		a.So(received.Header.Get(signatureHeader), should.Equal, Signature("0123456789abcdef", timestamp, body))
+
+		// The authentication headers are not added to the original request.
+		a.So(req.Header.Get("Authorization"), should.BeEmpty)
+		a.So(req.Header.Get(signatureHeader), should.BeEmpty)
+	}
+	// The token is cached.
+	assertions.New(t).So(tokenRequests, should.Equal, 1)
+
+	t.Run("InvalidClientCredentials", func(t *testing.T) {
+		a := assertions.New(t)
+		hook := &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: hook.ApplicationWebhookIdentifiers,
+			OAuth2ClientCredentials: &ttnpb.ApplicationWebhook_OAuth2ClientCredentials{
+				TokenURL:     srv.URL + "/token",
+				ClientID:     "client",
+				ClientSecret: "other",
+			},
+		}
+		ctx := withDeliveryInfo(context.Background(), deliveryInfo{hook: hook})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/hook", bytes.NewReader(nil))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		err = sink.Process(req)
+		a.So(err, should.HaveSameErrorDefinitionAs, errFetchOAuth2Token)
+	})
+
+	t.Run("InvalidCA", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := sink.auth(test.Context(), &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: hook.ApplicationWebhookIdentifiers,
+			TLSCA:                         []byte("not a certificate"),
+		})
+		a.So(err, should.HaveSameErrorDefinitionAs, errInvalidCAPEMData)
+	})
+}
+
+func TestHTTPClientSinkAuthCache(t *testing.T) {
+	a, ctx := test.New(t)
+
+	sink := &HTTPClientSink{
+		Client:  http.DefaultClient,
+		Context: ctx,
+	}
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	creds := &ttnpb.ApplicationWebhook_OAuth2ClientCredentials{
+		TokenURL:     "https://example.com/token",
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}
+	hook1 := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: appIDs,
+			WebhookID:              "test-hook-1",
+		},
+		OAuth2ClientCredentials: creds,
+	}
+	hook2 := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: appIDs,
+			WebhookID:              "test-hook-2",
+		},
+		OAuth2ClientCredentials: creds,
+	}
+
+	// Webhooks without TLS settings or OAuth 2.0 client credentials use the client of the sink and are not cached.
+	auth, err := sink.auth(ctx, &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: appIDs,
+			WebhookID:              "test-hook-0",
+		},
+	})
+	a.So(err, should.BeNil)
+	a.So(auth.client, should.Equal, http.DefaultClient)
+	a.So(auth.tokenSource, should.BeNil)
+	a.So(sink.authCache, should.BeNil)
+
+	auth1, err := sink.auth(ctx, hook1)
+	a.So(err, should.BeNil)
+	a.So(auth1.tokenSource, should.NotBeNil)
+
+	// The entry is reused for the same webhook.
+	auth, err = sink.auth(ctx, hook1)
+	a.So(err, should.BeNil)
+	a.So(auth, should.Equal, auth1)
+
+	// Entries are keyed by webhook ID.
+	auth2, err := sink.auth(ctx, hook2)
+	a.So(err, should.BeNil)
+	a.So(auth2, should.NotEqual, auth1)
+	a.So(sink.authCache.Len(false), should.Equal, 2)
+
+	// The entry is replaced when the settings of the webhook change.
+	hook1.OAuth2ClientCredentials = &ttnpb.ApplicationWebhook_OAuth2ClientCredentials{
+		TokenURL:     "https://example.com/token",
+		ClientID:     "client",
+		ClientSecret: "other",
+	}
+	auth, err = sink.auth(ctx, hook1)
+	a.So(err, should.BeNil)
+	a.So(auth, should.NotEqual, auth1)
+	a.So(sink.authCache.Len(false), should.Equal, 2)
+}
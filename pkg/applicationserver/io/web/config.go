@@ -18,6 +18,7 @@ import (
 	"context"
 	"net/url"
 	"os"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -77,3 +78,11 @@ type DownlinksConfig struct {
 	PublicAddress    string `name:"public-address" description:"Public address of the HTTP webhooks frontend"`
 	PublicTLSAddress string `name:"public-tls-address" description:"Public address of the HTTPS webhooks frontend"`
 }
+
+// RetryConfig defines the default retry policy of failed webhook deliveries.
+// The retry policy of a webhook takes precedence over the default retry policy.
+type RetryConfig struct {
+	MaxAttempts    uint32        `name:"max-attempts" description:"Maximum number of delivery attempts, including the first attempt"`
+	InitialBackoff time.Duration `name:"initial-backoff" description:"Backoff before the first retry of a failed delivery"`
+	MaxBackoff     time.Duration `name:"max-backoff" description:"Maximum backoff between delivery attempts"`
+}
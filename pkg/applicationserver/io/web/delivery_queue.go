@@ -0,0 +1,107 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+type memoryQueuedDelivery struct {
+	delivery *ttnpb.ApplicationWebhookDelivery
+	startAt  time.Time
+}
+
+// MemoryDeliveryQueue is a DeliveryQueue that keeps the deliveries in memory.
+// Queued deliveries are lost on restarts of the Application Server.
+type MemoryDeliveryQueue struct {
+	maxLen int64
+
+	mu     sync.Mutex
+	queued []memoryQueuedDelivery // Sorted by start time.
+	added  chan struct{}          // Closed when a delivery is added.
+}
+
+// NewMemoryDeliveryQueue returns a new MemoryDeliveryQueue that holds at most maxLen deliveries.
+// When the queue is full, the deliveries with the earliest start time are dropped.
+// If maxLen is not positive, the queue is unbounded.
+func NewMemoryDeliveryQueue(maxLen int64) *MemoryDeliveryQueue {
+	return &MemoryDeliveryQueue{
+		maxLen: maxLen,
+		added:  make(chan struct{}),
+	}
+}
+
+// Add implements DeliveryQueue.
+func (q *MemoryDeliveryQueue) Add(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	i := sort.Search(len(q.queued), func(i int) bool {
+		return q.queued[i].startAt.After(startAt)
+	})
+	q.queued = append(q.queued, memoryQueuedDelivery{})
+	copy(q.queued[i+1:], q.queued[i:])
+	q.queued[i] = memoryQueuedDelivery{
+		delivery: delivery,
+		startAt:  startAt,
+	}
+	if n := int64(len(q.queued)); q.maxLen > 0 && n > q.maxLen {
+		q.queued = q.queued[n-q.maxLen:]
+	}
+	close(q.added)
+	q.added = make(chan struct{})
+	return nil
+}
+
+// Pop implements DeliveryQueue.
+func (q *MemoryDeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error {
+	for {
+		q.mu.Lock()
+		var wait time.Duration
+		if len(q.queued) > 0 {
+			wait = time.Until(q.queued[0].startAt)
+			if wait <= 0 {
+				delivery := q.queued[0].delivery
+				q.queued = q.queued[1:]
+				q.mu.Unlock()
+				return f(ctx, delivery)
+			}
+		}
+		added := q.added
+		q.mu.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-added:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ DeliveryQueue = &MemoryDeliveryQueue{}
+
+func TestMemoryDeliveryQueue(t *testing.T) {
+	a, ctx := test.New(t)
+
+	timeout := 10 * test.Delay
+	q := NewMemoryDeliveryQueue(2)
+
+	newDelivery := func(id string) *ttnpb.ApplicationWebhookDelivery {
+		return &ttnpb.ApplicationWebhookDelivery{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			ID:           id,
+			EndDeviceIDs: registeredDeviceID,
+			URL:          "https://example.com/up",
+			Body:         []byte("payload"),
+		}
+	}
+	first, second, third := newDelivery("first"), newDelivery("second"), newDelivery("third")
+
+	pop := func(ctx context.Context) (*ttnpb.ApplicationWebhookDelivery, error) {
+		var popped *ttnpb.ApplicationWebhookDelivery
+		err := q.Pop(ctx, func(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+			popped = delivery
+			return nil
+		})
+		return popped, err
+	}
+
+	// The second delivery is added first, but it is scheduled after the first delivery.
+	secondAt := time.Now().Add(timeout)
+	a.So(q.Add(ctx, second, secondAt), should.BeNil)
+	a.So(q.Add(ctx, first, time.Now()), should.BeNil)
+
+	popCtx, cancel := context.WithTimeout(ctx, timeout/2)
+	popped, err := pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, first)
+
+	// The second delivery is not available until it is scheduled.
+	popCtx, cancel = context.WithTimeout(ctx, 2*timeout)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, second)
+	a.So(time.Now(), should.HappenOnOrAfter, secondAt)
+
+	// Pop blocks until the context is done if the queue is empty.
+	popCtx, cancel = context.WithTimeout(ctx, timeout/2)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.NotBeNil)
+	a.So(popped, should.BeNil)
+
+	// Pop returns deliveries that are added while it blocks.
+	go func() {
+		time.Sleep(timeout / 2)
+		q.Add(ctx, first, time.Now())
+	}()
+	popCtx, cancel = context.WithTimeout(ctx, 2*timeout)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, first)
+
+	// The deliveries with the earliest start time are dropped when the queue is full.
+	now := time.Now()
+	a.So(q.Add(ctx, first, now), should.BeNil)
+	a.So(q.Add(ctx, second, now.Add(time.Millisecond)), should.BeNil)
+	a.So(q.Add(ctx, third, now.Add(2*time.Millisecond)), should.BeNil)
+
+	popCtx, cancel = context.WithTimeout(ctx, timeout)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, second)
+
+	popCtx, cancel = context.WithTimeout(ctx, timeout)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, third)
+}
@@ -20,6 +20,7 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -37,16 +38,46 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 	), paths...)
 }
 
+// webhookSecretPaths are the paths of the secrets of the webhook.
+var webhookSecretPaths = []string{
+	"oauth2_client_credentials.client_secret",
+	"signing.secret",
+	"tls_client_key",
+}
+
+// redactWebhookSecrets clears the secrets of the webhook.
+func redactWebhookSecrets(hook *ttnpb.ApplicationWebhook) {
+	if hook.Signing != nil {
+		hook.Signing.Secret = ""
+	}
+	if hook.OAuth2ClientCredentials != nil {
+		hook.OAuth2ClientCredentials.ClientSecret = ""
+	}
+	hook.TLSClientKey = nil
+}
+
+// mayReadWebhookSecrets returns whether the caller may read the secrets of the webhooks of the application.
+// The secrets are only readable by callers that are allowed to change the webhooks.
+func mayReadWebhookSecrets(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) bool {
+	if !ttnpb.HasAnyField(paths, webhookSecretPaths...) {
+		return true
+	}
+	return rights.RequireApplication(ctx, ids, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC) == nil
+}
+
 type webhookRegistryRPC struct {
-	webhooks  WebhookRegistry
-	templates TemplateStore
+	webhooks         WebhookRegistry
+	templates        TemplateStore
+	failedDeliveries FailedDeliveries
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
+// The failed deliveries may be nil if failed deliveries are not retained.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore, failedDeliveries FailedDeliveries) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+		webhooks:         webhooks,
+		templates:        templates,
+		failedDeliveries: failedDeliveries,
 	}
 }
 
@@ -72,7 +103,14 @@ func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWe
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	hook, err := s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	if err != nil {
+		return nil, err
+	}
+	if !mayReadWebhookSecrets(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths) {
+		redactWebhookSecrets(hook)
+	}
+	return hook, nil
 }
 
 func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationWebhooksRequest) (*ttnpb.ApplicationWebhooks, error) {
@@ -83,6 +121,11 @@ func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplication
 	if err != nil {
 		return nil, err
 	}
+	if !mayReadWebhookSecrets(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths) {
+		for _, hook := range webhooks {
+			redactWebhookSecrets(hook)
+		}
+	}
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, uint64(len(webhooks)))
@@ -130,5 +173,63 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	if s.failedDeliveries != nil {
+		if err := s.failedDeliveries.Purge(ctx, *req); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to purge failed deliveries")
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s webhookRegistryRPC) ListFailedDeliveries(ctx context.Context, req *ttnpb.ApplicationWebhookFailedDeliveriesRequest) (*ttnpb.ApplicationWebhookDeliveries, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.failedDeliveries == nil {
+		return nil, errNoDeadLetterStore.New()
+	}
+	deliveries, err := s.failedDeliveries.List(ctx, req.ApplicationWebhookIdentifiers, req.DeliveryIDs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, uint64(len(deliveries)))
+		}
+	}()
+	return &ttnpb.ApplicationWebhookDeliveries{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayFailedDeliveries(ctx context.Context, req *ttnpb.ApplicationWebhookFailedDeliveriesRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if s.failedDeliveries == nil {
+		return nil, errNoDeadLetterStore.New()
+	}
+	if err := s.failedDeliveries.Replay(ctx, req.ApplicationWebhookIdentifiers, req.DeliveryIDs...); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s webhookRegistryRPC) PurgeFailedDeliveries(ctx context.Context, req *ttnpb.ApplicationWebhookFailedDeliveriesRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if s.failedDeliveries == nil {
+		return nil, errNoDeadLetterStore.New()
+	}
+	if err := s.failedDeliveries.Purge(ctx, req.ApplicationWebhookIdentifiers, req.DeliveryIDs...); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
@@ -27,6 +27,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -55,7 +57,7 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	defer flush()
 	defer redisClient.Close()
 	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, nil)
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -158,6 +160,145 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	}
 }
 
+func TestWebhookRegistryRPCSecrets(t *testing.T) {
+	a, ctx := test.New(t)
+
+	const trafficReaderKey = "traffic-reader"
+
+	is, isAddr := startMockIS(ctx)
+	is.add(ctx, registeredApplicationID, registeredApplicationKey)
+	is.addKey(ctx, registeredApplicationID, trafficReaderKey, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	redisClient, flush := test.NewRedis(ctx, "applicationserver_test")
+	defer flush()
+	defer redisClient.Close()
+	webhookReg := &redis.WebhookRegistry{
+		Redis: redisClient,
+		KeyVault: cryptoutil.NewMemKeyVault(map[string][]byte{
+			"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+		}),
+		EncryptionKeyID: "test",
+	}
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, nil)
+	c.RegisterGRPC(&mockRegisterer{ctx, srv})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	client := ttnpb.NewApplicationWebhookRegistryClient(c.LoopbackConn())
+	creds := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     registeredApplicationKey,
+		AllowInsecure: true,
+	})
+	trafficReaderCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     trafficReaderKey,
+		AllowInsecure: true,
+	})
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	paths := []string{
+		"base_url",
+		"oauth2_client_credentials",
+		"signing",
+		"tls_client_key",
+	}
+
+	_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+		ApplicationWebhook: ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+			BaseURL:                       "http://localhost/test",
+			Signing: &ttnpb.ApplicationWebhook_Signing{
+				Secret: "0123456789abcdef",
+			},
+			OAuth2ClientCredentials: &ttnpb.ApplicationWebhook_OAuth2ClientCredentials{
+				TokenURL:     "http://localhost/token",
+				ClientID:     "client",
+				ClientSecret: "client-secret",
+			},
+			TLSClientKey: []byte("client-key"),
+		},
+		FieldMask: pbtypes.FieldMask{
+			Paths: paths,
+		},
+	}, creds)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The secrets are encrypted at rest.
+	{
+		stored := &ttnpb.ApplicationWebhook{}
+		err := ttnredis.GetProto(ctx, redisClient, redisClient.Key("uid", registeredApplicationUID, registeredWebhookID)).ScanProto(stored)
+		a.So(err, should.BeNil)
+		a.So(stored.SecretsKeyID, should.Equal, "test")
+		a.So(stored.Signing.Secret, should.NotEqual, "0123456789abcdef")
+		a.So(stored.OAuth2ClientCredentials.ClientSecret, should.NotEqual, "client-secret")
+		a.So(stored.TLSClientKey, should.NotResemble, []byte("client-key"))
+	}
+
+	// The secrets are readable with the rights to change the webhook.
+	{
+		res, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: ids,
+			FieldMask: pbtypes.FieldMask{
+				Paths: paths,
+			},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(res.SecretsKeyID, should.BeEmpty)
+			a.So(res.Signing.Secret, should.Equal, "0123456789abcdef")
+			a.So(res.OAuth2ClientCredentials.ClientSecret, should.Equal, "client-secret")
+			a.So(res.TLSClientKey, should.Resemble, []byte("client-key"))
+		}
+	}
+
+	// The secrets are redacted for traffic readers.
+	{
+		res, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: ids,
+			FieldMask: pbtypes.FieldMask{
+				Paths: paths,
+			},
+		}, trafficReaderCreds)
+		if a.So(err, should.BeNil) {
+			a.So(res.BaseURL, should.Equal, "http://localhost/test")
+			a.So(res.Signing.Secret, should.BeEmpty)
+			a.So(res.OAuth2ClientCredentials.ClientID, should.Equal, "client")
+			a.So(res.OAuth2ClientCredentials.ClientSecret, should.BeEmpty)
+			a.So(res.TLSClientKey, should.BeEmpty)
+		}
+
+		list, err := client.List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+			ApplicationIdentifiers: registeredApplicationID,
+			FieldMask: pbtypes.FieldMask{
+				Paths: paths,
+			},
+		}, trafficReaderCreds)
+		if a.So(err, should.BeNil) && a.So(list.Webhooks, should.HaveLength, 1) {
+			a.So(list.Webhooks[0].Signing.Secret, should.BeEmpty)
+			a.So(list.Webhooks[0].OAuth2ClientCredentials.ClientSecret, should.BeEmpty)
+			a.So(list.Webhooks[0].TLSClientKey, should.BeEmpty)
+		}
+	}
+}
+
 func TestTemplateStoreRPC(t *testing.T) {
 	ctx := test.Context()
 
@@ -234,7 +375,7 @@ description: Bar`),
 			a.So(err, should.BeNil)
 
 			c := componenttest.NewComponent(t, &component.Config{})
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, nil)})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
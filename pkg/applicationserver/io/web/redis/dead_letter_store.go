@@ -0,0 +1,156 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DeadLetterStore is a Redis store of webhook deliveries that exhausted their retry attempts.
+// The deliveries of a webhook are stored in a hash, indexed by a sorted set ordered by creation time.
+type DeadLetterStore struct {
+	Redis *ttnredis.Client
+	// Limit is the maximum number of deliveries retained per webhook. The oldest deliveries are removed first.
+	// If zero, the number of deliveries is not limited.
+	Limit int64
+	// TTL is the time the deliveries of a webhook are retained after the last delivery has been added.
+	// If zero, the deliveries do not expire.
+	TTL time.Duration
+}
+
+func (s *DeadLetterStore) deliveriesKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return s.Redis.Key("uid", unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+}
+
+func (s *DeadLetterStore) indexKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return ttnredis.Key(s.deliveriesKey(ctx, ids), "index")
+}
+
+// Add implements web.DeadLetterStore.
+func (s *DeadLetterStore) Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	v, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	dk, ik := s.deliveriesKey(ctx, delivery.ApplicationWebhookIdentifiers), s.indexKey(ctx, delivery.ApplicationWebhookIdentifiers)
+	_, err = s.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, dk, delivery.ID, v)
+		p.ZAdd(ctx, ik, &redis.Z{
+			Score:  float64(delivery.CreatedAt.UnixNano()),
+			Member: delivery.ID,
+		})
+		if s.TTL > 0 {
+			p.PExpire(ctx, dk, s.TTL)
+			p.PExpire(ctx, ik, s.TTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if s.Limit <= 0 {
+		return nil
+	}
+	expired, err := s.Redis.ZRange(ctx, ik, 0, -s.Limit-1).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	members := make([]interface{}, 0, len(expired))
+	for _, id := range expired {
+		members = append(members, id)
+	}
+	_, err = s.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HDel(ctx, dk, expired...)
+		p.ZRem(ctx, ik, members...)
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+func (s *DeadLetterStore) get(ctx context.Context, r redis.Cmdable, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	if len(deliveryIDs) == 0 {
+		var err error
+		deliveryIDs, err = r.ZRange(ctx, s.indexKey(ctx, ids), 0, -1).Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		if len(deliveryIDs) == 0 {
+			return nil, nil
+		}
+	}
+	vs, err := r.HMGet(ctx, s.deliveriesKey(ctx, ids), deliveryIDs...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	deliveries := make([]*ttnpb.ApplicationWebhookDelivery, 0, len(vs))
+	for _, v := range vs {
+		str, ok := v.(string)
+		if !ok {
+			continue
+		}
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(str, delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// List implements web.DeadLetterStore.
+func (s *DeadLetterStore) List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	return s.get(ctx, s.Redis, ids, deliveryIDs...)
+}
+
+// Remove implements web.DeadLetterStore.
+func (s *DeadLetterStore) Remove(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	dk, ik := s.deliveriesKey(ctx, ids), s.indexKey(ctx, ids)
+	var deliveries []*ttnpb.ApplicationWebhookDelivery
+	err := s.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		var err error
+		deliveries, err = s.get(ctx, tx, ids, deliveryIDs...)
+		if err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		removed := make([]string, 0, len(deliveries))
+		members := make([]interface{}, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			removed = append(removed, delivery.ID)
+			members = append(members, delivery.ID)
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.HDel(ctx, dk, removed...)
+			p.ZRem(ctx, ik, members...)
+			return nil
+		})
+		return err
+	}, dk, ik)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return deliveries, nil
+}
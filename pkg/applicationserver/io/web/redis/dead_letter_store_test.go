@@ -0,0 +1,153 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ web.DeadLetterStore = &DeadLetterStore{}
+
+func TestDeadLetterStore(t *testing.T) {
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	otherIDs := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "bar-hook",
+	}
+	now := time.Now().UTC()
+	newDelivery := func(ids ttnpb.ApplicationWebhookIdentifiers, i int) *ttnpb.ApplicationWebhookDelivery {
+		createdAt := now.Add(time.Duration(i) * time.Second)
+		return &ttnpb.ApplicationWebhookDelivery{
+			ApplicationWebhookIdentifiers: ids,
+			ID:                            fmt.Sprintf("delivery-%d", i),
+			CreatedAt:                     createdAt,
+			UpdatedAt:                     createdAt,
+			URL:                           "https://example.com/up",
+			Body:                          []byte("payload"),
+			Attempts:                      5,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name  string
+		Limit int64
+		TTL   time.Duration
+	}{
+		{
+			Name: "NoLimit",
+		},
+		{
+			Name:  "Limit",
+			Limit: 2,
+		},
+		{
+			Name: "TTL",
+			TTL:  time.Hour,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a, ctx := test.New(t)
+
+			cl, flush := test.NewRedis(ctx, "redis_test", tc.Name)
+			defer flush()
+			defer cl.Close()
+
+			s := &DeadLetterStore{
+				Redis: cl,
+				Limit: tc.Limit,
+				TTL:   tc.TTL,
+			}
+
+			deliveries := []*ttnpb.ApplicationWebhookDelivery{
+				newDelivery(ids, 0),
+				newDelivery(ids, 1),
+				newDelivery(ids, 2),
+			}
+			// Add the deliveries in reverse order; the deliveries are ordered by creation time.
+			for i := len(deliveries) - 1; i >= 0; i-- {
+				a.So(s.Add(ctx, deliveries[i]), should.BeNil)
+			}
+			other := newDelivery(otherIDs, 0)
+			a.So(s.Add(ctx, other), should.BeNil)
+
+			expected := deliveries
+			if tc.Limit > 0 {
+				// The oldest deliveries are removed first.
+				expected = deliveries[len(deliveries)-int(tc.Limit):]
+			}
+			list, err := s.List(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(list, should.Resemble, expected)
+
+			list, err = s.List(ctx, otherIDs)
+			a.So(err, should.BeNil)
+			a.So(list, should.Resemble, []*ttnpb.ApplicationWebhookDelivery{other})
+
+			list, err = s.List(ctx, ids, expected[0].ID, "unknown")
+			a.So(err, should.BeNil)
+			a.So(list, should.Resemble, expected[:1])
+
+			ttl, err := cl.PTTL(ctx, s.deliveriesKey(ctx, ids)).Result()
+			a.So(err, should.BeNil)
+			indexTTL, err := cl.PTTL(ctx, s.indexKey(ctx, ids)).Result()
+			a.So(err, should.BeNil)
+			if tc.TTL > 0 {
+				a.So(ttl, should.BeBetweenOrEqual, time.Duration(0), tc.TTL)
+				a.So(ttl, should.BeGreaterThan, 0)
+				a.So(indexTTL, should.BeBetweenOrEqual, time.Duration(0), tc.TTL)
+				a.So(indexTTL, should.BeGreaterThan, 0)
+			} else {
+				// Keys without expiry have a negative TTL.
+				a.So(ttl, should.BeLessThan, 0)
+				a.So(indexTTL, should.BeLessThan, 0)
+			}
+
+			// Remove the oldest delivery by ID.
+			removed, err := s.Remove(ctx, ids, expected[0].ID)
+			a.So(err, should.BeNil)
+			a.So(removed, should.Resemble, expected[:1])
+			list, err = s.List(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(list, should.Resemble, expected[1:])
+
+			// Remove all remaining deliveries of the webhook.
+			removed, err = s.Remove(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(removed, should.Resemble, expected[1:])
+			list, err = s.List(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(list, should.BeEmpty)
+
+			removed, err = s.Remove(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(removed, should.BeEmpty)
+
+			// The deliveries of other webhooks are retained.
+			list, err = s.List(ctx, otherIDs)
+			a.So(err, should.BeNil)
+			a.So(list, should.Resemble, []*ttnpb.ApplicationWebhookDelivery{other})
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// DeliveryQueue is an implementation of web.DeliveryQueue.
+type DeliveryQueue struct {
+	queue *ttnredis.TaskQueue
+}
+
+const (
+	deliveryKey = "delivery"
+)
+
+// NewDeliveryQueue returns a new delivery queue.
+func NewDeliveryQueue(cl *ttnredis.Client, maxLen int64, group, id string) *DeliveryQueue {
+	return &DeliveryQueue{
+		queue: &ttnredis.TaskQueue{
+			Redis:  cl,
+			MaxLen: maxLen,
+			Group:  group,
+			ID:     id,
+			Key:    cl.Key(deliveryKey),
+		},
+	}
+}
+
+// Init initializes the DeliveryQueue.
+func (q *DeliveryQueue) Init(ctx context.Context) error {
+	return q.queue.Init(ctx)
+}
+
+// Close closes the DeliveryQueue.
+func (q *DeliveryQueue) Close(ctx context.Context) error {
+	return q.queue.Close(ctx)
+}
+
+// Add adds the delivery to the queue, to be retried at startAt.
+func (q *DeliveryQueue) Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	return q.queue.Add(ctx, nil, s, startAt, false)
+}
+
+// Pop calls f on the earliest delivery in the queue, for which timestamp is in range [0, time.Now()],
+// if such is available, otherwise it blocks until it is.
+func (q *DeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error {
+	return q.queue.Pop(ctx, nil, func(_ redis.Pipeliner, s string, _ time.Time) error {
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+			return err
+		}
+		return f(ctx, delivery)
+	})
+}
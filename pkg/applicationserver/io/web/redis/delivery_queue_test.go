@@ -0,0 +1,104 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ web.DeliveryQueue = &DeliveryQueue{}
+
+var Timeout = 10 * test.Delay
+
+func TestDeliveryQueue(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	q := NewDeliveryQueue(cl, 100, "test", "test-consumer")
+	if err := q.Init(ctx); err != nil {
+		t.Fatalf("Failed to initialize delivery queue: %s", err)
+	}
+	defer q.Close(ctx)
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	now := time.Now().UTC()
+	newDelivery := func(id string) *ttnpb.ApplicationWebhookDelivery {
+		return &ttnpb.ApplicationWebhookDelivery{
+			ApplicationWebhookIdentifiers: ids,
+			ID:                            id,
+			EndDeviceIDs: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids.ApplicationIdentifiers,
+				DeviceID:               "foo-device",
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+			URL:       "https://example.com/up",
+			Headers: map[string]string{
+				"Content-Type": "application/json",
+			},
+			Body:     []byte("payload"),
+			Attempts: 1,
+		}
+	}
+	first, second := newDelivery("first"), newDelivery("second")
+
+	pop := func(ctx context.Context) (*ttnpb.ApplicationWebhookDelivery, error) {
+		var popped *ttnpb.ApplicationWebhookDelivery
+		err := q.Pop(ctx, func(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+			popped = delivery
+			return nil
+		})
+		return popped, err
+	}
+
+	// The second delivery is added first, but it is scheduled after the first delivery.
+	secondAt := time.Now().Add(Timeout)
+	a.So(q.Add(ctx, second, secondAt), should.BeNil)
+	a.So(q.Add(ctx, first, time.Now()), should.BeNil)
+
+	popCtx, cancel := context.WithTimeout(ctx, Timeout/2)
+	popped, err := pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, first)
+
+	// The second delivery is not available until it is scheduled.
+	popCtx, cancel = context.WithTimeout(ctx, 2*Timeout)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.BeNil)
+	a.So(popped, should.Resemble, second)
+	a.So(time.Now(), should.HappenOnOrAfter, secondAt)
+
+	// Pop blocks until the context is done if the queue is empty.
+	popCtx, cancel = context.WithTimeout(ctx, Timeout/2)
+	popped, err = pop(popCtx)
+	cancel()
+	a.So(err, should.NotBeNil)
+	a.So(popped, should.BeNil)
+}
@@ -16,11 +16,14 @@ package redis
 
 import (
 	"context"
+	"encoding/base64"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
@@ -49,8 +52,106 @@ func applyWebhookFieldMask(dst, src *ttnpb.ApplicationWebhook, paths ...string)
 }
 
 // WebhookRegistry is a Redis webhook registry.
+// The secrets of the webhooks are encrypted at rest if EncryptionKeyID is set.
 type WebhookRegistry struct {
-	Redis *ttnredis.Client
+	Redis           *ttnredis.Client
+	KeyVault        crypto.KeyVault
+	EncryptionKeyID string
+}
+
+// encryptSecrets returns a copy of pb with the secrets encrypted with the EncryptionKeyID.
+// If EncryptionKeyID is empty, the secrets are not encrypted.
+func (r WebhookRegistry) encryptSecrets(ctx context.Context, pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, error) {
+	enc := *pb
+	enc.SecretsKeyID = ""
+	if pb.Signing.GetSecret() == "" && pb.OAuth2ClientCredentials.GetClientSecret() == "" && len(pb.TLSClientKey) == 0 {
+		return &enc, nil
+	}
+	if r.EncryptionKeyID == "" {
+		log.FromContext(ctx).Warn("No encryption key defined, store webhook secrets in plaintext")
+		return &enc, nil
+	}
+	encryptString := func(s string) (string, error) {
+		if s == "" {
+			return "", nil
+		}
+		b, err := r.KeyVault.Encrypt(ctx, []byte(s), r.EncryptionKeyID)
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+	}
+	if pb.Signing != nil {
+		signing := *pb.Signing
+		secret, err := encryptString(signing.Secret)
+		if err != nil {
+			return nil, err
+		}
+		signing.Secret = secret
+		enc.Signing = &signing
+	}
+	if pb.OAuth2ClientCredentials != nil {
+		credentials := *pb.OAuth2ClientCredentials
+		secret, err := encryptString(credentials.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+		credentials.ClientSecret = secret
+		enc.OAuth2ClientCredentials = &credentials
+	}
+	if len(pb.TLSClientKey) > 0 {
+		key, err := r.KeyVault.Encrypt(ctx, pb.TLSClientKey, r.EncryptionKeyID)
+		if err != nil {
+			return nil, err
+		}
+		enc.TLSClientKey = key
+	}
+	enc.SecretsKeyID = r.EncryptionKeyID
+	return &enc, nil
+}
+
+// decryptSecrets decrypts the secrets of pb in place.
+func (r WebhookRegistry) decryptSecrets(ctx context.Context, pb *ttnpb.ApplicationWebhook) error {
+	keyID := pb.SecretsKeyID
+	if keyID == "" {
+		return nil
+	}
+	decryptString := func(s string) (string, error) {
+		if s == "" {
+			return "", nil
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return "", err
+		}
+		if b, err = r.KeyVault.Decrypt(ctx, b, keyID); err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	if pb.Signing != nil {
+		secret, err := decryptString(pb.Signing.Secret)
+		if err != nil {
+			return err
+		}
+		pb.Signing.Secret = secret
+	}
+	if pb.OAuth2ClientCredentials != nil {
+		secret, err := decryptString(pb.OAuth2ClientCredentials.ClientSecret)
+		if err != nil {
+			return err
+		}
+		pb.OAuth2ClientCredentials.ClientSecret = secret
+	}
+	if len(pb.TLSClientKey) > 0 {
+		key, err := r.KeyVault.Decrypt(ctx, pb.TLSClientKey, keyID)
+		if err != nil {
+			return err
+		}
+		pb.TLSClientKey = key
+	}
+	pb.SecretsKeyID = ""
+	return nil
 }
 
 func (r *WebhookRegistry) appKey(uid string) string {
@@ -73,6 +174,9 @@ func (r WebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookId
 	if err := ttnredis.GetProto(ctx, r.Redis, r.idKey(unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if err := r.decryptSecrets(ctx, pb); err != nil {
+		return nil, err
+	}
 	return applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
 }
 
@@ -83,6 +187,9 @@ func (r WebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifi
 	err := ttnredis.FindProtos(ctx, r.Redis, r.appKey(appUID), r.makeIDKeyFunc(appUID)).Range(func() (proto.Message, func() (bool, error)) {
 		pb := &ttnpb.ApplicationWebhook{}
 		return pb, func() (bool, error) {
+			if err := r.decryptSecrets(ctx, pb); err != nil {
+				return false, err
+			}
 			pb, err := applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
 			if err != nil {
 				return false, err
@@ -110,6 +217,8 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 			stored = nil
 		} else if err != nil {
 			return err
+		} else if err := r.decryptSecrets(ctx, stored); err != nil {
+			return err
 		}
 
 		gets = appendImplicitWebhookGetPaths(gets...)
@@ -120,6 +229,9 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 			if err := cmd.ScanProto(pb); err != nil {
 				return err
 			}
+			if err := r.decryptSecrets(ctx, pb); err != nil {
+				return err
+			}
 			pb, err = applyWebhookFieldMask(nil, pb, gets...)
 			if err != nil {
 				return err
@@ -185,6 +297,9 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 				if err := cmd.ScanProto(updated); err != nil {
 					return err
 				}
+				if err := r.decryptSecrets(ctx, updated); err != nil {
+					return err
+				}
 				updated, err = applyWebhookFieldMask(updated, pb, sets...)
 				if err != nil {
 					return err
@@ -193,9 +308,15 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 			if err := updated.ValidateFields(sets...); err != nil {
 				return err
 			}
+			// The key ID of the secrets is set on encryption; it is not settable by the caller.
+			updated.SecretsKeyID = ""
+			encrypted, err := r.encryptSecrets(ctx, updated)
+			if err != nil {
+				return err
+			}
 
 			pipelined = func(p redis.Pipeliner) error {
-				if _, err := ttnredis.SetProto(ctx, p, ik, updated, 0); err != nil {
+				if _, err := ttnredis.SetProto(ctx, p, ik, encrypted, 0); err != nil {
 					return err
 				}
 				p.SAdd(ctx, r.appKey(appUID), updated.WebhookID)
@@ -0,0 +1,447 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes of responses that are retried if the retry policy of the
+// webhook does not specify the retryable status codes.
+var DefaultRetryableStatusCodes = []uint32{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DeliveryQueue is a persistent queue of webhook deliveries that are scheduled to be retried.
+type DeliveryQueue interface {
+	// Add adds the delivery to the queue, to be retried at startAt.
+	Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error
+	// Pop calls f on the earliest delivery in the queue, for which the start time is in the past.
+	// If there is no such delivery available, Pop blocks until it is or until the context is done.
+	Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error
+}
+
+// DeadLetterStore is a store of webhook deliveries that exhausted their retry attempts.
+type DeadLetterStore interface {
+	// Add stores the delivery.
+	Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error
+	// List returns the deliveries of the webhook, oldest first.
+	// If no delivery IDs are given, all deliveries of the webhook are returned.
+	List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error)
+	// Remove removes the deliveries of the webhook and returns the removed deliveries.
+	// If no delivery IDs are given, all deliveries of the webhook are removed.
+	Remove(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error)
+}
+
+// FailedDeliveries manages the webhook deliveries that exhausted their retry attempts.
+type FailedDeliveries interface {
+	// List returns the failed deliveries of the webhook.
+	List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error)
+	// Replay schedules the failed deliveries of the webhook for delivery with a fresh retry budget.
+	Replay(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) error
+	// Purge removes the failed deliveries of the webhook.
+	Purge(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) error
+}
+
+type retryPolicy struct {
+	maxAttempts          uint32
+	initialBackoff       time.Duration
+	maxBackoff           time.Duration
+	retryableStatusCodes []uint32
+}
+
+// policy returns the retry policy of the webhook, using the defaults of the configuration for unset fields.
+func (c RetryConfig) policy(pb *ttnpb.ApplicationWebhook_RetryPolicy) retryPolicy {
+	p := retryPolicy{
+		maxAttempts:          c.MaxAttempts,
+		initialBackoff:       c.InitialBackoff,
+		maxBackoff:           c.MaxBackoff,
+		retryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+	if pb == nil {
+		return p
+	}
+	if pb.MaxAttempts > 0 {
+		p.maxAttempts = pb.MaxAttempts
+	}
+	if pb.InitialBackoff != nil && *pb.InitialBackoff > 0 {
+		p.initialBackoff = *pb.InitialBackoff
+	}
+	if pb.MaxBackoff != nil && *pb.MaxBackoff > 0 {
+		p.maxBackoff = *pb.MaxBackoff
+	}
+	if len(pb.RetryableStatusCodes) > 0 {
+		p.retryableStatusCodes = pb.RetryableStatusCodes
+	}
+	return p
+}
+
+// backoff returns the backoff after the given number of attempts.
+// The backoff doubles with every attempt, up to the maximum backoff.
+func (p retryPolicy) backoff(attempts uint32) time.Duration {
+	d := p.initialBackoff
+	for i := uint32(1); i < attempts; i++ {
+		if p.maxBackoff > 0 && d >= p.maxBackoff {
+			break
+		}
+		d *= 2
+	}
+	if p.maxBackoff > 0 && d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+	return d
+}
+
+// retryable returns whether the delivery that failed with the given error may be retried.
+// Requests that failed without a response, i.e. due to network errors or timeouts, are always retryable.
+func (p retryPolicy) retryable(err error) bool {
+	if !errors.Resemble(err, errRequest) {
+		return true
+	}
+	ttnErr, ok := errors.From(err)
+	if !ok {
+		return true
+	}
+	code, ok := ttnErr.Attributes()["code"].(int)
+	if !ok {
+		return true
+	}
+	for _, retryable := range p.retryableStatusCodes {
+		if uint32(code) == retryable {
+			return true
+		}
+	}
+	return false
+}
+
+type deliveryKeyType struct{}
+
+var deliveryKey deliveryKeyType
+
+type deliveryInfo struct {
+	hook         *ttnpb.ApplicationWebhook
+	endDeviceIDs ttnpb.EndDeviceIdentifiers
+}
+
+func withDeliveryInfo(ctx context.Context, info deliveryInfo) context.Context {
+	return context.WithValue(ctx, deliveryKey, info)
+}
+
+func deliveryInfoFromContext(ctx context.Context) (deliveryInfo, bool) {
+	info, ok := ctx.Value(deliveryKey).(deliveryInfo)
+	return info, ok
+}
+
+func newDelivery(req *http.Request, info deliveryInfo) (*ttnpb.ApplicationWebhookDelivery, error) {
+	var body []byte
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		if body, err = ioutil.ReadAll(rc); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now().UTC()
+	return &ttnpb.ApplicationWebhookDelivery{
+		ApplicationWebhookIdentifiers: info.hook.ApplicationWebhookIdentifiers,
+		ID:                            ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		EndDeviceIDs:                  info.endDeviceIDs,
+		CreatedAt:                     now,
+		UpdatedAt:                     now,
+		URL:                           req.URL.String(),
+		Headers:                       deliveryHeaders(req.Header, info.hook),
+		Body:                          body,
+	}, nil
+}
+
+// secretHeaders are the headers that are never stored with a delivery, as they may contain secrets.
+var secretHeaders = []string{
+	"Authorization",
+	downlinkKeyHeader,
+	signatureHeader,
+	timestampHeader,
+}
+
+// deliveryHeaders returns the request headers that are stored with the delivery.
+// The headers configured in the webhook and the secret headers are omitted; these are derived from the webhook
+// when the delivery is retried, so that stored deliveries do not expose webhook secrets.
+func deliveryHeaders(header http.Header, hook *ttnpb.ApplicationWebhook) map[string]string {
+	header = header.Clone()
+	for key := range hook.Headers {
+		header.Del(key)
+	}
+	for _, key := range secretHeaders {
+		header.Del(key)
+	}
+	headers := make(map[string]string, len(header))
+	for key := range header {
+		headers[key] = header.Get(key)
+	}
+	return headers
+}
+
+// newDeliveryRequest recreates the request of the delivery to the webhook.
+// The request context carries the delivery info, so that failures of the request can be handled by a RetrySink.
+func newDeliveryRequest(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
+	ctx = withDeliveryInfo(ctx, deliveryInfo{
+		hook:         hook,
+		endDeviceIDs: delivery.EndDeviceIDs,
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range delivery.Headers {
+		req.Header.Set(key, value)
+	}
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+	if hook.DownlinkAPIKey != "" {
+		req.Header.Set(downlinkKeyHeader, hook.DownlinkAPIKey)
+	} else {
+		req.Header.Del(downlinkPushHeader)
+		req.Header.Del(downlinkReplaceHeader)
+	}
+	return req, nil
+}
+
+var (
+	errDelivery          = errors.DefineUnavailable("delivery", "delivery failed")
+	errNoDeadLetterStore = errors.DefineFailedPrecondition("no_dead_letter_store", "failed deliveries are not stored")
+)
+
+func errorDetails(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errDelivery.WithCause(err))
+}
+
+// RetrySink is a ControllableSink that retries failed deliveries according to the retry policy of the webhook.
+// Deliveries that exhaust their retry attempts are stored as failed deliveries, if DeadLetters is set.
+type RetrySink struct {
+	Target      Sink
+	Queue       DeliveryQueue
+	DeadLetters DeadLetterStore
+	Registry    WebhookRegistry
+	Config      RetryConfig
+	Workers     int
+}
+
+const retryPopErrorBackoff = time.Second
+
+// retryWebhookPaths are the paths of the webhook that are needed to retry a delivery.
+var retryWebhookPaths = []string{
+	"downlink_api_key",
+	"headers",
+	"oauth2_client_credentials",
+	"retry_policy",
+	"signing",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+}
+
+// Run starts concurrent workers to retry the deliveries from the queue.
+// If Target is a ControllableSink, this method runs the target.
+// This method blocks until the target (if controllable) and all workers are done.
+func (s *RetrySink) Run(ctx context.Context) error {
+	if s.Workers < 1 {
+		s.Workers = 1
+	}
+	wg := sync.WaitGroup{}
+	if controllable, ok := s.Target.(ControllableSink); ok {
+		wg.Add(1)
+		go func() {
+			if err := controllable.Run(ctx); err != nil && !errors.IsCanceled(err) {
+				log.FromContext(ctx).WithError(err).Error("Target sink failed")
+			}
+			wg.Done()
+		}()
+	}
+	for i := 0; i < s.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				err := s.Queue.Pop(ctx, s.retry)
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				if err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to pop delivery from queue")
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(retryPopErrorBackoff):
+					}
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+	wg.Wait()
+	return ctx.Err()
+}
+
+// Process processes the request by the target. If the target fails, the delivery is scheduled for retry.
+// An error is returned when the delivery cannot be retried.
+func (s *RetrySink) Process(req *http.Request) error {
+	err := s.Target.Process(req)
+	if err == nil {
+		return nil
+	}
+	ctx := req.Context()
+	info, ok := deliveryInfoFromContext(ctx)
+	if !ok {
+		return err
+	}
+	delivery, dErr := newDelivery(req, info)
+	if dErr != nil {
+		log.FromContext(ctx).WithError(dErr).Warn("Failed to create delivery")
+		return err
+	}
+	return s.handleFailure(ctx, delivery, info.hook.RetryPolicy, err)
+}
+
+// deliveryLogger returns the logger of the context with the fields of the delivery.
+func deliveryLogger(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) log.Interface {
+	return log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", delivery.ApplicationID,
+		"webhook_id", delivery.WebhookID,
+		"delivery_id", delivery.ID,
+		"attempts", delivery.Attempts,
+	))
+}
+
+func (s *RetrySink) retry(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	logger := deliveryLogger(ctx, delivery)
+	hook, err := s.Registry.Get(ctx, delivery.ApplicationWebhookIdentifiers, retryWebhookPaths)
+	if errors.IsNotFound(err) {
+		logger.Debug("Webhook not found, drop delivery")
+		return nil
+	} else if err != nil {
+		logger.WithError(err).Warn("Failed to get webhook, reschedule delivery")
+		return s.Queue.Add(ctx, delivery, time.Now().Add(s.Config.policy(nil).backoff(delivery.Attempts)))
+	}
+	req, err := newDeliveryRequest(ctx, delivery, hook)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create request, drop delivery")
+		return nil
+	}
+	logger.WithField("url", req.URL).Debug("Retry delivery")
+	if err := s.Target.Process(req); err != nil {
+		if err := s.handleFailure(ctx, delivery, hook.RetryPolicy, err); err != nil {
+			logger.WithError(err).Warn("Failed to process delivery")
+		}
+	}
+	return nil
+}
+
+func (s *RetrySink) handleFailure(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, pb *ttnpb.ApplicationWebhook_RetryPolicy, err error) error {
+	policy := s.Config.policy(pb)
+	delivery.Attempts++
+	delivery.Error = errorDetails(err)
+	delivery.UpdatedAt = time.Now().UTC()
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"delivery_id", delivery.ID,
+		"attempts", delivery.Attempts,
+	))
+	if delivery.Attempts < policy.maxAttempts && policy.retryable(err) {
+		backoff := policy.backoff(delivery.Attempts)
+		logger.WithError(err).WithField("backoff", backoff).Debug("Delivery failed, schedule retry")
+		return s.Queue.Add(ctx, delivery, time.Now().Add(backoff))
+	}
+	if s.DeadLetters == nil {
+		return err
+	}
+	logger.WithError(err).Debug("Delivery failed, store failed delivery")
+	if dlErr := s.DeadLetters.Add(ctx, delivery); dlErr != nil {
+		return dlErr
+	}
+	return err
+}
+
+// List implements FailedDeliveries.
+func (s *RetrySink) List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	if s.DeadLetters == nil {
+		return nil, errNoDeadLetterStore.New()
+	}
+	return s.DeadLetters.List(ctx, ids, deliveryIDs...)
+}
+
+// Replay implements FailedDeliveries.
+// The deliveries are added to the queue before they are removed from the dead letters, so that a failure does not
+// lose deliveries. Deliveries that are added to the queue are removed from the dead letters, even if a later delivery
+// fails to be added.
+func (s *RetrySink) Replay(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) error {
+	if s.DeadLetters == nil {
+		return errNoDeadLetterStore.New()
+	}
+	deliveries, err := s.DeadLetters.List(ctx, ids, deliveryIDs...)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	replayed := make([]string, 0, len(deliveries))
+	var addErr error
+	for _, delivery := range deliveries {
+		delivery.Attempts = 0
+		delivery.Error = nil
+		delivery.UpdatedAt = now.UTC()
+		if addErr = s.Queue.Add(ctx, delivery, now); addErr != nil {
+			break
+		}
+		replayed = append(replayed, delivery.ID)
+	}
+	// Remove removes all deliveries of the webhook if no delivery IDs are given.
+	if len(replayed) > 0 {
+		if _, err := s.DeadLetters.Remove(ctx, ids, replayed...); err != nil {
+			return err
+		}
+	}
+	return addErr
+}
+
+// Purge implements FailedDeliveries.
+func (s *RetrySink) Purge(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) error {
+	if s.DeadLetters == nil {
+		return errNoDeadLetterStore.New()
+	}
+	_, err := s.DeadLetters.Remove(ctx, ids, deliveryIDs...)
+	return err
+}
@@ -0,0 +1,350 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestRetryPolicy(t *testing.T) {
+	conf := RetryConfig{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}
+
+	t.Run("Backoff", func(t *testing.T) {
+		a := assertions.New(t)
+		p := conf.policy(nil)
+		a.So(p.maxAttempts, should.Equal, uint32(5))
+		for attempts, expected := range []time.Duration{
+			1: time.Second,
+			2: 2 * time.Second,
+			3: 4 * time.Second,
+			4: 8 * time.Second,
+			5: 10 * time.Second,
+			6: 10 * time.Second,
+		} {
+			if attempts == 0 {
+				continue
+			}
+			a.So(p.backoff(uint32(attempts)), should.Equal, expected)
+		}
+
+		p = conf.policy(&ttnpb.ApplicationWebhook_RetryPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: durationPtr(time.Minute),
+		})
+		a.So(p.maxAttempts, should.Equal, uint32(2))
+		a.So(p.maxBackoff, should.Equal, 10*time.Second)
+		a.So(p.backoff(1), should.Equal, 10*time.Second)
+
+		p = conf.policy(&ttnpb.ApplicationWebhook_RetryPolicy{
+			InitialBackoff: durationPtr(time.Minute),
+			MaxBackoff:     durationPtr(time.Hour),
+		})
+		a.So(p.maxAttempts, should.Equal, uint32(5))
+		a.So(p.backoff(1), should.Equal, time.Minute)
+		a.So(p.backoff(2), should.Equal, 2*time.Minute)
+		a.So(p.backoff(8), should.Equal, time.Hour)
+	})
+
+	t.Run("Retryable", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name      string
+			Policy    *ttnpb.ApplicationWebhook_RetryPolicy
+			Error     error
+			Retryable bool
+		}{
+			{
+				Name:      "NetworkError",
+				Error:     errors.New("connection refused"),
+				Retryable: true,
+			},
+			{
+				Name:      "ServiceUnavailable",
+				Error:     errRequest.WithAttributes("code", http.StatusServiceUnavailable),
+				Retryable: true,
+			},
+			{
+				Name:      "BadRequest",
+				Error:     errRequest.WithAttributes("code", http.StatusBadRequest),
+				Retryable: false,
+			},
+			{
+				Name: "CustomStatusCode",
+				Policy: &ttnpb.ApplicationWebhook_RetryPolicy{
+					RetryableStatusCodes: []uint32{http.StatusConflict},
+				},
+				Error:     errRequest.WithAttributes("code", http.StatusConflict),
+				Retryable: true,
+			},
+			{
+				Name: "CustomStatusCodeExcluded",
+				Policy: &ttnpb.ApplicationWebhook_RetryPolicy{
+					RetryableStatusCodes: []uint32{http.StatusConflict},
+				},
+				Error:     errRequest.WithAttributes("code", http.StatusServiceUnavailable),
+				Retryable: false,
+			},
+		} {
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				a.So(conf.policy(tc.Policy).retryable(tc.Error), should.Equal, tc.Retryable)
+			})
+		}
+	})
+}
+
+type mockTarget struct {
+	errs []error
+	reqs []*http.Request
+}
+
+func (s *mockTarget) Process(req *http.Request) error {
+	s.reqs = append(s.reqs, req)
+	if len(s.errs) == 0 {
+		return nil
+	}
+	err := s.errs[0]
+	s.errs = s.errs[1:]
+	return err
+}
+
+type queuedDelivery struct {
+	delivery *ttnpb.ApplicationWebhookDelivery
+	startAt  time.Time
+}
+
+type mockDeliveryQueue struct {
+	queued []queuedDelivery
+	addErr error
+}
+
+func (q *mockDeliveryQueue) Add(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	if q.addErr != nil {
+		return q.addErr
+	}
+	q.queued = append(q.queued, queuedDelivery{delivery: delivery, startAt: startAt})
+	return nil
+}
+
+func (q *mockDeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error {
+	if len(q.queued) == 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	delivery := q.queued[0].delivery
+	q.queued = q.queued[1:]
+	return f(ctx, delivery)
+}
+
+type mockDeadLetterStore struct {
+	deliveries []*ttnpb.ApplicationWebhookDelivery
+}
+
+func (s *mockDeadLetterStore) Add(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	s.deliveries = append(s.deliveries, delivery)
+	return nil
+}
+
+func (s *mockDeadLetterStore) List(_ context.Context, _ ttnpb.ApplicationWebhookIdentifiers, _ ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	return s.deliveries, nil
+}
+
+func (s *mockDeadLetterStore) Remove(_ context.Context, _ ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	if len(deliveryIDs) == 0 {
+		deliveries := s.deliveries
+		s.deliveries = nil
+		return deliveries, nil
+	}
+	var removed, remaining []*ttnpb.ApplicationWebhookDelivery
+	for _, delivery := range s.deliveries {
+		found := false
+		for _, id := range deliveryIDs {
+			if delivery.ID == id {
+				found = true
+				break
+			}
+		}
+		if found {
+			removed = append(removed, delivery)
+		} else {
+			remaining = append(remaining, delivery)
+		}
+	}
+	s.deliveries = remaining
+	return removed, nil
+}
+
+type mockWebhookRegistry struct {
+	WebhookRegistry
+	hook *ttnpb.ApplicationWebhook
+	err  error
+}
+
+func (r *mockWebhookRegistry) Get(_ context.Context, _ ttnpb.ApplicationWebhookIdentifiers, _ []string) (*ttnpb.ApplicationWebhook, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.hook, nil
+}
+
+func TestRetrySink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids.ApplicationIdentifiers,
+		DeviceID:               "foo-device",
+	}
+	hook := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ids,
+		Headers: map[string]string{
+			"Authorization": "key secret",
+			"X-Custom":      "custom",
+		},
+		DownlinkAPIKey: "foo.secret",
+		RetryPolicy: &ttnpb.ApplicationWebhook_RetryPolicy{
+			MaxAttempts: 2,
+		},
+	}
+	target := &mockTarget{
+		errs: []error{
+			errRequest.WithAttributes("code", http.StatusServiceUnavailable),
+			errRequest.WithAttributes("code", http.StatusBadGateway),
+		},
+	}
+	queue := &mockDeliveryQueue{}
+	deadLetters := &mockDeadLetterStore{}
+	sink := &RetrySink{
+		Target:      target,
+		Queue:       queue,
+		DeadLetters: deadLetters,
+		Registry:    &mockWebhookRegistry{hook: hook},
+		Config: RetryConfig{
+			MaxAttempts:    5,
+			InitialBackoff: time.Minute,
+		},
+	}
+
+	reqCtx := withDeliveryInfo(ctx, deliveryInfo{
+		hook:         hook,
+		endDeviceIDs: devIDs,
+	})
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, "https://example.com/up", bytes.NewReader([]byte("payload")))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	req.Header.Set("Authorization", "key secret")
+	req.Header.Set("X-Custom", "custom")
+	req.Header.Set(downlinkKeyHeader, "foo.secret")
+	req.Header.Set(downlinkPushHeader, "https://example.com/push")
+	req.Header.Set("Content-Type", "application/json")
+
+	// The first attempt fails and the delivery is scheduled for retry.
+	before := time.Now()
+	a.So(sink.Process(req), should.BeNil)
+	if !a.So(queue.queued, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	delivery := queue.queued[0].delivery
+	a.So(queue.queued[0].startAt, should.HappenOnOrAfter, before.Add(time.Minute))
+	a.So(delivery.ApplicationWebhookIdentifiers, should.Resemble, ids)
+	a.So(delivery.EndDeviceIDs, should.Resemble, devIDs)
+	a.So(delivery.ID, should.NotBeEmpty)
+	a.So(delivery.URL, should.Equal, "https://example.com/up")
+	// The headers of the webhook and the downlink API key are not stored with the delivery.
+	a.So(delivery.Headers, should.Resemble, map[string]string{
+		"Content-Type":     "application/json",
+		downlinkPushHeader: "https://example.com/push",
+	})
+	a.So(delivery.Body, should.Resemble, []byte("payload"))
+	a.So(delivery.Attempts, should.Equal, uint32(1))
+	a.So(delivery.Error, should.NotBeNil)
+
+	// The retry fails and the delivery exhausted its retry attempts.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	a.So(queue.Pop(cancelCtx, sink.retry), should.BeNil)
+	a.So(queue.queued, should.BeEmpty)
+	if !a.So(target.reqs, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	body, err := ioutil.ReadAll(target.reqs[1].Body)
+	a.So(err, should.BeNil)
+	a.So(body, should.Resemble, []byte("payload"))
+	// The headers of the webhook are derived from the webhook on retry.
+	a.So(target.reqs[1].Header.Get("Authorization"), should.Equal, "key secret")
+	a.So(target.reqs[1].Header.Get("X-Custom"), should.Equal, "custom")
+	a.So(target.reqs[1].Header.Get(downlinkKeyHeader), should.Equal, "foo.secret")
+	a.So(target.reqs[1].Header.Get(downlinkPushHeader), should.Equal, "https://example.com/push")
+	a.So(target.reqs[1].Header.Get("Content-Type"), should.Equal, "application/json")
+
+	failed, err := sink.List(ctx, ids)
+	a.So(err, should.BeNil)
+	if !a.So(failed, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(failed[0].ID, should.Equal, delivery.ID)
+	a.So(failed[0].Attempts, should.Equal, uint32(2))
+
+	// Replaying the delivery resets its attempts and schedules it immediately.
+	a.So(sink.Replay(ctx, ids), should.BeNil)
+	a.So(deadLetters.deliveries, should.BeEmpty)
+	if !a.So(queue.queued, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(queue.queued[0].delivery.Attempts, should.Equal, uint32(0))
+	a.So(queue.queued[0].delivery.Error, should.BeNil)
+	a.So(queue.Pop(cancelCtx, sink.retry), should.BeNil)
+	a.So(target.reqs, should.HaveLength, 3)
+	a.So(queue.queued, should.BeEmpty)
+
+	// Without a retryable status code, the delivery fails immediately.
+	target.errs = []error{errRequest.WithAttributes("code", http.StatusBadRequest)}
+	a.So(errors.Resemble(sink.Process(req), errRequest), should.BeTrue)
+	a.So(queue.queued, should.BeEmpty)
+	a.So(deadLetters.deliveries, should.HaveLength, 1)
+
+	// If the delivery cannot be added to the queue, it is kept in the dead letters.
+	queue.addErr = errRequest.WithAttributes("code", http.StatusInternalServerError)
+	a.So(errors.Resemble(sink.Replay(ctx, ids), errRequest), should.BeTrue)
+	a.So(queue.queued, should.BeEmpty)
+	a.So(deadLetters.deliveries, should.HaveLength, 1)
+	queue.addErr = nil
+
+	a.So(sink.Purge(ctx, ids), should.BeNil)
+	a.So(deadLetters.deliveries, should.BeEmpty)
+}
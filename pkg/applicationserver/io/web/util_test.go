@@ -88,13 +88,13 @@ type mockIS struct {
 	ttnpb.ApplicationRegistryServer
 	ttnpb.ApplicationAccessServer
 	applications     map[string]*ttnpb.Application
-	applicationAuths map[string][]string
+	applicationAuths map[string]map[string][]ttnpb.Right
 }
 
 func startMockIS(ctx context.Context) (*mockIS, string) {
 	is := &mockIS{
 		applications:     make(map[string]*ttnpb.Application),
-		applicationAuths: make(map[string][]string),
+		applicationAuths: make(map[string]map[string][]ttnpb.Right),
 	}
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterApplicationRegistryServer(srv.Server, is)
@@ -113,10 +113,24 @@ func (is *mockIS) add(ctx context.Context, ids ttnpb.ApplicationIdentifiers, key
 		ApplicationIdentifiers: ids,
 	}
 	if key != "" {
-		is.applicationAuths[uid] = []string{fmt.Sprintf("Bearer %v", key)}
+		is.addKey(ctx, ids, key,
+			ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+			ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+			ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+			ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+		)
 	}
 }
 
+func (is *mockIS) addKey(ctx context.Context, ids ttnpb.ApplicationIdentifiers, key string, rights ...ttnpb.Right) {
+	uid := unique.ID(ctx, ids)
+	if is.applicationAuths[uid] == nil {
+		is.applicationAuths[uid] = make(map[string][]ttnpb.Right)
+	}
+	is.applicationAuths[uid][fmt.Sprintf("Bearer %v", key)] = rights
+}
+
 var errNotFound = errors.DefineNotFound("not_found", "not found")
 
 func (is *mockIS) Get(ctx context.Context, req *ttnpb.GetApplicationRequest) (*ttnpb.Application, error) {
@@ -142,16 +156,6 @@ func (is *mockIS) ListRights(ctx context.Context, ids *ttnpb.ApplicationIdentifi
 	if !ok {
 		return
 	}
-	for _, auth := range auths {
-		if auth == authorization[0] {
-			res.Rights = append(res.Rights,
-				ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
-				ttnpb.RIGHT_APPLICATION_DEVICES_READ,
-				ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
-				ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
-				ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
-			)
-		}
-	}
+	res.Rights = append(res.Rights, auths[authorization[0]]...)
 	return
 }
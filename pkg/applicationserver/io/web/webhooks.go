@@ -23,14 +23,17 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	stdio "io"
 
+	"github.com/bluele/gcache"
 	"github.com/gorilla/mux"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/version"
 	ttnweb "go.thethings.network/lorawan-stack/v3/pkg/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
@@ -52,15 +55,33 @@ type ControllableSink interface {
 }
 
 // HTTPClientSink contains an HTTP client to make outgoing requests.
+// The outbound authentication schemes of the webhook, if any, are applied to each request.
 type HTTPClientSink struct {
 	*http.Client
+	// Context is the context in which OAuth 2.0 tokens are fetched, typically the context of the component.
+	Context context.Context
+
+	authOnce  sync.Once
+	authMu    sync.Mutex
+	authCache gcache.Cache
 }
 
 var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
 
 // Process uses the HTTP client to perform the request.
 func (s *HTTPClientSink) Process(req *http.Request) error {
-	res, err := s.Do(req)
+	client := s.Client
+	if info, ok := deliveryInfoFromContext(req.Context()); ok {
+		auth, err := s.auth(req.Context(), info.hook)
+		if err != nil {
+			return err
+		}
+		client = auth.client
+		if req, err = s.authenticate(req, auth, info.hook); err != nil {
+			return err
+		}
+	}
+	res, err := client.Do(req)
 	if err != nil {
 		return err
 	}
@@ -74,13 +95,30 @@ func (s *HTTPClientSink) Process(req *http.Request) error {
 	return errRequest.WithAttributes("code", res.StatusCode)
 }
 
-// QueuedSink is a ControllableSink with queue.
+// QueuedSink is a ControllableSink with a persistent queue.
+// Requests are stored in the queue as deliveries, so that queued requests are not lost on restarts of the
+// Application Server. The queue is bounded: when it is full, the oldest deliveries are trimmed. Deliveries that are
+// being processed by a worker when the Application Server stops are not processed again.
+// Since deliveries do not contain the secrets of the webhook, the workers get the webhook from the registry to
+// recreate the request. Webhooks are cached for a short time, so that changes to a webhook may take up to
+// webhookCacheTTL to apply to queued deliveries.
 type QueuedSink struct {
-	Target  Sink
-	Queue   chan *http.Request
-	Workers int
+	Target   Sink
+	Queue    DeliveryQueue
+	Registry WebhookRegistry
+	Workers  int
+
+	webhookOnce  sync.Once
+	webhookCache gcache.Cache
 }
 
+const (
+	queuedSinkErrorBackoff = time.Second
+
+	webhookCacheSize = 4096
+	webhookCacheTTL  = 10 * time.Second
+)
+
 // Run starts concurrent workers to process messages from the queue.
 // If Target is a ControllableSink, this method runs the target.
 // This method blocks until the target (if controllable) and all workers are done.
@@ -101,14 +139,20 @@ func (s *QueuedSink) Run(ctx context.Context) error {
 	for i := 0; i < s.Workers; i++ {
 		wg.Add(1)
 		go func() {
+			defer wg.Done()
 			for {
+				err := s.Queue.Pop(ctx, s.process)
 				select {
 				case <-ctx.Done():
-					wg.Done()
 					return
-				case req := <-s.Queue:
-					if err := s.Target.Process(req); err != nil {
-						log.FromContext(req.Context()).WithError(err).Warn("Failed to process message")
+				default:
+				}
+				if err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to pop delivery from queue")
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(queuedSinkErrorBackoff):
 					}
 				}
 			}
@@ -119,17 +163,59 @@ func (s *QueuedSink) Run(ctx context.Context) error {
 	return ctx.Err()
 }
 
-var errQueueFull = errors.DefineResourceExhausted("queue_full", "the queue is full")
+// getWebhook returns the webhook from the cache, or from the registry if it is not cached.
+// Errors are not cached.
+func (s *QueuedSink) getWebhook(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (*ttnpb.ApplicationWebhook, error) {
+	s.webhookOnce.Do(func() {
+		s.webhookCache = gcache.New(webhookCacheSize).LRU().Expiration(webhookCacheTTL).Build()
+	})
+	key := fmt.Sprintf("%s:%s", unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+	if value, err := s.webhookCache.Get(key); err == nil {
+		return value.(*ttnpb.ApplicationWebhook), nil
+	}
+	hook, err := s.Registry.Get(ctx, ids, retryWebhookPaths)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.webhookCache.Set(key, hook); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to cache webhook")
+	}
+	return hook, nil
+}
+
+func (s *QueuedSink) process(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	logger := deliveryLogger(ctx, delivery)
+	hook, err := s.getWebhook(ctx, delivery.ApplicationWebhookIdentifiers)
+	if errors.IsNotFound(err) {
+		logger.Debug("Webhook not found, drop delivery")
+		return nil
+	} else if err != nil {
+		logger.WithError(err).Warn("Failed to get webhook, requeue delivery")
+		return s.Queue.Add(ctx, delivery, time.Now().Add(queuedSinkErrorBackoff))
+	}
+	req, err := newDeliveryRequest(ctx, delivery, hook)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create request, drop delivery")
+		return nil
+	}
+	if err := s.Target.Process(req); err != nil {
+		logger.WithError(err).Warn("Failed to process message")
+	}
+	return nil
+}
 
-// Process sends the request to the queue.
-// This method returns immediately. An error is returned when the queue is full.
+// Process adds the request to the queue.
+// Requests without delivery info cannot be recreated from the queue; these are processed by the target directly.
 func (s *QueuedSink) Process(req *http.Request) error {
-	select {
-	case s.Queue <- req:
-		return nil
-	default:
-		return errQueueFull.New()
+	info, ok := deliveryInfoFromContext(req.Context())
+	if !ok {
+		return s.Target.Process(req)
 	}
+	delivery, err := newDelivery(req, info)
+	if err != nil {
+		return err
+	}
+	return s.Queue.Add(req.Context(), delivery, time.Now())
 }
 
 // Webhooks is an interface for registering incoming webhooks for downlink and creating a subscription to outgoing
@@ -137,6 +223,8 @@ func (s *QueuedSink) Process(req *http.Request) error {
 type Webhooks interface {
 	ttnweb.Registerer
 	Registry() WebhookRegistry
+	// FailedDeliveries returns the failed deliveries of the webhooks, or nil if failed deliveries are not retained.
+	FailedDeliveries() FailedDeliveries
 }
 
 type webhooks struct {
@@ -180,6 +268,23 @@ func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry
 
 func (w *webhooks) Registry() WebhookRegistry { return w.registry }
 
+func (w *webhooks) FailedDeliveries() FailedDeliveries {
+	target := w.target
+	for {
+		switch sink := target.(type) {
+		case *RetrySink:
+			if sink.DeadLetters == nil {
+				return nil
+			}
+			return sink
+		case *QueuedSink:
+			target = sink.Target
+		default:
+			return nil
+		}
+	}
+}
+
 // RegisterRoutes registers the webhooks to the web server to handle downlink requests.
 func (w *webhooks) RegisterRoutes(server *ttnweb.Server) {
 	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/webhooks/{webhook_id}/devices/{device_id}/down").Subrouter()
@@ -232,7 +337,13 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"headers",
 			"join_accept",
 			"location_solved",
+			"oauth2_client_credentials",
+			"retry_policy",
 			"service_data",
+			"signing",
+			"tls_ca",
+			"tls_client_cert",
+			"tls_client_key",
 			"uplink_message",
 		},
 	)
@@ -246,6 +357,10 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			ctx := withDeliveryInfo(ctx, deliveryInfo{
+				hook:         hook,
+				endDeviceIDs: msg.EndDeviceIdentifiers,
+			})
 			req, err := w.newRequest(ctx, msg, hook)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to create request")
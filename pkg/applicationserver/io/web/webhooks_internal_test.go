@@ -0,0 +1,129 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestQueuedSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids.ApplicationIdentifiers,
+		DeviceID:               "foo-device",
+	}
+	hook := &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ids,
+		Headers: map[string]string{
+			"Authorization": "key secret",
+		},
+		DownlinkAPIKey: "foo.secret",
+	}
+	target := &mockTarget{}
+	queue := &mockDeliveryQueue{}
+	registry := &mockWebhookRegistry{hook: hook}
+	sink := &QueuedSink{
+		Target:   target,
+		Queue:    queue,
+		Registry: registry,
+	}
+
+	newRequest := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com/up", bytes.NewReader([]byte("payload")))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		req.Header.Set("Authorization", "key secret")
+		req.Header.Set(downlinkKeyHeader, "foo.secret")
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	// Requests are stored in the queue, without the secrets of the webhook.
+	before := time.Now()
+	a.So(sink.Process(newRequest(withDeliveryInfo(ctx, deliveryInfo{
+		hook:         hook,
+		endDeviceIDs: devIDs,
+	}))), should.BeNil)
+	a.So(target.reqs, should.BeEmpty)
+	if !a.So(queue.queued, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(queue.queued[0].startAt, should.HappenBetween, before, time.Now())
+	delivery := queue.queued[0].delivery
+	a.So(delivery.ApplicationWebhookIdentifiers, should.Resemble, ids)
+	a.So(delivery.EndDeviceIDs, should.Resemble, devIDs)
+	a.So(delivery.Headers, should.Resemble, map[string]string{
+		"Content-Type": "application/json",
+	})
+	a.So(delivery.Attempts, should.BeZeroValue)
+
+	// The workers recreate the request with the secrets of the webhook.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	a.So(queue.Pop(cancelCtx, sink.process), should.BeNil)
+	a.So(queue.queued, should.BeEmpty)
+	if !a.So(target.reqs, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	req := target.reqs[0]
+	a.So(req.URL.String(), should.Equal, "https://example.com/up")
+	a.So(req.Header.Get("Authorization"), should.Equal, "key secret")
+	a.So(req.Header.Get(downlinkKeyHeader), should.Equal, "foo.secret")
+	a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+	body, err := ioutil.ReadAll(req.Body)
+	a.So(err, should.BeNil)
+	a.So(body, should.Resemble, []byte("payload"))
+	info, ok := deliveryInfoFromContext(req.Context())
+	if a.So(ok, should.BeTrue) {
+		a.So(info.hook, should.Equal, hook)
+		a.So(info.endDeviceIDs, should.Resemble, devIDs)
+	}
+
+	// Deliveries are requeued if the webhook cannot be retrieved.
+	registry.err = errors.DefineUnavailable("registry_unavailable", "registry unavailable").New()
+	a.So(queue.Add(ctx, delivery, time.Now()), should.BeNil)
+	a.So(queue.Pop(cancelCtx, sink.process), should.BeNil)
+	a.So(queue.queued, should.HaveLength, 1)
+	a.So(target.reqs, should.HaveLength, 1)
+
+	// Deliveries of deleted webhooks are dropped.
+	registry.err = errWebhookNotFound.New()
+	a.So(queue.Pop(cancelCtx, sink.process), should.BeNil)
+	a.So(queue.queued, should.BeEmpty)
+	a.So(target.reqs, should.HaveLength, 1)
+
+	// Requests without delivery info are processed by the target directly.
+	a.So(sink.Process(newRequest(ctx)), should.BeNil)
+	a.So(queue.queued, should.BeEmpty)
+	a.So(target.reqs, should.HaveLength, 2)
+}
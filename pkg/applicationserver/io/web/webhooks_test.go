@@ -145,21 +145,36 @@ func TestWebhooks(t *testing.T) {
 				testSink := &mockSink{
 					ch: make(chan *http.Request, 1),
 				}
+				newQueue := func(name string) web.DeliveryQueue {
+					cl, flush := test.NewRedis(ctx, "web_test", name)
+					t.Cleanup(func() {
+						flush()
+						cl.Close()
+					})
+					q := redis.NewDeliveryQueue(cl, 100, "test", "test")
+					if err := q.Init(ctx); err != nil {
+						t.Fatalf("Failed to initialize delivery queue: %s", err)
+					}
+					return q
+				}
 				for _, sink := range []web.Sink{
 					testSink,
 					&web.QueuedSink{
-						Target:  testSink,
-						Queue:   make(chan *http.Request, 4),
-						Workers: 1,
+						Target:   testSink,
+						Queue:    newQueue("single"),
+						Registry: registry,
+						Workers:  1,
 					},
 					&web.QueuedSink{
 						Target: &web.QueuedSink{
-							Target:  testSink,
-							Queue:   make(chan *http.Request, 4),
-							Workers: 1,
+							Target:   testSink,
+							Queue:    newQueue("inner"),
+							Registry: registry,
+							Workers:  1,
 						},
-						Queue:   make(chan *http.Request, 4),
-						Workers: 1,
+						Queue:    newQueue("outer"),
+						Registry: registry,
+						Workers:  1,
 					},
 				} {
 					t.Run(fmt.Sprintf("%T", sink), func(t *testing.T) {
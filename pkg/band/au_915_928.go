@@ -155,6 +155,8 @@ func init() {
 		Beacon: Beacon{
 			DataRateIndex:    ttnpb.DATA_RATE_8,
 			CodingRate:       "4/5",
+			RFU1Size:         5,
+			RFU2Size:         3,
 			ComputeFrequency: makeBeaconFrequencyFunc(usAuBeaconFrequencies),
 		},
 
@@ -115,6 +115,10 @@ type Beacon struct {
 	DataRateIndex    ttnpb.DataRateIndex
 	CodingRate       string
 	InvertedPolarity bool
+	// RFU1Size is the size in bytes of the reserved field preceding the Time field of the beacon frame.
+	RFU1Size int
+	// RFU2Size is the size in bytes of the reserved field following the GwSpecific field of the beacon frame.
+	RFU2Size int
 	// Channel returns in Hz on which beaconing is performed.
 	//
 	// beaconTime is the integer value, converted in float64, of the 4 bytes “Time” field of the beacon frame.
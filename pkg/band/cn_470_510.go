@@ -130,6 +130,8 @@ func init() {
 		Beacon: Beacon{
 			DataRateIndex:    ttnpb.DATA_RATE_2,
 			CodingRate:       "4/5",
+			RFU1Size:         3,
+			RFU2Size:         1,
 			ComputeFrequency: makeBeaconFrequencyFunc(beaconFrequencies),
 		},
 
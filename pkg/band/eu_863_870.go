@@ -172,6 +172,8 @@ func init() {
 		Beacon: Beacon{
 			DataRateIndex:    ttnpb.DATA_RATE_3,
 			CodingRate:       "4/5",
+			RFU1Size:         2,
+			RFU2Size:         0,
 			ComputeFrequency: func(_ float64) uint64 { return beaconFrequency },
 		},
 		PingSlotFrequency: uint64Ptr(beaconFrequency),
@@ -136,6 +136,8 @@ func init() {
 		Beacon: Beacon{
 			DataRateIndex:    ttnpb.DATA_RATE_4,
 			CodingRate:       "4/5",
+			RFU1Size:         1,
+			RFU2Size:         3,
 			ComputeFrequency: func(_ float64) uint64 { return beaconFrequency },
 		},
 		PingSlotFrequency: uint64Ptr(beaconFrequency),
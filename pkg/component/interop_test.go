@@ -54,6 +54,19 @@ func (m mockInterop) JoinRequest(ctx context.Context, req *interop.JoinReq) (*in
 	}, nil
 }
 
+func (m mockInterop) RejoinRequest(ctx context.Context, req *interop.RejoinReq) (*interop.RejoinAns, error) {
+	ansHeader, err := req.AnswerHeader()
+	if err != nil {
+		return nil, err
+	}
+	return &interop.RejoinAns{
+		JsNsMessageHeader: ansHeader,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
 func (m mockInterop) AppSKeyRequest(ctx context.Context, req *interop.AppSKeyReq) (*interop.AppSKeyAns, error) {
 	ansHeader, err := req.AnswerHeader()
 	if err != nil {
@@ -123,11 +123,19 @@ type Cache struct {
 	Redis   redis.Config `name:"redis"`
 }
 
+// EventsStore represents configuration for the history of events.
+type EventsStore struct {
+	Enable      bool          `name:"enable" description:"Enable the history of events (internal, redis backends)"`
+	TTL         time.Duration `name:"ttl" description:"How long the history of an entity is retained (0 means no expiry)"`
+	EntityCount int           `name:"entity-count" description:"Maximum number of events retained in the history of an entity"`
+}
+
 // Events represents configuration for the events system.
 type Events struct {
 	Backend string       `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
 	Redis   redis.Config `name:"redis"`
 	Cloud   CloudEvents  `name:"cloud"`
+	Store   EventsStore  `name:"store"`
 }
 
 // Rights represents the configuration to apply when fetching entity rights.
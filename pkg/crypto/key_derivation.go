@@ -79,6 +79,16 @@ func DeriveLegacyNwkSKey(appKey types.AES128Key, jn types.JoinNonce, nid types.N
 	return deriveLegacySKey(appKey, 0x01, jn, nid, dn)
 }
 
+// DeriveRootWorSKey derives the LoRaWAN Relay Root Wake On Radio Session Key
+// - If a LoRaWAN 1.0 device is served by the relay, the NwkSKey is used as "nwkSEncKey"
+func DeriveRootWorSKey(nwkSEncKey types.AES128Key) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = 0x01
+	block, _ := aes.NewCipher(nwkSEncKey[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
+
 // deriveKey derives a device key
 func deriveDeviceKey(key types.AES128Key, t byte, devEUI types.EUI64) (derived types.AES128Key) {
 	buf := make([]byte, 16)
@@ -0,0 +1,666 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+)
+
+var (
+	isEndDevicePaths = []string{
+		"application_server_address",
+		"attributes",
+		"description",
+		"join_server_address",
+		"locations",
+		"name",
+		"network_server_address",
+		"service_profile_id",
+		"version_ids",
+	}
+	jsEndDevicePaths = []string{
+		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"claim_authentication_code",
+		"last_dev_nonce",
+		"last_join_nonce",
+		"last_rj_count_0",
+		"last_rj_count_1",
+		"net_id",
+		"network_server_address",
+		"network_server_kek_label",
+		"next_rj_count_0",
+		"next_rj_count_1",
+		"provisioner_id",
+		"provisioning_data",
+		"resets_join_nonces",
+		"root_keys",
+		"used_dev_nonces",
+	}
+	// jsTransferPaths are the Join Server fields that are transferred to the target end device.
+	// The join nonces are not transferred, since the target end device starts with a new session.
+	jsTransferPaths = []string{
+		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"claim_authentication_code",
+		"net_id",
+		"network_server_address",
+		"network_server_kek_label",
+		"provisioner_id",
+		"provisioning_data",
+		"resets_join_nonces",
+		"root_keys",
+	}
+	// nsEndDevicePaths are the Network Server fields that are transferred to the target end device.
+	// The session and MAC state are not transferred, since the target end device has to join.
+	nsEndDevicePaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"max_frequency",
+		"min_frequency",
+		"multicast",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+		"version_ids",
+	}
+	asEndDevicePaths = []string{
+		"formatters",
+		"skip_payload_crypto_override",
+		"version_ids",
+	}
+
+	// nsEndDeviceStatePaths and asEndDeviceStatePaths are the paths of the complete end device state in the Network
+	// Server and Application Server. The state of the source end device is fetched before it is deleted, so that it
+	// can be restored with the writable paths if the claim fails.
+	nsEndDeviceStatePaths   = endDeviceStatePaths(ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.NsEndDeviceRegistry/Get"].Allowed)
+	nsEndDeviceRestorePaths = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.NsEndDeviceRegistry/Set"].Allowed
+	asEndDeviceStatePaths   = endDeviceStatePaths(ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.AsEndDeviceRegistry/Get"].Allowed)
+	asEndDeviceRestorePaths = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.AsEndDeviceRegistry/Set"].Allowed
+)
+
+// endDeviceStatePaths returns the top level fields of the given paths, without the identifiers and timestamps.
+func endDeviceStatePaths(paths []string) []string {
+	return ttnpb.ExcludeFields(ttnpb.TopLevelFields(paths),
+		"created_at",
+		"ids",
+		"updated_at",
+	)
+}
+
+var (
+	errQRCodeData              = errors.DefineInvalidArgument("qr_code_data", "invalid QR code data")
+	errNoEUI                   = errors.DefineInvalidArgument("no_eui", "no JoinEUI or DevEUI")
+	errDeviceNotFound          = errors.DefineNotFound("device_not_found", "device with JoinEUI `{join_eui}` and DevEUI `{dev_eui}` not found")
+	errSameApplication         = errors.DefineFailedPrecondition("same_application", "device is already registered in the target application")
+	errApplicationUnauthorized = errors.DefinePermissionDenied("application_unauthorized", "source application is not authorized for claiming")
+	errClaimAuthentication     = errors.DefinePermissionDenied("claim_authentication", "invalid claim authentication code")
+	errClaimFailed             = errors.DefineAborted("claim_failed", "claim failed and changes were reverted")
+	errRollback                = errors.DefineDataLoss("rollback", "failed to revert changes of failed claim")
+	errSourceNotRestored       = errors.DefineDataLoss("source_not_restored", "fields `{fields}` of source end device not restored in `{role}`")
+	errNoFrequencyPlan         = errors.DefineInvalidArgument("no_frequency_plan", "no target frequency plan ID for device that is not registered on a Network Server")
+	errNoLoRaWANVersion        = errors.DefineInvalidArgument("no_lorawan_version", "no target LoRaWAN versions for device that is not registered on a Network Server and has no device profile")
+)
+
+// setDeviceProfileVersions sets the MAC and PHY versions of the device profile of the given end device, if the end
+// device has version identifiers and the Device Repository is available.
+func (dcs *DeviceClaimingServer) setDeviceProfileVersions(ctx context.Context, dev *ttnpb.EndDevice, opts ...grpc.CallOption) error {
+	if dev.VersionIDs == nil {
+		return nil
+	}
+	conn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_DEVICE_REPOSITORY, nil)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Device Repository not available, skip device profile versions")
+		return nil
+	}
+	template, err := ttnpb.NewDeviceRepositoryClient(conn).GetTemplate(ctx, &ttnpb.GetTemplateRequest{
+		ApplicationIDs: dev.ApplicationIdentifiers,
+		VersionIDs:     dev.VersionIDs,
+	}, opts...)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	dev.LoRaWANVersion = template.EndDevice.LoRaWANVersion
+	dev.LoRaWANPHYVersion = template.EndDevice.LoRaWANPHYVersion
+	return nil
+}
+
+// encryptAPIKey encrypts the API key of an authorized application with the configured encryption key.
+func (dcs *DeviceClaimingServer) encryptAPIKey(ctx context.Context, apiKey string) (*ttnpb.Secret, error) {
+	value := []byte(apiKey)
+	if dcs.encryptionKeyID != "" {
+		var err error
+		value, err = dcs.KeyVault.Encrypt(ctx, value, dcs.encryptionKeyID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		log.FromContext(ctx).Warn("No encryption key defined, store API key of authorized application in plaintext")
+	}
+	return &ttnpb.Secret{
+		KeyID: dcs.encryptionKeyID,
+		Value: value,
+	}, nil
+}
+
+// decryptAPIKey decrypts the API key of an authorized application.
+func (dcs *DeviceClaimingServer) decryptAPIKey(ctx context.Context, secret *ttnpb.Secret) (string, error) {
+	value := secret.Value
+	if secret.KeyID != "" {
+		var err error
+		value, err = dcs.KeyVault.Decrypt(ctx, secret.Value, secret.KeyID)
+		if err != nil {
+			return "", err
+		}
+	}
+	return string(value), nil
+}
+
+func (dcs *DeviceClaimingServer) withAPIKey(key string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     key,
+		AllowInsecure: dcs.AllowInsecureForCredentials(),
+	})
+}
+
+// sourceIdentifiers returns the JoinEUI, DevEUI and claim authentication code of the claim request.
+func sourceIdentifiers(req *ttnpb.ClaimEndDeviceRequest) (joinEUI, devEUI types.EUI64, authenticationCode string, err error) {
+	switch source := req.SourceDevice.(type) {
+	case *ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_:
+		ids := source.AuthenticatedIdentifiers
+		joinEUI, devEUI, authenticationCode = ids.JoinEUI, ids.DevEUI, ids.AuthenticationCode
+	case *ttnpb.ClaimEndDeviceRequest_QRCode:
+		data, err := qrcode.Parse(source.QRCode)
+		if err != nil {
+			return types.EUI64{}, types.EUI64{}, "", errQRCodeData.WithCause(err)
+		}
+		authIDs, ok := data.(qrcode.AuthenticatedEndDeviceIdentifiers)
+		if !ok {
+			return types.EUI64{}, types.EUI64{}, "", errQRCodeData.New()
+		}
+		joinEUI, devEUI, authenticationCode = authIDs.AuthenticatedEndDeviceIdentifiers()
+	}
+	if joinEUI.IsZero() || devEUI.IsZero() {
+		return types.EUI64{}, types.EUI64{}, "", errNoEUI.New()
+	}
+	return joinEUI, devEUI, authenticationCode, nil
+}
+
+// validateAuthenticationCode validates the given claim authentication code against the one of the end device.
+func validateAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode, value string, now time.Time) error {
+	if code == nil || code.Value == "" || subtle.ConstantTimeCompare([]byte(code.Value), []byte(value)) != 1 {
+		return errClaimAuthentication.New()
+	}
+	if code.ValidFrom != nil && now.Before(*code.ValidFrom) {
+		return errClaimAuthentication.New()
+	}
+	if code.ValidTo != nil && now.After(*code.ValidTo) {
+		return errClaimAuthentication.New()
+	}
+	return nil
+}
+
+// endDeviceRegistry is a component registry of end devices that takes part in a claim.
+// The statePaths are the paths of the end device state that is fetched from the registry, and the restorePaths are
+// the paths that can be written to the registry.
+type endDeviceRegistry struct {
+	role         ttnpb.ClusterRole
+	statePaths   []string
+	restorePaths []string
+	get          func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, paths []string, opts ...grpc.CallOption) (*ttnpb.EndDevice, error)
+	create       func(ctx context.Context, conn *grpc.ClientConn, dev *ttnpb.EndDevice, paths []string, opts ...grpc.CallOption) error
+	delete       func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error
+}
+
+var (
+	isRegistry = endDeviceRegistry{
+		role:         ttnpb.ClusterRole_ENTITY_REGISTRY,
+		statePaths:   isEndDevicePaths,
+		restorePaths: isEndDevicePaths,
+		get: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, paths []string, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+			return ttnpb.NewEndDeviceRegistryClient(conn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: ids,
+				FieldMask:            pbtypes.FieldMask{Paths: paths},
+			}, opts...)
+		},
+		create: func(ctx context.Context, conn *grpc.ClientConn, dev *ttnpb.EndDevice, paths []string, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewEndDeviceRegistryClient(conn).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+				EndDevice: *dev,
+			}, opts...)
+			return err
+		},
+		delete: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewEndDeviceRegistryClient(conn).Delete(ctx, &ids, opts...)
+			return err
+		},
+	}
+	jsRegistry = endDeviceRegistry{
+		role:         ttnpb.ClusterRole_JOIN_SERVER,
+		statePaths:   jsEndDevicePaths,
+		restorePaths: jsEndDevicePaths,
+		get: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, paths []string, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+			return ttnpb.NewJsEndDeviceRegistryClient(conn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: ids,
+				FieldMask:            pbtypes.FieldMask{Paths: paths},
+			}, opts...)
+		},
+		create: func(ctx context.Context, conn *grpc.ClientConn, dev *ttnpb.EndDevice, paths []string, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewJsEndDeviceRegistryClient(conn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+				EndDevice: *dev,
+				FieldMask: pbtypes.FieldMask{Paths: endDeviceCreatePaths(paths...)},
+			}, opts...)
+			return err
+		},
+		delete: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewJsEndDeviceRegistryClient(conn).Delete(ctx, &ids, opts...)
+			return err
+		},
+	}
+	nsRegistry = endDeviceRegistry{
+		role:         ttnpb.ClusterRole_NETWORK_SERVER,
+		statePaths:   nsEndDeviceStatePaths,
+		restorePaths: nsEndDeviceRestorePaths,
+		get: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, paths []string, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+			return ttnpb.NewNsEndDeviceRegistryClient(conn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: ids,
+				FieldMask:            pbtypes.FieldMask{Paths: paths},
+			}, opts...)
+		},
+		create: func(ctx context.Context, conn *grpc.ClientConn, dev *ttnpb.EndDevice, paths []string, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewNsEndDeviceRegistryClient(conn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+				EndDevice: *dev,
+				FieldMask: pbtypes.FieldMask{Paths: endDeviceCreatePaths(paths...)},
+			}, opts...)
+			return err
+		},
+		delete: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewNsEndDeviceRegistryClient(conn).Delete(ctx, &ids, opts...)
+			return err
+		},
+	}
+	asRegistry = endDeviceRegistry{
+		role:         ttnpb.ClusterRole_APPLICATION_SERVER,
+		statePaths:   asEndDeviceStatePaths,
+		restorePaths: asEndDeviceRestorePaths,
+		get: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, paths []string, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+			return ttnpb.NewAsEndDeviceRegistryClient(conn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: ids,
+				FieldMask:            pbtypes.FieldMask{Paths: paths},
+			}, opts...)
+		},
+		create: func(ctx context.Context, conn *grpc.ClientConn, dev *ttnpb.EndDevice, paths []string, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewAsEndDeviceRegistryClient(conn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+				EndDevice: *dev,
+				FieldMask: pbtypes.FieldMask{Paths: endDeviceCreatePaths(paths...)},
+			}, opts...)
+			return err
+		},
+		delete: func(ctx context.Context, conn *grpc.ClientConn, ids ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) error {
+			_, err := ttnpb.NewAsEndDeviceRegistryClient(conn).Delete(ctx, &ids, opts...)
+			return err
+		},
+	}
+)
+
+// sourceRestorePaths returns the paths to restore the given source end device with, and the paths of the state of the
+// source end device that cannot be restored in the registry.
+func (r endDeviceRegistry) sourceRestorePaths(source *ttnpb.EndDevice) (restore, missing []string) {
+	for _, path := range r.statePaths {
+		if source.FieldIsZero(path) {
+			continue
+		}
+		paths := ttnpb.AllowedBottomLevelFields([]string{path}, r.restorePaths)
+		if len(paths) == 0 {
+			missing = append(missing, path)
+			continue
+		}
+		for _, path := range paths {
+			// The registries reject session keys that are not set.
+			if strings.HasPrefix(path, "session.keys.") && source.FieldIsZero(path) {
+				continue
+			}
+			restore = append(restore, path)
+		}
+	}
+	return restore, missing
+}
+
+// endDeviceCreatePaths returns the given paths with the end device identifiers.
+func endDeviceCreatePaths(paths ...string) []string {
+	return ttnpb.AddFields(paths,
+		"ids.application_ids.application_id",
+		"ids.dev_eui",
+		"ids.device_id",
+		"ids.join_eui",
+	)
+}
+
+// claimStep is a step of a claim, with the function that reverts it.
+type claimStep struct {
+	name   string
+	revert func(context.Context) error
+}
+
+// claimTransaction keeps track of the steps of a claim, so that they can be reverted if a later step fails.
+type claimTransaction struct {
+	steps []claimStep
+}
+
+// Do executes f and, if it succeeds, records revert as the function that undoes it.
+func (tx *claimTransaction) Do(ctx context.Context, name string, f, revert func(context.Context) error) error {
+	if err := f(ctx); err != nil {
+		return err
+	}
+	tx.steps = append(tx.steps, claimStep{name: name, revert: revert})
+	return nil
+}
+
+// Rollback reverts the executed steps in reverse order.
+// All steps are attempted to be reverted, and the first error is returned.
+func (tx *claimTransaction) Rollback(ctx context.Context) error {
+	var firstErr error
+	for i := len(tx.steps) - 1; i >= 0; i-- {
+		step := tx.steps[i]
+		if err := step.revert(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("step", step.name).Error("Failed to revert claim step")
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	tx.steps = nil
+	return firstErr
+}
+
+// claimParticipant is a registry that takes part in a claim with the end device data it stores.
+type claimParticipant struct {
+	endDeviceRegistry
+	conn   *grpc.ClientConn
+	source *ttnpb.EndDevice
+}
+
+func (dcs *DeviceClaimingServer) claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("dcs:claim:%s", events.NewCorrelationID()))
+	joinEUI, devEUI, authenticationCode, err := sourceIdentifiers(req)
+	if err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	targetAuth, err := rpcmetadata.WithForwardedAuth(ctx, dcs.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs, err := ttnpb.NewEndDeviceRegistryClient(isConn).GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEUI: joinEUI,
+		DevEUI:  devEUI,
+	}, dcs.WithClusterAuth())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errDeviceNotFound.WithAttributes("join_eui", joinEUI, "dev_eui", devEUI)
+		}
+		return nil, err
+	}
+	if sourceIDs.ApplicationID == req.TargetApplicationIDs.ApplicationID {
+		return nil, errSameApplication.New()
+	}
+	encryptedAPIKey, err := dcs.authorizedApplications.Get(ctx, sourceIDs.ApplicationIdentifiers)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errApplicationUnauthorized.WithCause(err)
+		}
+		return nil, err
+	}
+	apiKey, err := dcs.decryptAPIKey(ctx, encryptedAPIKey)
+	if err != nil {
+		return nil, err
+	}
+	sourceAuth := dcs.withAPIKey(apiKey)
+
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: req.TargetApplicationIDs,
+		DeviceID:               req.TargetDeviceID,
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+	if targetIDs.DeviceID == "" {
+		targetIDs.DeviceID = sourceIDs.DeviceID
+	}
+
+	// Fetch the source end device from all registries where it is registered, before changing anything.
+	isDev, err := isRegistry.get(ctx, isConn, *sourceIDs, isRegistry.statePaths, sourceAuth)
+	if err != nil {
+		return nil, err
+	}
+	participants := []*claimParticipant{
+		{endDeviceRegistry: isRegistry, conn: isConn, source: isDev},
+	}
+	for _, r := range []struct {
+		endDeviceRegistry
+		registered bool
+	}{
+		{jsRegistry, true},
+		{nsRegistry, isDev.NetworkServerAddress != ""},
+		{asRegistry, isDev.ApplicationServerAddress != ""},
+	} {
+		if !r.registered {
+			participants = append(participants, &claimParticipant{endDeviceRegistry: r.endDeviceRegistry})
+			continue
+		}
+		conn, err := dcs.GetPeerConn(ctx, r.role, sourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		dev, err := r.get(ctx, conn, *sourceIDs, r.statePaths, sourceAuth)
+		if err != nil {
+			return nil, err
+		}
+		participants = append(participants, &claimParticipant{endDeviceRegistry: r.endDeviceRegistry, conn: conn, source: dev})
+	}
+	is, js, ns, as := participants[0], participants[1], participants[2], participants[3]
+
+	now := time.Now()
+	if err := validateAuthenticationCode(js.source.ClaimAuthenticationCode, authenticationCode, now); err != nil {
+		registerClaimFail(ctx, *sourceIDs, err)
+		return nil, err
+	}
+	registerClaimBegin(ctx, *sourceIDs)
+
+	// Build the target end device registrations.
+	targets := make(map[ttnpb.ClusterRole]*ttnpb.EndDevice, 4)
+	targetPaths := make(map[ttnpb.ClusterRole][]string, 4)
+
+	isTarget := &ttnpb.EndDevice{EndDeviceIdentifiers: targetIDs}
+	if err := isTarget.SetFields(is.source, isEndDevicePaths...); err != nil {
+		return nil, err
+	}
+	isTarget.NetworkServerAddress = req.TargetNetworkServerAddress
+	isTarget.ApplicationServerAddress = req.TargetApplicationServerAddress
+	targets[is.role], targetPaths[is.role] = isTarget, isEndDevicePaths
+
+	jsTarget := &ttnpb.EndDevice{EndDeviceIdentifiers: targetIDs}
+	if err := jsTarget.SetFields(js.source, jsTransferPaths...); err != nil {
+		return nil, err
+	}
+	jsTarget.NetworkServerAddress = req.TargetNetworkServerAddress
+	jsTarget.NetworkServerKEKLabel = req.TargetNetworkServerKEKLabel
+	jsTarget.ApplicationServerAddress = req.TargetApplicationServerAddress
+	jsTarget.ApplicationServerKEKLabel = req.TargetApplicationServerKEKLabel
+	jsTarget.ApplicationServerID = req.TargetApplicationServerID
+	jsTarget.NetID = req.TargetNetID
+	if req.InvalidateAuthenticationCode {
+		jsTarget.ClaimAuthenticationCode = nil
+	}
+	targets[js.role], targetPaths[js.role] = jsTarget, jsTransferPaths
+
+	if req.TargetNetworkServerAddress != "" {
+		nsTarget := &ttnpb.EndDevice{EndDeviceIdentifiers: targetIDs}
+		if ns.source != nil {
+			if err := nsTarget.SetFields(ns.source, nsEndDevicePaths...); err != nil {
+				return nil, err
+			}
+		} else {
+			// The source device is not registered on a Network Server, so the MAC and PHY versions are taken from the
+			// device profile. The request fields below take precedence.
+			nsTarget.VersionIDs = is.source.VersionIDs
+			if req.TargetLoRaWANVersion == ttnpb.MAC_UNKNOWN || req.TargetLoRaWANPHYVersion == ttnpb.PHY_UNKNOWN {
+				if err := dcs.setDeviceProfileVersions(ctx, nsTarget, targetAuth); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if req.TargetFrequencyPlanID != "" {
+			nsTarget.FrequencyPlanID = req.TargetFrequencyPlanID
+		}
+		if req.TargetLoRaWANVersion != ttnpb.MAC_UNKNOWN {
+			nsTarget.LoRaWANVersion = req.TargetLoRaWANVersion
+		}
+		if req.TargetLoRaWANPHYVersion != ttnpb.PHY_UNKNOWN {
+			nsTarget.LoRaWANPHYVersion = req.TargetLoRaWANPHYVersion
+		}
+		if nsTarget.FrequencyPlanID == "" {
+			return nil, errNoFrequencyPlan.New()
+		}
+		if nsTarget.LoRaWANVersion == ttnpb.MAC_UNKNOWN || nsTarget.LoRaWANPHYVersion == ttnpb.PHY_UNKNOWN {
+			return nil, errNoLoRaWANVersion.New()
+		}
+		nsTarget.SupportsJoin = true
+		targets[ns.role], targetPaths[ns.role] = nsTarget, nsEndDevicePaths
+	}
+	if req.TargetApplicationServerAddress != "" {
+		asTarget := &ttnpb.EndDevice{EndDeviceIdentifiers: targetIDs}
+		if as.source != nil {
+			if err := asTarget.SetFields(as.source, asEndDevicePaths...); err != nil {
+				return nil, err
+			}
+		}
+		targets[as.role], targetPaths[as.role] = asTarget, asEndDevicePaths
+	}
+
+	// Move the end device registrations. The source registrations are deleted before the target registrations are
+	// created, since the Identity Server and Join Server require the EUIs to be unique.
+	// If any step fails, all preceding steps are reverted.
+	tx := &claimTransaction{}
+	err = func() error {
+		for _, p := range []*claimParticipant{as, ns, js, is} {
+			p := p
+			if p.source == nil {
+				continue
+			}
+			source := &ttnpb.EndDevice{EndDeviceIdentifiers: *sourceIDs}
+			restorePaths, missingPaths := p.sourceRestorePaths(p.source)
+			if err := source.SetFields(p.source, restorePaths...); err != nil {
+				return err
+			}
+			if err := tx.Do(ctx, fmt.Sprintf("delete_source_%s", p.role),
+				func(ctx context.Context) error {
+					if err := p.delete(ctx, p.conn, *sourceIDs, sourceAuth); err != nil {
+						return err
+					}
+					registerDeleteSource(ctx, *sourceIDs, p.role)
+					return nil
+				},
+				func(ctx context.Context) error {
+					if err := p.create(ctx, p.conn, source, restorePaths, sourceAuth); err != nil {
+						return err
+					}
+					registerRestoreSource(ctx, *sourceIDs, p.role)
+					if len(missingPaths) > 0 {
+						return errSourceNotRestored.WithAttributes(
+							"fields", strings.Join(missingPaths, ","),
+							"role", p.role.String(),
+						)
+					}
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		for _, p := range []*claimParticipant{is, js, ns, as} {
+			p := p
+			target, ok := targets[p.role]
+			if !ok {
+				continue
+			}
+			conn := p.conn
+			if conn == nil {
+				var err error
+				if conn, err = dcs.GetPeerConn(ctx, p.role, &targetIDs); err != nil {
+					return err
+				}
+			}
+			if err := tx.Do(ctx, fmt.Sprintf("create_target_%s", p.role),
+				func(ctx context.Context) error {
+					if err := p.create(ctx, conn, target, targetPaths[p.role], targetAuth); err != nil {
+						return err
+					}
+					registerCreateTarget(ctx, targetIDs, p.role)
+					return nil
+				},
+				func(ctx context.Context) error {
+					return p.delete(ctx, conn, targetIDs, targetAuth)
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to claim end device, revert changes")
+		registerClaimFail(ctx, *sourceIDs, err)
+		// Revert with a context that is not canceled when the client goes away.
+		rollbackCtx := events.ContextWithCorrelationID(log.NewContext(dcs.Context(), logger), events.CorrelationIDsFromContext(ctx)...)
+		rollbackCtx, cancel := context.WithTimeout(rollbackCtx, time.Minute)
+		defer cancel()
+		if rollbackErr := tx.Rollback(rollbackCtx); rollbackErr != nil {
+			return nil, errRollback.WithCause(rollbackErr)
+		}
+		return nil, errClaimFailed.WithCause(err)
+	}
+	registerClaimSuccess(ctx, targetIDs)
+	return &targetIDs, nil
+}
@@ -0,0 +1,628 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestValidateAuthenticationCode(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	before, after := now.Add(-time.Hour), now.Add(time.Hour)
+
+	for _, tc := range []struct {
+		Name  string
+		Code  *ttnpb.EndDeviceAuthenticationCode
+		Value string
+		OK    bool
+	}{
+		{
+			Name:  "NoCode",
+			Value: "ABCD",
+		},
+		{
+			Name:  "EmptyCode",
+			Code:  &ttnpb.EndDeviceAuthenticationCode{},
+			Value: "",
+		},
+		{
+			Name:  "Mismatch",
+			Code:  &ttnpb.EndDeviceAuthenticationCode{Value: "ABCD"},
+			Value: "ABCE",
+		},
+		{
+			Name:  "Match",
+			Code:  &ttnpb.EndDeviceAuthenticationCode{Value: "ABCD"},
+			Value: "ABCD",
+			OK:    true,
+		},
+		{
+			Name:  "Valid",
+			Code:  &ttnpb.EndDeviceAuthenticationCode{Value: "ABCD", ValidFrom: &before, ValidTo: &after},
+			Value: "ABCD",
+			OK:    true,
+		},
+		{
+			Name:  "NotYetValid",
+			Code:  &ttnpb.EndDeviceAuthenticationCode{Value: "ABCD", ValidFrom: &after},
+			Value: "ABCD",
+		},
+		{
+			Name:  "Expired",
+			Code:  &ttnpb.EndDeviceAuthenticationCode{Value: "ABCD", ValidTo: &before},
+			Value: "ABCD",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := validateAuthenticationCode(tc.Code, tc.Value, now)
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestSourceIdentifiers(t *testing.T) {
+	a := assertions.New(t)
+
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	devEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	j, d, code, err := sourceIdentifiers(&ttnpb.ClaimEndDeviceRequest{
+		SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+				JoinEUI:            joinEUI,
+				DevEUI:             devEUI,
+				AuthenticationCode: "BEEF",
+			},
+		},
+	})
+	a.So(err, should.BeNil)
+	a.So(j, should.Equal, joinEUI)
+	a.So(d, should.Equal, devEUI)
+	a.So(code, should.Equal, "BEEF")
+
+	_, _, _, err = sourceIdentifiers(&ttnpb.ClaimEndDeviceRequest{
+		SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+				JoinEUI: joinEUI,
+			},
+		},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, _, _, err = sourceIdentifiers(&ttnpb.ClaimEndDeviceRequest{
+		SourceDevice: &ttnpb.ClaimEndDeviceRequest_QRCode{
+			QRCode: []byte("invalid"),
+		},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestClaimTransaction(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	var reverted []string
+	step := func(name string, fail bool) (func(context.Context) error, func(context.Context) error) {
+		return func(context.Context) error {
+				if fail {
+					return errors.New("fail")
+				}
+				return nil
+			}, func(context.Context) error {
+				reverted = append(reverted, name)
+				return nil
+			}
+	}
+
+	tx := &claimTransaction{}
+	for _, s := range []struct {
+		name string
+		fail bool
+	}{
+		{"first", false},
+		{"second", false},
+		{"third", true},
+	} {
+		do, revert := step(s.name, s.fail)
+		if err := tx.Do(ctx, s.name, do, revert); err != nil {
+			a.So(s.name, should.Equal, "third")
+			break
+		}
+	}
+	a.So(tx.Rollback(ctx), should.BeNil)
+	a.So(reverted, should.Resemble, []string{"second", "first"})
+
+	reverted = nil
+	a.So(tx.Rollback(ctx), should.BeNil)
+	a.So(reverted, should.BeEmpty)
+}
+
+// mockEndDeviceRegistry is an in-memory end device registry that serves the Identity Server, Join Server, Network
+// Server and Application Server end device registry services.
+type mockEndDeviceRegistry struct {
+	ttnpb.UnimplementedEndDeviceRegistryServer
+	ttnpb.UnimplementedJsEndDeviceRegistryServer
+	ttnpb.UnimplementedNsEndDeviceRegistryServer
+	ttnpb.UnimplementedAsEndDeviceRegistryServer
+
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+	setErr  func(*ttnpb.EndDevice) error
+}
+
+func newMockEndDeviceRegistry(devs ...*ttnpb.EndDevice) *mockEndDeviceRegistry {
+	r := &mockEndDeviceRegistry{
+		devices: make(map[string]*ttnpb.EndDevice),
+	}
+	for _, dev := range devs {
+		r.devices[unique.ID(test.Context(), dev.EndDeviceIdentifiers)] = dev
+	}
+	return r
+}
+
+func (r *mockEndDeviceRegistry) Devices() map[string]*ttnpb.EndDevice {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	devs := make(map[string]*ttnpb.EndDevice, len(r.devices))
+	for uid, dev := range r.devices {
+		devs[uid] = dev
+	}
+	return devs
+}
+
+func (r *mockEndDeviceRegistry) GetIdentifiersForEUIs(ctx context.Context, req *ttnpb.GetEndDeviceIdentifiersForEUIsRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, dev := range r.devices {
+		if dev.JoinEUI.Equal(req.JoinEUI) && dev.DevEUI.Equal(req.DevEUI) {
+			ids := dev.EndDeviceIdentifiers
+			return &ids, nil
+		}
+	}
+	return nil, errors.DefineNotFound("device_not_found", "device not found").New()
+}
+
+func (r *mockEndDeviceRegistry) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.devices[unique.ID(ctx, req.EndDeviceIdentifiers)]
+	if !ok {
+		return nil, errors.DefineNotFound("device_not_found", "device not found").New()
+	}
+	dev := &ttnpb.EndDevice{EndDeviceIdentifiers: stored.EndDeviceIdentifiers}
+	if err := dev.SetFields(stored, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
+
+func (r *mockEndDeviceRegistry) set(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	if r.setErr != nil {
+		if err := r.setErr(dev); err != nil {
+			return nil, err
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices[unique.ID(ctx, dev.EndDeviceIdentifiers)] = dev
+	return dev, nil
+}
+
+func (r *mockEndDeviceRegistry) Create(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return r.set(ctx, &req.EndDevice)
+}
+
+func (r *mockEndDeviceRegistry) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return r.set(ctx, &req.EndDevice)
+}
+
+func (r *mockEndDeviceRegistry) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.devices, unique.ID(ctx, ids))
+	return ttnpb.Empty, nil
+}
+
+type mockDeviceRepository struct {
+	ttnpb.UnimplementedDeviceRepositoryServer
+
+	template *ttnpb.EndDeviceTemplate
+}
+
+func (dr *mockDeviceRepository) GetTemplate(ctx context.Context, req *ttnpb.GetTemplateRequest) (*ttnpb.EndDeviceTemplate, error) {
+	return dr.template, nil
+}
+
+type mockAuthorizedApplicationRegistry struct {
+	mu      sync.Mutex
+	apiKeys map[string]*ttnpb.Secret
+}
+
+func (r *mockAuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	apiKey, ok := r.apiKeys[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errors.DefineNotFound("authorization_not_found", "authorization not found").New()
+	}
+	return apiKey, nil
+}
+
+func (r *mockAuthorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, apiKey *ttnpb.Secret) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.apiKeys[unique.ID(ctx, ids)] = apiKey
+	return nil
+}
+
+func (r *mockAuthorizedApplicationRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.apiKeys, unique.ID(ctx, ids))
+	return nil
+}
+
+func TestClaim(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x01}
+	sourceIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"},
+		DeviceID:               "source-dev",
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"},
+		DeviceID:               "target-dev",
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+	versionIDs := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "test-brand",
+		ModelID:         "test-model",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0",
+		BandID:          "EU_863_870",
+	}
+	makeISDevice := func(nsAddress string) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers:     sourceIDs,
+			Name:                     "Test Device",
+			NetworkServerAddress:     nsAddress,
+			ApplicationServerAddress: "as.localhost",
+			JoinServerAddress:        "js.localhost",
+			VersionIDs:               versionIDs,
+		}
+	}
+	jsDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers:     sourceIDs,
+		NetworkServerAddress:     "ns.localhost",
+		ApplicationServerAddress: "as.localhost",
+		ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+			Value: "ABCD",
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+		},
+	}
+	nsDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: sourceIDs,
+		FrequencyPlanID:      test.EUFrequencyPlanID,
+		LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+		LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+		SupportsJoin:         true,
+		VersionIDs:           versionIDs,
+	}
+	nsSession := &ttnpb.Session{
+		DevAddr:    types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		LastFCntUp: 42,
+		SessionKeys: ttnpb.SessionKeys{
+			SessionKeyID: []byte{0x01},
+			FNwkSIntKey: &ttnpb.KeyEnvelope{
+				Key: &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+		},
+	}
+	nsMACState := &ttnpb.MACState{
+		LoRaWANVersion: ttnpb.MAC_V1_0_3,
+		DeviceClass:    ttnpb.CLASS_A,
+	}
+	activatedNSDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: sourceIDs,
+		FrequencyPlanID:      test.EUFrequencyPlanID,
+		LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+		LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+		Session:              nsSession,
+		MACState:             nsMACState,
+		VersionIDs:           versionIDs,
+	}
+	joiningNSDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: sourceIDs,
+		FrequencyPlanID:      test.EUFrequencyPlanID,
+		LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+		LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+		SupportsJoin:         true,
+		PendingSession:       nsSession,
+		PendingMACState:      nsMACState,
+		VersionIDs:           versionIDs,
+	}
+	asDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: sourceIDs,
+		Formatters: &ttnpb.MessagePayloadFormatters{
+			UpFormatter: ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
+		},
+		VersionIDs: versionIDs,
+	}
+
+	for _, tc := range []struct {
+		Name                string
+		ISDevice            *ttnpb.EndDevice
+		NSDevice            *ttnpb.EndDevice
+		Request             *ttnpb.ClaimEndDeviceRequest
+		ASSetErr            error
+		ErrorAssertion      func(error) bool
+		ExpectedNSSource    *ttnpb.EndDevice
+		ExpectedNSTarget    *ttnpb.EndDevice
+		ExpectedTargetCount int
+	}{
+		{
+			Name:     "Success",
+			ISDevice: makeISDevice("ns.localhost"),
+			NSDevice: nsDevice,
+			ExpectedNSTarget: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+			},
+		},
+		{
+			Name:     "NoSourceNetworkServer",
+			ISDevice: makeISDevice(""),
+			Request: &ttnpb.ClaimEndDeviceRequest{
+				TargetFrequencyPlanID: test.EUFrequencyPlanID,
+			},
+			ExpectedNSTarget: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+			},
+		},
+		{
+			Name:     "NoSourceNetworkServerVersionsInRequest",
+			ISDevice: makeISDevice(""),
+			Request: &ttnpb.ClaimEndDeviceRequest{
+				TargetFrequencyPlanID:   test.EUFrequencyPlanID,
+				TargetLoRaWANVersion:    ttnpb.MAC_V1_1,
+				TargetLoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			},
+			ExpectedNSTarget: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANVersion:    ttnpb.MAC_V1_1,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			},
+		},
+		{
+			Name:           "NoSourceNetworkServerNoFrequencyPlan",
+			ISDevice:       makeISDevice(""),
+			ErrorAssertion: errNoFrequencyPlan.Is,
+		},
+		{
+			Name:           "Rollback",
+			ISDevice:       makeISDevice("ns.localhost"),
+			NSDevice:       nsDevice,
+			ASSetErr:       errors.DefineUnavailable("as_unavailable", "Application Server unavailable").New(),
+			ErrorAssertion: errClaimFailed.Is,
+		},
+		{
+			Name:           "RollbackSession",
+			ISDevice:       makeISDevice("ns.localhost"),
+			NSDevice:       activatedNSDevice,
+			ASSetErr:       errors.DefineUnavailable("as_unavailable", "Application Server unavailable").New(),
+			ErrorAssertion: errClaimFailed.Is,
+		},
+		{
+			Name:           "RollbackPendingSession",
+			ISDevice:       makeISDevice("ns.localhost"),
+			NSDevice:       joiningNSDevice,
+			ASSetErr:       errors.DefineUnavailable("as_unavailable", "Application Server unavailable").New(),
+			ErrorAssertion: errRollback.Is,
+			ExpectedNSSource: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: sourceIDs,
+				FrequencyPlanID:      test.EUFrequencyPlanID,
+				LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+				LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+				SupportsJoin:         true,
+				VersionIDs:           versionIDs,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			nsDevices := []*ttnpb.EndDevice{}
+			if tc.NSDevice != nil {
+				nsDevices = append(nsDevices, tc.NSDevice)
+			}
+			registries := map[ttnpb.ClusterRole]*mockEndDeviceRegistry{
+				ttnpb.ClusterRole_ENTITY_REGISTRY:    newMockEndDeviceRegistry(tc.ISDevice),
+				ttnpb.ClusterRole_JOIN_SERVER:        newMockEndDeviceRegistry(jsDevice),
+				ttnpb.ClusterRole_NETWORK_SERVER:     newMockEndDeviceRegistry(nsDevices...),
+				ttnpb.ClusterRole_APPLICATION_SERVER: newMockEndDeviceRegistry(asDevice),
+			}
+			registries[ttnpb.ClusterRole_APPLICATION_SERVER].setErr = func(dev *ttnpb.EndDevice) error {
+				if dev.ApplicationIdentifiers == targetIDs.ApplicationIdentifiers {
+					return tc.ASSetErr
+				}
+				return nil
+			}
+			sourceDevices := make(map[ttnpb.ClusterRole]map[string]*ttnpb.EndDevice, len(registries))
+			for role, r := range registries {
+				sourceDevices[role] = r.Devices()
+			}
+
+			peers := make(map[ttnpb.ClusterRole]cluster.Peer, len(registries)+1)
+			for role, registrator := range map[ttnpb.ClusterRole]interface{}{
+				ttnpb.ClusterRole_ENTITY_REGISTRY:    ttnpb.RegisterEndDeviceRegistryServer,
+				ttnpb.ClusterRole_JOIN_SERVER:        ttnpb.RegisterJsEndDeviceRegistryServer,
+				ttnpb.ClusterRole_NETWORK_SERVER:     ttnpb.RegisterNsEndDeviceRegistryServer,
+				ttnpb.ClusterRole_APPLICATION_SERVER: ttnpb.RegisterAsEndDeviceRegistryServer,
+			} {
+				peers[role] = test.Must(test.NewGRPCServerPeer(ctx, registries[role], registrator)).(cluster.Peer)
+			}
+			peers[ttnpb.ClusterRole_DEVICE_REPOSITORY] = test.Must(test.NewGRPCServerPeer(ctx, &mockDeviceRepository{
+				template: &ttnpb.EndDeviceTemplate{
+					EndDevice: ttnpb.EndDevice{
+						VersionIDs:        versionIDs,
+						LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+						LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+					},
+				},
+			}, ttnpb.RegisterDeviceRepositoryServer)).(cluster.Peer)
+
+			c := componenttest.NewComponent(t, &component.Config{
+				ServiceBase: config.ServiceBase{
+					GRPC: config.GRPC{
+						AllowInsecureForCredentials: true,
+					},
+				},
+			}, component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+				return &test.MockCluster{
+					JoinFunc: test.ClusterJoinNilFunc,
+					GetPeerFunc: func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (cluster.Peer, error) {
+						peer, ok := peers[role]
+						if !ok {
+							return nil, errors.DefineNotFound("peer_not_found", "peer not found").New()
+						}
+						return peer, nil
+					},
+					AuthFunc: func() grpc.CallOption {
+						return grpc.EmptyCallOption{}
+					},
+				}, nil
+			}))
+			c.KeyVault = cryptoutil.NewMemKeyVault(map[string][]byte{
+				"test": {0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			})
+			authorizedApplications := &mockAuthorizedApplicationRegistry{
+				apiKeys: make(map[string]*ttnpb.Secret),
+			}
+			dcs := test.Must(New(c, &Config{
+				AuthorizedApplications: authorizedApplications,
+				EncryptionKeyID:        "test",
+			})).(*DeviceClaimingServer)
+			componenttest.StartComponent(t, c)
+			defer c.Close()
+
+			apiKey, err := dcs.encryptAPIKey(ctx, "source-key")
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(apiKey.Value, should.NotResemble, []byte("source-key"))
+			if !a.So(authorizedApplications.Set(ctx, sourceIDs.ApplicationIdentifiers, apiKey), should.BeNil) {
+				t.FailNow()
+			}
+
+			req := &ttnpb.ClaimEndDeviceRequest{}
+			if tc.Request != nil {
+				req = tc.Request
+			}
+			req.SourceDevice = &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+				AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+					JoinEUI:            joinEUI,
+					DevEUI:             devEUI,
+					AuthenticationCode: "ABCD",
+				},
+			}
+			req.TargetApplicationIDs = targetIDs.ApplicationIdentifiers
+			req.TargetDeviceID = targetIDs.DeviceID
+			req.TargetNetworkServerAddress = "ns.localhost"
+			req.TargetApplicationServerAddress = "as.localhost"
+
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer target-key"))
+			ids, err := dcs.claim(ctx, req)
+			if tc.ErrorAssertion != nil {
+				if !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				// The source end device must be restored in all registries and the target end device must not exist.
+				// The state that cannot be restored is missing.
+				if tc.ExpectedNSSource != nil {
+					sourceDevices[ttnpb.ClusterRole_NETWORK_SERVER] = map[string]*ttnpb.EndDevice{
+						unique.ID(ctx, sourceIDs): tc.ExpectedNSSource,
+					}
+				}
+				for role, r := range registries {
+					a.So(r.Devices(), should.Resemble, sourceDevices[role])
+				}
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(*ids, should.Resemble, targetIDs)
+
+			targetUID := unique.ID(ctx, targetIDs)
+			for role, r := range registries {
+				devs := r.Devices()
+				if !a.So(devs, should.HaveLength, 1) || !a.So(devs, should.ContainKey, targetUID) {
+					t.Fatalf("Target end device not registered in %s", role)
+				}
+			}
+			isTarget := registries[ttnpb.ClusterRole_ENTITY_REGISTRY].Devices()[targetUID]
+			a.So(isTarget.Name, should.Equal, tc.ISDevice.Name)
+			a.So(isTarget.NetworkServerAddress, should.Equal, "ns.localhost")
+			jsTarget := registries[ttnpb.ClusterRole_JOIN_SERVER].Devices()[targetUID]
+			a.So(jsTarget.RootKeys, should.Resemble, jsDevice.RootKeys)
+			nsTarget := registries[ttnpb.ClusterRole_NETWORK_SERVER].Devices()[targetUID]
+			a.So(nsTarget.FrequencyPlanID, should.Equal, tc.ExpectedNSTarget.FrequencyPlanID)
+			a.So(nsTarget.LoRaWANVersion, should.Equal, tc.ExpectedNSTarget.LoRaWANVersion)
+			a.So(nsTarget.LoRaWANPHYVersion, should.Equal, tc.ExpectedNSTarget.LoRaWANPHYVersion)
+			a.So(nsTarget.SupportsJoin, should.BeTrue)
+			asTarget := registries[ttnpb.ClusterRole_APPLICATION_SERVER].Devices()[targetUID]
+			a.So(asTarget.Formatters, should.Resemble, asDevice.Formatters)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// claimRights are the rights that are required to claim end devices in an application, and that the API key of an
+// authorized source application must have.
+var claimRights = []ttnpb.Right{
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+}
+
+type endDeviceClaimingServer struct {
+	DCS *DeviceClaimingServer
+}
+
+var errAPIKeyRights = errors.DefinePermissionDenied("api_key_rights", "API key does not have the rights to claim end devices")
+
+// AuthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, claimRights...); err != nil {
+		return nil, err
+	}
+	conn, err := s.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := ttnpb.NewApplicationAccessClient(conn).ListRights(ctx, &req.ApplicationIdentifiers, s.DCS.withAPIKey(req.APIKey))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.Implied().IncludesAll(claimRights...) {
+		return nil, errAPIKeyRights.New()
+	}
+	apiKey, err := s.DCS.encryptAPIKey(ctx, req.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DCS.authorizedApplications.Set(ctx, req.ApplicationIdentifiers, apiKey); err != nil {
+		return nil, err
+	}
+	registerAuthorizeApplication(ctx, req.ApplicationIdentifiers)
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids, claimRights...); err != nil {
+		return nil, err
+	}
+	if err := s.DCS.authorizedApplications.Delete(ctx, *ids); err != nil {
+		return nil, err
+	}
+	registerUnauthorizeApplication(ctx, *ids)
+	return ttnpb.Empty, nil
+}
+
+// Claim implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	if err := rights.RequireApplication(ctx, req.TargetApplicationIDs, claimRights...); err != nil {
+		return nil, err
+	}
+	return s.DCS.claim(ctx, req)
+}
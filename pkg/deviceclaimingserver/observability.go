@@ -0,0 +1,103 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtAuthorizeApplication = events.Define(
+		"dcs.application.authorize", "authorize application for claiming",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtUnauthorizeApplication = events.Define(
+		"dcs.application.unauthorize", "unauthorize application for claiming",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtClaimBegin = events.Define(
+		"dcs.end_device.claim.begin", "begin claiming end device",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteSource = events.Define(
+		"dcs.end_device.claim.delete_source", "delete claimed end device from source application",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(ttnpb.ClusterRole_NONE),
+	)
+	evtRestoreSource = events.Define(
+		"dcs.end_device.claim.restore_source", "restore claimed end device in source application",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(ttnpb.ClusterRole_NONE),
+	)
+	evtCreateTarget = events.Define(
+		"dcs.end_device.claim.create_target", "create claimed end device in target application",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(ttnpb.ClusterRole_NONE),
+	)
+	evtClaimSuccess = events.Define(
+		"dcs.end_device.claim.success", "claim end device",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtClaimFail = events.Define(
+		"dcs.end_device.claim.fail", "fail to claim end device",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithErrorDataType(),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+func registerAuthorizeApplication(ctx context.Context, ids ttnpb.ApplicationIdentifiers) {
+	events.Publish(evtAuthorizeApplication.NewWithIdentifiersAndData(ctx, ids, nil))
+}
+
+func registerUnauthorizeApplication(ctx context.Context, ids ttnpb.ApplicationIdentifiers) {
+	events.Publish(evtUnauthorizeApplication.NewWithIdentifiersAndData(ctx, ids, nil))
+}
+
+func registerClaimBegin(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) {
+	events.Publish(evtClaimBegin.NewWithIdentifiersAndData(ctx, ids, nil))
+}
+
+func registerDeleteSource(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, role ttnpb.ClusterRole) {
+	events.Publish(evtDeleteSource.NewWithIdentifiersAndData(ctx, ids, role))
+}
+
+func registerRestoreSource(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, role ttnpb.ClusterRole) {
+	events.Publish(evtRestoreSource.NewWithIdentifiersAndData(ctx, ids, role))
+}
+
+func registerCreateTarget(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, role ttnpb.ClusterRole) {
+	events.Publish(evtCreateTarget.NewWithIdentifiersAndData(ctx, ids, role))
+}
+
+func registerClaimSuccess(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) {
+	events.Publish(evtClaimSuccess.NewWithIdentifiersAndData(ctx, ids, nil))
+}
+
+func registerClaimFail(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, err error) {
+	events.Publish(evtClaimFail.NewWithIdentifiersAndData(ctx, ids, err))
+}
@@ -0,0 +1,71 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides Redis implementations of interfaces used by deviceclaimingserver.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// AuthorizedApplicationRegistry implements the deviceclaimingserver.AuthorizedApplicationRegistry interface.
+type AuthorizedApplicationRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *AuthorizedApplicationRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.Secret, error) {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "get authorized application").End()
+
+	result := &ttnpb.Secret{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.key(uid)).ScanProto(result); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return result, nil
+}
+
+// Set implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, apiKey *ttnpb.Secret) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set authorized application").End()
+
+	if _, err := ttnredis.SetProto(ctx, r.Redis, r.key(uid), apiKey, 0); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "delete authorized application").End()
+
+	if err := r.Redis.Del(ctx, r.key(uid)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// AuthorizedApplicationRegistry is a registry of applications that authorized the Device Claiming Server to claim
+// their end devices.
+// The registry stores the API key of the application as a secret, which is encrypted by the Device Claiming Server.
+type AuthorizedApplicationRegistry interface {
+	// Get returns the API key of the authorized application by its identifiers.
+	Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.Secret, error)
+	// Set creates or updates the API key of the authorized application.
+	Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, apiKey *ttnpb.Secret) error
+	// Delete deletes the authorization of the application by its identifiers.
+	Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error
+}
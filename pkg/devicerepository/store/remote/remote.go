@@ -287,7 +287,16 @@ func (s *remoteStore) getCodec(ids *ttnpb.EndDeviceVersionIdentifiers, chooseFil
 
 // GetUplinkDecoder retrieves the codec for decoding uplink messages.
 func (s *remoteStore) GetUplinkDecoder(ids *ttnpb.EndDeviceVersionIdentifiers) (*ttnpb.MessagePayloadFormatter, error) {
-	return s.getCodec(ids, func(c EndDeviceCodec) string { return c.UplinkDecoder.FileName })
+	var mapping map[string]string
+	formatter, err := s.getCodec(ids, func(c EndDeviceCodec) string {
+		mapping = c.UplinkDecoder.NormalizedPayloadMapping
+		return c.UplinkDecoder.FileName
+	})
+	if err != nil {
+		return nil, err
+	}
+	formatter.NormalizedPayloadMapping = mapping
+	return formatter, nil
 }
 
 // GetDownlinkDecoder retrieves the codec for decoding downlink messages.
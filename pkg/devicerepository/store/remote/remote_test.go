@@ -379,14 +379,19 @@ func TestRemoteStore(t *testing.T) {
 			}
 		})
 		for _, tc := range []struct {
-			name  string
-			f     func(*ttnpb.EndDeviceVersionIdentifiers) (*ttnpb.MessagePayloadFormatter, error)
-			codec string
+			name    string
+			f       func(*ttnpb.EndDeviceVersionIdentifiers) (*ttnpb.MessagePayloadFormatter, error)
+			codec   string
+			mapping map[string]string
 		}{
 			{
 				name:  "UplinkDecoder",
 				f:     s.GetUplinkDecoder,
 				codec: "// uplink decoder\n",
+				mapping: map[string]string{
+					"air.temperature": "temperature",
+					"battery.voltage": "battery.voltage",
+				},
 			},
 			{
 				name:  "DownlinkDecoder",
@@ -411,8 +416,9 @@ func TestRemoteStore(t *testing.T) {
 				codec, err := tc.f(versionIDs)
 				a.So(err, should.BeNil)
 				a.So(codec, should.Resemble, &ttnpb.MessagePayloadFormatter{
-					Formatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
-					FormatterParameter: tc.codec,
+					Formatter:                ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+					FormatterParameter:       tc.codec,
+					NormalizedPayloadMapping: tc.mapping,
 				})
 			})
 		}
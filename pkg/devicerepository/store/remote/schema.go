@@ -447,7 +447,8 @@ func (p EndDeviceProfile) ToTemplatePB(ids *ttnpb.EndDeviceVersionIdentifiers, i
 // EndDeviceCodec is the format of the `vendor/<vendor>/<codec-id>.yaml` files.
 type EndDeviceCodec struct {
 	UplinkDecoder struct {
-		FileName string `yaml:"fileName"`
+		FileName                 string            `yaml:"fileName"`
+		NormalizedPayloadMapping map[string]string `yaml:"normalizedPayloadMapping"`
 	} `yaml:"uplinkDecoder"`
 	DownlinkEncoder struct {
 		FileName string `yaml:"fileName"`
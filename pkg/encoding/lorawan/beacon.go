@@ -0,0 +1,114 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan
+
+import (
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+)
+
+// BeaconInfoDescNetworkSpecific is the first info descriptor of the gateway specific field of a beacon frame that is
+// network specific. Info descriptors below this value contain the GPS coordinates of the antenna of the gateway.
+const BeaconInfoDescNetworkSpecific = 128
+
+// BeaconPayload is the payload of a Class B beacon frame.
+type BeaconPayload struct {
+	// Time is the number of seconds since GPS epoch of the beacon, modulo 2^32.
+	Time uint32
+	// InfoDesc is the info descriptor of the gateway specific field.
+	InfoDesc uint8
+	// Info is the gateway specific information.
+	Info [6]byte
+}
+
+// BeaconPayloadLength returns the length of the beacon frame in the given band.
+func BeaconPayloadLength(phy band.Band) int {
+	return phy.Beacon.RFU1Size + 4 + 2 + 7 + phy.Beacon.RFU2Size + 2
+}
+
+// beaconCRC computes the CRC-16 (CCITT polynomial 0x1021, initial value 0) of the given bytes.
+func beaconCRC(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// AppendBeaconPayload appends encoded msg to dst.
+func AppendBeaconPayload(phy band.Band, dst []byte, msg BeaconPayload) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, make([]byte, phy.Beacon.RFU1Size)...)
+	dst = appendUint32(dst, msg.Time, 4)
+	dst = appendUint16(dst, beaconCRC(dst[start:]), 2)
+	gwSpecific := len(dst)
+	dst = append(dst, msg.InfoDesc)
+	dst = append(dst, msg.Info[:]...)
+	dst = append(dst, make([]byte, phy.Beacon.RFU2Size)...)
+	dst = appendUint16(dst, beaconCRC(dst[gwSpecific:]), 2)
+	return dst, nil
+}
+
+// MarshalBeaconPayload returns encoded msg.
+func MarshalBeaconPayload(phy band.Band, msg BeaconPayload) ([]byte, error) {
+	return AppendBeaconPayload(phy, make([]byte, 0, BeaconPayloadLength(phy)), msg)
+}
+
+// UnmarshalBeaconPayload decodes data into msg.
+func UnmarshalBeaconPayload(phy band.Band, b []byte, msg *BeaconPayload) error {
+	if n := BeaconPayloadLength(phy); len(b) != n {
+		return errExpectedLengthEqual("BeaconPayload", n)(len(b))
+	}
+	n := phy.Beacon.RFU1Size + 4
+	if beaconCRC(b[:n]) != uint16(parseUint32(b[n:n+2])) {
+		return errFailedDecoding("CRC1")
+	}
+	gwSpecific := n + 2
+	n = gwSpecific + 7 + phy.Beacon.RFU2Size
+	if beaconCRC(b[gwSpecific:n]) != uint16(parseUint32(b[n:n+2])) {
+		return errFailedDecoding("CRC2")
+	}
+	msg.Time = parseUint32(b[phy.Beacon.RFU1Size : phy.Beacon.RFU1Size+4])
+	msg.InfoDesc = b[gwSpecific]
+	copy(msg.Info[:], b[gwSpecific+1:gwSpecific+7])
+	return nil
+}
+
+// BeaconGPSCoordinates returns the gateway specific information that contains the given GPS coordinates, in degrees.
+func BeaconGPSCoordinates(latitude, longitude float64) (info [6]byte) {
+	encode := func(v, max float64) uint32 {
+		return uint32(int32(math.Max(math.Min(v/max*(1<<23), 1<<23-1), -1<<23)))
+	}
+	lat, lng := encode(latitude, 90), encode(longitude, 180)
+	copy(info[:3], appendUint32(nil, lat, 3))
+	copy(info[3:], appendUint32(nil, lng, 3))
+	return info
+}
+
+// ParseBeaconGPSCoordinates returns the GPS coordinates, in degrees, of the given gateway specific information.
+func ParseBeaconGPSCoordinates(info [6]byte) (latitude, longitude float64) {
+	decode := func(b []byte, max float64) float64 {
+		return float64(int32(parseUint32(b)<<8)>>8) / (1 << 23) * max
+	}
+	return decode(info[:3], 90), decode(info[3:], 180)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBeaconPayload(t *testing.T) {
+	for _, tc := range []struct {
+		BandID  string
+		Payload BeaconPayload
+		Bytes   []byte
+	}{
+		{
+			BandID: band.EU_863_870,
+			Payload: BeaconPayload{
+				Time:     1334880128,
+				InfoDesc: 0,
+				Info:     BeaconGPSCoordinates(52.3758916, 4.8942),
+			},
+			Bytes: []byte{
+				0x00, 0x00, // RFU
+				0x80, 0xa7, 0x90, 0x4f, // Time
+				0xf4, 0x44, // CRC
+				0x00,                               // InfoDesc
+				0x7a, 0x7d, 0x4a, 0xf6, 0x7a, 0x03, // Info
+				0x56, 0x7f, // CRC
+			},
+		},
+		{
+			BandID: band.US_902_928,
+			Payload: BeaconPayload{
+				Time:     1334880128,
+				InfoDesc: 0,
+				Info:     BeaconGPSCoordinates(52.3758916, 4.8942),
+			},
+			Bytes: []byte{
+				0x00, 0x00, 0x00, 0x00, 0x00, // RFU
+				0x80, 0xa7, 0x90, 0x4f, // Time
+				0xf4, 0x44, // CRC
+				0x00,                               // InfoDesc
+				0x7a, 0x7d, 0x4a, 0xf6, 0x7a, 0x03, // Info
+				0x00, 0x00, 0x00, // RFU
+				0x20, 0x50, // CRC
+			},
+		},
+	} {
+		t.Run(tc.BandID, func(t *testing.T) {
+			a := assertions.New(t)
+			phy, err := band.GetByID(tc.BandID)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			b, err := MarshalBeaconPayload(phy, tc.Payload)
+			a.So(err, should.BeNil)
+			a.So(b, should.Resemble, tc.Bytes)
+			a.So(b, should.HaveLength, BeaconPayloadLength(phy))
+
+			var pld BeaconPayload
+			a.So(UnmarshalBeaconPayload(phy, tc.Bytes, &pld), should.BeNil)
+			a.So(pld, should.Resemble, tc.Payload)
+
+			lat, lng := ParseBeaconGPSCoordinates(pld.Info)
+			a.So(lat, should.AlmostEqual, 52.3758916, 0.0001)
+			a.So(lng, should.AlmostEqual, 4.8942, 0.0001)
+
+			corrupted := append([]byte(nil), tc.Bytes...)
+			corrupted[len(corrupted)-3] ^= 0xff
+			a.So(UnmarshalBeaconPayload(phy, corrupted, &pld), should.NotBeNil)
+			a.So(UnmarshalBeaconPayload(phy, tc.Bytes[1:], &pld), should.NotBeNil)
+		})
+	}
+}
+
+func TestBeaconGPSCoordinates(t *testing.T) {
+	a := assertions.New(t)
+	for _, tc := range []struct {
+		Latitude, Longitude float64
+	}{
+		{Latitude: 0, Longitude: 0},
+		{Latitude: -33.8688, Longitude: 151.2093},
+		{Latitude: 40.7128, Longitude: -74.006},
+		{Latitude: -90, Longitude: -180},
+		{Latitude: 90, Longitude: 180},
+	} {
+		lat, lng := ParseBeaconGPSCoordinates(BeaconGPSCoordinates(tc.Latitude, tc.Longitude))
+		a.So(lat, should.AlmostEqual, tc.Latitude, 0.0001)
+		a.So(lng, should.AlmostEqual, tc.Longitude, 0.0001)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
 
 // fractStep defines (1/2)^8 second step used in DeviceTimeAns payload.
@@ -903,6 +904,396 @@ var DefaultMACCommands = MACCommandSpec{
 			return nil
 		}),
 	},
+
+	ttnpb.CID_RELAY_CONF: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfAns()
+			var v byte
+			if pld.SecondChannelFrequencyAck {
+				v |= 1
+			}
+			if pld.SecondChannelAckOffsetAck {
+				v |= 1 << 1
+			}
+			if pld.SecondChannelDataRateIndexAck {
+				v |= 1 << 2
+			}
+			if pld.SecondChannelIndexAck {
+				v |= 1 << 3
+			}
+			if pld.DefaultChannelIndexAck {
+				v |= 1 << 4
+			}
+			if pld.CADPeriodicityAck {
+				v |= 1 << 5
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CONF, "RelayConfAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayConfAns_{
+				RelayConfAns: &ttnpb.MACCommand_RelayConfAns{
+					SecondChannelFrequencyAck:     b[0]&1 == 1,
+					SecondChannelAckOffsetAck:     (b[0]>>1)&1 == 1,
+					SecondChannelDataRateIndexAck: (b[0]>>2)&1 == 1,
+					SecondChannelIndexAck:         (b[0]>>3)&1 == 1,
+					DefaultChannelIndexAck:        (b[0]>>4)&1 == 1,
+					CADPeriodicityAck:             (b[0]>>5)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 5,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfReq()
+			if pld.CADPeriodicity > 7 {
+				return nil, errExpectedLowerOrEqual("CADPeriodicity", 7)(pld.CADPeriodicity)
+			}
+			if pld.DefaultChannelIndex > 3 {
+				return nil, errExpectedLowerOrEqual("DefaultChIdx", 3)(pld.DefaultChannelIndex)
+			}
+			settings, freq, err := relaySecondChannelSettings(phy, pld.SecondChannel)
+			if err != nil {
+				return nil, err
+			}
+			settings |= uint16(boolToByte(pld.Enable))<<13 | uint16(pld.CADPeriodicity)<<10 | uint16(pld.DefaultChannelIndex)<<8
+			b = appendUint16(b, settings, 2)
+			b = append(b, freq...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CONF, "RelayConfReq", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			settings := uint16(parseUint32(b[0:2]))
+			cmd.Payload = &ttnpb.MACCommand_RelayConfReq_{
+				RelayConfReq: &ttnpb.MACCommand_RelayConfReq{
+					Enable:              (settings>>13)&1 == 1,
+					CADPeriodicity:      ttnpb.RelayCADPeriodicity((settings >> 10) & 0x7),
+					DefaultChannelIndex: uint32((settings >> 8) & 0x3),
+					SecondChannel:       parseRelaySecondChannel(phy, settings, b[2:5]),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_END_DEVICE_CONF: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayEndDeviceConfAns()
+			var v byte
+			if pld.SecondChannelAckOffsetAck {
+				v |= 1
+			}
+			if pld.SecondChannelDataRateIndexAck {
+				v |= 1 << 1
+			}
+			if pld.SecondChannelFrequencyAck {
+				v |= 1 << 2
+			}
+			if pld.BackoffAck {
+				v |= 1 << 3
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_END_DEVICE_CONF, "RelayEndDeviceConfAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayEndDeviceConfAns_{
+				RelayEndDeviceConfAns: &ttnpb.MACCommand_RelayEndDeviceConfAns{
+					SecondChannelAckOffsetAck:     b[0]&1 == 1,
+					SecondChannelDataRateIndexAck: (b[0]>>1)&1 == 1,
+					SecondChannelFrequencyAck:     (b[0]>>2)&1 == 1,
+					BackoffAck:                    (b[0]>>3)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 6,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayEndDeviceConfReq()
+			if pld.Mode > 3 {
+				return nil, errExpectedLowerOrEqual("RelayMode", 3)(pld.Mode)
+			}
+			if pld.SmartEnableLevel > 3 {
+				return nil, errExpectedLowerOrEqual("SmartEnableLevel", 3)(pld.SmartEnableLevel)
+			}
+			if pld.Backoff > 63 {
+				return nil, errExpectedLowerOrEqual("Backoff", 63)(pld.Backoff)
+			}
+			settings, freq, err := relaySecondChannelSettings(phy, pld.SecondChannel)
+			if err != nil {
+				return nil, err
+			}
+			settings |= uint16(pld.Backoff) << 8
+			b = append(b, byte(pld.Mode)<<2|byte(pld.SmartEnableLevel))
+			b = appendUint16(b, settings, 2)
+			b = append(b, freq...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_END_DEVICE_CONF, "RelayEndDeviceConfReq", 6, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			settings := uint16(parseUint32(b[1:3]))
+			cmd.Payload = &ttnpb.MACCommand_RelayEndDeviceConfReq_{
+				RelayEndDeviceConfReq: &ttnpb.MACCommand_RelayEndDeviceConfReq{
+					Mode:             ttnpb.RelayEndDeviceMode((b[0] >> 2) & 0x3),
+					SmartEnableLevel: ttnpb.RelaySmartEnableLevel(b[0] & 0x3),
+					Backoff:          uint32((settings >> 8) & 0x3f),
+					SecondChannel:    parseRelaySecondChannel(phy, settings, b[3:6]),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_FILTER_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayFilterListAns()
+			var v byte
+			if pld.CombinedRulesAck {
+				v |= 1
+			}
+			if pld.ActionAck {
+				v |= 1 << 1
+			}
+			if pld.IndexAck {
+				v |= 1 << 2
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_FILTER_LIST, "RelayFilterListAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayFilterListAns_{
+				RelayFilterListAns: &ttnpb.MACCommand_RelayFilterListAns{
+					CombinedRulesAck: b[0]&1 == 1,
+					ActionAck:        (b[0]>>1)&1 == 1,
+					IndexAck:         (b[0]>>2)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 17,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayFilterListReq()
+			if pld.Index > 15 {
+				return nil, errExpectedLowerOrEqual("FilterListIdx", 15)(pld.Index)
+			}
+			if pld.Action > 3 {
+				return nil, errExpectedLowerOrEqual("FilterListAction", 3)(pld.Action)
+			}
+			b = append(b, byte(pld.Action)<<4|byte(pld.Index))
+			b = appendReverse(b, pld.JoinEUI[:]...)
+			b = appendReverse(b, pld.DevEUI[:]...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_FILTER_LIST, "RelayFilterListReq", 17, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			pld := &ttnpb.MACCommand_RelayFilterListReq{
+				Index:  uint32(b[0] & 0xf),
+				Action: ttnpb.RelayFilterListAction((b[0] >> 4) & 0x3),
+			}
+			copyReverse(pld.JoinEUI[:], b[1:9])
+			copyReverse(pld.DevEUI[:], b[9:17])
+			cmd.Payload = &ttnpb.MACCommand_RelayFilterListReq_{
+				RelayFilterListReq: pld,
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_UPDATE_UPLINK_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		AppendUplink: func(phy band.Band, b []byte, _ ttnpb.MACCommand) ([]byte, error) {
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, "RelayUpdateUplinkListAns", 0, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayUpdateUplinkListAns_{
+				RelayUpdateUplinkListAns: &ttnpb.MACCommand_RelayUpdateUplinkListAns{},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 26,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayUpdateUplinkListReq()
+			if pld.RuleIndex > 15 {
+				return nil, errExpectedLowerOrEqual("UplinkListIdx", 15)(pld.RuleIndex)
+			}
+			if pld.RootWorSKey == nil {
+				return nil, errMissing("RootWorSKey")
+			}
+			bucketSize, reloadRate, err := relayForwardLimits("Uplink", pld.ForwardLimits, 63)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, byte(pld.RuleIndex), byte(bucketSize<<6|reloadRate))
+			b = appendReverse(b, pld.DevAddr[:]...)
+			b = appendUint32(b, pld.WFCnt, 4)
+			b = append(b, pld.RootWorSKey[:]...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, "RelayUpdateUplinkListReq", 26, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			pld := &ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex: uint32(b[0] & 0xf),
+				ForwardLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RelayLimitBucketSize((b[1] >> 6) & 0x3),
+					ReloadRate: uint32(b[1] & 0x3f),
+				},
+				WFCnt:       parseUint32(b[6:10]),
+				RootWorSKey: &types.AES128Key{},
+			}
+			copyReverse(pld.DevAddr[:], b[2:6])
+			copy(pld.RootWorSKey[:], b[10:26])
+			cmd.Payload = &ttnpb.MACCommand_RelayUpdateUplinkListReq_{
+				RelayUpdateUplinkListReq: pld,
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_CTRL_UPLINK_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 5,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayCtrlUplinkListAns()
+			var v byte
+			if pld.RuleIndexAck {
+				v |= 1
+			}
+			b = append(b, v)
+			b = appendUint32(b, pld.WFCnt, 4)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, "RelayCtrlUplinkListAns", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayCtrlUplinkListAns_{
+				RelayCtrlUplinkListAns: &ttnpb.MACCommand_RelayCtrlUplinkListAns{
+					RuleIndexAck: b[0]&1 == 1,
+					WFCnt:        parseUint32(b[1:5]),
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 1,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayCtrlUplinkListReq()
+			if pld.RuleIndex > 15 {
+				return nil, errExpectedLowerOrEqual("UplinkListIdx", 15)(pld.RuleIndex)
+			}
+			if pld.Action > 1 {
+				return nil, errExpectedLowerOrEqual("CtrlAction", 1)(pld.Action)
+			}
+			b = append(b, byte(pld.Action)<<4|byte(pld.RuleIndex))
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, "RelayCtrlUplinkListReq", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayCtrlUplinkListReq_{
+				RelayCtrlUplinkListReq: &ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					RuleIndex: uint32(b[0] & 0xf),
+					Action:    ttnpb.RelayCtrlUplinkListAction((b[0] >> 4) & 0xf),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		AppendUplink: func(phy band.Band, b []byte, _ ttnpb.MACCommand) ([]byte, error) {
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, "RelayConfigureFwdLimitAns", 0, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayConfigureFwdLimitAns_{
+				RelayConfigureFwdLimitAns: &ttnpb.MACCommand_RelayConfigureFwdLimitAns{},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 5,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfigureFwdLimitReq()
+			if pld.ResetLimitCounter > 3 {
+				return nil, errExpectedLowerOrEqual("ResetLimitCounter", 3)(pld.ResetLimitCounter)
+			}
+			joinBucket, joinReload, err := relayForwardLimits("JoinReq", pld.JoinRequestLimits, 127)
+			if err != nil {
+				return nil, err
+			}
+			notifyBucket, notifyReload, err := relayForwardLimits("Notify", pld.NotifyLimits, 127)
+			if err != nil {
+				return nil, err
+			}
+			globalBucket, globalReload, err := relayForwardLimits("GlobalUplink", pld.GlobalUplinkLimits, 127)
+			if err != nil {
+				return nil, err
+			}
+			overallBucket, overallReload, err := relayForwardLimits("Overall", pld.OverallLimits, 127)
+			if err != nil {
+				return nil, err
+			}
+			b = appendUint32(b, uint32(pld.ResetLimitCounter)<<28|joinReload<<21|notifyReload<<14|globalReload<<7|overallReload, 4)
+			b = append(b, byte(joinBucket<<6|notifyBucket<<4|globalBucket<<2|overallBucket))
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, "RelayConfigureFwdLimitReq", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			reload := parseUint32(b[0:4])
+			limits := func(reloadShift, bucketShift uint) *ttnpb.RelayForwardLimits {
+				return &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RelayLimitBucketSize((b[4] >> bucketShift) & 0x3),
+					ReloadRate: (reload >> reloadShift) & 0x7f,
+				}
+			}
+			cmd.Payload = &ttnpb.MACCommand_RelayConfigureFwdLimitReq_{
+				RelayConfigureFwdLimitReq: &ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+					ResetLimitCounter:  ttnpb.RelayResetLimitCounter((reload >> 28) & 0x3),
+					JoinRequestLimits:  limits(21, 6),
+					NotifyLimits:       limits(14, 4),
+					GlobalUplinkLimits: limits(7, 2),
+					OverallLimits:      limits(0, 0),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE: &MACCommandDescriptor{
+		InitiatedByDevice: true,
+
+		UplinkLength: 6,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayNotifyNewEndDeviceReq()
+			if pld.SNR < -20 || pld.SNR > 11 {
+				return nil, errExpectedBetween("SNR", -20, 11)(pld.SNR)
+			}
+			if pld.RSSI < -142 || pld.RSSI > -15 {
+				return nil, errExpectedBetween("RSSI", -142, -15)(pld.RSSI)
+			}
+			b = appendReverse(b, pld.DevAddr[:]...)
+			b = appendUint16(b, uint16(-pld.RSSI-15)<<5|uint16(pld.SNR+20), 2)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE, "RelayNotifyNewEndDeviceReq", 6, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			powerLevel := parseUint32(b[4:6])
+			pld := &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				SNR:  int32(powerLevel&0x1f) - 20,
+				RSSI: -int32((powerLevel>>5)&0x7f) - 15,
+			}
+			copyReverse(pld.DevAddr[:], b[0:4])
+			cmd.Payload = &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq_{
+				RelayNotifyNewEndDeviceReq: pld,
+			}
+			return nil
+		}),
+	},
 }
 
 var (
@@ -25,6 +25,7 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -371,6 +372,161 @@ func TestLoRaWANEncodingMAC(t *testing.T) {
 			[]byte{0x20, 0x02},
 			false,
 		},
+		{
+			"RelayConfReq",
+			&ttnpb.MACCommand_RelayConfReq{
+				Enable:              true,
+				CADPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_250_MILLISECONDS,
+				DefaultChannelIndex: 1,
+				SecondChannel: &ttnpb.RelaySecondChannel{
+					AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_400,
+					DataRateIndex: ttnpb.DATA_RATE_3,
+					Frequency:     869100000,
+				},
+			},
+			[]byte{0x40, 0x9a, 0x29, 0x38, 0x9d, 0x84},
+			false,
+		},
+		{
+			"RelayConfAns",
+			&ttnpb.MACCommand_RelayConfAns{
+				SecondChannelFrequencyAck:     true,
+				SecondChannelAckOffsetAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				SecondChannelIndexAck:         true,
+				DefaultChannelIndexAck:        true,
+			},
+			[]byte{0x40, 0x1f},
+			true,
+		},
+		{
+			"RelayEndDeviceConfReq",
+			&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Mode:             ttnpb.RELAY_END_DEVICE_MODE_DYNAMIC,
+				SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_16,
+				Backoff:          4,
+			},
+			[]byte{0x41, 0x09, 0x00, 0x04, 0x00, 0x00, 0x00},
+			false,
+		},
+		{
+			"RelayEndDeviceConfAns",
+			&ttnpb.MACCommand_RelayEndDeviceConfAns{
+				SecondChannelAckOffsetAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				BackoffAck:                    true,
+			},
+			[]byte{0x41, 0x0b},
+			true,
+		},
+		{
+			"RelayFilterListReq",
+			&ttnpb.MACCommand_RelayFilterListReq{
+				Index:   3,
+				Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FILTER,
+				JoinEUI: types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				DevEUI:  types.EUI64{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18},
+			},
+			[]byte{
+				0x42, 0x23,
+				0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+				0x18, 0x17, 0x16, 0x15, 0x14, 0x13, 0x12, 0x11,
+			},
+			false,
+		},
+		{
+			"RelayFilterListAns",
+			&ttnpb.MACCommand_RelayFilterListAns{
+				CombinedRulesAck: true,
+				IndexAck:         true,
+			},
+			[]byte{0x42, 0x05},
+			true,
+		},
+		{
+			"RelayUpdateUplinkListReq",
+			&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex: 1,
+				ForwardLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+					ReloadRate: 10,
+				},
+				DevAddr:     types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				WFCnt:       0x42,
+				RootWorSKey: &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+			},
+			[]byte{
+				0x43, 0x01, 0x8a,
+				0x04, 0x03, 0x02, 0x01,
+				0x42, 0x00, 0x00, 0x00,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+			},
+			false,
+		},
+		{
+			"RelayUpdateUplinkListAns",
+			&ttnpb.MACCommand_RelayUpdateUplinkListAns{},
+			[]byte{0x43},
+			true,
+		},
+		{
+			"RelayCtrlUplinkListReq",
+			&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+				RuleIndex: 3,
+				Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+			},
+			[]byte{0x44, 0x13},
+			false,
+		},
+		{
+			"RelayCtrlUplinkListAns",
+			&ttnpb.MACCommand_RelayCtrlUplinkListAns{
+				RuleIndexAck: true,
+				WFCnt:        0x01020304,
+			},
+			[]byte{0x44, 0x01, 0x04, 0x03, 0x02, 0x01},
+			true,
+		},
+		{
+			"RelayConfigureFwdLimitReq",
+			&ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+				ResetLimitCounter: ttnpb.RELAY_RESET_LIMIT_COUNTER_RELOAD_RATE,
+				JoinRequestLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_2,
+					ReloadRate: 10,
+				},
+				NotifyLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+					ReloadRate: 20,
+				},
+				GlobalUplinkLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_12,
+					ReloadRate: 30,
+				},
+				OverallLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_1,
+					ReloadRate: 127,
+				},
+			},
+			[]byte{0x45, 0x7f, 0x0f, 0x45, 0x11, 0x6c},
+			false,
+		},
+		{
+			"RelayConfigureFwdLimitAns",
+			&ttnpb.MACCommand_RelayConfigureFwdLimitAns{},
+			[]byte{0x45},
+			true,
+		},
+		{
+			"RelayNotifyNewEndDeviceReq",
+			&ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				SNR:     -5,
+				RSSI:    -80,
+			},
+			[]byte{0x46, 0x04, 0x03, 0x02, 0x01, 0x2f, 0x08},
+			true,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
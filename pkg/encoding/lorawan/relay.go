@@ -0,0 +1,130 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// RelayFPort is the FPort used by relays to exchange forwarded uplinks and downlinks with the Network Server.
+const RelayFPort = 226
+
+// RelayForwardUplinkReq is an uplink forwarded by a relay on behalf of an end device.
+type RelayForwardUplinkReq struct {
+	// DataRateIndex is the data rate at which the relay received the uplink.
+	DataRateIndex ttnpb.DataRateIndex
+	// SNR is the signal-to-noise ratio measured by the relay (dB; [-20, 11]).
+	SNR int32
+	// RSSI is the received signal strength measured by the relay (dBm; [-142, -15]).
+	RSSI int32
+	// WORChannel is the wake on radio channel on which the relay received the uplink.
+	WORChannel ttnpb.RelayWORChannel
+	// Frequency is the frequency at which the relay received the uplink (Hz).
+	Frequency uint64
+	// RawPayload is the PHYPayload of the forwarded uplink.
+	RawPayload []byte
+}
+
+func appendRelayFrequency(dst []byte, phy band.Band, name string, freq uint64) ([]byte, error) {
+	if freq < 100000 || freq > maxUint24*phy.FreqMultiplier {
+		return nil, errExpectedBetween(name, 100000, maxUint24*phy.FreqMultiplier)(freq)
+	}
+	return appendUint64(dst, freq/phy.FreqMultiplier, 3), nil
+}
+
+// relaySecondChannelSettings returns the lower 8 bits of the channel settings field and the
+// frequency field of the relay configuration MAC commands.
+func relaySecondChannelSettings(phy band.Band, sc *ttnpb.RelaySecondChannel) (uint16, []byte, error) {
+	if sc == nil {
+		return 0, []byte{0, 0, 0}, nil
+	}
+	if sc.AckOffset > 7 {
+		return 0, nil, errExpectedLowerOrEqual("SecondChAckOffset", 7)(sc.AckOffset)
+	}
+	if sc.DataRateIndex > 15 {
+		return 0, nil, errExpectedLowerOrEqual("SecondChDataRate", 15)(sc.DataRateIndex)
+	}
+	freq, err := appendRelayFrequency(make([]byte, 0, 3), phy, "SecondChFrequency", sc.Frequency)
+	if err != nil {
+		return 0, nil, err
+	}
+	return 1<<7 | uint16(sc.DataRateIndex)<<3 | uint16(sc.AckOffset), freq, nil
+}
+
+func parseRelaySecondChannel(phy band.Band, settings uint16, freq []byte) *ttnpb.RelaySecondChannel {
+	if (settings>>7)&1 == 0 {
+		return nil
+	}
+	return &ttnpb.RelaySecondChannel{
+		AckOffset:     ttnpb.RelaySecondChAckOffset(settings & 0x7),
+		DataRateIndex: ttnpb.DataRateIndex((settings >> 3) & 0xf),
+		Frequency:     parseUint64(freq) * phy.FreqMultiplier,
+	}
+}
+
+// relayForwardLimits returns the bucket size and reload rate of l.
+// A nil l is encoded as the highest reload rate, which disables the limit.
+func relayForwardLimits(name string, l *ttnpb.RelayForwardLimits, maxReloadRate uint32) (uint32, uint32, error) {
+	if l == nil {
+		return 0, maxReloadRate, nil
+	}
+	if l.BucketSize > 3 {
+		return 0, 0, errExpectedLowerOrEqual(name+"BucketSize", 3)(l.BucketSize)
+	}
+	if l.ReloadRate > maxReloadRate {
+		return 0, 0, errExpectedLowerOrEqual(name+"ReloadRate", maxReloadRate)(l.ReloadRate)
+	}
+	return uint32(l.BucketSize), l.ReloadRate, nil
+}
+
+// AppendRelayForwardUplinkReq appends encoded req to dst.
+func AppendRelayForwardUplinkReq(phy band.Band, dst []byte, req RelayForwardUplinkReq) ([]byte, error) {
+	if req.DataRateIndex > 15 {
+		return nil, errExpectedLowerOrEqual("DataRate", 15)(req.DataRateIndex)
+	}
+	if req.SNR < -20 || req.SNR > 11 {
+		return nil, errExpectedBetween("UplinkSNR", -20, 11)(req.SNR)
+	}
+	if req.RSSI < -142 || req.RSSI > -15 {
+		return nil, errExpectedBetween("UplinkRSSI", -142, -15)(req.RSSI)
+	}
+	if req.WORChannel > 3 {
+		return nil, errExpectedLowerOrEqual("WORChannel", 3)(req.WORChannel)
+	}
+	dst = appendUint32(dst, uint32(req.WORChannel)<<16|uint32(-req.RSSI-15)<<9|uint32(req.SNR+20)<<4|uint32(req.DataRateIndex), 3)
+	dst, err := appendRelayFrequency(dst, phy, "Frequency", req.Frequency)
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, req.RawPayload...), nil
+}
+
+// UnmarshalRelayForwardUplinkReq unmarshals b into req.
+func UnmarshalRelayForwardUplinkReq(phy band.Band, b []byte, req *RelayForwardUplinkReq) error {
+	if n := len(b); n < 7 {
+		return errExpectedLengthHigherOrEqual("ForwardUplinkReq", 7)(n)
+	}
+	md := parseUint32(b[0:3])
+	*req = RelayForwardUplinkReq{
+		DataRateIndex: ttnpb.DataRateIndex(md & 0xf),
+		SNR:           int32((md>>4)&0x1f) - 20,
+		RSSI:          -int32((md>>9)&0x7f) - 15,
+		WORChannel:    ttnpb.RelayWORChannel((md >> 16) & 0x3),
+		Frequency:     parseUint64(b[3:6]) * phy.FreqMultiplier,
+		RawPayload:    append([]byte(nil), b[6:]...),
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRelayForwardUplinkReq(t *testing.T) {
+	phy := test.Must(test.Must(band.GetByID(band.EU_863_870)).(band.Band).Version(ttnpb.PHY_V1_0_3_REV_A)).(band.Band)
+
+	req := RelayForwardUplinkReq{
+		DataRateIndex: ttnpb.DATA_RATE_5,
+		SNR:           3,
+		RSSI:          -60,
+		WORChannel:    ttnpb.RELAY_WOR_CHANNEL_SECONDARY,
+		Frequency:     868100000,
+		RawPayload:    []byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x42, 0x01, 0x02, 0x03, 0x04},
+	}
+	b := []byte{
+		0x75, 0x5b, 0x01,
+		0x28, 0x76, 0x84,
+		0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x42, 0x01, 0x02, 0x03, 0x04,
+	}
+
+	t.Run("Append", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := AppendRelayForwardUplinkReq(phy, nil, req)
+		if a.So(err, should.BeNil) {
+			a.So(res, should.Resemble, b)
+		}
+
+		invalid := req
+		invalid.SNR = 12
+		_, err = AppendRelayForwardUplinkReq(phy, nil, invalid)
+		a.So(err, should.NotBeNil)
+
+		invalid = req
+		invalid.RSSI = -10
+		_, err = AppendRelayForwardUplinkReq(phy, nil, invalid)
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		a := assertions.New(t)
+		var res RelayForwardUplinkReq
+		if a.So(UnmarshalRelayForwardUplinkReq(phy, b, &res), should.BeNil) {
+			a.So(res, should.Resemble, req)
+		}
+		a.So(UnmarshalRelayForwardUplinkReq(phy, b[:6], &res), should.NotBeNil)
+	})
+}
@@ -34,9 +34,15 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-const workersPerCPU = 2
+const (
+	workersPerCPU = 2
+
+	// historyBufferSize is the number of live events that are buffered while historical events are sent.
+	historyBufferSize = 64
+)
 
 // NewEventsServer returns a new EventsServer on the given PubSub.
+// If the PubSub is an events.HistoryPubSub, historical events are sent when requested.
 func NewEventsServer(ctx context.Context, pubsub events.PubSub) *EventsServer {
 	srv := &EventsServer{
 		ctx:    ctx,
@@ -109,13 +115,29 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 
 	srv.subscribe()
 
-	ch := make(events.Channel, 8)
+	wantHistory := req.Tail > 0 || req.After != nil
+
+	// Live events are buffered while the historical events are being sent.
+	bufSize := 8
+	if wantHistory {
+		bufSize = historyBufferSize
+	}
+	ch := make(events.Channel, bufSize)
 	handler := events.ContextHandler(ctx, ch)
 	srv.filter.Subscribe(ctx, req, handler)
 	defer srv.filter.Unsubscribe(ctx, req, handler)
 
-	if req.Tail > 0 || req.After != nil {
-		warning.Add(ctx, "Historical events not implemented")
+	var history []events.Event
+	if wantHistory {
+		if store, ok := srv.pubsub.(events.HistoryPubSub); ok {
+			var err error
+			history, err = store.FetchHistory(ctx, req.Identifiers, req.After, int(req.Tail))
+			if err != nil {
+				return err
+			}
+		} else {
+			warning.Add(ctx, "Historical events not implemented")
+		}
 	}
 
 	if err := stream.SendHeader(metadata.MD{}); err != nil {
@@ -137,11 +159,34 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 		return err
 	}
 
+	// Live events that were received while fetching the history may also be part of the history.
+	sent := make(map[string]struct{}, len(history))
+	for _, evt := range history {
+		isVisible, err := rightsutil.EventIsVisible(ctx, evt)
+		if err != nil {
+			return err
+		}
+		if !isVisible {
+			continue
+		}
+		proto, err := events.Proto(evt)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(proto); err != nil {
+			return err
+		}
+		sent[evt.UniqueID()] = struct{}{}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case evt := <-ch:
+			if _, ok := sent[evt.UniqueID()]; ok {
+				continue
+			}
 			isVisible, err := rightsutil.EventIsVisible(ctx, evt)
 			if err != nil {
 				return err
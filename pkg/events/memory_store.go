@@ -0,0 +1,110 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// NewMemoryStore returns a new Store that keeps the history of events in memory.
+// The history of each entity is limited to entityCount events, which are kept for the given TTL.
+// If the TTL is zero, events do not expire and are only removed when the entityCount is exceeded.
+func NewMemoryStore(ttl time.Duration, entityCount int) Store {
+	return &memoryStore{
+		ttl:         ttl,
+		entityCount: entityCount,
+		history:     make(map[string][]Event),
+		timeNow:     time.Now,
+	}
+}
+
+type memoryStore struct {
+	ttl         time.Duration
+	entityCount int
+	timeNow     func() time.Time
+
+	mu          sync.RWMutex
+	history     map[string][]Event
+	lastCleanup time.Time
+}
+
+func memoryStoreKey(ctx context.Context, ids *ttnpb.EntityIdentifiers) string {
+	return ids.Identifiers().EntityType() + ":" + unique.ID(ctx, ids)
+}
+
+// expired returns the index of the first event in the history that did not expire.
+func (s *memoryStore) expired(evts []Event, now time.Time) int {
+	if s.ttl <= 0 {
+		return 0
+	}
+	for i, evt := range evts {
+		if now.Sub(evt.Time()) < s.ttl {
+			return i
+		}
+	}
+	return len(evts)
+}
+
+// cleanup removes the expired events of all entities. It is called at most once per TTL.
+// The caller must hold the write lock.
+func (s *memoryStore) cleanup(now time.Time) {
+	if s.ttl <= 0 || now.Sub(s.lastCleanup) < s.ttl {
+		return
+	}
+	for key, evts := range s.history {
+		if i := s.expired(evts, now); i == len(evts) {
+			delete(s.history, key)
+		} else if i > 0 {
+			s.history[key] = append(evts[:0:0], evts[i:]...)
+		}
+	}
+	s.lastCleanup = now
+}
+
+// Store implements the Store interface.
+func (s *memoryStore) Store(ctx context.Context, evt Event) error {
+	now := s.timeNow()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cleanup(now)
+	for _, ids := range HistoryIdentifiers(evt) {
+		key := memoryStoreKey(ctx, ids)
+		evts := s.history[key]
+		evts = evts[s.expired(evts, now):]
+		if s.entityCount > 0 && len(evts) >= s.entityCount {
+			evts = evts[len(evts)-s.entityCount+1:]
+		}
+		s.history[key] = append(evts[:len(evts):len(evts)], evt)
+	}
+	return nil
+}
+
+// FetchHistory implements the Store interface.
+func (s *memoryStore) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error) {
+	now := s.timeNow()
+	s.mu.RLock()
+	var evts []Event
+	for _, entityIDs := range ids {
+		history := s.history[memoryStoreKey(ctx, entityIDs)]
+		evts = append(evts, history[s.expired(history, now):]...)
+	}
+	s.mu.RUnlock()
+	return SortHistory(evts, after, tail), nil
+}
@@ -48,12 +48,32 @@ var channelDropped = metrics.NewCounterVec(
 	[]string{"name"},
 )
 
+var storeFailed = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "store_failed_total",
+		Help:      "Number of events that failed to be stored in the history",
+	},
+	[]string{"name"},
+)
+
+var storeDropped = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "store_dropped_total",
+		Help:      "Number of events that were not stored in the history because the buffer was full",
+	},
+	[]string{"name"},
+)
+
 func initMetrics(name string) {
 	publishes.WithLabelValues(name).Add(0)
 	subscriptions.WithLabelValues(name).Add(0)
 	channelDropped.WithLabelValues(name).Add(0)
+	storeFailed.WithLabelValues(name).Add(0)
+	storeDropped.WithLabelValues(name).Add(0)
 }
 
 func init() {
-	metrics.MustRegister(publishes, subscriptions, channelDropped)
+	metrics.MustRegister(publishes, subscriptions, channelDropped, storeFailed, storeDropped)
 }
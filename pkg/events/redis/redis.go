@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package redis implements an events.PubSub implementation that uses Redis PubSub.
+// Package redis implements an events.PubSub implementation that uses Redis PubSub,
+// and an events.Store implementation that uses Redis streams.
 package redis
 
 import (
@@ -0,0 +1,132 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const eventKey = "event"
+
+// NewStore returns a new events.Store that keeps the history of events of each entity in a Redis stream.
+// The history of each entity is limited to approximately entityCount events, and expires after the given TTL
+// of inactivity. If the TTL is zero, the history does not expire.
+func NewStore(client *ttnredis.Client, ttl time.Duration, entityCount int) *Store {
+	return &Store{
+		client:      client,
+		ttl:         ttl,
+		entityCount: int64(entityCount),
+	}
+}
+
+// Store is an events.Store with a Redis backend.
+type Store struct {
+	client      *ttnredis.Client
+	ttl         time.Duration
+	entityCount int64
+}
+
+func (s *Store) historyKey(ctx context.Context, ids *ttnpb.EntityIdentifiers) string {
+	entityType := strings.ReplaceAll(ids.Identifiers().EntityType(), " ", "_")
+	return s.client.Key("events", "history", entityType, unique.ID(ctx, ids))
+}
+
+// Store implements the events.Store interface.
+func (s *Store) Store(ctx context.Context, evt events.Event) error {
+	b, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for _, ids := range events.HistoryIdentifiers(evt) {
+			key := s.historyKey(ctx, ids)
+			p.XAdd(ctx, &redis.XAddArgs{
+				Stream:       key,
+				MaxLenApprox: s.entityCount,
+				Values: map[string]interface{}{
+					eventKey: b,
+				},
+			})
+			if s.ttl > 0 {
+				p.PExpire(ctx, key, s.ttl)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// FetchHistory implements the events.Store interface.
+func (s *Store) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+	start := "-"
+	if after != nil {
+		// Stream entry IDs start with the time at which the entry was added in milliseconds.
+		start = strconv.FormatInt(after.UnixNano()/int64(time.Millisecond), 10)
+	}
+	cmds := make([]*redis.XMessageSliceCmd, 0, len(ids))
+	_, err := s.client.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, entityIDs := range ids {
+			key := s.historyKey(ctx, entityIDs)
+			if tail > 0 {
+				cmds = append(cmds, p.XRevRangeN(ctx, key, "+", start, int64(tail)))
+			} else {
+				cmds = append(cmds, p.XRevRange(ctx, key, "+", start))
+			}
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	logger := log.FromContext(ctx)
+	var evts []events.Event
+	for _, cmd := range cmds {
+		msgs, err := cmd.Result()
+		if err != nil {
+			if err == redis.Nil {
+				continue
+			}
+			return nil, ttnredis.ConvertError(err)
+		}
+		for _, msg := range msgs {
+			v, ok := msg.Values[eventKey].(string)
+			if !ok {
+				logger.WithField("id", msg.ID).Warn("Invalid event in history")
+				continue
+			}
+			evt, err := events.UnmarshalJSON([]byte(v))
+			if err != nil {
+				logger.WithError(err).WithField("id", msg.ID).Warn("Failed to unmarshal event from JSON")
+				continue
+			}
+			evts = append(evts, evt)
+		}
+	}
+	return events.SortHistory(evts, after, tail), nil
+}
@@ -0,0 +1,108 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/redis"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRedisStore(t *testing.T) {
+	test.RunTest(t, test.TestConfig{
+		Timeout: 4 * timeout,
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			conf := redisConfig()
+			conf.RootNamespace = append(conf.RootNamespace, t.Name())
+			cl := ttnredis.New(&conf)
+			defer cl.Close()
+
+			store := redis.NewStore(cl, time.Minute, 10)
+
+			appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+			devIDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "test-dev"}
+
+			start := time.Now()
+			for _, name := range []string{"redis.test.evt0", "redis.test.evt1", "redis.test.evt2"} {
+				time.Sleep(test.Delay)
+				a.So(store.Store(ctx, events.New(ctx, name, "redis test event", events.WithIdentifiers(&devIDs))), should.BeNil)
+			}
+
+			names := func(evts []events.Event) []string {
+				names := make([]string, len(evts))
+				for i, evt := range evts {
+					names[i] = evt.Name()
+				}
+				return names
+			}
+
+			evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+			a.So(err, should.BeNil)
+			a.So(names(evts), should.Resemble, []string{"redis.test.evt0", "redis.test.evt1", "redis.test.evt2"})
+
+			evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{devIDs.EntityIdentifiers()}, &start, 2)
+			a.So(err, should.BeNil)
+			a.So(names(evts), should.Resemble, []string{"redis.test.evt1", "redis.test.evt2"})
+
+			evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{(&ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}).EntityIdentifiers()}, nil, 0)
+			a.So(err, should.BeNil)
+			a.So(evts, should.BeEmpty)
+		},
+	})
+}
+
+func TestRedisStoreTTL(t *testing.T) {
+	test.RunTest(t, test.TestConfig{
+		Timeout: 4 * timeout,
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			conf := redisConfig()
+			conf.RootNamespace = append(conf.RootNamespace, t.Name())
+			cl := ttnredis.New(&conf)
+			defer cl.Close()
+
+			appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+			historyKey := cl.Key("events", "history", "application", unique.ID(ctx, appIDs))
+
+			store := redis.NewStore(cl, time.Minute, 10)
+			a.So(store.Store(ctx, events.New(ctx, "redis.test.evt0", "redis test event", events.WithIdentifiers(&appIDs))), should.BeNil)
+			ttl, err := cl.PTTL(ctx, historyKey).Result()
+			a.So(err, should.BeNil)
+			a.So(ttl, should.BeBetweenOrEqual, time.Minute-timeout, time.Minute)
+
+			// A TTL of zero means that the history does not expire.
+			a.So(cl.Del(ctx, historyKey).Err(), should.BeNil)
+			store = redis.NewStore(cl, 0, 10)
+			a.So(store.Store(ctx, events.New(ctx, "redis.test.evt1", "redis test event", events.WithIdentifiers(&appIDs))), should.BeNil)
+			ttl, err = cl.PTTL(ctx, historyKey).Result()
+			a.So(err, should.BeNil)
+			a.So(ttl, should.Equal, time.Duration(-1))
+
+			evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+			a.So(err, should.BeNil)
+			if a.So(evts, should.HaveLength, 1) {
+				a.So(evts[0].Name(), should.Equal, "redis.test.evt1")
+			}
+		},
+	})
+}
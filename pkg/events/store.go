@@ -0,0 +1,142 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Store interface for storing and retrieving the history of events.
+type Store interface {
+	// Store stores the event in the history of each of the entities returned by HistoryIdentifiers.
+	Store(ctx context.Context, evt Event) error
+	// FetchHistory fetches the historical events of the given entities, ordered by time.
+	// If after is non-nil, only events after that time are returned.
+	// If tail is greater than zero, only the most recent tail events are returned.
+	FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error)
+}
+
+// HistoryPubSub is a PubSub that keeps the history of the events it publishes.
+type HistoryPubSub interface {
+	PubSub
+	// FetchHistory fetches the historical events of the given entities. See Store.FetchHistory.
+	FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error)
+}
+
+// HistoryIdentifiers returns the identifiers of the entities in which history the event is stored.
+// Events of end devices are also stored in the history of their application,
+// as subscribers to application events also receive the events of its end devices.
+func HistoryIdentifiers(evt Event) []*ttnpb.EntityIdentifiers {
+	ids := make([]*ttnpb.EntityIdentifiers, 0, len(evt.Identifiers()))
+	seen := make(map[string]struct{}, len(evt.Identifiers()))
+	add := func(entityIDs *ttnpb.EntityIdentifiers) {
+		key := entityIDs.Identifiers().EntityType() + ":" + entityIDs.IDString()
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		ids = append(ids, entityIDs)
+	}
+	for _, entityIDs := range evt.Identifiers() {
+		add(entityIDs)
+		if devIDs := entityIDs.GetDeviceIDs(); devIDs != nil {
+			add(devIDs.ApplicationIdentifiers.EntityIdentifiers())
+		}
+	}
+	return ids
+}
+
+// SortHistory sorts the events by time, removes duplicate events and applies
+// the after and tail limits as described by Store.FetchHistory.
+// This is useful for Store implementations that collect events from multiple entities.
+func SortHistory(evts []Event, after *time.Time, tail int) []Event {
+	seen := make(map[string]struct{}, len(evts))
+	res := evts[:0]
+	for _, evt := range evts {
+		if _, ok := seen[evt.UniqueID()]; ok {
+			continue
+		}
+		seen[evt.UniqueID()] = struct{}{}
+		if after != nil && !evt.Time().After(*after) {
+			continue
+		}
+		res = append(res, evt)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Time().Before(res[j].Time())
+	})
+	if tail > 0 && len(res) > tail {
+		res = res[len(res)-tail:]
+	}
+	return res
+}
+
+// DefaultStoreBufferSize is the default number of events that can be buffered before they are stored.
+const DefaultStoreBufferSize = 1024
+
+// WithStore returns a HistoryPubSub that publishes events to the given PubSub
+// and stores them in the given Store.
+// Events are buffered and stored asynchronously, so that publishing does not block
+// on the store. If the buffer of bufSize events is full, events are published but not stored.
+// The events are stored until ctx is done.
+func WithStore(ctx context.Context, pubsub PubSub, store Store, bufSize uint) HistoryPubSub {
+	ps := &historyPubSub{
+		PubSub: pubsub,
+		store:  store,
+		events: make(chan Event, bufSize),
+	}
+	go ps.run(ctx)
+	return ps
+}
+
+type historyPubSub struct {
+	PubSub
+	store  Store
+	events chan Event
+}
+
+func (ps *historyPubSub) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case evt := <-ps.events:
+			if err := ps.store.Store(ctx, evt); err != nil {
+				storeFailed.WithLabelValues(evt.Name()).Inc()
+				log.FromContext(ctx).WithError(err).WithField("event_name", evt.Name()).Warn("Failed to store event")
+			}
+		}
+	}
+}
+
+// Publish implements the Publisher interface.
+func (ps *historyPubSub) Publish(evt Event) {
+	select {
+	case ps.events <- evt:
+	default:
+		storeDropped.WithLabelValues(evt.Name()).Inc()
+	}
+	ps.PubSub.Publish(evt)
+}
+
+// FetchHistory implements the HistoryPubSub interface.
+func (ps *historyPubSub) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error) {
+	return ps.store.FetchHistory(ctx, ids, after, tail)
+}
@@ -0,0 +1,179 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func eventNames(evts []events.Event) []string {
+	names := make([]string, len(evts))
+	for i, evt := range evts {
+		names[i] = evt.Name()
+	}
+	return names
+}
+
+func TestMemoryStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	devIDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "test-dev"}
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
+
+	store := events.NewMemoryStore(time.Minute, 3)
+
+	start := time.Now()
+	for _, tc := range []struct {
+		name string
+		ids  []ttnpb.Identifiers
+	}{
+		{"test.app.1", []ttnpb.Identifiers{&appIDs}},
+		{"test.dev.1", []ttnpb.Identifiers{&devIDs}},
+		{"test.gtw.1", []ttnpb.Identifiers{&gtwIDs}},
+		{"test.dev.2", []ttnpb.Identifiers{&devIDs, &gtwIDs}},
+	} {
+		time.Sleep(test.Delay)
+		a.So(store.Store(ctx, events.New(ctx, tc.name, "test", events.WithIdentifiers(tc.ids...))), should.BeNil)
+	}
+
+	evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{devIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.dev.1", "test.dev.2"})
+
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.app.1", "test.dev.1", "test.dev.2"})
+
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers(), gtwIDs.EntityIdentifiers()}, nil, 2)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.gtw.1", "test.dev.2"})
+
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{devIDs.EntityIdentifiers()}, &start, 0)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.dev.1", "test.dev.2"})
+
+	time.Sleep(test.Delay)
+	a.So(store.Store(ctx, events.New(ctx, "test.app.2", "test", events.WithIdentifiers(&appIDs))), should.BeNil)
+
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.dev.1", "test.dev.2", "test.app.2"})
+
+	now := time.Now()
+	evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, &now, 0)
+	a.So(err, should.BeNil)
+	a.So(evts, should.BeEmpty)
+}
+
+func TestMemoryStoreNoTTL(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+	// A TTL of zero means that the history does not expire, but it is still limited by the entity count.
+	store := events.NewMemoryStore(0, 2)
+
+	for _, name := range []string{"test.app.1", "test.app.2", "test.app.3"} {
+		time.Sleep(test.Delay)
+		a.So(store.Store(ctx, events.New(ctx, name, "test", events.WithIdentifiers(&appIDs))), should.BeNil)
+	}
+	time.Sleep(test.Delay)
+
+	evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.app.2", "test.app.3"})
+}
+
+type blockingStore struct {
+	store events.Store
+	ch    chan struct{}
+}
+
+func (s *blockingStore) Store(ctx context.Context, evt events.Event) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.ch:
+	}
+	return s.store.Store(ctx, evt)
+}
+
+func (s *blockingStore) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+	return s.store.FetchHistory(ctx, ids, after, tail)
+}
+
+func TestWithStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+	store := &blockingStore{
+		store: events.NewMemoryStore(time.Minute, 10),
+		ch:    make(chan struct{}),
+	}
+	pubsub := events.WithStore(ctx, events.NewPubSub(events.DefaultBufferSize), store, 1)
+
+	ch := make(events.Channel, 3)
+	a.So(pubsub.Subscribe("test.**", ch), should.BeNil)
+	defer pubsub.Unsubscribe("test.**", ch)
+
+	// Publishing does not block on the store. The first event is taken by the store,
+	// the second event is buffered and the third event is not stored.
+	published := make(chan struct{})
+	go func() {
+		for _, name := range []string{"test.app.1", "test.app.2", "test.app.3"} {
+			pubsub.Publish(events.New(ctx, name, "test", events.WithIdentifiers(&appIDs)))
+			time.Sleep(test.Delay)
+		}
+		close(published)
+	}()
+	select {
+	case <-published:
+	case <-time.After(test.Delay * 10):
+		t.Fatal("Publish blocked on the store")
+	}
+
+	for _, name := range []string{"test.app.1", "test.app.2", "test.app.3"} {
+		evt := ch.ReceiveTimeout(test.Delay * 10)
+		if a.So(evt, should.NotBeNil) {
+			a.So(evt.Name(), should.Equal, name)
+		}
+	}
+
+	evts, err := pubsub.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(evts, should.BeEmpty)
+
+	store.ch <- struct{}{}
+	store.ch <- struct{}{}
+	time.Sleep(test.Delay)
+
+	evts, err = pubsub.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()}, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(eventNames(evts), should.Resemble, []string{"test.app.1", "test.app.2"})
+}
@@ -0,0 +1,152 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture records the traffic of gateway connections and exports it as packet capture files.
+package capture
+
+import (
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Direction is the direction of a captured packet.
+type Direction uint8
+
+const (
+	// Uplink is the direction of traffic from the gateway to the Gateway Server.
+	Uplink Direction = iota
+	// Downlink is the direction of traffic from the Gateway Server to the gateway.
+	Downlink
+)
+
+// String implements fmt.Stringer.
+func (d Direction) String() string {
+	if d == Downlink {
+		return "downlink"
+	}
+	return "uplink"
+}
+
+// Packet is a captured packet.
+// A packet is either a raw frame of the gateway frontend, or a decoded gateway upstream or downstream message.
+type Packet struct {
+	Time      time.Time
+	Direction Direction
+	// Protocol is the protocol of the frontend that sent or received the raw frame.
+	Protocol string
+	// Raw is the raw frame as sent or received by the frontend.
+	Raw []byte
+	// Up is the decoded upstream message.
+	Up *ttnpb.GatewayUp
+	// Down is the decoded downstream message.
+	Down *ttnpb.GatewayDown
+}
+
+// Capture is a bounded capture of gateway traffic.
+// A capture stops when it reaches the maximum number of packets, when it reaches its end time or when it is stopped.
+// Packets recorded after the capture stopped are discarded.
+type Capture struct {
+	maxPackets int
+	startedAt  time.Time
+	endsAt     time.Time
+
+	mu        sync.RWMutex
+	packets   []Packet
+	stoppedAt *time.Time
+}
+
+// New returns a new capture that starts at the given time and records up to maxPackets packets for the given duration.
+func New(startedAt time.Time, duration time.Duration, maxPackets int) *Capture {
+	return &Capture{
+		maxPackets: maxPackets,
+		startedAt:  startedAt,
+		endsAt:     startedAt.Add(duration),
+		packets:    make([]Packet, 0, maxPackets),
+	}
+}
+
+// stop stops the capture at the given time. The caller must hold the write lock.
+func (c *Capture) stop(t time.Time) {
+	if c.stoppedAt == nil {
+		c.stoppedAt = &t
+	}
+}
+
+// expire stops the capture if it passed the end time at the given time. The caller must hold the write lock.
+func (c *Capture) expire(t time.Time) {
+	if !t.Before(c.endsAt) {
+		c.stop(c.endsAt)
+	}
+}
+
+// Record records the packet. It returns false if the capture is stopped and the packet is discarded.
+func (c *Capture) Record(pkt Packet) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expire(pkt.Time)
+	if c.stoppedAt != nil {
+		return false
+	}
+	c.packets = append(c.packets, pkt)
+	if len(c.packets) >= c.maxPackets {
+		c.stop(pkt.Time)
+	}
+	return true
+}
+
+// Stop stops the capture.
+func (c *Capture) Stop() {
+	now := time.Now()
+	c.mu.Lock()
+	c.expire(now)
+	c.stop(now)
+	c.mu.Unlock()
+}
+
+// Active returns whether the capture records packets.
+func (c *Capture) Active() bool {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expire(now)
+	return c.stoppedAt == nil
+}
+
+// Packets returns the captured packets.
+func (c *Capture) Packets() []Packet {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append(c.packets[:0:0], c.packets...)
+}
+
+// Proto returns the status of the capture.
+func (c *Capture) Proto() *ttnpb.GatewayCapture {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expire(now)
+	res := &ttnpb.GatewayCapture{
+		StartedAt:   c.startedAt,
+		EndsAt:      c.endsAt,
+		PacketCount: uint32(len(c.packets)),
+		MaxPackets:  uint32(c.maxPackets),
+	}
+	if c.stoppedAt != nil {
+		stoppedAt := *c.stoppedAt
+		res.StoppedAt = &stoppedAt
+	}
+	return res
+}
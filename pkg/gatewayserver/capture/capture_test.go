@@ -0,0 +1,72 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCapture(t *testing.T) {
+	start := time.Now()
+
+	t.Run("MaxPackets", func(t *testing.T) {
+		a := assertions.New(t)
+		c := New(start, time.Hour, 2)
+		a.So(c.Active(), should.BeTrue)
+		a.So(c.Record(Packet{Time: start, Raw: []byte{0x1}}), should.BeTrue)
+		a.So(c.Record(Packet{Time: start.Add(time.Second), Raw: []byte{0x2}}), should.BeTrue)
+		a.So(c.Record(Packet{Time: start.Add(2 * time.Second), Raw: []byte{0x3}}), should.BeFalse)
+		a.So(c.Active(), should.BeFalse)
+		a.So(c.Packets(), should.HaveLength, 2)
+
+		pb := c.Proto()
+		a.So(pb.StartedAt, should.Equal, start)
+		a.So(pb.EndsAt, should.Equal, start.Add(time.Hour))
+		a.So(pb.PacketCount, should.Equal, 2)
+		a.So(pb.MaxPackets, should.Equal, 2)
+		if a.So(pb.StoppedAt, should.NotBeNil) {
+			a.So(*pb.StoppedAt, should.Equal, start.Add(time.Second))
+		}
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		a := assertions.New(t)
+		c := New(start, time.Minute, 10)
+		a.So(c.Record(Packet{Time: start.Add(30 * time.Second)}), should.BeTrue)
+		a.So(c.Record(Packet{Time: start.Add(time.Minute)}), should.BeFalse)
+		a.So(c.Packets(), should.HaveLength, 1)
+		pb := c.Proto()
+		if a.So(pb.StoppedAt, should.NotBeNil) {
+			a.So(*pb.StoppedAt, should.Equal, start.Add(time.Minute))
+		}
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		a := assertions.New(t)
+		c := New(start, time.Hour, 10)
+		a.So(c.Record(Packet{Time: start}), should.BeTrue)
+		a.So(c.Proto().StoppedAt, should.BeNil)
+		c.Stop()
+		a.So(c.Active(), should.BeFalse)
+		a.So(c.Record(Packet{Time: start}), should.BeFalse)
+		a.So(c.Packets(), should.HaveLength, 1)
+		a.So(c.Proto().StoppedAt, should.NotBeNil)
+	})
+}
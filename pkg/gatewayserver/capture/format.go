@@ -0,0 +1,38 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"io"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errFormat = errors.DefineInvalidArgument("format", "invalid capture format `{format}`")
+
+// Write writes the packets to w in the given format.
+func Write(w io.Writer, format ttnpb.CaptureFormat, packets []Packet) error {
+	switch format {
+	case ttnpb.CAPTURE_FORMAT_PCAPNG:
+		return WritePCAPNG(w, packets)
+	case ttnpb.CAPTURE_FORMAT_PCAP:
+		return WritePCAP(w, packets)
+	case ttnpb.CAPTURE_FORMAT_NDJSON:
+		return WriteNDJSON(w, packets)
+	default:
+		return errFormat.WithAttributes("format", format)
+	}
+}
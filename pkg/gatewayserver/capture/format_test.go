@@ -0,0 +1,256 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	testTime = time.Unix(1600000000, 123456789)
+
+	testUplink = Packet{
+		Time:      testTime,
+		Direction: Uplink,
+		Up: &ttnpb.GatewayUp{
+			UplinkMessages: []*ttnpb.UplinkMessage{
+				{
+					RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+					Settings: ttnpb.TxSettings{
+						DataRate: ttnpb.DataRate{
+							Modulation: &ttnpb.DataRate_LoRa{
+								LoRa: &ttnpb.LoRaDataRate{
+									Bandwidth:       125000,
+									SpreadingFactor: 7,
+								},
+							},
+						},
+						Frequency: 868100000,
+					},
+					RxMetadata: []*ttnpb.RxMetadata{
+						{RSSI: -100, SNR: -2.5},
+						{RSSI: -50, SNR: 7.25},
+					},
+				},
+			},
+		},
+	}
+	testDownlink = Packet{
+		Time:      testTime.Add(time.Second),
+		Direction: Downlink,
+		Down: &ttnpb.GatewayDown{
+			DownlinkMessage: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x60, 0x01},
+				Settings: &ttnpb.DownlinkMessage_Scheduled{
+					Scheduled: &ttnpb.TxSettings{
+						DataRate: ttnpb.DataRate{
+							Modulation: &ttnpb.DataRate_LoRa{
+								LoRa: &ttnpb.LoRaDataRate{
+									Bandwidth:       500000,
+									SpreadingFactor: 12,
+								},
+							},
+						},
+						Frequency: 923300000,
+					},
+				},
+			},
+		},
+	}
+	testRaw = Packet{
+		Time:      testTime,
+		Direction: Uplink,
+		Protocol:  "udp",
+		Raw:       []byte{0x02, 0x12, 0x34, 0x00},
+	}
+)
+
+func TestLoRaTap(t *testing.T) {
+	a := assertions.New(t)
+
+	frames := loraFrames(testUplink)
+	if !a.So(frames, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(appendLoRaTap(nil, frames[0]), should.Resemble, []byte{
+		0x00, 0x00, 0x00, 0x0f, // Version, padding and length.
+		0x33, 0xbe, 0x27, 0xa0, // Frequency.
+		0x01, 0x07, // Bandwidth and spreading factor.
+		0x59, 0x59, 0x59, 0x1d, // RSSI and SNR.
+		0x34,                         // Sync word.
+		0x40, 0x01, 0x02, 0x03, 0x04, // Payload.
+	})
+
+	frames = loraFrames(testDownlink)
+	if !a.So(frames, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(appendLoRaTap(nil, frames[0]), should.Resemble, []byte{
+		0x00, 0x00, 0x00, 0x0f,
+		0x37, 0x08, 0x70, 0xa0,
+		0x04, 0x0c,
+		0x00, 0x00, 0x00, 0x00,
+		0x34,
+		0x60, 0x01,
+	})
+
+	a.So(loraFrames(testRaw), should.BeEmpty)
+}
+
+func TestWritePCAP(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	a.So(WritePCAP(&buf, []Packet{testRaw, testUplink, testDownlink}), should.BeNil)
+	b := buf.Bytes()
+	if !a.So(len(b), should.Equal, 24+16+15+5+16+15+2) {
+		t.FailNow()
+	}
+	a.So(binary.LittleEndian.Uint32(b[0:4]), should.Equal, pcapMagicNanoseconds)
+	a.So(binary.LittleEndian.Uint32(b[20:24]), should.Equal, linkTypeLoRaTap)
+	a.So(binary.LittleEndian.Uint32(b[24:28]), should.Equal, testTime.Unix())
+	a.So(binary.LittleEndian.Uint32(b[28:32]), should.Equal, testTime.Nanosecond())
+	a.So(binary.LittleEndian.Uint32(b[32:36]), should.Equal, 20)
+	a.So(b[55:60], should.Resemble, testUplink.Up.UplinkMessages[0].RawPayload)
+}
+
+func TestWritePCAPNG(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	a.So(WritePCAPNG(&buf, []Packet{testRaw, testUplink, testDownlink}), should.BeNil)
+
+	type block struct {
+		blockType uint32
+		body      []byte
+	}
+	var blocks []block
+	b := buf.Bytes()
+	for len(b) > 0 {
+		if !a.So(len(b), should.BeGreaterThanOrEqualTo, 12) {
+			t.FailNow()
+		}
+		length := binary.LittleEndian.Uint32(b[4:8])
+		if !a.So(length%4, should.Equal, 0) || !a.So(int(length), should.BeLessThanOrEqualTo, len(b)) {
+			t.FailNow()
+		}
+		a.So(binary.LittleEndian.Uint32(b[length-4:length]), should.Equal, length)
+		blocks = append(blocks, block{binary.LittleEndian.Uint32(b[0:4]), b[8 : length-4]})
+		b = b[length:]
+	}
+	if !a.So(blocks, should.HaveLength, 6) {
+		t.FailNow()
+	}
+	a.So(blocks[0].blockType, should.Equal, pcapngSectionHeaderBlock)
+	a.So(binary.LittleEndian.Uint32(blocks[0].body[0:4]), should.Equal, pcapngByteOrderMagic)
+	a.So(blocks[1].blockType, should.Equal, pcapngInterfaceDescription)
+	a.So(binary.LittleEndian.Uint16(blocks[1].body[0:2]), should.Equal, linkTypeLoRaTap)
+	a.So(blocks[2].blockType, should.Equal, pcapngInterfaceDescription)
+	a.So(binary.LittleEndian.Uint16(blocks[2].body[0:2]), should.Equal, linkTypeUser0)
+
+	for i, expected := range []struct {
+		iface   uint32
+		time    time.Time
+		data    []byte
+		flags   uint32
+		comment string
+	}{
+		{
+			iface:   pcapngFrontendInterface,
+			time:    testRaw.Time,
+			data:    testRaw.Raw,
+			flags:   pcapngFlagsInbound,
+			comment: "udp uplink",
+		},
+		{
+			iface: pcapngLoRaInterface,
+			time:  testUplink.Time,
+			data:  appendLoRaTap(nil, loraFrames(testUplink)[0]),
+			flags: pcapngFlagsInbound,
+		},
+		{
+			iface: pcapngLoRaInterface,
+			time:  testDownlink.Time,
+			data:  appendLoRaTap(nil, loraFrames(testDownlink)[0]),
+			flags: pcapngFlagsOutbound,
+		},
+	} {
+		blk := blocks[3+i]
+		a.So(blk.blockType, should.Equal, pcapngEnhancedPacketBlock)
+		a.So(binary.LittleEndian.Uint32(blk.body[0:4]), should.Equal, expected.iface)
+		ts := uint64(binary.LittleEndian.Uint32(blk.body[4:8]))<<32 | uint64(binary.LittleEndian.Uint32(blk.body[8:12]))
+		a.So(ts, should.Equal, expected.time.UnixNano())
+		n := binary.LittleEndian.Uint32(blk.body[12:16])
+		a.So(blk.body[20:20+n], should.Resemble, expected.data)
+
+		opts := blk.body[20+pad4(int(n)):]
+		options := make(map[uint16][]byte)
+		for len(opts) >= 4 {
+			code, length := binary.LittleEndian.Uint16(opts[0:2]), int(binary.LittleEndian.Uint16(opts[2:4]))
+			if code == pcapngOptionEndOfOptions {
+				break
+			}
+			options[code] = opts[4 : 4+length]
+			opts = opts[4+pad4(length):]
+		}
+		a.So(binary.LittleEndian.Uint32(options[pcapngOptionFlags]), should.Equal, expected.flags)
+		a.So(string(options[pcapngOptionComment]), should.Equal, expected.comment)
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	a.So(WriteNDJSON(&buf, []Packet{testRaw, testUplink, testDownlink}), should.BeNil)
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if !a.So(json.Unmarshal(scanner.Bytes(), &line), should.BeNil) {
+			t.FailNow()
+		}
+		lines = append(lines, line)
+	}
+	if !a.So(lines, should.HaveLength, 3) {
+		t.FailNow()
+	}
+	a.So(lines[0]["direction"], should.Equal, "uplink")
+	a.So(lines[0]["protocol"], should.Equal, "udp")
+	a.So(lines[0]["raw"], should.Equal, "AhI0AA==")
+	a.So(lines[0], should.NotContainKey, "up")
+	a.So(lines[1]["time"], should.Equal, "2020-09-13T12:26:40.123456789Z")
+	a.So(lines[1], should.ContainKey, "up")
+	a.So(lines[1], should.NotContainKey, "raw")
+	a.So(lines[2]["direction"], should.Equal, "downlink")
+	a.So(lines[2], should.ContainKey, "down")
+}
+
+func TestWrite(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	a.So(errFormat.Is(Write(&buf, ttnpb.CaptureFormat(42), nil)), should.BeTrue)
+}
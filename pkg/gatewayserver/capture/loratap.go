@@ -0,0 +1,94 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// linkTypeLoRaTap is the link type of LoRaTap frames.
+	linkTypeLoRaTap = 270
+	// linkTypeUser0 is the link type of the raw frontend frames.
+	linkTypeUser0 = 147
+
+	loraTapHeaderLength = 15
+	loraTapSyncWord     = 0x34
+)
+
+// loraFrame is a LoRa PHY frame.
+type loraFrame struct {
+	direction Direction
+	settings  *ttnpb.TxSettings
+	rssi, snr float32
+	payload   []byte
+}
+
+// loraFrames returns the LoRa PHY frames of the decoded messages in the packet.
+func loraFrames(pkt Packet) []loraFrame {
+	var frames []loraFrame
+	for _, up := range pkt.Up.GetUplinkMessages() {
+		frame := loraFrame{
+			direction: Uplink,
+			settings:  &up.Settings,
+			rssi:      float32(math.Inf(-1)),
+			payload:   up.RawPayload,
+		}
+		for _, md := range up.RxMetadata {
+			if md.RSSI > frame.rssi {
+				frame.rssi, frame.snr = md.RSSI, md.SNR
+			}
+		}
+		frames = append(frames, frame)
+	}
+	if down := pkt.Down.GetDownlinkMessage(); down != nil {
+		frames = append(frames, loraFrame{
+			direction: Downlink,
+			settings:  down.GetScheduled(),
+			payload:   down.RawPayload,
+		})
+	}
+	return frames
+}
+
+// clampUint8 returns v clamped to the uint8 range.
+func clampUint8(v float64) uint8 {
+	return uint8(math.Max(0, math.Min(math.MaxUint8, math.Round(v))))
+}
+
+// appendLoRaTap appends the LoRaTap version 0 header and the payload of the frame to b.
+// See https://github.com/eriknl/LoRaTap for the header format.
+func appendLoRaTap(b []byte, frame loraFrame) []byte {
+	var hdr [loraTapHeaderLength]byte
+	binary.BigEndian.PutUint16(hdr[2:4], loraTapHeaderLength)
+	if frame.settings != nil {
+		binary.BigEndian.PutUint32(hdr[4:8], uint32(frame.settings.Frequency))
+		if lora := frame.settings.DataRate.GetLoRa(); lora != nil {
+			hdr[8] = uint8(lora.Bandwidth / 125000)
+			hdr[9] = uint8(lora.SpreadingFactor)
+		}
+	}
+	if frame.direction == Uplink && !math.IsInf(float64(frame.rssi), -1) {
+		rssi := clampUint8(float64(frame.rssi) + 139)
+		hdr[10], hdr[11], hdr[12] = rssi, rssi, rssi
+		hdr[13] = uint8(int8(math.Max(math.MinInt8, math.Min(math.MaxInt8, math.Round(float64(frame.snr)*4)))))
+	}
+	hdr[14] = loraTapSyncWord
+	b = append(b, hdr[:]...)
+	return append(b, frame.payload...)
+}
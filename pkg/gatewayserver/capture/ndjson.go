@@ -0,0 +1,64 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+)
+
+type ndjsonPacket struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Protocol  string          `json:"protocol,omitempty"`
+	Raw       []byte          `json:"raw,omitempty"`
+	Up        json.RawMessage `json:"up,omitempty"`
+	Down      json.RawMessage `json:"down,omitempty"`
+}
+
+// WriteNDJSON writes the packets to w as newline-delimited JSON.
+// Raw frontend frames are base64 encoded, and decoded messages are encoded with the JSON encoding of the API.
+func WriteNDJSON(w io.Writer, packets []Packet) error {
+	enc := json.NewEncoder(w)
+	for _, pkt := range packets {
+		line := ndjsonPacket{
+			Time:      pkt.Time.UTC(),
+			Direction: pkt.Direction.String(),
+			Protocol:  pkt.Protocol,
+			Raw:       pkt.Raw,
+		}
+		if pkt.Up != nil {
+			buf, err := jsonpb.TTN().Marshal(pkt.Up)
+			if err != nil {
+				return err
+			}
+			line.Up = buf
+		}
+		if pkt.Down != nil {
+			buf, err := jsonpb.TTN().Marshal(pkt.Down)
+			if err != nil {
+				return err
+			}
+			line.Down = buf
+		}
+		if err := enc.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	pcapMagicNanoseconds = 0xa1b23c4d
+	pcapSnapLength       = 65535
+)
+
+// WritePCAP writes the LoRa PHY frames of the decoded messages in the packets to w in the PCAP format with the
+// LoRaTap link type. Raw frontend frames are not written, as PCAP files support only one link type.
+func WritePCAP(w io.Writer, packets []Packet) error {
+	hdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(hdr[0:4], pcapMagicNanoseconds)
+	binary.LittleEndian.PutUint16(hdr[4:6], 2)
+	binary.LittleEndian.PutUint16(hdr[6:8], 4)
+	binary.LittleEndian.PutUint32(hdr[16:20], pcapSnapLength)
+	binary.LittleEndian.PutUint32(hdr[20:24], linkTypeLoRaTap)
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	for _, pkt := range packets {
+		for _, frame := range loraFrames(pkt) {
+			data := appendLoRaTap(nil, frame)
+			rec := make([]byte, 16, 16+len(data))
+			binary.LittleEndian.PutUint32(rec[0:4], uint32(pkt.Time.Unix()))
+			binary.LittleEndian.PutUint32(rec[4:8], uint32(pkt.Time.Nanosecond()))
+			binary.LittleEndian.PutUint32(rec[8:12], uint32(len(data)))
+			binary.LittleEndian.PutUint32(rec[12:16], uint32(len(data)))
+			if _, err := w.Write(append(rec, data...)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pcapng block types and options.
+// See https://datatracker.ietf.org/doc/draft-ietf-opsawg-pcapng/ for the format.
+const (
+	pcapngSectionHeaderBlock        = 0x0a0d0d0a
+	pcapngInterfaceDescription      = 0x00000001
+	pcapngEnhancedPacketBlock       = 0x00000006
+	pcapngByteOrderMagic            = 0x1a2b3c4d
+	pcapngOptionEndOfOptions        = 0
+	pcapngOptionComment             = 1
+	pcapngOptionInterfaceName       = 2
+	pcapngOptionInterfaceDesc       = 3
+	pcapngOptionTimestampResolution = 9
+	pcapngOptionFlags               = 2
+
+	pcapngFlagsInbound  = 0x1
+	pcapngFlagsOutbound = 0x2
+
+	// pcapngLoRaInterface is the interface of the LoRa PHY frames.
+	pcapngLoRaInterface = 0
+	// pcapngFrontendInterface is the interface of the raw frontend frames.
+	pcapngFrontendInterface = 1
+)
+
+// pad4 returns n rounded up to a multiple of 4.
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
+
+// appendPCAPNGOption appends the option to b.
+func appendPCAPNGOption(b []byte, code uint16, value []byte) []byte {
+	var hdr [4]byte
+	binary.LittleEndian.PutUint16(hdr[0:2], code)
+	binary.LittleEndian.PutUint16(hdr[2:4], uint16(len(value)))
+	b = append(b, hdr[:]...)
+	b = append(b, value...)
+	return append(b, make([]byte, pad4(len(value))-len(value))...)
+}
+
+// writePCAPNGBlock writes the block with the given type and body to w.
+func writePCAPNGBlock(w io.Writer, blockType uint32, body []byte) error {
+	length := 12 + pad4(len(body))
+	b := make([]byte, 8, length)
+	binary.LittleEndian.PutUint32(b[0:4], blockType)
+	binary.LittleEndian.PutUint32(b[4:8], uint32(length))
+	b = append(b, body...)
+	b = append(b, make([]byte, pad4(len(body))-len(body))...)
+	b = append(b, b[4:8]...)
+	_, err := w.Write(b)
+	return err
+}
+
+// writePCAPNGInterface writes an interface description block with the given link type, name and description to w.
+// The timestamp resolution of the interface is nanoseconds.
+func writePCAPNGInterface(w io.Writer, linkType uint16, name, description string) error {
+	body := make([]byte, 8)
+	binary.LittleEndian.PutUint16(body[0:2], linkType)
+	binary.LittleEndian.PutUint32(body[4:8], pcapSnapLength)
+	body = appendPCAPNGOption(body, pcapngOptionInterfaceName, []byte(name))
+	body = appendPCAPNGOption(body, pcapngOptionInterfaceDesc, []byte(description))
+	body = appendPCAPNGOption(body, pcapngOptionTimestampResolution, []byte{9})
+	body = appendPCAPNGOption(body, pcapngOptionEndOfOptions, nil)
+	return writePCAPNGBlock(w, pcapngInterfaceDescription, body)
+}
+
+// writePCAPNGPacket writes an enhanced packet block with the given data to w.
+func writePCAPNGPacket(w io.Writer, iface uint32, t time.Time, direction Direction, data []byte, comment string) error {
+	body := make([]byte, 20, 20+pad4(len(data))+32)
+	ts := uint64(t.UnixNano())
+	binary.LittleEndian.PutUint32(body[0:4], iface)
+	binary.LittleEndian.PutUint32(body[4:8], uint32(ts>>32))
+	binary.LittleEndian.PutUint32(body[8:12], uint32(ts))
+	binary.LittleEndian.PutUint32(body[12:16], uint32(len(data)))
+	binary.LittleEndian.PutUint32(body[16:20], uint32(len(data)))
+	body = append(body, data...)
+	body = append(body, make([]byte, pad4(len(data))-len(data))...)
+	flags := make([]byte, 4)
+	if direction == Downlink {
+		binary.LittleEndian.PutUint32(flags, pcapngFlagsOutbound)
+	} else {
+		binary.LittleEndian.PutUint32(flags, pcapngFlagsInbound)
+	}
+	body = appendPCAPNGOption(body, pcapngOptionFlags, flags)
+	if comment != "" {
+		body = appendPCAPNGOption(body, pcapngOptionComment, []byte(comment))
+	}
+	body = appendPCAPNGOption(body, pcapngOptionEndOfOptions, nil)
+	return writePCAPNGBlock(w, pcapngEnhancedPacketBlock, body)
+}
+
+// WritePCAPNG writes the packets to w in the pcapng format.
+// The LoRa PHY frames of the decoded messages are written on an interface with the LoRaTap link type, and the raw
+// frontend frames are written on an interface with the USER0 link type.
+func WritePCAPNG(w io.Writer, packets []Packet) error {
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb[0:4], pcapngByteOrderMagic)
+	binary.LittleEndian.PutUint16(shb[4:6], 1)
+	binary.LittleEndian.PutUint64(shb[8:16], ^uint64(0))
+	if err := writePCAPNGBlock(w, pcapngSectionHeaderBlock, shb); err != nil {
+		return err
+	}
+	if err := writePCAPNGInterface(w, linkTypeLoRaTap, "lora", "LoRa PHY frames"); err != nil {
+		return err
+	}
+	if err := writePCAPNGInterface(w, linkTypeUser0, "frontend", "Raw gateway frontend frames"); err != nil {
+		return err
+	}
+	for _, pkt := range packets {
+		if pkt.Raw != nil {
+			comment := fmt.Sprintf("%s %s", pkt.Protocol, pkt.Direction)
+			if err := writePCAPNGPacket(w, pcapngFrontendInterface, pkt.Time, pkt.Direction, pkt.Raw, comment); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, frame := range loraFrames(pkt) {
+			if err := writePCAPNGPacket(w, pcapngLoRaInterface, pkt.Time, frame.direction, appendLoRaTap(nil, frame), ""); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -38,6 +39,108 @@ type BasicStationConfig struct {
 	ListenTLS               string        `name:"listen-tls" description:"Address for the Basic Station frontend to listen on (with TLS)"`
 }
 
+// MQTTChirpStackConfig defines the ChirpStack Gateway Bridge compatible MQTT configuration of the Gateway Server.
+type MQTTChirpStackConfig struct {
+	config.MQTT `name:",squash"`
+	Marshaler   string `name:"marshaler" description:"Marshaler of the ChirpStack Gateway Bridge payloads (protobuf, json)"`
+}
+
+// StatsHistoryConfig defines the configuration of the gateway connection stats history.
+type StatsHistoryConfig struct {
+	Registry                  GatewayConnectionStatsHistoryRegistry `name:"-"`
+	Enable                    bool                                  `name:"enable" description:"Keep the history of gateway connection stats"`
+	BucketDuration            time.Duration                         `name:"bucket-duration" description:"Duration of the time buckets"`
+	Retention                 time.Duration                         `name:"retention" description:"Time to keep the time buckets"`
+	DownsampledBucketDuration time.Duration                         `name:"downsampled-bucket-duration" description:"Duration of the downsampled time buckets"`
+	DownsampledRetention      time.Duration                         `name:"downsampled-retention" description:"Time to keep the downsampled time buckets"`
+}
+
+var errStatsHistoryConfig = errors.DefineInvalidArgument(
+	"stats_history_config",
+	"invalid stats history configuration: bucket durations must be positive and the downsampled bucket duration must be a multiple of the bucket duration",
+)
+
+// Validate returns an error if the configuration is invalid.
+func (c StatsHistoryConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.BucketDuration <= 0 || c.DownsampledBucketDuration <= 0 || c.DownsampledBucketDuration%c.BucketDuration != 0 {
+		return errStatsHistoryConfig.New()
+	}
+	return nil
+}
+
+// SpectrumConfig defines the configuration of the spectrum and channel occupancy stats of connected gateways.
+type SpectrumConfig struct {
+	Enable          bool          `name:"enable" description:"Aggregate the spectrum and channel occupancy stats of connected gateways"`
+	Window          time.Duration `name:"window" description:"Sliding window over which the stats are aggregated"`
+	MaxObservations int           `name:"max-observations" description:"Maximum number of messages per gateway to keep in the window"`
+	Metrics         bool          `name:"metrics" description:"Expose the stats per gateway and channel as Prometheus metrics"`
+
+	NetID           types.NetID           `name:"net-id" description:"NetID of the network of the cluster; uplink messages of other networks are counted as foreign"`
+	DevAddrPrefixes []types.DevAddrPrefix `name:"dev-addr-prefixes" description:"Device address prefixes of the network of the cluster (default prefix of the NetID)"`
+}
+
+var errSpectrumConfig = errors.DefineInvalidArgument(
+	"spectrum_config",
+	"invalid spectrum configuration: window and maximum number of messages must be positive",
+)
+
+// Validate returns an error if the configuration is invalid.
+func (c SpectrumConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Window <= 0 || c.MaxObservations <= 0 {
+		return errSpectrumConfig.New()
+	}
+	return nil
+}
+
+// HomeDevAddrPrefixes returns the device address prefixes of the network of the cluster.
+// If no prefixes are configured, the prefix of the NetID is returned.
+func (c SpectrumConfig) HomeDevAddrPrefixes() ([]types.DevAddrPrefix, error) {
+	if len(c.DevAddrPrefixes) > 0 {
+		return c.DevAddrPrefixes, nil
+	}
+	devAddr, err := types.NewDevAddr(c.NetID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return []types.DevAddrPrefix{
+		{
+			DevAddr: devAddr,
+			Length:  uint8(32 - types.NwkAddrBits(c.NetID)),
+		},
+	}, nil
+}
+
+// ConnectionRegistryConfig defines the configuration of the cluster-wide gateway connection registry.
+type ConnectionRegistryConfig struct {
+	Registry          GatewayConnectionRegistry `name:"-"`
+	Enable            bool                      `name:"enable" description:"Register gateway connections cluster-wide and forward downlink messages to the instance that holds the gateway connection"`
+	Address           string                    `name:"address" description:"gRPC address of this instance for the other instances in the cluster (default cluster address)"`
+	TTL               time.Duration             `name:"ttl" description:"Time to live of the gateway connection leases"`
+	HeartbeatInterval time.Duration             `name:"heartbeat-interval" description:"Interval at which the gateway connection leases are renewed"`
+}
+
+var errConnectionRegistryConfig = errors.DefineInvalidArgument(
+	"connection_registry_config",
+	"invalid connection registry configuration: heartbeat interval must be positive and less than the time to live",
+)
+
+// Validate returns an error if the configuration is invalid.
+func (c ConnectionRegistryConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.HeartbeatInterval <= 0 || c.HeartbeatInterval >= c.TTL {
+		return errConnectionRegistryConfig.New()
+	}
+	return nil
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways         bool          `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
@@ -45,13 +148,17 @@ type Config struct {
 
 	Stats                             GatewayConnectionStatsRegistry `name:"-"`
 	UpdateConnectionStatsDebounceTime time.Duration                  `name:"update-connection-stats-debounce-time" description:"Time before repeated refresh of the gateway connection stats"`
+	StatsHistory                      StatsHistoryConfig             `name:"stats-history"`
+	Spectrum                          SpectrumConfig                 `name:"spectrum"`
+	ConnectionRegistry                ConnectionRegistryConfig       `name:"connection-registry"`
 
 	Forward map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 
-	MQTT         config.MQTT        `name:"mqtt"`
-	MQTTV2       config.MQTT        `name:"mqtt-v2"`
-	UDP          UDPConfig          `name:"udp"`
-	BasicStation BasicStationConfig `name:"basic-station"`
+	MQTT           config.MQTT          `name:"mqtt"`
+	MQTTV2         config.MQTT          `name:"mqtt-v2"`
+	MQTTChirpStack MQTTChirpStackConfig `name:"mqtt-chirpstack"`
+	UDP            UDPConfig            `name:"udp"`
+	BasicStation   BasicStationConfig   `name:"basic-station"`
 }
 
 // ForwardDevAddrPrefixes parses the configured forward map.
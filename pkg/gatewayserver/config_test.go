@@ -16,6 +16,7 @@ package gatewayserver_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
@@ -53,4 +54,58 @@ func TestConfig(t *testing.T) {
 		_, err := conf.ForwardDevAddrPrefixes()
 		a.So(err, should.NotBeNil)
 	}
+
+	{
+		conf := gatewayserver.StatsHistoryConfig{
+			Enable:                    true,
+			BucketDuration:            5 * time.Minute,
+			DownsampledBucketDuration: time.Hour,
+		}
+		a.So(conf.Validate(), should.BeNil)
+
+		conf.DownsampledBucketDuration = 7 * time.Minute
+		a.So(conf.Validate(), should.NotBeNil)
+
+		conf.BucketDuration = 0
+		a.So(conf.Validate(), should.NotBeNil)
+
+		conf.Enable = false
+		a.So(conf.Validate(), should.BeNil)
+	}
+
+	{
+		conf := gatewayserver.SpectrumConfig{
+			Enable:          true,
+			Window:          time.Hour,
+			MaxObservations: 1000,
+		}
+		a.So(conf.Validate(), should.BeNil)
+
+		conf.MaxObservations = 0
+		a.So(conf.Validate(), should.NotBeNil)
+
+		conf.MaxObservations, conf.Window = 1000, 0
+		a.So(conf.Validate(), should.NotBeNil)
+
+		conf.Enable = false
+		a.So(conf.Validate(), should.BeNil)
+	}
+
+	{
+		conf := gatewayserver.ConnectionRegistryConfig{
+			Enable:            true,
+			TTL:               30 * time.Second,
+			HeartbeatInterval: 10 * time.Second,
+		}
+		a.So(conf.Validate(), should.BeNil)
+
+		conf.HeartbeatInterval = 30 * time.Second
+		a.So(conf.Validate(), should.NotBeNil)
+
+		conf.HeartbeatInterval = 0
+		a.So(conf.Validate(), should.NotBeNil)
+
+		conf.Enable = false
+		a.So(conf.Validate(), should.BeNil)
+	}
 }
@@ -0,0 +1,204 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// forwardedByMDKey is the gRPC metadata key that marks downlink messages that are forwarded by another instance.
+// Forwarded downlink messages are not forwarded again.
+const forwardedByMDKey = "ttn-lw-gs-forwarded-by"
+
+var errNoInstanceAddress = errors.DefineFailedPrecondition(
+	"no_instance_address",
+	"no address of this instance for the connection registry; configure the connection registry or cluster address",
+)
+
+var errConnectionClaimed = errors.DefineAborted("connection_claimed", "gateway connection claimed by another instance")
+
+func (gs *GatewayServer) connectionRegistryEnabled() bool {
+	return gs.config.ConnectionRegistry.Enable && gs.config.ConnectionRegistry.Registry != nil
+}
+
+// connectionClaim is a claim of a gateway connection in the connection registry.
+// Claims are reference counted, so that the frontend connection and the downlink path can claim the same gateway
+// connection.
+type connectionClaim struct {
+	refs   int
+	cancel context.CancelFunc
+}
+
+// claimConnection claims the connection of the gateway in the connection registry, and renews the claim until it is
+// released with the returned function.
+func (gs *GatewayServer) claimConnection(ctx context.Context, ids ttnpb.GatewayIdentifiers) (release func(), err error) {
+	uid := unique.ID(ctx, ids)
+	conf := gs.config.ConnectionRegistry
+	logger := log.FromContext(ctx)
+
+	gs.claimsMu.Lock()
+	defer gs.claimsMu.Unlock()
+	claim, ok := gs.claims[uid]
+	if !ok {
+		if err := conf.Registry.Claim(ctx, ids, gs.instanceAddress, conf.TTL); err != nil {
+			return nil, err
+		}
+		var renewCtx context.Context
+		claim = &connectionClaim{}
+		renewCtx, claim.cancel = context.WithCancel(log.NewContext(gs.Context(), logger))
+		gs.claims[uid] = claim
+		go gs.renewConnectionClaim(renewCtx, ids, claim)
+	}
+	claim.refs++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			gs.claimsMu.Lock()
+			defer gs.claimsMu.Unlock()
+			if claim.refs--; claim.refs > 0 {
+				return
+			}
+			claim.cancel()
+			if gs.claims[uid] != claim {
+				// The claim is dropped, as another instance claimed the gateway connection.
+				return
+			}
+			delete(gs.claims, uid)
+			releaseCtx, cancel := context.WithTimeout(log.NewContext(gs.Context(), logger), conf.HeartbeatInterval)
+			defer cancel()
+			if err := conf.Registry.Release(releaseCtx, ids, gs.instanceAddress); err != nil {
+				logger.WithError(err).Warn("Failed to release gateway connection claim")
+			}
+		})
+	}, nil
+}
+
+// renewConnectionClaim renews the claim of the gateway connection until the context is done.
+// If the connection is claimed by another instance, the claim is dropped and the gateway connection to this instance
+// is disconnected, so that the next claim of the gateway connection claims it again in the connection registry.
+func (gs *GatewayServer) renewConnectionClaim(ctx context.Context, ids ttnpb.GatewayIdentifiers, claim *connectionClaim) {
+	conf := gs.config.ConnectionRegistry
+	logger := log.FromContext(ctx)
+	ticker := time.NewTicker(conf.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := conf.Registry.Renew(ctx, ids, gs.instanceAddress, conf.TTL)
+			if errors.IsAborted(err) {
+				logger.WithError(err).Warn("Gateway connection claimed by another instance")
+				uid := unique.ID(ctx, ids)
+				gs.claimsMu.Lock()
+				if gs.claims[uid] == claim {
+					delete(gs.claims, uid)
+				}
+				gs.claimsMu.Unlock()
+				if conn, ok := gs.connections.Load(uid); ok {
+					conn.(connectionEntry).Disconnect(errConnectionClaimed.WithCause(err))
+				}
+				return
+			} else if err != nil {
+				logger.WithError(err).Warn("Failed to renew gateway connection claim")
+			}
+		}
+	}
+}
+
+// isForwardedDownlink returns whether the downlink message in the request context is forwarded by another instance.
+func isForwardedDownlink(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(forwardedByMDKey)) > 0
+}
+
+// instanceConn returns the gRPC client connection to the Gateway Server instance with the given address.
+func (gs *GatewayServer) instanceConn(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	gs.instanceConnsMu.Lock()
+	defer gs.instanceConnsMu.Unlock()
+	if cc, ok := gs.instanceConns[address]; ok {
+		return cc, nil
+	}
+	opts := rpcclient.DefaultDialOptions(gs.Context())
+	if gs.ClusterTLS() {
+		tlsConfig, err := gs.GetTLSClientConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	cc, err := grpc.DialContext(gs.Context(), address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	gs.instanceConns[address] = cc
+	return cc, nil
+}
+
+// closeInstanceConns closes the gRPC client connections to the other Gateway Server instances.
+func (gs *GatewayServer) closeInstanceConns() {
+	gs.instanceConnsMu.Lock()
+	defer gs.instanceConnsMu.Unlock()
+	for address, cc := range gs.instanceConns {
+		cc.Close()
+		delete(gs.instanceConns, address)
+	}
+}
+
+// forwardDownlink forwards the downlink message on the given path to the Gateway Server instance that holds the
+// connection of the gateway, according to the connection registry.
+func (gs *GatewayServer) forwardDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers, path *ttnpb.DownlinkPath, down *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+	uid := unique.ID(ctx, ids)
+	if !gs.connectionRegistryEnabled() || isForwardedDownlink(ctx) {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	address, err := gs.config.ConnectionRegistry.Registry.Get(ctx, ids)
+	if errors.IsNotFound(err) {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	} else if err != nil {
+		return nil, err
+	}
+	if address == gs.instanceAddress {
+		// The claim of this instance is stale, as the gateway is not connected to this instance.
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	cc, err := gs.instanceConn(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	down = deepcopy.Copy(down).(*ttnpb.DownlinkMessage)
+	down.GetRequest().DownlinkPaths = []*ttnpb.DownlinkPath{path}
+	ctx = metadata.AppendToOutgoingContext(ctx, forwardedByMDKey, gs.instanceAddress)
+	log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", uid,
+		"address", address,
+	)).Debug("Forward downlink message to instance that holds gateway connection")
+	return ttnpb.NewNsGsClient(cc).ScheduleDownlink(ctx, down, gs.WithClusterAuth())
+}
@@ -0,0 +1,541 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var (
+	errMockNotFound = errors.DefineNotFound("mock_not_found", "not found")
+	errMockClaimed  = errors.DefineAborted("mock_claimed", "claimed by another instance")
+)
+
+type mockConnectionRegistry struct {
+	mu      sync.Mutex
+	claims  map[string]string
+	renewed map[string]int
+	gets    int
+}
+
+func (r *mockConnectionRegistry) Claim(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.claims[unique.ID(ctx, ids)] = address
+	return nil
+}
+
+func (r *mockConnectionRegistry) Renew(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	if existing, ok := r.claims[uid]; ok && existing != address {
+		return errMockClaimed.New()
+	}
+	r.claims[uid] = address
+	r.renewed[uid]++
+	return nil
+}
+
+func (r *mockConnectionRegistry) Release(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.claims[unique.ID(ctx, ids)] == address {
+		delete(r.claims, unique.ID(ctx, ids))
+	}
+	return nil
+}
+
+func (r *mockConnectionRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gets++
+	address, ok := r.claims[unique.ID(ctx, ids)]
+	if !ok {
+		return "", errMockNotFound.New()
+	}
+	return address, nil
+}
+
+func (r *mockConnectionRegistry) getCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.gets
+}
+
+func (r *mockConnectionRegistry) renewCount(ctx context.Context, ids ttnpb.GatewayIdentifiers) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.renewed[unique.ID(ctx, ids)]
+}
+
+func TestConnectionRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	registry := &mockConnectionRegistry{
+		claims:  make(map[string]string),
+		renewed: make(map[string]int),
+	}
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		ConnectionRegistry: gatewayserver.ConnectionRegistryConfig{
+			Registry:          registry,
+			Enable:            true,
+			Address:           "gs1:8884",
+			TTL:               time.Second,
+			HeartbeatInterval: test.Delay,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+
+	a.So(gs.ClaimDownlink(ctx, ids), should.BeNil)
+	address, err := registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs1:8884")
+
+	time.Sleep(5 * test.Delay)
+	a.So(registry.renewCount(ctx, ids), should.BeGreaterThan, 0)
+
+	// Claiming the downlink path again keeps the claim.
+	a.So(gs.ClaimDownlink(ctx, ids), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+
+	a.So(gs.UnclaimDownlink(ctx, ids), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// The claim is not renewed after it is released.
+	time.Sleep(2 * test.Delay)
+	renewed := registry.renewCount(ctx, ids)
+	time.Sleep(5 * test.Delay)
+	a.So(registry.renewCount(ctx, ids), should.Equal, renewed)
+}
+
+func TestConnectionRegistryClaimAborted(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	registry := &mockConnectionRegistry{
+		claims:  make(map[string]string),
+		renewed: make(map[string]int),
+	}
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		ConnectionRegistry: gatewayserver.ConnectionRegistryConfig{
+			Registry:          registry,
+			Enable:            true,
+			Address:           "gs1:8884",
+			TTL:               time.Second,
+			HeartbeatInterval: test.Delay,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+
+	a.So(gs.ClaimDownlink(ctx, ids), should.BeNil)
+
+	// Another instance claims the gateway connection, so that renewing the claim is aborted.
+	a.So(registry.Claim(ctx, ids, "gs2:8884", time.Second), should.BeNil)
+	time.Sleep(5 * test.Delay)
+	address, err := registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs2:8884")
+
+	// Claiming the downlink path again claims the gateway connection again.
+	a.So(gs.ClaimDownlink(ctx, ids), should.BeNil)
+	address, err = registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs1:8884")
+
+	// The new claim is renewed.
+	renewed := registry.renewCount(ctx, ids)
+	time.Sleep(5 * test.Delay)
+	a.So(registry.renewCount(ctx, ids), should.BeGreaterThan, renewed)
+	address, err = registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs1:8884")
+
+	a.So(gs.UnclaimDownlink(ctx, ids), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestConnectionRegistryClaimAbortedDisconnect(t *testing.T) {
+	a, ctx := test.New(t)
+
+	is, isAddr := startMockIS(ctx)
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: registeredGatewayID,
+		EUI:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+
+	const address = "127.0.0.1:9190"
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      address,
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	registry := &mockConnectionRegistry{
+		claims:  make(map[string]string),
+		renewed: make(map[string]int),
+	}
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		ConnectionRegistry: gatewayserver.ConnectionRegistryConfig{
+			Registry:          registry,
+			Enable:            true,
+			Address:           address,
+			TTL:               time.Second,
+			HeartbeatInterval: test.Delay,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	conn, err := grpc.Dial(address, append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	linkCtx, cancelLink := context.WithCancel(ctx)
+	defer cancelLink()
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(linkCtx, grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.GatewayID,
+		AuthType:      "Bearer",
+		AuthValue:     registeredGatewayKey,
+		AllowInsecure: true,
+	}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	linkErrCh := make(chan error, 1)
+	go func() {
+		for {
+			if _, err := link.Recv(); err != nil {
+				linkErrCh <- err
+				return
+			}
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		if claimed, err := registry.Get(ctx, ids); err == nil && claimed == address {
+			break
+		}
+		time.Sleep(test.Delay)
+	}
+	_, ok := gs.GetConnection(ctx, ids)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+
+	// Another instance claims the gateway connection, so that the gateway is disconnected from this instance.
+	a.So(registry.Claim(ctx, ids, "127.0.0.1:9191", time.Second), should.BeNil)
+	select {
+	case err := <-linkErrCh:
+		a.So(errors.IsAborted(err), should.BeTrue)
+	case <-time.After(timeout):
+		t.Fatal("Expected the gateway to be disconnected")
+	}
+	for i := 0; i < 20; i++ {
+		if _, ok = gs.GetConnection(ctx, ids); !ok {
+			break
+		}
+		time.Sleep(test.Delay)
+	}
+	a.So(ok, should.BeFalse)
+
+	// The claim of the other instance is not released.
+	claimed, err := registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(claimed, should.Equal, "127.0.0.1:9191")
+}
+
+func TestConnectionRegistryNoAddress(t *testing.T) {
+	a := assertions.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	_, err := gatewayserver.New(c, &gatewayserver.Config{
+		ConnectionRegistry: gatewayserver.ConnectionRegistryConfig{
+			Registry:          &mockConnectionRegistry{},
+			Enable:            true,
+			TTL:               time.Second,
+			HeartbeatInterval: test.Delay,
+		},
+	})
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+}
+
+func TestForwardDownlink(t *testing.T) {
+	a, ctx := test.New(t)
+
+	is, isAddr := startMockIS(ctx)
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: registeredGatewayID,
+		EUI:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+
+	clusterKey := hex.EncodeToString(random.Bytes(32))
+	newGatewayServer := func(address string, registry gatewayserver.GatewayConnectionRegistry) *gatewayserver.GatewayServer {
+		c := componenttest.NewComponent(t, &component.Config{
+			ServiceBase: config.ServiceBase{
+				GRPC: config.GRPC{
+					Listen:                      address,
+					AllowInsecureForCredentials: true,
+				},
+				Cluster: cluster.Config{
+					Keys:           []string{clusterKey},
+					IdentityServer: isAddr,
+				},
+			},
+		})
+		c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+		gs, err := gatewayserver.New(c, &gatewayserver.Config{
+			ConnectionRegistry: gatewayserver.ConnectionRegistryConfig{
+				Registry:          registry,
+				Enable:            true,
+				Address:           address,
+				TTL:               time.Second,
+				HeartbeatInterval: test.Delay,
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		componenttest.StartComponent(t, c)
+		mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+		return gs
+	}
+
+	const (
+		gs1Address = "127.0.0.1:9188"
+		gs2Address = "127.0.0.1:9189"
+	)
+	registry1 := &mockConnectionRegistry{
+		claims:  make(map[string]string),
+		renewed: make(map[string]int),
+	}
+	registry2 := &mockConnectionRegistry{
+		claims:  make(map[string]string),
+		renewed: make(map[string]int),
+	}
+	gs1 := newGatewayServer(gs1Address, registry1)
+	defer gs1.Close()
+	gs2 := newGatewayServer(gs2Address, registry2)
+	defer gs2.Close()
+
+	// Connect the gateway to the second instance.
+	conn, err := grpc.Dial(gs2Address, append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	linkCtx, cancelLink := context.WithCancel(ctx)
+	defer cancelLink()
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(linkCtx, grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.GatewayID,
+		AuthType:      "Bearer",
+		AuthValue:     registeredGatewayKey,
+		AllowInsecure: true,
+	}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	downCh := make(chan *ttnpb.GatewayDown)
+	go func() {
+		for {
+			msg, err := link.Recv()
+			if err != nil {
+				return
+			}
+			downCh <- msg
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		if address, err := registry2.Get(ctx, ids); err == nil && address == gs2Address {
+			break
+		}
+		time.Sleep(test.Delay)
+	}
+	_, ok := gs2.GetConnection(ctx, ids)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	// Send an uplink message to synchronize the clock of the gateway.
+	if !a.So(link.Send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{
+			{
+				RawPayload: randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6),
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								SpreadingFactor: 7,
+								Bandwidth:       125000,
+							},
+						},
+					},
+					Frequency: 868100000,
+					Timestamp: 100,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ids,
+						Timestamp:          100,
+					},
+				},
+			},
+		},
+	}), should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(timeout)
+
+	makeDownlink := func() *ttnpb.DownlinkMessage {
+		return &ttnpb.DownlinkMessage{
+			RawPayload: randomDownDataPayload(types.DevAddr{0x26, 0x02, 0xff, 0xff}, 1, 6),
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class: ttnpb.CLASS_C,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_Fixed{
+								Fixed: &ttnpb.GatewayAntennaIdentifiers{
+									GatewayIdentifiers: ids,
+								},
+							},
+						},
+					},
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx1Delay:         ttnpb.RX_DELAY_1,
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     868100000,
+					FrequencyPlanID:  test.EUFrequencyPlanID,
+				},
+			},
+		}
+	}
+	assertNotConnected := func(a *assertions.Assertion, err error) {
+		if !a.So(errors.IsAborted(err), should.BeTrue) || !a.So(errors.Details(err), should.HaveLength, 1) {
+			return
+		}
+		details := errors.Details(err)[0].(*ttnpb.ScheduleDownlinkErrorDetails)
+		if a.So(details.PathErrors, should.HaveLength, 1) {
+			a.So(ttnpb.ErrorDetailsFromProto(details.PathErrors[0]), should.HaveSameErrorDefinitionAs, gatewayserver.ErrNotConnected)
+		}
+	}
+	scheduleCtx := clusterauth.NewContext(ctx, nil)
+
+	t.Run("LeaseHolder", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// The first instance forwards the downlink message to the instance that holds the lease.
+		a.So(registry1.Claim(ctx, ids, gs2Address, time.Second), should.BeNil)
+		res, err := gs1.ScheduleDownlink(scheduleCtx, makeDownlink())
+		if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+			t.FailNow()
+		}
+		select {
+		case msg := <-downCh:
+			a.So(msg.DownlinkMessage.GetScheduled(), should.NotBeNil)
+		case <-time.After(timeout):
+			t.Fatal("Expected downlink timeout")
+		}
+	})
+
+	t.Run("SecondHop", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// The gateway disconnects from the second instance, and the lease of the second instance points back to the
+		// first instance. The forwarded downlink message is not forwarded again.
+		cancelLink()
+		for i := 0; i < 20; i++ {
+			if _, ok := gs2.GetConnection(ctx, ids); !ok {
+				break
+			}
+			time.Sleep(test.Delay)
+		}
+		a.So(registry2.Claim(ctx, ids, gs1Address, time.Second), should.BeNil)
+		gets := registry2.getCount()
+		// Forwarding loops until the deadline if the forwarded downlink message is forwarded again.
+		hopCtx, cancel := context.WithTimeout(scheduleCtx, timeout)
+		defer cancel()
+		_, err := gs1.ScheduleDownlink(hopCtx, makeDownlink())
+		a.So(err, should.NotBeNil)
+		a.So(errors.IsAborted(err), should.BeTrue)
+		a.So(registry2.getCount(), should.Equal, gets)
+
+		// Without forwarding, the second instance forwards to the first instance, according to its lease.
+		hopCtx, cancel = context.WithTimeout(scheduleCtx, timeout)
+		defer cancel()
+		_, err = gs2.ScheduleDownlink(hopCtx, makeDownlink())
+		a.So(errors.IsAborted(err), should.BeTrue)
+		a.So(registry2.getCount(), should.Equal, gets+1)
+	})
+
+	t.Run("OwnLease", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// A lease that points to this instance is stale, as the gateway is not connected to this instance.
+		a.So(registry1.Claim(ctx, ids, gs1Address, time.Second), should.BeNil)
+		_, err := gs1.ScheduleDownlink(scheduleCtx, makeDownlink())
+		assertNotConnected(a, err)
+	})
+}
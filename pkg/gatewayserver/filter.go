@@ -0,0 +1,137 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errFilterNotAllowed = errors.DefinePermissionDenied("filter_not_allowed", "uplink message not allowed by filter")
+	errFilterDenied     = errors.DefinePermissionDenied("filter_denied", "uplink message denied by filter")
+	errFilterSNR        = errors.DefineFailedPrecondition("filter_snr", "SNR `{snr}` dB below minimum `{min_snr}` dB")
+	errFilterRSSI       = errors.DefineFailedPrecondition("filter_rssi", "RSSI `{rssi}` dBm below minimum `{min_rssi}` dBm")
+)
+
+// uplinkFilterIdentifiers contains the identifiers of an uplink message that are matched by uplink filter rules.
+// Only one of the identifiers is set, depending on the message type.
+type uplinkFilterIdentifiers struct {
+	devAddr *types.DevAddr
+	netID   *types.NetID
+	joinEUI *types.EUI64
+}
+
+func getUplinkFilterIdentifiers(msg *ttnpb.Message) (ids uplinkFilterIdentifiers) {
+	switch pld := msg.GetPayload().(type) {
+	case *ttnpb.Message_MACPayload:
+		ids.devAddr = &pld.MACPayload.DevAddr
+	case *ttnpb.Message_JoinRequestPayload:
+		ids.joinEUI = &pld.JoinRequestPayload.JoinEUI
+	case *ttnpb.Message_RejoinRequestPayload:
+		switch pld.RejoinRequestPayload.RejoinType {
+		case ttnpb.RejoinType_CONTEXT, ttnpb.RejoinType_KEYS:
+			ids.netID = &pld.RejoinRequestPayload.NetID
+		case ttnpb.RejoinType_SESSION:
+			ids.joinEUI = &pld.RejoinRequestPayload.JoinEUI
+		}
+	}
+	return
+}
+
+// matchUplinkFilterRule returns whether the rule applies to the identifiers, and if so, whether the identifiers match.
+// A rule applies if it defines identifiers of the same kind.
+func matchUplinkFilterRule(rule *ttnpb.GatewayUplinkFilters_Rule, ids uplinkFilterIdentifiers) (applies, matches bool) {
+	if rule == nil {
+		return false, false
+	}
+	switch {
+	case ids.devAddr != nil:
+		for _, prefix := range rule.DevAddrPrefixes {
+			if ids.devAddr.HasPrefix(prefix) {
+				return true, true
+			}
+		}
+		for _, netID := range rule.NetIDs {
+			devAddr, err := types.NewDevAddr(netID, nil)
+			if err != nil {
+				continue
+			}
+			prefix := types.DevAddrPrefix{
+				DevAddr: devAddr,
+				Length:  uint8(32 - types.NwkAddrBits(netID)),
+			}
+			if ids.devAddr.HasPrefix(prefix) {
+				return true, true
+			}
+		}
+		return len(rule.DevAddrPrefixes) > 0 || len(rule.NetIDs) > 0, false
+	case ids.netID != nil:
+		for _, netID := range rule.NetIDs {
+			if ids.netID.Equal(netID) {
+				return true, true
+			}
+		}
+		return len(rule.NetIDs) > 0, false
+	case ids.joinEUI != nil:
+		for _, prefix := range rule.JoinEUIPrefixes {
+			if ids.joinEUI.HasPrefix(prefix) {
+				return true, true
+			}
+		}
+		return len(rule.JoinEUIPrefixes) > 0, false
+	}
+	return false, false
+}
+
+// filterUplink returns an error if the uplink message does not pass the given uplink filters.
+// The payload of the uplink message must be decoded to filter by identifiers.
+func filterUplink(filters *ttnpb.GatewayUplinkFilters, msg *ttnpb.UplinkMessage) error {
+	if filters == nil {
+		return nil
+	}
+	if len(msg.RxMetadata) > 0 {
+		snr, rssi := msg.RxMetadata[0].SNR, msg.RxMetadata[0].ChannelRSSI
+		for _, md := range msg.RxMetadata[1:] {
+			if md.SNR > snr {
+				snr = md.SNR
+			}
+			if md.ChannelRSSI > rssi {
+				rssi = md.ChannelRSSI
+			}
+		}
+		if filters.MinSNR != nil && msg.Settings.DataRate.GetLoRa() != nil && snr < filters.MinSNR.Value {
+			return errFilterSNR.WithAttributes(
+				"snr", snr,
+				"min_snr", filters.MinSNR.Value,
+			)
+		}
+		if filters.MinRSSI != nil && rssi < filters.MinRSSI.Value {
+			return errFilterRSSI.WithAttributes(
+				"rssi", rssi,
+				"min_rssi", filters.MinRSSI.Value,
+			)
+		}
+	}
+	ids := getUplinkFilterIdentifiers(msg.Payload)
+	if applies, matches := matchUplinkFilterRule(filters.Allow, ids); applies && !matches {
+		return errFilterNotAllowed.New()
+	}
+	if _, matches := matchUplinkFilterRule(filters.Deny, ids); matches {
+		return errFilterDenied.New()
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"fmt"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFilterUplink(t *testing.T) {
+	loRaSettings := ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				},
+			},
+		},
+	}
+	dataUp := func(devAddr types.DevAddr, md ...*ttnpb.RxMetadata) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_UP},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{DevAddr: devAddr},
+					},
+				},
+			},
+			Settings:   loRaSettings,
+			RxMetadata: md,
+		}
+	}
+	joinUp := func(joinEUI types.EUI64) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{MType: ttnpb.MType_JOIN_REQUEST},
+				Payload: &ttnpb.Message_JoinRequestPayload{
+					JoinRequestPayload: &ttnpb.JoinRequestPayload{JoinEUI: joinEUI},
+				},
+			},
+			Settings: loRaSettings,
+		}
+	}
+	rejoinUp := func(netID types.NetID) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{MType: ttnpb.MType_REJOIN_REQUEST},
+				Payload: &ttnpb.Message_RejoinRequestPayload{
+					RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+						RejoinType: ttnpb.RejoinType_CONTEXT,
+						NetID:      netID,
+					},
+				},
+			},
+			Settings: loRaSettings,
+		}
+	}
+
+	for i, tc := range []struct {
+		Filters *ttnpb.GatewayUplinkFilters
+		Message *ttnpb.UplinkMessage
+		Error   error
+	}{
+		{
+			Filters: nil,
+			Message: dataUp(types.DevAddr{0x01, 0x02, 0x03, 0x04}),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+			},
+			Message: dataUp(types.DevAddr{0x01, 0x02, 0x03, 0x04}),
+			Error:   ErrFilterNotAllowed,
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+			},
+			Message: joinUp(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+			},
+			Message: rejoinUp(types.NetID{0x00, 0x00, 0x13}),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+			},
+			Message: rejoinUp(types.NetID{0x00, 0x00, 0x42}),
+			Error:   ErrFilterNotAllowed,
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					JoinEUIPrefixes: []types.EUI64Prefix{
+						{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36},
+					},
+				},
+			},
+			Message: joinUp(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					JoinEUIPrefixes: []types.EUI64Prefix{
+						{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36},
+					},
+				},
+			},
+			Message: joinUp(types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}),
+			Error:   ErrFilterNotAllowed,
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Deny: &ttnpb.GatewayUplinkFilters_Rule{
+					DevAddrPrefixes: []types.DevAddrPrefix{
+						{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+					},
+				},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			Error:   ErrFilterDenied,
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Deny: &ttnpb.GatewayUplinkFilters_Rule{
+					DevAddrPrefixes: []types.DevAddrPrefix{
+						{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+					},
+				},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x02, 0x02, 0x03}),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				Allow: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+				Deny: &ttnpb.GatewayUplinkFilters_Rule{
+					DevAddrPrefixes: []types.DevAddrPrefix{
+						{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+					},
+				},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			Error:   ErrFilterDenied,
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				MinSNR: &pbtypes.FloatValue{Value: -5},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03},
+				&ttnpb.RxMetadata{SNR: -10},
+				&ttnpb.RxMetadata{SNR: -2},
+			),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				MinSNR: &pbtypes.FloatValue{Value: -5},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03},
+				&ttnpb.RxMetadata{SNR: -10},
+			),
+			Error: ErrFilterSNR,
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				MinRSSI: &pbtypes.FloatValue{Value: -110},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03},
+				&ttnpb.RxMetadata{ChannelRSSI: -100},
+			),
+		},
+		{
+			Filters: &ttnpb.GatewayUplinkFilters{
+				MinRSSI: &pbtypes.FloatValue{Value: -110},
+			},
+			Message: dataUp(types.DevAddr{0x26, 0x01, 0x02, 0x03},
+				&ttnpb.RxMetadata{ChannelRSSI: -120},
+			),
+			Error: ErrFilterRSSI,
+		},
+	} {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			err := FilterUplink(tc.Filters, tc.Message)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
@@ -40,15 +40,20 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/spectrum"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/ns"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -70,12 +75,22 @@ type GatewayServer struct {
 
 	requireRegisteredGateways bool
 	forward                   map[string][]types.DevAddrPrefix
+	homeDevAddrPrefixes       []types.DevAddrPrefix
 
 	registry ttnpb.GatewayRegistryClient
 
 	upstreamHandlers map[string]upstream.Handler
 
 	connections sync.Map // string to connectionEntry
+	captures    sync.Map // string to *capture.Capture
+
+	instanceAddress  string
+	instanceConnsMu  sync.Mutex
+	instanceConns    map[string]*grpc.ClientConn
+	claimsMu         sync.Mutex
+	claims           map[string]*connectionClaim
+	downlinkClaimsMu sync.Mutex
+	downlinkClaims   map[string]func()
 
 	statsRegistry                     GatewayConnectionStatsRegistry
 	updateConnectionStatsDebounceTime time.Duration
@@ -125,6 +140,7 @@ var (
 	errSetupUpstream       = errors.DefineFailedPrecondition("upstream", "failed to setup upstream `{name}`")
 	errUpstreamType        = errors.DefineUnimplemented("upstream_type_not_implemented", "upstream `{name}` not implemented")
 	errInvalidUpstreamName = errors.DefineInvalidArgument("invalid_upstream_name", "upstream `{name}` is invalid")
+	errMarshaler           = errors.DefineInvalidArgument("marshaler", "unknown marshaler `{marshaler}`")
 )
 
 // New returns new *GatewayServer.
@@ -133,6 +149,19 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	if err != nil {
 		return nil, err
 	}
+	if err := conf.StatsHistory.Validate(); err != nil {
+		return nil, err
+	}
+	if err := conf.Spectrum.Validate(); err != nil {
+		return nil, err
+	}
+	homeDevAddrPrefixes, err := conf.Spectrum.HomeDevAddrPrefixes()
+	if err != nil {
+		return nil, err
+	}
+	if err := conf.ConnectionRegistry.Validate(); err != nil {
+		return nil, err
+	}
 	if len(forward) == 0 {
 		forward[""] = []types.DevAddrPrefix{{}}
 	}
@@ -143,14 +172,32 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		config:                            conf,
 		requireRegisteredGateways:         conf.RequireRegisteredGateways,
 		forward:                           forward,
+		homeDevAddrPrefixes:               homeDevAddrPrefixes,
 		upstreamHandlers:                  make(map[string]upstream.Handler),
 		statsRegistry:                     conf.Stats,
 		updateConnectionStatsDebounceTime: conf.UpdateConnectionStatsDebounceTime,
+		instanceConns:                     make(map[string]*grpc.ClientConn),
+		claims:                            make(map[string]*connectionClaim),
+		downlinkClaims:                    make(map[string]func()),
 	}
 	for _, opt := range opts {
 		opt(gs)
 	}
 
+	if gs.connectionRegistryEnabled() {
+		gs.instanceAddress = conf.ConnectionRegistry.Address
+		if gs.instanceAddress == "" {
+			gs.instanceAddress = c.GetBaseConfig(gs.Context()).Cluster.Address
+		}
+		if gs.instanceAddress == "" {
+			return nil, errNoInstanceAddress.New()
+		}
+		go func() {
+			<-gs.Context().Done()
+			gs.closeInstanceConns()
+		}()
+	}
+
 	// Setup forwarding table.
 	for name, prefix := range gs.forward {
 		if len(prefix) == 0 {
@@ -208,6 +255,14 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	}
 
 	// Start MQTT listeners.
+	chirpStackFormat := mqtt.NewChirpStackProtobuf(gs.ctx)
+	switch conf.MQTTChirpStack.Marshaler {
+	case "", "protobuf":
+	case "json":
+		chirpStackFormat = mqtt.NewChirpStackJSON(gs.ctx)
+	default:
+		return nil, errMarshaler.WithAttributes("marshaler", conf.MQTTChirpStack.Marshaler)
+	}
 	for _, version := range []struct {
 		Format mqtt.Format
 		Config config.MQTT
@@ -220,6 +275,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			Format: mqtt.NewProtobufV2(gs.ctx),
 			Config: conf.MQTTV2,
 		},
+		{
+			Format: chirpStackFormat,
+			Config: conf.MQTTChirpStack.MQTT,
+		},
 	} {
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.Config.Listen, "MQTT"),
@@ -426,6 +485,7 @@ type connectionEntry struct {
 	*io.Connection
 	upstreamDone chan struct{}
 	tasksDone    *sync.WaitGroup
+	spectrum     *spectrum.Analyzer
 }
 
 // Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
@@ -460,6 +520,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		FieldMask: pbtypes.FieldMask{
 			Paths: []string{
 				"antennas",
+				"beaconing",
 				"downlink_path_constraint",
 				"enforce_duty_cycle",
 				"frequency_plan_id",
@@ -468,6 +529,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 				"schedule_anytime_delay",
 				"schedule_downlink_late",
 				"update_location_from_status",
+				"uplink_filters",
 			},
 		},
 	}, callOpt)
@@ -500,6 +562,9 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	if err != nil {
 		return nil, err
 	}
+	if cpt, ok := gs.captures.Load(uid); ok {
+		conn.SetCapture(cpt.(*capture.Capture))
+	}
 	wg := &sync.WaitGroup{}
 	// The tasks will always start once the entry is stored.
 	// As such, we must ensure any new connection waits for
@@ -510,6 +575,9 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		upstreamDone: make(chan struct{}),
 		tasksDone:    wg,
 	}
+	if conf := gs.config.Spectrum; conf.Enable {
+		connEntry.spectrum = spectrum.NewAnalyzer(time.Now(), conf.Window, conf.MaxObservations)
+	}
 	for existing, exists := gs.connections.LoadOrStore(uid, connEntry); exists; existing, exists = gs.connections.LoadOrStore(uid, connEntry) {
 		existingConnEntry := existing.(connectionEntry)
 		logger.Warn("Disconnect existing connection")
@@ -522,14 +590,34 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		existingConnEntry.tasksDone.Wait()
 	}
 	registerGatewayConnect(ctx, ids, frontend.Protocol())
+	if connEntry.spectrum != nil && gs.config.Spectrum.Metrics {
+		spectrumMetrics.Register(uid, connEntry.spectrum)
+	}
+	// Frontends that claim downlink paths themselves claim the gateway connection with the downlink path.
+	if gs.connectionRegistryEnabled() && !frontend.SupportsDownlinkClaim() {
+		if release, err := gs.claimConnection(conn.Context(), ids); err != nil {
+			logger.WithError(err).Warn("Failed to claim gateway connection")
+		} else {
+			go func() {
+				<-conn.Context().Done()
+				release()
+			}()
+		}
+	}
 	logger.Info("Connected")
 	go gs.handleUpstream(connEntry)
 	if gs.statsRegistry != nil {
 		go gs.updateConnStats(connEntry)
 	}
+	if gs.statsHistoryEnabled() {
+		go gs.recordConnStatsHistory(connEntry)
+	}
 	if gtw.UpdateLocationFromStatus {
 		go gs.handleLocationUpdates(connEntry)
 	}
+	if conn.BeaconingEnabled() {
+		go gs.handleBeacons(connEntry)
+	}
 
 	for name, handler := range gs.upstreamHandlers {
 		handler := handler
@@ -591,7 +679,11 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 		logger   = log.FromContext(ctx)
 	)
 	defer func() {
-		gs.connections.Delete(unique.ID(ctx, gtw.GatewayIdentifiers))
+		uid := unique.ID(ctx, gtw.GatewayIdentifiers)
+		gs.connections.Delete(uid)
+		if conn.spectrum != nil {
+			spectrumMetrics.Unregister(uid, conn.spectrum)
+		}
 		registerGatewayDisconnect(ctx, gtw.GatewayIdentifiers, protocol)
 		logger.Info("Disconnected")
 		close(conn.upstreamDone)
@@ -701,6 +793,15 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			}
 			val = msg
 			registerReceiveUplink(ctx, gtw, msg.UplinkMessage, protocol)
+			if conn.spectrum != nil {
+				conn.spectrum.AddUplink(msg.UplinkMessage, gs.isForeignUplink(msg.UplinkMessage))
+			}
+			if err := filterUplink(gtw.UplinkFilters, msg.UplinkMessage); err != nil {
+				log.FromContext(ctx).WithError(err).Debug("Filter message")
+				conn.HandleFilteredUp()
+				registerFilterUplink(ctx, gtw, msg.UplinkMessage, err)
+				continue
+			}
 		case msg := <-conn.Status():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
 			val = msg
@@ -755,6 +856,28 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 	}
 }
 
+// isForeignUplink returns whether the uplink message is of a foreign network.
+// Frames that are not LoRaWAN messages and data uplink messages with a DevAddr that is not of the network of the
+// cluster are foreign. Join-request and rejoin-request messages are not foreign, as they cannot be attributed to a
+// network.
+// The network of the cluster is not derived from the forwarding configuration, as the cluster typically forwards all
+// DevAddr prefixes to the Network Server, which in turn forwards the uplinks of other networks to Packet Broker.
+func (gs *GatewayServer) isForeignUplink(up *ttnpb.UplinkMessage) bool {
+	ids, err := lorawan.GetUplinkMessageIdentifiers(up.RawPayload)
+	if err != nil {
+		return true
+	}
+	if ids.DevAddr == nil {
+		return false
+	}
+	for _, prefix := range gs.homeDevAddrPrefixes {
+		if ids.DevAddr.HasPrefix(prefix) {
+			return false
+		}
+	}
+	return true
+}
+
 func (gs *GatewayServer) updateConnStats(conn connectionEntry) {
 	ctx := conn.Context()
 	logger := log.FromContext(ctx)
@@ -794,6 +917,54 @@ func (gs *GatewayServer) updateConnStats(conn connectionEntry) {
 	}
 }
 
+func (gs *GatewayServer) statsHistoryEnabled() bool {
+	return gs.config.StatsHistory.Enable && gs.config.StatsHistory.Registry != nil
+}
+
+// recordConnStatsHistory records the connection stats history of the gateway until the connection is closed.
+// The time buckets are aligned to the bucket duration. Each time bucket is also merged into the downsampled time
+// bucket, which is stored when the next downsampled time bucket starts or when the connection is closed.
+func (gs *GatewayServer) recordConnStatsHistory(conn connectionEntry) {
+	ctx := conn.Context()
+	logger := log.FromContext(ctx)
+	conf := gs.config.StatsHistory
+	ids := conn.Gateway().GatewayIdentifiers
+
+	add := func(bucket *ttnpb.GatewayConnectionStatsBucket, retention time.Duration) {
+		if err := conf.Registry.Add(gs.FromRequestContext(ctx), ids, bucket, retention); err != nil {
+			logger.WithError(err).Warn("Failed to add connection stats history")
+		}
+	}
+	var downsampled *ttnpb.GatewayConnectionStatsBucket
+	record := func(bucket *ttnpb.GatewayConnectionStatsBucket) {
+		add(bucket, conf.Retention)
+		if downsampled != nil && !downsampled.Start.Equal(bucket.Start.Truncate(conf.DownsampledBucketDuration)) {
+			add(downsampled, conf.DownsampledRetention)
+			downsampled = nil
+		}
+		buckets := []*ttnpb.GatewayConnectionStatsBucket{bucket}
+		if downsampled != nil {
+			buckets = append([]*ttnpb.GatewayConnectionStatsBucket{downsampled}, buckets...)
+		}
+		downsampled = statshistory.Merge(buckets, conf.DownsampledBucketDuration)[0]
+	}
+
+	counter := statshistory.NewCounter(conn.Connection)
+	start := time.Now().Truncate(conf.BucketDuration)
+	for {
+		next := start.Add(conf.BucketDuration)
+		select {
+		case <-ctx.Done():
+			record(counter.Bucket(start, conf.BucketDuration))
+			add(downsampled, conf.DownsampledRetention)
+			return
+		case <-time.After(time.Until(next)):
+		}
+		record(counter.Bucket(start, conf.BucketDuration))
+		start = next
+	}
+}
+
 const (
 	allowedLocationDelta = 0.00001
 )
@@ -861,6 +1032,30 @@ func (gs *GatewayServer) handleLocationUpdates(conn connectionEntry) {
 	}
 }
 
+// beaconScheduleAhead is the time before the start of the beacon period that the beacon is scheduled.
+var beaconScheduleAhead = 5 * time.Second
+
+// handleBeacons schedules the beacon of every beacon period while the gateway is connected.
+// Beacons are only scheduled when the gateway time is synchronized with GPS time.
+func (gs *GatewayServer) handleBeacons(conn connectionEntry) {
+	ctx := conn.Context()
+	logger := log.FromContext(ctx)
+	for {
+		beaconTime := scheduling.BeaconTimeAfter(time.Now().Add(beaconScheduleAhead))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(gpstime.Parse(beaconTime - beaconScheduleAhead))):
+		}
+		logger := logger.WithField("beacon_time", beaconTime/time.Second)
+		if _, err := conn.ScheduleBeacon(beaconTime); err != nil {
+			logger.WithError(err).Debug("Failed to schedule beacon")
+			continue
+		}
+		logger.Debug("Scheduled beacon")
+	}
+}
+
 // GetFrequencyPlans gets the frequency plans by the gateway identifiers.
 func (gs *GatewayServer) GetFrequencyPlans(ctx context.Context, ids ttnpb.GatewayIdentifiers) (map[string]*frequencyplans.FrequencyPlan, error) {
 	var err error
@@ -904,12 +1099,40 @@ func (gs *GatewayServer) GetFrequencyPlans(ctx context.Context, ids ttnpb.Gatewa
 }
 
 // ClaimDownlink claims the downlink path for the given gateway.
+// If the connection registry is enabled, the gateway connection is claimed until the downlink path is unclaimed.
 func (gs *GatewayServer) ClaimDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers) error {
-	return gs.ClaimIDs(ctx, ids)
+	if err := gs.ClaimIDs(ctx, ids); err != nil {
+		return err
+	}
+	if !gs.connectionRegistryEnabled() {
+		return nil
+	}
+	release, err := gs.claimConnection(ctx, ids)
+	if err != nil {
+		return err
+	}
+	uid := unique.ID(ctx, ids)
+	gs.downlinkClaimsMu.Lock()
+	existing, ok := gs.downlinkClaims[uid]
+	gs.downlinkClaims[uid] = release
+	gs.downlinkClaimsMu.Unlock()
+	if ok {
+		// The gateway connection stays claimed by the new claim of the downlink path.
+		existing()
+	}
+	return nil
 }
 
 // UnclaimDownlink releases the claim of the downlink path for the given gateway.
 func (gs *GatewayServer) UnclaimDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers) error {
+	uid := unique.ID(ctx, ids)
+	gs.downlinkClaimsMu.Lock()
+	release, ok := gs.downlinkClaims[uid]
+	delete(gs.downlinkClaims, uid)
+	gs.downlinkClaimsMu.Unlock()
+	if ok {
+		release()
+	}
 	return gs.UnclaimIDs(ctx, ids)
 }
 
@@ -14,4 +14,16 @@
 
 package gatewayserver
 
-var ErrSchedule = errSchedule
+var (
+	ErrSchedule     = errSchedule
+	ErrNotConnected = errNotConnected
+)
+
+var (
+	ErrFilterNotAllowed = errFilterNotAllowed
+	ErrFilterDenied     = errFilterDenied
+	ErrFilterSNR        = errFilterSNR
+	ErrFilterRSSI       = errFilterRSSI
+
+	FilterUplink = filterUplink
+)
@@ -1183,6 +1183,8 @@ func TestGatewayServer(t *testing.T) {
 								for _, md := range msg.RxMetadata {
 									a.So(md.UplinkToken, should.NotBeEmpty)
 									md.UplinkToken = nil
+									a.So(md.FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+									md.FrequencyPlanID = ""
 								}
 								a.So(msg.RxMetadata, should.Resemble, expected.RxMetadata)
 								a.So(msg.RawPayload, should.Resemble, expected.RawPayload)
@@ -15,10 +15,19 @@
 package gatewayserver
 
 import (
+	"bytes"
 	"context"
+	stdio "io"
+	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
@@ -48,3 +57,283 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	}
 	return val.(connectionEntry).Stats(), nil
 }
+
+var errStatsHistoryDisabled = errors.DefineFailedPrecondition("stats_history_disabled", "connection stats history is disabled")
+
+// GetGatewayConnectionStatsHistory returns the historical connection stats of a gateway.
+// The downsampled time buckets are used if the requested bucket duration is at least the downsampled bucket duration,
+// or if the requested time buckets are older than the retention of the time buckets.
+func (gs *GatewayServer) GetGatewayConnectionStatsHistory(ctx context.Context, req *ttnpb.GetGatewayConnectionStatsHistoryRequest) (*ttnpb.GatewayConnectionStatsHistory, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if !gs.statsHistoryEnabled() {
+		return nil, errStatsHistoryDisabled.New()
+	}
+	conf := gs.config.StatsHistory
+
+	now := time.Now()
+	d, retention := conf.BucketDuration, conf.Retention
+	if req.BucketDuration != nil && *req.BucketDuration >= conf.DownsampledBucketDuration ||
+		req.After != nil && req.After.Before(now.Add(-conf.Retention)) {
+		d, retention = conf.DownsampledBucketDuration, conf.DownsampledRetention
+	}
+	before := now
+	if req.Before != nil {
+		before = *req.Before
+	}
+	after := before.Add(-retention)
+	if req.After != nil {
+		after = *req.After
+	}
+
+	buckets, err := conf.Registry.Range(ctx, req.GatewayIdentifiers, d, after, before)
+	if err != nil {
+		return nil, err
+	}
+	if req.BucketDuration != nil && *req.BucketDuration > d {
+		d = *req.BucketDuration
+	}
+	return &ttnpb.GatewayConnectionStatsHistory{
+		Buckets: statshistory.Merge(buckets, d),
+	}, nil
+}
+
+// getConnection returns the connection of the gateway, if the gateway is connected to this Gateway Server.
+func (gs *GatewayServer) getConnection(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*io.Connection, error) {
+	uid := unique.ID(ctx, ids)
+	val, ok := gs.connections.Load(uid)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	return val.(connectionEntry).Connection, nil
+}
+
+// ExecuteGatewayCommand executes the command on the gateway.
+func (gs *GatewayServer) ExecuteGatewayCommand(ctx context.Context, req *ttnpb.ExecuteGatewayCommandRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_REMOTE_COMMANDS); err != nil {
+		return nil, err
+	}
+	conn, err := gs.getConnection(ctx, req.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SendRemoteCommand(req); err != nil {
+		return nil, err
+	}
+	events.Publish(evtExecuteCommand.NewWithIdentifiersAndData(ctx, req.GatewayIdentifiers, req))
+	return ttnpb.Empty, nil
+}
+
+var errNoGatewayIDs = errors.DefineInvalidArgument("no_gateway_ids", "no gateway identifiers in first message")
+
+// RemoteShell opens a remote shell session on the gateway.
+func (gs *GatewayServer) RemoteShell(stream ttnpb.Gs_RemoteShellServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.GatewayIDs == nil {
+		return errNoGatewayIDs.New()
+	}
+	ids := *req.GatewayIDs
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	if err := rights.RequireGateway(ctx, ids, ttnpb.RIGHT_GATEWAY_REMOTE_COMMANDS); err != nil {
+		return err
+	}
+	conn, err := gs.getConnection(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.NewContextWithField(ctx, "gateway_uid", unique.ID(ctx, ids))
+	logger := log.FromContext(ctx)
+	shell := io.NewRemoteShell(ctx, req.User, req.Term)
+	if err := conn.StartRemoteShell(shell); err != nil {
+		return err
+	}
+	events.Publish(evtStartRemoteShell.NewWithIdentifiersAndData(ctx, ids, nil))
+	if len(req.Data) > 0 {
+		if err := shell.Write(req.Data); err != nil {
+			return err
+		}
+	}
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if err == stdio.EOF {
+					err = nil
+				} else if !errors.IsCanceled(err) {
+					logger.WithError(err).Warn("Remote shell stream failed")
+				}
+				shell.Close(err)
+				return
+			}
+			if err := shell.Write(req.Data); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-shell.Context().Done():
+			err := shell.Context().Err()
+			if errors.IsCanceled(err) {
+				err = nil
+			}
+			events.Publish(evtStopRemoteShell.NewWithIdentifiersAndData(ctx, ids, err))
+			return err
+		case data := <-shell.Output():
+			if err := stream.Send(&ttnpb.GatewayRemoteShellResponse{
+				Data: data,
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to send remote shell output")
+				shell.Close(err)
+			}
+		}
+	}
+}
+
+const (
+	defaultCaptureDuration   = 10 * time.Minute
+	maxCaptureDuration       = time.Hour
+	defaultCaptureMaxPackets = 1000
+	// captureRetention is the time that a capture is available for download after the end of the capture duration.
+	captureRetention = time.Hour
+)
+
+var (
+	errCaptureDuration = errors.DefineInvalidArgument(
+		"capture_duration",
+		"capture duration `{duration}` must be positive and at most `{max}`",
+	)
+	errNoCapture = errors.DefineNotFound("no_capture", "no capture for gateway `{gateway_uid}`")
+)
+
+// StartGatewayCapture starts capturing the traffic of the gateway.
+func (gs *GatewayServer) StartGatewayCapture(ctx context.Context, req *ttnpb.StartGatewayCaptureRequest) (*ttnpb.GatewayCapture, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	duration := defaultCaptureDuration
+	if req.Duration != nil {
+		duration = *req.Duration
+	}
+	if duration <= 0 || duration > maxCaptureDuration {
+		return nil, errCaptureDuration.WithAttributes(
+			"duration", duration,
+			"max", maxCaptureDuration,
+		)
+	}
+	maxPackets := defaultCaptureMaxPackets
+	if req.MaxPackets > 0 {
+		maxPackets = int(req.MaxPackets)
+	}
+	conn, err := gs.getConnection(ctx, req.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	cpt := capture.New(time.Now(), duration, maxPackets)
+	if existing, ok := gs.captures.Load(uid); ok {
+		existing.(*capture.Capture).Stop()
+	}
+	gs.captures.Store(uid, cpt)
+	conn.SetCapture(cpt)
+	time.AfterFunc(duration+captureRetention, func() {
+		if existing, ok := gs.captures.Load(uid); ok && existing == cpt {
+			gs.captures.Delete(uid)
+		}
+	})
+
+	res := cpt.Proto()
+	events.Publish(evtStartCapture.NewWithIdentifiersAndData(ctx, req.GatewayIdentifiers, res))
+	return res, nil
+}
+
+// getCapture returns the capture of the gateway.
+func (gs *GatewayServer) getCapture(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*capture.Capture, error) {
+	uid := unique.ID(ctx, ids)
+	val, ok := gs.captures.Load(uid)
+	if !ok {
+		return nil, errNoCapture.WithAttributes("gateway_uid", uid)
+	}
+	return val.(*capture.Capture), nil
+}
+
+// StopGatewayCapture stops capturing the traffic of the gateway.
+func (gs *GatewayServer) StopGatewayCapture(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayCapture, error) {
+	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	cpt, err := gs.getCapture(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	cpt.Stop()
+	res := cpt.Proto()
+	events.Publish(evtStopCapture.NewWithIdentifiersAndData(ctx, ids, res))
+	return res, nil
+}
+
+// DownloadGatewayCapture returns the captured traffic of the gateway in the requested format.
+func (gs *GatewayServer) DownloadGatewayCapture(ctx context.Context, req *ttnpb.DownloadGatewayCaptureRequest) (*ttnpb.GatewayCaptureFile, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	cpt, err := gs.getCapture(ctx, req.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.GatewayCaptureFile{
+		Capture: cpt.Proto(),
+		Format:  req.Format,
+	}
+	var buf bytes.Buffer
+	if err := capture.Write(&buf, req.Format, cpt.Packets()); err != nil {
+		return nil, err
+	}
+	res.Data = buf.Bytes()
+	return res, nil
+}
+
+var (
+	errSpectrumDisabled = errors.DefineFailedPrecondition("spectrum_disabled", "spectrum stats are disabled")
+	errSpectrumWindow   = errors.DefineInvalidArgument(
+		"spectrum_window",
+		"spectrum stats window `{window}` must be positive and at most `{max}`",
+	)
+)
+
+// GetGatewaySpectrumStats returns the spectrum and channel occupancy stats of the gateway.
+func (gs *GatewayServer) GetGatewaySpectrumStats(ctx context.Context, req *ttnpb.GetGatewaySpectrumStatsRequest) (*ttnpb.GatewaySpectrumStats, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if !gs.config.Spectrum.Enable {
+		return nil, errSpectrumDisabled.New()
+	}
+	window := gs.config.Spectrum.Window
+	if req.Window != nil {
+		if *req.Window <= 0 || *req.Window > window {
+			return nil, errSpectrumWindow.WithAttributes(
+				"window", *req.Window,
+				"max", window,
+			)
+		}
+		window = *req.Window
+	}
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	val, ok := gs.connections.Load(uid)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	return val.(connectionEntry).spectrum.Stats(time.Now(), window), nil
+}
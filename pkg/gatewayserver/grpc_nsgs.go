@@ -17,6 +17,7 @@ package gatewayserver
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/mohae/deepcopy"
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
@@ -69,7 +70,16 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		uid := unique.ID(ctx, ids)
 		conn, ok := gs.GetConnection(ctx, ids)
 		if !ok {
-			pathErrs = append(pathErrs, errNotConnected.WithAttributes("gateway_uid", uid))
+			res, err := gs.forwardDownlink(ctx, ids, path, down)
+			switch {
+			case err == nil:
+				return res, nil
+			case errors.IsNotFound(err):
+				pathErrs = append(pathErrs, errNotConnected.WithAttributes("gateway_uid", uid))
+			default:
+				logger.WithField("gateway_uid", uid).WithError(err).Debug("Failed to forward on path")
+				pathErrs = append(pathErrs, errSchedulePath.WithCause(err).WithAttributes("gateway_uid", uid))
+			}
 			continue
 		}
 		down := deepcopy.Copy(down).(*ttnpb.DownlinkMessage) // Let the connection own the DownlinkMessage.
@@ -83,6 +93,11 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		ctx = events.ContextWithCorrelationID(ctx, events.CorrelationIDsFromContext(conn.Context())...)
 		down.CorrelationIDs = append(down.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
 		registerSendDownlink(ctx, conn.Gateway(), down, conn.Frontend().Protocol())
+		if entry, ok := gs.connections.Load(uid); ok && entry.(connectionEntry).Connection == conn {
+			if analyzer := entry.(connectionEntry).spectrum; analyzer != nil {
+				analyzer.AddDownlink(down, time.Now().Add(delay))
+			}
+		}
 		return &ttnpb.ScheduleDownlinkResponse{
 			Delay: delay,
 		}, nil
@@ -0,0 +1,145 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errBeaconsNotSupported = errors.DefineFailedPrecondition(
+		"beacons_not_supported",
+		"beacons are not supported by the `{protocol}` frontend",
+	)
+	errBeaconingDisabled = errors.DefineFailedPrecondition("beaconing_disabled", "beaconing is disabled for the gateway")
+)
+
+// BeaconingEnabled returns true if the Gateway Server transmits beacons on behalf of the gateway.
+func (c *Connection) BeaconingEnabled() bool {
+	return c.gateway.Beaconing.GetEnable() && c.frontend.SupportsBeacons()
+}
+
+// beaconPayload returns the beacon payload for the beacon period that starts at the given GPS time.
+// The gateway specific field contains the location of the configured antenna if the gateway location is public.
+func (c *Connection) beaconPayload(beaconTime time.Duration) lorawan.BeaconPayload {
+	pld := lorawan.BeaconPayload{
+		Time:     uint32(beaconTime / time.Second),
+		InfoDesc: lorawan.BeaconInfoDescNetworkSpecific,
+	}
+	antennaIndex := c.gateway.Beaconing.GetAntennaIndex()
+	if c.gateway.LocationPublic && int(antennaIndex) < len(c.gateway.Antennas) {
+		loc := c.gateway.Antennas[antennaIndex].Location
+		pld.InfoDesc = uint8(antennaIndex)
+		pld.Info = lorawan.BeaconGPSCoordinates(loc.Latitude, loc.Longitude)
+	}
+	return pld
+}
+
+// ScheduleBeacon schedules the beacon of the beacon period that starts at the given GPS time and sends it on the
+// beacons channel. The beacon is scheduled with the band specific beacon settings and is protected from downlink
+// collisions by the scheduler.
+// This method returns the time until the beacon is transmitted.
+func (c *Connection) ScheduleBeacon(beaconTime time.Duration) (time.Duration, error) {
+	if !c.frontend.SupportsBeacons() {
+		return 0, errBeaconsNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	if !c.gateway.Beaconing.GetEnable() {
+		return 0, errBeaconingDisabled.New()
+	}
+	if !c.scheduler.IsGatewayTimeSynced() {
+		return 0, errNoGPSSync.New()
+	}
+	phy, err := band.GetByID(c.bandID)
+	if err != nil {
+		return 0, err
+	}
+	dr, ok := phy.DataRates[phy.Beacon.DataRateIndex]
+	if !ok {
+		return 0, errDataRate.WithAttributes("index", phy.Beacon.DataRateIndex)
+	}
+	pld := c.beaconPayload(beaconTime)
+	buf, err := lorawan.MarshalBeaconPayload(phy, pld)
+	if err != nil {
+		return 0, err
+	}
+	antennaIndex := c.gateway.Beaconing.GetAntennaIndex()
+	frequency := phy.Beacon.ComputeFrequency(float64(pld.Time))
+	transmitAt := gpstime.Parse(beaconTime + scheduling.BeaconDelay)
+	settings := ttnpb.TxSettings{
+		DataRate:      dr.Rate,
+		DataRateIndex: phy.Beacon.DataRateIndex,
+		CodingRate:    phy.Beacon.CodingRate,
+		Frequency:     frequency,
+		Time:          &transmitAt,
+		Downlink: &ttnpb.TxSettings_Downlink{
+			TxPower:            maxEIRP(phy, c.gatewayPrimaryFP, frequency),
+			AntennaIndex:       antennaIndex,
+			InvertPolarization: phy.Beacon.InvertedPolarity,
+		},
+	}
+	if int(antennaIndex) < len(c.gateway.Antennas) {
+		settings.Downlink.TxPower -= c.gateway.Antennas[antennaIndex].Gain
+	}
+	em, err := c.scheduler.ScheduleBeacon(c.ctx, scheduling.Options{
+		PayloadSize: len(buf),
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if err != nil {
+		return 0, err
+	}
+	// Beacons are transmitted on the concentrator timestamp, which is accurate as long as the clock is synchronized with
+	// the gateway time.
+	settings.Time = nil
+	settings.Timestamp = uint32(time.Duration(em.Starts()) / time.Microsecond)
+	msg := &ttnpb.DownlinkMessage{
+		RawPayload: buf,
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &settings,
+		},
+	}
+	c.captureDown(&ttnpb.GatewayDown{DownlinkMessage: msg})
+	select {
+	case <-c.ctx.Done():
+		return 0, c.ctx.Err()
+	case c.beaconCh <- msg:
+	default:
+		return 0, errBufferFull.New()
+	}
+	var delay time.Duration
+	if now, ok := c.scheduler.Now(); ok {
+		delay = time.Duration(em.Starts() - now)
+	}
+	log.FromContext(c.ctx).WithFields(log.Fields(
+		"beacon_time", pld.Time,
+		"frequency", frequency,
+		"starts", em.Starts(),
+		"duration", em.Duration(),
+	)).Debug("Scheduled beacon")
+	return delay, nil
+}
+
+// Beacons returns the beacons channel.
+func (c *Connection) Beacons() <-chan *ttnpb.DownlinkMessage {
+	return c.beaconCh
+}
@@ -0,0 +1,135 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestScheduleBeacon(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "beacon-gateway"}
+	antennaGain := float32(3)
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+		Antennas: []ttnpb.GatewayAntenna{
+			{
+				Gain: antennaGain,
+				Location: ttnpb.Location{
+					Latitude:  52.3758916,
+					Longitude: 4.8942,
+				},
+			},
+		},
+		LocationPublic: true,
+		Beaconing: &ttnpb.GatewayBeaconing{
+			Enable: true,
+		},
+	}
+	gs.RegisterGateway(ctx, ids, gtw)
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+	a.So(conn.BeaconingEnabled(), should.BeTrue)
+
+	// The mock frontend gateway time starts at Unix epoch.
+	beaconTime := gpstime.ToGPS(time.Unix(5, 0))
+
+	// Beacons cannot be scheduled without synchronization with the gateway time.
+	_, err = conn.ScheduleBeacon(beaconTime)
+	a.So(err, should.NotBeNil)
+
+	// Sync the clock with the gateway time.
+	frontend.Up <- &ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				AntennaIndex: 0,
+				Timestamp:    100,
+			},
+		},
+	}
+	select {
+	case <-conn.Up():
+	case <-time.After(timeout):
+		t.Fatalf("Expected uplink message time-out")
+	}
+
+	_, err = conn.ScheduleBeacon(beaconTime)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case beacon := <-frontend.Beacons:
+		phy, err := band.GetByID(band.EU_863_870)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var pld lorawan.BeaconPayload
+		a.So(lorawan.UnmarshalBeaconPayload(phy, beacon.RawPayload, &pld), should.BeNil)
+		a.So(pld.Time, should.Equal, uint32(beaconTime/time.Second))
+		a.So(pld.InfoDesc, should.Equal, 0)
+		lat, lng := lorawan.ParseBeaconGPSCoordinates(pld.Info)
+		a.So(lat, should.AlmostEqual, 52.3758916, 0.0001)
+		a.So(lng, should.AlmostEqual, 4.8942, 0.0001)
+
+		settings := beacon.GetScheduled()
+		if !a.So(settings, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(settings.Frequency, should.Equal, 869525000)
+		a.So(settings.DataRateIndex, should.Equal, ttnpb.DATA_RATE_3)
+		a.So(settings.Downlink.TxPower, should.Equal, phy.SubBands[4].MaxEIRP-antennaGain)
+		a.So(settings.Downlink.InvertPolarization, should.BeFalse)
+		a.So(settings.Time, should.BeNil)
+		a.So(settings.Timestamp, should.NotEqual, 0)
+	case <-time.After(timeout):
+		t.Fatalf("Expected beacon time-out")
+	}
+
+	// Beacons conflict with beacons that are already scheduled.
+	_, err = conn.ScheduleBeacon(beaconTime)
+	a.So(err, should.NotBeNil)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// SetCapture sets the capture that records the traffic of the connection.
+func (c *Connection) SetCapture(capture *capture.Capture) {
+	c.capture.Store(capture)
+}
+
+// activeCapture returns the capture of the connection if it is active.
+func (c *Connection) activeCapture() (*capture.Capture, bool) {
+	cpt, _ := c.capture.Load().(*capture.Capture)
+	if cpt == nil || !cpt.Active() {
+		return nil, false
+	}
+	return cpt, true
+}
+
+func (c *Connection) captureRaw(direction capture.Direction, raw []byte) {
+	if cpt, ok := c.activeCapture(); ok {
+		cpt.Record(capture.Packet{
+			Time:      time.Now(),
+			Direction: direction,
+			Protocol:  c.frontend.Protocol(),
+			Raw:       raw,
+		})
+	}
+}
+
+// CaptureRawUp records the raw frame received from the gateway if the traffic of the connection is captured.
+// The frame must not be modified after it is recorded.
+func (c *Connection) CaptureRawUp(raw []byte) {
+	c.captureRaw(capture.Uplink, raw)
+}
+
+// CaptureRawDown records the raw frame sent to the gateway if the traffic of the connection is captured.
+// The frame must not be modified after it is recorded.
+func (c *Connection) CaptureRawDown(raw []byte) {
+	c.captureRaw(capture.Downlink, raw)
+}
+
+// captureUp records a copy of the decoded upstream message if the traffic of the connection is captured.
+func (c *Connection) captureUp(up *ttnpb.GatewayUp) {
+	if cpt, ok := c.activeCapture(); ok {
+		cpt.Record(capture.Packet{
+			Time:      time.Now(),
+			Direction: capture.Uplink,
+			Up:        deepcopy.Copy(up).(*ttnpb.GatewayUp),
+		})
+	}
+}
+
+// captureDown records a copy of the decoded downstream message if the traffic of the connection is captured.
+func (c *Connection) captureDown(down *ttnpb.GatewayDown) {
+	if cpt, ok := c.activeCapture(); ok {
+		cpt.Record(capture.Packet{
+			Time:      time.Now(),
+			Direction: capture.Downlink,
+			Down:      deepcopy.Copy(down).(*ttnpb.GatewayDown),
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCapture(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "capture-gateway"}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+	})
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	_, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	// Traffic is not recorded without capture.
+	conn.CaptureRawUp([]byte{0x1})
+
+	cpt := capture.New(time.Now(), time.Hour, 10)
+	conn.SetCapture(cpt)
+
+	conn.CaptureRawUp([]byte{0x2})
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01},
+		Settings: ttnpb.TxSettings{
+			Frequency: 868100000,
+			Timestamp: 100,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Timestamp:          100,
+			},
+		},
+		ReceivedAt: time.Now(),
+	}
+	a.So(conn.HandleUp(up), should.BeNil)
+	status := &ttnpb.GatewayStatus{Time: time.Now()}
+	a.So(conn.HandleStatus(status), should.BeNil)
+	down := &ttnpb.DownlinkMessage{RawPayload: []byte{0x60, 0x01}}
+	a.So(conn.SendDown(down), should.BeNil)
+	conn.CaptureRawDown([]byte{0x3})
+
+	cpt.Stop()
+	conn.CaptureRawUp([]byte{0x4})
+
+	packets := cpt.Packets()
+	if !a.So(packets, should.HaveLength, 5) {
+		t.FailNow()
+	}
+	a.So(packets[0].Direction, should.Equal, capture.Uplink)
+	a.So(packets[0].Protocol, should.Equal, "mock")
+	a.So(packets[0].Raw, should.Resemble, []byte{0x2})
+	a.So(packets[1].Up.GetUplinkMessages(), should.HaveLength, 1)
+	a.So(packets[1].Up.UplinkMessages[0].RawPayload, should.Resemble, up.RawPayload)
+	a.So(packets[2].Up.GetGatewayStatus(), should.NotBeNil)
+	a.So(packets[3].Direction, should.Equal, capture.Downlink)
+	a.So(packets[3].Down.GetDownlinkMessage(), should.Resemble, down)
+	a.So(packets[4].Direction, should.Equal, capture.Downlink)
+	a.So(packets[4].Raw, should.Resemble, []byte{0x3})
+}
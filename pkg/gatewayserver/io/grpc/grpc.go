@@ -69,8 +69,10 @@ func New(server io.Server, opts ...Option) ttnpb.GtwGsServer {
 	return i
 }
 
-func (*impl) Protocol() string            { return "grpc" }
-func (*impl) SupportsDownlinkClaim() bool { return false }
+func (*impl) Protocol() string             { return "grpc" }
+func (*impl) SupportsDownlinkClaim() bool  { return false }
+func (*impl) SupportsRemoteCommands() bool { return false }
+func (*impl) SupportsBeacons() bool        { return false }
 
 var errConnect = errors.Define("connect", "failed to connect gateway `{gateway_uid}`")
 
@@ -44,6 +44,11 @@ type Frontend interface {
 	Protocol() string
 	// SupportsDownlinkClaim returns true if the frontend can itself claim downlinks.
 	SupportsDownlinkClaim() bool
+	// SupportsRemoteCommands returns true if the frontend can execute commands on gateways and open remote shell
+	// sessions.
+	SupportsRemoteCommands() bool
+	// SupportsBeacons returns true if the frontend can transmit beacons that are scheduled by the Gateway Server.
+	SupportsBeacons() bool
 }
 
 // Server represents the Gateway Server to gateway frontends.
@@ -68,12 +73,16 @@ type Server interface {
 type Connection struct {
 	// Align for sync/atomic.
 	uplinks,
-	downlinks uint64
+	filteredUplinks,
+	downlinks,
+	txAcks,
+	txAckFailures uint64
 	connectTime,
 	lastStatusTime,
 	lastUplinkTime,
 	lastDownlinkTime int64
 	lastStatus atomic.Value
+	capture    atomic.Value
 
 	ctx       context.Context
 	cancelCtx errorcontext.CancelFunc
@@ -92,6 +101,11 @@ type Connection struct {
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
 
+	remoteCommandCh chan *ttnpb.ExecuteGatewayCommandRequest
+	remoteShellCh   chan *RemoteShell
+
+	beaconCh chan *ttnpb.DownlinkMessage
+
 	statsChangedCh chan struct{}
 	locCh          chan struct{}
 }
@@ -139,6 +153,7 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 	if err != nil {
 		return nil, err
 	}
+	scheduler.ReserveBeacons(gateway.Beaconing.GetEnable() && frontend.SupportsBeacons())
 	return &Connection{
 		ctx:       ctx,
 		cancelCtx: cancelCtx,
@@ -155,6 +170,9 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		downCh:           make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh:         make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:          make(chan *ttnpb.TxAcknowledgment, bufferSize),
+		remoteCommandCh:  make(chan *ttnpb.ExecuteGatewayCommandRequest, bufferSize),
+		remoteShellCh:    make(chan *RemoteShell, bufferSize),
+		beaconCh:         make(chan *ttnpb.DownlinkMessage, bufferSize),
 		locCh:            make(chan struct{}, 1),
 		connectTime:      time.Now().UnixNano(),
 
@@ -197,6 +215,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	}
 
 	for _, md := range up.RxMetadata {
+		md.FrequencyPlanID = c.gateway.FrequencyPlanID
 		if md.AntennaIndex != 0 {
 			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
 			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
@@ -222,6 +241,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		}
 	}
 
+	c.captureUp(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
 	msg := &ttnpb.GatewayUplinkMessage{
 		UplinkMessage: up,
 		BandID:        c.bandID,
@@ -242,6 +262,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 
 // HandleStatus updates the status stats and sends the status to the status channel.
 func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
+	c.captureUp(&ttnpb.GatewayUp{GatewayStatus: status})
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -264,10 +285,15 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 
 // HandleTxAck sends the acknowledgment to the status channel.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	c.captureUp(&ttnpb.GatewayUp{TxAcknowledgment: ack})
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.txAckCh <- ack:
+		atomic.AddUint64(&c.txAcks, 1)
+		if ack.Result != ttnpb.TxAcknowledgment_SUCCESS {
+			atomic.AddUint64(&c.txAckFailures, 1)
+		}
 		c.notifyStatsChanged()
 	default:
 		return errBufferFull.New()
@@ -321,6 +347,7 @@ func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ttnpb.Gateway
 
 // SendDown sends the downlink message directly on the downlink channel.
 func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
+	c.captureDown(&ttnpb.GatewayDown{DownlinkMessage: msg})
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -340,6 +367,21 @@ var (
 	errNoFrequencyPlanIDInTxRequest = errors.DefineInvalidArgument("no_frequency_plan_id_in_tx_request", "no frequency plan ID in tx request")
 )
 
+// maxEIRP returns the maximum EIRP for transmissions on the given frequency in the given band and frequency plan.
+func maxEIRP(phy band.Band, fp *frequencyplans.FrequencyPlan, frequency uint64) float32 {
+	eirp := phy.DefaultMaxEIRP
+	if sb, ok := phy.FindSubBand(frequency); ok {
+		eirp = sb.MaxEIRP
+	}
+	if fp.MaxEIRP != nil {
+		eirp = *fp.MaxEIRP
+	}
+	if sb, ok := fp.FindSubBand(frequency); ok && sb.MaxEIRP != nil {
+		eirp = *sb.MaxEIRP
+	}
+	return eirp
+}
+
 // ScheduleDown schedules and sends a downlink message by using the given path and updates the downlink stats.
 // This method returns an error if the downlink message is not a Tx request.
 func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessage) (time.Duration, error) {
@@ -427,22 +469,12 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 				"data_rate_index", rx.dataRateIndex,
 			)
 		}
-		eirp := phy.DefaultMaxEIRP
-		if sb, ok := phy.FindSubBand(rx.frequency); ok {
-			eirp = sb.MaxEIRP
-		}
-		if fp.MaxEIRP != nil {
-			eirp = *fp.MaxEIRP
-		}
-		if sb, ok := fp.FindSubBand(rx.frequency); ok && sb.MaxEIRP != nil {
-			eirp = *sb.MaxEIRP
-		}
 		settings := ttnpb.TxSettings{
 			DataRate:      dr.Rate,
 			DataRateIndex: rx.dataRateIndex,
 			Frequency:     rx.frequency,
 			Downlink: &ttnpb.TxSettings_Downlink{
-				TxPower:      eirp,
+				TxPower:      maxEIRP(phy, fp, rx.frequency),
 				AntennaIndex: ids.AntennaIndex,
 			},
 		}
@@ -579,6 +611,17 @@ func (c *Connection) UpStats() (total uint64, t time.Time, ok bool) {
 	return
 }
 
+// HandleFilteredUp updates the filtered uplink stats.
+func (c *Connection) HandleFilteredUp() {
+	atomic.AddUint64(&c.filteredUplinks, 1)
+	c.notifyStatsChanged()
+}
+
+// FilteredUpStats returns the number of uplink messages that were filtered.
+func (c *Connection) FilteredUpStats() uint64 {
+	return atomic.LoadUint64(&c.filteredUplinks)
+}
+
 // DownStats returns the downstream statistics.
 func (c *Connection) DownStats() (total uint64, t time.Time, ok bool) {
 	total = atomic.LoadUint64(&c.downlinks)
@@ -588,11 +631,21 @@ func (c *Connection) DownStats() (total uint64, t time.Time, ok bool) {
 	return
 }
 
+// TxAckStats returns the transmission acknowledgment statistics.
+func (c *Connection) TxAckStats() (total, failures uint64) {
+	return atomic.LoadUint64(&c.txAcks), atomic.LoadUint64(&c.txAckFailures)
+}
+
 // RTTStats returns the recorded round-trip time statistics.
 func (c *Connection) RTTStats(percentile int, t time.Time) (min, max, median, np time.Duration, count int) {
 	return c.rtts.Stats(percentile, t)
 }
 
+// RTTStatsSince returns the statistics of the round-trip times recorded since the given time.
+func (c *Connection) RTTStatsSince(percentile int, since, t time.Time) (min, max, median, np time.Duration, count int) {
+	return c.rtts.StatsSince(percentile, since, t)
+}
+
 // Stats collects and returns the gateway connection statistics.
 func (c *Connection) Stats() *ttnpb.GatewayConnectionStats {
 	stats := &ttnpb.GatewayConnectionStats{}
@@ -607,6 +660,7 @@ func (c *Connection) Stats() *ttnpb.GatewayConnectionStats {
 		stats.LastUplinkReceivedAt = &t
 		stats.UplinkCount = c
 	}
+	stats.UplinkFilteredCount = c.FilteredUpStats()
 	if c, t, ok := c.DownStats(); ok {
 		stats.LastDownlinkReceivedAt = &t
 		stats.DownlinkCount = c
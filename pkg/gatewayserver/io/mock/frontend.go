@@ -28,10 +28,17 @@ type Frontend struct {
 	Status chan *ttnpb.GatewayStatus
 	TxAck  chan *ttnpb.TxAcknowledgment
 	Down   chan *ttnpb.DownlinkMessage
+
+	RemoteCommands chan *ttnpb.ExecuteGatewayCommandRequest
+	RemoteShells   chan *io.RemoteShell
+
+	Beacons chan *ttnpb.DownlinkMessage
 }
 
-func (*Frontend) Protocol() string            { return "mock" }
-func (*Frontend) SupportsDownlinkClaim() bool { return true }
+func (*Frontend) Protocol() string             { return "mock" }
+func (*Frontend) SupportsDownlinkClaim() bool  { return true }
+func (*Frontend) SupportsRemoteCommands() bool { return true }
+func (*Frontend) SupportsBeacons() bool        { return true }
 
 // ConnectFrontend connects a new mock front-end to the given server.
 // The gateway time starts at Unix epoch.
@@ -41,6 +48,11 @@ func ConnectFrontend(ctx context.Context, ids ttnpb.GatewayIdentifiers, server i
 		Status: make(chan *ttnpb.GatewayStatus, 1),
 		TxAck:  make(chan *ttnpb.TxAcknowledgment, 1),
 		Down:   make(chan *ttnpb.DownlinkMessage, 1),
+
+		RemoteCommands: make(chan *ttnpb.ExecuteGatewayCommandRequest, 1),
+		RemoteShells:   make(chan *io.RemoteShell, 1),
+
+		Beacons: make(chan *ttnpb.DownlinkMessage, 1),
 	}
 	conn, err := server.Connect(ctx, f, ids)
 	if err != nil {
@@ -71,6 +83,12 @@ func ConnectFrontend(ctx context.Context, ids ttnpb.GatewayIdentifiers, server i
 				return
 			case down := <-conn.Down():
 				f.Down <- down
+			case cmd := <-conn.RemoteCommands():
+				f.RemoteCommands <- cmd
+			case shell := <-conn.RemoteShells():
+				f.RemoteShells <- shell
+			case beacon := <-conn.Beacons():
+				f.Beacons <- beacon
 			}
 		}
 	}()
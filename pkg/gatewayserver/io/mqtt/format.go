@@ -16,6 +16,7 @@ package mqtt
 
 import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -30,4 +31,16 @@ type Format interface {
 	ToTxAck(message []byte, ids ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error)
 }
 
+// TopicIdentifier is implemented by formats that identify gateways in topics by something else than the gateway
+// unique ID.
+type TopicIdentifier interface {
+	TopicID(ids ttnpb.GatewayIdentifiers) (string, error)
+}
+
+// ConfigFormat is implemented by formats that publish the gateway configuration when the gateway subscribes to it.
+type ConfigFormat interface {
+	ConfigTopic(id string) []string
+	FromFrequencyPlan(fp *frequencyplans.FrequencyPlan, ids ttnpb.GatewayIdentifiers) ([]byte, error)
+}
+
 var errNotSupported = errors.DefineFailedPrecondition("not_supported", "not supported")
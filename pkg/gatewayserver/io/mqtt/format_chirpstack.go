@@ -0,0 +1,130 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb/chirpstack"
+)
+
+var errNoEUI = errors.DefineFailedPrecondition("no_eui", "gateway `{gateway_uid}` has no EUI")
+
+// chirpstackJSON marshals the messages like the ChirpStack Gateway Bridge, with the JSON field names and enum names.
+var chirpstackJSON = &jsonpb.GoGoJSONPb{}
+
+type chirpstackMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+type chirpstackFormat struct {
+	topics.ChirpStackLayout
+	json bool
+}
+
+func (f chirpstackFormat) marshal(msg chirpstackMessage) ([]byte, error) {
+	if f.json {
+		return chirpstackJSON.Marshal(msg)
+	}
+	return msg.Marshal()
+}
+
+// unmarshal unmarshals both JSON and Protocol Buffers payloads, regardless of the configured marshaler.
+// JSON payloads are detected by the opening brace, which is not a valid start of the Protocol Buffers messages.
+func (chirpstackFormat) unmarshal(message []byte, msg chirpstackMessage) error {
+	if len(message) > 0 && message[0] == '{' {
+		return chirpstackJSON.Unmarshal(message, msg)
+	}
+	return msg.Unmarshal(message)
+}
+
+// TopicID implements TopicIdentifier. The ChirpStack Gateway Bridge identifies gateways by their EUI.
+func (chirpstackFormat) TopicID(ids ttnpb.GatewayIdentifiers) (string, error) {
+	if ids.EUI == nil {
+		return "", errNoEUI.WithAttributes("gateway_uid", ids.GatewayID)
+	}
+	return strings.ToLower(ids.EUI.String()), nil
+}
+
+func gatewayEUIBytes(ids ttnpb.GatewayIdentifiers) []byte {
+	if ids.EUI == nil {
+		return nil
+	}
+	return ids.EUI[:]
+}
+
+func (f chirpstackFormat) FromDownlink(down *ttnpb.DownlinkMessage, ids ttnpb.GatewayIdentifiers) ([]byte, error) {
+	frame, err := chirpstack.FromDownlinkMessage(down, gatewayEUIBytes(ids))
+	if err != nil {
+		return nil, err
+	}
+	return f.marshal(frame)
+}
+
+func (f chirpstackFormat) ToUplink(message []byte, ids ttnpb.GatewayIdentifiers) (*ttnpb.UplinkMessage, error) {
+	frame := &chirpstack.UplinkFrame{}
+	if err := f.unmarshal(message, frame); err != nil {
+		return nil, err
+	}
+	return chirpstack.ToUplinkMessage(frame, ids)
+}
+
+func (f chirpstackFormat) ToStatus(message []byte, _ ttnpb.GatewayIdentifiers) (*ttnpb.GatewayStatus, error) {
+	stats := &chirpstack.GatewayStats{}
+	if err := f.unmarshal(message, stats); err != nil {
+		return nil, err
+	}
+	return chirpstack.ToGatewayStatus(stats), nil
+}
+
+func (f chirpstackFormat) ToTxAck(message []byte, _ ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error) {
+	ack := &chirpstack.DownlinkTXAck{}
+	if err := f.unmarshal(message, ack); err != nil {
+		return nil, err
+	}
+	return chirpstack.ToTxAcknowledgment(ack), nil
+}
+
+// FromFrequencyPlan implements ConfigFormat.
+func (f chirpstackFormat) FromFrequencyPlan(fp *frequencyplans.FrequencyPlan, ids ttnpb.GatewayIdentifiers) ([]byte, error) {
+	conf, err := chirpstack.FromFrequencyPlan(fp, gatewayEUIBytes(ids))
+	if err != nil {
+		return nil, err
+	}
+	return f.marshal(conf)
+}
+
+// NewChirpStackProtobuf returns a format that is compatible with the ChirpStack Gateway Bridge using Protocol Buffers
+// marshaling.
+func NewChirpStackProtobuf(ctx context.Context) Format {
+	return &chirpstackFormat{
+		ChirpStackLayout: topics.NewChirpStack(ctx),
+	}
+}
+
+// NewChirpStackJSON returns a format that is compatible with the ChirpStack Gateway Bridge using JSON marshaling.
+func NewChirpStackJSON(ctx context.Context) Format {
+	return &chirpstackFormat{
+		ChirpStackLayout: topics.NewChirpStack(ctx),
+		json:             true,
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestChirpStackTraffic(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr := mock.NewIS(ctx)
+	is.Add(ctx, registeredGatewayID, registeredGatewayKey)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	gs := mock.NewServer(c)
+	gs.RegisterGateway(ctx, registeredGatewayID, &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+			GatewayID: registeredGatewayID.GatewayID,
+			EUI:       &eui,
+		},
+		FrequencyPlanID: test.EUFrequencyPlanID,
+	})
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(ctx, gs, lis, NewChirpStackProtobuf(ctx), "tcp")
+
+	clientOpts := mqtt.NewClientOptions()
+	clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+	clientOpts.SetUsername(registeredGatewayUID)
+	clientOpts.SetPassword(registeredGatewayKey)
+	client := mqtt.NewClient(clientOpts)
+	token := client.Connect()
+	if !token.WaitTimeout(timeout) {
+		t.Fatal("Connection timeout")
+	}
+	if !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+
+	var conn *io.Connection
+	select {
+	case conn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+	defer client.Disconnect(100)
+
+	configCh := make(chan []byte, 1)
+	downCh := make(chan []byte, 1)
+	token = client.Subscribe("gateway/0102030405060708/command/#", 1, func(_ mqtt.Client, msg mqtt.Message) {
+		switch msg.Topic() {
+		case "gateway/0102030405060708/command/config":
+			configCh <- msg.Payload()
+		case "gateway/0102030405060708/command/down":
+			downCh <- msg.Payload()
+		default:
+			t.Errorf("Unexpected message on topic %q", msg.Topic())
+		}
+	})
+	if !token.WaitTimeout(timeout) {
+		t.Fatal("Subscribe timeout")
+	}
+	if !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+
+	t.Run("Config", func(t *testing.T) {
+		a := assertions.New(t)
+		select {
+		case buf := <-configCh:
+			var conf chirpstack.GatewayConfiguration
+			if !a.So(conf.Unmarshal(buf), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(conf.GatewayId, should.Resemble, eui[:])
+			a.So(conf.Version, should.NotBeEmpty)
+			a.So(conf.Channels, should.NotBeEmpty)
+		case <-time.After(timeout):
+			t.Fatal("Receive expected configuration timeout")
+		}
+	})
+
+	uplink := &chirpstack.UplinkFrame{
+		PhyPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		TxInfo: &chirpstack.UplinkTXInfo{
+			Frequency:  868100000,
+			Modulation: chirpstack.Modulation_LORA,
+			ModulationInfo: &chirpstack.UplinkTXInfo_LoraModulationInfo{
+				LoraModulationInfo: &chirpstack.LoRaModulationInfo{
+					Bandwidth:       125,
+					SpreadingFactor: 7,
+					CodeRate:        "4/5",
+				},
+			},
+		},
+		RxInfo: &chirpstack.UplinkRXInfo{
+			GatewayId: eui[:],
+			Rssi:      -42,
+			LoraSnr:   7,
+			Context:   []byte{0x00, 0x0f, 0x42, 0x40},
+			CrcStatus: chirpstack.CRCStatus_CRC_OK,
+		},
+	}
+	uplinkProtobuf, err := uplink.Marshal()
+	a.So(err, should.BeNil)
+	// The uplink as marshaled by the ChirpStack Gateway Bridge, with fields that are not vendored.
+	uplinkJSON := []byte(`{
+		"phyPayload": "QAECAwQ=",
+		"txInfo": {
+			"frequency": 868100000,
+			"modulation": "LORA",
+			"loRaModulationInfo": {"bandwidth": 125, "spreadingFactor": 7, "codeRate": "4/5", "polarizationInversion": false}
+		},
+		"rxInfo": {
+			"gatewayID": "AQIDBAUGBwg=",
+			"time": null,
+			"timeSinceGPSEpoch": null,
+			"rssi": -42,
+			"loRaSNR": 7,
+			"channel": 0,
+			"rfChain": 0,
+			"board": 0,
+			"antenna": 0,
+			"location": null,
+			"fineTimestampType": "NONE",
+			"context": "AA9CQA==",
+			"uplinkID": "AAAAAAAAAAAAAAAAAAAAAA==",
+			"crcStatus": "CRC_OK",
+			"metadata": {}
+		}
+	}`)
+	stats, err := (&chirpstack.GatewayStats{
+		GatewayId:         eui[:],
+		Ip:                "192.168.1.2",
+		RxPacketsReceived: 2,
+	}).Marshal()
+	a.So(err, should.BeNil)
+	ack, err := (&chirpstack.DownlinkTXAck{
+		GatewayId: eui[:],
+		Items: []*chirpstack.DownlinkTXAckItem{
+			{Status: chirpstack.TxAckStatus_OK},
+		},
+	}).Marshal()
+	a.So(err, should.BeNil)
+
+	t.Run("Upstream", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name    string
+			Topic   string
+			Payload []byte
+			OK      bool
+		}{
+			{
+				Name:    "UplinkProtobuf",
+				Topic:   "gateway/0102030405060708/event/up",
+				Payload: uplinkProtobuf,
+				OK:      true,
+			},
+			{
+				Name:    "UplinkJSON",
+				Topic:   "gateway/0102030405060708/event/up",
+				Payload: uplinkJSON,
+				OK:      true,
+			},
+			{
+				Name:    "UplinkOtherGateway",
+				Topic:   "gateway/0807060504030201/event/up",
+				Payload: uplinkProtobuf,
+				OK:      false, // invalid gateway EUI
+			},
+			{
+				Name:    "UplinkUID",
+				Topic:   fmt.Sprintf("gateway/%v/event/up", registeredGatewayUID),
+				Payload: uplinkProtobuf,
+				OK:      false, // gateways are identified by EUI
+			},
+			{
+				Name:    "Stats",
+				Topic:   "gateway/0102030405060708/event/stats",
+				Payload: stats,
+				OK:      true,
+			},
+			{
+				Name:    "Ack",
+				Topic:   "gateway/0102030405060708/event/ack",
+				Payload: ack,
+				OK:      true,
+			},
+		} {
+			tcok := t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				token := client.Publish(tc.Topic, 1, false, tc.Payload)
+				if !token.WaitTimeout(timeout) {
+					t.Fatal("Publish timeout")
+				}
+				if !a.So(token.Error(), should.BeNil) {
+					t.FailNow()
+				}
+				select {
+				case up := <-conn.Up():
+					if !tc.OK {
+						t.Fatalf("Did not expect uplink message, but have %v", up)
+					}
+					a.So(up.RawPayload, should.Resemble, uplink.PhyPayload)
+					a.So(up.Settings.Frequency, should.Equal, 868100000)
+					a.So(up.Settings.Timestamp, should.Equal, 1000000)
+					a.So(up.RxMetadata, should.HaveLength, 1)
+					a.So(up.RxMetadata[0].RSSI, should.Equal, -42)
+				case status := <-conn.Status():
+					if !tc.OK {
+						t.Fatalf("Did not expect status message, but have %v", status)
+					}
+					a.So(status.IP, should.Resemble, []string{"192.168.1.2"})
+					a.So(status.Metrics["rxin"], should.Equal, 2)
+				case ack := <-conn.TxAck():
+					if !tc.OK {
+						t.Fatalf("Did not expect ack message, but have %v", ack)
+					}
+					a.So(ack.Result, should.Equal, ttnpb.TxAcknowledgment_SUCCESS)
+				case <-time.After(timeout):
+					if tc.OK {
+						t.Fatal("Receive expected upstream timeout")
+					}
+				}
+			})
+			if !tcok {
+				t.FailNow()
+			}
+		}
+	})
+
+	t.Run("Downstream", func(t *testing.T) {
+		a := assertions.New(t)
+		path := &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_UplinkToken{
+				UplinkToken: io.MustUplinkToken(
+					ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: registeredGatewayID},
+					100,
+					100000,
+					time.Unix(0, 100*1000),
+				),
+			},
+		}
+		_, err := conn.ScheduleDown(path, &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x60, 0x01},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class:            ttnpb.CLASS_A,
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx1Delay:         ttnpb.RX_DELAY_1,
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     868100000,
+					Rx2DataRateIndex: 0,
+					Rx2Frequency:     869525000,
+					FrequencyPlanID:  test.EUFrequencyPlanID,
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case buf := <-downCh:
+			var frame chirpstack.DownlinkFrame
+			if !a.So(frame.Unmarshal(buf), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(frame.GatewayId, should.Resemble, eui[:])
+			a.So(frame.Items, should.HaveLength, 1)
+			a.So(frame.Items[0].PhyPayload, should.Resemble, []byte{0x60, 0x01})
+			a.So(frame.Items[0].TxInfo.Timing, should.Equal, chirpstack.DownlinkTiming_DELAY)
+			a.So(frame.Items[0].TxInfo.Context, should.HaveLength, 4)
+		case <-time.After(timeout):
+			t.Fatal("Receive expected downlink timeout")
+		}
+	})
+}
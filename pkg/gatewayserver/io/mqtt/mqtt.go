@@ -93,10 +93,13 @@ type connection struct {
 	mqtt    mqttnet.Conn
 	session session.Session
 	io      *io.Connection
+	topicID string
 }
 
-func (*connection) Protocol() string            { return "mqtt" }
-func (*connection) SupportsDownlinkClaim() bool { return false }
+func (*connection) Protocol() string             { return "mqtt" }
+func (*connection) SupportsDownlinkClaim() bool  { return false }
+func (*connection) SupportsRemoteCommands() bool { return false }
+func (*connection) SupportsBeacons() bool        { return false }
 
 func (c *connection) setup(ctx context.Context) (err error) {
 	ctx = auth.NewContextWithInterface(ctx, c)
@@ -136,6 +139,9 @@ func (c *connection) setup(ctx context.Context) (err error) {
 					return
 				case controlCh <- pkt:
 				}
+				if _, ok := pkt.(*packet.SubackPacket); ok {
+					c.publishConfig()
+				}
 			}
 		}
 	}()
@@ -154,13 +160,14 @@ func (c *connection) setup(ctx context.Context) (err error) {
 					continue
 				}
 				logger.Info("Publish downlink message")
-				topicParts := c.format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GatewayIdentifiers))
+				topicParts := c.format.DownlinkTopic(c.topicID)
 				c.session.Publish(&packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
 					TopicParts: topicParts,
 					QoS:        qosDownlink,
 					Message:    buf,
 				})
+				c.io.CaptureRawDown(buf)
 			}
 		}
 	}()
@@ -203,8 +210,29 @@ func (c *connection) setup(ctx context.Context) (err error) {
 	return nil
 }
 
+// publishConfig publishes the gateway configuration if the format supports it.
+// The session only publishes the configuration if the gateway subscribed to the configuration topic.
+func (c *connection) publishConfig() {
+	f, ok := c.format.(ConfigFormat)
+	if !ok {
+		return
+	}
+	logger := log.FromContext(c.io.Context())
+	buf, err := f.FromFrequencyPlan(c.io.PrimaryFrequencyPlan(), c.io.Gateway().GatewayIdentifiers)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal gateway configuration")
+		return
+	}
+	topicParts := f.ConfigTopic(c.topicID)
+	c.session.Publish(&packet.PublishPacket{
+		TopicName:  topic.Join(topicParts),
+		TopicParts: topicParts,
+		QoS:        qosDownlink,
+		Message:    buf,
+	})
+}
+
 type topicAccess struct {
-	gtwUID string
 	reads  [][]string
 	writes [][]string
 }
@@ -238,19 +266,29 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		return nil, err
 	}
 
+	c.topicID = uid
+	if ti, ok := c.format.(TopicIdentifier); ok {
+		if c.topicID, err = ti.TopicID(c.io.Gateway().GatewayIdentifiers); err != nil {
+			c.io.Disconnect(err)
+			return nil, err
+		}
+	}
+
 	access := topicAccess{
-		gtwUID: uid,
 		reads: [][]string{
-			c.format.DownlinkTopic(uid),
+			c.format.DownlinkTopic(c.topicID),
 		},
 		writes: [][]string{
-			c.format.BirthTopic(uid),
-			c.format.LastWillTopic(uid),
-			c.format.UplinkTopic(uid),
-			c.format.StatusTopic(uid),
-			c.format.TxAckTopic(uid),
+			c.format.BirthTopic(c.topicID),
+			c.format.LastWillTopic(c.topicID),
+			c.format.UplinkTopic(c.topicID),
+			c.format.StatusTopic(c.topicID),
+			c.format.TxAckTopic(c.topicID),
 		},
 	}
+	if cf, ok := c.format.(ConfigFormat); ok {
+		access.reads = append(access.reads, cf.ConfigTopic(c.topicID))
+	}
 	info.Metadata = access
 	info.Interface = c
 	return c.io.Context(), nil
@@ -260,11 +298,23 @@ var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not auth
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	access := info.Metadata.(topicAccess)
-	acceptedTopicParts := c.format.DownlinkTopic(access.gtwUID)
-	if !topic.MatchPath(acceptedTopicParts, topic.Split(requestedTopic)) {
+	requestedTopicParts := topic.Split(requestedTopic)
+	var matches [][]string
+	for _, reads := range access.reads {
+		if topic.MatchPath(reads, requestedTopicParts) {
+			matches = append(matches, reads)
+		}
+	}
+	switch len(matches) {
+	case 0:
 		return "", 0, errNotAuthorized.New()
+	case 1:
+		acceptedTopic = topic.Join(matches[0])
+	default:
+		// The requested filter matches multiple topics, i.e. gateway/{id}/command/#. CanRead restricts the topics that
+		// are published.
+		acceptedTopic = requestedTopic
 	}
-	acceptedTopic = topic.Join(acceptedTopicParts)
 	acceptedQoS = requestedQoS
 	return
 }
@@ -295,6 +345,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 	case c.format.IsBirthTopic(pkt.TopicParts):
 	case c.format.IsLastWillTopic(pkt.TopicParts):
 	case c.format.IsUplinkTopic(pkt.TopicParts):
+		c.io.CaptureRawUp(pkt.Message)
 		up, err := c.format.ToUplink(pkt.Message, c.io.Gateway().GatewayIdentifiers)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal uplink message")
@@ -305,6 +356,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 			logger.WithError(err).Warn("Failed to handle uplink message")
 		}
 	case c.format.IsStatusTopic(pkt.TopicParts):
+		c.io.CaptureRawUp(pkt.Message)
 		status, err := c.format.ToStatus(pkt.Message, c.io.Gateway().GatewayIdentifiers)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal status message")
@@ -314,6 +366,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 			logger.WithError(err).Warn("Failed to handle status message")
 		}
 	case c.format.IsTxAckTopic(pkt.TopicParts):
+		c.io.CaptureRawUp(pkt.Message)
 		ack, err := c.format.ToTxAck(pkt.Message, c.io.Gateway().GatewayIdentifiers)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal Tx acknowledgment message")
@@ -0,0 +1,92 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+)
+
+const topicChirpStack = "gateway"
+
+type chirpstack struct{}
+
+func (cs *chirpstack) BirthTopic(id string) []string {
+	return cs.createTopic(id, []string{"state", "conn"})
+}
+
+func (cs *chirpstack) IsBirthTopic(path []string) bool {
+	return cs.isTopic(path, "state", "conn")
+}
+
+func (cs *chirpstack) LastWillTopic(id string) []string {
+	return cs.createTopic(id, []string{"state", "conn"})
+}
+
+func (cs *chirpstack) IsLastWillTopic(path []string) bool {
+	return cs.isTopic(path, "state", "conn")
+}
+
+func (cs *chirpstack) UplinkTopic(id string) []string {
+	return cs.createTopic(id, []string{"event", "up"})
+}
+
+func (cs *chirpstack) IsUplinkTopic(path []string) bool {
+	return cs.isTopic(path, "event", "up")
+}
+
+func (cs *chirpstack) StatusTopic(id string) []string {
+	return cs.createTopic(id, []string{"event", "stats"})
+}
+
+func (cs *chirpstack) IsStatusTopic(path []string) bool {
+	return cs.isTopic(path, "event", "stats")
+}
+
+func (cs *chirpstack) TxAckTopic(id string) []string {
+	return cs.createTopic(id, []string{"event", "ack"})
+}
+
+func (cs *chirpstack) IsTxAckTopic(path []string) bool {
+	return cs.isTopic(path, "event", "ack")
+}
+
+func (cs *chirpstack) DownlinkTopic(id string) []string {
+	return cs.createTopic(id, []string{"command", "down"})
+}
+
+// ConfigTopic returns the topic on which the gateway configuration is published.
+func (cs *chirpstack) ConfigTopic(id string) []string {
+	return cs.createTopic(id, []string{"command", "config"})
+}
+
+func (cs *chirpstack) createTopic(id string, path []string) []string {
+	return append([]string{topicChirpStack, id}, path...)
+}
+
+func (cs *chirpstack) isTopic(path []string, kind, name string) bool {
+	return len(path) == 4 && path[0] == topicChirpStack && path[2] == kind && path[3] == name
+}
+
+// ChirpStackLayout is the topic layout of the ChirpStack Gateway Bridge.
+// Gateways are identified in topics by their EUI instead of their unique ID.
+type ChirpStackLayout interface {
+	Layout
+	ConfigTopic(id string) []string
+}
+
+// NewChirpStack returns the ChirpStack Gateway Bridge layout.
+func NewChirpStack(ctx context.Context) ChirpStackLayout {
+	return &chirpstack{}
+}
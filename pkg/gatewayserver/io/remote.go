@@ -0,0 +1,137 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errRemoteCommandsNotSupported = errors.DefineFailedPrecondition(
+	"remote_commands_not_supported",
+	"remote commands are not supported by the `{protocol}` frontend",
+)
+
+// RemoteShell is a remote shell session on a gateway.
+// The Gateway Server writes input with Write and reads output from Output.
+// The frontend reads input from Input and writes output with HandleOutput.
+type RemoteShell struct {
+	ctx       context.Context
+	cancelCtx errorcontext.CancelFunc
+
+	user,
+	term string
+
+	inputCh  chan []byte
+	outputCh chan []byte
+}
+
+// NewRemoteShell returns a new remote shell session for the given user and terminal type.
+// The session is closed when the context is done.
+func NewRemoteShell(ctx context.Context, user, term string) *RemoteShell {
+	ctx, cancelCtx := errorcontext.New(ctx)
+	return &RemoteShell{
+		ctx:       ctx,
+		cancelCtx: cancelCtx,
+		user:      user,
+		term:      term,
+		inputCh:   make(chan []byte, bufferSize),
+		outputCh:  make(chan []byte, bufferSize),
+	}
+}
+
+// Context returns the session context.
+func (s *RemoteShell) Context() context.Context { return s.ctx }
+
+// Close closes the session with the given error.
+func (s *RemoteShell) Close(err error) { s.cancelCtx(err) }
+
+// User returns the user that runs the remote shell on the gateway.
+func (s *RemoteShell) User() string { return s.user }
+
+// Term returns the terminal type of the remote shell.
+func (s *RemoteShell) Term() string { return s.term }
+
+// Write sends the input to the gateway.
+func (s *RemoteShell) Write(data []byte) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.inputCh <- data:
+		return nil
+	}
+}
+
+// Input returns the input to write to the gateway.
+func (s *RemoteShell) Input() <-chan []byte { return s.inputCh }
+
+// HandleOutput sends the output of the gateway to the output channel.
+func (s *RemoteShell) HandleOutput(data []byte) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.outputCh <- data:
+		return nil
+	default:
+		return errBufferFull.New()
+	}
+}
+
+// Output returns the output of the gateway.
+func (s *RemoteShell) Output() <-chan []byte { return s.outputCh }
+
+// SendRemoteCommand sends the command to execute on the gateway to the remote commands channel.
+func (c *Connection) SendRemoteCommand(cmd *ttnpb.ExecuteGatewayCommandRequest) error {
+	if !c.frontend.SupportsRemoteCommands() {
+		return errRemoteCommandsNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.remoteCommandCh <- cmd:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
+// RemoteCommands returns the remote commands channel.
+func (c *Connection) RemoteCommands() <-chan *ttnpb.ExecuteGatewayCommandRequest {
+	return c.remoteCommandCh
+}
+
+// StartRemoteShell sends the remote shell session to the remote shells channel.
+// The frontend opens the session on the gateway and closes it on the gateway when the session context is done.
+func (c *Connection) StartRemoteShell(shell *RemoteShell) error {
+	if !c.frontend.SupportsRemoteCommands() {
+		return errRemoteCommandsNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.remoteShellCh <- shell:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
+// RemoteShells returns the remote shells channel.
+func (c *Connection) RemoteShells() <-chan *RemoteShell {
+	return c.remoteShellCh
+}
@@ -62,10 +62,16 @@ func (r *rtts) Last(ref time.Time) (time.Duration, bool) {
 
 // Stats returns the min, max, median, requested percentile and number of recorded round-trip times.
 func (r *rtts) Stats(percentile int, ref time.Time) (min, max, median, np time.Duration, count int) {
+	return r.StatsSince(percentile, time.Time{}, ref)
+}
+
+// StatsSince returns the min, max, median, requested percentile and number of round-trip times recorded since the
+// given time.
+func (r *rtts) StatsSince(percentile int, since, ref time.Time) (min, max, median, np time.Duration, count int) {
 	r.mu.RLock()
 	sorted := make([]rttItem, 0, len(r.items))
 	for i, item := range r.items {
-		if ref.Sub(item.t) <= r.ttl {
+		if ref.Sub(item.t) <= r.ttl && !item.t.Before(since) {
 			sorted = append(sorted, r.items[i:]...)
 			break
 		}
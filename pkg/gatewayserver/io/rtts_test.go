@@ -69,4 +69,7 @@ func TestRTTs(t *testing.T) {
 
 	_, _, _, _, count = rtts.Stats(90, ref.Add(10*time.Second))
 	a.So(count, should.Equal, 3)
+
+	_, _, _, _, count = rtts.StatsSince(90, ref.Add(6*time.Second), ref.Add(7*time.Second))
+	a.So(count, should.Equal, 2)
 }
@@ -35,19 +35,27 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
 
+// rawPacket is a packet received from a gateway with its raw frame.
+type rawPacket struct {
+	encoding.Packet
+	raw []byte
+}
+
 type srv struct {
 	ctx    context.Context
 	config Config
 
 	server      io.Server
 	conn        *net.UDPConn
-	packetCh    chan encoding.Packet
+	packetCh    chan rawPacket
 	connections sync.Map
 	firewall    Firewall
 }
 
-func (*srv) Protocol() string            { return "udp" }
-func (*srv) SupportsDownlinkClaim() bool { return true }
+func (*srv) Protocol() string             { return "udp" }
+func (*srv) SupportsDownlinkClaim() bool  { return true }
+func (*srv) SupportsRemoteCommands() bool { return false }
+func (*srv) SupportsBeacons() bool        { return true }
 
 var errUDPFrontendRecovered = errors.DefineInternal("udp_frontend_recovered", "internal server error")
 
@@ -66,7 +74,7 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, config Conf
 		config:   config,
 		server:   server,
 		conn:     conn,
-		packetCh: make(chan encoding.Packet, config.PacketBuffer),
+		packetCh: make(chan rawPacket, config.PacketBuffer),
 		firewall: firewall,
 	}
 	go s.gc()
@@ -122,7 +130,7 @@ func (s *srv) read() (err error) {
 		}
 
 		select {
-		case s.packetCh <- packet:
+		case s.packetCh <- rawPacket{Packet: packet, raw: packetBuf}:
 		default:
 			log.FromContext(ctx).Warn("Packet handlers busy, dropping packet")
 		}
@@ -143,13 +151,13 @@ func (s *srv) handlePackets() {
 
 			switch packet.PacketType {
 			case encoding.PullData, encoding.PushData:
-				if err := s.writeAckFor(packet); err != nil {
+				if err := s.writeAckFor(packet.Packet); err != nil {
 					logger.WithError(err).Warn("Failed to write acknowledgement")
 				}
 			}
 
 			if s.firewall != nil {
-				if err := s.firewall.Filter(packet); err != nil {
+				if err := s.firewall.Filter(packet.Packet); err != nil {
 					logger.WithError(err).Warn("Packet filtered")
 					break
 				}
@@ -161,7 +169,8 @@ func (s *srv) handlePackets() {
 				break
 			}
 
-			if err := s.handleUp(cs.io.Context(), cs, packet); err != nil {
+			cs.io.CaptureRawUp(packet.raw)
+			if err := s.handleUp(cs.io.Context(), cs, packet.Packet); err != nil {
 				logger.WithError(err).Warn("Failed to handle upstream packet")
 			}
 		}
@@ -355,43 +364,14 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
 				break
 			}
-			downlinkPath := state.lastDownlinkPath.Load().(downlinkPath)
-			logger := logger.WithField("remote_addr", downlinkPath.addr.String())
-			packet := encoding.Packet{
-				GatewayAddr:     &downlinkPath.addr,
-				ProtocolVersion: downlinkPath.version,
-				PacketType:      encoding.PullResp,
-				Data: &encoding.Data{
-					TxPacket: tx,
-				},
-			}
-			write := func() {
-				logger.Debug("Write downlink message")
-				token := state.tokens.Next(down.CorrelationIDs, time.Now())
-				packet.Token = [2]byte{byte(token >> 8), byte(token)}
-				if err := s.write(packet); err != nil {
-					logger.WithError(err).Warn("Failed to write downlink message")
-					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
-				}
-			}
-			canImmediate := atomic.LoadUint32(&state.receivedTxAck) == 1
-			forceLate := state.io.Gateway().ScheduleDownlinkLate
-			if canImmediate && !forceLate {
-				write()
-				break
-			}
-			state.clockMu.RLock()
-			if !state.clock.IsSynced() {
-				state.clockMu.RUnlock()
-				logger.Warn("Schedule late forced but no gateway clock available")
-				write()
+			s.writeDown(ctx, state, down, tx)
+		case beacon := <-state.io.Beacons():
+			tx, err := encoding.FromBeaconMessage(beacon)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to marshal beacon")
 				break
 			}
-			serverTime := state.clock.ToServerTime(state.clock.FromTimestampTime(tx.Tmst))
-			state.clockMu.RUnlock()
-			d := time.Until(serverTime.Add(-s.config.ScheduleLateTime))
-			logger.WithField("duration", d).Debug("Wait to schedule downlink message late")
-			time.AfterFunc(d, write)
+			s.writeDown(ctx, state, beacon, tx)
 		case <-healthCheck.C:
 			lastSeenPull := time.Unix(0, atomic.LoadInt64(&state.lastSeenPull))
 			if time.Since(lastSeenPull) > s.config.DownlinkPathExpires {
@@ -402,13 +382,58 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 	}
 }
 
-func (s *srv) write(packet encoding.Packet) error {
+// writeDown writes the downlink message to the gateway. If the gateway has no just-in-time queue or if the gateway
+// is configured to schedule downlink late, the downlink message is written right before it should be transmitted.
+func (s *srv) writeDown(ctx context.Context, state *state, down *ttnpb.DownlinkMessage, tx *encoding.TxPacket) {
+	downlinkPath := state.lastDownlinkPath.Load().(downlinkPath)
+	logger := log.FromContext(ctx).WithField("remote_addr", downlinkPath.addr.String())
+	packet := encoding.Packet{
+		GatewayAddr:     &downlinkPath.addr,
+		ProtocolVersion: downlinkPath.version,
+		PacketType:      encoding.PullResp,
+		Data: &encoding.Data{
+			TxPacket: tx,
+		},
+	}
+	write := func() {
+		logger.Debug("Write downlink message")
+		token := state.tokens.Next(down.CorrelationIDs, time.Now())
+		packet.Token = [2]byte{byte(token >> 8), byte(token)}
+		if err := s.write(state.io, packet); err != nil {
+			logger.WithError(err).Warn("Failed to write downlink message")
+			// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+		}
+	}
+	canImmediate := atomic.LoadUint32(&state.receivedTxAck) == 1
+	forceLate := state.io.Gateway().ScheduleDownlinkLate
+	if canImmediate && !forceLate {
+		write()
+		return
+	}
+	state.clockMu.RLock()
+	if !state.clock.IsSynced() {
+		state.clockMu.RUnlock()
+		logger.Warn("Schedule late forced but no gateway clock available")
+		write()
+		return
+	}
+	serverTime := state.clock.ToServerTime(state.clock.FromTimestampTime(tx.Tmst))
+	state.clockMu.RUnlock()
+	d := time.Until(serverTime.Add(-s.config.ScheduleLateTime))
+	logger.WithField("duration", d).Debug("Wait to schedule downlink message late")
+	time.AfterFunc(d, write)
+}
+
+func (s *srv) write(conn *io.Connection, packet encoding.Packet) error {
 	buf, err := packet.MarshalBinary()
 	if err != nil {
 		return err
 	}
-	_, err = s.conn.WriteToUDP(buf, packet.GatewayAddr)
-	return err
+	if _, err := s.conn.WriteToUDP(buf, packet.GatewayAddr); err != nil {
+		return err
+	}
+	conn.CaptureRawDown(buf)
+	return nil
 }
 
 func (s *srv) writeAckFor(packet encoding.Packet) error {
@@ -48,3 +48,19 @@ type Formatter interface {
 	// FromDownlink generates a downlink byte stream that can be sent over the WS connection.
 	FromDownlink(ctx context.Context, uid string, down ttnpb.DownlinkMessage, concentratorTime scheduling.ConcentratorTime, dlTime time.Time) ([]byte, error)
 }
+
+// RemoteFormatter is a Formatter that supports remote commands and remote shell sessions.
+type RemoteFormatter interface {
+	Formatter
+	// FromRemoteCommand generates a byte stream to execute the command on the gateway.
+	FromRemoteCommand(ctx context.Context, cmd *ttnpb.ExecuteGatewayCommandRequest) ([]byte, error)
+	// FromRemoteShellStart generates a byte stream to start the remote shell session with the given index.
+	FromRemoteShellStart(ctx context.Context, index uint8, user, term string) ([]byte, error)
+	// FromRemoteShellStop generates a byte stream to stop the remote shell session with the given index.
+	FromRemoteShellStop(ctx context.Context, index uint8) ([]byte, error)
+	// FromRemoteShellInput generates a binary byte stream with the input of the remote shell session with the given index.
+	FromRemoteShellInput(ctx context.Context, index uint8, data []byte) ([]byte, error)
+	// ToRemoteShellOutput parses a binary byte stream with output of a remote shell session.
+	// This function returns the index of the remote shell session and the output.
+	ToRemoteShellOutput(ctx context.Context, raw []byte) (uint8, []byte, error)
+}
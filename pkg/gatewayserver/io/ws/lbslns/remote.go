@@ -0,0 +1,135 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// RemoteCommand is the command to execute on the LoRa Basics Station.
+type RemoteCommand struct {
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (cmd RemoteCommand) MarshalJSON() ([]byte, error) {
+	type Alias RemoteCommand
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteCommand,
+		Alias: Alias(cmd),
+	})
+}
+
+// RemoteShellStart starts the remote shell session with the given index on the LoRa Basics Station.
+type RemoteShellStart struct {
+	User  string `json:"user"`
+	Term  string `json:"term"`
+	Start uint8  `json:"start"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (start RemoteShellStart) MarshalJSON() ([]byte, error) {
+	type Alias RemoteShellStart
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteShell,
+		Alias: Alias(start),
+	})
+}
+
+// RemoteShellStop stops the remote shell session with the given index on the LoRa Basics Station.
+type RemoteShellStop struct {
+	Stop uint8 `json:"stop"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (stop RemoteShellStop) MarshalJSON() ([]byte, error) {
+	type Alias RemoteShellStop
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteShell,
+		Alias: Alias(stop),
+	})
+}
+
+// RemoteShellSession is the state of a remote shell session on the LoRa Basics Station.
+type RemoteShellSession struct {
+	User    string `json:"user"`
+	Started bool   `json:"started"`
+	Age     int64  `json:"age"`
+	PID     int64  `json:"pid"`
+}
+
+// RemoteShellState is the state of the remote shell sessions sent by the LoRa Basics Station.
+type RemoteShellState struct {
+	Sessions []RemoteShellSession `json:"rmtsh"`
+}
+
+// FromRemoteCommand implements ws.RemoteFormatter.
+func (f *lbsLNS) FromRemoteCommand(ctx context.Context, cmd *ttnpb.ExecuteGatewayCommandRequest) ([]byte, error) {
+	arguments := cmd.Arguments
+	if arguments == nil {
+		arguments = []string{}
+	}
+	return RemoteCommand{
+		Command:   cmd.Command,
+		Arguments: arguments,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellStart implements ws.RemoteFormatter.
+func (f *lbsLNS) FromRemoteShellStart(ctx context.Context, index uint8, user, term string) ([]byte, error) {
+	return RemoteShellStart{
+		User:  user,
+		Term:  term,
+		Start: index,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellStop implements ws.RemoteFormatter.
+func (f *lbsLNS) FromRemoteShellStop(ctx context.Context, index uint8) ([]byte, error) {
+	return RemoteShellStop{
+		Stop: index,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellInput implements ws.RemoteFormatter.
+// The binary message contains the session index, followed by the input.
+func (f *lbsLNS) FromRemoteShellInput(ctx context.Context, index uint8, data []byte) ([]byte, error) {
+	return append([]byte{index}, data...), nil
+}
+
+var errRemoteShellOutput = errors.DefineInvalidArgument("remote_shell_output", "invalid remote shell output")
+
+// ToRemoteShellOutput implements ws.RemoteFormatter.
+// The binary message contains the session index, followed by the output.
+func (f *lbsLNS) ToRemoteShellOutput(ctx context.Context, raw []byte) (uint8, []byte, error) {
+	if len(raw) == 0 {
+		return 0, nil, errRemoteShellOutput.New()
+	}
+	return raw[0], raw[1:], nil
+}
@@ -540,7 +540,23 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 		}
 		recordTime(recordRTT, txConf.RefTime, txConf.XTime, receivedAt)
 
-	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell, TypeUpstreamTimeSync:
+	case TypeUpstreamRemoteShell:
+		var state RemoteShellState
+		if err := json.Unmarshal(raw, &state); err != nil {
+			logger.WithError(err).Debug("Failed to unmarshal remote shell state message")
+			return nil, err
+		}
+		for i, session := range state.Sessions {
+			logger.WithFields(log.Fields(
+				"index", i,
+				"user", session.User,
+				"started", session.Started,
+				"age", session.Age,
+				"pid", session.PID,
+			)).Debug("Received remote shell session state")
+		}
+
+	case TypeUpstreamProprietaryDataFrame, TypeUpstreamTimeSync:
 		logger.WithField("message_type", typ).Debug("Message type not implemented")
 
 	default:
@@ -0,0 +1,150 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+// maxRemoteShells is the maximum number of concurrent remote shell sessions per gateway.
+const maxRemoteShells = 4
+
+var errTooManyRemoteShells = errors.DefineResourceExhausted(
+	"too_many_remote_shells",
+	"too many remote shell sessions, at most `{max}` sessions are allowed per gateway",
+)
+
+// remoteShells contains the remote shell sessions of a gateway connection by their index.
+type remoteShells struct {
+	mu       sync.RWMutex
+	sessions [maxRemoteShells]*io.RemoteShell
+}
+
+// add adds the remote shell session and returns its index.
+// This method returns false if there are too many remote shell sessions.
+func (r *remoteShells) add(shell *io.RemoteShell) (uint8, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, s := range r.sessions {
+		if s == nil {
+			r.sessions[i] = shell
+			return uint8(i), true
+		}
+	}
+	return 0, false
+}
+
+// get returns the remote shell session by its index.
+func (r *remoteShells) get(index uint8) *io.RemoteShell {
+	if int(index) >= maxRemoteShells {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sessions[index]
+}
+
+// remove removes the remote shell session by its index.
+func (r *remoteShells) remove(index uint8) {
+	r.mu.Lock()
+	r.sessions[index] = nil
+	r.mu.Unlock()
+}
+
+type writeMessageFunc func(messageType int, data []byte) error
+
+// handleRemoteShell opens the remote shell session on the gateway and writes the input of the session to the gateway
+// until the session or the connection is closed.
+func (s *srv) handleRemoteShell(ctx context.Context, conn *io.Connection, formatter RemoteFormatter, shells *remoteShells, shell *io.RemoteShell, write writeMessageFunc) {
+	index, ok := shells.add(shell)
+	if !ok {
+		shell.Close(errTooManyRemoteShells.WithAttributes("max", maxRemoteShells))
+		return
+	}
+	defer shells.remove(index)
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"remote_shell_index", index,
+		"remote_shell_user", shell.User(),
+	))
+
+	msg, err := formatter.FromRemoteShellStart(ctx, index, shell.User(), shell.Term())
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal remote shell start message")
+		shell.Close(err)
+		return
+	}
+	if err := write(websocket.TextMessage, msg); err != nil {
+		logger.WithError(err).Warn("Failed to send remote shell start message")
+		shell.Close(err)
+		return
+	}
+	logger.Info("Started remote shell session")
+
+	for {
+		select {
+		case <-conn.Context().Done():
+			shell.Close(conn.Context().Err())
+			return
+		case <-shell.Context().Done():
+			msg, err := formatter.FromRemoteShellStop(ctx, index)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to marshal remote shell stop message")
+				return
+			}
+			if err := write(websocket.TextMessage, msg); err != nil {
+				logger.WithError(err).Warn("Failed to send remote shell stop message")
+				return
+			}
+			logger.Info("Stopped remote shell session")
+			return
+		case data := <-shell.Input():
+			msg, err := formatter.FromRemoteShellInput(ctx, index, data)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to marshal remote shell input")
+				shell.Close(err)
+				continue
+			}
+			if err := write(websocket.BinaryMessage, msg); err != nil {
+				logger.WithError(err).Warn("Failed to send remote shell input")
+				shell.Close(err)
+				continue
+			}
+		}
+	}
+}
+
+// handleRemoteShellOutput sends the output of a remote shell session to the session.
+func (s *srv) handleRemoteShellOutput(ctx context.Context, formatter RemoteFormatter, shells *remoteShells, raw []byte) {
+	logger := log.FromContext(ctx)
+	index, data, err := formatter.ToRemoteShellOutput(ctx, raw)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to parse remote shell output")
+		return
+	}
+	shell := shells.get(index)
+	if shell == nil {
+		logger.WithField("remote_shell_index", index).Debug("Received output of unknown remote shell session")
+		return
+	}
+	if err := shell.HandleOutput(data); err != nil {
+		logger.WithError(err).WithField("remote_shell_index", index).Warn("Failed to handle remote shell output")
+	}
+}
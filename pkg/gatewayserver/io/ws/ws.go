@@ -58,6 +58,11 @@ type srv struct {
 
 func (s *srv) Protocol() string            { return "ws" }
 func (s *srv) SupportsDownlinkClaim() bool { return false }
+func (s *srv) SupportsBeacons() bool       { return false }
+func (s *srv) SupportsRemoteCommands() bool {
+	_, ok := s.formatter.(RemoteFormatter)
+	return ok
+}
 
 // New creates a new WebSocket frontend.
 func New(ctx context.Context, server io.Server, formatter Formatter, cfg Config) *echo.Echo {
@@ -218,6 +223,13 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 	}
 	defer ws.Close()
 	wsWriteMu := &sync.Mutex{}
+	writeMessage := func(messageType int, data []byte) error {
+		wsWriteMu.Lock()
+		defer wsWriteMu.Unlock()
+		return ws.WriteMessage(messageType, data)
+	}
+	remoteFormatter, _ := s.formatter.(RemoteFormatter)
+	shells := &remoteShells{}
 
 	defer func() {
 		conn.Disconnect(err)
@@ -284,16 +296,36 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 					conn.Disconnect(err)
 					return
 				}
+				conn.CaptureRawDown(dnmsg)
+			case cmd := <-conn.RemoteCommands():
+				msg, err := remoteFormatter.FromRemoteCommand(ctx, cmd)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal remote command message")
+					continue
+				}
+				logger.WithField("command", cmd.Command).Info("Send remote command message")
+				if err := writeMessage(websocket.TextMessage, msg); err != nil {
+					logger.WithError(err).Warn("Failed to send remote command message")
+					conn.Disconnect(err)
+					return
+				}
+			case shell := <-conn.RemoteShells():
+				go s.handleRemoteShell(ctx, conn, remoteFormatter, shells, shell, writeMessage)
 			}
 		}
 	}()
 
 	for {
-		_, data, err := ws.ReadMessage()
+		messageType, data, err := ws.ReadMessage()
 		if err != nil {
 			logger.WithError(err).Debug("Failed to read message")
 			return err
 		}
+		if messageType == websocket.BinaryMessage && remoteFormatter != nil {
+			s.handleRemoteShellOutput(ctx, remoteFormatter, shells, data)
+			continue
+		}
+		conn.CaptureRawUp(data)
 		sessionCtx := NewContextWithSession(ctx, &session)
 		downstream, err := s.formatter.HandleUp(sessionCtx, data, ids, conn, time.Now())
 		if err != nil {
@@ -309,6 +341,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 				conn.Disconnect(err)
 				return err
 			}
+			conn.CaptureRawDown(downstream)
 		}
 	}
 }
@@ -993,6 +993,8 @@ func TestTraffic(t *testing.T) {
 						}
 						up.RawPayload = nil
 						up.RxMetadata[0].UplinkToken = nil
+						a.So(up.RxMetadata[0].FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+						up.RxMetadata[0].FrequencyPlanID = ""
 						expectedUp := tc.ExpectedNetworkUpstream.(ttnpb.UplinkMessage)
 						a.So(up.UplinkMessage, should.Resemble, &expectedUp)
 					case <-time.After(timeout):
@@ -1350,6 +1352,116 @@ func TestRTT(t *testing.T) {
 	}
 }
 
+func TestRemoteCommands(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr := mock.NewIS(ctx)
+	is.Add(ctx, registeredGatewayID, registeredGatewayToken)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+	gs := mock.NewServer(c)
+
+	bsWebServer := New(ctx, gs, lbslns.NewFormatter(maxValidRoundTripDelay), defaultConfig)
+	lis, err := net.Listen("tcp", serverAddress)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	go func() error {
+		return http.Serve(lis, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			bsWebServer.ServeHTTP(w, r)
+		}))
+	}()
+	servAddr := fmt.Sprintf("ws://%s", lis.Addr().String())
+
+	wsConn, _, err := websocket.DefaultDialer.Dial(servAddr+testTrafficEndPoint, nil)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Connection failed: %v", err)
+	}
+	defer wsConn.Close()
+
+	var gsConn *io.Connection
+	select {
+	case gsConn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+
+	type message struct {
+		messageType int
+		data        []byte
+	}
+	msgCh := make(chan message, 1)
+	go func() {
+		for {
+			messageType, data, err := wsConn.ReadMessage()
+			if err != nil {
+				return
+			}
+			msgCh <- message{messageType, data}
+		}
+	}()
+	expectMessage := func(messageType int, data []byte) bool {
+		select {
+		case msg := <-msgCh:
+			return a.So(msg.messageType, should.Equal, messageType) && a.So(msg.data, should.Resemble, data)
+		case <-time.After(timeout):
+			t.Fatal("Read message timeout")
+			return false
+		}
+	}
+
+	// Remote command.
+	if err := gsConn.SendRemoteCommand(&ttnpb.ExecuteGatewayCommandRequest{
+		GatewayIdentifiers: registeredGatewayID,
+		Command:            "reboot",
+	}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expectMessage(websocket.TextMessage, []byte(`{"msgtype":"runcmd","command":"reboot","arguments":[]}`))
+
+	// Remote shell.
+	shell := io.NewRemoteShell(ctx, "root", "xterm")
+	if err := gsConn.StartRemoteShell(shell); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expectMessage(websocket.TextMessage, []byte(`{"msgtype":"rmtsh","user":"root","term":"xterm","start":0}`))
+
+	if err := shell.Write([]byte("ls\n")); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expectMessage(websocket.BinaryMessage, []byte("\x00ls\n"))
+
+	if err := wsConn.WriteMessage(websocket.BinaryMessage, []byte("\x00station.conf\n")); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case data := <-shell.Output():
+		a.So(data, should.Resemble, []byte("station.conf\n"))
+	case <-time.After(timeout):
+		t.Fatal("Remote shell output timeout")
+	}
+
+	shell.Close(nil)
+	expectMessage(websocket.TextMessage, []byte(`{"msgtype":"rmtsh","stop":0}`))
+}
+
 func TestPingPong(t *testing.T) {
 	a := assertions.New(t)
 	ctx := log.NewContext(test.Context(), test.GetLogger(t))
@@ -20,6 +20,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/spectrum"
 	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -71,6 +72,11 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
 		events.WithErrorDataType(),
 	)
+	evtFilterUp = events.Define(
+		"gs.up.filter", "filter uplink message",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
 	evtForwardUp = events.Define(
 		"gs.up.forward", "forward uplink message",
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
@@ -94,6 +100,40 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
 		events.WithDataType(ttnpb.TxAcknowledgment_COLLISION_PACKET),
 	)
+	evtExecuteCommand = events.Define(
+		"gs.gateway.command.execute", "execute command on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_REMOTE_COMMANDS),
+		events.WithDataType(&ttnpb.ExecuteGatewayCommandRequest{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStartRemoteShell = events.Define(
+		"gs.gateway.remote_shell.start", "start remote shell session on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_REMOTE_COMMANDS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStopRemoteShell = events.Define(
+		"gs.gateway.remote_shell.stop", "stop remote shell session on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_REMOTE_COMMANDS),
+		events.WithErrorDataType(),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStartCapture = events.Define(
+		"gs.gateway.capture.start", "start gateway traffic capture",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.GatewayCapture{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStopCapture = events.Define(
+		"gs.gateway.capture.stop", "stop gateway traffic capture",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.GatewayCapture{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
 )
 
 const (
@@ -161,6 +201,14 @@ var gsMetrics = &messageMetrics{
 		},
 		[]string{protocol},
 	),
+	uplinkFiltered: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_filtered_total",
+			Help:      "Total number of uplinks filtered by gateway uplink filters",
+		},
+		[]string{"error"},
+	),
 	uplinkForwarded: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -211,8 +259,10 @@ var gsMetrics = &messageMetrics{
 	),
 }
 
+var spectrumMetrics = spectrum.NewCollector(subsystem)
+
 func init() {
-	metrics.MustRegister(gsMetrics)
+	metrics.MustRegister(gsMetrics, spectrumMetrics)
 }
 
 type messageMetrics struct {
@@ -223,6 +273,7 @@ type messageMetrics struct {
 	statusDropped       *metrics.ContextualCounterVec
 	statusFailed        *metrics.ContextualCounterVec
 	uplinkReceived      *metrics.ContextualCounterVec
+	uplinkFiltered      *metrics.ContextualCounterVec
 	uplinkForwarded     *metrics.ContextualCounterVec
 	uplinkDropped       *metrics.ContextualCounterVec
 	uplinkFailed        *metrics.ContextualCounterVec
@@ -239,6 +290,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.statusDropped.Describe(ch)
 	m.statusFailed.Describe(ch)
 	m.uplinkReceived.Describe(ch)
+	m.uplinkFiltered.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
 	m.uplinkFailed.Describe(ch)
@@ -255,6 +307,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.statusDropped.Collect(ch)
 	m.statusFailed.Collect(ch)
 	m.uplinkReceived.Collect(ch)
+	m.uplinkFiltered.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
 	m.uplinkFailed.Collect(ch)
@@ -310,6 +363,15 @@ func registerReceiveUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.U
 	gsMetrics.uplinkReceived.WithLabelValues(ctx, protocol).Inc()
 }
 
+func registerFilterUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.UplinkMessage, err error) {
+	events.Publish(evtFilterUp.NewWithIdentifiersAndData(ctx, gtw, err))
+	if ttnErr, ok := errors.From(err); ok {
+		gsMetrics.uplinkFiltered.WithLabelValues(ctx, ttnErr.FullName()).Inc()
+	} else {
+		gsMetrics.uplinkFiltered.WithLabelValues(ctx, unknown).Inc()
+	}
+}
+
 func registerForwardUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.UplinkMessage, host string) {
 	events.Publish(evtForwardUp.NewWithIdentifiersAndData(ctx, gtw, nil))
 	gsMetrics.uplinkForwarded.WithLabelValues(ctx, host).Inc()
@@ -0,0 +1,107 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errConnectionClaimed = errors.DefineAborted("connection_claimed", "connection of gateway `{gateway_uid}` is claimed by `{address}`")
+
+// renewScript renews the lease if it is held by the instance or if it expired.
+// It returns an empty string if the lease is renewed, or the address of the instance that holds the lease otherwise.
+var renewScript = redis.NewScript(`local address = redis.call('get', KEYS[1])
+if not address or address == ARGV[1] then
+	redis.call('set', KEYS[1], ARGV[1], 'px', ARGV[2])
+	return ''
+end
+return address`)
+
+// releaseScript deletes the lease if it is held by the instance.
+var releaseScript = redis.NewScript(`if redis.call('get', KEYS[1]) == ARGV[1] then
+	redis.call('del', KEYS[1])
+end
+return redis.status_reply('OK')`)
+
+// GatewayConnectionRegistry implements the GatewayConnectionRegistry interface.
+// The lease of a gateway connection is a key with the address of the instance that expires with the lease.
+type GatewayConnectionRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayConnectionRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Claim claims the connection of a gateway for the instance with the given address.
+func (r *GatewayConnectionRegistry) Claim(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string, ttl time.Duration) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "claim gateway connection").End()
+
+	if err := r.Redis.Set(ctx, r.key(uid), address, ttl).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Renew renews the claim of the instance with the given address.
+func (r *GatewayConnectionRegistry) Renew(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string, ttl time.Duration) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "renew gateway connection").End()
+
+	holder, err := renewScript.Run(ctx, r.Redis, []string{r.key(uid)}, address, ttl.Milliseconds()).Text()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if holder != "" {
+		return errConnectionClaimed.WithAttributes(
+			"gateway_uid", uid,
+			"address", holder,
+		)
+	}
+	return nil
+}
+
+// Release releases the claim of the instance with the given address.
+func (r *GatewayConnectionRegistry) Release(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "release gateway connection").End()
+
+	if err := releaseScript.Run(ctx, r.Redis, []string{r.key(uid)}, address).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Get returns the address of the instance that holds the connection of a gateway.
+func (r *GatewayConnectionRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (string, error) {
+	uid := unique.ID(ctx, ids)
+	address, err := r.Redis.Get(ctx, r.key(uid)).Result()
+	if err != nil {
+		return "", ttnredis.ConvertError(err)
+	}
+	return address, nil
+}
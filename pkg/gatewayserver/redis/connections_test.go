@@ -0,0 +1,75 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestConnectionRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "gtw1"}
+	ids2 := ttnpb.GatewayIdentifiers{GatewayID: "gtw2"}
+	registry := &GatewayConnectionRegistry{
+		Redis: cl,
+	}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	a.So(registry.Claim(ctx, ids, "gs1:1884", time.Minute), should.BeNil)
+	address, err := registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs1:1884")
+	a.So(registry.Renew(ctx, ids, "gs1:1884", time.Minute), should.BeNil)
+
+	// Other gateways not affected.
+	_, err = registry.Get(ctx, ids2)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Another instance takes over the connection.
+	a.So(registry.Claim(ctx, ids, "gs2:1884", time.Minute), should.BeNil)
+	err = registry.Renew(ctx, ids, "gs1:1884", time.Minute)
+	a.So(errors.IsAborted(err), should.BeTrue)
+	a.So(registry.Release(ctx, ids, "gs1:1884"), should.BeNil)
+	address, err = registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs2:1884")
+
+	a.So(registry.Release(ctx, ids, "gs2:1884"), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Renewing an expired lease claims the connection again.
+	a.So(registry.Claim(ctx, ids, "gs1:1884", 50*time.Millisecond), should.BeNil)
+	time.Sleep(100 * time.Millisecond)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(registry.Renew(ctx, ids, "gs1:1884", time.Minute), should.BeNil)
+	address, err = registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs1:1884")
+}
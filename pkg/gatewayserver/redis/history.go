@@ -0,0 +1,89 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayConnectionStatsHistoryRegistry implements the GatewayConnectionStatsHistoryRegistry interface.
+// The time buckets of a gateway are stored in a sorted set per bucket duration, ordered by start time.
+type GatewayConnectionStatsHistoryRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayConnectionStatsHistoryRegistry) key(uid string, d time.Duration) string {
+	return r.Redis.Key("uid", uid, fmt.Sprintf("%d", d.Milliseconds()))
+}
+
+// Add adds the time bucket to the connection stats history of a gateway.
+func (r *GatewayConnectionStatsHistoryRegistry) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, bucket *ttnpb.GatewayConnectionStatsBucket, retention time.Duration) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "add gateway connection stats bucket").End()
+
+	v, err := ttnredis.MarshalProto(bucket)
+	if err != nil {
+		return err
+	}
+	k := r.key(uid, bucket.Duration)
+	_, err = r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.ZAdd(ctx, k, &redis.Z{
+			Score:  float64(bucket.Start.UnixNano()),
+			Member: v,
+		})
+		if retention > 0 {
+			p.ZRemRangeByScore(ctx, k, "-inf", fmt.Sprintf("(%d", time.Now().Add(-retention).UnixNano()))
+			p.PExpire(ctx, k, retention)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range returns the time buckets of the given duration of a gateway that start in the interval [from, to).
+func (r *GatewayConnectionStatsHistoryRegistry) Range(ctx context.Context, ids ttnpb.GatewayIdentifiers, d time.Duration, from, to time.Time) ([]*ttnpb.GatewayConnectionStatsBucket, error) {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "range gateway connection stats buckets").End()
+
+	vs, err := r.Redis.ZRangeByScore(ctx, r.key(uid, d), &redis.ZRangeBy{
+		Min: fmt.Sprintf("%d", from.UnixNano()),
+		Max: fmt.Sprintf("(%d", to.UnixNano()),
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	buckets := make([]*ttnpb.GatewayConnectionStatsBucket, 0, len(vs))
+	for _, v := range vs {
+		bucket := &ttnpb.GatewayConnectionStatsBucket{}
+		if err := ttnredis.UnmarshalProto(v, bucket); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, nil
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHistoryRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "gtw1"}
+	ids2 := ttnpb.GatewayIdentifiers{GatewayID: "gtw2"}
+	registry := &GatewayConnectionStatsHistoryRegistry{
+		Redis: cl,
+	}
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	bucket := func(start time.Time, d time.Duration, uplinks uint64) *ttnpb.GatewayConnectionStatsBucket {
+		return &ttnpb.GatewayConnectionStatsBucket{
+			Start:       start,
+			Duration:    d,
+			UplinkCount: uplinks,
+		}
+	}
+
+	t.Run("RangeNonExisting", func(t *testing.T) {
+		buckets, err := registry.Range(ctx, ids, time.Minute, now.Add(-time.Hour), now)
+		a.So(err, should.BeNil)
+		a.So(buckets, should.BeEmpty)
+	})
+
+	t.Run("AddAndRange", func(t *testing.T) {
+		for _, b := range []*ttnpb.GatewayConnectionStatsBucket{
+			bucket(now.Add(-2*time.Minute), time.Minute, 1),
+			bucket(now.Add(-time.Minute), time.Minute, 2),
+			bucket(now, time.Minute, 3),
+			bucket(now.Add(-time.Hour), time.Hour, 4),
+		} {
+			a.So(registry.Add(ctx, ids, b, 24*time.Hour), should.BeNil)
+		}
+
+		buckets, err := registry.Range(ctx, ids, time.Minute, now.Add(-2*time.Minute), now)
+		a.So(err, should.BeNil)
+		a.So(buckets, should.Resemble, []*ttnpb.GatewayConnectionStatsBucket{
+			bucket(now.Add(-2*time.Minute), time.Minute, 1),
+			bucket(now.Add(-time.Minute), time.Minute, 2),
+		})
+
+		buckets, err = registry.Range(ctx, ids, time.Hour, now.Add(-24*time.Hour), now.Add(time.Hour))
+		a.So(err, should.BeNil)
+		a.So(buckets, should.Resemble, []*ttnpb.GatewayConnectionStatsBucket{
+			bucket(now.Add(-time.Hour), time.Hour, 4),
+		})
+
+		// Other gateways not affected
+		buckets, err = registry.Range(ctx, ids2, time.Minute, now.Add(-time.Hour), now.Add(time.Hour))
+		a.So(err, should.BeNil)
+		a.So(buckets, should.BeEmpty)
+	})
+
+	t.Run("Retention", func(t *testing.T) {
+		a.So(registry.Add(ctx, ids2, bucket(now.Add(-2*time.Hour), time.Minute, 1), 24*time.Hour), should.BeNil)
+		a.So(registry.Add(ctx, ids2, bucket(now, time.Minute, 2), time.Hour), should.BeNil)
+
+		buckets, err := registry.Range(ctx, ids2, time.Minute, now.Add(-24*time.Hour), now.Add(time.Hour))
+		a.So(err, should.BeNil)
+		a.So(buckets, should.Resemble, []*ttnpb.GatewayConnectionStatsBucket{
+			bucket(now, time.Minute, 2),
+		})
+	})
+}
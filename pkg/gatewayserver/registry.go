@@ -16,6 +16,7 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -27,3 +28,28 @@ type GatewayConnectionStatsRegistry interface {
 	// Set sets or clears the connection stats for a gateway.
 	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats) error
 }
+
+// GatewayConnectionStatsHistoryRegistry stores the historical connection stats of gateways in time buckets.
+type GatewayConnectionStatsHistoryRegistry interface {
+	// Add adds the time bucket to the connection stats history of a gateway.
+	// Time buckets of the same duration that are older than the retention are removed.
+	Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, bucket *ttnpb.GatewayConnectionStatsBucket, retention time.Duration) error
+	// Range returns the time buckets of the given duration that start in the interval [from, to), ordered by start time.
+	Range(ctx context.Context, ids ttnpb.GatewayIdentifiers, duration time.Duration, from, to time.Time) ([]*ttnpb.GatewayConnectionStatsBucket, error)
+}
+
+// GatewayConnectionRegistry stores which Gateway Server instance in the cluster holds the connection of a gateway.
+// Instances claim a gateway connection with a lease that expires unless it is renewed.
+type GatewayConnectionRegistry interface {
+	// Claim claims the connection of a gateway for the instance with the given address.
+	// A claim replaces the claim of another instance.
+	Claim(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string, ttl time.Duration) error
+	// Renew renews the claim of the instance with the given address.
+	// If the lease expired, the connection is claimed again. If another instance claimed the connection in the
+	// meantime, Renew returns an error.
+	Renew(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string, ttl time.Duration) error
+	// Release releases the claim of the instance with the given address, if the instance holds the claim.
+	Release(ctx context.Context, ids ttnpb.GatewayIdentifiers, address string) error
+	// Get returns the address of the instance that holds the connection of a gateway.
+	Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (string, error)
+}
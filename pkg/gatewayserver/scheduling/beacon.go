@@ -0,0 +1,117 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// BeaconPeriod is the period of Class B beacons.
+	BeaconPeriod = 128 * time.Second
+	// BeaconReserved is the time reserved for the beacon transmission at the start of each beacon period.
+	BeaconReserved = 2*time.Second + 120*time.Millisecond
+	// BeaconDelay is the delay between the start of the beacon period and the start of the beacon transmission.
+	BeaconDelay = 1*time.Microsecond + 500*time.Nanosecond
+)
+
+// BeaconTimeAfter returns the GPS time of the first beacon period that starts after the given time.
+func BeaconTimeAfter(t time.Time) time.Duration {
+	return (gpstime.ToGPS(t)/BeaconPeriod + 1) * BeaconPeriod
+}
+
+// ReserveBeacons configures whether the scheduler reserves the beacon reserved time of each beacon period.
+// When beacons are reserved, downlink emissions that overlap with the beacon reserved time are avoided when the
+// scheduler clock is synchronized with the gateway time.
+func (s *Scheduler) ReserveBeacons(reserve bool) {
+	s.mu.Lock()
+	s.reserveBeacons = reserve
+	s.mu.Unlock()
+}
+
+// beaconReservedEnd returns the concentrator time at which the beacon reserved time ends that the given emission
+// overlaps with, considering time-off-air.
+// This method returns false if the emission does not overlap with the beacon reserved time.
+// This method assumes that the mutex is held.
+func (s *Scheduler) beaconReservedEnd(em Emission) (ConcentratorTime, bool) {
+	if !s.reserveBeacons {
+		return 0, false
+	}
+	starts, ok := s.clock.ToGatewayTime(em.Starts())
+	if !ok {
+		return 0, false
+	}
+	offset := gpstime.ToGPS(starts) % BeaconPeriod
+	switch {
+	case offset < BeaconReserved:
+		return em.Starts() + ConcentratorTime(BeaconReserved-offset), true
+	case offset+time.Duration(em.EndsWithOffAir(s.timeOffAir)-em.Starts()) > BeaconPeriod:
+		return em.Starts() + ConcentratorTime(BeaconPeriod-offset+BeaconReserved), true
+	default:
+		return 0, false
+	}
+}
+
+var errBeaconReserved = errors.DefineResourceExhausted("beacon_reserved", "emission overlaps with beacon reserved time")
+
+// ScheduleBeacon attempts to schedule the given Tx settings of a beacon at the absolute gateway time in the settings.
+// The absolute gateway time is the start of the beacon transmission. Beacons are scheduled with the highest priority
+// and are not subject to the beacon reserved time.
+// This method requires the scheduler clock to be synchronized with the gateway time.
+func (s *Scheduler) ScheduleBeacon(ctx context.Context, opts Options) (Emission, error) {
+	defer trace.StartRegion(ctx, "schedule beacon").End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.clock.IsSynced() {
+		return Emission{}, errNoClockSync.New()
+	}
+	if opts.Time == nil {
+		return Emission{}, errNoAbsoluteGatewayTime.New()
+	}
+	starts, ok := s.clock.FromGatewayTime(*opts.Time)
+	if !ok {
+		return Emission{}, errNoAbsoluteGatewayTime.New()
+	}
+	if now, ok := s.clock.FromServerTime(s.timeSource.Now()); ok {
+		if delta := time.Duration(starts - now); delta < ScheduleTimeShort {
+			return Emission{}, errTooLate.WithAttributes("delta", delta)
+		}
+	}
+	sb, err := s.findSubBand(opts.Frequency)
+	if err != nil {
+		return Emission{}, err
+	}
+	em, err := s.newEmission(opts.PayloadSize, opts.TxSettings, starts)
+	if err != nil {
+		return Emission{}, err
+	}
+	for _, other := range s.emissions {
+		if em.OverlapsWithOffAir(other, s.timeOffAir) {
+			return Emission{}, errConflict.New()
+		}
+	}
+	if err := sb.Schedule(em, ttnpb.TxSchedulePriority_HIGHEST); err != nil {
+		return Emission{}, err
+	}
+	s.emissions = s.emissions.Insert(em)
+	return em, nil
+}
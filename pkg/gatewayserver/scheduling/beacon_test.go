@@ -0,0 +1,133 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBeaconTimeAfter(t *testing.T) {
+	a := assertions.New(t)
+	a.So(scheduling.BeaconTimeAfter(gpstime.Parse(1000*scheduling.BeaconPeriod-time.Second)), should.Equal, 1000*scheduling.BeaconPeriod)
+	a.So(scheduling.BeaconTimeAfter(gpstime.Parse(1000*scheduling.BeaconPeriod)), should.Equal, 1001*scheduling.BeaconPeriod)
+}
+
+func TestScheduleBeacon(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, false, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	scheduler.ReserveBeacons(true)
+
+	// The concentrator time 10 seconds from now is the start of a beacon period.
+	beaconTime := gpstime.Parse(1000 * scheduling.BeaconPeriod)
+	scheduler.SyncWithGatewayAbsolute(0, timeSource.Now(), beaconTime.Add(-10*time.Second))
+
+	downlinkSettings := func(timestamp time.Duration) ttnpb.TxSettings {
+		return ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  868100000,
+			Timestamp:  uint32(timestamp / time.Microsecond),
+		}
+	}
+
+	// Emissions in the beacon reserved time and emissions that end in the next beacon period are rejected.
+	for _, timestamp := range []time.Duration{
+		10*time.Second + 500*time.Millisecond,
+		10*time.Second - 50*time.Millisecond,
+	} {
+		_, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+			PayloadSize: 10,
+			TxSettings:  downlinkSettings(timestamp),
+			Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+		})
+		a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrBeaconReserved)
+	}
+
+	// Emissions outside the beacon reserved time are scheduled.
+	em, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  downlinkSettings(13 * time.Second),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.BeNil)
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(13*time.Second))
+
+	// Beacons are scheduled in the beacon reserved time.
+	beaconAt := beaconTime.Add(scheduling.BeaconDelay)
+	em, err = scheduler.ScheduleBeacon(ctx, scheduling.Options{
+		PayloadSize: 17,
+		TxSettings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 9,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  869525000,
+			Time:       &beaconAt,
+		},
+	})
+	a.So(err, should.BeNil)
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(10*time.Second+scheduling.BeaconDelay))
+
+	// Emissions scheduled at any time are scheduled after the beacon reserved time.
+	em, err = scheduler.ScheduleAnytime(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  downlinkSettings(10*time.Second - 20*time.Millisecond),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.BeNil)
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(10*time.Second+scheduling.BeaconReserved))
+
+	// The beacon reserved time is not taken into account when beacons are not reserved.
+	scheduler.ReserveBeacons(false)
+	em, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  downlinkSettings(11 * time.Second),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.BeNil)
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(11*time.Second))
+}
@@ -32,6 +32,8 @@ type Clock interface {
 	ToServerTime(ConcentratorTime) time.Time
 	// FromGatewayTime returns an indication of the concentrator time at the given gateway time if available.
 	FromGatewayTime(time.Time) (ConcentratorTime, bool)
+	// ToGatewayTime returns an indication of the gateway time at the given concentrator time if available.
+	ToGatewayTime(ConcentratorTime) (time.Time, bool)
 	// FromTimestampTime returns the concentrator time for the given timestamp.
 	FromTimestampTime(timestamp uint32) ConcentratorTime
 }
@@ -116,6 +118,14 @@ func (c *RolloverClock) FromGatewayTime(gateway time.Time) (ConcentratorTime, bo
 	return c.absolute + ConcentratorTime(gateway.Sub(*c.gateway)), true
 }
 
+// ToGatewayTime implements Clock.
+func (c *RolloverClock) ToGatewayTime(t ConcentratorTime) (time.Time, bool) {
+	if c.gateway == nil {
+		return time.Time{}, false
+	}
+	return c.gateway.Add(time.Duration(t - c.absolute)), true
+}
+
 // FromTimestampTime implements Clock.
 func (c *RolloverClock) FromTimestampTime(timestamp uint32) ConcentratorTime {
 	passed := int64(timestamp) - int64(c.relative)
@@ -122,6 +122,30 @@ func TestSyncWithGatewayConcentrator(t *testing.T) {
 	a.So(v, should.Equal, int64(0xAA499D5DD6))
 }
 
+func TestGatewayTime(t *testing.T) {
+	a := assertions.New(t)
+
+	clock := &RolloverClock{}
+	serverTime := time.Unix(1000, 0)
+	gatewayTime := time.Unix(2000, 0)
+
+	clock.Sync(0x10000, serverTime)
+	_, ok := clock.FromGatewayTime(gatewayTime)
+	a.So(ok, should.BeFalse)
+	_, ok = clock.ToGatewayTime(0)
+	a.So(ok, should.BeFalse)
+
+	absolute := clock.SyncWithGatewayAbsolute(0x10000, serverTime, gatewayTime)
+	v, ok := clock.FromGatewayTime(gatewayTime.Add(5 * time.Second))
+	if a.So(ok, should.BeTrue) {
+		a.So(v, should.Equal, absolute+ConcentratorTime(5*time.Second))
+	}
+	gt, ok := clock.ToGatewayTime(v)
+	if a.So(ok, should.BeTrue) {
+		a.So(gt, should.Equal, gatewayTime.Add(5*time.Second))
+	}
+}
+
 // TestIssue2581 is a test case for resolving https://github.com/TheThingsNetwork/lorawan-stack/issues/2581.
 func TestIssue2581(t *testing.T) {
 	a := assertions.New(t)
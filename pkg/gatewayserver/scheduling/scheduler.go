@@ -189,6 +189,7 @@ type Scheduler struct {
 	mu                   sync.RWMutex
 	emissions            Emissions
 	scheduleAnytimeDelay time.Duration
+	reserveBeacons       bool
 }
 
 var errSubBandNotFound = errors.DefineFailedPrecondition("sub_band_not_found", "sub-band not found for frequency `{frequency}` Hz")
@@ -337,6 +338,9 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (Emission, err
 	if err != nil {
 		return Emission{}, err
 	}
+	if _, ok := s.beaconReservedEnd(em); ok {
+		return Emission{}, errBeaconReserved.New()
+	}
 	for _, other := range s.emissions {
 		if em.OverlapsWithOffAir(other, s.timeOffAir) {
 			return Emission{}, errConflict.New()
@@ -427,7 +431,21 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (Emission
 		}
 		return em.t
 	}
-	em, err = sb.ScheduleAnytime(em.d, next, opts.Priority)
+	nextOutsideBeaconReserved := func() ConcentratorTime {
+		for {
+			t := next()
+			end, ok := s.beaconReservedEnd(NewEmission(t, em.d))
+			if !ok {
+				return t
+			}
+			// Schedule right after the beacon reserved time and resolve conflicts from the previous emission.
+			em.t = end
+			if i > 0 {
+				i--
+			}
+		}
+	}
+	em, err = sb.ScheduleAnytime(em.d, nextOutsideBeaconReserved, opts.Priority)
 	if err != nil {
 		return Emission{}, err
 	}
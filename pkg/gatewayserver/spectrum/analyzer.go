@@ -0,0 +1,320 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spectrum aggregates the radio traffic of gateway connections per channel into spectrum and channel
+// occupancy stats.
+package spectrum
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// rssiBinWidth is the width of the RSSI histogram bins in dB.
+	rssiBinWidth = 10
+	// snrBinWidth is the width of the SNR histogram bins in dB.
+	snrBinWidth = 2.5
+	// maxTimestampDrift is the maximum difference between concentrator timestamps of subsequent uplink messages for
+	// which the concentrator timestamps are used to position the uplink messages in time.
+	maxTimestampDrift = 30 * time.Minute
+)
+
+// observation is an uplink message received or a downlink message scheduled by the gateway.
+type observation struct {
+	// end is the time at which the transmission ended.
+	end       time.Time
+	frequency uint64
+	dataRate  ttnpb.DataRate
+	airtime   time.Duration
+	downlink  bool
+	foreign   bool
+	// hasRSSI and hasSNR indicate whether the rssi and snr are set.
+	hasRSSI, hasSNR bool
+	rssi, snr       float32
+}
+
+func (o observation) start() time.Time {
+	return o.end.Add(-o.airtime)
+}
+
+// Analyzer aggregates the uplink and downlink messages of a gateway connection over a sliding window.
+// An Analyzer is safe for concurrent use.
+type Analyzer struct {
+	window          time.Duration
+	maxObservations int
+	createdAt       time.Time
+
+	mu           sync.Mutex
+	observations []observation
+	// refEnd and refTimestamp are the end time and the concentrator timestamp of the last uplink message with a
+	// concentrator timestamp.
+	refEnd       time.Time
+	refTimestamp uint32
+}
+
+// NewAnalyzer returns a new Analyzer that keeps the messages of the given window, up to maxObservations messages.
+// The oldest messages are discarded when the maximum number of messages is reached.
+func NewAnalyzer(createdAt time.Time, window time.Duration, maxObservations int) *Analyzer {
+	return &Analyzer{
+		window:          window,
+		maxObservations: maxObservations,
+		createdAt:       createdAt,
+	}
+}
+
+// Window returns the sliding window of the Analyzer.
+func (a *Analyzer) Window() time.Duration {
+	return a.window
+}
+
+// add adds the observation and discards the observations that are out of the window or exceed the maximum number
+// of observations. The caller must hold the lock.
+func (a *Analyzer) add(obs observation) {
+	a.observations = append(a.observations, obs)
+	threshold := obs.end.Add(-a.window)
+	n := 0
+	for n < len(a.observations) && (len(a.observations)-n > a.maxObservations || a.observations[n].end.Before(threshold)) {
+		n++
+	}
+	if n > 0 {
+		a.observations = append(a.observations[:0], a.observations[n:]...)
+	}
+}
+
+// uplinkEnd returns the time at which the transmission of the uplink message ended.
+// The concentrator timestamp marks the end of the reception. The concentrator timestamps are relative to the previous
+// uplink message with a concentrator timestamp, so that the time between uplink messages is accurate to the
+// microsecond. If there is no concentrator timestamp, the server time of reception is used. The caller must hold the
+// lock.
+func (a *Analyzer) uplinkEnd(up *ttnpb.UplinkMessage) time.Time {
+	end, timestamp := up.ReceivedAt, up.Settings.Timestamp
+	if timestamp == 0 {
+		for _, md := range up.RxMetadata {
+			if md.Timestamp != 0 {
+				timestamp = md.Timestamp
+				break
+			}
+		}
+	}
+	if timestamp == 0 {
+		return end
+	}
+	if !a.refEnd.IsZero() {
+		drift := time.Duration(int32(timestamp-a.refTimestamp)) * time.Microsecond
+		if candidate := a.refEnd.Add(drift); candidate.Sub(end) < maxTimestampDrift && end.Sub(candidate) < maxTimestampDrift {
+			end = candidate
+		}
+	}
+	a.refEnd, a.refTimestamp = end, timestamp
+	return end
+}
+
+// AddUplink adds the uplink message received by the gateway.
+// Foreign indicates whether the uplink message is of a foreign network.
+func (a *Analyzer) AddUplink(up *ttnpb.UplinkMessage, foreign bool) {
+	if up.Settings.DataRate.Modulation == nil {
+		return
+	}
+	airtime, err := toa.Compute(len(up.RawPayload), up.Settings)
+	if err != nil {
+		return
+	}
+	obs := observation{
+		frequency: up.Settings.Frequency,
+		dataRate:  up.Settings.DataRate,
+		airtime:   airtime,
+		foreign:   foreign,
+	}
+	_, isLoRa := up.Settings.DataRate.Modulation.(*ttnpb.DataRate_LoRa)
+	for _, md := range up.RxMetadata {
+		if !obs.hasRSSI || md.RSSI > obs.rssi {
+			obs.hasRSSI, obs.rssi = true, md.RSSI
+		}
+		if isLoRa && (!obs.hasSNR || md.SNR > obs.snr) {
+			obs.hasSNR, obs.snr = true, md.SNR
+		}
+	}
+	a.mu.Lock()
+	obs.end = a.uplinkEnd(up)
+	a.add(obs)
+	a.mu.Unlock()
+}
+
+// AddDownlink adds the downlink message that is scheduled at the given time.
+func (a *Analyzer) AddDownlink(msg *ttnpb.DownlinkMessage, t time.Time) {
+	settings := msg.GetScheduled()
+	if settings == nil || settings.DataRate.Modulation == nil {
+		return
+	}
+	airtime, err := toa.Compute(len(msg.RawPayload), *settings)
+	if err != nil {
+		return
+	}
+	a.mu.Lock()
+	a.add(observation{
+		end:       t.Add(airtime),
+		frequency: settings.Frequency,
+		dataRate:  settings.DataRate,
+		airtime:   airtime,
+		downlink:  true,
+	})
+	a.mu.Unlock()
+}
+
+// dataRateLess returns whether data rate a orders before data rate b.
+// LoRa data rates order before FSK data rates. LoRa data rates are ordered by spreading factor and bandwidth, FSK
+// data rates are ordered by bit rate.
+func dataRateLess(a, b ttnpb.DataRate) bool {
+	aLoRa, bLoRa := a.GetLoRa(), b.GetLoRa()
+	switch {
+	case aLoRa != nil && bLoRa != nil:
+		if aLoRa.SpreadingFactor != bLoRa.SpreadingFactor {
+			return aLoRa.SpreadingFactor < bLoRa.SpreadingFactor
+		}
+		return aLoRa.Bandwidth < bLoRa.Bandwidth
+	case aLoRa != nil:
+		return true
+	case bLoRa != nil:
+		return false
+	default:
+		return a.GetFSK().GetBitRate() < b.GetFSK().GetBitRate()
+	}
+}
+
+// histogram adds the value to the histogram with bins of the given width.
+func histogram(bins []*ttnpb.GatewaySpectrumStats_HistogramBin, value, width float32) []*ttnpb.GatewaySpectrumStats_HistogramBin {
+	lower := float32(math.Floor(float64(value/width))) * width
+	for _, bin := range bins {
+		if bin.Lower == lower {
+			bin.Count++
+			return bins
+		}
+	}
+	bins = append(bins, &ttnpb.GatewaySpectrumStats_HistogramBin{
+		Lower: lower,
+		Upper: lower + width,
+		Count: 1,
+	})
+	sort.Slice(bins, func(i, j int) bool { return bins[i].Lower < bins[j].Lower })
+	return bins
+}
+
+// countOverlapping returns the number of uplink messages that overlap in time with another uplink message.
+func countOverlapping(uplinks []observation) uint32 {
+	sort.Slice(uplinks, func(i, j int) bool { return uplinks[i].start().Before(uplinks[j].start()) })
+	overlapping := make([]bool, len(uplinks))
+	latest := -1
+	for i, obs := range uplinks {
+		if latest >= 0 && obs.start().Before(uplinks[latest].end) {
+			overlapping[i], overlapping[latest] = true, true
+		}
+		if latest < 0 || obs.end.After(uplinks[latest].end) {
+			latest = i
+		}
+	}
+	var n uint32
+	for _, ok := range overlapping {
+		if ok {
+			n++
+		}
+	}
+	return n
+}
+
+// Stats returns the stats of the messages in the given window until now.
+// The window is limited to the window of the Analyzer and to the time since the Analyzer is created.
+func (a *Analyzer) Stats(now time.Time, window time.Duration) *ttnpb.GatewaySpectrumStats {
+	if window <= 0 || window > a.window {
+		window = a.window
+	}
+	start := now.Add(-window)
+	if start.Before(a.createdAt) {
+		start = a.createdAt
+	}
+	stats := &ttnpb.GatewaySpectrumStats{
+		WindowStart: start,
+		WindowEnd:   now,
+	}
+	duration := now.Sub(start)
+
+	a.mu.Lock()
+	channels := make(map[uint64][]observation)
+	for _, obs := range a.observations {
+		if obs.end.Before(start) || obs.end.After(now) {
+			continue
+		}
+		channels[obs.frequency] = append(channels[obs.frequency], obs)
+	}
+	a.mu.Unlock()
+
+	for frequency, observations := range channels {
+		ch := &ttnpb.GatewaySpectrumStats_Channel{
+			Frequency: frequency,
+		}
+		uplinks := make(map[string][]observation)
+		dataRates := make(map[string]*ttnpb.GatewaySpectrumStats_DataRateStats)
+		for _, obs := range observations {
+			if obs.downlink {
+				ch.DownlinkCount++
+				ch.DownlinkAirtime += obs.airtime
+				continue
+			}
+			ch.UplinkCount++
+			ch.UplinkAirtime += obs.airtime
+			if obs.foreign {
+				ch.ForeignUplinkCount++
+			}
+			if obs.hasRSSI {
+				ch.RSSI = histogram(ch.RSSI, obs.rssi, rssiBinWidth)
+			}
+			if obs.hasSNR {
+				ch.SNR = histogram(ch.SNR, obs.snr, snrBinWidth)
+			}
+			key := obs.dataRate.String()
+			uplinks[key] = append(uplinks[key], obs)
+			dr, ok := dataRates[key]
+			if !ok {
+				dr = &ttnpb.GatewaySpectrumStats_DataRateStats{
+					DataRate: obs.dataRate,
+				}
+				dataRates[key] = dr
+				ch.DataRates = append(ch.DataRates, dr)
+			}
+			dr.UplinkCount++
+			dr.UplinkAirtime += obs.airtime
+		}
+		for _, observations := range uplinks {
+			ch.OverlappingUplinkCount += countOverlapping(observations)
+		}
+		sort.Slice(ch.DataRates, func(i, j int) bool {
+			return dataRateLess(ch.DataRates[i].DataRate, ch.DataRates[j].DataRate)
+		})
+		if duration > 0 {
+			ch.Utilization = float32(float64(ch.UplinkAirtime+ch.DownlinkAirtime) / float64(duration))
+			load := float64(ch.UplinkAirtime) / float64(duration)
+			ch.CollisionProbability = float32(1 - math.Exp(-2*load))
+		}
+		stats.Channels = append(stats.Channels, ch)
+	}
+	sort.Slice(stats.Channels, func(i, j int) bool {
+		return stats.Channels[i].Frequency < stats.Channels[j].Frequency
+	})
+	return stats
+}
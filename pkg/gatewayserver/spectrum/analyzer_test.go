@@ -0,0 +1,200 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectrum_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/spectrum"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func loraDataRate(sf uint32) ttnpb.DataRate {
+	return ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+		SpreadingFactor: sf,
+		Bandwidth:       125000,
+	}}}
+}
+
+func uplink(frequency uint64, sf uint32, timestamp uint32, receivedAt time.Time, rssi, snr float32) *ttnpb.UplinkMessage {
+	return &ttnpb.UplinkMessage{
+		RawPayload: make([]byte, 20),
+		Settings: ttnpb.TxSettings{
+			DataRate:   loraDataRate(sf),
+			CodingRate: "4/5",
+			Frequency:  frequency,
+			Timestamp:  timestamp,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{RSSI: rssi - 10, SNR: snr - 5, Timestamp: timestamp},
+			{RSSI: rssi, SNR: snr, Timestamp: timestamp},
+		},
+		ReceivedAt: receivedAt,
+	}
+}
+
+func airtime(t *testing.T, msg *ttnpb.UplinkMessage) time.Duration {
+	d, err := toa.Compute(len(msg.RawPayload), msg.Settings)
+	if err != nil {
+		t.Fatalf("Failed to compute airtime: %v", err)
+	}
+	return d
+}
+
+func TestAnalyzer(t *testing.T) {
+	start := time.Now()
+
+	t.Run("Channels", func(t *testing.T) {
+		a := assertions.New(t)
+		analyzer := NewAnalyzer(start, time.Hour, 100)
+
+		up1 := uplink(868100000, 7, 1000000, start.Add(time.Second), -50, 8)
+		up2 := uplink(868100000, 7, 1020000, start.Add(time.Second), -95, -3)
+		up3 := uplink(868100000, 9, 5000000, start.Add(5*time.Second), -45, 1)
+		up4 := uplink(868300000, 7, 5010000, start.Add(5*time.Second), -80, 4)
+		analyzer.AddUplink(up1, false)
+		analyzer.AddUplink(up2, true)
+		analyzer.AddUplink(up3, false)
+		analyzer.AddUplink(up4, false)
+
+		down := &ttnpb.DownlinkMessage{
+			RawPayload: make([]byte, 20),
+			Settings: &ttnpb.DownlinkMessage_Scheduled{
+				Scheduled: &ttnpb.TxSettings{
+					DataRate:   loraDataRate(7),
+					CodingRate: "4/5",
+					Frequency:  868100000,
+				},
+			},
+		}
+		analyzer.AddDownlink(down, start.Add(2*time.Second))
+		// Downlink messages that are scheduled after the end of the window are not counted.
+		analyzer.AddDownlink(down, start.Add(20*time.Second))
+
+		stats := analyzer.Stats(start.Add(10*time.Second), 0)
+		a.So(stats.WindowStart, should.Equal, start)
+		a.So(stats.WindowEnd, should.Equal, start.Add(10*time.Second))
+		if !a.So(stats.Channels, should.HaveLength, 2) {
+			t.FailNow()
+		}
+
+		ch := stats.Channels[0]
+		a.So(ch.Frequency, should.Equal, 868100000)
+		a.So(ch.UplinkCount, should.Equal, 3)
+		a.So(ch.DownlinkCount, should.Equal, 1)
+		a.So(ch.UplinkAirtime, should.Equal, airtime(t, up1)+airtime(t, up2)+airtime(t, up3))
+		a.So(ch.DownlinkAirtime, should.Equal, airtime(t, up1))
+		a.So(ch.OverlappingUplinkCount, should.Equal, 2)
+		a.So(ch.ForeignUplinkCount, should.Equal, 1)
+		a.So(ch.Utilization, should.AlmostEqual, float32(ch.UplinkAirtime+ch.DownlinkAirtime)/float32(10*time.Second), 1e-6)
+		a.So(ch.CollisionProbability, should.BeGreaterThan, 0)
+		a.So(ch.CollisionProbability, should.BeLessThan, 2*ch.Utilization)
+		if a.So(ch.DataRates, should.HaveLength, 2) {
+			a.So(ch.DataRates[0].DataRate, should.Resemble, loraDataRate(7))
+			a.So(ch.DataRates[0].UplinkCount, should.Equal, 2)
+			a.So(ch.DataRates[1].DataRate, should.Resemble, loraDataRate(9))
+			a.So(ch.DataRates[1].UplinkCount, should.Equal, 1)
+			a.So(ch.DataRates[1].UplinkAirtime, should.Equal, airtime(t, up3))
+		}
+		a.So(ch.RSSI, should.Resemble, []*ttnpb.GatewaySpectrumStats_HistogramBin{
+			{Lower: -100, Upper: -90, Count: 1},
+			{Lower: -50, Upper: -40, Count: 2},
+		})
+		a.So(ch.SNR, should.Resemble, []*ttnpb.GatewaySpectrumStats_HistogramBin{
+			{Lower: -5, Upper: -2.5, Count: 1},
+			{Lower: 0, Upper: 2.5, Count: 1},
+			{Lower: 7.5, Upper: 10, Count: 1},
+		})
+
+		ch = stats.Channels[1]
+		a.So(ch.Frequency, should.Equal, 868300000)
+		a.So(ch.UplinkCount, should.Equal, 1)
+		a.So(ch.OverlappingUplinkCount, should.Equal, 0)
+	})
+
+	t.Run("TimestampRollover", func(t *testing.T) {
+		a := assertions.New(t)
+		analyzer := NewAnalyzer(start, time.Hour, 100)
+
+		// The server times of reception are far apart, but the concentrator timestamps show that the uplink
+		// messages overlap.
+		analyzer.AddUplink(uplink(868100000, 7, 1<<32-1000, start.Add(time.Second), -50, 8), false)
+		analyzer.AddUplink(uplink(868100000, 7, 1000, start.Add(2*time.Second), -50, 8), false)
+		analyzer.AddUplink(uplink(868100000, 7, 2000000, start.Add(2*time.Second), -50, 8), false)
+
+		stats := analyzer.Stats(start.Add(10*time.Second), 0)
+		if a.So(stats.Channels, should.HaveLength, 1) {
+			a.So(stats.Channels[0].UplinkCount, should.Equal, 3)
+			a.So(stats.Channels[0].OverlappingUplinkCount, should.Equal, 2)
+		}
+	})
+
+	t.Run("Window", func(t *testing.T) {
+		a := assertions.New(t)
+		analyzer := NewAnalyzer(start, time.Minute, 3)
+		a.So(analyzer.Window(), should.Equal, time.Minute)
+
+		for i := 0; i < 4; i++ {
+			analyzer.AddUplink(uplink(868100000, 7, 0, start.Add(time.Duration(i)*10*time.Second), -50, 8), false)
+		}
+		stats := analyzer.Stats(start.Add(40*time.Second), 0)
+		if a.So(stats.Channels, should.HaveLength, 1) {
+			// The first uplink message is discarded because of the maximum number of observations.
+			a.So(stats.Channels[0].UplinkCount, should.Equal, 3)
+		}
+
+		stats = analyzer.Stats(start.Add(40*time.Second), 15*time.Second)
+		a.So(stats.WindowStart, should.Equal, start.Add(25*time.Second))
+		if a.So(stats.Channels, should.HaveLength, 1) {
+			a.So(stats.Channels[0].UplinkCount, should.Equal, 1)
+		}
+
+		// The window is limited to the window of the analyzer.
+		stats = analyzer.Stats(start.Add(2*time.Minute), time.Hour)
+		a.So(stats.WindowStart, should.Equal, start.Add(time.Minute))
+		a.So(stats.Channels, should.BeEmpty)
+	})
+}
+
+func TestCollector(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Now()
+
+	analyzer := NewAnalyzer(now.Add(-time.Minute), time.Hour, 100)
+	analyzer.AddUplink(uplink(868100000, 7, 1000000, now.Add(-30*time.Second), -50, 8), false)
+	analyzer.AddUplink(uplink(868300000, 9, 2000000, now.Add(-20*time.Second), -50, 8), true)
+
+	collect := func(c prometheus.Collector) int {
+		ch := make(chan prometheus.Metric, 100)
+		c.Collect(ch)
+		close(ch)
+		return len(ch)
+	}
+
+	c := NewCollector("gs")
+	a.So(collect(c), should.Equal, 0)
+	c.Register("test-gateway", analyzer)
+	// 5 channel metrics and 1 data rate metric per channel.
+	a.So(collect(c), should.Equal, 12)
+	c.Unregister("test-gateway", NewAnalyzer(now, time.Hour, 100))
+	a.So(collect(c), should.Equal, 12)
+	c.Unregister("test-gateway", analyzer)
+	a.So(collect(c), should.Equal, 0)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectrum
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	gatewayUID = "gateway_uid"
+	frequency  = "frequency"
+	dataRate   = "data_rate"
+)
+
+// Collector is a prometheus.Collector that exposes the stats of the registered Analyzers over their window.
+type Collector struct {
+	analyzers sync.Map // gateway UID to *Analyzer
+
+	utilization,
+	collisionProbability,
+	uplinkAirtime,
+	downlinkAirtime,
+	foreignUplinks,
+	uplinks *prometheus.Desc
+}
+
+// NewCollector returns a new Collector with the metrics in the given subsystem.
+func NewCollector(subsystem string) *Collector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, subsystem, name), help,
+			append([]string{gatewayUID, frequency}, labels...), nil,
+		)
+	}
+	return &Collector{
+		utilization:          desc("spectrum_channel_utilization", "Fraction of the window that the gateway channel is occupied"),
+		collisionProbability: desc("spectrum_channel_collision_probability", "Estimated collision probability of uplink messages on the gateway channel"),
+		uplinkAirtime:        desc("spectrum_channel_uplink_airtime_seconds", "Airtime of uplink messages on the gateway channel in the window"),
+		downlinkAirtime:      desc("spectrum_channel_downlink_airtime_seconds", "Airtime of downlink messages on the gateway channel in the window"),
+		foreignUplinks:       desc("spectrum_channel_foreign_uplinks", "Number of uplink messages of foreign networks on the gateway channel in the window"),
+		uplinks:              desc("spectrum_channel_uplinks", "Number of uplink messages on the gateway channel in the window", dataRate),
+	}
+}
+
+// Register registers the Analyzer of the gateway by its unique ID.
+func (c *Collector) Register(uid string, a *Analyzer) {
+	c.analyzers.Store(uid, a)
+}
+
+// Unregister unregisters the Analyzer of the gateway, if it is registered.
+func (c *Collector) Unregister(uid string, a *Analyzer) {
+	if existing, ok := c.analyzers.Load(uid); ok && existing.(*Analyzer) == a {
+		c.analyzers.Delete(uid)
+	}
+}
+
+// dataRateLabel returns the label value of the data rate.
+func dataRateLabel(dr ttnpb.DataRate) string {
+	switch mod := dr.Modulation.(type) {
+	case *ttnpb.DataRate_LoRa:
+		return fmt.Sprintf("SF%dBW%d", mod.LoRa.SpreadingFactor, mod.LoRa.Bandwidth/1000)
+	case *ttnpb.DataRate_FSK:
+		return fmt.Sprintf("FSK%d", mod.FSK.BitRate)
+	default:
+		return "unknown"
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.utilization
+	ch <- c.collisionProbability
+	ch <- c.uplinkAirtime
+	ch <- c.downlinkAirtime
+	ch <- c.foreignUplinks
+	ch <- c.uplinks
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	now := time.Now()
+	c.analyzers.Range(func(k, v interface{}) bool {
+		uid := k.(string)
+		stats := v.(*Analyzer).Stats(now, 0)
+		for _, channel := range stats.Channels {
+			freq := strconv.FormatUint(channel.Frequency, 10)
+			ch <- prometheus.MustNewConstMetric(c.utilization, prometheus.GaugeValue, float64(channel.Utilization), uid, freq)
+			ch <- prometheus.MustNewConstMetric(c.collisionProbability, prometheus.GaugeValue, float64(channel.CollisionProbability), uid, freq)
+			ch <- prometheus.MustNewConstMetric(c.uplinkAirtime, prometheus.GaugeValue, channel.UplinkAirtime.Seconds(), uid, freq)
+			ch <- prometheus.MustNewConstMetric(c.downlinkAirtime, prometheus.GaugeValue, channel.DownlinkAirtime.Seconds(), uid, freq)
+			ch <- prometheus.MustNewConstMetric(c.foreignUplinks, prometheus.GaugeValue, float64(channel.ForeignUplinkCount), uid, freq)
+			for _, dr := range channel.DataRates {
+				ch <- prometheus.MustNewConstMetric(c.uplinks, prometheus.GaugeValue, float64(dr.UplinkCount), uid, freq, dataRateLabel(dr.DataRate))
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func dataUplink(devAddr types.DevAddr) *ttnpb.UplinkMessage {
+	return &ttnpb.UplinkMessage{
+		RawPayload: []byte{
+			0x40,
+			devAddr[3], devAddr[2], devAddr[1], devAddr[0],
+			0x00,
+			0x01, 0x00,
+			0x01, 0x02, 0x03, 0x04,
+		},
+	}
+}
+
+func TestIsForeignUplink(t *testing.T) {
+	joinRequest := &ttnpb.UplinkMessage{
+		RawPayload: append([]byte{0x00}, make([]byte, 22)...),
+	}
+	for _, tc := range []struct {
+		Name     string
+		Spectrum SpectrumConfig
+		Home     []*ttnpb.UplinkMessage
+		Foreign  []*ttnpb.UplinkMessage
+	}{
+		{
+			Name: "DefaultNetID",
+			Home: []*ttnpb.UplinkMessage{
+				dataUplink(types.DevAddr{0x01, 0x02, 0x03, 0x04}),
+				joinRequest,
+			},
+			Foreign: []*ttnpb.UplinkMessage{
+				dataUplink(types.DevAddr{0x26, 0x01, 0x23, 0x45}),
+				{RawPayload: []byte{0x01, 0x02}},
+			},
+		},
+		{
+			Name: "NetID",
+			Spectrum: SpectrumConfig{
+				NetID: types.NetID{0x00, 0x00, 0x13},
+			},
+			Home: []*ttnpb.UplinkMessage{
+				dataUplink(types.DevAddr{0x26, 0x01, 0x23, 0x45}),
+				joinRequest,
+			},
+			Foreign: []*ttnpb.UplinkMessage{
+				dataUplink(types.DevAddr{0x01, 0x02, 0x03, 0x04}),
+			},
+		},
+		{
+			Name: "DevAddrPrefixes",
+			Spectrum: SpectrumConfig{
+				NetID: types.NetID{0x00, 0x00, 0x13},
+				DevAddrPrefixes: []types.DevAddrPrefix{
+					{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+				},
+			},
+			Home: []*ttnpb.UplinkMessage{
+				dataUplink(types.DevAddr{0x26, 0x01, 0x23, 0x45}),
+			},
+			Foreign: []*ttnpb.UplinkMessage{
+				dataUplink(types.DevAddr{0x26, 0x02, 0x23, 0x45}),
+				dataUplink(types.DevAddr{0x01, 0x02, 0x03, 0x04}),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				c := componenttest.NewComponent(t, &component.Config{})
+				// The default configuration forwards all DevAddr prefixes to the cluster.
+				gs, err := New(c, &Config{
+					Forward: map[string][]string{
+						"": {"00000000/0"},
+					},
+					Spectrum: tc.Spectrum,
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				for _, up := range tc.Home {
+					a.So(gs.isForeignUplink(up), should.BeFalse)
+				}
+				for _, up := range tc.Foreign {
+					a.So(gs.isForeignUplink(up), should.BeTrue)
+				}
+			},
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statshistory implements time-bucketed historical gateway connection stats.
+package statshistory
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// rttPercentile is the percentile of the round-trip times that is kept in the time buckets.
+const rttPercentile = 90
+
+// Counter counts the traffic of a gateway connection in time buckets.
+type Counter struct {
+	conn *io.Connection
+
+	uplinks,
+	downlinks,
+	txAcks,
+	txAckFailures uint64
+}
+
+// NewCounter returns a new Counter for the given connection.
+// The first time bucket counts the traffic since the counter is created.
+func NewCounter(conn *io.Connection) *Counter {
+	c := &Counter{
+		conn: conn,
+	}
+	c.uplinks, _, _ = conn.UpStats()
+	c.downlinks, _, _ = conn.DownStats()
+	c.txAcks, c.txAckFailures = conn.TxAckStats()
+	return c
+}
+
+// Bucket returns the time bucket with the given start and duration.
+// The time bucket counts the traffic since the previous time bucket.
+func (c *Counter) Bucket(start time.Time, d time.Duration) *ttnpb.GatewayConnectionStatsBucket {
+	bucket := &ttnpb.GatewayConnectionStatsBucket{
+		Start:    start,
+		Duration: d,
+	}
+
+	uplinks, _, _ := c.conn.UpStats()
+	downlinks, _, _ := c.conn.DownStats()
+	txAcks, txAckFailures := c.conn.TxAckStats()
+	bucket.UplinkCount = uplinks - c.uplinks
+	bucket.DownlinkCount = downlinks - c.downlinks
+	bucket.TxAcknowledgmentCount = txAcks - c.txAcks
+	bucket.TxAcknowledgmentFailureCount = txAckFailures - c.txAckFailures
+	c.uplinks, c.downlinks, c.txAcks, c.txAckFailures = uplinks, downlinks, txAcks, txAckFailures
+
+	if min, max, median, np, count := c.conn.RTTStatsSince(rttPercentile, start, start.Add(d)); count > 0 {
+		bucket.RoundTripTimes = &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+			Min:          min,
+			Max:          max,
+			Median:       median,
+			Percentile90: np,
+			Count:        uint32(count),
+		}
+	}
+	bucket.SubBands = c.conn.Stats().SubBands
+	return bucket
+}
@@ -0,0 +1,95 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statshistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCounter(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"},
+		FrequencyPlanID:    "EU_863_870",
+	}
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, gtw, frequencyplans.NewStore(test.FrequencyPlansFetcher), true, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	handleTraffic := func(uplinks int, acks ...ttnpb.TxAcknowledgment_Result) {
+		for i := 0; i < uplinks; i++ {
+			if err := conn.HandleUp(&ttnpb.UplinkMessage{
+				ReceivedAt: time.Now(),
+				RxMetadata: []*ttnpb.RxMetadata{{}},
+			}); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			<-conn.Up()
+		}
+		for _, result := range acks {
+			if err := conn.HandleTxAck(&ttnpb.TxAcknowledgment{Result: result}); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			<-conn.TxAck()
+		}
+	}
+
+	// Traffic before the counter is created is not counted.
+	handleTraffic(1, ttnpb.TxAcknowledgment_SUCCESS)
+
+	counter := NewCounter(conn)
+	start := time.Now().Truncate(time.Minute)
+
+	handleTraffic(3, ttnpb.TxAcknowledgment_SUCCESS, ttnpb.TxAcknowledgment_TOO_LATE)
+	conn.RecordRTT(20*time.Millisecond, start)
+	conn.RecordRTT(40*time.Millisecond, start)
+
+	bucket := counter.Bucket(start, time.Minute)
+	a.So(bucket.Start, should.Equal, start)
+	a.So(bucket.Duration, should.Equal, time.Minute)
+	a.So(bucket.UplinkCount, should.Equal, 3)
+	a.So(bucket.DownlinkCount, should.Equal, 0)
+	a.So(bucket.TxAcknowledgmentCount, should.Equal, 2)
+	a.So(bucket.TxAcknowledgmentFailureCount, should.Equal, 1)
+	a.So(bucket.RoundTripTimes, should.Resemble, &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+		Min:          20 * time.Millisecond,
+		Max:          40 * time.Millisecond,
+		Median:       30 * time.Millisecond,
+		Percentile90: 20 * time.Millisecond,
+		Count:        2,
+	})
+	a.So(bucket.SubBands, should.NotBeEmpty)
+
+	// The next time bucket only counts the traffic since the previous time bucket.
+	handleTraffic(1)
+	bucket = counter.Bucket(start.Add(time.Minute), time.Minute)
+	a.So(bucket.UplinkCount, should.Equal, 1)
+	a.So(bucket.TxAcknowledgmentCount, should.Equal, 0)
+	a.So(bucket.TxAcknowledgmentFailureCount, should.Equal, 0)
+	a.So(bucket.RoundTripTimes, should.BeNil)
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statshistory
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Merge merges the time buckets into time buckets of the given duration.
+// The merged time buckets start at a multiple of the duration since the zero time.
+// The time buckets must be ordered by start time. Time buckets that are longer than the given duration are merged
+// into time buckets of their own duration.
+//
+// The counts of the merged time buckets are the sums of the counts. The median and percentile round-trip times are
+// approximated by the averages weighted by the number of round-trip times. The sub band utilization is the peak
+// utilization of the merged time buckets.
+func Merge(buckets []*ttnpb.GatewayConnectionStatsBucket, d time.Duration) []*ttnpb.GatewayConnectionStatsBucket {
+	var res []*ttnpb.GatewayConnectionStatsBucket
+	var last *ttnpb.GatewayConnectionStatsBucket
+	for _, bucket := range buckets {
+		duration := d
+		if bucket.Duration > duration {
+			duration = bucket.Duration
+		}
+		start := bucket.Start.Truncate(duration)
+		if last == nil || !last.Start.Equal(start) || last.Duration != duration {
+			last = &ttnpb.GatewayConnectionStatsBucket{
+				Start:    start,
+				Duration: duration,
+			}
+			res = append(res, last)
+		}
+		merge(last, bucket)
+	}
+	return res
+}
+
+func merge(dst, src *ttnpb.GatewayConnectionStatsBucket) {
+	dst.UplinkCount += src.UplinkCount
+	dst.DownlinkCount += src.DownlinkCount
+	dst.TxAcknowledgmentCount += src.TxAcknowledgmentCount
+	dst.TxAcknowledgmentFailureCount += src.TxAcknowledgmentFailureCount
+
+	if src := src.RoundTripTimes; src != nil && src.Count > 0 {
+		if dst.RoundTripTimes == nil {
+			dst.RoundTripTimes = &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+				Min: src.Min,
+				Max: src.Max,
+			}
+		}
+		rtts := dst.RoundTripTimes
+		if src.Min < rtts.Min {
+			rtts.Min = src.Min
+		}
+		if src.Max > rtts.Max {
+			rtts.Max = src.Max
+		}
+		weightedAverage := func(a, b time.Duration) time.Duration {
+			return time.Duration((int64(a)*int64(rtts.Count) + int64(b)*int64(src.Count)) / int64(rtts.Count+src.Count))
+		}
+		rtts.Median = weightedAverage(rtts.Median, src.Median)
+		rtts.Percentile90 = weightedAverage(rtts.Percentile90, src.Percentile90)
+		rtts.Count += src.Count
+	}
+
+outer:
+	for _, src := range src.SubBands {
+		for _, dst := range dst.SubBands {
+			if dst.MinFrequency == src.MinFrequency && dst.MaxFrequency == src.MaxFrequency {
+				if src.DownlinkUtilization > dst.DownlinkUtilization {
+					dst.DownlinkUtilization = src.DownlinkUtilization
+				}
+				continue outer
+			}
+		}
+		subBand := *src
+		dst.SubBands = append(dst.SubBands, &subBand)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statshistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMerge(t *testing.T) {
+	ref := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	subBand := func(utilization float32) []*ttnpb.GatewayConnectionStats_SubBand {
+		return []*ttnpb.GatewayConnectionStats_SubBand{
+			{
+				MinFrequency:             863000000,
+				MaxFrequency:             865000000,
+				DownlinkUtilizationLimit: 0.001,
+				DownlinkUtilization:      utilization,
+			},
+		}
+	}
+	buckets := []*ttnpb.GatewayConnectionStatsBucket{
+		{
+			Start:                        ref,
+			Duration:                     5 * time.Minute,
+			UplinkCount:                  10,
+			DownlinkCount:                2,
+			TxAcknowledgmentCount:        2,
+			TxAcknowledgmentFailureCount: 1,
+			RoundTripTimes: &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+				Min:          10 * time.Millisecond,
+				Max:          50 * time.Millisecond,
+				Median:       20 * time.Millisecond,
+				Percentile90: 40 * time.Millisecond,
+				Count:        3,
+			},
+			SubBands: subBand(0.0005),
+		},
+		{
+			Start:       ref.Add(5 * time.Minute),
+			Duration:    5 * time.Minute,
+			UplinkCount: 5,
+			RoundTripTimes: &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+				Min:          5 * time.Millisecond,
+				Max:          30 * time.Millisecond,
+				Median:       30 * time.Millisecond,
+				Percentile90: 30 * time.Millisecond,
+				Count:        1,
+			},
+			SubBands: subBand(0.0008),
+		},
+		{
+			Start:       ref.Add(5 * time.Minute),
+			Duration:    5 * time.Minute,
+			UplinkCount: 1,
+			SubBands:    subBand(0.0002),
+		},
+		{
+			Start:         ref.Add(time.Hour),
+			Duration:      5 * time.Minute,
+			DownlinkCount: 1,
+		},
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Duration time.Duration
+		Expected []*ttnpb.GatewayConnectionStatsBucket
+	}{
+		{
+			Name:     "SameDuration",
+			Duration: 5 * time.Minute,
+			Expected: []*ttnpb.GatewayConnectionStatsBucket{
+				buckets[0],
+				{
+					Start:       ref.Add(5 * time.Minute),
+					Duration:    5 * time.Minute,
+					UplinkCount: 6,
+					RoundTripTimes: &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+						Min:          5 * time.Millisecond,
+						Max:          30 * time.Millisecond,
+						Median:       30 * time.Millisecond,
+						Percentile90: 30 * time.Millisecond,
+						Count:        1,
+					},
+					SubBands: subBand(0.0008),
+				},
+				buckets[3],
+			},
+		},
+		{
+			Name:     "ShorterDuration",
+			Duration: time.Minute,
+			Expected: []*ttnpb.GatewayConnectionStatsBucket{
+				buckets[0],
+				{
+					Start:       ref.Add(5 * time.Minute),
+					Duration:    5 * time.Minute,
+					UplinkCount: 6,
+					RoundTripTimes: &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+						Min:          5 * time.Millisecond,
+						Max:          30 * time.Millisecond,
+						Median:       30 * time.Millisecond,
+						Percentile90: 30 * time.Millisecond,
+						Count:        1,
+					},
+					SubBands: subBand(0.0008),
+				},
+				buckets[3],
+			},
+		},
+		{
+			Name:     "Hour",
+			Duration: time.Hour,
+			Expected: []*ttnpb.GatewayConnectionStatsBucket{
+				{
+					Start:                        ref,
+					Duration:                     time.Hour,
+					UplinkCount:                  16,
+					DownlinkCount:                2,
+					TxAcknowledgmentCount:        2,
+					TxAcknowledgmentFailureCount: 1,
+					RoundTripTimes: &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+						Min:          5 * time.Millisecond,
+						Max:          50 * time.Millisecond,
+						Median:       22500 * time.Microsecond,
+						Percentile90: 37500 * time.Microsecond,
+						Count:        4,
+					},
+					SubBands: subBand(0.0008),
+				},
+				{
+					Start:         ref.Add(time.Hour),
+					Duration:      time.Hour,
+					DownlinkCount: 1,
+				},
+			},
+		},
+		{
+			Name:     "Day",
+			Duration: 24 * time.Hour,
+			Expected: []*ttnpb.GatewayConnectionStatsBucket{
+				{
+					Start:                        ref.Truncate(24 * time.Hour),
+					Duration:                     24 * time.Hour,
+					UplinkCount:                  16,
+					DownlinkCount:                3,
+					TxAcknowledgmentCount:        2,
+					TxAcknowledgmentFailureCount: 1,
+					RoundTripTimes: &ttnpb.GatewayConnectionStatsBucket_RoundTripTimes{
+						Min:          5 * time.Millisecond,
+						Max:          50 * time.Millisecond,
+						Median:       22500 * time.Microsecond,
+						Percentile90: 37500 * time.Microsecond,
+						Count:        4,
+					},
+					SubBands: subBand(0.0008),
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(Merge(buckets, tc.Duration), should.Resemble, tc.Expected)
+		})
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(Merge(nil, time.Hour), should.BeEmpty)
+	})
+}
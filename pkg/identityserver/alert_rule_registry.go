@@ -0,0 +1,252 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtCreateAlertRule = events.Define(
+		"alert_rule.create", "create alert rule",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtUpdateAlertRule = events.Define(
+		"alert_rule.update", "update alert rule",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC),
+		events.WithUpdatedFieldsDataType(),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteAlertRule = events.Define(
+		"alert_rule.delete", "delete alert rule",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errAlertRuleEntity      = errors.DefineInvalidArgument("alert_rule_entity", "alert rules must be defined on exactly one gateway or application")
+	errAlertRuleCondition   = errors.DefineInvalidArgument("alert_rule_condition", "condition `{condition}` is not supported on {entity_type}s")
+	errAlertRuleNoCondition = errors.DefineInvalidArgument("alert_rule_no_condition", "no alert rule condition")
+	errAlertRuleDuration    = errors.DefineInvalidArgument("alert_rule_duration", "`{field}` must be positive")
+)
+
+// requireAlertRuleRights checks whether the caller has the rights to read and
+// write the alert rules of the entity. Reading alert rules requires the same
+// rights as writing them, as alert rules contain webhook URLs.
+func requireAlertRuleRights(ctx context.Context, ids *ttnpb.EntityIdentifiers) error {
+	switch id := ids.Identifiers().(type) {
+	case *ttnpb.GatewayIdentifiers:
+		return rights.RequireGateway(ctx, *id, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC)
+	case *ttnpb.ApplicationIdentifiers:
+		return rights.RequireApplication(ctx, *id, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC)
+	default:
+		return errAlertRuleEntity.New()
+	}
+}
+
+// validateAlertRuleCondition checks whether the condition of the alert rule is
+// supported on the entity that it is defined on, and that its durations are positive.
+func validateAlertRuleCondition(rule *ttnpb.AlertRule) error {
+	var (
+		name      string
+		supported bool
+		durations map[string]time.Duration
+	)
+	switch cond := rule.Condition.(type) {
+	case *ttnpb.AlertRule_GatewayOffline:
+		name, supported = "gateway_offline", rule.GatewayIDs != nil
+		durations = map[string]time.Duration{
+			"gateway_offline.timeout": cond.GatewayOffline.GetTimeout(),
+		}
+	case *ttnpb.AlertRule_UplinkRateDrop:
+		name, supported = "uplink_rate_drop", true
+		durations = map[string]time.Duration{
+			"uplink_rate_drop.window": cond.UplinkRateDrop.GetWindow(),
+		}
+	case *ttnpb.AlertRule_TxAckFailure:
+		name, supported = "tx_ack_failure", rule.GatewayIDs != nil
+		durations = map[string]time.Duration{
+			"tx_ack_failure.window": cond.TxAckFailure.GetWindow(),
+		}
+	case *ttnpb.AlertRule_DeviceNotSeen:
+		name, supported = "device_not_seen", rule.ApplicationIDs != nil
+		durations = map[string]time.Duration{
+			"device_not_seen.period": cond.DeviceNotSeen.GetPeriod(),
+		}
+	default:
+		return errAlertRuleNoCondition.New()
+	}
+	if !supported {
+		return errAlertRuleCondition.WithAttributes(
+			"condition", name,
+			"entity_type", rule.GetEntityIdentifiers().EntityType(),
+		)
+	}
+	for field, d := range durations {
+		if d <= 0 {
+			return errAlertRuleDuration.WithAttributes("field", field)
+		}
+	}
+	return nil
+}
+
+func (is *IdentityServer) createAlertRule(ctx context.Context, req *ttnpb.CreateAlertRuleRequest) (rule *ttnpb.AlertRule, err error) {
+	entityIDs := req.GetEntityIdentifiers()
+	if err = requireAlertRuleRights(ctx, entityIDs); err != nil {
+		return nil, err
+	}
+	if err = validateAlertRuleCondition(&req.AlertRule); err != nil {
+		return nil, err
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		rule, err = store.GetAlertRuleStore(db).CreateAlertRule(ctx, &req.AlertRule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtCreateAlertRule.NewWithIdentifiersAndData(ctx, entityIDs, &req.AlertRuleIdentifiers))
+	return rule, nil
+}
+
+func (is *IdentityServer) getAlertRule(ctx context.Context, req *ttnpb.GetAlertRuleRequest) (rule *ttnpb.AlertRule, err error) {
+	if err = requireAlertRuleRights(ctx, req.GetEntityIdentifiers()); err != nil {
+		return nil, err
+	}
+	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.AlertRuleFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		rule, err = store.GetAlertRuleStore(db).GetAlertRule(ctx, &req.AlertRuleIdentifiers)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.AlertRule{}
+	if err = res.SetFields(rule, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) listAlertRules(ctx context.Context, req *ttnpb.ListAlertRulesRequest) (rules *ttnpb.AlertRules, err error) {
+	entityIDs := req.GetEntityIdentifiers()
+	if err = requireAlertRuleRights(ctx, entityIDs); err != nil {
+		return nil, err
+	}
+	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.AlertRuleFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	var total uint64
+	ctx = store.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	var found []*ttnpb.AlertRule
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		found, err = store.GetAlertRuleStore(db).ListAlertRules(ctx, entityIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rules = &ttnpb.AlertRules{
+		Rules: make([]*ttnpb.AlertRule, len(found)),
+	}
+	for i, rule := range found {
+		rules.Rules[i] = &ttnpb.AlertRule{}
+		if err = rules.Rules[i].SetFields(rule, req.FieldMask.Paths...); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+func (is *IdentityServer) updateAlertRule(ctx context.Context, req *ttnpb.UpdateAlertRuleRequest) (rule *ttnpb.AlertRule, err error) {
+	entityIDs := req.GetEntityIdentifiers()
+	if err = requireAlertRuleRights(ctx, entityIDs); err != nil {
+		return nil, err
+	}
+	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.AlertRuleFieldPathsNested, req.FieldMask.Paths, nil, getPaths)
+	if len(req.FieldMask.Paths) == 0 {
+		req.FieldMask.Paths = updatePaths
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "condition") {
+		if err = validateAlertRuleCondition(&req.AlertRule); err != nil {
+			return nil, err
+		}
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		rule, err = store.GetAlertRuleStore(db).UpdateAlertRule(ctx, &req.AlertRule, &req.FieldMask)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtUpdateAlertRule.NewWithIdentifiersAndData(ctx, entityIDs, req.FieldMask.Paths))
+	return rule, nil
+}
+
+func (is *IdentityServer) deleteAlertRule(ctx context.Context, ids *ttnpb.AlertRuleIdentifiers) (*types.Empty, error) {
+	entityIDs := ids.GetEntityIdentifiers()
+	if err := requireAlertRuleRights(ctx, entityIDs); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetAlertRuleStore(db).DeleteAlertRule(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtDeleteAlertRule.NewWithIdentifiersAndData(ctx, entityIDs, ids))
+	return ttnpb.Empty, nil
+}
+
+type alertRuleRegistry struct {
+	*IdentityServer
+}
+
+func (ar *alertRuleRegistry) Create(ctx context.Context, req *ttnpb.CreateAlertRuleRequest) (*ttnpb.AlertRule, error) {
+	return ar.createAlertRule(ctx, req)
+}
+
+func (ar *alertRuleRegistry) Get(ctx context.Context, req *ttnpb.GetAlertRuleRequest) (*ttnpb.AlertRule, error) {
+	return ar.getAlertRule(ctx, req)
+}
+
+func (ar *alertRuleRegistry) List(ctx context.Context, req *ttnpb.ListAlertRulesRequest) (*ttnpb.AlertRules, error) {
+	return ar.listAlertRules(ctx, req)
+}
+
+func (ar *alertRuleRegistry) Update(ctx context.Context, req *ttnpb.UpdateAlertRuleRequest) (*ttnpb.AlertRule, error) {
+	return ar.updateAlertRule(ctx, req)
+}
+
+func (ar *alertRuleRegistry) Delete(ctx context.Context, req *ttnpb.AlertRuleIdentifiers) (*types.Empty, error) {
+	return ar.deleteAlertRule(ctx, req)
+}
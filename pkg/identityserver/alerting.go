@@ -0,0 +1,111 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/jinzhu/gorm"
+	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/alerting"
+	alertingredis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/alerting/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// gatewayConnected returns whether the gateway is connected to the Gateway Server of the cluster.
+func (is *IdentityServer) gatewayConnected(ctx context.Context, ids ttnpb.GatewayIdentifiers) (bool, error) {
+	cc, err := is.GetPeerConn(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	if err != nil {
+		return false, err
+	}
+	_, err = ttnpb.NewGsClient(cc).GetGatewayConnectionStats(ctx, &ids, is.WithClusterAuth())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (is *IdentityServer) listEnabledAlertRules(ctx context.Context) (rules []*ttnpb.AlertRule, err error) {
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		rules, err = store.GetAlertRuleStore(db).ListEnabledAlertRules(ctx)
+		return err
+	})
+	return rules, err
+}
+
+// notifyAlertContacts sends the alert by email to the contacts of the entity
+// of the alert rule, if the alert rule is configured to do so.
+func (is *IdentityServer) notifyAlertContacts(ctx context.Context, alert *alerting.Alert) error {
+	if !alert.Rule.GetNotifyContacts() {
+		return nil
+	}
+	ids := alert.Rule.GetEntityIdentifiers()
+	return is.SendContactsEmail(ctx, ids, func(data emails.Data) email.MessageData {
+		data.SetEntity(ids)
+		return &emails.AlertNotification{
+			Data:      data,
+			RuleID:    alert.RuleID,
+			RuleName:  alert.RuleName,
+			Condition: alert.Condition,
+			DeviceID:  alert.DeviceID,
+			State:     string(alert.State),
+			Message:   alert.Message,
+		}
+	})
+}
+
+// initAlerting registers the task that evaluates the alert rules.
+// If Redis is configured, a single instance is elected to evaluate the alert
+// rules, and the active alerts are persisted in Redis. Otherwise, each instance
+// evaluates the alert rules and notifies about the alerts.
+// The connection state of gateways is reconciled with the Gateway Server, if
+// a reconcile interval is configured.
+func (is *IdentityServer) initAlerting() {
+	evaluator := alerting.NewEvaluator(
+		alerting.RuleSourceFunc(is.listEnabledAlertRules),
+		alerting.NotifierFunc(is.notifyAlertContacts),
+		&alerting.WebhookNotifier{
+			Client: alerting.NewWebhookClient(is.config.Alerting.WebhookTimeout, is.config.Alerting.WebhookAllowPrivate),
+		},
+	)
+	if interval := is.config.Alerting.ReconcileInterval; interval > 0 {
+		evaluator.SetConnectionSource(alerting.ConnectionSourceFunc(is.gatewayConnected), interval)
+	}
+	is.RegisterTask(&component.TaskConfig{
+		Context: is.Context(),
+		ID:      "alerting",
+		Func: func(ctx context.Context) error {
+			if is.redis != nil {
+				store := &alertingredis.Store{
+					Redis: is.redis,
+					ID:    ulid.MustNew(ulid.Now(), rand.Reader).String(),
+				}
+				evaluator.SetElection(store, store)
+			}
+			return evaluator.Run(ctx, is.config.Alerting.EvaluationInterval)
+		},
+		Restart: component.TaskRestartOnFailure,
+		Backoff: component.DefaultTaskBackoffConfig,
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alerting implements the evaluation of the alert rules of gateways
+// and applications on the events of the stack, and the notification of
+// triggered and resolved alerts.
+package alerting
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// State is the state of an alert.
+type State string
+
+const (
+	// StateTriggered is the state of an alert of which the condition is met.
+	StateTriggered State = "triggered"
+	// StateResolved is the state of an alert of which the condition is no longer met.
+	StateResolved State = "resolved"
+)
+
+// Alert is a triggered or resolved alert of an alert rule.
+type Alert struct {
+	// Rule is the alert rule that the alert originates from.
+	Rule *ttnpb.AlertRule `json:"-"`
+
+	RuleID     string    `json:"rule_id"`
+	RuleName   string    `json:"rule_name,omitempty"`
+	Condition  string    `json:"condition"`
+	EntityType string    `json:"entity_type"`
+	EntityID   string    `json:"entity_id"`
+	DeviceID   string    `json:"device_id,omitempty"`
+	State      State     `json:"state"`
+	Time       time.Time `json:"time"`
+	Message    string    `json:"message"`
+}
+
+// Notifier notifies about triggered and resolved alerts.
+type Notifier interface {
+	Notify(ctx context.Context, alert *Alert) error
+}
+
+// NotifierFunc is a function that implements Notifier.
+type NotifierFunc func(ctx context.Context, alert *Alert) error
+
+// Notify implements Notifier.
+func (f NotifierFunc) Notify(ctx context.Context, alert *Alert) error {
+	return f(ctx, alert)
+}
+
+// RuleSource provides the alert rules to evaluate.
+type RuleSource interface {
+	ListEnabledAlertRules(ctx context.Context) ([]*ttnpb.AlertRule, error)
+}
+
+// RuleSourceFunc is a function that implements RuleSource.
+type RuleSourceFunc func(ctx context.Context) ([]*ttnpb.AlertRule, error)
+
+// ListEnabledAlertRules implements RuleSource.
+func (f RuleSourceFunc) ListEnabledAlertRules(ctx context.Context) ([]*ttnpb.AlertRule, error) {
+	return f(ctx)
+}
+
+// ConnectionSource provides the connection state of gateways.
+type ConnectionSource interface {
+	// GatewayConnected returns whether the gateway is connected to a Gateway Server.
+	GatewayConnected(ctx context.Context, ids ttnpb.GatewayIdentifiers) (bool, error)
+}
+
+// ConnectionSourceFunc is a function that implements ConnectionSource.
+type ConnectionSourceFunc func(ctx context.Context, ids ttnpb.GatewayIdentifiers) (bool, error)
+
+// GatewayConnected implements ConnectionSource.
+func (f ConnectionSourceFunc) GatewayConnected(ctx context.Context, ids ttnpb.GatewayIdentifiers) (bool, error) {
+	return f(ctx, ids)
+}
+
+// Elector elects a single Evaluator among the instances that evaluate the same
+// alert rules, so that each alert is notified once.
+type Elector interface {
+	// Elect elects or re-elects the instance for the given duration, and
+	// returns whether the instance is elected.
+	Elect(ctx context.Context, ttl time.Duration) (bool, error)
+}
+
+// StateStore persists the active alerts, so that an Evaluator that takes over
+// from another instance does not notify about the same alerts again.
+type StateStore interface {
+	// LoadAlerts returns the active alerts by key.
+	LoadAlerts(ctx context.Context) (map[string]*Alert, error)
+	// SetAlert stores the active alert.
+	SetAlert(ctx context.Context, key string, alert *Alert) error
+	// DeleteAlert deletes the active alert.
+	DeleteAlert(ctx context.Context, key string) error
+}
+
+// conditionName returns the name of the condition of the alert rule.
+func conditionName(rule *ttnpb.AlertRule) string {
+	switch rule.Condition.(type) {
+	case *ttnpb.AlertRule_GatewayOffline:
+		return "gateway_offline"
+	case *ttnpb.AlertRule_UplinkRateDrop:
+		return "uplink_rate_drop"
+	case *ttnpb.AlertRule_TxAckFailure:
+		return "tx_ack_failure"
+	case *ttnpb.AlertRule_DeviceNotSeen:
+		return "device_not_seen"
+	default:
+		return ""
+	}
+}
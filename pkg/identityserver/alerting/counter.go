@@ -0,0 +1,53 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import "time"
+
+// bucketDuration is the resolution of counters.
+const bucketDuration = 10 * time.Second
+
+// counter counts occurrences in buckets of bucketDuration.
+type counter map[int64]uint64
+
+func bucket(t time.Time) int64 {
+	return t.UnixNano() / int64(bucketDuration)
+}
+
+// add counts an occurrence at t.
+func (c counter) add(t time.Time) {
+	c[bucket(t)]++
+}
+
+// count returns the number of occurrences in [from, to).
+func (c counter) count(from, to time.Time) (n uint64) {
+	first, last := bucket(from), bucket(to)
+	for b, v := range c {
+		if b >= first && b < last {
+			n += v
+		}
+	}
+	return n
+}
+
+// prune removes the occurrences before t.
+func (c counter) prune(t time.Time) {
+	first := bucket(t)
+	for b := range c {
+		if b < first {
+			delete(c, b)
+		}
+	}
+}
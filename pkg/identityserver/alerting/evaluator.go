@@ -0,0 +1,613 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtAlertTrigger = events.Define(
+		"alert.trigger", "trigger alert",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_GATEWAY_INFO),
+		events.WithDataType(&ttnpb.AlertRuleIdentifiers{}),
+	)
+	evtAlertResolve = events.Define(
+		"alert.resolve", "resolve alert",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_GATEWAY_INFO),
+		events.WithDataType(&ttnpb.AlertRuleIdentifiers{}),
+	)
+)
+
+// eventNames are the names of the events that the Evaluator observes.
+var eventNames = []string{
+	"gs.gateway.connect",
+	"gs.gateway.disconnect",
+	"gs.up.receive",
+	"gs.down.tx.success",
+	"gs.down.tx.fail",
+	"as.up.data.forward",
+}
+
+type gatewayState struct {
+	since          time.Time
+	retention      time.Duration
+	connected      bool
+	disconnectedAt time.Time
+	uplinks        counter
+	txSuccess      counter
+	txFail         counter
+	// changedAt is the time of the last connect or disconnect event of the gateway.
+	changedAt time.Time
+	// reconcile is whether the connection state of the gateway is reconciled with the
+	// ConnectionSource. It is set if the gateway has a gateway_offline rule.
+	reconcile    bool
+	reconciledAt time.Time
+}
+
+type applicationState struct {
+	since     time.Time
+	retention time.Duration
+	uplinks   counter
+	// notSeenTimeout is the longest timeout of the device_not_seen rules of the
+	// application. End devices are only tracked if it is non-zero.
+	notSeenTimeout time.Duration
+	lastSeen       map[string]time.Time
+}
+
+// Evaluator evaluates alert rules on the events of the gateways and
+// applications that the rules are defined on.
+//
+// The Evaluator mostly considers what it observed itself: end devices that
+// were not seen since the Evaluator started are not considered missing. If a
+// ConnectionSource is configured, the connection state of gateways is seeded
+// and periodically reconciled from it, so that gateways that disconnected
+// before the Evaluator started, or of which the disconnect event was missed,
+// are considered offline since they were first found disconnected.
+//
+// When multiple instances run, an Elector elects the instance that evaluates
+// the alert rules and notifies about alerts, and a StateStore persists the
+// active alerts. All instances observe the events, so that an instance that
+// takes over has the same view as the instance it takes over from.
+type Evaluator struct {
+	source    RuleSource
+	notifiers []Notifier
+	elector   Elector
+	store     StateStore
+
+	connections       ConnectionSource
+	reconcileInterval time.Duration
+
+	mu           sync.Mutex
+	rules        []*ttnpb.AlertRule
+	gateways     map[string]*gatewayState
+	applications map[string]*applicationState
+	elected      bool
+	active       map[string]*Alert
+	// discarded are the keys of the active alerts of rules that no longer exist.
+	discarded []string
+}
+
+// NewEvaluator returns a new Evaluator that evaluates the alert rules of the
+// given source, and notifies the given notifiers about alerts.
+func NewEvaluator(source RuleSource, notifiers ...Notifier) *Evaluator {
+	return &Evaluator{
+		source:       source,
+		notifiers:    notifiers,
+		gateways:     make(map[string]*gatewayState),
+		applications: make(map[string]*applicationState),
+		active:       make(map[string]*Alert),
+	}
+}
+
+// SetElection configures the Evaluator to only evaluate the alert rules while
+// it is elected by the given elector, and to persist the active alerts in the
+// given store. The store may be nil. SetElection must be called before Run.
+func (e *Evaluator) SetElection(elector Elector, store StateStore) {
+	e.elector, e.store = elector, store
+}
+
+// SetConnectionSource configures the Evaluator to reconcile the connection
+// state of gateways with gateway_offline rules with the given source, at the
+// given interval. SetConnectionSource must be called before Run.
+func (e *Evaluator) SetConnectionSource(source ConnectionSource, interval time.Duration) {
+	e.connections, e.reconcileInterval = source, interval
+}
+
+func ruleKey(rule *ttnpb.AlertRule) string {
+	ids := rule.GetEntityIdentifiers()
+	return fmt.Sprintf("%s:%s:%s", ids.EntityType(), ids.IDString(), rule.RuleID)
+}
+
+// alertRuleKey returns the key of the alert rule that the alert originates from.
+func alertRuleKey(alert *Alert) string {
+	return fmt.Sprintf("%s:%s:%s", alert.EntityType, alert.EntityID, alert.RuleID)
+}
+
+// alertKey returns the key of the alert.
+func alertKey(alert *Alert) string {
+	key := alertRuleKey(alert)
+	if alert.DeviceID != "" {
+		key += ":" + alert.DeviceID
+	}
+	return key
+}
+
+func deviceNotSeenTimeout(rule *ttnpb.AlertRule) time.Duration {
+	cond, ok := rule.Condition.(*ttnpb.AlertRule_DeviceNotSeen)
+	if !ok {
+		return 0
+	}
+	return cond.DeviceNotSeen.GetPeriod() * time.Duration(cond.DeviceNotSeen.GetPeriods())
+}
+
+func ruleRetention(rule *ttnpb.AlertRule) time.Duration {
+	switch cond := rule.Condition.(type) {
+	case *ttnpb.AlertRule_UplinkRateDrop:
+		return 2 * cond.UplinkRateDrop.GetWindow()
+	case *ttnpb.AlertRule_TxAckFailure:
+		return cond.TxAckFailure.GetWindow()
+	default:
+		return 0
+	}
+}
+
+// SetRules sets the alert rules to evaluate. The state of gateways and
+// applications that are no longer referenced by any rule is discarded, as are
+// the active alerts of rules that no longer exist.
+func (e *Evaluator) SetRules(rules []*ttnpb.AlertRule, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	gateways := make(map[string]*gatewayState)
+	applications := make(map[string]*applicationState)
+	for _, rule := range rules {
+		retention := ruleRetention(rule)
+		switch {
+		case rule.GatewayIDs != nil:
+			id := rule.GatewayIDs.GatewayID
+			st, ok := gateways[id]
+			if !ok {
+				if st, ok = e.gateways[id]; !ok {
+					st = &gatewayState{
+						since:     now,
+						uplinks:   make(counter),
+						txSuccess: make(counter),
+						txFail:    make(counter),
+					}
+				}
+				st.retention, st.reconcile = 0, false
+				gateways[id] = st
+			}
+			if retention > st.retention {
+				st.retention = retention
+			}
+			if _, ok := rule.Condition.(*ttnpb.AlertRule_GatewayOffline); ok {
+				st.reconcile = true
+			}
+		case rule.ApplicationIDs != nil:
+			id := rule.ApplicationIDs.ApplicationID
+			st, ok := applications[id]
+			if !ok {
+				if st, ok = e.applications[id]; !ok {
+					st = &applicationState{
+						since:    now,
+						uplinks:  make(counter),
+						lastSeen: make(map[string]time.Time),
+					}
+				}
+				st.retention, st.notSeenTimeout = 0, 0
+				applications[id] = st
+			}
+			if retention > st.retention {
+				st.retention = retention
+			}
+			if timeout := deviceNotSeenTimeout(rule); timeout > st.notSeenTimeout {
+				st.notSeenTimeout = timeout
+			}
+		}
+	}
+	for _, st := range applications {
+		if st.notSeenTimeout == 0 && len(st.lastSeen) > 0 {
+			st.lastSeen = make(map[string]time.Time)
+		}
+	}
+	e.rules, e.gateways, e.applications = rules, gateways, applications
+	e.bindActive()
+}
+
+// bindActive binds the active alerts to the alert rules that they originate
+// from. Active alerts of rules that no longer exist are discarded.
+func (e *Evaluator) bindActive() {
+	rules := make(map[string]*ttnpb.AlertRule, len(e.rules))
+	for _, rule := range e.rules {
+		rules[ruleKey(rule)] = rule
+	}
+	for key, alert := range e.active {
+		rule, ok := rules[alertRuleKey(alert)]
+		if !ok {
+			delete(e.active, key)
+			e.discarded = append(e.discarded, key)
+			continue
+		}
+		alert.Rule = rule
+	}
+}
+
+// Refresh loads the alert rules from the rule source.
+func (e *Evaluator) Refresh(ctx context.Context, now time.Time) error {
+	rules, err := e.source.ListEnabledAlertRules(ctx)
+	if err != nil {
+		return err
+	}
+	e.SetRules(rules, now)
+	return nil
+}
+
+// Notify implements events.Handler.
+func (e *Evaluator) Notify(evt events.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	t := evt.Time()
+	for _, ids := range evt.Identifiers() {
+		switch {
+		case ids.GetGatewayIDs() != nil:
+			st, ok := e.gateways[ids.GetGatewayIDs().GatewayID]
+			if !ok {
+				continue
+			}
+			switch evt.Name() {
+			case "gs.gateway.connect":
+				st.connected, st.disconnectedAt, st.changedAt = true, time.Time{}, t
+			case "gs.gateway.disconnect":
+				st.connected, st.disconnectedAt, st.changedAt = false, t, t
+			case "gs.up.receive":
+				st.uplinks.add(t)
+			case "gs.down.tx.success":
+				st.txSuccess.add(t)
+			case "gs.down.tx.fail":
+				st.txFail.add(t)
+			}
+		case ids.GetDeviceIDs() != nil:
+			devIDs := ids.GetDeviceIDs()
+			st, ok := e.applications[devIDs.ApplicationID]
+			if !ok {
+				continue
+			}
+			if evt.Name() == "as.up.data.forward" {
+				st.uplinks.add(t)
+				if st.notSeenTimeout > 0 {
+					st.lastSeen[devIDs.DeviceID] = t
+				}
+			}
+		}
+	}
+}
+
+// Reconcile reconciles the connection state of the gateways that have
+// gateway_offline rules with the ConnectionSource, if one is configured. Each
+// gateway is reconciled once per reconcile interval, and gateways that are not
+// reconciled yet are reconciled immediately. A gateway that is not connected
+// is considered offline since now, unless it is already considered offline.
+func (e *Evaluator) Reconcile(ctx context.Context, now time.Time) {
+	if e.connections == nil {
+		return
+	}
+	e.mu.Lock()
+	var gtwIDs []ttnpb.GatewayIdentifiers
+	for id, st := range e.gateways {
+		if st.reconcile && (st.reconciledAt.IsZero() || now.Sub(st.reconciledAt) >= e.reconcileInterval) {
+			st.reconciledAt = now
+			gtwIDs = append(gtwIDs, ttnpb.GatewayIdentifiers{GatewayID: id})
+		}
+	}
+	e.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+	for _, ids := range gtwIDs {
+		connected, err := e.connections.GatewayConnected(ctx, ids)
+		if err != nil {
+			logger.WithError(err).WithField("gateway_id", ids.GatewayID).Warn("Failed to get gateway connection state")
+			continue
+		}
+		e.mu.Lock()
+		st, ok := e.gateways[ids.GatewayID]
+		switch {
+		case !ok || st.changedAt.After(now):
+			// The gateway is no longer referenced by any rule, or it connected
+			// or disconnected since its connection state was requested.
+		case connected:
+			st.connected, st.disconnectedAt = true, time.Time{}
+		case st.connected || st.disconnectedAt.IsZero():
+			st.connected, st.disconnectedAt = false, now
+		}
+		e.mu.Unlock()
+	}
+}
+
+// condition is the outcome of the evaluation of an alert rule on an entity
+// or end device.
+type condition struct {
+	deviceID string
+	met      bool
+	message  string
+}
+
+func evaluateRateDrop(uplinks counter, since time.Time, cond *ttnpb.AlertRule_UplinkRateDropCondition, now time.Time) condition {
+	window := cond.GetWindow()
+	if now.Sub(since) < 2*window {
+		return condition{}
+	}
+	current := uplinks.count(now.Add(-window), now)
+	previous := uplinks.count(now.Add(-2*window), now.Add(-window))
+	if previous == 0 {
+		return condition{}
+	}
+	drop := 100 * (1 - float64(current)/float64(previous))
+	return condition{
+		met:     drop >= float64(cond.GetDropPercentage()),
+		message: fmt.Sprintf("uplink rate dropped by %.1f%% from %d to %d uplinks per %s", drop, previous, current, window),
+	}
+}
+
+func (e *Evaluator) evaluateGateway(rule *ttnpb.AlertRule, st *gatewayState, now time.Time) []condition {
+	switch cond := rule.Condition.(type) {
+	case *ttnpb.AlertRule_GatewayOffline:
+		timeout := cond.GatewayOffline.GetTimeout()
+		if st.connected || st.disconnectedAt.IsZero() {
+			return []condition{{message: "gateway is connected"}}
+		}
+		offline := now.Sub(st.disconnectedAt)
+		return []condition{{
+			met:     offline >= timeout,
+			message: fmt.Sprintf("gateway is offline since %s", st.disconnectedAt.UTC().Format(time.RFC3339)),
+		}}
+	case *ttnpb.AlertRule_UplinkRateDrop:
+		return []condition{evaluateRateDrop(st.uplinks, st.since, cond.UplinkRateDrop, now)}
+	case *ttnpb.AlertRule_TxAckFailure:
+		from := now.Add(-cond.TxAckFailure.GetWindow())
+		success, fail := st.txSuccess.count(from, now), st.txFail.count(from, now)
+		total := success + fail
+		min := uint64(cond.TxAckFailure.GetMinAcknowledgments())
+		if min < 1 {
+			min = 1
+		}
+		if total < min {
+			return []condition{{}}
+		}
+		ratio := float64(fail) / float64(total)
+		return []condition{{
+			met:     ratio >= float64(cond.TxAckFailure.GetFailureRatio()),
+			message: fmt.Sprintf("%d of %d transmissions failed", fail, total),
+		}}
+	default:
+		return nil
+	}
+}
+
+func (e *Evaluator) evaluateApplication(rule *ttnpb.AlertRule, st *applicationState, now time.Time) []condition {
+	switch cond := rule.Condition.(type) {
+	case *ttnpb.AlertRule_UplinkRateDrop:
+		return []condition{evaluateRateDrop(st.uplinks, st.since, cond.UplinkRateDrop, now)}
+	case *ttnpb.AlertRule_DeviceNotSeen:
+		timeout := deviceNotSeenTimeout(rule)
+		res := make([]condition, 0, len(st.lastSeen))
+		for devID, lastSeen := range st.lastSeen {
+			res = append(res, condition{
+				deviceID: devID,
+				met:      now.Sub(lastSeen) >= timeout,
+				message:  fmt.Sprintf("end device last seen at %s", lastSeen.UTC().Format(time.RFC3339)),
+			})
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+// prune discards the observations that are no longer needed to evaluate the
+// alert rules. End devices that are not seen for longer than the longest
+// device_not_seen timeout are no longer tracked: their alerts are triggered by
+// then, and are resolved when the end device is seen again.
+func (e *Evaluator) prune(now time.Time) {
+	for _, st := range e.gateways {
+		st.uplinks.prune(now.Add(-st.retention))
+		st.txSuccess.prune(now.Add(-st.retention))
+		st.txFail.prune(now.Add(-st.retention))
+	}
+	for _, st := range e.applications {
+		st.uplinks.prune(now.Add(-st.retention))
+		for devID, lastSeen := range st.lastSeen {
+			if now.Sub(lastSeen) >= st.notSeenTimeout {
+				delete(st.lastSeen, devID)
+			}
+		}
+	}
+}
+
+// Evaluate evaluates the alert rules, and publishes events and notifies the
+// notifiers about alerts that are triggered or resolved since the last evaluation.
+func (e *Evaluator) Evaluate(ctx context.Context, now time.Time) {
+	var changed []*Alert
+
+	e.mu.Lock()
+	for _, rule := range e.rules {
+		var conds []condition
+		switch {
+		case rule.GatewayIDs != nil:
+			if st, ok := e.gateways[rule.GatewayIDs.GatewayID]; ok {
+				conds = e.evaluateGateway(rule, st, now)
+			}
+		case rule.ApplicationIDs != nil:
+			if st, ok := e.applications[rule.ApplicationIDs.ApplicationID]; ok {
+				conds = e.evaluateApplication(rule, st, now)
+			}
+		}
+		for _, cond := range conds {
+			key := ruleKey(rule)
+			if cond.deviceID != "" {
+				key += ":" + cond.deviceID
+			}
+			_, active := e.active[key]
+			switch {
+			case cond.met && !active:
+				ids := rule.GetEntityIdentifiers()
+				alert := &Alert{
+					Rule:       rule,
+					RuleID:     rule.RuleID,
+					RuleName:   rule.Name,
+					Condition:  conditionName(rule),
+					EntityType: ids.EntityType(),
+					EntityID:   ids.IDString(),
+					DeviceID:   cond.deviceID,
+					State:      StateTriggered,
+					Time:       now,
+					Message:    cond.message,
+				}
+				e.active[key] = alert
+				changed = append(changed, alert)
+			case !cond.met && active:
+				alert := *e.active[key]
+				alert.State, alert.Time, alert.Message = StateResolved, now, cond.message
+				delete(e.active, key)
+				changed = append(changed, &alert)
+			}
+		}
+	}
+	e.prune(now)
+	discarded := e.discarded
+	e.discarded = nil
+	e.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+	if e.store != nil {
+		for _, key := range discarded {
+			if err := e.store.DeleteAlert(ctx, key); err != nil {
+				logger.WithError(err).WithField("key", key).Warn("Failed to delete discarded alert")
+			}
+		}
+	}
+	for _, alert := range changed {
+		if err := e.storeAlert(ctx, alert); err != nil {
+			logger.WithError(err).WithField("key", alertKey(alert)).Warn("Failed to store alert")
+		}
+		evt := evtAlertTrigger
+		if alert.State == StateResolved {
+			evt = evtAlertResolve
+		}
+		events.Publish(evt.NewWithIdentifiersAndData(ctx, alert.Rule.GetEntityIdentifiers(), &alert.Rule.AlertRuleIdentifiers))
+		for _, notifier := range e.notifiers {
+			if err := notifier.Notify(ctx, alert); err != nil {
+				logger.WithError(err).WithFields(log.Fields(
+					"rule_id", alert.RuleID,
+					"entity_type", alert.EntityType,
+					"entity_id", alert.EntityID,
+				)).Warn("Failed to send alert notification")
+			}
+		}
+	}
+}
+
+// storeAlert stores the triggered alert, or deletes the resolved alert, if a
+// StateStore is configured.
+func (e *Evaluator) storeAlert(ctx context.Context, alert *Alert) error {
+	if e.store == nil {
+		return nil
+	}
+	if alert.State == StateResolved {
+		return e.store.DeleteAlert(ctx, alertKey(alert))
+	}
+	return e.store.SetAlert(ctx, alertKey(alert), alert)
+}
+
+// elect elects the Evaluator for the given duration and returns whether it is
+// elected. When the Evaluator is newly elected, the active alerts are loaded
+// from the store. When the Evaluator is not elected, the active alerts are
+// discarded, as these are owned by the elected instance.
+func (e *Evaluator) elect(ctx context.Context, ttl time.Duration) (bool, error) {
+	if e.elector == nil {
+		return true, nil
+	}
+	elected, err := e.elector.Elect(ctx, ttl)
+	if err != nil || !elected {
+		e.mu.Lock()
+		e.elected, e.active, e.discarded = false, make(map[string]*Alert), nil
+		e.mu.Unlock()
+		return false, err
+	}
+	e.mu.Lock()
+	wasElected := e.elected
+	e.mu.Unlock()
+	if wasElected {
+		return true, nil
+	}
+	active := make(map[string]*Alert)
+	if e.store != nil {
+		if active, err = e.store.LoadAlerts(ctx); err != nil {
+			return false, err
+		}
+	}
+	e.mu.Lock()
+	e.elected, e.active = true, active
+	e.bindActive()
+	e.mu.Unlock()
+	return true, nil
+}
+
+// Run subscribes the Evaluator to events, and refreshes the alert rules,
+// reconciles the connection state of gateways and evaluates the alert rules
+// at the given interval until the context is done.
+// If an Elector is configured, the alert rules are only evaluated while the
+// Evaluator is elected.
+func (e *Evaluator) Run(ctx context.Context, interval time.Duration) error {
+	for _, name := range eventNames {
+		if err := events.Subscribe(name, e); err != nil {
+			return err
+		}
+		defer events.Unsubscribe(name, e)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		if err := e.Refresh(ctx, now); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to refresh alert rules")
+		} else {
+			e.Reconcile(ctx, now)
+			if elected, err := e.elect(ctx, 2*interval); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to elect alert evaluator")
+			} else if elected {
+				e.Evaluate(ctx, now)
+			} else {
+				e.mu.Lock()
+				e.prune(now)
+				e.mu.Unlock()
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/identityserver/alerting"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type recorder struct {
+	alerts []*Alert
+}
+
+func (r *recorder) Notify(_ context.Context, alert *Alert) error {
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *recorder) take() []*Alert {
+	alerts := r.alerts
+	r.alerts = nil
+	return alerts
+}
+
+func newEvent(t *testing.T, name string, at time.Time, ids ttnpb.Identifiers) events.Event {
+	evt, err := events.FromProto(&ttnpb.Event{
+		Name:        name,
+		Time:        at,
+		Identifiers: []*ttnpb.EntityIdentifiers{ids.EntityIdentifiers()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return evt
+}
+
+var (
+	start  = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	gtwIDs = ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
+	appIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	devIDs = ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "test-dev"}
+)
+
+func TestGatewayOffline(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	rec := &recorder{}
+	e := NewEvaluator(nil, rec)
+	e.SetRules([]*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtwIDs, RuleID: "offline"},
+		Condition: &ttnpb.AlertRule_GatewayOffline{
+			GatewayOffline: &ttnpb.AlertRule_GatewayOfflineCondition{Timeout: 5 * time.Minute},
+		},
+	}}, start)
+
+	e.Evaluate(ctx, start.Add(time.Hour))
+	a.So(rec.take(), should.BeEmpty)
+
+	e.Notify(newEvent(t, "gs.gateway.connect", start.Add(time.Minute), gtwIDs))
+	e.Notify(newEvent(t, "gs.gateway.disconnect", start.Add(2*time.Minute), gtwIDs))
+
+	e.Evaluate(ctx, start.Add(6*time.Minute))
+	a.So(rec.take(), should.BeEmpty)
+
+	e.Evaluate(ctx, start.Add(7*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateTriggered)
+		a.So(alerts[0].Condition, should.Equal, "gateway_offline")
+		a.So(alerts[0].EntityType, should.Equal, "gateway")
+		a.So(alerts[0].EntityID, should.Equal, "test-gtw")
+	}
+
+	e.Evaluate(ctx, start.Add(8*time.Minute))
+	a.So(rec.take(), should.BeEmpty)
+
+	e.Notify(newEvent(t, "gs.gateway.connect", start.Add(9*time.Minute), gtwIDs))
+	e.Evaluate(ctx, start.Add(10*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+	}
+}
+
+func TestGatewayOfflineReconcile(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	connected, requests := false, 0
+	rec := &recorder{}
+	e := NewEvaluator(nil, rec)
+	e.SetConnectionSource(ConnectionSourceFunc(func(_ context.Context, ids ttnpb.GatewayIdentifiers) (bool, error) {
+		a.So(ids, should.Resemble, gtwIDs)
+		requests++
+		return connected, nil
+	}), 10*time.Minute)
+	e.SetRules([]*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtwIDs, RuleID: "offline"},
+		Condition: &ttnpb.AlertRule_GatewayOffline{
+			GatewayOffline: &ttnpb.AlertRule_GatewayOfflineCondition{Timeout: 5 * time.Minute},
+		},
+	}}, start)
+
+	// The gateway disconnected before the Evaluator started; it is offline since it is first found disconnected.
+	e.Reconcile(ctx, start)
+	a.So(requests, should.Equal, 1)
+	e.Evaluate(ctx, start.Add(4*time.Minute))
+	a.So(rec.take(), should.BeEmpty)
+
+	// The connection state is not requested again before the reconcile interval passed.
+	e.Reconcile(ctx, start.Add(5*time.Minute))
+	a.So(requests, should.Equal, 1)
+	e.Evaluate(ctx, start.Add(5*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateTriggered)
+	}
+
+	// The connect event was missed; the gateway is found connected on the next reconciliation.
+	connected = true
+	e.Reconcile(ctx, start.Add(10*time.Minute))
+	a.So(requests, should.Equal, 2)
+	e.Evaluate(ctx, start.Add(10*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+	}
+
+	// The disconnect event was missed; the gateway is offline since the reconciliation.
+	connected = false
+	e.Reconcile(ctx, start.Add(20*time.Minute))
+	a.So(requests, should.Equal, 3)
+	e.Evaluate(ctx, start.Add(24*time.Minute))
+	a.So(rec.take(), should.BeEmpty)
+	e.Evaluate(ctx, start.Add(25*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateTriggered)
+	}
+
+	// Events since the connection state was requested take precedence.
+	e.Notify(newEvent(t, "gs.gateway.connect", start.Add(31*time.Minute), gtwIDs))
+	e.Reconcile(ctx, start.Add(30*time.Minute))
+	a.So(requests, should.Equal, 4)
+	e.Evaluate(ctx, start.Add(32*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+	}
+}
+
+func TestUplinkRateDrop(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	rec := &recorder{}
+	e := NewEvaluator(nil, rec)
+	e.SetRules([]*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{ApplicationIDs: &appIDs, RuleID: "rate"},
+		Condition: &ttnpb.AlertRule_UplinkRateDrop{
+			UplinkRateDrop: &ttnpb.AlertRule_UplinkRateDropCondition{DropPercentage: 50, Window: time.Hour},
+		},
+	}}, start)
+
+	uplinks := func(hour, n int) {
+		for i := 0; i < n; i++ {
+			e.Notify(newEvent(t, "as.up.data.forward", start.Add(time.Duration(hour)*time.Hour+time.Duration(i)*time.Minute), devIDs))
+		}
+	}
+
+	uplinks(0, 10)
+	uplinks(1, 8)
+	e.Evaluate(ctx, start.Add(2*time.Hour))
+	a.So(rec.take(), should.BeEmpty)
+
+	uplinks(2, 2)
+	e.Evaluate(ctx, start.Add(3*time.Hour))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateTriggered)
+		a.So(alerts[0].Condition, should.Equal, "uplink_rate_drop")
+		a.So(alerts[0].EntityType, should.Equal, "application")
+	}
+
+	uplinks(3, 2)
+	e.Evaluate(ctx, start.Add(4*time.Hour))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+	}
+}
+
+func TestTxAckFailure(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	rec := &recorder{}
+	e := NewEvaluator(nil, rec)
+	e.SetRules([]*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtwIDs, RuleID: "tx"},
+		Condition: &ttnpb.AlertRule_TxAckFailure{
+			TxAckFailure: &ttnpb.AlertRule_TxAckFailureCondition{
+				FailureRatio:       0.5,
+				MinAcknowledgments: 4,
+				Window:             10 * time.Minute,
+			},
+		},
+	}}, start)
+
+	e.Notify(newEvent(t, "gs.down.tx.fail", start.Add(time.Minute), gtwIDs))
+	e.Notify(newEvent(t, "gs.down.tx.fail", start.Add(2*time.Minute), gtwIDs))
+	e.Evaluate(ctx, start.Add(5*time.Minute))
+	a.So(rec.take(), should.BeEmpty)
+
+	e.Notify(newEvent(t, "gs.down.tx.success", start.Add(3*time.Minute), gtwIDs))
+	e.Notify(newEvent(t, "gs.down.tx.fail", start.Add(4*time.Minute), gtwIDs))
+	e.Evaluate(ctx, start.Add(5*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateTriggered)
+		a.So(alerts[0].Condition, should.Equal, "tx_ack_failure")
+	}
+
+	for i := 0; i < 4; i++ {
+		e.Notify(newEvent(t, "gs.down.tx.success", start.Add(time.Duration(10+i)*time.Minute), gtwIDs))
+	}
+	e.Evaluate(ctx, start.Add(15*time.Minute))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+	}
+}
+
+func TestDeviceNotSeen(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	rec := &recorder{}
+	e := NewEvaluator(nil, rec)
+	e.SetRules([]*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{ApplicationIDs: &appIDs, RuleID: "missing"},
+		Condition: &ttnpb.AlertRule_DeviceNotSeen{
+			DeviceNotSeen: &ttnpb.AlertRule_DeviceNotSeenCondition{Period: time.Hour, Periods: 3},
+		},
+	}}, start)
+
+	e.Notify(newEvent(t, "as.up.data.forward", start, devIDs))
+	e.Evaluate(ctx, start.Add(2*time.Hour))
+	a.So(rec.take(), should.BeEmpty)
+
+	e.Evaluate(ctx, start.Add(3*time.Hour))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateTriggered)
+		a.So(alerts[0].Condition, should.Equal, "device_not_seen")
+		a.So(alerts[0].DeviceID, should.Equal, "test-dev")
+	}
+
+	e.Notify(newEvent(t, "as.up.data.forward", start.Add(4*time.Hour), devIDs))
+	e.Evaluate(ctx, start.Add(4*time.Hour))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+		a.So(alerts[0].DeviceID, should.Equal, "test-dev")
+	}
+
+	// Alerts of removed rules are discarded without notification.
+	e.Evaluate(ctx, start.Add(8*time.Hour))
+	a.So(rec.take(), should.HaveLength, 1)
+	e.SetRules(nil, start.Add(8*time.Hour))
+	e.Evaluate(ctx, start.Add(9*time.Hour))
+	a.So(rec.take(), should.BeEmpty)
+}
+
+type memoryElection struct {
+	leader *Evaluator
+	alerts map[string]*Alert
+}
+
+type memoryElector struct {
+	*memoryElection
+	e *Evaluator
+}
+
+func (m memoryElector) Elect(_ context.Context, _ time.Duration) (bool, error) {
+	if m.leader == nil {
+		m.leader = m.e
+	}
+	return m.leader == m.e, nil
+}
+
+func (m *memoryElection) LoadAlerts(context.Context) (map[string]*Alert, error) {
+	alerts := make(map[string]*Alert, len(m.alerts))
+	for key, alert := range m.alerts {
+		alert := *alert
+		alerts[key] = &alert
+	}
+	return alerts, nil
+}
+
+func (m *memoryElection) SetAlert(_ context.Context, key string, alert *Alert) error {
+	alert2 := *alert
+	m.alerts[key] = &alert2
+	return nil
+}
+
+func (m *memoryElection) DeleteAlert(_ context.Context, key string) error {
+	delete(m.alerts, key)
+	return nil
+}
+
+func TestElection(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	rules := []*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtwIDs, RuleID: "offline"},
+		Condition: &ttnpb.AlertRule_GatewayOffline{
+			GatewayOffline: &ttnpb.AlertRule_GatewayOfflineCondition{Timeout: 5 * time.Minute},
+		},
+	}}
+	election := &memoryElection{alerts: make(map[string]*Alert)}
+	rec1, rec2 := &recorder{}, &recorder{}
+	e1, e2 := NewEvaluator(nil, rec1), NewEvaluator(nil, rec2)
+	e1.SetElection(memoryElector{election, e1}, election)
+	e2.SetElection(memoryElector{election, e2}, election)
+	for _, e := range []*Evaluator{e1, e2} {
+		e.SetRules(rules, start)
+		e.Notify(newEvent(t, "gs.gateway.connect", start.Add(time.Minute), gtwIDs))
+		e.Notify(newEvent(t, "gs.gateway.disconnect", start.Add(2*time.Minute), gtwIDs))
+	}
+
+	elected, err := e1.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeTrue)
+	elected, err = e2.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeFalse)
+
+	e1.Evaluate(ctx, start.Add(7*time.Minute))
+	a.So(rec1.take(), should.HaveLength, 1)
+	a.So(election.alerts, should.ContainKey, "gateway:test-gtw:offline")
+
+	// The instance that takes over loads the active alerts and does not notify again.
+	election.leader = nil
+	elected, err = e2.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeTrue)
+	e2.Evaluate(ctx, start.Add(8*time.Minute))
+	a.So(rec2.take(), should.BeEmpty)
+
+	for _, e := range []*Evaluator{e1, e2} {
+		e.Notify(newEvent(t, "gs.gateway.connect", start.Add(9*time.Minute), gtwIDs))
+	}
+	e2.Evaluate(ctx, start.Add(10*time.Minute))
+	if alerts := rec2.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+		a.So(alerts[0].Rule, should.Equal, rules[0])
+	}
+	a.So(election.alerts, should.BeEmpty)
+
+	// Active alerts of removed rules are deleted from the store.
+	e2.Notify(newEvent(t, "gs.gateway.disconnect", start.Add(11*time.Minute), gtwIDs))
+	e2.Evaluate(ctx, start.Add(20*time.Minute))
+	a.So(rec2.take(), should.HaveLength, 1)
+	a.So(election.alerts, should.HaveLength, 1)
+	e2.SetRules(nil, start.Add(20*time.Minute))
+	e2.Evaluate(ctx, start.Add(21*time.Minute))
+	a.So(rec2.take(), should.BeEmpty)
+	a.So(election.alerts, should.BeEmpty)
+}
+
+func TestDeviceNotSeenPrune(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	rec := &recorder{}
+	e := NewEvaluator(nil, rec)
+	e.SetRules([]*ttnpb.AlertRule{{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{ApplicationIDs: &appIDs, RuleID: "missing"},
+		Condition: &ttnpb.AlertRule_DeviceNotSeen{
+			DeviceNotSeen: &ttnpb.AlertRule_DeviceNotSeenCondition{Period: time.Hour, Periods: 1},
+		},
+	}}, start)
+
+	e.Notify(newEvent(t, "as.up.data.forward", start, devIDs))
+	e.Evaluate(ctx, start.Add(time.Hour))
+	a.So(rec.take(), should.HaveLength, 1)
+
+	// The end device is no longer tracked, but the alert remains active until
+	// the end device is seen again.
+	e.Evaluate(ctx, start.Add(2*time.Hour))
+	a.So(rec.take(), should.BeEmpty)
+	e.Notify(newEvent(t, "as.up.data.forward", start.Add(3*time.Hour), devIDs))
+	e.Evaluate(ctx, start.Add(3*time.Hour))
+	if alerts := rec.take(); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].State, should.Equal, StateResolved)
+	}
+}
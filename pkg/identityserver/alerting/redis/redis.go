@@ -0,0 +1,100 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the election of the alert rule evaluator and the
+// persistence of active alerts using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/alerting"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+// electScript sets the leader key to the instance if the key is held by the
+// instance or if it expired. It returns 1 if the instance is elected, and 0
+// otherwise.
+var electScript = redis.NewScript(`local leader = redis.call('get', KEYS[1])
+if not leader or leader == ARGV[1] then
+	redis.call('set', KEYS[1], ARGV[1], 'px', ARGV[2])
+	return 1
+end
+return 0`)
+
+// Store implements alerting.Elector and alerting.StateStore.
+// The elected instance is stored in a key that expires with the election.
+// The active alerts are stored as JSON in a hash.
+type Store struct {
+	Redis *ttnredis.Client
+	// ID is the unique identifier of the instance.
+	ID string
+}
+
+func (s *Store) leaderKey() string {
+	return s.Redis.Key("alerting", "leader")
+}
+
+func (s *Store) alertsKey() string {
+	return s.Redis.Key("alerting", "alerts")
+}
+
+// Elect implements alerting.Elector.
+func (s *Store) Elect(ctx context.Context, ttl time.Duration) (bool, error) {
+	res, err := electScript.Run(ctx, s.Redis, []string{s.leaderKey()}, s.ID, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return res == 1, nil
+}
+
+// LoadAlerts implements alerting.StateStore.
+func (s *Store) LoadAlerts(ctx context.Context) (map[string]*alerting.Alert, error) {
+	vs, err := s.Redis.HGetAll(ctx, s.alertsKey()).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	alerts := make(map[string]*alerting.Alert, len(vs))
+	for key, v := range vs {
+		alert := &alerting.Alert{}
+		if err := json.Unmarshal([]byte(v), alert); err != nil {
+			return nil, err
+		}
+		alerts[key] = alert
+	}
+	return alerts, nil
+}
+
+// SetAlert implements alerting.StateStore.
+func (s *Store) SetAlert(ctx context.Context, key string, alert *alerting.Alert) error {
+	v, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	if err := s.Redis.HSet(ctx, s.alertsKey(), key, v).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// DeleteAlert implements alerting.StateStore.
+func (s *Store) DeleteAlert(ctx context.Context, key string) error {
+	if err := s.Redis.HDel(ctx, s.alertsKey(), key).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/alerting"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestElect(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	is1 := &Store{Redis: cl, ID: "is1"}
+	is2 := &Store{Redis: cl, ID: "is2"}
+
+	elected, err := is1.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeTrue)
+
+	elected, err = is2.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeFalse)
+
+	// The elected instance is re-elected.
+	elected, err = is1.Elect(ctx, 50*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeTrue)
+
+	// Another instance is elected when the election expires.
+	time.Sleep(100 * time.Millisecond)
+	elected, err = is2.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeTrue)
+	elected, err = is1.Elect(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(elected, should.BeFalse)
+}
+
+func TestAlerts(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	s := &Store{Redis: cl, ID: "is1"}
+
+	alerts, err := s.LoadAlerts(ctx)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.BeEmpty)
+
+	alert := &alerting.Alert{
+		RuleID:     "offline",
+		Condition:  "gateway_offline",
+		EntityType: "gateway",
+		EntityID:   "test-gtw",
+		State:      alerting.StateTriggered,
+		Time:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Message:    "gateway is offline",
+	}
+	a.So(s.SetAlert(ctx, "gateway:test-gtw:offline", alert), should.BeNil)
+	alerts, err = s.LoadAlerts(ctx)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.Resemble, map[string]*alerting.Alert{
+		"gateway:test-gtw:offline": alert,
+	})
+
+	a.So(s.DeleteAlert(ctx, "gateway:test-gtw:offline"), should.BeNil)
+	alerts, err = s.LoadAlerts(ctx)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.BeEmpty)
+}
@@ -0,0 +1,161 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errWebhookStatus      = errors.DefineUnavailable("webhook_status", "webhook `{url}` responded with status `{code}`")
+	errWebhookDestination = errors.DefinePermissionDenied("webhook_destination", "webhook destination `{address}` is not allowed")
+)
+
+// restrictedNetworks are the networks that are not public: unspecified,
+// loopback, private, shared, link-local, benchmarking, multicast and reserved
+// addresses.
+var restrictedNetworks = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.0.0.0/24",
+		"192.168.0.0/16",
+		"198.18.0.0/15",
+		"224.0.0.0/4",
+		"240.0.0.0/4",
+		"::/128",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+		"ff00::/8",
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}()
+
+func isRestrictedIP(ip net.IP) bool {
+	for _, n := range restrictedNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// restrictDestination refuses connections to addresses in restrictedNetworks.
+// The address is checked after name resolution, so that host names that
+// resolve to restricted addresses are refused as well.
+func restrictDestination(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || isRestrictedIP(ip) {
+		return errWebhookDestination.WithAttributes("address", host)
+	}
+	return nil
+}
+
+// NewWebhookClient returns an HTTP client for alert notification webhooks with
+// the given timeout. Unless allowPrivate is set, the client refuses to connect
+// to loopback, private, link-local and other non-public addresses, so that the
+// webhook URLs of alert rules cannot be used to reach internal services.
+// Proxies are not used if private addresses are not allowed.
+func NewWebhookClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   restrictDestination,
+		}
+		transport.Proxy = nil
+		transport.DialContext = dialer.DialContext
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+// WebhookNotifier is a Notifier that posts alerts as JSON to the webhook URLs
+// of the alert rule.
+type WebhookNotifier struct {
+	Client *http.Client
+}
+
+// Notify implements Notifier.
+func (n *WebhookNotifier) Notify(ctx context.Context, alert *Alert) error {
+	urls := alert.Rule.GetWebhookURLs()
+	if len(urls) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	client := n.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	// Post to all webhooks, even if one of them fails, and return the first error.
+	var firstErr error
+	for _, url := range urls {
+		if err := n.post(ctx, client, url, body); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (n *WebhookNotifier) post(ctx context.Context, client *http.Client, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}()
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+	return errWebhookStatus.WithAttributes("url", url, "code", res.StatusCode)
+}
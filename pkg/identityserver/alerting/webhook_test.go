@@ -0,0 +1,111 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/identityserver/alerting"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	received := make(chan map[string]interface{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode body: %v", err)
+		}
+		received <- body
+	}))
+	defer srv.Close()
+
+	n := &WebhookNotifier{Client: srv.Client()}
+	alert := &Alert{
+		Rule: &ttnpb.AlertRule{
+			WebhookURLs: []string{srv.URL + "/alert"},
+		},
+		RuleID:     "test-rule",
+		Condition:  "gateway_offline",
+		EntityType: "gateway",
+		EntityID:   "test-gtw",
+		State:      StateTriggered,
+		Time:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Message:    "gateway is offline",
+	}
+	a.So(n.Notify(ctx, alert), should.BeNil)
+	select {
+	case body := <-received:
+		a.So(body["rule_id"], should.Equal, "test-rule")
+		a.So(body["condition"], should.Equal, "gateway_offline")
+		a.So(body["entity_id"], should.Equal, "test-gtw")
+		a.So(body["state"], should.Equal, "triggered")
+	default:
+		t.Fatal("Webhook not called")
+	}
+
+	alert.Rule.WebhookURLs = []string{srv.URL + "/fail"}
+	a.So(n.Notify(ctx, alert), should.NotBeNil)
+}
+
+func TestWebhookClient(t *testing.T) {
+	a, ctx := assertions.New(t), test.Context()
+
+	received := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+	}))
+	defer srv.Close()
+
+	alert := &Alert{
+		Rule: &ttnpb.AlertRule{
+			WebhookURLs: []string{srv.URL + "/alert"},
+		},
+		RuleID: "test-rule",
+		State:  StateTriggered,
+	}
+
+	// Webhooks to loopback addresses are refused by default.
+	n := &WebhookNotifier{Client: NewWebhookClient(time.Second, false)}
+	err := n.Notify(ctx, alert)
+	a.So(err, should.NotBeNil)
+	a.So(err.Error(), should.ContainSubstring, "webhook_destination")
+	select {
+	case <-received:
+		t.Fatal("Webhook called")
+	default:
+	}
+
+	n = &WebhookNotifier{Client: NewWebhookClient(time.Second, true)}
+	a.So(n.Notify(ctx, alert), should.BeNil)
+	select {
+	case <-received:
+	default:
+		t.Fatal("Webhook not called")
+	}
+}
@@ -287,6 +287,11 @@ func (is *IdentityServer) purgeApplication(ctx context.Context, ids *ttnpb.Appli
 		if err != nil {
 			return err
 		}
+		// delete related alert rules before purging the application
+		err = store.GetAlertRuleStore(db).DeleteEntityAlertRules(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return store.GetApplicationStore(db).PurgeApplication(ctx, ids)
 	})
 	if err != nil {
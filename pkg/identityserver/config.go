@@ -86,6 +86,13 @@ type Config struct {
 	Gateways struct {
 		EncryptionKeyID string `name:"encryption-key-id" description:"ID of the key used to encrypt gateway secrets at rest"`
 	} `name:"gateways"`
+	Alerting struct {
+		Enable              bool          `name:"enable" description:"Enable evaluation of gateway and application alert rules"`
+		EvaluationInterval  time.Duration `name:"evaluation-interval" description:"Interval at which alert rules are evaluated"`
+		ReconcileInterval   time.Duration `name:"reconcile-interval" description:"Interval at which the connection state of gateways with offline alert rules is reconciled with the Gateway Server (0 is disabled)"`
+		WebhookTimeout      time.Duration `name:"webhook-timeout" description:"Timeout of alert notification webhook requests"`
+		WebhookAllowPrivate bool          `name:"webhook-allow-private" description:"Allow alert notification webhooks to loopback, private and link-local addresses"`
+	} `name:"alerting"`
 }
 
 type emailTemplatesConfig struct {
@@ -0,0 +1,56 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+// AlertNotification is the email that is sent to the contacts of a gateway or
+// application when an alert of one of its alert rules is triggered or resolved.
+type AlertNotification struct {
+	Data
+	RuleID    string
+	RuleName  string
+	Condition string
+	DeviceID  string
+	State     string
+	Message   string
+}
+
+// RuleTitle returns the name of the alert rule, or its ID if it has no name.
+func (a AlertNotification) RuleTitle() string {
+	if a.RuleName != "" {
+		return a.RuleName
+	}
+	return a.RuleID
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (AlertNotification) TemplateName() string { return "alert_notification" }
+
+const alertNotificationSubject = `Alert {{ .RuleTitle }} {{ .State }} on {{ .Entity.Type }} {{ .Entity.ID }}`
+
+const alertNotificationText = `Dear {{ .User.Name }},
+
+The alert rule "{{ .RuleTitle }}" of {{ .Entity.Type }} "{{ .Entity.ID }}" on {{ .Network.Name }} has been {{ .State }}{{ if .DeviceID }} for end device "{{ .DeviceID }}"{{ end }}:
+
+{{ .Message }}
+
+You can view and edit the alert rules of this {{ .Entity.Type }} with the command-line interface:
+
+ttn-lw-cli {{ .Entity.Type }}s alert-rules list --{{ .Entity.Type }}-id {{ .Entity.ID }}
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (AlertNotification) DefaultTemplates() (subject, html, text string) {
+	return alertNotificationSubject, "", alertNotificationText
+}
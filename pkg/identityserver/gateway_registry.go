@@ -565,6 +565,11 @@ func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayId
 		if err != nil {
 			return err
 		}
+		// delete related alert rules before purging the gateway
+		err = store.GetAlertRuleStore(db).DeleteEntityAlertRules(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return store.GetGatewayStore(db).PurgeGateway(ctx, ids)
 	})
 	if err != nil {
@@ -0,0 +1,91 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// AlertRule model.
+type AlertRule struct {
+	Model
+
+	EntityID   string `gorm:"type:UUID;unique_index:alert_rule_id_index;index:alert_rule_entity_index;not null"`
+	EntityType string `gorm:"type:VARCHAR(32);unique_index:alert_rule_id_index;index:alert_rule_entity_index;not null"`
+	RuleID     string `gorm:"type:VARCHAR(36);unique_index:alert_rule_id_index;not null"`
+
+	Name     string `gorm:"type:VARCHAR"`
+	Disabled bool   `gorm:"not null"`
+
+	// Condition is the AlertRule with only the condition set, in protobuf encoding.
+	Condition []byte `gorm:"type:BYTEA"`
+
+	NotifyContacts bool           `gorm:"not null"`
+	WebhookURLs    pq.StringArray `gorm:"type:VARCHAR ARRAY;column:webhook_urls"`
+}
+
+func init() {
+	registerModel(&AlertRule{})
+}
+
+// toPB converts the model to protobuf. The entity identifiers are not set.
+func (r AlertRule) toPB() *ttnpb.AlertRule {
+	pb := &ttnpb.AlertRule{
+		AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{
+			RuleID: r.RuleID,
+		},
+		CreatedAt:      cleanTime(r.CreatedAt),
+		UpdatedAt:      cleanTime(r.UpdatedAt),
+		Name:           r.Name,
+		Disabled:       r.Disabled,
+		NotifyContacts: r.NotifyContacts,
+		WebhookURLs:    r.WebhookURLs,
+	}
+	if len(r.Condition) > 0 {
+		var condition ttnpb.AlertRule
+		if err := condition.Unmarshal(r.Condition); err == nil {
+			pb.Condition = condition.Condition
+		}
+	}
+	return pb
+}
+
+// fromPB sets the fields in the field mask from the protobuf and returns the
+// names of the columns that were set.
+func (r *AlertRule) fromPB(pb *ttnpb.AlertRule, fieldMask *types.FieldMask) (columns []string) {
+	for _, path := range ttnpb.TopLevelFields(fieldMask.GetPaths()) {
+		switch path {
+		case nameField:
+			r.Name = pb.Name
+		case disabledField:
+			r.Disabled = pb.Disabled
+		case conditionField:
+			r.Condition = nil
+			if pb.Condition != nil {
+				r.Condition, _ = (&ttnpb.AlertRule{Condition: pb.Condition}).Marshal()
+			}
+		case notifyContactsField:
+			r.NotifyContacts = pb.NotifyContacts
+		case webhookURLsField:
+			r.WebhookURLs = pq.StringArray(pb.WebhookURLs)
+		default:
+			continue
+		}
+		columns = append(columns, path)
+	}
+	return columns
+}
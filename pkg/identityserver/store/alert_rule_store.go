@@ -0,0 +1,214 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// GetAlertRuleStore returns an AlertRuleStore on the given db (or transaction).
+func GetAlertRuleStore(db *gorm.DB) AlertRuleStore {
+	return &alertRuleStore{store: newStore(db)}
+}
+
+type alertRuleStore struct {
+	*store
+}
+
+var errAlertRuleNotFound = errors.DefineNotFound("alert_rule_not_found", "alert rule `{rule_id}` not found")
+
+func (s *alertRuleStore) CreateAlertRule(ctx context.Context, pb *ttnpb.AlertRule) (*ttnpb.AlertRule, error) {
+	defer trace.StartRegion(ctx, "create alert rule").End()
+	entityID := pb.GetEntityIdentifiers()
+	entity, err := s.findEntity(ctx, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	model := AlertRule{
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+		RuleID:     pb.RuleID,
+	}
+	model.fromPB(pb, &types.FieldMask{Paths: ttnpb.AlertRuleFieldPathsTopLevel})
+	if err = s.createEntity(ctx, &model); err != nil {
+		return nil, convertError(err)
+	}
+	res := model.toPB()
+	res.AlertRuleIdentifiers = pb.AlertRuleIdentifiers
+	return res, nil
+}
+
+func (s *alertRuleStore) findAlertRule(ctx context.Context, ids *ttnpb.AlertRuleIdentifiers) (*AlertRule, error) {
+	entityID := ids.GetEntityIdentifiers()
+	entity, err := s.findEntity(ctx, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var model AlertRule
+	err = s.query(ctx, AlertRule{}).Where(AlertRule{
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+		RuleID:     ids.RuleID,
+	}).First(&model).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errAlertRuleNotFound.WithAttributes("rule_id", ids.RuleID)
+		}
+		return nil, err
+	}
+	return &model, nil
+}
+
+func (s *alertRuleStore) ListAlertRules(ctx context.Context, entityID ttnpb.Identifiers) ([]*ttnpb.AlertRule, error) {
+	defer trace.StartRegion(ctx, "list alert rules").End()
+	entity, err := s.findEntity(ctx, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	query := s.query(ctx, AlertRule{}).Where(AlertRule{
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+	})
+	query = query.Order(orderFromContext(ctx, "alert_rules", "rule_id", "ASC"))
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query.Model(&AlertRule{}))
+		query = query.Limit(limit).Offset(offset)
+	}
+	var models []AlertRule
+	if err = query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+	ids := ttnpb.AlertRuleIdentifiers{}
+	switch entityID := entityID.Identifiers().(type) {
+	case *ttnpb.GatewayIdentifiers:
+		ids.GatewayIDs = entityID
+	case *ttnpb.ApplicationIdentifiers:
+		ids.ApplicationIDs = entityID
+	}
+	pbs := make([]*ttnpb.AlertRule, len(models))
+	for i, model := range models {
+		pbs[i] = model.toPB()
+		pbs[i].GatewayIDs, pbs[i].ApplicationIDs = ids.GatewayIDs, ids.ApplicationIDs
+	}
+	return pbs, nil
+}
+
+func (s *alertRuleStore) ListEnabledAlertRules(ctx context.Context) ([]*ttnpb.AlertRule, error) {
+	defer trace.StartRegion(ctx, "list enabled alert rules").End()
+	var models []AlertRule
+	if err := s.query(ctx, AlertRule{}).Where("disabled = ?", false).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	var gatewayUUIDs, applicationUUIDs []string
+	for _, model := range models {
+		switch model.EntityType {
+		case "gateway":
+			gatewayUUIDs = append(gatewayUUIDs, model.EntityID)
+		case "application":
+			applicationUUIDs = append(applicationUUIDs, model.EntityID)
+		}
+	}
+	gatewayIDs := make(map[string]*ttnpb.GatewayIdentifiers, len(gatewayUUIDs))
+	if len(gatewayUUIDs) > 0 {
+		var gateways []Gateway
+		if err := s.query(ctx, Gateway{}).Select("id, gateway_id, gateway_eui").Where("id in (?)", gatewayUUIDs).Find(&gateways).Error; err != nil {
+			return nil, err
+		}
+		for _, gtw := range gateways {
+			gatewayIDs[gtw.ID] = &ttnpb.GatewayIdentifiers{
+				GatewayID: gtw.GatewayID,
+				EUI:       gtw.GatewayEUI.toPB(),
+			}
+		}
+	}
+	applicationIDs := make(map[string]*ttnpb.ApplicationIdentifiers, len(applicationUUIDs))
+	if len(applicationUUIDs) > 0 {
+		var applications []Application
+		if err := s.query(ctx, Application{}).Select("id, application_id").Where("id in (?)", applicationUUIDs).Find(&applications).Error; err != nil {
+			return nil, err
+		}
+		for _, app := range applications {
+			applicationIDs[app.ID] = &ttnpb.ApplicationIdentifiers{ApplicationID: app.ApplicationID}
+		}
+	}
+	pbs := make([]*ttnpb.AlertRule, 0, len(models))
+	for _, model := range models {
+		pb := model.toPB()
+		switch model.EntityType {
+		case "gateway":
+			pb.GatewayIDs = gatewayIDs[model.EntityID]
+		case "application":
+			pb.ApplicationIDs = applicationIDs[model.EntityID]
+		}
+		if pb.GatewayIDs == nil && pb.ApplicationIDs == nil {
+			continue // The entity is deleted.
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+func (s *alertRuleStore) GetAlertRule(ctx context.Context, ids *ttnpb.AlertRuleIdentifiers) (*ttnpb.AlertRule, error) {
+	defer trace.StartRegion(ctx, "get alert rule").End()
+	model, err := s.findAlertRule(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	pb := model.toPB()
+	pb.AlertRuleIdentifiers = *ids
+	return pb, nil
+}
+
+func (s *alertRuleStore) UpdateAlertRule(ctx context.Context, pb *ttnpb.AlertRule, fieldMask *types.FieldMask) (*ttnpb.AlertRule, error) {
+	defer trace.StartRegion(ctx, "update alert rule").End()
+	model, err := s.findAlertRule(ctx, &pb.AlertRuleIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	columns := model.fromPB(pb, fieldMask)
+	if err = s.updateEntity(ctx, model, columns...); err != nil {
+		return nil, convertError(err)
+	}
+	res := model.toPB()
+	res.AlertRuleIdentifiers = pb.AlertRuleIdentifiers
+	return res, nil
+}
+
+func (s *alertRuleStore) DeleteAlertRule(ctx context.Context, ids *ttnpb.AlertRuleIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete alert rule").End()
+	model, err := s.findAlertRule(ctx, ids)
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, AlertRule{}).Delete(model).Error
+}
+
+func (s *alertRuleStore) DeleteEntityAlertRules(ctx context.Context, entityID ttnpb.Identifiers) error {
+	defer trace.StartRegion(ctx, "delete entity alert rules").End()
+	entity, err := s.findDeletedEntity(ctx, entityID, "id")
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, AlertRule{}).Where(AlertRule{
+		EntityID:   entity.PrimaryKey(),
+		EntityType: entityTypeForID(entityID),
+	}).Delete(&AlertRule{}).Error
+}
@@ -0,0 +1,129 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestAlertRuleStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &AlertRule{}, &Application{}, &Gateway{}, &GatewayAntenna{}, &Attribute{})
+
+		app, err := GetApplicationStore(db).CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+		})
+		a.So(err, should.BeNil)
+
+		gtw, err := GetGatewayStore(db).CreateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo"},
+		})
+		a.So(err, should.BeNil)
+
+		s := GetAlertRuleStore(db)
+
+		created, err := s.CreateAlertRule(ctx, &ttnpb.AlertRule{
+			AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtw.GatewayIdentifiers, RuleID: "offline"},
+			Name:                 "Offline",
+			Condition: &ttnpb.AlertRule_GatewayOffline{
+				GatewayOffline: &ttnpb.AlertRule_GatewayOfflineCondition{Timeout: 10 * time.Minute},
+			},
+			NotifyContacts: true,
+			WebhookURLs:    []string{"https://example.com/alerts"},
+		})
+		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+			a.So(created.Name, should.Equal, "Offline")
+			a.So(created.GetGatewayOffline().GetTimeout(), should.Equal, 10*time.Minute)
+			a.So(created.CreatedAt, should.HappenAfter, time.Now().Add(-1*time.Hour))
+		}
+
+		_, err = s.CreateAlertRule(ctx, &ttnpb.AlertRule{
+			AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtw.GatewayIdentifiers, RuleID: "offline"},
+			Condition: &ttnpb.AlertRule_GatewayOffline{
+				GatewayOffline: &ttnpb.AlertRule_GatewayOfflineCondition{Timeout: time.Minute},
+			},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		_, err = s.CreateAlertRule(ctx, &ttnpb.AlertRule{
+			AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{ApplicationIDs: &app.ApplicationIdentifiers, RuleID: "offline"},
+			Disabled:             true,
+			Condition: &ttnpb.AlertRule_DeviceNotSeen{
+				DeviceNotSeen: &ttnpb.AlertRule_DeviceNotSeenCondition{Period: time.Hour, Periods: 3},
+			},
+		})
+		a.So(err, should.BeNil)
+
+		got, err := s.GetAlertRule(ctx, &ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtw.GatewayIdentifiers, RuleID: "offline"})
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.WebhookURLs, should.Resemble, []string{"https://example.com/alerts"})
+			a.So(got.NotifyContacts, should.BeTrue)
+		}
+
+		_, err = s.GetAlertRule(ctx, &ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtw.GatewayIdentifiers, RuleID: "other"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		list, err := s.ListAlertRules(ctx, gtw.GatewayIdentifiers)
+		a.So(err, should.BeNil)
+		a.So(list, should.HaveLength, 1)
+
+		updated, err := s.UpdateAlertRule(ctx, &ttnpb.AlertRule{
+			AlertRuleIdentifiers: ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtw.GatewayIdentifiers, RuleID: "offline"},
+			Condition: &ttnpb.AlertRule_TxAckFailure{
+				TxAckFailure: &ttnpb.AlertRule_TxAckFailureCondition{FailureRatio: 0.5, Window: time.Hour},
+			},
+		}, &pbtypes.FieldMask{Paths: []string{"condition"}})
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.GetTxAckFailure().GetFailureRatio(), should.Equal, 0.5)
+			a.So(updated.Name, should.Equal, "Offline")
+		}
+
+		enabled, err := s.ListEnabledAlertRules(ctx)
+		if a.So(err, should.BeNil) && a.So(enabled, should.HaveLength, 1) {
+			a.So(enabled[0].GatewayIDs.GatewayID, should.Equal, "foo")
+			a.So(enabled[0].RuleID, should.Equal, "offline")
+		}
+
+		err = s.DeleteAlertRule(ctx, &ttnpb.AlertRuleIdentifiers{GatewayIDs: &gtw.GatewayIdentifiers, RuleID: "offline"})
+		a.So(err, should.BeNil)
+
+		list, err = s.ListAlertRules(ctx, gtw.GatewayIdentifiers)
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		err = s.DeleteEntityAlertRules(ctx, app.ApplicationIdentifiers)
+		a.So(err, should.BeNil)
+
+		list, err = s.ListAlertRules(ctx, app.ApplicationIdentifiers)
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+	})
+}
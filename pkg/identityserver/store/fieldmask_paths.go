@@ -22,10 +22,13 @@ const (
 	attributesField                     = "attributes"
 	autoUpdateField                     = "auto_update"
 	bandIDField                         = "version_ids.band_id"
+	beaconingField                      = "beaconing"
 	brandIDField                        = "version_ids.brand_id"
 	claimAuthenticationCodeField        = "claim_authentication_code"
+	conditionField                      = "condition"
 	contactInfoField                    = "contact_info"
 	descriptionField                    = "description"
+	disabledField                       = "disabled"
 	downlinkPathConstraintField         = "downlink_path_constraint"
 	endorsedField                       = "endorsed"
 	enforceDutyCycleField               = "enforce_duty_cycle"
@@ -41,6 +44,7 @@ const (
 	modelIDField                        = "version_ids.model_id"
 	nameField                           = "name"
 	networkServerAddressField           = "network_server_address"
+	notifyContactsField                 = "notify_contacts"
 	passwordField                       = "password"
 	passwordUpdatedAtField              = "password_updated_at"
 	pictureField                        = "picture"
@@ -65,5 +69,7 @@ const (
 	temporaryPasswordField              = "temporary_password"
 	updateChannelField                  = "update_channel"
 	updateLocationFromStatusField       = "update_location_from_status"
+	uplinkFiltersField                  = "uplink_filters"
 	versionIDsField                     = "version_ids"
+	webhookURLsField                    = "webhook_urls"
 )
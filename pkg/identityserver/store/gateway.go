@@ -73,6 +73,11 @@ type Gateway struct {
 
 	TargetCUPSURI string `gorm:"type:VARCHAR"`
 	TargetCUPSKey []byte `gorm:"type:BYTEA"`
+
+	BeaconingEnable       bool `gorm:"default:false not null"`
+	BeaconingAntennaIndex int  `gorm:"default:0 not null"`
+
+	UplinkFilters *GatewayUplinkFilters `gorm:"type:BYTEA"`
 }
 
 func init() {
@@ -166,6 +171,15 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 			pb.TargetCUPSKey = nil
 		}
 	},
+	beaconingField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		pb.Beaconing = &ttnpb.GatewayBeaconing{
+			Enable:       gtw.BeaconingEnable,
+			AntennaIndex: uint32(gtw.BeaconingAntennaIndex),
+		}
+	},
+	uplinkFiltersField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		pb.UplinkFilters = (*ttnpb.GatewayUplinkFilters)(gtw.UplinkFilters)
+	},
 }
 
 // functions to set fields from the gateway proto into the gateway model.
@@ -256,6 +270,13 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 			gtw.TargetCUPSKey = nil
 		}
 	},
+	beaconingField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.BeaconingEnable = pb.Beaconing.GetEnable()
+		gtw.BeaconingAntennaIndex = int(pb.Beaconing.GetAntennaIndex())
+	},
+	uplinkFiltersField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.UplinkFilters = (*GatewayUplinkFilters)(pb.UplinkFilters)
+	},
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -277,6 +298,7 @@ var gatewayColumnNames = map[string][]string{
 	antennasField:                 {},
 	attributesField:               {},
 	autoUpdateField:               {autoUpdateField},
+	beaconingField:                {"beaconing_enable", "beaconing_antenna_index"},
 	brandIDField:                  {"brand_id"},
 	claimAuthenticationCodeField:  {"claim_authentication_code_secret", "claim_authentication_code_valid_from", "claim_authentication_code_valid_to"},
 	contactInfoField:              {},
@@ -298,6 +320,7 @@ var gatewayColumnNames = map[string][]string{
 	targetCUPSKeyField:            {"target_cups_key"},
 	updateChannelField:            {updateChannelField},
 	updateLocationFromStatusField: {updateLocationFromStatusField},
+	uplinkFiltersField:            {uplinkFiltersField},
 	versionIDsField:               {"brand_id", "model_id", "hardware_version", "firmware_version"},
 }
 
@@ -90,6 +90,16 @@ func TestGatewayStore(t *testing.T) {
 			ClaimAuthenticationCode:  &gtwClaimAuthCode,
 			TargetCUPSURI:            targetCUPSURI,
 			TargetCUPSKey:            secret,
+			Beaconing: &ttnpb.GatewayBeaconing{
+				Enable:       true,
+				AntennaIndex: 1,
+			},
+			UplinkFilters: &ttnpb.GatewayUplinkFilters{
+				Deny: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+				MinSNR: &pbtypes.FloatValue{Value: -10},
+			},
 		})
 
 		a.So(err, should.BeNil)
@@ -105,6 +115,13 @@ func TestGatewayStore(t *testing.T) {
 			a.So(created.UpdatedAt, should.HappenAfter, time.Now().Add(-1*time.Hour))
 			a.So(*created.ScheduleAnytimeDelay, should.Equal, time.Second)
 			a.So(created.UpdateLocationFromStatus, should.BeTrue)
+			a.So(created.Beaconing, should.Resemble, &ttnpb.GatewayBeaconing{Enable: true, AntennaIndex: 1})
+			a.So(created.UplinkFilters, should.Resemble, &ttnpb.GatewayUplinkFilters{
+				Deny: &ttnpb.GatewayUplinkFilters_Rule{
+					NetIDs: []types.NetID{{0x00, 0x00, 0x13}},
+				},
+				MinSNR: &pbtypes.FloatValue{Value: -10},
+			})
 			a.So(created.LBSLNSSecret, should.NotBeNil)
 			a.So(created.LBSLNSSecret, should.Resemble, secret)
 			a.So(created.ClaimAuthenticationCode, should.NotBeNil)
@@ -167,7 +184,9 @@ func TestGatewayStore(t *testing.T) {
 			ClaimAuthenticationCode:  &otherGtwClaimAuthCode,
 			TargetCUPSURI:            otherTargetCUPSURI,
 			TargetCUPSKey:            otherSecret,
-		}, &pbtypes.FieldMask{Paths: []string{"description", "attributes", "antennas", "schedule_anytime_delay", "update_location_from_status", "lbs_lns_secret", "claim_authentication_code", "target_cups_uri", "target_cups_key"}})
+			Beaconing:                nil,
+			UplinkFilters:            nil,
+		}, &pbtypes.FieldMask{Paths: []string{"description", "attributes", "antennas", "schedule_anytime_delay", "update_location_from_status", "lbs_lns_secret", "claim_authentication_code", "target_cups_uri", "target_cups_key", "beaconing", "uplink_filters"}})
 
 		a.So(err, should.BeNil)
 		if a.So(updated, should.NotBeNil) {
@@ -183,6 +202,8 @@ func TestGatewayStore(t *testing.T) {
 			a.So(updated.UpdatedAt, should.HappenAfter, created.CreatedAt)
 			a.So(*updated.ScheduleAnytimeDelay, should.Equal, time.Duration(0))
 			a.So(updated.UpdateLocationFromStatus, should.BeFalse)
+			a.So(updated.Beaconing, should.Resemble, &ttnpb.GatewayBeaconing{})
+			a.So(updated.UplinkFilters, should.BeNil)
 			a.So(updated.LBSLNSSecret, should.Resemble, otherSecret)
 			a.So(updated.ClaimAuthenticationCode.Secret, should.Resemble, otherGtwClaimAuthCode.Secret)
 			a.So(updated.TargetCUPSKey, should.Resemble, otherSecret)
@@ -34,7 +34,7 @@ var (
 // Check that the database contains all tables.
 func Check(db *gorm.DB) error {
 	db = db.Unscoped()
-	db.SetLogger(logger{log.Noop})
+	SetLogger(db, log.Noop)
 
 	dbKind, ok := db.Get("db:kind")
 	if !ok || (dbKind != "CockroachDB" && dbKind != "PostgreSQL") {
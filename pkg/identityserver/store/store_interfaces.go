@@ -197,6 +197,19 @@ type ContactInfoStore interface {
 	DeleteEntityContactInfo(ctx context.Context, entityID ttnpb.Identifiers) error
 }
 
+// AlertRuleStore interface for storing alert rules of gateways and applications.
+type AlertRuleStore interface {
+	CreateAlertRule(ctx context.Context, rule *ttnpb.AlertRule) (*ttnpb.AlertRule, error)
+	ListAlertRules(ctx context.Context, entityID ttnpb.Identifiers) ([]*ttnpb.AlertRule, error)
+	// List the alert rules that are not disabled, of all gateways and applications.
+	ListEnabledAlertRules(ctx context.Context) ([]*ttnpb.AlertRule, error)
+	GetAlertRule(ctx context.Context, ids *ttnpb.AlertRuleIdentifiers) (*ttnpb.AlertRule, error)
+	UpdateAlertRule(ctx context.Context, rule *ttnpb.AlertRule, fieldMask *types.FieldMask) (*ttnpb.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, ids *ttnpb.AlertRuleIdentifiers) error
+	// Delete all alert rules of the entity. Used for purging entities.
+	DeleteEntityAlertRules(ctx context.Context, entityID ttnpb.Identifiers) error
+}
+
 // MigrationStore interface for migration history.
 type MigrationStore interface {
 	CreateMigration(ctx context.Context, migration *Migration) error
@@ -121,6 +121,39 @@ func (r *Rights) Scan(src interface{}) error {
 	return nil
 }
 
+// GatewayUplinkFilters adds methods on a ttnpb.GatewayUplinkFilters so that it can be stored in an SQL database.
+type GatewayUplinkFilters ttnpb.GatewayUplinkFilters
+
+// Value returns the value to store in the database.
+func (f GatewayUplinkFilters) Value() (driver.Value, error) {
+	pb := ttnpb.GatewayUplinkFilters(f)
+	b, err := pb.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// Scan reads the value from the database into the GatewayUplinkFilters.
+func (f *GatewayUplinkFilters) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		var pb ttnpb.GatewayUplinkFilters
+		if err := pb.Unmarshal(src); err != nil {
+			return err
+		}
+		*f = GatewayUplinkFilters(pb)
+	case nil:
+		*f = GatewayUplinkFilters{}
+	default:
+		return fmt.Errorf("cannot convert %T to GatewayUplinkFilters", src)
+	}
+	return nil
+}
+
 // Location can be embedded in other models.
 type Location struct {
 	Latitude  float64
@@ -106,6 +106,25 @@ func (p jsRPCPaths) rejoin() string  { return p.Rejoin }
 func (p jsRPCPaths) appSKey() string { return p.AppSKey }
 func (p jsRPCPaths) homeNS() string  { return p.HomeNS }
 
+type nsRPCPaths struct {
+	SNS string `yaml:"sns"`
+	FNS string `yaml:"fns"`
+}
+
+func (p nsRPCPaths) sns() string {
+	if p.SNS == "" {
+		return "sns"
+	}
+	return p.SNS
+}
+
+func (p nsRPCPaths) fns() string {
+	if p.FNS == "" {
+		return "fns"
+	}
+	return p.FNS
+}
+
 func serverURL(scheme, fqdn, path string, port uint32) string {
 	if scheme == "" {
 		scheme = "https"
@@ -243,11 +262,31 @@ var (
 	generatedSessionKeyIDPrefix = []byte("ttn-lw-interop-generated:")
 )
 
+// joinRequestEUIs returns the JoinEUI and DevEUI of the join-request or rejoin-request.
+// Rejoin-requests of type 0 and 2 do not contain the JoinEUI, so the JoinEUI of the request is used.
+func joinRequestEUIs(req *ttnpb.JoinRequest) (joinEUI, devEUI types.EUI64, err error) {
+	switch pld := req.Payload.GetPayload().(type) {
+	case *ttnpb.Message_JoinRequestPayload:
+		return pld.JoinRequestPayload.JoinEUI, pld.JoinRequestPayload.DevEUI, nil
+	case *ttnpb.Message_RejoinRequestPayload:
+		if pld.RejoinRequestPayload.RejoinType == ttnpb.RejoinType_SESSION {
+			return pld.RejoinRequestPayload.JoinEUI, pld.RejoinRequestPayload.DevEUI, nil
+		}
+		if req.JoinEUI == nil {
+			return types.EUI64{}, types.EUI64{}, ErrMalformedMessage.New()
+		}
+		return *req.JoinEUI, pld.RejoinRequestPayload.DevEUI, nil
+	default:
+		return types.EUI64{}, types.EUI64{}, ErrMalformedMessage.New()
+	}
+}
+
 // HandleJoinRequest performs Join request according to LoRaWAN Backend Interfaces specification.
+// Rejoin-requests are sent as RejoinReq messages.
 func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, ErrMalformedMessage.New()
+	joinEUI, devEUI, err := joinRequestEUIs(req)
+	if err != nil {
+		return nil, err
 	}
 
 	dlSettings, err := lorawan.MarshalDLSettings(req.DownlinkSettings)
@@ -263,25 +302,31 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 		}
 	}
 
-	interopAns := &JoinAns{}
-	if err := cl.exchange(ctx, pld.JoinEUI, jsRPCPaths.join, &JoinReq{
+	interopReq := &JoinReq{
 		NsJsMessageHeader: NsJsMessageHeader{
 			MessageHeader: MessageHeader{
 				ProtocolVersion: cl.Protocol.BackendInterfacesVersion(),
 				MessageType:     MessageTypeJoinReq,
 			},
 			SenderID:   NetID(netID),
-			ReceiverID: EUI64(pld.JoinEUI),
+			ReceiverID: EUI64(joinEUI),
 			SenderNSID: NetID(netID),
 		},
 		MACVersion: MACVersion(req.SelectedMACVersion),
 		PHYPayload: Buffer(req.RawPayload),
-		DevEUI:     EUI64(pld.DevEUI),
+		DevEUI:     EUI64(devEUI),
 		DevAddr:    DevAddr(req.DevAddr),
 		DLSettings: Buffer(dlSettings),
 		RxDelay:    req.RxDelay,
 		CFList:     Buffer(cfList),
-	}, interopAns); err != nil {
+	}
+	interopAns := &JoinAns{}
+	if req.Payload.MType == ttnpb.MType_REJOIN_REQUEST {
+		interopReq.MessageType = MessageTypeRejoinReq
+		if err := cl.exchange(ctx, joinEUI, jsRPCPaths.rejoin, (*RejoinReq)(interopReq), (*RejoinAns)(interopAns)); err != nil {
+			return nil, err
+		}
+	} else if err := cl.exchange(ctx, joinEUI, jsRPCPaths.join, interopReq, interopAns); err != nil {
 		return nil, err
 	}
 	if err := parseResult(interopAns.Result); err != nil {
@@ -289,7 +334,7 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 	}
 
 	fNwkSIntKey := interopAns.FNwkSIntKey
-	if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) <= 0 {
+	if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
 		fNwkSIntKey = interopAns.NwkSKey
 	}
 
@@ -335,6 +380,56 @@ func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPat
 	}
 }
 
+type networkServerHTTPClient struct {
+	Client         http.Client
+	NewRequestFunc func(func(nsRPCPaths) string, interface{}) (*http.Request, error)
+	Protocol       JoinServerProtocol
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, pathFunc func(nsRPCPaths) string, req, res interface{}) error {
+	httpReq, err := cl.NewRequestFunc(pathFunc, req)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
+}
+
+func makeNetworkServerHTTPRequestFunc(scheme, fqdn string, port uint32, rpcPaths nsRPCPaths, headers map[string]string) func(func(nsRPCPaths) string, interface{}) (*http.Request, error) {
+	return func(pathFunc func(nsRPCPaths) string, pld interface{}) (*http.Request, error) {
+		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+	}
+}
+
+// PRStartRequest performs passive roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error) {
+	req.ProtocolVersion = cl.Protocol.BackendInterfacesVersion()
+	req.MessageType = MessageTypePRStartReq
+	req.ReceiverID = NetID(netID)
+	ans := &PRStartAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.sns, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// XmitDataRequest performs data transmission request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error) {
+	req.ProtocolVersion = cl.Protocol.BackendInterfacesVersion()
+	req.MessageType = MessageTypeXmitDataReq
+	req.ReceiverID = NetID(netID)
+	ans := &XmitDataAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.fns, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
 type joinServerClient interface {
 	HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
@@ -346,7 +441,8 @@ type prefixJoinServerClient struct {
 }
 
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]*networkServerHTTPClient
 }
 
 var errUnknownProtocol = errors.DefineInvalidArgument("unknown_protocol", "unknown protocol")
@@ -381,6 +477,10 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 			File     string              `yaml:"file"`
 			JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -416,24 +516,12 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		var js joinServerClient
 		switch yamlJSConf.Protocol {
 		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
-			tlsConf := fallbackTLS
-			if !yamlJSConf.TLS.IsZero() {
-				tlsConf, err = yamlJSConf.TLS.TLSConfig(fetcher)
-				if err != nil {
-					return nil, err
-				}
-			}
-
-			var tr *http.Transport
-			if tlsConf != nil {
-				tr = &http.Transport{
-					TLSClientConfig: tlsConf,
-				}
+			httpClient, err := newHTTPClient(yamlJSConf.TLS, fetcher, fallbackTLS)
+			if err != nil {
+				return nil, err
 			}
 			js = &joinServerHTTPClient{
-				Client: http.Client{
-					Transport: tr,
-				},
+				Client:         httpClient,
 				NewRequestFunc: makeJoinServerHTTPRequestFunc("https", yamlJSConf.DNS, yamlJSConf.FQDN, yamlJSConf.Port, yamlJSConf.Paths, yamlJSConf.Headers),
 				Protocol:       yamlJSConf.Protocol,
 			}
@@ -447,6 +535,44 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 			})
 		}
 	}
+
+	nss := make(map[types.NetID]*networkServerHTTPClient, len(yamlConf.NetworkServers))
+	for _, nsConf := range yamlConf.NetworkServers {
+		nsConfEls := strings.Split(filepath.ToSlash(nsConf.File), "/")
+
+		fetcher := fetch.WithBasePath(fetcher, nsConfEls[:len(nsConfEls)-1]...)
+		nsFileBytes, err := fetcher.File(nsConfEls[len(nsConfEls)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var yamlNSConf struct {
+			ComponentConfig `yaml:",inline"`
+			Paths           nsRPCPaths         `yaml:"paths"`
+			Protocol        JoinServerProtocol `yaml:"protocol"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &yamlNSConf); err != nil {
+			return nil, err
+		}
+		switch yamlNSConf.Protocol {
+		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
+		default:
+			return nil, errUnknownProtocol.New()
+		}
+		httpClient, err := newHTTPClient(yamlNSConf.TLS, fetcher, fallbackTLS)
+		if err != nil {
+			return nil, err
+		}
+		ns := &networkServerHTTPClient{
+			Client:         httpClient,
+			NewRequestFunc: makeNetworkServerHTTPRequestFunc("https", yamlNSConf.FQDN, yamlNSConf.Port, yamlNSConf.Paths, yamlNSConf.Headers),
+			Protocol:       yamlNSConf.Protocol,
+		}
+		for _, netID := range nsConf.NetIDs {
+			nss[netID] = ns
+		}
+	}
+
 	sort.Slice(jss, func(i, j int) bool {
 		pi, pj := jss[i].prefix, jss[j].prefix
 		if pi.Length != pj.Length {
@@ -455,7 +581,28 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
+	}, nil
+}
+
+func newHTTPClient(conf tlsConfig, fetcher fetch.Interface, fallbackTLS *tls.Config) (http.Client, error) {
+	tlsConf := fallbackTLS
+	if !conf.IsZero() {
+		var err error
+		tlsConf, err = conf.TLSConfig(fetcher)
+		if err != nil {
+			return http.Client{}, err
+		}
+	}
+	var tr *http.Transport
+	if tlsConf != nil {
+		tr = &http.Transport{
+			TLSClientConfig: tlsConf,
+		}
+	}
+	return http.Client{
+		Transport: tr,
 	}, nil
 }
 
@@ -480,13 +627,43 @@ func (cl Client) GetAppSKey(ctx context.Context, asID string, req *ttnpb.Session
 
 // HandleJoinRequest performs Join request to Join Server associated with req.JoinEUI.
 func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, ErrMalformedMessage.New()
+	joinEUI, _, err := joinRequestEUIs(req)
+	if err != nil {
+		return nil, err
 	}
-	js, ok := cl.joinServer(pld.JoinEUI)
+	js, ok := cl.joinServer(joinEUI)
 	if !ok {
 		return nil, errNotRegistered.New()
 	}
 	return js.HandleJoinRequest(ctx, netID, req)
 }
+
+// NetworkServerNetIDs returns the NetIDs of the Network Servers with which roaming is configured.
+func (cl Client) NetworkServerNetIDs() []types.NetID {
+	netIDs := make([]types.NetID, 0, len(cl.networkServers))
+	for netID := range cl.networkServers {
+		netIDs = append(netIDs, netID)
+	}
+	sort.Slice(netIDs, func(i, j int) bool {
+		return netIDs[i].MarshalNumber() < netIDs[j].MarshalNumber()
+	})
+	return netIDs
+}
+
+// PRStartRequest performs passive roaming start request to the Serving Network Server associated with netID.
+func (cl Client) PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return nil, ErrNoRoamingAgreement.New()
+	}
+	return ns.PRStartRequest(ctx, netID, req)
+}
+
+// XmitDataRequest performs data transmission request to the Forwarding Network Server associated with netID.
+func (cl Client) XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return nil, ErrNoRoamingAgreement.New()
+	}
+	return ns.XmitDataRequest(ctx, netID, req)
+}
@@ -17,6 +17,7 @@ package interop_test
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -1354,3 +1355,255 @@ paths:
 		})
 	}
 }
+
+func TestHandleRejoinRequest(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+
+	srv := newTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := assertions.New(t)
+		a.So(r.Method, should.Equal, http.MethodPost)
+		a.So(r.URL.Path, should.Equal, "/test-rejoin-path")
+
+		b, err := ioutil.ReadAll(r.Body)
+		a.So(err, should.BeNil)
+		a.So(string(b), should.Equal, `{"ProtocolVersion":"1.1","TransactionID":0,"MessageType":"RejoinReq","SenderID":"42FFFF","ReceiverID":"70B3D57ED0000000","SenderNSID":"42FFFF","MACVersion":"1.1","PHYPayload":"C000FFFF4208070605040302010100DEADBEEF","DevEUI":"0102030405060708","DevAddr":"01020304","DLSettings":"80","RxDelay":5,"CFList":""}
+`)
+		a.So(r.Body.Close(), should.BeNil)
+
+		_, err = w.Write([]byte(`{
+  "ProtocolVersion": "1.1",
+  "TransactionID": 0,
+  "MessageType": "RejoinAns",
+  "SenderID": "70B3D57ED0000000",
+  "ReceiverID": "42FFFF",
+  "PHYPayload": "204D675073BB4153B23653EFA82C1F3A49",
+  "Result": {
+    "ResultCode": "Success"
+  },
+  "Lifetime": 0,
+  "FNwkSIntKey": {
+    "KEKLabel": "ns:42ffff",
+    "AESKey": "EB56FE6681999F25D548CFEDD4A6528B331BB5ADE1CAF17F"
+  },
+  "SessionKeyID": "016BFA7BAD4756346A674981E75CDBDC"
+}`))
+		a.So(err, should.BeNil)
+	}))
+	defer srv.Close()
+
+	host := strings.Split(test.Must(url.Parse(srv.URL)).(*url.URL).Host, ":")
+	if len(host) != 2 {
+		t.Fatalf("Invalid server host: %s", host)
+	}
+
+	confDir := test.Must(ioutil.TempDir("", "lorawan-stack-js-interop-test")).(string)
+	defer os.RemoveAll(confDir)
+	test.MustMultiple(os.Mkdir(filepath.Join(confDir, "testdata"), 0755))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientCertPath), ClientCert, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientKeyPath), ClientKey, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, RootCAPath), RootCA, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, InteropClientConfigurationName), []byte(`join-servers:
+   - file: test-js.yml
+     join-euis:
+        - 70b3d57ed0000000/40`), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "test-js.yml"), []byte(fmt.Sprintf(`fqdn: %s
+port: %s
+protocol: BI1.1
+paths:
+   join: test-join-path
+   rejoin: test-rejoin-path
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		host[0],
+		host[1],
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	)), 0644))
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+
+	makeRejoinRequest := func() *ttnpb.JoinRequest {
+		return &ttnpb.JoinRequest{
+			SelectedMACVersion: ttnpb.MAC_V1_1,
+			DevAddr:            types.DevAddr{0x01, 0x02, 0x03, 0x04},
+			DownlinkSettings: ttnpb.DLSettings{
+				OptNeg: true,
+			},
+			RxDelay: ttnpb.RX_DELAY_5,
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_REJOIN_REQUEST,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_RejoinRequestPayload{
+					RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+						RejoinType: ttnpb.RejoinType_CONTEXT,
+						NetID:      types.NetID{0x42, 0xff, 0xff},
+						DevEUI:     types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+						RejoinCnt:  1,
+					},
+				},
+			},
+			RawPayload: []byte{0xc0, 0x00, 0xff, 0xff, 0x42, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x00, 0xde, 0xad, 0xbe, 0xef},
+		}
+	}
+
+	// Rejoin-requests of type 0 and 2 do not contain the JoinEUI.
+	_, err = cl.HandleJoinRequest(ctx, types.NetID{0x42, 0xff, 0xff}, makeRejoinRequest())
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrMalformedMessage)
+
+	req := makeRejoinRequest()
+	req.JoinEUI = &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}
+	res, err := cl.HandleJoinRequest(ctx, types.NetID{0x42, 0xff, 0xff}, req)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Received unexpected error: %v", errors.Stack(err))
+	}
+	a.So(res.RawPayload, should.Resemble, []byte{0x20, 0x4d, 0x67, 0x50, 0x73, 0xbb, 0x41, 0x53, 0xb2, 0x36, 0x53, 0xef, 0xa8, 0x2c, 0x1f, 0x3a, 0x49})
+	a.So(res.SessionKeys.SessionKeyID, should.Resemble, []byte{0x01, 0x6b, 0xfa, 0x7b, 0xad, 0x47, 0x56, 0x34, 0x6a, 0x67, 0x49, 0x81, 0xe7, 0x5c, 0xdb, 0xdc})
+	a.So(res.SessionKeys.FNwkSIntKey, should.NotBeNil)
+}
+
+func TestNetworkServerRoaming(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+
+	srv := newTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := assertions.New(t)
+		a.So(r.Method, should.Equal, http.MethodPost)
+
+		b, err := ioutil.ReadAll(r.Body)
+		a.So(err, should.BeNil)
+		a.So(r.Body.Close(), should.BeNil)
+
+		switch r.URL.Path {
+		case "/test-sns-path":
+			var req PRStartReq
+			a.So(json.Unmarshal(b, &req), should.BeNil)
+			a.So(req.ProtocolVersion, should.Equal, "1.1")
+			a.So(req.MessageType, should.Equal, MessageTypePRStartReq)
+			a.So(req.SenderID, should.Equal, NetID{0x00, 0x00, 0x13})
+			a.So(req.ReceiverID, should.Equal, NetID{0x42, 0xff, 0xff})
+			a.So(req.PHYPayload, should.Resemble, Buffer{0x40, 0x04, 0x03, 0x02, 0x01})
+			a.So(req.ULMetaData.RFRegion, should.Equal, "EU868")
+			_, err = w.Write([]byte(`{
+  "ProtocolVersion": "1.1",
+  "TransactionID": 0,
+  "MessageType": "PRStartAns",
+  "SenderID": "42FFFF",
+  "ReceiverID": "000013",
+  "Result": {
+    "ResultCode": "Success"
+  },
+  "Lifetime": 0
+}`))
+		case "/fns":
+			var req XmitDataReq
+			a.So(json.Unmarshal(b, &req), should.BeNil)
+			a.So(req.MessageType, should.Equal, MessageTypeXmitDataReq)
+			a.So(req.ReceiverID, should.Equal, NetID{0x42, 0xff, 0xff})
+			a.So(req.DLMetaData, should.NotBeNil)
+			_, err = w.Write([]byte(`{
+  "ProtocolVersion": "1.1",
+  "TransactionID": 0,
+  "MessageType": "XmitDataAns",
+  "SenderID": "42FFFF",
+  "ReceiverID": "000013",
+  "Result": {
+    "ResultCode": "XmitFailed"
+  }
+}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+		a.So(err, should.BeNil)
+	}))
+	defer srv.Close()
+
+	host := strings.Split(test.Must(url.Parse(srv.URL)).(*url.URL).Host, ":")
+	if len(host) != 2 {
+		t.Fatalf("Invalid server host: %s", host)
+	}
+
+	confDir := test.Must(ioutil.TempDir("", "lorawan-stack-ns-interop-test")).(string)
+	defer os.RemoveAll(confDir)
+	test.MustMultiple(os.Mkdir(filepath.Join(confDir, "testdata"), 0755))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientCertPath), ClientCert, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientKeyPath), ClientKey, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, RootCAPath), RootCA, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, InteropClientConfigurationName), []byte(`network-servers:
+   - file: test-ns.yml
+     net-ids:
+        - 42ffff
+        - 000042`), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "test-ns.yml"), []byte(fmt.Sprintf(`fqdn: %s
+port: %s
+protocol: BI1.1
+paths:
+   sns: test-sns-path
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		host[0],
+		host[1],
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	)), 0644))
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+	a.So(cl.NetworkServerNetIDs(), should.Resemble, []types.NetID{
+		{0x00, 0x00, 0x42},
+		{0x42, 0xff, 0xff},
+	})
+
+	header := NsMessageHeader{
+		SenderID: NetID{0x00, 0x00, 0x13},
+	}
+	_, err = cl.PRStartRequest(ctx, types.NetID{0x00, 0x00, 0x01}, &PRStartReq{
+		NsMessageHeader: header,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrNoRoamingAgreement)
+
+	ans, err := cl.PRStartRequest(ctx, types.NetID{0x42, 0xff, 0xff}, &PRStartReq{
+		NsMessageHeader: header,
+		PHYPayload:      Buffer{0x40, 0x04, 0x03, 0x02, 0x01},
+		ULMetaData: ULMetaData{
+			RFRegion: "EU868",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Received unexpected error: %v", errors.Stack(err))
+	}
+	a.So(ans.Result.ResultCode, should.Equal, ResultSuccess)
+
+	_, err = cl.XmitDataRequest(ctx, types.NetID{0x42, 0xff, 0xff}, &XmitDataReq{
+		NsMessageHeader: header,
+		PHYPayload:      Buffer{0x60, 0x04, 0x03, 0x02, 0x01},
+		DLMetaData: &DLMetaData{
+			ClassMode: "A",
+		},
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrTransmitFailed)
+}
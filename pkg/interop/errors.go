@@ -28,6 +28,8 @@ var (
 	errNotRegistered      = errors.DefineNotFound("not_registered", "not registered")
 	errUnexpectedResult   = errors.Define("unexpected_result", "unexpected result code {code}", "code")
 
+	errHandoverRoamingNotSupported = errors.DefineUnimplemented("handover_roaming_not_supported", "handover roaming is not supported")
+
 	ErrNoAction           = defineError("no_action", ResultNoAction, "no action")
 	ErrMIC                = defineError("mic", ResultMICFailed, "MIC failed")
 	ErrFrameReplayed      = defineError("frame_replayed", ResultFrameReplayed, "frame replayed")
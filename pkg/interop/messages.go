@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -189,6 +190,12 @@ type JoinAns struct {
 	SessionKeyID Buffer       `json:",omitempty"`
 }
 
+// RejoinReq is a rejoin-request message.
+type RejoinReq JoinReq
+
+// RejoinAns is an answer to a RejoinReq message.
+type RejoinAns JoinAns
+
 // AppSKeyReq is a AppSKey request message.
 type AppSKeyReq struct {
 	AsJsMessageHeader
@@ -219,6 +226,153 @@ type HomeNSAns struct {
 	HNetID NetID
 }
 
+// NsMessageHeader contains the message header for NS to NS messages.
+type NsMessageHeader struct {
+	MessageHeader
+	SenderID     NetID
+	ReceiverID   NetID
+	SenderNSID   *NetID `json:",omitempty"`
+	ReceiverNSID *NetID `json:",omitempty"`
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsMessageHeader) AnswerHeader() (NsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsMessageHeader{}, err
+	}
+	return NsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		ReceiverID:    h.SenderID,
+		SenderNSID:    h.ReceiverNSID,
+		ReceiverNSID:  h.SenderNSID,
+	}, nil
+}
+
+// GWInfoElement contains the metadata of a gateway.
+type GWInfoElement struct {
+	ID        Buffer   `json:",omitempty"`
+	RFRegion  string   `json:",omitempty"`
+	RSSI      *float32 `json:",omitempty"`
+	SNR       *float32 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool     `json:",omitempty"`
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI     *EUI64          `json:",omitempty"`
+	DevAddr    *DevAddr        `json:",omitempty"`
+	FPort      *uint8          `json:",omitempty"`
+	FCntUp     *uint32         `json:",omitempty"`
+	Confirmed  bool            `json:",omitempty"`
+	DataRate   *int            `json:",omitempty"`
+	ULFreq     *float64        `json:",omitempty"`
+	Margin     *int            `json:",omitempty"`
+	Battery    *int            `json:",omitempty"`
+	FNSULToken Buffer          `json:",omitempty"`
+	RecvTime   time.Time       `json:",omitempty"`
+	RFRegion   string          `json:",omitempty"`
+	GWCnt      *int            `json:",omitempty"`
+	GWInfo     []GWInfoElement `json:",omitempty"`
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI         *EUI64          `json:",omitempty"`
+	FPort          *uint8          `json:",omitempty"`
+	FCntDown       *uint32         `json:",omitempty"`
+	Confirmed      bool            `json:",omitempty"`
+	DLFreq1        *float64        `json:",omitempty"`
+	DLFreq2        *float64        `json:",omitempty"`
+	RXDelay1       *int            `json:",omitempty"`
+	ClassMode      string          `json:",omitempty"`
+	DataRate1      *int            `json:",omitempty"`
+	DataRate2      *int            `json:",omitempty"`
+	FNSULToken     Buffer          `json:",omitempty"`
+	GWInfo         []GWInfoElement `json:",omitempty"`
+	HiPriorityFlag bool            `json:",omitempty"`
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// ServiceProfile contains the service profile of a roaming device.
+type ServiceProfile struct {
+	ServiceProfileID string `json:"ServiceProfile-ID,omitempty"`
+	AddGWMetadata    bool
+	PRAllowed        bool
+	HRAllowed        bool
+	RAAllowed        bool
+	NwkGeoLoc        bool
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsMessageHeader
+	Result         Result
+	PHYPayload     Buffer          `json:",omitempty"`
+	DevEUI         *EUI64          `json:",omitempty"`
+	Lifetime       *uint32         `json:",omitempty"`
+	FNwkSIntKey    *KeyEnvelope    `json:",omitempty"`
+	NwkSKey        *KeyEnvelope    `json:",omitempty"`
+	FCntUp         *uint32         `json:",omitempty"`
+	ServiceProfile *ServiceProfile `json:",omitempty"`
+	DLMetaData     *DLMetaData     `json:",omitempty"`
+}
+
+// PRStopReq is a passive roaming stop request message.
+type PRStopReq struct {
+	NsMessageHeader
+	DevEUI   *EUI64   `json:",omitempty"`
+	DevAddr  *DevAddr `json:",omitempty"`
+	Lifetime *uint32  `json:",omitempty"`
+}
+
+// PRStopAns is an answer to a PRStopReq message.
+type PRStopAns struct {
+	NsMessageHeader
+	Result Result
+}
+
+// HRStartReq is a handover roaming start request message.
+// Handover roaming is not supported yet, so the device profile and session parameters are not decoded.
+type HRStartReq struct {
+	NsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// HRStopReq is a handover roaming stop request message.
+type HRStopReq struct {
+	NsMessageHeader
+	DevEUI *EUI64 `json:",omitempty"`
+}
+
+// XmitDataReq is a data transmission request message.
+type XmitDataReq struct {
+	NsMessageHeader
+	PHYPayload Buffer      `json:",omitempty"`
+	FRMPayload Buffer      `json:",omitempty"`
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsMessageHeader
+	Result  Result
+	DLFreq1 *float64 `json:",omitempty"`
+	DLFreq2 *float64 `json:",omitempty"`
+}
+
 // parseMessage parses the header and the message type of the request body.
 // This middleware sets the header in the context on the `headerKey` and the message on the `messageKey`.
 func parseMessage() echo.MiddlewareFunc {
@@ -250,6 +404,10 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &JoinReq{}
 			case MessageTypeJoinAns:
 				msg = &JoinAns{}
+			case MessageTypeRejoinReq:
+				msg = &RejoinReq{}
+			case MessageTypeRejoinAns:
+				msg = &RejoinAns{}
 			case MessageTypeAppSKeyReq:
 				msg = &AppSKeyReq{}
 			case MessageTypeAppSKeyAns:
@@ -258,6 +416,22 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &HomeNSReq{}
 			case MessageTypeHomeNSAns:
 				msg = &HomeNSAns{}
+			case MessageTypePRStartReq:
+				msg = &PRStartReq{}
+			case MessageTypePRStartAns:
+				msg = &PRStartAns{}
+			case MessageTypePRStopReq:
+				msg = &PRStopReq{}
+			case MessageTypePRStopAns:
+				msg = &PRStopAns{}
+			case MessageTypeHRStartReq:
+				msg = &HRStartReq{}
+			case MessageTypeHRStopReq:
+				msg = &HRStopReq{}
+			case MessageTypeXmitDataReq:
+				msg = &XmitDataReq{}
+			case MessageTypeXmitDataAns:
+				msg = &XmitDataAns{}
 			default:
 				return ErrMalformedMessage.New()
 			}
@@ -47,20 +47,26 @@ type Registerer interface {
 // JoinServer represents a Join Server.
 type JoinServer interface {
 	JoinRequest(context.Context, *JoinReq) (*JoinAns, error)
+	RejoinRequest(context.Context, *RejoinReq) (*RejoinAns, error)
 	AppSKeyRequest(context.Context, *AppSKeyReq) (*AppSKeyAns, error)
 	HomeNSRequest(context.Context, *HomeNSReq) (*HomeNSAns, error)
 }
 
 // HomeNetworkServer represents a Home Network Server.
+// sNS-hNS messages are not supported yet; a Network Server that serves its own devices acts as both.
+// Handover roaming requests are answered with RoamingActDisallowed.
 type HomeNetworkServer interface {
 }
 
 // ServingNetworkServer represents a Serving Network Server.
 type ServingNetworkServer interface {
+	PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error)
 }
 
 // ForwardingNetworkServer represents a Forwarding Network Server.
 type ForwardingNetworkServer interface {
+	PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
 }
 
 // ApplicationServer represents an Application Server.
@@ -73,6 +79,10 @@ func (noopServer) JoinRequest(context.Context, *JoinReq) (*JoinAns, error) {
 	return nil, errNotRegistered.New()
 }
 
+func (noopServer) RejoinRequest(context.Context, *RejoinReq) (*RejoinAns, error) {
+	return nil, errNotRegistered.New()
+}
+
 func (noopServer) AppSKeyRequest(context.Context, *AppSKeyReq) (*AppSKeyAns, error) {
 	return nil, errNotRegistered.New()
 }
@@ -81,6 +91,18 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*HomeNSAns, error)
 	return nil, errNotRegistered.New()
 }
 
+func (noopServer) PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error) {
+	return nil, errNotRegistered.New()
+}
+
 // Server is the server.
 type Server struct {
 	SenderClientCAs map[string][]*x509.Certificate
@@ -246,13 +268,13 @@ func (s *Server) RegisterHNS(hNS HomeNetworkServer) {
 // RegisterSNS registers the Serving Network Server for hNS-sNS, fNS-sNS and JS-vNS messages.
 func (s *Server) RegisterSNS(sNS ServingNetworkServer) {
 	s.sNS = sNS
-	s.rootGroup.POST("/sns", s.handleNsRequest)
+	s.rootGroup.POST("/sns", s.handleSNSRequest)
 }
 
 // RegisterFNS registers the Forwarding Network Server for sNS-fNS and JS-vNS messages.
 func (s *Server) RegisterFNS(fNS ForwardingNetworkServer) {
 	s.fNS = fNS
-	s.rootGroup.POST("/fns", s.handleNsRequest)
+	s.rootGroup.POST("/fns", s.handleFNSRequest)
 }
 
 // RegisterAS registers the Application Server for JS-AS messages.
@@ -260,18 +282,25 @@ func (s *Server) RegisterAS(as ApplicationServer) {
 	s.as = as
 }
 
-func (s *Server) handleRequest(c echo.Context) error {
+func requestContext(c echo.Context) context.Context {
 	cid := fmt.Sprintf("interop:%s:%s", c.Request().URL.Path, c.Request().Header.Get(echo.HeaderXRequestID))
 	ctx := events.ContextWithCorrelationID(c.Request().Context(), cid)
 	if state := c.Request().TLS; state != nil {
 		ctx = auth.NewContextWithX509DN(ctx, state.PeerCertificates[0].Subject)
 	}
+	return ctx
+}
+
+func (s *Server) handleRequest(c echo.Context) error {
+	ctx := requestContext(c)
 
 	var ans interface{}
 	var err error
 	switch req := c.Get(messageKey).(type) {
 	case *JoinReq:
 		ans, err = s.js.JoinRequest(ctx, req)
+	case *RejoinReq:
+		ans, err = s.js.RejoinRequest(ctx, req)
 	case *HomeNSReq:
 		ans, err = s.js.HomeNSRequest(ctx, req)
 	case *AppSKeyReq:
@@ -285,7 +314,55 @@ func (s *Server) handleRequest(c echo.Context) error {
 	return c.JSON(http.StatusOK, ans)
 }
 
+func (s *Server) handleSNSRequest(c echo.Context) error {
+	ctx := requestContext(c)
+
+	var ans interface{}
+	var err error
+	switch req := c.Get(messageKey).(type) {
+	case *PRStartReq:
+		ans, err = s.sNS.PRStartRequest(ctx, req)
+	case *HRStopReq:
+		err = errHandoverRoaming()
+	default:
+		return ErrMalformedMessage.New()
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, ans)
+}
+
+func (s *Server) handleFNSRequest(c echo.Context) error {
+	ctx := requestContext(c)
+
+	var ans interface{}
+	var err error
+	switch req := c.Get(messageKey).(type) {
+	case *PRStopReq:
+		ans, err = s.fNS.PRStopRequest(ctx, req)
+	case *XmitDataReq:
+		ans, err = s.fNS.XmitDataRequest(ctx, req)
+	default:
+		return ErrMalformedMessage.New()
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, ans)
+}
+
+// errHandoverRoaming returns the error of handover roaming requests.
+// TODO: Implement LoRaWAN handover roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
+func errHandoverRoaming() error {
+	return ErrRoamingActivation.WithCause(errHandoverRoamingNotSupported.New())
+}
+
 func (s *Server) handleNsRequest(c echo.Context) error {
-	// TODO: Implement LoRaWAN roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
-	return echo.NewHTTPError(http.StatusNotFound)
+	switch c.Get(messageKey).(type) {
+	case *HRStartReq, *HRStopReq:
+		return errHandoverRoaming()
+	default:
+		return ErrMalformedMessage.New()
+	}
 }
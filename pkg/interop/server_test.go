@@ -16,6 +16,7 @@ package interop_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -36,6 +37,7 @@ func TestServeHTTP(t *testing.T) {
 		sNS               ServingNetworkServer
 		fNS               ForwardingNetworkServer
 		AS                ApplicationServer
+		Path              string
 		RequestBody       interface{}
 		ResponseAssertion func(*testing.T, *http.Response) bool
 	}{
@@ -87,6 +89,96 @@ func TestServeHTTP(t *testing.T) {
 				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
 			},
 		},
+		{
+			Name: "PRStartReq/Success",
+			sNS: &mockServingNetworkServer{
+				PRStartRequestFunc: func(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &PRStartAns{
+						NsMessageHeader: header,
+						Result: Result{
+							ResultCode: ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			Path: "/sns",
+			RequestBody: &PRStartReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypePRStartReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x13},
+				},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg PRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, MessageTypePRStartAns) &&
+					a.So(msg.SenderID, should.Equal, NetID{0x0, 0x0, 0x13}) &&
+					a.So(msg.Result, should.Resemble, Result{ResultCode: ResultSuccess})
+			},
+		},
+		{
+			Name: "HRStartReq/NotSupported",
+			hNS:  &struct{}{},
+			Path: "/hns",
+			RequestBody: &HRStartReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypeHRStartReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x13},
+				},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusBadRequest) {
+					return false
+				}
+				var msg ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, MessageTypeHRStartAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, ResultRoamingActDisallowed)
+			},
+		},
+		{
+			Name: "XmitDataReq/ServingNetworkServer",
+			sNS:  &mockServingNetworkServer{},
+			Path: "/sns",
+			RequestBody: &XmitDataReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypeXmitDataReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x13},
+				},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusBadRequest) {
+					return false
+				}
+				var msg ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) && a.So(msg.Result, should.Resemble, Result{ResultCode: ResultMalformedMessage})
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -108,6 +200,9 @@ func TestServeHTTP(t *testing.T) {
 			if tc.sNS != nil {
 				s.RegisterSNS(tc.sNS)
 			}
+			if tc.fNS != nil {
+				s.RegisterFNS(tc.fNS)
+			}
 			if tc.AS != nil {
 				s.RegisterAS(tc.AS)
 			}
@@ -122,7 +217,7 @@ func TestServeHTTP(t *testing.T) {
 			if !a.So(err, should.BeNil) {
 				t.Fatal("Failed to marshal request body")
 			}
-			res, err := client.Post(srv.URL, "application/json", bytes.NewReader(buf))
+			res, err := client.Post(srv.URL+tc.Path, "application/json", bytes.NewReader(buf))
 			if !a.So(err, should.BeNil) {
 				t.Fatal("Request failed")
 			}
@@ -132,3 +227,14 @@ func TestServeHTTP(t *testing.T) {
 		})
 	}
 }
+
+type mockServingNetworkServer struct {
+	PRStartRequestFunc func(context.Context, *PRStartReq) (*PRStartAns, error)
+}
+
+func (m *mockServingNetworkServer) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	if m.PRStartRequestFunc == nil {
+		panic("PRStartRequest called, but not set")
+	}
+	return m.PRStartRequestFunc(ctx, req)
+}
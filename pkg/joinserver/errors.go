@@ -48,6 +48,7 @@ var (
 	errNoNwkKey                       = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey                   = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoPayload                      = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+	errNoRejoinRequest                = errors.DefineInvalidArgument("no_rejoin_request", "no RejoinRequest specified")
 	errNoRootKeys                     = errors.DefineCorruption("no_root_keys", "no root keys specified")
 	errNoSNwkSIntKey                  = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
 	errPayloadLengthMismatch          = errors.DefineInvalidArgument("payload_length", "expected length of payload to be equal to 23 got {length}")
@@ -56,7 +57,9 @@ var (
 	errProvisionerNotFound            = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
 	errProvisioning                   = errors.DefineAborted("provisioning", "provisioning failed")
 	errRegistryOperation              = errors.Define("registry_operation", "registry operation failed")
+	errRejoinRequestMACVersion        = errors.DefineInvalidArgument("rejoin_request_mac_version", "rejoin-request not supported by MAC version `{version}`")
 	errReuseDevNonce                  = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
+	errRJCountTooSmall                = errors.DefineInvalidArgument("rj_count_too_small", "RJcount1 is too small")
 	errUnauthenticated                = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnknownJoinEUI                 = errors.Define("unknown_join_eui", "JoinEUI specified is not known")
 	errUnsupportedLoRaWANMajorVersion = errors.DefineInvalidArgument("lorawan_major_version", "unsupported LoRaWAN major version: `{major}`")
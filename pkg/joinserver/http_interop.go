@@ -37,6 +37,21 @@ type interopServer struct {
 }
 
 func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (*interop.JoinAns, error) {
+	return srv.handleJoin(ctx, in, nil)
+}
+
+func (srv interopServer) RejoinRequest(ctx context.Context, in *interop.RejoinReq) (*interop.RejoinAns, error) {
+	// Rejoin-requests of type 0 and 2 do not contain the JoinEUI; the receiver of the message is the JoinEUI.
+	joinEUI := types.EUI64(in.ReceiverID)
+	ans, err := srv.handleJoin(ctx, (*interop.JoinReq)(in), &joinEUI)
+	if err != nil {
+		return nil, err
+	}
+	return (*interop.RejoinAns)(ans), nil
+}
+
+// handleJoin handles the join-request or rejoin-request in the JoinReq or RejoinReq message.
+func (srv interopServer) handleJoin(ctx context.Context, in *interop.JoinReq, joinEUI *types.EUI64) (*interop.JoinAns, error) {
 	ctx = log.NewContextWithField(ctx, "namespace", "joinserver/interop")
 
 	var cfList *ttnpb.CFList
@@ -59,6 +74,7 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 		DownlinkSettings:   dlSettings,
 		RxDelay:            in.RxDelay,
 		CFList:             cfList,
+		JoinEUI:            joinEUI,
 	}
 	if err := req.ValidateFields(
 		"raw_payload",
@@ -78,7 +94,9 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 		case errors.Resemble(err, errDecodePayload),
 			errors.Resemble(err, errWrongPayloadType),
 			errors.Resemble(err, errNoDevEUI),
-			errors.Resemble(err, errNoJoinEUI):
+			errors.Resemble(err, errNoJoinEUI),
+			errors.Resemble(err, errNoRejoinRequest),
+			errors.Resemble(err, errRejoinRequestMACVersion):
 			return nil, interop.ErrMalformedMessage.WithCause(err)
 		case errors.Resemble(err, errCallerNotAuthorized):
 			return nil, interop.ErrActivation.WithCause(err)
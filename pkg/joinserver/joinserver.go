@@ -235,6 +235,25 @@ func wrapKeyWithKEK(ctx context.Context, key types.AES128Key, kekLabel string, k
 	return ke, nil
 }
 
+// checkRejoinRequestMIC checks the MIC of the rejoin-request of type 1, which is computed with the JSIntKey.
+func checkRejoinRequestMIC(ctx context.Context, networkCryptoService cryptoservices.Network, dev *ttnpb.EndDevice, rawPayload []byte) error {
+	nwkKey, err := networkCryptoService.GetNwkKey(ctx, dev)
+	if err != nil {
+		return err
+	}
+	if nwkKey == nil {
+		return errNoNwkKey.New()
+	}
+	mic, err := crypto.ComputeRejoinRequestMIC(crypto.DeriveJSIntKey(*nwkKey, *dev.DevEUI), rawPayload[:20])
+	if err != nil {
+		return errComputeMIC.WithCause(err)
+	}
+	if !bytes.Equal(mic[:], rawPayload[20:]) {
+		return errMICMismatch.New()
+	}
+	return nil
+}
+
 var (
 	errGetApplicationActivationSettings = errors.Define("application_activation_settings", "failed to get application activation settings")
 	errNoKEK                            = errors.DefineNotFound("kek", "KEK not found")
@@ -274,25 +293,66 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	if req.Payload.Major != ttnpb.Major_LORAWAN_R1 {
 		return nil, errUnsupportedLoRaWANMajorVersion.WithAttributes("major", req.Payload.Major)
 	}
-	if req.Payload.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
-	}
 
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, errNoJoinRequest.New()
+	var (
+		joinEUI, devEUI types.EUI64
+		// devNonce is the DevNonce of the join-request or the RJcount0 or RJcount1 of the rejoin-request.
+		// The RJcount replaces the DevNonce in the join-accept MIC and in the session key derivation.
+		devNonce types.DevNonce
+		// joinReqType is 0xff for join-requests and the rejoin type for rejoin-requests.
+		joinReqType byte = 0xff
+		rejoinPld   *ttnpb.RejoinRequestPayload
+	)
+	switch req.Payload.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		pld := req.Payload.GetJoinRequestPayload()
+		if pld == nil {
+			return nil, errNoJoinRequest.New()
+		}
+		joinEUI, devEUI, devNonce = pld.JoinEUI, pld.DevEUI, pld.DevNonce
+	case ttnpb.MType_REJOIN_REQUEST:
+		if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			return nil, errRejoinRequestMACVersion.WithAttributes("version", req.SelectedMACVersion)
+		}
+		rejoinPld = req.Payload.GetRejoinRequestPayload()
+		if rejoinPld == nil {
+			return nil, errNoRejoinRequest.New()
+		}
+		devEUI, joinReqType = rejoinPld.DevEUI, byte(rejoinPld.RejoinType)
+		binary.BigEndian.PutUint16(devNonce[:], uint16(rejoinPld.RejoinCnt))
+		switch rejoinPld.RejoinType {
+		case ttnpb.RejoinType_SESSION:
+			joinEUI = rejoinPld.JoinEUI
+		default:
+			// Rejoin-requests of type 0 and 2 do not contain the JoinEUI, so the Network Server provides it.
+			if req.JoinEUI == nil {
+				return nil, errNoJoinEUI.New()
+			}
+			if !rejoinPld.NetID.Equal(req.NetID) {
+				return nil, errNetIDMismatch.WithAttributes("net_id", rejoinPld.NetID)
+			}
+			joinEUI = *req.JoinEUI
+		}
+	default:
+		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
 	}
-	if pld.DevEUI.IsZero() {
+	if devEUI.IsZero() {
 		return nil, errNoDevEUI.New()
 	}
 	logger = logger.WithFields(log.Fields(
-		"join_eui", pld.JoinEUI,
-		"dev_eui", pld.DevEUI,
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
 	))
+	if rejoinPld != nil {
+		logger = logger.WithFields(log.Fields(
+			"rejoin_type", rejoinPld.RejoinType,
+			"rejoin_cnt", rejoinPld.RejoinCnt,
+		))
+	}
 
 	var match bool
 	for _, p := range js.euiPrefixes {
-		if p.Matches(pld.JoinEUI) {
+		if p.Matches(joinEUI) {
 			match = true
 			break
 		}
@@ -302,16 +362,18 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	}
 
 	var handled bool
-	dev, err := js.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
+	dev, err := js.devices.SetByEUI(ctx, joinEUI, devEUI,
 		[]string{
 			"application_server_address",
 			"application_server_id",
 			"application_server_kek_label",
 			"last_dev_nonce",
 			"last_join_nonce",
+			"last_rj_count_1",
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
+			"next_rj_count_1",
 			"provisioner_id",
 			"provisioning_data",
 			"resets_join_nonces",
@@ -361,8 +423,17 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 
 			paths := make([]string, 0, 3)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if req.SelectedMACVersion.IncrementDevNonce() {
+			dn := uint32(binary.BigEndian.Uint16(devNonce[:]))
+			switch {
+			case rejoinPld != nil && rejoinPld.RejoinType == ttnpb.RejoinType_SESSION:
+				if dn < dev.NextRJCount1 {
+					return nil, nil, errRJCountTooSmall.New()
+				}
+				dev.LastRJCount1, dev.NextRJCount1 = dn, dn+1
+				paths = append(paths, "last_rj_count_1", "next_rj_count_1")
+			case rejoinPld != nil:
+				// RJcount0 of rejoin-requests of type 0 and 2 is verified by the Network Server.
+			case req.SelectedMACVersion.IncrementDevNonce():
 				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
 					if dn <= dev.LastDevNonce {
 						return nil, nil, errDevNonceTooSmall.New()
@@ -373,7 +444,7 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				}
 				dev.LastDevNonce = dn
 				paths = append(paths, "last_dev_nonce")
-			} else {
+			default:
 				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
 				if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
 					dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
@@ -474,26 +545,41 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			if err := cryptoDev.SetFields(dev, "ids", "provisioner_id", "provisioning_data"); err != nil {
 				return nil, nil, err
 			}
-			reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
-			if err != nil {
-				return nil, nil, errComputeMIC.WithCause(err)
-			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
-				return nil, nil, errMICMismatch.New()
+			switch {
+			case rejoinPld == nil:
+				reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+					return nil, nil, errMICMismatch.New()
+				}
+			case rejoinPld.RejoinType == ttnpb.RejoinType_SESSION:
+				if err := checkRejoinRequestMIC(ctx, networkCryptoService, cryptoDev, req.RawPayload); err != nil {
+					return nil, nil, err
+				}
+			default:
+				// The MIC of rejoin-requests of type 0 and 2 is computed with the SNwkSIntKey and verified by the
+				// Network Server.
 			}
-			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, 0xff, pld.DevNonce, b)
+			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, joinReqType, devNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			var enc []byte
+			if rejoinPld == nil {
+				enc, err = networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			} else {
+				enc, err = networkCryptoService.EncryptRejoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			}
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
-			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, devNonce, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveNwkSKeys.WithCause(err)
 			}
-			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, devNonce, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveAppSKey.WithCause(err)
 			}
@@ -17,6 +17,7 @@ package joinserver_test
 import (
 	"context"
 	"crypto/x509/pkix"
+	"encoding/binary"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	. "go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
@@ -2087,6 +2089,186 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func TestHandleRejoin(t *testing.T) {
+	a, ctx := test.New(t)
+
+	redisClient, flush := test.NewRedis(ctx, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+	aasReg, aasRegCloseFn := NewRedisApplicationActivationSettingRegistry(ctx)
+	defer aasRegCloseFn()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			ApplicationActivationSettings: aasReg,
+			Devices:                       devReg,
+			Keys:                          keyReg,
+			JoinEUIPrefixes:               joinEUIPrefixes,
+		},
+	)).(*JoinServer)
+	componenttest.StartComponent(t, c)
+
+	ctx = clusterauth.NewContext(ctx, nil)
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	netID := types.NetID{0x42, 0xff, 0xff}
+	devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI:                 &devEUI,
+			JoinEUI:                &joinEUI,
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: &appKey,
+			},
+			NwkKey: &ttnpb.KeyEnvelope{
+				Key: &nwkKey,
+			},
+		},
+		LoRaWANVersion:       ttnpb.MAC_V1_1,
+		NetworkServerAddress: nsAddr,
+	}
+	_, err := devReg.SetByID(ctx, dev.ApplicationIdentifiers, dev.DeviceID, nil,
+		func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return dev, []string{
+				"ids.application_ids",
+				"ids.dev_eui",
+				"ids.device_id",
+				"ids.join_eui",
+				"lorawan_version",
+				"network_server_address",
+				"root_keys",
+			}, nil
+		},
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	jsIntKey := crypto.DeriveJSIntKey(nwkKey, devEUI)
+	jsEncKey := crypto.DeriveJSEncKey(nwkKey, devEUI)
+
+	rejoinRequest := func(pld ttnpb.RejoinRequestPayload, micKey types.AES128Key) *ttnpb.JoinRequest {
+		b := test.Must(lorawan.AppendMHDR(nil, ttnpb.MHDR{
+			MType: ttnpb.MType_REJOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		})).([]byte)
+		b = test.Must(lorawan.AppendRejoinRequestPayload(b, pld)).([]byte)
+		mic := test.Must(crypto.ComputeRejoinRequestMIC(micKey, b)).([4]byte)
+		return &ttnpb.JoinRequest{
+			RawPayload:         append(b, mic[:]...),
+			SelectedMACVersion: ttnpb.MAC_V1_1,
+			NetID:              netID,
+			DevAddr:            devAddr,
+			DownlinkSettings: ttnpb.DLSettings{
+				OptNeg: true,
+			},
+			RxDelay: ttnpb.RX_DELAY_5,
+		}
+	}
+	assertRejoinAccept := func(res *ttnpb.JoinResponse, joinReqType byte, rjCount uint16, jn types.JoinNonce) {
+		if !a.So(res, should.NotBeNil) || !a.So(len(res.RawPayload), should.Equal, 17) {
+			t.FailNow()
+		}
+		var dn types.DevNonce
+		binary.BigEndian.PutUint16(dn[:], rjCount)
+
+		dec := test.Must(crypto.DecryptJoinAccept(jsEncKey, res.RawPayload[1:])).([]byte)
+		mic := test.Must(crypto.ComputeJoinAcceptMIC(jsIntKey, joinReqType, joinEUI, dn, append(res.RawPayload[:1:1], dec[:12]...))).([4]byte)
+		a.So(dec[12:], should.Resemble, mic[:])
+		a.So(res.FNwkSIntKey, should.Resemble, &ttnpb.KeyEnvelope{
+			Key: KeyPtr(crypto.DeriveFNwkSIntKey(nwkKey, jn, joinEUI, dn)),
+		})
+		a.So(res.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{
+			Key: KeyPtr(crypto.DeriveAppSKey(appKey, jn, joinEUI, dn)),
+		})
+	}
+
+	// Rejoin-request of type 1 is verified with the JSIntKey.
+	res, err := js.HandleJoin(ctx, rejoinRequest(ttnpb.RejoinRequestPayload{
+		RejoinType: ttnpb.RejoinType_SESSION,
+		JoinEUI:    joinEUI,
+		DevEUI:     devEUI,
+		RejoinCnt:  0,
+	}, jsIntKey))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	assertRejoinAccept(res, 0x01, 0, types.JoinNonce{0x00, 0x00, 0x01})
+
+	stored, err := devReg.GetByEUI(ctx, joinEUI, devEUI, []string{"last_rj_count_1", "next_rj_count_1"})
+	if a.So(err, should.BeNil) {
+		a.So(stored.LastRJCount1, should.Equal, 0)
+		a.So(stored.NextRJCount1, should.Equal, 1)
+	}
+
+	// RJcount1 must increase, also after a rejoin-request with RJcount1 0.
+	_, err = js.HandleJoin(ctx, rejoinRequest(ttnpb.RejoinRequestPayload{
+		RejoinType: ttnpb.RejoinType_SESSION,
+		JoinEUI:    joinEUI,
+		DevEUI:     devEUI,
+		RejoinCnt:  0,
+	}, jsIntKey))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Rejoin-request of type 1 with invalid MIC.
+	_, err = js.HandleJoin(ctx, rejoinRequest(ttnpb.RejoinRequestPayload{
+		RejoinType: ttnpb.RejoinType_SESSION,
+		JoinEUI:    joinEUI,
+		DevEUI:     devEUI,
+		RejoinCnt:  2,
+	}, nwkKey))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Rejoin-request of type 0 without JoinEUI.
+	req := rejoinRequest(ttnpb.RejoinRequestPayload{
+		RejoinType: ttnpb.RejoinType_CONTEXT,
+		NetID:      netID,
+		DevEUI:     devEUI,
+		RejoinCnt:  3,
+	}, types.AES128Key{})
+	_, err = js.HandleJoin(ctx, req)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Rejoin-request of type 0 is verified by the Network Server, which provides the JoinEUI.
+	req.JoinEUI = &joinEUI
+	res, err = js.HandleJoin(ctx, req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	assertRejoinAccept(res, 0x00, 3, types.JoinNonce{0x00, 0x00, 0x02})
+
+	// Rejoin-request of type 2 with a NetID that does not match.
+	req = rejoinRequest(ttnpb.RejoinRequestPayload{
+		RejoinType: ttnpb.RejoinType_KEYS,
+		NetID:      types.NetID{0x00, 0x00, 0x13},
+		DevEUI:     devEUI,
+	}, types.AES128Key{})
+	req.JoinEUI = &joinEUI
+	_, err = js.HandleJoin(ctx, req)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Rejoin-requests are not supported by LoRaWAN 1.0.x.
+	req = rejoinRequest(ttnpb.RejoinRequestPayload{
+		RejoinType: ttnpb.RejoinType_SESSION,
+		JoinEUI:    joinEUI,
+		DevEUI:     devEUI,
+		RejoinCnt:  4,
+	}, jsIntKey)
+	req.SelectedMACVersion = ttnpb.MAC_V1_0_3
+	_, err = js.HandleJoin(ctx, req)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	errTest := errors.New("test")
 
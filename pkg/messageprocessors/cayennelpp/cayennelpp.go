@@ -19,11 +19,13 @@ import (
 	"bytes"
 	"context"
 	"runtime/trace"
+	"sort"
 
 	lpp "github.com/TheThingsNetwork/go-cayenne-lib/cayennelpp"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -85,6 +87,13 @@ func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers,
 		return errOutput.WithCause(err)
 	}
 	msg.DecodedPayload = s
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = nil, nil
+	normalized, warnings, err := normalizedpayload.Parse(m.normalize())
+	if err != nil {
+		msg.NormalizedPayloadWarnings = []string{err.Error()}
+		return nil
+	}
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = normalized, warnings
 	return nil
 }
 
@@ -105,6 +114,52 @@ func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifier
 	return nil
 }
 
+// normalize maps the values onto the normalized payload schema.
+// The values of each channel are mapped onto a measurement, ordered by channel. Values that have no equivalent in the
+// normalized payload schema are left out.
+func (d decodedMap) normalize() []map[string]interface{} {
+	byChannel := make(map[uint8]map[string]interface{})
+	set := func(channel uint8, path string, value float64) {
+		measurement, ok := byChannel[channel]
+		if !ok {
+			measurement = make(map[string]interface{})
+			byChannel[channel] = measurement
+		}
+		measurement[path] = value
+	}
+	for name, value := range d {
+		key, channel, err := parseName(name)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case temperatureKey:
+			set(channel, "air.temperature", float64(value.(float32)))
+		case relativeHumidityKey:
+			set(channel, "air.relativeHumidity", float64(value.(float32)))
+		case barometricPressureKey:
+			set(channel, "air.pressure", float64(value.(float32)))
+		case luminosityKey:
+			set(channel, "air.lightIntensity", float64(value.(uint16)))
+		case gpsKey:
+			gps := value.(map[string]float32)
+			set(channel, "location.latitude", float64(gps["latitude"]))
+			set(channel, "location.longitude", float64(gps["longitude"]))
+			set(channel, "location.altitude", float64(gps["altitude"]))
+		}
+	}
+	channels := make([]int, 0, len(byChannel))
+	for channel := range byChannel {
+		channels = append(channels, int(channel))
+	}
+	sort.Ints(channels)
+	measurements := make([]map[string]interface{}, 0, len(channels))
+	for _, channel := range channels {
+		measurements = append(measurements, byChannel[uint8(channel)])
+	}
+	return measurements
+}
+
 func (d decodedMap) DigitalInput(channel, value uint8) {
 	d[formatName(digitalInputKey, channel)] = value
 }
@@ -167,3 +167,98 @@ func TestDecode(t *testing.T) {
 	a.So(m["gps_12"].(map[string]interface{})["longitude"], should.AlmostEqual, 4.8885, 0.00001)
 	a.So(m["gps_12"].(map[string]interface{})["altitude"], should.AlmostEqual, 21.54, 0.00001)
 }
+
+func TestDecodeNormalized(t *testing.T) {
+	ctx := test.Context()
+	host := New()
+
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+		DevEUI:   &eui,
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		a := assertions.New(t)
+
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{
+				1, lpp.DigitalInput, 255,
+				2, lpp.Temperature, 255, 100,
+				2, lpp.RelativeHumidity, 99,
+				2, lpp.BarometricPressure, 41, 239,
+				3, lpp.Luminosity, 1, 244,
+				4, lpp.GPS, 7, 253, 135, 0, 190, 245, 0, 8, 106,
+			},
+		}
+
+		err := host.DecodeUplink(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		a.So(message.NormalizedPayloadWarnings, should.BeEmpty)
+		if !a.So(message.NormalizedPayload, should.HaveLength, 3) {
+			t.FailNow()
+		}
+		measurements := make([]map[string]interface{}, 0, len(message.NormalizedPayload))
+		for _, s := range message.NormalizedPayload {
+			m, err := gogoproto.Map(s)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			measurements = append(measurements, m)
+		}
+
+		// The values of channel 2 are mapped onto one measurement.
+		air := measurements[0]["air"].(map[string]interface{})
+		a.So(air, should.HaveLength, 3)
+		a.So(air["temperature"], should.AlmostEqual, -15.6, 0.00001)
+		a.So(air["relativeHumidity"], should.AlmostEqual, 49.5, 0.00001)
+		a.So(air["pressure"], should.AlmostEqual, 1073.5, 0.00001)
+
+		a.So(measurements[1], should.Resemble, map[string]interface{}{
+			"air": map[string]interface{}{
+				"lightIntensity": 500.0,
+			},
+		})
+
+		location := measurements[2]["location"].(map[string]interface{})
+		a.So(location["latitude"], should.AlmostEqual, 52.3655, 0.00001)
+		a.So(location["longitude"], should.AlmostEqual, 4.8885, 0.00001)
+		a.So(location["altitude"], should.AlmostEqual, 21.54, 0.00001)
+	})
+
+	t.Run("NoMeasurements", func(t *testing.T) {
+		a := assertions.New(t)
+
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{
+				1, lpp.DigitalInput, 255,
+				2, lpp.Presence, 50,
+			},
+		}
+
+		err := host.DecodeUplink(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		a.So(message.NormalizedPayload, should.BeEmpty)
+		a.So(message.NormalizedPayloadWarnings, should.BeEmpty)
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// A relative humidity of 127.5% is out of range; this does not fail decoding.
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{
+				1, lpp.RelativeHumidity, 255,
+			},
+		}
+
+		err := host.DecodeUplink(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		a.So(message.DecodedPayload, should.NotBeNil)
+		a.So(message.NormalizedPayload, should.BeEmpty)
+		a.So(message.NormalizedPayloadWarnings, should.HaveLength, 1)
+	})
+}
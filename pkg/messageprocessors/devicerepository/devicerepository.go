@@ -21,8 +21,10 @@ import (
 
 	"github.com/bluele/gcache"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
@@ -149,7 +151,25 @@ func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers,
 	if err != nil {
 		return err
 	}
-	return h.processor.DecodeUplink(ctx, ids, version, message, res.Formatter, res.FormatterParameter)
+	if err := h.processor.DecodeUplink(ctx, ids, version, message, res.Formatter, res.FormatterParameter); err != nil {
+		return err
+	}
+	if len(res.NormalizedPayloadMapping) == 0 || message.DecodedPayload == nil || len(message.NormalizedPayload) > 0 {
+		return nil
+	}
+	// The codec does not normalize the payload itself, so apply the mapping of the Device Repository.
+	decoded, err := gogoproto.Map(message.DecodedPayload)
+	if err != nil {
+		return err
+	}
+	normalized, warnings, err := normalizedpayload.Parse(normalizedpayload.Map(decoded, res.NormalizedPayloadMapping))
+	if err != nil {
+		message.NormalizedPayloadWarnings = []string{err.Error()}
+		return nil
+	}
+	message.NormalizedPayload = normalized
+	message.NormalizedPayloadWarnings = warnings
+	return nil
 }
 
 // DecodeDownlink decodes a downlink message.
@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
 	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
 	js "go.thethings.network/lorawan-stack/v3/pkg/scripting/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -120,10 +121,62 @@ type decodeUplinkInput struct {
 	FPort uint8   `json:"fPort"`
 }
 
+type normalizeUplinkOutput struct {
+	Data     interface{} `json:"data"`
+	Warnings []string    `json:"warnings"`
+	Errors   []string    `json:"errors"`
+}
+
 type decodeUplinkOutput struct {
-	Data     map[string]interface{} `json:"data"`
-	Warnings []string               `json:"warnings"`
-	Errors   []string               `json:"errors"`
+	Data       map[string]interface{} `json:"data"`
+	Warnings   []string               `json:"warnings"`
+	Errors     []string               `json:"errors"`
+	Normalized *normalizeUplinkOutput `json:"normalized"`
+}
+
+var errNormalizedData = errors.DefineInvalidArgument("normalized_data", "normalized data must be an object or an array of objects")
+
+// measurements returns the normalized data as a list of measurements.
+func (o *normalizeUplinkOutput) measurements() ([]map[string]interface{}, error) {
+	switch data := o.Data.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return []map[string]interface{}{data}, nil
+	case []interface{}:
+		res := make([]map[string]interface{}, 0, len(data))
+		for _, v := range data {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, errNormalizedData.New()
+			}
+			res = append(res, m)
+		}
+		return res, nil
+	default:
+		return nil, errNormalizedData.New()
+	}
+}
+
+// normalizeUplink sets the message's NormalizedPayload from the output of the normalizeUplink() function.
+// Normalization errors do not fail the decoding; they are reported as NormalizedPayloadWarnings instead.
+func normalizeUplink(msg *ttnpb.ApplicationUplink, output *normalizeUplinkOutput) {
+	if len(output.Errors) > 0 {
+		msg.NormalizedPayloadWarnings = output.Errors
+		return
+	}
+	measurements, err := output.measurements()
+	if err != nil {
+		msg.NormalizedPayloadWarnings = []string{err.Error()}
+		return
+	}
+	normalized, warnings, err := normalizedpayload.Parse(measurements)
+	if err != nil {
+		msg.NormalizedPayloadWarnings = []string{err.Error()}
+		return
+	}
+	msg.NormalizedPayload = normalized
+	msg.NormalizedPayloadWarnings = append(output.Warnings, warnings...)
 }
 
 // DecodeUplink decodes the message's FRMPayload to DecodedPayload using the given script.
@@ -144,12 +197,18 @@ func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers,
 				bytes: input.bytes.slice(),
 				fPort: input.fPort,
 			}
+			var output;
 			if (typeof decodeUplink === 'function') {
-				return decodeUplink(input);
+				output = decodeUplink(input);
+			} else {
+				output = {
+					data: Decoder(input.bytes, input.fPort)
+				}
 			}
-			return {
-				data: Decoder(input.bytes, input.fPort)
+			if (typeof normalizeUplink === 'function' && output && !(output.errors && output.errors.length)) {
+				output.normalized = normalizeUplink({ data: output.data });
 			}
+			return output;
 		}
 	`, script)
 	valueAs, err := h.engine.Run(ctx, script, "main", input)
@@ -172,6 +231,10 @@ func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers,
 	}
 	msg.DecodedPayload = s
 	msg.DecodedPayloadWarnings = output.Warnings
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = nil, nil
+	if output.Normalized != nil {
+		normalizeUplink(msg, output.Normalized)
+	}
 	return nil
 }
 
@@ -443,6 +443,136 @@ func TestDecodeUplink(t *testing.T) {
 	}
 }
 
+func TestDecodeUplinkNormalized(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	decoder := `
+	function decodeUplink(input) {
+		return {
+			data: {
+				temperature: (((input.bytes[0] & 0x80 ? input.bytes[0] - 0x100 : input.bytes[0]) << 8) | input.bytes[1]) / 100,
+				humidity: input.bytes[2]
+			}
+		}
+	}
+	`
+
+	// Normalize single measurement.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0xF7, 0xAE, 0x42},
+		}
+		script := decoder + `
+		function normalizeUplink(input) {
+			return {
+				data: {
+					air: {
+						temperature: input.data.temperature,
+						relativeHumidity: input.data.humidity
+					},
+					foo: "bar"
+				}
+			}
+		}
+		`
+		err := host.DecodeUplink(ctx, ids, nil, message, script)
+		a.So(err, should.BeNil)
+		if a.So(message.NormalizedPayload, should.HaveLength, 1) {
+			m, err := gogoproto.Map(message.NormalizedPayload[0])
+			a.So(err, should.BeNil)
+			a.So(m, should.Resemble, map[string]interface{}{
+				"air": map[string]interface{}{
+					"temperature":      -21.3,
+					"relativeHumidity": 66.0,
+				},
+			})
+		}
+		a.So(message.NormalizedPayloadWarnings, should.Resemble, []string{"unknown field `foo`"})
+	}
+
+	// Normalize multiple measurements.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0xF7, 0xAE, 0x42},
+		}
+		script := decoder + `
+		function normalizeUplink(input) {
+			return {
+				data: [
+					{ time: "2021-06-01T12:00:00+02:00", air: { temperature: input.data.temperature } },
+					{ time: "2021-06-01T12:30:00+02:00", air: { temperature: input.data.temperature + 1 } }
+				]
+			}
+		}
+		`
+		err := host.DecodeUplink(ctx, ids, nil, message, script)
+		a.So(err, should.BeNil)
+		if a.So(message.NormalizedPayload, should.HaveLength, 2) {
+			m, err := gogoproto.Map(message.NormalizedPayload[1])
+			a.So(err, should.BeNil)
+			a.So(m, should.Resemble, map[string]interface{}{
+				"time": "2021-06-01T10:30:00Z",
+				"air": map[string]interface{}{
+					"temperature": -20.3,
+				},
+			})
+		}
+		a.So(message.NormalizedPayloadWarnings, should.BeEmpty)
+	}
+
+	// Invalid measurement does not fail decoding.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0xF7, 0xAE, 0x42},
+		}
+		script := decoder + `
+		function normalizeUplink(input) {
+			return {
+				data: {
+					air: {
+						relativeHumidity: input.data.humidity * 2
+					}
+				}
+			}
+		}
+		`
+		err := host.DecodeUplink(ctx, ids, nil, message, script)
+		a.So(err, should.BeNil)
+		a.So(message.DecodedPayload, should.NotBeNil)
+		a.So(message.NormalizedPayload, should.BeNil)
+		a.So(message.NormalizedPayloadWarnings, should.HaveLength, 1)
+	}
+
+	// Return errors.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0xF7, 0xAE, 0x42},
+		}
+		script := decoder + `
+		function normalizeUplink(input) {
+			return {
+				errors: ["unsupported"]
+			}
+		}
+		`
+		err := host.DecodeUplink(ctx, ids, nil, message, script)
+		a.So(err, should.BeNil)
+		a.So(message.DecodedPayload, should.NotBeNil)
+		a.So(message.NormalizedPayload, should.BeNil)
+		a.So(message.NormalizedPayloadWarnings, should.Resemble, []string{"unsupported"})
+	}
+}
+
 func TestDecodeDownlink(t *testing.T) {
 	a := assertions.New(t)
 
@@ -0,0 +1,244 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package normalizedpayload implements the normalized payload schema, which is a common set of measurement types that
+// payload formatters can map their decoded payloads onto.
+//
+// A normalized payload is a list of measurements. Each measurement is an object with the following fields:
+//
+//	time                   RFC3339 timestamp of the measurement
+//	air.temperature        air temperature (°C)
+//	air.relativeHumidity   relative humidity of the air (%)
+//	air.pressure           atmospheric pressure (hPa)
+//	air.co2                CO2 concentration (ppm)
+//	air.lightIntensity     light intensity (lx)
+//	soil.temperature       soil temperature (°C)
+//	soil.moisture          volumetric water content of the soil (%)
+//	water.temperature      water temperature (°C)
+//	wind.speed             wind speed (m/s)
+//	wind.direction         wind direction (degrees from north)
+//	battery.voltage        battery voltage (V)
+//	battery.level          battery level (%)
+//	location.latitude      latitude (degrees)
+//	location.longitude     longitude (degrees)
+//	location.altitude      altitude (m)
+package normalizedpayload
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+)
+
+// Field is a measurement field of the normalized payload schema.
+type Field struct {
+	// Unit is the unit of the field value.
+	Unit string
+	// Min is the inclusive minimum of the field value. It is -Inf if there is no minimum.
+	Min float64
+	// Max is the inclusive maximum of the field value. It is +Inf if there is no maximum.
+	Max float64
+}
+
+// TimeField is the path of the field that contains the time of the measurement.
+const TimeField = "time"
+
+var temperature = Field{Unit: "°C", Min: -273.15, Max: math.Inf(1)}
+
+// Fields are the measurement fields of the normalized payload schema by their path.
+var Fields = map[string]Field{
+	"air.temperature":      temperature,
+	"air.relativeHumidity": {Unit: "%", Min: 0, Max: 100},
+	"air.pressure":         {Unit: "hPa", Min: 0, Max: math.Inf(1)},
+	"air.co2":              {Unit: "ppm", Min: 0, Max: 1000000},
+	"air.lightIntensity":   {Unit: "lx", Min: 0, Max: math.Inf(1)},
+	"soil.temperature":     temperature,
+	"soil.moisture":        {Unit: "%", Min: 0, Max: 100},
+	"water.temperature":    temperature,
+	"wind.speed":           {Unit: "m/s", Min: 0, Max: math.Inf(1)},
+	"wind.direction":       {Unit: "°", Min: 0, Max: 360},
+	"battery.voltage":      {Unit: "V", Min: 0, Max: math.Inf(1)},
+	"battery.level":        {Unit: "%", Min: 0, Max: 100},
+	"location.latitude":    {Unit: "°", Min: -90, Max: 90},
+	"location.longitude":   {Unit: "°", Min: -180, Max: 180},
+	"location.altitude":    {Unit: "m", Min: math.Inf(-1), Max: math.Inf(1)},
+}
+
+var (
+	errMeasurement = errors.DefineInvalidArgument("measurement", "invalid measurement `{index}`")
+	errFieldType   = errors.DefineInvalidArgument("field_type", "field `{path}` must be a {type}")
+	errFieldMin    = errors.DefineInvalidArgument("field_min", "field `{path}` must be at least `{min}` {unit}")
+	errFieldMax    = errors.DefineInvalidArgument("field_max", "field `{path}` must be at most `{max}` {unit}")
+)
+
+// flatten adds the leaf values of m to the flat map by their dot-separated path.
+func flatten(flat map[string]interface{}, prefix string, m map[string]interface{}) {
+	for k, v := range m {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if sub, ok := v.(map[string]interface{}); ok && path != TimeField {
+			flatten(flat, path, sub)
+			continue
+		}
+		flat[path] = v
+	}
+}
+
+// set sets the value at the dot-separated path in m, creating the intermediate objects.
+func set(m map[string]interface{}, path string, v interface{}) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		sub, ok := m[part].(map[string]interface{})
+		if !ok {
+			sub = make(map[string]interface{})
+			m[part] = sub
+		}
+		m = sub
+	}
+	m[parts[len(parts)-1]] = v
+}
+
+// get returns the value at the dot-separated path in m.
+func get(m map[string]interface{}, path string) (interface{}, bool) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		sub, ok := m[part].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		m = sub
+	}
+	v, ok := m[parts[len(parts)-1]]
+	return v, ok
+}
+
+// toFloat returns the numeric value v as float64.
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func parseMeasurement(measurement map[string]interface{}) (map[string]interface{}, []string, error) {
+	flat := make(map[string]interface{})
+	flatten(flat, "", measurement)
+	paths := make([]string, 0, len(flat))
+	for path := range flat {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	res := make(map[string]interface{}, len(flat))
+	var warnings []string
+	for _, path := range paths {
+		v := flat[path]
+		if path == TimeField {
+			s, ok := v.(string)
+			if !ok {
+				return nil, nil, errFieldType.WithAttributes("path", path, "type", "string")
+			}
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, nil, errFieldType.WithCause(err).WithAttributes("path", path, "type", "RFC3339 timestamp")
+			}
+			res[TimeField] = t.UTC().Format(time.RFC3339Nano)
+			continue
+		}
+		field, ok := Fields[path]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("unknown field `%s`", path))
+			continue
+		}
+		f, ok := toFloat(v)
+		if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, nil, errFieldType.WithAttributes("path", path, "type", "finite number")
+		}
+		if f < field.Min {
+			return nil, nil, errFieldMin.WithAttributes("path", path, "min", field.Min, "unit", field.Unit)
+		}
+		if f > field.Max {
+			return nil, nil, errFieldMax.WithAttributes("path", path, "max", field.Max, "unit", field.Unit)
+		}
+		set(res, path, f)
+	}
+	return res, warnings, nil
+}
+
+// Parse validates the measurements against the normalized payload schema and returns them as structs.
+// Fields that are not part of the schema are left out, and a warning is returned for each of them.
+// Measurements without any known fields are left out.
+func Parse(measurements []map[string]interface{}) ([]*pbtypes.Struct, []string, error) {
+	var (
+		res      []*pbtypes.Struct
+		warnings []string
+	)
+	for i, measurement := range measurements {
+		m, measurementWarnings, err := parseMeasurement(measurement)
+		if err != nil {
+			return nil, nil, errMeasurement.WithCause(err).WithAttributes("index", i)
+		}
+		warnings = append(warnings, measurementWarnings...)
+		if len(m) == 0 {
+			continue
+		}
+		s, err := gogoproto.Struct(m)
+		if err != nil {
+			return nil, nil, errMeasurement.WithCause(err).WithAttributes("index", i)
+		}
+		res = append(res, s)
+	}
+	return res, warnings, nil
+}
+
+// Map maps the fields of the decoded payload onto a measurement using the given mapping.
+// The keys of the mapping are the paths of the normalized payload fields, and the values are the paths of the decoded
+// payload fields. Fields that are not present in the decoded payload are left out.
+// If none of the fields are present, Map returns nil.
+func Map(decoded map[string]interface{}, mapping map[string]string) []map[string]interface{} {
+	measurement := make(map[string]interface{})
+	for target, source := range mapping {
+		v, ok := get(decoded, source)
+		if !ok || v == nil {
+			continue
+		}
+		set(measurement, target, v)
+	}
+	if len(measurement) == 0 {
+		return nil
+	}
+	return []map[string]interface{}{measurement}
+}
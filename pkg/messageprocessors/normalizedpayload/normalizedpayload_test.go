@@ -0,0 +1,193 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package normalizedpayload_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		measurements   []map[string]interface{}
+		expected       []map[string]interface{}
+		warnings       []string
+		errorAssertion func(error) bool
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name: "Valid",
+			measurements: []map[string]interface{}{
+				{
+					"time": "2021-06-01T12:00:00+02:00",
+					"air": map[string]interface{}{
+						"temperature":      21.5,
+						"relativeHumidity": int64(42),
+					},
+					"battery": map[string]interface{}{
+						"voltage": 3.3,
+					},
+				},
+				{
+					"location": map[string]interface{}{
+						"latitude":  52.37,
+						"longitude": 4.89,
+					},
+				},
+			},
+			expected: []map[string]interface{}{
+				{
+					"time": "2021-06-01T10:00:00Z",
+					"air": map[string]interface{}{
+						"temperature":      21.5,
+						"relativeHumidity": 42.0,
+					},
+					"battery": map[string]interface{}{
+						"voltage": 3.3,
+					},
+				},
+				{
+					"location": map[string]interface{}{
+						"latitude":  52.37,
+						"longitude": 4.89,
+					},
+				},
+			},
+		},
+		{
+			name: "UnknownFields",
+			measurements: []map[string]interface{}{
+				{
+					"air": map[string]interface{}{
+						"temperature": 21.5,
+						"foo":         "bar",
+					},
+				},
+				{
+					"bar": 42.0,
+				},
+			},
+			expected: []map[string]interface{}{
+				{
+					"air": map[string]interface{}{
+						"temperature": 21.5,
+					},
+				},
+			},
+			warnings: []string{"unknown field `air.foo`", "unknown field `bar`"},
+		},
+		{
+			name: "InvalidTime",
+			measurements: []map[string]interface{}{
+				{
+					"time": "yesterday",
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "InvalidType",
+			measurements: []map[string]interface{}{
+				{
+					"air": map[string]interface{}{
+						"temperature": "21.5",
+					},
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "BelowMinimum",
+			measurements: []map[string]interface{}{
+				{
+					"air": map[string]interface{}{
+						"temperature": -300.0,
+					},
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "AboveMaximum",
+			measurements: []map[string]interface{}{
+				{
+					"battery": map[string]interface{}{
+						"level": 101.0,
+					},
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			res, warnings, err := normalizedpayload.Parse(tc.measurements)
+			if tc.errorAssertion != nil {
+				a.So(tc.errorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var actual []map[string]interface{}
+			for _, s := range res {
+				m, err := gogoproto.Map(s)
+				a.So(err, should.BeNil)
+				actual = append(actual, m)
+			}
+			a.So(actual, should.Resemble, tc.expected)
+			a.So(warnings, should.Resemble, tc.warnings)
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	a := assertions.New(t)
+
+	decoded := map[string]interface{}{
+		"temperature": 21.5,
+		"battery": map[string]interface{}{
+			"mV": 3300.0,
+			"V":  3.3,
+		},
+		"humidity": nil,
+	}
+	a.So(normalizedpayload.Map(decoded, map[string]string{
+		"air.temperature":      "temperature",
+		"air.relativeHumidity": "humidity",
+		"battery.voltage":      "battery.V",
+		"wind.speed":           "wind.speed",
+	}), should.Resemble, []map[string]interface{}{
+		{
+			"air": map[string]interface{}{
+				"temperature": 21.5,
+			},
+			"battery": map[string]interface{}{
+				"voltage": 3.3,
+			},
+		},
+	})
+	a.So(normalizedpayload.Map(decoded, map[string]string{
+		"wind.speed": "wind.speed",
+	}), should.BeNil)
+}
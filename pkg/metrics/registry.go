@@ -19,6 +19,7 @@ import (
 
 	ocprom "contrib.go.opencensus.io/exporter/prometheus"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opencensus.io/stats/view"
 )
 
@@ -29,7 +30,10 @@ var exporter, _ = ocprom.NewExporter(ocprom.Options{
 })
 
 // Exporter for the metrics registry.
-var Exporter http.Handler = exporter
+// The OpenMetrics format is served if requested by the scraper, which includes the exemplars of metrics.
+var Exporter http.Handler = promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+	EnableOpenMetrics: true,
+})
 
 // Registry for metrics.
 var Registry prometheus.Registerer = registry
@@ -15,6 +15,7 @@
 package networkserver
 
 import (
+	"strconv"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
@@ -34,6 +35,8 @@ type ApplicationUplinkQueueConfig struct {
 // MACSettingConfig defines MAC-layer configuration.
 type MACSettingConfig struct {
 	ADRMargin                  *float32                   `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
+	ADRAlgorithm               *string                    `name:"adr-algorithm" description:"The default ADR algorithm Network Server should use if not configured in device's MAC settings (default, conservative, loss_aware)"`
+	ADRAlgorithmParameters     map[string]string          `name:"adr-algorithm-parameters" description:"The default ADR algorithm parameters Network Server should use if not configured in device's MAC settings"`
 	DesiredRx1Delay            *ttnpb.RxDelay             `name:"desired-rx1-delay" description:"Desired Rx1Delay value Network Server should use if not configured in device's MAC settings"`
 	DesiredMaxDutyCycle        *ttnpb.AggregatedDutyCycle `name:"desired-max-duty-cycle" description:"Desired MaxDutyCycle value Network Server should use if not configured in device's MAC settings"`
 	DesiredADRAckLimitExponent *ttnpb.ADRAckLimitExponent `name:"desired-adr-ack-limit-exponent" description:"Desired ADR_ACK_LIMIT value Network Server should use if not configured in device's MAC settings"`
@@ -44,7 +47,25 @@ type MACSettingConfig struct {
 	StatusCountPeriodicity     *uint32                    `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 }
 
+var errADRAlgorithmParameter = errors.DefineInvalidArgument("adr_algorithm_parameter", "invalid ADR algorithm parameter `{name}`")
+
+// Validate returns an error if the configuration is invalid.
+func (c MACSettingConfig) Validate() error {
+	if c.ADRAlgorithm != nil {
+		if _, ok := mac.GetADRAlgorithm(*c.ADRAlgorithm); !ok {
+			return mac.ErrUnknownADRAlgorithm.WithAttributes("name", *c.ADRAlgorithm)
+		}
+	}
+	for k, v := range c.ADRAlgorithmParameters {
+		if _, err := strconv.ParseFloat(v, 32); err != nil {
+			return errADRAlgorithmParameter.WithAttributes("name", k).WithCause(err)
+		}
+	}
+	return nil
+}
+
 // Parse parses the configuration and returns ttnpb.MACSettings.
+// Invalid ADR algorithm parameters are ignored, use Validate to check the configuration.
 func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	p := ttnpb.MACSettings{
 		ClassBTimeout:         c.ClassBTimeout,
@@ -54,6 +75,19 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	if c.ADRMargin != nil {
 		p.ADRMargin = &pbtypes.FloatValue{Value: *c.ADRMargin}
 	}
+	if c.ADRAlgorithm != nil {
+		p.ADRAlgorithm = &pbtypes.StringValue{Value: *c.ADRAlgorithm}
+	}
+	if len(c.ADRAlgorithmParameters) > 0 {
+		p.ADRAlgorithmParameters = make(map[string]float32, len(c.ADRAlgorithmParameters))
+		for k, v := range c.ADRAlgorithmParameters {
+			f, err := strconv.ParseFloat(v, 32)
+			if err != nil {
+				continue
+			}
+			p.ADRAlgorithmParameters[k] = float32(f)
+		}
+	}
 	if c.DesiredRx1Delay != nil {
 		p.DesiredRx1Delay = &ttnpb.RxDelayValue{Value: *c.DesiredRx1Delay}
 	}
@@ -113,10 +147,58 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 	return p, nil
 }
 
+// RoamingConfig represents the passive roaming configuration.
+// Roaming agreements are configured per NetID in the interop client configuration.
+// The Network Server acts as Serving Network Server only for devices of which it is the Home Network Server;
+// the separate Home Network Server role (sNS-hNS messages) is not supported.
+// The band of forwarded uplink messages is derived from the frequency plan of the gateways in the uplink metadata.
+// Roaming partners can only transmit downlink messages through the gateways of this Network Server if UplinkTokenKeyID
+// is set.
+type RoamingConfig struct {
+	ForwardUplinks   bool   `name:"forward-uplinks" description:"Forward uplink messages of roaming devices to roaming partners"`
+	BandID           string `name:"band-id" description:"Band ID of the gateways of which the frequency plan is not in the uplink metadata"`
+	UplinkTokenKeyID string `name:"uplink-token-key-id" description:"ID of the key used to encrypt the uplink tokens of gateways that are handed out to roaming partners"`
+}
+
+// DeviceRegistryConfig defines the device registry configuration.
+type DeviceRegistryConfig struct {
+	Backend     string `name:"backend" description:"Backend of the device registry (redis, sql)"`
+	DatabaseURI string `name:"database-uri" description:"Database connection URI of the SQL device registry"`
+}
+
+var errDeviceRegistryBackend = errors.DefineInvalidArgument("device_registry_backend", "invalid device registry backend `{backend}`")
+
+// Validate returns an error if the configuration is invalid.
+func (c DeviceRegistryConfig) Validate() error {
+	switch c.Backend {
+	case "redis", "sql":
+		return nil
+	default:
+		return errDeviceRegistryBackend.WithAttributes("backend", c.Backend)
+	}
+}
+
+// LinkQualityConfig defines the configuration of the link quality stats of end devices.
+type LinkQualityConfig struct {
+	Enable bool `name:"enable" description:"Maintain link quality stats of end devices"`
+	Window int  `name:"window" description:"Number of recent uplink and downlink messages per device over which the link quality stats are aggregated. The observations are stored in the MAC state, so up to twice this number of observations is read and written on every uplink; keep the window small"`
+}
+
+var errLinkQualityWindow = errors.DefineInvalidArgument("link_quality_window", "link quality window must be greater than 0")
+
+// Validate returns an error if the configuration is invalid.
+func (c LinkQualityConfig) Validate() error {
+	if c.Enable && c.Window <= 0 {
+		return errLinkQualityWindow.New()
+	}
+	return nil
+}
+
 // Config represents the NetworkServer configuration.
 type Config struct {
 	ApplicationUplinkQueue ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
 	Devices                DeviceRegistry               `name:"-"`
+	DeviceRegistry         DeviceRegistryConfig         `name:"device-registry" description:"Device registry configuration"`
 	DownlinkTasks          DownlinkTaskQueue            `name:"-"`
 	UplinkDeduplicator     UplinkDeduplicator           `name:"-"`
 	NetID                  types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
@@ -126,6 +208,8 @@ type Config struct {
 	DownlinkPriorities     DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
 	DefaultMACSettings     MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
 	Interop                config.InteropClient         `name:"interop" description:"Interop client configuration"`
+	Roaming                RoamingConfig                `name:"roaming" description:"Passive roaming configuration"`
+	LinkQuality            LinkQualityConfig            `name:"link-quality" description:"Link quality stats configuration"`
 	DeviceKEKLabel         string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity  int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
 }
@@ -135,6 +219,10 @@ var DefaultConfig = Config{
 	ApplicationUplinkQueue: ApplicationUplinkQueueConfig{
 		BufferSize: 1000,
 	},
+	DeviceRegistry: DeviceRegistryConfig{
+		Backend:     "redis",
+		DatabaseURI: "postgresql://root@localhost:26257/ttn_lorawan_dev?sslmode=disable",
+	},
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
 	DownlinkPriorities: DownlinkPriorityConfig{
@@ -150,5 +238,9 @@ var DefaultConfig = Config{
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
+	LinkQuality: LinkQualityConfig{
+		Enable: false,
+		Window: 20,
+	},
 	DownlinkQueueCapacity: 10000,
 }
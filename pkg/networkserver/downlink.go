@@ -215,7 +215,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		dev.MACState.QueuedResponses = nil
 		dev.MACState.PendingRequests = dev.MACState.PendingRequests[:0]
 
-		enqueuers := make([]func(context.Context, *ttnpb.EndDevice, uint16, uint16) mac.EnqueueState, 0, 13)
+		enqueuers := make([]func(context.Context, *ttnpb.EndDevice, uint16, uint16) mac.EnqueueState, 0, 18)
 		if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_0) >= 0 {
 			enqueuers = append(enqueuers,
 				mac.EnqueueDutyCycleReq,
@@ -270,6 +270,18 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				mac.EnqueueRejoinParamSetupReq,
 			)
 		}
+		if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_0_4) >= 0 {
+			enqueuers = append(enqueuers,
+				mac.EnqueueRelayConfReq,
+				mac.EnqueueRelayEndDeviceConfReq,
+				mac.EnqueueRelayFilterListReq,
+				mac.EnqueueRelayCtrlUplinkListReq,
+				func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+					return mac.EnqueueRelayUpdateUplinkListReq(ctx, dev, maxDownLen, maxUpLen, ns.relayServedDeviceSession(dev.ApplicationIdentifiers))
+				},
+				mac.EnqueueRelayConfigureFwdLimitReq,
+			)
+		}
 
 		for _, f := range enqueuers {
 			st := f(ctx, dev, maxDownLen, maxUpLen)
@@ -290,6 +302,12 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				return nil, generateDownlinkState{}, errEncodeMAC.WithCause(err)
 			}
 		}
+		for _, cmd := range dev.MACState.PendingRequests {
+			if req := cmd.GetRelayUpdateUplinkListReq(); req != nil {
+				// NOTE: Pending requests are stored in the registry, the key must not be persisted.
+				req.RootWorSKey = nil
+			}
+		}
 		logger = logger.WithFields(log.Fields(
 			"mac_count", len(cmds),
 			"mac_length", len(b),
@@ -618,6 +636,9 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 type downlinkPath struct {
 	*ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+
+	// relay is set if the downlink is to be forwarded by a relay.
+	relay *ttnpb.RelayMetadata
 }
 
 func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
@@ -630,6 +651,13 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 	body := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
 	for _, md := range mds {
+		if md.Relay != nil {
+			tail = append(tail, downlinkPath{
+				DownlinkPath: &ttnpb.DownlinkPath{},
+				relay:        md.Relay,
+			})
+			continue
+		}
 		if len(md.UplinkToken) == 0 || md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
 			continue
 		}
@@ -642,6 +670,9 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 		}
 		if md.PacketBroker != nil {
 			tail = append(tail, path)
+		} else if _, ok := parseRoamingUplinkToken(&md.GatewayIdentifiers, md.UplinkToken); ok {
+			path.GatewayIdentifiers = &md.GatewayIdentifiers
+			tail = append(tail, path)
 		} else {
 			path.GatewayIdentifiers = &md.GatewayIdentifiers
 			switch md.DownlinkPathConstraint {
@@ -807,7 +838,25 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 	attempts := make([]*attempt, 0, len(paths))
 	for _, path := range paths {
 		var target downlinkTarget
-		if path.GatewayIdentifiers != nil {
+		if path.relay != nil {
+			target = &relayDownlinkTarget{
+				ns: ns,
+				ids: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: req.ApplicationIdentifiers,
+					DeviceID:               path.relay.DeviceID,
+				},
+			}
+		} else if token, ok := parseRoamingUplinkToken(path.GatewayIdentifiers, path.GetUplinkToken()); ok {
+			if ns.interopClient == nil {
+				logger.WithField("target", "roaming").Warn("Interop client not configured for roaming downlink")
+				continue
+			}
+			target = &roamingDownlinkTarget{
+				client:   ns.interopClient,
+				senderID: ns.netID,
+				netID:    token.NetID,
+			}
+		} else if path.GatewayIdentifiers != nil {
 			logger := logger.WithFields(log.Fields(
 				"target", "gateway_server",
 				"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
@@ -1246,6 +1295,10 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		sets = ttnpb.AddFields(sets, "session.queued_application_downlinks")
 	}
 	recordDataDownlink(dev, genState, genDown.NeedsMACAnswer, down, ns.defaultMACSettings)
+	if ns.linkQualityWindow > 0 {
+		recordLinkQualityDownlink(dev, phy, down, ns.linkQualityWindow)
+		sets = ttnpb.AddFields(sets, "mac_state.link_quality_downlinks")
+	}
 	return downlinkAttemptResult{
 		SetPaths: ttnpb.AddFields(sets,
 			"mac_state.last_confirmed_downlink_at",
@@ -1446,6 +1499,10 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 	}
 
 	recordDataDownlink(dev, genState, genDown.NeedsMACAnswer, down, ns.defaultMACSettings)
+	if ns.linkQualityWindow > 0 {
+		recordLinkQualityDownlink(dev, phy, down, ns.linkQualityWindow)
+		sets = ttnpb.AddFields(sets, "mac_state.link_quality_downlinks")
+	}
 	if genState.ApplicationDownlink != nil {
 		sets = ttnpb.AddFields(sets, "session.queued_application_downlinks")
 	}
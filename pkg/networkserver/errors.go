@@ -20,6 +20,7 @@ import (
 
 var (
 	errABPJoinRequest             = errors.DefineInvalidArgument("abp_join_request", "received a join-request from ABP device")
+	errABPRejoinRequest           = errors.DefineInvalidArgument("abp_rejoin_request", "received a rejoin-request from ABP device")
 	errApplicationDownlinkTooLong = errors.DefineInvalidArgument("application_downlink_too_long", "application downlink payload length `{length}` exceeds maximum '{max}'")
 	errComputeMIC                 = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
 	errConfirmedDownlinkTooSoon   = errors.DefineUnavailable("confirmed_too_soon", "confirmed downlink is scheduled too soon")
@@ -28,12 +29,14 @@ var (
 	errDataRateNotFound           = errors.DefineNotFound("data_rate_not_found", "data rate not found")
 	errDataRateIndexNotFound      = errors.DefineNotFound("data_rate_index_not_found", "data rate with index `{index}` not found")
 	errDecodePayload              = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
+	errDecryptPayload             = errors.DefineInvalidArgument("decrypt_payload", "failed to decrypt payload")
 	errDeviceNotFound             = errors.DefineNotFound("device_not_found", "device not found")
 	errDuplicate                  = errors.DefineFailedPrecondition("duplicate", "uplink is a duplicate")
 	errEmptySession               = errors.DefineFailedPrecondition("empty_session", "session in empty")
 	errEncodeMAC                  = errors.DefineInternal("encode_mac", "failed to encode MAC commands")
 	errEncodePayload              = errors.Define("encode_payload", "failed to encode payload")
 	errEncryptMAC                 = errors.DefineInternal("encrypt_mac", "failed to encrypt MAC commands")
+	errEncryptPayload             = errors.DefineInternal("encrypt_payload", "failed to encrypt payload")
 	errExpiredDownlink            = errors.DefineFailedPrecondition("downlink_expired", "queued downlink is expired")
 	errFCntTooLow                 = errors.DefineInvalidArgument("f_cnt_too_low", "FCnt `{f_cnt}` is lower than minimum of `{min_f_cnt}`")
 	errInvalidAbsoluteTime        = errors.DefineInvalidArgument("absolute_time", "invalid absolute time set in application downlink")
@@ -45,13 +48,25 @@ var (
 	errInvalidFixedPaths          = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
 	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
 	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errLinkQualityDisabled        = errors.DefineFailedPrecondition("link_quality_disabled", "link quality stats are disabled")
 	errMACRequestNotFound         = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
 	errNoDevEUI                   = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
 	errNoJoinEUI                  = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoPath                     = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errNoPayload                  = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errOutdatedData               = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
+	errRJCountTooSmall            = errors.DefineInvalidArgument("rj_count_too_small", "RJcount0 `{rj_count}` is smaller than next expected RJcount0 `{next_rj_count}`")
+	errRFRegion                   = errors.DefineInvalidArgument("rf_region", "unknown RFRegion `{rf_region}`")
 	errRawPayloadTooShort         = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
+	errRejoinMACVersion           = errors.DefineInvalidArgument("rejoin_mac_version", "rejoin-request not supported by MAC version `{version}`")
+	errRejoinNetIDMismatch        = errors.DefineNotFound("rejoin_net_id_mismatch", "rejoin-request NetID `{net_id}` does not match")
+	errRelayDownlinkClass         = errors.DefineFailedPrecondition("relay_downlink_class", "only class A downlinks can be forwarded by relays")
+	errRelayDownlinkTooLong       = errors.DefineInvalidArgument("relay_downlink_too_long", "relay downlink payload length `{length}` exceeds maximum")
+	errRelayRxWindowsUnavailable  = errors.DefineFailedPrecondition("relay_rx_windows_unavailable", "relay RX windows are not available")
+	errRoamingBand                = errors.DefineNotFound("roaming_band", "band of roaming uplink not found")
+	errRoamingDownlinkFrequency   = errors.DefineInvalidArgument("roaming_downlink_frequency", "downlink frequency `{frequency}` is not in the frequency plan of the gateway")
+	errRoamingNotConfigured       = errors.DefineFailedPrecondition("roaming_not_configured", "passive roaming is not configured")
+	errRoamingUplinkToken         = errors.DefineInvalidArgument("roaming_uplink_token", "invalid roaming uplink token")
 	errSchedule                   = errors.Define("schedule", "all downlink scheduling attempts failed")
 	errUnknownChannel             = errors.Define("unknown_chanel", "channel is unknown")
 	errUnknownFNwkSIntKey         = errors.DefineNotFound("unknown_f_nwk_s_int_key", "FNwkSIntKey is unknown")
@@ -307,6 +307,12 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		return nil, errInvalidFieldValue.WithAttributes("field", "session.keys.session_key_id")
 	}
 
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mac_settings.adr_algorithm") && req.EndDevice.GetMACSettings().GetADRAlgorithm() != nil {
+		if _, ok := mac.GetADRAlgorithm(req.EndDevice.MACSettings.ADRAlgorithm.Value); !ok {
+			return nil, errInvalidFieldValue.WithAttributes("field", "mac_settings.adr_algorithm")
+		}
+	}
+
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "multicast") && ttnpb.HasAnyField(req.FieldMask.Paths, "supports_join") && req.EndDevice.Multicast && req.EndDevice.SupportsJoin {
 		return nil, errInvalidFieldValue.WithAttributes("field", "supports_join")
 	}
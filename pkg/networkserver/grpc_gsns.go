@@ -258,6 +258,9 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 				return nil, false, nil
 			}
 			dev.PendingMACState.CurrentParameters.Channels = chs
+			// The link quality observations span sessions.
+			dev.PendingMACState.LinkQualityUplinks = dev.MACState.GetLinkQualityUplinks()
+			dev.PendingMACState.LinkQualityDownlinks = dev.MACState.GetLinkQualityDownlinks()
 
 			dev.MACState = dev.PendingMACState
 			dev.PendingSession.StartedAt = up.ReceivedAt
@@ -298,6 +301,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 					log.FromContext(ctx).WithError(err).Warn("Failed to generate new MAC state")
 					return nil, false, nil
 				}
+				macState.LinkQualityUplinks = dev.MACState.LinkQualityUplinks
+				macState.LinkQualityDownlinks = dev.MACState.LinkQualityDownlinks
 
 				dev.MACState = macState
 				dev.Session.StartedAt = up.ReceivedAt
@@ -564,6 +569,20 @@ macLoop:
 			evs, err = mac.HandleBeaconFreqAns(ctx, dev, cmd.GetBeaconFreqAns())
 		case ttnpb.CID_DEVICE_MODE:
 			evs, err = mac.HandleDeviceModeInd(ctx, dev, cmd.GetDeviceModeInd())
+		case ttnpb.CID_RELAY_CONF:
+			evs, err = mac.HandleRelayConfAns(ctx, dev, cmd.GetRelayConfAns())
+		case ttnpb.CID_RELAY_END_DEVICE_CONF:
+			evs, err = mac.HandleRelayEndDeviceConfAns(ctx, dev, cmd.GetRelayEndDeviceConfAns())
+		case ttnpb.CID_RELAY_FILTER_LIST:
+			evs, err = mac.HandleRelayFilterListAns(ctx, dev, cmd.GetRelayFilterListAns())
+		case ttnpb.CID_RELAY_UPDATE_UPLINK_LIST:
+			evs, err = mac.HandleRelayUpdateUplinkListAns(ctx, dev, cmd.GetRelayUpdateUplinkListAns())
+		case ttnpb.CID_RELAY_CTRL_UPLINK_LIST:
+			evs, err = mac.HandleRelayCtrlUplinkListAns(ctx, dev, cmd.GetRelayCtrlUplinkListAns())
+		case ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT:
+			evs, err = mac.HandleRelayConfigureFwdLimitAns(ctx, dev, cmd.GetRelayConfigureFwdLimitAns())
+		case ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE:
+			evs, err = mac.HandleRelayNotifyNewEndDeviceReq(ctx, dev, cmd.GetRelayNotifyNewEndDeviceReq())
 		default:
 			logger.Warn("Unknown MAC command received, skip the rest")
 			break macLoop
@@ -587,7 +606,9 @@ macLoop:
 			logger.Debug("No RekeyInd received for LoRaWAN 1.1+ device")
 			return nil, false, nil
 		}
-		setPaths = append(setPaths, "ids.dev_addr")
+		// RJcount0 is reset on every new session.
+		dev.LastRJCount0, dev.NextRJCount0 = 0, 0
+		setPaths = append(setPaths, "ids.dev_addr", "last_rj_count_0", "next_rj_count_0")
 	} else if dev.PendingSession != nil || dev.PendingMACState != nil {
 		// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
 	}
@@ -810,6 +831,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	up.DeviceChannelIndex = uint32(matched.ChannelIndex)
 	up.Settings.DataRateIndex = matched.DataRateIndex
 	ctx = matched.Context
+	observeUplinkMatch(ctx, matched.Device.EndDeviceIdentifiers)
 
 	queuedEvents := []events.Event{
 		evtReceiveDataUplink.NewWithIdentifiersAndData(ctx, matched.Device.EndDeviceIdentifiers, up),
@@ -853,6 +875,8 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	var queuedApplicationUplinks []*ttnpb.ApplicationUp
 	defer func() { ns.enqueueApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
+	var linkQualityUplink *ttnpb.MACState_UplinkObservation
+	var lostUplinkCount uint32
 	stored, _, err := ns.devices.SetByID(ctx, matched.Device.ApplicationIdentifiers, matched.Device.DeviceID, handleDataUplinkGetPaths[:],
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if stored == nil {
@@ -890,6 +914,13 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				DeviceChannelIndex: up.DeviceChannelIndex,
 				ConsumedAirtime:    up.ConsumedAirtime,
 			}, recentUplinkCount)
+			if ns.linkQualityWindow > 0 {
+				paths = ttnpb.AddFields(paths,
+					"mac_state.link_quality_downlinks",
+					"mac_state.link_quality_uplinks",
+				)
+				linkQualityUplink, lostUplinkCount = recordLinkQualityUplink(ctx, stored, up, pld.FullFCnt, pld.FHDR.Ack, ns.linkQualityWindow)
+			}
 			useADR := mac.DeviceUseADR(stored, ns.defaultMACSettings, matched.phy)
 			if useADR {
 				if !pld.FHDR.ADR {
@@ -924,11 +955,19 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 	matched.Device = stored
 	ctx = matched.Context
+	if linkQualityUplink != nil {
+		registerLinkQualityUplink(ctx, stored.EndDeviceIdentifiers, linkQualityUplink, lostUplinkCount)
+	}
 
 	if err := ns.updateDataDownlinkTask(ctx, stored, time.Time{}); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after data uplink")
 	}
-	if !matched.IsRetransmission && pld.FPort != 0 {
+	switch {
+	case matched.IsRetransmission:
+	case pld.FPort == lorawan.RelayFPort && stored.MACState.CurrentParameters.Relay.GetServing() != nil:
+		// NOTE: Uplinks forwarded by relays are not sent to the Application Server of the relay.
+		ns.handleRelayedUplink(ctx, stored, matched.phy, up)
+	case pld.FPort != 0:
 		queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
 			EndDeviceIdentifiers: stored.EndDeviceIdentifiers,
 			CorrelationIDs:       up.CorrelationIDs,
@@ -1008,45 +1047,69 @@ func (ns *NetworkServer) deduplicationDone(ctx context.Context, up *ttnpb.Uplink
 	return timeAfter(timeUntil(up.ReceivedAt.Add(ns.deduplicationWindow(ctx))))
 }
 
-func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
+// joinRequestDeviceGetPaths are the paths of the device needed to handle a join-request or rejoin-request.
+var joinRequestDeviceGetPaths = [...]string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"session.dev_addr",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) error {
 	pld := up.Payload.GetJoinRequestPayload()
 	ctx = log.NewContextWithFields(ctx, log.Fields(
 		"dev_eui", pld.DevEUI,
 		"join_eui", pld.JoinEUI,
 	))
 
-	matched, matchedCtx, err := ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
-		[]string{
-			"frequency_plan_id",
-			"lorawan_phy_version",
-			"lorawan_version",
-			"mac_settings",
-			"session.dev_addr",
-			"supports_class_b",
-			"supports_class_c",
-			"supports_join",
-		},
-	)
+	matched, matchedCtx, err := ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI, joinRequestDeviceGetPaths[:])
 	if err != nil {
 		logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
 		return err
 	}
-	ctx = matchedCtx
+	return ns.handleMatchedJoinRequest(matchedCtx, up, matched, joinRequestHandling{
+		EvtReceive: evtReceiveJoinRequest,
+		EvtDrop:    evtDropJoinRequest,
+		EvtProcess: evtProcessJoinRequest,
+		ErrABP:     errABPJoinRequest,
+	})
+}
+
+// joinRequestHandling describes how handleMatchedJoinRequest handles a join-request or rejoin-request.
+type joinRequestHandling struct {
+	EvtReceive, EvtDrop, EvtProcess events.Builder
+	ErrABP                          error
+
+	// JoinEUI is the JoinEUI sent to the Join Server, if the uplink does not contain it.
+	JoinEUI *types.EUI64
+	// KeepMACParameters indicates whether the current and desired MAC parameters of the device are retained.
+	KeepMACParameters bool
+	// SetPaths are the paths set by Apply in the device registry.
+	SetPaths []string
+	// Apply is called on the stored device, if not nil.
+	Apply func(*ttnpb.EndDevice)
+}
+
+func (ns *NetworkServer) handleMatchedJoinRequest(ctx context.Context, up *ttnpb.UplinkMessage, matched *ttnpb.EndDevice, h joinRequestHandling) (err error) {
 	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, matched.EndDeviceIdentifiers))
 
 	queuedEvents := []events.Event{
-		evtReceiveJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, up),
+		h.EvtReceive.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, up),
 	}
 	defer func() {
 		if err != nil {
-			queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, err))
+			queuedEvents = append(queuedEvents, h.EvtDrop.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, err))
 		}
 		publishEvents(ctx, queuedEvents...)
 	}()
 
 	if !matched.SupportsJoin {
 		log.FromContext(ctx).Warn("ABP device sent a join-request, drop")
-		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, errABPJoinRequest))
+		queuedEvents = append(queuedEvents, h.EvtDrop.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, h.ErrABP))
 		return nil
 	}
 
@@ -1068,6 +1131,12 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		log.FromContext(ctx).WithError(err).Warn("Failed to reset device's MAC state")
 		return err
 	}
+	cfList := frequencyplans.CFList(*fp, matched.LoRaWANPHYVersion)
+	if h.KeepMACParameters && matched.MACState != nil {
+		macState.CurrentParameters = matched.MACState.CurrentParameters
+		macState.DesiredParameters = matched.MACState.DesiredParameters
+		cfList = nil
+	}
 
 	chIdx, err := searchUplinkChannel(up.Settings.Frequency, macState)
 	if err != nil {
@@ -1083,7 +1152,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		return err
 	}
 	if !ok {
-		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, errDuplicate))
+		queuedEvents = append(queuedEvents, h.EvtDrop.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, errDuplicate))
 		registerReceiveDuplicateUplink(ctx, up)
 		return nil
 	}
@@ -1098,7 +1167,6 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		log.FromContext(ctx).Error("Reusing the DevAddr used for current session")
 	}
 
-	cfList := frequencyplans.CFList(*fp, matched.LoRaWANPHYVersion)
 	dlSettings := ttnpb.DLSettings{
 		Rx1DROffset: macState.DesiredParameters.Rx1DataRateOffset,
 		Rx2DR:       macState.DesiredParameters.Rx2DataRateIndex,
@@ -1115,6 +1183,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		SelectedMACVersion: matched.LoRaWANVersion, // Assume NS version is always higher than the version of the device
 		DownlinkSettings:   dlSettings,
 		ConsumedAirtime:    up.ConsumedAirtime,
+		JoinEUI:            h.JoinEUI,
 	})
 	queuedEvents = append(queuedEvents, joinEvents...)
 	if err != nil {
@@ -1175,9 +1244,12 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 				return nil, nil, errOutdatedData.New()
 			}
 			stored.PendingMACState = macState
-			return stored, []string{
+			if h.Apply != nil {
+				h.Apply(stored)
+			}
+			return stored, append([]string{
 				"pending_mac_state",
-			}, nil
+			}, h.SetPaths...), nil
 		})
 	if err != nil {
 		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
@@ -1187,7 +1259,6 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	matched = stored
 	ctx = storedCtx
 
-	// TODO: Extract this into a utility function shared with mac.HandleRejoinRequest. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
 	downAt := up.ReceivedAt.Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - macState.DesiredParameters.Rx1Delay.Duration()/2 - nsScheduleWindow())
 	if earliestAt := timeNow().Add(nsScheduleWindow()); downAt.Before(earliestAt) {
 		downAt = earliestAt
@@ -1196,24 +1267,133 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	if err := ns.downlinkTasks.Add(ctx, stored.EndDeviceIdentifiers, downAt, true); err != nil {
 		logger.WithError(err).Error("Failed to add downlink task after join-request")
 	}
-	queuedEvents = append(queuedEvents, evtProcessJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, up))
+	queuedEvents = append(queuedEvents, h.EvtProcess.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, up))
 	registerProcessUplink(ctx, up)
 	return nil
 }
 
-var errRejoinRequest = errors.DefineUnimplemented("rejoin_request", "rejoin-request handling is not implemented")
+// rejoinRequestDeviceGetPaths are the paths of the device needed to match a rejoin-request of type 0 or 2.
+var rejoinRequestDeviceGetPaths = append(joinRequestDeviceGetPaths[:],
+	"ids.join_eui",
+	"last_rj_count_0",
+	"mac_state",
+	"next_rj_count_0",
+	"session.keys.s_nwk_s_int_key",
+)
+
+// matchRejoinRequest returns the device, which sent the rejoin-request of type 0 or 2.
+// Rejoin-requests of type 0 and 2 contain only the NetID and DevEUI, so the device is matched by the MIC,
+// which is computed with the SNwkSIntKey of the current session.
+func (ns *NetworkServer) matchRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (*ttnpb.EndDevice, context.Context, error) {
+	pld := up.Payload.GetRejoinRequestPayload()
+	if !pld.NetID.Equal(ns.netID) {
+		return nil, nil, errRejoinNetIDMismatch.WithAttributes("net_id", pld.NetID)
+	}
+	var (
+		matched    *ttnpb.EndDevice
+		matchedCtx context.Context
+	)
+	if err := ns.devices.RangeByDevEUI(ctx, pld.DevEUI, rejoinRequestDeviceGetPaths, func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+		if !dev.SupportsJoin || dev.JoinEUI == nil ||
+			dev.Session == nil || dev.Session.SNwkSIntKey == nil || dev.MACState == nil ||
+			dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			return true
+		}
+		sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.SNwkSIntKey, ns.KeyVault)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("kek_label", dev.Session.SNwkSIntKey.KEKLabel).Warn("Failed to unwrap SNwkSIntKey")
+			return true
+		}
+		mic, err := crypto.ComputeRejoinRequestMIC(sNwkSIntKey, up.RawPayload[:15])
+		if err != nil || !bytes.Equal(mic[:], up.RawPayload[15:]) {
+			return true
+		}
+		matched, matchedCtx = dev, ctx
+		return false
+	}); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to load devices from registry by DevEUI")
+		return nil, nil, err
+	}
+	if matched == nil {
+		return nil, nil, errDeviceNotFound.New()
+	}
+	return matched, matchedCtx, nil
+}
 
 func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) error {
-	// TODO: Implement https://github.com/TheThingsNetwork/lorawan-stack/issues/8
-	return errRejoinRequest.New()
+	pld := up.Payload.GetRejoinRequestPayload()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", pld.DevEUI,
+		"rejoin_cnt", pld.RejoinCnt,
+		"rejoin_type", pld.RejoinType,
+	))
+
+	switch pld.RejoinType {
+	case ttnpb.RejoinType_SESSION:
+		ctx = log.NewContextWithField(ctx, "join_eui", pld.JoinEUI)
+		matched, matchedCtx, err := ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI, joinRequestDeviceGetPaths[:])
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
+			return err
+		}
+		if matched.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			return errRejoinMACVersion.WithAttributes("version", matched.LoRaWANVersion)
+		}
+		// RJcount1 is verified by the Join Server.
+		return ns.handleMatchedJoinRequest(matchedCtx, up, matched, joinRequestHandling{
+			EvtReceive: evtReceiveRejoinRequest,
+			EvtDrop:    evtDropRejoinRequest,
+			EvtProcess: evtProcessRejoinRequest,
+			ErrABP:     errABPRejoinRequest,
+		})
+
+	case ttnpb.RejoinType_CONTEXT, ttnpb.RejoinType_KEYS:
+		matched, matchedCtx, err := ns.matchRejoinRequest(ctx, up)
+		if err != nil {
+			return err
+		}
+		ctx = log.NewContextWithField(matchedCtx, "join_eui", matched.JoinEUI)
+		if pld.RejoinCnt < matched.NextRJCount0 {
+			log.FromContext(ctx).WithField("next_rj_count_0", matched.NextRJCount0).Debug("RJcount0 replayed, drop")
+			return errRJCountTooSmall.WithAttributes(
+				"rj_count", pld.RejoinCnt,
+				"next_rj_count", matched.NextRJCount0,
+			)
+		}
+		return ns.handleMatchedJoinRequest(ctx, up, matched, joinRequestHandling{
+			EvtReceive:        evtReceiveRejoinRequest,
+			EvtDrop:           evtDropRejoinRequest,
+			EvtProcess:        evtProcessRejoinRequest,
+			ErrABP:            errABPRejoinRequest,
+			JoinEUI:           matched.JoinEUI,
+			KeepMACParameters: pld.RejoinType == ttnpb.RejoinType_KEYS,
+			SetPaths: []string{
+				"last_rj_count_0",
+				"next_rj_count_0",
+			},
+			Apply: func(stored *ttnpb.EndDevice) {
+				stored.LastRJCount0, stored.NextRJCount0 = pld.RejoinCnt, pld.RejoinCnt+1
+			},
+		})
+
+	default:
+		return errInvalidPayload.New()
+	}
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
-func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (_ *pbtypes.Empty, err error) {
+func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*pbtypes.Empty, error) {
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if err := ns.handleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// handleUplink handles the uplink message received from a Gateway Server or a roaming partner.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIDs,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
@@ -1222,7 +1402,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	up.ReceivedAt = timeNow().UTC()
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1231,7 +1411,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		}
 	}()
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes(
+		return errUnsupportedLoRaWANVersion.WithAttributes(
 			"version", up.Payload.Major,
 		)
 	}
@@ -1254,7 +1434,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"spreading_factor", dr.LoRa.GetSpreadingFactor(),
 		))
 	default:
-		return nil, errDataRateNotFound.New()
+		return errDataRateNotFound.New()
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1265,12 +1445,15 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		if netID, ok := ns.roamingNetID(up.Payload.GetMACPayload().DevAddr); ok {
+			return ns.forwardRoamingUplink(ctx, netID, up)
+		}
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
@@ -18,11 +18,20 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -90,3 +99,510 @@ func TestAppendRecentUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchRejoinRequest(t *testing.T) {
+	netID := types.NetID{0x42, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	sNwkSIntKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+
+	makeDevice := func(id string, key types.AES128Key, macVersion ttnpb.MACVersion) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				DeviceID:               id,
+				JoinEUI:                &joinEUI,
+				DevEUI:                 &devEUI,
+			},
+			SupportsJoin: true,
+			Session: &ttnpb.Session{
+				SessionKeys: ttnpb.SessionKeys{
+					SNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: &key,
+					},
+				},
+			},
+			MACState: &ttnpb.MACState{
+				LoRaWANVersion: macVersion,
+			},
+		}
+	}
+	makeUplink := func(netID types.NetID) *ttnpb.UplinkMessage {
+		raw := []byte{0xc0, 0x00}
+		raw = append(raw, netID[2], netID[1], netID[0])
+		raw = append(raw, devEUI[7], devEUI[6], devEUI[5], devEUI[4], devEUI[3], devEUI[2], devEUI[1], devEUI[0])
+		raw = append(raw, 0x01, 0x00)
+		mic := test.Must(crypto.ComputeRejoinRequestMIC(sNwkSIntKey, raw)).([4]byte)
+		return &ttnpb.UplinkMessage{
+			RawPayload: append(raw, mic[:]...),
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_REJOIN_REQUEST,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_RejoinRequestPayload{
+					RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+						RejoinType: ttnpb.RejoinType_CONTEXT,
+						NetID:      netID,
+						DevEUI:     devEUI,
+						RejoinCnt:  1,
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Devices        []*ttnpb.EndDevice
+		Uplink         *ttnpb.UplinkMessage
+		ExpectedDevice string
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "NetID mismatch",
+			Devices:        []*ttnpb.EndDevice{makeDevice("test-dev", sNwkSIntKey, ttnpb.MAC_V1_1)},
+			Uplink:         makeUplink(types.NetID{0x43, 0xff, 0xff}),
+			ErrorAssertion: errRejoinNetIDMismatch.Is,
+		},
+		{
+			Name:           "No device",
+			Uplink:         makeUplink(netID),
+			ErrorAssertion: errDeviceNotFound.Is,
+		},
+		{
+			Name: "MIC mismatch",
+			Devices: []*ttnpb.EndDevice{
+				makeDevice("test-dev", types.AES128Key{0x43}, ttnpb.MAC_V1_1),
+			},
+			Uplink:         makeUplink(netID),
+			ErrorAssertion: errDeviceNotFound.Is,
+		},
+		{
+			Name: "LoRaWAN 1.0.3",
+			Devices: []*ttnpb.EndDevice{
+				makeDevice("test-dev", sNwkSIntKey, ttnpb.MAC_V1_0_3),
+			},
+			Uplink:         makeUplink(netID),
+			ErrorAssertion: errDeviceNotFound.Is,
+		},
+		{
+			Name: "Match",
+			Devices: []*ttnpb.EndDevice{
+				makeDevice("test-dev-1", types.AES128Key{0x43}, ttnpb.MAC_V1_1),
+				makeDevice("test-dev-2", sNwkSIntKey, ttnpb.MAC_V1_1),
+			},
+			Uplink:         makeUplink(netID),
+			ExpectedDevice: "test-dev-2",
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				ns := &NetworkServer{
+					Component: &component.Component{},
+					netID:     netID,
+					devices: MockDeviceRegistry{
+						RangeByDevEUIFunc: func(ctx context.Context, eui types.EUI64, _ []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+							a.So(eui, should.Equal, devEUI)
+							for _, dev := range tc.Devices {
+								if !f(ctx, dev) {
+									break
+								}
+							}
+							return nil
+						},
+					},
+				}
+				dev, _, err := ns.matchRejoinRequest(ctx, tc.Uplink)
+				if tc.ErrorAssertion != nil {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					a.So(dev, should.BeNil)
+					return
+				}
+				if a.So(err, should.BeNil) && a.So(dev, should.NotBeNil) {
+					a.So(dev.DeviceID, should.Equal, tc.ExpectedDevice)
+				}
+			},
+		})
+	}
+}
+
+func TestHandleRejoinRequest(t *testing.T) {
+	netID := types.NetID{0x42, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	uplinkJoinEUI := types.EUI64{0x43, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+	newDevAddr := types.DevAddr{0x43, 0xff, 0xff, 0xff}
+	sNwkSIntKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	makeDevice := func(lastRJCount0, nextRJCount0 uint32) *ttnpb.EndDevice {
+		dev := &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				DeviceID:               "test-dev",
+				JoinEUI:                &joinEUI,
+				DevEUI:                 &devEUI,
+				DevAddr:                &devAddr,
+			},
+			FrequencyPlanID:   test.EUFrequencyPlanID,
+			LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			LoRaWANVersion:    ttnpb.MAC_V1_1,
+			SupportsJoin:      true,
+			LastRJCount0:      lastRJCount0,
+			NextRJCount0:      nextRJCount0,
+			Session: &ttnpb.Session{
+				DevAddr: devAddr,
+				SessionKeys: ttnpb.SessionKeys{
+					SNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: &sNwkSIntKey,
+					},
+				},
+			},
+		}
+		dev.MACState = test.Must(mac.NewState(dev, fps, ttnpb.MACSettings{})).(*ttnpb.MACState)
+		dev.MACState.CurrentParameters.Rx1Delay = ttnpb.RX_DELAY_5
+		dev.MACState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_6
+		return dev
+	}
+	makeUplink := func(rejoinType ttnpb.RejoinType, rejoinCnt uint32) *ttnpb.UplinkMessage {
+		raw := []byte{0xc0, byte(rejoinType)}
+		pld := &ttnpb.RejoinRequestPayload{
+			RejoinType: rejoinType,
+			DevEUI:     devEUI,
+			RejoinCnt:  rejoinCnt,
+		}
+		var mic [4]byte
+		switch rejoinType {
+		case ttnpb.RejoinType_SESSION:
+			pld.JoinEUI = uplinkJoinEUI
+			raw = append(raw, uplinkJoinEUI[7], uplinkJoinEUI[6], uplinkJoinEUI[5], uplinkJoinEUI[4], uplinkJoinEUI[3], uplinkJoinEUI[2], uplinkJoinEUI[1], uplinkJoinEUI[0])
+			raw = append(raw, devEUI[7], devEUI[6], devEUI[5], devEUI[4], devEUI[3], devEUI[2], devEUI[1], devEUI[0])
+			raw = append(raw, byte(rejoinCnt), byte(rejoinCnt>>8))
+			mic = [4]byte{0x42, 0x42, 0x42, 0x42}
+		default:
+			pld.NetID = netID
+			raw = append(raw, netID[2], netID[1], netID[0])
+			raw = append(raw, devEUI[7], devEUI[6], devEUI[5], devEUI[4], devEUI[3], devEUI[2], devEUI[1], devEUI[0])
+			raw = append(raw, byte(rejoinCnt), byte(rejoinCnt>>8))
+			mic = test.Must(crypto.ComputeRejoinRequestMIC(sNwkSIntKey, raw)).([4]byte)
+		}
+		return &ttnpb.UplinkMessage{
+			RawPayload: append(raw, mic[:]...),
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_REJOIN_REQUEST,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				MIC: mic[:],
+				Payload: &ttnpb.Message_RejoinRequestPayload{
+					RejoinRequestPayload: pld,
+				},
+			},
+			Settings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 12,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				Frequency: 868100000,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"},
+			}},
+			ReceivedAt: time.Now(),
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Device         *ttnpb.EndDevice
+		Uplink         *ttnpb.UplinkMessage
+		ErrorAssertion func(error) bool
+		Assertion      func(*testing.T, *assertions.Assertion, *ttnpb.JoinRequest, *ttnpb.EndDevice, []string)
+	}{
+		{
+			Name:   "Type 1",
+			Device: makeDevice(0, 0),
+			Uplink: makeUplink(ttnpb.RejoinType_SESSION, 0),
+			Assertion: func(t *testing.T, a *assertions.Assertion, req *ttnpb.JoinRequest, stored *ttnpb.EndDevice, paths []string) {
+				// The JoinEUI is contained in the rejoin-request of type 1.
+				a.So(req.JoinEUI, should.BeNil)
+				a.So(req.CFList, should.NotBeNil)
+				a.So(paths, should.NotContain, "last_rj_count_0")
+				a.So(stored.NextRJCount0, should.Equal, 0)
+			},
+		},
+		{
+			Name:           "Type 0/RJcount0 0 replayed",
+			Device:         makeDevice(0, 1),
+			Uplink:         makeUplink(ttnpb.RejoinType_CONTEXT, 0),
+			ErrorAssertion: errRJCountTooSmall.Is,
+		},
+		{
+			Name:           "Type 0/RJcount0 replayed",
+			Device:         makeDevice(5, 6),
+			Uplink:         makeUplink(ttnpb.RejoinType_CONTEXT, 5),
+			ErrorAssertion: errRJCountTooSmall.Is,
+		},
+		{
+			Name:           "Type 2/RJcount0 too small",
+			Device:         makeDevice(5, 6),
+			Uplink:         makeUplink(ttnpb.RejoinType_KEYS, 3),
+			ErrorAssertion: errRJCountTooSmall.Is,
+		},
+		{
+			Name:   "Type 0/first RJcount0 0",
+			Device: makeDevice(0, 0),
+			Uplink: makeUplink(ttnpb.RejoinType_CONTEXT, 0),
+			Assertion: func(t *testing.T, a *assertions.Assertion, req *ttnpb.JoinRequest, stored *ttnpb.EndDevice, paths []string) {
+				a.So(req.JoinEUI, should.Resemble, &joinEUI)
+				a.So(req.CFList, should.NotBeNil)
+				a.So(paths, should.Contain, "last_rj_count_0")
+				a.So(paths, should.Contain, "next_rj_count_0")
+				a.So(stored.LastRJCount0, should.Equal, 0)
+				a.So(stored.NextRJCount0, should.Equal, 1)
+				a.So(stored.PendingMACState.CurrentParameters.Rx1Delay, should.NotEqual, ttnpb.RX_DELAY_5)
+				a.So(stored.PendingMACState.DesiredParameters.Rx1Delay, should.NotEqual, ttnpb.RX_DELAY_6)
+			},
+		},
+		{
+			Name:   "Type 0/RJcount0 incremented",
+			Device: makeDevice(5, 6),
+			Uplink: makeUplink(ttnpb.RejoinType_CONTEXT, 6),
+			Assertion: func(t *testing.T, a *assertions.Assertion, req *ttnpb.JoinRequest, stored *ttnpb.EndDevice, paths []string) {
+				a.So(paths, should.Contain, "last_rj_count_0")
+				a.So(paths, should.Contain, "next_rj_count_0")
+				a.So(stored.LastRJCount0, should.Equal, 6)
+				a.So(stored.NextRJCount0, should.Equal, 7)
+			},
+		},
+		{
+			Name:   "Type 2",
+			Device: makeDevice(5, 6),
+			Uplink: makeUplink(ttnpb.RejoinType_KEYS, 6),
+			Assertion: func(t *testing.T, a *assertions.Assertion, req *ttnpb.JoinRequest, stored *ttnpb.EndDevice, paths []string) {
+				a.So(req.JoinEUI, should.Resemble, &joinEUI)
+				a.So(req.CFList, should.BeNil)
+				a.So(stored.LastRJCount0, should.Equal, 6)
+				a.So(stored.NextRJCount0, should.Equal, 7)
+				if a.So(stored.PendingMACState, should.NotBeNil) && a.So(stored.PendingMACState.QueuedJoinAccept, should.NotBeNil) {
+					a.So(stored.PendingMACState.QueuedJoinAccept.Request.CFList, should.BeNil)
+					a.So(stored.PendingMACState.CurrentParameters.Rx1Delay, should.Equal, ttnpb.RX_DELAY_5)
+					a.So(stored.PendingMACState.DesiredParameters.Rx1Delay, should.Equal, ttnpb.RX_DELAY_6)
+					a.So(stored.PendingMACState.QueuedJoinAccept.Request.RxDelay, should.Equal, ttnpb.RX_DELAY_6)
+				}
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				c := component.MustNew(
+					log.Noop,
+					&component.Config{},
+					component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+						return &test.MockCluster{
+							GetPeerFunc: func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (cluster.Peer, error) {
+								return nil, errPeerNotFound.New()
+							},
+							JoinFunc: test.ClusterJoinNilFunc,
+						}, nil
+					}),
+				)
+				c.FrequencyPlans = fps
+				componenttest.StartComponent(t, c)
+
+				var (
+					joinReq     *ttnpb.JoinRequest
+					stored      *ttnpb.EndDevice
+					storedPaths []string
+				)
+				ns := &NetworkServer{
+					Component:           c,
+					ctx:                 ctx,
+					netID:               netID,
+					newDevAddr:          func(context.Context, *ttnpb.EndDevice) types.DevAddr { return newDevAddr },
+					deduplicationWindow: makeWindowDurationFunc(0),
+					collectionWindow:    makeWindowDurationFunc(0),
+					devices: MockDeviceRegistry{
+						GetByEUIFunc: func(ctx context.Context, getJoinEUI, getDevEUI types.EUI64, _ []string) (*ttnpb.EndDevice, context.Context, error) {
+							a.So(getJoinEUI, should.Equal, uplinkJoinEUI)
+							a.So(getDevEUI, should.Equal, devEUI)
+							return CopyEndDevice(tc.Device), ctx, nil
+						},
+						RangeByDevEUIFunc: func(ctx context.Context, eui types.EUI64, _ []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+							a.So(eui, should.Equal, devEUI)
+							f(ctx, CopyEndDevice(tc.Device))
+							return nil
+						},
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, _ []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+							a.So(appID, should.Resemble, tc.Device.ApplicationIdentifiers)
+							a.So(devID, should.Equal, tc.Device.DeviceID)
+							dev, paths, err := f(ctx, CopyEndDevice(tc.Device))
+							if err != nil {
+								return nil, ctx, err
+							}
+							stored, storedPaths = dev, paths
+							return dev, ctx, nil
+						},
+					},
+					downlinkTasks: MockDownlinkTaskQueue{
+						AddFunc: func(context.Context, ttnpb.EndDeviceIdentifiers, time.Time, bool) error {
+							return nil
+						},
+					},
+					uplinkDeduplicator: MockUplinkDeduplicator{
+						DeduplicateUplinkFunc: func(context.Context, *ttnpb.UplinkMessage, time.Duration) (bool, error) {
+							return true, nil
+						},
+						AccumulatedMetadataFunc: func(_ context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
+							return up.RxMetadata, nil
+						},
+					},
+					interopClient: MockInteropClient{
+						HandleJoinRequestFunc: func(_ context.Context, reqNetID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+							a.So(reqNetID, should.Equal, netID)
+							joinReq = req
+							return &ttnpb.JoinResponse{
+								RawPayload: []byte{0x20, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+								SessionKeys: ttnpb.SessionKeys{
+									SessionKeyID: []byte("session-key-id"),
+								},
+							}, nil
+						},
+					},
+				}
+
+				err := ns.handleRejoinRequest(ctx, tc.Uplink)
+				if tc.ErrorAssertion != nil {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					a.So(joinReq, should.BeNil)
+					a.So(stored, should.BeNil)
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(joinReq, should.NotBeNil) || !a.So(stored, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(joinReq.DevAddr, should.Equal, newDevAddr)
+				a.So(joinReq.CFList, should.Resemble, stored.PendingMACState.QueuedJoinAccept.Request.CFList)
+				a.So(storedPaths, should.Contain, "pending_mac_state")
+				tc.Assertion(t, a, joinReq, stored, storedPaths)
+			},
+		})
+	}
+}
+
+func TestMatchAndHandleDataUplinkResetRJCount0(t *testing.T) {
+	a, ctx := test.New(t)
+
+	devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+	pendingDevAddr := types.DevAddr{0x43, 0xff, 0xff, 0xff}
+	key := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+
+	ns := &NetworkServer{
+		Component: c,
+		ctx:       ctx,
+	}
+
+	sessionKeys := ttnpb.SessionKeys{
+		FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &key},
+		SNwkSIntKey: &ttnpb.KeyEnvelope{Key: &key},
+		NwkSEncKey:  &ttnpb.KeyEnvelope{Key: &key},
+	}
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+			DevAddr:                &devAddr,
+		},
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+		SupportsJoin:      true,
+		LastRJCount0:      5,
+		NextRJCount0:      6,
+		Session: &ttnpb.Session{
+			DevAddr:     devAddr,
+			LastFCntUp:  42,
+			SessionKeys: sessionKeys,
+		},
+		PendingSession: &ttnpb.Session{
+			DevAddr:     pendingDevAddr,
+			SessionKeys: sessionKeys,
+		},
+	}
+	dev.MACState = test.Must(mac.NewState(dev, ns.FrequencyPlans, ttnpb.MACSettings{})).(*ttnpb.MACState)
+	dev.PendingMACState = test.Must(mac.NewState(dev, ns.FrequencyPlans, ttnpb.MACSettings{})).(*ttnpb.MACState)
+	dev.PendingMACState.PendingJoinRequest = &ttnpb.MACState_JoinRequest{
+		RxDelay: ttnpb.RX_DELAY_5,
+	}
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0xff, 0xff, 0xff, 0x43, 0x00, 0x00, 0x00, 0x01, 0x42, 0x42, 0x42, 0x42, 0x42},
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			MIC: []byte{0x42, 0x42, 0x42, 0x42},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: pendingDevAddr,
+					},
+					FPort:      1,
+					FRMPayload: []byte{0x42},
+				},
+			},
+		},
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 7,
+						Bandwidth:       125000,
+					},
+				},
+			},
+			Frequency: 868100000,
+		},
+		ReceivedAt: time.Now(),
+	}
+
+	res, ok, err := ns.matchAndHandleDataUplink(ctx, dev, up, true, cmacFMatchingResult{
+		IsPending:      true,
+		FNwkSIntKey:    key,
+		LoRaWANVersion: ttnpb.MAC_V1_0_3,
+	})
+	if !a.So(err, should.BeNil) || !a.So(ok, should.BeTrue) || !a.So(res, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(res.Device.Session.DevAddr, should.Equal, pendingDevAddr)
+	a.So(res.Device.LastRJCount0, should.Equal, 0)
+	a.So(res.Device.NextRJCount0, should.Equal, 0)
+	a.So(res.SetPaths, should.Contain, "last_rj_count_0")
+	a.So(res.SetPaths, should.Contain, "next_rj_count_0")
+}
@@ -0,0 +1,45 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+type nsEndDeviceLinkQuality struct {
+	*NetworkServer
+}
+
+// Get implements ttnpb.NsEndDeviceLinkQualityServer.
+func (srv *nsEndDeviceLinkQuality) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceLinkQuality, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if srv.linkQualityWindow == 0 {
+		return nil, errLinkQualityDisabled.New()
+	}
+	dev, ctx, err := srv.devices.GetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, []string{
+		"mac_state.link_quality_downlinks",
+		"mac_state.link_quality_uplinks",
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get device from registry")
+		return nil, err
+	}
+	return linkQualityFromMACState(dev.MACState), nil
+}
@@ -0,0 +1,240 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEndDeviceLinkQualityGet(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	ids := &ttnpb.EndDeviceIdentifiers{
+		DeviceID:               "test-dev-id",
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+	}
+	rightsContext := func(rs ...ttnpb.Right) func(context.Context) context.Context {
+		return func(ctx context.Context) context.Context {
+			return rights.NewContext(ctx, rights.Rights{
+				ApplicationRights: map[string]*ttnpb.Rights{
+					unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+						Rights: rs,
+					},
+				},
+			})
+		}
+	}
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		LinkQuality    LinkQualityConfig
+		GetByIDFunc    func(context.Context, ttnpb.ApplicationIdentifiers, string, []string) (*ttnpb.EndDevice, context.Context, error)
+		LinkQualityOut *ttnpb.EndDeviceLinkQuality
+		ErrorAssertion func(*testing.T, error) bool
+		GetByIDCalls   uint64
+	}{
+		{
+			Name:        "No device read rights",
+			ContextFunc: rightsContext(ttnpb.RIGHT_APPLICATION_INFO),
+			LinkQuality: LinkQualityConfig{Enable: true, Window: 4},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+				err := errors.New("GetByIDFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return nil, ctx, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+		{
+			Name:        "Disabled",
+			ContextFunc: rightsContext(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+				err := errors.New("GetByIDFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return nil, ctx, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrLinkQualityDisabled)
+			},
+		},
+		{
+			Name:        "Not found",
+			ContextFunc: rightsContext(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+			LinkQuality: LinkQualityConfig{Enable: true, Window: 4},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+				return nil, ctx, ErrDeviceNotFound.New()
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrDeviceNotFound)
+			},
+			GetByIDCalls: 1,
+		},
+		{
+			Name:        "No observations",
+			ContextFunc: rightsContext(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+			LinkQuality: LinkQualityConfig{Enable: true, Window: 4},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: *ids,
+				}, ctx, nil
+			},
+			LinkQualityOut: &ttnpb.EndDeviceLinkQuality{},
+			GetByIDCalls:   1,
+		},
+		{
+			Name:        "Observations",
+			ContextFunc: rightsContext(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+			LinkQuality: LinkQualityConfig{Enable: true, Window: 4},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+				a.So(devID, should.Equal, ids.DeviceID)
+				a.So(gets, should.HaveSameElementsDeep, []string{
+					"mac_state.link_quality_downlinks",
+					"mac_state.link_quality_uplinks",
+				})
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: *ids,
+					MACState: &ttnpb.MACState{
+						LinkQualityUplinks: []*ttnpb.MACState_UplinkObservation{
+							{
+								FCnt:          1,
+								ReceivedAt:    start,
+								DataRateIndex: ttnpb.DATA_RATE_5,
+								SNR:           5,
+								RSSI:          -40,
+								GatewayCount:  1,
+								Airtime:       50 * time.Millisecond,
+							},
+							{
+								FCnt:          3,
+								ReceivedAt:    start.Add(time.Minute),
+								DataRateIndex: ttnpb.DATA_RATE_3,
+								SNR:           7,
+								RSSI:          -60,
+								GatewayCount:  3,
+								Airtime:       100 * time.Millisecond,
+							},
+						},
+						LinkQualityDownlinks: []*ttnpb.MACState_DownlinkObservation{
+							{
+								TransmitAt:   start.Add(30 * time.Second),
+								Confirmed:    true,
+								Acknowledged: true,
+								Airtime:      40 * time.Millisecond,
+							},
+						},
+					},
+				}, ctx, nil
+			},
+			LinkQualityOut: &ttnpb.EndDeviceLinkQuality{
+				WindowStart:     TimePtr(start),
+				WindowEnd:       TimePtr(start.Add(time.Minute)),
+				UplinkCount:     2,
+				LostUplinkCount: 1,
+				PacketErrorRate: float32(1) / float32(3),
+				SNR: &ttnpb.EndDeviceLinkQuality_Distribution{
+					Min:               5,
+					Max:               7,
+					Mean:              6,
+					Median:            6,
+					StandardDeviation: 1,
+				},
+				RSSI: &ttnpb.EndDeviceLinkQuality_Distribution{
+					Min:               -60,
+					Max:               -40,
+					Mean:              -50,
+					Median:            -50,
+					StandardDeviation: 10,
+				},
+				GatewayCount: &ttnpb.EndDeviceLinkQuality_Distribution{
+					Min:               1,
+					Max:               3,
+					Mean:              2,
+					Median:            2,
+					StandardDeviation: 1,
+				},
+				DataRates: []*ttnpb.EndDeviceLinkQuality_DataRateCount{
+					{DataRateIndex: ttnpb.DATA_RATE_3, UplinkCount: 1},
+					{DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 1},
+				},
+				DownlinkCount:                1,
+				ConfirmedDownlinkCount:       1,
+				AcknowledgedDownlinkCount:    1,
+				ConfirmedDownlinkSuccessRate: 1,
+				UplinkAirtime:                150 * time.Millisecond,
+				DownlinkAirtime:              40 * time.Millisecond,
+			},
+			GetByIDCalls: 1,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var getByIDCalls uint64
+
+				ns, ctx, _, stop := StartTest(
+					ctx,
+					TestConfig{
+						NetworkServer: Config{
+							Devices: &MockDeviceRegistry{
+								GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+									atomic.AddUint64(&getByIDCalls, 1)
+									return tc.GetByIDFunc(ctx, appID, devID, gets)
+								},
+							},
+							LinkQuality: tc.LinkQuality,
+						},
+						TaskStarter: StartTaskExclude(
+							DownlinkProcessTaskName,
+						),
+					},
+				)
+				defer stop()
+
+				ns.AddContextFiller(tc.ContextFunc)
+				ns.AddContextFiller(func(ctx context.Context) context.Context {
+					return test.ContextWithTB(ctx, t)
+				})
+
+				req := deepcopy.Copy(ids).(*ttnpb.EndDeviceIdentifiers)
+				lq, err := ttnpb.NewNsEndDeviceLinkQualityClient(ns.LoopbackConn()).Get(ctx, req)
+				if tc.ErrorAssertion != nil && a.So(tc.ErrorAssertion(t, err), should.BeTrue) {
+					a.So(lq, should.BeNil)
+				} else if a.So(err, should.BeNil) {
+					a.So(lq, should.Resemble, tc.LinkQualityOut)
+				}
+				a.So(req, should.Resemble, ids)
+				a.So(getByIDCalls, should.Equal, tc.GetByIDCalls)
+			},
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+// roamingServiceProfile is the service profile of devices served through passive roaming.
+var roamingServiceProfile = &interop.ServiceProfile{
+	AddGWMetadata: true,
+	PRAllowed:     true,
+}
+
+// PRStartRequest handles the passive roaming start request of a Forwarding Network Server.
+// The Network Server acts as stateless Serving Network Server and Home Network Server of the device.
+// As the Serving Network Server is stateless, the answer has a zero lifetime and contains no session keys.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if types.NetID(in.ReceiverID) != srv.NS.netID {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	if !srv.NS.hasRoamingAgreement(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if in.ULMetaData.DevAddr == nil {
+		// Passive roaming of join-requests is not supported.
+		return nil, interop.ErrRoamingActivation.New()
+	}
+	if !srv.NS.isOwnDevAddr(types.DevAddr(*in.ULMetaData.DevAddr)) {
+		return nil, interop.ErrUnknownDevAddr.New()
+	}
+	up, err := roamingUplinkFromPRStartReq(in)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	var ids ttnpb.EndDeviceIdentifiers
+	ctx = newContextWithUplinkMatchObserver(ctx, func(matched ttnpb.EndDeviceIdentifiers) {
+		ids = matched
+	})
+	if err := srv.NS.handleUplink(ctx, up); err != nil {
+		if errors.Resemble(err, errDeviceNotFound) {
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		}
+		return nil, err
+	}
+	var lifetime uint32
+	ans := &interop.PRStartAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime:       &lifetime,
+		ServiceProfile: roamingServiceProfile,
+	}
+	if ids.DevEUI != nil && !ids.DevEUI.IsZero() {
+		ans.DevEUI = (*interop.EUI64)(ids.DevEUI)
+	}
+	return ans, nil
+}
+
+// PRStopRequest handles the passive roaming stop request of a Serving Network Server.
+// The Network Server acts as stateless Forwarding Network Server, so there is no roaming state to stop.
+func (srv interopServer) PRStopRequest(ctx context.Context, in *interop.PRStopReq) (*interop.PRStopAns, error) {
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if types.NetID(in.ReceiverID) != srv.NS.netID {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	if !srv.NS.hasRoamingAgreement(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	return &interop.PRStopAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// XmitDataRequest handles the data transmission request of a Serving Network Server.
+// The Network Server acts as Forwarding Network Server and transmits the downlink through its gateways.
+func (srv interopServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if types.NetID(in.ReceiverID) != srv.NS.netID {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	if !srv.NS.hasRoamingAgreement(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if len(in.PHYPayload) == 0 || in.DLMetaData == nil {
+		// Transmission of FRMPayload of the Application Server is not supported.
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	if err := srv.NS.transmitRoamingDownlink(ctx, types.NetID(in.SenderID), in.PHYPayload, in.DLMetaData); err != nil {
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
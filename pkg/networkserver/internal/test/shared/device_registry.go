@@ -121,6 +121,19 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 			return false
 		}
 
+		var ranged []*ttnpb.EndDevice
+		err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(_ context.Context, dev *ttnpb.EndDevice) bool {
+			ranged = append(ranged, dev)
+			return true
+		})
+		if !test.AllTrue(
+			a.So(err, should.BeNil) || a.So(errors.Stack(err), should.BeEmpty),
+			a.So(ranged, should.BeEmpty),
+		) {
+			t.Error("RangeByDevEUI assertion failed with empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.ApplicationIdentifiers, pb.DeviceID, ttnpb.EndDeviceFieldPathsTopLevel,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
@@ -188,6 +201,19 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 		}
 		ctx = storedCtx
 
+		var ranged []*ttnpb.EndDevice
+		err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(_ context.Context, dev *ttnpb.EndDevice) bool {
+			ranged = append(ranged, dev)
+			return true
+		})
+		if !test.AllTrue(
+			a.So(err, should.BeNil) || a.So(errors.Stack(err), should.BeEmpty),
+			a.So(ranged, should.Resemble, []*ttnpb.EndDevice{pb}),
+		) {
+			t.Error("RangeByDevEUI assertion failed with non-empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.ApplicationIdentifiers, pb.DeviceID, fields,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
@@ -0,0 +1,209 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// lostUplinkFrames returns the amount of uplink frames lost between the last of ups and the uplink with full frame counter fCnt.
+func lostUplinkFrames(ups []*ttnpb.MACState_UplinkObservation, fCnt uint32) uint32 {
+	if len(ups) == 0 {
+		return 0
+	}
+	last := ups[len(ups)-1].FCnt
+	if fCnt <= last {
+		return 0
+	}
+	return fCnt - last - 1
+}
+
+// recordLinkQualityUplink records up with full frame counter fCnt in the link quality observations of dev and returns
+// the observation and the amount of uplink frames lost before up.
+// If ack is set, the last confirmed downlink observation is marked as acknowledged.
+// At most window uplink observations are retained.
+func recordLinkQualityUplink(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage, fCnt uint32, ack bool, window int) (*ttnpb.MACState_UplinkObservation, uint32) {
+	gtwCount, maxSNR := RXMetadataStats(ctx, up.RxMetadata)
+	obs := &ttnpb.MACState_UplinkObservation{
+		FCnt:          fCnt,
+		ReceivedAt:    up.ReceivedAt,
+		DataRateIndex: up.Settings.DataRateIndex,
+		SNR:           maxSNR,
+		GatewayCount:  uint32(gtwCount),
+	}
+	for i, md := range up.RxMetadata {
+		if i == 0 || md.RSSI > obs.RSSI {
+			obs.RSSI = md.RSSI
+		}
+	}
+	if up.ConsumedAirtime != nil {
+		obs.Airtime = *up.ConsumedAirtime
+	}
+	lost := lostUplinkFrames(dev.MACState.LinkQualityUplinks, fCnt)
+
+	ups := append(dev.MACState.LinkQualityUplinks, obs)
+	if len(ups) > window {
+		ups = ups[len(ups)-window:]
+	}
+	dev.MACState.LinkQualityUplinks = ups
+
+	if ack {
+		downs := dev.MACState.LinkQualityDownlinks
+		for i := len(downs) - 1; i >= 0; i-- {
+			if downs[i].Confirmed {
+				downs[i].Acknowledged = true
+				break
+			}
+		}
+	}
+	return obs, lost
+}
+
+// recordLinkQualityDownlink records down in the link quality observations of dev.
+// The time-on-air is estimated assuming that down is transmitted in the first receive window it is scheduled in.
+// At most window downlink observations are retained.
+func recordLinkQualityDownlink(dev *ttnpb.EndDevice, phy *band.Band, down *scheduledDownlink, window int) {
+	obs := &ttnpb.MACState_DownlinkObservation{
+		TransmitAt: down.TransmitAt,
+		Confirmed:  down.Message.Payload.MType == ttnpb.MType_CONFIRMED_DOWN,
+	}
+	req := down.Message.GetRequest()
+	drIdx, freq := req.GetRx1DataRateIndex(), req.GetRx1Frequency()
+	if freq == 0 {
+		drIdx, freq = req.GetRx2DataRateIndex(), req.GetRx2Frequency()
+	}
+	if dr, ok := phy.DataRates[drIdx]; ok && freq > 0 {
+		if d, err := toa.Compute(len(down.Message.RawPayload), ttnpb.TxSettings{
+			DataRate:   dr.Rate,
+			Frequency:  freq,
+			CodingRate: phy.LoRaCodingRate,
+		}); err == nil {
+			obs.Airtime = d
+		}
+	}
+
+	downs := append(dev.MACState.LinkQualityDownlinks, obs)
+	if len(downs) > window {
+		downs = downs[len(downs)-window:]
+	}
+	dev.MACState.LinkQualityDownlinks = downs
+}
+
+func newLinkQualityDistribution(vs []float64) *ttnpb.EndDeviceLinkQuality_Distribution {
+	if len(vs) == 0 {
+		return nil
+	}
+	sort.Float64s(vs)
+	var sum float64
+	for _, v := range vs {
+		sum += v
+	}
+	mean := sum / float64(len(vs))
+	var sqDiffSum float64
+	for _, v := range vs {
+		sqDiffSum += (v - mean) * (v - mean)
+	}
+	median := vs[len(vs)/2]
+	if len(vs)%2 == 0 {
+		median = (vs[len(vs)/2-1] + median) / 2
+	}
+	return &ttnpb.EndDeviceLinkQuality_Distribution{
+		Min:               float32(vs[0]),
+		Max:               float32(vs[len(vs)-1]),
+		Mean:              float32(mean),
+		Median:            float32(median),
+		StandardDeviation: float32(math.Sqrt(sqDiffSum / float64(len(vs)))),
+	}
+}
+
+// linkQualityFromMACState computes the link quality stats from the link quality observations in macState.
+// The packet error rate is derived from gaps in the frame counter of consecutive uplink observations.
+// Confirmed downlinks are only counted if an uplink was received after they were transmitted.
+func linkQualityFromMACState(macState *ttnpb.MACState) *ttnpb.EndDeviceLinkQuality {
+	ups, downs := macState.GetLinkQualityUplinks(), macState.GetLinkQualityDownlinks()
+	lq := &ttnpb.EndDeviceLinkQuality{
+		UplinkCount:   uint32(len(ups)),
+		DownlinkCount: uint32(len(downs)),
+	}
+	if len(ups) > 0 {
+		lq.WindowStart = TimePtr(ups[0].ReceivedAt)
+		lq.WindowEnd = TimePtr(ups[len(ups)-1].ReceivedAt)
+	}
+
+	var frames uint32
+	snrs := make([]float64, 0, len(ups))
+	rssis := make([]float64, 0, len(ups))
+	gtwCounts := make([]float64, 0, len(ups))
+	drCounts := map[ttnpb.DataRateIndex]uint32{}
+	for i, up := range ups {
+		switch {
+		case i == 0:
+			frames++
+		case up.FCnt > ups[i-1].FCnt:
+			frames++
+			lq.LostUplinkCount += up.FCnt - ups[i-1].FCnt - 1
+		case up.FCnt < ups[i-1].FCnt:
+			// NOTE: The frame counter was reset.
+			frames++
+		}
+		snrs = append(snrs, float64(up.SNR))
+		rssis = append(rssis, float64(up.RSSI))
+		gtwCounts = append(gtwCounts, float64(up.GatewayCount))
+		drCounts[up.DataRateIndex]++
+		lq.UplinkAirtime += up.Airtime
+	}
+	if frames > 0 {
+		lq.PacketErrorRate = float32(lq.LostUplinkCount) / float32(frames+lq.LostUplinkCount)
+	}
+	lq.SNR = newLinkQualityDistribution(snrs)
+	lq.RSSI = newLinkQualityDistribution(rssis)
+	lq.GatewayCount = newLinkQualityDistribution(gtwCounts)
+	for drIdx, n := range drCounts {
+		lq.DataRates = append(lq.DataRates, &ttnpb.EndDeviceLinkQuality_DataRateCount{
+			DataRateIndex: drIdx,
+			UplinkCount:   n,
+		})
+	}
+	sort.Slice(lq.DataRates, func(i, j int) bool {
+		return lq.DataRates[i].DataRateIndex < lq.DataRates[j].DataRateIndex
+	})
+
+	var lastUplinkAt time.Time
+	if lq.WindowEnd != nil {
+		lastUplinkAt = *lq.WindowEnd
+	}
+	for _, down := range downs {
+		lq.DownlinkAirtime += down.Airtime
+		if !down.Confirmed || !down.TransmitAt.Before(lastUplinkAt) {
+			continue
+		}
+		lq.ConfirmedDownlinkCount++
+		if down.Acknowledged {
+			lq.AcknowledgedDownlinkCount++
+		}
+	}
+	if lq.ConfirmedDownlinkCount > 0 {
+		lq.ConfirmedDownlinkSuccessRate = float32(lq.AcknowledgedDownlinkCount) / float32(lq.ConfirmedDownlinkCount)
+	}
+	return lq
+}
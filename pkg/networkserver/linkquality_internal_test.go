@@ -0,0 +1,147 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestLinkQuality(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	phy := test.Must(band.GetByID(band.EU_863_870)).(band.Band)
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		MACState: &ttnpb.MACState{},
+	}
+	start := time.Unix(1000, 0).UTC()
+	airtime := 50 * time.Millisecond
+
+	recordUplink := func(fCnt uint32, at time.Duration, drIdx ttnpb.DataRateIndex, ack bool, mds ...*ttnpb.RxMetadata) uint32 {
+		_, lost := recordLinkQualityUplink(ctx, dev, &ttnpb.UplinkMessage{
+			Settings: ttnpb.TxSettings{
+				DataRateIndex: drIdx,
+			},
+			RxMetadata:      mds,
+			ReceivedAt:      start.Add(at),
+			ConsumedAirtime: &airtime,
+		}, fCnt, ack, 4)
+		return lost
+	}
+	recordDownlink := func(mType ttnpb.MType, at time.Duration) {
+		recordLinkQualityDownlink(dev, &phy, &scheduledDownlink{
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: make([]byte, 13),
+				Payload: &ttnpb.Message{
+					MHDR: ttnpb.MHDR{MType: mType},
+				},
+				Settings: &ttnpb.DownlinkMessage_Request{
+					Request: &ttnpb.TxRequest{
+						Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+						Rx1Frequency:     868100000,
+						Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+						Rx2Frequency:     869525000,
+					},
+				},
+			},
+			TransmitAt: start.Add(at),
+		}, 4)
+	}
+	gtw := func(id string, snr, rssi float32) *ttnpb.RxMetadata {
+		return &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: id},
+			SNR:                snr,
+			RSSI:               rssi,
+		}
+	}
+
+	a.So(recordUplink(10, 0, ttnpb.DATA_RATE_5, false, gtw("gtw-1", 5, -80), gtw("gtw-2", 7, -90)), should.Equal, 0)
+	recordDownlink(ttnpb.MType_CONFIRMED_DOWN, time.Second)
+	a.So(recordUplink(12, time.Minute, ttnpb.DATA_RATE_5, true, gtw("gtw-1", 3, -85)), should.Equal, 1)
+	// NOTE: Retransmissions are not counted as lost or received frames.
+	a.So(recordUplink(12, time.Minute+time.Second, ttnpb.DATA_RATE_5, false, gtw("gtw-2", -1, -100)), should.Equal, 0)
+	recordDownlink(ttnpb.MType_CONFIRMED_DOWN, time.Minute+2*time.Second)
+	recordDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 2*time.Minute+time.Second)
+	a.So(recordUplink(15, 2*time.Minute, ttnpb.DATA_RATE_3, false, gtw("gtw-1", -3, -110)), should.Equal, 2)
+
+	a.So(dev.MACState.LinkQualityUplinks, should.HaveLength, 4)
+	a.So(dev.MACState.LinkQualityDownlinks, should.HaveLength, 3)
+	a.So(dev.MACState.LinkQualityDownlinks[0].Acknowledged, should.BeTrue)
+	a.So(dev.MACState.LinkQualityDownlinks[1].Acknowledged, should.BeFalse)
+
+	downAirtime := test.Must(toa.Compute(13, ttnpb.TxSettings{
+		DataRate:   phy.DataRates[ttnpb.DATA_RATE_5].Rate,
+		Frequency:  868100000,
+		CodingRate: phy.LoRaCodingRate,
+	})).(time.Duration)
+	a.So(linkQualityFromMACState(dev.MACState), should.Resemble, &ttnpb.EndDeviceLinkQuality{
+		WindowStart:     TimePtr(start),
+		WindowEnd:       TimePtr(start.Add(2 * time.Minute)),
+		UplinkCount:     4,
+		LostUplinkCount: 3,
+		PacketErrorRate: 0.5,
+		SNR: &ttnpb.EndDeviceLinkQuality_Distribution{
+			Min:               -3,
+			Max:               7,
+			Mean:              1.5,
+			Median:            1,
+			StandardDeviation: float32(math.Sqrt(14.75)),
+		},
+		RSSI: &ttnpb.EndDeviceLinkQuality_Distribution{
+			Min:               -110,
+			Max:               -80,
+			Mean:              -93.75,
+			Median:            -92.5,
+			StandardDeviation: float32(math.Sqrt(142.1875)),
+		},
+		GatewayCount: &ttnpb.EndDeviceLinkQuality_Distribution{
+			Min:               1,
+			Max:               2,
+			Mean:              1.25,
+			Median:            1,
+			StandardDeviation: float32(math.Sqrt(0.1875)),
+		},
+		DataRates: []*ttnpb.EndDeviceLinkQuality_DataRateCount{
+			{DataRateIndex: ttnpb.DATA_RATE_3, UplinkCount: 1},
+			{DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 3},
+		},
+		DownlinkCount:                3,
+		ConfirmedDownlinkCount:       2,
+		AcknowledgedDownlinkCount:    1,
+		ConfirmedDownlinkSuccessRate: 0.5,
+		UplinkAirtime:                4 * airtime,
+		DownlinkAirtime:              3 * downAirtime,
+	})
+
+	a.So(recordUplink(16, 3*time.Minute, ttnpb.DATA_RATE_3, false, gtw("gtw-1", -3, -110)), should.Equal, 0)
+	a.So(dev.MACState.LinkQualityUplinks, should.HaveLength, 4)
+	a.So(dev.MACState.LinkQualityUplinks[0].FCnt, should.Equal, 12)
+
+	a.So(linkQualityFromMACState(nil), should.Resemble, &ttnpb.EndDeviceLinkQuality{})
+}
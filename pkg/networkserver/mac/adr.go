@@ -16,6 +16,8 @@ package mac
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
@@ -79,6 +81,13 @@ const (
 
 	// DefaultADRMargin is the default ADR margin used if not specified in MACSettings of the device or NS-wide defaults.
 	DefaultADRMargin = 15
+
+	// DefaultADRAlgorithm is the name of the ADR algorithm used if not specified in MACSettings of the device or NS-wide defaults.
+	DefaultADRAlgorithm = "default"
+	// ConservativeADRAlgorithm is the name of the conservative ADR algorithm, which is suitable for mobile devices.
+	ConservativeADRAlgorithm = "conservative"
+	// LossAwareADRAlgorithm is the name of the loss-aware ADR algorithm.
+	LossAwareADRAlgorithm = "loss_aware"
 )
 
 func deviceADRMargin(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) float32 {
@@ -144,9 +153,127 @@ func txPowerStep(phy *band.Band, from, to uint8) float32 {
 	return phy.TxOffset[from] - phy.TxOffset[to]
 }
 
+// ADRParameters are the parameters passed to an ADRAlgorithm.
+type ADRParameters struct {
+	// Margin is the margin in dB the algorithm should add in ADR requests.
+	Margin float32
+	// Values are the algorithm-specific parameters.
+	// Device-specific values take precedence over the Network Server defaults.
+	Values map[string]float32
+}
+
+// Value returns the value of the parameter identified by name, or fallback if it is not set.
+func (p ADRParameters) Value(name string, fallback float32) float32 {
+	if v, ok := p.Values[name]; ok {
+		return v
+	}
+	return fallback
+}
+
+// ADRAlgorithm computes the desired ADR parameters of a device based on its recent uplinks.
+// Implementations set the ADR parameters of dev.MACState.DesiredParameters.
+type ADRAlgorithm interface {
+	AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, params ADRParameters) error
+}
+
+// ADRAlgorithmFunc is a function that implements ADRAlgorithm.
+type ADRAlgorithmFunc func(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, params ADRParameters) error
+
+// AdaptDataRate implements ADRAlgorithm.
+func (f ADRAlgorithmFunc) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, params ADRParameters) error {
+	return f(ctx, dev, phy, params)
+}
+
+var (
+	adrAlgorithmsMu sync.RWMutex
+	adrAlgorithms   = map[string]ADRAlgorithm{
+		DefaultADRAlgorithm:      ADRAlgorithmFunc(adaptDataRateDefault),
+		ConservativeADRAlgorithm: ADRAlgorithmFunc(adaptDataRateConservative),
+		LossAwareADRAlgorithm:    ADRAlgorithmFunc(adaptDataRateLossAware),
+	}
+)
+
+// RegisterADRAlgorithm registers the ADR algorithm under the given name.
+// RegisterADRAlgorithm panics if an algorithm is already registered under the name.
+func RegisterADRAlgorithm(name string, alg ADRAlgorithm) {
+	adrAlgorithmsMu.Lock()
+	defer adrAlgorithmsMu.Unlock()
+	if _, ok := adrAlgorithms[name]; ok {
+		panic(errADRAlgorithmRegistered.WithAttributes("name", name))
+	}
+	adrAlgorithms[name] = alg
+}
+
+// GetADRAlgorithm returns the ADR algorithm registered under the given name.
+func GetADRAlgorithm(name string) (ADRAlgorithm, bool) {
+	adrAlgorithmsMu.RLock()
+	defer adrAlgorithmsMu.RUnlock()
+	alg, ok := adrAlgorithms[name]
+	return alg, ok
+}
+
+// ADRAlgorithms returns the sorted names of the registered ADR algorithms.
+func ADRAlgorithms() []string {
+	adrAlgorithmsMu.RLock()
+	defer adrAlgorithmsMu.RUnlock()
+	names := make([]string, 0, len(adrAlgorithms))
+	for name := range adrAlgorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func deviceADRAlgorithm(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) string {
+	if dev.MACSettings != nil && dev.MACSettings.ADRAlgorithm != nil {
+		return dev.MACSettings.ADRAlgorithm.Value
+	}
+	if defaults.ADRAlgorithm != nil {
+		return defaults.ADRAlgorithm.Value
+	}
+	return DefaultADRAlgorithm
+}
+
+func deviceADRParameters(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) ADRParameters {
+	vs := make(map[string]float32, len(defaults.ADRAlgorithmParameters)+len(dev.GetMACSettings().GetADRAlgorithmParameters()))
+	for k, v := range defaults.ADRAlgorithmParameters {
+		vs[k] = v
+	}
+	for k, v := range dev.GetMACSettings().GetADRAlgorithmParameters() {
+		vs[k] = v
+	}
+	return ADRParameters{
+		Margin: deviceADRMargin(dev, defaults),
+		Values: vs,
+	}
+}
+
+// AdaptDataRate computes the desired ADR parameters of the device using the ADR algorithm
+// configured in device's MAC settings, or the defaults if not configured.
 func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
+	name := deviceADRAlgorithm(dev, defaults)
+	alg, ok := GetADRAlgorithm(name)
+	if !ok {
+		return ErrUnknownADRAlgorithm.WithAttributes("name", name)
+	}
+	return alg.AdaptDataRate(ctx, dev, phy, deviceADRParameters(dev, defaults))
+}
+
+// adrState represents the data rate and TX output power index ranges, which may be used by ADR algorithms.
+type adrState struct {
+	uplinks                 []*ttnpb.UplinkMessage
+	minDataRateIndex        ttnpb.DataRateIndex
+	maxDataRateIndex        ttnpb.DataRateIndex
+	rejectedDataRateIndexes map[ttnpb.DataRateIndex]struct{}
+	minTxPowerIndex         uint8
+	maxTxPowerIndex         uint8
+	rejectedTxPowerIndexes  map[uint8]struct{}
+}
+
+// newADRState returns the adrState of dev. newADRState returns nil if ADR should be avoided.
+func newADRState(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band) (*adrState, error) {
 	if dev.MACState == nil || len(dev.MACState.RecentUplinks) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	adrUplinks := dev.MACState.RecentUplinks
@@ -162,7 +289,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 
 	minDataRateIndex, maxDataRateIndex, ok := channelDataRateRange(dev.MACState.CurrentParameters.Channels...)
 	if !ok {
-		return ErrCorruptedMACState
+		return nil, ErrCorruptedMACState
 	}
 	if maxDataRateIndex > phy.MaxADRDataRateIndex {
 		maxDataRateIndex = phy.MaxADRDataRateIndex
@@ -183,10 +310,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	}
 	if minDataRateIndex > maxDataRateIndex {
 		log.FromContext(ctx).Debug("Device has rejected all possible data rate values given the channels enabled, avoid ADR.")
-		return nil
-	}
-	if dev.MACState.CurrentParameters.ADRDataRateIndex > minDataRateIndex {
-		minDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+		return nil, nil
 	}
 
 	minTxPowerIndex := uint8(0)
@@ -207,33 +331,46 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	}
 	if minTxPowerIndex > maxTxPowerIndex {
 		log.FromContext(ctx).Debug("Device has rejected all possible TX output power index values, avoid ADR.")
-		return nil
-	}
-
-	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(adrUplinks...)...)
-	if !ok {
-		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR.")
-		return nil
-	}
-	up := LastUplink(adrUplinks...)
+		return nil, nil
+	}
+	return &adrState{
+		uplinks:                 adrUplinks,
+		minDataRateIndex:        minDataRateIndex,
+		maxDataRateIndex:        maxDataRateIndex,
+		rejectedDataRateIndexes: rejectedDataRateIndexes,
+		minTxPowerIndex:         minTxPowerIndex,
+		maxTxPowerIndex:         maxTxPowerIndex,
+		rejectedTxPowerIndexes:  rejectedTxPowerIndexes,
+	}, nil
+}
 
-	// The link margin indicates how much stronger the signal (SNR) is than the
-	// minimum (floor) that we need to demodulate the signal. We subtract a
-	// configurable margin, and an extra safety margin if we're afraid that we
-	// don't have enough data for our decision.
+// adrMargin returns the link margin given the SNR of the ADR uplinks.
+// The link margin indicates how much stronger the signal (SNR) is than the
+// minimum (floor) that we need to demodulate the signal. We subtract a
+// configurable margin, and an extra safety margin if we're afraid that we
+// don't have enough data for our decision.
+func adrMargin(ups []*ttnpb.UplinkMessage, snr float32, params ADRParameters) (float32, error) {
 	var margin float32
 	// NOTE: We currently assume that the uplink's SF and BW correspond to CurrentParameters.ADRDataRateIndex.
-	if dr := up.Settings.DataRate.GetLoRa(); dr != nil {
-		var ok bool
+	if dr := LastUplink(ups...).Settings.DataRate.GetLoRa(); dr != nil {
 		df, ok := demodulationFloor[dr.SpreadingFactor][dr.Bandwidth]
 		if !ok {
-			return ErrInvalidDataRate.New()
+			return 0, ErrInvalidDataRate.New()
 		}
-		margin = maxSNR - df - deviceADRMargin(dev, defaults)
+		margin = snr - df - params.Margin
 	}
-	if len(adrUplinks) < OptimalADRUplinkCount {
+	if len(ups) < OptimalADRUplinkCount {
 		margin -= safetyMargin
 	}
+	return margin, nil
+}
+
+// applyADRMargin increases the data rate and decreases the TX output power of the device as long as margin permits.
+func applyADRMargin(dev *ttnpb.EndDevice, phy *band.Band, st *adrState, margin float32) {
+	minDataRateIndex, maxDataRateIndex := st.minDataRateIndex, st.maxDataRateIndex
+	if dev.MACState.CurrentParameters.ADRDataRateIndex > minDataRateIndex {
+		minDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	}
 
 	// NOTE: Network Server may only increase the data rate index of the device.
 	// NOTE(2): TX output power is reset whenever data rate is increased.
@@ -244,11 +381,11 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		dev.MACState.DesiredParameters.ADRDataRateIndex = minDataRateIndex
 		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
 	}
-	if marginSteps := (margin - txPowerStep(phy, 0, minTxPowerIndex)) / drStep; marginSteps >= 0 && marginSteps < float32(maxDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex) {
+	if marginSteps := (margin - txPowerStep(phy, 0, st.minTxPowerIndex)) / drStep; marginSteps >= 0 && marginSteps < float32(maxDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex) {
 		maxDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex + ttnpb.DataRateIndex(marginSteps)
 	}
 	for drIdx := maxDataRateIndex; drIdx > minDataRateIndex; drIdx-- {
-		if _, ok := rejectedDataRateIndexes[drIdx]; ok {
+		if _, ok := st.rejectedDataRateIndexes[drIdx]; ok {
 			continue
 		}
 		margin -= float32(drIdx-dev.MACState.DesiredParameters.ADRDataRateIndex) * drStep
@@ -257,31 +394,40 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		break
 	}
 
-	if dev.MACState.DesiredParameters.ADRTxPowerIndex < uint32(minTxPowerIndex) {
-		margin -= txPowerStep(phy, uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex), minTxPowerIndex)
-		dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(minTxPowerIndex)
-	}
-	if dev.MACState.DesiredParameters.ADRTxPowerIndex > uint32(maxTxPowerIndex) {
-		margin += txPowerStep(phy, maxTxPowerIndex, uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex))
-		dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(maxTxPowerIndex)
-	}
+	clampADRTxPowerIndex(dev, phy, st, &margin)
 	// If we still have margin left, we decrease the TX output power (increase the index).
-	for txPowerIdx := maxTxPowerIndex; txPowerIdx > minTxPowerIndex; txPowerIdx-- {
+	for txPowerIdx := st.maxTxPowerIndex; txPowerIdx > st.minTxPowerIndex; txPowerIdx-- {
 		diff := txPowerStep(phy, uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex), txPowerIdx)
-		if _, ok := rejectedTxPowerIndexes[txPowerIdx]; ok || diff > margin {
+		if _, ok := st.rejectedTxPowerIndexes[txPowerIdx]; ok || diff > margin {
 			continue
 		}
 		margin -= diff
 		dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(txPowerIdx)
 		break
 	}
+}
+
+// clampADRTxPowerIndex ensures the desired TX output power index is within the range permitted by st and updates margin accordingly.
+func clampADRTxPowerIndex(dev *ttnpb.EndDevice, phy *band.Band, st *adrState, margin *float32) {
+	if dev.MACState.DesiredParameters.ADRTxPowerIndex < uint32(st.minTxPowerIndex) {
+		*margin -= txPowerStep(phy, uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex), st.minTxPowerIndex)
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(st.minTxPowerIndex)
+	}
+	if dev.MACState.DesiredParameters.ADRTxPowerIndex > uint32(st.maxTxPowerIndex) {
+		*margin += txPowerStep(phy, st.maxTxPowerIndex, uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex))
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(st.maxTxPowerIndex)
+	}
+}
 
+// adaptNbTrans sets the desired NbTrans of the device based on the loss rate of the ADR uplinks.
+// The loss rate is only considered if at least minUplinks ADR uplinks are available.
+func adaptNbTrans(dev *ttnpb.EndDevice, ups []*ttnpb.UplinkMessage, minUplinks int) {
 	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
 	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
 		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
 	}
-	if len(adrUplinks) >= OptimalADRUplinkCount/2 {
-		switch r := adrLossRate(adrUplinks...); {
+	if len(ups) >= minUplinks {
+		switch r := adrLossRate(ups...); {
 		case r < 0.05:
 			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
 		case r < 0.10:
@@ -291,5 +437,25 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
 		}
 	}
+}
+
+// adaptDataRateDefault is the default ADR algorithm. It uses the maximum SNR of the ADR uplinks
+// to determine the link margin, and only increases the data rate.
+func adaptDataRateDefault(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, params ADRParameters) error {
+	st, err := newADRState(ctx, dev, phy)
+	if err != nil || st == nil {
+		return err
+	}
+	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(st.uplinks...)...)
+	if !ok {
+		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR.")
+		return nil
+	}
+	margin, err := adrMargin(st.uplinks, maxSNR, params)
+	if err != nil {
+		return err
+	}
+	applyADRMargin(dev, phy, st, margin)
+	adaptNbTrans(dev, st.uplinks, OptimalADRUplinkCount/2)
 	return nil
 }
@@ -0,0 +1,105 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// ConservativeADRSNRPercentile is the name of the parameter of the conservative ADR algorithm,
+	// which configures the percentile of the SNR of ADR uplinks used to determine the link margin.
+	ConservativeADRSNRPercentile = "snr_percentile"
+
+	// DefaultConservativeADRSNRPercentile is the default value of ConservativeADRSNRPercentile.
+	DefaultConservativeADRSNRPercentile = 10
+)
+
+// snrPercentile returns the p-th percentile of the maximum SNR per uplink.
+func snrPercentile(p float32, ups ...*ttnpb.UplinkMessage) (float32, bool) {
+	snrs := make([]float32, 0, len(ups))
+	for _, up := range ups {
+		if snr, ok := maxSNRFromMetadata(up.RxMetadata...); ok {
+			snrs = append(snrs, snr)
+		}
+	}
+	if len(snrs) == 0 {
+		return 0, false
+	}
+	switch {
+	case p < 0:
+		p = 0
+	case p > 100:
+		p = 100
+	}
+	sort.Slice(snrs, func(i, j int) bool { return snrs[i] < snrs[j] })
+	return snrs[int(math.Round(float64(p)/100*float64(len(snrs)-1)))], true
+}
+
+// adaptDataRateConservative is an ADR algorithm suitable for mobile devices, for which the link quality varies.
+// It uses a low percentile of the SNR of the ADR uplinks to determine the link margin, and never increases
+// the data rate or decreases the TX output power of the device. If the link margin is negative, the TX output
+// power is increased first, after which the data rate is decreased.
+func adaptDataRateConservative(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, params ADRParameters) error {
+	st, err := newADRState(ctx, dev, phy)
+	if err != nil || st == nil {
+		return err
+	}
+	snr, ok := snrPercentile(params.Value(ConservativeADRSNRPercentile, DefaultConservativeADRSNRPercentile), st.uplinks...)
+	if !ok {
+		log.FromContext(ctx).Debug("Failed to determine SNR percentile, avoid ADR.")
+		return nil
+	}
+	margin, err := adrMargin(st.uplinks, snr, params)
+	if err != nil {
+		return err
+	}
+
+	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = dev.MACState.CurrentParameters.ADRTxPowerIndex
+	if dev.MACState.DesiredParameters.ADRDataRateIndex > st.maxDataRateIndex {
+		margin += float32(dev.MACState.DesiredParameters.ADRDataRateIndex-st.maxDataRateIndex) * drStep
+		dev.MACState.DesiredParameters.ADRDataRateIndex = st.maxDataRateIndex
+	}
+	if dev.MACState.DesiredParameters.ADRDataRateIndex < st.minDataRateIndex {
+		dev.MACState.DesiredParameters.ADRDataRateIndex = st.minDataRateIndex
+	}
+	clampADRTxPowerIndex(dev, phy, st, &margin)
+
+	// Increase the TX output power (decrease the index) until the link margin is no longer negative.
+	for txPowerIdx := int(dev.MACState.DesiredParameters.ADRTxPowerIndex) - 1; margin < 0 && txPowerIdx >= int(st.minTxPowerIndex); txPowerIdx-- {
+		if _, ok := st.rejectedTxPowerIndexes[uint8(txPowerIdx)]; ok {
+			continue
+		}
+		margin += txPowerStep(phy, uint8(txPowerIdx), uint8(dev.MACState.DesiredParameters.ADRTxPowerIndex))
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = uint32(txPowerIdx)
+	}
+	// Decrease the data rate until the link margin is no longer negative.
+	for drIdx := int(dev.MACState.DesiredParameters.ADRDataRateIndex) - 1; margin < 0 && drIdx >= int(st.minDataRateIndex); drIdx-- {
+		if _, ok := st.rejectedDataRateIndexes[ttnpb.DataRateIndex(drIdx)]; ok {
+			continue
+		}
+		margin += float32(dev.MACState.DesiredParameters.ADRDataRateIndex-ttnpb.DataRateIndex(drIdx)) * drStep
+		dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DataRateIndex(drIdx)
+	}
+	adaptNbTrans(dev, st.uplinks, OptimalADRUplinkCount/2)
+	return nil
+}
@@ -212,3 +212,70 @@ func TestADRLossRate(t *testing.T) {
 		})
 	}
 }
+
+func TestSNRPercentile(t *testing.T) {
+	ups := ADRMatrixToUplinks([]ADRMatrixRow{
+		{FCnt: 1, MaxSNR: -2, GtwDiversity: 1},
+		{FCnt: 2, MaxSNR: -4, GtwDiversity: 2},
+		{FCnt: 3, MaxSNR: 0, GtwDiversity: 1},
+		{FCnt: 4, MaxSNR: -3, GtwDiversity: 3},
+		{FCnt: 5, MaxSNR: -1, GtwDiversity: 1},
+	})
+	for _, tc := range []struct {
+		Percentile float32
+		Uplinks    []*ttnpb.UplinkMessage
+		SNR        float32
+		OK         bool
+	}{
+		{
+			Percentile: 10,
+		},
+		{
+			Percentile: -5,
+			Uplinks:    ups,
+			SNR:        -4,
+			OK:         true,
+		},
+		{
+			Percentile: 0,
+			Uplinks:    ups,
+			SNR:        -4,
+			OK:         true,
+		},
+		{
+			Percentile: 10,
+			Uplinks:    ups,
+			SNR:        -4,
+			OK:         true,
+		},
+		{
+			Percentile: 50,
+			Uplinks:    ups,
+			SNR:        -2,
+			OK:         true,
+		},
+		{
+			Percentile: 100,
+			Uplinks:    ups,
+			SNR:        0,
+			OK:         true,
+		},
+		{
+			Percentile: 200,
+			Uplinks:    ups,
+			SNR:        0,
+			OK:         true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     fmt.Sprintf("%d uplinks/percentile:%v", len(tc.Uplinks), tc.Percentile),
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				snr, ok := snrPercentile(tc.Percentile, tc.Uplinks...)
+				a.So(ok, should.Equal, tc.OK)
+				a.So(snr, should.Equal, tc.SNR)
+			},
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// LossAwareADRLossPenalty is the name of the parameter of the loss-aware ADR algorithm,
+	// which configures the margin in dB subtracted from the link margin at a loss rate of 100%.
+	LossAwareADRLossPenalty = "loss_penalty"
+	// LossAwareADRMinUplinks is the name of the parameter of the loss-aware ADR algorithm,
+	// which configures the minimum amount of ADR uplinks required to adapt NbTrans.
+	LossAwareADRMinUplinks = "min_uplinks"
+
+	// DefaultLossAwareADRLossPenalty is the default value of LossAwareADRLossPenalty.
+	DefaultLossAwareADRLossPenalty = 30
+	// DefaultLossAwareADRMinUplinks is the default value of LossAwareADRMinUplinks.
+	DefaultLossAwareADRMinUplinks = 4
+)
+
+// adaptDataRateLossAware is an ADR algorithm that takes the loss rate of the ADR uplinks into account.
+// The loss rate is derived from the gaps in the frame counters of the ADR uplinks and it reduces the
+// link margin proportionally. Since the Network Server only increases the data rate, a reduced margin
+// holds back data rate increases and TX output power decreases on lossy links, but it never lowers the
+// data rate of the device.
+// NbTrans is adapted based on the loss rate once a configurable amount of ADR uplinks is available.
+func adaptDataRateLossAware(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, params ADRParameters) error {
+	st, err := newADRState(ctx, dev, phy)
+	if err != nil || st == nil {
+		return err
+	}
+	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(st.uplinks...)...)
+	if !ok {
+		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR.")
+		return nil
+	}
+	margin, err := adrMargin(st.uplinks, maxSNR, params)
+	if err != nil {
+		return err
+	}
+	margin -= adrLossRate(st.uplinks...) * params.Value(LossAwareADRLossPenalty, DefaultLossAwareADRLossPenalty)
+	applyADRMargin(dev, phy, st, margin)
+
+	minUplinks := int(params.Value(LossAwareADRMinUplinks, DefaultLossAwareADRMinUplinks))
+	if minUplinks < 2 {
+		minUplinks = 2
+	}
+	adaptNbTrans(dev, st.uplinks, minUplinks)
+	return nil
+}
@@ -262,6 +262,165 @@ func TestAdaptDataRate(t *testing.T) {
 				dev.MACState.DesiredParameters.ADRNbTrans = 1
 			},
 		},
+		{
+			Name: "conservative/adapted example from Semtech paper",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_3,
+						ADRNbTrans:       1,
+						ADRTxPowerIndex:  1,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: &pbtypes.StringValue{
+						Value: ConservativeADRAlgorithm,
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "conservative/adapted example from Semtech paper/SNR percentile:90",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_3,
+						ADRNbTrans:       1,
+						ADRTxPowerIndex:  1,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: &pbtypes.StringValue{
+						Value: ConservativeADRAlgorithm,
+					},
+					ADRAlgorithmParameters: map[string]float32{
+						ConservativeADRSNRPercentile: 90,
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "loss-aware/adapted example from Semtech paper",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: &pbtypes.StringValue{
+						Value: LossAwareADRAlgorithm,
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "loss-aware/adapted example from Semtech paper/loss penalty:0",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: &pbtypes.StringValue{
+						Value: LossAwareADRAlgorithm,
+					},
+					ADRAlgorithmParameters: map[string]float32{
+						LossAwareADRLossPenalty: 0,
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_4
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "unknown algorithm",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: &pbtypes.StringValue{
+						Value: "unknown",
+					},
+				},
+			},
+			Error: ErrUnknownADRAlgorithm,
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -271,7 +430,11 @@ func TestAdaptDataRate(t *testing.T) {
 				dev := CopyEndDevice(tc.Device)
 				fp := FrequencyPlan(dev.FrequencyPlanID)
 				err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], ttnpb.MACSettings{})
-				if !a.So(err, should.Equal, tc.Error) {
+				if tc.Error != nil {
+					a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+					return
+				}
+				if !a.So(err, should.BeNil) {
 					t.Fatalf("ADR failed with: %s", err)
 				}
 				expected := CopyEndDevice(tc.Device)
@@ -0,0 +1,59 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func currentRelayParameters(dev *ttnpb.EndDevice) *ttnpb.RelayParameters {
+	if dev.MACState.CurrentParameters.Relay == nil {
+		dev.MACState.CurrentParameters.Relay = &ttnpb.RelayParameters{}
+	}
+	return dev.MACState.CurrentParameters.Relay
+}
+
+func currentServingRelayParameters(dev *ttnpb.EndDevice) *ttnpb.ServingRelayParameters {
+	relay := currentRelayParameters(dev)
+	if relay.Serving == nil {
+		relay.Serving = &ttnpb.ServingRelayParameters{}
+	}
+	return relay.Serving
+}
+
+// servingRelayParameters returns the desired and current parameters of dev acting as a relay.
+// ok is false if dev is not an enabled relay, or should not be one.
+func servingRelayParameters(dev *ttnpb.EndDevice) (desired, current *ttnpb.ServingRelayParameters, ok bool) {
+	if dev.GetMulticast() || dev.GetMACState() == nil {
+		return nil, nil, false
+	}
+	desired = dev.MACState.DesiredParameters.Relay.GetServing()
+	current = dev.MACState.CurrentParameters.Relay.GetServing()
+	return desired, current, desired != nil && current != nil
+}
+
+func relayJoinRequestFilterAt(filters []*ttnpb.RelayJoinRequestFilter, i int) *ttnpb.RelayJoinRequestFilter {
+	if i < len(filters) && filters[i] != nil {
+		return filters[i]
+	}
+	return &ttnpb.RelayJoinRequestFilter{}
+}
+
+func relayUplinkForwardingRuleAt(rules []*ttnpb.RelayUplinkForwardingRule, i int) *ttnpb.RelayUplinkForwardingRule {
+	if i < len(rules) && rules[i] != nil {
+		return rules[i]
+	}
+	return &ttnpb.RelayUplinkForwardingRule{}
+}
@@ -0,0 +1,131 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayConfRequest = defineEnqueueMACRequestEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfReq{}),
+	)()
+	EvtReceiveRelayConfAccept = defineReceiveMACAcceptEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfAns{}),
+	)()
+	EvtReceiveRelayConfReject = defineReceiveMACRejectEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfAns{}),
+	)()
+)
+
+func DeviceNeedsRelayConfReq(dev *ttnpb.EndDevice) bool {
+	if dev.GetMulticast() || dev.GetMACState() == nil {
+		return false
+	}
+	desired := dev.MACState.DesiredParameters.Relay.GetServing()
+	current := dev.MACState.CurrentParameters.Relay.GetServing()
+	switch {
+	case (desired == nil) != (current == nil):
+		return true
+	case desired == nil:
+		return false
+	}
+	return desired.CADPeriodicity != current.CADPeriodicity ||
+		desired.DefaultChannelIndex != current.DefaultChannelIndex ||
+		!desired.SecondChannel.Equal(current.SecondChannel)
+}
+
+func EnqueueRelayConfReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayConfReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_CONF, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 || nUp < 1 {
+			return nil, 0, nil, false
+		}
+		req := &ttnpb.MACCommand_RelayConfReq{}
+		if desired := dev.MACState.DesiredParameters.Relay.GetServing(); desired != nil {
+			req.Enable = true
+			req.CADPeriodicity = desired.CADPeriodicity
+			req.DefaultChannelIndex = desired.DefaultChannelIndex
+			req.SecondChannel = desired.SecondChannel
+		}
+		log.FromContext(ctx).WithFields(log.Fields(
+			"enable", req.Enable,
+			"cad_periodicity", req.CADPeriodicity,
+			"default_channel_index", req.DefaultChannelIndex,
+		)).Debug("Enqueued RelayConfReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			1,
+			events.Builders{
+				EvtEnqueueRelayConfRequest.With(events.WithData(req)),
+			},
+			true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+func HandleRelayConfAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayConfAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	accepted := pld.SecondChannelFrequencyAck &&
+		pld.SecondChannelAckOffsetAck &&
+		pld.SecondChannelDataRateIndexAck &&
+		pld.SecondChannelIndexAck &&
+		pld.DefaultChannelIndexAck &&
+		pld.CADPeriodicityAck
+
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_CONF, func(cmd *ttnpb.MACCommand) error {
+		if !accepted {
+			return nil
+		}
+
+		req := cmd.GetRelayConfReq()
+		if !req.Enable {
+			currentRelayParameters(dev).Serving = nil
+			return nil
+		}
+		serving := currentServingRelayParameters(dev)
+		serving.CADPeriodicity = req.CADPeriodicity
+		serving.DefaultChannelIndex = req.DefaultChannelIndex
+		serving.SecondChannel = req.SecondChannel
+		return nil
+	}, dev.MACState.PendingRequests...)
+	ev := EvtReceiveRelayConfAccept
+	if !accepted {
+		ev = EvtReceiveRelayConfReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
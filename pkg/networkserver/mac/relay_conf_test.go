@@ -0,0 +1,312 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayConfReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "current(nil),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+		},
+		{
+			Name: "current(nil),desired(cad-periodicity:1s)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(cad-periodicity:1s),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(cad-periodicity:1s),desired(cad-periodicity:1s)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(cad-periodicity:1s),desired(cad-periodicity:500ms)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								CADPeriodicity: ttnpb.RELAY_CAD_PERIODICITY_500_MILLISECONDS,
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(second-channel:nil),desired(second-channel:869525000)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								SecondChannel: &ttnpb.RelaySecondChannel{
+									Frequency: 869525000,
+								},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayConfReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestHandleRelayConfAns(t *testing.T) {
+	allAck := &ttnpb.MACCommand_RelayConfAns{
+		SecondChannelFrequencyAck:     true,
+		SecondChannelAckOffsetAck:     true,
+		SecondChannelDataRateIndexAck: true,
+		SecondChannelIndexAck:         true,
+		DefaultChannelIndexAck:        true,
+		CADPeriodicityAck:             true,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayConfAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayConfAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "enable/all ack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{
+							Enable:              true,
+							CADPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_250_MILLISECONDS,
+							DefaultChannelIndex: 1,
+							SecondChannel: &ttnpb.RelaySecondChannel{
+								DataRateIndex: ttnpb.DATA_RATE_3,
+								Frequency:     869525000,
+							},
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								CADPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_250_MILLISECONDS,
+								DefaultChannelIndex: 1,
+								SecondChannel: &ttnpb.RelaySecondChannel{
+									DataRateIndex: ttnpb.DATA_RATE_3,
+									Frequency:     869525000,
+								},
+							},
+						},
+					},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayConfAccept.With(events.WithData(allAck)),
+			},
+		},
+		{
+			Name: "enable/second channel frequency nack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{
+							Enable: true,
+							SecondChannel: &ttnpb.RelaySecondChannel{
+								Frequency: 869525000,
+							},
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayConfAns{
+				SecondChannelAckOffsetAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				SecondChannelIndexAck:         true,
+				DefaultChannelIndexAck:        true,
+				CADPeriodicityAck:             true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayConfReject.With(events.WithData(&ttnpb.MACCommand_RelayConfAns{
+					SecondChannelAckOffsetAck:     true,
+					SecondChannelDataRateIndexAck: true,
+					SecondChannelIndexAck:         true,
+					DefaultChannelIndexAck:        true,
+					CADPeriodicityAck:             true,
+				})),
+			},
+		},
+		{
+			Name: "disable/all ack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								DefaultChannelIndex: 1,
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayConfAccept.With(events.WithData(allAck)),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayConfAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
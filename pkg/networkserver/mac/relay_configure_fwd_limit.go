@@ -0,0 +1,91 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayConfigureFwdLimitRequest = defineEnqueueMACRequestEvent(
+		"relay_configure_fwd_limit", "relay configure forwarding limit",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfigureFwdLimitReq{}),
+	)()
+	EvtReceiveRelayConfigureFwdLimitAnswer = defineReceiveMACAnswerEvent(
+		"relay_configure_fwd_limit", "relay configure forwarding limit",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfigureFwdLimitAns{}),
+	)()
+)
+
+func DeviceNeedsRelayConfigureFwdLimitReq(dev *ttnpb.EndDevice) bool {
+	desired, current, ok := servingRelayParameters(dev)
+	return ok && desired.Limits != nil && !desired.Limits.Equal(current.Limits)
+}
+
+func EnqueueRelayConfigureFwdLimitReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayConfigureFwdLimitReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 || nUp < 1 {
+			return nil, 0, nil, false
+		}
+		desired := dev.MACState.DesiredParameters.Relay.GetServing().GetLimits()
+		req := &ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+			ResetLimitCounter:  desired.ResetLimitCounter,
+			JoinRequestLimits:  desired.JoinRequestLimits,
+			NotifyLimits:       desired.NotifyLimits,
+			GlobalUplinkLimits: desired.GlobalUplinkLimits,
+			OverallLimits:      desired.OverallLimits,
+		}
+		log.FromContext(ctx).WithField(
+			"reset_limit_counter", req.ResetLimitCounter,
+		).Debug("Enqueued RelayConfigureFwdLimitReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			1,
+			events.Builders{
+				EvtEnqueueRelayConfigureFwdLimitRequest.With(events.WithData(req)),
+			},
+			true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+func HandleRelayConfigureFwdLimitAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayConfigureFwdLimitAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, func(cmd *ttnpb.MACCommand) error {
+		currentServingRelayParameters(dev).Limits = cmd.GetRelayConfigureFwdLimitReq()
+		return nil
+	}, dev.MACState.PendingRequests...)
+	return events.Builders{
+		EvtReceiveRelayConfigureFwdLimitAnswer.With(events.WithData(pld)),
+	}, err
+}
@@ -0,0 +1,312 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayConfigureFwdLimitReq(t *testing.T) {
+	limits := &ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+		ResetLimitCounter: ttnpb.RELAY_RESET_LIMIT_COUNTER_MAX_VALUE,
+		JoinRequestLimits: &ttnpb.RelayForwardLimits{
+			BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_2,
+			ReloadRate: 10,
+		},
+	}
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "not serving",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								Limits: limits,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(limits)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								Limits: limits,
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(limits),desired(limits)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								Limits: limits,
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								Limits: limits,
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayConfigureFwdLimitReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayConfigureFwdLimitReq(t *testing.T) {
+	limits := &ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+		ResetLimitCounter: ttnpb.RELAY_RESET_LIMIT_COUNTER_MAX_VALUE,
+		JoinRequestLimits: &ttnpb.RelayForwardLimits{
+			BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_2,
+			ReloadRate: 10,
+		},
+		OverallLimits: &ttnpb.RelayForwardLimits{
+			BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_12,
+			ReloadRate: 60,
+		},
+	}
+	makeDevice := func(pending ...*ttnpb.MACCommand) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{},
+					},
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							Limits: limits,
+						},
+					},
+				},
+				PendingRequests: pending,
+			},
+		}
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name:              "payload fits",
+			InputDevice:       makeDevice(),
+			ExpectedDevice:    makeDevice(limits.MACCommand()),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 45,
+				MaxUpLen:   50,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayConfigureFwdLimitRequest.With(events.WithData(limits)),
+				},
+			},
+		},
+		{
+			Name:              "downlink does not fit",
+			InputDevice:       makeDevice(),
+			ExpectedDevice:    makeDevice(),
+			MaxDownlinkLength: 5,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 5,
+				MaxUpLen:   51,
+			},
+		},
+		{
+			Name:              "uplink does not fit",
+			InputDevice:       makeDevice(),
+			ExpectedDevice:    makeDevice(),
+			MaxDownlinkLength: 51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayConfigureFwdLimitReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayConfigureFwdLimitAns(t *testing.T) {
+	limits := &ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+		ResetLimitCounter: ttnpb.RELAY_RESET_LIMIT_COUNTER_RELOAD_RATE,
+		NotifyLimits: &ttnpb.RelayForwardLimits{
+			BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+			ReloadRate: 20,
+		},
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayConfigureFwdLimitAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Payload: &ttnpb.MACCommand_RelayConfigureFwdLimitAns{},
+			Events: events.Builders{
+				EvtReceiveRelayConfigureFwdLimitAnswer.With(events.WithData(&ttnpb.MACCommand_RelayConfigureFwdLimitAns{})),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "limits",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						limits.MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								Limits: limits,
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayConfigureFwdLimitAns{},
+			Events: events.Builders{
+				EvtReceiveRelayConfigureFwdLimitAnswer.With(events.WithData(&ttnpb.MACCommand_RelayConfigureFwdLimitAns{})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayConfigureFwdLimitAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
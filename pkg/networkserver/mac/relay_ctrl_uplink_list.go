@@ -0,0 +1,136 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayCtrlUplinkListRequest = defineEnqueueMACRequestEvent(
+		"relay_ctrl_uplink_list", "relay control uplink list",
+		events.WithDataType(&ttnpb.MACCommand_RelayCtrlUplinkListReq{}),
+	)()
+	EvtReceiveRelayCtrlUplinkListAccept = defineReceiveMACAcceptEvent(
+		"relay_ctrl_uplink_list", "relay control uplink list",
+		events.WithDataType(&ttnpb.MACCommand_RelayCtrlUplinkListAns{}),
+	)()
+	EvtReceiveRelayCtrlUplinkListReject = defineReceiveMACRejectEvent(
+		"relay_ctrl_uplink_list", "relay control uplink list",
+		events.WithDataType(&ttnpb.MACCommand_RelayCtrlUplinkListAns{}),
+	)()
+)
+
+func DeviceNeedsRelayCtrlUplinkListReqAtIndex(dev *ttnpb.EndDevice, i int) bool {
+	desired, current, ok := servingRelayParameters(dev)
+	if !ok {
+		return false
+	}
+	return relayUplinkForwardingRuleAt(desired.UplinkForwardingRules, i).DeviceID == "" &&
+		relayUplinkForwardingRuleAt(current.UplinkForwardingRules, i).DeviceID != ""
+}
+
+func DeviceNeedsRelayCtrlUplinkListReq(dev *ttnpb.EndDevice) bool {
+	_, current, ok := servingRelayParameters(dev)
+	if !ok {
+		return false
+	}
+	for i := range current.UplinkForwardingRules {
+		if DeviceNeedsRelayCtrlUplinkListReqAtIndex(dev, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func EnqueueRelayCtrlUplinkListReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayCtrlUplinkListReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		_, current, _ := servingRelayParameters(dev)
+
+		var cmds []*ttnpb.MACCommand
+		var evs events.Builders
+		for i := range current.UplinkForwardingRules {
+			if !DeviceNeedsRelayCtrlUplinkListReqAtIndex(dev, i) {
+				continue
+			}
+			if nDown < 1 || nUp < 1 {
+				return cmds, uint16(len(cmds)), evs, false
+			}
+			nDown--
+			nUp--
+			req := &ttnpb.MACCommand_RelayCtrlUplinkListReq{
+				RuleIndex: uint32(i),
+				Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+			}
+			log.FromContext(ctx).WithFields(log.Fields(
+				"rule_index", req.RuleIndex,
+				"action", req.Action,
+				"served_device_id", current.UplinkForwardingRules[i].DeviceID,
+			)).Debug("Enqueued RelayCtrlUplinkListReq")
+			cmds = append(cmds, req.MACCommand())
+			evs = append(evs, EvtEnqueueRelayCtrlUplinkListRequest.With(events.WithData(req)))
+		}
+		return cmds, uint16(len(cmds)), evs, true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+func HandleRelayCtrlUplinkListAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayCtrlUplinkListAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, func(cmd *ttnpb.MACCommand) error {
+		if !pld.RuleIndexAck {
+			return nil
+		}
+
+		req := cmd.GetRelayCtrlUplinkListReq()
+		serving := currentServingRelayParameters(dev)
+		if int(req.RuleIndex) >= len(serving.UplinkForwardingRules) {
+			return nil
+		}
+		switch req.Action {
+		case ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT:
+			if rule := serving.UplinkForwardingRules[req.RuleIndex]; rule != nil {
+				rule.LastWFCnt = pld.WFCnt
+			}
+		case ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE:
+			serving.UplinkForwardingRules[req.RuleIndex] = &ttnpb.RelayUplinkForwardingRule{}
+		}
+		return nil
+	}, dev.MACState.PendingRequests...)
+	ev := EvtReceiveRelayCtrlUplinkListAccept
+	if !pld.RuleIndexAck {
+		ev = EvtReceiveRelayCtrlUplinkListReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
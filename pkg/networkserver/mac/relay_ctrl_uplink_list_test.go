@@ -0,0 +1,282 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEnqueueRelayCtrlUplinkListReq(t *testing.T) {
+	makeDevice := func(current, desired []*ttnpb.RelayUplinkForwardingRule, pending ...*ttnpb.MACCommand) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: current,
+						},
+					},
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: desired,
+						},
+					},
+				},
+				PendingRequests: pending,
+			},
+		}
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "no MAC state",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   51,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "rule still desired",
+			InputDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1"}},
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-2"}},
+			),
+			ExpectedDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1"}},
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-2"}},
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   51,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "remove rules",
+			InputDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1"}, {}, {DeviceID: "served-2"}},
+				[]*ttnpb.RelayUplinkForwardingRule{{}},
+			),
+			ExpectedDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1"}, {}, {DeviceID: "served-2"}},
+				[]*ttnpb.RelayUplinkForwardingRule{{}},
+				(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					Action: ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+				}).MACCommand(),
+				(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					RuleIndex: 2,
+					Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 47,
+				MaxUpLen:   39,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayCtrlUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+						Action: ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+					})),
+					EvtEnqueueRelayCtrlUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+						RuleIndex: 2,
+						Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+					})),
+				},
+			},
+		},
+		{
+			Name: "uplink does not fit",
+			InputDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1"}},
+				nil,
+			),
+			ExpectedDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1"}},
+				nil,
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   5,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   5,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayCtrlUplinkListReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayCtrlUplinkListAns(t *testing.T) {
+	makeDevice := func(rules []*ttnpb.RelayUplinkForwardingRule, pending ...*ttnpb.MACCommand) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: rules,
+						},
+					},
+				},
+				PendingRequests: pending,
+			},
+		}
+	}
+	ack := &ttnpb.MACCommand_RelayCtrlUplinkListAns{
+		RuleIndexAck: true,
+		WFCnt:        42,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayCtrlUplinkListAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Payload: ack,
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData(ack)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "read WFCnt",
+			Device: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{}, {DeviceID: "served-1", LastWFCnt: 2}},
+				(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					RuleIndex: 1,
+					Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT,
+				}).MACCommand(),
+			),
+			Expected: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{}, {DeviceID: "served-1", LastWFCnt: 42}},
+				[]*ttnpb.MACCommand{}...,
+			),
+			Payload: ack,
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData(ack)),
+			},
+		},
+		{
+			Name: "remove",
+			Device: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1", LastWFCnt: 2}},
+				(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					Action: ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+				}).MACCommand(),
+			),
+			Expected: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{}},
+				[]*ttnpb.MACCommand{}...,
+			),
+			Payload: ack,
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData(ack)),
+			},
+		},
+		{
+			Name: "remove/rule index nack",
+			Device: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1", LastWFCnt: 2}},
+				(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					Action: ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+				}).MACCommand(),
+			),
+			Expected: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{{DeviceID: "served-1", LastWFCnt: 2}},
+				[]*ttnpb.MACCommand{}...,
+			),
+			Payload: &ttnpb.MACCommand_RelayCtrlUplinkListAns{},
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListReject.With(events.WithData(&ttnpb.MACCommand_RelayCtrlUplinkListAns{})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayCtrlUplinkListAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
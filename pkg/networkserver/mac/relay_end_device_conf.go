@@ -0,0 +1,128 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayEndDeviceConfRequest = defineEnqueueMACRequestEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayEndDeviceConfReq{}),
+	)()
+	EvtReceiveRelayEndDeviceConfAccept = defineReceiveMACAcceptEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayEndDeviceConfAns{}),
+	)()
+	EvtReceiveRelayEndDeviceConfReject = defineReceiveMACRejectEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayEndDeviceConfAns{}),
+	)()
+)
+
+func DeviceNeedsRelayEndDeviceConfReq(dev *ttnpb.EndDevice) bool {
+	if dev.GetMulticast() || dev.GetMACState() == nil {
+		return false
+	}
+	desired := dev.MACState.DesiredParameters.Relay.GetServed()
+	current := dev.MACState.CurrentParameters.Relay.GetServed()
+	return desired.GetMode() != current.GetMode() ||
+		desired.GetSmartEnableLevel() != current.GetSmartEnableLevel() ||
+		desired.GetBackoff() != current.GetBackoff() ||
+		!desired.GetSecondChannel().Equal(current.GetSecondChannel())
+}
+
+func EnqueueRelayEndDeviceConfReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayEndDeviceConfReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_END_DEVICE_CONF, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 || nUp < 1 {
+			return nil, 0, nil, false
+		}
+		desired := dev.MACState.DesiredParameters.Relay.GetServed()
+		req := &ttnpb.MACCommand_RelayEndDeviceConfReq{
+			Mode:             desired.GetMode(),
+			SmartEnableLevel: desired.GetSmartEnableLevel(),
+			Backoff:          desired.GetBackoff(),
+			SecondChannel:    desired.GetSecondChannel(),
+		}
+		log.FromContext(ctx).WithFields(log.Fields(
+			"mode", req.Mode,
+			"smart_enable_level", req.SmartEnableLevel,
+			"backoff", req.Backoff,
+		)).Debug("Enqueued RelayEndDeviceConfReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			1,
+			events.Builders{
+				EvtEnqueueRelayEndDeviceConfRequest.With(events.WithData(req)),
+			},
+			true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+func HandleRelayEndDeviceConfAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayEndDeviceConfAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	accepted := pld.SecondChannelFrequencyAck &&
+		pld.SecondChannelAckOffsetAck &&
+		pld.SecondChannelDataRateIndexAck &&
+		pld.BackoffAck
+
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_END_DEVICE_CONF, func(cmd *ttnpb.MACCommand) error {
+		if !accepted {
+			return nil
+		}
+
+		req := cmd.GetRelayEndDeviceConfReq()
+		desired := dev.MACState.DesiredParameters.Relay.GetServed()
+		if req.Mode == ttnpb.RELAY_END_DEVICE_MODE_DISABLED && desired == nil {
+			currentRelayParameters(dev).Served = nil
+			return nil
+		}
+		currentRelayParameters(dev).Served = &ttnpb.ServedRelayParameters{
+			Mode:             req.Mode,
+			SmartEnableLevel: req.SmartEnableLevel,
+			Backoff:          req.Backoff,
+			SecondChannel:    req.SecondChannel,
+			ServingDeviceID:  desired.GetServingDeviceID(),
+		}
+		return nil
+	}, dev.MACState.PendingRequests...)
+	ev := EvtReceiveRelayEndDeviceConfAccept
+	if !accepted {
+		ev = EvtReceiveRelayEndDeviceConfReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
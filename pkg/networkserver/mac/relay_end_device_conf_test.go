@@ -0,0 +1,504 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayEndDeviceConfReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "multicast",
+			InputDevice: &ttnpb.EndDevice{
+				Multicast: true,
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+		},
+		{
+			Name: "current(nil),desired(mode:always)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(mode:always),desired(mode:always)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode:            ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+								ServingDeviceID: "relay",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(mode:always),desired(mode:always,backoff:8)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode:    ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+								Backoff: 8,
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(mode:always),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayEndDeviceConfReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayEndDeviceConfReq(t *testing.T) {
+	desired := &ttnpb.ServedRelayParameters{
+		Mode:             ttnpb.RELAY_END_DEVICE_MODE_DYNAMIC,
+		SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_16,
+		Backoff:          8,
+		SecondChannel: &ttnpb.RelaySecondChannel{
+			AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+			DataRateIndex: ttnpb.DATA_RATE_3,
+			Frequency:     869525000,
+		},
+		ServingDeviceID: "relay",
+	}
+	req := &ttnpb.MACCommand_RelayEndDeviceConfReq{
+		Mode:             ttnpb.RELAY_END_DEVICE_MODE_DYNAMIC,
+		SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_16,
+		Backoff:          8,
+		SecondChannel: &ttnpb.RelaySecondChannel{
+			AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+			DataRateIndex: ttnpb.DATA_RATE_3,
+			Frequency:     869525000,
+		},
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "no change",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   51,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "payload fits/enable",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						req.MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 44,
+				MaxUpLen:   49,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayEndDeviceConfRequest.With(events.WithData(req)),
+				},
+			},
+		},
+		{
+			Name: "payload fits/disable",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{}).MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 44,
+				MaxUpLen:   49,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayEndDeviceConfRequest.With(events.WithData(&ttnpb.MACCommand_RelayEndDeviceConfReq{})),
+				},
+			},
+		},
+		{
+			Name: "downlink does not fit",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+				},
+			},
+			MaxDownlinkLength: 6,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 6,
+				MaxUpLen:   51,
+			},
+		},
+		{
+			Name: "uplink does not fit",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: desired,
+						},
+					},
+				},
+			},
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   1,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   1,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayEndDeviceConfReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayEndDeviceConfAns(t *testing.T) {
+	allAck := &ttnpb.MACCommand_RelayEndDeviceConfAns{
+		SecondChannelFrequencyAck:     true,
+		SecondChannelAckOffsetAck:     true,
+		SecondChannelDataRateIndexAck: true,
+		BackoffAck:                    true,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayEndDeviceConfAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "enable/all ack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode:            ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+								Backoff:         8,
+								ServingDeviceID: "relay",
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{
+							Mode:    ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							Backoff: 8,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode:            ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+								Backoff:         8,
+								ServingDeviceID: "relay",
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode:            ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+								Backoff:         8,
+								ServingDeviceID: "relay",
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfAccept.With(events.WithData(allAck)),
+			},
+		},
+		{
+			Name: "enable/backoff nack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{
+							Mode:    ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							Backoff: 8,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayEndDeviceConfAns{
+				SecondChannelFrequencyAck:     true,
+				SecondChannelAckOffsetAck:     true,
+				SecondChannelDataRateIndexAck: true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfReject.With(events.WithData(&ttnpb.MACCommand_RelayEndDeviceConfAns{
+					SecondChannelFrequencyAck:     true,
+					SecondChannelAckOffsetAck:     true,
+					SecondChannelDataRateIndexAck: true,
+				})),
+			},
+		},
+		{
+			Name: "disable/all ack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: ttnpb.RELAY_END_DEVICE_MODE_ALWAYS,
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfAccept.With(events.WithData(allAck)),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayEndDeviceConfAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
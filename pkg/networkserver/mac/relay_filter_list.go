@@ -0,0 +1,138 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayFilterListRequest = defineEnqueueMACRequestEvent(
+		"relay_filter_list", "relay filter list",
+		events.WithDataType(&ttnpb.MACCommand_RelayFilterListReq{}),
+	)()
+	EvtReceiveRelayFilterListAccept = defineReceiveMACAcceptEvent(
+		"relay_filter_list", "relay filter list",
+		events.WithDataType(&ttnpb.MACCommand_RelayFilterListAns{}),
+	)()
+	EvtReceiveRelayFilterListReject = defineReceiveMACRejectEvent(
+		"relay_filter_list", "relay filter list",
+		events.WithDataType(&ttnpb.MACCommand_RelayFilterListAns{}),
+	)()
+)
+
+func DeviceNeedsRelayFilterListReqAtIndex(dev *ttnpb.EndDevice, i int) bool {
+	desired, current, ok := servingRelayParameters(dev)
+	if !ok {
+		return false
+	}
+	return !relayJoinRequestFilterAt(desired.JoinRequestFilters, i).Equal(relayJoinRequestFilterAt(current.JoinRequestFilters, i))
+}
+
+func DeviceNeedsRelayFilterListReq(dev *ttnpb.EndDevice) bool {
+	desired, current, ok := servingRelayParameters(dev)
+	if !ok {
+		return false
+	}
+	for i := 0; i < len(desired.JoinRequestFilters) || i < len(current.JoinRequestFilters); i++ {
+		if DeviceNeedsRelayFilterListReqAtIndex(dev, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func EnqueueRelayFilterListReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayFilterListReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_FILTER_LIST, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		desired, current, _ := servingRelayParameters(dev)
+
+		var cmds []*ttnpb.MACCommand
+		var evs events.Builders
+		for i := 0; i < len(desired.JoinRequestFilters) || i < len(current.JoinRequestFilters); i++ {
+			if !DeviceNeedsRelayFilterListReqAtIndex(dev, i) {
+				continue
+			}
+			if nDown < 1 || nUp < 1 {
+				return cmds, uint16(len(cmds)), evs, false
+			}
+			nDown--
+			nUp--
+			filter := relayJoinRequestFilterAt(desired.JoinRequestFilters, i)
+			req := &ttnpb.MACCommand_RelayFilterListReq{
+				Index:   uint32(i),
+				Action:  filter.Action,
+				JoinEUI: filter.JoinEUI,
+				DevEUI:  filter.DevEUI,
+			}
+			log.FromContext(ctx).WithFields(log.Fields(
+				"index", req.Index,
+				"action", req.Action,
+				"join_eui", req.JoinEUI,
+				"dev_eui", req.DevEUI,
+			)).Debug("Enqueued RelayFilterListReq")
+			cmds = append(cmds, req.MACCommand())
+			evs = append(evs, EvtEnqueueRelayFilterListRequest.With(events.WithData(req)))
+		}
+		return cmds, uint16(len(cmds)), evs, true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+func HandleRelayFilterListAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayFilterListAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	accepted := pld.IndexAck && pld.ActionAck && pld.CombinedRulesAck
+
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_FILTER_LIST, func(cmd *ttnpb.MACCommand) error {
+		if !accepted {
+			return nil
+		}
+
+		req := cmd.GetRelayFilterListReq()
+		serving := currentServingRelayParameters(dev)
+		for len(serving.JoinRequestFilters) <= int(req.Index) {
+			serving.JoinRequestFilters = append(serving.JoinRequestFilters, &ttnpb.RelayJoinRequestFilter{})
+		}
+		serving.JoinRequestFilters[req.Index] = &ttnpb.RelayJoinRequestFilter{
+			Action:  req.Action,
+			JoinEUI: req.JoinEUI,
+			DevEUI:  req.DevEUI,
+		}
+		return nil
+	}, dev.MACState.PendingRequests...)
+	ev := EvtReceiveRelayFilterListAccept
+	if !accepted {
+		ev = EvtReceiveRelayFilterListReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
@@ -0,0 +1,392 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayFilterListReq(t *testing.T) {
+	filter := &ttnpb.RelayJoinRequestFilter{
+		Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+		JoinEUI: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	}
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "not serving",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{filter},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(forward)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{filter},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(forward),desired(forward)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{filter},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{filter},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(forward),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{filter},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayFilterListReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayFilterListReq(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	devEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	makeDevice := func(current, desired []*ttnpb.RelayJoinRequestFilter, pending ...*ttnpb.MACCommand) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							JoinRequestFilters: current,
+						},
+					},
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							JoinRequestFilters: desired,
+						},
+					},
+				},
+				PendingRequests: pending,
+			},
+		}
+	}
+	forward := &ttnpb.RelayJoinRequestFilter{
+		Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+		JoinEUI: joinEUI,
+	}
+	filter := &ttnpb.RelayJoinRequestFilter{
+		Action: ttnpb.RELAY_FILTER_LIST_ACTION_FILTER,
+		DevEUI: devEUI,
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name:              "no change",
+			InputDevice:       makeDevice([]*ttnpb.RelayJoinRequestFilter{forward}, []*ttnpb.RelayJoinRequestFilter{forward}),
+			ExpectedDevice:    makeDevice([]*ttnpb.RelayJoinRequestFilter{forward}, []*ttnpb.RelayJoinRequestFilter{forward}),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   51,
+				Ok:         true,
+			},
+		},
+		{
+			Name:        "add and remove",
+			InputDevice: makeDevice([]*ttnpb.RelayJoinRequestFilter{forward}, []*ttnpb.RelayJoinRequestFilter{nil, filter}),
+			ExpectedDevice: makeDevice(
+				[]*ttnpb.RelayJoinRequestFilter{forward},
+				[]*ttnpb.RelayJoinRequestFilter{nil, filter},
+				(&ttnpb.MACCommand_RelayFilterListReq{}).MACCommand(),
+				(&ttnpb.MACCommand_RelayFilterListReq{
+					Index:  1,
+					Action: ttnpb.RELAY_FILTER_LIST_ACTION_FILTER,
+					DevEUI: devEUI,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 15,
+				MaxUpLen:   47,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayFilterListRequest.With(events.WithData(&ttnpb.MACCommand_RelayFilterListReq{})),
+					EvtEnqueueRelayFilterListRequest.With(events.WithData(&ttnpb.MACCommand_RelayFilterListReq{
+						Index:  1,
+						Action: ttnpb.RELAY_FILTER_LIST_ACTION_FILTER,
+						DevEUI: devEUI,
+					})),
+				},
+			},
+		},
+		{
+			Name:        "second filter does not fit",
+			InputDevice: makeDevice(nil, []*ttnpb.RelayJoinRequestFilter{forward, filter}),
+			ExpectedDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayJoinRequestFilter{forward, filter},
+				(&ttnpb.MACCommand_RelayFilterListReq{
+					Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+					JoinEUI: joinEUI,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   3,
+			State: EnqueueState{
+				MaxDownLen: 33,
+				MaxUpLen:   1,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayFilterListRequest.With(events.WithData(&ttnpb.MACCommand_RelayFilterListReq{
+						Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+						JoinEUI: joinEUI,
+					})),
+				},
+			},
+		},
+		{
+			Name:              "downlink does not fit",
+			InputDevice:       makeDevice(nil, []*ttnpb.RelayJoinRequestFilter{forward}),
+			ExpectedDevice:    makeDevice(nil, []*ttnpb.RelayJoinRequestFilter{forward}),
+			MaxDownlinkLength: 17,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 17,
+				MaxUpLen:   51,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayFilterListReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayFilterListAns(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	allAck := &ttnpb.MACCommand_RelayFilterListAns{
+		IndexAck:         true,
+		ActionAck:        true,
+		CombinedRulesAck: true,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayFilterListAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayFilterListAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "all ack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							Index:   1,
+							Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+							JoinEUI: joinEUI,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{
+									{},
+									{
+										Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+										JoinEUI: joinEUI,
+									},
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayFilterListAccept.With(events.WithData(allAck)),
+			},
+		},
+		{
+			Name: "combined rules nack",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							Action:  ttnpb.RELAY_FILTER_LIST_ACTION_FORWARD,
+							JoinEUI: joinEUI,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayFilterListAns{
+				IndexAck:  true,
+				ActionAck: true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayFilterListReject.With(events.WithData(&ttnpb.MACCommand_RelayFilterListAns{
+					IndexAck:  true,
+					ActionAck: true,
+				})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayFilterListAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
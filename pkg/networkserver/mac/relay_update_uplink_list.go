@@ -0,0 +1,152 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	EvtEnqueueRelayUpdateUplinkListRequest = defineEnqueueMACRequestEvent(
+		"relay_update_uplink_list", "relay update uplink list",
+		events.WithDataType(&ttnpb.MACCommand_RelayUpdateUplinkListReq{}),
+	)()
+	EvtReceiveRelayUpdateUplinkListAnswer = defineReceiveMACAnswerEvent(
+		"relay_update_uplink_list", "relay update uplink list",
+		events.WithDataType(&ttnpb.MACCommand_RelayUpdateUplinkListAns{}),
+	)()
+)
+
+// RelayServedDeviceSessionFunc returns the device address and the root wake on radio session key
+// of the end device with the given identifier, served by the relay.
+type RelayServedDeviceSessionFunc func(ctx context.Context, deviceID string) (types.DevAddr, types.AES128Key, error)
+
+func DeviceNeedsRelayUpdateUplinkListReqAtIndex(dev *ttnpb.EndDevice, i int) bool {
+	desired, current, ok := servingRelayParameters(dev)
+	if !ok {
+		return false
+	}
+	desiredRule := relayUplinkForwardingRuleAt(desired.UplinkForwardingRules, i)
+	if desiredRule.DeviceID == "" {
+		// Forwarding rules are removed from the relay using RelayCtrlUplinkListReq.
+		return false
+	}
+	currentRule := relayUplinkForwardingRuleAt(current.UplinkForwardingRules, i)
+	return desiredRule.DeviceID != currentRule.DeviceID || !desiredRule.Limits.Equal(currentRule.Limits)
+}
+
+func DeviceNeedsRelayUpdateUplinkListReq(dev *ttnpb.EndDevice) bool {
+	desired, _, ok := servingRelayParameters(dev)
+	if !ok {
+		return false
+	}
+	for i := range desired.UplinkForwardingRules {
+		if DeviceNeedsRelayUpdateUplinkListReqAtIndex(dev, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func EnqueueRelayUpdateUplinkListReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16, f RelayServedDeviceSessionFunc) EnqueueState {
+	if !DeviceNeedsRelayUpdateUplinkListReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		desired, current, _ := servingRelayParameters(dev)
+
+		var cmds []*ttnpb.MACCommand
+		var evs events.Builders
+		for i, rule := range desired.UplinkForwardingRules {
+			if !DeviceNeedsRelayUpdateUplinkListReqAtIndex(dev, i) {
+				continue
+			}
+			if nDown < 1 || nUp < 1 {
+				return cmds, uint16(len(cmds)), evs, false
+			}
+			logger := log.FromContext(ctx).WithFields(log.Fields(
+				"rule_index", i,
+				"served_device_id", rule.DeviceID,
+			))
+			devAddr, rootWorSKey, err := f(ctx, rule.DeviceID)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to get session of served end device, skip RelayUpdateUplinkListReq")
+				continue
+			}
+			nDown--
+			nUp--
+			var wFCnt uint32
+			if currentRule := relayUplinkForwardingRuleAt(current.UplinkForwardingRules, i); currentRule.DeviceID == rule.DeviceID {
+				wFCnt = currentRule.LastWFCnt
+			}
+			req := &ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex:     uint32(i),
+				ForwardLimits: rule.Limits,
+				DevAddr:       devAddr,
+				WFCnt:         wFCnt,
+				RootWorSKey:   &rootWorSKey,
+			}
+			logger.WithFields(log.Fields(
+				"dev_addr", req.DevAddr,
+				"w_f_cnt", req.WFCnt,
+			)).Debug("Enqueued RelayUpdateUplinkListReq")
+			cmds = append(cmds, req.MACCommand())
+			evs = append(evs, EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex:     req.RuleIndex,
+				ForwardLimits: req.ForwardLimits,
+				DevAddr:       req.DevAddr,
+				WFCnt:         req.WFCnt,
+			})))
+		}
+		return cmds, uint16(len(cmds)), evs, true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+func HandleRelayUpdateUplinkListAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayUpdateUplinkListAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, func(cmd *ttnpb.MACCommand) error {
+		req := cmd.GetRelayUpdateUplinkListReq()
+		desired := dev.MACState.DesiredParameters.Relay.GetServing()
+		serving := currentServingRelayParameters(dev)
+		for len(serving.UplinkForwardingRules) <= int(req.RuleIndex) {
+			serving.UplinkForwardingRules = append(serving.UplinkForwardingRules, &ttnpb.RelayUplinkForwardingRule{})
+		}
+		serving.UplinkForwardingRules[req.RuleIndex] = &ttnpb.RelayUplinkForwardingRule{
+			Limits:    req.ForwardLimits,
+			LastWFCnt: req.WFCnt,
+			DeviceID:  relayUplinkForwardingRuleAt(desired.GetUplinkForwardingRules(), int(req.RuleIndex)).DeviceID,
+		}
+		return nil
+	}, dev.MACState.PendingRequests...)
+	return events.Builders{
+		EvtReceiveRelayUpdateUplinkListAnswer.With(events.WithData(pld)),
+	}, err
+}
@@ -0,0 +1,564 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayUpdateUplinkListReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "not serving",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{DeviceID: "served-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(served-1)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{DeviceID: "served-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(served-1),desired(served-1)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{DeviceID: "served-1", LastWFCnt: 42},
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{DeviceID: "served-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(served-1),desired(served-1,limits)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{DeviceID: "served-1"},
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{
+										DeviceID: "served-1",
+										Limits: &ttnpb.RelayForwardLimits{
+											BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+											ReloadRate: 10,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(served-1),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{DeviceID: "served-1"},
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{},
+						},
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayUpdateUplinkListReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayUpdateUplinkListReq(t *testing.T) {
+	served1DevAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	served1Key := types.AES128Key{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	served2DevAddr := types.DevAddr{0x05, 0x06, 0x07, 0x08}
+	served2Key := types.AES128Key{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	limits := &ttnpb.RelayForwardLimits{
+		BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+		ReloadRate: 10,
+	}
+	errNotFound := errors.DefineNotFound("test_served_device_not_found", "served device not found")
+	session := func(ctx context.Context, deviceID string) (types.DevAddr, types.AES128Key, error) {
+		switch deviceID {
+		case "served-1":
+			return served1DevAddr, served1Key, nil
+		case "served-2":
+			return served2DevAddr, served2Key, nil
+		default:
+			return types.DevAddr{}, types.AES128Key{}, errNotFound.New()
+		}
+	}
+	makeDevice := func(current, desired []*ttnpb.RelayUplinkForwardingRule, pending ...*ttnpb.MACCommand) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: current,
+						},
+					},
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					Relay: &ttnpb.RelayParameters{
+						Serving: &ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: desired,
+						},
+					},
+				},
+				PendingRequests: pending,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "no rules",
+			InputDevice: makeDevice(
+				nil,
+				nil,
+			),
+			ExpectedDevice: makeDevice(
+				nil,
+				nil,
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+				MaxUpLen:   51,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "new rule",
+			InputDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", Limits: limits},
+				},
+			),
+			ExpectedDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", Limits: limits},
+				},
+				(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+					ForwardLimits: limits,
+					DevAddr:       served1DevAddr,
+					RootWorSKey:   &served1Key,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 24,
+				MaxUpLen:   50,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+						ForwardLimits: limits,
+						DevAddr:       served1DevAddr,
+					})),
+				},
+			},
+		},
+		{
+			Name: "same device/WFCnt carried over",
+			InputDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", LastWFCnt: 42},
+				},
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", Limits: limits},
+				},
+			),
+			ExpectedDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", LastWFCnt: 42},
+				},
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", Limits: limits},
+				},
+				(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+					ForwardLimits: limits,
+					DevAddr:       served1DevAddr,
+					WFCnt:         42,
+					RootWorSKey:   &served1Key,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 24,
+				MaxUpLen:   50,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+						ForwardLimits: limits,
+						DevAddr:       served1DevAddr,
+						WFCnt:         42,
+					})),
+				},
+			},
+		},
+		{
+			Name: "different device/WFCnt reset",
+			InputDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", LastWFCnt: 42},
+				},
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-2"},
+				},
+			),
+			ExpectedDevice: makeDevice(
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1", LastWFCnt: 42},
+				},
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-2"},
+				},
+				(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+					DevAddr:     served2DevAddr,
+					RootWorSKey: &served2Key,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 24,
+				MaxUpLen:   50,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+						DevAddr: served2DevAddr,
+					})),
+				},
+			},
+		},
+		{
+			Name: "unknown device skipped",
+			InputDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "unknown"},
+					{DeviceID: "served-2"},
+				},
+			),
+			ExpectedDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "unknown"},
+					{DeviceID: "served-2"},
+				},
+				(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+					RuleIndex:   1,
+					DevAddr:     served2DevAddr,
+					RootWorSKey: &served2Key,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 54,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 27,
+				MaxUpLen:   50,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+						RuleIndex: 1,
+						DevAddr:   served2DevAddr,
+					})),
+				},
+			},
+		},
+		{
+			Name: "second rule does not fit",
+			InputDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1"},
+					{DeviceID: "served-2"},
+				},
+			),
+			ExpectedDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1"},
+					{DeviceID: "served-2"},
+				},
+				(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+					DevAddr:     served1DevAddr,
+					RootWorSKey: &served1Key,
+				}).MACCommand(),
+			),
+			MaxDownlinkLength: 51,
+			MaxUplinkLength:   51,
+			State: EnqueueState{
+				MaxDownLen: 24,
+				MaxUpLen:   50,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+						DevAddr: served1DevAddr,
+					})),
+				},
+			},
+		},
+		{
+			Name: "uplink does not fit",
+			InputDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1"},
+				},
+			),
+			ExpectedDevice: makeDevice(
+				nil,
+				[]*ttnpb.RelayUplinkForwardingRule{
+					{DeviceID: "served-1"},
+				},
+			),
+			MaxDownlinkLength: 51,
+			State: EnqueueState{
+				MaxDownLen: 51,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayUpdateUplinkListReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength, session)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayUpdateUplinkListAns(t *testing.T) {
+	limits := &ttnpb.RelayForwardLimits{
+		BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+		ReloadRate: 10,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayUpdateUplinkListAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Payload: &ttnpb.MACCommand_RelayUpdateUplinkListAns{},
+			Events: events.Builders{
+				EvtReceiveRelayUpdateUplinkListAnswer.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListAns{})),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			// The root wake on radio session key is cleared from pending requests before they are stored.
+			Name: "request without key",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									nil,
+									{DeviceID: "served-1", Limits: limits},
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+							RuleIndex:     1,
+							ForwardLimits: limits,
+							DevAddr:       types.DevAddr{0x01, 0x02, 0x03, 0x04},
+							WFCnt:         42,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									{},
+									{DeviceID: "served-1", Limits: limits, LastWFCnt: 42},
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Serving: &ttnpb.ServingRelayParameters{
+								UplinkForwardingRules: []*ttnpb.RelayUplinkForwardingRule{
+									nil,
+									{DeviceID: "served-1", Limits: limits},
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayUpdateUplinkListAns{},
+			Events: events.Builders{
+				EvtReceiveRelayUpdateUplinkListAnswer.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListAns{})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayUpdateUplinkListAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
@@ -49,6 +49,8 @@ func HandleResetInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCom
 	if err != nil {
 		return evs, err
 	}
+	macState.LinkQualityUplinks = dev.MACState.GetLinkQualityUplinks()
+	macState.LinkQualityDownlinks = dev.MACState.GetLinkQualityDownlinks()
 	dev.MACState = macState
 	dev.MACState.LoRaWANVersion = ttnpb.MAC_V1_1
 
@@ -147,6 +147,64 @@ func TestHandleResetInd(t *testing.T) {
 				})),
 			},
 		},
+		{
+			Name: "link quality observations",
+			Device: &ttnpb.EndDevice{
+				LoRaWANVersion:    ttnpb.MAC_V1_1,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				SupportsJoin:      false,
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: *ttnpb.NewPopulatedMACParameters(test.Randy, false),
+					DesiredParameters: *ttnpb.NewPopulatedMACParameters(test.Randy, false),
+					LinkQualityUplinks: []*ttnpb.MACState_UplinkObservation{
+						{FCnt: 41, SNR: 7.5, RSSI: -80, GatewayCount: 2},
+						{FCnt: 42, SNR: 8, RSSI: -75, GatewayCount: 1},
+					},
+					LinkQualityDownlinks: []*ttnpb.MACState_DownlinkObservation{
+						{Confirmed: true, Acknowledged: true},
+					},
+				},
+			},
+			Expected: func() *ttnpb.EndDevice {
+				dev := &ttnpb.EndDevice{
+					LoRaWANVersion:    ttnpb.MAC_V1_1,
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					SupportsJoin:      false,
+					FrequencyPlanID:   test.EUFrequencyPlanID,
+				}
+				macState, err := NewState(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher), ttnpb.MACSettings{})
+				if err != nil {
+					t.Fatalf("Failed to reset MACState: %v", errors.Stack(err))
+				}
+				dev.MACState = macState
+				dev.MACState.LoRaWANVersion = ttnpb.MAC_V1_1
+				dev.MACState.QueuedResponses = []*ttnpb.MACCommand{
+					(&ttnpb.MACCommand_ResetConf{
+						MinorVersion: 1,
+					}).MACCommand(),
+				}
+				dev.MACState.LinkQualityUplinks = []*ttnpb.MACState_UplinkObservation{
+					{FCnt: 41, SNR: 7.5, RSSI: -80, GatewayCount: 2},
+					{FCnt: 42, SNR: 8, RSSI: -75, GatewayCount: 1},
+				}
+				dev.MACState.LinkQualityDownlinks = []*ttnpb.MACState_DownlinkObservation{
+					{Confirmed: true, Acknowledged: true},
+				}
+				return dev
+			}(),
+			Payload: &ttnpb.MACCommand_ResetInd{
+				MinorVersion: 1,
+			},
+			Events: events.Builders{
+				EvtReceiveResetIndication.With(events.WithData(&ttnpb.MACCommand_ResetInd{
+					MinorVersion: 1,
+				})),
+				EvtEnqueueResetConfirmation.With(events.WithData(&ttnpb.MACCommand_ResetConf{
+					MinorVersion: 1,
+				})),
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/mohae/deepcopy"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -286,6 +287,17 @@ func DeviceDesiredADRAckDelayExponent(dev *ttnpb.EndDevice, phy *band.Band, defa
 	}
 }
 
+func DeviceDesiredRelay(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) *ttnpb.RelayParameters {
+	switch {
+	case dev.GetMACSettings().GetDesiredRelay() != nil:
+		return deepcopy.Copy(dev.MACSettings.DesiredRelay).(*ttnpb.RelayParameters)
+	case defaults.DesiredRelay != nil:
+		return deepcopy.Copy(defaults.DesiredRelay).(*ttnpb.RelayParameters)
+	default:
+		return nil
+	}
+}
+
 func DeviceDefaultRX1DataRateOffset(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) uint32 {
 	switch {
 	case dev.GetMACSettings().GetRx1DataRateOffset() != nil:
@@ -596,6 +608,7 @@ func NewState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MA
 			ADRAckLimitExponent:        DeviceDesiredADRAckLimitExponent(dev, phy, defaults),
 			ADRAckDelayExponent:        DeviceDesiredADRAckDelayExponent(dev, phy, defaults),
 			PingSlotDataRateIndexValue: DeviceDesiredPingSlotDataRateIndexValue(dev, phy, fp, defaults),
+			Relay:                      DeviceDesiredRelay(dev, defaults),
 		},
 	}, nil
 }
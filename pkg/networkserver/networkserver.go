@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -93,6 +94,9 @@ type DownlinkPriorities struct {
 // InteropClient is a client, which Network Server can use for interoperability.
 type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	NetworkServerNetIDs() []types.NetID
+	PRStartRequest(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // NetworkServer implements the Network Server component.
@@ -104,8 +108,9 @@ type NetworkServer struct {
 
 	devices DeviceRegistry
 
-	netID      types.NetID
-	newDevAddr newDevAddrFunc
+	netID           types.NetID
+	devAddrPrefixes []types.DevAddrPrefix
+	newDevAddr      newDevAddrFunc
 
 	applicationServers *sync.Map // string -> *applicationUpStream
 	applicationUplinks ApplicationUplinkQueue
@@ -119,11 +124,24 @@ type NetworkServer struct {
 	defaultMACSettings ttnpb.MACSettings
 
 	interopClient InteropClient
+	interop       interopServer
+
+	// forwardRoamingUplinks indicates whether uplink messages of roaming devices are forwarded to roaming partners.
+	forwardRoamingUplinks bool
+	// roamingDefaultBand is the band of forwarded uplink messages received by gateways with unknown frequency plan.
+	roamingDefaultBand *band.Band
+	// roamingUplinkTokenKeyID is the ID of the key used to encrypt the uplink tokens of gateways that are handed out to
+	// roaming partners.
+	roamingUplinkTokenKeyID string
 
 	uplinkDeduplicator UplinkDeduplicator
 
 	deviceKEKLabel        string
 	downlinkQueueCapacity int
+
+	// linkQualityWindow is the maximum amount of observations stored per device for the link quality stats.
+	// Link quality stats are disabled if linkQualityWindow is 0.
+	linkQualityWindow int
 }
 
 // Option configures the NetworkServer.
@@ -183,6 +201,25 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if err != nil {
 		return nil, err
 	}
+	if err := conf.DefaultMACSettings.Validate(); err != nil {
+		return nil, err
+	}
+	if err := conf.LinkQuality.Validate(); err != nil {
+		return nil, err
+	}
+	var linkQualityWindow int
+	if conf.LinkQuality.Enable {
+		linkQualityWindow = conf.LinkQuality.Window
+	}
+
+	var roamingDefaultBand *band.Band
+	if conf.Roaming.BandID != "" {
+		b, err := band.GetByID(conf.Roaming.BandID)
+		if err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		roamingDefaultBand = &b
+	}
 
 	var interopCl InteropClient
 	if !conf.Interop.IsZero() {
@@ -199,23 +236,29 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	}
 
 	ns := &NetworkServer{
-		Component:             c,
-		ctx:                   ctx,
-		netID:                 conf.NetID,
-		newDevAddr:            makeNewDevAddrFunc(devAddrPrefixes...),
-		applicationServers:    &sync.Map{},
-		applicationUplinks:    conf.ApplicationUplinkQueue.Queue,
-		deduplicationWindow:   makeWindowDurationFunc(conf.DeduplicationWindow),
-		collectionWindow:      makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
-		devices:               wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
-		downlinkTasks:         conf.DownlinkTasks,
-		downlinkPriorities:    downlinkPriorities,
-		defaultMACSettings:    conf.DefaultMACSettings.Parse(),
-		interopClient:         interopCl,
-		uplinkDeduplicator:    conf.UplinkDeduplicator,
-		deviceKEKLabel:        conf.DeviceKEKLabel,
-		downlinkQueueCapacity: conf.DownlinkQueueCapacity,
+		Component:               c,
+		ctx:                     ctx,
+		netID:                   conf.NetID,
+		devAddrPrefixes:         devAddrPrefixes,
+		newDevAddr:              makeNewDevAddrFunc(devAddrPrefixes...),
+		applicationServers:      &sync.Map{},
+		applicationUplinks:      conf.ApplicationUplinkQueue.Queue,
+		deduplicationWindow:     makeWindowDurationFunc(conf.DeduplicationWindow),
+		collectionWindow:        makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
+		devices:                 wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
+		downlinkTasks:           conf.DownlinkTasks,
+		downlinkPriorities:      downlinkPriorities,
+		defaultMACSettings:      conf.DefaultMACSettings.Parse(),
+		interopClient:           interopCl,
+		forwardRoamingUplinks:   conf.Roaming.ForwardUplinks,
+		roamingDefaultBand:      roamingDefaultBand,
+		roamingUplinkTokenKeyID: conf.Roaming.UplinkTokenKeyID,
+		uplinkDeduplicator:      conf.UplinkDeduplicator,
+		deviceKEKLabel:          conf.DeviceKEKLabel,
+		downlinkQueueCapacity:   conf.DownlinkQueueCapacity,
+		linkQualityWindow:       linkQualityWindow,
 	}
+	ns.interop = interopServer{NS: ns}
 	ctx = ns.Context()
 
 	if len(opts) == 0 {
@@ -247,6 +290,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		})
 	}
 	c.RegisterGRPC(ns)
+	c.RegisterInterop(ns)
 	return ns, nil
 }
 
@@ -260,12 +304,21 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsNsServer(s, ns)
 	ttnpb.RegisterAsNsServer(s, ns)
 	ttnpb.RegisterNsEndDeviceRegistryServer(s, ns)
+	ttnpb.RegisterNsEndDeviceLinkQualityServer(s, &nsEndDeviceLinkQuality{NetworkServer: ns})
 	ttnpb.RegisterNsServer(s, ns)
 }
 
+// RegisterInterop registers the sNS-fNS and fNS-sNS interop services.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterHNS(ns.interop)
+	srv.RegisterSNS(ns.interop)
+	srv.RegisterFNS(ns.interop)
+}
+
 // RegisterHandlers registers gRPC handlers.
 func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterNsEndDeviceRegistryHandler(ns.Context(), s, conn)
+	ttnpb.RegisterNsEndDeviceLinkQualityHandler(ns.Context(), s, conn)
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 }
 
@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
@@ -68,8 +69,8 @@ var (
 	ErrDeviceNotFound             = errDeviceNotFound
 	ErrDuplicate                  = errDuplicate
 	ErrInvalidAbsoluteTime        = errInvalidAbsoluteTime
+	ErrLinkQualityDisabled        = errLinkQualityDisabled
 	ErrOutdatedData               = errOutdatedData
-	ErrRejoinRequest              = errRejoinRequest
 	ErrUnsupportedLoRaWANVersion  = errUnsupportedLoRaWANVersion
 
 	EvtClusterJoinAttempt          = evtClusterJoinAttempt
@@ -265,7 +266,10 @@ var _ InteropClient = MockInteropClient{}
 
 // MockInteropClient is a mock InteropClient used for testing.
 type MockInteropClient struct {
-	HandleJoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleJoinRequestFunc   func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	NetworkServerNetIDsFunc func() []types.NetID
+	PRStartRequestFunc      func(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequestFunc     func(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // HandleJoinRequest calls HandleJoinRequestFunc if set and panics otherwise.
@@ -276,6 +280,30 @@ func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.Ne
 	return m.HandleJoinRequestFunc(ctx, netID, req)
 }
 
+// NetworkServerNetIDs calls NetworkServerNetIDsFunc if set and returns nil otherwise.
+func (m MockInteropClient) NetworkServerNetIDs() []types.NetID {
+	if m.NetworkServerNetIDsFunc == nil {
+		return nil
+	}
+	return m.NetworkServerNetIDsFunc()
+}
+
+// PRStartRequest calls PRStartRequestFunc if set and panics otherwise.
+func (m MockInteropClient) PRStartRequest(ctx context.Context, netID types.NetID, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc == nil {
+		panic("PRStartRequest called, but not set")
+	}
+	return m.PRStartRequestFunc(ctx, netID, req)
+}
+
+// XmitDataRequest calls XmitDataRequestFunc if set and panics otherwise.
+func (m MockInteropClient) XmitDataRequest(ctx context.Context, netID types.NetID, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc == nil {
+		panic("XmitDataRequest called, but not set")
+	}
+	return m.XmitDataRequestFunc(ctx, netID, req)
+}
+
 type InteropClientHandleJoinRequestResponse struct {
 	Response *ttnpb.JoinResponse
 	Error    error
@@ -2056,17 +2084,48 @@ func (m MockDownlinkTaskQueue) Pop(ctx context.Context, f func(context.Context,
 	return m.PopFunc(ctx, f)
 }
 
+var _ UplinkDeduplicator = MockUplinkDeduplicator{}
+
+// MockUplinkDeduplicator is a mock UplinkDeduplicator used for testing.
+type MockUplinkDeduplicator struct {
+	DeduplicateUplinkFunc   func(context.Context, *ttnpb.UplinkMessage, time.Duration) (bool, error)
+	AccumulatedMetadataFunc func(context.Context, *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error)
+}
+
+// DeduplicateUplink calls DeduplicateUplinkFunc if set and panics otherwise.
+func (m MockUplinkDeduplicator) DeduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage, d time.Duration) (bool, error) {
+	if m.DeduplicateUplinkFunc == nil {
+		panic("DeduplicateUplink called, but not set")
+	}
+	return m.DeduplicateUplinkFunc(ctx, up, d)
+}
+
+// AccumulatedMetadata calls AccumulatedMetadataFunc if set and panics otherwise.
+func (m MockUplinkDeduplicator) AccumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
+	if m.AccumulatedMetadataFunc == nil {
+		panic("AccumulatedMetadata called, but not set")
+	}
+	return m.AccumulatedMetadataFunc(ctx, up)
+}
+
 var _ DeviceRegistry = MockDeviceRegistry{}
 
 // MockDeviceRegistry is a mock DeviceRegistry used for testing.
 type MockDeviceRegistry struct {
+	GetByEUIFunc func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	SetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+
+	RangeByDevEUIFunc        func(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	RangeByUplinkMatchesFunc func(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, *UplinkMatch) (bool, error)) error
 }
 
-// GetByEUI panics.
+// GetByEUI calls GetByEUIFunc if set and panics otherwise.
 func (m MockDeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
-	panic("GetByEUI must not be called")
+	if m.GetByEUIFunc == nil {
+		panic("GetByEUI called, but not set")
+	}
+	return m.GetByEUIFunc(ctx, joinEUI, devEUI, paths)
 }
 
 // GetByID calls GetByIDFunc if set and panics otherwise.
@@ -2085,7 +2144,18 @@ func (m MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.Application
 	return m.SetByIDFunc(ctx, appID, devID, paths, f)
 }
 
-// RangeByUplinkMatches panics.
-func (m MockDeviceRegistry) RangeByUplinkMatches(context.Context, *ttnpb.UplinkMessage, time.Duration, func(context.Context, *UplinkMatch) (bool, error)) error {
-	panic("RangeByUplinkMatches must not be called")
+// RangeByDevEUI calls RangeByDevEUIFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	if m.RangeByDevEUIFunc == nil {
+		panic("RangeByDevEUI called, but not set")
+	}
+	return m.RangeByDevEUIFunc(ctx, devEUI, paths, f)
+}
+
+// RangeByUplinkMatches calls RangeByUplinkMatchesFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, *UplinkMatch) (bool, error)) error {
+	if m.RangeByUplinkMatchesFunc == nil {
+		panic("RangeByUplinkMatches called, but not set")
+	}
+	return m.RangeByUplinkMatchesFunc(ctx, up, cacheTTL, f)
 }
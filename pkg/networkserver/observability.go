@@ -17,6 +17,7 @@ package networkserver
 import (
 	"context"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -24,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
 
 var (
@@ -79,6 +81,21 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
 		events.WithDataType(&ttnpb.UplinkMessage{}),
 	)
+	evtReceiveRejoinRequest = events.Define(
+		"ns.up.rejoin.receive", "receive rejoin-request",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+	evtDropRejoinRequest = events.Define(
+		"ns.up.rejoin.drop", "drop rejoin-request",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtProcessRejoinRequest = events.Define(
+		"ns.up.rejoin.process", "successfully processed rejoin-request",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
 	evtClusterJoinAttempt = events.Define(
 		"ns.up.join.cluster.attempt", "send join-request to cluster-local Join Server",
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
@@ -189,6 +206,33 @@ var nsMetrics = &messageMetrics{
 		},
 		nil,
 	),
+	uplinkSNR: metrics.NewContextualHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_snr",
+			Help:      "Highest SNR of the gateways that received the uplink (dB)",
+			Buckets:   []float64{-20, -15, -10, -7.5, -5, -2.5, 0, 2.5, 5, 7.5, 10},
+		},
+		nil,
+	),
+	uplinkRSSI: metrics.NewContextualHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_rssi",
+			Help:      "Highest RSSI of the gateways that received the uplink (dBm)",
+			Buckets:   []float64{-140, -130, -120, -110, -100, -90, -80, -70, -60, -50},
+		},
+		nil,
+	),
+	uplinkLostFrames: metrics.NewContextualHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_lost_frames",
+			Help:      "Number of uplink frames lost before the uplink, derived from gaps in the frame counter",
+			Buckets:   []float64{0, 1, 2, 5, 10, 20, 50, 100},
+		},
+		nil,
+	),
 	micComputations: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -235,6 +279,9 @@ type messageMetrics struct {
 	uplinkForwarded  *metrics.ContextualCounterVec
 	uplinkDropped    *metrics.ContextualCounterVec
 	uplinkGateways   *metrics.ContextualHistogramVec
+	uplinkSNR        *metrics.ContextualHistogramVec
+	uplinkRSSI       *metrics.ContextualHistogramVec
+	uplinkLostFrames *metrics.ContextualHistogramVec
 	micComputations  *metrics.ContextualCounterVec
 	micMismatches    *metrics.ContextualCounterVec
 
@@ -249,6 +296,9 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
 	m.uplinkGateways.Describe(ch)
+	m.uplinkSNR.Describe(ch)
+	m.uplinkRSSI.Describe(ch)
+	m.uplinkLostFrames.Describe(ch)
 	m.micComputations.Describe(ch)
 	m.micMismatches.Describe(ch)
 
@@ -263,6 +313,9 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
 	m.uplinkGateways.Collect(ch)
+	m.uplinkSNR.Collect(ch)
+	m.uplinkRSSI.Collect(ch)
+	m.uplinkLostFrames.Collect(ch)
 	m.micComputations.Collect(ch)
 	m.micMismatches.Collect(ch)
 
@@ -311,6 +364,36 @@ func registerMergeMetadata(ctx context.Context, msg *ttnpb.UplinkMessage) {
 	nsMetrics.uplinkGateways.WithLabelValues(ctx).Observe(float64(gtwCount))
 }
 
+// maxExemplarRunes is the maximum amount of runes in the labels of an exemplar.
+const maxExemplarRunes = 64
+
+// deviceExemplar returns the exemplar labels identifying the device.
+// The DevEUI is used if the unique ID of the device exceeds the maximum length of exemplar labels.
+func deviceExemplar(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) prometheus.Labels {
+	if uid := unique.ID(ctx, ids); len("device_uid")+utf8.RuneCountInString(uid) <= maxExemplarRunes {
+		return prometheus.Labels{"device_uid": uid}
+	}
+	if ids.DevEUI != nil && !ids.DevEUI.IsZero() {
+		return prometheus.Labels{"dev_eui": ids.DevEUI.String()}
+	}
+	return prometheus.Labels{}
+}
+
+func observeWithExemplar(o prometheus.Observer, v float64, exemplar prometheus.Labels) {
+	if eo, ok := o.(prometheus.ExemplarObserver); ok {
+		eo.ObserveWithExemplar(v, exemplar)
+		return
+	}
+	o.Observe(v)
+}
+
+func registerLinkQualityUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, obs *ttnpb.MACState_UplinkObservation, lost uint32) {
+	exemplar := deviceExemplar(ctx, ids)
+	observeWithExemplar(nsMetrics.uplinkSNR.WithLabelValues(ctx), float64(obs.SNR), exemplar)
+	observeWithExemplar(nsMetrics.uplinkRSSI.WithLabelValues(ctx), float64(obs.RSSI), exemplar)
+	observeWithExemplar(nsMetrics.uplinkLostFrames.WithLabelValues(ctx), float64(lost), exemplar)
+}
+
 func registerMICComputation(ctx context.Context) {
 	nsMetrics.micComputations.WithLabelValues(ctx).Inc()
 }
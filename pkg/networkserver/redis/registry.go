@@ -73,6 +73,10 @@ func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
 	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
 }
 
+func (r *DeviceRegistry) devEUIKey(devEUI types.EUI64) string {
+	return r.Redis.Key("dev_eui", devEUI.String())
+}
+
 // GetByID gets device by appID, devID.
 func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	ids := ttnpb.EndDeviceIdentifiers{
@@ -113,6 +117,69 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI
 	return pb, ctx, nil
 }
 
+// indexDevEUI indexes the devices with devEUI by the JoinEUI and DevEUI index, and returns the UIDs of the devices.
+// This indexes devices that were stored before the DevEUI index was introduced and that have not been updated since.
+func (r *DeviceRegistry) indexDevEUI(ctx context.Context, devEUI types.EUI64) ([]string, error) {
+	var uids []string
+	iter := r.Redis.Scan(ctx, 0, r.Redis.Key("eui", "*", devEUI.String()), 0).Iterator()
+	for iter.Next(ctx) {
+		uid, err := r.Redis.Get(ctx, iter.Val()).Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		uids = append(uids, uid)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	members := make([]interface{}, 0, len(uids))
+	for _, uid := range uids {
+		members = append(members, uid)
+	}
+	if err := r.Redis.SAdd(ctx, r.devEUIKey(devEUI), members...).Err(); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return uids, nil
+}
+
+// RangeByDevEUI ranges over devices with devEUI.
+// Devices are indexed by DevEUI when they are updated. If no devices are indexed by devEUI, the devices are indexed by
+// the JoinEUI and DevEUI index first.
+func (r *DeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_eui").End()
+
+	uids, err := r.Redis.SMembers(ctx, r.devEUIKey(devEUI)).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if len(uids) == 0 {
+		if uids, err = r.indexDevEUI(ctx, devEUI); err != nil {
+			return err
+		}
+	}
+	for _, uid := range uids {
+		pb := &ttnpb.EndDevice{}
+		if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(uid)).ScanProto(pb); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
 type UplinkMatchSession struct {
 	FNwkSIntKey       *ttnpb.KeyEnvelope
 	ResetsFCnt        *pbtypes.BoolValue
@@ -845,6 +912,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if stored.JoinEUI != nil && stored.DevEUI != nil {
 					p.Del(ctx, r.euiKey(*stored.JoinEUI, *stored.DevEUI))
 				}
+				if stored.DevEUI != nil {
+					p.SRem(ctx, r.devEUIKey(*stored.DevEUI), uid)
+				}
 				if stored.PendingSession != nil {
 					removeAddrMapping(ctx, p, PendingAddrKey(r.addrKey(stored.PendingSession.DevAddr)), uid)
 				}
@@ -994,6 +1064,10 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				}
 			}
 
+			if updated.DevEUI != nil {
+				p.SAdd(ctx, r.devEUIKey(*updated.DevEUI), uid)
+			}
+
 			_, err := ttnredis.SetProto(ctx, p, uk, updated, 0)
 			if err != nil {
 				return err
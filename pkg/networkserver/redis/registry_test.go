@@ -15,12 +15,18 @@
 package redis_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test/shared"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
 
 var _ networkserver.DeviceRegistry = &DeviceRegistry{}
@@ -31,3 +37,63 @@ func TestDeviceRegistry(t *testing.T) {
 	t.Cleanup(closeFn)
 	HandleDeviceRegistryTest(t, reg)
 }
+
+func TestDeviceRegistryRangeByDevEUIUnindexed(t *testing.T) {
+	a, ctx := test.New(t)
+	reg, closeFn := NewRedisDeviceRegistry(ctx)
+	t.Cleanup(closeFn)
+
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	pb := &ttnpb.EndDevice{
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANVersion:    ttnpb.MAC_V1_1,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevEUI:                 &devEUI,
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+	}
+	_, _, err := networkserver.CreateDevice(ctx, reg, pb,
+		"frequency_plan_id",
+		"ids.application_ids",
+		"ids.dev_eui",
+		"ids.device_id",
+		"ids.join_eui",
+		"lorawan_phy_version",
+		"lorawan_version",
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Remove the DevEUI index, as if the device was stored before the DevEUI index was introduced.
+	cl := reg.(*DeviceRegistry).Redis
+	devEUIKey := cl.Key("dev_eui", devEUI.String())
+	if !a.So(cl.Del(ctx, devEUIKey).Err(), should.BeNil) {
+		t.FailNow()
+	}
+
+	var devs []*ttnpb.EndDevice
+	err = reg.RangeByDevEUI(ctx, devEUI, []string{"ids"}, func(_ context.Context, dev *ttnpb.EndDevice) bool {
+		devs = append(devs, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	if a.So(devs, should.HaveLength, 1) {
+		a.So(devs[0].EndDeviceIdentifiers, should.Resemble, pb.EndDeviceIdentifiers)
+	}
+	uids, err := cl.SMembers(ctx, devEUIKey).Result()
+	a.So(err, should.BeNil)
+	a.So(uids, should.Resemble, []string{unique.ID(ctx, pb.EndDeviceIdentifiers)})
+
+	t.Run("Unknown", func(t *testing.T) {
+		a := assertions.New(t)
+		err := reg.RangeByDevEUI(ctx, types.EUI64{0x43, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []string{"ids"}, func(context.Context, *ttnpb.EndDevice) bool {
+			t.Error("Unexpected device")
+			return true
+		})
+		a.So(err, should.BeNil)
+	})
+}
@@ -41,6 +41,7 @@ type UplinkMatch struct {
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
 	RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, *UplinkMatch) (bool, error)) error
 	SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
@@ -106,6 +107,16 @@ func (w replacedEndDeviceFieldRegistryWrapper) GetByID(ctx context.Context, appI
 	return dev, ctx, nil
 }
 
+func (w replacedEndDeviceFieldRegistryWrapper) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
+	return w.DeviceRegistry.RangeByDevEUI(ctx, devEUI, paths, func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+		for _, d := range replaced {
+			d.GetTransform(dev)
+		}
+		return f(ctx, dev)
+	})
+}
+
 func (w replacedEndDeviceFieldRegistryWrapper) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
 	dev, ctx, err := w.DeviceRegistry.SetByID(ctx, appID, devID, paths, func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
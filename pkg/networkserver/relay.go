@@ -0,0 +1,314 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+// relayServedDeviceSession returns a mac.RelayServedDeviceSessionFunc, which looks up
+// end devices served by a relay in application identified by appID.
+func (ns *NetworkServer) relayServedDeviceSession(appID ttnpb.ApplicationIdentifiers) mac.RelayServedDeviceSessionFunc {
+	return func(ctx context.Context, deviceID string) (types.DevAddr, types.AES128Key, error) {
+		dev, ctx, err := ns.devices.GetByID(ctx, appID, deviceID, []string{
+			"session.dev_addr",
+			"session.keys.nwk_s_enc_key",
+		})
+		if err != nil {
+			return types.DevAddr{}, types.AES128Key{}, err
+		}
+		if dev.Session == nil {
+			return types.DevAddr{}, types.AES128Key{}, errEmptySession.New()
+		}
+		if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+			return types.DevAddr{}, types.AES128Key{}, errUnknownNwkSEncKey.New()
+		}
+		key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+		if err != nil {
+			return types.DevAddr{}, types.AES128Key{}, err
+		}
+		return dev.Session.DevAddr, crypto.DeriveRootWorSKey(key), nil
+	}
+}
+
+// relayedUplink returns the uplink forwarded by relay dev in the FRMPayload of up.
+func (ns *NetworkServer) relayedUplink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, up *ttnpb.UplinkMessage) (*ttnpb.UplinkMessage, error) {
+	pld := up.Payload.GetMACPayload()
+	if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+		return nil, errUnknownNwkSEncKey.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	b, err := crypto.DecryptUplink(key, dev.Session.DevAddr, pld.FullFCnt, pld.FRMPayload, false)
+	if err != nil {
+		return nil, errDecryptPayload.WithCause(err)
+	}
+	var req lorawan.RelayForwardUplinkReq
+	if err := lorawan.UnmarshalRelayForwardUplinkReq(*phy, b, &req); err != nil {
+		return nil, errDecodePayload.WithCause(err)
+	}
+	dr, ok := phy.DataRates[req.DataRateIndex]
+	if !ok {
+		return nil, errDataRateIndexNotFound.WithAttributes("index", req.DataRateIndex)
+	}
+	mds := make([]*ttnpb.RxMetadata, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		mds = append(mds, &ttnpb.RxMetadata{
+			GatewayIdentifiers: md.GatewayIdentifiers,
+			PacketBroker:       md.PacketBroker,
+			Relay: &ttnpb.RelayMetadata{
+				DeviceID:   dev.DeviceID,
+				WORChannel: req.WORChannel,
+			},
+			Time:        md.Time,
+			RSSI:        float32(req.RSSI),
+			ChannelRSSI: float32(req.RSSI),
+			SNR:         float32(req.SNR),
+			Location:    md.Location,
+		})
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: req.RawPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      dr.Rate,
+			DataRateIndex: req.DataRateIndex,
+			Frequency:     req.Frequency,
+			EnableCRC:     up.Settings.EnableCRC,
+			Time:          up.Settings.Time,
+		},
+		RxMetadata:     mds,
+		CorrelationIDs: up.CorrelationIDs,
+	}, nil
+}
+
+// handleRelayedUplink handles the uplink forwarded by relay dev in up.
+func (ns *NetworkServer) handleRelayedUplink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, up *ttnpb.UplinkMessage) {
+	logger := log.FromContext(ctx).WithField("relay_device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers))
+	relayed, err := ns.relayedUplink(ctx, dev, phy, up)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to unwrap relayed uplink")
+		return
+	}
+	if err := ns.handleUplink(log.NewContext(ctx, logger), relayed); err != nil {
+		logger.WithError(err).Debug("Failed to handle relayed uplink")
+	}
+}
+
+// relayDownlinkTarget is the downlink target for downlink through a relay.
+type relayDownlinkTarget struct {
+	ns  *NetworkServer
+	ids ttnpb.EndDeviceIdentifiers
+}
+
+func (t *relayDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*relayDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.ids.ApplicationID == t.ids.ApplicationID && other.ids.DeviceID == t.ids.DeviceID
+}
+
+func (t *relayDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption) (time.Duration, error) {
+	if msg.GetRequest().GetClass() != ttnpb.CLASS_A {
+		return 0, errRelayDownlinkClass.New()
+	}
+	return t.ns.scheduleRelayDownlink(ctx, t.ids, msg.RawPayload)
+}
+
+var relayDownlinkGetPaths = [...]string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"mac_settings",
+	"mac_state",
+	"multicast",
+	"session",
+}
+
+// relayDownlinkPayload returns the downlink message of relay dev forwarding payload b
+// and the encoded message.
+func (ns *NetworkServer) relayDownlinkPayload(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, b []byte) (*ttnpb.Message, []byte, error) {
+	if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+		return nil, nil, errUnknownNwkSEncKey.New()
+	}
+	if dev.Session.SNwkSIntKey == nil || len(dev.Session.SNwkSIntKey.Key) == 0 {
+		return nil, nil, errUnknownSNwkSIntKey.New()
+	}
+	nwkSEncKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+	if err != nil {
+		return nil, nil, err
+	}
+	sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.SNwkSIntKey, ns.KeyVault)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fCnt := dev.Session.LastNFCntDown + 1
+	frmPayload, err := crypto.EncryptDownlink(nwkSEncKey, dev.Session.DevAddr, fCnt, b, false)
+	if err != nil {
+		return nil, nil, errEncryptPayload.WithCause(err)
+	}
+	pld := &ttnpb.MACPayload{
+		FHDR: ttnpb.FHDR{
+			DevAddr: dev.Session.DevAddr,
+			FCtrl: ttnpb.FCtrl{
+				ADR: mac.DeviceUseADR(dev, ns.defaultMACSettings, phy),
+			},
+			FCnt: fCnt & 0xffff,
+		},
+		FPort:      lorawan.RelayFPort,
+		FRMPayload: frmPayload,
+		FullFCnt:   fCnt,
+	}
+	msg := &ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_DOWN,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: pld,
+		},
+	}
+	raw, err := lorawan.MarshalMessage(*msg)
+	if err != nil {
+		return nil, nil, errEncodePayload.WithCause(err)
+	}
+	var mic [4]byte
+	if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		mic, err = crypto.ComputeLegacyDownlinkMIC(sNwkSIntKey, dev.Session.DevAddr, fCnt, raw)
+	} else {
+		mic, err = crypto.ComputeDownlinkMIC(sNwkSIntKey, dev.Session.DevAddr, 0, fCnt, raw)
+	}
+	if err != nil {
+		return nil, nil, errComputeMIC.WithCause(err)
+	}
+	msg.MIC = mic[:]
+	return msg, append(raw, mic[:]...), nil
+}
+
+// scheduleRelayDownlink schedules payload b for transmission by the relay identified by ids
+// in the RX windows of its most recent uplink. It returns the delay until the relay transmits.
+func (ns *NetworkServer) scheduleRelayDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, b []byte) (time.Duration, error) {
+	ctx = log.NewContextWithField(ctx, "relay_device_uid", unique.ID(ctx, ids))
+	var (
+		down         *scheduledDownlink
+		queuedEvents []events.Event
+		queuedUps    []*ttnpb.ApplicationUp
+	)
+	_, ctx, err := ns.devices.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, relayDownlinkGetPaths[:], func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		switch {
+		case dev == nil:
+			return nil, nil, errDeviceNotFound.New()
+		case dev.MACState == nil:
+			return nil, nil, errUnknownMACState.New()
+		case dev.Session == nil:
+			return nil, nil, errEmptySession.New()
+		case !dev.MACState.RxWindowsAvailable || len(dev.MACState.RecentUplinks) == 0:
+			return nil, nil, errRelayRxWindowsUnavailable.New()
+		}
+		fp, phy, err := DeviceFrequencyPlanAndBand(dev, ns.FrequencyPlans)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg, raw, err := ns.relayDownlinkPayload(ctx, dev, phy, b)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// NOTE: len(MHDR) + len(MIC) = 1 + 4 = 5
+		macPayloadLen := len(raw) - 5
+		up := LastUplink(dev.MACState.RecentUplinks...)
+		req := &ttnpb.TxRequest{
+			Class:           ttnpb.CLASS_A,
+			Priority:        ns.downlinkPriorities.MACCommands,
+			FrequencyPlanID: dev.FrequencyPlanID,
+			Rx1Delay:        dev.MACState.CurrentParameters.Rx1Delay,
+		}
+		if freq, drIdx, err := rx1Parameters(phy, dev.MACState, up); err != nil {
+			log.FromContext(ctx).WithError(err).Debug("Failed to compute relay RX1 parameters")
+		} else if dr, ok := phy.DataRates[drIdx]; ok && macPayloadLen <= int(dr.MaxMACPayloadSize(fp.DwellTime.GetDownlinks())) {
+			req.Rx1Frequency = freq
+			req.Rx1DataRateIndex = drIdx
+		}
+		if dr, ok := phy.DataRates[dev.MACState.CurrentParameters.Rx2DataRateIndex]; ok && macPayloadLen <= int(dr.MaxMACPayloadSize(fp.DwellTime.GetDownlinks())) {
+			req.Rx2Frequency = dev.MACState.CurrentParameters.Rx2Frequency
+			req.Rx2DataRateIndex = dev.MACState.CurrentParameters.Rx2DataRateIndex
+		}
+		if req.Rx1Frequency == 0 && req.Rx2Frequency == 0 {
+			return nil, nil, errRelayDownlinkTooLong.WithAttributes("length", len(b))
+		}
+
+		down, queuedEvents, err = ns.scheduleDownlinkByPaths(
+			log.NewContext(ctx, loggerWithTxRequestFields(log.FromContext(ctx), req, req.Rx1Frequency != 0, req.Rx2Frequency != 0)),
+			&scheduleRequest{
+				TxRequest:            req,
+				EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+				Payload:              msg,
+				RawPayload:           raw,
+			},
+			downlinkPathsFromRecentUplinks(dev.MACState.RecentUplinks...)...,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			queuedUps = append(queuedUps, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+				CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+				Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+					DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+						Downlinks:    dev.Session.QueuedApplicationDownlinks,
+						LastFCntDown: msg.GetMACPayload().FullFCnt,
+					},
+				},
+			})
+		}
+		dev.Session.LastNFCntDown = msg.GetMACPayload().FullFCnt
+		dev.MACState.LastDownlinkAt = TimePtr(down.TransmitAt)
+		dev.MACState.RecentDownlinks = appendRecentDownlink(dev.MACState.RecentDownlinks, &ttnpb.DownlinkMessage{
+			Payload:        down.Message.Payload,
+			Settings:       down.Message.Settings,
+			CorrelationIDs: down.Message.CorrelationIDs,
+		}, recentDownlinkCount)
+		dev.MACState.RxWindowsAvailable = false
+		return dev, []string{
+			"mac_state.last_downlink_at",
+			"mac_state.recent_downlinks",
+			"mac_state.rx_windows_available",
+			"session.last_n_f_cnt_down",
+		}, nil
+	})
+	publishEvents(ctx, queuedEvents...)
+	if err != nil {
+		return 0, err
+	}
+	ns.enqueueApplicationUplinks(ctx, queuedUps...)
+	return timeUntil(down.TransmitAt), nil
+}
@@ -0,0 +1,284 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRelayedUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	phy := test.Must(band.GetByID(band.EU_863_870)).(band.Band)
+	devAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	nwkSEncKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-relay",
+		},
+		Session: &ttnpb.Session{
+			DevAddr: devAddr,
+			SessionKeys: ttnpb.SessionKeys{
+				NwkSEncKey: &ttnpb.KeyEnvelope{Key: &nwkSEncKey},
+			},
+		},
+	}
+
+	relayedPHYPayload := []byte{0x40, 0x08, 0x07, 0x06, 0x05, 0x00, 0x01, 0x00, 0xaa, 0xbb, 0xcc, 0xdd}
+	b, err := lorawan.AppendRelayForwardUplinkReq(phy, nil, lorawan.RelayForwardUplinkReq{
+		DataRateIndex: ttnpb.DATA_RATE_3,
+		SNR:           -5,
+		RSSI:          -110,
+		WORChannel:    ttnpb.RELAY_WOR_CHANNEL_SECONDARY,
+		Frequency:     868300000,
+		RawPayload:    relayedPHYPayload,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	frmPayload := test.Must(crypto.EncryptUplink(nwkSEncKey, devAddr, 42, b, false)).([]byte)
+
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
+	up := &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: devAddr,
+						FCnt:    42,
+					},
+					FPort:      lorawan.RelayFPort,
+					FRMPayload: frmPayload,
+					FullFCnt:   42,
+				},
+			},
+		},
+		Settings: ttnpb.TxSettings{
+			DataRate:      phy.DataRates[ttnpb.DATA_RATE_5].Rate,
+			DataRateIndex: ttnpb.DATA_RATE_5,
+			Frequency:     868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: gtwIDs,
+				RSSI:               -42,
+				SNR:                7,
+				UplinkToken:        []byte{0x01, 0x02},
+			},
+		},
+		CorrelationIDs: []string{"test"},
+	}
+
+	ns := &NetworkServer{
+		Component: &component.Component{},
+	}
+	relayed, err := ns.relayedUplink(context.Background(), dev, &phy, up)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(relayed, should.Resemble, &ttnpb.UplinkMessage{
+		RawPayload: relayedPHYPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      phy.DataRates[ttnpb.DATA_RATE_3].Rate,
+			DataRateIndex: ttnpb.DATA_RATE_3,
+			Frequency:     868300000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: gtwIDs,
+				Relay: &ttnpb.RelayMetadata{
+					DeviceID:   "test-relay",
+					WORChannel: ttnpb.RELAY_WOR_CHANNEL_SECONDARY,
+				},
+				RSSI:        -110,
+				ChannelRSSI: -110,
+				SNR:         -5,
+			},
+		},
+		CorrelationIDs: []string{"test"},
+	})
+
+	paths := downlinkPathsFromMetadata(relayed.RxMetadata...)
+	if a.So(paths, should.HaveLength, 1) {
+		a.So(paths[0].relay, should.Resemble, &ttnpb.RelayMetadata{
+			DeviceID:   "test-relay",
+			WORChannel: ttnpb.RELAY_WOR_CHANNEL_SECONDARY,
+		})
+	}
+
+	up.Payload.GetMACPayload().FRMPayload = frmPayload[:5]
+	_, err = ns.relayedUplink(context.Background(), dev, &phy, up)
+	a.So(err, should.HaveSameErrorDefinitionAs, errDecodePayload)
+}
+
+func TestGenerateDataDownlinkRelayUpdateUplinkList(t *testing.T) {
+	a, ctx := test.New(t)
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	devAddr := types.DevAddr{0x42, 0xff, 0xff, 0xff}
+	nwkSEncKey := types.AES128Key{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	sNwkSIntKey := types.AES128Key{0x42, 0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	servedDevAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	servedNwkSEncKey := types.AES128Key{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+
+	ns := &NetworkServer{
+		Component: c,
+		ctx:       ctx,
+		defaultMACSettings: ttnpb.MACSettings{
+			StatusTimePeriodicity:  DurationPtr(0),
+			StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+		},
+		devices: MockDeviceRegistry{
+			GetByIDFunc: func(ctx context.Context, getAppID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+				a.So(getAppID, should.Resemble, appID)
+				a.So(devID, should.Equal, "test-served")
+				return &ttnpb.EndDevice{
+					Session: &ttnpb.Session{
+						DevAddr: servedDevAddr,
+						SessionKeys: ttnpb.SessionKeys{
+							NwkSEncKey: &ttnpb.KeyEnvelope{Key: &servedNwkSEncKey},
+						},
+					},
+				}, ctx, nil
+			},
+		},
+	}
+
+	serving := func(rules ...*ttnpb.RelayUplinkForwardingRule) *ttnpb.RelayParameters {
+		return &ttnpb.RelayParameters{
+			Serving: &ttnpb.ServingRelayParameters{
+				UplinkForwardingRules: rules,
+			},
+		}
+	}
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: appID,
+			DeviceID:               "test-relay",
+			DevAddr:                &devAddr,
+		},
+		MACState: &ttnpb.MACState{
+			LoRaWANVersion: ttnpb.MAC_V1_1,
+			CurrentParameters: ttnpb.MACParameters{
+				Relay: serving(&ttnpb.RelayUplinkForwardingRule{
+					DeviceID:  "test-served",
+					LastWFCnt: 42,
+				}),
+			},
+			DesiredParameters: ttnpb.MACParameters{
+				Relay: serving(&ttnpb.RelayUplinkForwardingRule{
+					DeviceID: "test-served",
+					Limits: &ttnpb.RelayForwardLimits{
+						BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+						ReloadRate: 10,
+					},
+				}),
+			},
+			RecentUplinks: []*ttnpb.UplinkMessage{{
+				Payload: &ttnpb.Message{
+					MHDR: ttnpb.MHDR{
+						MType: ttnpb.MType_UNCONFIRMED_UP,
+					},
+					Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+				},
+			}},
+		},
+		Session: &ttnpb.Session{
+			DevAddr:       devAddr,
+			LastNFCntDown: 41,
+			SessionKeys: ttnpb.SessionKeys{
+				NwkSEncKey: &ttnpb.KeyEnvelope{
+					Key: &nwkSEncKey,
+				},
+				SNwkSIntKey: &ttnpb.KeyEnvelope{
+					Key: &sNwkSIntKey,
+				},
+			},
+		},
+		LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+		FrequencyPlanID:   band.EU_863_870,
+	}
+	phy, err := DeviceBand(dev, ns.FrequencyPlans)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	genDown, _, err := ns.generateDataDownlink(ctx, dev, phy, dev.MACState.DeviceClass, time.Now(), math.MaxUint16, math.MaxUint16)
+	if !a.So(err, should.BeNil) || !a.So(genDown, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	// The root wake on radio session key is transmitted to the relay, but not persisted.
+	req := &ttnpb.MACCommand_RelayUpdateUplinkListReq{
+		ForwardLimits: &ttnpb.RelayForwardLimits{
+			BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+			ReloadRate: 10,
+		},
+		DevAddr: servedDevAddr,
+		WFCnt:   42,
+	}
+	a.So(dev.MACState.PendingRequests, should.Resemble, []*ttnpb.MACCommand{
+		req.MACCommand(),
+	})
+
+	rootWorSKey := crypto.DeriveRootWorSKey(servedNwkSEncKey)
+	req.RootWorSKey = &rootWorSKey
+	expected, err := lorawan.DefaultMACCommands.AppendDownlink(*phy, nil, *req.MACCommand())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	pld := genDown.Payload.GetMACPayload()
+	if !a.So(pld, should.NotBeNil) || !a.So(pld.FPort, should.Equal, 0) {
+		t.FailNow()
+	}
+	a.So(test.Must(crypto.DecryptDownlink(nwkSEncKey, devAddr, pld.FullFCnt, pld.FRMPayload, false)).([]byte), should.Resemble, expected)
+}
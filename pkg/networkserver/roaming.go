@@ -0,0 +1,560 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+// rfRegions maps band IDs to the RFRegion values of LoRaWAN Backend Interfaces.
+var rfRegions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "Australia915",
+	band.CN_470_510: "China470",
+	band.CN_779_787: "China779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "India865",
+	band.KR_920_923: "SouthKorea920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US902",
+}
+
+// bandByRFRegion returns the band identified by the RFRegion of LoRaWAN Backend Interfaces.
+func bandByRFRegion(rfRegion string) (band.Band, error) {
+	for id, region := range rfRegions {
+		if region == rfRegion {
+			return band.GetByID(id)
+		}
+	}
+	return band.Band{}, errRFRegion.WithAttributes("rf_region", rfRegion)
+}
+
+// roamingGatewayIDPrefix is the prefix of the gateway ID used in the metadata of uplink messages received from roaming
+// partners.
+const roamingGatewayIDPrefix = "roaming-"
+
+func roamingGatewayID(netID types.NetID) string {
+	return roamingGatewayIDPrefix + strings.ToLower(netID.String())
+}
+
+// roamingUplinkToken is the uplink token of the metadata of uplink messages received from roaming partners.
+type roamingUplinkToken struct {
+	NetID      types.NetID
+	RFRegion   string `json:",omitempty"`
+	GatewayID  []byte `json:",omitempty"`
+	ULToken    []byte `json:",omitempty"`
+	FNSULToken []byte `json:",omitempty"`
+}
+
+func parseRoamingUplinkToken(ids *ttnpb.GatewayIdentifiers, buf []byte) (*roamingUplinkToken, bool) {
+	if ids == nil || !strings.HasPrefix(ids.GatewayID, roamingGatewayIDPrefix) {
+		return nil, false
+	}
+	token := &roamingUplinkToken{}
+	if err := json.Unmarshal(buf, token); err != nil {
+		return nil, false
+	}
+	return token, true
+}
+
+// roamingGatewayToken is the uplink token of a gateway of this Network Server that is handed out to a roaming partner.
+// The token is encrypted, so that the roaming partner can only transmit downlink messages through the gateways that
+// received the uplink messages that were forwarded to it, and only on the frequencies and data rates of the frequency
+// plan of the gateway.
+type roamingGatewayToken struct {
+	NetID           types.NetID
+	FrequencyPlanID string `json:",omitempty"`
+	UplinkToken     []byte
+}
+
+// encryptRoamingGatewayToken returns the encrypted uplink token of the gateway that received the uplink message with
+// the given metadata, to be handed out to the roaming partner identified by netID.
+func (ns *NetworkServer) encryptRoamingGatewayToken(ctx context.Context, netID types.NetID, md *ttnpb.RxMetadata) ([]byte, error) {
+	buf, err := json.Marshal(roamingGatewayToken{
+		NetID:           netID,
+		FrequencyPlanID: md.FrequencyPlanID,
+		UplinkToken:     md.UplinkToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ns.KeyVault.Encrypt(ctx, buf, ns.roamingUplinkTokenKeyID)
+}
+
+// decryptRoamingGatewayToken decrypts the uplink token that was handed out to a roaming partner.
+func (ns *NetworkServer) decryptRoamingGatewayToken(ctx context.Context, buf []byte) (*roamingGatewayToken, error) {
+	buf, err := ns.KeyVault.Decrypt(ctx, buf, ns.roamingUplinkTokenKeyID)
+	if err != nil {
+		return nil, errRoamingUplinkToken.WithCause(err)
+	}
+	token := &roamingGatewayToken{}
+	if err := json.Unmarshal(buf, token); err != nil {
+		return nil, errRoamingUplinkToken.WithCause(err)
+	}
+	return token, nil
+}
+
+// validateRoamingDownlink returns an error if the frequencies and data rates of the downlink request are not allowed by
+// the frequency plan of the gateway of the token.
+func (ns *NetworkServer) validateRoamingDownlink(token *roamingGatewayToken, req *ttnpb.TxRequest) error {
+	phy := ns.roamingDefaultBand
+	findSubBand := func(frequency uint64) bool {
+		_, ok := phy.FindSubBand(frequency)
+		return ok
+	}
+	if token.FrequencyPlanID != "" {
+		fp, err := ns.FrequencyPlans.GetByID(token.FrequencyPlanID)
+		if err != nil {
+			return err
+		}
+		fpPHY, err := band.GetByID(fp.BandID)
+		if err != nil {
+			return err
+		}
+		phy = &fpPHY
+		if len(fp.SubBands) > 0 {
+			findSubBand = func(frequency uint64) bool {
+				_, ok := fp.FindSubBand(frequency)
+				return ok
+			}
+		}
+	}
+	if phy == nil {
+		return errRoamingBand.New()
+	}
+	for _, rx := range []struct {
+		frequency uint64
+		dataRate  ttnpb.DataRateIndex
+	}{
+		{req.Rx1Frequency, req.Rx1DataRateIndex},
+		{req.Rx2Frequency, req.Rx2DataRateIndex},
+	} {
+		if rx.frequency == 0 {
+			continue
+		}
+		if !findSubBand(rx.frequency) {
+			return errRoamingDownlinkFrequency.WithAttributes("frequency", rx.frequency)
+		}
+		if _, ok := phy.DataRates[rx.dataRate]; !ok {
+			return errDataRateIndexNotFound.WithAttributes("index", rx.dataRate)
+		}
+	}
+	return nil
+}
+
+// uplinkMatchObserverKeyType is the type of the context key of the uplink match observer.
+type uplinkMatchObserverKeyType struct{}
+
+var uplinkMatchObserverKey = &uplinkMatchObserverKeyType{}
+
+// newContextWithUplinkMatchObserver returns a derived context with the given function called with the identifiers of
+// the device that matches the data uplink.
+func newContextWithUplinkMatchObserver(ctx context.Context, f func(ttnpb.EndDeviceIdentifiers)) context.Context {
+	return context.WithValue(ctx, uplinkMatchObserverKey, f)
+}
+
+func observeUplinkMatch(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) {
+	if f, ok := ctx.Value(uplinkMatchObserverKey).(func(ttnpb.EndDeviceIdentifiers)); ok {
+		f(ids)
+	}
+}
+
+// hasRoamingAgreement returns true if a roaming agreement with the Network Server identified by netID is configured.
+func (ns *NetworkServer) hasRoamingAgreement(netID types.NetID) bool {
+	if ns.interopClient == nil {
+		return false
+	}
+	for _, agreementNetID := range ns.interopClient.NetworkServerNetIDs() {
+		if agreementNetID == netID {
+			return true
+		}
+	}
+	return false
+}
+
+// isOwnDevAddr returns true if devAddr belongs to one of the DevAddr prefixes of the Network Server.
+func (ns *NetworkServer) isOwnDevAddr(devAddr types.DevAddr) bool {
+	for _, prefix := range ns.devAddrPrefixes {
+		if prefix.Matches(devAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// roamingNetID resolves the NetID of the roaming partner to which devAddr belongs.
+// roamingNetID returns false if devAddr belongs to this Network Server, or if uplink forwarding is not configured.
+func (ns *NetworkServer) roamingNetID(devAddr types.DevAddr) (types.NetID, bool) {
+	if ns.interopClient == nil || !ns.forwardRoamingUplinks || ns.isOwnDevAddr(devAddr) {
+		return types.NetID{}, false
+	}
+	for _, netID := range ns.interopClient.NetworkServerNetIDs() {
+		addr, err := types.NewDevAddr(netID, nil)
+		if err != nil {
+			continue
+		}
+		prefix := types.DevAddrPrefix{
+			DevAddr: addr,
+			Length:  uint8(32 - types.NwkAddrBits(netID)),
+		}
+		if prefix.Matches(devAddr) {
+			return netID, true
+		}
+	}
+	return types.NetID{}, false
+}
+
+// roamingGatewayBand returns the band of the gateway that received the uplink message with the given metadata.
+// The band is derived from the frequency plan of the gateway, or is the configured default band if the frequency plan is
+// unknown.
+func (ns *NetworkServer) roamingGatewayBand(md *ttnpb.RxMetadata) (*band.Band, error) {
+	if md.FrequencyPlanID == "" {
+		if ns.roamingDefaultBand == nil {
+			return nil, errRoamingBand.New()
+		}
+		return ns.roamingDefaultBand, nil
+	}
+	fp, err := ns.FrequencyPlans.GetByID(md.FrequencyPlanID)
+	if err != nil {
+		return nil, err
+	}
+	phy, err := band.GetByID(fp.BandID)
+	if err != nil {
+		return nil, err
+	}
+	return &phy, nil
+}
+
+// forwardRoamingUplink forwards the data uplink of a roaming device to the Serving Network Server identified by netID.
+// This Network Server acts as stateless Forwarding Network Server.
+func (ns *NetworkServer) forwardRoamingUplink(ctx context.Context, netID types.NetID, up *ttnpb.UplinkMessage) error {
+	pld := up.Payload.GetMACPayload()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_addr", pld.DevAddr,
+		"roaming_net_id", netID,
+	))
+
+	ok, err := ns.deduplicateUplink(ctx, up)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		registerReceiveDuplicateUplink(ctx, up)
+		return nil
+	}
+	up = CopyUplinkMessage(up)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	ns.mergeMetadata(ctx, up)
+
+	var phy *band.Band
+	gwInfo := make([]interop.GWInfoElement, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		if md.PacketBroker != nil {
+			continue
+		}
+		gtwPHY, err := ns.roamingGatewayBand(md)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("gateway_uid", unique.ID(ctx, md.GatewayIdentifiers)).Debug("Failed to determine band of gateway, skip metadata")
+			continue
+		}
+		if phy == nil {
+			phy = gtwPHY
+		}
+		rssi, snr := md.RSSI, md.SNR
+		el := interop.GWInfoElement{
+			RFRegion: rfRegions[gtwPHY.ID],
+			RSSI:     &rssi,
+			SNR:      &snr,
+		}
+		// Downlink through the gateways of this Network Server is only allowed with an encrypted uplink token.
+		if ns.roamingUplinkTokenKeyID != "" && len(md.UplinkToken) > 0 && md.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
+			token, err := ns.encryptRoamingGatewayToken(ctx, netID, md)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).WithField("gateway_uid", unique.ID(ctx, md.GatewayIdentifiers)).Warn("Failed to encrypt uplink token")
+			} else {
+				el.ULToken, el.DLAllowed = token, true
+			}
+		}
+		if md.EUI != nil {
+			el.ID = md.EUI[:]
+		}
+		if loc := md.Location; loc != nil {
+			lat, lon := loc.Latitude, loc.Longitude
+			el.Lat, el.Lon = &lat, &lon
+		}
+		gwInfo = append(gwInfo, el)
+	}
+	if phy == nil {
+		return errRoamingBand.New()
+	}
+	drIdx, _, ok := phy.FindUplinkDataRate(up.Settings.DataRate)
+	if !ok {
+		return errDataRateNotFound.New()
+	}
+	var (
+		devAddr  = interop.DevAddr(pld.DevAddr)
+		dataRate = int(drIdx)
+		ulFreq   = float64(up.Settings.Frequency) / 1e6
+		gwCnt    = len(gwInfo)
+	)
+	if _, err := ns.interopClient.PRStartRequest(ctx, netID, &interop.PRStartReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			SenderID: interop.NetID(ns.netID),
+		},
+		PHYPayload: interop.Buffer(up.RawPayload),
+		ULMetaData: interop.ULMetaData{
+			DevAddr:  &devAddr,
+			DataRate: &dataRate,
+			ULFreq:   &ulFreq,
+			RecvTime: up.ReceivedAt,
+			RFRegion: rfRegions[phy.ID],
+			GWCnt:    &gwCnt,
+			GWInfo:   gwInfo,
+		},
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to forward uplink to roaming partner")
+		return err
+	}
+	log.FromContext(ctx).WithField("metadata_count", gwCnt).Debug("Forwarded uplink to roaming partner")
+	return nil
+}
+
+// roamingUplinkFromPRStartReq returns the uplink message contained in the PRStartReq.
+func roamingUplinkFromPRStartReq(req *interop.PRStartReq) (*ttnpb.UplinkMessage, error) {
+	md := req.ULMetaData
+	if md.DataRate == nil || md.ULFreq == nil {
+		return nil, errInvalidPayload.New()
+	}
+	phy, err := bandByRFRegion(md.RFRegion)
+	if err != nil {
+		return nil, err
+	}
+	drIdx := ttnpb.DataRateIndex(*md.DataRate)
+	dr, ok := phy.DataRates[drIdx]
+	if !ok {
+		return nil, errDataRateIndexNotFound.WithAttributes("index", drIdx)
+	}
+	netID := types.NetID(req.SenderID)
+	up := &ttnpb.UplinkMessage{
+		RawPayload: req.PHYPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      dr.Rate,
+			DataRateIndex: drIdx,
+			Frequency:     uint64(math.Round(*md.ULFreq * 1e6)),
+		},
+		RxMetadata: make([]*ttnpb.RxMetadata, 0, len(md.GWInfo)),
+	}
+	for _, gw := range md.GWInfo {
+		token, err := json.Marshal(roamingUplinkToken{
+			NetID:      netID,
+			RFRegion:   md.RFRegion,
+			GatewayID:  gw.ID,
+			ULToken:    gw.ULToken,
+			FNSULToken: md.FNSULToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rxMD := &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: roamingGatewayID(netID),
+			},
+			UplinkToken:            token,
+			DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if len(gw.ID) == 8 {
+			var eui types.EUI64
+			copy(eui[:], gw.ID)
+			rxMD.EUI = &eui
+		}
+		if gw.DLAllowed && len(gw.ULToken) > 0 {
+			rxMD.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE
+		}
+		if gw.RSSI != nil {
+			rxMD.RSSI, rxMD.ChannelRSSI = *gw.RSSI, *gw.RSSI
+		}
+		if gw.SNR != nil {
+			rxMD.SNR = *gw.SNR
+		}
+		if gw.Lat != nil && gw.Lon != nil {
+			rxMD.Location = &ttnpb.Location{
+				Latitude:  *gw.Lat,
+				Longitude: *gw.Lon,
+				Source:    ttnpb.SOURCE_REGISTRY,
+			}
+		}
+		up.RxMetadata = append(up.RxMetadata, rxMD)
+	}
+	return up, nil
+}
+
+// transmitRoamingDownlink transmits the downlink of a roaming device received from the Serving Network Server identified
+// by netID through the gateways in the downlink metadata.
+// Only the gateways of the uplink tokens that were handed out to the Serving Network Server are used.
+func (ns *NetworkServer) transmitRoamingDownlink(ctx context.Context, netID types.NetID, phyPayload []byte, md *interop.DLMetaData) error {
+	if !ns.forwardRoamingUplinks || ns.roamingUplinkTokenKeyID == "" {
+		return errRoamingNotConfigured.New()
+	}
+	req := &ttnpb.TxRequest{
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	switch md.ClassMode {
+	case "", "A":
+		req.Class = ttnpb.CLASS_A
+	case "B":
+		req.Class = ttnpb.CLASS_B
+	case "C":
+		req.Class = ttnpb.CLASS_C
+	default:
+		return errInvalidFieldValue.WithAttributes("field", "ClassMode")
+	}
+	if md.HiPriorityFlag {
+		req.Priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	if md.RXDelay1 != nil {
+		req.Rx1Delay = ttnpb.RxDelay(*md.RXDelay1)
+	}
+	if md.DLFreq1 != nil && md.DataRate1 != nil {
+		req.Rx1Frequency = uint64(math.Round(*md.DLFreq1 * 1e6))
+		req.Rx1DataRateIndex = ttnpb.DataRateIndex(*md.DataRate1)
+	}
+	if md.DLFreq2 != nil && md.DataRate2 != nil {
+		req.Rx2Frequency = uint64(math.Round(*md.DLFreq2 * 1e6))
+		req.Rx2DataRateIndex = ttnpb.DataRateIndex(*md.DataRate2)
+	}
+
+	logger := log.FromContext(ctx)
+	for _, gw := range md.GWInfo {
+		if len(gw.ULToken) == 0 {
+			continue
+		}
+		gtwToken, err := ns.decryptRoamingGatewayToken(ctx, gw.ULToken)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to decrypt uplink token")
+			continue
+		}
+		if gtwToken.NetID != netID {
+			logger.WithField("token_net_id", gtwToken.NetID).Debug("Uplink token was handed out to another roaming partner")
+			continue
+		}
+		if err := ns.validateRoamingDownlink(gtwToken, req); err != nil {
+			logger.WithError(err).Debug("Invalid roaming downlink")
+			continue
+		}
+		token := &ttnpb.UplinkToken{}
+		if err := token.Unmarshal(gtwToken.UplinkToken); err != nil {
+			logger.WithError(err).Debug("Failed to parse uplink token")
+			continue
+		}
+		peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, token.GatewayIdentifiers)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get Gateway Server peer")
+			continue
+		}
+		req.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: gtwToken.UplinkToken,
+				},
+			},
+		}
+		target := &gatewayServerDownlinkTarget{peer: peer}
+		if _, err := target.Schedule(ctx, &ttnpb.DownlinkMessage{
+			RawPayload: phyPayload,
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: req,
+			},
+		}, ns.WithClusterAuth()); err != nil {
+			logger.WithError(err).WithField("gateway_uid", token.GatewayID).Debug("Failed to schedule roaming downlink")
+			continue
+		}
+		return nil
+	}
+	return errSchedule.New()
+}
+
+// roamingDownlinkTarget is the downlink target for downlink through the Forwarding Network Server of a roaming partner.
+type roamingDownlinkTarget struct {
+	client   InteropClient
+	senderID types.NetID
+	netID    types.NetID
+}
+
+func (t *roamingDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*roamingDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.netID == t.netID
+}
+
+func (t *roamingDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption) (time.Duration, error) {
+	req := msg.GetRequest()
+	if req == nil {
+		return 0, errInvalidPayload.New()
+	}
+	md := &interop.DLMetaData{
+		ClassMode:      strings.TrimPrefix(req.Class.String(), "CLASS_"),
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	if req.Rx1Frequency != 0 {
+		rx1Delay, dlFreq1, dataRate1 := int(req.Rx1Delay), float64(req.Rx1Frequency)/1e6, int(req.Rx1DataRateIndex)
+		md.RXDelay1, md.DLFreq1, md.DataRate1 = &rx1Delay, &dlFreq1, &dataRate1
+	}
+	if req.Rx2Frequency != 0 {
+		dlFreq2, dataRate2 := float64(req.Rx2Frequency)/1e6, int(req.Rx2DataRateIndex)
+		md.DLFreq2, md.DataRate2 = &dlFreq2, &dataRate2
+	}
+	for _, path := range req.DownlinkPaths {
+		token := &roamingUplinkToken{}
+		if err := json.Unmarshal(path.GetUplinkToken(), token); err != nil {
+			return 0, errRoamingUplinkToken.WithCause(err)
+		}
+		md.FNSULToken = token.FNSULToken
+		md.GWInfo = append(md.GWInfo, interop.GWInfoElement{
+			ID:       token.GatewayID,
+			RFRegion: token.RFRegion,
+			ULToken:  token.ULToken,
+		})
+	}
+	if _, err := t.client.XmitDataRequest(ctx, t.netID, &interop.XmitDataReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			SenderID: interop.NetID(t.senderID),
+		},
+		PHYPayload: interop.Buffer(msg.RawPayload),
+		DLMetaData: md,
+	}); err != nil {
+		return 0, err
+	}
+	return peeringScheduleDelay, nil
+}
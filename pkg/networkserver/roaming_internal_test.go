@@ -0,0 +1,603 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRoamingNetID(t *testing.T) {
+	partnerNetID := types.NetID{0x00, 0x00, 0x13}
+	ns := &NetworkServer{
+		netID: types.NetID{0x00, 0x00, 0x01},
+		devAddrPrefixes: []types.DevAddrPrefix{
+			{
+				DevAddr: types.DevAddr{0x02, 0x00, 0x00, 0x00},
+				Length:  7,
+			},
+		},
+		interopClient: MockInteropClient{
+			NetworkServerNetIDsFunc: func() []types.NetID {
+				return []types.NetID{partnerNetID}
+			},
+		},
+		forwardRoamingUplinks: true,
+	}
+
+	for _, tc := range []struct {
+		Name          string
+		DevAddr       types.DevAddr
+		ExpectedNetID types.NetID
+		ExpectedOK    bool
+	}{
+		{
+			Name:    "Own",
+			DevAddr: types.DevAddr{0x02, 0x01, 0x02, 0x03},
+		},
+		{
+			Name:          "Partner",
+			DevAddr:       types.DevAddr{0x26, 0x01, 0x02, 0x03},
+			ExpectedNetID: partnerNetID,
+			ExpectedOK:    true,
+		},
+		{
+			Name:    "Unknown",
+			DevAddr: types.DevAddr{0x42, 0x01, 0x02, 0x03},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			netID, ok := ns.roamingNetID(tc.DevAddr)
+			a.So(ok, should.Equal, tc.ExpectedOK)
+			a.So(netID, should.Equal, tc.ExpectedNetID)
+		})
+	}
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		a := assertions.New(t)
+		ns := *ns
+		ns.forwardRoamingUplinks = false
+		_, ok := ns.roamingNetID(types.DevAddr{0x26, 0x01, 0x02, 0x03})
+		a.So(ok, should.BeFalse)
+	})
+}
+
+func TestRoamingUplinkFromPRStartReq(t *testing.T) {
+	a := assertions.New(t)
+
+	dataRate, ulFreq := 5, 868.1
+	rssi, snr := float32(-42), float32(7.5)
+	req := &interop.PRStartReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			SenderID:   interop.NetID{0x00, 0x00, 0x13},
+			ReceiverID: interop.NetID{0x00, 0x00, 0x01},
+		},
+		PHYPayload: interop.Buffer{0x40, 0x03, 0x02, 0x01, 0x02},
+		ULMetaData: interop.ULMetaData{
+			DataRate:   &dataRate,
+			ULFreq:     &ulFreq,
+			RFRegion:   "EU868",
+			FNSULToken: interop.Buffer{0x42},
+			GWInfo: []interop.GWInfoElement{
+				{
+					ID:   interop.Buffer{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+					RSSI: &rssi,
+					SNR:  &snr,
+				},
+				{
+					ID:        interop.Buffer{0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01},
+					ULToken:   interop.Buffer{0x01, 0x02},
+					DLAllowed: true,
+				},
+			},
+		},
+	}
+
+	up, err := roamingUplinkFromPRStartReq(req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	phy := test.Must(band.GetByID(band.EU_863_870)).(band.Band)
+	a.So(up.RawPayload, should.Resemble, []byte{0x40, 0x03, 0x02, 0x01, 0x02})
+	a.So(up.Settings.Frequency, should.Equal, 868100000)
+	a.So(up.Settings.DataRateIndex, should.Equal, ttnpb.DATA_RATE_5)
+	a.So(up.Settings.DataRate, should.Resemble, phy.DataRates[ttnpb.DATA_RATE_5].Rate)
+	if !a.So(up.RxMetadata, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(up.RxMetadata[0].GatewayID, should.Equal, "roaming-000013")
+	a.So(up.RxMetadata[0].EUI, should.Resemble, &types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+	a.So(up.RxMetadata[0].RSSI, should.Equal, rssi)
+	a.So(up.RxMetadata[0].SNR, should.Equal, snr)
+	a.So(up.RxMetadata[0].DownlinkPathConstraint, should.Equal, ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER)
+	a.So(up.RxMetadata[1].DownlinkPathConstraint, should.Equal, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE)
+
+	paths := downlinkPathsFromMetadata(up.RxMetadata...)
+	if !a.So(paths, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	token, ok := parseRoamingUplinkToken(paths[0].GatewayIdentifiers, paths[0].GetUplinkToken())
+	if a.So(ok, should.BeTrue) {
+		a.So(token, should.Resemble, &roamingUplinkToken{
+			NetID:      types.NetID{0x00, 0x00, 0x13},
+			RFRegion:   "EU868",
+			GatewayID:  []byte{0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01},
+			ULToken:    []byte{0x01, 0x02},
+			FNSULToken: []byte{0x42},
+		})
+	}
+
+	req.ULMetaData.RFRegion = "Unknown"
+	_, err = roamingUplinkFromPRStartReq(req)
+	a.So(err, should.HaveSameErrorDefinitionAs, errRFRegion)
+}
+
+func TestPRStartRequest(t *testing.T) {
+	a, ctx := test.New(t)
+
+	netID := types.NetID{0x00, 0x00, 0x01}
+	partnerNetID := types.NetID{0x00, 0x00, 0x13}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devAddr := types.DevAddr{0x02, 0x01, 0x02, 0x03}
+	key := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+			DevEUI:                 &devEUI,
+			DevAddr:                &devAddr,
+		},
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+		Session: &ttnpb.Session{
+			DevAddr: devAddr,
+			SessionKeys: ttnpb.SessionKeys{
+				FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &key},
+				SNwkSIntKey: &ttnpb.KeyEnvelope{Key: &key},
+				NwkSEncKey:  &ttnpb.KeyEnvelope{Key: &key},
+			},
+		},
+	}
+	dev.MACState = test.Must(mac.NewState(dev, c.FrequencyPlans, ttnpb.MACSettings{})).(*ttnpb.MACState)
+
+	raw := []byte{0x40, devAddr[3], devAddr[2], devAddr[1], devAddr[0], 0x00, 0x01, 0x00, 0x01, 0x42}
+	mic := test.Must(crypto.ComputeLegacyUplinkMIC(key, devAddr, 1, raw)).([4]byte)
+	raw = append(raw, mic[:]...)
+
+	var duplicates int
+	ns := &NetworkServer{
+		Component: c,
+		ctx:       ctx,
+		netID:     netID,
+		devAddrPrefixes: []types.DevAddrPrefix{
+			{
+				DevAddr: types.DevAddr{0x02, 0x00, 0x00, 0x00},
+				Length:  7,
+			},
+		},
+		interopClient: MockInteropClient{
+			NetworkServerNetIDsFunc: func() []types.NetID {
+				return []types.NetID{partnerNetID}
+			},
+		},
+		collectionWindow: makeWindowDurationFunc(0),
+		devices: MockDeviceRegistry{
+			RangeByUplinkMatchesFunc: func(ctx context.Context, up *ttnpb.UplinkMessage, _ time.Duration, f func(context.Context, *UplinkMatch) (bool, error)) error {
+				a.So(up.Payload.GetMACPayload().DevAddr, should.Equal, devAddr)
+				_, err := f(ctx, &UplinkMatch{
+					ApplicationIdentifiers: dev.ApplicationIdentifiers,
+					DeviceID:               dev.DeviceID,
+					LoRaWANVersion:         dev.LoRaWANVersion,
+					FNwkSIntKey:            dev.Session.FNwkSIntKey,
+				})
+				return err
+			},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, _ []string) (*ttnpb.EndDevice, context.Context, error) {
+				return CopyEndDevice(dev), ctx, nil
+			},
+		},
+		uplinkDeduplicator: MockUplinkDeduplicator{
+			// Handle the uplink as a duplicate, so that the device is matched but not updated.
+			DeduplicateUplinkFunc: func(context.Context, *ttnpb.UplinkMessage, time.Duration) (bool, error) {
+				duplicates++
+				return false, nil
+			},
+		},
+	}
+	ns.interop = interopServer{NS: ns}
+
+	dataRate, ulFreq := 5, 868.1
+	rawDevAddr := interop.DevAddr(devAddr)
+	req := &interop.PRStartReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: "1.0",
+				MessageType:     interop.MessageTypePRStartReq,
+				TransactionID:   42,
+			},
+			SenderID:   interop.NetID(partnerNetID),
+			ReceiverID: interop.NetID(netID),
+		},
+		PHYPayload: raw,
+		ULMetaData: interop.ULMetaData{
+			DevAddr:  &rawDevAddr,
+			DataRate: &dataRate,
+			ULFreq:   &ulFreq,
+			RFRegion: "EU868",
+			GWInfo: []interop.GWInfoElement{
+				{
+					ID: interop.Buffer{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				},
+			},
+		},
+	}
+
+	ans, err := ns.interop.PRStartRequest(ctx, req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(duplicates, should.Equal, 1)
+	a.So(ans.MessageType, should.Equal, interop.MessageTypePRStartAns)
+	a.So(ans.TransactionID, should.Equal, 42)
+	a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+	a.So(ans.DevEUI, should.Resemble, (*interop.EUI64)(&devEUI))
+	if a.So(ans.Lifetime, should.NotBeNil) {
+		a.So(*ans.Lifetime, should.Equal, 0)
+	}
+	a.So(ans.ServiceProfile, should.Resemble, &interop.ServiceProfile{
+		AddGWMetadata: true,
+		PRAllowed:     true,
+	})
+	a.So(ans.FNwkSIntKey, should.BeNil)
+	a.So(ans.NwkSKey, should.BeNil)
+
+	t.Run("UnknownDevAddr", func(t *testing.T) {
+		a := assertions.New(t)
+		req := *req
+		ns := *ns
+		ns.devices = MockDeviceRegistry{
+			RangeByUplinkMatchesFunc: func(context.Context, *ttnpb.UplinkMessage, time.Duration, func(context.Context, *UplinkMatch) (bool, error)) error {
+				return nil
+			},
+		}
+		_, err := interopServer{NS: &ns}.PRStartRequest(ctx, &req)
+		a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownDevAddr)
+	})
+}
+
+func TestForwardRoamingUplinkBand(t *testing.T) {
+	netID := types.NetID{0x00, 0x00, 0x01}
+	partnerNetID := types.NetID{0x00, 0x00, 0x13}
+	euPHY := test.Must(band.GetByID(band.EU_863_870)).(band.Band)
+
+	c := component.MustNew(log.Noop, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	makeUplink := func(fpIDs ...string) *ttnpb.UplinkMessage {
+		up := &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x03, 0x02, 0x01, 0x26, 0x00, 0x01, 0x00, 0x01, 0x42, 0x42, 0x42, 0x42, 0x42},
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_UP,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: types.DevAddr{0x26, 0x01, 0x02, 0x03},
+						},
+					},
+				},
+			},
+			Settings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 7,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				Frequency: 868100000,
+			},
+			ReceivedAt: time.Now(),
+		}
+		for i, fpID := range fpIDs {
+			up.RxMetadata = append(up.RxMetadata, &ttnpb.RxMetadata{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: fmt.Sprintf("test-gtw-%d", i),
+					EUI:       &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, byte(i)},
+				},
+				FrequencyPlanID: fpID,
+			})
+		}
+		return up
+	}
+
+	for _, tc := range []struct {
+		Name              string
+		DefaultBand       *band.Band
+		Uplink            *ttnpb.UplinkMessage
+		ErrorAssertion    func(error) bool
+		ExpectedRFRegion  string
+		ExpectedGWRegions []string
+		ExpectedDataRate  int
+	}{
+		{
+			Name:              "FrequencyPlan",
+			Uplink:            makeUplink(test.EUFrequencyPlanID, test.USFrequencyPlanID),
+			ExpectedRFRegion:  "EU868",
+			ExpectedGWRegions: []string{"EU868", "US902"},
+			ExpectedDataRate:  5,
+		},
+		{
+			Name:              "FrequencyPlan/UnknownSkipped",
+			Uplink:            makeUplink("", test.EUFrequencyPlanID),
+			ExpectedRFRegion:  "EU868",
+			ExpectedGWRegions: []string{"EU868"},
+			ExpectedDataRate:  5,
+		},
+		{
+			Name:              "DefaultBand",
+			DefaultBand:       &euPHY,
+			Uplink:            makeUplink("", ""),
+			ExpectedRFRegion:  "EU868",
+			ExpectedGWRegions: []string{"EU868", "EU868"},
+			ExpectedDataRate:  5,
+		},
+		{
+			Name:           "NoBand",
+			Uplink:         makeUplink("", ""),
+			ErrorAssertion: errRoamingBand.Is,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a, ctx := test.New(t)
+
+			var req *interop.PRStartReq
+			ns := &NetworkServer{
+				Component:             c,
+				ctx:                   ctx,
+				netID:                 netID,
+				forwardRoamingUplinks: true,
+				roamingDefaultBand:    tc.DefaultBand,
+				interopClient: MockInteropClient{
+					PRStartRequestFunc: func(_ context.Context, reqNetID types.NetID, r *interop.PRStartReq) (*interop.PRStartAns, error) {
+						a.So(reqNetID, should.Equal, partnerNetID)
+						req = r
+						return &interop.PRStartAns{}, nil
+					},
+				},
+				deduplicationWindow: makeWindowDurationFunc(0),
+				collectionWindow:    makeWindowDurationFunc(0),
+				uplinkDeduplicator: MockUplinkDeduplicator{
+					DeduplicateUplinkFunc: func(context.Context, *ttnpb.UplinkMessage, time.Duration) (bool, error) {
+						return true, nil
+					},
+					AccumulatedMetadataFunc: func(_ context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
+						return up.RxMetadata, nil
+					},
+				},
+			}
+
+			err := ns.forwardRoamingUplink(ctx, partnerNetID, tc.Uplink)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				a.So(req, should.BeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) || !a.So(req, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(req.ULMetaData.RFRegion, should.Equal, tc.ExpectedRFRegion)
+			if a.So(req.ULMetaData.DataRate, should.NotBeNil) {
+				a.So(*req.ULMetaData.DataRate, should.Equal, tc.ExpectedDataRate)
+			}
+			gwRegions := make([]string, 0, len(req.ULMetaData.GWInfo))
+			for _, gw := range req.ULMetaData.GWInfo {
+				gwRegions = append(gwRegions, gw.RFRegion)
+			}
+			a.So(gwRegions, should.Resemble, tc.ExpectedGWRegions)
+		})
+	}
+}
+
+func TestRoamingGatewayToken(t *testing.T) {
+	a, ctx := test.New(t)
+
+	partnerNetID := types.NetID{0x00, 0x00, 0x13}
+	uplinkToken := test.Must((&ttnpb.UplinkToken{
+		GatewayAntennaIdentifiers: ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"},
+		},
+		Timestamp:        42,
+		ServerTime:       time.Unix(42, 0).UTC(),
+		ConcentratorTime: 42000,
+	}).Marshal()).([]byte)
+
+	c := component.MustNew(log.Noop, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	c.KeyVault = cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+	})
+	ns := &NetworkServer{
+		Component:               c,
+		ctx:                     ctx,
+		netID:                   types.NetID{0x00, 0x00, 0x01},
+		forwardRoamingUplinks:   true,
+		roamingUplinkTokenKeyID: "test",
+	}
+
+	buf, err := ns.encryptRoamingGatewayToken(ctx, partnerNetID, &ttnpb.RxMetadata{
+		FrequencyPlanID: test.EUFrequencyPlanID,
+		UplinkToken:     uplinkToken,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(buf, should.NotResemble, uplinkToken)
+	token, err := ns.decryptRoamingGatewayToken(ctx, buf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(token, should.Resemble, &roamingGatewayToken{
+		NetID:           partnerNetID,
+		FrequencyPlanID: test.EUFrequencyPlanID,
+		UplinkToken:     uplinkToken,
+	})
+
+	t.Run("Unencrypted", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := ns.decryptRoamingGatewayToken(ctx, uplinkToken)
+		a.So(err, should.HaveSameErrorDefinitionAs, errRoamingUplinkToken)
+	})
+
+	t.Run("OtherRoamingPartner", func(t *testing.T) {
+		a := assertions.New(t)
+		err := ns.transmitRoamingDownlink(ctx, types.NetID{0x00, 0x00, 0x14}, []byte{0x60}, &interop.DLMetaData{
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: buf},
+			},
+		})
+		a.So(err, should.HaveSameErrorDefinitionAs, errSchedule)
+	})
+
+	for _, tc := range []struct {
+		Name           string
+		Request        *ttnpb.TxRequest
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Valid",
+			Request: &ttnpb.TxRequest{
+				Rx1Frequency:     868100000,
+				Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+				Rx2Frequency:     869525000,
+				Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+			},
+		},
+		{
+			Name: "InvalidFrequency",
+			Request: &ttnpb.TxRequest{
+				Rx1Frequency:     915200000,
+				Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+			},
+			ErrorAssertion: errRoamingDownlinkFrequency.Is,
+		},
+		{
+			Name: "InvalidDataRate",
+			Request: &ttnpb.TxRequest{
+				Rx2Frequency:     869525000,
+				Rx2DataRateIndex: ttnpb.DATA_RATE_15,
+			},
+			ErrorAssertion: errDataRateIndexNotFound.Is,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := ns.validateRoamingDownlink(token, tc.Request)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
+
+func TestPRStopRequest(t *testing.T) {
+	a, ctx := test.New(t)
+
+	netID := types.NetID{0x00, 0x00, 0x01}
+	partnerNetID := types.NetID{0x00, 0x00, 0x13}
+
+	ns := &NetworkServer{
+		netID: netID,
+		interopClient: MockInteropClient{
+			NetworkServerNetIDsFunc: func() []types.NetID {
+				return []types.NetID{partnerNetID}
+			},
+		},
+	}
+	srv := interopServer{NS: ns}
+
+	req := &interop.PRStopReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: "1.0",
+				MessageType:     interop.MessageTypePRStopReq,
+				TransactionID:   42,
+			},
+			SenderID:   interop.NetID(partnerNetID),
+			ReceiverID: interop.NetID(netID),
+		},
+	}
+	ans, err := srv.PRStopRequest(ctx, req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ans.MessageType, should.Equal, interop.MessageTypePRStopAns)
+	a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+
+	t.Run("UnknownReceiver", func(t *testing.T) {
+		a := assertions.New(t)
+		req := *req
+		req.ReceiverID = interop.NetID{0x00, 0x00, 0x02}
+		_, err := srv.PRStopRequest(ctx, &req)
+		a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownReceiver)
+	})
+
+	t.Run("NoRoamingAgreement", func(t *testing.T) {
+		a := assertions.New(t)
+		req := *req
+		req.SenderID = interop.NetID{0x00, 0x00, 0x14}
+		_, err := srv.PRStopRequest(ctx, &req)
+		a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrNoRoamingAgreement)
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// EndDevice is the database model of an end device in the Network Server.
+// The end device is stored as a whole in Data, the other columns are indexes.
+type EndDevice struct {
+	ID string `gorm:"type:UUID;primary_key;default:gen_random_uuid()"`
+
+	ApplicationID string  `gorm:"type:VARCHAR(36);not null;unique_index:ns_end_device_id_index"`
+	DeviceID      string  `gorm:"type:VARCHAR(36);not null;unique_index:ns_end_device_id_index"`
+	JoinEUI       *string `gorm:"type:VARCHAR(16);unique_index:ns_end_device_eui_index"`
+	DevEUI        *string `gorm:"type:VARCHAR(16);unique_index:ns_end_device_eui_index;index:ns_end_device_dev_eui_index"`
+
+	// SessionDevAddr is the DevAddr of the current session.
+	SessionDevAddr *string `gorm:"type:VARCHAR(8);index:ns_end_device_session_dev_addr_index"`
+	// SessionFCntLSB are the 16 least significant bits of the last uplink frame counter of the current session.
+	SessionFCntLSB int `gorm:"type:INTEGER;not null;default:0"`
+	// PendingSessionDevAddr is the DevAddr of the pending session.
+	PendingSessionDevAddr *string `gorm:"type:VARCHAR(8);index:ns_end_device_pending_session_dev_addr_index"`
+	// PendingSessionAt is the time at which the pending session was created.
+	PendingSessionAt *time.Time
+
+	Data []byte `gorm:"type:BYTEA;not null"`
+}
+
+// TableName implements the gorm tabler interface.
+func (EndDevice) TableName() string { return "ns_end_devices" }
+
+func (m *EndDevice) fromPB(pb *ttnpb.EndDevice, now time.Time) error {
+	data, err := pb.Marshal()
+	if err != nil {
+		return err
+	}
+	m.ApplicationID = pb.ApplicationID
+	m.DeviceID = pb.DeviceID
+	m.JoinEUI, m.DevEUI = nil, nil
+	if pb.JoinEUI != nil && pb.DevEUI != nil {
+		joinEUI := pb.JoinEUI.String()
+		m.JoinEUI = &joinEUI
+	}
+	if pb.DevEUI != nil {
+		devEUI := pb.DevEUI.String()
+		m.DevEUI = &devEUI
+	}
+
+	m.SessionDevAddr, m.SessionFCntLSB = nil, 0
+	if pb.Session != nil {
+		devAddr := pb.Session.DevAddr.String()
+		m.SessionDevAddr = &devAddr
+		m.SessionFCntLSB = int(pb.Session.LastFCntUp & 0xffff)
+	}
+
+	if pb.PendingSession == nil {
+		m.PendingSessionDevAddr, m.PendingSessionAt = nil, nil
+	} else if devAddr := pb.PendingSession.DevAddr.String(); m.PendingSessionDevAddr == nil || *m.PendingSessionDevAddr != devAddr {
+		m.PendingSessionDevAddr = &devAddr
+		m.PendingSessionAt = &now
+	}
+	m.Data = data
+	return nil
+}
+
+func (m EndDevice) toPB() (*ttnpb.EndDevice, error) {
+	pb := &ttnpb.EndDevice{}
+	if err := pb.Unmarshal(m.Data); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -0,0 +1,379 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql implements the Network Server device registry on top of an SQL database.
+package sql
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
+)
+
+var (
+	errDatabase             = errors.DefineInternal("database", "database error")
+	errDeviceNotFound       = errors.DefineNotFound("device_not_found", "device not found")
+	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errInvalidDevice        = errors.DefineInvalidArgument("invalid_device", "device is invalid")
+	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errNoUplinkMatch        = errors.DefineNotFound("no_uplink_match", "no device matches uplink")
+	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+func convertError(err error) error {
+	switch err {
+	case nil, context.Canceled, context.DeadlineExceeded:
+		return err
+	}
+	if gorm.IsRecordNotFoundError(err) {
+		return errDeviceNotFound.WithCause(err)
+	}
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnErr
+	}
+	return errDatabase.WithCause(err)
+}
+
+// AutoMigrate automatically migrates the schema of the device registry tables.
+func AutoMigrate(db *gorm.DB) *gorm.DB {
+	return db.AutoMigrate(&EndDevice{})
+}
+
+// DeviceRegistry is an SQL implementation of networkserver.DeviceRegistry.
+type DeviceRegistry struct {
+	DB *gorm.DB
+}
+
+// NewDeviceRegistry returns a new SQL device registry on top of the given database connection.
+func NewDeviceRegistry(db *gorm.DB) *DeviceRegistry {
+	return &DeviceRegistry{DB: db}
+}
+
+func (r *DeviceRegistry) findByID(db *gorm.DB, ids ttnpb.EndDeviceIdentifiers) (*EndDevice, error) {
+	var model EndDevice
+	if err := db.Where(&EndDevice{
+		ApplicationID: ids.ApplicationID,
+		DeviceID:      ids.DeviceID,
+	}).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+func filterGetEndDevice(model *EndDevice, paths ...string) (*ttnpb.EndDevice, error) {
+	pb, err := model.toPB()
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	model, err := r.findByID(r.DB, ids)
+	if err != nil {
+		return nil, ctx, convertError(err)
+	}
+	pb, err := filterGetEndDevice(model, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	var model EndDevice
+	if err := r.DB.Where("join_eui = ? AND dev_eui = ?", joinEUI.String(), devEUI.String()).First(&model).Error; err != nil {
+		return nil, ctx, convertError(err)
+	}
+	pb, err := filterGetEndDevice(&model, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// RangeByDevEUI ranges over devices with devEUI.
+func (r *DeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_eui").End()
+
+	var models []EndDevice
+	if err := r.DB.Where("dev_eui = ?", devEUI.String()).Order("application_id, device_id").Find(&models).Error; err != nil {
+		return convertError(err)
+	}
+	for i := range models {
+		pb, err := filterGetEndDevice(&models[i], paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// RangeByUplinkMatches ranges over devices matching the uplink.
+// Devices are matched by the DevAddr of the current session first, ordered by the proximity of the
+// last uplink frame counter to the frame counter of the uplink. Devices with a pending session with
+// matching DevAddr are matched last, most recent pending session first.
+// The match results are not cached, as the devices may be updated by other Network Server instances.
+func (r *DeviceRegistry) RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, *networkserver.UplinkMatch) (bool, error)) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_addr").End()
+
+	pld := up.Payload.GetMACPayload()
+
+	var matches []*networkserver.UplinkMatch
+
+	devAddr := pld.DevAddr.String()
+	lsb := int(pld.FCnt & 0xffff)
+	var current []EndDevice
+	if err := r.DB.Where("session_dev_addr = ?", devAddr).
+		Order(fmt.Sprintf("session_f_cnt_lsb <= %d DESC, session_f_cnt_lsb DESC", lsb)).
+		Find(&current).Error; err != nil {
+		return convertError(err)
+	}
+	for i := range current {
+		dev, err := current[i].toPB()
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_id", current[i].DeviceID).Error("Failed to unmarshal device")
+			return errDatabase.WithCause(err)
+		}
+		if current[i].SessionFCntLSB > lsb {
+			// NOTE: The frame counter of the uplink is lower than the last frame counter of the session.
+			// This is only possible if the 16 least significant bits of the frame counter rolled over,
+			// or if the device reset its frame counter.
+			supports32BitFCnt := dev.GetMACSettings().GetSupports32BitFCnt()
+			resetsFCnt := dev.GetMACSettings().GetResetsFCnt()
+			if supports32BitFCnt != nil && !supports32BitFCnt.Value &&
+				(pld.Ack || resetsFCnt == nil || !resetsFCnt.Value) {
+				continue
+			}
+		}
+		matches = append(matches, &networkserver.UplinkMatch{
+			ApplicationIdentifiers: dev.ApplicationIdentifiers,
+			DeviceID:               dev.DeviceID,
+			LoRaWANVersion:         dev.GetMACState().GetLoRaWANVersion(),
+			FNwkSIntKey:            dev.GetSession().GetFNwkSIntKey(),
+			LastFCnt:               dev.GetSession().GetLastFCntUp(),
+			ResetsFCnt:             dev.GetMACSettings().GetResetsFCnt(),
+			Supports32BitFCnt:      dev.GetMACSettings().GetSupports32BitFCnt(),
+		})
+	}
+
+	if !pld.Ack {
+		var pending []EndDevice
+		if err := r.DB.Where("pending_session_dev_addr = ?", devAddr).
+			Order("pending_session_at DESC").
+			Find(&pending).Error; err != nil {
+			return convertError(err)
+		}
+		for i := range pending {
+			dev, err := pending[i].toPB()
+			if err != nil {
+				log.FromContext(ctx).WithError(err).WithField("device_id", pending[i].DeviceID).Error("Failed to unmarshal device")
+				return errDatabase.WithCause(err)
+			}
+			matches = append(matches, &networkserver.UplinkMatch{
+				ApplicationIdentifiers: dev.ApplicationIdentifiers,
+				DeviceID:               dev.DeviceID,
+				LoRaWANVersion:         dev.GetPendingMACState().GetLoRaWANVersion(),
+				FNwkSIntKey:            dev.GetPendingSession().GetFNwkSIntKey(),
+				IsPending:              true,
+			})
+		}
+	}
+	if len(matches) == 0 {
+		return errNoUplinkMatch.New()
+	}
+
+	for _, match := range matches {
+		ctx := log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: match.ApplicationIdentifiers,
+			DeviceID:               match.DeviceID,
+		}))
+		ok, err := f(ctx, match)
+		if err != nil {
+			return errNoUplinkMatch.WithCause(err)
+		}
+		if ok {
+			return nil
+		}
+	}
+	return errNoUplinkMatch.New()
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(ctx context.Context, pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.EndDevice
+	if err := gormutil.Transact(ctx, r.DB, func(db *gorm.DB) error {
+		model, err := r.findByID(db.Set("gorm:query_option", "FOR UPDATE"), ids)
+		if gorm.IsRecordNotFoundError(err) {
+			model = nil
+		} else if err != nil {
+			return err
+		}
+
+		var stored *ttnpb.EndDevice
+		if model != nil {
+			stored, err = model.toPB()
+			if err != nil {
+				return err
+			}
+			pb, err = filterGetEndDevice(model, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(ctx, pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			return db.Delete(model).Error
+		}
+
+		if err := pb.ValidateFields(sets...); err != nil {
+			return err
+		}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+			if pb.ApplicationIdentifiers != appID || pb.DeviceID != devID {
+				return errInvalidIdentifiers.New()
+			}
+			if pb.JoinEUI != nil && pb.DevEUI != nil {
+				var count int
+				if err := db.Model(&EndDevice{}).
+					Where("join_eui = ? AND dev_eui = ?", pb.JoinEUI.String(), pb.DevEUI.String()).
+					Count(&count).Error; err != nil {
+					return err
+				}
+				if count > 0 {
+					return errDuplicateIdentifiers.New()
+				}
+			}
+			model = &EndDevice{}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+				return errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+				return errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+				return errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+		}
+
+		updated := stored
+		if updated == nil {
+			updated = &ttnpb.EndDevice{}
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return err
+		}
+		updated.UpdatedAt = time.Now().UTC()
+		if stored == nil {
+			updated.CreatedAt = updated.UpdatedAt
+		}
+
+		if updated.Session != nil && updated.MACState == nil ||
+			updated.PendingSession != nil && updated.PendingMACState == nil {
+			return errInvalidDevice.New()
+		}
+
+		if err := model.fromPB(updated, updated.UpdatedAt); err != nil {
+			return err
+		}
+		if stored == nil {
+			err = db.Create(model).Error
+		} else {
+			err = db.Save(model).Error
+		}
+		if err != nil {
+			return err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		return err
+	}); err != nil {
+		return nil, ctx, convertError(err)
+	}
+	return pb, ctx, nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test/shared"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/sql"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/gormutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+var _ networkserver.DeviceRegistry = &DeviceRegistry{}
+
+func newTestDB(ctx context.Context, t *testing.T) *gorm.DB {
+	dbAddress := os.Getenv("SQL_DB_ADDRESS")
+	if dbAddress == "" {
+		dbAddress = "localhost:26257"
+	}
+	dbName := os.Getenv("TEST_DATABASE_NAME")
+	if dbName == "" {
+		dbName = "ttn_lorawan_ns_registry_test"
+	}
+	dbAuth := os.Getenv("SQL_DB_AUTH")
+	if dbAuth == "" {
+		dbAuth = "root"
+	}
+	db, err := gormutil.Open(ctx, fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName))
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", test.FormatError(err))
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := gormutil.Initialize(db); err != nil {
+		t.Fatalf("Failed to initialize database: %s", test.FormatError(err))
+	}
+	if err := AutoMigrate(db).Error; err != nil {
+		t.Fatalf("Failed to migrate database: %s", test.FormatError(err))
+	}
+	if dbKind, ok := db.Get("db:kind"); ok && dbKind == "CockroachDB" {
+		if err := db.Exec("SET SQL_SAFE_UPDATES = FALSE").Error; err != nil {
+			t.Fatalf("Failed to disable safe updates: %s", test.FormatError(err))
+		}
+	}
+	if err := db.Delete(&EndDevice{}).Error; err != nil {
+		t.Fatalf("Failed to clear database: %s", test.FormatError(err))
+	}
+	return db
+}
+
+func TestDeviceRegistry(t *testing.T) {
+	_, ctx := test.New(t)
+	HandleDeviceRegistryTest(t, NewDeviceRegistry(newTestDB(ctx, t)))
+}
@@ -0,0 +1,54 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttnpb
+
+import "context"
+
+// GetEntityIdentifiers returns the identifiers of the gateway or application
+// that the alert rule is defined on. It returns nil if neither or both are set.
+func (ids *AlertRuleIdentifiers) GetEntityIdentifiers() *EntityIdentifiers {
+	switch {
+	case ids == nil:
+		return nil
+	case ids.GatewayIDs != nil && ids.ApplicationIDs == nil:
+		return ids.GatewayIDs.EntityIdentifiers()
+	case ids.ApplicationIDs != nil && ids.GatewayIDs == nil:
+		return ids.ApplicationIDs.EntityIdentifiers()
+	default:
+		return nil
+	}
+}
+
+// GetEntityIdentifiers returns the identifiers of the gateway or application
+// to list the alert rules of. It returns nil if neither or both are set.
+func (m *ListAlertRulesRequest) GetEntityIdentifiers() *EntityIdentifiers {
+	if m == nil {
+		return nil
+	}
+	return (&AlertRuleIdentifiers{
+		GatewayIDs:     m.GatewayIDs,
+		ApplicationIDs: m.ApplicationIDs,
+	}).GetEntityIdentifiers()
+}
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (m *UpdateAlertRuleRequest) ValidateContext(context.Context) error {
+	if len(m.FieldMask.Paths) == 0 {
+		return m.ValidateFields()
+	}
+	return m.ValidateFields(append(FieldsWithPrefix("alert_rule", m.FieldMask.Paths...),
+		"alert_rule.ids",
+	)...)
+}
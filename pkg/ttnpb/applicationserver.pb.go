@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -412,6 +413,8 @@ type AsConfiguration_PubSub_Providers struct {
 	MQTT                 AsConfiguration_PubSub_Providers_Status `protobuf:"varint,1,opt,name=mqtt,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"mqtt,omitempty"`
 	NATS                 AsConfiguration_PubSub_Providers_Status `protobuf:"varint,2,opt,name=nats,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"nats,omitempty"`
 	AWSIoT               AsConfiguration_PubSub_Providers_Status `protobuf:"varint,3,opt,name=aws_iot,json=awsIot,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"aws_iot,omitempty"`
+	Kafka                AsConfiguration_PubSub_Providers_Status `protobuf:"varint,4,opt,name=kafka,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"kafka,omitempty"`
+	AMQP                 AsConfiguration_PubSub_Providers_Status `protobuf:"varint,5,opt,name=amqp,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"amqp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
 	XXX_sizecache        int32                                   `json:"-"`
 }
@@ -469,6 +472,20 @@ func (m *AsConfiguration_PubSub_Providers) GetAWSIoT() AsConfiguration_PubSub_Pr
 	return AsConfiguration_PubSub_Providers_ENABLED
 }
 
+func (m *AsConfiguration_PubSub_Providers) GetKafka() AsConfiguration_PubSub_Providers_Status {
+	if m != nil {
+		return m.Kafka
+	}
+	return AsConfiguration_PubSub_Providers_ENABLED
+}
+
+func (m *AsConfiguration_PubSub_Providers) GetAMQP() AsConfiguration_PubSub_Providers_Status {
+	if m != nil {
+		return m.AMQP
+	}
+	return AsConfiguration_PubSub_Providers_ENABLED
+}
+
 type GetAsConfigurationRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -596,246 +613,787 @@ func (m *NsAsHandleUplinkRequest) GetApplicationUps() []*ApplicationUp {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
-	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
-	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
-	proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
-	golang_proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
-	proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
-	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
-	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
-	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
-	proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
-	golang_proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
-	proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
-	golang_proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
-	proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
-	golang_proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
-	proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
-	golang_proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
-	proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
-	golang_proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
-	proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
-	golang_proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
+type DecodeUplinkRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Version identifiers of the end device, used by the repository payload formatter.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	// Payload formatter to test.
+	Formatter PayloadFormatter `protobuf:"varint,3,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	// Parameter of the payload formatter, such as the JavaScript code.
+	Parameter string `protobuf:"bytes,4,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	FPort     uint32 `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// The frame payload to decode.
+	FRMPayload           []byte   `protobuf:"bytes,6,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+func (m *DecodeUplinkRequest) Reset()      { *m = DecodeUplinkRequest{} }
+func (*DecodeUplinkRequest) ProtoMessage() {}
+func (*DecodeUplinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{8}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+func (m *DecodeUplinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodeUplinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodeUplinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodeUplinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeUplinkRequest.Merge(m, src)
+}
+func (m *DecodeUplinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodeUplinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeUplinkRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0x21, 0x29, 0x89, 0x1a, 0x3b, 0xb2, 0x3c, 0x76, 0x13, 0x89, 0x49, 0x97, 0xc6, 0xc6,
-	0x4d, 0x25, 0x21, 0xdc, 0x75, 0xe5, 0xfe, 0xaa, 0x68, 0x85, 0xa5, 0x25, 0x2b, 0x12, 0x24, 0x45,
-	0x59, 0x4a, 0x35, 0xe0, 0xd8, 0x21, 0x86, 0xdc, 0x21, 0xb5, 0xe0, 0x72, 0x77, 0xb3, 0x33, 0x4b,
-	0x45, 0xfe, 0x01, 0x82, 0xc2, 0x48, 0x83, 0x1c, 0xda, 0x20, 0x45, 0x80, 0x1c, 0x5b, 0xb4, 0x05,
-	0x72, 0x0c, 0xda, 0x43, 0x73, 0x6a, 0x03, 0x14, 0x05, 0x5c, 0xf4, 0xe2, 0xa0, 0x97, 0x5c, 0xaa,
-	0x46, 0xcb, 0x1e, 0x02, 0xf4, 0x92, 0x63, 0xe0, 0x53, 0xb1, 0xb3, 0xcb, 0x7f, 0x52, 0xa2, 0x7f,
-	0xe0, 0xdc, 0x66, 0xe6, 0xbd, 0xf7, 0xbd, 0xef, 0xbd, 0x79, 0xef, 0x0d, 0x97, 0x70, 0xd6, 0xb4,
-	0x5d, 0xbc, 0x87, 0xad, 0x0c, 0x65, 0xb8, 0x58, 0x51, 0xb0, 0x63, 0x28, 0xd8, 0x71, 0x4c, 0xa3,
-	0x88, 0x99, 0x61, 0x5b, 0x94, 0xb8, 0x35, 0xe2, 0xca, 0x8e, 0x6b, 0x33, 0x1b, 0x4d, 0x30, 0x66,
-	0xc9, 0x91, 0xba, 0x5c, 0xbb, 0x98, 0x52, 0xcb, 0x06, 0xdb, 0xf5, 0x0a, 0x72, 0xd1, 0xae, 0x2a,
-	0xc4, 0xaa, 0xd9, 0xfb, 0x8e, 0x6b, 0xbf, 0xb1, 0xaf, 0x70, 0xe5, 0x62, 0xa6, 0x4c, 0xac, 0x4c,
-	0x0d, 0x9b, 0x86, 0x8e, 0x19, 0x51, 0x7a, 0x16, 0x21, 0x64, 0x2a, 0xd3, 0x06, 0x51, 0xb6, 0xcb,
-	0x76, 0x68, 0x5c, 0xf0, 0x4a, 0x7c, 0xc7, 0x37, 0x7c, 0x15, 0xa9, 0x3f, 0x57, 0xb6, 0xed, 0xb2,
-	0x49, 0x42, 0x96, 0x96, 0x65, 0xb3, 0x90, 0x64, 0x24, 0x7d, 0x36, 0x92, 0x36, 0x31, 0x48, 0xd5,
-	0x61, 0xfb, 0x91, 0xf0, 0x5c, 0xb7, 0xb0, 0x64, 0x10, 0x53, 0xcf, 0x57, 0x31, 0xad, 0x44, 0x1a,
-	0xe9, 0x6e, 0x0d, 0x66, 0x54, 0x09, 0x65, 0xb8, 0xea, 0x44, 0x0a, 0x62, 0xb7, 0xc2, 0x9e, 0x8b,
-	0x1d, 0x87, 0xb8, 0x0d, 0xff, 0x52, 0x6f, 0x2a, 0x89, 0xa5, 0xe7, 0x75, 0x52, 0x33, 0x8a, 0x8d,
-	0x80, 0x9f, 0xef, 0xd5, 0x31, 0x74, 0x62, 0x31, 0xa3, 0x64, 0xb4, 0x80, 0xce, 0xf5, 0x2a, 0x55,
-	0x09, 0xa5, 0xb8, 0x4c, 0x1a, 0x1a, 0xcf, 0xf5, 0xd1, 0x78, 0x9d, 0xb1, 0x50, 0x2a, 0xfd, 0x1b,
-	0xc0, 0x53, 0x6a, 0xeb, 0x12, 0xd7, 0x0d, 0xab, 0x82, 0xae, 0x40, 0xa4, 0x93, 0x12, 0xf6, 0x4c,
-	0x96, 0x2f, 0xd9, 0x6e, 0x15, 0x33, 0x46, 0x5c, 0x3a, 0x15, 0x3f, 0x07, 0x66, 0x4e, 0xcc, 0xcf,
-	0xc8, 0x9d, 0x37, 0x2b, 0x6f, 0x84, 0xde, 0xb6, 0xf0, 0xbe, 0x69, 0x63, 0xfd, 0x72, 0x53, 0x5f,
-	0x3b, 0x1d, 0x61, 0xb4, 0x8e, 0xd0, 0x34, 0x8c, 0x33, 0x93, 0x4e, 0x25, 0xce, 0x81, 0x99, 0x64,
-	0x76, 0xcc, 0x3f, 0x48, 0xc7, 0xb7, 0xd7, 0x73, 0x5a, 0x70, 0x86, 0xd6, 0xe0, 0x19, 0x5a, 0x31,
-	0x9c, 0xbc, 0x13, 0xe2, 0xe4, 0x8b, 0xee, 0xbe, 0xc3, 0xec, 0xa9, 0x11, 0xee, 0x34, 0x25, 0x87,
-	0xe9, 0x94, 0x1b, 0xe9, 0x94, 0xb3, 0xb6, 0x6d, 0xfe, 0x0c, 0x9b, 0x1e, 0xd1, 0x4e, 0x07, 0x66,
-	0x91, 0xf7, 0x4b, 0xdc, 0x68, 0x2d, 0x91, 0x04, 0x93, 0xb1, 0xb5, 0x44, 0x32, 0x36, 0x19, 0x97,
-	0xfe, 0x0a, 0xe0, 0xf4, 0x0a, 0x61, 0x5d, 0x21, 0x6a, 0xe4, 0x75, 0x8f, 0x50, 0x86, 0x30, 0x3c,
-	0xd5, 0x56, 0xc1, 0x79, 0x43, 0xa7, 0x53, 0x80, 0x7b, 0x7c, 0xa1, 0x3b, 0xcc, 0x36, 0x80, 0xd5,
-	0xd6, 0x25, 0x64, 0x27, 0xef, 0x67, 0x47, 0xde, 0x01, 0xb1, 0x49, 0x70, 0xf7, 0x20, 0x2d, 0xdc,
-	0x3b, 0x48, 0x03, 0x6d, 0x02, 0xb7, 0x6b, 0x52, 0xb4, 0x08, 0x61, 0xab, 0x7c, 0xa6, 0x62, 0x03,
-	0xe2, 0xb9, 0x1c, 0xa8, 0x6c, 0x60, 0x5a, 0xc9, 0x26, 0x02, 0x24, 0x6d, 0xbc, 0xd4, 0x38, 0x90,
-	0xde, 0x8a, 0xc1, 0xe9, 0xdc, 0xd7, 0x19, 0xc1, 0x32, 0x4c, 0x98, 0x86, 0xd5, 0xe0, 0x9e, 0x3e,
-	0x02, 0x37, 0x20, 0xd6, 0x07, 0x90, 0x9b, 0x77, 0x25, 0x22, 0xfe, 0xe0, 0x89, 0xf8, 0x55, 0x02,
-	0x9e, 0xed, 0x72, 0x96, 0x63, 0x98, 0x51, 0xf4, 0x13, 0x38, 0x1e, 0x78, 0x20, 0x7a, 0x1e, 0xb3,
-	0x28, 0xfa, 0x5e, 0xe0, 0xed, 0x46, 0x87, 0x66, 0x13, 0xef, 0xfe, 0x27, 0x0d, 0xb4, 0x64, 0x68,
-	0xa2, 0x32, 0xf4, 0x77, 0x00, 0x9f, 0xb6, 0x08, 0xdb, 0xb3, 0xdd, 0x4a, 0x3e, 0x1c, 0x62, 0x79,
-	0xac, 0xeb, 0x2e, 0xa1, 0x94, 0x87, 0x3c, 0x9e, 0xfd, 0x25, 0xb8, 0x9f, 0x7d, 0x07, 0xb8, 0xbf,
-	0x00, 0xf3, 0x77, 0xc0, 0x6b, 0x33, 0x8b, 0x0b, 0x33, 0x8b, 0x0b, 0xaf, 0xe2, 0xcc, 0x0d, 0x35,
-	0x73, 0xf5, 0x42, 0xe6, 0x47, 0xd7, 0x6f, 0xb5, 0xad, 0x5b, 0xcb, 0x6b, 0x99, 0xeb, 0x73, 0x6d,
-	0x82, 0xd9, 0x6b, 0xf2, 0xec, 0x5c, 0x60, 0xa7, 0x66, 0xae, 0xe2, 0xcc, 0x8d, 0xd0, 0xae, 0xb5,
-	0x6e, 0x2d, 0xb9, 0x5d, 0x4b, 0x30, 0x3b, 0xb3, 0xb8, 0xb0, 0xf0, 0x6a, 0xb0, 0xba, 0xf9, 0x9d,
-	0x17, 0xbf, 0x77, 0x7b, 0x76, 0xf1, 0xfc, 0xad, 0xd7, 0xce, 0x6b, 0x67, 0x23, 0xba, 0x39, 0xce,
-	0x56, 0x0d, 0xc9, 0xa2, 0x97, 0xe1, 0x19, 0x13, 0x53, 0x96, 0xf7, 0x9c, 0xbc, 0x4b, 0x8a, 0xc4,
-	0xa8, 0x85, 0x09, 0x89, 0x0f, 0x99, 0x90, 0xc9, 0xc0, 0x78, 0xc7, 0xd1, 0x22, 0x53, 0x95, 0xa1,
-	0x69, 0x98, 0xf4, 0x9c, 0x7c, 0xd1, 0xf6, 0x2c, 0xc6, 0x7b, 0x36, 0xa1, 0x8d, 0x79, 0xce, 0xa5,
-	0x60, 0x8b, 0xae, 0xc3, 0x14, 0xf7, 0xa5, 0xdb, 0x7b, 0x56, 0x90, 0xc8, 0x60, 0x50, 0xec, 0x61,
-	0x57, 0x0f, 0x5d, 0x8e, 0x0c, 0xe9, 0xf2, 0x99, 0x00, 0x63, 0x29, 0x82, 0xb8, 0xdc, 0x40, 0x50,
-	0x19, 0xfa, 0x16, 0x9c, 0x68, 0x22, 0x87, 0xfe, 0x47, 0xb9, 0xff, 0xa7, 0x1a, 0xa7, 0x9c, 0x85,
-	0x74, 0x27, 0x01, 0x4f, 0xa9, 0xf4, 0x92, 0x6d, 0x95, 0x8c, 0xb2, 0xe7, 0xf2, 0xaa, 0x40, 0x6b,
-	0x70, 0xd4, 0xf1, 0x0a, 0xd4, 0x2b, 0x0c, 0xec, 0x83, 0x4e, 0x03, 0x79, 0xcb, 0x2b, 0xe4, 0xbc,
-	0x42, 0x16, 0xfa, 0x07, 0xe9, 0xd1, 0x70, 0xad, 0x45, 0x08, 0xa9, 0xdf, 0xc7, 0x61, 0x74, 0x84,
-	0x36, 0xe1, 0xb8, 0xe3, 0xda, 0x35, 0x43, 0x0f, 0x46, 0x61, 0x88, 0x7c, 0x61, 0x38, 0x64, 0x79,
-	0xab, 0x61, 0xa7, 0xb5, 0x20, 0x52, 0xff, 0x88, 0xc1, 0xf1, 0xa6, 0x00, 0xed, 0xc0, 0x44, 0x30,
-	0x93, 0x39, 0xf0, 0xc4, 0xfc, 0x0f, 0x1e, 0x14, 0x58, 0x0e, 0xfa, 0xc0, 0xa3, 0xd9, 0xa4, 0x7f,
-	0x90, 0x4e, 0x6c, 0xbc, 0xb2, 0xbd, 0xad, 0x71, 0xb8, 0x00, 0xd6, 0xc2, 0x2c, 0x2c, 0xe3, 0x47,
-	0x85, 0xdd, 0x54, 0xb7, 0x73, 0x1a, 0x87, 0x43, 0xd7, 0xe0, 0x18, 0xde, 0xa3, 0x79, 0xc3, 0x0e,
-	0x8b, 0xeb, 0x11, 0x90, 0x79, 0xd2, 0xd5, 0x2b, 0xb9, 0x55, 0x7b, 0x5b, 0x1b, 0xc5, 0x7b, 0x74,
-	0xd5, 0x66, 0xd2, 0x05, 0x38, 0x1a, 0x4a, 0xd1, 0x09, 0x38, 0xb6, 0xbc, 0xa9, 0x66, 0xd7, 0x97,
-	0x97, 0x26, 0x85, 0x60, 0x73, 0x45, 0xd5, 0x36, 0x57, 0x37, 0x57, 0x26, 0x01, 0x3a, 0x09, 0x93,
-	0x4b, 0xab, 0xb9, 0x50, 0x14, 0x93, 0x9e, 0x0d, 0x47, 0x7c, 0xa7, 0xcf, 0x68, 0x40, 0x4a, 0x45,
-	0x98, 0xea, 0x27, 0xa4, 0x4e, 0xf0, 0x8b, 0x05, 0x2d, 0xc3, 0xa7, 0x8a, 0xed, 0x82, 0xe8, 0x6a,
-	0xd3, 0xc7, 0x04, 0xa4, 0x75, 0x5a, 0x49, 0x15, 0xf8, 0xcc, 0x26, 0x55, 0xe9, 0x4b, 0xd8, 0xd2,
-	0x4d, 0xb2, 0xe3, 0x98, 0x6d, 0x03, 0x7a, 0xab, 0x73, 0x40, 0x7b, 0x4e, 0x50, 0x3e, 0xf1, 0x99,
-	0x13, 0xf3, 0xdf, 0x3c, 0x62, 0x90, 0xee, 0x38, 0xd9, 0xe4, 0xfd, 0xec, 0xc8, 0x7b, 0x20, 0x96,
-	0xec, 0x9c, 0xc7, 0x3b, 0x0e, 0x9d, 0xff, 0x74, 0x04, 0xc6, 0x54, 0x8a, 0xde, 0x07, 0x70, 0x6c,
-	0x85, 0x30, 0xfe, 0x62, 0xcf, 0x76, 0x63, 0x0d, 0x7c, 0xf2, 0x52, 0xc7, 0xcd, 0x6f, 0xe9, 0xa7,
-	0x3f, 0xff, 0xd7, 0x7f, 0x7f, 0x1d, 0xfb, 0x21, 0xfa, 0xbe, 0x82, 0x69, 0xc7, 0xef, 0x3b, 0xe5,
-	0x66, 0xd7, 0x4b, 0x23, 0x77, 0xee, 0x6f, 0x2b, 0x7c, 0xce, 0x7f, 0x00, 0xe0, 0x58, 0x6e, 0x10,
-	0xaf, 0xdc, 0xc3, 0xf3, 0x52, 0x39, 0xaf, 0x1f, 0xa7, 0x1e, 0x92, 0xd7, 0x02, 0x98, 0x43, 0xb7,
-	0x20, 0x5c, 0x22, 0x26, 0x61, 0x84, 0x93, 0x1b, 0xf2, 0x85, 0x4c, 0x3d, 0xdd, 0x33, 0xc7, 0x96,
-	0x83, 0x1f, 0x8b, 0x92, 0xcc, 0x09, 0xcd, 0xcc, 0xbd, 0x70, 0x1c, 0xa1, 0x28, 0x31, 0xef, 0x01,
-	0x78, 0x32, 0xba, 0xb0, 0xf0, 0xdd, 0x1a, 0x96, 0xc0, 0xf9, 0x63, 0x52, 0xc3, 0xd1, 0xa4, 0xef,
-	0x72, 0x3a, 0x32, 0x7a, 0x71, 0x38, 0x3a, 0x0a, 0xe5, 0x1c, 0xee, 0x00, 0x38, 0xb9, 0x42, 0x58,
-	0xe7, 0x0c, 0xed, 0x5b, 0x4e, 0x7d, 0xdb, 0x2b, 0x35, 0x37, 0x8c, 0x6a, 0xd8, 0x6c, 0xd2, 0x34,
-	0x67, 0x78, 0x06, 0x9d, 0x0e, 0x18, 0x76, 0x34, 0xd0, 0xfc, 0x15, 0x98, 0x08, 0x1a, 0x08, 0xbd,
-	0x0c, 0x4f, 0xb6, 0x37, 0x11, 0xfa, 0x76, 0x37, 0xfc, 0x80, 0x36, 0x1b, 0x74, 0x49, 0xf3, 0xbf,
-	0x4d, 0xc2, 0x11, 0xd5, 0x71, 0x54, 0x8a, 0xb6, 0xe1, 0x78, 0xce, 0x2b, 0xd0, 0xa2, 0x6b, 0x14,
-	0xc8, 0xd0, 0xa9, 0x3f, 0xba, 0x49, 0x2f, 0x00, 0xf4, 0x4f, 0x00, 0x4f, 0x37, 0x5e, 0xb0, 0x57,
-	0x3c, 0xe2, 0x91, 0x2d, 0x8f, 0xee, 0xa2, 0x9e, 0x1b, 0xeb, 0x50, 0x39, 0x86, 0xb3, 0xf4, 0x06,
-	0xcf, 0x93, 0x2b, 0x55, 0x7b, 0x6f, 0xb2, 0xf5, 0x91, 0xd0, 0xa7, 0xd0, 0x7b, 0x0b, 0x3f, 0x54,
-	0xed, 0xb5, 0x6b, 0x2e, 0x6f, 0x2b, 0xc1, 0x8b, 0xaa, 0x38, 0x1e, 0xdd, 0x0d, 0x1a, 0xe4, 0x53,
-	0x00, 0xcf, 0x76, 0x51, 0x75, 0x4c, 0x5c, 0x24, 0x8f, 0x18, 0xd0, 0x4d, 0x1e, 0x90, 0x27, 0x39,
-	0x4f, 0x2c, 0x20, 0x37, 0xe4, 0x1d, 0xc4, 0xf4, 0xa7, 0xee, 0x1b, 0x5a, 0x37, 0x28, 0xeb, 0x0d,
-	0x68, 0xd9, 0xd2, 0x97, 0x38, 0xc8, 0xb0, 0x9d, 0xd7, 0xc0, 0xa4, 0x92, 0xc6, 0xc3, 0x5b, 0x47,
-	0x6b, 0x0f, 0x3e, 0x99, 0x9a, 0xf1, 0x74, 0x05, 0x80, 0x7e, 0x07, 0xe0, 0x37, 0x56, 0x08, 0x0b,
-	0x1e, 0xf3, 0x4b, 0xb6, 0x65, 0x91, 0x22, 0xaf, 0x4c, 0xab, 0x64, 0x0f, 0x5d, 0xba, 0x52, 0xcf,
-	0x97, 0x5a, 0x0f, 0xd6, 0xf0, 0xb3, 0xfe, 0x36, 0xff, 0x66, 0xcc, 0x14, 0x9b, 0xe6, 0x19, 0x23,
-	0xe0, 0xf2, 0x37, 0x00, 0x27, 0x72, 0x46, 0xd5, 0x33, 0x31, 0x6b, 0x74, 0xec, 0xd1, 0x1d, 0x33,
-	0xb0, 0x44, 0x6e, 0x70, 0x26, 0x4c, 0xb2, 0x9f, 0x44, 0x89, 0x78, 0x8e, 0x42, 0x23, 0xd6, 0x0b,
-	0x60, 0x6e, 0xfe, 0x7f, 0x09, 0x78, 0x46, 0xa5, 0xcd, 0x02, 0xd0, 0x48, 0xd9, 0xa0, 0xcc, 0xdd,
-	0x47, 0x7f, 0x04, 0x30, 0xbe, 0x42, 0x18, 0x7a, 0xbe, 0xcf, 0x8c, 0x6b, 0xd3, 0x0e, 0x6b, 0x7f,
-	0x7a, 0x60, 0x41, 0x49, 0x15, 0x1e, 0x1b, 0x41, 0xc5, 0x27, 0x10, 0x1b, 0x7a, 0x2b, 0x06, 0xe3,
-	0xb9, 0x7e, 0xa4, 0x73, 0x0f, 0x46, 0xfa, 0x2f, 0x80, 0xb3, 0xfe, 0x33, 0x48, 0x1d, 0x49, 0x5b,
-	0x7e, 0x48, 0xda, 0x72, 0x27, 0xed, 0x05, 0x30, 0x77, 0x75, 0x43, 0x7a, 0xe9, 0x71, 0x79, 0x0a,
-	0xfa, 0xfe, 0x7d, 0x00, 0x47, 0xc3, 0xd7, 0x7e, 0xc8, 0x66, 0x1f, 0x54, 0x9a, 0x1b, 0x3c, 0x11,
-	0x2b, 0x73, 0xcb, 0x8f, 0xa5, 0xbd, 0xb3, 0x7f, 0x00, 0x77, 0x0f, 0x45, 0x70, 0xef, 0x50, 0x04,
-	0x9f, 0x1d, 0x8a, 0xc2, 0xe7, 0x87, 0xa2, 0xf0, 0xc5, 0xa1, 0x28, 0x7c, 0x79, 0x28, 0x0a, 0x5f,
-	0x1d, 0x8a, 0xe0, 0x4d, 0x5f, 0x04, 0x6f, 0xfb, 0xa2, 0xf0, 0xa1, 0x2f, 0x82, 0x8f, 0x7c, 0x51,
-	0xf8, 0xd8, 0x17, 0x85, 0x4f, 0x7c, 0x51, 0xb8, 0xeb, 0x8b, 0xe0, 0x9e, 0x2f, 0x82, 0xcf, 0x7c,
-	0x51, 0xf8, 0xdc, 0x17, 0xc1, 0x17, 0xbe, 0x28, 0x7c, 0xe9, 0x8b, 0xe0, 0x2b, 0x5f, 0x14, 0xde,
-	0xac, 0x8b, 0xc2, 0xdb, 0x75, 0x11, 0xbc, 0x5b, 0x17, 0x85, 0x0f, 0xea, 0x22, 0xf8, 0x4d, 0x5d,
-	0x14, 0x3e, 0xac, 0x8b, 0xc2, 0x47, 0x75, 0x11, 0x7c, 0x5c, 0x17, 0xc1, 0x27, 0x75, 0x11, 0x5c,
-	0x55, 0xca, 0xb6, 0xcc, 0x76, 0x09, 0xdb, 0x35, 0xac, 0x32, 0x95, 0xa3, 0x0f, 0x48, 0xa5, 0xf3,
-	0xbf, 0xa1, 0xda, 0x45, 0xc5, 0xa9, 0x94, 0x15, 0xc6, 0x2c, 0xa7, 0x50, 0x18, 0xe5, 0x69, 0xb8,
-	0xf8, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x41, 0xae, 0x0a, 0x12, 0xf6, 0x13, 0x00, 0x00,
+var xxx_messageInfo_DecodeUplinkRequest proto.InternalMessageInfo
+
+func (m *DecodeUplinkRequest) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
+	}
+	return nil
 }
 
-func (x AsConfiguration_PubSub_Providers_Status) String() string {
-	s, ok := AsConfiguration_PubSub_Providers_Status_name[int32(x)]
-	if ok {
-		return s
+func (m *DecodeUplinkRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
 	}
-	return strconv.Itoa(int(x))
+	return PayloadFormatter_FORMATTER_NONE
 }
-func (this *ApplicationLink) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DecodeUplinkRequest) GetParameter() string {
+	if m != nil {
+		return m.Parameter
 	}
+	return ""
+}
 
-	that1, ok := that.(*ApplicationLink)
-	if !ok {
-		that2, ok := that.(ApplicationLink)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DecodeUplinkRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DecodeUplinkRequest) GetFRMPayload() []byte {
+	if m != nil {
+		return m.FRMPayload
+	}
+	return nil
+}
+
+type DecodeUplinkResponse struct {
+	// The decoded frame payload.
+	DecodedPayload *types.Struct `protobuf:"bytes,1,opt,name=decoded_payload,json=decodedPayload,proto3" json:"decoded_payload,omitempty"`
+	// Warnings generated by the payload formatter.
+	DecodedPayloadWarnings []string `protobuf:"bytes,2,rep,name=decoded_payload_warnings,json=decodedPayloadWarnings,proto3" json:"decoded_payload_warnings,omitempty"`
+	// Error returned by the payload formatter, if any.
+	Error *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Duration of the payload formatter execution.
+	ExecutionTime time.Duration `protobuf:"bytes,4,opt,name=execution_time,json=executionTime,proto3,stdduration" json:"execution_time"`
+	// The decoded frame payload, normalized to the common measurement schema.
+	NormalizedPayload []*types.Struct `protobuf:"bytes,5,rep,name=normalized_payload,json=normalizedPayload,proto3" json:"normalized_payload,omitempty"`
+	// Warnings generated by the payload formatter while normalizing the decoded payload.
+	NormalizedPayloadWarnings []string `protobuf:"bytes,6,rep,name=normalized_payload_warnings,json=normalizedPayloadWarnings,proto3" json:"normalized_payload_warnings,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *DecodeUplinkResponse) Reset()      { *m = DecodeUplinkResponse{} }
+func (*DecodeUplinkResponse) ProtoMessage() {}
+func (*DecodeUplinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{9}
+}
+func (m *DecodeUplinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodeUplinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodeUplinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DecodeUplinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeUplinkResponse.Merge(m, src)
+}
+func (m *DecodeUplinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodeUplinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeUplinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeUplinkResponse proto.InternalMessageInfo
+
+func (m *DecodeUplinkResponse) GetDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.DecodedPayload
 	}
-	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
-		return false
+	return nil
+}
+
+func (m *DecodeUplinkResponse) GetDecodedPayloadWarnings() []string {
+	if m != nil {
+		return m.DecodedPayloadWarnings
 	}
-	if this.TLS != that1.TLS {
-		return false
+	return nil
+}
+
+func (m *DecodeUplinkResponse) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	if !this.SkipPayloadCrypto.Equal(that1.SkipPayloadCrypto) {
-		return false
+	return nil
+}
+
+func (m *DecodeUplinkResponse) GetExecutionTime() time.Duration {
+	if m != nil {
+		return m.ExecutionTime
 	}
-	return true
+	return 0
 }
-func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DecodeUplinkResponse) GetNormalizedPayload() []*types.Struct {
+	if m != nil {
+		return m.NormalizedPayload
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetApplicationLinkRequest)
-	if !ok {
-		that2, ok := that.(GetApplicationLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DecodeUplinkResponse) GetNormalizedPayloadWarnings() []string {
+	if m != nil {
+		return m.NormalizedPayloadWarnings
+	}
+	return nil
+}
+
+type EncodeDownlinkRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Version identifiers of the end device, used by the repository payload formatter.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	// Payload formatter to test.
+	Formatter PayloadFormatter `protobuf:"varint,3,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	// Parameter of the payload formatter, such as the JavaScript code.
+	Parameter string `protobuf:"bytes,4,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	FPort     uint32 `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// The frame payload to encode.
+	DecodedPayload       *types.Struct `protobuf:"bytes,6,opt,name=decoded_payload,json=decodedPayload,proto3" json:"decoded_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EncodeDownlinkRequest) Reset()      { *m = EncodeDownlinkRequest{} }
+func (*EncodeDownlinkRequest) ProtoMessage() {}
+func (*EncodeDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{10}
+}
+func (m *EncodeDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodeDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodeDownlinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EncodeDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeDownlinkRequest.Merge(m, src)
+}
+func (m *EncodeDownlinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodeDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodeDownlinkRequest proto.InternalMessageInfo
+
+func (m *EncodeDownlinkRequest) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
+	return nil
+}
+
+func (m *EncodeDownlinkRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
+	return PayloadFormatter_FORMATTER_NONE
+}
+
+func (m *EncodeDownlinkRequest) GetParameter() string {
+	if m != nil {
+		return m.Parameter
 	}
-	return true
+	return ""
 }
-func (this *SetApplicationLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EncodeDownlinkRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *EncodeDownlinkRequest) GetDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.DecodedPayload
 	}
+	return nil
+}
 
-	that1, ok := that.(*SetApplicationLinkRequest)
-	if !ok {
-		that2, ok := that.(SetApplicationLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EncodeDownlinkResponse struct {
+	// The encoded frame payload.
+	FRMPayload []byte `protobuf:"bytes,1,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	// The FPort set by the payload formatter.
+	FPort uint32 `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Warnings generated by the payload formatter.
+	DecodedPayloadWarnings []string `protobuf:"bytes,3,rep,name=decoded_payload_warnings,json=decodedPayloadWarnings,proto3" json:"decoded_payload_warnings,omitempty"`
+	// Error returned by the payload formatter, if any.
+	Error *ErrorDetails `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Duration of the payload formatter execution.
+	ExecutionTime        time.Duration `protobuf:"bytes,5,opt,name=execution_time,json=executionTime,proto3,stdduration" json:"execution_time"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EncodeDownlinkResponse) Reset()      { *m = EncodeDownlinkResponse{} }
+func (*EncodeDownlinkResponse) ProtoMessage() {}
+func (*EncodeDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{11}
+}
+func (m *EncodeDownlinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodeDownlinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodeDownlinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EncodeDownlinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeDownlinkResponse.Merge(m, src)
+}
+func (m *EncodeDownlinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodeDownlinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeDownlinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodeDownlinkResponse proto.InternalMessageInfo
+
+func (m *EncodeDownlinkResponse) GetFRMPayload() []byte {
+	if m != nil {
+		return m.FRMPayload
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
+	return nil
+}
+
+func (m *EncodeDownlinkResponse) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
 	}
-	if !this.ApplicationLink.Equal(&that1.ApplicationLink) {
-		return false
+	return 0
+}
+
+func (m *EncodeDownlinkResponse) GetDecodedPayloadWarnings() []string {
+	if m != nil {
+		return m.DecodedPayloadWarnings
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
+	return nil
+}
+
+func (m *EncodeDownlinkResponse) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	return true
+	return nil
 }
-func (this *ApplicationLinkStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EncodeDownlinkResponse) GetExecutionTime() time.Duration {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return 0
+}
+
+type DecodeDownlinkRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Version identifiers of the end device, used by the repository payload formatter.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	// Payload formatter to test.
+	Formatter PayloadFormatter `protobuf:"varint,3,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	// Parameter of the payload formatter, such as the JavaScript code.
+	Parameter string `protobuf:"bytes,4,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	FPort     uint32 `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// The frame payload to decode.
+	FRMPayload           []byte   `protobuf:"bytes,6,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodeDownlinkRequest) Reset()      { *m = DecodeDownlinkRequest{} }
+func (*DecodeDownlinkRequest) ProtoMessage() {}
+func (*DecodeDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{12}
+}
+func (m *DecodeDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodeDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodeDownlinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodeDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeDownlinkRequest.Merge(m, src)
+}
+func (m *DecodeDownlinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodeDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeDownlinkRequest proto.InternalMessageInfo
+
+func (m *DecodeDownlinkRequest) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
+	}
+	return nil
+}
+
+func (m *DecodeDownlinkRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
+	}
+	return PayloadFormatter_FORMATTER_NONE
+}
+
+func (m *DecodeDownlinkRequest) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
+}
+
+func (m *DecodeDownlinkRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DecodeDownlinkRequest) GetFRMPayload() []byte {
+	if m != nil {
+		return m.FRMPayload
+	}
+	return nil
+}
+
+type DecodeDownlinkResponse struct {
+	// The decoded frame payload.
+	DecodedPayload *types.Struct `protobuf:"bytes,1,opt,name=decoded_payload,json=decodedPayload,proto3" json:"decoded_payload,omitempty"`
+	// Warnings generated by the payload formatter.
+	DecodedPayloadWarnings []string `protobuf:"bytes,2,rep,name=decoded_payload_warnings,json=decodedPayloadWarnings,proto3" json:"decoded_payload_warnings,omitempty"`
+	// Error returned by the payload formatter, if any.
+	Error *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Duration of the payload formatter execution.
+	ExecutionTime        time.Duration `protobuf:"bytes,4,opt,name=execution_time,json=executionTime,proto3,stdduration" json:"execution_time"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DecodeDownlinkResponse) Reset()      { *m = DecodeDownlinkResponse{} }
+func (*DecodeDownlinkResponse) ProtoMessage() {}
+func (*DecodeDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{13}
+}
+func (m *DecodeDownlinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodeDownlinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodeDownlinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodeDownlinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeDownlinkResponse.Merge(m, src)
+}
+func (m *DecodeDownlinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodeDownlinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeDownlinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeDownlinkResponse proto.InternalMessageInfo
+
+func (m *DecodeDownlinkResponse) GetDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.DecodedPayload
+	}
+	return nil
+}
+
+func (m *DecodeDownlinkResponse) GetDecodedPayloadWarnings() []string {
+	if m != nil {
+		return m.DecodedPayloadWarnings
+	}
+	return nil
+}
+
+func (m *DecodeDownlinkResponse) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DecodeDownlinkResponse) GetExecutionTime() time.Duration {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
+	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
+	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
+	proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
+	golang_proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
+	proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
+	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
+	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
+	golang_proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
+	proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
+	golang_proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
+	proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
+	golang_proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
+	proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
+	golang_proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
+	proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
+	golang_proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
+	proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
+	golang_proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
+	proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
+	golang_proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
+	proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
+	golang_proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
+	proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
+	golang_proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
+	proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
+	golang_proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
+	proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
+	golang_proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
+	proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+	golang_proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+}
+
+var fileDescriptor_df9d75a19dc066e1 = []byte{
+	// 2302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0xf0, 0x4f, 0xd4, 0x48, 0xa2, 0xe5, 0xb1, 0x2d, 0x53, 0xb4, 0xb3, 0x12, 0x36, 0x8a,
+	0x23, 0xa9, 0x21, 0xe9, 0xd2, 0x6d, 0xe3, 0xaa, 0x68, 0x5c, 0xae, 0x29, 0x2b, 0x16, 0x2c, 0x45,
+	0x5e, 0xca, 0x31, 0xe0, 0xd8, 0x21, 0x56, 0xe4, 0x90, 0x5e, 0x90, 0xdc, 0x5d, 0xef, 0xce, 0x4a,
+	0x96, 0x6c, 0xa3, 0x46, 0x9a, 0xa6, 0x41, 0x0e, 0x6d, 0x90, 0x36, 0x80, 0x91, 0x53, 0x8b, 0x22,
+	0x68, 0xd0, 0x53, 0xd0, 0x1e, 0x1a, 0xf4, 0xd0, 0x1a, 0x28, 0x0a, 0x18, 0xcd, 0xc5, 0x41, 0x2f,
+	0xb9, 0x54, 0xb1, 0xa8, 0x02, 0x0d, 0xd0, 0x43, 0x73, 0x0c, 0x74, 0x69, 0x31, 0xb3, 0xbb, 0xfc,
+	0x59, 0xfe, 0x88, 0xb6, 0x52, 0x37, 0x40, 0x73, 0x5b, 0xee, 0x7b, 0xef, 0x9b, 0xef, 0xfd, 0xcd,
+	0xdb, 0x19, 0xc2, 0xa9, 0xb2, 0xaa, 0x4b, 0x6b, 0x92, 0x12, 0x33, 0x88, 0x94, 0x2b, 0x25, 0x24,
+	0x4d, 0x4e, 0x48, 0x9a, 0x56, 0x96, 0x73, 0x12, 0x91, 0x55, 0xc5, 0xc0, 0xfa, 0x2a, 0xd6, 0xe3,
+	0x9a, 0xae, 0x12, 0x15, 0x85, 0x09, 0x51, 0xe2, 0xb6, 0x7a, 0x7c, 0xf5, 0x44, 0x34, 0x55, 0x94,
+	0xc9, 0x55, 0x73, 0x25, 0x9e, 0x53, 0x2b, 0x09, 0xac, 0xac, 0xaa, 0xeb, 0x9a, 0xae, 0x5e, 0x5f,
+	0x4f, 0x30, 0xe5, 0x5c, 0xac, 0x88, 0x95, 0xd8, 0xaa, 0x54, 0x96, 0xf3, 0x12, 0xc1, 0x89, 0x96,
+	0x07, 0x0b, 0x32, 0x1a, 0x6b, 0x80, 0x28, 0xaa, 0x45, 0xd5, 0x32, 0x5e, 0x31, 0x0b, 0xec, 0x17,
+	0xfb, 0xc1, 0x9e, 0x6c, 0xf5, 0xa3, 0x45, 0x55, 0x2d, 0x96, 0xb1, 0xc5, 0x52, 0x51, 0x54, 0x62,
+	0x91, 0xb4, 0xa5, 0x9c, 0x2d, 0xad, 0x61, 0xe4, 0x4d, 0x9d, 0x29, 0xd8, 0xf2, 0x23, 0x6e, 0x39,
+	0xae, 0x68, 0x64, 0xdd, 0x16, 0x8e, 0xbb, 0x85, 0x05, 0x19, 0x97, 0xf3, 0xd9, 0x8a, 0x64, 0x94,
+	0x5c, 0x8b, 0xd7, 0x34, 0x0c, 0xa2, 0x9b, 0x39, 0x62, 0x4b, 0xc7, 0xdc, 0x52, 0x22, 0x57, 0xb0,
+	0x41, 0xa4, 0x8a, 0xd6, 0x89, 0xdd, 0x9a, 0x2e, 0x69, 0x1a, 0xd6, 0x1d, 0xf6, 0x7c, 0x6b, 0x22,
+	0xb0, 0x92, 0xcf, 0xe6, 0xf1, 0xaa, 0x9c, 0x73, 0xc2, 0xf5, 0x44, 0x1b, 0x1d, 0x5d, 0x57, 0xed,
+	0x04, 0x45, 0x9f, 0x6c, 0x15, 0xcb, 0x79, 0xac, 0x10, 0xb9, 0x20, 0xd7, 0xd7, 0x19, 0x6f, 0x55,
+	0xaa, 0x60, 0xc3, 0x90, 0x8a, 0xd8, 0xd1, 0x38, 0xda, 0x46, 0xe3, 0x1a, 0xb1, 0x1d, 0xe5, 0xff,
+	0x06, 0xe0, 0xbe, 0x54, 0xbd, 0x42, 0xce, 0xc9, 0x4a, 0x09, 0x5d, 0x84, 0x28, 0x8f, 0x0b, 0x92,
+	0x59, 0x26, 0xd9, 0x82, 0xaa, 0x57, 0x24, 0x42, 0xb0, 0x6e, 0x44, 0x7c, 0xe3, 0x60, 0x72, 0x20,
+	0x39, 0x19, 0x6f, 0x2e, 0x9b, 0xf8, 0x82, 0xb5, 0xda, 0x92, 0xb4, 0x5e, 0x56, 0xa5, 0xfc, 0x99,
+	0x9a, 0xbe, 0xb8, 0xdf, 0xc6, 0xa8, 0xbf, 0x42, 0xa3, 0xd0, 0x47, 0xca, 0x46, 0xc4, 0x3f, 0x0e,
+	0x26, 0x43, 0x42, 0x5f, 0x75, 0x73, 0xcc, 0xb7, 0x7c, 0x2e, 0x23, 0xd2, 0x77, 0x68, 0x1e, 0x1e,
+	0x30, 0x4a, 0xb2, 0x96, 0xd5, 0x2c, 0x9c, 0x6c, 0x4e, 0x5f, 0xd7, 0x88, 0x1a, 0x09, 0xb0, 0x45,
+	0xa3, 0x71, 0x2b, 0xda, 0x71, 0x27, 0xda, 0x71, 0x41, 0x55, 0xcb, 0x2f, 0x4a, 0x65, 0x13, 0x8b,
+	0xfb, 0xa9, 0x99, 0xbd, 0xfa, 0x69, 0x66, 0x34, 0xef, 0x0f, 0x81, 0x61, 0xef, 0xbc, 0x3f, 0xe4,
+	0x1d, 0xf6, 0xf1, 0x7f, 0x04, 0x70, 0x74, 0x0e, 0x13, 0x97, 0x8b, 0x22, 0xbe, 0x66, 0x62, 0x83,
+	0x20, 0x09, 0xee, 0x6b, 0x68, 0x8f, 0xac, 0x9c, 0x37, 0x22, 0x80, 0xad, 0x78, 0xcc, 0xed, 0x66,
+	0x03, 0xc0, 0xd9, 0x7a, 0x12, 0x84, 0xe1, 0x1d, 0x21, 0xf0, 0x06, 0xf0, 0x0e, 0x83, 0x7b, 0x9b,
+	0x63, 0x9e, 0xfb, 0x9b, 0x63, 0x40, 0x0c, 0x4b, 0x8d, 0x9a, 0x06, 0x3a, 0x05, 0x61, 0xbd, 0xf6,
+	0x22, 0xde, 0x0e, 0xfe, 0x9c, 0xa1, 0x2a, 0x0b, 0x92, 0x51, 0x12, 0xfc, 0x14, 0x49, 0xec, 0x2f,
+	0x38, 0x2f, 0xf8, 0xd7, 0xbc, 0x70, 0x34, 0xf3, 0xbf, 0xf4, 0x60, 0x16, 0xfa, 0xcb, 0xb2, 0xe2,
+	0x70, 0x1f, 0xeb, 0x82, 0x4b, 0x89, 0xb5, 0x01, 0x64, 0xe6, 0xae, 0x40, 0xf8, 0x1e, 0x3e, 0x10,
+	0x3f, 0xf1, 0xc3, 0x83, 0xae, 0xc5, 0x32, 0x44, 0x22, 0x06, 0xfa, 0x2e, 0xec, 0xa7, 0x2b, 0xe0,
+	0x7c, 0x56, 0x22, 0x11, 0xd0, 0x01, 0x78, 0xd9, 0x69, 0x60, 0xc1, 0xff, 0xe6, 0x27, 0x63, 0x40,
+	0x0c, 0x59, 0x26, 0x29, 0x82, 0xfe, 0x0c, 0xe0, 0x88, 0x82, 0xc9, 0x9a, 0xaa, 0x97, 0xb2, 0xd6,
+	0x0e, 0x99, 0x95, 0xf2, 0x79, 0x1d, 0x1b, 0x06, 0x73, 0xb9, 0x5f, 0xf8, 0x31, 0xd8, 0x11, 0xde,
+	0x00, 0xfa, 0x8f, 0x40, 0xf2, 0x55, 0xf0, 0xf2, 0xe4, 0xa9, 0x99, 0xc9, 0x53, 0x33, 0x2f, 0x49,
+	0xb1, 0x8d, 0x54, 0xec, 0xd2, 0xf1, 0xd8, 0xb7, 0xaf, 0xdc, 0x6c, 0x78, 0xae, 0x3f, 0x5e, 0x8e,
+	0x5d, 0x99, 0x6e, 0x10, 0x4c, 0x5d, 0x8e, 0x4f, 0x4d, 0x53, 0xbb, 0x54, 0xec, 0x92, 0x14, 0xdb,
+	0xb0, 0xec, 0xea, 0xcf, 0xf5, 0x47, 0x66, 0x57, 0x17, 0x4c, 0x4d, 0x9e, 0x9a, 0x99, 0x79, 0x89,
+	0x3e, 0xdd, 0xf8, 0xfa, 0x33, 0xdf, 0xbc, 0x35, 0x75, 0x6a, 0xe2, 0xe6, 0xcb, 0x13, 0xe2, 0x41,
+	0x9b, 0x6e, 0x86, 0xb1, 0x4d, 0x59, 0x64, 0xd1, 0x0b, 0xf0, 0x40, 0x59, 0x32, 0x48, 0xd6, 0xd4,
+	0xb2, 0x3a, 0xce, 0x61, 0x79, 0xd5, 0x0a, 0x88, 0xaf, 0xc7, 0x80, 0x0c, 0x53, 0xe3, 0x0b, 0x9a,
+	0x68, 0x9b, 0xa6, 0x08, 0x1a, 0x85, 0x21, 0x53, 0xcb, 0xe6, 0x54, 0x53, 0x21, 0xac, 0x67, 0xfd,
+	0x62, 0x9f, 0xa9, 0x9d, 0xa6, 0x3f, 0xd1, 0x15, 0x18, 0x65, 0x6b, 0xe5, 0xd5, 0x35, 0x85, 0x06,
+	0x92, 0x6e, 0x14, 0x6b, 0x92, 0x9e, 0xb7, 0x96, 0x0c, 0xf4, 0xb8, 0xe4, 0x61, 0x8a, 0x91, 0xb6,
+	0x21, 0xce, 0x38, 0x08, 0x29, 0x82, 0x9e, 0x82, 0xe1, 0x1a, 0xb2, 0xb5, 0x7e, 0x90, 0xad, 0x3f,
+	0xe4, 0xbc, 0x65, 0x2c, 0xf8, 0x5f, 0x07, 0xe0, 0xbe, 0x94, 0x71, 0x5a, 0x55, 0x0a, 0x72, 0xd1,
+	0x1e, 0x0e, 0x68, 0x1e, 0x06, 0x35, 0x73, 0xc5, 0x30, 0x57, 0x3a, 0xf6, 0x41, 0xb3, 0x41, 0x7c,
+	0xc9, 0x5c, 0xc9, 0x98, 0x2b, 0x02, 0xac, 0x6e, 0x8e, 0x05, 0xad, 0x67, 0xd1, 0x46, 0x88, 0xfe,
+	0xc5, 0x0f, 0xed, 0x57, 0x68, 0x11, 0xf6, 0x6b, 0xba, 0xba, 0x2a, 0xe7, 0xe9, 0x56, 0x68, 0x21,
+	0x1f, 0xef, 0x0d, 0x39, 0xbe, 0xe4, 0xd8, 0x89, 0x75, 0x88, 0xe8, 0xbf, 0x7c, 0xb0, 0xbf, 0x26,
+	0x40, 0x17, 0xa0, 0x9f, 0xee, 0xc9, 0x0c, 0x38, 0x9c, 0x7c, 0xf6, 0x61, 0x81, 0xe3, 0xb4, 0x0f,
+	0x4c, 0x43, 0x08, 0x55, 0x37, 0xc7, 0xfc, 0x0b, 0xe7, 0x97, 0x97, 0x45, 0x06, 0x47, 0x61, 0x15,
+	0x89, 0x58, 0x65, 0xbc, 0x57, 0xd8, 0xc5, 0xd4, 0x72, 0x46, 0x64, 0x70, 0xe8, 0x32, 0xec, 0x93,
+	0xd6, 0x8c, 0xac, 0xac, 0x5a, 0xc5, 0xb5, 0x07, 0x64, 0x16, 0xf4, 0xd4, 0xc5, 0xcc, 0x59, 0x75,
+	0x59, 0x0c, 0x4a, 0x6b, 0xc6, 0x59, 0x95, 0xa0, 0x05, 0x18, 0x28, 0x49, 0x85, 0x92, 0x14, 0xf1,
+	0xef, 0x09, 0x5b, 0xb4, 0x50, 0x68, 0x0c, 0xa4, 0xca, 0x35, 0x2d, 0x12, 0xd8, 0x13, 0x9a, 0x15,
+	0x83, 0xd4, 0xc2, 0xf9, 0x25, 0x91, 0xc1, 0xf1, 0xc7, 0x61, 0xd0, 0x92, 0xa0, 0x01, 0xd8, 0x37,
+	0xbb, 0x98, 0x12, 0xce, 0xcd, 0xa6, 0x87, 0x3d, 0xf4, 0xc7, 0xc5, 0x94, 0xb8, 0x78, 0x76, 0x71,
+	0x6e, 0x18, 0xa0, 0x41, 0x18, 0x4a, 0x9f, 0xcd, 0x58, 0x22, 0x2f, 0x7f, 0xc4, 0x1a, 0x44, 0xcd,
+	0xeb, 0xd9, 0xdb, 0x38, 0x9f, 0x83, 0xd1, 0x76, 0x42, 0x43, 0x53, 0x15, 0x03, 0xa3, 0x59, 0x38,
+	0x94, 0x6b, 0x14, 0x44, 0x40, 0x87, 0xad, 0xd8, 0x65, 0xdf, 0x6c, 0xc5, 0x97, 0xe0, 0xe1, 0x45,
+	0x23, 0x65, 0x3c, 0x2f, 0x29, 0xf9, 0x32, 0xbe, 0xa0, 0x95, 0x1b, 0xc6, 0xc8, 0x52, 0xf3, 0x18,
+	0x31, 0x35, 0x5a, 0xe4, 0xbe, 0xc9, 0x81, 0xe4, 0x13, 0x5d, 0xb6, 0xfb, 0x0b, 0x9a, 0x10, 0xda,
+	0x11, 0x02, 0x6f, 0x01, 0x6f, 0xa8, 0x79, 0x6a, 0x5c, 0xd0, 0x0c, 0xfe, 0x5d, 0x1f, 0x3c, 0x90,
+	0xc6, 0x39, 0x35, 0xef, 0x5a, 0xe9, 0x32, 0x0c, 0xd7, 0x3f, 0x84, 0x1a, 0xe6, 0xd5, 0x84, 0x7b,
+	0xa1, 0x59, 0x25, 0x9f, 0x66, 0x4a, 0xdd, 0xa7, 0xd5, 0x20, 0xae, 0xeb, 0xd1, 0xd2, 0x1c, 0x58,
+	0xc5, 0xba, 0xe1, 0x8c, 0x42, 0x6b, 0x64, 0x7d, 0xad, 0x23, 0xf4, 0x8b, 0x96, 0x6e, 0xe3, 0x0a,
+	0xe1, 0xea, 0xe6, 0x18, 0x74, 0xde, 0xa7, 0x0d, 0x11, 0xae, 0x3a, 0x3a, 0xf4, 0x23, 0xa5, 0xbf,
+	0xf6, 0x41, 0x64, 0x97, 0xfe, 0xb8, 0x1b, 0xdb, 0xfd, 0x21, 0x24, 0xc0, 0x1d, 0xa1, 0xef, 0x15,
+	0xe0, 0x1f, 0x06, 0xe3, 0x74, 0x9a, 0x39, 0xaf, 0xd1, 0xd3, 0xb0, 0x5f, 0x93, 0x74, 0xa9, 0x82,
+	0x29, 0x96, 0x9f, 0xcd, 0x99, 0xfe, 0x1d, 0x21, 0xa8, 0xfb, 0x23, 0xb7, 0xef, 0x7a, 0xc5, 0xba,
+	0x0c, 0x4d, 0xc0, 0x60, 0x21, 0xab, 0xa9, 0xba, 0xb5, 0xad, 0x0e, 0x09, 0x43, 0x3b, 0x02, 0x9c,
+	0x0e, 0x45, 0xfe, 0x0d, 0x26, 0xc1, 0xc9, 0x07, 0x40, 0x0c, 0x14, 0x96, 0x54, 0x9d, 0xa0, 0x67,
+	0xe1, 0x40, 0x41, 0xaf, 0x38, 0x9f, 0x4f, 0x6c, 0xbb, 0x1c, 0x14, 0x46, 0x76, 0x84, 0xc0, 0x86,
+	0x2f, 0x72, 0xdb, 0x4b, 0x7d, 0x3a, 0x23, 0x2e, 0xd8, 0xdc, 0x44, 0x58, 0xd0, 0x2b, 0xf6, 0x33,
+	0xff, 0x8e, 0x0f, 0x1e, 0x6c, 0xce, 0x93, 0x5d, 0x74, 0xdf, 0x83, 0xfb, 0xf2, 0xec, 0x7d, 0xbe,
+	0x86, 0x6a, 0x65, 0xea, 0x70, 0xcb, 0xbe, 0x9e, 0x61, 0x9f, 0xce, 0x62, 0xd8, 0xd6, 0xb7, 0xa1,
+	0xd1, 0x49, 0x18, 0x71, 0x21, 0x64, 0xd7, 0x24, 0x5d, 0x91, 0x95, 0x22, 0xcd, 0x8c, 0x6f, 0xb2,
+	0x5f, 0x1c, 0x69, 0xb6, 0xb8, 0x68, 0x4b, 0x51, 0x12, 0x06, 0xd8, 0x97, 0xb0, 0x3d, 0xbc, 0x8e,
+	0xb6, 0x24, 0x90, 0x0a, 0xd3, 0x98, 0x48, 0x72, 0xd9, 0x10, 0x2d, 0x55, 0x34, 0x0f, 0xc3, 0xf8,
+	0x3a, 0xce, 0x99, 0xac, 0x80, 0xe9, 0xe7, 0x3a, 0x8b, 0xea, 0x40, 0x72, 0xb4, 0x85, 0x6e, 0xda,
+	0x6e, 0x08, 0x21, 0x44, 0xab, 0xe8, 0x0e, 0x9d, 0x44, 0x43, 0x35, 0x53, 0x3a, 0xa3, 0xd0, 0x19,
+	0x88, 0x14, 0x9a, 0xa9, 0xb2, 0xbc, 0xd1, 0xe0, 0x7e, 0x60, 0xdc, 0xd7, 0xcd, 0xfd, 0xfd, 0x75,
+	0x13, 0x27, 0x02, 0xcf, 0xc1, 0x23, 0xad, 0x38, 0xf5, 0x20, 0x04, 0x59, 0x10, 0x46, 0x5b, 0xec,
+	0x9c, 0x38, 0xf0, 0xbf, 0xf7, 0xc1, 0x43, 0xb3, 0x0a, 0x0d, 0x91, 0x33, 0x25, 0xbf, 0x6a, 0xa3,
+	0xff, 0x6a, 0x1b, 0xcd, 0xb7, 0x16, 0x7d, 0xb0, 0x6b, 0xd1, 0x0b, 0x21, 0x27, 0x94, 0xee, 0xf2,
+	0xe7, 0xdf, 0xf1, 0xc2, 0x11, 0x77, 0xf2, 0xec, 0xde, 0x4a, 0x34, 0x77, 0x2b, 0x60, 0xdd, 0x1a,
+	0xee, 0xdc, 0xa5, 0xe8, 0x50, 0x8d, 0x3d, 0xcd, 0xc5, 0x90, 0x43, 0xb7, 0x5b, 0x87, 0xf9, 0x7a,
+	0xeb, 0x30, 0xff, 0x5e, 0x3a, 0x2c, 0xf0, 0xa8, 0x1d, 0xc6, 0xff, 0xca, 0x07, 0x0f, 0xa5, 0x71,
+	0x73, 0x70, 0xbe, 0xaa, 0xec, 0x2f, 0xe3, 0x80, 0xf8, 0x99, 0x17, 0x8e, 0xa4, 0x71, 0xdb, 0x32,
+	0xfe, 0x3f, 0x1e, 0x11, 0xc9, 0x8f, 0x02, 0xd0, 0x9b, 0x32, 0xd0, 0xdb, 0x00, 0xf6, 0xcd, 0x61,
+	0xc2, 0xee, 0x4d, 0xa6, 0xdc, 0x1c, 0x3a, 0x5e, 0x3c, 0x44, 0x77, 0x3b, 0x45, 0xf3, 0xcf, 0xbd,
+	0xf2, 0xd7, 0xbf, 0xff, 0xd4, 0x7b, 0x12, 0x7d, 0x2b, 0x21, 0x19, 0x4d, 0x57, 0x78, 0x89, 0x1b,
+	0xae, 0xf3, 0x7e, 0xbc, 0xf9, 0xf7, 0xad, 0x04, 0x3b, 0x6d, 0xdf, 0x01, 0xb0, 0x2f, 0xd3, 0x89,
+	0x57, 0xe6, 0xd1, 0x79, 0xa5, 0x18, 0xaf, 0xef, 0x44, 0x1f, 0x91, 0xd7, 0x0c, 0x98, 0x46, 0x37,
+	0x21, 0x4c, 0xe3, 0x32, 0x26, 0x98, 0x91, 0xeb, 0xf1, 0x9e, 0x22, 0x3a, 0xd2, 0x92, 0xa3, 0x59,
+	0x7a, 0xdf, 0xc7, 0xc7, 0x19, 0xa1, 0xc9, 0xe9, 0x63, 0xbb, 0x11, 0xb2, 0x03, 0xf3, 0x16, 0x80,
+	0x83, 0x76, 0xc2, 0xac, 0xdb, 0x83, 0x5e, 0x09, 0x4c, 0xec, 0x12, 0x1a, 0x86, 0xc6, 0x7f, 0x83,
+	0xd1, 0x89, 0xa3, 0x67, 0x7a, 0xa3, 0x93, 0x30, 0x18, 0x87, 0x57, 0x01, 0x1c, 0x9e, 0xc3, 0xa4,
+	0xf9, 0x24, 0xdb, 0xb6, 0x9c, 0xda, 0x1e, 0x1f, 0xa2, 0xd3, 0xbd, 0xa8, 0x5a, 0x4d, 0xcb, 0x8f,
+	0x32, 0x86, 0x07, 0xd0, 0x7e, 0xca, 0xb0, 0xe9, 0x80, 0x90, 0xbc, 0x08, 0xfd, 0xf4, 0x80, 0x80,
+	0x5e, 0x80, 0x83, 0x8d, 0x87, 0x04, 0xf4, 0xb4, 0x1b, 0xbe, 0xc3, 0x31, 0xa2, 0x53, 0x92, 0x92,
+	0xbf, 0x08, 0xc1, 0x40, 0x4a, 0xd3, 0x52, 0x06, 0x5a, 0x86, 0xfd, 0x19, 0x73, 0xc5, 0xc8, 0xe9,
+	0xf2, 0x0a, 0xee, 0x39, 0xf4, 0xdd, 0x0f, 0x21, 0xc7, 0x01, 0xfa, 0x10, 0xc0, 0xfd, 0xce, 0xee,
+	0x74, 0xde, 0xc4, 0x26, 0x5e, 0x32, 0x8d, 0xab, 0xa8, 0x25, 0x63, 0x4d, 0x2a, 0xbb, 0x70, 0xe6,
+	0xaf, 0xb3, 0x38, 0xe9, 0x7c, 0xa5, 0x35, 0x93, 0xcd, 0xf3, 0x29, 0xbe, 0x5b, 0xe1, 0x5b, 0xaa,
+	0xad, 0x76, 0xb5, 0xc7, 0x5b, 0x09, 0x7a, 0xaf, 0x91, 0xd0, 0x4c, 0xe3, 0x2a, 0x6d, 0x90, 0x8f,
+	0x00, 0x3c, 0xe8, 0xa2, 0xaa, 0x95, 0xa5, 0x1c, 0xde, 0xa3, 0x43, 0x37, 0x98, 0x43, 0x26, 0xaf,
+	0x3d, 0x36, 0x87, 0x74, 0x8b, 0x37, 0xf5, 0xe9, 0xb7, 0xee, 0x0c, 0x9d, 0x93, 0x0d, 0x82, 0x7a,
+	0x9a, 0xe9, 0x5d, 0x3b, 0xcf, 0xc1, 0x34, 0x78, 0x91, 0xb9, 0x77, 0x0e, 0xcd, 0x3f, 0xfc, 0xce,
+	0x54, 0xf3, 0xc7, 0xe5, 0x00, 0xfa, 0x25, 0x80, 0x87, 0xe6, 0x30, 0xa1, 0x57, 0x2a, 0xa7, 0x55,
+	0x45, 0xc1, 0x39, 0x56, 0x99, 0x4a, 0x41, 0xed, 0xb9, 0x74, 0xf9, 0x96, 0xfb, 0xf2, 0x16, 0xac,
+	0xde, 0xf7, 0xfa, 0x5b, 0xec, 0xe6, 0x3e, 0x96, 0xab, 0x99, 0xc7, 0x64, 0xca, 0xe5, 0x4f, 0x00,
+	0x86, 0x33, 0x72, 0xc5, 0x2c, 0x4b, 0xc4, 0xe9, 0xd8, 0xee, 0x1d, 0xd3, 0xb1, 0x44, 0x36, 0x18,
+	0x13, 0xc2, 0xab, 0x8f, 0xa3, 0x44, 0x4c, 0x2d, 0x61, 0xd8, 0xac, 0x67, 0xc0, 0x74, 0xf2, 0x9f,
+	0x7e, 0x78, 0x20, 0x65, 0xd4, 0x0a, 0x40, 0xc4, 0x45, 0xd9, 0x20, 0xfa, 0x3a, 0xfa, 0x0d, 0x80,
+	0xbe, 0x39, 0x4c, 0xd0, 0x93, 0x6d, 0xf6, 0xb8, 0x06, 0x6d, 0xab, 0xf6, 0x47, 0x3b, 0x16, 0x14,
+	0x5f, 0x62, 0xbe, 0x61, 0x94, 0x7b, 0x0c, 0xbe, 0xa1, 0xd7, 0xbc, 0xd0, 0x97, 0x69, 0x47, 0x3a,
+	0xf3, 0x70, 0xa4, 0xff, 0x00, 0x18, 0xeb, 0xdf, 0x81, 0x68, 0x57, 0xda, 0xf1, 0x47, 0xa4, 0x1d,
+	0x6f, 0xa6, 0x3d, 0x03, 0xa6, 0x2f, 0x2d, 0xf0, 0xcf, 0x7f, 0x51, 0x2b, 0xd1, 0xbe, 0x7f, 0x1b,
+	0xc0, 0xa0, 0x35, 0xed, 0x7b, 0x6c, 0xf6, 0x4e, 0xa5, 0xb9, 0xc0, 0x02, 0x31, 0x37, 0x3d, 0xfb,
+	0x85, 0xb4, 0x77, 0xf2, 0xc3, 0x00, 0xad, 0xb6, 0x96, 0x7f, 0xad, 0xd0, 0x27, 0x00, 0x0e, 0x36,
+	0x5e, 0x87, 0xb4, 0x66, 0xb0, 0xcd, 0xa5, 0x56, 0x74, 0xa2, 0xbb, 0x92, 0x3d, 0x79, 0x7f, 0x60,
+	0x25, 0xf3, 0x16, 0x7f, 0xfd, 0x71, 0xb4, 0x57, 0xfd, 0xaf, 0x3b, 0xda, 0x69, 0xd6, 0x57, 0x34,
+	0xcd, 0xc8, 0x3f, 0x00, 0x0c, 0x37, 0x1f, 0x4b, 0xd1, 0x53, 0xad, 0x99, 0x69, 0x73, 0x32, 0x8b,
+	0x1e, 0xdb, 0x4d, 0xcd, 0xf6, 0xf3, 0x87, 0x96, 0x9f, 0xdf, 0xe7, 0x37, 0x1e, 0xb3, 0x9f, 0x6c,
+	0xe8, 0x60, 0xa5, 0xd1, 0xd3, 0x34, 0xee, 0xee, 0x69, 0x1a, 0xf7, 0xe4, 0x69, 0x1a, 0x7f, 0x09,
+	0x3d, 0xad, 0xe5, 0x54, 0x78, 0x17, 0xdc, 0xdb, 0xe2, 0xc0, 0xfd, 0x2d, 0x0e, 0x7c, 0xbc, 0xc5,
+	0x79, 0x1e, 0x6c, 0x71, 0x9e, 0x4f, 0xb7, 0x38, 0xcf, 0x67, 0x5b, 0x9c, 0xe7, 0xf3, 0x2d, 0x0e,
+	0xdc, 0xae, 0x72, 0xe0, 0xf5, 0x2a, 0xe7, 0x79, 0xaf, 0xca, 0x81, 0xf7, 0xab, 0x9c, 0xe7, 0x83,
+	0x2a, 0xe7, 0xb9, 0x5b, 0xe5, 0x3c, 0xf7, 0xaa, 0x1c, 0xb8, 0x5f, 0xe5, 0xc0, 0xc7, 0x55, 0xce,
+	0xf3, 0xa0, 0xca, 0x81, 0x4f, 0xab, 0x9c, 0xe7, 0xb3, 0x2a, 0x07, 0x3e, 0xaf, 0x72, 0x9e, 0xdb,
+	0xdb, 0x9c, 0xe7, 0xf5, 0x6d, 0x0e, 0xbc, 0xb9, 0xcd, 0x79, 0xee, 0x6c, 0x73, 0xe0, 0xe7, 0xdb,
+	0x9c, 0xe7, 0xbd, 0x6d, 0xce, 0xf3, 0xfe, 0x36, 0x07, 0x3e, 0xd8, 0xe6, 0xc0, 0xdd, 0x6d, 0x0e,
+	0x5c, 0x4a, 0x14, 0xd5, 0x38, 0xb9, 0x8a, 0xc9, 0x55, 0x7a, 0x22, 0x8b, 0xdb, 0x7f, 0x4a, 0x25,
+	0x9a, 0xff, 0x6f, 0x5e, 0x3d, 0x91, 0xd0, 0x4a, 0xc5, 0x04, 0x21, 0x8a, 0xb6, 0xb2, 0x12, 0x64,
+	0x4d, 0x7d, 0xe2, 0x3f, 0x03, 0x00, 0xf9, 0x8a, 0xf6, 0x61, 0xa7, 0x20, 0x00, 0x00,
+}
+
+func (x AsConfiguration_PubSub_Providers_Status) String() string {
+	s, ok := AsConfiguration_PubSub_Providers_Status_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ApplicationLink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationLink)
+	if !ok {
+		that2, ok := that.(ApplicationLink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
+		return false
+	}
+	if this.TLS != that1.TLS {
+		return false
+	}
+	if !this.SkipPayloadCrypto.Equal(that1.SkipPayloadCrypto) {
+		return false
+	}
+	return true
+}
+func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationLinkRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *SetApplicationLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetApplicationLinkRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.ApplicationLink.Equal(&that1.ApplicationLink) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationLinkStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
 	that1, ok := that.(*ApplicationLinkStats)
@@ -960,6 +1518,12 @@ func (this *AsConfiguration_PubSub_Providers) Equal(that interface{}) bool {
 	if this.AWSIoT != that1.AWSIoT {
 		return false
 	}
+	if this.Kafka != that1.Kafka {
+		return false
+	}
+	if this.AMQP != that1.AMQP {
+		return false
+	}
 	return true
 }
 func (this *GetAsConfigurationRequest) Equal(that interface{}) bool {
@@ -1036,34 +1600,284 @@ func (this *NsAsHandleUplinkRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DecodeUplinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AsClient is the client API for As service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AsClient interface {
-	// Get a link configuration from the Application Server to Network Server.
-	// This only contains the configuration. Use GetLinkStats to view statistics and any link errors.
-	GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
-	// Set a link configuration from the Application Server a Network Server.
-	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
-	// To get link statistics or errors, use GetLinkStats.
-	// Note that there can only be one Application Server instance linked to a Network Server for a given application at a time.
-	SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
-	// Delete the link between the Application Server and Network Server for the specified application.
-	DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
-	// GetLinkStats returns the link statistics.
-	// This call returns a NotFound error code if there is no link for the given application identifiers.
-	// This call returns the error code of the link error if linking to a Network Server failed.
-	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
-	GetConfiguration(ctx context.Context, in *GetAsConfigurationRequest, opts ...grpc.CallOption) (*GetAsConfigurationResponse, error)
+	that1, ok := that.(*DecodeUplinkRequest)
+	if !ok {
+		that2, ok := that.(DecodeUplinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
+		return false
+	}
+	return true
+}
+func (this *DecodeUplinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecodeUplinkResponse)
+	if !ok {
+		that2, ok := that.(DecodeUplinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
+		return false
+	}
+	if len(this.DecodedPayloadWarnings) != len(that1.DecodedPayloadWarnings) {
+		return false
+	}
+	for i := range this.DecodedPayloadWarnings {
+		if this.DecodedPayloadWarnings[i] != that1.DecodedPayloadWarnings[i] {
+			return false
+		}
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.ExecutionTime != that1.ExecutionTime {
+		return false
+	}
+	if len(this.NormalizedPayload) != len(that1.NormalizedPayload) {
+		return false
+	}
+	for i := range this.NormalizedPayload {
+		if !this.NormalizedPayload[i].Equal(that1.NormalizedPayload[i]) {
+			return false
+		}
+	}
+	if len(this.NormalizedPayloadWarnings) != len(that1.NormalizedPayloadWarnings) {
+		return false
+	}
+	for i := range this.NormalizedPayloadWarnings {
+		if this.NormalizedPayloadWarnings[i] != that1.NormalizedPayloadWarnings[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EncodeDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodeDownlinkRequest)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
+		return false
+	}
+	return true
+}
+func (this *EncodeDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodeDownlinkResponse)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if len(this.DecodedPayloadWarnings) != len(that1.DecodedPayloadWarnings) {
+		return false
+	}
+	for i := range this.DecodedPayloadWarnings {
+		if this.DecodedPayloadWarnings[i] != that1.DecodedPayloadWarnings[i] {
+			return false
+		}
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.ExecutionTime != that1.ExecutionTime {
+		return false
+	}
+	return true
+}
+func (this *DecodeDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecodeDownlinkRequest)
+	if !ok {
+		that2, ok := that.(DecodeDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
+		return false
+	}
+	return true
+}
+func (this *DecodeDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecodeDownlinkResponse)
+	if !ok {
+		that2, ok := that.(DecodeDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
+		return false
+	}
+	if len(this.DecodedPayloadWarnings) != len(that1.DecodedPayloadWarnings) {
+		return false
+	}
+	for i := range this.DecodedPayloadWarnings {
+		if this.DecodedPayloadWarnings[i] != that1.DecodedPayloadWarnings[i] {
+			return false
+		}
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.ExecutionTime != that1.ExecutionTime {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AsClient is the client API for As service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsClient interface {
+	// Get a link configuration from the Application Server to Network Server.
+	// This only contains the configuration. Use GetLinkStats to view statistics and any link errors.
+	GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
+	// Set a link configuration from the Application Server a Network Server.
+	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
+	// To get link statistics or errors, use GetLinkStats.
+	// Note that there can only be one Application Server instance linked to a Network Server for a given application at a time.
+	SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
+	// Delete the link between the Application Server and Network Server for the specified application.
+	DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetLinkStats returns the link statistics.
+	// This call returns a NotFound error code if there is no link for the given application identifiers.
+	// This call returns the error code of the link error if linking to a Network Server failed.
+	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
+	GetConfiguration(ctx context.Context, in *GetAsConfigurationRequest, opts ...grpc.CallOption) (*GetAsConfigurationResponse, error)
 }
 
 type asClient struct {
@@ -1801,41 +2615,191 @@ var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/applicationserver.proto",
 }
 
-func (m *ApplicationLink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// AsPayloadFormattersClient is the client API for AsPayloadFormatters service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsPayloadFormattersClient interface {
+	// Decode an uplink frame payload with the given payload formatter.
+	DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error)
+	// Encode a downlink frame payload with the given payload formatter.
+	EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error)
+	// Decode a downlink frame payload with the given payload formatter.
+	DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error)
+}
+
+type asPayloadFormattersClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsPayloadFormattersClient(cc *grpc.ClientConn) AsPayloadFormattersClient {
+	return &asPayloadFormattersClient{cc}
+}
+
+func (c *asPayloadFormattersClient) DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error) {
+	out := new(DecodeUplinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsPayloadFormatters/DecodeUplink", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ApplicationLink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *asPayloadFormattersClient) EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error) {
+	out := new(EncodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsPayloadFormatters/EncodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ApplicationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SkipPayloadCrypto != nil {
-		{
-			size, err := m.SkipPayloadCrypto.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (c *asPayloadFormattersClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error) {
+	out := new(DecodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsPayloadFormatters/DecodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TLS {
-		i--
-		if m.TLS {
+	return out, nil
+}
+
+// AsPayloadFormattersServer is the server API for AsPayloadFormatters service.
+type AsPayloadFormattersServer interface {
+	// Decode an uplink frame payload with the given payload formatter.
+	DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error)
+	// Encode a downlink frame payload with the given payload formatter.
+	EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error)
+	// Decode a downlink frame payload with the given payload formatter.
+	DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error)
+}
+
+// UnimplementedAsPayloadFormattersServer can be embedded to have forward compatible implementations.
+type UnimplementedAsPayloadFormattersServer struct {
+}
+
+func (*UnimplementedAsPayloadFormattersServer) DecodeUplink(ctx context.Context, req *DecodeUplinkRequest) (*DecodeUplinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeUplink not implemented")
+}
+func (*UnimplementedAsPayloadFormattersServer) EncodeDownlink(ctx context.Context, req *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeDownlink not implemented")
+}
+func (*UnimplementedAsPayloadFormattersServer) DecodeDownlink(ctx context.Context, req *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeDownlink not implemented")
+}
+
+func RegisterAsPayloadFormattersServer(s *grpc.Server, srv AsPayloadFormattersServer) {
+	s.RegisterService(&_AsPayloadFormatters_serviceDesc, srv)
+}
+
+func _AsPayloadFormatters_DecodeUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsPayloadFormattersServer).DecodeUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsPayloadFormatters/DecodeUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsPayloadFormattersServer).DecodeUplink(ctx, req.(*DecodeUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsPayloadFormatters_EncodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsPayloadFormattersServer).EncodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsPayloadFormatters/EncodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsPayloadFormattersServer).EncodeDownlink(ctx, req.(*EncodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsPayloadFormatters_DecodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsPayloadFormattersServer).DecodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsPayloadFormatters/DecodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsPayloadFormattersServer).DecodeDownlink(ctx, req.(*DecodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AsPayloadFormatters_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AsPayloadFormatters",
+	HandlerType: (*AsPayloadFormattersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DecodeUplink",
+			Handler:    _AsPayloadFormatters_DecodeUplink_Handler,
+		},
+		{
+			MethodName: "EncodeDownlink",
+			Handler:    _AsPayloadFormatters_EncodeDownlink_Handler,
+		},
+		{
+			MethodName: "DecodeDownlink",
+			Handler:    _AsPayloadFormatters_DecodeDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
+func (m *ApplicationLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkipPayloadCrypto != nil {
+		{
+			size, err := m.SkipPayloadCrypto.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TLS {
+		i--
+		if m.TLS {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -2114,6 +3078,16 @@ func (m *AsConfiguration_PubSub_Providers) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.AMQP != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.AMQP))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Kafka != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Kafka))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AWSIoT != 0 {
 		i = encodeVarintApplicationserver(dAtA, i, uint64(m.AWSIoT))
 		i--
@@ -2227,498 +3201,2363 @@ func (m *NsAsHandleUplinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
-	this := &ApplicationLink{}
-	if r.Intn(5) != 0 {
-		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
-	}
-	this.TLS = bool(r.Intn(2) == 0)
-	if r.Intn(5) != 0 {
-		this.SkipPayloadCrypto = types.NewPopulatedBoolValue(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedGetApplicationLinkRequest(r randyApplicationserver, easy bool) *GetApplicationLinkRequest {
-	this := &GetApplicationLinkRequest{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	v2 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v2
-	if !easy && r.Intn(10) != 0 {
+func (m *DecodeUplinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedSetApplicationLinkRequest(r randyApplicationserver, easy bool) *SetApplicationLinkRequest {
-	this := &SetApplicationLinkRequest{}
-	v3 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v3
-	v4 := NewPopulatedApplicationLink(r, easy)
-	this.ApplicationLink = *v4
-	v5 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v5
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *DecodeUplinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *ApplicationLinkStats {
-	this := &ApplicationLinkStats{}
-	if r.Intn(5) != 0 {
-		this.LinkedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+func (m *DecodeUplinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.FRMPayload)))
+		i--
+		dAtA[i] = 0x32
 	}
-	this.NetworkServerAddress = randStringApplicationserver(r)
-	if r.Intn(5) != 0 {
-		this.LastUpReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if m.FPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x28
 	}
-	this.UpCount = uint64(r.Uint32())
-	if r.Intn(5) != 0 {
-		this.LastDownlinkForwardedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x22
 	}
-	this.DownlinkCount = uint64(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x18
 	}
-	return this
-}
-
-func NewPopulatedAsConfiguration(r randyApplicationserver, easy bool) *AsConfiguration {
-	this := &AsConfiguration{}
-	if r.Intn(5) != 0 {
-		this.PubSub = NewPopulatedAsConfiguration_PubSub(r, easy)
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if !easy && r.Intn(10) != 0 {
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedAsConfiguration_PubSub(r randyApplicationserver, easy bool) *AsConfiguration_PubSub {
-	this := &AsConfiguration_PubSub{}
-	if r.Intn(5) != 0 {
-		this.Providers = NewPopulatedAsConfiguration_PubSub_Providers(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *DecodeUplinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedAsConfiguration_PubSub_Providers(r randyApplicationserver, easy bool) *AsConfiguration_PubSub_Providers {
-	this := &AsConfiguration_PubSub_Providers{}
-	this.MQTT = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
-	this.NATS = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
-	this.AWSIoT = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *DecodeUplinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedGetAsConfigurationRequest(r randyApplicationserver, easy bool) *GetAsConfigurationRequest {
-	this := &GetAsConfigurationRequest{}
-	if !easy && r.Intn(10) != 0 {
+func (m *DecodeUplinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NormalizedPayloadWarnings) > 0 {
+		for iNdEx := len(m.NormalizedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NormalizedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.NormalizedPayloadWarnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.NormalizedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return this
-}
-
-func NewPopulatedGetAsConfigurationResponse(r randyApplicationserver, easy bool) *GetAsConfigurationResponse {
-	this := &GetAsConfigurationResponse{}
-	if r.Intn(5) != 0 {
-		this.Configuration = NewPopulatedAsConfiguration(r, easy)
+	if len(m.NormalizedPayload) > 0 {
+		for iNdEx := len(m.NormalizedPayload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizedPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	return this
-}
-
-func NewPopulatedNsAsHandleUplinkRequest(r randyApplicationserver, easy bool) *NsAsHandleUplinkRequest {
-	this := &NsAsHandleUplinkRequest{}
-	if r.Intn(5) == 0 {
-		v6 := r.Intn(5)
-		this.ApplicationUps = make([]*ApplicationUp, v6)
-		for i := 0; i < v6; i++ {
-			this.ApplicationUps[i] = NewPopulatedApplicationUp(r, easy)
+	i -= n16
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for iNdEx := len(m.DecodedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DecodedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.DecodedPayloadWarnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.DecodedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return this
-}
-
-type randyApplicationserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if m.DecodedPayload != nil {
+		{
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return rune(ru + 61)
+	return len(dAtA) - i, nil
 }
-func randStringApplicationserver(r randyApplicationserver) string {
-	v7 := r.Intn(100)
-	tmps := make([]rune, v7)
-	for i := 0; i < v7; i++ {
-		tmps[i] = randUTF8RuneApplicationserver(r)
+
+func (m *EncodeDownlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return string(tmps)
+	return dAtA[:n], nil
 }
-func randUnrecognizedApplicationserver(r randyApplicationserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApplicationserver(dAtA, r, fieldNumber, wire)
-	}
-	return dAtA
+
+func (m *EncodeDownlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func randFieldApplicationserver(dAtA []byte, r randyApplicationserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		v8 := r.Int63()
-		if r.Intn(2) == 0 {
-			v8 *= -1
-		}
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v8))
-	case 1:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+
+func (m *EncodeDownlinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodedPayload != nil {
+		{
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
 		}
-	default:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA
-}
-func encodeVarintPopulateApplicationserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+	if m.FPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x28
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *ApplicationLink) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.DefaultFormatters != nil {
-		l = m.DefaultFormatters.Size()
-		n += 1 + l + sovApplicationserver(uint64(l))
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.TLS {
-		n += 2
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SkipPayloadCrypto != nil {
-		l = m.SkipPayloadCrypto.Size()
-		n += 1 + l + sovApplicationserver(uint64(l))
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetApplicationLinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EncodeDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetApplicationLinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	l = m.ApplicationLink.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	return n
+func (m *EncodeDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationLinkStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EncodeDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LinkedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LinkedAt)
-		n += 1 + l + sovApplicationserver(uint64(l))
-	}
-	l = len(m.NetworkServerAddress)
-	if l > 0 {
-		n += 1 + l + sovApplicationserver(uint64(l))
+	n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionTime):])
+	if err22 != nil {
+		return 0, err22
 	}
-	if m.LastUpReceivedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpReceivedAt)
-		n += 1 + l + sovApplicationserver(uint64(l))
+	i -= n22
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.UpCount != 0 {
-		n += 1 + sovApplicationserver(m.UpCount)
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for iNdEx := len(m.DecodedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DecodedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.DecodedPayloadWarnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.DecodedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.LastDownlinkForwardedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkForwardedAt)
-		n += 1 + l + sovApplicationserver(uint64(l))
+	if m.FPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DownlinkCount != 0 {
-		n += 1 + sovApplicationserver(m.DownlinkCount)
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.FRMPayload)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AsConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PubSub != nil {
-		l = m.PubSub.Size()
-		n += 1 + l + sovApplicationserver(uint64(l))
+func (m *DecodeDownlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AsConfiguration_PubSub) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Providers != nil {
-		l = m.Providers.Size()
-		n += 1 + l + sovApplicationserver(uint64(l))
-	}
-	return n
+func (m *DecodeDownlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AsConfiguration_PubSub_Providers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DecodeDownlinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MQTT != 0 {
-		n += 1 + sovApplicationserver(uint64(m.MQTT))
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.FRMPayload)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.NATS != 0 {
-		n += 1 + sovApplicationserver(uint64(m.NATS))
+	if m.FPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.AWSIoT != 0 {
-		n += 1 + sovApplicationserver(uint64(m.AWSIoT))
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAsConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DecodeDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAsConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Configuration != nil {
-		l = m.Configuration.Size()
-		n += 1 + l + sovApplicationserver(uint64(l))
-	}
-	return n
+func (m *DecodeDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NsAsHandleUplinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DecodeDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ApplicationUps) > 0 {
-		for _, e := range m.ApplicationUps {
-			l = e.Size()
-			n += 1 + l + sovApplicationserver(uint64(l))
+	n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionTime):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x22
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for iNdEx := len(m.DecodedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DecodedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.DecodedPayloadWarnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.DecodedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.DecodedPayload != nil {
+		{
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovApplicationserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApplicationserver(x uint64) (n int) {
-	return sovApplicationserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *ApplicationLink) String() string {
-	if this == nil {
-		return "nil"
+func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	s := strings.Join([]string{`&ApplicationLink{`,
-		`DefaultFormatters:` + strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
-		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
-		`SkipPayloadCrypto:` + strings.Replace(fmt.Sprintf("%v", this.SkipPayloadCrypto), "BoolValue", "types.BoolValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (this *GetApplicationLinkRequest) String() string {
-	if this == nil {
-		return "nil"
+func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
+	this := &ApplicationLink{}
+	if r.Intn(5) != 0 {
+		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
 	}
-	s := strings.Join([]string{`&GetApplicationLinkRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetApplicationLinkRequest) String() string {
-	if this == nil {
-		return "nil"
+	this.TLS = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.SkipPayloadCrypto = types.NewPopulatedBoolValue(r, easy)
 	}
-	s := strings.Join([]string{`&SetApplicationLinkRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`ApplicationLink:` + strings.Replace(strings.Replace(this.ApplicationLink.String(), "ApplicationLink", "ApplicationLink", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationLinkStats) String() string {
-	if this == nil {
-		return "nil"
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&ApplicationLinkStats{`,
-		`LinkedAt:` + strings.Replace(fmt.Sprintf("%v", this.LinkedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
-		`LastUpReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUpReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`UpCount:` + fmt.Sprintf("%v", this.UpCount) + `,`,
-		`LastDownlinkForwardedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkForwardedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *AsConfiguration) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedGetApplicationLinkRequest(r randyApplicationserver, easy bool) *GetApplicationLinkRequest {
+	this := &GetApplicationLinkRequest{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	v2 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v2
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&AsConfiguration{`,
-		`PubSub:` + strings.Replace(fmt.Sprintf("%v", this.PubSub), "AsConfiguration_PubSub", "AsConfiguration_PubSub", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *AsConfiguration_PubSub) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedSetApplicationLinkRequest(r randyApplicationserver, easy bool) *SetApplicationLinkRequest {
+	this := &SetApplicationLinkRequest{}
+	v3 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v3
+	v4 := NewPopulatedApplicationLink(r, easy)
+	this.ApplicationLink = *v4
+	v5 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v5
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&AsConfiguration_PubSub{`,
-		`Providers:` + strings.Replace(fmt.Sprintf("%v", this.Providers), "AsConfiguration_PubSub_Providers", "AsConfiguration_PubSub_Providers", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *AsConfiguration_PubSub_Providers) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *ApplicationLinkStats {
+	this := &ApplicationLinkStats{}
+	if r.Intn(5) != 0 {
+		this.LinkedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
-	s := strings.Join([]string{`&AsConfiguration_PubSub_Providers{`,
-		`MQTT:` + fmt.Sprintf("%v", this.MQTT) + `,`,
-		`NATS:` + fmt.Sprintf("%v", this.NATS) + `,`,
-		`AWSIoT:` + fmt.Sprintf("%v", this.AWSIoT) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetAsConfigurationRequest) String() string {
-	if this == nil {
-		return "nil"
+	this.NetworkServerAddress = randStringApplicationserver(r)
+	if r.Intn(5) != 0 {
+		this.LastUpReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
-	s := strings.Join([]string{`&GetAsConfigurationRequest{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetAsConfigurationResponse) String() string {
-	if this == nil {
-		return "nil"
+	this.UpCount = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.LastDownlinkForwardedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
-	s := strings.Join([]string{`&GetAsConfigurationResponse{`,
-		`Configuration:` + strings.Replace(this.Configuration.String(), "AsConfiguration", "AsConfiguration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	this.DownlinkCount = uint64(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *NsAsHandleUplinkRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedAsConfiguration(r randyApplicationserver, easy bool) *AsConfiguration {
+	this := &AsConfiguration{}
+	if r.Intn(5) != 0 {
+		this.PubSub = NewPopulatedAsConfiguration_PubSub(r, easy)
 	}
-	repeatedStringForApplicationUps := "[]*ApplicationUp{"
-	for _, f := range this.ApplicationUps {
-		repeatedStringForApplicationUps += strings.Replace(fmt.Sprintf("%v", f), "ApplicationUp", "ApplicationUp", 1) + ","
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForApplicationUps += "}"
-	s := strings.Join([]string{`&NsAsHandleUplinkRequest{`,
-		`ApplicationUps:` + repeatedStringForApplicationUps + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
+}
+
+func NewPopulatedAsConfiguration_PubSub(r randyApplicationserver, easy bool) *AsConfiguration_PubSub {
+	this := &AsConfiguration_PubSub{}
+	if r.Intn(5) != 0 {
+		this.Providers = NewPopulatedAsConfiguration_PubSub_Providers(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAsConfiguration_PubSub_Providers(r randyApplicationserver, easy bool) *AsConfiguration_PubSub_Providers {
+	this := &AsConfiguration_PubSub_Providers{}
+	this.MQTT = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	this.NATS = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	this.AWSIoT = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	this.Kafka = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	this.AMQP = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetAsConfigurationRequest(r randyApplicationserver, easy bool) *GetAsConfigurationRequest {
+	this := &GetAsConfigurationRequest{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetAsConfigurationResponse(r randyApplicationserver, easy bool) *GetAsConfigurationResponse {
+	this := &GetAsConfigurationResponse{}
+	if r.Intn(5) != 0 {
+		this.Configuration = NewPopulatedAsConfiguration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNsAsHandleUplinkRequest(r randyApplicationserver, easy bool) *NsAsHandleUplinkRequest {
+	this := &NsAsHandleUplinkRequest{}
+	if r.Intn(5) == 0 {
+		v6 := r.Intn(5)
+		this.ApplicationUps = make([]*ApplicationUp, v6)
+		for i := 0; i < v6; i++ {
+			this.ApplicationUps[i] = NewPopulatedApplicationUp(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodeUplinkRequest(r randyApplicationserver, easy bool) *DecodeUplinkRequest {
+	this := &DecodeUplinkRequest{}
+	v7 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v7
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Parameter = randStringApplicationserver(r)
+	this.FPort = r.Uint32()
+	v8 := r.Intn(100)
+	this.FRMPayload = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.FRMPayload[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodeUplinkResponse(r randyApplicationserver, easy bool) *DecodeUplinkResponse {
+	this := &DecodeUplinkResponse{}
+	if r.Intn(5) != 0 {
+		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	v9 := r.Intn(10)
+	this.DecodedPayloadWarnings = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.DecodedPayloadWarnings[i] = randStringApplicationserver(r)
+	}
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.ExecutionTime = *v10
+	if r.Intn(5) != 0 {
+		v11 := r.Intn(5)
+		this.NormalizedPayload = make([]*types.Struct, v11)
+		for i := 0; i < v11; i++ {
+			this.NormalizedPayload[i] = types.NewPopulatedStruct(r, easy)
+		}
+	}
+	v12 := r.Intn(10)
+	this.NormalizedPayloadWarnings = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.NormalizedPayloadWarnings[i] = randStringApplicationserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEncodeDownlinkRequest(r randyApplicationserver, easy bool) *EncodeDownlinkRequest {
+	this := &EncodeDownlinkRequest{}
+	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v13
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Parameter = randStringApplicationserver(r)
+	this.FPort = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEncodeDownlinkResponse(r randyApplicationserver, easy bool) *EncodeDownlinkResponse {
+	this := &EncodeDownlinkResponse{}
+	v14 := r.Intn(100)
+	this.FRMPayload = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.FRMPayload[i] = byte(r.Intn(256))
+	}
+	this.FPort = r.Uint32()
+	v15 := r.Intn(10)
+	this.DecodedPayloadWarnings = make([]string, v15)
+	for i := 0; i < v15; i++ {
+		this.DecodedPayloadWarnings[i] = randStringApplicationserver(r)
+	}
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	v16 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.ExecutionTime = *v16
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodeDownlinkRequest(r randyApplicationserver, easy bool) *DecodeDownlinkRequest {
+	this := &DecodeDownlinkRequest{}
+	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v17
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Parameter = randStringApplicationserver(r)
+	this.FPort = r.Uint32()
+	v18 := r.Intn(100)
+	this.FRMPayload = make([]byte, v18)
+	for i := 0; i < v18; i++ {
+		this.FRMPayload[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodeDownlinkResponse(r randyApplicationserver, easy bool) *DecodeDownlinkResponse {
+	this := &DecodeDownlinkResponse{}
+	if r.Intn(5) != 0 {
+		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	v19 := r.Intn(10)
+	this.DecodedPayloadWarnings = make([]string, v19)
+	for i := 0; i < v19; i++ {
+		this.DecodedPayloadWarnings[i] = randStringApplicationserver(r)
+	}
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	v20 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.ExecutionTime = *v20
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplicationserver(r randyApplicationserver) string {
+	v21 := r.Intn(100)
+	tmps := make([]rune, v21)
+	for i := 0; i < v21; i++ {
+		tmps[i] = randUTF8RuneApplicationserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApplicationserver(r randyApplicationserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApplicationserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApplicationserver(dAtA []byte, r randyApplicationserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		v22 := r.Int63()
+		if r.Intn(2) == 0 {
+			v22 *= -1
+		}
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v22))
+	case 1:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplicationserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ApplicationLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultFormatters != nil {
+		l = m.DefaultFormatters.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.TLS {
+		n += 2
+	}
+	if m.SkipPayloadCrypto != nil {
+		l = m.SkipPayloadCrypto.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GetApplicationLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *SetApplicationLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = m.ApplicationLink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *ApplicationLinkStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LinkedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = len(m.NetworkServerAddress)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.LastUpReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpReceivedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.UpCount != 0 {
+		n += 1 + sovApplicationserver(m.UpCount)
+	}
+	if m.LastDownlinkForwardedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkForwardedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovApplicationserver(m.DownlinkCount)
+	}
+	return n
+}
+
+func (m *AsConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubSub != nil {
+		l = m.PubSub.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *AsConfiguration_PubSub) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Providers != nil {
+		l = m.Providers.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *AsConfiguration_PubSub_Providers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MQTT != 0 {
+		n += 1 + sovApplicationserver(uint64(m.MQTT))
+	}
+	if m.NATS != 0 {
+		n += 1 + sovApplicationserver(uint64(m.NATS))
+	}
+	if m.AWSIoT != 0 {
+		n += 1 + sovApplicationserver(uint64(m.AWSIoT))
+	}
+	if m.Kafka != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Kafka))
+	}
+	if m.AMQP != 0 {
+		n += 1 + sovApplicationserver(uint64(m.AMQP))
+	}
+	return n
+}
+
+func (m *GetAsConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAsConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configuration != nil {
+		l = m.Configuration.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *NsAsHandleUplinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApplicationUps) > 0 {
+		for _, e := range m.ApplicationUps {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecodeUplinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.FPort))
+	}
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodeUplinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for _, s := range m.DecodedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionTime)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if len(m.NormalizedPayload) > 0 {
+		for _, e := range m.NormalizedPayload {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if len(m.NormalizedPayloadWarnings) > 0 {
+		for _, s := range m.NormalizedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EncodeDownlinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.FPort))
+	}
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *EncodeDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.FPort))
+	}
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for _, s := range m.DecodedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionTime)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *DecodeDownlinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.FPort))
+	}
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodeDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for _, s := range m.DecodedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionTime)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func sovApplicationserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationserver(x uint64) (n int) {
+	return sovApplicationserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *ApplicationLink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationLink{`,
+		`DefaultFormatters:` + strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`SkipPayloadCrypto:` + strings.Replace(fmt.Sprintf("%v", this.SkipPayloadCrypto), "BoolValue", "types.BoolValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetApplicationLinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetApplicationLinkRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetApplicationLinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetApplicationLinkRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ApplicationLink:` + strings.Replace(strings.Replace(this.ApplicationLink.String(), "ApplicationLink", "ApplicationLink", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationLinkStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationLinkStats{`,
+		`LinkedAt:` + strings.Replace(fmt.Sprintf("%v", this.LinkedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
+		`LastUpReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUpReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpCount:` + fmt.Sprintf("%v", this.UpCount) + `,`,
+		`LastDownlinkForwardedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkForwardedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AsConfiguration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AsConfiguration{`,
+		`PubSub:` + strings.Replace(fmt.Sprintf("%v", this.PubSub), "AsConfiguration_PubSub", "AsConfiguration_PubSub", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AsConfiguration_PubSub) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AsConfiguration_PubSub{`,
+		`Providers:` + strings.Replace(fmt.Sprintf("%v", this.Providers), "AsConfiguration_PubSub_Providers", "AsConfiguration_PubSub_Providers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AsConfiguration_PubSub_Providers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AsConfiguration_PubSub_Providers{`,
+		`MQTT:` + fmt.Sprintf("%v", this.MQTT) + `,`,
+		`NATS:` + fmt.Sprintf("%v", this.NATS) + `,`,
+		`AWSIoT:` + fmt.Sprintf("%v", this.AWSIoT) + `,`,
+		`Kafka:` + fmt.Sprintf("%v", this.Kafka) + `,`,
+		`AMQP:` + fmt.Sprintf("%v", this.AMQP) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAsConfigurationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAsConfigurationRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAsConfigurationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAsConfigurationResponse{`,
+		`Configuration:` + strings.Replace(this.Configuration.String(), "AsConfiguration", "AsConfiguration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NsAsHandleUplinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForApplicationUps := "[]*ApplicationUp{"
+	for _, f := range this.ApplicationUps {
+		repeatedStringForApplicationUps += strings.Replace(fmt.Sprintf("%v", f), "ApplicationUp", "ApplicationUp", 1) + ","
+	}
+	repeatedStringForApplicationUps += "}"
+	s := strings.Join([]string{`&NsAsHandleUplinkRequest{`,
+		`ApplicationUps:` + repeatedStringForApplicationUps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecodeUplinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecodeUplinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecodeUplinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNormalizedPayload := "[]*Struct{"
+	for _, f := range this.NormalizedPayload {
+		repeatedStringForNormalizedPayload += strings.Replace(fmt.Sprintf("%v", f), "Struct", "types.Struct", 1) + ","
+	}
+	repeatedStringForNormalizedPayload += "}"
+	s := strings.Join([]string{`&DecodeUplinkResponse{`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`DecodedPayloadWarnings:` + fmt.Sprintf("%v", this.DecodedPayloadWarnings) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`ExecutionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExecutionTime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`NormalizedPayload:` + repeatedStringForNormalizedPayload + `,`,
+		`NormalizedPayloadWarnings:` + fmt.Sprintf("%v", this.NormalizedPayloadWarnings) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncodeDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncodeDownlinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncodeDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncodeDownlinkResponse{`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`DecodedPayloadWarnings:` + fmt.Sprintf("%v", this.DecodedPayloadWarnings) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`ExecutionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExecutionTime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecodeDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecodeDownlinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecodeDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecodeDownlinkResponse{`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`DecodedPayloadWarnings:` + fmt.Sprintf("%v", this.DecodedPayloadWarnings) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`ExecutionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExecutionTime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
 }
 func valueToStringApplicationserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultFormatters == nil {
+				m.DefaultFormatters = &MessagePayloadFormatters{}
+			}
+			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SkipPayloadCrypto == nil {
+				m.SkipPayloadCrypto = &types.BoolValue{}
+			}
+			if err := m.SkipPayloadCrypto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetApplicationLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationLink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationLinkStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationLinkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinkedAt == nil {
+				m.LinkedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LinkedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpReceivedAt == nil {
+				m.LastUpReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpCount", wireType)
+			}
+			m.UpCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkForwardedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDownlinkForwardedAt == nil {
+				m.LastDownlinkForwardedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AsConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubSub == nil {
+				m.PubSub = &AsConfiguration_PubSub{}
+			}
+			if err := m.PubSub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubSub: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubSub: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Providers == nil {
+				m.Providers = &AsConfiguration_PubSub_Providers{}
+			}
+			if err := m.Providers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Providers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Providers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			}
+			m.MQTT = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MQTT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			m.NATS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NATS |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
+			}
+			m.AWSIoT = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AWSIoT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			m.Kafka = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kafka |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			m.AMQP = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AMQP |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAsConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAsConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
+func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2741,15 +5580,15 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAsConfigurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2776,36 +5615,69 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultFormatters == nil {
-				m.DefaultFormatters = &MessagePayloadFormatters{}
+			if m.Configuration == nil {
+				m.Configuration = &AsConfiguration{}
 			}
-			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
 			}
-			m.TLS = bool(v != 0)
-		case 5:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NsAsHandleUplinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NsAsHandleUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationUps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2832,10 +5704,8 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SkipPayloadCrypto == nil {
-				m.SkipPayloadCrypto = &types.BoolValue{}
-			}
-			if err := m.SkipPayloadCrypto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationUps = append(m.ApplicationUps, &ApplicationUp{})
+			if err := m.ApplicationUps[len(m.ApplicationUps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2863,7 +5733,7 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2886,15 +5756,15 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeUplinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2921,13 +5791,13 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2954,10 +5824,117 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -2982,7 +5959,7 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+func (m *DecodeUplinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3005,15 +5982,83 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeUplinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeUplinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
+			}
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedPayloadWarnings = append(m.DecodedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3040,13 +6085,16 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationLink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3073,13 +6121,13 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3106,10 +6154,43 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NormalizedPayload = append(m.NormalizedPayload, &types.Struct{})
+			if err := m.NormalizedPayload[len(m.NormalizedPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedPayloadWarnings = append(m.NormalizedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -3134,7 +6215,7 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
+func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3157,15 +6238,15 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationLinkStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncodeDownlinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationLinkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3192,18 +6273,15 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinkedAt == nil {
-				m.LinkedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LinkedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3213,29 +6291,52 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpReceivedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3245,33 +6346,29 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpReceivedAt == nil {
-				m.LastUpReceivedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
 			}
-			m.UpCount = 0
+			m.FPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3281,14 +6378,14 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpCount |= uint64(b&0x7F) << shift
+				m.FPort |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkForwardedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3315,32 +6412,13 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDownlinkForwardedAt == nil {
-				m.LastDownlinkForwardedAt = new(time.Time)
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
-			}
-			m.DownlinkCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownlinkCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -3365,7 +6443,7 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
+func (m *EncodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3388,17 +6466,17 @@ func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AsConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncodeDownlinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3408,84 +6486,116 @@ func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubSub == nil {
-				m.PubSub = &AsConfiguration_PubSub{}
-			}
-			if err := m.PubSub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
 			}
-			if skippy < 0 {
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
+			m.DecodedPayloadWarnings = append(m.DecodedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PubSub: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubSub: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3512,10 +6622,7 @@ func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Providers == nil {
-				m.Providers = &AsConfiguration_PubSub_Providers{}
-			}
-			if err := m.Providers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3543,7 +6650,7 @@ func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
+func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3566,17 +6673,17 @@ func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Providers: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeDownlinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Providers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
-			m.MQTT = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3586,16 +6693,30 @@ func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MQTT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
 			}
-			m.NATS = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3605,16 +6726,33 @@ func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NATS |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
 			}
-			m.AWSIoT = 0
+			m.Formatter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -3624,64 +6762,96 @@ func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AWSIoT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAsConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
 			}
-			if iNdEx >= l {
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAsConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -3706,7 +6876,7 @@ func (m *GetAsConfigurationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *DecodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3729,15 +6899,15 @@ func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAsConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeDownlinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3764,69 +6934,84 @@ func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Configuration == nil {
-				m.Configuration = &AsConfiguration{}
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
 			}
-			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayloadWarnings", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
+			m.DecodedPayloadWarnings = append(m.DecodedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NsAsHandleUplinkRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NsAsHandleUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationUps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3853,8 +7038,7 @@ func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationUps = append(m.ApplicationUps, &ApplicationUp{})
-			if err := m.ApplicationUps[len(m.ApplicationUps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -66,7 +66,9 @@ var ApplicationLinkStatsFieldPathsTopLevel = []string{
 var AsConfigurationFieldPathsNested = []string{
 	"pubsub",
 	"pubsub.providers",
+	"pubsub.providers.amqp",
 	"pubsub.providers.aws_iot",
+	"pubsub.providers.kafka",
 	"pubsub.providers.mqtt",
 	"pubsub.providers.nats",
 }
@@ -80,7 +82,9 @@ var GetAsConfigurationResponseFieldPathsNested = []string{
 	"configuration",
 	"configuration.pubsub",
 	"configuration.pubsub.providers",
+	"configuration.pubsub.providers.amqp",
 	"configuration.pubsub.providers.aws_iot",
+	"configuration.pubsub.providers.kafka",
 	"configuration.pubsub.providers.mqtt",
 	"configuration.pubsub.providers.nats",
 }
@@ -95,9 +99,179 @@ var NsAsHandleUplinkRequestFieldPathsNested = []string{
 var NsAsHandleUplinkRequestFieldPathsTopLevel = []string{
 	"application_ups",
 }
+var DecodeUplinkRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_port",
+	"formatter",
+	"frm_payload",
+	"parameter",
+	"version_ids",
+	"version_ids.band_id",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var DecodeUplinkRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"f_port",
+	"formatter",
+	"frm_payload",
+	"parameter",
+	"version_ids",
+}
+var DecodeUplinkResponseFieldPathsNested = []string{
+	"decoded_payload",
+	"decoded_payload_warnings",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"execution_time",
+	"normalized_payload",
+	"normalized_payload_warnings",
+}
+
+var DecodeUplinkResponseFieldPathsTopLevel = []string{
+	"decoded_payload",
+	"decoded_payload_warnings",
+	"error",
+	"execution_time",
+	"normalized_payload",
+	"normalized_payload_warnings",
+}
+var EncodeDownlinkRequestFieldPathsNested = []string{
+	"decoded_payload",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_port",
+	"formatter",
+	"parameter",
+	"version_ids",
+	"version_ids.band_id",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var EncodeDownlinkRequestFieldPathsTopLevel = []string{
+	"decoded_payload",
+	"end_device_ids",
+	"f_port",
+	"formatter",
+	"parameter",
+	"version_ids",
+}
+var EncodeDownlinkResponseFieldPathsNested = []string{
+	"decoded_payload_warnings",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"execution_time",
+	"f_port",
+	"frm_payload",
+}
+
+var EncodeDownlinkResponseFieldPathsTopLevel = []string{
+	"decoded_payload_warnings",
+	"error",
+	"execution_time",
+	"f_port",
+	"frm_payload",
+}
+var DecodeDownlinkRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_port",
+	"formatter",
+	"frm_payload",
+	"parameter",
+	"version_ids",
+	"version_ids.band_id",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var DecodeDownlinkRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"f_port",
+	"formatter",
+	"frm_payload",
+	"parameter",
+	"version_ids",
+}
+var DecodeDownlinkResponseFieldPathsNested = []string{
+	"decoded_payload",
+	"decoded_payload_warnings",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"execution_time",
+}
+
+var DecodeDownlinkResponseFieldPathsTopLevel = []string{
+	"decoded_payload",
+	"decoded_payload_warnings",
+	"error",
+	"execution_time",
+}
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
+	"providers.amqp",
 	"providers.aws_iot",
+	"providers.kafka",
 	"providers.mqtt",
 	"providers.nats",
 }
@@ -106,13 +280,17 @@ var AsConfiguration_PubSubFieldPathsTopLevel = []string{
 	"providers",
 }
 var AsConfiguration_PubSub_ProvidersFieldPathsNested = []string{
+	"amqp",
 	"aws_iot",
+	"kafka",
 	"mqtt",
 	"nats",
 }
 
 var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
+	"amqp",
 	"aws_iot",
+	"kafka",
 	"mqtt",
 	"nats",
 }
@@ -4,6 +4,7 @@ package ttnpb
 
 import (
 	fmt "fmt"
+	time "time"
 
 	types "github.com/gogo/protobuf/types"
 )
@@ -329,6 +330,505 @@ func (dst *NsAsHandleUplinkRequest) SetFields(src *NsAsHandleUplinkRequest, path
 	return nil
 }
 
+func (dst *DecodeUplinkRequest) SetFields(src *DecodeUplinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIDs == nil) && dst.VersionIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIDs
+				}
+				if dst.VersionIDs != nil {
+					newDst = dst.VersionIDs
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIDs = src.VersionIDs
+				} else {
+					dst.VersionIDs = nil
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Parameter = src.Parameter
+			} else {
+				var zero string
+				dst.Parameter = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FRMPayload = src.FRMPayload
+			} else {
+				dst.FRMPayload = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodeUplinkResponse) SetFields(src *DecodeUplinkResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayload = src.DecodedPayload
+			} else {
+				dst.DecodedPayload = nil
+			}
+		case "decoded_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayloadWarnings = src.DecodedPayloadWarnings
+			} else {
+				dst.DecodedPayloadWarnings = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "execution_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'execution_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExecutionTime = src.ExecutionTime
+			} else {
+				var zero time.Duration
+				dst.ExecutionTime = zero
+			}
+		case "normalized_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'normalized_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NormalizedPayload = src.NormalizedPayload
+			} else {
+				dst.NormalizedPayload = nil
+			}
+		case "normalized_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'normalized_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NormalizedPayloadWarnings = src.NormalizedPayloadWarnings
+			} else {
+				dst.NormalizedPayloadWarnings = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EncodeDownlinkRequest) SetFields(src *EncodeDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIDs == nil) && dst.VersionIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIDs
+				}
+				if dst.VersionIDs != nil {
+					newDst = dst.VersionIDs
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIDs = src.VersionIDs
+				} else {
+					dst.VersionIDs = nil
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Parameter = src.Parameter
+			} else {
+				var zero string
+				dst.Parameter = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayload = src.DecodedPayload
+			} else {
+				dst.DecodedPayload = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EncodeDownlinkResponse) SetFields(src *EncodeDownlinkResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FRMPayload = src.FRMPayload
+			} else {
+				dst.FRMPayload = nil
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "decoded_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayloadWarnings = src.DecodedPayloadWarnings
+			} else {
+				dst.DecodedPayloadWarnings = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "execution_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'execution_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExecutionTime = src.ExecutionTime
+			} else {
+				var zero time.Duration
+				dst.ExecutionTime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodeDownlinkRequest) SetFields(src *DecodeDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIDs == nil) && dst.VersionIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIDs
+				}
+				if dst.VersionIDs != nil {
+					newDst = dst.VersionIDs
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIDs = src.VersionIDs
+				} else {
+					dst.VersionIDs = nil
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Parameter = src.Parameter
+			} else {
+				var zero string
+				dst.Parameter = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FRMPayload = src.FRMPayload
+			} else {
+				dst.FRMPayload = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodeDownlinkResponse) SetFields(src *DecodeDownlinkResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayload = src.DecodedPayload
+			} else {
+				dst.DecodedPayload = nil
+			}
+		case "decoded_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayloadWarnings = src.DecodedPayloadWarnings
+			} else {
+				dst.DecodedPayloadWarnings = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "execution_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'execution_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExecutionTime = src.ExecutionTime
+			} else {
+				var zero time.Duration
+				dst.ExecutionTime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AsConfiguration_PubSub) SetFields(src *AsConfiguration_PubSub, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -398,6 +898,26 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 				var zero AsConfiguration_PubSub_Providers_Status
 				dst.AWSIoT = zero
 			}
+		case "kafka":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kafka' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Kafka = src.Kafka
+			} else {
+				var zero AsConfiguration_PubSub_Providers_Status
+				dst.Kafka = zero
+			}
+		case "amqp":
+			if len(subs) > 0 {
+				return fmt.Errorf("'amqp' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AMQP = src.AMQP
+			} else {
+				var zero AsConfiguration_PubSub_Providers_Status
+				dst.AMQP = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -967,6 +967,10 @@ func (m *AsConfiguration_PubSub_Providers) ValidateFields(paths ...string) error
 			// no validation rules for NATS
 		case "aws_iot":
 			// no validation rules for AWSIoT
+		case "kafka":
+			// no validation rules for Kafka
+		case "amqp":
+			// no validation rules for AMQP
 		default:
 			return AsConfiguration_PubSub_ProvidersValidationError{
 				field:  name,
@@ -1033,3 +1037,874 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AsConfiguration_PubSub_ProvidersValidationError{}
+
+// ValidateFields checks the field values on DecodeUplinkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *DecodeUplinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeUplinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := _DecodeUplinkRequest_Formatter_NotInLookup[m.GetFormatter()]; ok {
+				return DecodeUplinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must not be in list [0]",
+				}
+			}
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return DecodeUplinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+
+			if utf8.RuneCountInString(m.GetParameter()) > 40960 {
+				return DecodeUplinkRequestValidationError{
+					field:  "parameter",
+					reason: "value length must be at most 40960 runes",
+				}
+			}
+
+		case "f_port":
+
+			if val := m.GetFPort(); val < 1 || val > 255 {
+				return DecodeUplinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must be inside range [1, 255]",
+				}
+			}
+
+			if _, ok := _DecodeUplinkRequest_FPort_NotInLookup[m.GetFPort()]; ok {
+				return DecodeUplinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must not be in list [224]",
+				}
+			}
+
+		case "frm_payload":
+
+			if len(m.GetFRMPayload()) > 256 {
+				return DecodeUplinkRequestValidationError{
+					field:  "frm_payload",
+					reason: "value length must be at most 256 bytes",
+				}
+			}
+
+		default:
+			return DecodeUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeUplinkRequestValidationError is the validation error returned by
+// DecodeUplinkRequest.ValidateFields if the designated constraints aren't met.
+type DecodeUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeUplinkRequestValidationError) ErrorName() string {
+	return "DecodeUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeUplinkRequestValidationError{}
+
+var _DecodeUplinkRequest_Formatter_NotInLookup = map[PayloadFormatter]struct{}{
+	0: {},
+}
+
+var _DecodeUplinkRequest_FPort_NotInLookup = map[uint32]struct{}{
+	224: {},
+}
+
+// ValidateFields checks the field values on DecodeUplinkResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *DecodeUplinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeUplinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "decoded_payload":
+
+			if v, ok := interface{}(m.GetDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkResponseValidationError{
+						field:  "decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "decoded_payload_warnings":
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkResponseValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "execution_time":
+
+			if v, ok := interface{}(&m.ExecutionTime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkResponseValidationError{
+						field:  "execution_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "normalized_payload":
+
+			for idx, item := range m.GetNormalizedPayload() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return DecodeUplinkResponseValidationError{
+							field:  fmt.Sprintf("normalized_payload[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "normalized_payload_warnings":
+
+		default:
+			return DecodeUplinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeUplinkResponseValidationError is the validation error returned by
+// DecodeUplinkResponse.ValidateFields if the designated constraints aren't met.
+type DecodeUplinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeUplinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeUplinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeUplinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeUplinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeUplinkResponseValidationError) ErrorName() string {
+	return "DecodeUplinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeUplinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeUplinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeUplinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeUplinkResponseValidationError{}
+
+// ValidateFields checks the field values on EncodeDownlinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := _EncodeDownlinkRequest_Formatter_NotInLookup[m.GetFormatter()]; ok {
+				return EncodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must not be in list [0]",
+				}
+			}
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return EncodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+
+			if utf8.RuneCountInString(m.GetParameter()) > 40960 {
+				return EncodeDownlinkRequestValidationError{
+					field:  "parameter",
+					reason: "value length must be at most 40960 runes",
+				}
+			}
+
+		case "f_port":
+
+			if val := m.GetFPort(); val < 1 || val > 255 {
+				return EncodeDownlinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must be inside range [1, 255]",
+				}
+			}
+
+			if _, ok := _EncodeDownlinkRequest_FPort_NotInLookup[m.GetFPort()]; ok {
+				return EncodeDownlinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must not be in list [224]",
+				}
+			}
+
+		case "decoded_payload":
+
+			if m.DecodedPayload == nil {
+				return EncodeDownlinkRequestValidationError{
+					field:  "decoded_payload",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.DecodedPayload).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EncodeDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkRequestValidationError is the validation error returned by
+// EncodeDownlinkRequest.ValidateFields if the designated constraints aren't
+// met.
+type EncodeDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkRequestValidationError) ErrorName() string {
+	return "EncodeDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkRequestValidationError{}
+
+var _EncodeDownlinkRequest_Formatter_NotInLookup = map[PayloadFormatter]struct{}{
+	0: {},
+}
+
+var _EncodeDownlinkRequest_FPort_NotInLookup = map[uint32]struct{}{
+	224: {},
+}
+
+// ValidateFields checks the field values on EncodeDownlinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frm_payload":
+			// no validation rules for FRMPayload
+		case "f_port":
+			// no validation rules for FPort
+		case "decoded_payload_warnings":
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkResponseValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "execution_time":
+
+			if v, ok := interface{}(&m.ExecutionTime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkResponseValidationError{
+						field:  "execution_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EncodeDownlinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkResponseValidationError is the validation error returned by
+// EncodeDownlinkResponse.ValidateFields if the designated constraints aren't
+// met.
+type EncodeDownlinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkResponseValidationError) ErrorName() string {
+	return "EncodeDownlinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkResponseValidationError{}
+
+// ValidateFields checks the field values on DecodeDownlinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := _DecodeDownlinkRequest_Formatter_NotInLookup[m.GetFormatter()]; ok {
+				return DecodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must not be in list [0]",
+				}
+			}
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return DecodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+
+			if utf8.RuneCountInString(m.GetParameter()) > 40960 {
+				return DecodeDownlinkRequestValidationError{
+					field:  "parameter",
+					reason: "value length must be at most 40960 runes",
+				}
+			}
+
+		case "f_port":
+
+			if val := m.GetFPort(); val < 1 || val > 255 {
+				return DecodeDownlinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must be inside range [1, 255]",
+				}
+			}
+
+			if _, ok := _DecodeDownlinkRequest_FPort_NotInLookup[m.GetFPort()]; ok {
+				return DecodeDownlinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must not be in list [224]",
+				}
+			}
+
+		case "frm_payload":
+
+			if len(m.GetFRMPayload()) > 256 {
+				return DecodeDownlinkRequestValidationError{
+					field:  "frm_payload",
+					reason: "value length must be at most 256 bytes",
+				}
+			}
+
+		default:
+			return DecodeDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeDownlinkRequestValidationError is the validation error returned by
+// DecodeDownlinkRequest.ValidateFields if the designated constraints aren't
+// met.
+type DecodeDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeDownlinkRequestValidationError) ErrorName() string {
+	return "DecodeDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeDownlinkRequestValidationError{}
+
+var _DecodeDownlinkRequest_Formatter_NotInLookup = map[PayloadFormatter]struct{}{
+	0: {},
+}
+
+var _DecodeDownlinkRequest_FPort_NotInLookup = map[uint32]struct{}{
+	224: {},
+}
+
+// ValidateFields checks the field values on DecodeDownlinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeDownlinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeDownlinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "decoded_payload":
+
+			if v, ok := interface{}(m.GetDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkResponseValidationError{
+						field:  "decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "decoded_payload_warnings":
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkResponseValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "execution_time":
+
+			if v, ok := interface{}(&m.ExecutionTime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkResponseValidationError{
+						field:  "execution_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DecodeDownlinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeDownlinkResponseValidationError is the validation error returned by
+// DecodeDownlinkResponse.ValidateFields if the designated constraints aren't
+// met.
+type DecodeDownlinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeDownlinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeDownlinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeDownlinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeDownlinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeDownlinkResponseValidationError) ErrorName() string {
+	return "DecodeDownlinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeDownlinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeDownlinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeDownlinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeDownlinkResponseValidationError{}
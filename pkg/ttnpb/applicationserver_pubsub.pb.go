@@ -65,6 +65,30 @@ func (ApplicationPubSub_MQTTProvider_QoS) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1dce56ec18597200, []int{1, 1, 0}
 }
 
+type ApplicationPubSub_KafkaProvider_SASL_Mechanism int32
+
+const (
+	ApplicationPubSub_KafkaProvider_SASL_PLAIN         ApplicationPubSub_KafkaProvider_SASL_Mechanism = 0
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 1
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 2
+)
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_name = map[int32]string{
+	0: "PLAIN",
+	1: "SCRAM_SHA_256",
+	2: "SCRAM_SHA_512",
+}
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_value = map[string]int32{
+	"PLAIN":         0,
+	"SCRAM_SHA_256": 1,
+	"SCRAM_SHA_512": 2,
+}
+
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3, 0, 0}
+}
+
 type ApplicationPubSubIdentifiers struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	PubSubID               string   `protobuf:"bytes,2,opt,name=pub_sub_id,json=pubSubId,proto3" json:"pub_sub_id,omitempty"`
@@ -123,6 +147,8 @@ type ApplicationPubSub struct {
 	// Types that are valid to be assigned to Provider:
 	//	*ApplicationPubSub_NATS
 	//	*ApplicationPubSub_MQTT
+	//	*ApplicationPubSub_Kafka
+	//	*ApplicationPubSub_AMQP
 	//	*ApplicationPubSub_AWSIoT
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
@@ -190,12 +216,20 @@ type ApplicationPubSub_NATS struct {
 type ApplicationPubSub_MQTT struct {
 	MQTT *ApplicationPubSub_MQTTProvider `protobuf:"bytes,25,opt,name=mqtt,proto3,oneof" json:"mqtt,omitempty"`
 }
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,26,opt,name=kafka,proto3,oneof" json:"kafka,omitempty"`
+}
+type ApplicationPubSub_AMQP struct {
+	AMQP *ApplicationPubSub_AMQPProvider `protobuf:"bytes,27,opt,name=amqp,proto3,oneof" json:"amqp,omitempty"`
+}
 type ApplicationPubSub_AWSIoT struct {
 	AWSIoT *ApplicationPubSub_AWSIoTProvider `protobuf:"bytes,101,opt,name=aws_iot,json=awsIot,proto3,oneof" json:"aws_iot,omitempty"`
 }
 
 func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider()   {}
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider()  {}
+func (*ApplicationPubSub_AMQP) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_AWSIoT) isApplicationPubSub_Provider() {}
 
 func (m *ApplicationPubSub) GetProvider() isApplicationPubSub_Provider {
@@ -240,6 +274,20 @@ func (m *ApplicationPubSub) GetMQTT() *ApplicationPubSub_MQTTProvider {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetAMQP() *ApplicationPubSub_AMQPProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_AMQP); ok {
+		return x.AMQP
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub) GetAWSIoT() *ApplicationPubSub_AWSIoTProvider {
 	if x, ok := m.GetProvider().(*ApplicationPubSub_AWSIoT); ok {
 		return x.AWSIoT
@@ -343,6 +391,8 @@ func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ApplicationPubSub_NATS)(nil),
 		(*ApplicationPubSub_MQTT)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
+		(*ApplicationPubSub_AMQP)(nil),
 		(*ApplicationPubSub_AWSIoT)(nil),
 	}
 }
@@ -809,6 +859,261 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) GetStackName() str
 	return ""
 }
 
+// The Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	// The addresses of the bootstrap brokers (host:port).
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// The consumer group used to consume downlink messages.
+	// If empty, the consumer group is derived from the base topic.
+	ConsumerGroupID string `protobuf:"bytes,2,opt,name=consumer_group_id,json=consumerGroupId,proto3" json:"consumer_group_id,omitempty"`
+	UseTLS          bool   `protobuf:"varint,3,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,4,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,5,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,6,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// If set, the integration authenticates with SASL.
+	SASL                 *ApplicationPubSub_KafkaProvider_SASL `protobuf:"bytes,7,opt,name=sasl,proto3" json:"sasl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Reset()      { *m = ApplicationPubSub_KafkaProvider{} }
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetConsumerGroupID() string {
+	if m != nil {
+		return m.ConsumerGroupID
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetSASL() *ApplicationPubSub_KafkaProvider_SASL {
+	if m != nil {
+		return m.SASL
+	}
+	return nil
+}
+
+type ApplicationPubSub_KafkaProvider_SASL struct {
+	Mechanism            ApplicationPubSub_KafkaProvider_SASL_Mechanism `protobuf:"varint,1,opt,name=mechanism,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism" json:"mechanism,omitempty"`
+	Username             string                                         `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string                                         `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Reset()      { *m = ApplicationPubSub_KafkaProvider_SASL{} }
+func (*ApplicationPubSub_KafkaProvider_SASL) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider_SASL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3, 0}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetMechanism() ApplicationPubSub_KafkaProvider_SASL_Mechanism {
+	if m != nil {
+		return m.Mechanism
+	}
+	return ApplicationPubSub_KafkaProvider_SASL_PLAIN
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// The AMQP 0-9-1 provider settings.
+type ApplicationPubSub_AMQPProvider struct {
+	// The server connection URL.
+	// The username and password in the URL are used for SASL PLAIN authentication.
+	ServerURL string `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	// The exchange to which messages are published, and to which the downlink queues are bound.
+	// If empty, the default exchange is used, which routes messages to the queue with the routing key as name.
+	Exchange string `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	UseTLS   bool   `protobuf:"varint,3,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,4,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,5,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey         []byte   `protobuf:"bytes,6,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_AMQPProvider) Reset()      { *m = ApplicationPubSub_AMQPProvider{} }
+func (*ApplicationPubSub_AMQPProvider) ProtoMessage() {}
+func (*ApplicationPubSub_AMQPProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 4}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_AMQPProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_AMQPProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_AMQPProvider) GetServerURL() string {
+	if m != nil {
+		return m.ServerURL
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
 type ApplicationPubSub_Message struct {
 	// The topic on which the Application Server publishes or receives the messages.
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -819,7 +1124,7 @@ type ApplicationPubSub_Message struct {
 func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
 func (*ApplicationPubSub_Message) ProtoMessage() {}
 func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+	return fileDescriptor_1dce56ec18597200, []int{1, 5}
 }
 func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,6 +1392,8 @@ func (m *SetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
 	proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	golang_proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
@@ -1105,6 +1412,12 @@ func init() {
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_AssumeRole)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole")
 	proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
+	proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
 	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
@@ -1129,152 +1442,169 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 2316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0x41, 0x6c, 0x1b, 0xc7,
-	0xd5, 0x80, 0x39, 0xa2, 0x44, 0x89, 0x43, 0x4a, 0xa2, 0x27, 0xc9, 0x9f, 0x35, 0x13, 0x2f, 0xfd,
-	0x33, 0x46, 0x4a, 0xdb, 0x59, 0xd2, 0xa6, 0xd2, 0x20, 0xa5, 0xdb, 0xda, 0x5c, 0xc9, 0x8e, 0x95,
-	0x28, 0x8a, 0xb4, 0xa4, 0x91, 0xc6, 0x96, 0xbd, 0x18, 0x72, 0x47, 0xd4, 0x46, 0xcb, 0xdd, 0xf5,
-	0xce, 0xac, 0x14, 0x25, 0x31, 0x60, 0xe4, 0x94, 0xf6, 0x50, 0x18, 0xe8, 0xa1, 0x01, 0x7a, 0x68,
-	0x81, 0xa2, 0x68, 0x80, 0x5e, 0x02, 0xf4, 0xd0, 0xdc, 0x1a, 0xa0, 0x17, 0x1f, 0x03, 0xb4, 0x87,
-	0x9c, 0xd4, 0x88, 0xea, 0x21, 0xb7, 0xe6, 0xd6, 0x54, 0x05, 0x8a, 0x62, 0x66, 0x77, 0x49, 0x4a,
-	0x74, 0x2c, 0x53, 0x46, 0x7b, 0xe2, 0x9b, 0x7d, 0xef, 0x7d, 0xfb, 0xe6, 0xcd, 0xe3, 0xbc, 0x99,
-	0x85, 0xe7, 0x2c, 0xc7, 0xc3, 0x9b, 0xd8, 0x56, 0x28, 0xc3, 0xcd, 0xf5, 0x12, 0x76, 0xcd, 0x12,
-	0x76, 0x5d, 0xcb, 0x6c, 0x62, 0x66, 0x3a, 0x36, 0x25, 0xde, 0x06, 0xf1, 0x74, 0xd7, 0x6f, 0x50,
-	0xbf, 0x51, 0x74, 0x3d, 0x87, 0x39, 0x68, 0x8a, 0x31, 0xbb, 0x18, 0x7a, 0x15, 0x37, 0x66, 0xb2,
-	0xd5, 0x96, 0xc9, 0xd6, 0xfc, 0x46, 0xb1, 0xe9, 0xb4, 0x4b, 0xc4, 0xde, 0x70, 0xb6, 0x5c, 0xcf,
-	0x79, 0x67, 0xab, 0x24, 0x8c, 0x9b, 0x4a, 0x8b, 0xd8, 0xca, 0x06, 0xb6, 0x4c, 0x03, 0x33, 0x52,
-	0x1a, 0x10, 0x02, 0x64, 0x56, 0xe9, 0x43, 0xb4, 0x9c, 0x96, 0x13, 0x38, 0x37, 0xfc, 0x55, 0x31,
-	0x12, 0x03, 0x21, 0x85, 0xe6, 0xcf, 0xb6, 0x1c, 0xa7, 0x65, 0x91, 0x20, 0x58, 0xdb, 0x76, 0x58,
-	0x10, 0x6b, 0xa8, 0x95, 0x43, 0x6d, 0x97, 0x61, 0xf8, 0x9e, 0x30, 0x08, 0xf5, 0xcf, 0x1c, 0xd4,
-	0x93, 0xb6, 0xcb, 0xb6, 0x42, 0xe5, 0xc9, 0x83, 0xca, 0x55, 0x93, 0x58, 0x86, 0xde, 0xc6, 0x74,
-	0x3d, 0xb4, 0xc8, 0x1d, 0xb4, 0x60, 0x66, 0x9b, 0x50, 0x86, 0xdb, 0x6e, 0x68, 0xf0, 0xdc, 0x60,
-	0x46, 0x4d, 0x83, 0xd8, 0xcc, 0x5c, 0x35, 0x89, 0x17, 0x06, 0x99, 0xff, 0x0b, 0x80, 0xcf, 0x56,
-	0x7b, 0x79, 0x5e, 0xf2, 0x1b, 0x35, 0xbf, 0x31, 0xdf, 0x33, 0x43, 0x18, 0x4e, 0xf7, 0xad, 0x83,
-	0x6e, 0x1a, 0x54, 0x02, 0x27, 0x41, 0x21, 0x55, 0x7e, 0xbe, 0xb8, 0x3f, 0xff, 0xc5, 0x3e, 0x4c,
-	0x1f, 0x40, 0xcd, 0xec, 0xa9, 0x63, 0x3f, 0x01, 0x23, 0x19, 0x70, 0x7f, 0x3b, 0x17, 0xfb, 0x7c,
-	0x3b, 0x07, 0xb4, 0x29, 0xdc, 0x6f, 0x49, 0xd1, 0x32, 0x84, 0xae, 0xdf, 0xd0, 0xa9, 0xdf, 0xd0,
-	0x4d, 0x43, 0x1a, 0x39, 0x09, 0x0a, 0x49, 0x75, 0x66, 0x4f, 0x3d, 0xe5, 0xe5, 0xa5, 0x53, 0x65,
-	0xf9, 0xd6, 0x0d, 0xac, 0xbc, 0x7b, 0x4e, 0xf9, 0xde, 0xcd, 0xc2, 0xc5, 0xca, 0x0d, 0xe5, 0xe6,
-	0xc5, 0x68, 0x78, 0xfa, 0xbd, 0xf2, 0x0b, 0x77, 0x4e, 0x75, 0xb6, 0x73, 0x13, 0x61, 0xd0, 0x73,
-	0xda, 0x84, 0x1b, 0x86, 0x9f, 0xff, 0xe7, 0x09, 0x78, 0x6c, 0x60, 0x5a, 0x68, 0x09, 0xc6, 0x7b,
-	0xf1, 0xbf, 0xf0, 0x90, 0xf8, 0x07, 0xd2, 0xf0, 0x80, 0x59, 0x70, 0x14, 0x9a, 0x85, 0xb0, 0xe9,
-	0x11, 0xcc, 0x88, 0xa1, 0x63, 0x26, 0x42, 0x4f, 0x95, 0xb3, 0xc5, 0x60, 0x65, 0x8a, 0xd1, 0xca,
-	0x14, 0xeb, 0xd1, 0xca, 0xa8, 0x13, 0xdc, 0xfd, 0xde, 0x5f, 0x73, 0x40, 0x4b, 0x86, 0x7e, 0x55,
-	0xc6, 0x21, 0xbe, 0x6b, 0x44, 0x90, 0xf8, 0x30, 0x90, 0xd0, 0xaf, 0xca, 0xd0, 0x45, 0x98, 0x58,
-	0x75, 0xbc, 0x36, 0x66, 0xd2, 0xa8, 0x48, 0xe0, 0x77, 0x82, 0x04, 0x3e, 0x79, 0x58, 0x02, 0xb5,
-	0xd0, 0x0d, 0x2d, 0xc2, 0x51, 0x1b, 0x33, 0x2a, 0x1d, 0x13, 0xef, 0x2f, 0x1e, 0x9a, 0x9d, 0xe2,
-	0x62, 0xb5, 0x5e, 0x5b, 0xf2, 0x9c, 0x0d, 0xd3, 0x20, 0x9e, 0x3a, 0xd1, 0xd9, 0xce, 0x8d, 0xf2,
-	0x27, 0x57, 0x63, 0x9a, 0xe0, 0x70, 0x5e, 0xfb, 0x36, 0x63, 0xd2, 0xf1, 0x47, 0xe5, 0xbd, 0xbe,
-	0x5c, 0xaf, 0xef, 0xe7, 0xf1, 0x27, 0x9c, 0xc7, 0x39, 0xe8, 0x4d, 0x38, 0x8e, 0x37, 0xa9, 0x6e,
-	0x3a, 0x4c, 0x22, 0x02, 0x79, 0xee, 0x70, 0x64, 0xf5, 0xcd, 0xda, 0xbc, 0xd3, 0x83, 0xc2, 0xce,
-	0x76, 0x2e, 0x11, 0x3c, 0xbb, 0x1a, 0xd3, 0x12, 0x78, 0x93, 0xce, 0x3b, 0x0c, 0x3d, 0x0f, 0x61,
-	0x03, 0x53, 0xa2, 0x33, 0xc7, 0x35, 0x9b, 0x52, 0x42, 0x64, 0x6f, 0x7c, 0x4f, 0x1d, 0xf5, 0x46,
-	0x24, 0x43, 0x4b, 0x72, 0x55, 0x9d, 0x6b, 0xd0, 0x22, 0x9c, 0x34, 0x9c, 0x4d, 0xdb, 0x32, 0xed,
-	0x75, 0xdd, 0xf5, 0xe9, 0x9a, 0x34, 0x2e, 0xc2, 0x38, 0xfd, 0x08, 0x33, 0x23, 0x94, 0xe2, 0x16,
-	0xd1, 0xd2, 0x91, 0xff, 0x92, 0x4f, 0xd7, 0x50, 0x1d, 0x66, 0xba, 0x3c, 0x8f, 0xb8, 0x16, 0x6e,
-	0x12, 0x69, 0x62, 0x58, 0xe4, 0x74, 0x84, 0xd0, 0x02, 0x02, 0x5a, 0x82, 0x53, 0xbe, 0x2b, 0x98,
-	0xed, 0xc0, 0x44, 0x4a, 0x0e, 0xcb, 0x9c, 0x0c, 0x00, 0xe1, 0x10, 0xbd, 0x0a, 0x53, 0x6f, 0x3b,
-	0xa6, 0xad, 0xe3, 0x66, 0x93, 0xb8, 0x4c, 0x82, 0xc3, 0xe2, 0x20, 0xf7, 0xae, 0x0a, 0x67, 0xb4,
-	0x00, 0xbb, 0x39, 0xd0, 0x71, 0x73, 0x5d, 0x4a, 0x0d, 0x0b, 0x4b, 0x45, 0xee, 0xd5, 0xe6, 0xfa,
-	0xbe, 0x15, 0xb1, 0x39, 0x2e, 0x7d, 0xe4, 0x15, 0x59, 0xc4, 0x07, 0x78, 0x94, 0xd8, 0x4c, 0x9a,
-	0x3c, 0x32, 0xaf, 0x46, 0x6c, 0x86, 0x34, 0xd8, 0x5d, 0x1e, 0x7d, 0x15, 0x9b, 0x16, 0x31, 0xa4,
-	0xa9, 0x61, 0x89, 0x53, 0x11, 0xe1, 0x8a, 0x00, 0xec, 0x63, 0xde, 0xf6, 0x89, 0x4f, 0x0c, 0x69,
-	0xfa, 0xc8, 0xcc, 0x65, 0x01, 0x40, 0x2d, 0x98, 0xdd, 0xcf, 0xd4, 0x4d, 0x3b, 0xea, 0x8c, 0x86,
-	0xf4, 0xc4, 0xb0, 0x78, 0x69, 0x1f, 0x7e, 0xbe, 0x87, 0xe2, 0xc1, 0x5b, 0x4e, 0xd8, 0x49, 0xa8,
-	0x63, 0x6d, 0x10, 0x43, 0xca, 0x0c, 0x1d, 0x7c, 0x44, 0xa8, 0x09, 0x00, 0x2f, 0x29, 0x7e, 0x3a,
-	0x30, 0x9b, 0x44, 0x37, 0x30, 0xc3, 0x12, 0x1a, 0xba, 0xa4, 0x42, 0xf7, 0x39, 0xcc, 0x70, 0x76,
-	0x0e, 0xa6, 0xfb, 0xf7, 0x35, 0xf4, 0x22, 0x84, 0xe1, 0xd9, 0xc3, 0xf7, 0x2c, 0xd1, 0x39, 0x92,
-	0xea, 0x53, 0x7b, 0xea, 0x98, 0x17, 0xff, 0x10, 0x80, 0xce, 0x76, 0x2e, 0x59, 0x13, 0xda, 0x6b,
-	0xda, 0x82, 0x96, 0x0c, 0x0c, 0xaf, 0x79, 0x56, 0xf6, 0xf7, 0x09, 0x98, 0xee, 0xdf, 0xce, 0x8e,
-	0x86, 0x41, 0xe7, 0x60, 0xb2, 0x69, 0x99, 0xc4, 0x66, 0xbd, 0xbe, 0xf8, 0x44, 0xb0, 0x31, 0x3d,
-	0xcd, 0xfb, 0xde, 0xac, 0xd0, 0xf1, 0xbe, 0x17, 0x58, 0xcd, 0x1b, 0xe8, 0x39, 0x38, 0xe1, 0x53,
-	0xe2, 0xd9, 0xb8, 0x4d, 0x44, 0x23, 0xe9, 0xdb, 0xc9, 0xba, 0x0a, 0x6e, 0xe4, 0x62, 0x4a, 0x37,
-	0x1d, 0xcf, 0x08, 0x9b, 0x45, 0xcf, 0x28, 0x52, 0x20, 0x13, 0x4e, 0x52, 0xbf, 0x41, 0x9b, 0x9e,
-	0xd9, 0x20, 0xfa, 0x6d, 0x87, 0x4a, 0x63, 0x27, 0x41, 0x61, 0xaa, 0x5c, 0x1e, 0x6e, 0x1f, 0x2f,
-	0x2e, 0x3b, 0x35, 0x35, 0xd3, 0xd9, 0xce, 0xa5, 0x6b, 0x11, 0x6c, 0xd9, 0xa9, 0x69, 0x69, 0xda,
-	0x1b, 0x51, 0xd4, 0x84, 0x29, 0xd7, 0x6f, 0x58, 0x26, 0x5d, 0x13, 0x2f, 0x4a, 0x1c, 0xf9, 0x45,
-	0x53, 0x9d, 0xed, 0x1c, 0x5c, 0x0a, 0x50, 0xfc, 0x35, 0xd0, 0x8d, 0x64, 0x8a, 0x9e, 0x83, 0xe3,
-	0x3e, 0xdf, 0xe4, 0x2d, 0x2a, 0xf6, 0xed, 0x89, 0xa0, 0x19, 0x5c, 0xa3, 0xa4, 0xbe, 0x50, 0xd3,
-	0x12, 0x3e, 0x25, 0x75, 0x8b, 0xa2, 0x02, 0x4c, 0x30, 0x8b, 0xea, 0x4d, 0x2c, 0x36, 0xe2, 0xb4,
-	0x7a, 0x6c, 0x4f, 0x1d, 0x7b, 0x37, 0x2e, 0xdd, 0xbd, 0xd4, 0xd9, 0xce, 0x8d, 0xd5, 0x17, 0x6a,
-	0xb3, 0x55, 0x6d, 0x8c, 0x59, 0x74, 0x16, 0xa3, 0x2a, 0x9c, 0x16, 0x96, 0xc1, 0xf2, 0x34, 0x89,
-	0xc7, 0xc4, 0x3e, 0x9b, 0x56, 0x8f, 0xf7, 0xb9, 0x4c, 0x72, 0x17, 0x61, 0x31, 0x4b, 0x3c, 0xa6,
-	0x4d, 0x72, 0xd7, 0xee, 0x10, 0xfd, 0x10, 0x4e, 0xf5, 0x21, 0xd6, 0xc9, 0x96, 0xd8, 0x5a, 0xd3,
-	0xaa, 0xd4, 0x47, 0x48, 0x77, 0x09, 0xaf, 0x91, 0x2d, 0x2d, 0xdd, 0x05, 0xbc, 0x46, 0xb6, 0xd0,
-	0x35, 0x38, 0xbe, 0x46, 0xb0, 0x41, 0x3c, 0x2a, 0xa5, 0x4e, 0xc6, 0x0b, 0xa9, 0xf2, 0x85, 0x21,
-	0x53, 0x76, 0x35, 0xf0, 0xbe, 0x6c, 0x33, 0x6f, 0x4b, 0x8b, 0x58, 0xd9, 0x0a, 0x4c, 0xf7, 0x2b,
-	0x50, 0x06, 0xc6, 0x79, 0x6c, 0xa2, 0x66, 0x35, 0x2e, 0xa2, 0x27, 0xe1, 0xd8, 0x06, 0xb6, 0x7c,
-	0x12, 0x94, 0xa4, 0x16, 0x0c, 0x2a, 0x23, 0x2f, 0x83, 0xfc, 0xf7, 0x61, 0x7c, 0xd9, 0xa9, 0xa1,
-	0x0c, 0x4c, 0x57, 0xeb, 0xfa, 0xeb, 0x6f, 0xd4, 0xea, 0xfa, 0x1b, 0x8b, 0xb3, 0x97, 0x33, 0x31,
-	0x74, 0x0c, 0x4e, 0x56, 0xeb, 0xfa, 0xc2, 0xe5, 0x6a, 0xf4, 0x08, 0x70, 0xa3, 0xcb, 0x3f, 0xaa,
-	0xce, 0xd6, 0x17, 0xde, 0x0a, 0x9e, 0x8c, 0x64, 0x7f, 0x0c, 0xe1, 0xd4, 0xfe, 0x8e, 0x8d, 0x7e,
-	0x31, 0x02, 0x13, 0x1e, 0x69, 0x99, 0x8e, 0x1d, 0xfe, 0x69, 0x3e, 0x18, 0xd9, 0x53, 0xff, 0x0d,
-	0xbc, 0x7f, 0x01, 0x0d, 0xe2, 0x55, 0x85, 0x3a, 0x3e, 0x5b, 0x53, 0xce, 0x6b, 0x49, 0xec, 0x2a,
-	0x04, 0x53, 0xa6, 0x9c, 0xe7, 0x87, 0x4b, 0xc5, 0x76, 0x3c, 0xb6, 0xf6, 0xc0, 0x71, 0x59, 0x83,
-	0xd8, 0xed, 0xba, 0x4d, 0x45, 0x72, 0x9f, 0x6d, 0x6f, 0x5c, 0xd6, 0xd2, 0x4d, 0xac, 0x34, 0x89,
-	0xcd, 0x3c, 0x6c, 0x29, 0xe7, 0xb5, 0x34, 0xf1, 0xfb, 0x46, 0x90, 0xf8, 0x01, 0x37, 0x94, 0xbb,
-	0xa1, 0x10, 0x5f, 0xd9, 0x24, 0x02, 0xd7, 0x15, 0xcb, 0x3d, 0x71, 0x46, 0x83, 0x6d, 0xd2, 0x33,
-	0xa6, 0x38, 0x8a, 0x3b, 0xe9, 0xd3, 0x01, 0xb1, 0x2c, 0xc4, 0x88, 0x16, 0x89, 0x65, 0x2d, 0x4c,
-	0x09, 0x7a, 0x0b, 0x42, 0xde, 0x94, 0x29, 0x15, 0xd5, 0x13, 0x9c, 0x3e, 0x2b, 0xc3, 0x9e, 0x8a,
-	0x8a, 0x55, 0x81, 0xe0, 0xf5, 0x95, 0xc4, 0x91, 0x88, 0x56, 0x60, 0x0a, 0x53, 0xea, 0xb7, 0x89,
-	0xee, 0x39, 0x16, 0x09, 0x0f, 0xa5, 0x17, 0x86, 0x67, 0x0b, 0x86, 0xe6, 0x58, 0x44, 0x83, 0xb8,
-	0x2b, 0xa3, 0x5f, 0x03, 0x98, 0x21, 0xb6, 0xe1, 0x3a, 0xa6, 0xcd, 0x74, 0x6c, 0x18, 0x1e, 0xa1,
-	0x34, 0xdc, 0x8a, 0xde, 0xd9, 0x53, 0x7d, 0x8f, 0x4a, 0x77, 0x41, 0xd9, 0xbe, 0x55, 0x28, 0x14,
-	0xf8, 0x61, 0xb5, 0xaa, 0x5c, 0xe7, 0xe7, 0xd5, 0xf7, 0xfb, 0xe4, 0x9e, 0xb8, 0xa2, 0xdc, 0x3c,
-	0xd3, 0xa7, 0x38, 0xbd, 0x52, 0x3c, 0x7d, 0xa6, 0x70, 0xa3, 0xaa, 0x5c, 0x0f, 0x4f, 0xb9, 0xef,
-	0xf7, 0xc9, 0x3d, 0x51, 0x78, 0xf5, 0x14, 0xa7, 0xdf, 0x3f, 0x7d, 0x4a, 0x9b, 0x8e, 0x22, 0xaa,
-	0x06, 0x01, 0x21, 0x1d, 0x8e, 0x1b, 0x64, 0x15, 0xfb, 0x16, 0x13, 0x9b, 0x5f, 0xaa, 0x3c, 0x3b,
-	0xf4, 0xfc, 0xe7, 0x02, 0xff, 0x79, 0x9b, 0x91, 0x56, 0x70, 0xf9, 0xbb, 0x1a, 0xd3, 0x22, 0x6a,
-	0xf6, 0x0f, 0x00, 0x26, 0xbb, 0xd9, 0x47, 0x57, 0xe0, 0x64, 0x6f, 0x35, 0xf9, 0x8e, 0x1f, 0x54,
-	0x7c, 0x7e, 0x4f, 0xcd, 0x78, 0x53, 0x99, 0x0c, 0x4f, 0xc9, 0xf8, 0xad, 0x1b, 0x2b, 0x9b, 0x37,
-	0xcf, 0xf0, 0x5b, 0x4f, 0xaa, 0xeb, 0x38, 0x3f, 0xa7, 0xa5, 0xba, 0x0b, 0x37, 0x6f, 0xa0, 0x19,
-	0x78, 0x8c, 0x92, 0xa6, 0x47, 0x98, 0x7e, 0xa0, 0x38, 0xba, 0xfb, 0x7c, 0x41, 0x9b, 0x0e, 0x2c,
-	0x7a, 0x2f, 0x57, 0xe0, 0x24, 0x25, 0x94, 0xf2, 0xc6, 0xcc, 0x9c, 0x75, 0x62, 0x87, 0xdd, 0x63,
-	0x42, 0xf4, 0x28, 0xe9, 0xee, 0x88, 0x96, 0x0e, 0xd5, 0x75, 0xae, 0xcd, 0xfe, 0x03, 0x40, 0xd8,
-	0x5b, 0x5b, 0xa4, 0xc1, 0x38, 0xf6, 0xa2, 0xbf, 0xe8, 0xa5, 0x3d, 0xf5, 0x25, 0xef, 0xc5, 0x72,
-	0xf9, 0x16, 0xf6, 0xec, 0x0a, 0xde, 0xa4, 0x15, 0x13, 0xb7, 0x2b, 0x95, 0x1b, 0x3c, 0xcf, 0xef,
-	0x9d, 0x2f, 0xdf, 0xa9, 0xf0, 0x82, 0x5a, 0x29, 0xf5, 0xb2, 0xaf, 0x9f, 0xfd, 0xc1, 0x0b, 0xc5,
-	0x4b, 0xca, 0xcd, 0xb3, 0x7c, 0x5a, 0xf1, 0xaa, 0xb6, 0xa8, 0x71, 0x18, 0x9a, 0x87, 0x29, 0xf2,
-	0x0e, 0xe3, 0x2d, 0xcb, 0xea, 0xb5, 0xbf, 0xc2, 0x9e, 0xfa, 0xb4, 0xf7, 0x94, 0x74, 0x3f, 0x59,
-	0xce, 0xf0, 0x54, 0x08, 0xcf, 0xca, 0x4a, 0x49, 0x09, 0x72, 0x02, 0x2f, 0x87, 0x0e, 0xf3, 0x73,
-	0x1a, 0x8c, 0x9c, 0xe7, 0x0d, 0xf4, 0x2a, 0xcc, 0x44, 0x93, 0x8b, 0xee, 0xe0, 0x61, 0x45, 0x1f,
-	0x1f, 0xb8, 0x66, 0xcd, 0x85, 0x06, 0xea, 0xe8, 0x47, 0xfc, 0x86, 0x35, 0x1d, 0x3a, 0x46, 0x8f,
-	0xb3, 0x6f, 0x42, 0x34, 0xb8, 0xa8, 0xa8, 0x0a, 0xa1, 0xb8, 0x65, 0xeb, 0xa2, 0xf3, 0x76, 0x17,
-	0x2e, 0xe7, 0x9d, 0xe0, 0xcb, 0x26, 0xdd, 0x0a, 0x67, 0x7b, 0xa0, 0x02, 0x4f, 0x69, 0x49, 0xe1,
-	0xb5, 0x88, 0xdb, 0x44, 0x4d, 0x43, 0x68, 0x10, 0xd7, 0x72, 0xb6, 0xda, 0xc4, 0x66, 0xd9, 0x02,
-	0x1c, 0x8f, 0xce, 0xdf, 0x27, 0xe0, 0x58, 0x70, 0x35, 0x01, 0xfb, 0x7b, 0x75, 0xf0, 0x54, 0x9d,
-	0x86, 0x13, 0x6e, 0xb4, 0x5d, 0xc6, 0xbf, 0x51, 0x41, 0x7e, 0x19, 0xa2, 0x81, 0xba, 0xa4, 0xe8,
-	0x02, 0x1c, 0x0f, 0xbe, 0x9e, 0xf0, 0xfb, 0x2f, 0xef, 0x16, 0xff, 0x7f, 0x68, 0x31, 0x6b, 0x91,
-	0x47, 0xfe, 0xb7, 0x00, 0x4a, 0x03, 0xea, 0x2b, 0xe2, 0xde, 0x48, 0xd1, 0x1b, 0x70, 0x3c, 0xb8,
-	0x42, 0x46, 0xe4, 0xef, 0x1e, 0x4a, 0x0e, 0x5d, 0x8b, 0xe1, 0x6f, 0xd8, 0x81, 0x42, 0x0a, 0xef,
-	0x40, 0xfd, 0x8a, 0xa1, 0x3a, 0xd0, 0x27, 0x00, 0x3e, 0xf3, 0x0a, 0x61, 0x83, 0x73, 0x21, 0xb7,
-	0x7d, 0x42, 0xd9, 0x7f, 0xe1, 0x13, 0xc0, 0x45, 0x08, 0x7b, 0xdf, 0x66, 0xbe, 0xf5, 0x13, 0xc0,
-	0x15, 0x6e, 0xf2, 0x3a, 0xa6, 0xeb, 0xea, 0x28, 0x77, 0xd7, 0x92, 0xab, 0xd1, 0x83, 0xfc, 0x9f,
-	0x00, 0x3c, 0xb1, 0x60, 0xd2, 0xc1, 0x98, 0x69, 0x14, 0xf4, 0xff, 0xe0, 0x1b, 0xcc, 0x63, 0xcf,
-	0xe2, 0x77, 0x00, 0x3e, 0x53, 0x7b, 0x48, 0xe2, 0x5f, 0x83, 0x89, 0xa0, 0x9a, 0xc2, 0xd0, 0x0f,
-	0x2f, 0xbf, 0x07, 0x44, 0x1d, 0x22, 0x1e, 0x3b, 0xda, 0xf2, 0x1f, 0x13, 0xf0, 0xf8, 0x03, 0x42,
-	0x6d, 0x99, 0x94, 0x17, 0xdc, 0xdb, 0x10, 0xbe, 0x42, 0x58, 0x54, 0xdf, 0xff, 0x37, 0x00, 0xbe,
-	0xdc, 0x76, 0xd9, 0x56, 0xb6, 0xf0, 0xa8, 0x65, 0x9e, 0xcf, 0x7e, 0xf0, 0xe7, 0xbf, 0xfd, 0x6c,
-	0xe4, 0x49, 0x84, 0x4a, 0x98, 0x96, 0x82, 0x29, 0x28, 0x61, 0xb1, 0xa3, 0x5f, 0x02, 0x18, 0x7f,
-	0x85, 0x30, 0x74, 0xf6, 0x20, 0xed, 0x21, 0x55, 0x9c, 0x3d, 0x3c, 0x79, 0xf9, 0xab, 0xe2, 0x9d,
-	0x2a, 0xba, 0xd4, 0x7b, 0x67, 0xe9, 0x3d, 0xd3, 0xa0, 0xc5, 0x03, 0x95, 0x74, 0x60, 0x7c, 0x27,
-	0x30, 0xea, 0x7d, 0x8f, 0xbb, 0x83, 0x7e, 0x0a, 0xe0, 0x28, 0xaf, 0x4f, 0xa4, 0x1c, 0x7c, 0xeb,
-	0x43, 0xab, 0x36, 0x9b, 0x3f, 0x34, 0x48, 0x9a, 0x9f, 0x11, 0x51, 0x2a, 0xe8, 0x6c, 0x7f, 0x94,
-	0x87, 0x44, 0x88, 0xfe, 0x0e, 0x60, 0xbc, 0xf6, 0xa0, 0x94, 0xd5, 0x1e, 0x2f, 0x65, 0x3f, 0x07,
-	0x22, 0x9a, 0x7b, 0x20, 0xbb, 0xd8, 0x1f, 0x4e, 0xf8, 0xd1, 0xf9, 0x91, 0x72, 0xd7, 0x67, 0xdb,
-	0x97, 0xc2, 0x0a, 0x38, 0x73, 0xfd, 0x42, 0xfe, 0xa5, 0xa3, 0x41, 0x2b, 0xe0, 0x0c, 0xba, 0x07,
-	0x60, 0x62, 0x8e, 0x58, 0x84, 0x11, 0x34, 0xd4, 0x9e, 0x95, 0xfd, 0x96, 0xda, 0xcd, 0x5f, 0x12,
-	0x33, 0xad, 0x9c, 0x79, 0x79, 0x88, 0xbc, 0x8b, 0xa0, 0xa3, 0x29, 0xa9, 0xbf, 0x01, 0xf7, 0x77,
-	0x64, 0xf0, 0xf9, 0x8e, 0x0c, 0xbe, 0xd8, 0x91, 0x63, 0x5f, 0xee, 0xc8, 0xb1, 0xaf, 0x76, 0xe4,
-	0xd8, 0xd7, 0x3b, 0x72, 0xec, 0x9b, 0x1d, 0x19, 0xdc, 0xed, 0xc8, 0xe0, 0xc3, 0x8e, 0x1c, 0xfb,
-	0xb8, 0x23, 0x83, 0x4f, 0x3a, 0x72, 0xec, 0xd3, 0x8e, 0x1c, 0xfb, 0xac, 0x23, 0xc7, 0xee, 0x77,
-	0x64, 0xf0, 0x79, 0x47, 0x06, 0x5f, 0x74, 0xe4, 0xd8, 0x97, 0x1d, 0x19, 0x7c, 0xd5, 0x91, 0x63,
-	0x5f, 0x77, 0x64, 0xf0, 0x4d, 0x47, 0x8e, 0xdd, 0xdd, 0x95, 0x63, 0x1f, 0xee, 0xca, 0xe0, 0xde,
-	0xae, 0x1c, 0xfb, 0x68, 0x57, 0x06, 0xbf, 0xda, 0x95, 0x63, 0x1f, 0xef, 0xca, 0xb1, 0x4f, 0x76,
-	0x65, 0xf0, 0xe9, 0xae, 0x0c, 0x3e, 0xdb, 0x95, 0xc1, 0xf5, 0x52, 0xcb, 0x29, 0xb2, 0x35, 0xc2,
-	0xd6, 0x4c, 0xbb, 0x45, 0x8b, 0x36, 0x61, 0x9b, 0x8e, 0xb7, 0x5e, 0xda, 0xff, 0xa5, 0x7b, 0x63,
-	0xa6, 0xe4, 0xae, 0xb7, 0x4a, 0x8c, 0xd9, 0x6e, 0xa3, 0x91, 0x10, 0x33, 0x9f, 0xf9, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x90, 0x14, 0x4b, 0x63, 0x60, 0x18, 0x00, 0x00,
+	// 2581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x99, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xc0, 0x39, 0xa2, 0x44, 0x89, 0x8f, 0x94, 0x44, 0x4f, 0x92, 0x7f, 0xd6, 0x74, 0xb2, 0xf2,
+	0x9f, 0x36, 0x52, 0xda, 0x0e, 0x49, 0x9b, 0x4e, 0x82, 0x54, 0x4e, 0x63, 0x93, 0x92, 0x3f, 0x14,
+	0xcb, 0x8a, 0xb4, 0xa4, 0x91, 0xc6, 0x5f, 0x8b, 0x21, 0x77, 0x44, 0x6d, 0xb4, 0xdc, 0x5d, 0xef,
+	0xcc, 0x4a, 0x56, 0x1c, 0x03, 0x46, 0x4e, 0x69, 0x0f, 0x85, 0xd1, 0x1e, 0x1a, 0xa0, 0x87, 0x16,
+	0x08, 0x8a, 0x06, 0xe8, 0x25, 0x40, 0x0f, 0x0d, 0xd0, 0x43, 0x03, 0xf4, 0xe2, 0x63, 0x80, 0xf6,
+	0x90, 0x93, 0x1a, 0x51, 0x3d, 0xe4, 0xd6, 0x9c, 0xd2, 0x40, 0x05, 0x8a, 0x62, 0xf6, 0x83, 0x1f,
+	0x92, 0x63, 0x89, 0x32, 0xda, 0x43, 0x4f, 0x9a, 0x99, 0xf7, 0xde, 0x6f, 0xdf, 0xbc, 0x79, 0x9a,
+	0x37, 0x33, 0x84, 0x93, 0x86, 0xe5, 0x90, 0x55, 0x62, 0xe6, 0x18, 0x27, 0xf5, 0xe5, 0x02, 0xb1,
+	0xf5, 0x02, 0xb1, 0x6d, 0x43, 0xaf, 0x13, 0xae, 0x5b, 0x26, 0xa3, 0xce, 0x0a, 0x75, 0x54, 0xdb,
+	0xad, 0x31, 0xb7, 0x96, 0xb7, 0x1d, 0x8b, 0x5b, 0x78, 0x8c, 0x73, 0x33, 0x1f, 0x58, 0xe5, 0x57,
+	0x4e, 0xa7, 0x4b, 0x0d, 0x9d, 0x2f, 0xb9, 0xb5, 0x7c, 0xdd, 0x6a, 0x16, 0xa8, 0xb9, 0x62, 0xad,
+	0xd9, 0x8e, 0x75, 0x67, 0xad, 0xe0, 0x29, 0xd7, 0x73, 0x0d, 0x6a, 0xe6, 0x56, 0x88, 0xa1, 0x6b,
+	0x84, 0xd3, 0xc2, 0x8e, 0x86, 0x8f, 0x4c, 0xe7, 0xba, 0x10, 0x0d, 0xab, 0x61, 0xf9, 0xc6, 0x35,
+	0x77, 0xd1, 0xeb, 0x79, 0x1d, 0xaf, 0x15, 0xa8, 0x3f, 0xd7, 0xb0, 0xac, 0x86, 0x41, 0x7d, 0x67,
+	0x4d, 0xd3, 0xe2, 0xbe, 0xaf, 0x81, 0x54, 0x0e, 0xa4, 0x6d, 0x86, 0xe6, 0x3a, 0x9e, 0x42, 0x20,
+	0x3f, 0xb4, 0x5d, 0x4e, 0x9b, 0x36, 0x5f, 0x0b, 0x84, 0x87, 0xb7, 0x0b, 0x17, 0x75, 0x6a, 0x68,
+	0x6a, 0x93, 0xb0, 0xe5, 0x40, 0x63, 0x62, 0xbb, 0x06, 0xd7, 0x9b, 0x94, 0x71, 0xd2, 0xb4, 0x03,
+	0x85, 0x23, 0x3b, 0x23, 0xaa, 0x6b, 0xd4, 0xe4, 0xfa, 0xa2, 0x4e, 0x9d, 0xc0, 0xc9, 0xcc, 0x5f,
+	0x10, 0x3c, 0x57, 0xea, 0xc4, 0x79, 0xde, 0xad, 0x55, 0xdc, 0xda, 0x4c, 0x47, 0x0d, 0x13, 0x18,
+	0xef, 0x5a, 0x07, 0x55, 0xd7, 0x98, 0x84, 0x0e, 0xa3, 0x6c, 0xa2, 0xf8, 0x42, 0xbe, 0x37, 0xfe,
+	0xf9, 0x2e, 0x4c, 0x17, 0xa0, 0x9c, 0xda, 0x2a, 0x0f, 0xfd, 0x18, 0x0d, 0xa4, 0xd0, 0xc3, 0xf5,
+	0x89, 0xc8, 0xe7, 0xeb, 0x13, 0x48, 0x19, 0x23, 0xdd, 0x9a, 0x0c, 0x2f, 0x00, 0xd8, 0x6e, 0x4d,
+	0x65, 0x6e, 0x4d, 0xd5, 0x35, 0x69, 0xe0, 0x30, 0xca, 0xc6, 0xcb, 0xa7, 0xb7, 0xca, 0x47, 0x9d,
+	0x8c, 0x74, 0xb4, 0x28, 0xdf, 0xba, 0x4e, 0x72, 0xef, 0x9e, 0xcc, 0x7d, 0xff, 0x66, 0xf6, 0xec,
+	0xe4, 0xf5, 0xdc, 0xcd, 0xb3, 0x61, 0xf7, 0xd8, 0xdd, 0xe2, 0x8b, 0xf7, 0x8e, 0xb6, 0xd6, 0x27,
+	0x46, 0x02, 0xa7, 0xa7, 0x95, 0x11, 0x3b, 0x70, 0x3f, 0xf3, 0xcd, 0x0b, 0x70, 0x60, 0xc7, 0xb4,
+	0xf0, 0x3c, 0x44, 0x3b, 0xfe, 0xbf, 0xf8, 0x18, 0xff, 0x77, 0x84, 0xe1, 0x11, 0xb3, 0x10, 0x28,
+	0x3c, 0x05, 0x50, 0x77, 0x28, 0xe1, 0x54, 0x53, 0x09, 0xf7, 0x5c, 0x4f, 0x14, 0xd3, 0x79, 0x7f,
+	0x65, 0xf2, 0xe1, 0xca, 0xe4, 0xab, 0xe1, 0xca, 0x94, 0x47, 0x84, 0xf9, 0x83, 0xbf, 0x4e, 0x20,
+	0x25, 0x1e, 0xd8, 0x95, 0xb8, 0x80, 0xb8, 0xb6, 0x16, 0x42, 0xa2, 0xfd, 0x40, 0x02, 0xbb, 0x12,
+	0xc7, 0x67, 0x21, 0xb6, 0x68, 0x39, 0x4d, 0xc2, 0xa5, 0x41, 0x2f, 0x80, 0xdf, 0xf3, 0x03, 0xf8,
+	0xf4, 0x6e, 0x01, 0x54, 0x02, 0x33, 0x3c, 0x07, 0x83, 0x26, 0xe1, 0x4c, 0x3a, 0xe0, 0x7d, 0x3f,
+	0xbf, 0x6b, 0x74, 0xf2, 0x73, 0xa5, 0x6a, 0x65, 0xde, 0xb1, 0x56, 0x74, 0x8d, 0x3a, 0xe5, 0x91,
+	0xd6, 0xfa, 0xc4, 0xa0, 0x18, 0xb9, 0x14, 0x51, 0x3c, 0x8e, 0xe0, 0x35, 0x6f, 0x73, 0x2e, 0x1d,
+	0xdc, 0x2b, 0xef, 0xca, 0x42, 0xb5, 0xda, 0xcb, 0x13, 0x23, 0x82, 0x27, 0x38, 0xf8, 0x22, 0x0c,
+	0x2d, 0x93, 0xc5, 0x65, 0x22, 0xa5, 0x3d, 0x60, 0x61, 0x77, 0xe0, 0x65, 0xa1, 0x1e, 0x12, 0x2f,
+	0x45, 0x14, 0xdf, 0x5e, 0x38, 0x46, 0x9a, 0xb7, 0x6d, 0xe9, 0xd0, 0x5e, 0x1d, 0x2b, 0x5d, 0x59,
+	0x98, 0xef, 0x75, 0x4c, 0x8c, 0x08, 0xc7, 0x04, 0x07, 0xbf, 0x05, 0xc3, 0x64, 0x95, 0xa9, 0xba,
+	0xc5, 0x25, 0xea, 0x21, 0x4f, 0xee, 0x01, 0xf9, 0x56, 0x65, 0xc6, 0xea, 0xcc, 0x16, 0x5a, 0xeb,
+	0x13, 0x31, 0x7f, 0xec, 0x52, 0x44, 0x89, 0x91, 0x55, 0x36, 0x63, 0x71, 0xfc, 0x02, 0x40, 0x8d,
+	0x30, 0xaa, 0x72, 0xcb, 0xd6, 0xeb, 0x52, 0xcc, 0x5b, 0xd6, 0xe1, 0xad, 0xf2, 0xa0, 0x33, 0x20,
+	0x69, 0x4a, 0x5c, 0x88, 0xaa, 0x42, 0x82, 0xe7, 0x60, 0x54, 0xb3, 0x56, 0x4d, 0x43, 0x37, 0x97,
+	0x55, 0xdb, 0x65, 0x4b, 0xd2, 0xb0, 0xe7, 0xc6, 0xb1, 0x3d, 0x84, 0x9c, 0x32, 0x46, 0x1a, 0x54,
+	0x49, 0x86, 0xf6, 0xf3, 0x2e, 0x5b, 0xc2, 0x55, 0x48, 0xb5, 0x79, 0x0e, 0xb5, 0x0d, 0x52, 0xa7,
+	0xd2, 0x48, 0xbf, 0xc8, 0xf1, 0x10, 0xa1, 0xf8, 0x04, 0x3c, 0x0f, 0x63, 0xae, 0xed, 0x31, 0x9b,
+	0xbe, 0x8a, 0x14, 0xef, 0x97, 0x39, 0xea, 0x03, 0x82, 0x2e, 0x7e, 0x03, 0x12, 0xef, 0x58, 0xba,
+	0xa9, 0x92, 0x7a, 0x9d, 0xda, 0x5c, 0x82, 0x7e, 0x71, 0x20, 0xac, 0x4b, 0x9e, 0x31, 0x9e, 0x85,
+	0x76, 0x0c, 0x54, 0x52, 0x5f, 0x96, 0x12, 0xfd, 0xc2, 0x12, 0xa1, 0x79, 0xa9, 0xbe, 0xdc, 0xb3,
+	0x22, 0xa6, 0xc0, 0x25, 0xf7, 0xbd, 0x22, 0x73, 0x64, 0x1b, 0x8f, 0x51, 0x93, 0x4b, 0xa3, 0xfb,
+	0xe6, 0x55, 0xa8, 0xc9, 0xb1, 0x02, 0xed, 0xe5, 0x51, 0x17, 0x89, 0x6e, 0x50, 0x4d, 0x1a, 0xeb,
+	0x97, 0x38, 0x16, 0x12, 0x2e, 0x78, 0x80, 0x1e, 0xe6, 0x6d, 0x97, 0xba, 0x54, 0x93, 0xc6, 0xf7,
+	0xcd, 0x5c, 0xf0, 0x00, 0xb8, 0x01, 0xe9, 0x5e, 0xa6, 0xaa, 0x9b, 0x61, 0xc9, 0xd6, 0xa4, 0xa7,
+	0xfa, 0xc5, 0x4b, 0x3d, 0xf8, 0x99, 0x0e, 0x4a, 0x38, 0x6f, 0x58, 0x41, 0x89, 0x63, 0x96, 0xb1,
+	0x42, 0x35, 0x29, 0xd5, 0xb7, 0xf3, 0x21, 0xa1, 0xe2, 0x01, 0x44, 0x4a, 0x89, 0x63, 0x8b, 0x5e,
+	0xa7, 0xaa, 0x46, 0x38, 0x91, 0x70, 0xdf, 0x29, 0x15, 0x98, 0x4f, 0x13, 0x4e, 0xd2, 0xd3, 0x90,
+	0xec, 0xde, 0x70, 0xf1, 0x4b, 0x00, 0xc1, 0xa1, 0xc8, 0x75, 0x0c, 0xaf, 0xa4, 0xc5, 0xcb, 0xcf,
+	0x6c, 0x95, 0x87, 0x9c, 0xe8, 0x07, 0x08, 0xb5, 0xd6, 0x27, 0xe2, 0x15, 0x4f, 0x7a, 0x55, 0x99,
+	0x55, 0xe2, 0xbe, 0xe2, 0x55, 0xc7, 0x48, 0xff, 0x2e, 0x06, 0xc9, 0xee, 0x7d, 0x76, 0x7f, 0x18,
+	0x7c, 0x12, 0xe2, 0x75, 0x43, 0xa7, 0x26, 0xef, 0x14, 0xec, 0xa7, 0xfc, 0x8d, 0xe9, 0x59, 0x51,
+	0x90, 0xa7, 0x3c, 0x99, 0x28, 0xc8, 0xbe, 0xd6, 0x8c, 0x86, 0x8f, 0xc0, 0x88, 0xcb, 0xa8, 0x63,
+	0x92, 0x26, 0x95, 0xa2, 0xbd, 0x3b, 0x59, 0x5b, 0x20, 0x94, 0x6c, 0xc2, 0xd8, 0xaa, 0xe5, 0x68,
+	0xd2, 0xe0, 0x36, 0xa5, 0x50, 0x80, 0x75, 0x18, 0x65, 0x6e, 0x8d, 0xd5, 0x1d, 0xbd, 0x46, 0xd5,
+	0xdb, 0x16, 0x93, 0x86, 0x0e, 0xa3, 0xec, 0x58, 0xb1, 0xd8, 0x5f, 0x81, 0xc9, 0x2f, 0x58, 0x95,
+	0x72, 0xaa, 0xb5, 0x3e, 0x91, 0xac, 0x84, 0xb0, 0x05, 0xab, 0xa2, 0x24, 0x59, 0xa7, 0xc7, 0x70,
+	0x1d, 0x12, 0xb6, 0x5b, 0x33, 0x74, 0xb6, 0xe4, 0x7d, 0x28, 0xb6, 0xef, 0x0f, 0x8d, 0xb5, 0xd6,
+	0x27, 0x60, 0xde, 0x47, 0x89, 0xcf, 0x80, 0x1d, 0xb6, 0x19, 0x3e, 0x02, 0xc3, 0xae, 0xd8, 0xe4,
+	0x0d, 0xe6, 0xed, 0xdb, 0x23, 0x7e, 0x31, 0xb8, 0xca, 0x68, 0x75, 0xb6, 0xa2, 0xc4, 0x5c, 0x46,
+	0xab, 0x06, 0xc3, 0x59, 0x88, 0x71, 0x83, 0xa9, 0x75, 0xe2, 0x6d, 0xc4, 0xc9, 0xf2, 0x81, 0xad,
+	0xf2, 0xd0, 0xbb, 0x51, 0xe9, 0xfe, 0xb9, 0xd6, 0xfa, 0xc4, 0x50, 0x75, 0xb6, 0x32, 0x55, 0x52,
+	0x86, 0xb8, 0xc1, 0xa6, 0x08, 0x2e, 0xc1, 0xb8, 0xa7, 0xe9, 0x2f, 0x4f, 0x9d, 0x3a, 0xdc, 0xdb,
+	0x67, 0x93, 0xe5, 0x83, 0x5d, 0x26, 0xa3, 0xc2, 0xc4, 0xd3, 0x98, 0xa2, 0x0e, 0x57, 0x46, 0x85,
+	0x69, 0xbb, 0x8b, 0x5f, 0x87, 0xb1, 0x2e, 0xc4, 0x32, 0x5d, 0xf3, 0xb6, 0xd6, 0x64, 0x59, 0xea,
+	0x22, 0x24, 0xdb, 0x84, 0xcb, 0x74, 0x4d, 0x49, 0xb6, 0x01, 0x97, 0xe9, 0x1a, 0xbe, 0x0a, 0xc3,
+	0x4b, 0x94, 0x68, 0xd4, 0x61, 0x52, 0xe2, 0x70, 0x34, 0x9b, 0x28, 0x9e, 0xe9, 0x33, 0x64, 0x97,
+	0x7c, 0xeb, 0xf3, 0x26, 0x77, 0xd6, 0x94, 0x90, 0x95, 0x9e, 0x84, 0x64, 0xb7, 0x00, 0xa7, 0x20,
+	0x2a, 0x7c, 0xf3, 0x72, 0x56, 0x11, 0x4d, 0xfc, 0x34, 0x0c, 0xad, 0x10, 0xc3, 0xa5, 0x7e, 0x4a,
+	0x2a, 0x7e, 0x67, 0x72, 0xe0, 0x55, 0x94, 0x79, 0x0d, 0xa2, 0x0b, 0x56, 0x05, 0xa7, 0x20, 0x59,
+	0xaa, 0xaa, 0x57, 0xde, 0xac, 0x54, 0xd5, 0x37, 0xe7, 0xa6, 0xce, 0xa7, 0x22, 0xf8, 0x00, 0x8c,
+	0x96, 0xaa, 0xea, 0xec, 0xf9, 0x52, 0x38, 0x84, 0x84, 0xd2, 0xf9, 0x1f, 0x96, 0xa6, 0xaa, 0xb3,
+	0x6f, 0xfb, 0x23, 0x03, 0xe9, 0x1f, 0x01, 0x8c, 0xf5, 0x56, 0x6c, 0xfc, 0x8b, 0x01, 0x88, 0x39,
+	0xb4, 0xa1, 0x5b, 0x66, 0xf0, 0x4f, 0xf3, 0xfe, 0xc0, 0x56, 0xf9, 0x5f, 0xc8, 0xf9, 0x27, 0x52,
+	0x80, 0x2c, 0xe6, 0x98, 0xe5, 0xf2, 0xa5, 0xdc, 0x29, 0x25, 0x4e, 0xec, 0x1c, 0x25, 0x8c, 0xe7,
+	0x4e, 0x89, 0x53, 0x6f, 0xce, 0xb4, 0x1c, 0xbe, 0xf4, 0xc8, 0x7e, 0x51, 0x01, 0x62, 0xb7, 0xcd,
+	0xc6, 0xc2, 0x76, 0x97, 0x6e, 0xa7, 0x5f, 0x54, 0x92, 0x75, 0x92, 0xab, 0x53, 0x93, 0x3b, 0xc4,
+	0xc8, 0x9d, 0x52, 0x92, 0xd4, 0xed, 0xea, 0x01, 0x75, 0x7d, 0x6e, 0xd0, 0x6e, 0xbb, 0x42, 0xdd,
+	0xdc, 0x2a, 0x65, 0xbc, 0xbb, 0x59, 0xec, 0x34, 0x4f, 0x2b, 0xd0, 0xa4, 0x1d, 0x65, 0x46, 0x42,
+	0xbf, 0xe3, 0x2e, 0xdb, 0xd1, 0x2c, 0x7a, 0xcd, 0x90, 0x16, 0x36, 0x8b, 0x4a, 0x10, 0x12, 0xfc,
+	0x36, 0x80, 0x28, 0xca, 0x8c, 0x79, 0xd9, 0xe3, 0x1f, 0x8b, 0x27, 0xfb, 0x3d, 0x15, 0xe5, 0x4b,
+	0x1e, 0x42, 0xe4, 0x57, 0x9c, 0x84, 0x4d, 0x7c, 0x03, 0x12, 0x84, 0x31, 0xb7, 0x49, 0x55, 0xc7,
+	0x32, 0x68, 0x70, 0x5a, 0x3e, 0xd3, 0x3f, 0xdb, 0x63, 0x28, 0x96, 0x41, 0x15, 0x20, 0xed, 0x36,
+	0xfe, 0x08, 0x41, 0x8a, 0x9a, 0x9a, 0x6d, 0xe9, 0x26, 0x57, 0x89, 0xa6, 0x39, 0x94, 0xb1, 0x60,
+	0x2b, 0xba, 0xb3, 0x55, 0x76, 0x1d, 0x26, 0xdd, 0x47, 0x45, 0xf3, 0x56, 0x36, 0x9b, 0x15, 0xa7,
+	0xe8, 0x52, 0xee, 0x9a, 0x38, 0x48, 0xbf, 0xd7, 0xd5, 0xee, 0x34, 0x6f, 0xe4, 0x6e, 0x1e, 0xef,
+	0x12, 0x1c, 0xbb, 0x91, 0x3f, 0x76, 0x3c, 0x7b, 0xbd, 0x94, 0xbb, 0x16, 0x1c, 0xbf, 0xdf, 0xeb,
+	0x6a, 0x77, 0x9a, 0x9e, 0x55, 0x47, 0x70, 0xec, 0xbd, 0x63, 0x47, 0x95, 0xf1, 0xd0, 0xa3, 0x92,
+	0xef, 0x10, 0x56, 0x61, 0x58, 0xa3, 0x8b, 0xc4, 0x35, 0xb8, 0xb7, 0xf9, 0x25, 0x8a, 0x53, 0x7d,
+	0xcf, 0x7f, 0xda, 0xb7, 0x9f, 0x31, 0x39, 0x6d, 0xf8, 0xb7, 0xd2, 0x4b, 0x11, 0x25, 0xa4, 0xa6,
+	0x7f, 0x8f, 0x20, 0xde, 0x8e, 0x3e, 0xbe, 0x00, 0xa3, 0x9d, 0xd5, 0x14, 0x3b, 0xbe, 0x9f, 0xf1,
+	0x99, 0xad, 0x72, 0xca, 0x19, 0x4b, 0xa5, 0x44, 0x48, 0x86, 0x6f, 0x5d, 0xbf, 0xb1, 0x7a, 0xf3,
+	0xb8, 0xb8, 0x8e, 0x25, 0xda, 0x86, 0x33, 0xd3, 0x4a, 0xa2, 0xbd, 0x70, 0x33, 0x1a, 0x3e, 0x0d,
+	0x07, 0x18, 0xad, 0x3b, 0x94, 0xab, 0xdb, 0x92, 0xa3, 0xbd, 0xcf, 0x67, 0x95, 0x71, 0x5f, 0xa3,
+	0xf3, 0xf1, 0x1c, 0x8c, 0x32, 0xca, 0x98, 0x28, 0xcc, 0xdc, 0x5a, 0xa6, 0x66, 0x50, 0x3d, 0x46,
+	0xbc, 0x1a, 0x25, 0xdd, 0x1f, 0x50, 0x92, 0x81, 0xb8, 0x2a, 0xa4, 0xe9, 0x7f, 0x20, 0x80, 0xce,
+	0xda, 0x62, 0x05, 0xa2, 0xc4, 0x09, 0xff, 0x45, 0xcf, 0x6d, 0x95, 0x5f, 0x71, 0x5e, 0x2a, 0x16,
+	0x6f, 0x11, 0xc7, 0x9c, 0x24, 0xab, 0x6c, 0x52, 0x27, 0xcd, 0xc9, 0xc9, 0xeb, 0x22, 0xce, 0x77,
+	0x4f, 0x15, 0xef, 0x4d, 0x8a, 0x84, 0xba, 0x51, 0xe8, 0x44, 0x5f, 0x3d, 0xf1, 0x83, 0x17, 0xf3,
+	0xe7, 0x72, 0x37, 0x4f, 0x88, 0x69, 0x45, 0x4b, 0xca, 0x9c, 0x22, 0x60, 0x78, 0x06, 0x12, 0xf4,
+	0x0e, 0x17, 0x25, 0xcb, 0xe8, 0x94, 0xbf, 0xec, 0x56, 0xf9, 0x59, 0xe7, 0x19, 0xe9, 0x61, 0xbc,
+	0x98, 0x12, 0xa1, 0xf0, 0x2c, 0x27, 0x6f, 0x14, 0x72, 0x7e, 0x4c, 0xe0, 0x7c, 0x60, 0x30, 0x33,
+	0xad, 0x40, 0x68, 0x3c, 0xa3, 0xe1, 0x37, 0x20, 0x15, 0x4e, 0x2e, 0x7c, 0x1c, 0x08, 0x32, 0xfa,
+	0xe0, 0x8e, 0xfb, 0xdf, 0x74, 0xa0, 0x50, 0x1e, 0xfc, 0x50, 0x5c, 0xfd, 0xc6, 0x03, 0xc3, 0x70,
+	0x38, 0xfd, 0x16, 0xe0, 0x9d, 0x8b, 0x8a, 0x4b, 0x00, 0xde, 0xf5, 0x5f, 0xf5, 0x2a, 0x6f, 0x7b,
+	0xe1, 0x26, 0x9c, 0xe7, 0xc5, 0xb2, 0x49, 0xb7, 0x82, 0xd9, 0x6e, 0xcb, 0xc0, 0xa3, 0x4a, 0xdc,
+	0xb3, 0x9a, 0x23, 0x4d, 0x5a, 0x4e, 0x02, 0x68, 0xd4, 0x36, 0xac, 0xb5, 0x26, 0x35, 0x79, 0xfa,
+	0xa3, 0x21, 0x18, 0xed, 0xb9, 0x58, 0xe1, 0x13, 0x30, 0x5c, 0x73, 0xac, 0x65, 0xb1, 0xdd, 0xa3,
+	0xc3, 0xd1, 0x6c, 0x5c, 0x14, 0xa7, 0xb1, 0x9f, 0xa2, 0xc4, 0x08, 0x4a, 0xa5, 0x32, 0xc1, 0x22,
+	0x85, 0x1a, 0xf8, 0x02, 0x1c, 0xa8, 0x5b, 0xa6, 0x58, 0x1f, 0x47, 0x6d, 0x38, 0x96, 0x6b, 0x77,
+	0x42, 0x98, 0x0e, 0x6a, 0x7d, 0x6b, 0x7d, 0x62, 0x7c, 0x2a, 0xd0, 0xb9, 0x28, 0x54, 0x66, 0xa6,
+	0x95, 0xf1, 0x7a, 0xcf, 0x80, 0xd6, 0x5d, 0x35, 0xa3, 0x7b, 0xa8, 0x9a, 0x83, 0xfd, 0x57, 0xcd,
+	0xa1, 0x27, 0xae, 0x9a, 0xb1, 0xbe, 0xaa, 0xa6, 0x02, 0x83, 0x8c, 0x30, 0x23, 0xb8, 0xbc, 0xbd,
+	0xd4, 0xe7, 0xf5, 0x36, 0x5f, 0x29, 0x55, 0x66, 0xfd, 0xcb, 0xa9, 0x68, 0x29, 0x1e, 0x2b, 0xfd,
+	0x0d, 0x02, 0xaf, 0x8b, 0x17, 0x21, 0xde, 0xa4, 0xf5, 0x25, 0x62, 0xea, 0xac, 0xe9, 0x65, 0xc1,
+	0x58, 0xf1, 0xf5, 0xfd, 0x7c, 0x21, 0x7f, 0x25, 0xa4, 0x78, 0xff, 0x81, 0xef, 0x8b, 0x17, 0x11,
+	0xa5, 0x83, 0xee, 0x39, 0xe6, 0x0d, 0xec, 0xe5, 0x98, 0x17, 0xfd, 0x8e, 0x63, 0x5e, 0xe6, 0x35,
+	0x88, 0xb7, 0xbf, 0x85, 0xe3, 0x30, 0x34, 0x3f, 0x5b, 0x9a, 0x99, 0xf3, 0x0b, 0x76, 0x65, 0x4a,
+	0x29, 0x5d, 0x51, 0x2b, 0x97, 0x4a, 0x6a, 0xf1, 0xe5, 0x57, 0x52, 0xa8, 0x77, 0xe8, 0xe5, 0x53,
+	0xc5, 0xd4, 0x40, 0xfa, 0x0f, 0x03, 0x90, 0xec, 0xbe, 0xb6, 0xef, 0xf3, 0x9c, 0x7b, 0x04, 0x46,
+	0xe8, 0x1d, 0xe1, 0x44, 0x63, 0xe7, 0x74, 0x42, 0xc1, 0xff, 0x5e, 0x2a, 0xa6, 0xb3, 0x30, 0x1c,
+	0xde, 0xb1, 0x9f, 0x87, 0x21, 0xff, 0xf9, 0x01, 0xf5, 0x4e, 0xdf, 0x1f, 0x2d, 0x8f, 0xc3, 0x88,
+	0x1d, 0x86, 0x38, 0xfa, 0x6d, 0x19, 0x65, 0x16, 0x00, 0xef, 0xc8, 0x23, 0x86, 0xcf, 0xc0, 0xb0,
+	0xff, 0x74, 0xeb, 0x6f, 0x11, 0x89, 0xe2, 0xff, 0xef, 0x9a, 0x7c, 0x4a, 0x68, 0x91, 0xf9, 0x0d,
+	0x02, 0x69, 0x87, 0xf8, 0x82, 0xf7, 0x68, 0xc5, 0xf0, 0x9b, 0x30, 0xec, 0xbf, 0x5f, 0x85, 0xe4,
+	0x97, 0x77, 0x25, 0x07, 0xa6, 0xf9, 0xe0, 0x6f, 0x70, 0xca, 0x0c, 0x28, 0xe2, 0x94, 0xd9, 0x2d,
+	0xe8, 0xeb, 0x94, 0xf9, 0x09, 0x82, 0x43, 0x17, 0x29, 0xdf, 0x39, 0x17, 0x7a, 0xdb, 0xa5, 0x8c,
+	0xff, 0x07, 0xde, 0x1f, 0xcf, 0x02, 0x74, 0x1e, 0x86, 0xbf, 0xf3, 0xfd, 0xf1, 0x82, 0x50, 0xb9,
+	0x42, 0xd8, 0x72, 0x79, 0x50, 0x98, 0x2b, 0xf1, 0xc5, 0x70, 0x20, 0xf3, 0x27, 0x04, 0xcf, 0xcf,
+	0xea, 0x6c, 0xa7, 0xcf, 0x2c, 0x74, 0xfa, 0xbf, 0xf0, 0x00, 0xfc, 0xc4, 0xb3, 0xf8, 0x2d, 0x82,
+	0x43, 0x95, 0xc7, 0x04, 0xfe, 0x32, 0xc4, 0xfc, 0x6c, 0x0a, 0x5c, 0xdf, 0x3d, 0xfd, 0x1e, 0xe1,
+	0x75, 0x80, 0x78, 0x62, 0x6f, 0x8b, 0x7f, 0x8c, 0xc1, 0xc1, 0x47, 0xb8, 0xda, 0xd0, 0x99, 0x48,
+	0xb8, 0x77, 0x00, 0x2e, 0x52, 0x1e, 0xe6, 0xf7, 0xff, 0xed, 0x00, 0x9f, 0x17, 0xbf, 0x12, 0xa4,
+	0xb3, 0x7b, 0x4d, 0xf3, 0x4c, 0xfa, 0xfd, 0x3f, 0xff, 0xed, 0x67, 0x03, 0x4f, 0x63, 0x5c, 0x20,
+	0xac, 0xe0, 0x4f, 0x21, 0x17, 0x24, 0x3b, 0xfe, 0x25, 0x82, 0xe8, 0x45, 0xca, 0xf1, 0x89, 0xed,
+	0xb4, 0xc7, 0x64, 0x71, 0x7a, 0xf7, 0xe0, 0x65, 0x2e, 0x79, 0xdf, 0x2c, 0xe3, 0x73, 0x9d, 0x6f,
+	0x16, 0xee, 0xea, 0x1a, 0xcb, 0x6f, 0xcb, 0xa4, 0x6d, 0xfd, 0x7b, 0xbe, 0x52, 0xe7, 0xc7, 0x80,
+	0x7b, 0xf8, 0x27, 0x08, 0x06, 0x45, 0x7e, 0xe2, 0xdc, 0xf6, 0xaf, 0x3e, 0x36, 0x6b, 0xd3, 0x99,
+	0x5d, 0x9d, 0x64, 0x99, 0xd3, 0x9e, 0x97, 0x39, 0x7c, 0xa2, 0xdb, 0xcb, 0x5d, 0x3c, 0xc4, 0x7f,
+	0x47, 0x10, 0xad, 0x3c, 0x2a, 0x64, 0x95, 0x27, 0x0b, 0xd9, 0xcf, 0x91, 0xe7, 0xcd, 0x03, 0x94,
+	0x9e, 0xeb, 0x76, 0xc7, 0xff, 0x9b, 0xdf, 0x53, 0xec, 0xba, 0x74, 0xbb, 0x42, 0x38, 0x89, 0x8e,
+	0x5f, 0x3b, 0x93, 0x79, 0x65, 0x7f, 0xd0, 0x49, 0x74, 0x1c, 0x3f, 0x40, 0x10, 0x9b, 0xa6, 0x06,
+	0xe5, 0x14, 0xf7, 0xb5, 0x67, 0xa5, 0xbf, 0x23, 0x77, 0x33, 0xe7, 0xbc, 0x99, 0x4e, 0x1e, 0x7f,
+	0xb5, 0x8f, 0xb8, 0x17, 0xee, 0x76, 0x4d, 0xa9, 0xfc, 0x6b, 0xf4, 0x70, 0x43, 0x46, 0x9f, 0x6f,
+	0xc8, 0xe8, 0x8b, 0x0d, 0x39, 0xf2, 0xe5, 0x86, 0x1c, 0xf9, 0x6a, 0x43, 0x8e, 0x7c, 0xbd, 0x21,
+	0x47, 0xbe, 0xdd, 0x90, 0xd1, 0xfd, 0x96, 0x8c, 0x3e, 0x68, 0xc9, 0x91, 0x8f, 0x5b, 0x32, 0xfa,
+	0xa4, 0x25, 0x47, 0x3e, 0x6d, 0xc9, 0x91, 0xcf, 0x5a, 0x72, 0xe4, 0x61, 0x4b, 0x46, 0x9f, 0xb7,
+	0x64, 0xf4, 0x45, 0x4b, 0x8e, 0x7c, 0xd9, 0x92, 0xd1, 0x57, 0x2d, 0x39, 0xf2, 0x75, 0x4b, 0x46,
+	0xdf, 0xb6, 0xe4, 0xc8, 0xfd, 0x4d, 0x39, 0xf2, 0xc1, 0xa6, 0x8c, 0x1e, 0x6c, 0xca, 0x91, 0x0f,
+	0x37, 0x65, 0xf4, 0xab, 0x4d, 0x39, 0xf2, 0xf1, 0xa6, 0x1c, 0xf9, 0x64, 0x53, 0x46, 0x9f, 0x6e,
+	0xca, 0xe8, 0xb3, 0x4d, 0x19, 0x5d, 0x2b, 0x34, 0xac, 0x3c, 0x5f, 0xa2, 0x7c, 0x49, 0x37, 0x1b,
+	0x2c, 0x6f, 0x52, 0xbe, 0x6a, 0x39, 0xcb, 0x85, 0xde, 0x9f, 0xd9, 0x56, 0x4e, 0x17, 0xec, 0xe5,
+	0x46, 0x81, 0x73, 0xd3, 0xae, 0xd5, 0x62, 0xde, 0xcc, 0x4f, 0xff, 0x7b, 0x00, 0x15, 0x28, 0x6e,
+	0xa2, 0xdd, 0x1c, 0x00, 0x00,
 }
 
 func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
@@ -1284,6 +1614,13 @@ func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) String() string {
+	s, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *ApplicationPubSubIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1440,6 +1777,54 @@ func (this *ApplicationPubSub_MQTT) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationPubSub_Kafka) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Kafka)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Kafka)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Kafka.Equal(that1.Kafka) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_AMQP) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQP)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQP)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AMQP.Equal(that1.AMQP) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_AWSIoT) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1703,14 +2088,14 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Equal(that inte
 	}
 	return true
 }
-func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
+func (this *ApplicationPubSub_KafkaProvider) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationPubSub_Message)
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider)
 	if !ok {
-		that2, ok := that.(ApplicationPubSub_Message)
+		that2, ok := that.(ApplicationPubSub_KafkaProvider)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1722,19 +2107,42 @@ func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Topic != that1.Topic {
+	if len(this.Brokers) != len(that1.Brokers) {
+		return false
+	}
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
+		}
+	}
+	if this.ConsumerGroupID != that1.ConsumerGroupID {
+		return false
+	}
+	if this.UseTLS != that1.UseTLS {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if !this.SASL.Equal(that1.SASL) {
 		return false
 	}
 	return true
 }
-func (this *ApplicationPubSubs) Equal(that interface{}) bool {
+func (this *ApplicationPubSub_KafkaProvider_SASL) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationPubSubs)
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider_SASL)
 	if !ok {
-		that2, ok := that.(ApplicationPubSubs)
+		that2, ok := that.(ApplicationPubSub_KafkaProvider_SASL)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1746,7 +2154,100 @@ func (this *ApplicationPubSubs) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Pubsubs) != len(that1.Pubsubs) {
+	if this.Mechanism != that1.Mechanism {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_AMQPProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQPProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQPProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServerURL != that1.ServerURL {
+		return false
+	}
+	if this.Exchange != that1.Exchange {
+		return false
+	}
+	if this.UseTLS != that1.UseTLS {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Message)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSubs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSubs)
+	if !ok {
+		that2, ok := that.(ApplicationPubSubs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Pubsubs) != len(that1.Pubsubs) {
 		return false
 	}
 	for i := range this.Pubsubs {
@@ -2399,6 +2900,52 @@ func (m *ApplicationPubSub_MQTT) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ApplicationPubSub_Kafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Kafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApplicationPubSub_AMQP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_AMQP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AMQP != nil {
+		{
+			size, err := m.AMQP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ApplicationPubSub_AWSIoT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -2719,12 +3266,12 @@ func (m *ApplicationPubSub_AWSIoTProvider_AssumeRole) MarshalToSizedBuffer(dAtA
 	var l int
 	_ = l
 	if m.SessionDuration != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
-		if err23 != nil {
-			return 0, err23
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n23
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n23))
+		i -= n25
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2775,7 +3322,7 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) MarshalToSizedBuff
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_KafkaProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2785,56 +3332,71 @@ func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Topic) > 0 {
-		i -= len(m.Topic)
-		copy(dAtA[i:], m.Topic)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pubsubs) > 0 {
-		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-			}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsumerGroupID) > 0 {
+		i -= len(m.ConsumerGroupID)
+		copy(dAtA[i:], m.ConsumerGroupID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ConsumerGroupID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Brokers[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2842,7 +3404,7 @@ func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2852,39 +3414,39 @@ func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			v := m.Formats[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mechanism != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.Mechanism))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_AMQPProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2894,28 +3456,205 @@ func (m *GetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetApplicationPubSubRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_AMQPProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetApplicationPubSubRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_AMQPProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationPubSubIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Exchange) > 0 {
+		i -= len(m.Exchange)
+		copy(dAtA[i:], m.Exchange)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Exchange)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pubsubs) > 0 {
+		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			v := m.Formats[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationPubSubRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApplicationPubSubRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationPubSubIdentifiers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3074,12 +3813,16 @@ func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *A
 	if r.Intn(5) != 0 {
 		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
 	}
-	oneofNumber_Provider := []int32{17, 25, 101}[r.Intn(3)]
+	oneofNumber_Provider := []int32{17, 25, 26, 27, 101}[r.Intn(5)]
 	switch oneofNumber_Provider {
 	case 17:
 		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
 	case 25:
 		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
+	case 26:
+		this.Provider = NewPopulatedApplicationPubSub_Kafka(r, easy)
+	case 27:
+		this.Provider = NewPopulatedApplicationPubSub_AMQP(r, easy)
 	case 101:
 		this.Provider = NewPopulatedApplicationPubSub_AWSIoT(r, easy)
 	}
@@ -3104,6 +3847,16 @@ func NewPopulatedApplicationPubSub_MQTT(r randyApplicationserverPubsub, easy boo
 	this.MQTT = NewPopulatedApplicationPubSub_MQTTProvider(r, easy)
 	return this
 }
+func NewPopulatedApplicationPubSub_Kafka(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Kafka {
+	this := &ApplicationPubSub_Kafka{}
+	this.Kafka = NewPopulatedApplicationPubSub_KafkaProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_AMQP(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQP {
+	this := &ApplicationPubSub_AMQP{}
+	this.AMQP = NewPopulatedApplicationPubSub_AMQPProvider(r, easy)
+	return this
+}
 func NewPopulatedApplicationPubSub_AWSIoT(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AWSIoT {
 	this := &ApplicationPubSub_AWSIoT{}
 	this.AWSIoT = NewPopulatedApplicationPubSub_AWSIoTProvider(r, easy)
@@ -3208,6 +3961,73 @@ func NewPopulatedApplicationPubSub_AWSIoTProvider_DefaultIntegration(r randyAppl
 	return this
 }
 
+func NewPopulatedApplicationPubSub_KafkaProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider {
+	this := &ApplicationPubSub_KafkaProvider{}
+	v9 := r.Intn(10)
+	this.Brokers = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.Brokers[i] = randStringApplicationserverPubsub(r)
+	}
+	this.ConsumerGroupID = randStringApplicationserverPubsub(r)
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v10 := r.Intn(100)
+	this.TLSCA = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v11 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v12 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.SASL = NewPopulatedApplicationPubSub_KafkaProvider_SASL(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_KafkaProvider_SASL(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider_SASL {
+	this := &ApplicationPubSub_KafkaProvider_SASL{}
+	this.Mechanism = ApplicationPubSub_KafkaProvider_SASL_Mechanism([]int32{0, 1, 2}[r.Intn(3)])
+	this.Username = randStringApplicationserverPubsub(r)
+	this.Password = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_AMQPProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQPProvider {
+	this := &ApplicationPubSub_AMQPProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.Exchange = randStringApplicationserverPubsub(r)
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v13 := r.Intn(100)
+	this.TLSCA = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v14 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v15 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v15)
+	for i := 0; i < v15; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
 	this := &ApplicationPubSub_Message{}
 	this.Topic = randStringApplicationserverPubsub(r)
@@ -3219,9 +4039,9 @@ func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy
 func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
 	this := &ApplicationPubSubs{}
 	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.Pubsubs = make([]*ApplicationPubSub, v9)
-		for i := 0; i < v9; i++ {
+		v16 := r.Intn(5)
+		this.Pubsubs = make([]*ApplicationPubSub, v16)
+		for i := 0; i < v16; i++ {
 			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
 		}
 	}
@@ -3233,9 +4053,9 @@ func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *
 func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
 	this := &ApplicationPubSubFormats{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(10)
+		v17 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v10; i++ {
+		for i := 0; i < v17; i++ {
 			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
 		}
 	}
@@ -3246,10 +4066,10 @@ func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy b
 
 func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
 	this := &GetApplicationPubSubRequest{}
-	v11 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v18 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3257,10 +4077,10 @@ func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, eas
 
 func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
 	this := &ListApplicationPubSubsRequest{}
-	v13 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v20 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3268,10 +4088,10 @@ func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, e
 
 func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
 	this := &SetApplicationPubSubRequest{}
-	v15 := NewPopulatedApplicationPubSub(r, easy)
-	this.ApplicationPubSub = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v22 := NewPopulatedApplicationPubSub(r, easy)
+	this.ApplicationPubSub = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3296,9 +4116,9 @@ func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
-	v17 := r.Intn(100)
-	tmps := make([]rune, v17)
-	for i := 0; i < v17; i++ {
+	v24 := r.Intn(100)
+	tmps := make([]rune, v24)
+	for i := 0; i < v24; i++ {
 		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
 	}
 	return string(tmps)
@@ -3320,11 +4140,11 @@ func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsu
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		v18 := r.Int63()
+		v25 := r.Int63()
 		if r.Intn(2) == 0 {
-			v18 *= -1
+			v25 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v18))
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v25))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3462,6 +4282,30 @@ func (m *ApplicationPubSub_MQTT) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationPubSub_Kafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_AMQP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AMQP != nil {
+		l = m.AMQP.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
 func (m *ApplicationPubSub_AWSIoT) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3636,25 +4480,115 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationPubSub_Message) Size() (n int) {
+func (m *ApplicationPubSub_KafkaProvider) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Topic)
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	l = len(m.ConsumerGroupID)
 	if l > 0 {
 		n += 1 + l + sovApplicationserverPubsub(uint64(l))
 	}
-	return n
-}
-
-func (m *ApplicationPubSubs) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UseTLS {
+		n += 2
 	}
-	var l int
-	_ = l
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mechanism != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.Mechanism))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_AMQPProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSubs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Pubsubs) > 0 {
 		for _, e := range m.Pubsubs {
 			l = e.Size()
@@ -3784,6 +4718,26 @@ func (this *ApplicationPubSub_MQTT) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_Kafka) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Kafka{`,
+		`Kafka:` + strings.Replace(fmt.Sprintf("%v", this.Kafka), "ApplicationPubSub_KafkaProvider", "ApplicationPubSub_KafkaProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AMQP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQP{`,
+		`AMQP:` + strings.Replace(fmt.Sprintf("%v", this.AMQP), "ApplicationPubSub_AMQPProvider", "ApplicationPubSub_AMQPProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_AWSIoT) String() string {
 	if this == nil {
 		return "nil"
@@ -3892,6 +4846,49 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) String() string
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_KafkaProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`ConsumerGroupID:` + fmt.Sprintf("%v", this.ConsumerGroupID) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "ApplicationPubSub_KafkaProvider_SASL", "ApplicationPubSub_KafkaProvider_SASL", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider_SASL{`,
+		`Mechanism:` + fmt.Sprintf("%v", this.Mechanism) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AMQPProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQPProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`Exchange:` + fmt.Sprintf("%v", this.Exchange) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_Message) String() string {
 	if this == nil {
 		return "nil"
@@ -4790,6 +5787,76 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 			}
 			m.Provider = &ApplicationPubSub_MQTT{v}
 			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_KafkaProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Kafka{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_AMQPProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_AMQP{v}
+			iNdEx = postIndex
 		case 101:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
@@ -6013,6 +7080,656 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Unmarshal(dAtA []b
 	}
 	return nil
 }
+func (m *ApplicationPubSub_KafkaProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &ApplicationPubSub_KafkaProvider_SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			}
+			m.Mechanism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mechanism |= ApplicationPubSub_KafkaProvider_SASL_Mechanism(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_AMQPProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationPubSub_Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
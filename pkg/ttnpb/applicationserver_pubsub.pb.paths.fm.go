@@ -41,6 +41,13 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"location_solved",
 	"location_solved.topic",
 	"provider",
+	"provider.amqp",
+	"provider.amqp.exchange",
+	"provider.amqp.server_url",
+	"provider.amqp.tls_ca",
+	"provider.amqp.tls_client_cert",
+	"provider.amqp.tls_client_key",
+	"provider.amqp.use_tls",
 	"provider.aws_iot",
 	"provider.aws_iot.access_key",
 	"provider.aws_iot.access_key.access_key_id",
@@ -55,6 +62,17 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.aws_iot.deployment.default.stack_name",
 	"provider.aws_iot.endpoint_address",
 	"provider.aws_iot.region",
+	"provider.kafka",
+	"provider.kafka.brokers",
+	"provider.kafka.consumer_group_id",
+	"provider.kafka.sasl",
+	"provider.kafka.sasl.mechanism",
+	"provider.kafka.sasl.password",
+	"provider.kafka.sasl.username",
+	"provider.kafka.tls_ca",
+	"provider.kafka.tls_client_cert",
+	"provider.kafka.tls_client_key",
+	"provider.kafka.use_tls",
 	"provider.mqtt",
 	"provider.mqtt.client_id",
 	"provider.mqtt.headers",
@@ -163,6 +181,13 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.location_solved",
 	"pubsub.location_solved.topic",
 	"pubsub.provider",
+	"pubsub.provider.amqp",
+	"pubsub.provider.amqp.exchange",
+	"pubsub.provider.amqp.server_url",
+	"pubsub.provider.amqp.tls_ca",
+	"pubsub.provider.amqp.tls_client_cert",
+	"pubsub.provider.amqp.tls_client_key",
+	"pubsub.provider.amqp.use_tls",
 	"pubsub.provider.aws_iot",
 	"pubsub.provider.aws_iot.access_key",
 	"pubsub.provider.aws_iot.access_key.access_key_id",
@@ -177,6 +202,17 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.aws_iot.deployment.default.stack_name",
 	"pubsub.provider.aws_iot.endpoint_address",
 	"pubsub.provider.aws_iot.region",
+	"pubsub.provider.kafka",
+	"pubsub.provider.kafka.brokers",
+	"pubsub.provider.kafka.consumer_group_id",
+	"pubsub.provider.kafka.sasl",
+	"pubsub.provider.kafka.sasl.mechanism",
+	"pubsub.provider.kafka.sasl.password",
+	"pubsub.provider.kafka.sasl.username",
+	"pubsub.provider.kafka.tls_ca",
+	"pubsub.provider.kafka.tls_client_cert",
+	"pubsub.provider.kafka.tls_client_key",
+	"pubsub.provider.kafka.use_tls",
 	"pubsub.provider.mqtt",
 	"pubsub.provider.mqtt.client_id",
 	"pubsub.provider.mqtt.headers",
@@ -259,6 +295,45 @@ var ApplicationPubSub_AWSIoTProviderFieldPathsTopLevel = []string{
 	"endpoint_address",
 	"region",
 }
+var ApplicationPubSub_KafkaProviderFieldPathsNested = []string{
+	"brokers",
+	"consumer_group_id",
+	"sasl",
+	"sasl.mechanism",
+	"sasl.password",
+	"sasl.username",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_KafkaProviderFieldPathsTopLevel = []string{
+	"brokers",
+	"consumer_group_id",
+	"sasl",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+var ApplicationPubSub_AMQPProviderFieldPathsNested = []string{
+	"exchange",
+	"server_url",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_AMQPProviderFieldPathsTopLevel = []string{
+	"exchange",
+	"server_url",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
 var ApplicationPubSub_MessageFieldPathsNested = []string{
 	"topic",
 }
@@ -295,3 +370,14 @@ var ApplicationPubSub_AWSIoTProvider_DefaultIntegrationFieldPathsNested = []stri
 var ApplicationPubSub_AWSIoTProvider_DefaultIntegrationFieldPathsTopLevel = []string{
 	"stack_name",
 }
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsNested = []string{
+	"mechanism",
+	"password",
+	"username",
+}
+
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsTopLevel = []string{
+	"mechanism",
+	"password",
+	"username",
+}
@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -531,8 +532,27 @@ type ApplicationWebhook struct {
 	DownlinkQueueInvalidated *ApplicationWebhook_Message `protobuf:"bytes,19,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationWebhook_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
-	XXX_sizecache            int32                       `json:"-"`
+	// The retry policy of failed deliveries.
+	RetryPolicy *ApplicationWebhook_RetryPolicy `protobuf:"bytes,20,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// HMAC-SHA256 signing of the requests.
+	// If set, the requests contain a X-Webhook-Timestamp header with the Unix timestamp of the request,
+	// and a X-Webhook-Signature header with the hex encoded signature of the timestamp and the body, separated by a dot.
+	Signing *ApplicationWebhook_Signing `protobuf:"bytes,21,opt,name=signing,proto3" json:"signing,omitempty"`
+	// OAuth 2.0 client credentials used to acquire access tokens for the requests.
+	// If set, the requests contain an Authorization header with the access token.
+	OAuth2ClientCredentials *ApplicationWebhook_OAuth2ClientCredentials `protobuf:"bytes,22,opt,name=oauth2_client_credentials,json=oauth2ClientCredentials,proto3" json:"oauth2_client_credentials,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	// If empty, the system-wide Root CA certificates are used.
+	TLSCA []byte `protobuf:"bytes,23,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate used for mutual TLS authentication. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,24,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key used for mutual TLS authentication. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,25,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The ID of the key used to encrypt the secrets of the webhook at rest.
+	// Stored in the Application Server.
+	SecretsKeyID         string   `protobuf:"bytes,26,opt,name=secrets_key_id,json=secretsKeyId,proto3" json:"secrets_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
@@ -686,6 +706,55 @@ func (m *ApplicationWebhook) GetServiceData() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetRetryPolicy() *ApplicationWebhook_RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetSigning() *ApplicationWebhook_Signing {
+	if m != nil {
+		return m.Signing
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetOAuth2ClientCredentials() *ApplicationWebhook_OAuth2ClientCredentials {
+	if m != nil {
+		return m.OAuth2ClientCredentials
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetSecretsKeyID() string {
+	if m != nil {
+		return m.SecretsKeyID
+	}
+	return ""
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -732,6 +801,201 @@ func (m *ApplicationWebhook_Message) GetPath() string {
 	return ""
 }
 
+type ApplicationWebhook_RetryPolicy struct {
+	// Maximum number of delivery attempts, including the first one.
+	// If zero, the default of the Application Server is used.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Backoff before the first retry. The backoff doubles with every following attempt.
+	// If zero, the default of the Application Server is used.
+	InitialBackoff *time.Duration `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff,proto3,stdduration" json:"initial_backoff,omitempty"`
+	// Maximum backoff between attempts.
+	// If zero, the default of the Application Server is used.
+	MaxBackoff *time.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3,stdduration" json:"max_backoff,omitempty"`
+	// HTTP status codes of responses that are retried. Network errors and timeouts are always retried.
+	// If empty, the default of the Application Server is used.
+	RetryableStatusCodes []uint32 `protobuf:"varint,4,rep,packed,name=retryable_status_codes,json=retryableStatusCodes,proto3" json:"retryable_status_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhook_RetryPolicy) Reset()      { *m = ApplicationWebhook_RetryPolicy{} }
+func (*ApplicationWebhook_RetryPolicy) ProtoMessage() {}
+func (*ApplicationWebhook_RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 3}
+}
+func (m *ApplicationWebhook_RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhook_RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhook_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhook_RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhook_RetryPolicy.Merge(m, src)
+}
+func (m *ApplicationWebhook_RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhook_RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhook_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhook_RetryPolicy proto.InternalMessageInfo
+
+func (m *ApplicationWebhook_RetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhook_RetryPolicy) GetInitialBackoff() *time.Duration {
+	if m != nil {
+		return m.InitialBackoff
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook_RetryPolicy) GetMaxBackoff() *time.Duration {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook_RetryPolicy) GetRetryableStatusCodes() []uint32 {
+	if m != nil {
+		return m.RetryableStatusCodes
+	}
+	return nil
+}
+
+type ApplicationWebhook_Signing struct {
+	// The secret used to compute the HMAC-SHA256 signature of the requests.
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhook_Signing) Reset()      { *m = ApplicationWebhook_Signing{} }
+func (*ApplicationWebhook_Signing) ProtoMessage() {}
+func (*ApplicationWebhook_Signing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 4}
+}
+func (m *ApplicationWebhook_Signing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhook_Signing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhook_Signing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhook_Signing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhook_Signing.Merge(m, src)
+}
+func (m *ApplicationWebhook_Signing) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhook_Signing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhook_Signing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhook_Signing proto.InternalMessageInfo
+
+func (m *ApplicationWebhook_Signing) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type ApplicationWebhook_OAuth2ClientCredentials struct {
+	// The URL of the token endpoint of the authorization server.
+	TokenURL             string   `protobuf:"bytes,1,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	ClientID             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret         string   `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes               []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) Reset() {
+	*m = ApplicationWebhook_OAuth2ClientCredentials{}
+}
+func (*ApplicationWebhook_OAuth2ClientCredentials) ProtoMessage() {}
+func (*ApplicationWebhook_OAuth2ClientCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 5}
+}
+func (m *ApplicationWebhook_OAuth2ClientCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhook_OAuth2ClientCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhook_OAuth2ClientCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhook_OAuth2ClientCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhook_OAuth2ClientCredentials.Merge(m, src)
+}
+func (m *ApplicationWebhook_OAuth2ClientCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhook_OAuth2ClientCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhook_OAuth2ClientCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhook_OAuth2ClientCredentials proto.InternalMessageInfo
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) GetTokenURL() string {
+	if m != nil {
+		return m.TokenURL
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 type ApplicationWebhooks struct {
 	Webhooks             []*ApplicationWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -823,24 +1087,38 @@ func (m *ApplicationWebhookFormats) GetFormats() map[string]string {
 	return nil
 }
 
-type GetApplicationWebhookRequest struct {
+// ApplicationWebhookDelivery is a request to a webhook that failed to be delivered.
+type ApplicationWebhookDelivery struct {
 	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
-	FieldMask                     types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral          struct{}        `json:"-"`
-	XXX_sizecache                 int32           `json:"-"`
-}
-
-func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
-func (*GetApplicationWebhookRequest) ProtoMessage() {}
-func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+	ID                            string               `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	EndDeviceIDs                  EndDeviceIdentifiers `protobuf:"bytes,3,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids"`
+	CreatedAt                     time.Time            `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt                     time.Time            `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// The URL of the request.
+	URL string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	// The HTTP headers of the request.
+	Headers map[string]string `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The body of the request.
+	Body []byte `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	// Number of delivery attempts.
+	Attempts uint32 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The error of the last delivery attempt.
+	Error                *ErrorDetails `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationWebhookDelivery) Reset()      { *m = ApplicationWebhookDelivery{} }
+func (*ApplicationWebhookDelivery) ProtoMessage() {}
+func (*ApplicationWebhookDelivery) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2652f2d8eaceda0e, []int{8}
 }
-func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationWebhookDelivery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationWebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetApplicationWebhookRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationWebhookDelivery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -850,43 +1128,98 @@ func (m *GetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetApplicationWebhookRequest.Merge(m, src)
+func (m *ApplicationWebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDelivery.Merge(m, src)
 }
-func (m *GetApplicationWebhookRequest) XXX_Size() int {
+func (m *ApplicationWebhookDelivery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetApplicationWebhookRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetApplicationWebhookRequest.DiscardUnknown(m)
+func (m *ApplicationWebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDelivery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetApplicationWebhookRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationWebhookDelivery proto.InternalMessageInfo
 
-func (m *GetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationWebhookDelivery) GetID() string {
 	if m != nil {
-		return m.FieldMask
+		return m.ID
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-type ListApplicationWebhooksRequest struct {
-	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
-	XXX_sizecache          int32           `json:"-"`
+func (m *ApplicationWebhookDelivery) GetEndDeviceIDs() EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIDs
+	}
+	return EndDeviceIdentifiers{}
 }
 
-func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
-func (*ListApplicationWebhooksRequest) ProtoMessage() {}
-func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
+func (m *ApplicationWebhookDelivery) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookDelivery) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookDelivery) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookDelivery) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type ApplicationWebhookDeliveries struct {
+	Deliveries           []*ApplicationWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationWebhookDeliveries) Reset()      { *m = ApplicationWebhookDeliveries{} }
+func (*ApplicationWebhookDeliveries) ProtoMessage() {}
+func (*ApplicationWebhookDeliveries) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2652f2d8eaceda0e, []int{9}
 }
-func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationWebhookDeliveries) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListApplicationWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationWebhookDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListApplicationWebhooksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationWebhookDeliveries.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -896,43 +1229,46 @@ func (m *ListApplicationWebhooksRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *ListApplicationWebhooksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListApplicationWebhooksRequest.Merge(m, src)
+func (m *ApplicationWebhookDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDeliveries.Merge(m, src)
 }
-func (m *ListApplicationWebhooksRequest) XXX_Size() int {
+func (m *ApplicationWebhookDeliveries) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListApplicationWebhooksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListApplicationWebhooksRequest.DiscardUnknown(m)
+func (m *ApplicationWebhookDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDeliveries.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListApplicationWebhooksRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationWebhookDeliveries proto.InternalMessageInfo
 
-func (m *ListApplicationWebhooksRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationWebhookDeliveries) GetDeliveries() []*ApplicationWebhookDelivery {
 	if m != nil {
-		return m.FieldMask
+		return m.Deliveries
 	}
-	return types.FieldMask{}
+	return nil
 }
 
-type SetApplicationWebhookRequest struct {
-	ApplicationWebhook   `protobuf:"bytes,1,opt,name=webhook,proto3,embedded=webhook" json:"webhook"`
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ApplicationWebhookFailedDeliveriesRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// The IDs of the deliveries. If empty, all failed deliveries of the webhook are selected.
+	DeliveryIDs          []string `protobuf:"bytes,2,rep,name=delivery_ids,json=deliveryIds,proto3" json:"delivery_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
-func (*SetApplicationWebhookRequest) ProtoMessage() {}
-func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+func (m *ApplicationWebhookFailedDeliveriesRequest) Reset() {
+	*m = ApplicationWebhookFailedDeliveriesRequest{}
+}
+func (*ApplicationWebhookFailedDeliveriesRequest) ProtoMessage() {}
+func (*ApplicationWebhookFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2652f2d8eaceda0e, []int{10}
 }
-func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationWebhookFailedDeliveriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationWebhookFailedDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetApplicationWebhookRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationWebhookFailedDeliveriesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -942,8 +1278,146 @@ func (m *SetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *SetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetApplicationWebhookRequest.Merge(m, src)
+func (m *ApplicationWebhookFailedDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookFailedDeliveriesRequest.Merge(m, src)
+}
+func (m *ApplicationWebhookFailedDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookFailedDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookFailedDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookFailedDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ApplicationWebhookFailedDeliveriesRequest) GetDeliveryIDs() []string {
+	if m != nil {
+		return m.DeliveryIDs
+	}
+	return nil
+}
+
+type GetApplicationWebhookRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	FieldMask                     types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral          struct{}        `json:"-"`
+	XXX_sizecache                 int32           `json:"-"`
+}
+
+func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
+func (*GetApplicationWebhookRequest) ProtoMessage() {}
+func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{11}
+}
+func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetApplicationWebhookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationWebhookRequest.Merge(m, src)
+}
+func (m *GetApplicationWebhookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetApplicationWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationWebhookRequest proto.InternalMessageInfo
+
+func (m *GetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+type ListApplicationWebhooksRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
+func (*ListApplicationWebhooksRequest) ProtoMessage() {}
+func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{12}
+}
+func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListApplicationWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhooksRequest.Merge(m, src)
+}
+func (m *ListApplicationWebhooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationWebhooksRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhooksRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+type SetApplicationWebhookRequest struct {
+	ApplicationWebhook   `protobuf:"bytes,1,opt,name=webhook,proto3,embedded=webhook" json:"webhook"`
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
+func (*SetApplicationWebhookRequest) ProtoMessage() {}
+func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{13}
+}
+func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetApplicationWebhookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetApplicationWebhookRequest.Merge(m, src)
 }
 func (m *SetApplicationWebhookRequest) XXX_Size() int {
 	return m.Size()
@@ -971,7 +1445,7 @@ type GetApplicationWebhookTemplateRequest struct {
 func (m *GetApplicationWebhookTemplateRequest) Reset()      { *m = GetApplicationWebhookTemplateRequest{} }
 func (*GetApplicationWebhookTemplateRequest) ProtoMessage() {}
 func (*GetApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{11}
+	return fileDescriptor_2652f2d8eaceda0e, []int{14}
 }
 func (m *GetApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1492,7 @@ func (m *ListApplicationWebhookTemplatesRequest) Reset() {
 }
 func (*ListApplicationWebhookTemplatesRequest) ProtoMessage() {}
 func (*ListApplicationWebhookTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{12}
+	return fileDescriptor_2652f2d8eaceda0e, []int{15}
 }
 func (m *ListApplicationWebhookTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,12 +1551,26 @@ func init() {
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
 	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
 	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
+	proto.RegisterType((*ApplicationWebhook_RetryPolicy)(nil), "ttn.lorawan.v3.ApplicationWebhook.RetryPolicy")
+	golang_proto.RegisterType((*ApplicationWebhook_RetryPolicy)(nil), "ttn.lorawan.v3.ApplicationWebhook.RetryPolicy")
+	proto.RegisterType((*ApplicationWebhook_Signing)(nil), "ttn.lorawan.v3.ApplicationWebhook.Signing")
+	golang_proto.RegisterType((*ApplicationWebhook_Signing)(nil), "ttn.lorawan.v3.ApplicationWebhook.Signing")
+	proto.RegisterType((*ApplicationWebhook_OAuth2ClientCredentials)(nil), "ttn.lorawan.v3.ApplicationWebhook.OAuth2ClientCredentials")
+	golang_proto.RegisterType((*ApplicationWebhook_OAuth2ClientCredentials)(nil), "ttn.lorawan.v3.ApplicationWebhook.OAuth2ClientCredentials")
 	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
 	golang_proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
 	proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
 	golang_proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
+	proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	golang_proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery.HeadersEntry")
+	proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	golang_proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	proto.RegisterType((*ApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ApplicationWebhookFailedDeliveriesRequest")
+	golang_proto.RegisterType((*ApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ApplicationWebhookFailedDeliveriesRequest")
 	proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
 	golang_proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
 	proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
@@ -1103,124 +1591,169 @@ func init() {
 }
 
 var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1868 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0xe8, 0x8f, 0xe2, 0x50, 0x92, 0xe5, 0x91, 0x9c, 0x6c, 0x29, 0x67, 0x25, 0x6c, 0x5c,
-	0x47, 0x76, 0xcd, 0x65, 0x21, 0xc7, 0x6d, 0x23, 0xb4, 0x71, 0xc5, 0x30, 0x56, 0x54, 0xdb, 0x71,
-	0xbc, 0x8c, 0x13, 0x24, 0x46, 0x42, 0x8c, 0xb8, 0x43, 0x6a, 0xcb, 0xe5, 0xee, 0x66, 0x77, 0x28,
-	0x55, 0x0d, 0x8c, 0x1a, 0x3d, 0x19, 0xbd, 0x34, 0x68, 0x0e, 0xed, 0xa9, 0x08, 0xd0, 0x16, 0x48,
-	0x4f, 0x0d, 0x7a, 0xca, 0x31, 0x28, 0x5a, 0xc0, 0x47, 0x03, 0x3d, 0x34, 0x27, 0x35, 0x22, 0x7b,
-	0xc8, 0xa9, 0xc8, 0xd1, 0xf0, 0xa9, 0x98, 0xd9, 0x59, 0x72, 0x97, 0x3f, 0xd6, 0x92, 0xb2, 0x7b,
-	0x12, 0x67, 0xe7, 0xbd, 0xef, 0x7d, 0xef, 0xcd, 0xcc, 0xfb, 0x66, 0x57, 0x30, 0x6b, 0xda, 0x2e,
-	0xde, 0xc3, 0x56, 0xd6, 0xa3, 0xb8, 0x5c, 0xcb, 0x61, 0xc7, 0xc8, 0x61, 0xc7, 0x31, 0x8d, 0x32,
-	0xa6, 0x86, 0x6d, 0x79, 0xc4, 0xdd, 0x25, 0x6e, 0x69, 0x8f, 0x6c, 0xab, 0x8e, 0x6b, 0x53, 0x1b,
-	0xcd, 0x51, 0x6a, 0xa9, 0xc2, 0x45, 0xdd, 0xbd, 0x98, 0xd9, 0xa8, 0x1a, 0x74, 0xa7, 0xb1, 0xad,
-	0x96, 0xed, 0x7a, 0x8e, 0x58, 0xbb, 0xf6, 0xbe, 0xe3, 0xda, 0x3f, 0xdb, 0xcf, 0x71, 0xe3, 0x72,
-	0xb6, 0x4a, 0xac, 0xec, 0x2e, 0x36, 0x0d, 0x1d, 0x53, 0x92, 0xeb, 0xf9, 0xe1, 0x43, 0x66, 0xb2,
-	0x21, 0x88, 0xaa, 0x5d, 0xb5, 0x7d, 0xe7, 0xed, 0x46, 0x85, 0x8f, 0xf8, 0x80, 0xff, 0x12, 0xe6,
-	0xa7, 0xab, 0xb6, 0x5d, 0x35, 0x89, 0xcf, 0xd4, 0xb2, 0x6c, 0xea, 0x13, 0x15, 0xb3, 0x4b, 0x62,
-	0xb6, 0x8d, 0x41, 0xea, 0x0e, 0xdd, 0x17, 0x93, 0x2b, 0xdd, 0x93, 0x15, 0x83, 0x98, 0x7a, 0xa9,
-	0x8e, 0xbd, 0x9a, 0xb0, 0x58, 0xee, 0xb6, 0xa0, 0x46, 0x9d, 0x78, 0x14, 0xd7, 0x1d, 0x61, 0xf0,
-	0x7c, 0x6f, 0xb9, 0x0c, 0x9d, 0x58, 0xd4, 0xa8, 0x18, 0xc4, 0x15, 0x24, 0x94, 0x7f, 0x01, 0xf8,
-	0xdc, 0x46, 0xa7, 0x88, 0x6f, 0x93, 0xed, 0x1d, 0xdb, 0xae, 0x6d, 0x75, 0xec, 0x10, 0x86, 0x27,
-	0x42, 0x55, 0x2e, 0x19, 0xba, 0x27, 0x81, 0x15, 0xb0, 0x9a, 0x5e, 0x3b, 0xab, 0x46, 0x0b, 0xac,
-	0x86, 0x70, 0x42, 0x00, 0xf9, 0xf9, 0x47, 0xf9, 0xc9, 0x5f, 0x81, 0xb1, 0x79, 0x70, 0xff, 0x60,
-	0x39, 0xf1, 0xe0, 0x60, 0x19, 0x68, 0x73, 0x38, 0x6c, 0xe9, 0xa1, 0x22, 0x84, 0x7b, 0x7e, 0xe0,
-	0x92, 0xa1, 0x4b, 0x63, 0x2b, 0x60, 0x35, 0x95, 0x7f, 0xf1, 0x51, 0xfe, 0x8c, 0xab, 0x48, 0x67,
-	0xd6, 0xe4, 0xf7, 0x6f, 0xe3, 0xec, 0xcf, 0xbf, 0x9b, 0x7d, 0xe9, 0xbd, 0xd5, 0xcb, 0xeb, 0xb7,
-	0xb3, 0xef, 0x5d, 0x0e, 0x86, 0xe7, 0x3e, 0x5c, 0xbb, 0x70, 0xe7, 0x4c, 0xf3, 0x60, 0x39, 0x15,
-	0xb0, 0x2e, 0x68, 0xa9, 0xbd, 0x20, 0x01, 0xe5, 0x17, 0xf0, 0xdb, 0xbd, 0x89, 0xbd, 0x49, 0xea,
-	0x8e, 0x89, 0x29, 0x09, 0x27, 0xf8, 0x16, 0x4c, 0x53, 0xf1, 0x98, 0x85, 0x07, 0x3c, 0xfc, 0xa5,
-	0xf8, 0xe1, 0x61, 0x1b, 0xb4, 0xa0, 0x41, 0xda, 0x0e, 0xa0, 0xfc, 0x17, 0xc0, 0xe5, 0xc1, 0x0c,
-	0xae, 0xb0, 0xf5, 0x44, 0x3f, 0x82, 0x63, 0xed, 0x90, 0xd9, 0xf8, 0x21, 0xc7, 0xb6, 0x0a, 0xda,
-	0x98, 0xa1, 0xa3, 0x25, 0x38, 0x61, 0xe1, 0x3a, 0x11, 0x25, 0x4b, 0x3e, 0xca, 0x4f, 0xb8, 0x63,
-	0xd2, 0xa2, 0xc6, 0x1f, 0xa2, 0x73, 0x30, 0xad, 0x13, 0xaf, 0xec, 0x1a, 0x0e, 0x0b, 0x2f, 0x8d,
-	0x87, 0x6d, 0x74, 0x2d, 0x3c, 0x87, 0x9e, 0x81, 0x53, 0x1e, 0x29, 0xbb, 0x84, 0x4a, 0x13, 0x2b,
-	0x60, 0x75, 0x5a, 0x13, 0x23, 0x74, 0x01, 0xce, 0xea, 0xa4, 0x82, 0x1b, 0x26, 0x2d, 0xed, 0x62,
-	0xb3, 0x41, 0xa4, 0xc9, 0x28, 0xc8, 0x8c, 0x98, 0x7d, 0x8b, 0x4d, 0x2a, 0xff, 0x98, 0x85, 0x99,
-	0xc1, 0x09, 0xa3, 0x77, 0xe0, 0x78, 0x67, 0xf3, 0x5c, 0x7a, 0xcc, 0xe6, 0x19, 0xbc, 0x56, 0x7d,
-	0xf6, 0x12, 0xc3, 0x7c, 0x62, 0x75, 0x50, 0xe1, 0xb4, 0x69, 0x57, 0xed, 0x52, 0xc3, 0x35, 0x79,
-	0x25, 0x52, 0xf9, 0x85, 0x47, 0xf9, 0x49, 0x77, 0xfc, 0x1e, 0x00, 0xcd, 0x83, 0xe5, 0xe4, 0x35,
-	0xbb, 0x6a, 0xdf, 0xd2, 0xae, 0x69, 0x49, 0x66, 0x74, 0xcb, 0x35, 0x99, 0xbd, 0x61, 0x55, 0x7c,
-	0xfb, 0xc9, 0x5e, 0xfb, 0x2d, 0xab, 0xe2, 0xdb, 0x33, 0x23, 0x66, 0xbf, 0x05, 0x4f, 0xea, 0x76,
-	0xb9, 0x51, 0x27, 0x96, 0xdf, 0x0a, 0xb8, 0xe3, 0x14, 0x77, 0x3c, 0x1d, 0x72, 0x9c, 0x2f, 0x84,
-	0x8d, 0x18, 0xc2, 0x7c, 0xc4, 0x4d, 0x84, 0xde, 0xc6, 0x1e, 0xe1, 0x08, 0xc9, 0xde, 0xd0, 0x79,
-	0xec, 0x11, 0x1e, 0x9a, 0x19, 0x31, 0xfb, 0x9b, 0x30, 0xb9, 0x43, 0xb0, 0x4e, 0x5c, 0x4f, 0x9a,
-	0x5e, 0x19, 0x5f, 0x4d, 0xaf, 0x7d, 0x3f, 0xfe, 0x0a, 0xa8, 0xaf, 0xf9, 0x9e, 0xaf, 0x5a, 0xd4,
-	0xdd, 0xd7, 0x02, 0x1c, 0x74, 0x19, 0x4e, 0x55, 0x6c, 0xb7, 0x8e, 0xa9, 0x94, 0xe2, 0x04, 0x5e,
-	0xf0, 0x37, 0xf0, 0xe2, 0x51, 0x1b, 0x58, 0x13, 0x6e, 0x68, 0x13, 0x4e, 0xf1, 0xb6, 0xe6, 0x49,
-	0x90, 0x53, 0xca, 0xc5, 0xa7, 0xc4, 0x8f, 0x8f, 0x26, 0xdc, 0xd1, 0x0d, 0xf8, 0x6c, 0xd9, 0x25,
-	0xec, 0x00, 0xeb, 0xf6, 0x9e, 0x65, 0x1a, 0x56, 0xad, 0x84, 0x1d, 0xa3, 0x54, 0x23, 0xfb, 0xd2,
-	0x02, 0xdb, 0xd0, 0x79, 0xa9, 0x79, 0xb0, 0xbc, 0xf8, 0x0a, 0x37, 0x29, 0x08, 0x8b, 0x8d, 0x37,
-	0xb6, 0xae, 0x92, 0x7d, 0x6d, 0xb1, 0x1c, 0x7d, 0xea, 0x18, 0x57, 0xc9, 0x3e, 0x7a, 0x07, 0xce,
-	0x35, 0x1c, 0x8e, 0x53, 0x27, 0x9e, 0x87, 0xab, 0x44, 0x4a, 0xf3, 0x6d, 0xbb, 0x36, 0x44, 0xd1,
-	0xae, 0xfb, 0x9e, 0xda, 0xac, 0x8f, 0x24, 0x86, 0xa8, 0x08, 0xd3, 0x3f, 0xb5, 0x0d, 0xab, 0x84,
-	0xcb, 0x65, 0xe2, 0x50, 0x69, 0x66, 0x64, 0x5c, 0xc8, 0x60, 0x36, 0x38, 0x0a, 0xba, 0x05, 0x67,
-	0x3a, 0x99, 0x97, 0x6b, 0xd2, 0xec, 0xc8, 0xa8, 0xe9, 0x00, 0x67, 0xa3, 0x5c, 0x43, 0x6f, 0xc3,
-	0xd9, 0x36, 0xac, 0xc5, 0x70, 0xe7, 0x46, 0xc6, 0x6d, 0xf3, 0x7b, 0x1d, 0x77, 0x01, 0x7b, 0xc4,
-	0xa2, 0xd2, 0x89, 0xe3, 0x03, 0x17, 0x89, 0x45, 0xd1, 0x6d, 0x78, 0xa2, 0x0d, 0x5c, 0xc1, 0x86,
-	0x49, 0x74, 0x69, 0x7e, 0x64, 0xe8, 0xb9, 0x00, 0xea, 0x0a, 0x47, 0x8a, 0x80, 0x7f, 0xd0, 0x20,
-	0x0d, 0xa2, 0x4b, 0x27, 0x8f, 0x0f, 0x7e, 0x93, 0x23, 0x21, 0x07, 0x66, 0xa2, 0xe0, 0x25, 0xc3,
-	0x0a, 0xae, 0x1f, 0xba, 0x74, 0x6a, 0xe4, 0x38, 0x52, 0x24, 0xce, 0x56, 0x07, 0x93, 0xa5, 0x63,
-	0xda, 0x42, 0xd6, 0x3d, 0xdb, 0xdc, 0x25, 0xba, 0x84, 0x46, 0x4f, 0x27, 0x80, 0x2a, 0x72, 0x24,
-	0xb6, 0x23, 0xd9, 0x8d, 0xcc, 0x28, 0x93, 0x92, 0x8e, 0x29, 0x96, 0x16, 0x47, 0xdf, 0x91, 0x02,
-	0xa7, 0x80, 0x29, 0xce, 0xac, 0xc3, 0x99, 0x70, 0x33, 0x42, 0xf3, 0x70, 0x9c, 0x9d, 0x72, 0xae,
-	0xa0, 0x1a, 0xfb, 0x89, 0x16, 0xe1, 0xa4, 0xaf, 0x55, 0x5c, 0x0c, 0x34, 0x7f, 0xb0, 0x3e, 0xf6,
-	0x03, 0x90, 0x39, 0x0b, 0x93, 0xc1, 0x21, 0x5c, 0x82, 0x13, 0x0e, 0xa6, 0x3b, 0x42, 0x79, 0x85,
-	0x18, 0xfc, 0x58, 0xe3, 0x0f, 0x95, 0x2a, 0x5c, 0x1a, 0x4c, 0xcb, 0x43, 0xaf, 0xc1, 0x54, 0xa0,
-	0xf2, 0x4c, 0xcd, 0x58, 0xe3, 0x3a, 0x1f, 0x3f, 0x2d, 0xad, 0xe3, 0xac, 0xfc, 0x71, 0x16, 0xa2,
-	0x5e, 0x4b, 0x74, 0x33, 0x2c, 0x94, 0xd9, 0xa3, 0xa1, 0x63, 0x08, 0xe4, 0x2b, 0x10, 0xfa, 0x7d,
-	0x4e, 0x2f, 0x61, 0xca, 0x2b, 0x93, 0x5e, 0xcb, 0xa8, 0xfe, 0x0d, 0x52, 0x0d, 0x6e, 0x90, 0xea,
-	0x9b, 0xc1, 0x0d, 0x32, 0x3f, 0xcd, 0xdc, 0x3f, 0xfa, 0xf7, 0x32, 0xd0, 0x52, 0xc2, 0x6f, 0x83,
-	0x32, 0x90, 0x86, 0xa3, 0x07, 0x20, 0xe3, 0xc3, 0x80, 0x08, 0xbf, 0x0d, 0x1a, 0xd1, 0xad, 0x89,
-	0x18, 0xba, 0xb5, 0xd5, 0xd1, 0xad, 0xc9, 0xb8, 0x22, 0x71, 0xa4, 0x5e, 0x4d, 0x8d, 0xa6, 0x57,
-	0xef, 0xc3, 0x99, 0xd0, 0x4d, 0xd1, 0x13, 0x4d, 0x6b, 0xc4, 0xab, 0xcc, 0x04, 0x5f, 0x9d, 0x74,
-	0xe7, 0xc2, 0xe8, 0xa1, 0x12, 0x3c, 0xd1, 0xc6, 0x17, 0xc2, 0x38, 0xcf, 0x73, 0xfe, 0x5e, 0x8c,
-	0x9c, 0x23, 0xca, 0x28, 0x52, 0x9f, 0xa3, 0x91, 0x87, 0xa8, 0x00, 0xe7, 0x7b, 0x04, 0xf2, 0x24,
-	0xaf, 0x45, 0x86, 0x2f, 0x82, 0x74, 0x97, 0x2d, 0xc2, 0x5c, 0x97, 0x44, 0xb6, 0x3b, 0x95, 0x10,
-	0xc7, 0x9b, 0x3d, 0xe2, 0x98, 0xe4, 0x85, 0x88, 0x71, 0x0a, 0x06, 0x89, 0xe2, 0xd5, 0xa8, 0x28,
-	0x4e, 0x0f, 0x8d, 0x17, 0x16, 0xc3, 0xeb, 0x5d, 0x62, 0x98, 0x1a, 0x1a, 0x2d, 0x22, 0x82, 0x37,
-	0xba, 0x45, 0x10, 0x0e, 0x8d, 0x17, 0x15, 0xbf, 0x1b, 0xdd, 0xe2, 0x97, 0x1e, 0x1d, 0x90, 0x8b,
-	0x5e, 0xb1, 0x57, 0xf4, 0x66, 0x86, 0x86, 0xec, 0x16, 0xbb, 0x62, 0xaf, 0xd8, 0xcd, 0x8e, 0x0e,
-	0x2a, 0x44, 0x6e, 0xe7, 0xb1, 0x22, 0xb7, 0x30, 0x34, 0xfe, 0x60, 0x71, 0x2b, 0xf6, 0x8a, 0xdb,
-	0xdc, 0xf0, 0xf4, 0xbb, 0x44, 0xed, 0x7a, 0x97, 0xa8, 0xa1, 0xe1, 0x77, 0xd6, 0x93, 0x12, 0xb3,
-	0x0d, 0xb8, 0xd0, 0xe7, 0xc4, 0x3f, 0x15, 0x3d, 0xbc, 0x05, 0x17, 0x7a, 0x33, 0xf2, 0xd0, 0xcb,
-	0x70, 0x5a, 0xbc, 0x6d, 0x07, 0x32, 0xa8, 0x1c, 0x5d, 0x08, 0xad, 0xed, 0xa3, 0xfc, 0x19, 0xc0,
-	0x6f, 0xf5, 0x1a, 0x5c, 0xe1, 0xad, 0xd6, 0x43, 0x6f, 0xc0, 0xa4, 0xdf, 0x75, 0x03, 0xf0, 0x18,
-	0x3d, 0x50, 0xf8, 0xaa, 0xe2, 0xaf, 0x68, 0xff, 0x02, 0x86, 0x55, 0x3b, 0x3c, 0x31, 0x4c, 0xa9,
-	0x94, 0xbf, 0x02, 0x78, 0x7a, 0x93, 0xd0, 0x3e, 0xf9, 0x90, 0x0f, 0x1a, 0xc4, 0xa3, 0x4f, 0x43,
-	0xb3, 0x2f, 0x43, 0xd8, 0xf9, 0xe8, 0x33, 0x50, 0xb3, 0xf9, 0xe2, 0x5f, 0xc7, 0x5e, 0x2d, 0x3f,
-	0xc1, 0xdc, 0xb5, 0x54, 0x25, 0x78, 0xa0, 0xfc, 0x1d, 0x40, 0xf9, 0x9a, 0xe1, 0xf5, 0x61, 0xed,
-	0x05, 0xb4, 0xff, 0x0f, 0x1f, 0x77, 0x8e, 0x9d, 0xc6, 0x5f, 0x00, 0x3c, 0x5d, 0x7c, 0x5c, 0xed,
-	0x5f, 0x87, 0x49, 0xb1, 0xa9, 0x04, 0xf9, 0x18, 0xfb, 0xb0, 0x0f, 0xf1, 0x00, 0xe4, 0xf8, 0x8c,
-	0xff, 0x06, 0xe0, 0x99, 0xbe, 0xbb, 0xa5, 0x7d, 0x09, 0x14, 0xcc, 0x9f, 0xe2, 0x27, 0x91, 0x63,
-	0x27, 0x61, 0xc0, 0xb3, 0xfd, 0x37, 0x4f, 0xfb, 0x26, 0x1c, 0x64, 0x11, 0x0d, 0x05, 0x86, 0x0e,
-	0xb5, 0xf6, 0xeb, 0x54, 0xbf, 0x0f, 0x47, 0x1a, 0xa9, 0x1a, 0x1e, 0x3b, 0xa8, 0x26, 0x84, 0x9b,
-	0x84, 0x06, 0x8d, 0xe1, 0x99, 0x1e, 0xe4, 0x57, 0xeb, 0x0e, 0xdd, 0xcf, 0x9c, 0x8b, 0xdd, 0x1f,
-	0x94, 0xa5, 0x5f, 0xfe, 0xf3, 0x3f, 0x1f, 0x8f, 0x9d, 0x42, 0x0b, 0x39, 0xec, 0xe5, 0xc4, 0xaa,
-	0x67, 0x45, 0x9b, 0x40, 0x9f, 0x00, 0x98, 0xde, 0x24, 0xb4, 0xfd, 0xd9, 0xea, 0xc5, 0x6e, 0xdc,
-	0x38, 0x2b, 0x9b, 0x19, 0xe2, 0x8d, 0x40, 0xc9, 0x71, 0x3a, 0xe7, 0xd0, 0x0b, 0x61, 0x3a, 0xed,
-	0xb7, 0x84, 0xdc, 0x87, 0x86, 0xee, 0xa9, 0xa1, 0x7b, 0xe7, 0x1d, 0xf4, 0x31, 0x80, 0xb3, 0x6c,
-	0x6d, 0x3a, 0xef, 0x24, 0x3d, 0xcd, 0x31, 0xde, 0xd2, 0x65, 0xbe, 0x13, 0x9f, 0xa6, 0xa7, 0x3c,
-	0xc7, 0x79, 0x3e, 0x8b, 0x4e, 0xf5, 0xe5, 0x89, 0xfe, 0x00, 0xe0, 0xf8, 0x26, 0xa1, 0xe8, 0x42,
-	0xac, 0x82, 0x05, 0x0c, 0x62, 0x9c, 0x55, 0xe5, 0x27, 0x3c, 0x70, 0x01, 0xe5, 0x43, 0x81, 0x45,
-	0x5d, 0xba, 0xba, 0x57, 0xd7, 0xf8, 0x8e, 0x6f, 0xd4, 0xf9, 0xb8, 0x7c, 0x07, 0xfd, 0x06, 0xc0,
-	0x09, 0x56, 0x1c, 0xa4, 0xc6, 0x2b, 0x59, 0xbb, 0x54, 0xcf, 0x1f, 0x4d, 0xd4, 0x53, 0x2e, 0x71,
-	0xa6, 0x39, 0x94, 0x8d, 0x32, 0x3d, 0x82, 0x25, 0x7a, 0x08, 0xe0, 0x78, 0xb1, 0x5f, 0xe9, 0x8a,
-	0xc7, 0x2d, 0xdd, 0xef, 0x01, 0x67, 0xf4, 0x5b, 0x90, 0xd1, 0xa2, 0x94, 0xc4, 0x2f, 0x35, 0x56,
-	0x11, 0xc3, 0xc6, 0xa1, 0x62, 0xae, 0x83, 0xf3, 0xef, 0xbe, 0xac, 0xbc, 0x34, 0x32, 0xf0, 0x3a,
-	0x38, 0xcf, 0xf6, 0xf2, 0x54, 0x81, 0x98, 0x84, 0x12, 0x34, 0x9c, 0x6c, 0x66, 0x06, 0x34, 0x02,
-	0x25, 0xcf, 0x33, 0xfe, 0xe1, 0xf9, 0xf5, 0xa1, 0xd6, 0xa0, 0x4d, 0x9c, 0x0d, 0xf2, 0x7f, 0x02,
-	0xf7, 0x0f, 0x65, 0xf0, 0xe0, 0x50, 0x06, 0x5f, 0x1e, 0xca, 0x89, 0xaf, 0x0e, 0xe5, 0xc4, 0xd7,
-	0x87, 0x72, 0xe2, 0x9b, 0x43, 0x39, 0xf1, 0xf0, 0x50, 0x06, 0x77, 0x9b, 0x32, 0xb8, 0xd7, 0x94,
-	0x13, 0x9f, 0x36, 0x65, 0xf0, 0x59, 0x53, 0x4e, 0x7c, 0xde, 0x94, 0x13, 0x5f, 0x34, 0xe5, 0xc4,
-	0xfd, 0xa6, 0x0c, 0x1e, 0x34, 0x65, 0xf0, 0x65, 0x53, 0x4e, 0x7c, 0xd5, 0x94, 0xc1, 0xd7, 0x4d,
-	0x39, 0xf1, 0x4d, 0x53, 0x06, 0x0f, 0x9b, 0x72, 0xe2, 0x6e, 0x4b, 0x4e, 0xdc, 0x6b, 0xc9, 0xe0,
-	0xa3, 0x96, 0x9c, 0xf8, 0x5d, 0x4b, 0x06, 0x9f, 0xb4, 0xe4, 0xc4, 0xa7, 0x2d, 0x39, 0xf1, 0x59,
-	0x4b, 0x06, 0x9f, 0xb7, 0x64, 0xf0, 0x45, 0x4b, 0x06, 0xef, 0xe6, 0xaa, 0xb6, 0x4a, 0x77, 0x08,
-	0xdd, 0x31, 0xac, 0xaa, 0xa7, 0x5a, 0x84, 0xee, 0xd9, 0x6e, 0x2d, 0x17, 0xfd, 0x3f, 0xce, 0xee,
-	0xc5, 0x9c, 0x53, 0xab, 0xe6, 0x28, 0xb5, 0x9c, 0xed, 0xed, 0x29, 0x9e, 0xfb, 0xc5, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x02, 0xc3, 0x57, 0xd3, 0x1b, 0x1b, 0x00, 0x00,
+	// 2589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xbd, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xf6, 0x92, 0xa2, 0x48, 0x0d, 0x7f, 0x24, 0x8f, 0x64, 0x79, 0x4d, 0xcb, 0xb2, 0xb1, 0x71,
+	0x1c, 0x5b, 0x35, 0xc9, 0x56, 0x8e, 0xdb, 0x5a, 0x68, 0x63, 0x93, 0xa2, 0xed, 0x28, 0xb1, 0x63,
+	0x7b, 0x69, 0x3b, 0x68, 0xdc, 0x84, 0x5d, 0x71, 0x47, 0xd4, 0x46, 0xd4, 0x2e, 0xbb, 0xbb, 0x94,
+	0xc2, 0x04, 0x46, 0x8d, 0x9e, 0xdc, 0xa2, 0x07, 0xc3, 0x39, 0x34, 0xa7, 0x22, 0x40, 0x51, 0x20,
+	0x3d, 0x35, 0xe8, 0x29, 0xc7, 0xa0, 0x68, 0x01, 0x1f, 0x0d, 0x04, 0x6d, 0x72, 0xa9, 0x9b, 0x38,
+	0x3d, 0xe4, 0x54, 0xe4, 0x68, 0xe8, 0xd4, 0x37, 0xb3, 0xb3, 0xcb, 0xe5, 0x9f, 0xb5, 0xa4, 0xac,
+	0x1c, 0x16, 0xcb, 0xd9, 0x79, 0xef, 0x7b, 0x6f, 0xde, 0xbc, 0x99, 0xef, 0xed, 0x2c, 0x51, 0xa6,
+	0x66, 0x98, 0xca, 0xa6, 0xa2, 0x67, 0x2c, 0x5b, 0xa9, 0xac, 0xe5, 0x94, 0xba, 0x06, 0x57, 0xbd,
+	0xa6, 0x55, 0x14, 0x5b, 0x33, 0x74, 0x8b, 0x98, 0x1b, 0xc4, 0x2c, 0x6f, 0x92, 0xe5, 0x6c, 0xdd,
+	0x34, 0x6c, 0x03, 0xa7, 0x6c, 0x5b, 0xcf, 0x72, 0x95, 0xec, 0xc6, 0xa9, 0x74, 0xbe, 0xaa, 0xd9,
+	0xab, 0x8d, 0xe5, 0x6c, 0xc5, 0x58, 0xcf, 0x11, 0x7d, 0xc3, 0x68, 0x82, 0xd8, 0x3b, 0xcd, 0x1c,
+	0x13, 0xae, 0x64, 0xaa, 0x44, 0xcf, 0x6c, 0x28, 0x35, 0x4d, 0x55, 0x6c, 0x92, 0xeb, 0xfa, 0xe1,
+	0x40, 0xa6, 0x33, 0x3e, 0x88, 0xaa, 0x51, 0x35, 0x1c, 0xe5, 0xe5, 0xc6, 0x0a, 0x6b, 0xb1, 0x06,
+	0xfb, 0xc5, 0xc5, 0x67, 0xaa, 0x86, 0x51, 0xad, 0x11, 0xc7, 0x53, 0x5d, 0x37, 0x6c, 0xc7, 0x51,
+	0xde, 0x3b, 0xcb, 0x7b, 0x3d, 0x0c, 0xb5, 0x61, 0x32, 0x01, 0xde, 0x7f, 0xb0, 0xb3, 0x9f, 0xac,
+	0xd7, 0xed, 0x26, 0xef, 0x3c, 0xd2, 0xd9, 0xb9, 0xa2, 0x91, 0x9a, 0x5a, 0x5e, 0x57, 0xac, 0x35,
+	0x2e, 0x71, 0xb8, 0x53, 0xc2, 0xd6, 0xd6, 0x09, 0x44, 0x6e, 0xbd, 0xce, 0x05, 0x0e, 0x75, 0x87,
+	0x93, 0x98, 0xa6, 0x61, 0xf2, 0xee, 0xe7, 0xba, 0xbb, 0x35, 0x95, 0xe8, 0xb6, 0x06, 0x96, 0x4c,
+	0x3e, 0x06, 0xe9, 0x73, 0x01, 0x1d, 0xca, 0xb7, 0xe6, 0xe0, 0x75, 0xb2, 0xbc, 0x6a, 0x18, 0x6b,
+	0x4b, 0x2d, 0x39, 0xac, 0xa0, 0x71, 0xdf, 0x24, 0x95, 0x35, 0xd5, 0x12, 0x85, 0x23, 0xc2, 0xf1,
+	0xf8, 0xfc, 0xb1, 0x6c, 0xfb, 0xfc, 0x64, 0x7d, 0x38, 0x3e, 0x80, 0xc2, 0xc4, 0x56, 0x21, 0xf2,
+	0x5b, 0x21, 0x34, 0x21, 0x3c, 0x78, 0x74, 0x78, 0xcf, 0xc3, 0x47, 0x87, 0x05, 0x39, 0xa5, 0xf8,
+	0x25, 0x2d, 0x5c, 0x42, 0x68, 0xd3, 0x31, 0x0c, 0xf0, 0x62, 0x08, 0xd0, 0xc7, 0x0a, 0x2f, 0x6e,
+	0x15, 0x8e, 0x9a, 0x92, 0x78, 0x74, 0x7e, 0xf6, 0xad, 0x5b, 0x4a, 0xe6, 0xdd, 0xef, 0x67, 0xce,
+	0xbc, 0x79, 0xfc, 0xec, 0xc2, 0xad, 0xcc, 0x9b, 0x67, 0xdd, 0xe6, 0x89, 0xf7, 0xe6, 0x4f, 0xde,
+	0x3e, 0xfa, 0xf8, 0xd1, 0xe1, 0x31, 0xd7, 0xeb, 0xa2, 0x3c, 0xb6, 0xe9, 0x0e, 0x40, 0xfa, 0x15,
+	0x7a, 0xbe, 0x7b, 0x60, 0xd7, 0x61, 0x0a, 0x6a, 0x90, 0x0e, 0xfe, 0x01, 0xde, 0x44, 0x71, 0x9b,
+	0x3f, 0xa6, 0xe6, 0x05, 0x66, 0xfe, 0x74, 0x70, 0xf3, 0xc8, 0x03, 0x2d, 0xca, 0xc8, 0xf6, 0x0c,
+	0x48, 0xff, 0x13, 0xd0, 0xe1, 0xfe, 0x1e, 0x5c, 0xa0, 0xd3, 0x8d, 0x7f, 0x8a, 0x42, 0x9e, 0xc9,
+	0x4c, 0x70, 0x93, 0x21, 0x30, 0x05, 0x8a, 0xf8, 0x20, 0x1a, 0xd1, 0x95, 0x75, 0xc2, 0x43, 0x16,
+	0xdd, 0x2a, 0x8c, 0x98, 0x21, 0x71, 0x4a, 0x66, 0x0f, 0xf1, 0x09, 0x14, 0x57, 0x89, 0x55, 0x31,
+	0xb5, 0x3a, 0x35, 0x2f, 0x86, 0xfd, 0x32, 0xaa, 0xec, 0xef, 0xc3, 0xd3, 0x68, 0xd4, 0x22, 0x15,
+	0x93, 0xd8, 0xe2, 0x08, 0x48, 0xc5, 0x64, 0xde, 0xc2, 0x27, 0x51, 0x52, 0x25, 0x2b, 0x4a, 0xa3,
+	0x66, 0x97, 0x61, 0x21, 0x35, 0x88, 0x18, 0x69, 0x07, 0x49, 0xf0, 0xde, 0x9b, 0xb4, 0x53, 0xfa,
+	0x47, 0x12, 0xa5, 0xfb, 0x0f, 0x18, 0xff, 0x0c, 0x85, 0x5b, 0xc9, 0x73, 0xfa, 0x29, 0xc9, 0xd3,
+	0x7f, 0xae, 0x7a, 0xe4, 0x12, 0xc5, 0x7c, 0x66, 0x71, 0xc8, 0xa2, 0x58, 0x0d, 0x56, 0x7f, 0xb9,
+	0x61, 0xd6, 0x58, 0x24, 0xc6, 0x0a, 0x93, 0x60, 0xd0, 0x0c, 0xdf, 0x15, 0x04, 0x88, 0x7a, 0xf4,
+	0x12, 0xf4, 0xdd, 0x90, 0x2f, 0xc9, 0x51, 0x2a, 0x74, 0xc3, 0xac, 0x51, 0x79, 0x4d, 0x5f, 0x71,
+	0xe4, 0x23, 0xdd, 0xf2, 0x4b, 0xd0, 0xc7, 0xe4, 0xa9, 0x10, 0x95, 0x5f, 0x42, 0x7b, 0x55, 0xa3,
+	0xd2, 0x58, 0x87, 0x01, 0x39, 0xab, 0x89, 0x2a, 0x8e, 0x32, 0xc5, 0x19, 0x9f, 0xe2, 0x44, 0xd1,
+	0x2f, 0x44, 0x11, 0x26, 0xda, 0xd4, 0xb8, 0xe9, 0x65, 0xc5, 0x22, 0x0c, 0x21, 0xda, 0x6d, 0xba,
+	0x00, 0x7d, 0xcc, 0x34, 0x15, 0xa2, 0xf2, 0xd7, 0x50, 0x74, 0x95, 0x28, 0x2a, 0x04, 0x51, 0x8c,
+	0x1d, 0x09, 0xc3, 0x0c, 0xfc, 0x28, 0xf8, 0x0c, 0x64, 0x5f, 0x76, 0x34, 0xcf, 0xeb, 0xb6, 0xd9,
+	0x94, 0x5d, 0x1c, 0x7c, 0x16, 0x8d, 0xae, 0x18, 0xe6, 0xba, 0x62, 0x8b, 0x63, 0xcc, 0x81, 0x17,
+	0x9c, 0x04, 0x9e, 0xda, 0x2e, 0x81, 0x65, 0xae, 0x86, 0x2f, 0x02, 0x00, 0x5d, 0x06, 0x96, 0x88,
+	0x98, 0x4b, 0xb9, 0xe0, 0x2e, 0xb1, 0xe5, 0x23, 0x73, 0x75, 0x7c, 0x05, 0xed, 0x87, 0x7c, 0xa5,
+	0x0b, 0x58, 0x35, 0x36, 0xf5, 0x9a, 0xa6, 0xaf, 0x95, 0x61, 0xbb, 0x2b, 0xaf, 0x91, 0xa6, 0x38,
+	0x49, 0x13, 0xba, 0x20, 0x42, 0x4c, 0xa6, 0x16, 0x99, 0x48, 0x91, 0x4b, 0xe4, 0xaf, 0x2e, 0xbd,
+	0x4a, 0x9a, 0xf2, 0x54, 0xa5, 0xfd, 0x69, 0x5d, 0x83, 0xa7, 0x90, 0xab, 0xa9, 0x46, 0x9d, 0xe1,
+	0xc0, 0x96, 0x6b, 0x29, 0x55, 0x22, 0xc6, 0x59, 0xda, 0xce, 0x0f, 0x10, 0xb4, 0xcb, 0x8e, 0xa6,
+	0x9c, 0x74, 0x90, 0x78, 0x13, 0x36, 0xbb, 0xf8, 0xdb, 0x86, 0xa6, 0x97, 0x95, 0x4a, 0x85, 0xd4,
+	0x6d, 0x31, 0x31, 0x34, 0x2e, 0xa2, 0x30, 0x79, 0x86, 0x82, 0x6f, 0xa0, 0x44, 0x6b, 0xe4, 0x95,
+	0x35, 0x31, 0x39, 0x34, 0x6a, 0xdc, 0xc5, 0xc9, 0x57, 0xd6, 0xf0, 0xeb, 0xb0, 0xfe, 0x5d, 0x58,
+	0x9d, 0xe2, 0xa6, 0x86, 0xc6, 0xf5, 0xfc, 0x7b, 0x4d, 0xe9, 0x00, 0xb6, 0x20, 0xad, 0xc5, 0xf1,
+	0x9d, 0x03, 0x97, 0x00, 0x07, 0xdf, 0x42, 0xe3, 0x1e, 0xf0, 0x8a, 0xa2, 0xd5, 0x88, 0x2a, 0x4e,
+	0x0c, 0x0d, 0x9d, 0x72, 0xa1, 0x2e, 0x30, 0xa4, 0x36, 0xf0, 0x5f, 0x36, 0x48, 0x03, 0xc0, 0xf7,
+	0xee, 0x1c, 0xfc, 0x1a, 0x43, 0xc2, 0x75, 0x94, 0x6e, 0x07, 0x2f, 0x6b, 0xba, 0x5b, 0xbd, 0xa8,
+	0xe2, 0xbe, 0xa1, 0xed, 0x88, 0x6d, 0x76, 0x96, 0x5a, 0x98, 0x74, 0x38, 0x35, 0x83, 0xd3, 0xba,
+	0x65, 0xd4, 0x36, 0xc0, 0x0c, 0x1e, 0x7e, 0x38, 0x2e, 0x54, 0x89, 0x21, 0xd1, 0x8c, 0xa4, 0x05,
+	0x9d, 0x56, 0x81, 0x35, 0xa9, 0xd8, 0x8a, 0x38, 0x35, 0x7c, 0x46, 0x72, 0x9c, 0x22, 0xc0, 0xa4,
+	0x17, 0x50, 0xc2, 0xbf, 0x19, 0xe1, 0x09, 0x14, 0xa6, 0xab, 0x9c, 0x31, 0xa8, 0x4c, 0x7f, 0xe2,
+	0x29, 0x14, 0x71, 0xb8, 0x8a, 0x91, 0x81, 0xec, 0x34, 0x16, 0x42, 0x3f, 0x16, 0xd2, 0xc7, 0x50,
+	0xd4, 0x5d, 0x84, 0x40, 0x18, 0x75, 0xc5, 0x5e, 0xe5, 0xcc, 0xcb, 0xc9, 0xe0, 0x9c, 0xcc, 0x1e,
+	0x4a, 0x55, 0x74, 0xb0, 0xbf, 0x5b, 0x16, 0x7e, 0x19, 0x8d, 0xb9, 0x2c, 0x4f, 0xd9, 0x8c, 0x6e,
+	0x5c, 0x73, 0xc1, 0x87, 0x25, 0xb7, 0x94, 0xa5, 0x7f, 0xed, 0x43, 0xb8, 0x5b, 0x12, 0xb6, 0x6a,
+	0x1f, 0x51, 0x66, 0xb6, 0x87, 0x0e, 0x40, 0x90, 0x8b, 0x08, 0x39, 0xfb, 0x9c, 0x5a, 0x86, 0xed,
+	0x3a, 0xc4, 0x90, 0xd3, 0x59, 0xa7, 0xc0, 0xcc, 0xba, 0x05, 0x66, 0xf6, 0xba, 0x5b, 0x60, 0x16,
+	0x62, 0x54, 0xfd, 0xde, 0x7f, 0x40, 0x7d, 0x8c, 0xeb, 0xe5, 0x6d, 0x0a, 0xd2, 0xa8, 0xab, 0x2e,
+	0x48, 0x78, 0x10, 0x10, 0xae, 0x07, 0x20, 0x7e, 0xde, 0x1a, 0x09, 0xc0, 0x5b, 0x4b, 0x2d, 0xde,
+	0x8a, 0x04, 0x25, 0x89, 0x6d, 0xf9, 0x6a, 0x74, 0x38, 0xbe, 0x7a, 0x0b, 0x25, 0x7c, 0x95, 0xa2,
+	0xc5, 0x37, 0xad, 0x21, 0x4b, 0x99, 0x11, 0x36, 0x3b, 0xf1, 0x56, 0xc1, 0x68, 0xe1, 0x32, 0x1a,
+	0xf7, 0xf0, 0x39, 0x31, 0x4e, 0xb0, 0x31, 0xff, 0x30, 0xc0, 0x98, 0xdb, 0x98, 0x91, 0x0f, 0x3d,
+	0x65, 0xb7, 0x3d, 0xc4, 0x45, 0x34, 0xd1, 0x45, 0x90, 0x7b, 0x59, 0x2c, 0xd2, 0x6c, 0x12, 0xc4,
+	0x3b, 0x74, 0x12, 0x52, 0x1d, 0x14, 0xe9, 0xed, 0x54, 0x9c, 0x1c, 0xaf, 0x75, 0x91, 0x63, 0x94,
+	0x05, 0x22, 0xc0, 0x2a, 0xe8, 0x47, 0x8a, 0xaf, 0xb6, 0x93, 0x62, 0x6c, 0x60, 0x3c, 0x3f, 0x19,
+	0x5e, 0xee, 0x20, 0xc3, 0xb1, 0x81, 0xd1, 0xda, 0x48, 0xf0, 0x4a, 0x27, 0x09, 0xa2, 0x81, 0xf1,
+	0xda, 0xc9, 0xef, 0x4a, 0x27, 0xf9, 0xc5, 0x87, 0x07, 0x64, 0xa4, 0x57, 0xea, 0x26, 0xbd, 0xc4,
+	0xc0, 0x90, 0x9d, 0x64, 0x57, 0xea, 0x26, 0xbb, 0xe4, 0xf0, 0xa0, 0x9c, 0xe4, 0x56, 0x9f, 0x4a,
+	0x72, 0x93, 0x03, 0xe3, 0xf7, 0x27, 0xb7, 0x52, 0x37, 0xb9, 0xa5, 0x06, 0x77, 0xbf, 0x83, 0xd4,
+	0x2e, 0x77, 0x90, 0x1a, 0x1e, 0x3c, 0xb3, 0x7c, 0x64, 0x06, 0x0b, 0x29, 0x01, 0x6f, 0x59, 0x66,
+	0xb3, 0x5c, 0x37, 0x40, 0xbe, 0xc9, 0x39, 0x32, 0x1b, 0x00, 0x4e, 0xa6, 0x6a, 0x57, 0x99, 0x96,
+	0x1c, 0x37, 0x5b, 0x0d, 0x58, 0xe1, 0x51, 0x4b, 0xab, 0xea, 0x9a, 0x5e, 0xe5, 0x25, 0x43, 0x10,
+	0xe7, 0x4a, 0x8e, 0x86, 0xec, 0xaa, 0xe2, 0xf7, 0x05, 0x74, 0xc0, 0x50, 0x1a, 0xf6, 0xea, 0x7c,
+	0xb9, 0x52, 0xd3, 0x20, 0xc5, 0xca, 0xc0, 0x02, 0x6c, 0xef, 0x52, 0x6a, 0x96, 0x38, 0xcd, 0x80,
+	0x17, 0x02, 0x00, 0x5f, 0xc9, 0x53, 0x8c, 0x45, 0x06, 0xb1, 0xd8, 0x42, 0x28, 0x1c, 0x84, 0x5d,
+	0x66, 0x7f, 0x9f, 0x4e, 0x79, 0xbf, 0x63, 0xb9, 0xab, 0x03, 0x1f, 0x47, 0xa3, 0x76, 0xcd, 0x2a,
+	0x57, 0x14, 0x71, 0x3f, 0x78, 0x90, 0x28, 0xec, 0x85, 0x3d, 0xeb, 0x5d, 0xd8, 0xb3, 0xce, 0x01,
+	0x5a, 0xe4, 0xfa, 0xa5, 0xd2, 0x62, 0x5e, 0x8e, 0x80, 0xc0, 0xa2, 0x82, 0xf3, 0xb0, 0x91, 0x52,
+	0x49, 0xee, 0x3b, 0x31, 0x6d, 0x51, 0x64, 0x2a, 0x07, 0x7c, 0x2a, 0x49, 0xaa, 0xe2, 0x18, 0x01,
+	0x01, 0x39, 0x49, 0x55, 0xbd, 0x26, 0x7e, 0x09, 0xa5, 0x7c, 0x10, 0x74, 0xa3, 0x3c, 0xc0, 0x10,
+	0x44, 0x1f, 0x42, 0xc2, 0x43, 0xa0, 0xdb, 0x64, 0xc2, 0x03, 0x80, 0xd6, 0x8e, 0x0a, 0x95, 0x3c,
+	0x9a, 0xec, 0xb1, 0x9b, 0xef, 0x46, 0xad, 0x93, 0xfe, 0x5d, 0x08, 0xc5, 0x7d, 0xc9, 0x84, 0xe7,
+	0x50, 0x62, 0x5d, 0x79, 0x07, 0xf8, 0x9d, 0x32, 0x87, 0xed, 0x14, 0x21, 0x49, 0xa6, 0x34, 0xc7,
+	0xde, 0x96, 0xa1, 0x33, 0xcf, 0xfb, 0xa0, 0x10, 0x1a, 0xd7, 0x74, 0x8d, 0xce, 0x4d, 0x79, 0x19,
+	0xf6, 0x35, 0x63, 0x65, 0x85, 0x57, 0x16, 0x07, 0xba, 0x8a, 0x82, 0x22, 0x3f, 0x19, 0x2b, 0x8c,
+	0x7c, 0x40, 0xeb, 0x81, 0x14, 0xd7, 0x2b, 0x38, 0x6a, 0xf8, 0x1c, 0xa2, 0xc0, 0x1e, 0x4a, 0x38,
+	0x18, 0x0a, 0x02, 0x1d, 0x17, 0xe1, 0x3c, 0x9a, 0x66, 0xcb, 0x40, 0x59, 0xae, 0x91, 0x32, 0x94,
+	0x1f, 0x76, 0x03, 0xe6, 0xce, 0x80, 0x77, 0x7b, 0x28, 0x32, 0xc2, 0x30, 0x82, 0xf1, 0xad, 0x42,
+	0xe2, 0xbe, 0x30, 0x26, 0x45, 0xe7, 0x22, 0xe2, 0xe7, 0x23, 0xc7, 0x55, 0x79, 0xca, 0x13, 0x2f,
+	0x31, 0xe9, 0x45, 0x2a, 0x9c, 0xce, 0xa0, 0x28, 0x5f, 0x0c, 0x58, 0xf2, 0xce, 0x44, 0x9c, 0xc0,
+	0xa1, 0xad, 0x42, 0xd4, 0x8c, 0x4c, 0x4c, 0x00, 0x47, 0xba, 0xe7, 0x23, 0xe9, 0x7f, 0x0a, 0xa8,
+	0x5f, 0x1a, 0xe3, 0x1f, 0x40, 0x99, 0x68, 0xac, 0x11, 0xe7, 0x1d, 0xdf, 0x81, 0x98, 0xf2, 0x55,
+	0x3a, 0xb1, 0xeb, 0xb4, 0x93, 0x96, 0x3a, 0x31, 0x26, 0x46, 0x6b, 0x1d, 0x50, 0xe1, 0xf9, 0xe6,
+	0x1d, 0x83, 0x4d, 0x71, 0x5e, 0x0e, 0x51, 0x15, 0xc7, 0xc8, 0x52, 0x51, 0x8e, 0x39, 0x62, 0x4b,
+	0x2a, 0xce, 0xa0, 0x24, 0x57, 0xe1, 0xce, 0x3a, 0xc7, 0x1b, 0x31, 0x57, 0x4d, 0x4e, 0x38, 0xdd,
+	0x25, 0xe7, 0x40, 0xe7, 0x79, 0x18, 0x54, 0xc5, 0xa8, 0xf3, 0xb0, 0x8c, 0x15, 0x92, 0x5b, 0x05,
+	0x74, 0x5f, 0x88, 0x4a, 0x5c, 0x98, 0x77, 0xd2, 0xe4, 0x77, 0xe0, 0x2c, 0x9a, 0xf9, 0xd4, 0x9b,
+	0x34, 0x83, 0x15, 0x7d, 0x55, 0x42, 0xc2, 0x81, 0xb4, 0x20, 0xd9, 0xc1, 0xa3, 0x84, 0xd5, 0x6a,
+	0xa9, 0xd2, 0x0d, 0x34, 0xd9, 0xbd, 0x1b, 0x50, 0xd8, 0x18, 0x3f, 0x9f, 0x73, 0x0b, 0x67, 0x69,
+	0xfb, 0x4d, 0x44, 0xf6, 0x74, 0xa4, 0x3f, 0xc3, 0xb6, 0xd4, 0x2d, 0x70, 0x81, 0x15, 0x67, 0x16,
+	0xbe, 0x8a, 0xa2, 0x4e, 0x9d, 0xe6, 0x82, 0x07, 0xa8, 0x9a, 0xb8, 0x6e, 0x96, 0xdf, 0x79, 0xc1,
+	0xc8, 0x61, 0xe8, 0x1a, 0xf6, 0x77, 0x0c, 0xb2, 0x00, 0xa5, 0xdf, 0x44, 0x7a, 0x1d, 0x86, 0x15,
+	0x49, 0x4d, 0xdb, 0x20, 0x66, 0x73, 0x37, 0x6a, 0xfc, 0x69, 0x76, 0x96, 0xe8, 0xa4, 0xcd, 0xa8,
+	0xef, 0x90, 0x70, 0x15, 0xa5, 0x88, 0xae, 0x96, 0x55, 0xc2, 0x78, 0x8b, 0x5a, 0x75, 0xd6, 0xd7,
+	0xd1, 0x4e, 0xab, 0xe7, 0x75, 0xb5, 0xc8, 0x84, 0xfc, 0xc6, 0x66, 0xfc, 0xc6, 0xe8, 0xb4, 0xb7,
+	0xa4, 0x8a, 0x96, 0x9c, 0x20, 0x2d, 0x9d, 0xce, 0xb7, 0x8c, 0x91, 0x67, 0xf1, 0x96, 0x11, 0x19,
+	0xee, 0x2d, 0xe3, 0x00, 0x0a, 0xb7, 0x8e, 0xd6, 0xa2, 0xe0, 0x76, 0x98, 0xae, 0x34, 0xfa, 0xcc,
+	0x7f, 0x10, 0x16, 0x0d, 0x7a, 0x10, 0xe6, 0x4e, 0x5b, 0x9f, 0x17, 0x0b, 0x8c, 0x46, 0x96, 0x0d,
+	0xb5, 0xc9, 0xca, 0xd6, 0x84, 0xcc, 0x7e, 0xe3, 0x34, 0x8a, 0x79, 0x9b, 0x28, 0x2d, 0x40, 0x93,
+	0xb2, 0xd7, 0xc6, 0xf3, 0x28, 0xc2, 0x0e, 0xea, 0x79, 0x25, 0x39, 0xd3, 0x35, 0x11, 0xb4, 0xb3,
+	0x48, 0x6c, 0x28, 0xc4, 0x2c, 0xd9, 0x11, 0xdd, 0x09, 0x9f, 0x48, 0x6f, 0xa3, 0x99, 0xbe, 0x63,
+	0xd2, 0x60, 0xb9, 0xbf, 0x82, 0x90, 0xea, 0xb5, 0x82, 0xbf, 0xd2, 0xba, 0x51, 0x91, 0x7d, 0xda,
+	0xd2, 0x5f, 0x05, 0x74, 0xa2, 0xc7, 0x3a, 0x63, 0x35, 0x65, 0xcb, 0xa4, 0x4c, 0xa0, 0xfa, 0xb3,
+	0xec, 0xdd, 0x58, 0x06, 0xf3, 0x50, 0xfd, 0x73, 0xc7, 0x58, 0xb2, 0x87, 0xd8, 0x46, 0x37, 0x0e,
+	0x39, 0x10, 0x77, 0x1d, 0xa6, 0x99, 0x1b, 0x77, 0x85, 0x20, 0x71, 0xa9, 0xd3, 0x33, 0x17, 0x89,
+	0xdd, 0x63, 0xf3, 0xd9, 0x3d, 0x3f, 0xcf, 0x22, 0xd4, 0xfa, 0xe4, 0xd3, 0xf7, 0x95, 0x9c, 0xf1,
+	0xff, 0x65, 0x90, 0x28, 0x8c, 0x50, 0x75, 0x79, 0x6c, 0xc5, 0x7d, 0x20, 0xfd, 0x5d, 0x40, 0xb3,
+	0x97, 0x34, 0xab, 0x87, 0xd7, 0x5e, 0x78, 0xbf, 0x83, 0x6f, 0x37, 0x3b, 0x1e, 0xc6, 0x5f, 0x20,
+	0xf6, 0xa5, 0xa7, 0xc5, 0xfe, 0x35, 0x14, 0xe5, 0x0c, 0xc0, 0x9d, 0x0f, 0x40, 0x1a, 0x3d, 0x1c,
+	0x77, 0x41, 0x76, 0xee, 0xf1, 0xdf, 0x04, 0x74, 0xb4, 0x67, 0xb6, 0x78, 0x67, 0x3c, 0xdc, 0xf3,
+	0x5d, 0xfc, 0xe2, 0xb1, 0xe3, 0x41, 0x68, 0xe8, 0x58, 0xef, 0xe4, 0xf1, 0x0e, 0xba, 0xdc, 0x51,
+	0xb4, 0x9b, 0x12, 0x06, 0x36, 0x35, 0x7f, 0x2f, 0xd5, 0x8b, 0x0a, 0x65, 0x52, 0x05, 0xfb, 0xb0,
+	0x93, 0xd5, 0x10, 0x82, 0x68, 0xba, 0x2c, 0x3e, 0xdd, 0x85, 0x7c, 0x9e, 0x7e, 0x35, 0x4d, 0x9f,
+	0x08, 0x4c, 0xe6, 0xd2, 0xc1, 0x5f, 0x7f, 0xf6, 0xdf, 0xf7, 0x43, 0xfb, 0xf0, 0x64, 0x4e, 0xb1,
+	0x72, 0x7c, 0xd6, 0x33, 0x9c, 0xd3, 0xf1, 0x87, 0x02, 0x8a, 0x83, 0x39, 0xef, 0xab, 0xd4, 0x8b,
+	0x9d, 0xb8, 0x41, 0x66, 0x36, 0x3d, 0xc0, 0x81, 0x9f, 0x94, 0x63, 0xee, 0x9c, 0xc0, 0x2f, 0xf8,
+	0xdd, 0xf1, 0x0e, 0x01, 0x73, 0xef, 0xc1, 0x74, 0x66, 0x7d, 0xc7, 0x4a, 0xb7, 0xe9, 0xdb, 0x57,
+	0x92, 0xce, 0x4d, 0xeb, 0xc8, 0xb1, 0xab, 0x92, 0x09, 0x36, 0x75, 0xe9, 0xef, 0x05, 0x77, 0xd3,
+	0x92, 0x0e, 0x31, 0x3f, 0xf7, 0xe3, 0x7d, 0x3d, 0xfd, 0xc4, 0x7f, 0x14, 0x50, 0xf8, 0x22, 0xfd,
+	0x26, 0x18, 0x28, 0x60, 0xae, 0x07, 0x01, 0xd6, 0xaa, 0xf4, 0x0a, 0x33, 0x5c, 0xc4, 0x05, 0x9f,
+	0x61, 0x1e, 0x97, 0x8e, 0xdd, 0xab, 0xa3, 0x7d, 0xdb, 0x11, 0x6a, 0x7d, 0x3b, 0xbe, 0x8d, 0xef,
+	0x0b, 0x68, 0x84, 0x06, 0x07, 0x67, 0x83, 0x85, 0xcc, 0x0b, 0xd5, 0x73, 0xdb, 0x3b, 0x6a, 0x49,
+	0xa7, 0x99, 0xa7, 0x39, 0x9c, 0x69, 0xf7, 0x74, 0x1b, 0x2f, 0xf1, 0x13, 0x08, 0x5d, 0xa9, 0x57,
+	0xe8, 0x4a, 0x3b, 0x0d, 0xdd, 0x1f, 0x04, 0xe6, 0xd1, 0xef, 0x85, 0xb4, 0xdc, 0xee, 0x12, 0xff,
+	0x95, 0x0d, 0x14, 0x44, 0xbf, 0xb0, 0x2f, 0x98, 0x0b, 0xc2, 0xdc, 0x1b, 0x2f, 0x49, 0x67, 0x86,
+	0x06, 0x06, 0x7d, 0x9a, 0xcb, 0xa3, 0x40, 0xbb, 0x04, 0x56, 0xda, 0x60, 0xb4, 0x99, 0xee, 0xb3,
+	0x11, 0x48, 0x05, 0x36, 0xe2, 0x9f, 0xcc, 0x2d, 0x0c, 0x34, 0x07, 0x9e, 0xe3, 0x6c, 0x42, 0xfe,
+	0x2d, 0xa0, 0x29, 0x9a, 0x0f, 0x9d, 0x65, 0x09, 0x3e, 0x13, 0x60, 0x97, 0xe9, 0x5d, 0xca, 0xa4,
+	0x4f, 0x06, 0x2e, 0x98, 0x68, 0x99, 0xf4, 0x73, 0x36, 0x8a, 0x9b, 0xf8, 0xfa, 0xce, 0x73, 0x3e,
+	0xe7, 0x1c, 0xf9, 0x65, 0x5a, 0x45, 0x18, 0xfe, 0x4c, 0x40, 0xd3, 0x32, 0x81, 0x85, 0xdb, 0x7c,
+	0x96, 0x23, 0xec, 0x37, 0x23, 0x2b, 0x6c, 0x2c, 0xbf, 0x90, 0x6e, 0xed, 0xc6, 0x58, 0x72, 0x26,
+	0x1b, 0x07, 0xcd, 0xa5, 0x07, 0x02, 0xda, 0x77, 0xb5, 0x61, 0x56, 0xc9, 0x77, 0x31, 0x28, 0x3e,
+	0x41, 0x73, 0xbb, 0x32, 0x41, 0x85, 0x3f, 0x09, 0x0f, 0xbe, 0x9a, 0x15, 0x1e, 0xc2, 0xf5, 0xc5,
+	0x57, 0xb3, 0x7b, 0xbe, 0x84, 0xeb, 0x1b, 0xb8, 0xbe, 0x85, 0xeb, 0x09, 0x3c, 0xbb, 0xf3, 0x78,
+	0x56, 0xb8, 0xfb, 0x78, 0x76, 0xcf, 0x47, 0x70, 0xff, 0x18, 0xee, 0x9f, 0xc0, 0xf5, 0x29, 0x5c,
+	0x0f, 0xa0, 0xfd, 0x10, 0xae, 0x2f, 0xe0, 0xf7, 0x97, 0x70, 0xff, 0x06, 0xee, 0xdf, 0xc2, 0xfd,
+	0x09, 0xdc, 0xef, 0x7c, 0x3d, 0xbb, 0xe7, 0xee, 0xd7, 0xb3, 0xc2, 0x3d, 0xb8, 0x7f, 0x00, 0xf7,
+	0x0f, 0xe1, 0xfe, 0x11, 0x5c, 0x1f, 0xc3, 0xef, 0x4f, 0xe0, 0xfa, 0x14, 0xae, 0x37, 0x72, 0x55,
+	0x23, 0x6b, 0xaf, 0x12, 0x7b, 0x55, 0xd3, 0xab, 0x56, 0x56, 0x27, 0xf6, 0xa6, 0x61, 0xae, 0xe5,
+	0xda, 0xff, 0x27, 0xb4, 0x71, 0x2a, 0x57, 0x5f, 0xab, 0xe6, 0x20, 0xa0, 0xf5, 0xe5, 0xe5, 0x51,
+	0x16, 0x95, 0x53, 0xff, 0x07, 0x5b, 0x65, 0x2f, 0xe1, 0xba, 0x25, 0x00, 0x00,
 }
 
 func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
@@ -1543,6 +2076,27 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if !this.ServiceData.Equal(that1.ServiceData) {
 		return false
 	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if !this.Signing.Equal(that1.Signing) {
+		return false
+	}
+	if !this.OAuth2ClientCredentials.Equal(that1.OAuth2ClientCredentials) {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if this.SecretsKeyID != that1.SecretsKeyID {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
@@ -1569,14 +2123,14 @@ func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ApplicationWebhooks) Equal(that interface{}) bool {
+func (this *ApplicationWebhook_RetryPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationWebhooks)
+	that1, ok := that.(*ApplicationWebhook_RetryPolicy)
 	if !ok {
-		that2, ok := that.(ApplicationWebhooks)
+		that2, ok := that.(ApplicationWebhook_RetryPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1588,24 +2142,45 @@ func (this *ApplicationWebhooks) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Webhooks) != len(that1.Webhooks) {
+	if this.MaxAttempts != that1.MaxAttempts {
 		return false
 	}
-	for i := range this.Webhooks {
-		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+	if this.InitialBackoff != nil && that1.InitialBackoff != nil {
+		if *this.InitialBackoff != *that1.InitialBackoff {
+			return false
+		}
+	} else if this.InitialBackoff != nil {
+		return false
+	} else if that1.InitialBackoff != nil {
+		return false
+	}
+	if this.MaxBackoff != nil && that1.MaxBackoff != nil {
+		if *this.MaxBackoff != *that1.MaxBackoff {
+			return false
+		}
+	} else if this.MaxBackoff != nil {
+		return false
+	} else if that1.MaxBackoff != nil {
+		return false
+	}
+	if len(this.RetryableStatusCodes) != len(that1.RetryableStatusCodes) {
+		return false
+	}
+	for i := range this.RetryableStatusCodes {
+		if this.RetryableStatusCodes[i] != that1.RetryableStatusCodes[i] {
 			return false
 		}
 	}
 	return true
 }
-func (this *ApplicationWebhookFormats) Equal(that interface{}) bool {
+func (this *ApplicationWebhook_Signing) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationWebhookFormats)
+	that1, ok := that.(*ApplicationWebhook_Signing)
 	if !ok {
-		that2, ok := that.(ApplicationWebhookFormats)
+		that2, ok := that.(ApplicationWebhook_Signing)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1617,24 +2192,19 @@ func (this *ApplicationWebhookFormats) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Formats) != len(that1.Formats) {
+	if this.Secret != that1.Secret {
 		return false
 	}
-	for i := range this.Formats {
-		if this.Formats[i] != that1.Formats[i] {
-			return false
-		}
-	}
 	return true
 }
-func (this *GetApplicationWebhookRequest) Equal(that interface{}) bool {
+func (this *ApplicationWebhook_OAuth2ClientCredentials) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetApplicationWebhookRequest)
+	that1, ok := that.(*ApplicationWebhook_OAuth2ClientCredentials)
 	if !ok {
-		that2, ok := that.(GetApplicationWebhookRequest)
+		that2, ok := that.(ApplicationWebhook_OAuth2ClientCredentials)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1646,22 +2216,33 @@ func (this *GetApplicationWebhookRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+	if this.TokenURL != that1.TokenURL {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.ClientSecret != that1.ClientSecret {
+		return false
+	}
+	if len(this.Scopes) != len(that1.Scopes) {
 		return false
 	}
+	for i := range this.Scopes {
+		if this.Scopes[i] != that1.Scopes[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *ListApplicationWebhooksRequest) Equal(that interface{}) bool {
+func (this *ApplicationWebhooks) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListApplicationWebhooksRequest)
+	that1, ok := that.(*ApplicationWebhooks)
 	if !ok {
-		that2, ok := that.(ListApplicationWebhooksRequest)
+		that2, ok := that.(ApplicationWebhooks)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1673,22 +2254,24 @@ func (this *ListApplicationWebhooksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+	if len(this.Webhooks) != len(that1.Webhooks) {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *SetApplicationWebhookRequest) Equal(that interface{}) bool {
+func (this *ApplicationWebhookFormats) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SetApplicationWebhookRequest)
+	that1, ok := that.(*ApplicationWebhookFormats)
 	if !ok {
-		that2, ok := that.(SetApplicationWebhookRequest)
+		that2, ok := that.(ApplicationWebhookFormats)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1700,22 +2283,24 @@ func (this *SetApplicationWebhookRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationWebhook.Equal(&that1.ApplicationWebhook) {
+	if len(this.Formats) != len(that1.Formats) {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
+	for i := range this.Formats {
+		if this.Formats[i] != that1.Formats[i] {
+			return false
+		}
 	}
 	return true
 }
-func (this *GetApplicationWebhookTemplateRequest) Equal(that interface{}) bool {
+func (this *ApplicationWebhookDelivery) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetApplicationWebhookTemplateRequest)
+	that1, ok := that.(*ApplicationWebhookDelivery)
 	if !ok {
-		that2, ok := that.(GetApplicationWebhookTemplateRequest)
+		that2, ok := that.(ApplicationWebhookDelivery)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1727,22 +2312,51 @@ func (this *GetApplicationWebhookTemplateRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationWebhookTemplateIdentifiers.Equal(&that1.ApplicationWebhookTemplateIdentifiers) {
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if this.ID != that1.ID {
+		return false
+	}
+	if !this.EndDeviceIDs.Equal(&that1.EndDeviceIDs) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
 		return false
 	}
 	return true
 }
-func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool {
+func (this *ApplicationWebhookDeliveries) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListApplicationWebhookTemplatesRequest)
+	that1, ok := that.(*ApplicationWebhookDeliveries)
 	if !ok {
-		that2, ok := that.(ListApplicationWebhookTemplatesRequest)
+		that2, ok := that.(ApplicationWebhookDeliveries)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1754,7 +2368,176 @@ func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if len(this.Deliveries) != len(that1.Deliveries) {
+		return false
+	}
+	for i := range this.Deliveries {
+		if !this.Deliveries[i].Equal(that1.Deliveries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ApplicationWebhookFailedDeliveriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookFailedDeliveriesRequest)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookFailedDeliveriesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if len(this.DeliveryIDs) != len(that1.DeliveryIDs) {
+		return false
+	}
+	for i := range this.DeliveryIDs {
+		if this.DeliveryIDs[i] != that1.DeliveryIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetApplicationWebhookRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationWebhookRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationWebhookRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ListApplicationWebhooksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhooksRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhooksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *SetApplicationWebhookRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetApplicationWebhookRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationWebhookRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhook.Equal(&that1.ApplicationWebhook) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *GetApplicationWebhookTemplateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationWebhookTemplateRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationWebhookTemplateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookTemplateIdentifiers.Equal(&that1.ApplicationWebhookTemplateIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhookTemplatesRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhookTemplatesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
 		return false
 	}
 	return true
@@ -1779,6 +2562,13 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the deliveries to the webhook that exhausted their retry attempts.
+	ListFailedDeliveries(ctx context.Context, in *ApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error)
+	// Replay the deliveries to the webhook that exhausted their retry attempts.
+	// The deliveries are removed from the failed deliveries and are retried with a fresh retry policy.
+	ReplayFailedDeliveries(ctx context.Context, in *ApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the deliveries to the webhook that exhausted their retry attempts.
+	PurgeFailedDeliveries(ctx context.Context, in *ApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -1852,6 +2642,33 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListFailedDeliveries(ctx context.Context, in *ApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error) {
+	out := new(ApplicationWebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayFailedDeliveries(ctx context.Context, in *ApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) PurgeFailedDeliveries(ctx context.Context, in *ApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/PurgeFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
@@ -1861,6 +2678,13 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// List the deliveries to the webhook that exhausted their retry attempts.
+	ListFailedDeliveries(context.Context, *ApplicationWebhookFailedDeliveriesRequest) (*ApplicationWebhookDeliveries, error)
+	// Replay the deliveries to the webhook that exhausted their retry attempts.
+	// The deliveries are removed from the failed deliveries and are retried with a fresh retry policy.
+	ReplayFailedDeliveries(context.Context, *ApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error)
+	// Purge the deliveries to the webhook that exhausted their retry attempts.
+	PurgeFailedDeliveries(context.Context, *ApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error)
 }
 
 // UnimplementedApplicationWebhookRegistryServer can be embedded to have forward compatible implementations.
@@ -1888,6 +2712,15 @@ func (*UnimplementedApplicationWebhookRegistryServer) Set(ctx context.Context, r
 func (*UnimplementedApplicationWebhookRegistryServer) Delete(ctx context.Context, req *ApplicationWebhookIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationWebhookRegistryServer) ListFailedDeliveries(ctx context.Context, req *ApplicationWebhookFailedDeliveriesRequest) (*ApplicationWebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedDeliveries not implemented")
+}
+func (*UnimplementedApplicationWebhookRegistryServer) ReplayFailedDeliveries(ctx context.Context, req *ApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayFailedDeliveries not implemented")
+}
+func (*UnimplementedApplicationWebhookRegistryServer) PurgeFailedDeliveries(ctx context.Context, req *ApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeFailedDeliveries not implemented")
+}
 
 func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
 	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
@@ -2019,6 +2852,60 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListFailedDeliveries(ctx, req.(*ApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayFailedDeliveries(ctx, req.(*ApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_PurgeFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).PurgeFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/PurgeFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).PurgeFailedDeliveries(ctx, req.(*ApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
 	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
@@ -2051,6 +2938,18 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ListFailedDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ReplayFailedDeliveries_Handler,
+		},
+		{
+			MethodName: "PurgeFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_PurgeFailedDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -2531,9 +3430,45 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.DownlinkQueueInvalidated != nil {
+	if len(m.SecretsKeyID) > 0 {
+		i -= len(m.SecretsKeyID)
+		copy(dAtA[i:], m.SecretsKeyID)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.SecretsKeyID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.OAuth2ClientCredentials != nil {
 		{
-			size, err := m.DownlinkQueueInvalidated.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OAuth2ClientCredentials.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2543,11 +3478,11 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0xb2
 	}
-	if m.ServiceData != nil {
+	if m.Signing != nil {
 		{
-			size, err := m.ServiceData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Signing.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2557,18 +3492,60 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.DownlinkAPIKey) > 0 {
-		i -= len(m.DownlinkAPIKey)
-		copy(dAtA[i:], m.DownlinkAPIKey)
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DownlinkAPIKey)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xaa
 	}
-	if len(m.TemplateFields) > 0 {
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DownlinkQueueInvalidated != nil {
+		{
+			size, err := m.DownlinkQueueInvalidated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ServiceData != nil {
+		{
+			size, err := m.ServiceData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DownlinkAPIKey) > 0 {
+		i -= len(m.DownlinkAPIKey)
+		copy(dAtA[i:], m.DownlinkAPIKey)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DownlinkAPIKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.TemplateFields) > 0 {
 		for k := range m.TemplateFields {
 			v := m.TemplateFields[k]
 			baseI := i
@@ -2730,20 +3707,20 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err24 != nil {
-		return 0, err24
+	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err27 != nil {
+		return 0, err27
 	}
-	i -= n24
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n24))
+	i -= n27
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n27))
 	i--
 	dAtA[i] = 0x1a
-	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err25 != nil {
-		return 0, err25
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err28 != nil {
+		return 0, err28
 	}
-	i -= n25
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n25))
+	i -= n28
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n28))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2789,6 +3766,155 @@ func (m *ApplicationWebhook_Message) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationWebhook_RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook_RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook_RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryableStatusCodes) > 0 {
+		dAtA31 := make([]byte, len(m.RetryableStatusCodes)*10)
+		var j30 int
+		for _, num := range m.RetryableStatusCodes {
+			for num >= 1<<7 {
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j30++
+			}
+			dAtA31[j30] = uint8(num)
+			j30++
+		}
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(j30))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxBackoff != nil {
+		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxBackoff):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitialBackoff != nil {
+		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.InitialBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.InitialBackoff):])
+		if err33 != nil {
+			return 0, err33
+		}
+		i -= n33
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n33))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhook_Signing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook_Signing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook_Signing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenURL) > 0 {
+		i -= len(m.TokenURL)
+		copy(dAtA[i:], m.TokenURL)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TokenURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationWebhooks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2868,7 +3994,7 @@ func (m *ApplicationWebhookFormats) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookDelivery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2878,18 +4004,84 @@ func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x2a
+	n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x22
 	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.EndDeviceIDs.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2897,7 +4089,14 @@ func (m *GetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2911,7 +4110,7 @@ func (m *GetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookDeliveries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2921,40 +4120,34 @@ func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDeliveries) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListApplicationWebhooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDeliveries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Deliveries) > 0 {
+		for iNdEx := len(m.Deliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookFailedDeliveriesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2964,28 +4157,27 @@ func (m *SetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookFailedDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookFailedDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.DeliveryIDs) > 0 {
+		for iNdEx := len(m.DeliveryIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeliveryIDs[iNdEx])
+			copy(dAtA[i:], m.DeliveryIDs[iNdEx])
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DeliveryIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.ApplicationWebhook.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2997,7 +4189,7 @@ func (m *SetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *GetApplicationWebhookTemplateRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3007,12 +4199,12 @@ func (m *GetApplicationWebhookTemplateRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *GetApplicationWebhookTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetApplicationWebhookTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3028,7 +4220,7 @@ func (m *GetApplicationWebhookTemplateRequest) MarshalToSizedBuffer(dAtA []byte)
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.ApplicationWebhookTemplateIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3040,7 +4232,7 @@ func (m *GetApplicationWebhookTemplateRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *ListApplicationWebhookTemplatesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3050,12 +4242,12 @@ func (m *ListApplicationWebhookTemplatesRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *ListApplicationWebhookTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListApplicationWebhookTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListApplicationWebhooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3069,12 +4261,141 @@ func (m *ListApplicationWebhookTemplatesRequest) MarshalToSizedBuffer(dAtA []byt
 		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserverWeb(v)
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationWebhook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApplicationWebhookTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationWebhookTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApplicationWebhookTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationWebhookTemplateIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverWeb(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3255,6 +4576,31 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 	if r.Intn(5) != 0 {
 		this.DownlinkQueueInvalidated = NewPopulatedApplicationWebhook_Message(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.RetryPolicy = NewPopulatedApplicationWebhook_RetryPolicy(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Signing = NewPopulatedApplicationWebhook_Signing(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.OAuth2ClientCredentials = NewPopulatedApplicationWebhook_OAuth2ClientCredentials(r, easy)
+	}
+	v11 := r.Intn(100)
+	this.TLSCA = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v12 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	this.SecretsKeyID = randStringApplicationserverWeb(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3268,12 +4614,54 @@ func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bo
 	return this
 }
 
+func NewPopulatedApplicationWebhook_RetryPolicy(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_RetryPolicy {
+	this := &ApplicationWebhook_RetryPolicy{}
+	this.MaxAttempts = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.InitialBackoff = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.MaxBackoff = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	v14 := r.Intn(10)
+	this.RetryableStatusCodes = make([]uint32, v14)
+	for i := 0; i < v14; i++ {
+		this.RetryableStatusCodes[i] = r.Uint32()
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhook_Signing(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_Signing {
+	this := &ApplicationWebhook_Signing{}
+	this.Secret = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhook_OAuth2ClientCredentials(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_OAuth2ClientCredentials {
+	this := &ApplicationWebhook_OAuth2ClientCredentials{}
+	this.TokenURL = randStringApplicationserverWeb(r)
+	this.ClientID = randStringApplicationserverWeb(r)
+	this.ClientSecret = randStringApplicationserverWeb(r)
+	v15 := r.Intn(10)
+	this.Scopes = make([]string, v15)
+	for i := 0; i < v15; i++ {
+		this.Scopes[i] = randStringApplicationserverWeb(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
 	this := &ApplicationWebhooks{}
 	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Webhooks = make([]*ApplicationWebhook, v11)
-		for i := 0; i < v11; i++ {
+		v16 := r.Intn(5)
+		this.Webhooks = make([]*ApplicationWebhook, v16)
+		for i := 0; i < v16; i++ {
 			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
 		}
 	}
@@ -3285,9 +4673,9 @@ func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *Ap
 func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
 	this := &ApplicationWebhookFormats{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(10)
+		v17 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v12; i++ {
+		for i := 0; i < v17; i++ {
 			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
 		}
 	}
@@ -3296,12 +4684,73 @@ func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy boo
 	return this
 }
 
+func NewPopulatedApplicationWebhookDelivery(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDelivery {
+	this := &ApplicationWebhookDelivery{}
+	v18 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v18
+	this.ID = randStringApplicationserverWeb(r)
+	v19 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIDs = *v19
+	v20 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v20
+	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v21
+	this.URL = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v22 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v22; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	v23 := r.Intn(100)
+	this.Body = make([]byte, v23)
+	for i := 0; i < v23; i++ {
+		this.Body[i] = byte(r.Intn(256))
+	}
+	this.Attempts = r.Uint32()
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDeliveries(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDeliveries {
+	this := &ApplicationWebhookDeliveries{}
+	if r.Intn(5) == 0 {
+		v24 := r.Intn(5)
+		this.Deliveries = make([]*ApplicationWebhookDelivery, v24)
+		for i := 0; i < v24; i++ {
+			this.Deliveries[i] = NewPopulatedApplicationWebhookDelivery(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookFailedDeliveriesRequest(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFailedDeliveriesRequest {
+	this := &ApplicationWebhookFailedDeliveriesRequest{}
+	v25 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v25
+	v26 := r.Intn(10)
+	this.DeliveryIDs = make([]string, v26)
+	for i := 0; i < v26; i++ {
+		this.DeliveryIDs[i] = randStringApplicationserverWeb(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
 	this := &GetApplicationWebhookRequest{}
-	v13 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v27 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v27
+	v28 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v28
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3309,10 +4758,10 @@ func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
 	this := &ListApplicationWebhooksRequest{}
-	v15 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v29 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v29
+	v30 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v30
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3320,10 +4769,10 @@ func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, eas
 
 func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
 	this := &SetApplicationWebhookRequest{}
-	v17 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v31 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v31
+	v32 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v32
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3331,10 +4780,10 @@ func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookTemplateRequest {
 	this := &GetApplicationWebhookTemplateRequest{}
-	v19 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v33 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v33
+	v34 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v34
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3342,8 +4791,8 @@ func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWe
 
 func NewPopulatedListApplicationWebhookTemplatesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookTemplatesRequest {
 	this := &ListApplicationWebhookTemplatesRequest{}
-	v21 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v21
+	v35 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v35
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3368,9 +4817,9 @@ func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v36 := r.Intn(100)
+	tmps := make([]rune, v36)
+	for i := 0; i < v36; i++ {
 		tmps[i] = randUTF8RuneApplicationserverWeb(r)
 	}
 	return string(tmps)
@@ -3392,11 +4841,11 @@ func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fie
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v23 := r.Int63()
+		v37 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v37 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v37))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3685,6 +5134,34 @@ func (m *ApplicationWebhook) Size() (n int) {
 		l = m.DownlinkQueueInvalidated.Size()
 		n += 2 + l + sovApplicationserverWeb(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Signing != nil {
+		l = m.Signing.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.OAuth2ClientCredentials != nil {
+		l = m.OAuth2ClientCredentials.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.SecretsKeyID)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -3701,6 +5178,73 @@ func (m *ApplicationWebhook_Message) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhook_RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoff != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.InitialBackoff)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.MaxBackoff != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxBackoff)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.RetryableStatusCodes) > 0 {
+		l = 0
+		for _, e := range m.RetryableStatusCodes {
+			l += sovApplicationserverWeb(uint64(e))
+		}
+		n += 1 + sovApplicationserverWeb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ApplicationWebhook_Signing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhook_OAuth2ClientCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ApplicationWebhooks) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3733,7 +5277,83 @@ func (m *ApplicationWebhookFormats) Size() (n int) {
 	return n
 }
 
-func (m *GetApplicationWebhookRequest) Size() (n int) {
+func (m *ApplicationWebhookDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = m.EndDeviceIDs.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Attempts))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationWebhookFailedDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if len(m.DeliveryIDs) > 0 {
+		for _, s := range m.DeliveryIDs {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetApplicationWebhookRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3951,6 +5571,13 @@ func (this *ApplicationWebhook) String() string {
 		`DownlinkAPIKey:` + fmt.Sprintf("%v", this.DownlinkAPIKey) + `,`,
 		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`DownlinkQueueInvalidated:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueueInvalidated), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "ApplicationWebhook_RetryPolicy", "ApplicationWebhook_RetryPolicy", 1) + `,`,
+		`Signing:` + strings.Replace(fmt.Sprintf("%v", this.Signing), "ApplicationWebhook_Signing", "ApplicationWebhook_Signing", 1) + `,`,
+		`OAuth2ClientCredentials:` + strings.Replace(fmt.Sprintf("%v", this.OAuth2ClientCredentials), "ApplicationWebhook_OAuth2ClientCredentials", "ApplicationWebhook_OAuth2ClientCredentials", 1) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`SecretsKeyID:` + fmt.Sprintf("%v", this.SecretsKeyID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3965,6 +5592,42 @@ func (this *ApplicationWebhook_Message) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhook_RetryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_RetryPolicy{`,
+		`MaxAttempts:` + fmt.Sprintf("%v", this.MaxAttempts) + `,`,
+		`InitialBackoff:` + strings.Replace(fmt.Sprintf("%v", this.InitialBackoff), "Duration", "types.Duration", 1) + `,`,
+		`MaxBackoff:` + strings.Replace(fmt.Sprintf("%v", this.MaxBackoff), "Duration", "types.Duration", 1) + `,`,
+		`RetryableStatusCodes:` + fmt.Sprintf("%v", this.RetryableStatusCodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhook_Signing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_Signing{`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhook_OAuth2ClientCredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_OAuth2ClientCredentials{`,
+		`TokenURL:` + fmt.Sprintf("%v", this.TokenURL) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`ClientSecret:` + fmt.Sprintf("%v", this.ClientSecret) + `,`,
+		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationWebhooks) String() string {
 	if this == nil {
 		return "nil"
@@ -4000,6 +5663,61 @@ func (this *ApplicationWebhookFormats) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDelivery{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`EndDeviceIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIDs), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDeliveries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeliveries := "[]*ApplicationWebhookDelivery{"
+	for _, f := range this.Deliveries {
+		repeatedStringForDeliveries += strings.Replace(f.String(), "ApplicationWebhookDelivery", "ApplicationWebhookDelivery", 1) + ","
+	}
+	repeatedStringForDeliveries += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDeliveries{`,
+		`Deliveries:` + repeatedStringForDeliveries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookFailedDeliveriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookFailedDeliveriesRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeliveryIDs:` + fmt.Sprintf("%v", this.DeliveryIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetApplicationWebhookRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -6092,15 +7810,1579 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if m.LocationSolved == nil {
 				m.LocationSolved = &ApplicationWebhook_Message{}
 			}
-			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationWebhookTemplateIdentifiers == nil {
+				m.ApplicationWebhookTemplateIdentifiers = &ApplicationWebhookTemplateIdentifiers{}
+			}
+			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateFields == nil {
+				m.TemplateFields = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TemplateFields[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAPIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkAPIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceData == nil {
+				m.ServiceData = &ApplicationWebhook_Message{}
+			}
+			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueueInvalidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkQueueInvalidated == nil {
+				m.DownlinkQueueInvalidated = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkQueueInvalidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &ApplicationWebhook_RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signing == nil {
+				m.Signing = &ApplicationWebhook_Signing{}
+			}
+			if err := m.Signing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2ClientCredentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OAuth2ClientCredentials == nil {
+				m.OAuth2ClientCredentials = &ApplicationWebhook_OAuth2ClientCredentials{}
+			}
+			if err := m.OAuth2ClientCredentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretsKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretsKeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitialBackoff == nil {
+				m.InitialBackoff = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.InitialBackoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBackoff == nil {
+				m.MaxBackoff = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxBackoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryableStatusCodes = append(m.RetryableStatusCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplicationserverWeb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplicationserverWeb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetryableStatusCodes) == 0 {
+					m.RetryableStatusCodes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryableStatusCodes = append(m.RetryableStatusCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableStatusCodes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Signing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_OAuth2ClientCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2ClientCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2ClientCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Formats == nil {
+				m.Formats = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Formats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6110,31 +9392,27 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplicationWebhookTemplateIdentifiers == nil {
-				m.ApplicationWebhookTemplateIdentifiers = &ApplicationWebhookTemplateIdentifiers{}
-			}
-			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6161,8 +9439,8 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateFields == nil {
-				m.TemplateFields = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -6257,13 +9535,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TemplateFields[mapkey] = mapvalue
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAPIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6273,29 +9551,31 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownlinkAPIKey = string(dAtA[iNdEx:postIndex])
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var msglen int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6305,31 +9585,14 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ServiceData == nil {
-				m.ServiceData = &ApplicationWebhook_Message{}
-			}
-			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueueInvalidated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6356,10 +9619,10 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkQueueInvalidated == nil {
-				m.DownlinkQueueInvalidated = &ApplicationWebhook_Message{}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
 			}
-			if err := m.DownlinkQueueInvalidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6387,7 +9650,7 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookDeliveries) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6410,17 +9673,17 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6430,23 +9693,25 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Deliveries = append(m.Deliveries, &ApplicationWebhookDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6472,7 +9737,7 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookFailedDeliveriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6495,15 +9760,15 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookFailedDeliveriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookFailedDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6530,69 +9795,15 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
-			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6602,118 +9813,23 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formats == nil {
-				m.Formats = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplicationserverWeb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Formats[mapkey] = mapvalue
+			m.DeliveryIDs = append(m.DeliveryIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
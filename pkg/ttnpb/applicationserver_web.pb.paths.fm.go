@@ -125,11 +125,27 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"join_accept.path",
 	"location_solved",
 	"location_solved.path",
+	"oauth2_client_credentials",
+	"oauth2_client_credentials.client_id",
+	"oauth2_client_credentials.client_secret",
+	"oauth2_client_credentials.scopes",
+	"oauth2_client_credentials.token_url",
+	"retry_policy",
+	"retry_policy.initial_backoff",
+	"retry_policy.max_attempts",
+	"retry_policy.max_backoff",
+	"retry_policy.retryable_status_codes",
+	"secrets_key_id",
 	"service_data",
 	"service_data.path",
+	"signing",
+	"signing.secret",
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.path",
@@ -150,9 +166,16 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"ids",
 	"join_accept",
 	"location_solved",
+	"oauth2_client_credentials",
+	"retry_policy",
+	"secrets_key_id",
 	"service_data",
+	"signing",
 	"template_fields",
 	"template_ids",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
 	"updated_at",
 	"uplink_message",
 }
@@ -170,6 +193,72 @@ var ApplicationWebhookFormatsFieldPathsNested = []string{
 var ApplicationWebhookFormatsFieldPathsTopLevel = []string{
 	"formats",
 }
+var ApplicationWebhookDeliveryFieldPathsNested = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"headers",
+	"id",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"updated_at",
+	"url",
+}
+
+var ApplicationWebhookDeliveryFieldPathsTopLevel = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"end_device_ids",
+	"error",
+	"headers",
+	"id",
+	"ids",
+	"updated_at",
+	"url",
+}
+var ApplicationWebhookDeliveriesFieldPathsNested = []string{
+	"deliveries",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsTopLevel = []string{
+	"deliveries",
+}
+var ApplicationWebhookFailedDeliveriesRequestFieldPathsNested = []string{
+	"delivery_ids",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+}
+
+var ApplicationWebhookFailedDeliveriesRequestFieldPathsTopLevel = []string{
+	"delivery_ids",
+	"ids",
+}
 var GetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"ids",
@@ -220,11 +309,27 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.join_accept.path",
 	"webhook.location_solved",
 	"webhook.location_solved.path",
+	"webhook.oauth2_client_credentials",
+	"webhook.oauth2_client_credentials.client_id",
+	"webhook.oauth2_client_credentials.client_secret",
+	"webhook.oauth2_client_credentials.scopes",
+	"webhook.oauth2_client_credentials.token_url",
+	"webhook.retry_policy",
+	"webhook.retry_policy.initial_backoff",
+	"webhook.retry_policy.max_attempts",
+	"webhook.retry_policy.max_backoff",
+	"webhook.retry_policy.retryable_status_codes",
+	"webhook.secrets_key_id",
 	"webhook.service_data",
 	"webhook.service_data.path",
+	"webhook.signing",
+	"webhook.signing.secret",
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
+	"webhook.tls_ca",
+	"webhook.tls_client_cert",
+	"webhook.tls_client_key",
 	"webhook.updated_at",
 	"webhook.uplink_message",
 	"webhook.uplink_message.path",
@@ -265,3 +370,36 @@ var ApplicationWebhook_MessageFieldPathsNested = []string{
 var ApplicationWebhook_MessageFieldPathsTopLevel = []string{
 	"path",
 }
+var ApplicationWebhook_RetryPolicyFieldPathsNested = []string{
+	"initial_backoff",
+	"max_attempts",
+	"max_backoff",
+	"retryable_status_codes",
+}
+
+var ApplicationWebhook_RetryPolicyFieldPathsTopLevel = []string{
+	"initial_backoff",
+	"max_attempts",
+	"max_backoff",
+	"retryable_status_codes",
+}
+var ApplicationWebhook_SigningFieldPathsNested = []string{
+	"secret",
+}
+
+var ApplicationWebhook_SigningFieldPathsTopLevel = []string{
+	"secret",
+}
+var ApplicationWebhook_OAuth2ClientCredentialsFieldPathsNested = []string{
+	"client_id",
+	"client_secret",
+	"scopes",
+	"token_url",
+}
+
+var ApplicationWebhook_OAuth2ClientCredentialsFieldPathsTopLevel = []string{
+	"client_id",
+	"client_secret",
+	"scopes",
+	"token_url",
+}
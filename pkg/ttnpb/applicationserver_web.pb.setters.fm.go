@@ -891,6 +891,118 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.ServiceData = nil
 				}
 			}
+		case "retry_policy":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_RetryPolicy
+				if (src == nil || src.RetryPolicy == nil) && dst.RetryPolicy == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RetryPolicy
+				}
+				if dst.RetryPolicy != nil {
+					newDst = dst.RetryPolicy
+				} else {
+					newDst = &ApplicationWebhook_RetryPolicy{}
+					dst.RetryPolicy = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RetryPolicy = src.RetryPolicy
+				} else {
+					dst.RetryPolicy = nil
+				}
+			}
+		case "signing":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_Signing
+				if (src == nil || src.Signing == nil) && dst.Signing == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Signing
+				}
+				if dst.Signing != nil {
+					newDst = dst.Signing
+				} else {
+					newDst = &ApplicationWebhook_Signing{}
+					dst.Signing = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Signing = src.Signing
+				} else {
+					dst.Signing = nil
+				}
+			}
+		case "oauth2_client_credentials":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_OAuth2ClientCredentials
+				if (src == nil || src.OAuth2ClientCredentials == nil) && dst.OAuth2ClientCredentials == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OAuth2ClientCredentials
+				}
+				if dst.OAuth2ClientCredentials != nil {
+					newDst = dst.OAuth2ClientCredentials
+				} else {
+					newDst = &ApplicationWebhook_OAuth2ClientCredentials{}
+					dst.OAuth2ClientCredentials = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OAuth2ClientCredentials = src.OAuth2ClientCredentials
+				} else {
+					dst.OAuth2ClientCredentials = nil
+				}
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSCA = src.TLSCA
+			} else {
+				dst.TLSCA = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
+		case "secrets_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secrets_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecretsKeyID = src.SecretsKeyID
+			} else {
+				var zero string
+				dst.SecretsKeyID = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -939,6 +1051,204 @@ func (dst *ApplicationWebhookFormats) SetFields(src *ApplicationWebhookFormats,
 	return nil
 }
 
+func (dst *ApplicationWebhookDelivery) SetFields(src *ApplicationWebhookDelivery, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIDs
+				}
+				newDst = &dst.EndDeviceIDs
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIDs = src.EndDeviceIDs
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIDs = zero
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				var zero time.Time
+				dst.UpdatedAt = zero
+			}
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.URL = src.URL
+			} else {
+				var zero string
+				dst.URL = zero
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+		case "body":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Body = src.Body
+			} else {
+				dst.Body = nil
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeliveries) SetFields(src *ApplicationWebhookDeliveries, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "deliveries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deliveries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deliveries = src.Deliveries
+			} else {
+				dst.Deliveries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookFailedDeliveriesRequest) SetFields(src *ApplicationWebhookFailedDeliveriesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "delivery_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delivery_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeliveryIDs = src.DeliveryIDs
+			} else {
+				dst.DeliveryIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *GetApplicationWebhookRequest) SetFields(src *GetApplicationWebhookRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1157,3 +1467,122 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 	}
 	return nil
 }
+
+func (dst *ApplicationWebhook_RetryPolicy) SetFields(src *ApplicationWebhook_RetryPolicy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "max_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxAttempts = src.MaxAttempts
+			} else {
+				var zero uint32
+				dst.MaxAttempts = zero
+			}
+		case "initial_backoff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'initial_backoff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.InitialBackoff = src.InitialBackoff
+			} else {
+				dst.InitialBackoff = nil
+			}
+		case "max_backoff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_backoff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxBackoff = src.MaxBackoff
+			} else {
+				dst.MaxBackoff = nil
+			}
+		case "retryable_status_codes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'retryable_status_codes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RetryableStatusCodes = src.RetryableStatusCodes
+			} else {
+				dst.RetryableStatusCodes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhook_Signing) SetFields(src *ApplicationWebhook_Signing, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Secret = src.Secret
+			} else {
+				var zero string
+				dst.Secret = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhook_OAuth2ClientCredentials) SetFields(src *ApplicationWebhook_OAuth2ClientCredentials, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "token_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'token_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TokenURL = src.TokenURL
+			} else {
+				var zero string
+				dst.TokenURL = zero
+			}
+		case "client_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientID = src.ClientID
+			} else {
+				var zero string
+				dst.ClientID = zero
+			}
+		case "client_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientSecret = src.ClientSecret
+			} else {
+				var zero string
+				dst.ClientSecret = zero
+			}
+		case "scopes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scopes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Scopes = src.Scopes
+			} else {
+				dst.Scopes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -1033,6 +1033,78 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "retry_policy":
+
+			if v, ok := interface{}(m.GetRetryPolicy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "retry_policy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "signing":
+
+			if v, ok := interface{}(m.GetSigning()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "signing",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "oauth2_client_credentials":
+
+			if v, ok := interface{}(m.GetOAuth2ClientCredentials()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "oauth2_client_credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "tls_ca":
+
+			if len(m.GetTLSCA()) > 8192 {
+				return ApplicationWebhookValidationError{
+					field:  "tls_ca",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_cert":
+
+			if len(m.GetTLSClientCert()) > 8192 {
+				return ApplicationWebhookValidationError{
+					field:  "tls_client_cert",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_key":
+
+			if len(m.GetTLSClientKey()) > 8192 {
+				return ApplicationWebhookValidationError{
+					field:  "tls_client_key",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "secrets_key_id":
+
+			if utf8.RuneCountInString(m.GetSecretsKeyID()) > 128 {
+				return ApplicationWebhookValidationError{
+					field:  "secrets_key_id",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -1211,13 +1283,360 @@ func (m *ApplicationWebhookFormats) ValidateFields(paths ...string) error {
 		paths = ApplicationWebhookFormatsFieldPathsNested
 	}
 
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		_ = subs
-		switch name {
-		case "formats":
-			// no validation rules for Formats
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "formats":
+			// no validation rules for Formats
+		default:
+			return ApplicationWebhookFormatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookFormatsValidationError is the validation error returned by
+// ApplicationWebhookFormats.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookFormatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookFormatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookFormatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookFormatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookFormatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookFormatsValidationError) ErrorName() string {
+	return "ApplicationWebhookFormatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookFormatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookFormats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookFormatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookFormatsValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDelivery with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookDelivery) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "id":
+			// no validation rules for ID
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(&m.UpdatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "url":
+			// no validation rules for URL
+		case "headers":
+			// no validation rules for Headers
+		case "body":
+			// no validation rules for Body
+		case "attempts":
+			// no validation rules for Attempts
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookDeliveryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveryValidationError is the validation error returned by
+// ApplicationWebhookDelivery.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveryValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveryValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDeliveries with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookDeliveries) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveriesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deliveries":
+
+			for idx, item := range m.GetDeliveries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeliveriesValidationError{
+							field:  fmt.Sprintf("deliveries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeliveriesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveriesValidationError is the validation error returned
+// by ApplicationWebhookDeliveries.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveriesValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeliveries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveriesValidationError{}
+
+// ValidateFields checks the field values on
+// ApplicationWebhookFailedDeliveriesRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ApplicationWebhookFailedDeliveriesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookFailedDeliveriesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookFailedDeliveriesRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "delivery_ids":
+			// no validation rules for DeliveryIDs
 		default:
-			return ApplicationWebhookFormatsValidationError{
+			return ApplicationWebhookFailedDeliveriesRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1226,10 +1645,10 @@ func (m *ApplicationWebhookFormats) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ApplicationWebhookFormatsValidationError is the validation error returned by
-// ApplicationWebhookFormats.ValidateFields if the designated constraints
-// aren't met.
-type ApplicationWebhookFormatsValidationError struct {
+// ApplicationWebhookFailedDeliveriesRequestValidationError is the validation
+// error returned by ApplicationWebhookFailedDeliveriesRequest.ValidateFields if
+// the designated constraints aren't met.
+type ApplicationWebhookFailedDeliveriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1237,24 +1656,24 @@ type ApplicationWebhookFormatsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplicationWebhookFormatsValidationError) Field() string { return e.field }
+func (e ApplicationWebhookFailedDeliveriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplicationWebhookFormatsValidationError) Reason() string { return e.reason }
+func (e ApplicationWebhookFailedDeliveriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplicationWebhookFormatsValidationError) Cause() error { return e.cause }
+func (e ApplicationWebhookFailedDeliveriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplicationWebhookFormatsValidationError) Key() bool { return e.key }
+func (e ApplicationWebhookFailedDeliveriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplicationWebhookFormatsValidationError) ErrorName() string {
-	return "ApplicationWebhookFormatsValidationError"
+func (e ApplicationWebhookFailedDeliveriesRequestValidationError) ErrorName() string {
+	return "ApplicationWebhookFailedDeliveriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApplicationWebhookFormatsValidationError) Error() string {
+func (e ApplicationWebhookFailedDeliveriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1266,14 +1685,14 @@ func (e ApplicationWebhookFormatsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplicationWebhookFormats.%s: %s%s",
+		"invalid %sApplicationWebhookFailedDeliveriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplicationWebhookFormatsValidationError{}
+var _ error = ApplicationWebhookFailedDeliveriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1281,7 +1700,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplicationWebhookFormatsValidationError{}
+} = ApplicationWebhookFailedDeliveriesRequestValidationError{}
 
 // ValidateFields checks the field values on GetApplicationWebhookRequest with
 // the rules defined in the proto definition for this message. If any rules
@@ -1982,3 +2401,333 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationWebhook_MessageValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhook_RetryPolicy with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhook_RetryPolicy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_RetryPolicyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_attempts":
+
+			if m.GetMaxAttempts() > 100 {
+				return ApplicationWebhook_RetryPolicyValidationError{
+					field:  "max_attempts",
+					reason: "value must be less than or equal to 100",
+				}
+			}
+
+		case "initial_backoff":
+			// no validation rules for InitialBackoff
+		case "max_backoff":
+			// no validation rules for MaxBackoff
+		case "retryable_status_codes":
+
+			for idx, item := range m.GetRetryableStatusCodes() {
+				_, _ = idx, item
+
+				if val := item; val < 100 || val > 599 {
+					return ApplicationWebhook_RetryPolicyValidationError{
+						field:  fmt.Sprintf("retryable_status_codes[%v]", idx),
+						reason: "value must be inside range [100, 599]",
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhook_RetryPolicyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_RetryPolicyValidationError is the validation error
+// returned by ApplicationWebhook_RetryPolicy.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhook_RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_RetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_RetryPolicyValidationError) ErrorName() string {
+	return "ApplicationWebhook_RetryPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_RetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_RetryPolicyValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhook_Signing with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhook_Signing) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_SigningFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "secret":
+
+			if l := utf8.RuneCountInString(m.GetSecret()); l < 16 || l > 128 {
+				return ApplicationWebhook_SigningValidationError{
+					field:  "secret",
+					reason: "value length must be between 16 and 128 runes, inclusive",
+				}
+			}
+
+		default:
+			return ApplicationWebhook_SigningValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_SigningValidationError is the validation error returned by
+// ApplicationWebhook_Signing.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhook_SigningValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_SigningValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_SigningValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_SigningValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_SigningValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_SigningValidationError) ErrorName() string {
+	return "ApplicationWebhook_SigningValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_SigningValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_Signing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_SigningValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_SigningValidationError{}
+
+// ValidateFields checks the field values on
+// ApplicationWebhook_OAuth2ClientCredentials with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is
+// returned.
+func (m *ApplicationWebhook_OAuth2ClientCredentials) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_OAuth2ClientCredentialsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "token_url":
+
+			if uri, err := url.Parse(m.GetTokenURL()); err != nil {
+				return ApplicationWebhook_OAuth2ClientCredentialsValidationError{
+					field:  "token_url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return ApplicationWebhook_OAuth2ClientCredentialsValidationError{
+					field:  "token_url",
+					reason: "value must be absolute",
+				}
+			}
+
+		case "client_id":
+
+			if utf8.RuneCountInString(m.GetClientID()) > 256 {
+				return ApplicationWebhook_OAuth2ClientCredentialsValidationError{
+					field:  "client_id",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		case "client_secret":
+
+			if utf8.RuneCountInString(m.GetClientSecret()) > 256 {
+				return ApplicationWebhook_OAuth2ClientCredentialsValidationError{
+					field:  "client_secret",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		case "scopes":
+
+			for idx, item := range m.GetScopes() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 256 {
+					return ApplicationWebhook_OAuth2ClientCredentialsValidationError{
+						field:  fmt.Sprintf("scopes[%v]", idx),
+						reason: "value length must be at most 256 runes",
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhook_OAuth2ClientCredentialsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_OAuth2ClientCredentialsValidationError is the validation
+// error returned by ApplicationWebhook_OAuth2ClientCredentials.ValidateFields
+// if the designated constraints aren't met.
+type ApplicationWebhook_OAuth2ClientCredentialsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_OAuth2ClientCredentialsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_OAuth2ClientCredentialsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_OAuth2ClientCredentialsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_OAuth2ClientCredentialsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_OAuth2ClientCredentialsValidationError) ErrorName() string {
+	return "ApplicationWebhook_OAuth2ClientCredentialsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_OAuth2ClientCredentialsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_OAuth2ClientCredentials.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_OAuth2ClientCredentialsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_OAuth2ClientCredentialsValidationError{}
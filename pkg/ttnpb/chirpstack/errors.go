@@ -0,0 +1,27 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chirpstack
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errModulation   = errors.DefineInvalidArgument("modulation", "invalid modulation `{modulation}`")
+	errNoTxInfo     = errors.DefineInvalidArgument("no_tx_info", "no transmission information")
+	errNoRxInfo     = errors.DefineInvalidArgument("no_rx_info", "no reception information")
+	errNotScheduled = errors.DefineInvalidArgument("not_scheduled", "downlink message not scheduled")
+	errCRC          = errors.DefineInvalidArgument("crc", "invalid CRC")
+)
@@ -0,0 +1,335 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chirpstack implements the ChirpStack Gateway Bridge protocol messages.
+package chirpstack
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// eirpDelta is the delta between EIRP and ERP.
+	eirpDelta = 2.15
+
+	// fskBandwidth is the bandwidth of the FSK channel in kHz.
+	fskBandwidth = 125
+)
+
+var (
+	sourceToTTN = map[LocationSource]ttnpb.LocationSource{
+		LocationSource_GPS:               ttnpb.SOURCE_GPS,
+		LocationSource_CONFIG:            ttnpb.SOURCE_REGISTRY,
+		LocationSource_GEO_RESOLVER_TDOA: ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+		LocationSource_GEO_RESOLVER_RSSI: ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+		LocationSource_GEO_RESOLVER_GNSS: ttnpb.SOURCE_GPS,
+		LocationSource_GEO_RESOLVER_WIFI: ttnpb.SOURCE_WIFI_RSSI_GEOLOCATION,
+	}
+	ttnAckResult = map[TxAckStatus]ttnpb.TxAcknowledgment_Result{
+		TxAckStatus_OK:               ttnpb.TxAcknowledgment_SUCCESS,
+		TxAckStatus_TOO_LATE:         ttnpb.TxAcknowledgment_TOO_LATE,
+		TxAckStatus_TOO_EARLY:        ttnpb.TxAcknowledgment_TOO_EARLY,
+		TxAckStatus_COLLISION_PACKET: ttnpb.TxAcknowledgment_COLLISION_PACKET,
+		TxAckStatus_COLLISION_BEACON: ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		TxAckStatus_TX_FREQ:          ttnpb.TxAcknowledgment_TX_FREQ,
+		TxAckStatus_TX_POWER:         ttnpb.TxAcknowledgment_TX_POWER,
+		TxAckStatus_GPS_UNLOCKED:     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+	}
+)
+
+func stdTime(ts *types.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// ToUplinkMessage converts the uplink frame to an uplink message.
+func ToUplinkMessage(frame *UplinkFrame, ids ttnpb.GatewayIdentifiers) (*ttnpb.UplinkMessage, error) {
+	tx, rx := frame.TxInfo, frame.RxInfo
+	if tx == nil {
+		return nil, errNoTxInfo.New()
+	}
+	if rx == nil {
+		return nil, errNoRxInfo.New()
+	}
+	if rx.CrcStatus == CRCStatus_BAD_CRC {
+		return nil, errCRC.New()
+	}
+	up := &ttnpb.UplinkMessage{
+		RawPayload: frame.PhyPayload,
+		Settings: ttnpb.TxSettings{
+			Frequency: uint64(tx.Frequency),
+			Time:      stdTime(rx.Time),
+		},
+	}
+	switch {
+	case tx.Modulation == Modulation_LORA && tx.GetLoraModulationInfo() != nil:
+		info := tx.GetLoraModulationInfo()
+		up.Settings.DataRate = ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       info.Bandwidth * 1000,
+					SpreadingFactor: info.SpreadingFactor,
+				},
+			},
+		}
+		up.Settings.CodingRate = info.CodeRate
+	case tx.Modulation == Modulation_FSK && tx.GetFskModulationInfo() != nil:
+		up.Settings.DataRate = ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_FSK{
+				FSK: &ttnpb.FSKDataRate{
+					BitRate: tx.GetFskModulationInfo().Datarate,
+				},
+			},
+		}
+	default:
+		return nil, errModulation.WithAttributes("modulation", tx.Modulation)
+	}
+	if len(rx.Context) == 4 {
+		up.Settings.Timestamp = binary.BigEndian.Uint32(rx.Context)
+	}
+
+	md := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ids,
+		AntennaIndex:       rx.Antenna,
+		ChannelIndex:       rx.Channel,
+		Timestamp:          up.Settings.Timestamp,
+		Time:               up.Settings.Time,
+		RSSI:               float32(rx.Rssi),
+		ChannelRSSI:        float32(rx.Rssi),
+		SNR:                float32(rx.LoraSnr),
+		Location:           toLocation(rx.Location),
+	}
+	if rx.FineTimestampType == FineTimestampType_PLAIN {
+		if t := stdTime(rx.GetPlainFineTimestamp().GetTime()); t != nil {
+			md.FineTimestamp = uint64(t.Nanosecond())
+		}
+	}
+	up.RxMetadata = []*ttnpb.RxMetadata{md}
+	return up, nil
+}
+
+func toLocation(loc *Location) *ttnpb.Location {
+	if loc == nil || loc.Latitude == 0 && loc.Longitude == 0 {
+		return nil
+	}
+	return &ttnpb.Location{
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Altitude:  int32(loc.Altitude),
+		Accuracy:  int32(loc.Accuracy),
+		Source:    sourceToTTN[loc.Source],
+	}
+}
+
+// ToGatewayStatus converts the gateway statistics to a gateway status.
+func ToGatewayStatus(stats *GatewayStats) *ttnpb.GatewayStatus {
+	status := &ttnpb.GatewayStatus{
+		Metrics: map[string]float32{
+			"rxin": float32(stats.RxPacketsReceived),
+			"rxok": float32(stats.RxPacketsReceivedOk),
+			"txin": float32(stats.TxPacketsReceived),
+			"txok": float32(stats.TxPacketsEmitted),
+		},
+		Versions: map[string]string{},
+	}
+	if t := stdTime(stats.Time); t != nil {
+		status.Time = *t
+	}
+	if stats.Ip != "" {
+		status.IP = []string{stats.Ip}
+	}
+	if stats.ConfigVersion != "" {
+		status.Versions["config"] = stats.ConfigVersion
+	}
+	if loc := toLocation(stats.Location); loc != nil {
+		status.AntennaLocations = []*ttnpb.Location{loc}
+	}
+	return status
+}
+
+// ToTxAcknowledgment converts the downlink acknowledgment to a Tx acknowledgment.
+func ToTxAcknowledgment(ack *DownlinkTXAck) *ttnpb.TxAcknowledgment {
+	status := TxAckStatus_OK
+	if len(ack.Items) > 0 {
+		// The gateway bridge acknowledges each transmission opportunity. As only one opportunity is sent, the first
+		// status that is not ignored is the result.
+		for _, item := range ack.Items {
+			if item.Status != TxAckStatus_IGNORED {
+				status = item.Status
+				break
+			}
+		}
+	} else if ack.Error != "" {
+		status = TxAckStatus_INTERNAL_ERROR
+		if value, ok := TxAckStatus_value[ack.Error]; ok {
+			status = TxAckStatus(value)
+		}
+	}
+	result, ok := ttnAckResult[status]
+	if !ok {
+		result = ttnpb.TxAcknowledgment_UNKNOWN_ERROR
+	}
+	return &ttnpb.TxAcknowledgment{
+		Result: result,
+	}
+}
+
+// FromDownlinkMessage converts the downlink message to a downlink frame.
+// The downlink is scheduled with a delay relative to the concentrator timestamp, which is passed as context.
+func FromDownlinkMessage(down *ttnpb.DownlinkMessage, gatewayID []byte) (*DownlinkFrame, error) {
+	settings := down.GetScheduled()
+	if settings == nil {
+		return nil, errNotScheduled.New()
+	}
+	tx := &DownlinkTXInfo{
+		GatewayId: gatewayID,
+		Frequency: uint32(settings.Frequency),
+		Timing:    DownlinkTiming_DELAY,
+		TimingInfo: &DownlinkTXInfo_DelayTimingInfo{
+			DelayTimingInfo: &DelayTimingInfo{
+				Delay: types.DurationProto(0),
+			},
+		},
+		Context: make([]byte, 4),
+	}
+	binary.BigEndian.PutUint32(tx.Context, settings.Timestamp)
+	if dl := settings.Downlink; dl != nil {
+		tx.Power = int32(dl.TxPower - eirpDelta)
+		tx.Antenna = dl.AntennaIndex
+	}
+	switch dr := settings.DataRate.Modulation.(type) {
+	case *ttnpb.DataRate_LoRa:
+		tx.Modulation = Modulation_LORA
+		tx.ModulationInfo = &DownlinkTXInfo_LoraModulationInfo{
+			LoraModulationInfo: &LoRaModulationInfo{
+				Bandwidth:             dr.LoRa.Bandwidth / 1000,
+				SpreadingFactor:       dr.LoRa.SpreadingFactor,
+				CodeRate:              settings.CodingRate,
+				PolarizationInversion: settings.GetDownlink().GetInvertPolarization(),
+			},
+		}
+	case *ttnpb.DataRate_FSK:
+		tx.Modulation = Modulation_FSK
+		tx.ModulationInfo = &DownlinkTXInfo_FskModulationInfo{
+			FskModulationInfo: &FSKModulationInfo{
+				FrequencyDeviation: dr.FSK.BitRate / 2,
+				Datarate:           dr.FSK.BitRate,
+			},
+		}
+	default:
+		return nil, errModulation.WithAttributes("modulation", "unknown")
+	}
+	downlinkID := random.Bytes(16)
+	return &DownlinkFrame{
+		PhyPayload: down.RawPayload,
+		TxInfo:     tx,
+		Token:      uint32(binary.BigEndian.Uint16(downlinkID)),
+		DownlinkId: downlinkID,
+		Items: []*DownlinkFrameItem{
+			{
+				PhyPayload: down.RawPayload,
+				TxInfo:     tx,
+			},
+		},
+		GatewayId: gatewayID,
+	}, nil
+}
+
+// FromFrequencyPlan converts the frequency plan to a gateway configuration.
+// The configuration version is derived from the channels, so that the gateway bridge only reconfigures the
+// concentrator when the channels change.
+func FromFrequencyPlan(fp *frequencyplans.FrequencyPlan, gatewayID []byte) (*GatewayConfiguration, error) {
+	phy, err := band.GetByID(fp.BandID)
+	if err != nil {
+		return nil, err
+	}
+	conf := &GatewayConfiguration{}
+	for _, ch := range fp.UplinkChannels {
+		lora := &LoRaModulationConfig{}
+		for i := ch.MinDataRate; i <= ch.MaxDataRate; i++ {
+			dr, ok := phy.DataRates[ttnpb.DataRateIndex(i)]
+			if !ok || dr.Rate.GetLoRa() == nil {
+				continue
+			}
+			lora.Bandwidth = dr.Rate.GetLoRa().Bandwidth / 1000
+			lora.SpreadingFactors = append(lora.SpreadingFactors, dr.Rate.GetLoRa().SpreadingFactor)
+		}
+		if len(lora.SpreadingFactors) == 0 {
+			continue
+		}
+		sort.Slice(lora.SpreadingFactors, func(i, j int) bool {
+			return lora.SpreadingFactors[i] < lora.SpreadingFactors[j]
+		})
+		conf.Channels = append(conf.Channels, &ChannelConfiguration{
+			Frequency:  uint32(ch.Frequency),
+			Modulation: Modulation_LORA,
+			ModulationConfig: &ChannelConfiguration_LoraModulationConfig{
+				LoraModulationConfig: lora,
+			},
+		})
+	}
+	if ch := fp.LoRaStandardChannel; ch != nil {
+		if dr, ok := phy.DataRates[ttnpb.DataRateIndex(ch.DataRate)]; ok && dr.Rate.GetLoRa() != nil {
+			conf.Channels = append(conf.Channels, &ChannelConfiguration{
+				Frequency:  uint32(ch.Frequency),
+				Modulation: Modulation_LORA,
+				ModulationConfig: &ChannelConfiguration_LoraModulationConfig{
+					LoraModulationConfig: &LoRaModulationConfig{
+						Bandwidth:        dr.Rate.GetLoRa().Bandwidth / 1000,
+						SpreadingFactors: []uint32{dr.Rate.GetLoRa().SpreadingFactor},
+					},
+				},
+			})
+		}
+	}
+	if ch := fp.FSKChannel; ch != nil {
+		if dr, ok := phy.DataRates[ttnpb.DataRateIndex(ch.DataRate)]; ok && dr.Rate.GetFSK() != nil {
+			conf.Channels = append(conf.Channels, &ChannelConfiguration{
+				Frequency:  uint32(ch.Frequency),
+				Modulation: Modulation_FSK,
+				ModulationConfig: &ChannelConfiguration_FskModulationConfig{
+					FskModulationConfig: &FSKModulationConfig{
+						Bandwidth: fskBandwidth,
+						Bitrate:   dr.Rate.GetFSK().BitRate,
+					},
+				},
+			})
+		}
+	}
+	buf, err := conf.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	h := fnv.New32a()
+	h.Write(buf)
+	conf.Version = fmt.Sprintf("%08x", h.Sum32())
+	conf.GatewayId = gatewayID
+	return conf, nil
+}
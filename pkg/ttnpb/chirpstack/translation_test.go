@@ -0,0 +1,391 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chirpstack_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var ids = ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+
+func mustTimestampProto(t time.Time) *types.Timestamp {
+	ts, err := types.TimestampProto(t)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+func TestToUplinkMessage(t *testing.T) {
+	now := time.Unix(1600000000, 0).UTC()
+	for _, tc := range []struct {
+		Name           string
+		Frame          *chirpstack.UplinkFrame
+		Expected       *ttnpb.UplinkMessage
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "LoRa",
+			Frame: &chirpstack.UplinkFrame{
+				PhyPayload: []byte{0x40, 0x01},
+				TxInfo: &chirpstack.UplinkTXInfo{
+					Frequency:  868100000,
+					Modulation: chirpstack.Modulation_LORA,
+					ModulationInfo: &chirpstack.UplinkTXInfo_LoraModulationInfo{
+						LoraModulationInfo: &chirpstack.LoRaModulationInfo{
+							Bandwidth:       125,
+							SpreadingFactor: 7,
+							CodeRate:        "4/5",
+						},
+					},
+				},
+				RxInfo: &chirpstack.UplinkRXInfo{
+					Time:      mustTimestampProto(now),
+					Rssi:      -42,
+					LoraSnr:   7.5,
+					Channel:   2,
+					Antenna:   1,
+					Context:   []byte{0x00, 0x0f, 0x42, 0x40},
+					CrcStatus: chirpstack.CRCStatus_CRC_OK,
+					Location: &chirpstack.Location{
+						Latitude:  52.37,
+						Longitude: 4.89,
+						Altitude:  10,
+						Source:    chirpstack.LocationSource_GPS,
+					},
+				},
+			},
+			Expected: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, 0x01},
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								Bandwidth:       125000,
+								SpreadingFactor: 7,
+							},
+						},
+					},
+					CodingRate: "4/5",
+					Frequency:  868100000,
+					Timestamp:  1000000,
+					Time:       &now,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ids,
+						AntennaIndex:       1,
+						ChannelIndex:       2,
+						Timestamp:          1000000,
+						Time:               &now,
+						RSSI:               -42,
+						ChannelRSSI:        -42,
+						SNR:                7.5,
+						Location: &ttnpb.Location{
+							Latitude:  52.37,
+							Longitude: 4.89,
+							Altitude:  10,
+							Source:    ttnpb.SOURCE_GPS,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "FSK",
+			Frame: &chirpstack.UplinkFrame{
+				PhyPayload: []byte{0x40, 0x02},
+				TxInfo: &chirpstack.UplinkTXInfo{
+					Frequency:  868800000,
+					Modulation: chirpstack.Modulation_FSK,
+					ModulationInfo: &chirpstack.UplinkTXInfo_FskModulationInfo{
+						FskModulationInfo: &chirpstack.FSKModulationInfo{
+							Datarate: 50000,
+						},
+					},
+				},
+				RxInfo: &chirpstack.UplinkRXInfo{
+					Rssi:    -80,
+					Context: []byte{0x00, 0x00, 0x00, 0x01},
+				},
+			},
+			Expected: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, 0x02},
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_FSK{
+							FSK: &ttnpb.FSKDataRate{
+								BitRate: 50000,
+							},
+						},
+					},
+					Frequency: 868800000,
+					Timestamp: 1,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ids,
+						Timestamp:          1,
+						RSSI:               -80,
+						ChannelRSSI:        -80,
+					},
+				},
+			},
+		},
+		{
+			Name: "BadCRC",
+			Frame: &chirpstack.UplinkFrame{
+				TxInfo: &chirpstack.UplinkTXInfo{},
+				RxInfo: &chirpstack.UplinkRXInfo{
+					CrcStatus: chirpstack.CRCStatus_BAD_CRC,
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "LRFHSS",
+			Frame: &chirpstack.UplinkFrame{
+				TxInfo: &chirpstack.UplinkTXInfo{
+					Modulation: chirpstack.Modulation_LR_FHSS,
+					ModulationInfo: &chirpstack.UplinkTXInfo_LrFhssModulationInfo{
+						LrFhssModulationInfo: &chirpstack.LRFHSSModulationInfo{
+							OperatingChannelWidth: 137000,
+							CodeRate:              "2/3",
+						},
+					},
+				},
+				RxInfo: &chirpstack.UplinkRXInfo{},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NoRxInfo",
+			Frame: &chirpstack.UplinkFrame{
+				TxInfo: &chirpstack.UplinkTXInfo{},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			up, err := chirpstack.ToUplinkMessage(tc.Frame, ids)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(up, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestToGatewayStatus(t *testing.T) {
+	a := assertions.New(t)
+
+	now := time.Unix(1600000000, 0).UTC()
+	status := chirpstack.ToGatewayStatus(&chirpstack.GatewayStats{
+		Ip:                  "192.168.1.2",
+		Time:                mustTimestampProto(now),
+		ConfigVersion:       "0a1b2c3d",
+		RxPacketsReceived:   10,
+		RxPacketsReceivedOk: 8,
+		TxPacketsReceived:   3,
+		TxPacketsEmitted:    2,
+		Location: &chirpstack.Location{
+			Latitude:  52.37,
+			Longitude: 4.89,
+			Source:    chirpstack.LocationSource_CONFIG,
+		},
+	})
+	a.So(status, should.Resemble, &ttnpb.GatewayStatus{
+		Time: now,
+		IP:   []string{"192.168.1.2"},
+		Metrics: map[string]float32{
+			"rxin": 10,
+			"rxok": 8,
+			"txin": 3,
+			"txok": 2,
+		},
+		Versions: map[string]string{
+			"config": "0a1b2c3d",
+		},
+		AntennaLocations: []*ttnpb.Location{
+			{
+				Latitude:  52.37,
+				Longitude: 4.89,
+				Source:    ttnpb.SOURCE_REGISTRY,
+			},
+		},
+	})
+}
+
+func TestToTxAcknowledgment(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Ack      *chirpstack.DownlinkTXAck
+		Expected ttnpb.TxAcknowledgment_Result
+	}{
+		{
+			Name:     "Empty",
+			Ack:      &chirpstack.DownlinkTXAck{},
+			Expected: ttnpb.TxAcknowledgment_SUCCESS,
+		},
+		{
+			Name: "Items",
+			Ack: &chirpstack.DownlinkTXAck{
+				Items: []*chirpstack.DownlinkTXAckItem{
+					{Status: chirpstack.TxAckStatus_IGNORED},
+					{Status: chirpstack.TxAckStatus_TOO_LATE},
+				},
+			},
+			Expected: ttnpb.TxAcknowledgment_TOO_LATE,
+		},
+		{
+			Name: "QueueFull",
+			Ack: &chirpstack.DownlinkTXAck{
+				Items: []*chirpstack.DownlinkTXAckItem{
+					{Status: chirpstack.TxAckStatus_QUEUE_FULL},
+				},
+			},
+			Expected: ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+		},
+		{
+			Name: "LegacyError",
+			Ack: &chirpstack.DownlinkTXAck{
+				Error: "COLLISION_BEACON",
+			},
+			Expected: ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		},
+		{
+			Name: "UnknownLegacyError",
+			Ack: &chirpstack.DownlinkTXAck{
+				Error: "something went wrong",
+			},
+			Expected: ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(chirpstack.ToTxAcknowledgment(tc.Ack).Result, should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestFromDownlinkMessage(t *testing.T) {
+	a := assertions.New(t)
+
+	gatewayID := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	_, err := chirpstack.FromDownlinkMessage(&ttnpb.DownlinkMessage{
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{},
+		},
+	}, gatewayID)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	frame, err := chirpstack.FromDownlinkMessage(&ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60, 0x01},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 9,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Frequency:  869525000,
+				Timestamp:  1000000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					TxPower:            16.15,
+					InvertPolarization: true,
+				},
+			},
+		},
+	}, gatewayID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(frame.GatewayId, should.Resemble, gatewayID)
+	a.So(frame.DownlinkId, should.HaveLength, 16)
+	a.So(frame.PhyPayload, should.Resemble, []byte{0x60, 0x01})
+	a.So(frame.Items, should.HaveLength, 1)
+	a.So(frame.Items[0].TxInfo, should.Equal, frame.TxInfo)
+	a.So(frame.TxInfo, should.Resemble, &chirpstack.DownlinkTXInfo{
+		GatewayId:  gatewayID,
+		Frequency:  869525000,
+		Power:      14,
+		Modulation: chirpstack.Modulation_LORA,
+		ModulationInfo: &chirpstack.DownlinkTXInfo_LoraModulationInfo{
+			LoraModulationInfo: &chirpstack.LoRaModulationInfo{
+				Bandwidth:             125,
+				SpreadingFactor:       9,
+				CodeRate:              "4/5",
+				PolarizationInversion: true,
+			},
+		},
+		Timing: chirpstack.DownlinkTiming_DELAY,
+		TimingInfo: &chirpstack.DownlinkTXInfo_DelayTimingInfo{
+			DelayTimingInfo: &chirpstack.DelayTimingInfo{
+				Delay: types.DurationProto(0),
+			},
+		},
+		Context: []byte{0x00, 0x0f, 0x42, 0x40},
+	})
+}
+
+func TestFromFrequencyPlan(t *testing.T) {
+	a := assertions.New(t)
+
+	fp, err := test.FrequencyPlanStore.GetByID(test.EUFrequencyPlanID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	conf, err := chirpstack.FromFrequencyPlan(fp, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(conf.Version, should.HaveLength, 8)
+	a.So(conf.Channels, should.HaveLength, len(fp.UplinkChannels)+2)
+	a.So(conf.Channels[0], should.Resemble, &chirpstack.ChannelConfiguration{
+		Frequency:  uint32(fp.UplinkChannels[0].Frequency),
+		Modulation: chirpstack.Modulation_LORA,
+		ModulationConfig: &chirpstack.ChannelConfiguration_LoraModulationConfig{
+			LoraModulationConfig: &chirpstack.LoRaModulationConfig{
+				Bandwidth:        125,
+				SpreadingFactors: []uint32{7, 8, 9, 10, 11, 12},
+			},
+		},
+	})
+	a.So(conf.Channels[len(conf.Channels)-1].Modulation, should.Equal, chirpstack.Modulation_FSK)
+
+	// The version only depends on the channels.
+	other, err := chirpstack.FromFrequencyPlan(fp, []byte{8, 7, 6, 5, 4, 3, 2, 1})
+	a.So(err, should.BeNil)
+	a.So(other.Version, should.Equal, conf.Version)
+}
@@ -62,6 +62,16 @@ type ClaimEndDeviceRequest struct {
 	TargetApplicationServerID string `protobuf:"bytes,11,opt,name=target_application_server_id,json=targetApplicationServerId,proto3" json:"target_application_server_id,omitempty"`
 	// Home NetID.
 	TargetNetID *go_thethings_network_lorawan_stack_v3_pkg_types.NetID `protobuf:"bytes,13,opt,name=target_net_id,json=targetNetId,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"target_net_id,omitempty"`
+	// The frequency plan ID of the target end device on the Network Server.
+	// If set, this overrides the frequency plan ID of the source device.
+	// If not set and the source device is not registered on a Network Server, the claim fails.
+	TargetFrequencyPlanID string `protobuf:"bytes,14,opt,name=target_frequency_plan_id,json=targetFrequencyPlanId,proto3" json:"target_frequency_plan_id,omitempty"`
+	// The LoRaWAN MAC version of the target end device on the Network Server.
+	// If not set and the source device is not registered on a Network Server, the version of the device profile is used.
+	TargetLoRaWANVersion MACVersion `protobuf:"varint,15,opt,name=target_lorawan_version,json=targetLorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"target_lorawan_version,omitempty"`
+	// The LoRaWAN PHY version of the target end device on the Network Server.
+	// If not set and the source device is not registered on a Network Server, the version of the device profile is used.
+	TargetLoRaWANPHYVersion PHYVersion `protobuf:"varint,16,opt,name=target_lorawan_phy_version,json=targetLorawanPhyVersion,proto3,enum=ttn.lorawan.v3.PHYVersion" json:"target_lorawan_phy_version,omitempty"`
 	// If set, invalidate the authentication code with which the device gets claimed. This prohibits subsequent claiming requests.
 	InvalidateAuthenticationCode bool     `protobuf:"varint,5,opt,name=invalidate_authentication_code,json=invalidateAuthenticationCode,proto3" json:"invalidate_authentication_code,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
@@ -187,6 +197,27 @@ func (m *ClaimEndDeviceRequest) GetTargetApplicationServerID() string {
 	return ""
 }
 
+func (m *ClaimEndDeviceRequest) GetTargetFrequencyPlanID() string {
+	if m != nil {
+		return m.TargetFrequencyPlanID
+	}
+	return ""
+}
+
+func (m *ClaimEndDeviceRequest) GetTargetLoRaWANVersion() MACVersion {
+	if m != nil {
+		return m.TargetLoRaWANVersion
+	}
+	return MAC_UNKNOWN
+}
+
+func (m *ClaimEndDeviceRequest) GetTargetLoRaWANPHYVersion() PHYVersion {
+	if m != nil {
+		return m.TargetLoRaWANPHYVersion
+	}
+	return PHY_UNKNOWN
+}
+
 func (m *ClaimEndDeviceRequest) GetInvalidateAuthenticationCode() bool {
 	if m != nil {
 		return m.InvalidateAuthenticationCode
@@ -318,85 +349,94 @@ func init() {
 }
 
 var fileDescriptor_e8a7f6d184fc3dc3 = []byte{
-	// 1245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6c, 0xdb, 0x54,
-	0x18, 0xf7, 0xeb, 0x9f, 0xa4, 0x7b, 0x5d, 0x4b, 0x64, 0x4a, 0xe7, 0xb5, 0xe5, 0xb9, 0xeb, 0x32,
-	0x96, 0x76, 0xb5, 0xcd, 0x52, 0x86, 0x44, 0x05, 0x54, 0xf1, 0x12, 0x91, 0xac, 0x68, 0x02, 0xb3,
-	0x5d, 0xd6, 0xad, 0xe1, 0x35, 0x7e, 0x4b, 0x4d, 0x52, 0x3b, 0xb3, 0x9d, 0x8c, 0xb4, 0xab, 0x54,
-	0x4d, 0x48, 0x4c, 0x3b, 0x00, 0x12, 0x17, 0x24, 0x2e, 0x5c, 0x26, 0x4d, 0xe2, 0xc0, 0x8e, 0x3b,
-	0x8e, 0xdb, 0x8e, 0x13, 0x5c, 0xa6, 0x1d, 0xa2, 0xc5, 0xe1, 0xb0, 0xe3, 0x8e, 0x55, 0x4e, 0xc8,
-	0xcf, 0x6e, 0xe2, 0x34, 0x89, 0xd8, 0xe0, 0xc2, 0xed, 0x7b, 0xf9, 0xbe, 0xf7, 0xfd, 0x7e, 0xdf,
-	0xcf, 0x5f, 0xbe, 0xef, 0xc1, 0xc5, 0xa2, 0x61, 0xe2, 0x9b, 0x58, 0x17, 0x2c, 0x1b, 0xe7, 0x0a,
-	0x12, 0x2e, 0x69, 0x92, 0x4a, 0x2a, 0x5a, 0x8e, 0xe4, 0x8a, 0x58, 0xdb, 0xd2, 0xf4, 0xbc, 0x45,
-	0xcc, 0x0a, 0x31, 0xc5, 0x92, 0x69, 0xd8, 0x06, 0x3b, 0x6e, 0xdb, 0xba, 0xe8, 0xdf, 0x10, 0x2b,
-	0x4b, 0x53, 0x89, 0xbc, 0x66, 0x6f, 0x96, 0x37, 0xc4, 0x9c, 0xb1, 0x25, 0x11, 0xbd, 0x62, 0x54,
-	0x4b, 0xa6, 0xf1, 0x75, 0x55, 0xa2, 0xc1, 0x39, 0x21, 0x4f, 0x74, 0xa1, 0x82, 0x8b, 0x9a, 0x8a,
-	0x6d, 0x22, 0x75, 0x19, 0x5e, 0xca, 0x29, 0x21, 0x90, 0x22, 0x6f, 0xe4, 0x0d, 0xef, 0xf2, 0x46,
-	0xf9, 0x3a, 0x3d, 0xd1, 0x03, 0xb5, 0xfc, 0xf0, 0x99, 0xbc, 0x61, 0xe4, 0x8b, 0x84, 0x12, 0xc5,
-	0xba, 0x6e, 0xd8, 0xd8, 0xd6, 0x0c, 0xdd, 0xf2, 0xbd, 0xd3, 0xbe, 0xb7, 0x95, 0x83, 0x6c, 0x95,
-	0xec, 0xaa, 0xef, 0x3c, 0xd9, 0x5d, 0xaa, 0xa6, 0x12, 0xdd, 0xd6, 0xae, 0x6b, 0xc4, 0xf4, 0x33,
-	0xcc, 0x3d, 0x1b, 0x83, 0x6f, 0x9d, 0x77, 0x4b, 0x4f, 0xe9, 0x6a, 0x92, 0x0a, 0xa1, 0x90, 0x1b,
-	0x65, 0x62, 0xd9, 0xec, 0xcf, 0x00, 0x1e, 0xc7, 0x65, 0x7b, 0xd3, 0xbd, 0x91, 0xc3, 0x36, 0x51,
-	0xb3, 0x81, 0xdb, 0x1c, 0x98, 0x05, 0xb1, 0xd1, 0xf8, 0xc7, 0x62, 0xa7, 0x40, 0x62, 0xcf, 0x54,
-	0x62, 0x22, 0x98, 0x26, 0xd3, 0xce, 0x22, 0xcf, 0x38, 0x35, 0x9e, 0xeb, 0xe7, 0x4d, 0x33, 0x0a,
-	0x87, 0xfb, 0xf8, 0x58, 0x09, 0x86, 0x6f, 0x98, 0xd9, 0x9c, 0xa1, 0x12, 0x6e, 0x60, 0x16, 0xc4,
-	0x8e, 0xca, 0x13, 0x4d, 0x39, 0xbc, 0x3d, 0x1c, 0x61, 0xb8, 0xbd, 0x11, 0xa7, 0xc6, 0x87, 0x3e,
-	0x57, 0xce, 0x1b, 0x2a, 0x49, 0x33, 0x4a, 0xe8, 0x86, 0xe9, 0x5a, 0xec, 0x6d, 0x00, 0x27, 0x6d,
-	0x6c, 0xe6, 0x89, 0x9d, 0xc5, 0xa5, 0x52, 0xd1, 0xcd, 0xa8, 0x19, 0x7a, 0x56, 0x53, 0x2d, 0x6e,
-	0x90, 0xd6, 0xf2, 0xce, 0xe1, 0x5a, 0x12, 0xed, 0xb0, 0x20, 0xe7, 0x68, 0x53, 0x1e, 0xbe, 0x0b,
-	0x06, 0x22, 0xe0, 0x71, 0x8d, 0x67, 0x9c, 0x1a, 0x3f, 0x71, 0x89, 0x66, 0x0d, 0x46, 0x27, 0x2d,
-	0x65, 0xc2, 0xee, 0xfa, 0x55, 0xb5, 0xd8, 0xeb, 0x30, 0xe2, 0x73, 0xf0, 0x9a, 0x2e, 0xab, 0xa9,
-	0xdc, 0xd0, 0x2c, 0x88, 0x1d, 0x91, 0x3f, 0x6c, 0xca, 0xa7, 0xcd, 0x53, 0x5c, 0x34, 0x7e, 0x62,
-	0x7d, 0x0d, 0x0b, 0xdb, 0xef, 0x0a, 0x1f, 0x5c, 0x8b, 0xad, 0x2c, 0xaf, 0x09, 0xd7, 0x56, 0x0e,
-	0x8e, 0xf3, 0x3b, 0xf1, 0xc5, 0xdd, 0xe8, 0xad, 0xf5, 0xa8, 0x53, 0xe3, 0xc7, 0x3d, 0x4c, 0x4f,
-	0xe5, 0x4c, 0x52, 0x19, 0xb7, 0x83, 0x67, 0x95, 0xfd, 0x03, 0xc0, 0xb7, 0x7d, 0x20, 0x9d, 0xd8,
-	0x37, 0x0d, 0xb3, 0x90, 0xf5, 0xfa, 0x3a, 0x8b, 0x55, 0xd5, 0x24, 0x96, 0xc5, 0x85, 0x29, 0xea,
-	0x77, 0xa0, 0x29, 0xdf, 0x05, 0xe6, 0xb7, 0x20, 0xfe, 0x0d, 0x58, 0x8f, 0xad, 0x2c, 0xbb, 0x90,
-	0x58, 0xd8, 0x4e, 0x08, 0x57, 0x5c, 0xc4, 0x5b, 0x01, 0xbb, 0x6d, 0x5e, 0x15, 0xae, 0x2d, 0x04,
-	0x1c, 0xf3, 0x57, 0xc5, 0xf9, 0x05, 0xf7, 0x5e, 0x42, 0xb8, 0xe2, 0x33, 0xbd, 0x15, 0xb0, 0xdb,
-	0x26, 0xbd, 0xd7, 0x76, 0xcc, 0xc7, 0x56, 0x96, 0x97, 0xd7, 0x5c, 0x6b, 0xe7, 0xec, 0xe2, 0xb9,
-	0xdd, 0xf9, 0x15, 0xb7, 0x34, 0x65, 0xca, 0x63, 0x7d, 0xd1, 0x23, 0xfd, 0x05, 0xe5, 0x9c, 0xf0,
-	0x28, 0xb3, 0x5b, 0x90, 0xef, 0x5d, 0x53, 0x81, 0x14, 0xb2, 0x45, 0xbc, 0x41, 0x8a, 0xdc, 0x08,
-	0xad, 0xea, 0x74, 0x53, 0x1e, 0x36, 0x07, 0xb9, 0xbd, 0x88, 0x53, 0xe3, 0xa7, 0x2f, 0x75, 0x27,
-	0x5c, 0x4d, 0xad, 0x7e, 0xea, 0x86, 0x2b, 0xd3, 0x3d, 0xd0, 0x56, 0x49, 0x81, 0x3a, 0xd9, 0x67,
-	0x00, 0x9e, 0xe8, 0xd1, 0x30, 0x87, 0x74, 0x3c, 0xf2, 0xff, 0xd4, 0x11, 0x75, 0xb5, 0x5f, 0xa7,
-	0x96, 0x55, 0x78, 0xb2, 0x7f, 0x6d, 0x6d, 0x3d, 0x21, 0xad, 0xee, 0x4c, 0x40, 0x4f, 0xfe, 0x52,
-	0xef, 0xc4, 0x2d, 0x4d, 0xf9, 0x3e, 0xc8, 0x2d, 0x5d, 0x09, 0x9c, 0xe9, 0x0f, 0xad, 0xa9, 0xdc,
-	0x28, 0xc5, 0x8c, 0x36, 0xe5, 0x21, 0x73, 0x80, 0x53, 0x9d, 0x1a, 0x7f, 0xbc, 0x0f, 0x64, 0x26,
-	0xa9, 0x1c, 0xef, 0x03, 0x96, 0x51, 0xd9, 0x22, 0x1c, 0x6b, 0x77, 0x8b, 0x9b, 0x77, 0x8c, 0x8e,
-	0x89, 0xf4, 0xb3, 0x1a, 0x7f, 0x2e, 0x6f, 0x88, 0xf6, 0x26, 0xb1, 0x37, 0xdd, 0x71, 0x2f, 0xfa,
-	0xcd, 0x24, 0x75, 0x4e, 0xcb, 0xca, 0x92, 0x54, 0x2a, 0xe4, 0x25, 0xbb, 0x5a, 0x22, 0x96, 0x78,
-	0x91, 0xd8, 0x99, 0xa4, 0x53, 0xe3, 0x47, 0x5b, 0xcd, 0x94, 0x49, 0x2a, 0xa3, 0xad, 0xe6, 0xc9,
-	0xa8, 0x6c, 0x12, 0x22, 0x4d, 0x3f, 0x98, 0xf4, 0xd9, 0xc0, 0xd4, 0x72, 0x6b, 0xa3, 0x53, 0x6a,
-	0x78, 0x16, 0xc4, 0x46, 0x94, 0x99, 0x76, 0x54, 0xa2, 0x23, 0xc8, 0x9d, 0x51, 0x53, 0xbf, 0x0e,
-	0xc0, 0xbe, 0xd3, 0x90, 0xcd, 0xc1, 0x91, 0xaf, 0x0c, 0x4d, 0xcf, 0x92, 0xb2, 0x46, 0xa7, 0xef,
-	0x51, 0x39, 0xed, 0x4e, 0xa0, 0x7f, 0x53, 0x4f, 0xea, 0x72, 0xe6, 0xfd, 0xf7, 0x9c, 0x1a, 0x1f,
-	0xbe, 0x60, 0x68, 0x7a, 0xea, 0x72, 0x46, 0x09, 0xbb, 0x99, 0x53, 0x65, 0x8d, 0xfd, 0x12, 0x86,
-	0x55, 0x52, 0xa1, 0x18, 0xde, 0x58, 0xfd, 0xe4, 0xbf, 0x62, 0x84, 0x92, 0xa4, 0xe2, 0x42, 0x84,
-	0x54, 0x52, 0x71, 0x11, 0xd2, 0xf0, 0xcd, 0x5e, 0xf2, 0x0c, 0xd2, 0xaf, 0x7e, 0xac, 0x29, 0x4f,
-	0x98, 0x6c, 0x3c, 0xb2, 0xbe, 0xe6, 0xff, 0x2b, 0x76, 0xce, 0x2e, 0x2e, 0xc5, 0x77, 0xa3, 0x0a,
-	0x8b, 0xbb, 0xd4, 0x92, 0x27, 0xe0, 0x98, 0x65, 0x94, 0xcd, 0x1c, 0xf1, 0x87, 0x29, 0x3b, 0xb8,
-	0x2f, 0x83, 0x0b, 0x43, 0x23, 0xa1, 0x48, 0xf8, 0xc2, 0xd0, 0xc8, 0xd1, 0xc8, 0xd8, 0xdc, 0x6f,
-	0x00, 0x4e, 0xbb, 0x7a, 0x1a, 0xa6, 0xb6, 0x4d, 0x02, 0x4d, 0x72, 0xb0, 0xe2, 0x30, 0x7c, 0xe3,
-	0xf0, 0x2e, 0x00, 0xaf, 0xb5, 0x0b, 0x22, 0xc1, 0x5d, 0xf0, 0xa4, 0xc6, 0x03, 0x65, 0x1c, 0x77,
-	0x4e, 0x7c, 0x01, 0x86, 0x71, 0x49, 0xcb, 0x16, 0x48, 0x95, 0x0a, 0x7a, 0x84, 0xee, 0x29, 0x73,
-	0x38, 0x02, 0xb8, 0x3d, 0xe0, 0xaa, 0x93, 0xf8, 0x2c, 0xb3, 0x4a, 0xaa, 0x4a, 0x08, 0x97, 0xb4,
-	0x55, 0x52, 0x8d, 0xff, 0x3e, 0x08, 0x8f, 0xb5, 0xd6, 0xe7, 0x79, 0xff, 0x49, 0xe2, 0xf5, 0x34,
-	0x5b, 0x84, 0xc3, 0xf4, 0x17, 0xf6, 0xd4, 0x2b, 0x6d, 0xdd, 0xa9, 0xe8, 0xe1, 0xb0, 0x56, 0x44,
-	0xa0, 0x84, 0xb9, 0xc9, 0xdb, 0x7f, 0xfe, 0xf5, 0xe3, 0x40, 0x64, 0x6e, 0x54, 0x22, 0x6a, 0xce,
-	0x92, 0xe8, 0x33, 0x68, 0x19, 0x2c, 0xb0, 0xf7, 0x00, 0x9c, 0xe8, 0xa5, 0x1d, 0x7b, 0xa6, 0x4b,
-	0x9b, 0xfe, 0x0a, 0x4f, 0x4d, 0x8a, 0xde, 0x0b, 0x45, 0x3c, 0x78, 0xa1, 0x88, 0x29, 0xf7, 0x85,
-	0x32, 0x97, 0xa6, 0xa8, 0xf2, 0xdc, 0x47, 0x1e, 0x6a, 0x40, 0x34, 0x4b, 0xda, 0x39, 0xf4, 0x4d,
-	0xc4, 0xce, 0xf3, 0xae, 0x84, 0x0f, 0x00, 0x5d, 0x9e, 0xdf, 0x03, 0x38, 0x79, 0x59, 0xc7, 0xbd,
-	0x98, 0xbe, 0xe2, 0x57, 0xec, 0x4b, 0xf2, 0x1c, 0x25, 0x29, 0x2d, 0x08, 0xff, 0x4c, 0x32, 0x40,
-	0x4a, 0xbe, 0x07, 0x1e, 0xd7, 0x11, 0x78, 0x52, 0x47, 0xe0, 0x69, 0x1d, 0x31, 0xcf, 0xeb, 0x88,
-	0x79, 0x51, 0x47, 0xcc, 0xcb, 0x3a, 0x62, 0xf6, 0xeb, 0x08, 0xec, 0x39, 0x08, 0xdc, 0x71, 0x10,
-	0x73, 0xdf, 0x41, 0xe0, 0x81, 0x83, 0x98, 0x87, 0x0e, 0x62, 0x1e, 0x39, 0x88, 0x79, 0xec, 0x20,
-	0xf0, 0xc4, 0x41, 0xe0, 0xa9, 0x83, 0x98, 0xe7, 0x0e, 0x02, 0x2f, 0x1c, 0xc4, 0xbc, 0x74, 0x10,
-	0xd8, 0x77, 0x10, 0xb3, 0xd7, 0x40, 0xcc, 0x9d, 0x06, 0x02, 0x3f, 0x34, 0x10, 0xf3, 0x53, 0x03,
-	0x81, 0x5f, 0x1a, 0x88, 0xb9, 0xdf, 0x40, 0xcc, 0x83, 0x06, 0x02, 0x0f, 0x1b, 0x08, 0x3c, 0x6a,
-	0x20, 0x70, 0x45, 0x7a, 0x8d, 0x7f, 0xa8, 0xad, 0x97, 0x36, 0x36, 0x42, 0xb4, 0xdc, 0xa5, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x84, 0xdf, 0xe6, 0x13, 0x0b, 0x00, 0x00,
+	// 1383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0xc9, 0x8f, 0x9d, 0x4e, 0x9a, 0xd4, 0x5a, 0xd2, 0x64, 0xeb, 0x84, 0xd9, 0x34, 0x4d,
+	0xa9, 0x93, 0xc6, 0xbb, 0xd4, 0xa1, 0x48, 0x44, 0x40, 0xf0, 0xc6, 0x81, 0xb8, 0x29, 0x55, 0x58,
+	0x5a, 0x50, 0x9b, 0x36, 0x66, 0xe2, 0x9d, 0x38, 0x4b, 0x9c, 0x5d, 0x77, 0x77, 0xe3, 0xe2, 0xfc,
+	0x48, 0x51, 0x85, 0x44, 0xd5, 0x03, 0x20, 0x71, 0x41, 0xe2, 0xc2, 0xa5, 0xa2, 0x12, 0x07, 0x7a,
+	0xec, 0xb1, 0xdc, 0x7a, 0xac, 0xe0, 0x52, 0xf5, 0x60, 0x35, 0x6b, 0x0e, 0x3d, 0xf6, 0x58, 0xf9,
+	0x84, 0x76, 0x76, 0x6d, 0xaf, 0xff, 0x44, 0x0b, 0x17, 0x6e, 0x33, 0xfb, 0xde, 0x7c, 0xdf, 0x7b,
+	0x9f, 0x9f, 0xdf, 0x7b, 0x70, 0x2a, 0xab, 0x1b, 0xf8, 0x06, 0xd6, 0xa2, 0xa6, 0x85, 0xd3, 0x1b,
+	0x22, 0xce, 0xa9, 0xa2, 0x42, 0xf2, 0x6a, 0x9a, 0xa4, 0xb3, 0x58, 0xdd, 0x54, 0xb5, 0x8c, 0x49,
+	0x8c, 0x3c, 0x31, 0x84, 0x9c, 0xa1, 0x5b, 0x3a, 0xdb, 0x6f, 0x59, 0x9a, 0xe0, 0xbd, 0x10, 0xf2,
+	0xd3, 0xe1, 0x78, 0x46, 0xb5, 0xd6, 0xb7, 0x56, 0x85, 0xb4, 0xbe, 0x29, 0x12, 0x2d, 0xaf, 0x17,
+	0x72, 0x86, 0xfe, 0x55, 0x41, 0xa4, 0xce, 0xe9, 0x68, 0x86, 0x68, 0xd1, 0x3c, 0xce, 0xaa, 0x0a,
+	0xb6, 0x88, 0xd8, 0x74, 0x70, 0x21, 0xc3, 0x51, 0x1f, 0x44, 0x46, 0xcf, 0xe8, 0xee, 0xe3, 0xd5,
+	0xad, 0x35, 0x7a, 0xa3, 0x17, 0x7a, 0xf2, 0xdc, 0x47, 0x32, 0xba, 0x9e, 0xc9, 0x12, 0x1a, 0x28,
+	0xd6, 0x34, 0xdd, 0xc2, 0x96, 0xaa, 0x6b, 0xa6, 0x67, 0x1d, 0xf6, 0xac, 0x55, 0x0c, 0xb2, 0x99,
+	0xb3, 0x0a, 0x9e, 0xf1, 0x44, 0x73, 0xaa, 0xaa, 0x42, 0x34, 0x4b, 0x5d, 0x53, 0x89, 0x51, 0x41,
+	0xe0, 0x9b, 0x9d, 0x2a, 0xf9, 0x52, 0x87, 0xb1, 0x5f, 0x42, 0xf0, 0xe8, 0x9c, 0xa3, 0xcd, 0xbc,
+	0xa6, 0x24, 0xa8, 0x52, 0x32, 0xb9, 0xbe, 0x45, 0x4c, 0x8b, 0xfd, 0x09, 0xc0, 0x63, 0x78, 0xcb,
+	0x5a, 0x77, 0x20, 0xd3, 0xd8, 0x22, 0x4a, 0xca, 0x07, 0xcf, 0x81, 0x51, 0x10, 0xe9, 0x8d, 0xbd,
+	0x2f, 0xd4, 0x2b, 0x28, 0xb4, 0x84, 0x12, 0xe2, 0x7e, 0x98, 0x64, 0x0d, 0x45, 0x1a, 0xb1, 0x8b,
+	0x3c, 0xd7, 0xce, 0xba, 0xc0, 0xc8, 0x1c, 0x6e, 0x63, 0x63, 0x45, 0x18, 0xbc, 0x6e, 0xa4, 0xd2,
+	0xba, 0x42, 0xb8, 0x8e, 0x51, 0x10, 0x39, 0x2c, 0x0d, 0x94, 0xa5, 0xe0, 0x76, 0x77, 0x88, 0xe1,
+	0xf6, 0x7b, 0xec, 0x22, 0x1f, 0xf8, 0x44, 0x9e, 0xd3, 0x15, 0xb2, 0xc0, 0xc8, 0x81, 0xeb, 0x86,
+	0x73, 0x62, 0x6f, 0x02, 0x38, 0x68, 0x61, 0x23, 0x43, 0xac, 0x14, 0xce, 0xe5, 0xb2, 0x0e, 0xa2,
+	0xaa, 0x6b, 0x29, 0x55, 0x31, 0xb9, 0x4e, 0x9a, 0xcb, 0x1b, 0x8d, 0xb9, 0xc4, 0x6b, 0x6e, 0xfe,
+	0x98, 0xc7, 0xcb, 0x52, 0xf7, 0x6d, 0xd0, 0x11, 0x02, 0x0f, 0x8b, 0x3c, 0x63, 0x17, 0xf9, 0x81,
+	0x8b, 0x14, 0xd5, 0xef, 0x9d, 0x30, 0xe5, 0x01, 0xab, 0xe9, 0xab, 0x62, 0xb2, 0x6b, 0x30, 0xe4,
+	0xc5, 0xe0, 0x56, 0x65, 0x4a, 0x55, 0xb8, 0xae, 0x51, 0x10, 0x39, 0x24, 0xbd, 0x5b, 0x96, 0x4e,
+	0x19, 0x27, 0xb9, 0xf1, 0xd8, 0xf1, 0x95, 0x65, 0x1c, 0xdd, 0x7e, 0x33, 0xfa, 0xce, 0xb5, 0xc8,
+	0xec, 0xcc, 0x72, 0xf4, 0xda, 0x6c, 0xe5, 0x3a, 0xb1, 0x13, 0x9b, 0xda, 0x1b, 0xdf, 0x5d, 0x19,
+	0xb7, 0x8b, 0x7c, 0xbf, 0xcb, 0xe9, 0xaa, 0x9c, 0x4c, 0xc8, 0xfd, 0x96, 0xff, 0xae, 0xb0, 0x7f,
+	0x00, 0xf8, 0xba, 0x47, 0xa4, 0x11, 0xeb, 0x86, 0x6e, 0x6c, 0xa4, 0xdc, 0xc2, 0x4f, 0x61, 0x45,
+	0x31, 0x88, 0x69, 0x72, 0x41, 0xca, 0xfa, 0x2d, 0x28, 0x4b, 0xb7, 0x81, 0xf1, 0x0d, 0x88, 0x7d,
+	0x0d, 0x56, 0x22, 0xb3, 0x33, 0x0e, 0x25, 0x8e, 0x6e, 0xc7, 0xa3, 0x57, 0x1c, 0xc6, 0x5d, 0xdf,
+	0xb9, 0x76, 0xbc, 0x1a, 0xbd, 0x36, 0xe9, 0x33, 0x4c, 0x5c, 0x15, 0x26, 0x26, 0x9d, 0x77, 0xf1,
+	0xe8, 0x15, 0x2f, 0xd2, 0x5d, 0xdf, 0xb9, 0x76, 0xa4, 0xef, 0x6a, 0x86, 0x89, 0xc8, 0xec, 0xcc,
+	0xcc, 0xb2, 0x73, 0xda, 0x39, 0x33, 0x75, 0x76, 0x6f, 0x62, 0xd6, 0x49, 0x4d, 0x0e, 0xbb, 0x51,
+	0x5f, 0x70, 0x83, 0xfe, 0x94, 0xc6, 0x1c, 0x77, 0x43, 0x66, 0x37, 0x21, 0xdf, 0x3a, 0xa7, 0x0d,
+	0xb2, 0x91, 0xca, 0xe2, 0x55, 0x92, 0xe5, 0x7a, 0x68, 0x56, 0xa7, 0xca, 0x52, 0xb7, 0xd1, 0xc9,
+	0xed, 0x87, 0xec, 0x22, 0x3f, 0x7c, 0xb1, 0x19, 0x70, 0x71, 0x7e, 0xf1, 0xbc, 0xe3, 0x2e, 0x0f,
+	0xb7, 0x60, 0x5b, 0x24, 0x1b, 0xd4, 0xc8, 0x3e, 0x01, 0xf0, 0x78, 0x8b, 0x82, 0x69, 0xd0, 0xf1,
+	0xd0, 0xff, 0x53, 0x47, 0xd4, 0x54, 0x7e, 0xf5, 0x5a, 0x16, 0xe0, 0x89, 0xf6, 0xb9, 0xd5, 0xf4,
+	0x84, 0x34, 0xbb, 0xd3, 0x3e, 0x3d, 0xf9, 0x8b, 0xad, 0x81, 0xab, 0x9a, 0xf2, 0x6d, 0x98, 0xab,
+	0xba, 0x12, 0x38, 0xd2, 0x9e, 0x5a, 0x55, 0xb8, 0x5e, 0xca, 0x39, 0x5e, 0x96, 0xba, 0x8c, 0x0e,
+	0x4e, 0xb1, 0x8b, 0xfc, 0xb1, 0x36, 0x94, 0xc9, 0x84, 0x7c, 0xac, 0x0d, 0x59, 0x52, 0x61, 0xb3,
+	0xb0, 0xaf, 0x56, 0x2d, 0x0e, 0x6e, 0x1f, 0x6d, 0x13, 0x0b, 0x4f, 0x8a, 0xfc, 0xd9, 0x8c, 0x2e,
+	0x58, 0xeb, 0xc4, 0x5a, 0x77, 0xe6, 0x81, 0xe0, 0x15, 0x93, 0x58, 0xdf, 0x29, 0xf3, 0xd3, 0x62,
+	0x6e, 0x23, 0x23, 0x5a, 0x85, 0x1c, 0x31, 0x85, 0x0b, 0xc4, 0x4a, 0x26, 0xec, 0x22, 0xdf, 0x5b,
+	0x2d, 0xa6, 0x64, 0x42, 0xee, 0xad, 0x16, 0x4f, 0x52, 0x61, 0x2f, 0x43, 0xce, 0x63, 0x5b, 0x33,
+	0x9c, 0xa6, 0xa7, 0xa5, 0x0b, 0xa9, 0x5c, 0x16, 0x3b, 0xfd, 0x85, 0xeb, 0xa7, 0x09, 0x8d, 0xba,
+	0x09, 0x7d, 0x60, 0x17, 0xf9, 0xa3, 0x2e, 0xcc, 0x87, 0x15, 0xcf, 0xa5, 0x2c, 0xd6, 0x92, 0x09,
+	0xf9, 0xa8, 0xd5, 0xe2, 0xb3, 0xc2, 0x1a, 0xd5, 0xbe, 0xe5, 0x45, 0x98, 0xca, 0x13, 0xc3, 0x54,
+	0x75, 0x8d, 0x3b, 0x32, 0x0a, 0x22, 0xfd, 0xb1, 0x70, 0x63, 0xdf, 0xfa, 0x38, 0x3e, 0xf7, 0x99,
+	0xeb, 0xe1, 0x90, 0x76, 0xdf, 0x74, 0x7a, 0x55, 0xad, 0x4f, 0x9d, 0xd7, 0x65, 0xfc, 0x79, 0xfc,
+	0x82, 0xe7, 0x51, 0xe9, 0x53, 0xe7, 0xdd, 0xe7, 0xde, 0x57, 0x76, 0x17, 0x86, 0x1b, 0x38, 0x73,
+	0xeb, 0x85, 0x2a, 0x6f, 0xa8, 0x35, 0xef, 0xd2, 0xc2, 0xe5, 0x0a, 0xef, 0x09, 0x1f, 0xef, 0x50,
+	0x1d, 0x6f, 0xcd, 0x49, 0x1e, 0xaa, 0xa3, 0x5e, 0x5a, 0x2f, 0x54, 0xd8, 0x13, 0x10, 0xa9, 0x5a,
+	0x65, 0xae, 0xa6, 0x7c, 0x23, 0xc0, 0x29, 0x14, 0xda, 0xf2, 0xbb, 0x47, 0x41, 0xa4, 0x47, 0x1e,
+	0xa9, 0x79, 0xc5, 0xeb, 0x9c, 0x9c, 0x86, 0x1f, 0xfe, 0xb5, 0x03, 0xb6, 0x1d, 0x2d, 0x6c, 0x1a,
+	0xf6, 0x7c, 0xa9, 0xab, 0x5a, 0x8a, 0x6c, 0xa9, 0x74, 0x94, 0x1d, 0x96, 0x16, 0x9c, 0x76, 0xfe,
+	0x6f, 0x8a, 0x63, 0xfe, 0x52, 0xf2, 0xed, 0xb7, 0xec, 0x22, 0x1f, 0x3c, 0xa7, 0xab, 0xda, 0xfc,
+	0xa5, 0xa4, 0x1c, 0x74, 0x90, 0xe7, 0xb7, 0x54, 0xf6, 0x0b, 0x18, 0x54, 0x48, 0x9e, 0x72, 0xb8,
+	0x33, 0xea, 0xa3, 0xff, 0xca, 0x11, 0x48, 0x90, 0xbc, 0x43, 0x11, 0x50, 0x48, 0xde, 0x61, 0x58,
+	0x80, 0xaf, 0xb5, 0x92, 0xa7, 0x93, 0x56, 0xdc, 0x50, 0x59, 0x1a, 0x30, 0xd8, 0x58, 0x68, 0x65,
+	0xd9, 0x6b, 0x31, 0x3b, 0x67, 0xa6, 0xa6, 0x63, 0x7b, 0xe3, 0x32, 0x8b, 0x9b, 0xd4, 0x92, 0x06,
+	0x60, 0x9f, 0xa9, 0x6f, 0x19, 0x69, 0xe2, 0x4d, 0x26, 0xb6, 0xf3, 0x85, 0x04, 0xce, 0x75, 0xf5,
+	0x04, 0x42, 0xc1, 0x73, 0x5d, 0x3d, 0x87, 0x43, 0x7d, 0x63, 0xbf, 0x01, 0x38, 0xec, 0xe8, 0xa9,
+	0x1b, 0xea, 0x36, 0xf1, 0xfd, 0xe3, 0x2a, 0xfb, 0x02, 0x86, 0x47, 0x1a, 0x07, 0x2b, 0x78, 0xa5,
+	0xc1, 0x1a, 0xf2, 0x0f, 0xd6, 0x47, 0x45, 0x1e, 0xc8, 0xfd, 0xb8, 0x7e, 0x7c, 0x46, 0x61, 0x10,
+	0xe7, 0xd4, 0xd4, 0x06, 0x29, 0x50, 0x41, 0x0f, 0xd1, 0xa1, 0x6f, 0x74, 0x87, 0x00, 0xb7, 0xef,
+	0x54, 0x5a, 0x20, 0xbe, 0x94, 0x5c, 0x24, 0x05, 0x39, 0x80, 0x73, 0xea, 0x22, 0x29, 0xc4, 0x7e,
+	0xef, 0x84, 0x43, 0xd5, 0x5d, 0x64, 0xce, 0x5b, 0x00, 0xdd, 0x06, 0xc1, 0x66, 0x61, 0x37, 0xfd,
+	0xc2, 0x9e, 0x7c, 0xa9, 0x15, 0x26, 0x3c, 0xde, 0xe8, 0x56, 0xf5, 0xf0, 0xa5, 0x30, 0x36, 0x78,
+	0xf3, 0xcf, 0xbf, 0x7e, 0xe8, 0x08, 0x8d, 0xf5, 0x8a, 0x44, 0x49, 0x9b, 0x22, 0x5d, 0x3a, 0x67,
+	0xc0, 0x24, 0x7b, 0x07, 0xc0, 0x81, 0x56, 0xda, 0xb1, 0xa7, 0x9b, 0xb4, 0x69, 0xaf, 0x70, 0x78,
+	0x50, 0x70, 0xf7, 0x41, 0xa1, 0xb2, 0x0f, 0x0a, 0xf3, 0xce, 0x3e, 0x38, 0xb6, 0x40, 0x59, 0xa5,
+	0xb1, 0xf7, 0x5c, 0x56, 0x9f, 0x68, 0xa6, 0xb8, 0xd3, 0xf0, 0x9b, 0x08, 0xf5, 0xf7, 0x3d, 0x11,
+	0x57, 0x08, 0x9d, 0x38, 0xbf, 0x03, 0x70, 0xf0, 0x92, 0x86, 0x5b, 0x45, 0xfa, 0x92, 0xbf, 0x62,
+	0xdb, 0x20, 0xcf, 0xd2, 0x20, 0xc5, 0xc9, 0xe8, 0x3f, 0x07, 0xe9, 0x0b, 0x4a, 0xba, 0x03, 0x1e,
+	0x1e, 0x20, 0xf0, 0xe8, 0x00, 0x81, 0xc7, 0x07, 0x88, 0x79, 0x7a, 0x80, 0x98, 0x67, 0x07, 0x88,
+	0x79, 0x7e, 0x80, 0x98, 0x17, 0x07, 0x08, 0xec, 0xdb, 0x08, 0xdc, 0xb2, 0x11, 0x73, 0xd7, 0x46,
+	0xe0, 0x9e, 0x8d, 0x98, 0xfb, 0x36, 0x62, 0x1e, 0xd8, 0x88, 0x79, 0x68, 0x23, 0xf0, 0xc8, 0x46,
+	0xe0, 0xb1, 0x8d, 0x98, 0xa7, 0x36, 0x02, 0xcf, 0x6c, 0xc4, 0x3c, 0xb7, 0x11, 0x78, 0x61, 0x23,
+	0x66, 0xbf, 0x84, 0x98, 0x5b, 0x25, 0x04, 0xbe, 0x2f, 0x21, 0xe6, 0xc7, 0x12, 0x02, 0x3f, 0x97,
+	0x10, 0x73, 0xb7, 0x84, 0x98, 0x7b, 0x25, 0x04, 0xee, 0x97, 0x10, 0x78, 0x50, 0x42, 0xe0, 0x8a,
+	0xf8, 0x0a, 0xff, 0x50, 0x4b, 0xcb, 0xad, 0xae, 0x06, 0x68, 0xba, 0xd3, 0x7f, 0x0f, 0x00, 0xf2,
+	0x9e, 0x84, 0x74, 0x81, 0x0c, 0x00, 0x00,
 }
 
 func (this *ClaimEndDeviceRequest) Equal(that interface{}) bool {
@@ -455,6 +495,15 @@ func (this *ClaimEndDeviceRequest) Equal(that interface{}) bool {
 	} else if !this.TargetNetID.Equal(*that1.TargetNetID) {
 		return false
 	}
+	if this.TargetFrequencyPlanID != that1.TargetFrequencyPlanID {
+		return false
+	}
+	if this.TargetLoRaWANVersion != that1.TargetLoRaWANVersion {
+		return false
+	}
+	if this.TargetLoRaWANPHYVersion != that1.TargetLoRaWANPHYVersion {
+		return false
+	}
 	if this.InvalidateAuthenticationCode != that1.InvalidateAuthenticationCode {
 		return false
 	}
@@ -752,6 +801,25 @@ func (m *ClaimEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetLoRaWANPHYVersion != 0 {
+		i = encodeVarintDeviceclaimingserver(dAtA, i, uint64(m.TargetLoRaWANPHYVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.TargetLoRaWANVersion != 0 {
+		i = encodeVarintDeviceclaimingserver(dAtA, i, uint64(m.TargetLoRaWANVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.TargetFrequencyPlanID) > 0 {
+		i -= len(m.TargetFrequencyPlanID)
+		copy(dAtA[i:], m.TargetFrequencyPlanID)
+		i = encodeVarintDeviceclaimingserver(dAtA, i, uint64(len(m.TargetFrequencyPlanID)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.TargetNetID != nil {
 		{
 			size := m.TargetNetID.Size()
@@ -995,6 +1063,9 @@ func NewPopulatedClaimEndDeviceRequest(r randyDeviceclaimingserver, easy bool) *
 	this.TargetApplicationServerKEKLabel = randStringDeviceclaimingserver(r)
 	this.TargetApplicationServerID = randStringDeviceclaimingserver(r)
 	this.TargetNetID = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
+	this.TargetFrequencyPlanID = randStringDeviceclaimingserver(r)
+	this.TargetLoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.TargetLoRaWANPHYVersion = PHYVersion([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1150,6 +1221,16 @@ func (m *ClaimEndDeviceRequest) Size() (n int) {
 		l = m.TargetNetID.Size()
 		n += 1 + l + sovDeviceclaimingserver(uint64(l))
 	}
+	l = len(m.TargetFrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovDeviceclaimingserver(uint64(l))
+	}
+	if m.TargetLoRaWANVersion != 0 {
+		n += 1 + sovDeviceclaimingserver(uint64(m.TargetLoRaWANVersion))
+	}
+	if m.TargetLoRaWANPHYVersion != 0 {
+		n += 2 + sovDeviceclaimingserver(uint64(m.TargetLoRaWANPHYVersion))
+	}
 	return n
 }
 
@@ -1230,6 +1311,9 @@ func (this *ClaimEndDeviceRequest) String() string {
 		`TargetApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.TargetApplicationServerKEKLabel) + `,`,
 		`TargetApplicationServerID:` + fmt.Sprintf("%v", this.TargetApplicationServerID) + `,`,
 		`TargetNetID:` + fmt.Sprintf("%v", this.TargetNetID) + `,`,
+		`TargetFrequencyPlanID:` + fmt.Sprintf("%v", this.TargetFrequencyPlanID) + `,`,
+		`TargetLoRaWANVersion:` + fmt.Sprintf("%v", this.TargetLoRaWANVersion) + `,`,
+		`TargetLoRaWANPHYVersion:` + fmt.Sprintf("%v", this.TargetLoRaWANPHYVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1662,6 +1746,76 @@ func (m *ClaimEndDeviceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceclaimingserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceclaimingserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceclaimingserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetFrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLoRaWANVersion", wireType)
+			}
+			m.TargetLoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceclaimingserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetLoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLoRaWANPHYVersion", wireType)
+			}
+			m.TargetLoRaWANPHYVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceclaimingserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetLoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeviceclaimingserver(dAtA[iNdEx:])
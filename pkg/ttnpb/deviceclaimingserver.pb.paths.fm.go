@@ -16,6 +16,9 @@ var ClaimEndDeviceRequestFieldPathsNested = []string{
 	"target_application_server_id",
 	"target_application_server_kek_label",
 	"target_device_id",
+	"target_frequency_plan_id",
+	"target_lorawan_phy_version",
+	"target_lorawan_version",
 	"target_net_id",
 	"target_network_server_address",
 	"target_network_server_kek_label",
@@ -29,6 +32,9 @@ var ClaimEndDeviceRequestFieldPathsTopLevel = []string{
 	"target_application_server_id",
 	"target_application_server_kek_label",
 	"target_device_id",
+	"target_frequency_plan_id",
+	"target_lorawan_phy_version",
+	"target_lorawan_version",
 	"target_net_id",
 	"target_network_server_address",
 	"target_network_server_kek_label",
@@ -98,6 +98,36 @@ func (dst *ClaimEndDeviceRequest) SetFields(src *ClaimEndDeviceRequest, paths ..
 			} else {
 				dst.TargetNetID = nil
 			}
+		case "target_frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'target_frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TargetFrequencyPlanID = src.TargetFrequencyPlanID
+			} else {
+				var zero string
+				dst.TargetFrequencyPlanID = zero
+			}
+		case "target_lorawan_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'target_lorawan_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TargetLoRaWANVersion = src.TargetLoRaWANVersion
+			} else {
+				var zero MACVersion
+				dst.TargetLoRaWANVersion = zero
+			}
+		case "target_lorawan_phy_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'target_lorawan_phy_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TargetLoRaWANPHYVersion = src.TargetLoRaWANPHYVersion
+			} else {
+				var zero PHYVersion
+				dst.TargetLoRaWANPHYVersion = zero
+			}
 		case "invalidate_authentication_code":
 			if len(subs) > 0 {
 				return fmt.Errorf("'invalidate_authentication_code' has no subfields, but %s were specified", subs)
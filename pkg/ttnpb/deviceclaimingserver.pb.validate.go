@@ -125,6 +125,33 @@ func (m *ClaimEndDeviceRequest) ValidateFields(paths ...string) error {
 
 		case "target_net_id":
 			// no validation rules for TargetNetID
+		case "target_frequency_plan_id":
+
+			if utf8.RuneCountInString(m.GetTargetFrequencyPlanID()) > 64 {
+				return ClaimEndDeviceRequestValidationError{
+					field:  "target_frequency_plan_id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "target_lorawan_version":
+
+			if _, ok := MACVersion_name[int32(m.GetTargetLoRaWANVersion())]; !ok {
+				return ClaimEndDeviceRequestValidationError{
+					field:  "target_lorawan_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "target_lorawan_phy_version":
+
+			if _, ok := PHYVersion_name[int32(m.GetTargetLoRaWANPHYVersion())]; !ok {
+				return ClaimEndDeviceRequestValidationError{
+					field:  "target_lorawan_phy_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		case "invalidate_authentication_code":
 			// no validation rules for InvalidateAuthenticationCode
 		case "source_device":
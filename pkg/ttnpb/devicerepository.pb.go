@@ -1707,9 +1707,13 @@ type MessagePayloadFormatter struct {
 	// Payload formatter type.
 	Formatter PayloadFormatter `protobuf:"varint,1,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
 	// Parameter for the formatter, must be set together.
-	FormatterParameter   string   `protobuf:"bytes,2,opt,name=formatter_parameter,json=formatterParameter,proto3" json:"formatter_parameter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FormatterParameter string `protobuf:"bytes,2,opt,name=formatter_parameter,json=formatterParameter,proto3" json:"formatter_parameter,omitempty"`
+	// Mapping of the normalized payload fields to the decoded payload fields.
+	// The keys are normalized payload field paths (e.g. `air.temperature`) and the values are decoded payload field
+	// paths (e.g. `sensor.temperature`). This is used for uplink decoders that do not normalize the payload themselves.
+	NormalizedPayloadMapping map[string]string `protobuf:"bytes,3,rep,name=normalized_payload_mapping,json=normalizedPayloadMapping,proto3" json:"normalized_payload_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
+	XXX_sizecache            int32             `json:"-"`
 }
 
 func (m *MessagePayloadFormatter) Reset()      { *m = MessagePayloadFormatter{} }
@@ -1758,6 +1762,13 @@ func (m *MessagePayloadFormatter) GetFormatterParameter() string {
 	return ""
 }
 
+func (m *MessagePayloadFormatter) GetNormalizedPayloadMapping() map[string]string {
+	if m != nil {
+		return m.NormalizedPayloadMapping
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.KeyProvisioning", KeyProvisioning_name, KeyProvisioning_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.KeyProvisioning", KeyProvisioning_name, KeyProvisioning_value)
@@ -1811,6 +1822,8 @@ func init() {
 	golang_proto.RegisterType((*ListEndDeviceModelsResponse)(nil), "ttn.lorawan.v3.ListEndDeviceModelsResponse")
 	proto.RegisterType((*MessagePayloadFormatter)(nil), "ttn.lorawan.v3.MessagePayloadFormatter")
 	golang_proto.RegisterType((*MessagePayloadFormatter)(nil), "ttn.lorawan.v3.MessagePayloadFormatter")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.MessagePayloadFormatter.NormalizedPayloadMappingEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.MessagePayloadFormatter.NormalizedPayloadMappingEntry")
 }
 
 func init() {
@@ -1821,172 +1834,176 @@ func init() {
 }
 
 var fileDescriptor_c0145ad4e3f42c22 = []byte{
-	// 2635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xe7, 0x92, 0x12, 0x29, 0x0d, 0x6d, 0x89, 0x1e, 0x2b, 0xf1, 0x9a, 0x4a, 0x96, 0xfa, 0x33,
-	0xfe, 0xbb, 0xb2, 0x13, 0x91, 0x2d, 0x8d, 0xa4, 0x89, 0x9b, 0xc6, 0x11, 0x45, 0xda, 0x66, 0xac,
-	0xaf, 0x8c, 0x2d, 0xa7, 0x89, 0x9b, 0x10, 0x23, 0xee, 0x88, 0xdc, 0x6a, 0xb9, 0xb3, 0x99, 0x5d,
-	0x4a, 0x61, 0x5c, 0x03, 0x41, 0x0e, 0x85, 0x0f, 0x01, 0x5a, 0x20, 0x3d, 0xf4, 0x54, 0xf4, 0x03,
-	0x45, 0x03, 0x14, 0x28, 0x02, 0xb4, 0x05, 0x72, 0x28, 0xda, 0xb4, 0x87, 0xc2, 0xbd, 0x05, 0xe8,
-	0x25, 0x40, 0x01, 0x21, 0xa2, 0x7a, 0xc8, 0x31, 0xb7, 0xa6, 0x3e, 0x15, 0x33, 0xb3, 0xbb, 0x5a,
-	0x92, 0x96, 0x29, 0x29, 0x69, 0x80, 0x14, 0x3d, 0x71, 0x66, 0xde, 0xef, 0xbd, 0x99, 0xf9, 0xcd,
-	0xfb, 0x98, 0x59, 0x82, 0x69, 0x93, 0x32, 0xbc, 0x89, 0xad, 0x19, 0xc7, 0xc5, 0xb5, 0xf5, 0x3c,
-	0xb6, 0x8d, 0xbc, 0x4e, 0x36, 0x8c, 0x1a, 0x61, 0xc4, 0xa6, 0x8e, 0xe1, 0x52, 0xd6, 0xce, 0xd9,
-	0x8c, 0xba, 0x14, 0x8e, 0xb9, 0xae, 0x95, 0xf3, 0xd0, 0xb9, 0x8d, 0x73, 0xe9, 0xd9, 0xba, 0xe1,
-	0x36, 0x5a, 0xab, 0xb9, 0x1a, 0x6d, 0xe6, 0x89, 0xb5, 0x41, 0xdb, 0x36, 0xa3, 0xaf, 0xb5, 0xf3,
-	0x02, 0x5c, 0x9b, 0xa9, 0x13, 0x6b, 0x66, 0x03, 0x9b, 0x86, 0x8e, 0x5d, 0x92, 0xef, 0x6b, 0x48,
-	0x93, 0xe9, 0x99, 0x90, 0x89, 0x3a, 0xad, 0x53, 0xa9, 0xbc, 0xda, 0x5a, 0x13, 0x3d, 0xd1, 0x11,
-	0x2d, 0x0f, 0xfe, 0x50, 0x9d, 0xd2, 0xba, 0x49, 0xc4, 0x22, 0xb1, 0x65, 0x51, 0x17, 0xbb, 0x06,
-	0xb5, 0x1c, 0x4f, 0xaa, 0x79, 0xd2, 0xc0, 0xc6, 0x26, 0xc3, 0xb6, 0x4d, 0x98, 0x2f, 0x9f, 0xea,
-	0x95, 0xaf, 0x19, 0xc4, 0xd4, 0xab, 0x4d, 0xec, 0xac, 0x7b, 0x88, 0x6c, 0x3f, 0x17, 0xc4, 0xd2,
-	0xab, 0x92, 0x0f, 0xdf, 0x4a, 0x3f, 0xa6, 0x49, 0x1c, 0x07, 0xd7, 0x89, 0x3f, 0xcf, 0x23, 0xfd,
-	0x08, 0x43, 0x27, 0x96, 0x6b, 0xac, 0x19, 0xc1, 0x62, 0xb2, 0x6f, 0xc5, 0xc0, 0x58, 0xd9, 0xd2,
-	0x4b, 0xc2, 0x74, 0x91, 0x61, 0x4b, 0x87, 0x0b, 0x60, 0x64, 0x95, 0x37, 0xaa, 0x86, 0xae, 0x2a,
-	0x53, 0xca, 0xf4, 0x68, 0xb1, 0x70, 0xb7, 0x78, 0x8a, 0x65, 0xd5, 0x53, 0x05, 0xed, 0x95, 0x1b,
-	0x78, 0xe6, 0xf5, 0xaf, 0xce, 0x3c, 0xf5, 0xf2, 0xf4, 0x85, 0xf3, 0x37, 0x66, 0x5e, 0xbe, 0xe0,
-	0x77, 0xcf, 0xdc, 0x2c, 0x3c, 0x76, 0xeb, 0x54, 0x67, 0x2b, 0x93, 0x10, 0x36, 0x2a, 0x25, 0x94,
-	0x10, 0x36, 0x2a, 0x3a, 0x84, 0x60, 0xc8, 0xc2, 0x4d, 0xa2, 0x46, 0xb9, 0x29, 0x24, 0xda, 0xf0,
-	0x3c, 0x38, 0x69, 0x33, 0x63, 0x03, 0xbb, 0xa4, 0x4a, 0x2c, 0x97, 0x30, 0x9b, 0x19, 0x0e, 0xa9,
-	0x5a, 0xad, 0xe6, 0x2a, 0x61, 0x6a, 0x6c, 0x4a, 0x99, 0x3e, 0x8a, 0x4e, 0x78, 0x80, 0x72, 0x20,
-	0x5f, 0x14, 0x62, 0x78, 0x11, 0x64, 0x28, 0xab, 0x63, 0xcb, 0x78, 0x5d, 0xb0, 0x5e, 0x6d, 0x59,
-	0xc6, 0xab, 0x2d, 0x52, 0x0d, 0x6d, 0x4d, 0x1d, 0x9a, 0x8a, 0x4d, 0x8f, 0xa2, 0x87, 0xc3, 0xb0,
-	0x15, 0x81, 0xaa, 0xec, 0x82, 0xe0, 0x12, 0x38, 0xc1, 0x09, 0xaa, 0x62, 0xd3, 0x34, 0xb0, 0x55,
-	0x23, 0xd5, 0x0d, 0x62, 0xe9, 0x94, 0xf1, 0x5d, 0x0f, 0xf3, 0x15, 0x14, 0xd5, 0xce, 0x56, 0x66,
-	0x62, 0x9e, 0x22, 0x3c, 0xeb, 0x21, 0xae, 0x0b, 0x40, 0xa5, 0x84, 0x26, 0xb8, 0x62, 0xcf, 0xa8,
-	0x0e, 0x55, 0x90, 0xd8, 0x24, 0xab, 0x8e, 0xe1, 0x12, 0x35, 0x2e, 0xf6, 0xea, 0x77, 0xe1, 0x04,
-	0x18, 0x26, 0x4d, 0x6c, 0x98, 0x6a, 0x42, 0x8c, 0xcb, 0x0e, 0x27, 0xc6, 0xa4, 0x75, 0xaa, 0x8e,
-	0x48, 0x62, 0x78, 0x3b, 0xfb, 0x63, 0x35, 0x74, 0x1c, 0x0b, 0x54, 0x27, 0xe6, 0xe7, 0x7d, 0x1c,
-	0x0b, 0x60, 0xa4, 0xc9, 0xed, 0x72, 0x73, 0xd1, 0x03, 0x9b, 0x13, 0x4b, 0xe2, 0xe6, 0x84, 0x8d,
-	0x8a, 0x0e, 0x27, 0xbd, 0xd3, 0x8d, 0x09, 0x53, 0x89, 0xbb, 0xc5, 0x21, 0x16, 0x55, 0x0b, 0xde,
-	0x31, 0x9f, 0x05, 0x49, 0x9d, 0x38, 0x35, 0x66, 0xd8, 0xfc, 0x08, 0xd4, 0x21, 0x81, 0x19, 0xb9,
-	0x5b, 0x1c, 0x66, 0x31, 0xf5, 0x83, 0x71, 0x14, 0x16, 0xc2, 0x1b, 0xe0, 0x58, 0x03, 0x33, 0x7d,
-	0x13, 0x33, 0x7e, 0x12, 0xcc, 0xe1, 0x01, 0xa5, 0x0e, 0x4f, 0xc5, 0xa6, 0x93, 0x85, 0x5c, 0xae,
-	0x3b, 0xe2, 0x73, 0xdd, 0x0c, 0xe5, 0x2e, 0x7b, 0x7a, 0xd7, 0xa5, 0x1a, 0x4a, 0x35, 0xba, 0x07,
-	0x1c, 0x6e, 0x7c, 0xcd, 0x60, 0xcd, 0x6e, 0xe3, 0xf1, 0x7d, 0x19, 0xbf, 0xe8, 0xe9, 0x05, 0xc6,
-	0xd7, 0xba, 0x07, 0x1c, 0x7e, 0xee, 0x0e, 0xb1, 0x1c, 0xca, 0x1c, 0x35, 0x21, 0x1c, 0xcf, 0xef,
-	0xc2, 0x0a, 0x00, 0xba, 0xd1, 0x24, 0x96, 0x9c, 0x8f, 0x9f, 0x73, 0xb2, 0x70, 0x66, 0xc0, 0x7c,
-	0xa5, 0x40, 0x01, 0x85, 0x94, 0xe1, 0x39, 0x10, 0xdf, 0x24, 0x46, 0xbd, 0xe1, 0xaa, 0xa3, 0xc2,
-	0xcc, 0x64, 0x4e, 0x66, 0x91, 0x9c, 0x9f, 0x45, 0x72, 0x17, 0x4d, 0x8a, 0xdd, 0xeb, 0xd8, 0x6c,
-	0x11, 0xe4, 0x41, 0xe1, 0xb3, 0x20, 0xb1, 0x8a, 0x5d, 0x97, 0xb0, 0xb6, 0x0a, 0x84, 0xd6, 0xe9,
-	0x01, 0x93, 0x17, 0x25, 0x1a, 0xf9, 0x6a, 0x90, 0x80, 0x09, 0x6a, 0x13, 0x86, 0x5d, 0xc3, 0xaa,
-	0x57, 0x6b, 0xd4, 0xd2, 0x0d, 0x91, 0xe9, 0xd4, 0xa4, 0x30, 0x57, 0x18, 0x60, 0x6e, 0xc9, 0x57,
-	0x9d, 0x0b, 0x34, 0xd1, 0x71, 0xda, 0x3f, 0x08, 0x1f, 0x01, 0x09, 0xc3, 0xae, 0xd6, 0xa8, 0x4e,
-	0xd4, 0x23, 0xc2, 0x49, 0x40, 0x67, 0x2b, 0x13, 0xaf, 0x2c, 0xcf, 0x51, 0x9d, 0xa0, 0xb8, 0x61,
-	0xf3, 0x5f, 0xf8, 0x1c, 0x48, 0xad, 0x93, 0x76, 0xd5, 0x66, 0x74, 0xc3, 0xe0, 0xa4, 0x18, 0x56,
-	0x5d, 0x3d, 0x3a, 0x15, 0x9b, 0x1e, 0x2b, 0x64, 0x7a, 0xd7, 0x71, 0x85, 0xb4, 0x97, 0x43, 0x30,
-	0x34, 0xbe, 0xde, 0x3d, 0x00, 0x9f, 0x01, 0x47, 0xb8, 0x2d, 0x87, 0xd4, 0x5a, 0xcc, 0x70, 0xdb,
-	0xea, 0xd8, 0x94, 0x32, 0x3d, 0x56, 0x98, 0xbc, 0x87, 0x9d, 0xab, 0x1e, 0x04, 0x25, 0xd7, 0x77,
-	0x3b, 0xf0, 0x9b, 0x20, 0x6e, 0x37, 0xa8, 0x4b, 0x1d, 0x75, 0x5c, 0x30, 0xf1, 0xff, 0x03, 0x98,
-	0x58, 0x16, 0x60, 0xe4, 0x29, 0x71, 0xf5, 0x0d, 0x43, 0x27, 0xd4, 0x51, 0x53, 0xfb, 0x52, 0xbf,
-	0x2e, 0xc0, 0xc8, 0x53, 0x82, 0x79, 0x90, 0xb4, 0x19, 0xd5, 0x5b, 0x35, 0xb7, 0xda, 0x62, 0xa6,
-	0x7a, 0x4c, 0x50, 0x36, 0xd6, 0xd9, 0xca, 0x80, 0x65, 0x39, 0xbc, 0x82, 0xe6, 0x11, 0xf0, 0x20,
-	0x2b, 0xcc, 0x84, 0x8f, 0x83, 0xa3, 0x3a, 0x76, 0xb1, 0xd3, 0x20, 0x44, 0xaa, 0x40, 0xa1, 0x92,
-	0xea, 0x6c, 0x65, 0x8e, 0x94, 0x7c, 0x01, 0x57, 0x3a, 0x12, 0xc0, 0xb8, 0x5a, 0x19, 0x8c, 0x32,
-	0xe2, 0x10, 0xd3, 0xe4, 0x49, 0xf5, 0xb8, 0x08, 0x97, 0xaf, 0x0c, 0x58, 0x29, 0xf2, 0xf0, 0x68,
-	0x57, 0x13, 0xce, 0x83, 0x64, 0x8d, 0x36, 0x6d, 0x99, 0x2e, 0x1d, 0x75, 0x42, 0x6c, 0xf9, 0xec,
-	0x00, 0x43, 0x73, 0xbb, 0x1a, 0x28, 0xac, 0x0e, 0x1f, 0x05, 0xc7, 0xb0, 0x2e, 0x1d, 0x07, 0x9b,
-	0x55, 0x86, 0x75, 0x83, 0x3a, 0xea, 0x03, 0x22, 0xf0, 0x52, 0xbb, 0x02, 0x24, 0xc6, 0xd3, 0x6b,
-	0x60, 0xbc, 0x27, 0x3b, 0xf0, 0x70, 0xf5, 0x52, 0x80, 0x4c, 0xa7, 0xc8, 0xef, 0x72, 0x89, 0xd5,
-	0x6a, 0x12, 0x66, 0xd4, 0x44, 0x66, 0x3c, 0x8a, 0xfc, 0x2e, 0xcc, 0x80, 0xa4, 0x8d, 0x99, 0x1b,
-	0xae, 0x50, 0xa3, 0x08, 0xf0, 0x21, 0x59, 0x94, 0xd2, 0xff, 0x8a, 0x81, 0xf1, 0x9e, 0x4c, 0x71,
-	0xa8, 0x89, 0x9e, 0x01, 0x93, 0x4e, 0xcb, 0xb6, 0x29, 0x73, 0x89, 0x5e, 0xed, 0xcf, 0x87, 0x31,
-	0xb1, 0xcd, 0x93, 0x01, 0xe4, 0x72, 0x6f, 0xa2, 0xfb, 0x16, 0x18, 0xb1, 0x19, 0x5d, 0x33, 0x4c,
-	0x22, 0xab, 0x60, 0xb2, 0xf0, 0xf4, 0xc1, 0xf2, 0x5b, 0x6e, 0xd9, 0x53, 0x2f, 0x5b, 0x2e, 0x6b,
-	0xa3, 0xc0, 0x5a, 0xfa, 0x67, 0x0a, 0x48, 0x78, 0x32, 0xf8, 0x18, 0x00, 0xde, 0xf8, 0x6e, 0x51,
-	0x3a, 0xda, 0xd9, 0xca, 0x8c, 0x7a, 0x80, 0x4a, 0x09, 0x8d, 0x7a, 0x80, 0x8a, 0x0e, 0x67, 0xc1,
-	0x31, 0x6f, 0xfa, 0x6a, 0x8d, 0x30, 0x51, 0x7e, 0x65, 0xe9, 0x19, 0x29, 0x4e, 0x74, 0xb6, 0x32,
-	0x29, 0x5e, 0x62, 0x5f, 0x98, 0x5d, 0x9c, 0xf3, 0x65, 0x28, 0xe5, 0xc1, 0x83, 0x11, 0x78, 0x1a,
-	0x8c, 0xf0, 0xe4, 0x50, 0xe3, 0xd3, 0xc9, 0x4a, 0x93, 0xe4, 0xd5, 0x88, 0xa7, 0x85, 0x1a, 0xaf,
-	0x46, 0x42, 0x58, 0xd1, 0xd3, 0x0e, 0x38, 0xda, 0xb5, 0x7e, 0x98, 0x02, 0xb1, 0x75, 0xd2, 0xf6,
-	0xf8, 0xe7, 0x4d, 0x38, 0x0f, 0x86, 0x37, 0x78, 0x92, 0x14, 0x2b, 0x48, 0x16, 0x9e, 0x38, 0x1c,
-	0x3d, 0x48, 0x1a, 0x39, 0x1f, 0x7d, 0x52, 0x49, 0x77, 0x14, 0x00, 0x76, 0xb3, 0x36, 0xfc, 0x1a,
-	0x18, 0xde, 0x34, 0x74, 0xb7, 0x21, 0x26, 0x1d, 0x90, 0xa8, 0x25, 0x92, 0x27, 0xf7, 0x86, 0x4c,
-	0xee, 0xd1, 0x7d, 0x24, 0x77, 0x09, 0x85, 0x5f, 0x07, 0x23, 0xba, 0x81, 0x9b, 0xc4, 0xf5, 0x1c,
-	0x72, 0x80, 0x5a, 0x00, 0xe6, 0xb3, 0x99, 0xc4, 0xaa, 0xbb, 0x0d, 0x51, 0x90, 0x07, 0xcd, 0x26,
-	0xa1, 0xe9, 0x1b, 0x20, 0xe1, 0x15, 0x07, 0xf8, 0x34, 0x48, 0x32, 0x62, 0x9b, 0xb8, 0x46, 0xf0,
-	0xaa, 0x49, 0xbc, 0x6d, 0xa6, 0xfb, 0x8c, 0x14, 0x29, 0x35, 0xa5, 0x8d, 0x30, 0x9c, 0xdf, 0x7a,
-	0xdc, 0xb6, 0x1d, 0x5c, 0x07, 0x79, 0x3b, 0xfd, 0xe7, 0x28, 0x38, 0x7e, 0x8f, 0x5a, 0x01, 0x6f,
-	0x80, 0xa4, 0x4b, 0x9a, 0x42, 0xd0, 0x62, 0xfe, 0x4c, 0x4f, 0x1d, 0xbc, 0xe8, 0xe4, 0xe6, 0x8d,
-	0xa6, 0xe1, 0x3a, 0x28, 0x6c, 0x0d, 0xae, 0x81, 0x63, 0x8c, 0x98, 0xd8, 0x35, 0x36, 0x48, 0xb5,
-	0xd1, 0x6a, 0x1a, 0x3a, 0xaf, 0x03, 0xd1, 0xcf, 0x3a, 0x45, 0xca, 0xb7, 0x79, 0xd9, 0x33, 0x99,
-	0x5e, 0x03, 0x71, 0x29, 0x83, 0x33, 0x20, 0xd6, 0x34, 0xac, 0xfd, 0xf8, 0x05, 0xc7, 0x09, 0x38,
-	0x7e, 0x6d, 0x3f, 0x2e, 0xc1, 0x71, 0xe9, 0x02, 0x88, 0xcb, 0x2a, 0xc3, 0x29, 0x6e, 0x62, 0xc3,
-	0xcf, 0x3a, 0xa2, 0xcd, 0xaf, 0xa0, 0xd4, 0x6d, 0x10, 0xa6, 0x46, 0x45, 0x0a, 0x91, 0x1d, 0xae,
-	0x23, 0x4b, 0xcb, 0x01, 0x74, 0x9e, 0x07, 0x23, 0x7e, 0x92, 0x0f, 0xee, 0xf6, 0x4a, 0xe8, 0x6e,
-	0xff, 0x20, 0x88, 0x33, 0x52, 0xe7, 0x59, 0x4f, 0xaa, 0x79, 0x3d, 0x78, 0x12, 0xc4, 0x78, 0xe5,
-	0xf1, 0x2e, 0x8a, 0x9d, 0xad, 0x4c, 0x8c, 0x17, 0x1c, 0x3e, 0x96, 0x7e, 0x27, 0x0a, 0x92, 0xa1,
-	0x7c, 0x0f, 0x17, 0x40, 0xdc, 0xc1, 0x6b, 0xc4, 0xe5, 0x81, 0xcb, 0x73, 0xd8, 0xe3, 0xfb, 0xaf,
-	0x15, 0xa1, 0x36, 0xf2, 0x8c, 0xc0, 0x57, 0xc0, 0xb8, 0x28, 0x13, 0x55, 0xf2, 0x6a, 0xcb, 0xb0,
-	0x9b, 0xc4, 0x72, 0xc5, 0xd2, 0x0e, 0x6d, 0x77, 0x4c, 0x58, 0x2b, 0xfb, 0xc6, 0xd2, 0xdf, 0x01,
-	0x60, 0x57, 0xca, 0x39, 0x59, 0xa5, 0xba, 0x9f, 0x73, 0x44, 0x5b, 0xf0, 0x44, 0x59, 0x33, 0x78,
-	0x03, 0x51, 0xd6, 0x84, 0x69, 0x30, 0xe2, 0xb8, 0xd8, 0xd2, 0x31, 0xf3, 0x72, 0x1a, 0x0a, 0xfa,
-	0xe1, 0xd2, 0x31, 0xd4, 0x55, 0x3a, 0xb2, 0x6f, 0x47, 0x81, 0x7a, 0x89, 0xb8, 0xdd, 0x4f, 0x36,
-	0x44, 0x5e, 0x6d, 0x11, 0xc7, 0x85, 0x75, 0x30, 0x8e, 0x6d, 0xdb, 0x34, 0x6a, 0xf2, 0x65, 0x64,
-	0xe8, 0x8e, 0xe7, 0x6c, 0x7d, 0xf7, 0xbe, 0xd9, 0x5d, 0x58, 0xe8, 0x4d, 0x54, 0x7c, 0xf0, 0xce,
-	0x56, 0x26, 0xd2, 0xd9, 0xca, 0x8c, 0x85, 0xe5, 0x25, 0x07, 0x8d, 0xe1, 0x30, 0xde, 0xe9, 0x7a,
-	0x93, 0x44, 0x3f, 0xfb, 0x9b, 0xe4, 0x02, 0x00, 0xbb, 0x6f, 0x60, 0x2f, 0x99, 0xf5, 0x27, 0x94,
-	0x8b, 0x1c, 0xb2, 0x80, 0x9d, 0xf5, 0xe2, 0x10, 0x5f, 0x26, 0x1a, 0x5d, 0xf3, 0x07, 0xb2, 0xdb,
-	0x51, 0x90, 0x9e, 0x37, 0x9c, 0x1e, 0x5a, 0x9c, 0x2f, 0x9c, 0x17, 0x0d, 0x0c, 0x9b, 0x3c, 0xd6,
-	0x65, 0x59, 0x17, 0x4f, 0x9d, 0xb3, 0x31, 0xf5, 0xe3, 0x04, 0x92, 0xc3, 0xdc, 0x0f, 0x6c, 0x5c,
-	0x27, 0xde, 0x13, 0x57, 0xb4, 0x61, 0x09, 0x8c, 0x50, 0xa6, 0x13, 0x56, 0x5d, 0x6d, 0x7b, 0x2f,
-	0xa4, 0x33, 0x77, 0x8b, 0xa7, 0xd9, 0x29, 0x14, 0x41, 0x01, 0xc9, 0x68, 0x74, 0x26, 0x68, 0x8a,
-	0x28, 0x43, 0xc3, 0x33, 0xe2, 0x27, 0x21, 0x54, 0x8b, 0x6d, 0x98, 0x01, 0x71, 0x87, 0x60, 0x56,
-	0x6b, 0x88, 0xc7, 0x6b, 0xf0, 0x12, 0xd3, 0x91, 0x37, 0xdc, 0xc3, 0x71, 0xfc, 0xe0, 0x1c, 0x77,
-	0x7a, 0x3c, 0x4f, 0x84, 0xc8, 0x97, 0xdd, 0xf3, 0xc2, 0xaf, 0xe1, 0xd8, 0x67, 0x7f, 0x0d, 0x77,
-	0x93, 0x3c, 0x74, 0x70, 0x92, 0xff, 0x1a, 0xeb, 0x71, 0x64, 0x31, 0xc5, 0x17, 0xef, 0xc8, 0xcf,
-	0xf7, 0xd1, 0xfc, 0xc4, 0xdd, 0xe2, 0x34, 0x3b, 0xad, 0x9e, 0x2a, 0x64, 0x5f, 0x99, 0xbe, 0x1f,
-	0x31, 0xdf, 0x3d, 0x73, 0xe1, 0xde, 0x54, 0x07, 0xb1, 0x11, 0xbb, 0x7f, 0x6c, 0x0c, 0x85, 0x62,
-	0xe3, 0xc5, 0x50, 0x6c, 0x48, 0xbf, 0x7e, 0xe6, 0x6e, 0xf1, 0x1b, 0xec, 0xa9, 0xbd, 0x62, 0x23,
-	0x38, 0x4c, 0x34, 0x3a, 0x13, 0x34, 0x07, 0x05, 0x4c, 0x7c, 0x3f, 0x01, 0x93, 0x38, 0xf8, 0x59,
-	0xfe, 0x5d, 0x01, 0xf0, 0x12, 0x71, 0xaf, 0x91, 0xa6, 0x6d, 0x62, 0x97, 0x7c, 0xe1, 0x67, 0xf8,
-	0x6d, 0x90, 0xf4, 0xaa, 0x86, 0x98, 0x44, 0xde, 0x23, 0x1e, 0xdd, 0xb3, 0xe4, 0x79, 0x37, 0xdc,
-	0xf0, 0x4c, 0xe2, 0x49, 0xe9, 0x8f, 0x97, 0x1c, 0x04, 0x36, 0x7c, 0x8c, 0x93, 0xdd, 0x51, 0x40,
-	0xfa, 0x12, 0x71, 0x97, 0x71, 0xdb, 0xa4, 0x58, 0xbf, 0x48, 0x59, 0x53, 0x5c, 0x0f, 0xff, 0xcb,
-	0x76, 0xb9, 0x02, 0x26, 0xef, 0x59, 0x57, 0x1c, 0x9b, 0x5a, 0x0e, 0x81, 0x4f, 0x80, 0xb8, 0xf0,
-	0x3d, 0xc7, 0xbb, 0xa8, 0x68, 0x7b, 0xce, 0x2b, 0xeb, 0xb4, 0x87, 0xee, 0x33, 0xeb, 0x47, 0xf9,
-	0xae, 0x59, 0xe1, 0xbd, 0x83, 0xcd, 0xca, 0x24, 0xec, 0xa1, 0xb3, 0x3f, 0x54, 0xc0, 0x89, 0x05,
-	0xf9, 0x11, 0xb8, 0xf7, 0x5c, 0xe0, 0x65, 0x30, 0xba, 0xe6, 0x77, 0xc4, 0x51, 0x8c, 0x15, 0xa6,
-	0x7a, 0xcd, 0xf6, 0x2a, 0x89, 0x20, 0x7d, 0x53, 0x89, 0xa6, 0x14, 0xb4, 0xab, 0x0c, 0xf3, 0xe0,
-	0x78, 0xd0, 0xa9, 0xda, 0x98, 0x79, 0x6f, 0x10, 0x79, 0xb7, 0x81, 0x81, 0x68, 0xd9, 0x97, 0x9c,
-	0x7d, 0x5b, 0x01, 0xe3, 0x3d, 0x5f, 0x64, 0xe0, 0x43, 0x40, 0xbd, 0x52, 0x7e, 0xb1, 0xba, 0x8c,
-	0x96, 0xae, 0x57, 0xae, 0x56, 0x96, 0x16, 0x2b, 0x8b, 0x97, 0xaa, 0x2b, 0x8b, 0x57, 0x16, 0x97,
-	0x5e, 0x58, 0x4c, 0x45, 0xe0, 0x24, 0x38, 0xd1, 0x27, 0x9d, 0x5b, 0xb9, 0x7a, 0x6d, 0x69, 0x21,
-	0xa5, 0xc0, 0x29, 0xf0, 0x50, 0x9f, 0xf0, 0xb9, 0xa5, 0xca, 0x62, 0xf5, 0x6a, 0x19, 0x5d, 0x2f,
-	0xa3, 0x54, 0x14, 0x3e, 0x0c, 0x4e, 0xf6, 0x21, 0x16, 0x66, 0x17, 0x2b, 0x17, 0xcb, 0x57, 0xaf,
-	0xa5, 0x62, 0xe9, 0xa1, 0xdb, 0x3f, 0xd7, 0x22, 0x67, 0xbf, 0xa7, 0x80, 0x64, 0xe8, 0xfb, 0x0e,
-	0x54, 0xc1, 0x04, 0x57, 0xba, 0x5a, 0x9e, 0x5b, 0x41, 0x95, 0x6b, 0x2f, 0x86, 0x56, 0xf3, 0x00,
-	0x38, 0xd6, 0x25, 0x59, 0x5c, 0x5a, 0x2c, 0xa7, 0x14, 0x98, 0x01, 0x93, 0x5d, 0xc3, 0xa8, 0x3c,
-	0x5b, 0xe2, 0x73, 0x5e, 0x2b, 0xcf, 0x5d, 0x2b, 0x97, 0x52, 0xd1, 0x3e, 0x80, 0x68, 0x94, 0xab,
-	0xe5, 0xf9, 0xf2, 0x42, 0x79, 0x31, 0x58, 0x48, 0xe1, 0xfd, 0x71, 0x90, 0x92, 0xa7, 0x89, 0x82,
-	0xbf, 0x3a, 0xe0, 0x2f, 0x15, 0x00, 0xb8, 0x8b, 0x48, 0x87, 0x83, 0x7d, 0x5f, 0x4b, 0xf6, 0xbe,
-	0xed, 0xa4, 0x1f, 0xdd, 0x17, 0x56, 0xba, 0x5a, 0xf6, 0xd9, 0x37, 0xff, 0xf6, 0x8f, 0xb7, 0xa3,
-	0xe7, 0xe1, 0x93, 0x79, 0x9d, 0xe5, 0x43, 0xa1, 0xe5, 0xe4, 0x6f, 0xf6, 0xc4, 0x6f, 0xae, 0xbb,
-	0x7f, 0x2b, 0x2f, 0x7d, 0x19, 0xfe, 0x54, 0x01, 0x23, 0x97, 0x88, 0x5c, 0x28, 0x9c, 0xee, 0x9d,
-	0x7b, 0xaf, 0xbb, 0x6a, 0x7a, 0x40, 0xa8, 0x64, 0xaf, 0x88, 0x85, 0x95, 0xe1, 0xdc, 0x61, 0x17,
-	0x96, 0xbf, 0xe9, 0x97, 0x85, 0x5b, 0xf0, 0x9f, 0x1e, 0x9b, 0x32, 0xce, 0x06, 0xb0, 0xd9, 0x55,
-	0x72, 0x07, 0xb0, 0xd9, 0x1d, 0xb8, 0xd9, 0xef, 0x2b, 0x62, 0xd5, 0xb7, 0x15, 0xf8, 0xdc, 0xe7,
-	0xb0, 0xec, 0xbc, 0x0c, 0xeb, 0x97, 0x0e, 0x75, 0x3a, 0x52, 0x17, 0xfe, 0x46, 0x9e, 0x8e, 0xfc,
-	0x2b, 0xe1, 0xbe, 0xa7, 0x13, 0xbe, 0xcf, 0xa5, 0x07, 0x64, 0x9c, 0xec, 0xcb, 0x62, 0x9f, 0x2f,
-	0xc0, 0x95, 0xcf, 0x6f, 0x9b, 0xf9, 0x9b, 0x7e, 0xc5, 0xbe, 0x05, 0xdf, 0x8a, 0x82, 0x64, 0xa8,
-	0x74, 0xc2, 0xec, 0x3d, 0x16, 0xde, 0x53, 0x57, 0xd3, 0xff, 0xb7, 0xe7, 0x92, 0x7d, 0x64, 0xf6,
-	0x77, 0xf2, 0x78, 0x7e, 0xad, 0xc0, 0x5f, 0x29, 0x87, 0x5f, 0x78, 0xa8, 0xdc, 0xe4, 0xfa, 0x37,
-	0x11, 0x96, 0x06, 0x1b, 0xea, 0x1e, 0xee, 0xfd, 0x2b, 0xa2, 0x47, 0xbc, 0xea, 0x9b, 0x74, 0xfd,
-	0xed, 0xbf, 0x1b, 0x05, 0xa9, 0x4b, 0xc4, 0x5d, 0xb1, 0x4d, 0xc3, 0x5a, 0x2f, 0x91, 0x1a, 0xd5,
-	0x09, 0xeb, 0x77, 0xe2, 0xbd, 0xab, 0x71, 0xba, 0xef, 0xab, 0xed, 0x1e, 0x55, 0x22, 0xfb, 0x17,
-	0xc9, 0xd0, 0x1f, 0x15, 0xf8, 0xfb, 0x2f, 0x03, 0x43, 0x41, 0xb1, 0x71, 0xf2, 0x2d, 0x41, 0x4e,
-	0x5e, 0xf7, 0xd8, 0xf9, 0x6d, 0x54, 0x5c, 0xbe, 0x4a, 0x74, 0xd3, 0xfa, 0x8f, 0x93, 0x76, 0x47,
-	0x92, 0xf6, 0x27, 0x05, 0xfe, 0xe1, 0x4b, 0x46, 0x9a, 0xee, 0xd1, 0xb3, 0x17, 0x6d, 0x65, 0xeb,
-	0x7f, 0xb4, 0xdd, 0x8f, 0x36, 0x22, 0xf9, 0x29, 0xfe, 0x42, 0xb9, 0xb3, 0xad, 0x29, 0x1f, 0x6c,
-	0x6b, 0xca, 0x87, 0xdb, 0x5a, 0xe4, 0xa3, 0x6d, 0x2d, 0xf2, 0xf1, 0xb6, 0x16, 0xf9, 0x64, 0x5b,
-	0x8b, 0x7c, 0xba, 0xad, 0x29, 0x6f, 0x74, 0x34, 0xe5, 0x76, 0x47, 0x8b, 0xbc, 0xd3, 0xd1, 0x94,
-	0x77, 0x3b, 0x5a, 0xe4, 0xbd, 0x8e, 0x16, 0x79, 0xbf, 0xa3, 0x45, 0xee, 0x74, 0x34, 0xe5, 0x83,
-	0x8e, 0xa6, 0x7c, 0xd8, 0xd1, 0x22, 0x1f, 0x75, 0x34, 0xe5, 0xe3, 0x8e, 0x16, 0xf9, 0xa4, 0xa3,
-	0x29, 0x9f, 0x76, 0xb4, 0xc8, 0x1b, 0x3b, 0x5a, 0xe4, 0xf6, 0x8e, 0xa6, 0xfc, 0x60, 0x47, 0x8b,
-	0xfc, 0x68, 0x47, 0x53, 0x7e, 0xb2, 0xa3, 0x45, 0xde, 0xd9, 0xd1, 0x22, 0xef, 0xee, 0x68, 0xca,
-	0x7b, 0x3b, 0x9a, 0xf2, 0xfe, 0x8e, 0xa6, 0xbc, 0x94, 0xaf, 0xd3, 0x9c, 0xdb, 0x20, 0x6e, 0xc3,
-	0xb0, 0xea, 0x4e, 0xce, 0x22, 0xee, 0x26, 0x65, 0xeb, 0xf9, 0xee, 0xbf, 0xfd, 0x37, 0xce, 0xe5,
-	0xed, 0xf5, 0x7a, 0xde, 0x75, 0x2d, 0x7b, 0x75, 0x35, 0x2e, 0xde, 0x2d, 0xe7, 0xfe, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0xd3, 0x8b, 0xff, 0xee, 0x6d, 0x21, 0x00, 0x00,
+	// 2693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x92, 0x12, 0x29, 0x0d, 0x6d, 0x89, 0x1e, 0x2b, 0xf1, 0x7a, 0x95, 0x2c, 0xf5, 0x67,
+	0xfc, 0x77, 0x64, 0x3b, 0x22, 0x5b, 0x1a, 0x49, 0x13, 0x37, 0x8d, 0x23, 0x8a, 0xb4, 0xcd, 0x58,
+	0xa2, 0x94, 0xb5, 0xe5, 0x34, 0x71, 0x93, 0xc5, 0x88, 0x3b, 0x22, 0xb7, 0x5a, 0xee, 0x6e, 0x66,
+	0x97, 0x54, 0x18, 0xd7, 0x40, 0x90, 0x02, 0x85, 0x0f, 0x01, 0x5a, 0x20, 0x97, 0x9e, 0x8a, 0x7e,
+	0xa0, 0x68, 0x80, 0x02, 0x45, 0x80, 0xb6, 0x40, 0x0e, 0x45, 0x9b, 0xf6, 0x50, 0xb8, 0xb7, 0x00,
+	0xbd, 0x04, 0x28, 0x20, 0x44, 0x54, 0x81, 0xe6, 0x98, 0x5b, 0x53, 0x9f, 0x8a, 0x99, 0xd9, 0x5d,
+	0x2e, 0x49, 0xc9, 0x94, 0xec, 0x34, 0x80, 0x8b, 0x9e, 0x34, 0x33, 0xef, 0xf7, 0x7e, 0x33, 0xf3,
+	0x66, 0xde, 0xc7, 0x2c, 0x05, 0x66, 0x0d, 0x8b, 0xa0, 0x4d, 0x64, 0xce, 0x39, 0x2e, 0xaa, 0x6e,
+	0xe4, 0x90, 0xad, 0xe7, 0x34, 0xdc, 0xd2, 0xab, 0x98, 0x60, 0xdb, 0x72, 0x74, 0xd7, 0x22, 0xed,
+	0xac, 0x4d, 0x2c, 0xd7, 0x82, 0x13, 0xae, 0x6b, 0x66, 0x3d, 0x74, 0xb6, 0x75, 0x56, 0x9a, 0xaf,
+	0xe9, 0x6e, 0xbd, 0xb9, 0x96, 0xad, 0x5a, 0x8d, 0x1c, 0x36, 0x5b, 0x56, 0xdb, 0x26, 0xd6, 0x1b,
+	0xed, 0x1c, 0x03, 0x57, 0xe7, 0x6a, 0xd8, 0x9c, 0x6b, 0x21, 0x43, 0xd7, 0x90, 0x8b, 0x73, 0x03,
+	0x0d, 0x4e, 0x29, 0xcd, 0x85, 0x28, 0x6a, 0x56, 0xcd, 0xe2, 0xca, 0x6b, 0xcd, 0x75, 0xd6, 0x63,
+	0x1d, 0xd6, 0xf2, 0xe0, 0x8f, 0xd4, 0x2c, 0xab, 0x66, 0x60, 0xb6, 0x48, 0x64, 0x9a, 0x96, 0x8b,
+	0x5c, 0xdd, 0x32, 0x1d, 0x4f, 0x2a, 0x7b, 0xd2, 0x80, 0x63, 0x93, 0x20, 0xdb, 0xc6, 0xc4, 0x97,
+	0xcf, 0xf4, 0xcb, 0xd7, 0x75, 0x6c, 0x68, 0x6a, 0x03, 0x39, 0x1b, 0x1e, 0x22, 0x33, 0x68, 0x0b,
+	0x6c, 0x6a, 0x2a, 0xb7, 0x87, 0xcf, 0x32, 0x88, 0x69, 0x60, 0xc7, 0x41, 0x35, 0xec, 0xcf, 0xf3,
+	0xd8, 0x20, 0x42, 0xd7, 0xb0, 0xe9, 0xea, 0xeb, 0x7a, 0xb0, 0x98, 0xcc, 0x3b, 0x31, 0x30, 0x51,
+	0x32, 0xb5, 0x22, 0xa3, 0x2e, 0x10, 0x64, 0x6a, 0x70, 0x09, 0x8c, 0xad, 0xd1, 0x86, 0xaa, 0x6b,
+	0xa2, 0x30, 0x23, 0xcc, 0x8e, 0x17, 0xf2, 0x77, 0x0a, 0x27, 0x48, 0x46, 0x3c, 0x91, 0x97, 0x5f,
+	0xbb, 0x8e, 0xe6, 0xde, 0xfc, 0xca, 0xdc, 0x33, 0xaf, 0xce, 0x9e, 0x3f, 0x77, 0x7d, 0xee, 0xd5,
+	0xf3, 0x7e, 0xf7, 0xd4, 0x8d, 0xfc, 0x13, 0x37, 0x4f, 0x74, 0xb6, 0xd2, 0x09, 0xc6, 0x51, 0x2e,
+	0x2a, 0x09, 0xc6, 0x51, 0xd6, 0x20, 0x04, 0x23, 0x26, 0x6a, 0x60, 0x31, 0x4a, 0xa9, 0x14, 0xd6,
+	0x86, 0xe7, 0xc0, 0x71, 0x9b, 0xe8, 0x2d, 0xe4, 0x62, 0x15, 0x9b, 0x2e, 0x26, 0x36, 0xd1, 0x1d,
+	0xac, 0x9a, 0xcd, 0xc6, 0x1a, 0x26, 0x62, 0x6c, 0x46, 0x98, 0x3d, 0xac, 0x1c, 0xf3, 0x00, 0xa5,
+	0x40, 0x5e, 0x61, 0x62, 0x78, 0x01, 0xa4, 0x2d, 0x52, 0x43, 0xa6, 0xfe, 0x26, 0xb3, 0xba, 0xda,
+	0x34, 0xf5, 0xd7, 0x9b, 0x58, 0x0d, 0x6d, 0x4d, 0x1c, 0x99, 0x89, 0xcd, 0x8e, 0x2b, 0x8f, 0x86,
+	0x61, 0xab, 0x0c, 0x55, 0xee, 0x82, 0xe0, 0x32, 0x38, 0x46, 0x0d, 0xa4, 0x22, 0xc3, 0xd0, 0x91,
+	0x59, 0xc5, 0x6a, 0x0b, 0x9b, 0x9a, 0x45, 0xe8, 0xae, 0x47, 0xe9, 0x0a, 0x0a, 0x62, 0x67, 0x2b,
+	0x3d, 0xb5, 0x68, 0x29, 0x68, 0xde, 0x43, 0x5c, 0x63, 0x80, 0x72, 0x51, 0x99, 0xa2, 0x8a, 0x7d,
+	0xa3, 0x1a, 0x14, 0x41, 0x62, 0x13, 0xaf, 0x39, 0xba, 0x8b, 0xc5, 0x38, 0xdb, 0xab, 0xdf, 0x85,
+	0x53, 0x60, 0x14, 0x37, 0x90, 0x6e, 0x88, 0x09, 0x36, 0xce, 0x3b, 0xd4, 0x30, 0x86, 0x55, 0xb3,
+	0xc4, 0x31, 0x6e, 0x18, 0xda, 0xce, 0xfc, 0x48, 0x0c, 0x1d, 0xc7, 0x92, 0xa5, 0x61, 0xe3, 0x8b,
+	0x3e, 0x8e, 0x25, 0x30, 0xd6, 0xa0, 0xbc, 0x94, 0x2e, 0x7a, 0x60, 0x3a, 0xb6, 0x24, 0x4a, 0xc7,
+	0x38, 0xca, 0x1a, 0x9c, 0xf6, 0x4e, 0x37, 0xc6, 0xa8, 0x12, 0x77, 0x0a, 0x23, 0x24, 0x2a, 0xe6,
+	0xbd, 0x63, 0x3e, 0x0d, 0x92, 0x1a, 0x76, 0xaa, 0x44, 0xb7, 0xe9, 0x11, 0x88, 0x23, 0x0c, 0x33,
+	0x76, 0xa7, 0x30, 0x4a, 0x62, 0xe2, 0x47, 0x93, 0x4a, 0x58, 0x08, 0xaf, 0x83, 0x23, 0x75, 0x44,
+	0xb4, 0x4d, 0x44, 0xe8, 0x49, 0x10, 0x87, 0x3a, 0x94, 0x38, 0x3a, 0x13, 0x9b, 0x4d, 0xe6, 0xb3,
+	0xd9, 0x5e, 0x8f, 0xcf, 0xf6, 0x5a, 0x28, 0x7b, 0xc9, 0xd3, 0xbb, 0xc6, 0xd5, 0x94, 0x54, 0xbd,
+	0x77, 0xc0, 0xa1, 0xe4, 0xeb, 0x3a, 0x69, 0xf4, 0x92, 0xc7, 0xf7, 0x45, 0x7e, 0xc1, 0xd3, 0x0b,
+	0xc8, 0xd7, 0x7b, 0x07, 0x1c, 0x7a, 0xee, 0x0e, 0x36, 0x1d, 0x8b, 0x38, 0x62, 0x82, 0x5d, 0x3c,
+	0xbf, 0x0b, 0xcb, 0x00, 0x68, 0x7a, 0x03, 0x9b, 0x7c, 0x3e, 0x7a, 0xce, 0xc9, 0xfc, 0xa9, 0x21,
+	0xf3, 0x15, 0x03, 0x05, 0x25, 0xa4, 0x0c, 0xcf, 0x82, 0xf8, 0x26, 0xd6, 0x6b, 0x75, 0x57, 0x1c,
+	0x67, 0x34, 0xd3, 0x59, 0x1e, 0x45, 0xb2, 0x7e, 0x14, 0xc9, 0x5e, 0x30, 0x2c, 0xe4, 0x5e, 0x43,
+	0x46, 0x13, 0x2b, 0x1e, 0x14, 0x3e, 0x0f, 0x12, 0x6b, 0xc8, 0x75, 0x31, 0x69, 0x8b, 0x80, 0x69,
+	0x9d, 0x1c, 0x32, 0x79, 0x81, 0xa3, 0x15, 0x5f, 0x0d, 0x62, 0x30, 0x65, 0xd9, 0x98, 0x20, 0x57,
+	0x37, 0x6b, 0x6a, 0xd5, 0x32, 0x35, 0x9d, 0x45, 0x3a, 0x31, 0xc9, 0xe8, 0xf2, 0x43, 0xe8, 0x96,
+	0x7d, 0xd5, 0x85, 0x40, 0x53, 0x39, 0x6a, 0x0d, 0x0e, 0xc2, 0xc7, 0x40, 0x42, 0xb7, 0xd5, 0xaa,
+	0xa5, 0x61, 0xf1, 0x10, 0xbb, 0x24, 0xa0, 0xb3, 0x95, 0x8e, 0x97, 0x57, 0x16, 0x2c, 0x0d, 0x2b,
+	0x71, 0xdd, 0xa6, 0x7f, 0xe1, 0x0b, 0x20, 0xb5, 0x81, 0xdb, 0xaa, 0x4d, 0xac, 0x96, 0x4e, 0x8d,
+	0xa2, 0x9b, 0x35, 0xf1, 0xf0, 0x4c, 0x6c, 0x76, 0x22, 0x9f, 0xee, 0x5f, 0xc7, 0x65, 0xdc, 0x5e,
+	0x09, 0xc1, 0x94, 0xc9, 0x8d, 0xde, 0x01, 0xf8, 0x1c, 0x38, 0x44, 0xb9, 0x1c, 0x5c, 0x6d, 0x12,
+	0xdd, 0x6d, 0x8b, 0x13, 0x33, 0xc2, 0xec, 0x44, 0x7e, 0x7a, 0x17, 0x9e, 0x2b, 0x1e, 0x44, 0x49,
+	0x6e, 0x74, 0x3b, 0xf0, 0x1b, 0x20, 0x6e, 0xd7, 0x2d, 0xd7, 0x72, 0xc4, 0x49, 0x66, 0x89, 0xff,
+	0x1f, 0x62, 0x89, 0x15, 0x06, 0x56, 0x3c, 0x25, 0xaa, 0xde, 0xd2, 0x35, 0x6c, 0x39, 0x62, 0x6a,
+	0x5f, 0xea, 0xd7, 0x18, 0x58, 0xf1, 0x94, 0x60, 0x0e, 0x24, 0x6d, 0x62, 0x69, 0xcd, 0xaa, 0xab,
+	0x36, 0x89, 0x21, 0x1e, 0x61, 0x26, 0x9b, 0xe8, 0x6c, 0xa5, 0xc1, 0x0a, 0x1f, 0x5e, 0x55, 0x16,
+	0x15, 0xe0, 0x41, 0x56, 0x89, 0x01, 0x9f, 0x04, 0x87, 0x35, 0xe4, 0x22, 0xa7, 0x8e, 0x31, 0x57,
+	0x81, 0x4c, 0x25, 0xd5, 0xd9, 0x4a, 0x1f, 0x2a, 0xfa, 0x02, 0xaa, 0x74, 0x28, 0x80, 0x51, 0xb5,
+	0x12, 0x18, 0x27, 0xd8, 0xc1, 0x86, 0x41, 0x83, 0xea, 0x51, 0xe6, 0x2e, 0x8f, 0x0f, 0x59, 0xa9,
+	0xe2, 0xe1, 0x95, 0xae, 0x26, 0x5c, 0x04, 0xc9, 0xaa, 0xd5, 0xb0, 0x79, 0xb8, 0x74, 0xc4, 0x29,
+	0xb6, 0xe5, 0xd3, 0x43, 0x88, 0x16, 0xba, 0x1a, 0x4a, 0x58, 0x1d, 0x9e, 0x01, 0x47, 0x90, 0xc6,
+	0x2f, 0x0e, 0x32, 0x54, 0x82, 0x34, 0xdd, 0x72, 0xc4, 0x87, 0x98, 0xe3, 0xa5, 0xba, 0x02, 0x85,
+	0x8d, 0x4b, 0xeb, 0x60, 0xb2, 0x2f, 0x3a, 0x50, 0x77, 0xf5, 0x42, 0x00, 0x0f, 0xa7, 0x4a, 0xa2,
+	0xd5, 0x95, 0x98, 0xcd, 0x06, 0x26, 0x7a, 0x95, 0x45, 0xc6, 0xc3, 0x8a, 0xdf, 0x85, 0x69, 0x90,
+	0xb4, 0x11, 0x71, 0xc3, 0x19, 0x6a, 0x5c, 0x01, 0x74, 0x88, 0x27, 0x25, 0xe9, 0x5f, 0x31, 0x30,
+	0xd9, 0x17, 0x29, 0xee, 0x69, 0xa2, 0xe7, 0xc0, 0xb4, 0xd3, 0xb4, 0x6d, 0x8b, 0xb8, 0x58, 0x53,
+	0x07, 0xe3, 0x61, 0x8c, 0x6d, 0xf3, 0x78, 0x00, 0xb9, 0xd4, 0x1f, 0xe8, 0xbe, 0x09, 0xc6, 0x6c,
+	0x62, 0xad, 0xeb, 0x06, 0xe6, 0x59, 0x30, 0x99, 0x7f, 0xf6, 0x60, 0xf1, 0x2d, 0xbb, 0xe2, 0xa9,
+	0x97, 0x4c, 0x97, 0xb4, 0x95, 0x80, 0x4d, 0xfa, 0xa9, 0x00, 0x12, 0x9e, 0x0c, 0x3e, 0x01, 0x80,
+	0x37, 0xde, 0x4d, 0x4a, 0x87, 0x3b, 0x5b, 0xe9, 0x71, 0x0f, 0x50, 0x2e, 0x2a, 0xe3, 0x1e, 0xa0,
+	0xac, 0xc1, 0x79, 0x70, 0xc4, 0x9b, 0x5e, 0xad, 0x62, 0xc2, 0xd2, 0x2f, 0x4f, 0x3d, 0x63, 0x85,
+	0xa9, 0xce, 0x56, 0x3a, 0x45, 0x53, 0xec, 0x4b, 0xf3, 0x95, 0x05, 0x5f, 0xa6, 0xa4, 0x3c, 0x78,
+	0x30, 0x02, 0x4f, 0x82, 0x31, 0x1a, 0x1c, 0xaa, 0x74, 0x3a, 0x9e, 0x69, 0x92, 0x34, 0x1b, 0xd1,
+	0xb0, 0x50, 0xa5, 0xd9, 0x88, 0x09, 0xcb, 0x9a, 0xe4, 0x80, 0xc3, 0x3d, 0xeb, 0x87, 0x29, 0x10,
+	0xdb, 0xc0, 0x6d, 0xcf, 0xfe, 0xb4, 0x09, 0x17, 0xc1, 0x68, 0x8b, 0x06, 0x49, 0xb6, 0x82, 0x64,
+	0xfe, 0xa9, 0x7b, 0x33, 0x8f, 0xc2, 0x49, 0xce, 0x45, 0x9f, 0x16, 0xa4, 0x8e, 0x00, 0x40, 0x37,
+	0x6a, 0xc3, 0xaf, 0x82, 0xd1, 0x4d, 0x5d, 0x73, 0xeb, 0xa2, 0x30, 0x3c, 0x50, 0x73, 0x24, 0x0d,
+	0xee, 0x75, 0x1e, 0xdc, 0xa3, 0xfb, 0x08, 0xee, 0x1c, 0x0a, 0xbf, 0x06, 0xc6, 0x34, 0x1d, 0x35,
+	0xb0, 0xeb, 0x5d, 0xc8, 0x21, 0x6a, 0x01, 0x98, 0xce, 0x66, 0x60, 0xb3, 0xe6, 0xd6, 0xc5, 0x91,
+	0xe1, 0x6a, 0x1e, 0x54, 0xba, 0x0e, 0x12, 0x5e, 0x72, 0x80, 0xcf, 0x82, 0x24, 0xc1, 0xb6, 0x81,
+	0xaa, 0x18, 0xad, 0x19, 0xd8, 0xdb, 0xa6, 0x34, 0x40, 0x52, 0xb0, 0x2c, 0x83, 0x73, 0x84, 0xe1,
+	0xb4, 0xea, 0x71, 0xdb, 0x76, 0x50, 0x0e, 0xd2, 0xb6, 0xf4, 0xa7, 0x28, 0x38, 0xba, 0x4b, 0xae,
+	0x80, 0xd7, 0x41, 0xd2, 0xc5, 0x0d, 0x26, 0x68, 0x12, 0x7f, 0xa6, 0x67, 0x0e, 0x9e, 0x74, 0xb2,
+	0x8b, 0x7a, 0x43, 0x77, 0x1d, 0x25, 0xcc, 0x06, 0xd7, 0xc1, 0x11, 0x82, 0x0d, 0xe4, 0xea, 0x2d,
+	0xac, 0xd6, 0x9b, 0x0d, 0x5d, 0xa3, 0x79, 0x20, 0x7a, 0xbf, 0x53, 0xa4, 0x7c, 0xce, 0x4b, 0x1e,
+	0xa5, 0xb4, 0x0e, 0xe2, 0x5c, 0x06, 0xe7, 0x40, 0xac, 0xa1, 0x9b, 0xfb, 0xb9, 0x17, 0x14, 0xc7,
+	0xe0, 0xe8, 0x8d, 0xfd, 0x5c, 0x09, 0x8a, 0x93, 0xf2, 0x20, 0xce, 0xb3, 0x0c, 0x35, 0x71, 0x03,
+	0xe9, 0x7e, 0xd4, 0x61, 0x6d, 0x5a, 0x82, 0x5a, 0x6e, 0x1d, 0x13, 0x31, 0xca, 0x42, 0x08, 0xef,
+	0x50, 0x1d, 0x9e, 0x5a, 0x0e, 0xa0, 0xf3, 0x22, 0x18, 0xf3, 0x83, 0x7c, 0x50, 0xdb, 0x0b, 0xa1,
+	0xda, 0xfe, 0x61, 0x10, 0x27, 0xb8, 0x46, 0xa3, 0x1e, 0x57, 0xf3, 0x7a, 0xf0, 0x38, 0x88, 0xd1,
+	0xcc, 0xe3, 0x15, 0x8a, 0x9d, 0xad, 0x74, 0x8c, 0x26, 0x1c, 0x3a, 0x26, 0xbd, 0x17, 0x05, 0xc9,
+	0x50, 0xbc, 0x87, 0x4b, 0x20, 0xee, 0xa0, 0x75, 0xec, 0x52, 0xc7, 0xa5, 0x31, 0xec, 0xc9, 0xfd,
+	0xe7, 0x8a, 0x50, 0x5b, 0xf1, 0x48, 0xe0, 0x6b, 0x60, 0x92, 0xa5, 0x09, 0x15, 0xbf, 0xde, 0xd4,
+	0xed, 0x06, 0x36, 0x5d, 0x31, 0x7a, 0x3f, 0xbc, 0x13, 0x8c, 0xad, 0xe4, 0x93, 0x49, 0xdf, 0x06,
+	0xa0, 0x2b, 0xa5, 0x36, 0x59, 0xb3, 0x34, 0x3f, 0xe6, 0xb0, 0x36, 0x1d, 0x33, 0x2d, 0xd2, 0x08,
+	0xde, 0x40, 0x16, 0x69, 0x40, 0x09, 0x8c, 0x39, 0x2e, 0x32, 0x35, 0x44, 0xbc, 0x98, 0xa6, 0x04,
+	0xfd, 0x70, 0xea, 0x18, 0xe9, 0x49, 0x1d, 0x99, 0x77, 0xa3, 0x40, 0xbc, 0x88, 0xdd, 0xde, 0x27,
+	0x9b, 0x82, 0x5f, 0x6f, 0x62, 0xc7, 0x85, 0x35, 0x30, 0x89, 0x6c, 0xdb, 0xd0, 0xab, 0xfc, 0x65,
+	0xa4, 0x6b, 0x8e, 0x28, 0xec, 0x5e, 0xf7, 0xcd, 0x77, 0x61, 0xa1, 0x37, 0x51, 0xe1, 0xe1, 0xdb,
+	0x5b, 0xe9, 0x48, 0x67, 0x2b, 0x3d, 0x11, 0x96, 0x17, 0x1d, 0x65, 0x02, 0x85, 0xf1, 0x4e, 0xcf,
+	0x9b, 0x24, 0x7a, 0xff, 0x6f, 0x92, 0xf3, 0x00, 0x74, 0xdf, 0xc0, 0x62, 0x6c, 0x8f, 0x80, 0x72,
+	0x81, 0x42, 0x96, 0x90, 0xb3, 0x51, 0x18, 0xa1, 0xcb, 0x54, 0xc6, 0xd7, 0xfd, 0x81, 0xcc, 0x76,
+	0x14, 0x48, 0x8b, 0xba, 0xd3, 0x67, 0x16, 0xe7, 0x4b, 0xb7, 0x8b, 0x0c, 0x46, 0x0d, 0xea, 0xeb,
+	0x3c, 0xad, 0xb3, 0xa7, 0xce, 0xe9, 0x98, 0xf8, 0x69, 0x42, 0xe1, 0xc3, 0xf4, 0x1e, 0xd8, 0xa8,
+	0x86, 0xbd, 0x27, 0x2e, 0x6b, 0xc3, 0x22, 0x18, 0xb3, 0x88, 0x86, 0x89, 0xba, 0xd6, 0xf6, 0x5e,
+	0x48, 0xa7, 0xee, 0x14, 0x4e, 0x92, 0x13, 0x4a, 0x44, 0x09, 0x8c, 0xac, 0x8c, 0xcf, 0x05, 0x4d,
+	0xe6, 0x65, 0xca, 0xe8, 0x1c, 0xfb, 0x93, 0x60, 0xaa, 0x85, 0x36, 0x4c, 0x83, 0xb8, 0x83, 0x11,
+	0xa9, 0xd6, 0xc5, 0xd1, 0xf0, 0x4b, 0x4c, 0x53, 0xbc, 0xe1, 0x3e, 0x1b, 0xc7, 0x0f, 0x6e, 0xe3,
+	0x4e, 0xdf, 0xcd, 0x63, 0x2e, 0xf2, 0xa0, 0xdf, 0xbc, 0xf0, 0x6b, 0x38, 0x76, 0xff, 0xaf, 0xe1,
+	0x5e, 0x23, 0x8f, 0x1c, 0xdc, 0xc8, 0x7f, 0x89, 0xf5, 0x5d, 0x64, 0x36, 0xc5, 0x97, 0x7f, 0x91,
+	0x5f, 0x1c, 0x30, 0xf3, 0x53, 0x77, 0x0a, 0xb3, 0xe4, 0xa4, 0x78, 0x22, 0x9f, 0x79, 0x6d, 0xf6,
+	0x6e, 0x86, 0xf9, 0xce, 0xa9, 0xf3, 0xbb, 0x9b, 0x3a, 0xf0, 0x8d, 0xd8, 0xdd, 0x7d, 0x63, 0x24,
+	0xe4, 0x1b, 0x2f, 0x87, 0x7c, 0x83, 0xdf, 0xeb, 0xe7, 0xee, 0x14, 0xbe, 0x4e, 0x9e, 0xd9, 0xcb,
+	0x37, 0x82, 0xc3, 0x54, 0xc6, 0xe7, 0x82, 0xe6, 0x30, 0x87, 0x89, 0xef, 0xc7, 0x61, 0x12, 0x07,
+	0x3f, 0xcb, 0xbf, 0x09, 0x00, 0x5e, 0xc4, 0xee, 0x55, 0xdc, 0xb0, 0x0d, 0xe4, 0xe2, 0x2f, 0xfd,
+	0x0c, 0xbf, 0x05, 0x92, 0x5e, 0xd6, 0x60, 0x93, 0xf0, 0x3a, 0xe2, 0xcc, 0x9e, 0x29, 0xcf, 0xab,
+	0x70, 0xc3, 0x33, 0xb1, 0x27, 0xa5, 0x3f, 0x5e, 0x74, 0x14, 0xd0, 0xf2, 0x31, 0x4e, 0x66, 0x47,
+	0x00, 0xd2, 0x45, 0xec, 0xae, 0xa0, 0xb6, 0x61, 0x21, 0xed, 0x82, 0x45, 0x1a, 0xac, 0x3c, 0xfc,
+	0x2f, 0xdb, 0xe5, 0x2a, 0x98, 0xde, 0x35, 0xaf, 0x38, 0xb6, 0x65, 0x3a, 0x18, 0x3e, 0x05, 0xe2,
+	0xec, 0xee, 0x39, 0x5e, 0xa1, 0x22, 0xef, 0x39, 0x2f, 0xcf, 0xd3, 0x1e, 0x7a, 0x80, 0xd6, 0xf7,
+	0xf2, 0x2e, 0x2d, 0xbb, 0xbd, 0xc3, 0x69, 0x79, 0x10, 0xf6, 0xd0, 0x99, 0x7f, 0x44, 0xc1, 0xb1,
+	0x25, 0xfe, 0x11, 0xb8, 0xff, 0x5c, 0xe0, 0x25, 0x30, 0xbe, 0xee, 0x77, 0xd8, 0x51, 0x4c, 0xe4,
+	0x67, 0xfa, 0x69, 0xfb, 0x95, 0x98, 0x93, 0xbe, 0x2d, 0x44, 0x53, 0x82, 0xd2, 0x55, 0x86, 0x39,
+	0x70, 0x34, 0xe8, 0xa8, 0x36, 0x22, 0xde, 0x1b, 0x84, 0xd7, 0x36, 0x30, 0x10, 0xad, 0xf8, 0x12,
+	0xf8, 0x5d, 0x01, 0x48, 0xb4, 0xe4, 0x41, 0x86, 0xfe, 0x26, 0xd6, 0x54, 0x9b, 0xcf, 0xa2, 0x36,
+	0x90, 0x6d, 0xd3, 0x6f, 0x38, 0x31, 0xb6, 0xc7, 0x52, 0xff, 0x62, 0xf6, 0xd8, 0x48, 0xb6, 0x12,
+	0x30, 0x79, 0xa2, 0x25, 0xce, 0xc3, 0x1f, 0xac, 0xa2, 0xb9, 0x87, 0x58, 0xba, 0x0c, 0x1e, 0xbd,
+	0xab, 0xea, 0x2e, 0x6f, 0xc5, 0xa9, 0xf0, 0x5b, 0x71, 0x3c, 0xf4, 0xe6, 0x3b, 0xfd, 0xae, 0x00,
+	0x26, 0xfb, 0x3e, 0x32, 0xc1, 0x47, 0x80, 0x78, 0xb9, 0xf4, 0xb2, 0xba, 0xa2, 0x2c, 0x5f, 0x2b,
+	0x5f, 0x29, 0x2f, 0x57, 0xca, 0x95, 0x8b, 0xea, 0x6a, 0xe5, 0x72, 0x65, 0xf9, 0xa5, 0x4a, 0x2a,
+	0x02, 0xa7, 0xc1, 0xb1, 0x01, 0xe9, 0xc2, 0xea, 0x95, 0xab, 0xcb, 0x4b, 0x29, 0x01, 0xce, 0x80,
+	0x47, 0x06, 0x84, 0x2f, 0x2c, 0x97, 0x2b, 0xea, 0x95, 0x92, 0x72, 0xad, 0xa4, 0xa4, 0xa2, 0xf0,
+	0x51, 0x70, 0x7c, 0x00, 0xb1, 0x34, 0x5f, 0x29, 0x5f, 0x28, 0x5d, 0xb9, 0x9a, 0x8a, 0x49, 0x23,
+	0xb7, 0x7e, 0x26, 0x47, 0x4e, 0x7f, 0x4f, 0x00, 0xc9, 0xd0, 0x27, 0x2b, 0x28, 0x82, 0x29, 0xaa,
+	0x74, 0xa5, 0xb4, 0xb0, 0xaa, 0x94, 0xaf, 0xbe, 0x1c, 0x5a, 0xcd, 0x43, 0xe0, 0x48, 0x8f, 0xa4,
+	0xb2, 0x5c, 0x29, 0xa5, 0x04, 0x98, 0x06, 0xd3, 0x3d, 0xc3, 0x4a, 0x69, 0xbe, 0x48, 0xe7, 0xbc,
+	0x5a, 0x5a, 0xb8, 0x5a, 0x2a, 0xa6, 0xa2, 0x03, 0x00, 0xd6, 0x28, 0xa9, 0xa5, 0xc5, 0xd2, 0x52,
+	0xa9, 0x12, 0x2c, 0x24, 0xff, 0xe1, 0x24, 0x48, 0xf1, 0x0b, 0xaa, 0x04, 0xbf, 0xde, 0xc0, 0x5f,
+	0x08, 0x00, 0xd0, 0x5b, 0xcf, 0x7d, 0x08, 0x0e, 0x7c, 0x00, 0xda, 0xbb, 0x80, 0x93, 0xce, 0xec,
+	0x0b, 0xcb, 0xbd, 0x27, 0xf3, 0xfc, 0xdb, 0x7f, 0xfd, 0xfb, 0xbb, 0xd1, 0x73, 0xf0, 0xe9, 0x9c,
+	0x46, 0x72, 0xa1, 0x68, 0xe1, 0xe4, 0x6e, 0xf4, 0x85, 0xa4, 0x6c, 0x6f, 0xff, 0x66, 0x8e, 0xbb,
+	0x27, 0xfc, 0x89, 0x00, 0xc6, 0x2e, 0x62, 0xbe, 0x50, 0x38, 0xdb, 0x3f, 0xf7, 0x5e, 0xe5, 0xb7,
+	0x34, 0xc4, 0xfb, 0x33, 0x97, 0xd9, 0xc2, 0x4a, 0x70, 0xe1, 0x5e, 0x17, 0x96, 0xbb, 0xe1, 0x67,
+	0xba, 0x9b, 0xf0, 0x9f, 0x9e, 0x35, 0x79, 0xe8, 0x18, 0x62, 0xcd, 0x9e, 0x2a, 0x42, 0x3a, 0xb3,
+	0x2f, 0xac, 0x67, 0xcd, 0xef, 0x0b, 0x6c, 0xd5, 0xb7, 0x04, 0xf8, 0xc2, 0x17, 0xb0, 0xec, 0x1c,
+	0x8f, 0x54, 0xaf, 0xdc, 0xd3, 0xe9, 0x70, 0x5d, 0xf8, 0x6b, 0x7e, 0x3a, 0xfc, 0xd7, 0x91, 0xbb,
+	0x9e, 0x4e, 0xb8, 0x44, 0x95, 0x86, 0x04, 0xd1, 0xcc, 0xab, 0x6c, 0x9f, 0x2f, 0xc1, 0xd5, 0x2f,
+	0x6e, 0x9b, 0xb9, 0x1b, 0x7e, 0x11, 0x72, 0x13, 0xbe, 0x13, 0x05, 0xc9, 0x50, 0x35, 0x00, 0x33,
+	0xbb, 0x2c, 0xbc, 0xaf, 0x54, 0x90, 0xfe, 0x6f, 0xcf, 0x25, 0xfb, 0xc8, 0xcc, 0x6f, 0xf9, 0xf1,
+	0xfc, 0x4a, 0x80, 0xbf, 0x14, 0xee, 0x7d, 0xe1, 0xa1, 0x0c, 0x9a, 0x1d, 0xdc, 0x44, 0x58, 0x1a,
+	0x6c, 0xa8, 0x77, 0xb8, 0xff, 0xd7, 0x95, 0x3e, 0xf1, 0x9a, 0x4f, 0xe9, 0xfa, 0xdb, 0x7f, 0x3f,
+	0x0a, 0x52, 0x17, 0xb1, 0xbb, 0x6a, 0x1b, 0xba, 0xb9, 0x51, 0xc4, 0xf4, 0x03, 0x1e, 0x19, 0xbc,
+	0xc4, 0x7b, 0x17, 0x18, 0xd2, 0xe3, 0xfb, 0xcc, 0x17, 0x99, 0x3f, 0x73, 0x0b, 0xfd, 0x41, 0x80,
+	0xbf, 0x7b, 0x10, 0x2c, 0x14, 0xe4, 0x4f, 0x27, 0xd7, 0x64, 0xc6, 0xc9, 0x69, 0x9e, 0x75, 0x7e,
+	0x13, 0x65, 0xf5, 0x64, 0xd1, 0xda, 0x34, 0xff, 0xe3, 0x46, 0xbb, 0xcd, 0x8d, 0xf6, 0x47, 0x01,
+	0xfe, 0xfe, 0x01, 0x33, 0x9a, 0xe6, 0x99, 0x67, 0x2f, 0xb3, 0x95, 0xcc, 0xff, 0x99, 0xed, 0x6e,
+	0x66, 0xc3, 0xdc, 0x3e, 0x85, 0x9f, 0x0b, 0xb7, 0xb7, 0x65, 0xe1, 0xa3, 0x6d, 0x59, 0xf8, 0x78,
+	0x5b, 0x8e, 0x7c, 0xb2, 0x2d, 0x47, 0x3e, 0xdd, 0x96, 0x23, 0x9f, 0x6d, 0xcb, 0x91, 0xcf, 0xb7,
+	0x65, 0xe1, 0xad, 0x8e, 0x2c, 0xdc, 0xea, 0xc8, 0x91, 0xf7, 0x3a, 0xb2, 0xf0, 0x7e, 0x47, 0x8e,
+	0x7c, 0xd0, 0x91, 0x23, 0x1f, 0x76, 0xe4, 0xc8, 0xed, 0x8e, 0x2c, 0x7c, 0xd4, 0x91, 0x85, 0x8f,
+	0x3b, 0x72, 0xe4, 0x93, 0x8e, 0x2c, 0x7c, 0xda, 0x91, 0x23, 0x9f, 0x75, 0x64, 0xe1, 0xf3, 0x8e,
+	0x1c, 0x79, 0x6b, 0x47, 0x8e, 0xdc, 0xda, 0x91, 0x85, 0x1f, 0xec, 0xc8, 0x91, 0x1f, 0xee, 0xc8,
+	0xc2, 0x8f, 0x77, 0xe4, 0xc8, 0x7b, 0x3b, 0x72, 0xe4, 0xfd, 0x1d, 0x59, 0xf8, 0x60, 0x47, 0x16,
+	0x3e, 0xdc, 0x91, 0x85, 0x57, 0x72, 0x35, 0x2b, 0xeb, 0xd6, 0xb1, 0x5b, 0xd7, 0xcd, 0x9a, 0x93,
+	0x35, 0xb1, 0xbb, 0x69, 0x91, 0x8d, 0x5c, 0xef, 0x7f, 0x32, 0xb4, 0xce, 0xe6, 0xec, 0x8d, 0x5a,
+	0xce, 0x75, 0x4d, 0x7b, 0x6d, 0x2d, 0xce, 0x9e, 0x62, 0x67, 0xff, 0x3d, 0x00, 0x6e, 0xa4, 0x88,
+	0x8b, 0x40, 0x22, 0x00, 0x00,
 }
 
 func (x KeyProvisioning) String() string {
@@ -2834,6 +2851,14 @@ func (this *MessagePayloadFormatter) Equal(that interface{}) bool {
 	if this.FormatterParameter != that1.FormatterParameter {
 		return false
 	}
+	if len(this.NormalizedPayloadMapping) != len(that1.NormalizedPayloadMapping) {
+		return false
+	}
+	for i := range this.NormalizedPayloadMapping {
+		if this.NormalizedPayloadMapping[i] != that1.NormalizedPayloadMapping[i] {
+			return false
+		}
+	}
 	return true
 }
 
@@ -4521,6 +4546,25 @@ func (m *MessagePayloadFormatter) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.NormalizedPayloadMapping) > 0 {
+		for k := range m.NormalizedPayloadMapping {
+			v := m.NormalizedPayloadMapping[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDevicerepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDevicerepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDevicerepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FormatterParameter) > 0 {
 		i -= len(m.FormatterParameter)
 		copy(dAtA[i:], m.FormatterParameter)
@@ -4919,6 +4963,13 @@ func NewPopulatedMessagePayloadFormatter(r randyDevicerepository, easy bool) *Me
 	this := &MessagePayloadFormatter{}
 	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
 	this.FormatterParameter = randStringDevicerepository(r)
+	if r.Intn(5) != 0 {
+		v27 := r.Intn(10)
+		this.NormalizedPayloadMapping = make(map[string]string)
+		for i := 0; i < v27; i++ {
+			this.NormalizedPayloadMapping[randStringDevicerepository(r)] = randStringDevicerepository(r)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4943,9 +4994,9 @@ func randUTF8RuneDevicerepository(r randyDevicerepository) rune {
 	return rune(ru + 61)
 }
 func randStringDevicerepository(r randyDevicerepository) string {
-	v27 := r.Intn(100)
-	tmps := make([]rune, v27)
-	for i := 0; i < v27; i++ {
+	v28 := r.Intn(100)
+	tmps := make([]rune, v28)
+	for i := 0; i < v28; i++ {
 		tmps[i] = randUTF8RuneDevicerepository(r)
 	}
 	return string(tmps)
@@ -4967,11 +5018,11 @@ func randFieldDevicerepository(dAtA []byte, r randyDevicerepository, fieldNumber
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateDevicerepository(dAtA, uint64(key))
-		v28 := r.Int63()
+		v29 := r.Int63()
 		if r.Intn(2) == 0 {
-			v28 *= -1
+			v29 *= -1
 		}
-		dAtA = encodeVarintPopulateDevicerepository(dAtA, uint64(v28))
+		dAtA = encodeVarintPopulateDevicerepository(dAtA, uint64(v29))
 	case 1:
 		dAtA = encodeVarintPopulateDevicerepository(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -5569,6 +5620,14 @@ func (m *MessagePayloadFormatter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDevicerepository(uint64(l))
 	}
+	if len(m.NormalizedPayloadMapping) > 0 {
+		for k, v := range m.NormalizedPayloadMapping {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDevicerepository(uint64(len(k))) + 1 + len(v) + sovDevicerepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDevicerepository(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -5913,9 +5972,20 @@ func (this *MessagePayloadFormatter) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForNormalizedPayloadMapping := make([]string, 0, len(this.NormalizedPayloadMapping))
+	for k := range this.NormalizedPayloadMapping {
+		keysForNormalizedPayloadMapping = append(keysForNormalizedPayloadMapping, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNormalizedPayloadMapping)
+	mapStringForNormalizedPayloadMapping := "map[string]string{"
+	for _, k := range keysForNormalizedPayloadMapping {
+		mapStringForNormalizedPayloadMapping += fmt.Sprintf("%v: %v,", k, this.NormalizedPayloadMapping[k])
+	}
+	mapStringForNormalizedPayloadMapping += "}"
 	s := strings.Join([]string{`&MessagePayloadFormatter{`,
 		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
 		`FormatterParameter:` + fmt.Sprintf("%v", this.FormatterParameter) + `,`,
+		`NormalizedPayloadMapping:` + mapStringForNormalizedPayloadMapping + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10091,6 +10161,133 @@ func (m *MessagePayloadFormatter) Unmarshal(dAtA []byte) error {
 			}
 			m.FormatterParameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayloadMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevicerepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevicerepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevicerepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NormalizedPayloadMapping == nil {
+				m.NormalizedPayloadMapping = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDevicerepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDevicerepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDevicerepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDevicerepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDevicerepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDevicerepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDevicerepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDevicerepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDevicerepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NormalizedPayloadMapping[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevicerepository(dAtA[iNdEx:])
@@ -199,11 +199,13 @@ var ListEndDeviceModelsResponseFieldPathsTopLevel = []string{
 var MessagePayloadFormatterFieldPathsNested = []string{
 	"formatter",
 	"formatter_parameter",
+	"normalized_payload_mapping",
 }
 
 var MessagePayloadFormatterFieldPathsTopLevel = []string{
 	"formatter",
 	"formatter_parameter",
+	"normalized_payload_mapping",
 }
 var EndDeviceModel_HardwareVersionFieldPathsNested = []string{
 	"numeric",
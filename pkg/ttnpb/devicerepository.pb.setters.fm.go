@@ -849,6 +849,15 @@ func (dst *MessagePayloadFormatter) SetFields(src *MessagePayloadFormatter, path
 				var zero string
 				dst.FormatterParameter = zero
 			}
+		case "normalized_payload_mapping":
+			if len(subs) > 0 {
+				return fmt.Errorf("'normalized_payload_mapping' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NormalizedPayloadMapping = src.NormalizedPayloadMapping
+			} else {
+				dst.NormalizedPayloadMapping = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
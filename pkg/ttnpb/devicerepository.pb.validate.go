@@ -1435,6 +1435,8 @@ func (m *MessagePayloadFormatter) ValidateFields(paths ...string) error {
 
 		case "formatter_parameter":
 			// no validation rules for FormatterParameter
+		case "normalized_payload_mapping":
+			// no validation rules for NormalizedPayloadMapping
 		default:
 			return MessagePayloadFormatterValidationError{
 				field:  name,
@@ -153,12 +153,236 @@ func (v *PingSlotPeriodValue) FieldIsZero(p string) bool {
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *RelaySecondChannel) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "ack_offset":
+		return v.AckOffset == 0
+	case "data_rate_index":
+		return v.DataRateIndex == 0
+	case "frequency":
+		return v.Frequency == 0
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
+// FieldIsZero returns whether path p is zero.
+func (v *RelayForwardLimits) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "bucket_size":
+		return v.BucketSize == 0
+	case "reload_rate":
+		return v.ReloadRate == 0
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
+// FieldIsZero returns whether path p is zero.
+func (v *MACCommand_RelayConfigureFwdLimitReq) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "global_uplink_limits":
+		return v.GlobalUplinkLimits == nil
+	case "global_uplink_limits.bucket_size":
+		return v.GlobalUplinkLimits.FieldIsZero("bucket_size")
+	case "global_uplink_limits.reload_rate":
+		return v.GlobalUplinkLimits.FieldIsZero("reload_rate")
+	case "join_request_limits":
+		return v.JoinRequestLimits == nil
+	case "join_request_limits.bucket_size":
+		return v.JoinRequestLimits.FieldIsZero("bucket_size")
+	case "join_request_limits.reload_rate":
+		return v.JoinRequestLimits.FieldIsZero("reload_rate")
+	case "notify_limits":
+		return v.NotifyLimits == nil
+	case "notify_limits.bucket_size":
+		return v.NotifyLimits.FieldIsZero("bucket_size")
+	case "notify_limits.reload_rate":
+		return v.NotifyLimits.FieldIsZero("reload_rate")
+	case "overall_limits":
+		return v.OverallLimits == nil
+	case "overall_limits.bucket_size":
+		return v.OverallLimits.FieldIsZero("bucket_size")
+	case "overall_limits.reload_rate":
+		return v.OverallLimits.FieldIsZero("reload_rate")
+	case "reset_limit_counter":
+		return v.ResetLimitCounter == 0
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
+// FieldIsZero returns whether path p is zero.
+func (v *ServingRelayParameters) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "cad_periodicity":
+		return v.CADPeriodicity == 0
+	case "default_channel_index":
+		return v.DefaultChannelIndex == 0
+	case "join_request_filters":
+		return v.JoinRequestFilters == nil
+	case "limits":
+		return v.Limits == nil
+	case "limits.global_uplink_limits":
+		return v.Limits.FieldIsZero("global_uplink_limits")
+	case "limits.global_uplink_limits.bucket_size":
+		return v.Limits.FieldIsZero("global_uplink_limits.bucket_size")
+	case "limits.global_uplink_limits.reload_rate":
+		return v.Limits.FieldIsZero("global_uplink_limits.reload_rate")
+	case "limits.join_request_limits":
+		return v.Limits.FieldIsZero("join_request_limits")
+	case "limits.join_request_limits.bucket_size":
+		return v.Limits.FieldIsZero("join_request_limits.bucket_size")
+	case "limits.join_request_limits.reload_rate":
+		return v.Limits.FieldIsZero("join_request_limits.reload_rate")
+	case "limits.notify_limits":
+		return v.Limits.FieldIsZero("notify_limits")
+	case "limits.notify_limits.bucket_size":
+		return v.Limits.FieldIsZero("notify_limits.bucket_size")
+	case "limits.notify_limits.reload_rate":
+		return v.Limits.FieldIsZero("notify_limits.reload_rate")
+	case "limits.overall_limits":
+		return v.Limits.FieldIsZero("overall_limits")
+	case "limits.overall_limits.bucket_size":
+		return v.Limits.FieldIsZero("overall_limits.bucket_size")
+	case "limits.overall_limits.reload_rate":
+		return v.Limits.FieldIsZero("overall_limits.reload_rate")
+	case "limits.reset_limit_counter":
+		return v.Limits.FieldIsZero("reset_limit_counter")
+	case "second_channel":
+		return v.SecondChannel == nil
+	case "second_channel.ack_offset":
+		return v.SecondChannel.FieldIsZero("ack_offset")
+	case "second_channel.data_rate_index":
+		return v.SecondChannel.FieldIsZero("data_rate_index")
+	case "second_channel.frequency":
+		return v.SecondChannel.FieldIsZero("frequency")
+	case "uplink_forwarding_rules":
+		return v.UplinkForwardingRules == nil
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
+// FieldIsZero returns whether path p is zero.
+func (v *ServedRelayParameters) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "backoff":
+		return v.Backoff == 0
+	case "mode":
+		return v.Mode == 0
+	case "second_channel":
+		return v.SecondChannel == nil
+	case "second_channel.ack_offset":
+		return v.SecondChannel.FieldIsZero("ack_offset")
+	case "second_channel.data_rate_index":
+		return v.SecondChannel.FieldIsZero("data_rate_index")
+	case "second_channel.frequency":
+		return v.SecondChannel.FieldIsZero("frequency")
+	case "serving_device_id":
+		return v.ServingDeviceID == ""
+	case "smart_enable_level":
+		return v.SmartEnableLevel == 0
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
+// FieldIsZero returns whether path p is zero.
+func (v *RelayParameters) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "served":
+		return v.Served == nil
+	case "served.backoff":
+		return v.Served.FieldIsZero("backoff")
+	case "served.mode":
+		return v.Served.FieldIsZero("mode")
+	case "served.second_channel":
+		return v.Served.FieldIsZero("second_channel")
+	case "served.second_channel.ack_offset":
+		return v.Served.FieldIsZero("second_channel.ack_offset")
+	case "served.second_channel.data_rate_index":
+		return v.Served.FieldIsZero("second_channel.data_rate_index")
+	case "served.second_channel.frequency":
+		return v.Served.FieldIsZero("second_channel.frequency")
+	case "served.serving_device_id":
+		return v.Served.FieldIsZero("serving_device_id")
+	case "served.smart_enable_level":
+		return v.Served.FieldIsZero("smart_enable_level")
+	case "serving":
+		return v.Serving == nil
+	case "serving.cad_periodicity":
+		return v.Serving.FieldIsZero("cad_periodicity")
+	case "serving.default_channel_index":
+		return v.Serving.FieldIsZero("default_channel_index")
+	case "serving.join_request_filters":
+		return v.Serving.FieldIsZero("join_request_filters")
+	case "serving.limits":
+		return v.Serving.FieldIsZero("limits")
+	case "serving.limits.global_uplink_limits":
+		return v.Serving.FieldIsZero("limits.global_uplink_limits")
+	case "serving.limits.global_uplink_limits.bucket_size":
+		return v.Serving.FieldIsZero("limits.global_uplink_limits.bucket_size")
+	case "serving.limits.global_uplink_limits.reload_rate":
+		return v.Serving.FieldIsZero("limits.global_uplink_limits.reload_rate")
+	case "serving.limits.join_request_limits":
+		return v.Serving.FieldIsZero("limits.join_request_limits")
+	case "serving.limits.join_request_limits.bucket_size":
+		return v.Serving.FieldIsZero("limits.join_request_limits.bucket_size")
+	case "serving.limits.join_request_limits.reload_rate":
+		return v.Serving.FieldIsZero("limits.join_request_limits.reload_rate")
+	case "serving.limits.notify_limits":
+		return v.Serving.FieldIsZero("limits.notify_limits")
+	case "serving.limits.notify_limits.bucket_size":
+		return v.Serving.FieldIsZero("limits.notify_limits.bucket_size")
+	case "serving.limits.notify_limits.reload_rate":
+		return v.Serving.FieldIsZero("limits.notify_limits.reload_rate")
+	case "serving.limits.overall_limits":
+		return v.Serving.FieldIsZero("limits.overall_limits")
+	case "serving.limits.overall_limits.bucket_size":
+		return v.Serving.FieldIsZero("limits.overall_limits.bucket_size")
+	case "serving.limits.overall_limits.reload_rate":
+		return v.Serving.FieldIsZero("limits.overall_limits.reload_rate")
+	case "serving.limits.reset_limit_counter":
+		return v.Serving.FieldIsZero("limits.reset_limit_counter")
+	case "serving.second_channel":
+		return v.Serving.FieldIsZero("second_channel")
+	case "serving.second_channel.ack_offset":
+		return v.Serving.FieldIsZero("second_channel.ack_offset")
+	case "serving.second_channel.data_rate_index":
+		return v.Serving.FieldIsZero("second_channel.data_rate_index")
+	case "serving.second_channel.frequency":
+		return v.Serving.FieldIsZero("second_channel.frequency")
+	case "serving.uplink_forwarding_rules":
+		return v.Serving.FieldIsZero("uplink_forwarding_rules")
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // FieldIsZero returns whether path p is zero.
 func (v *MACSettings) FieldIsZero(p string) bool {
 	if v == nil {
 		return true
 	}
 	switch p {
+	case "adr_algorithm":
+		return v.ADRAlgorithm == nil
+	case "adr_algorithm_parameters":
+		return v.ADRAlgorithmParameters == nil
 	case "adr_margin":
 		return v.ADRMargin == nil
 	case "beacon_frequency":
@@ -187,6 +411,72 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 		return v.DesiredPingSlotDataRateIndex.FieldIsZero("value")
 	case "desired_ping_slot_frequency":
 		return v.DesiredPingSlotFrequency == nil
+	case "desired_relay":
+		return v.DesiredRelay == nil
+	case "desired_relay.served":
+		return v.DesiredRelay.FieldIsZero("served")
+	case "desired_relay.served.backoff":
+		return v.DesiredRelay.FieldIsZero("served.backoff")
+	case "desired_relay.served.mode":
+		return v.DesiredRelay.FieldIsZero("served.mode")
+	case "desired_relay.served.second_channel":
+		return v.DesiredRelay.FieldIsZero("served.second_channel")
+	case "desired_relay.served.second_channel.ack_offset":
+		return v.DesiredRelay.FieldIsZero("served.second_channel.ack_offset")
+	case "desired_relay.served.second_channel.data_rate_index":
+		return v.DesiredRelay.FieldIsZero("served.second_channel.data_rate_index")
+	case "desired_relay.served.second_channel.frequency":
+		return v.DesiredRelay.FieldIsZero("served.second_channel.frequency")
+	case "desired_relay.served.serving_device_id":
+		return v.DesiredRelay.FieldIsZero("served.serving_device_id")
+	case "desired_relay.served.smart_enable_level":
+		return v.DesiredRelay.FieldIsZero("served.smart_enable_level")
+	case "desired_relay.serving":
+		return v.DesiredRelay.FieldIsZero("serving")
+	case "desired_relay.serving.cad_periodicity":
+		return v.DesiredRelay.FieldIsZero("serving.cad_periodicity")
+	case "desired_relay.serving.default_channel_index":
+		return v.DesiredRelay.FieldIsZero("serving.default_channel_index")
+	case "desired_relay.serving.join_request_filters":
+		return v.DesiredRelay.FieldIsZero("serving.join_request_filters")
+	case "desired_relay.serving.limits":
+		return v.DesiredRelay.FieldIsZero("serving.limits")
+	case "desired_relay.serving.limits.global_uplink_limits":
+		return v.DesiredRelay.FieldIsZero("serving.limits.global_uplink_limits")
+	case "desired_relay.serving.limits.global_uplink_limits.bucket_size":
+		return v.DesiredRelay.FieldIsZero("serving.limits.global_uplink_limits.bucket_size")
+	case "desired_relay.serving.limits.global_uplink_limits.reload_rate":
+		return v.DesiredRelay.FieldIsZero("serving.limits.global_uplink_limits.reload_rate")
+	case "desired_relay.serving.limits.join_request_limits":
+		return v.DesiredRelay.FieldIsZero("serving.limits.join_request_limits")
+	case "desired_relay.serving.limits.join_request_limits.bucket_size":
+		return v.DesiredRelay.FieldIsZero("serving.limits.join_request_limits.bucket_size")
+	case "desired_relay.serving.limits.join_request_limits.reload_rate":
+		return v.DesiredRelay.FieldIsZero("serving.limits.join_request_limits.reload_rate")
+	case "desired_relay.serving.limits.notify_limits":
+		return v.DesiredRelay.FieldIsZero("serving.limits.notify_limits")
+	case "desired_relay.serving.limits.notify_limits.bucket_size":
+		return v.DesiredRelay.FieldIsZero("serving.limits.notify_limits.bucket_size")
+	case "desired_relay.serving.limits.notify_limits.reload_rate":
+		return v.DesiredRelay.FieldIsZero("serving.limits.notify_limits.reload_rate")
+	case "desired_relay.serving.limits.overall_limits":
+		return v.DesiredRelay.FieldIsZero("serving.limits.overall_limits")
+	case "desired_relay.serving.limits.overall_limits.bucket_size":
+		return v.DesiredRelay.FieldIsZero("serving.limits.overall_limits.bucket_size")
+	case "desired_relay.serving.limits.overall_limits.reload_rate":
+		return v.DesiredRelay.FieldIsZero("serving.limits.overall_limits.reload_rate")
+	case "desired_relay.serving.limits.reset_limit_counter":
+		return v.DesiredRelay.FieldIsZero("serving.limits.reset_limit_counter")
+	case "desired_relay.serving.second_channel":
+		return v.DesiredRelay.FieldIsZero("serving.second_channel")
+	case "desired_relay.serving.second_channel.ack_offset":
+		return v.DesiredRelay.FieldIsZero("serving.second_channel.ack_offset")
+	case "desired_relay.serving.second_channel.data_rate_index":
+		return v.DesiredRelay.FieldIsZero("serving.second_channel.data_rate_index")
+	case "desired_relay.serving.second_channel.frequency":
+		return v.DesiredRelay.FieldIsZero("serving.second_channel.frequency")
+	case "desired_relay.serving.uplink_forwarding_rules":
+		return v.DesiredRelay.FieldIsZero("serving.uplink_forwarding_rules")
 	case "desired_rx1_data_rate_offset":
 		return v.DesiredRx1DataRateOffset == nil
 	case "desired_rx1_delay":
@@ -287,6 +577,72 @@ func (v *MACParameters) FieldIsZero(p string) bool {
 		return v.RejoinCountPeriodicity == 0
 	case "rejoin_time_periodicity":
 		return v.RejoinTimePeriodicity == 0
+	case "relay":
+		return v.Relay == nil
+	case "relay.served":
+		return v.Relay.FieldIsZero("served")
+	case "relay.served.backoff":
+		return v.Relay.FieldIsZero("served.backoff")
+	case "relay.served.mode":
+		return v.Relay.FieldIsZero("served.mode")
+	case "relay.served.second_channel":
+		return v.Relay.FieldIsZero("served.second_channel")
+	case "relay.served.second_channel.ack_offset":
+		return v.Relay.FieldIsZero("served.second_channel.ack_offset")
+	case "relay.served.second_channel.data_rate_index":
+		return v.Relay.FieldIsZero("served.second_channel.data_rate_index")
+	case "relay.served.second_channel.frequency":
+		return v.Relay.FieldIsZero("served.second_channel.frequency")
+	case "relay.served.serving_device_id":
+		return v.Relay.FieldIsZero("served.serving_device_id")
+	case "relay.served.smart_enable_level":
+		return v.Relay.FieldIsZero("served.smart_enable_level")
+	case "relay.serving":
+		return v.Relay.FieldIsZero("serving")
+	case "relay.serving.cad_periodicity":
+		return v.Relay.FieldIsZero("serving.cad_periodicity")
+	case "relay.serving.default_channel_index":
+		return v.Relay.FieldIsZero("serving.default_channel_index")
+	case "relay.serving.join_request_filters":
+		return v.Relay.FieldIsZero("serving.join_request_filters")
+	case "relay.serving.limits":
+		return v.Relay.FieldIsZero("serving.limits")
+	case "relay.serving.limits.global_uplink_limits":
+		return v.Relay.FieldIsZero("serving.limits.global_uplink_limits")
+	case "relay.serving.limits.global_uplink_limits.bucket_size":
+		return v.Relay.FieldIsZero("serving.limits.global_uplink_limits.bucket_size")
+	case "relay.serving.limits.global_uplink_limits.reload_rate":
+		return v.Relay.FieldIsZero("serving.limits.global_uplink_limits.reload_rate")
+	case "relay.serving.limits.join_request_limits":
+		return v.Relay.FieldIsZero("serving.limits.join_request_limits")
+	case "relay.serving.limits.join_request_limits.bucket_size":
+		return v.Relay.FieldIsZero("serving.limits.join_request_limits.bucket_size")
+	case "relay.serving.limits.join_request_limits.reload_rate":
+		return v.Relay.FieldIsZero("serving.limits.join_request_limits.reload_rate")
+	case "relay.serving.limits.notify_limits":
+		return v.Relay.FieldIsZero("serving.limits.notify_limits")
+	case "relay.serving.limits.notify_limits.bucket_size":
+		return v.Relay.FieldIsZero("serving.limits.notify_limits.bucket_size")
+	case "relay.serving.limits.notify_limits.reload_rate":
+		return v.Relay.FieldIsZero("serving.limits.notify_limits.reload_rate")
+	case "relay.serving.limits.overall_limits":
+		return v.Relay.FieldIsZero("serving.limits.overall_limits")
+	case "relay.serving.limits.overall_limits.bucket_size":
+		return v.Relay.FieldIsZero("serving.limits.overall_limits.bucket_size")
+	case "relay.serving.limits.overall_limits.reload_rate":
+		return v.Relay.FieldIsZero("serving.limits.overall_limits.reload_rate")
+	case "relay.serving.limits.reset_limit_counter":
+		return v.Relay.FieldIsZero("serving.limits.reset_limit_counter")
+	case "relay.serving.second_channel":
+		return v.Relay.FieldIsZero("serving.second_channel")
+	case "relay.serving.second_channel.ack_offset":
+		return v.Relay.FieldIsZero("serving.second_channel.ack_offset")
+	case "relay.serving.second_channel.data_rate_index":
+		return v.Relay.FieldIsZero("serving.second_channel.data_rate_index")
+	case "relay.serving.second_channel.frequency":
+		return v.Relay.FieldIsZero("serving.second_channel.frequency")
+	case "relay.serving.uplink_forwarding_rules":
+		return v.Relay.FieldIsZero("serving.uplink_forwarding_rules")
 	case "rx1_data_rate_offset":
 		return v.Rx1DataRateOffset == 0
 	case "rx1_delay":
@@ -451,6 +807,72 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.CurrentParameters.FieldIsZero("rejoin_count_periodicity")
 	case "current_parameters.rejoin_time_periodicity":
 		return v.CurrentParameters.FieldIsZero("rejoin_time_periodicity")
+	case "current_parameters.relay":
+		return v.CurrentParameters.FieldIsZero("relay")
+	case "current_parameters.relay.served":
+		return v.CurrentParameters.FieldIsZero("relay.served")
+	case "current_parameters.relay.served.backoff":
+		return v.CurrentParameters.FieldIsZero("relay.served.backoff")
+	case "current_parameters.relay.served.mode":
+		return v.CurrentParameters.FieldIsZero("relay.served.mode")
+	case "current_parameters.relay.served.second_channel":
+		return v.CurrentParameters.FieldIsZero("relay.served.second_channel")
+	case "current_parameters.relay.served.second_channel.ack_offset":
+		return v.CurrentParameters.FieldIsZero("relay.served.second_channel.ack_offset")
+	case "current_parameters.relay.served.second_channel.data_rate_index":
+		return v.CurrentParameters.FieldIsZero("relay.served.second_channel.data_rate_index")
+	case "current_parameters.relay.served.second_channel.frequency":
+		return v.CurrentParameters.FieldIsZero("relay.served.second_channel.frequency")
+	case "current_parameters.relay.served.serving_device_id":
+		return v.CurrentParameters.FieldIsZero("relay.served.serving_device_id")
+	case "current_parameters.relay.served.smart_enable_level":
+		return v.CurrentParameters.FieldIsZero("relay.served.smart_enable_level")
+	case "current_parameters.relay.serving":
+		return v.CurrentParameters.FieldIsZero("relay.serving")
+	case "current_parameters.relay.serving.cad_periodicity":
+		return v.CurrentParameters.FieldIsZero("relay.serving.cad_periodicity")
+	case "current_parameters.relay.serving.default_channel_index":
+		return v.CurrentParameters.FieldIsZero("relay.serving.default_channel_index")
+	case "current_parameters.relay.serving.join_request_filters":
+		return v.CurrentParameters.FieldIsZero("relay.serving.join_request_filters")
+	case "current_parameters.relay.serving.limits":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits")
+	case "current_parameters.relay.serving.limits.global_uplink_limits":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.global_uplink_limits")
+	case "current_parameters.relay.serving.limits.global_uplink_limits.bucket_size":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.global_uplink_limits.bucket_size")
+	case "current_parameters.relay.serving.limits.global_uplink_limits.reload_rate":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.global_uplink_limits.reload_rate")
+	case "current_parameters.relay.serving.limits.join_request_limits":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.join_request_limits")
+	case "current_parameters.relay.serving.limits.join_request_limits.bucket_size":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.join_request_limits.bucket_size")
+	case "current_parameters.relay.serving.limits.join_request_limits.reload_rate":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.join_request_limits.reload_rate")
+	case "current_parameters.relay.serving.limits.notify_limits":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.notify_limits")
+	case "current_parameters.relay.serving.limits.notify_limits.bucket_size":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.notify_limits.bucket_size")
+	case "current_parameters.relay.serving.limits.notify_limits.reload_rate":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.notify_limits.reload_rate")
+	case "current_parameters.relay.serving.limits.overall_limits":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.overall_limits")
+	case "current_parameters.relay.serving.limits.overall_limits.bucket_size":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.overall_limits.bucket_size")
+	case "current_parameters.relay.serving.limits.overall_limits.reload_rate":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.overall_limits.reload_rate")
+	case "current_parameters.relay.serving.limits.reset_limit_counter":
+		return v.CurrentParameters.FieldIsZero("relay.serving.limits.reset_limit_counter")
+	case "current_parameters.relay.serving.second_channel":
+		return v.CurrentParameters.FieldIsZero("relay.serving.second_channel")
+	case "current_parameters.relay.serving.second_channel.ack_offset":
+		return v.CurrentParameters.FieldIsZero("relay.serving.second_channel.ack_offset")
+	case "current_parameters.relay.serving.second_channel.data_rate_index":
+		return v.CurrentParameters.FieldIsZero("relay.serving.second_channel.data_rate_index")
+	case "current_parameters.relay.serving.second_channel.frequency":
+		return v.CurrentParameters.FieldIsZero("relay.serving.second_channel.frequency")
+	case "current_parameters.relay.serving.uplink_forwarding_rules":
+		return v.CurrentParameters.FieldIsZero("relay.serving.uplink_forwarding_rules")
 	case "current_parameters.rx1_data_rate_offset":
 		return v.CurrentParameters.FieldIsZero("rx1_data_rate_offset")
 	case "current_parameters.rx1_delay":
@@ -503,6 +925,72 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.DesiredParameters.FieldIsZero("rejoin_count_periodicity")
 	case "desired_parameters.rejoin_time_periodicity":
 		return v.DesiredParameters.FieldIsZero("rejoin_time_periodicity")
+	case "desired_parameters.relay":
+		return v.DesiredParameters.FieldIsZero("relay")
+	case "desired_parameters.relay.served":
+		return v.DesiredParameters.FieldIsZero("relay.served")
+	case "desired_parameters.relay.served.backoff":
+		return v.DesiredParameters.FieldIsZero("relay.served.backoff")
+	case "desired_parameters.relay.served.mode":
+		return v.DesiredParameters.FieldIsZero("relay.served.mode")
+	case "desired_parameters.relay.served.second_channel":
+		return v.DesiredParameters.FieldIsZero("relay.served.second_channel")
+	case "desired_parameters.relay.served.second_channel.ack_offset":
+		return v.DesiredParameters.FieldIsZero("relay.served.second_channel.ack_offset")
+	case "desired_parameters.relay.served.second_channel.data_rate_index":
+		return v.DesiredParameters.FieldIsZero("relay.served.second_channel.data_rate_index")
+	case "desired_parameters.relay.served.second_channel.frequency":
+		return v.DesiredParameters.FieldIsZero("relay.served.second_channel.frequency")
+	case "desired_parameters.relay.served.serving_device_id":
+		return v.DesiredParameters.FieldIsZero("relay.served.serving_device_id")
+	case "desired_parameters.relay.served.smart_enable_level":
+		return v.DesiredParameters.FieldIsZero("relay.served.smart_enable_level")
+	case "desired_parameters.relay.serving":
+		return v.DesiredParameters.FieldIsZero("relay.serving")
+	case "desired_parameters.relay.serving.cad_periodicity":
+		return v.DesiredParameters.FieldIsZero("relay.serving.cad_periodicity")
+	case "desired_parameters.relay.serving.default_channel_index":
+		return v.DesiredParameters.FieldIsZero("relay.serving.default_channel_index")
+	case "desired_parameters.relay.serving.join_request_filters":
+		return v.DesiredParameters.FieldIsZero("relay.serving.join_request_filters")
+	case "desired_parameters.relay.serving.limits":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits")
+	case "desired_parameters.relay.serving.limits.global_uplink_limits":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.global_uplink_limits")
+	case "desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.global_uplink_limits.bucket_size")
+	case "desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.global_uplink_limits.reload_rate")
+	case "desired_parameters.relay.serving.limits.join_request_limits":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.join_request_limits")
+	case "desired_parameters.relay.serving.limits.join_request_limits.bucket_size":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.join_request_limits.bucket_size")
+	case "desired_parameters.relay.serving.limits.join_request_limits.reload_rate":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.join_request_limits.reload_rate")
+	case "desired_parameters.relay.serving.limits.notify_limits":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.notify_limits")
+	case "desired_parameters.relay.serving.limits.notify_limits.bucket_size":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.notify_limits.bucket_size")
+	case "desired_parameters.relay.serving.limits.notify_limits.reload_rate":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.notify_limits.reload_rate")
+	case "desired_parameters.relay.serving.limits.overall_limits":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.overall_limits")
+	case "desired_parameters.relay.serving.limits.overall_limits.bucket_size":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.overall_limits.bucket_size")
+	case "desired_parameters.relay.serving.limits.overall_limits.reload_rate":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.overall_limits.reload_rate")
+	case "desired_parameters.relay.serving.limits.reset_limit_counter":
+		return v.DesiredParameters.FieldIsZero("relay.serving.limits.reset_limit_counter")
+	case "desired_parameters.relay.serving.second_channel":
+		return v.DesiredParameters.FieldIsZero("relay.serving.second_channel")
+	case "desired_parameters.relay.serving.second_channel.ack_offset":
+		return v.DesiredParameters.FieldIsZero("relay.serving.second_channel.ack_offset")
+	case "desired_parameters.relay.serving.second_channel.data_rate_index":
+		return v.DesiredParameters.FieldIsZero("relay.serving.second_channel.data_rate_index")
+	case "desired_parameters.relay.serving.second_channel.frequency":
+		return v.DesiredParameters.FieldIsZero("relay.serving.second_channel.frequency")
+	case "desired_parameters.relay.serving.uplink_forwarding_rules":
+		return v.DesiredParameters.FieldIsZero("relay.serving.uplink_forwarding_rules")
 	case "desired_parameters.rx1_data_rate_offset":
 		return v.DesiredParameters.FieldIsZero("rx1_data_rate_offset")
 	case "desired_parameters.rx1_delay":
@@ -525,6 +1013,10 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.LastDownlinkAt == nil
 	case "last_network_initiated_downlink_at":
 		return v.LastNetworkInitiatedDownlinkAt == nil
+	case "link_quality_downlinks":
+		return v.LinkQualityDownlinks == nil
+	case "link_quality_uplinks":
+		return v.LinkQualityUplinks == nil
 	case "lorawan_version":
 		return v.LoRaWANVersion == 0
 	case "pending_application_downlink":
@@ -923,6 +1415,10 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.LoRaWANVersion == 0
 	case "mac_settings":
 		return v.MACSettings == nil
+	case "mac_settings.adr_algorithm":
+		return v.MACSettings.FieldIsZero("adr_algorithm")
+	case "mac_settings.adr_algorithm_parameters":
+		return v.MACSettings.FieldIsZero("adr_algorithm_parameters")
 	case "mac_settings.adr_margin":
 		return v.MACSettings.FieldIsZero("adr_margin")
 	case "mac_settings.beacon_frequency":
@@ -951,6 +1447,72 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.MACSettings.FieldIsZero("desired_ping_slot_data_rate_index.value")
 	case "mac_settings.desired_ping_slot_frequency":
 		return v.MACSettings.FieldIsZero("desired_ping_slot_frequency")
+	case "mac_settings.desired_relay":
+		return v.MACSettings.FieldIsZero("desired_relay")
+	case "mac_settings.desired_relay.served":
+		return v.MACSettings.FieldIsZero("desired_relay.served")
+	case "mac_settings.desired_relay.served.backoff":
+		return v.MACSettings.FieldIsZero("desired_relay.served.backoff")
+	case "mac_settings.desired_relay.served.mode":
+		return v.MACSettings.FieldIsZero("desired_relay.served.mode")
+	case "mac_settings.desired_relay.served.second_channel":
+		return v.MACSettings.FieldIsZero("desired_relay.served.second_channel")
+	case "mac_settings.desired_relay.served.second_channel.ack_offset":
+		return v.MACSettings.FieldIsZero("desired_relay.served.second_channel.ack_offset")
+	case "mac_settings.desired_relay.served.second_channel.data_rate_index":
+		return v.MACSettings.FieldIsZero("desired_relay.served.second_channel.data_rate_index")
+	case "mac_settings.desired_relay.served.second_channel.frequency":
+		return v.MACSettings.FieldIsZero("desired_relay.served.second_channel.frequency")
+	case "mac_settings.desired_relay.served.serving_device_id":
+		return v.MACSettings.FieldIsZero("desired_relay.served.serving_device_id")
+	case "mac_settings.desired_relay.served.smart_enable_level":
+		return v.MACSettings.FieldIsZero("desired_relay.served.smart_enable_level")
+	case "mac_settings.desired_relay.serving":
+		return v.MACSettings.FieldIsZero("desired_relay.serving")
+	case "mac_settings.desired_relay.serving.cad_periodicity":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.cad_periodicity")
+	case "mac_settings.desired_relay.serving.default_channel_index":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.default_channel_index")
+	case "mac_settings.desired_relay.serving.join_request_filters":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.join_request_filters")
+	case "mac_settings.desired_relay.serving.limits":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits")
+	case "mac_settings.desired_relay.serving.limits.global_uplink_limits":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.global_uplink_limits")
+	case "mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.global_uplink_limits.bucket_size")
+	case "mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.global_uplink_limits.reload_rate")
+	case "mac_settings.desired_relay.serving.limits.join_request_limits":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.join_request_limits")
+	case "mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.join_request_limits.bucket_size")
+	case "mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.join_request_limits.reload_rate")
+	case "mac_settings.desired_relay.serving.limits.notify_limits":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.notify_limits")
+	case "mac_settings.desired_relay.serving.limits.notify_limits.bucket_size":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.notify_limits.bucket_size")
+	case "mac_settings.desired_relay.serving.limits.notify_limits.reload_rate":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.notify_limits.reload_rate")
+	case "mac_settings.desired_relay.serving.limits.overall_limits":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.overall_limits")
+	case "mac_settings.desired_relay.serving.limits.overall_limits.bucket_size":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.overall_limits.bucket_size")
+	case "mac_settings.desired_relay.serving.limits.overall_limits.reload_rate":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.overall_limits.reload_rate")
+	case "mac_settings.desired_relay.serving.limits.reset_limit_counter":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.limits.reset_limit_counter")
+	case "mac_settings.desired_relay.serving.second_channel":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.second_channel")
+	case "mac_settings.desired_relay.serving.second_channel.ack_offset":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.second_channel.ack_offset")
+	case "mac_settings.desired_relay.serving.second_channel.data_rate_index":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.second_channel.data_rate_index")
+	case "mac_settings.desired_relay.serving.second_channel.frequency":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.second_channel.frequency")
+	case "mac_settings.desired_relay.serving.uplink_forwarding_rules":
+		return v.MACSettings.FieldIsZero("desired_relay.serving.uplink_forwarding_rules")
 	case "mac_settings.desired_rx1_data_rate_offset":
 		return v.MACSettings.FieldIsZero("desired_rx1_data_rate_offset")
 	case "mac_settings.desired_rx1_delay":
@@ -1017,6 +1579,10 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.NetworkServerAddress == ""
 	case "network_server_kek_label":
 		return v.NetworkServerKEKLabel == ""
+	case "next_rj_count_0":
+		return v.NextRJCount0 == 0
+	case "next_rj_count_1":
+		return v.NextRJCount1 == 0
 	case "pending_mac_state":
 		return v.PendingMACState == nil
 	case "pending_session":
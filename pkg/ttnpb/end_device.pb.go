@@ -210,8 +210,10 @@ type MACParameters struct {
 	ADRAckDelayExponent *ADRAckDelayExponentValue `protobuf:"bytes,23,opt,name=adr_ack_delay_exponent,json=adrAckDelayExponent,proto3" json:"adr_ack_delay_exponent,omitempty"`
 	// Data rate index of the class B ping slot.
 	PingSlotDataRateIndexValue *DataRateIndexValue `protobuf:"bytes,24,opt,name=ping_slot_data_rate_index_value,json=pingSlotDataRateIndexValue,proto3" json:"ping_slot_data_rate_index_value,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}            `json:"-"`
-	XXX_sizecache              int32               `json:"-"`
+	// Relay parameters.
+	Relay                *RelayParameters `protobuf:"bytes,25,opt,name=relay,proto3" json:"relay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MACParameters) Reset()      { *m = MACParameters{} }
@@ -403,6 +405,13 @@ func (m *MACParameters) GetPingSlotDataRateIndexValue() *DataRateIndexValue {
 	return nil
 }
 
+func (m *MACParameters) GetRelay() *RelayParameters {
+	if m != nil {
+		return m.Relay
+	}
+	return nil
+}
+
 type MACParameters_Channel struct {
 	// Uplink frequency of the channel (Hz).
 	UplinkFrequency uint64 `protobuf:"varint,1,opt,name=uplink_frequency,json=uplinkFrequency,proto3" json:"uplink_frequency,omitempty"`
@@ -485,6 +494,347 @@ func (m *MACParameters_Channel) GetEnableUplink() bool {
 	return false
 }
 
+type RelayUplinkForwardingRule struct {
+	// Uplink forwarding limits of the served end device.
+	Limits *RelayForwardLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Last wake on radio frame counter used by the served end device.
+	LastWFCnt uint32 `protobuf:"varint,2,opt,name=last_w_f_cnt,json=lastWFCnt,proto3" json:"last_w_f_cnt,omitempty"`
+	// End device identifier of the served end device.
+	DeviceID             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelayUplinkForwardingRule) Reset()      { *m = RelayUplinkForwardingRule{} }
+func (*RelayUplinkForwardingRule) ProtoMessage() {}
+func (*RelayUplinkForwardingRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{2}
+}
+func (m *RelayUplinkForwardingRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayUplinkForwardingRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayUplinkForwardingRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayUplinkForwardingRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayUplinkForwardingRule.Merge(m, src)
+}
+func (m *RelayUplinkForwardingRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayUplinkForwardingRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayUplinkForwardingRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayUplinkForwardingRule proto.InternalMessageInfo
+
+func (m *RelayUplinkForwardingRule) GetLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *RelayUplinkForwardingRule) GetLastWFCnt() uint32 {
+	if m != nil {
+		return m.LastWFCnt
+	}
+	return 0
+}
+
+func (m *RelayUplinkForwardingRule) GetDeviceID() string {
+	if m != nil {
+		return m.DeviceID
+	}
+	return ""
+}
+
+type RelayJoinRequestFilter struct {
+	Action               RelayFilterListAction                                 `protobuf:"varint,1,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayFilterListAction" json:"action,omitempty"`
+	JoinEUI              go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui"`
+	DevEUI               go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,3,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"dev_eui"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *RelayJoinRequestFilter) Reset()      { *m = RelayJoinRequestFilter{} }
+func (*RelayJoinRequestFilter) ProtoMessage() {}
+func (*RelayJoinRequestFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{3}
+}
+func (m *RelayJoinRequestFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayJoinRequestFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayJoinRequestFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayJoinRequestFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayJoinRequestFilter.Merge(m, src)
+}
+func (m *RelayJoinRequestFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayJoinRequestFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayJoinRequestFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayJoinRequestFilter proto.InternalMessageInfo
+
+func (m *RelayJoinRequestFilter) GetAction() RelayFilterListAction {
+	if m != nil {
+		return m.Action
+	}
+	return RELAY_FILTER_LIST_ACTION_NO_RULE
+}
+
+// ServingRelayParameters are the parameters of an end device acting as a relay.
+type ServingRelayParameters struct {
+	// Second wake on radio channel. If not set, the second channel is disabled.
+	SecondChannel *RelaySecondChannel `protobuf:"bytes,1,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	// Index of the default wake on radio channel.
+	DefaultChannelIndex uint32 `protobuf:"varint,2,opt,name=default_channel_index,json=defaultChannelIndex,proto3" json:"default_channel_index,omitempty"`
+	// Channel activity detection periodicity.
+	CADPeriodicity RelayCADPeriodicity `protobuf:"varint,3,opt,name=cad_periodicity,json=cadPeriodicity,proto3,enum=ttn.lorawan.v3.RelayCADPeriodicity" json:"cad_periodicity,omitempty"`
+	// Uplink forwarding rules, indexed by rule index.
+	UplinkForwardingRules []*RelayUplinkForwardingRule `protobuf:"bytes,4,rep,name=uplink_forwarding_rules,json=uplinkForwardingRules,proto3" json:"uplink_forwarding_rules,omitempty"`
+	// Forwarding limits of the relay.
+	Limits *MACCommand_RelayConfigureFwdLimitReq `protobuf:"bytes,5,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Join-request filters, indexed by filter index.
+	JoinRequestFilters   []*RelayJoinRequestFilter `protobuf:"bytes,6,rep,name=join_request_filters,json=joinRequestFilters,proto3" json:"join_request_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ServingRelayParameters) Reset()      { *m = ServingRelayParameters{} }
+func (*ServingRelayParameters) ProtoMessage() {}
+func (*ServingRelayParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{4}
+}
+func (m *ServingRelayParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServingRelayParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServingRelayParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServingRelayParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServingRelayParameters.Merge(m, src)
+}
+func (m *ServingRelayParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServingRelayParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServingRelayParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServingRelayParameters proto.InternalMessageInfo
+
+func (m *ServingRelayParameters) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+func (m *ServingRelayParameters) GetDefaultChannelIndex() uint32 {
+	if m != nil {
+		return m.DefaultChannelIndex
+	}
+	return 0
+}
+
+func (m *ServingRelayParameters) GetCADPeriodicity() RelayCADPeriodicity {
+	if m != nil {
+		return m.CADPeriodicity
+	}
+	return RELAY_CAD_PERIODICITY_1_SECOND
+}
+
+func (m *ServingRelayParameters) GetUplinkForwardingRules() []*RelayUplinkForwardingRule {
+	if m != nil {
+		return m.UplinkForwardingRules
+	}
+	return nil
+}
+
+func (m *ServingRelayParameters) GetLimits() *MACCommand_RelayConfigureFwdLimitReq {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *ServingRelayParameters) GetJoinRequestFilters() []*RelayJoinRequestFilter {
+	if m != nil {
+		return m.JoinRequestFilters
+	}
+	return nil
+}
+
+// ServedRelayParameters are the parameters of an end device served by a relay.
+type ServedRelayParameters struct {
+	Mode             RelayEndDeviceMode    `protobuf:"varint,1,opt,name=mode,proto3,enum=ttn.lorawan.v3.RelayEndDeviceMode" json:"mode,omitempty"`
+	SmartEnableLevel RelaySmartEnableLevel `protobuf:"varint,2,opt,name=smart_enable_level,json=smartEnableLevel,proto3,enum=ttn.lorawan.v3.RelaySmartEnableLevel" json:"smart_enable_level,omitempty"`
+	// Number of wake on radio frames to transmit without acknowledgement before the end device stops using the relay.
+	Backoff uint32 `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Second wake on radio channel. If not set, the second channel is disabled.
+	SecondChannel *RelaySecondChannel `protobuf:"bytes,4,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	// End device identifier of the serving relay.
+	ServingDeviceID      string   `protobuf:"bytes,5,opt,name=serving_device_id,json=servingDeviceId,proto3" json:"serving_device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServedRelayParameters) Reset()      { *m = ServedRelayParameters{} }
+func (*ServedRelayParameters) ProtoMessage() {}
+func (*ServedRelayParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5}
+}
+func (m *ServedRelayParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServedRelayParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServedRelayParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServedRelayParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServedRelayParameters.Merge(m, src)
+}
+func (m *ServedRelayParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServedRelayParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServedRelayParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServedRelayParameters proto.InternalMessageInfo
+
+func (m *ServedRelayParameters) GetMode() RelayEndDeviceMode {
+	if m != nil {
+		return m.Mode
+	}
+	return RELAY_END_DEVICE_MODE_DISABLED
+}
+
+func (m *ServedRelayParameters) GetSmartEnableLevel() RelaySmartEnableLevel {
+	if m != nil {
+		return m.SmartEnableLevel
+	}
+	return RELAY_SMART_ENABLE_LEVEL_8
+}
+
+func (m *ServedRelayParameters) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *ServedRelayParameters) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+func (m *ServedRelayParameters) GetServingDeviceID() string {
+	if m != nil {
+		return m.ServingDeviceID
+	}
+	return ""
+}
+
+// RelayParameters represent the relay configuration of an end device.
+// At most one of serving and served may be set.
+type RelayParameters struct {
+	// Parameters of the end device acting as a relay.
+	Serving *ServingRelayParameters `protobuf:"bytes,1,opt,name=serving,proto3" json:"serving,omitempty"`
+	// Parameters of the end device served by a relay.
+	Served               *ServedRelayParameters `protobuf:"bytes,2,opt,name=served,proto3" json:"served,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RelayParameters) Reset()      { *m = RelayParameters{} }
+func (*RelayParameters) ProtoMessage() {}
+func (*RelayParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{6}
+}
+func (m *RelayParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayParameters.Merge(m, src)
+}
+func (m *RelayParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayParameters proto.InternalMessageInfo
+
+func (m *RelayParameters) GetServing() *ServingRelayParameters {
+	if m != nil {
+		return m.Serving
+	}
+	return nil
+}
+
+func (m *RelayParameters) GetServed() *ServedRelayParameters {
+	if m != nil {
+		return m.Served
+	}
+	return nil
+}
+
 // Identifies an end device model with version information.
 type EndDeviceVersionIdentifiers struct {
 	BrandID              string   `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
@@ -499,7 +849,7 @@ type EndDeviceVersionIdentifiers struct {
 func (m *EndDeviceVersionIdentifiers) Reset()      { *m = EndDeviceVersionIdentifiers{} }
 func (*EndDeviceVersionIdentifiers) ProtoMessage() {}
 func (*EndDeviceVersionIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{2}
+	return fileDescriptor_a656ee0551c94a80, []int{7}
 }
 func (m *EndDeviceVersionIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +948,7 @@ type EndDeviceVersion struct {
 func (m *EndDeviceVersion) Reset()      { *m = EndDeviceVersion{} }
 func (*EndDeviceVersion) ProtoMessage() {}
 func (*EndDeviceVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{3}
+	return fileDescriptor_a656ee0551c94a80, []int{8}
 }
 func (m *EndDeviceVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,14 +1146,23 @@ type MACSettings struct {
 	// The frequency of the class B beacon (Hz) Network Server should configure device to use via MAC commands.
 	// If unset, the default value from Network Server configuration will be used.
 	DesiredBeaconFrequency *types.UInt64Value `protobuf:"bytes,29,opt,name=desired_beacon_frequency,json=desiredBeaconFrequency,proto3" json:"desired_beacon_frequency,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
-	XXX_sizecache          int32              `json:"-"`
+	// The ADR algorithm Network Server should use for the device.
+	// If unset, the default value from Network Server configuration will be used.
+	ADRAlgorithm *types.StringValue `protobuf:"bytes,30,opt,name=adr_algorithm,json=adrAlgorithm,proto3" json:"adr_algorithm,omitempty"`
+	// The parameters of the ADR algorithm Network Server should use for the device.
+	// Parameters that are not set use the default value from Network Server configuration or the ADR algorithm.
+	ADRAlgorithmParameters map[string]float32 `protobuf:"bytes,31,rep,name=adr_algorithm_parameters,json=adrAlgorithmParameters,proto3" json:"adr_algorithm_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// The relay parameters Network Server should configure device to use via MAC commands.
+	// If unset, the relay is not configured.
+	DesiredRelay         *RelayParameters `protobuf:"bytes,32,opt,name=desired_relay,json=desiredRelay,proto3" json:"desired_relay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
 func (*MACSettings) ProtoMessage() {}
 func (*MACSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{4}
+	return fileDescriptor_a656ee0551c94a80, []int{9}
 }
 func (m *MACSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,6 +1387,27 @@ func (m *MACSettings) GetDesiredBeaconFrequency() *types.UInt64Value {
 	return nil
 }
 
+func (m *MACSettings) GetADRAlgorithm() *types.StringValue {
+	if m != nil {
+		return m.ADRAlgorithm
+	}
+	return nil
+}
+
+func (m *MACSettings) GetADRAlgorithmParameters() map[string]float32 {
+	if m != nil {
+		return m.ADRAlgorithmParameters
+	}
+	return nil
+}
+
+func (m *MACSettings) GetDesiredRelay() *RelayParameters {
+	if m != nil {
+		return m.DesiredRelay
+	}
+	return nil
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server.
@@ -1089,15 +1469,21 @@ type MACState struct {
 	// Data rate ranges rejected by the device per frequency.
 	RejectedDataRateRanges map[uint64]*MACState_DataRateRanges `protobuf:"bytes,21,rep,name=rejected_data_rate_ranges,json=rejectedDataRateRanges,proto3" json:"rejected_data_rate_ranges,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Frame counter of uplink, which confirmed the last ADR parameter change.
-	LastADRChangeFCntUp  uint32   `protobuf:"varint,22,opt,name=last_adr_change_f_cnt_up,json=lastAdrChangeFCntUp,proto3" json:"last_adr_change_f_cnt_up,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LastADRChangeFCntUp uint32 `protobuf:"varint,22,opt,name=last_adr_change_f_cnt_up,json=lastAdrChangeFCntUp,proto3" json:"last_adr_change_f_cnt_up,omitempty"`
+	// Recent data uplink messages sorted by time, used to compute the link quality stats of the device.
+	// Only stored if link quality stats are enabled in the Network Server.
+	LinkQualityUplinks []*MACState_UplinkObservation `protobuf:"bytes,23,rep,name=link_quality_uplinks,json=linkQualityUplinks,proto3" json:"link_quality_uplinks,omitempty"`
+	// Recent data downlink messages sorted by time, used to compute the link quality stats of the device.
+	// Only stored if link quality stats are enabled in the Network Server.
+	LinkQualityDownlinks []*MACState_DownlinkObservation `protobuf:"bytes,24,rep,name=link_quality_downlinks,json=linkQualityDownlinks,proto3" json:"link_quality_downlinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *MACState) Reset()      { *m = MACState{} }
 func (*MACState) ProtoMessage() {}
 func (*MACState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5}
+	return fileDescriptor_a656ee0551c94a80, []int{10}
 }
 func (m *MACState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1280,6 +1666,20 @@ func (m *MACState) GetLastADRChangeFCntUp() uint32 {
 	return 0
 }
 
+func (m *MACState) GetLinkQualityUplinks() []*MACState_UplinkObservation {
+	if m != nil {
+		return m.LinkQualityUplinks
+	}
+	return nil
+}
+
+func (m *MACState) GetLinkQualityDownlinks() []*MACState_DownlinkObservation {
+	if m != nil {
+		return m.LinkQualityDownlinks
+	}
+	return nil
+}
+
 type MACState_JoinRequest struct {
 	DownlinkSettings     DLSettings `protobuf:"bytes,6,opt,name=downlink_settings,json=downlinkSettings,proto3" json:"downlink_settings"`
 	RxDelay              RxDelay    `protobuf:"varint,7,opt,name=rx_delay,json=rxDelay,proto3,enum=ttn.lorawan.v3.RxDelay" json:"rx_delay,omitempty"`
@@ -1291,7 +1691,7 @@ type MACState_JoinRequest struct {
 func (m *MACState_JoinRequest) Reset()      { *m = MACState_JoinRequest{} }
 func (*MACState_JoinRequest) ProtoMessage() {}
 func (*MACState_JoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 0}
+	return fileDescriptor_a656ee0551c94a80, []int{10, 0}
 }
 func (m *MACState_JoinRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1757,7 @@ type MACState_JoinAccept struct {
 func (m *MACState_JoinAccept) Reset()      { *m = MACState_JoinAccept{} }
 func (*MACState_JoinAccept) ProtoMessage() {}
 func (*MACState_JoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 1}
+	return fileDescriptor_a656ee0551c94a80, []int{10, 1}
 }
 func (m *MACState_JoinAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1424,7 +1824,7 @@ type MACState_DataRateRange struct {
 func (m *MACState_DataRateRange) Reset()      { *m = MACState_DataRateRange{} }
 func (*MACState_DataRateRange) ProtoMessage() {}
 func (*MACState_DataRateRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 2}
+	return fileDescriptor_a656ee0551c94a80, []int{10, 2}
 }
 func (m *MACState_DataRateRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,7 +1876,7 @@ type MACState_DataRateRanges struct {
 func (m *MACState_DataRateRanges) Reset()      { *m = MACState_DataRateRanges{} }
 func (*MACState_DataRateRanges) ProtoMessage() {}
 func (*MACState_DataRateRanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 3}
+	return fileDescriptor_a656ee0551c94a80, []int{10, 3}
 }
 func (m *MACState_DataRateRanges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,26 +1912,35 @@ func (m *MACState_DataRateRanges) GetRanges() []*MACState_DataRateRange {
 	return nil
 }
 
-// Authentication code for end devices.
-type EndDeviceAuthenticationCode struct {
-	Value                string     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	ValidFrom            *time.Time `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
-	ValidTo              *time.Time `protobuf:"bytes,3,opt,name=valid_to,json=validTo,proto3,stdtime" json:"valid_to,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type MACState_UplinkObservation struct {
+	// Full frame counter of the uplink message.
+	FCnt uint32 `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Time when the uplink message was received.
+	ReceivedAt    time.Time     `protobuf:"bytes,2,opt,name=received_at,json=receivedAt,proto3,stdtime" json:"received_at"`
+	DataRateIndex DataRateIndex `protobuf:"varint,3,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// Highest SNR (dB) of the gateways that received the uplink message.
+	SNR float32 `protobuf:"fixed32,4,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Highest RSSI (dBm) of the gateways that received the uplink message.
+	RSSI float32 `protobuf:"fixed32,5,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// Number of gateways that received the uplink message.
+	GatewayCount uint32 `protobuf:"varint,6,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
+	// Time-on-air of the uplink message.
+	Airtime              time.Duration `protobuf:"bytes,7,opt,name=airtime,proto3,stdduration" json:"airtime"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
-func (*EndDeviceAuthenticationCode) ProtoMessage() {}
-func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{6}
+func (m *MACState_UplinkObservation) Reset()      { *m = MACState_UplinkObservation{} }
+func (*MACState_UplinkObservation) ProtoMessage() {}
+func (*MACState_UplinkObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{10, 5}
 }
-func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
+func (m *MACState_UplinkObservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndDeviceAuthenticationCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACState_UplinkObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndDeviceAuthenticationCode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACState_UplinkObservation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1541,10 +1950,172 @@ func (m *EndDeviceAuthenticationCode) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EndDeviceAuthenticationCode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceAuthenticationCode.Merge(m, src)
+func (m *MACState_UplinkObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_UplinkObservation.Merge(m, src)
 }
-func (m *EndDeviceAuthenticationCode) XXX_Size() int {
+func (m *MACState_UplinkObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACState_UplinkObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_UplinkObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACState_UplinkObservation proto.InternalMessageInfo
+
+func (m *MACState_UplinkObservation) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *MACState_UplinkObservation) GetReceivedAt() time.Time {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return time.Time{}
+}
+
+func (m *MACState_UplinkObservation) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
+	}
+	return DATA_RATE_0
+}
+
+func (m *MACState_UplinkObservation) GetSNR() float32 {
+	if m != nil {
+		return m.SNR
+	}
+	return 0
+}
+
+func (m *MACState_UplinkObservation) GetRSSI() float32 {
+	if m != nil {
+		return m.RSSI
+	}
+	return 0
+}
+
+func (m *MACState_UplinkObservation) GetGatewayCount() uint32 {
+	if m != nil {
+		return m.GatewayCount
+	}
+	return 0
+}
+
+func (m *MACState_UplinkObservation) GetAirtime() time.Duration {
+	if m != nil {
+		return m.Airtime
+	}
+	return 0
+}
+
+type MACState_DownlinkObservation struct {
+	// Time when the downlink message is transmitted.
+	TransmitAt time.Time `protobuf:"bytes,1,opt,name=transmit_at,json=transmitAt,proto3,stdtime" json:"transmit_at"`
+	Confirmed  bool      `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Whether the confirmed downlink message was acknowledged by the device.
+	Acknowledged bool `protobuf:"varint,3,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	// Estimated time-on-air of the downlink message.
+	Airtime              time.Duration `protobuf:"bytes,4,opt,name=airtime,proto3,stdduration" json:"airtime"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MACState_DownlinkObservation) Reset()      { *m = MACState_DownlinkObservation{} }
+func (*MACState_DownlinkObservation) ProtoMessage() {}
+func (*MACState_DownlinkObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{10, 6}
+}
+func (m *MACState_DownlinkObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACState_DownlinkObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACState_DownlinkObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MACState_DownlinkObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_DownlinkObservation.Merge(m, src)
+}
+func (m *MACState_DownlinkObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACState_DownlinkObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_DownlinkObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACState_DownlinkObservation proto.InternalMessageInfo
+
+func (m *MACState_DownlinkObservation) GetTransmitAt() time.Time {
+	if m != nil {
+		return m.TransmitAt
+	}
+	return time.Time{}
+}
+
+func (m *MACState_DownlinkObservation) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *MACState_DownlinkObservation) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *MACState_DownlinkObservation) GetAirtime() time.Duration {
+	if m != nil {
+		return m.Airtime
+	}
+	return 0
+}
+
+// Authentication code for end devices.
+type EndDeviceAuthenticationCode struct {
+	Value                string     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	ValidFrom            *time.Time `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
+	ValidTo              *time.Time `protobuf:"bytes,3,opt,name=valid_to,json=validTo,proto3,stdtime" json:"valid_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
+func (*EndDeviceAuthenticationCode) ProtoMessage() {}
+func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{11}
+}
+func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceAuthenticationCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceAuthenticationCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceAuthenticationCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceAuthenticationCode.Merge(m, src)
+}
+func (m *EndDeviceAuthenticationCode) XXX_Size() int {
 	return m.Size()
 }
 func (m *EndDeviceAuthenticationCode) XXX_DiscardUnknown() {
@@ -1677,8 +2248,8 @@ type EndDevice struct {
 	// Last JoinNonce/AppNonce(for devices using LoRaWAN versions preceding 1.1) used.
 	// Stored in Join Server.
 	LastJoinNonce uint32 `protobuf:"varint,30,opt,name=last_join_nonce,json=lastJoinNonce,proto3" json:"last_join_nonce,omitempty"`
-	// Last Rejoin counter value used (type 0/2).
-	// Stored in Join Server.
+	// Last Rejoin counter value used (type 0/2) in the current session.
+	// Stored in Network Server.
 	LastRJCount0 uint32 `protobuf:"varint,31,opt,name=last_rj_count_0,json=lastRjCount0,proto3" json:"last_rj_count_0,omitempty"`
 	// Last Rejoin counter value used (type 1).
 	// Stored in Join Server.
@@ -1719,14 +2290,20 @@ type EndDevice struct {
 	// Skip decryption of uplink payloads and encryption of downlink payloads.
 	// This field overrides the application-level setting.
 	SkipPayloadCryptoOverride *types.BoolValue `protobuf:"bytes,52,opt,name=skip_payload_crypto_override,json=skipPayloadCryptoOverride,proto3" json:"skip_payload_crypto_override,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}         `json:"-"`
-	XXX_sizecache             int32            `json:"-"`
+	// The next expected RJcount0 of rejoin-requests of type 0 or 2 in the current session.
+	// Stored in Network Server.
+	NextRJCount0 uint32 `protobuf:"varint,53,opt,name=next_rj_count_0,json=nextRjCount0,proto3" json:"next_rj_count_0,omitempty"`
+	// The next expected RJcount1 of rejoin-requests of type 1.
+	// Stored in Join Server.
+	NextRJCount1         uint32   `protobuf:"varint,54,opt,name=next_rj_count_1,json=nextRjCount1,proto3" json:"next_rj_count_1,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{7}
+	return fileDescriptor_a656ee0551c94a80, []int{12}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2084,6 +2661,20 @@ func (m *EndDevice) GetSkipPayloadCryptoOverride() *types.BoolValue {
 	return nil
 }
 
+func (m *EndDevice) GetNextRJCount0() uint32 {
+	if m != nil {
+		return m.NextRJCount0
+	}
+	return 0
+}
+
+func (m *EndDevice) GetNextRJCount1() uint32 {
+	if m != nil {
+		return m.NextRJCount1
+	}
+	return 0
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -2093,7 +2684,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{8}
+	return fileDescriptor_a656ee0551c94a80, []int{13}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2138,7 +2729,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{9}
+	return fileDescriptor_a656ee0551c94a80, []int{14}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2179,7 +2770,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{10}
+	return fileDescriptor_a656ee0551c94a80, []int{15}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2227,7 +2818,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{11}
+	return fileDescriptor_a656ee0551c94a80, []int{16}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2273,7 +2864,7 @@ type GetEndDeviceIdentifiersForEUIsRequest struct {
 func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
 func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
 func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{12}
+	return fileDescriptor_a656ee0551c94a80, []int{17}
 }
 func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2321,7 +2912,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{13}
+	return fileDescriptor_a656ee0551c94a80, []int{18}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2390,7 +2981,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{14}
+	return fileDescriptor_a656ee0551c94a80, []int{19}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2445,7 +3036,7 @@ type ResetAndGetEndDeviceRequest struct {
 func (m *ResetAndGetEndDeviceRequest) Reset()      { *m = ResetAndGetEndDeviceRequest{} }
 func (*ResetAndGetEndDeviceRequest) ProtoMessage() {}
 func (*ResetAndGetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{15}
+	return fileDescriptor_a656ee0551c94a80, []int{20}
 }
 func (m *ResetAndGetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +3083,7 @@ type EndDeviceTemplate struct {
 func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
 func (*EndDeviceTemplate) ProtoMessage() {}
 func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{16}
+	return fileDescriptor_a656ee0551c94a80, []int{21}
 }
 func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2553,7 +3144,7 @@ type EndDeviceTemplateFormat struct {
 func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
 func (*EndDeviceTemplateFormat) ProtoMessage() {}
 func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{17}
+	return fileDescriptor_a656ee0551c94a80, []int{22}
 }
 func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2612,7 +3203,7 @@ type EndDeviceTemplateFormats struct {
 func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
 func (*EndDeviceTemplateFormats) ProtoMessage() {}
 func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
+	return fileDescriptor_a656ee0551c94a80, []int{23}
 }
 func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +3251,7 @@ type ConvertEndDeviceTemplateRequest struct {
 func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
 func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
 func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+	return fileDescriptor_a656ee0551c94a80, []int{24}
 }
 func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2712,12 +3303,24 @@ func init() {
 	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
 	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
 	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	proto.RegisterType((*RelayUplinkForwardingRule)(nil), "ttn.lorawan.v3.RelayUplinkForwardingRule")
+	golang_proto.RegisterType((*RelayUplinkForwardingRule)(nil), "ttn.lorawan.v3.RelayUplinkForwardingRule")
+	proto.RegisterType((*RelayJoinRequestFilter)(nil), "ttn.lorawan.v3.RelayJoinRequestFilter")
+	golang_proto.RegisterType((*RelayJoinRequestFilter)(nil), "ttn.lorawan.v3.RelayJoinRequestFilter")
+	proto.RegisterType((*ServingRelayParameters)(nil), "ttn.lorawan.v3.ServingRelayParameters")
+	golang_proto.RegisterType((*ServingRelayParameters)(nil), "ttn.lorawan.v3.ServingRelayParameters")
+	proto.RegisterType((*ServedRelayParameters)(nil), "ttn.lorawan.v3.ServedRelayParameters")
+	golang_proto.RegisterType((*ServedRelayParameters)(nil), "ttn.lorawan.v3.ServedRelayParameters")
+	proto.RegisterType((*RelayParameters)(nil), "ttn.lorawan.v3.RelayParameters")
+	golang_proto.RegisterType((*RelayParameters)(nil), "ttn.lorawan.v3.RelayParameters")
 	proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
 	golang_proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
 	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
 	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
 	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
 	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	proto.RegisterMapType((map[string]float32)(nil), "ttn.lorawan.v3.MACSettings.AdrAlgorithmParametersEntry")
+	golang_proto.RegisterMapType((map[string]float32)(nil), "ttn.lorawan.v3.MACSettings.AdrAlgorithmParametersEntry")
 	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
@@ -2730,6 +3333,10 @@ func init() {
 	golang_proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
 	proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
 	golang_proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
+	proto.RegisterType((*MACState_UplinkObservation)(nil), "ttn.lorawan.v3.MACState.UplinkObservation")
+	golang_proto.RegisterType((*MACState_UplinkObservation)(nil), "ttn.lorawan.v3.MACState.UplinkObservation")
+	proto.RegisterType((*MACState_DownlinkObservation)(nil), "ttn.lorawan.v3.MACState.DownlinkObservation")
+	golang_proto.RegisterType((*MACState_DownlinkObservation)(nil), "ttn.lorawan.v3.MACState.DownlinkObservation")
 	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
 	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
 	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
@@ -2774,335 +3381,382 @@ func init() {
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 5245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x4b, 0x6c, 0x1c, 0xc9,
-	0x79, 0x9e, 0x26, 0x87, 0x9c, 0x99, 0x9f, 0x8f, 0x99, 0x29, 0x8a, 0x62, 0x6b, 0x24, 0xcd, 0x70,
-	0x67, 0xb9, 0x5a, 0x4a, 0x2b, 0x52, 0xab, 0xd1, 0x6a, 0x6d, 0x6b, 0xed, 0xc8, 0xd3, 0x1c, 0x72,
-	0x35, 0x94, 0xc4, 0xa5, 0x4b, 0xaf, 0xac, 0x5e, 0xed, 0xe6, 0x74, 0x91, 0xea, 0xe5, 0x4c, 0xf7,
-	0xb8, 0xbb, 0x87, 0x22, 0xbd, 0xbb, 0xc0, 0xc6, 0x48, 0xe0, 0x07, 0x92, 0xc0, 0xd8, 0x93, 0xe3,
-	0x43, 0xb0, 0x17, 0x03, 0x3e, 0x05, 0x3e, 0xe4, 0xb0, 0x08, 0x02, 0xd8, 0x97, 0x04, 0x8b, 0x04,
-	0x41, 0xf6, 0x90, 0x83, 0x91, 0x00, 0x8c, 0x35, 0xba, 0xf8, 0x14, 0xf8, 0x68, 0xf0, 0x10, 0x04,
-	0xf5, 0xe8, 0xd7, 0x3c, 0xf8, 0xd8, 0x5d, 0x1b, 0x9b, 0x8b, 0xd4, 0xac, 0xfa, 0xff, 0xef, 0xaf,
-	0xfa, 0xab, 0xea, 0xaf, 0xff, 0x51, 0x03, 0xc5, 0xba, 0x65, 0x6b, 0x4f, 0x35, 0x73, 0xce, 0x71,
-	0xb5, 0xda, 0xe6, 0x05, 0xad, 0x69, 0x5c, 0x20, 0xa6, 0xae, 0xea, 0x64, 0xcb, 0xa8, 0x91, 0xf9,
-	0xa6, 0x6d, 0xb9, 0x16, 0x1a, 0x77, 0x5d, 0x73, 0x5e, 0xd0, 0xcd, 0x6f, 0x5d, 0xca, 0x95, 0x37,
-	0x0c, 0xf7, 0x49, 0x6b, 0x6d, 0xbe, 0x66, 0x35, 0x2e, 0x10, 0x73, 0xcb, 0xda, 0x69, 0xda, 0xd6,
-	0xf6, 0xce, 0x05, 0x46, 0x5c, 0x9b, 0xdb, 0x20, 0xe6, 0xdc, 0x96, 0x56, 0x37, 0x74, 0xcd, 0x25,
-	0x17, 0xba, 0x3e, 0x38, 0x64, 0x6e, 0x2e, 0x04, 0xb1, 0x61, 0x6d, 0x58, 0x9c, 0x79, 0xad, 0xb5,
-	0xce, 0xfe, 0x62, 0x7f, 0xb0, 0x2f, 0x41, 0x9e, 0xdf, 0xb0, 0xac, 0x8d, 0x3a, 0x09, 0xa8, 0xf4,
-	0x96, 0xad, 0xb9, 0x86, 0x65, 0x8a, 0xfe, 0xe9, 0xce, 0xfe, 0x75, 0x83, 0xd4, 0x75, 0xb5, 0xa1,
-	0x39, 0x9b, 0x82, 0xe2, 0x54, 0x27, 0x85, 0xe3, 0xda, 0xad, 0x9a, 0x2b, 0x7a, 0x0b, 0x9d, 0xbd,
-	0xae, 0xd1, 0x20, 0x8e, 0xab, 0x35, 0x9a, 0xfd, 0x06, 0xf0, 0xd4, 0xd6, 0x9a, 0x4d, 0x62, 0x3b,
-	0xa2, 0xff, 0xc5, 0x6e, 0x35, 0x1a, 0x3a, 0x31, 0x5d, 0x63, 0xdd, 0x08, 0x88, 0x4e, 0x75, 0x13,
-	0x6d, 0x92, 0x1d, 0xaf, 0xb7, 0xd0, 0xdd, 0xeb, 0xe9, 0x5c, 0x4c, 0xb2, 0x9b, 0xa0, 0x41, 0x1c,
-	0x47, 0xdb, 0x20, 0xfb, 0x40, 0x34, 0x8d, 0x9a, 0xdb, 0xb2, 0xc9, 0x7e, 0x10, 0xae, 0xa6, 0x6b,
-	0xae, 0xc6, 0x29, 0x8a, 0x7f, 0x16, 0x87, 0xc4, 0x2d, 0xe2, 0x38, 0x86, 0x65, 0xa2, 0xfb, 0x90,
-	0xd4, 0xc9, 0x96, 0xaa, 0xe9, 0xba, 0x2d, 0x0f, 0x4c, 0x4b, 0xb3, 0xa3, 0xca, 0xd5, 0x4f, 0x76,
-	0x0b, 0xb1, 0xff, 0xdc, 0x2d, 0x7c, 0x65, 0xc3, 0x9a, 0x77, 0x9f, 0x10, 0xf7, 0x89, 0x61, 0x6e,
-	0x38, 0xf3, 0x26, 0x71, 0x9f, 0x5a, 0xf6, 0xe6, 0x85, 0x28, 0xf8, 0xd6, 0xa5, 0x0b, 0xcd, 0xcd,
-	0x8d, 0x0b, 0xee, 0x4e, 0x93, 0x38, 0xf3, 0x15, 0xb2, 0x55, 0xd6, 0x75, 0x1b, 0x27, 0x74, 0xfe,
-	0x81, 0xca, 0x10, 0xa7, 0x73, 0x97, 0x07, 0xa7, 0xa5, 0xd9, 0x91, 0xd2, 0xc9, 0xf9, 0xe8, 0x16,
-	0x9b, 0x17, 0x43, 0xb8, 0x4e, 0x76, 0x1c, 0x25, 0xb3, 0xa7, 0x0c, 0xfd, 0x48, 0x1a, 0xc8, 0x48,
-	0x54, 0xf8, 0xa7, 0xbb, 0x05, 0x09, 0x33, 0x56, 0xf4, 0x02, 0x8c, 0xd5, 0x35, 0xc7, 0x55, 0xd7,
-	0xd5, 0x9a, 0xe9, 0xaa, 0xad, 0xa6, 0x1c, 0x9f, 0x96, 0x66, 0xc7, 0x30, 0xd0, 0xc6, 0xa5, 0x05,
-	0xd3, 0xbd, 0xd3, 0x44, 0xb3, 0x90, 0x65, 0x24, 0xa6, 0x20, 0xd2, 0xad, 0xa7, 0xa6, 0x3c, 0xc4,
-	0xc8, 0x18, 0xef, 0x0a, 0xa5, 0xab, 0x58, 0x4f, 0x4d, 0x9f, 0x52, 0x0b, 0x53, 0x0e, 0x07, 0x94,
-	0x65, 0x9f, 0x72, 0x1e, 0x8e, 0x31, 0xca, 0x9a, 0x65, 0xae, 0x87, 0x89, 0x13, 0x8c, 0x38, 0x43,
-	0xfb, 0x16, 0x2c, 0x73, 0xdd, 0xa7, 0x5f, 0x00, 0x70, 0x5c, 0xcd, 0x76, 0x89, 0xae, 0x6a, 0xae,
-	0x9c, 0x64, 0xf3, 0xcd, 0xcd, 0xf3, 0xfd, 0x34, 0xef, 0xed, 0xa7, 0xf9, 0xdb, 0xde, 0x86, 0x53,
-	0x92, 0x74, 0x9a, 0x3f, 0xfe, 0xef, 0x82, 0x84, 0x53, 0x82, 0xaf, 0xec, 0x22, 0x02, 0xa7, 0xbe,
-	0xd3, 0x22, 0x2d, 0x8a, 0xd1, 0x6c, 0xd6, 0x8d, 0x1a, 0xdb, 0xfc, 0x4c, 0x6e, 0xdd, 0x30, 0x37,
-	0x1d, 0x39, 0x35, 0x3d, 0x38, 0x3b, 0x52, 0x7a, 0xb1, 0x53, 0x8d, 0xe5, 0x80, 0xb8, 0x22, 0x68,
-	0x71, 0x8e, 0x03, 0xf5, 0xe8, 0x72, 0x96, 0xe3, 0x49, 0x29, 0x33, 0x50, 0xfc, 0xbb, 0x0c, 0x8c,
-	0xdd, 0x2c, 0x2f, 0xac, 0x6a, 0xb6, 0xd6, 0x20, 0x2e, 0xb1, 0x1d, 0x74, 0x06, 0x92, 0x0d, 0x6d,
-	0x5b, 0x25, 0x86, 0xdd, 0x94, 0xa5, 0x69, 0x69, 0x76, 0x40, 0x19, 0x69, 0xef, 0x16, 0x12, 0x37,
-	0xb5, 0xed, 0xc5, 0x2a, 0x5e, 0xc5, 0x89, 0x86, 0xb6, 0xbd, 0x68, 0xd8, 0x4d, 0xf4, 0x0e, 0x4c,
-	0x68, 0xba, 0xad, 0xd2, 0xfd, 0xa4, 0xda, 0x9a, 0x4b, 0x54, 0xc3, 0xd4, 0xc9, 0x36, 0x5b, 0x98,
-	0xf1, 0xd2, 0xe9, 0xce, 0xd1, 0x55, 0x34, 0x57, 0xc3, 0x9a, 0x4b, 0xaa, 0x94, 0x48, 0x39, 0xb5,
-	0xa7, 0x0c, 0x7d, 0x8f, 0x2e, 0x73, 0x7b, 0xb7, 0x90, 0x29, 0x57, 0x70, 0xa4, 0x17, 0x67, 0x34,
-	0xdd, 0x8e, 0xb4, 0xa0, 0x37, 0x01, 0x51, 0x59, 0xee, 0xb6, 0xda, 0xb4, 0x9e, 0x12, 0x5b, 0x88,
-	0x62, 0x8b, 0xab, 0xe4, 0xf6, 0x94, 0xf8, 0xb9, 0x01, 0x39, 0xdd, 0xde, 0x2d, 0xa4, 0xcb, 0x15,
-	0x7c, 0x7b, 0x7b, 0x95, 0x92, 0x70, 0xa4, 0xb4, 0xa6, 0xdb, 0xe1, 0x06, 0xf4, 0x15, 0x18, 0xa5,
-	0x40, 0xe6, 0x9a, 0xea, 0xda, 0x9a, 0xe9, 0xf0, 0x55, 0x57, 0x26, 0x03, 0x08, 0x28, 0x57, 0xf0,
-	0xca, 0xda, 0x6d, 0xda, 0x89, 0x41, 0xd3, 0x6d, 0xf1, 0x8d, 0x2e, 0xc3, 0x18, 0x65, 0xd4, 0x6a,
-	0x9b, 0x6a, 0xdd, 0x68, 0x18, 0x2e, 0xdf, 0x02, 0x4a, 0xb6, 0xbd, 0x5b, 0x18, 0x29, 0x57, 0x70,
-	0xb9, 0xb6, 0x79, 0x83, 0x35, 0x4b, 0x78, 0x44, 0xd3, 0x6d, 0xef, 0xcf, 0x30, 0x9b, 0x4e, 0xea,
-	0xda, 0x0e, 0xdb, 0x13, 0x11, 0xb6, 0x0a, 0x6b, 0xf6, 0xd9, 0xd8, 0x9f, 0xe8, 0x4f, 0x20, 0x65,
-	0x6f, 0x5f, 0x14, 0x2c, 0x29, 0xa6, 0xd1, 0xa9, 0x4e, 0x8d, 0xe2, 0x6d, 0x46, 0xab, 0x24, 0x3d,
-	0x5d, 0xe2, 0xa4, 0xbd, 0x7d, 0x91, 0xf3, 0x7f, 0x15, 0x8e, 0x31, 0x7e, 0x7f, 0x6d, 0xac, 0xf5,
-	0x75, 0x87, 0xb8, 0x32, 0x30, 0xe9, 0x09, 0x3e, 0xdd, 0x04, 0xce, 0x52, 0x06, 0xa1, 0xe8, 0xb7,
-	0x18, 0x05, 0xba, 0x0b, 0x13, 0xf6, 0x76, 0xa9, 0x6b, 0x55, 0x47, 0x0e, 0xb3, 0xaa, 0xc1, 0x48,
-	0x32, 0xf6, 0x76, 0x29, 0xba, 0x82, 0xf3, 0x30, 0x46, 0x71, 0xd7, 0x6d, 0xf2, 0x9d, 0x16, 0x31,
-	0x6b, 0x3b, 0xf2, 0xe8, 0xb4, 0x34, 0x1b, 0x57, 0x52, 0x7b, 0xca, 0x70, 0x29, 0x3e, 0xfb, 0xd1,
-	0x5f, 0x0d, 0xe3, 0x51, 0x7b, 0xbb, 0xb4, 0xe4, 0x75, 0xa3, 0x5b, 0x30, 0x4e, 0x77, 0xa1, 0xde,
-	0x72, 0x77, 0xd4, 0xda, 0x4e, 0xad, 0x4e, 0xe4, 0x31, 0x36, 0x84, 0xee, 0x6d, 0xbf, 0xb1, 0x61,
-	0x93, 0x0d, 0xcd, 0x25, 0x7a, 0xa5, 0xe5, 0xee, 0x2c, 0x50, 0xd2, 0xd0, 0x40, 0x46, 0x1b, 0xda,
-	0xb6, 0xdf, 0x8e, 0x74, 0x98, 0xb2, 0xc9, 0x3b, 0x96, 0x61, 0xaa, 0xd4, 0xe6, 0xab, 0x4d, 0x62,
-	0x1b, 0x96, 0x6e, 0xd4, 0x0c, 0x77, 0x47, 0x1e, 0x67, 0xe8, 0xc5, 0x2e, 0x25, 0x33, 0x72, 0x7a,
-	0x60, 0x17, 0xb7, 0x9b, 0x96, 0x49, 0x4c, 0x37, 0x04, 0x3e, 0x69, 0xfb, 0xbd, 0xab, 0x01, 0x14,
-	0xda, 0x00, 0x59, 0x48, 0xa9, 0x59, 0x2d, 0xd3, 0x8d, 0x88, 0x49, 0xf7, 0x9e, 0x04, 0x17, 0xb3,
-	0x40, 0xc9, 0x7b, 0xc8, 0x39, 0x6e, 0x07, 0xdd, 0x61, 0x41, 0x6f, 0xc0, 0x44, 0xd3, 0x30, 0x37,
-	0x54, 0xa7, 0x6e, 0xb9, 0x21, 0xcd, 0x66, 0x98, 0x66, 0x47, 0xf6, 0x94, 0x64, 0x69, 0x58, 0x8e,
-	0x31, 0xdd, 0x66, 0x29, 0xdd, 0xad, 0xba, 0xe5, 0x06, 0x0a, 0x7e, 0x00, 0x27, 0x02, 0xe6, 0xce,
-	0xe5, 0xce, 0x1e, 0x66, 0xb9, 0x07, 0x64, 0x09, 0x4f, 0x7a, 0xc0, 0xd1, 0xd5, 0x7e, 0x1d, 0x32,
-	0x6b, 0x44, 0xab, 0x59, 0x66, 0x68, 0x58, 0xa8, 0x7b, 0x58, 0x69, 0x4e, 0x14, 0x0c, 0xea, 0x3a,
-	0x24, 0x6b, 0x4f, 0x34, 0xd3, 0x24, 0x75, 0x47, 0x9e, 0x60, 0x66, 0xee, 0xa5, 0xce, 0x31, 0x44,
-	0x8c, 0xd5, 0xfc, 0x02, 0xa7, 0x66, 0xca, 0xfa, 0x50, 0x1a, 0x48, 0x4a, 0xd8, 0x07, 0x40, 0x4b,
-	0x90, 0x6d, 0x35, 0xa9, 0xad, 0x53, 0xf5, 0xa7, 0xa4, 0x5e, 0x67, 0x6b, 0x2e, 0x1f, 0xeb, 0x63,
-	0x93, 0x15, 0xcb, 0xaa, 0xdf, 0xd5, 0xea, 0x2d, 0x82, 0xd3, 0x9c, 0xa9, 0x42, 0x79, 0xe8, 0xd2,
-	0xa2, 0x65, 0x98, 0xf0, 0x8c, 0x6f, 0x18, 0x69, 0xf2, 0x40, 0xa4, 0xac, 0xc7, 0x16, 0x60, 0x6d,
-	0xc1, 0xf1, 0x88, 0x19, 0x51, 0x89, 0x58, 0x6e, 0xf9, 0x38, 0x83, 0x9b, 0xed, 0xda, 0xde, 0x81,
-	0x6d, 0xf1, 0x76, 0x06, 0x03, 0x57, 0xa6, 0xda, 0xbb, 0x85, 0x89, 0x1e, 0xbd, 0x78, 0x22, 0x64,
-	0x7f, 0xbc, 0xc6, 0xb0, 0x5c, 0x66, 0x54, 0x02, 0xb9, 0x53, 0xfb, 0xc9, 0x65, 0xd6, 0xa4, 0xaf,
-	0xdc, 0x48, 0xaf, 0x27, 0x37, 0xd2, 0x88, 0x36, 0xa0, 0xd0, 0x77, 0x97, 0xa9, 0x5b, 0x14, 0x50,
-	0x96, 0xd9, 0x00, 0x8a, 0xfb, 0xee, 0x35, 0xae, 0xcf, 0x5c, 0xcf, 0xcd, 0xc6, 0xfa, 0x72, 0xff,
-	0x31, 0x00, 0x09, 0xb1, 0x19, 0xd0, 0x6b, 0x90, 0x11, 0x0b, 0x1f, 0xec, 0x3e, 0xa9, 0xd3, 0xdc,
-	0x88, 0x65, 0x0e, 0xf6, 0xde, 0x57, 0x01, 0xf9, 0xcb, 0x1c, 0xf0, 0x0d, 0x74, 0xf2, 0xf9, 0x8b,
-	0x1a, 0x70, 0xde, 0x85, 0x89, 0x86, 0x61, 0x76, 0x1d, 0xa2, 0xc1, 0x23, 0xda, 0xcc, 0x86, 0x61,
-	0x46, 0x4f, 0x11, 0xc5, 0xa5, 0x36, 0xf0, 0xb3, 0xdc, 0xb0, 0x61, 0x5c, 0x6d, 0x3b, 0x8a, 0xfb,
-	0x22, 0x8c, 0x11, 0x53, 0x5b, 0xab, 0x13, 0x95, 0xeb, 0x80, 0x5d, 0xa4, 0x49, 0x3c, 0xca, 0x1b,
-	0xef, 0xb0, 0xb6, 0x2b, 0xf1, 0x8f, 0x3f, 0x2a, 0xc4, 0xf8, 0xbf, 0xcb, 0xf1, 0xe4, 0x40, 0x66,
-	0x70, 0x39, 0x9e, 0x1c, 0xcc, 0xc4, 0x8b, 0xff, 0x3e, 0x00, 0x27, 0x17, 0x4d, 0xbd, 0xc2, 0x82,
-	0x86, 0xbb, 0xc4, 0xa6, 0xae, 0x5b, 0x35, 0x70, 0x7f, 0xd1, 0x4d, 0x48, 0xae, 0xd9, 0x9a, 0xa9,
-	0xab, 0x86, 0xce, 0x94, 0x9e, 0x52, 0x4a, 0x7b, 0xca, 0x8c, 0x5d, 0x94, 0x67, 0x4a, 0xf9, 0xc7,
-	0x0f, 0xb4, 0xb9, 0xef, 0xbe, 0x3a, 0xf7, 0xb5, 0x47, 0xb3, 0x57, 0xaf, 0x3c, 0x98, 0x7b, 0x74,
-	0xd5, 0xfb, 0xf3, 0xec, 0xbb, 0xa5, 0xf3, 0xef, 0xcf, 0x50, 0x2f, 0x43, 0xa1, 0xac, 0xd5, 0x0a,
-	0x4e, 0x30, 0x8c, 0xaa, 0x4e, 0xe1, 0x1a, 0x96, 0x4e, 0xea, 0x14, 0x6e, 0xe0, 0xc8, 0x70, 0x37,
-	0x29, 0x2b, 0x85, 0x63, 0x18, 0x55, 0x1d, 0x95, 0x20, 0xf3, 0x44, 0xb3, 0xf5, 0xa7, 0x9a, 0x4d,
-	0xd4, 0x2d, 0x3e, 0x78, 0xb6, 0x4e, 0x29, 0x76, 0x29, 0xda, 0x03, 0xf2, 0x34, 0x4e, 0x7b, 0x04,
-	0x62, 0x72, 0x94, 0x67, 0xdd, 0xb0, 0x1b, 0x11, 0x9e, 0x78, 0x07, 0x8f, 0x47, 0xe0, 0xf1, 0x9c,
-	0x83, 0xc4, 0x9a, 0x50, 0xc2, 0x10, 0x23, 0xcd, 0x0a, 0xd2, 0xf6, 0x6e, 0x61, 0x58, 0xe1, 0x73,
-	0x1c, 0x5e, 0x63, 0x53, 0x2c, 0xee, 0x0e, 0x43, 0xa6, 0x53, 0xa3, 0xe8, 0x2d, 0x18, 0x34, 0x74,
-	0x87, 0x69, 0x70, 0xa4, 0xf4, 0x4a, 0xe7, 0x5a, 0xef, 0xb3, 0x00, 0x3d, 0x5c, 0x68, 0x8a, 0x84,
-	0x54, 0x48, 0x0b, 0x00, 0x7f, 0x12, 0x03, 0x6c, 0x23, 0xe5, 0x7a, 0x58, 0x58, 0x01, 0x4b, 0x7d,
-	0x2b, 0xdf, 0x4f, 0x1b, 0xbf, 0x61, 0x61, 0xed, 0x5e, 0x79, 0x45, 0xf4, 0xe1, 0x71, 0xc1, 0xe2,
-	0x8d, 0xd8, 0x80, 0x09, 0x4f, 0x40, 0xf3, 0xc9, 0x4e, 0x44, 0xbb, 0x3d, 0x84, 0xac, 0x5e, 0x7b,
-	0xdb, 0x13, 0x72, 0x3a, 0x24, 0x24, 0x2b, 0x84, 0x04, 0xdd, 0x38, 0x2b, 0xb8, 0x56, 0x9f, 0xec,
-	0x78, 0xa2, 0x96, 0x20, 0xeb, 0x9f, 0x50, 0xb5, 0x59, 0xd7, 0x4c, 0xaa, 0x67, 0xbe, 0x24, 0x39,
-	0xae, 0xe7, 0x6f, 0x52, 0x6f, 0xd0, 0x3f, 0xa1, 0xab, 0x75, 0xcd, 0xac, 0x56, 0x70, 0x7a, 0x3d,
-	0xd2, 0xa0, 0xa3, 0x69, 0x18, 0x6e, 0x3e, 0xb1, 0x5c, 0xcb, 0x91, 0x87, 0xa6, 0x07, 0x67, 0x53,
-	0xde, 0x2d, 0x92, 0x01, 0x2c, 0xda, 0xd1, 0x2c, 0x64, 0x9c, 0x56, 0xb3, 0x69, 0xd9, 0xae, 0xa3,
-	0xd6, 0xea, 0x9a, 0xe3, 0xa8, 0x6b, 0xcc, 0x67, 0x4c, 0xe2, 0x71, 0xaf, 0x7d, 0x81, 0x36, 0x2b,
-	0x3d, 0x28, 0x6b, 0xcc, 0x47, 0xec, 0xa4, 0x5c, 0x40, 0x04, 0x8e, 0xe9, 0x64, 0x5d, 0x6b, 0xd5,
-	0x5d, 0xb5, 0xa1, 0xd5, 0x54, 0x87, 0xb8, 0x2e, 0x0d, 0xad, 0x44, 0xb8, 0x70, 0xb2, 0xc7, 0x72,
-	0xdc, 0x12, 0x24, 0xca, 0xf1, 0xf6, 0x6e, 0x01, 0x55, 0x38, 0x73, 0xa8, 0x1d, 0x23, 0x01, 0x78,
-	0x53, 0xab, 0x79, 0x6d, 0xf4, 0x94, 0x53, 0xab, 0x14, 0x98, 0x32, 0xea, 0x47, 0xc6, 0xf1, 0x68,
-	0xc3, 0x08, 0x5d, 0xb8, 0x94, 0x48, 0xdb, 0x0e, 0x11, 0x81, 0x20, 0xd2, 0xb6, 0x23, 0x44, 0xfe,
-	0xd4, 0xa8, 0x23, 0xc2, 0xbc, 0xc1, 0x24, 0x1e, 0xf5, 0x1a, 0x97, 0x2d, 0xc3, 0x44, 0xe7, 0x01,
-	0xd9, 0xc4, 0x21, 0x82, 0x44, 0x35, 0x2d, 0xb3, 0x46, 0x1c, 0xe6, 0xe5, 0x25, 0x71, 0x86, 0xf7,
-	0x50, 0xba, 0x15, 0xd6, 0x8e, 0x08, 0x78, 0x43, 0x56, 0xd7, 0x2d, 0xbb, 0xa1, 0xb9, 0xf4, 0x36,
-	0x67, 0x2e, 0x5e, 0x8f, 0xbb, 0xe8, 0x26, 0x8f, 0x7c, 0x57, 0xb5, 0x9d, 0xba, 0xa5, 0xe9, 0x4b,
-	0x3e, 0xbd, 0x32, 0x1a, 0xde, 0xea, 0x38, 0x2b, 0x10, 0x03, 0x02, 0x6e, 0xbe, 0x8a, 0xff, 0x3a,
-	0x01, 0x23, 0x21, 0x6d, 0xa1, 0x37, 0x21, 0x2d, 0xd6, 0x92, 0xdd, 0xe4, 0x56, 0xcb, 0x15, 0xe7,
-	0xec, 0x44, 0xd7, 0x65, 0x5e, 0x11, 0xb9, 0x07, 0x25, 0xfe, 0x13, 0x1a, 0xa5, 0x8d, 0x31, 0x3e,
-	0xe5, 0x36, 0xe7, 0x42, 0xf7, 0x60, 0x32, 0xb8, 0xdd, 0xc2, 0x6e, 0xde, 0x00, 0x83, 0xeb, 0x72,
-	0xf3, 0x56, 0xc5, 0xfd, 0xc5, 0x9d, 0x38, 0x7e, 0xa9, 0x4d, 0x34, 0x23, 0x8d, 0xdc, 0xb3, 0x7b,
-	0xb8, 0x9f, 0x73, 0x36, 0x78, 0xe8, 0x0b, 0xb3, 0x8f, 0x77, 0x76, 0xaf, 0xb7, 0xdf, 0x18, 0x67,
-	0xb8, 0xa7, 0xba, 0x74, 0x70, 0xa7, 0x6a, 0xba, 0xaf, 0xbf, 0xc6, 0x6f, 0xff, 0xf0, 0x45, 0xd8,
-	0xed, 0x53, 0xe2, 0x1e, 0x6e, 0xdf, 0x89, 0xa3, 0xa1, 0x76, 0xb9, 0x84, 0xfe, 0x62, 0xd5, 0xfc,
-	0xc5, 0x1a, 0x3a, 0xca, 0x62, 0x2d, 0x78, 0x8b, 0xf5, 0xb5, 0x70, 0x4c, 0x35, 0x2c, 0x46, 0xd5,
-	0x3b, 0xa6, 0xe2, 0xda, 0x0b, 0xc2, 0xa9, 0xbb, 0x7d, 0xc2, 0xa9, 0xc4, 0x3e, 0x73, 0xbb, 0x54,
-	0xe2, 0x73, 0xdb, 0x2f, 0xd8, 0xfa, 0x56, 0xef, 0x60, 0x2b, 0x79, 0xe8, 0x05, 0xee, 0x8e, 0xb3,
-	0x6e, 0x74, 0xc6, 0x59, 0xa9, 0xa3, 0xe9, 0x3f, 0x1a, 0x85, 0x2d, 0x41, 0x6e, 0x5d, 0xab, 0xb9,
-	0x96, 0xbd, 0xa3, 0x36, 0xd9, 0x19, 0xf6, 0x81, 0x0d, 0xe2, 0xc8, 0x30, 0x3d, 0x38, 0x1b, 0xf7,
-	0x8d, 0xe6, 0xb7, 0xb1, 0x2c, 0x68, 0x57, 0x19, 0xe9, 0x52, 0x40, 0x89, 0x56, 0xba, 0xa2, 0xb9,
-	0x91, 0x3e, 0x6e, 0x67, 0x77, 0x34, 0xc7, 0x67, 0x1a, 0x0d, 0xe4, 0x6a, 0x30, 0xe9, 0x5b, 0xa4,
-	0x4b, 0x25, 0x75, 0xcd, 0x10, 0x99, 0x21, 0x66, 0x6f, 0xf6, 0x75, 0xca, 0x95, 0x49, 0x7a, 0xcb,
-	0xdc, 0x12, 0xcc, 0x97, 0x4a, 0x8a, 0xc1, 0xf2, 0x47, 0x38, 0xeb, 0x74, 0x36, 0xa1, 0xab, 0x90,
-	0x68, 0x39, 0x44, 0xd5, 0x74, 0x5b, 0x18, 0xa6, 0xfd, 0x60, 0x81, 0x5e, 0xec, 0x77, 0x1c, 0x52,
-	0xae, 0x60, 0x3c, 0xdc, 0x72, 0x48, 0x59, 0xb7, 0x51, 0x15, 0x80, 0x3a, 0xdd, 0x0d, 0xcd, 0xde,
-	0x30, 0x4c, 0x16, 0x61, 0x52, 0xf3, 0xde, 0x89, 0xb1, 0x54, 0xb7, 0x34, 0xe1, 0x5b, 0x8f, 0xb5,
-	0x77, 0x0b, 0xa9, 0x72, 0x05, 0xdf, 0x64, 0x1c, 0x38, 0xa5, 0xe9, 0x36, 0xff, 0x44, 0x5f, 0x87,
-	0x51, 0x61, 0x5d, 0xf9, 0x3c, 0xd3, 0x07, 0x06, 0x1f, 0xc0, 0xe9, 0xd9, 0x4c, 0xee, 0xc1, 0x94,
-	0xe3, 0x6a, 0x6e, 0xcb, 0xe9, 0x8e, 0x7b, 0x33, 0x87, 0x3b, 0x4b, 0x93, 0x9c, 0xbf, 0x33, 0xd4,
-	0xbd, 0x0b, 0xb2, 0x00, 0xee, 0x0e, 0x75, 0xb3, 0x07, 0x1f, 0x0e, 0x7c, 0x9c, 0x73, 0x77, 0x45,
-	0xb6, 0xd7, 0x20, 0xab, 0x13, 0xc7, 0xb0, 0x89, 0xae, 0x06, 0x67, 0x16, 0x1d, 0xe2, 0xcc, 0xa6,
-	0x05, 0x1b, 0xf6, 0x8e, 0xee, 0x43, 0x38, 0x15, 0x41, 0xea, 0x3c, 0xc2, 0x13, 0x87, 0x18, 0xa5,
-	0x1c, 0x02, 0x8d, 0x1e, 0xe0, 0x6f, 0xc3, 0xc9, 0x00, 0xbd, 0xfb, 0x20, 0x1f, 0x3b, 0xf4, 0x41,
-	0x9e, 0xf2, 0x45, 0x74, 0x9c, 0xe7, 0x07, 0x30, 0x19, 0x96, 0x10, 0x9c, 0xeb, 0xc9, 0xa3, 0x9d,
-	0xeb, 0x89, 0x40, 0x40, 0x70, 0xbc, 0x1f, 0xc1, 0x71, 0x0f, 0xbc, 0xe3, 0x78, 0x1e, 0x3f, 0xe2,
-	0xf1, 0xf4, 0xe0, 0x6f, 0x86, 0x4f, 0xe9, 0x5f, 0x4a, 0x90, 0xf7, 0xf0, 0xfb, 0x44, 0xbd, 0x53,
-	0x47, 0x8c, 0x7a, 0xf3, 0xed, 0xdd, 0x42, 0xae, 0xc2, 0x31, 0x7b, 0x05, 0xbf, 0x39, 0x21, 0xaf,
-	0xdc, 0x23, 0x06, 0xee, 0x35, 0x9c, 0x8e, 0x60, 0x58, 0x3e, 0x62, 0x30, 0xdc, 0x3d, 0x9c, 0x68,
-	0x4c, 0x1c, 0x1d, 0x4e, 0x34, 0x34, 0xde, 0x84, 0x17, 0xbc, 0xd1, 0xf4, 0xbf, 0xeb, 0x4f, 0x1e,
-	0x7a, 0x07, 0x79, 0xdb, 0x7c, 0xb5, 0xe7, 0x95, 0xbf, 0x1e, 0x6c, 0xd4, 0x5e, 0x57, 0xff, 0xa9,
-	0xa3, 0x6d, 0x26, 0xb9, 0x43, 0x56, 0xb0, 0xa3, 0x34, 0xf0, 0xfa, 0xd4, 0x2e, 0x4f, 0xe0, 0xf4,
-	0xd1, 0x84, 0x78, 0x5b, 0x53, 0x89, 0x3a, 0x04, 0xc5, 0xff, 0x9a, 0x82, 0x24, 0xf5, 0xe6, 0x5c,
-	0xcd, 0x25, 0xe8, 0x3e, 0xa0, 0x5a, 0xcb, 0xb6, 0x09, 0xb5, 0x3d, 0x7e, 0x56, 0x48, 0x78, 0x73,
-	0xa7, 0xf7, 0x4d, 0x1d, 0x75, 0x3a, 0x8f, 0x02, 0x26, 0x94, 0x08, 0xbf, 0x4f, 0x7d, 0x54, 0xa1,
-	0xb3, 0x00, 0x7b, 0xe0, 0x33, 0x60, 0x7b, 0xea, 0x0a, 0xb0, 0x15, 0x18, 0xe5, 0x65, 0x37, 0x1e,
-	0x2b, 0x88, 0x28, 0x69, 0xb2, 0x13, 0x95, 0xc7, 0x16, 0x41, 0x2c, 0x3f, 0xc2, 0x99, 0x58, 0x73,
-	0xaf, 0x88, 0x2e, 0xfe, 0x85, 0x46, 0x74, 0x8f, 0x20, 0xe7, 0x57, 0x3f, 0x0c, 0xbb, 0x41, 0x74,
-	0xbf, 0x08, 0xa1, 0x6a, 0x9e, 0x17, 0xb6, 0x5f, 0x75, 0x23, 0xce, 0x2a, 0x1b, 0x53, 0x5e, 0x95,
-	0x84, 0x41, 0x78, 0xf5, 0x87, 0xb2, 0x8b, 0x2e, 0x83, 0xcc, 0xe0, 0x75, 0xb2, 0xa5, 0x8a, 0x5b,
-	0xc4, 0x2f, 0xef, 0xf0, 0x6a, 0xcc, 0x04, 0xed, 0xaf, 0x90, 0xad, 0x5b, 0xac, 0x57, 0xd4, 0x79,
-	0xfa, 0x3a, 0xdd, 0x89, 0xcf, 0xe9, 0x74, 0x13, 0x38, 0xd5, 0x24, 0xa6, 0x4e, 0xb1, 0x7b, 0x15,
-	0x5e, 0x84, 0x5b, 0x76, 0xb8, 0xba, 0x8b, 0x00, 0xea, 0xd1, 0x87, 0x16, 0x21, 0x23, 0xca, 0x3b,
-	0x36, 0x71, 0x9a, 0x96, 0xe9, 0x10, 0xaf, 0xa4, 0xd3, 0x6b, 0xdd, 0x16, 0xac, 0x46, 0x43, 0x33,
-	0x75, 0x9c, 0xe6, 0x3c, 0xd8, 0x63, 0xa1, 0x30, 0xde, 0x68, 0xd9, 0xd1, 0x70, 0x5c, 0xee, 0x90,
-	0x1d, 0x00, 0x23, 0x78, 0xb0, 0x60, 0x41, 0xdf, 0x02, 0x24, 0x46, 0xc3, 0xc2, 0x36, 0xad, 0x56,
-	0x23, 0x4d, 0x57, 0x78, 0x67, 0x2f, 0xf6, 0x0a, 0x45, 0xe9, 0xb1, 0x9b, 0xa7, 0x91, 0x5c, 0x99,
-	0x91, 0x62, 0x31, 0x99, 0xa0, 0x85, 0x7a, 0xcb, 0xde, 0xc8, 0x18, 0xa6, 0x18, 0x9e, 0xf0, 0xcd,
-	0x66, 0xf6, 0x05, 0x15, 0xe3, 0xc2, 0x48, 0x20, 0x84, 0xda, 0xd0, 0xab, 0xd4, 0x0b, 0x57, 0x9f,
-	0x1a, 0xa6, 0x6e, 0x3d, 0x75, 0x54, 0x6d, 0x4b, 0x33, 0xea, 0xda, 0x9a, 0x28, 0x0c, 0x24, 0x31,
-	0xb2, 0xb7, 0xef, 0xf1, 0xae, 0xb2, 0xd7, 0x83, 0x2a, 0x30, 0x6e, 0x93, 0x1a, 0x61, 0x5b, 0x8a,
-	0xd7, 0xce, 0xc6, 0x99, 0x86, 0xba, 0x4e, 0x2f, 0xcf, 0x79, 0x89, 0x38, 0x13, 0x8f, 0x71, 0x26,
-	0xde, 0xe8, 0xa0, 0x65, 0xc8, 0x08, 0x94, 0xa0, 0x06, 0x97, 0x66, 0x38, 0x85, 0x2e, 0xbb, 0x2c,
-	0x08, 0x3c, 0xa4, 0x34, 0x67, 0xf4, 0x8b, 0x6e, 0xa8, 0x0e, 0x45, 0x5e, 0xa4, 0xe4, 0x65, 0x54,
-	0xd5, 0x30, 0x0d, 0xd7, 0xa0, 0xf7, 0x69, 0xe4, 0x68, 0x65, 0x0e, 0x79, 0xb4, 0xf2, 0xac, 0xae,
-	0xc9, 0xa1, 0xaa, 0x1e, 0x52, 0xe8, 0x84, 0xfd, 0x58, 0x82, 0xbc, 0x4d, 0xde, 0x21, 0x35, 0x57,
-	0x5c, 0x79, 0x1d, 0xd7, 0x0b, 0x71, 0xe4, 0xec, 0xf4, 0xe0, 0xc1, 0xc9, 0xc4, 0xb9, 0x3d, 0x65,
-	0xf4, 0x43, 0x29, 0x95, 0x49, 0x17, 0x7d, 0xdb, 0x91, 0xc3, 0x02, 0xb7, 0xb3, 0x7a, 0x47, 0x1c,
-	0x9c, 0xf3, 0x64, 0x96, 0x3b, 0xea, 0x78, 0xc4, 0x41, 0x0d, 0x38, 0x1d, 0x19, 0x51, 0xb4, 0xa4,
-	0x47, 0x1c, 0x19, 0x4d, 0x0f, 0xce, 0x8e, 0x29, 0xaf, 0xec, 0x29, 0x23, 0x1f, 0x4a, 0xc9, 0x4c,
-	0xba, 0xe8, 0x15, 0xe6, 0x4e, 0x84, 0x04, 0x86, 0x4b, 0x7a, 0xc4, 0xc1, 0x27, 0x42, 0xf2, 0xa2,
-	0x5d, 0xa8, 0x0c, 0xc7, 0x7c, 0x71, 0xe1, 0xd0, 0x65, 0x82, 0x85, 0x2e, 0xe3, 0x5c, 0x4a, 0xd1,
-	0x77, 0x92, 0x3c, 0xda, 0x70, 0xec, 0xb2, 0x0c, 0x19, 0x6e, 0xa6, 0x42, 0x0b, 0x74, 0xec, 0x90,
-	0x0b, 0x34, 0xce, 0x0c, 0x58, 0xb0, 0x20, 0x16, 0xf8, 0x63, 0x0d, 0xad, 0x85, 0xad, 0x99, 0x1b,
-	0xc4, 0x91, 0x27, 0xd9, 0x9e, 0x7a, 0xad, 0xef, 0xf9, 0xf0, 0x14, 0xe0, 0xa9, 0x14, 0x33, 0xb6,
-	0x45, 0xd3, 0xb5, 0x77, 0x58, 0x89, 0xa8, 0x47, 0x27, 0x5a, 0x11, 0x36, 0x96, 0xaa, 0xba, 0xf6,
-	0x84, 0xb6, 0x05, 0x36, 0xf6, 0x38, 0x2b, 0x06, 0xb2, 0x7c, 0xfe, 0x0d, 0xcd, 0x71, 0xcb, 0x15,
-	0xbc, 0xc0, 0x28, 0xb8, 0x9d, 0xe5, 0xc6, 0xb7, 0xac, 0xdb, 0xe1, 0xc6, 0xdc, 0x0f, 0x07, 0x60,
-	0x24, 0x7c, 0x26, 0xef, 0x80, 0x9f, 0x0f, 0x0f, 0x12, 0x59, 0xc3, 0x42, 0x3b, 0x9d, 0x7b, 0xea,
-	0x86, 0x9f, 0xc7, 0x8a, 0xde, 0x8f, 0x19, 0x0f, 0xc2, 0xcf, 0xd0, 0x7c, 0x1d, 0x92, 0xf6, 0xb6,
-	0x70, 0xfb, 0x13, 0x87, 0x2d, 0x7f, 0x26, 0x6c, 0xde, 0x84, 0xde, 0x80, 0x44, 0x6d, 0x5d, 0xad,
-	0x1b, 0x8e, 0x57, 0x82, 0x3f, 0xde, 0x75, 0xaf, 0x2e, 0xdd, 0x30, 0x1c, 0x97, 0x07, 0x6d, 0xfc,
-	0x1b, 0x0f, 0xd7, 0xd6, 0xe9, 0xff, 0xbc, 0x2c, 0x1e, 0xce, 0x78, 0x2f, 0xc7, 0x93, 0xf1, 0xcc,
-	0xd0, 0x72, 0x3c, 0x39, 0x94, 0x19, 0x5e, 0x8e, 0x27, 0x53, 0x19, 0x58, 0x8e, 0x27, 0x21, 0x33,
-	0x92, 0xfb, 0x87, 0x41, 0x80, 0x90, 0xd9, 0x7b, 0x11, 0x12, 0x4d, 0x9e, 0xa1, 0x62, 0xfe, 0xc7,
-	0x28, 0xf3, 0x65, 0xbe, 0x1b, 0xcf, 0x64, 0xe5, 0x17, 0xb0, 0xd7, 0x83, 0x2a, 0x90, 0xf0, 0xcc,
-	0xe1, 0xc0, 0xe1, 0xcd, 0xa1, 0x12, 0x67, 0x7a, 0xf2, 0x58, 0xd1, 0x37, 0x0e, 0xff, 0xa0, 0x22,
-	0xaa, 0x69, 0xfe, 0x98, 0xe2, 0x4d, 0x48, 0xd7, 0x2c, 0xdb, 0x26, 0x75, 0x7e, 0xc1, 0x19, 0xba,
-	0x23, 0xc7, 0x59, 0xfe, 0x33, 0xbf, 0xa7, 0xa4, 0x3e, 0x94, 0x86, 0x8b, 0x71, 0x7b, 0x40, 0xd6,
-	0xa9, 0x83, 0xb0, 0x10, 0x90, 0x55, 0x2b, 0x0e, 0x1e, 0x0f, 0xb1, 0x55, 0x75, 0x27, 0xf2, 0x68,
-	0x64, 0xe8, 0x0b, 0x7e, 0x34, 0xf2, 0x08, 0x86, 0x4d, 0xe2, 0xaa, 0x86, 0xce, 0xb6, 0xd3, 0xa8,
-	0xb2, 0x24, 0x90, 0x2f, 0x1f, 0x15, 0x79, 0x85, 0xb8, 0xd5, 0x4a, 0x7b, 0xb7, 0x30, 0xc4, 0x3e,
-	0xf0, 0x90, 0x49, 0xdc, 0xaa, 0x9e, 0xfb, 0xa5, 0x04, 0x63, 0x91, 0xb3, 0xd2, 0xaf, 0x8a, 0x23,
-	0xfd, 0x81, 0xaa, 0x38, 0x03, 0x9f, 0xb3, 0x8a, 0x93, 0xbb, 0x0f, 0xe3, 0x1d, 0x87, 0xfd, 0x1a,
-	0x0c, 0x0b, 0x53, 0x22, 0x31, 0x53, 0x72, 0xa6, 0xef, 0xde, 0x8a, 0x30, 0x86, 0x8a, 0xa7, 0x82,
-	0x3f, 0x67, 0xc3, 0xc9, 0x7d, 0xac, 0x0d, 0xca, 0xc0, 0xe0, 0x26, 0x11, 0x35, 0x35, 0x4c, 0x3f,
-	0xd1, 0x37, 0x60, 0x88, 0x57, 0xf3, 0xf8, 0xae, 0x7e, 0xf9, 0x70, 0x92, 0x1d, 0xcc, 0xb9, 0xae,
-	0x0c, 0x7c, 0x55, 0x12, 0xb9, 0xda, 0x7f, 0x91, 0x42, 0xe5, 0xa5, 0x72, 0xcb, 0x7d, 0x42, 0x4c,
-	0x57, 0x78, 0x50, 0x0b, 0x96, 0x4e, 0xd0, 0x9c, 0x27, 0x88, 0xd7, 0x96, 0xa6, 0xf6, 0x94, 0x63,
-	0x36, 0x2a, 0x65, 0x1e, 0x3f, 0x28, 0xcf, 0xdd, 0x7f, 0x75, 0xee, 0x6b, 0x8f, 0xde, 0xbd, 0x78,
-	0xfe, 0x52, 0xe9, 0xfd, 0x19, 0x01, 0x8c, 0xae, 0x02, 0xb0, 0xd7, 0x68, 0xea, 0xba, 0x6d, 0x35,
-	0xc4, 0xe0, 0x0e, 0x36, 0xdb, 0x29, 0xc6, 0xb3, 0x64, 0x5b, 0x0d, 0xf4, 0x06, 0x24, 0x39, 0x80,
-	0x6b, 0x89, 0xe3, 0x76, 0x30, 0x7b, 0x82, 0x71, 0xdc, 0xb6, 0x8a, 0x7f, 0x53, 0x80, 0x94, 0x3f,
-	0x19, 0x74, 0x2d, 0x5c, 0xd2, 0x99, 0xe9, 0x5b, 0xd2, 0x39, 0x44, 0x2d, 0x67, 0x01, 0xa0, 0x66,
-	0x13, 0x4d, 0x3c, 0x33, 0x1a, 0x38, 0xca, 0x33, 0x23, 0xc1, 0x57, 0x76, 0x29, 0x48, 0xab, 0xa9,
-	0x7b, 0x20, 0x83, 0x47, 0x01, 0x11, 0x7c, 0x65, 0x17, 0x9d, 0x84, 0xb8, 0xa9, 0x35, 0x48, 0xb4,
-	0x1e, 0x56, 0xc2, 0xac, 0x11, 0x9d, 0x83, 0x11, 0x9d, 0x38, 0x35, 0xdb, 0x68, 0xd2, 0xe5, 0x13,
-	0x85, 0x30, 0xba, 0xd9, 0xec, 0x41, 0xf9, 0xd3, 0x34, 0x0e, 0x77, 0xa2, 0x0f, 0x24, 0x00, 0xcd,
-	0x75, 0x6d, 0x63, 0xad, 0xe5, 0x12, 0x7a, 0x85, 0xd0, 0x0d, 0x7c, 0xb6, 0xaf, 0x92, 0xe6, 0xcb,
-	0x3e, 0x2d, 0xdb, 0x92, 0xca, 0xe5, 0x3d, 0xa5, 0xf4, 0x53, 0xe9, 0x42, 0x06, 0x8a, 0x87, 0x2a,
-	0x0e, 0x9e, 0xa3, 0x63, 0xf8, 0x44, 0xc2, 0x21, 0x99, 0xe8, 0x21, 0x8c, 0x88, 0x38, 0x8a, 0x99,
-	0xc4, 0xc4, 0xd1, 0x4b, 0x6f, 0xe3, 0xed, 0xdd, 0x02, 0x78, 0xed, 0x15, 0x07, 0xc3, 0x96, 0x47,
-	0xe3, 0xa0, 0x2a, 0x20, 0x87, 0xd8, 0x2c, 0xe4, 0x6b, 0xda, 0xd6, 0xba, 0x51, 0x27, 0xd4, 0xb6,
-	0x25, 0x99, 0x4e, 0x4e, 0x06, 0x45, 0xab, 0xcc, 0x2d, 0x4e, 0xb4, 0xca, 0x69, 0xaa, 0x15, 0x9c,
-	0x71, 0xa2, 0x2d, 0x3a, 0xfa, 0x27, 0x09, 0x8e, 0x7b, 0x0e, 0x24, 0xed, 0x24, 0x36, 0x33, 0xc1,
-	0xc4, 0x71, 0x58, 0xb6, 0x37, 0xa5, 0xfc, 0xb5, 0xb4, 0xa7, 0xfc, 0x48, 0xb2, 0xbf, 0x2f, 0x95,
-	0xfe, 0x5c, 0x7a, 0x3c, 0x7b, 0xf5, 0x0a, 0x55, 0x81, 0x36, 0xf7, 0x5d, 0x71, 0x44, 0xde, 0x0b,
-	0x7d, 0x07, 0x9f, 0x0f, 0xe7, 0x1e, 0x9d, 0x0b, 0x75, 0x9c, 0x7d, 0x38, 0x7f, 0xf6, 0x1c, 0xe5,
-	0x2b, 0xcf, 0xdd, 0x17, 0x9a, 0x7b, 0x2f, 0xf4, 0x1d, 0x7c, 0x32, 0xbe, 0xa0, 0xe3, 0xec, 0xec,
-	0xd5, 0x2b, 0x57, 0x1e, 0x88, 0x93, 0x78, 0xf9, 0xfd, 0xb3, 0x57, 0x67, 0xde, 0x7b, 0x3c, 0x83,
-	0x8f, 0x89, 0xe1, 0xde, 0x62, 0xa3, 0x2d, 0xf3, 0xc1, 0xa2, 0xfb, 0x20, 0x77, 0x4c, 0x63, 0x93,
-	0x6c, 0xaa, 0x75, 0x6d, 0x8d, 0xd4, 0xe5, 0x0b, 0x6c, 0x22, 0x2f, 0xf0, 0xcd, 0xf2, 0x41, 0xa6,
-	0xbd, 0x5b, 0x98, 0x5c, 0x09, 0x63, 0x5c, 0x5f, 0xbc, 0x7e, 0x83, 0x12, 0xe2, 0xc9, 0x08, 0xf4,
-	0x75, 0xb2, 0xc9, 0x9a, 0xd1, 0xbf, 0x49, 0x90, 0x0b, 0x47, 0x71, 0x1d, 0x7a, 0x82, 0x2f, 0xa7,
-	0x9e, 0xe4, 0xd0, 0x90, 0xa3, 0xba, 0x5a, 0x87, 0x53, 0x3d, 0xa6, 0x13, 0xe8, 0xeb, 0x55, 0x36,
-	0xa1, 0x97, 0x42, 0xfa, 0x3a, 0x51, 0xee, 0xc4, 0xf2, 0x75, 0x76, 0xa2, 0x4b, 0x8c, 0xaf, 0x37,
-	0x0c, 0x93, 0x3d, 0xe4, 0x18, 0xba, 0x7c, 0x91, 0x09, 0xc8, 0xf3, 0x9d, 0xaa, 0xb3, 0x47, 0x20,
-	0x9d, 0x20, 0xd5, 0x0a, 0x9e, 0xe8, 0x42, 0xae, 0xea, 0xe8, 0x97, 0x12, 0x4c, 0xb0, 0x48, 0xb0,
-	0x63, 0x11, 0x46, 0xbe, 0x9c, 0x8b, 0x90, 0xa5, 0x63, 0x8d, 0x6a, 0xdf, 0x85, 0x54, 0xdd, 0xe2,
-	0xb3, 0x72, 0xe4, 0x51, 0x66, 0x9b, 0x66, 0xfb, 0xdb, 0xa6, 0x1b, 0x1e, 0x29, 0x37, 0x4d, 0xe7,
-	0xf7, 0x94, 0xb3, 0x3f, 0x95, 0xce, 0x1c, 0xce, 0x30, 0xe1, 0x40, 0x10, 0xba, 0x08, 0x09, 0xf1,
-	0xa4, 0x57, 0x2e, 0x31, 0x63, 0x34, 0xd5, 0x9d, 0xdb, 0x60, 0xdd, 0xd8, 0xa3, 0xeb, 0x59, 0xaf,
-	0x1e, 0x3b, 0x74, 0xbd, 0x7a, 0xbc, 0x67, 0xbd, 0xba, 0x47, 0x9e, 0x29, 0xfd, 0xc7, 0x78, 0x39,
-	0x90, 0xf9, 0x63, 0xbd, 0x1c, 0xc8, 0x1e, 0xfd, 0xe5, 0x40, 0x57, 0x71, 0x1d, 0x1d, 0xa6, 0xb8,
-	0x3e, 0x71, 0x98, 0xe2, 0xfa, 0xb1, 0x43, 0x17, 0xd7, 0x27, 0xfb, 0x14, 0xd7, 0x2f, 0x43, 0xca,
-	0xb6, 0x2c, 0x57, 0x65, 0x31, 0x02, 0xcf, 0xe4, 0xcb, 0x5d, 0xe1, 0x93, 0x65, 0xb9, 0x34, 0x40,
-	0xc0, 0x49, 0x5b, 0x7c, 0xa1, 0xb7, 0x7d, 0x8f, 0x7b, 0x8a, 0x79, 0xdc, 0xca, 0x17, 0xe6, 0x6d,
-	0xa3, 0xb7, 0x60, 0x34, 0xf2, 0xd4, 0x41, 0x3e, 0xf8, 0xa9, 0x03, 0x8d, 0xf9, 0xc3, 0x55, 0x7b,
-	0x3c, 0xd2, 0x08, 0x3d, 0x6e, 0x58, 0x80, 0x14, 0x03, 0xa4, 0x3e, 0xa5, 0x28, 0x31, 0xcb, 0xfd,
-	0x7c, 0x4e, 0x65, 0xb4, 0xbd, 0x5b, 0xf0, 0x53, 0xc6, 0x38, 0x49, 0x71, 0x58, 0xf2, 0xf8, 0x6d,
-	0xc8, 0x7a, 0x89, 0xaa, 0x00, 0xec, 0xfc, 0x01, 0x60, 0x13, 0x74, 0x7f, 0xac, 0x72, 0x36, 0x1f,
-	0xd3, 0x4b, 0xab, 0xdd, 0xf4, 0xa0, 0x2f, 0x42, 0xc2, 0xe1, 0x51, 0x98, 0x9c, 0xeb, 0x7d, 0x74,
-	0x45, 0x90, 0x86, 0x3d, 0x3a, 0xf4, 0x4d, 0xf0, 0x50, 0x54, 0x8f, 0xf5, 0xe4, 0xfe, 0xac, 0xe3,
-	0x82, 0xde, 0x7b, 0xc3, 0x3f, 0x03, 0xe3, 0x7e, 0x42, 0x95, 0x6d, 0x11, 0x96, 0xd7, 0x1f, 0xc3,
-	0xa3, 0x22, 0x8d, 0xca, 0xb6, 0x07, 0x3a, 0x03, 0xe9, 0x96, 0x43, 0xf4, 0x80, 0xca, 0x91, 0x4f,
-	0x4f, 0x0f, 0xce, 0x8e, 0xe1, 0x31, 0xda, 0xec, 0x91, 0x39, 0x94, 0x8e, 0xa1, 0x05, 0x3b, 0x4e,
-	0xce, 0x07, 0x6f, 0xe4, 0xfd, 0xed, 0x86, 0xbe, 0x22, 0xe8, 0xec, 0x77, 0x44, 0x11, 0xf0, 0x55,
-	0xb9, 0xc0, 0x32, 0x0b, 0xf4, 0x12, 0x1a, 0xbd, 0xa1, 0x39, 0x2e, 0x5e, 0x66, 0x05, 0xbe, 0x57,
-	0xf9, 0x40, 0xf0, 0x3b, 0xfc, 0xaf, 0x6e, 0xc6, 0x8b, 0xf2, 0x74, 0x4f, 0xc6, 0x8b, 0x11, 0xc6,
-	0x8b, 0xe8, 0x31, 0x9c, 0xec, 0x4c, 0x1c, 0xdb, 0xa4, 0x46, 0x8c, 0x2d, 0xee, 0xca, 0xbe, 0x70,
-	0x94, 0xc4, 0xb4, 0x9f, 0x5d, 0xc6, 0x02, 0xa1, 0xec, 0xa2, 0x45, 0x18, 0xe1, 0x39, 0x29, 0xbe,
-	0x23, 0x8a, 0x7d, 0xec, 0x10, 0x25, 0xe1, 0x7b, 0x22, 0x08, 0xd3, 0xa0, 0xe9, 0xb7, 0xa2, 0x07,
-	0x80, 0xd6, 0xd8, 0x3b, 0x94, 0x1d, 0xb5, 0x49, 0xec, 0x1a, 0x31, 0x5d, 0x6d, 0x83, 0xc8, 0x2f,
-	0x1e, 0x5c, 0x06, 0x4e, 0xef, 0x29, 0xa3, 0x00, 0xa7, 0x63, 0xb1, 0x0f, 0xae, 0xce, 0xc5, 0x62,
-	0xb1, 0x18, 0xce, 0x0a, 0x9c, 0x55, 0x1f, 0x06, 0xbd, 0x0c, 0x69, 0x3f, 0xf1, 0x22, 0x0a, 0xcc,
-	0x33, 0xd3, 0xd2, 0xec, 0x10, 0x1e, 0xf7, 0x9a, 0x45, 0xe5, 0xf8, 0xa0, 0x5f, 0x13, 0xcc, 0x7e,
-	0x21, 0xbf, 0x26, 0x40, 0xd7, 0x00, 0x42, 0x0f, 0x79, 0xce, 0x1e, 0xed, 0x21, 0x0f, 0x0e, 0xf1,
-	0xa2, 0x35, 0x18, 0x6f, 0xda, 0xd6, 0x96, 0x41, 0xb7, 0x34, 0x77, 0x3d, 0xce, 0x31, 0xfb, 0xfc,
-	0xc6, 0x9e, 0xf2, 0xb2, 0xfd, 0x92, 0x3c, 0x53, 0x7a, 0x61, 0xff, 0x1b, 0xf4, 0xbd, 0xc7, 0x33,
-	0xed, 0xdd, 0xc2, 0xd8, 0x6a, 0x80, 0x51, 0xad, 0xe0, 0xb1, 0x10, 0x64, 0x55, 0x47, 0x15, 0xc8,
-	0xfa, 0x0d, 0xf4, 0xc0, 0xd1, 0xe0, 0x5c, 0x7e, 0x45, 0x9c, 0xb6, 0xce, 0x95, 0xb9, 0xc5, 0x7e,
-	0x3d, 0x84, 0x33, 0x61, 0x0e, 0x1a, 0xc6, 0xa2, 0x53, 0x90, 0x6a, 0xb4, 0xea, 0x34, 0x3c, 0x75,
-	0x5c, 0x79, 0x8e, 0x19, 0xe3, 0xa0, 0x01, 0x6d, 0xc0, 0x89, 0x5a, 0x5d, 0x33, 0x1a, 0xaa, 0x16,
-	0x89, 0x62, 0xd5, 0x9a, 0xa5, 0x13, 0x79, 0xfe, 0x80, 0xe0, 0xa2, 0x3b, 0xf2, 0xc5, 0x53, 0x0c,
-	0xad, 0x47, 0x48, 0x3c, 0x0f, 0x13, 0xce, 0xa6, 0xd1, 0x54, 0x45, 0x8e, 0x49, 0xad, 0xd9, 0x3b,
-	0x4d, 0xd7, 0x92, 0x2f, 0xb1, 0x01, 0x65, 0x69, 0x97, 0x50, 0xf8, 0x02, 0xeb, 0x40, 0x0f, 0xe0,
-	0x54, 0x0f, 0x7a, 0xd5, 0xda, 0x22, 0xb6, 0x6d, 0xe8, 0x44, 0x7e, 0xed, 0xc0, 0xb7, 0x05, 0x27,
-	0xba, 0x40, 0xdf, 0x12, 0xcc, 0xb9, 0x6f, 0x40, 0xba, 0x23, 0x34, 0x0b, 0x67, 0x0b, 0x52, 0x3c,
-	0x5b, 0x70, 0x2c, 0x9c, 0x2d, 0x48, 0x85, 0x92, 0x00, 0xb9, 0xbb, 0x30, 0x1e, 0xf5, 0x9e, 0x7a,
-	0x70, 0xcf, 0x47, 0x73, 0x0d, 0x5d, 0xa6, 0xda, 0x03, 0x08, 0xe1, 0x2e, 0xc7, 0x93, 0x2f, 0x65,
-	0xce, 0x2c, 0xc7, 0x93, 0x67, 0x32, 0x2f, 0x2f, 0xc7, 0x93, 0x2f, 0x67, 0x66, 0x8b, 0xd7, 0x00,
-	0x7c, 0x6d, 0x3b, 0xe8, 0x0a, 0x8c, 0x04, 0x3f, 0x85, 0xf3, 0xf2, 0x27, 0x27, 0xfa, 0x2e, 0x0f,
-	0x06, 0xe2, 0xf3, 0x16, 0x75, 0x38, 0xbe, 0xc0, 0xa2, 0xea, 0xa0, 0x5b, 0xe4, 0xe9, 0x96, 0x01,
-	0x02, 0x54, 0xff, 0x8d, 0x59, 0x3f, 0xd0, 0x1e, 0xd1, 0x7e, 0xca, 0x17, 0x53, 0xfc, 0x99, 0x04,
-	0xc7, 0xef, 0xb0, 0xb8, 0xfb, 0x0f, 0x29, 0x06, 0x5d, 0x05, 0x08, 0x7e, 0x4f, 0xd7, 0x37, 0xb5,
-	0xb0, 0x44, 0x49, 0x6e, 0x6a, 0xce, 0xa6, 0xc8, 0x4c, 0xa6, 0xd6, 0xbd, 0x86, 0xe2, 0xdf, 0x4b,
-	0x30, 0xf1, 0x26, 0x71, 0xbb, 0x06, 0xf9, 0x10, 0xc6, 0x83, 0x41, 0xaa, 0x9f, 0x3f, 0x11, 0x32,
-	0x4a, 0x02, 0x3a, 0xe7, 0xf3, 0x0f, 0xfb, 0x7f, 0x24, 0x78, 0x29, 0x3c, 0xec, 0x90, 0xf0, 0x25,
-	0xcb, 0x5e, 0xbc, 0x53, 0x75, 0xbc, 0x89, 0xd4, 0x20, 0xc9, 0xae, 0x44, 0xd2, 0x32, 0x44, 0xa2,
-	0xf7, 0xda, 0x67, 0x4d, 0x4d, 0x2e, 0xde, 0xa9, 0xbe, 0xfe, 0x5a, 0x7b, 0xb7, 0x90, 0xa0, 0xb7,
-	0xe9, 0xe2, 0x9d, 0x2a, 0x4e, 0x50, 0xe4, 0xc5, 0x96, 0x81, 0xbe, 0x0d, 0x09, 0x7a, 0xbb, 0x51,
-	0x19, 0xfc, 0xd7, 0x78, 0x6f, 0x7e, 0x5e, 0x19, 0xc3, 0x15, 0xb2, 0x45, 0x45, 0x0c, 0xeb, 0x64,
-	0x6b, 0xb1, 0x65, 0x14, 0x3f, 0x1c, 0x84, 0xc9, 0x1b, 0x86, 0x13, 0xcc, 0xd8, 0x9f, 0xa0, 0x06,
-	0xe9, 0xf0, 0x55, 0x11, 0x2c, 0xd5, 0x99, 0x7d, 0x2e, 0x89, 0xfd, 0x17, 0x6b, 0x5c, 0x0b, 0x53,
-	0x7e, 0xfe, 0xe5, 0x42, 0x1f, 0x49, 0x30, 0x64, 0xd9, 0x3a, 0xb1, 0xc5, 0xeb, 0xed, 0x1f, 0x4a,
-	0x7b, 0xca, 0x5f, 0x48, 0xf6, 0xf7, 0x24, 0x1c, 0xc3, 0x29, 0x7f, 0x8f, 0x61, 0x98, 0x0b, 0xbe,
-	0xfd, 0x55, 0xc3, 0xa9, 0x39, 0xff, 0xd3, 0xd3, 0x32, 0x4e, 0xce, 0x79, 0x5f, 0x2c, 0x77, 0x85,
-	0x87, 0xe6, 0xd8, 0x7f, 0xe1, 0x1c, 0x15, 0x1e, 0x9d, 0x0b, 0xff, 0x15, 0x4a, 0xc1, 0xe1, 0x91,
-	0xb9, 0xd0, 0x1f, 0x7c, 0x60, 0x28, 0x0f, 0x43, 0xfc, 0x97, 0x62, 0xec, 0xa7, 0x8a, 0xcc, 0x47,
-	0x38, 0x37, 0x28, 0xff, 0x36, 0x81, 0x79, 0x33, 0x42, 0x10, 0x6f, 0x52, 0x87, 0x80, 0xff, 0x44,
-	0x91, 0x7d, 0x17, 0xff, 0x56, 0x82, 0x89, 0x5b, 0x3d, 0x0e, 0xcf, 0xd2, 0xd1, 0x4e, 0x78, 0x34,
-	0xe9, 0xff, 0x45, 0x9e, 0xee, 0x7f, 0x94, 0xe0, 0x24, 0xa6, 0xf1, 0x46, 0xd9, 0xd4, 0xff, 0x1f,
-	0x9e, 0xf2, 0x7f, 0x96, 0x20, 0xeb, 0x4b, 0xbe, 0x4d, 0x1a, 0xcd, 0x3a, 0x75, 0xd4, 0xbe, 0x2c,
-	0xda, 0x45, 0xb3, 0x30, 0xd2, 0xd0, 0x9a, 0xec, 0x75, 0x03, 0xbd, 0xf3, 0x22, 0x3f, 0x4c, 0xd0,
-	0x31, 0x88, 0xbe, 0xeb, 0x64, 0xa7, 0xf8, 0xb1, 0x04, 0x53, 0x5d, 0x13, 0xe1, 0x0e, 0x95, 0x9f,
-	0x93, 0x95, 0xa2, 0xec, 0x3d, 0x73, 0xb2, 0x03, 0xe1, 0x9c, 0xec, 0x27, 0x52, 0x34, 0x27, 0x7b,
-	0x1b, 0xd2, 0x2c, 0x4f, 0x49, 0xb6, 0x5d, 0x62, 0x3a, 0x2c, 0xf7, 0x31, 0xc8, 0xea, 0x44, 0xaf,
-	0xec, 0x29, 0xb3, 0x1f, 0x4a, 0x2f, 0x65, 0x74, 0x59, 0x2a, 0x16, 0xec, 0xd3, 0xa5, 0x93, 0x8f,
-	0x67, 0xaf, 0x5e, 0x79, 0x38, 0xef, 0xf9, 0x61, 0xef, 0x5e, 0x3c, 0x7f, 0xf1, 0xf5, 0xf7, 0xcf,
-	0xbe, 0x7b, 0xf1, 0x7c, 0xe9, 0xfd, 0x19, 0x3c, 0x4e, 0x31, 0x16, 0x7d, 0x88, 0xe2, 0xff, 0x4a,
-	0x20, 0xf7, 0x19, 0xba, 0x83, 0xde, 0x87, 0x04, 0x77, 0x05, 0xbd, 0x3b, 0xf8, 0x72, 0xdf, 0x75,
-	0xe8, 0x60, 0x9d, 0x17, 0xff, 0x7f, 0x96, 0x9c, 0x8b, 0x27, 0x33, 0x57, 0x83, 0xd1, 0x30, 0x4c,
-	0x0f, 0xe7, 0xe3, 0xa0, 0x42, 0x47, 0x9f, 0xe1, 0x85, 0x7c, 0x91, 0xe2, 0xf7, 0x25, 0x28, 0x2c,
-	0x58, 0xe6, 0x16, 0xb1, 0xdd, 0x2e, 0x6a, 0xef, 0x1c, 0xad, 0x42, 0x8a, 0x8f, 0x29, 0xf8, 0x19,
-	0xcd, 0xa5, 0xc3, 0xff, 0xee, 0x25, 0xc9, 0x85, 0x56, 0x2b, 0x38, 0xc9, 0x51, 0xaa, 0x3a, 0x35,
-	0x37, 0xcc, 0xcb, 0x65, 0xd7, 0x09, 0x66, 0xdf, 0xe7, 0x96, 0x00, 0x82, 0x28, 0x06, 0x65, 0x61,
-	0x6c, 0xf5, 0xad, 0x7b, 0x8b, 0x58, 0xbd, 0xb3, 0x72, 0x7d, 0xe5, 0xad, 0x7b, 0x2b, 0x99, 0x58,
-	0xd0, 0xa4, 0x94, 0x6f, 0xdf, 0x5e, 0xc4, 0x6f, 0x67, 0x24, 0x84, 0x60, 0x9c, 0x37, 0x2d, 0xfe,
-	0xe9, 0xed, 0x45, 0xbc, 0x52, 0xbe, 0x91, 0x19, 0x50, 0x7e, 0x26, 0x7d, 0xf2, 0x2c, 0x2f, 0x7d,
-	0xfa, 0x2c, 0x2f, 0xfd, 0xfa, 0x59, 0x3e, 0xf6, 0x9b, 0x67, 0xf9, 0xd8, 0x6f, 0x9f, 0xe5, 0x63,
-	0xbf, 0x7b, 0x96, 0x8f, 0xfd, 0xfe, 0x59, 0x5e, 0xfa, 0xa0, 0x9d, 0x97, 0x7e, 0xd0, 0xce, 0xc7,
-	0x7e, 0xde, 0xce, 0x4b, 0xbf, 0x68, 0xe7, 0x63, 0x1f, 0xb7, 0xf3, 0xb1, 0x5f, 0xb5, 0xf3, 0xb1,
-	0x4f, 0xda, 0x79, 0xe9, 0xd3, 0x76, 0x5e, 0xfa, 0x75, 0x3b, 0x1f, 0xfb, 0x4d, 0x3b, 0x2f, 0xfd,
-	0xb6, 0x9d, 0x8f, 0xfd, 0xae, 0x9d, 0x97, 0x7e, 0xdf, 0xce, 0xc7, 0x3e, 0x78, 0x9e, 0x8f, 0xfd,
-	0xe0, 0x79, 0x5e, 0xfa, 0xf1, 0xf3, 0x7c, 0xec, 0x27, 0xcf, 0xf3, 0xd2, 0x47, 0xcf, 0xf3, 0xb1,
-	0x9f, 0x3f, 0xcf, 0xc7, 0x7e, 0xf1, 0x3c, 0x2f, 0x7d, 0xfc, 0x3c, 0x2f, 0xfd, 0xea, 0x79, 0x5e,
-	0xba, 0x7f, 0xe1, 0x08, 0x77, 0xa1, 0x6b, 0x36, 0xd7, 0xd6, 0x86, 0xd9, 0x21, 0xbc, 0xf4, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x99, 0x01, 0x9c, 0x09, 0x41, 0x00, 0x00,
+	// 5988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xd5, 0x3c, 0x4b, 0x6c, 0x1c, 0x57,
+	0x72, 0xea, 0xe1, 0x90, 0x33, 0xf3, 0x48, 0xce, 0xa7, 0xf9, 0x6b, 0x0e, 0x29, 0x52, 0x1a, 0x7d,
+	0x2c, 0xc9, 0x22, 0x65, 0x52, 0x92, 0xed, 0xb5, 0xd7, 0x91, 0xa7, 0xf9, 0xb1, 0xa9, 0x0f, 0xc5,
+	0x7d, 0xd4, 0x27, 0xd6, 0xc7, 0xed, 0xe6, 0x74, 0x93, 0x6a, 0x71, 0x38, 0x33, 0xdb, 0xdd, 0xc3,
+	0xcf, 0x7a, 0x0d, 0x38, 0x8b, 0x04, 0xfb, 0x49, 0x36, 0x70, 0x74, 0x5a, 0xec, 0x21, 0xf0, 0x65,
+	0x01, 0xe7, 0xb6, 0x87, 0x1c, 0x8c, 0x20, 0x40, 0x36, 0x87, 0x04, 0x46, 0x80, 0x20, 0x3e, 0xe4,
+	0xb0, 0x09, 0x60, 0x67, 0xd7, 0x8b, 0x00, 0x3e, 0x05, 0x7b, 0x5c, 0xf0, 0x10, 0xa4, 0xde, 0xaf,
+	0x3f, 0x33, 0x3d, 0xe4, 0x8c, 0x65, 0x1b, 0x8e, 0x80, 0xe1, 0xf4, 0xbc, 0x57, 0x55, 0xef, 0xbd,
+	0x7a, 0x55, 0xf5, 0xaa, 0xea, 0x55, 0x0b, 0x15, 0xca, 0x55, 0x5b, 0xdf, 0xd1, 0x2b, 0x53, 0x8e,
+	0xab, 0x97, 0x36, 0x2f, 0xe8, 0x35, 0xeb, 0x82, 0x59, 0x31, 0x34, 0xc3, 0xdc, 0xb6, 0x4a, 0xe6,
+	0x74, 0xcd, 0xae, 0xba, 0x55, 0x39, 0xed, 0xba, 0x95, 0x69, 0x0e, 0x37, 0xbd, 0x7d, 0x31, 0x5f,
+	0xdc, 0xb0, 0xdc, 0x47, 0xf5, 0xb5, 0xe9, 0x52, 0x75, 0x0b, 0x80, 0xb7, 0xab, 0x7b, 0x00, 0xb6,
+	0xbb, 0x77, 0x81, 0x02, 0x97, 0xa6, 0x36, 0xcc, 0xca, 0xd4, 0xb6, 0x5e, 0xb6, 0x0c, 0xdd, 0x35,
+	0x2f, 0x34, 0x3d, 0x30, 0x92, 0xf9, 0xa9, 0x00, 0x89, 0x8d, 0xea, 0x46, 0x95, 0x21, 0xaf, 0xd5,
+	0xd7, 0xe9, 0x2f, 0xfa, 0x83, 0x3e, 0x71, 0xf0, 0x89, 0x8d, 0x6a, 0x75, 0xa3, 0x6c, 0xfa, 0x50,
+	0x46, 0xdd, 0xd6, 0x5d, 0xab, 0x5a, 0xe1, 0xfd, 0xc7, 0x1a, 0xfb, 0xd7, 0x2d, 0xb3, 0x6c, 0x68,
+	0x5b, 0xba, 0xb3, 0xc9, 0x21, 0xc6, 0x1b, 0x21, 0x1c, 0xd7, 0xae, 0x97, 0x5c, 0xde, 0x3b, 0xd9,
+	0xd8, 0xeb, 0x5a, 0x5b, 0x26, 0x70, 0x64, 0xab, 0xd6, 0x6a, 0x02, 0x3b, 0xb6, 0x5e, 0xab, 0x99,
+	0xb6, 0xc3, 0xfb, 0x4f, 0x34, 0xb3, 0xd1, 0x32, 0xcc, 0x8a, 0x6b, 0xc1, 0x44, 0x3c, 0xa0, 0xf1,
+	0x66, 0xa0, 0x4d, 0x73, 0x4f, 0xf4, 0x4e, 0x36, 0xf7, 0x0a, 0x9e, 0xf3, 0x45, 0x36, 0x03, 0xc0,
+	0x24, 0x1d, 0x7d, 0xc3, 0x3c, 0x80, 0x44, 0xcd, 0x2a, 0xb9, 0x75, 0xdb, 0x3c, 0x88, 0x84, 0xab,
+	0xc3, 0xc6, 0xe8, 0x0c, 0xa2, 0xf0, 0x27, 0x71, 0x94, 0x58, 0x05, 0xaa, 0xc0, 0x5b, 0xf9, 0x1e,
+	0x4a, 0x82, 0x1c, 0x68, 0xba, 0x61, 0xd8, 0x4a, 0xec, 0x98, 0x74, 0xa6, 0x4f, 0xbd, 0xf2, 0xd1,
+	0xa7, 0x93, 0x47, 0xfe, 0xf3, 0xd3, 0xc9, 0x17, 0x60, 0x67, 0xdc, 0x47, 0xa6, 0xfb, 0xc8, 0xaa,
+	0x6c, 0x38, 0xd3, 0x15, 0xd3, 0xdd, 0xa9, 0xda, 0x9b, 0x17, 0xc2, 0xc4, 0xb7, 0x2f, 0x5e, 0xa8,
+	0x6d, 0x6e, 0x5c, 0x70, 0xf7, 0x6a, 0x30, 0xbf, 0x79, 0x73, 0xbb, 0x08, 0x64, 0x70, 0xc2, 0x60,
+	0x0f, 0x72, 0x11, 0xc5, 0xc9, 0xda, 0x95, 0x2e, 0xa0, 0xdb, 0x3b, 0x3b, 0x36, 0x1d, 0x16, 0xb1,
+	0x69, 0x3e, 0x85, 0x6b, 0x00, 0xa2, 0x66, 0xf7, 0xd5, 0xee, 0x9f, 0x48, 0xb1, 0xac, 0x44, 0x06,
+	0xff, 0xf8, 0xd3, 0x49, 0x09, 0x53, 0x54, 0xf9, 0x38, 0xea, 0x2f, 0xeb, 0x8e, 0xab, 0xad, 0x6b,
+	0xa5, 0x8a, 0xab, 0xd5, 0x6b, 0x4a, 0x1c, 0x68, 0xf5, 0x63, 0x44, 0x1a, 0x17, 0xe7, 0x2a, 0xee,
+	0xed, 0x9a, 0x7c, 0x06, 0xe5, 0x28, 0x48, 0x85, 0x03, 0x19, 0xd5, 0x9d, 0x8a, 0xd2, 0x4d, 0xc1,
+	0x28, 0xee, 0x32, 0x81, 0x9b, 0x87, 0x46, 0x0f, 0x52, 0x0f, 0x42, 0xf6, 0xf8, 0x90, 0x45, 0x0f,
+	0x72, 0x1a, 0x0d, 0x52, 0xc8, 0x52, 0xb5, 0xb2, 0x1e, 0x04, 0x4e, 0x50, 0xe0, 0x2c, 0xe9, 0x9b,
+	0x83, 0x2e, 0x0f, 0x7e, 0x0e, 0x21, 0x60, 0x88, 0xed, 0x9a, 0x86, 0xa6, 0xbb, 0x4a, 0x92, 0xae,
+	0x37, 0x3f, 0xcd, 0xe4, 0x69, 0x5a, 0xc8, 0xd3, 0xf4, 0x2d, 0x21, 0x70, 0x6a, 0x92, 0x2c, 0xf3,
+	0xbd, 0xff, 0x82, 0x65, 0xa6, 0x38, 0x5e, 0xd1, 0x95, 0x4d, 0x34, 0xfe, 0xdd, 0xba, 0x59, 0x27,
+	0x34, 0x6a, 0xb5, 0xb2, 0x55, 0xa2, 0xc2, 0x4f, 0xc7, 0x2d, 0x5b, 0x95, 0x4d, 0x47, 0x49, 0x1d,
+	0xeb, 0x02, 0xb2, 0x27, 0x1a, 0xd9, 0x58, 0xf4, 0x81, 0xe7, 0x39, 0x2c, 0xce, 0x33, 0x42, 0x11,
+	0x5d, 0xce, 0xd5, 0x78, 0x52, 0xca, 0xc6, 0x0a, 0xff, 0x9d, 0x45, 0xfd, 0x37, 0x8a, 0x73, 0x2b,
+	0xba, 0xad, 0x83, 0x74, 0x80, 0xfc, 0xca, 0xa7, 0x51, 0x72, 0x4b, 0xdf, 0xd5, 0x4c, 0xcb, 0xae,
+	0x29, 0x12, 0xac, 0x20, 0xa6, 0xf6, 0x7e, 0xf6, 0xe9, 0x64, 0xe2, 0x86, 0xbe, 0xbb, 0xb0, 0x84,
+	0x57, 0x70, 0x02, 0x3a, 0x17, 0xa0, 0x4f, 0x7e, 0x8c, 0x06, 0x74, 0xc3, 0xd6, 0x88, 0x3c, 0x69,
+	0xa0, 0xa0, 0xa6, 0x66, 0x55, 0x0c, 0x73, 0x97, 0x6e, 0x4c, 0x7a, 0xf6, 0x68, 0xe3, 0xec, 0xe6,
+	0x01, 0x0c, 0x03, 0xd4, 0x12, 0x01, 0x52, 0xc7, 0x61, 0x9b, 0x7f, 0x40, 0xb6, 0x19, 0x28, 0x67,
+	0x8b, 0xf3, 0x38, 0xd4, 0x8b, 0xb3, 0x40, 0x37, 0xd4, 0x22, 0xbf, 0x86, 0x64, 0x32, 0x96, 0xbb,
+	0xab, 0xd5, 0xaa, 0x3b, 0xa6, 0xcd, 0x87, 0xa2, 0x9b, 0xab, 0xe6, 0xf7, 0xd5, 0xf8, 0xb9, 0x98,
+	0x92, 0x01, 0x52, 0x19, 0x20, 0x75, 0x6b, 0x77, 0x85, 0x80, 0x30, 0x4a, 0x19, 0xc0, 0x0a, 0x36,
+	0xc8, 0x2f, 0xa0, 0x3e, 0x42, 0xa8, 0xb2, 0xa6, 0xb9, 0xb6, 0x5e, 0x71, 0xd8, 0xae, 0xab, 0x43,
+	0x3e, 0x09, 0x04, 0x24, 0x96, 0xd7, 0x6e, 0x91, 0x4e, 0x8c, 0x00, 0x94, 0x3f, 0xcb, 0x97, 0x51,
+	0x3f, 0x41, 0x04, 0x61, 0xd7, 0xca, 0xd6, 0x96, 0xe5, 0x32, 0x11, 0x50, 0x73, 0x80, 0xd2, 0x0b,
+	0x28, 0xc5, 0xd2, 0xe6, 0x75, 0xda, 0x2c, 0xe1, 0x5e, 0x80, 0x13, 0x3f, 0x83, 0x68, 0x86, 0x59,
+	0xd6, 0xf7, 0xa8, 0x4c, 0x84, 0xd0, 0xe6, 0x69, 0xb3, 0x87, 0x46, 0x7f, 0xca, 0x7f, 0x84, 0x52,
+	0xf6, 0xee, 0x0c, 0x47, 0x49, 0x51, 0x8e, 0x8e, 0x34, 0x72, 0x14, 0xef, 0x52, 0x58, 0x35, 0x29,
+	0x78, 0x89, 0x93, 0x80, 0xc3, 0xf0, 0x5f, 0x44, 0x83, 0x14, 0xdf, 0xdb, 0x9b, 0xea, 0xfa, 0xba,
+	0x63, 0xba, 0x0a, 0xa2, 0xa3, 0x27, 0xd8, 0x72, 0x13, 0x38, 0x47, 0x10, 0x38, 0xa3, 0x6f, 0x52,
+	0x08, 0xf9, 0x0e, 0x1a, 0xb0, 0x77, 0x67, 0x9b, 0x76, 0xb5, 0xb7, 0x9d, 0x5d, 0xf5, 0x67, 0x92,
+	0x05, 0x1a, 0xe1, 0x1d, 0x9c, 0x46, 0xfd, 0x84, 0xee, 0xba, 0x6d, 0x82, 0x48, 0x56, 0x4a, 0x7b,
+	0x4a, 0x1f, 0x50, 0x8c, 0xab, 0xa9, 0x7d, 0xb5, 0x67, 0x36, 0x7e, 0xe6, 0xfd, 0x9f, 0xf6, 0xe0,
+	0x3e, 0xe8, 0x5f, 0x14, 0xdd, 0xf2, 0x2a, 0x4a, 0x13, 0x29, 0x34, 0xea, 0xee, 0x9e, 0x56, 0xda,
+	0x2b, 0x95, 0x4d, 0xa5, 0x9f, 0x4e, 0xa1, 0x59, 0xec, 0x37, 0x36, 0x6c, 0x73, 0x03, 0xc6, 0x31,
+	0xe6, 0x01, 0x76, 0x8e, 0x80, 0x06, 0x26, 0xd2, 0x07, 0x44, 0xbc, 0x76, 0xd9, 0x40, 0x23, 0xb6,
+	0xf9, 0xb8, 0x6a, 0x55, 0x34, 0x62, 0xf3, 0x35, 0xb0, 0xe9, 0x56, 0xd5, 0xb0, 0x4a, 0x96, 0xbb,
+	0xa7, 0xa4, 0x29, 0xf5, 0x42, 0x13, 0x93, 0x29, 0x38, 0x51, 0xd8, 0x85, 0xdd, 0x5a, 0xb5, 0x02,
+	0x56, 0x3e, 0x40, 0x7c, 0xc8, 0xf6, 0x7a, 0x57, 0x7c, 0x52, 0xf2, 0x06, 0x52, 0xf8, 0x28, 0xa5,
+	0x6a, 0x1d, 0x2c, 0x46, 0x70, 0x98, 0x4c, 0xf4, 0x22, 0xd8, 0x30, 0x73, 0x04, 0x3c, 0x62, 0x9c,
+	0x61, 0xdb, 0xef, 0x0e, 0x0e, 0xf4, 0x32, 0x1a, 0xa8, 0x81, 0x51, 0xd6, 0x9c, 0x72, 0xd5, 0x0d,
+	0x70, 0x36, 0x4b, 0x39, 0xdb, 0xbb, 0xaf, 0x26, 0x67, 0x7b, 0x94, 0x23, 0x94, 0xb7, 0x39, 0x02,
+	0xb7, 0x0a, 0x60, 0x3e, 0x83, 0xef, 0xa3, 0x51, 0x1f, 0xb9, 0x71, 0xbb, 0x73, 0xed, 0x6c, 0x77,
+	0x0c, 0xa4, 0x76, 0x48, 0x10, 0x0e, 0xef, 0xf6, 0xf3, 0x28, 0xbb, 0x66, 0xea, 0x60, 0x35, 0x03,
+	0xd3, 0x92, 0x9b, 0xa7, 0x95, 0x61, 0x40, 0xfe, 0xa4, 0xae, 0xa1, 0x64, 0xe9, 0x91, 0x5e, 0xa9,
+	0x98, 0x65, 0x47, 0x19, 0xa0, 0x66, 0xee, 0x54, 0xe3, 0x1c, 0x42, 0xc6, 0x6a, 0x7a, 0x8e, 0x41,
+	0x53, 0x66, 0x3d, 0x91, 0x62, 0x49, 0x50, 0x02, 0x41, 0x40, 0x5e, 0x44, 0xb9, 0x7a, 0x8d, 0xd8,
+	0x3a, 0xcd, 0xd8, 0x31, 0xcb, 0x65, 0xba, 0xe7, 0xca, 0x60, 0x0b, 0x9b, 0xac, 0x56, 0xab, 0xe5,
+	0x3b, 0x7a, 0xb9, 0x6e, 0xe2, 0x0c, 0x43, 0x9a, 0x27, 0x38, 0x64, 0x6b, 0xe5, 0xab, 0x68, 0x40,
+	0x18, 0xdf, 0x20, 0xa5, 0xa1, 0x43, 0x29, 0xe5, 0x04, 0x9a, 0x4f, 0x6b, 0x1b, 0x0d, 0x87, 0xcc,
+	0x88, 0x66, 0xf2, 0xed, 0x56, 0x86, 0x29, 0xb9, 0x33, 0x4d, 0xe2, 0xed, 0xdb, 0x16, 0x21, 0x19,
+	0x94, 0xb8, 0x3a, 0x02, 0x26, 0x64, 0x20, 0xa2, 0x17, 0x0f, 0x04, 0xec, 0x8f, 0x68, 0x0c, 0x8e,
+	0x4b, 0x8d, 0x8a, 0x3f, 0xee, 0xc8, 0x41, 0xe3, 0x52, 0x6b, 0xd2, 0x72, 0xdc, 0x50, 0xaf, 0x18,
+	0x37, 0xd4, 0x08, 0xba, 0x30, 0xd9, 0x52, 0xca, 0xb4, 0x6d, 0x42, 0x50, 0x51, 0xe8, 0x04, 0x0a,
+	0x07, 0xca, 0x1a, 0xe3, 0x67, 0x3e, 0x52, 0xd8, 0x68, 0x1f, 0x18, 0xda, 0x6e, 0x9b, 0x5a, 0xcb,
+	0x51, 0x4a, 0x6e, 0xb2, 0x59, 0xc3, 0xa0, 0xd3, 0x17, 0x1c, 0xcc, 0xa0, 0xf3, 0xff, 0x1e, 0x43,
+	0x09, 0x2e, 0x43, 0xf2, 0x25, 0x94, 0xe5, 0xf2, 0xe2, 0x0b, 0xad, 0xd4, 0x68, 0xa5, 0xb8, 0x74,
+	0xf8, 0x22, 0xfb, 0x22, 0x92, 0x3d, 0xe9, 0xf0, 0xf1, 0x62, 0x8d, 0x78, 0x9e, 0x2c, 0xf8, 0x98,
+	0x60, 0x6a, 0xb7, 0xc0, 0x48, 0x34, 0xea, 0x5e, 0x57, 0x87, 0xa6, 0x16, 0x68, 0x84, 0x95, 0x8f,
+	0xd0, 0x25, 0xa6, 0xf3, 0x8b, 0x1c, 0xcc, 0x41, 0xba, 0x60, 0x39, 0x43, 0x74, 0x4f, 0xa0, 0x7e,
+	0xb3, 0xa2, 0xaf, 0x95, 0x4d, 0x8d, 0xf1, 0x80, 0x9e, 0xbf, 0x49, 0xdc, 0xc7, 0x1a, 0x6f, 0xd3,
+	0xb6, 0x97, 0xe2, 0x1f, 0xbe, 0x3f, 0x79, 0x84, 0xfd, 0x05, 0x0f, 0x23, 0x96, 0xed, 0x82, 0xbf,
+	0x5d, 0xd9, 0x78, 0xe1, 0x3f, 0x24, 0x34, 0x4a, 0xf7, 0x80, 0xc1, 0x2d, 0x56, 0xed, 0x1d, 0xdd,
+	0x36, 0x60, 0x3f, 0x71, 0x1d, 0x0c, 0xf3, 0x4b, 0xa8, 0x87, 0xaa, 0x83, 0x43, 0x19, 0xde, 0x1b,
+	0x65, 0x87, 0x01, 0x95, 0x23, 0x51, 0xc9, 0x76, 0x30, 0xc7, 0x80, 0x93, 0xa5, 0x8f, 0xfa, 0x68,
+	0x3b, 0xcc, 0x41, 0xa3, 0xac, 0xef, 0x57, 0xfb, 0x41, 0x4c, 0x53, 0xd7, 0xa1, 0xfd, 0x2e, 0x71,
+	0xce, 0x70, 0xaa, 0x2c, 0x1e, 0xe5, 0x15, 0x94, 0x62, 0x11, 0x8f, 0x66, 0x19, 0x94, 0xd9, 0x29,
+	0xf5, 0xe2, 0xbe, 0x7a, 0xd2, 0x2e, 0x28, 0x27, 0x67, 0x27, 0xde, 0xbc, 0xaf, 0x4f, 0x7d, 0xef,
+	0xb9, 0xa9, 0x6f, 0x3d, 0x3c, 0x73, 0xe5, 0xa5, 0xfb, 0x53, 0x0f, 0xaf, 0x88, 0x9f, 0x67, 0xdf,
+	0x9e, 0x3d, 0xff, 0xce, 0x49, 0xa0, 0x99, 0x9c, 0xa7, 0xb8, 0x4b, 0xf3, 0x38, 0xc9, 0xa8, 0x2c,
+	0x19, 0x85, 0x0f, 0x62, 0x68, 0x98, 0x4e, 0xf0, 0x2a, 0x58, 0x69, 0x4c, 0xb6, 0x17, 0x9c, 0x52,
+	0xab, 0x0c, 0x72, 0x06, 0x8e, 0x4b, 0x8f, 0x5e, 0x22, 0x7e, 0x17, 0x5d, 0x58, 0xba, 0xd9, 0x9c,
+	0xb1, 0x85, 0x51, 0xe0, 0xeb, 0x16, 0xb8, 0x9e, 0x14, 0x38, 0xb0, 0x0d, 0x1c, 0x5d, 0x2e, 0xa1,
+	0x24, 0x3d, 0x52, 0xcc, 0xba, 0xc5, 0xfd, 0xf3, 0xd7, 0xb9, 0x7f, 0x7e, 0xb9, 0x53, 0xff, 0x7c,
+	0xe1, 0xf6, 0xd2, 0xf3, 0x97, 0x88, 0x4b, 0x47, 0xa6, 0x0b, 0x3f, 0x70, 0x82, 0x50, 0x5e, 0xa8,
+	0x5b, 0xf2, 0x5b, 0x88, 0xf8, 0xec, 0x74, 0x8c, 0x2e, 0x3a, 0xc6, 0x6b, 0x4f, 0x3b, 0x46, 0x0f,
+	0xb0, 0x8b, 0x0c, 0xd1, 0x03, 0x74, 0x61, 0x84, 0xc2, 0xdf, 0xc4, 0xd1, 0xf0, 0xaa, 0x69, 0x6f,
+	0x93, 0x8d, 0x0f, 0x6b, 0xa4, 0xbc, 0x84, 0xd2, 0x8e, 0x09, 0xa7, 0x81, 0xa1, 0x71, 0x0b, 0x7e,
+	0xa0, 0x2c, 0xac, 0x52, 0x50, 0xae, 0xba, 0xb8, 0xdf, 0x09, 0xfe, 0x84, 0x83, 0x71, 0xc8, 0x30,
+	0xd7, 0xf5, 0x7a, 0xd9, 0x15, 0xb4, 0xb8, 0x0e, 0xc4, 0x82, 0xfe, 0x4f, 0x17, 0x1e, 0xe0, 0x50,
+	0x1c, 0x8f, 0x89, 0xf9, 0x23, 0x94, 0x29, 0xe9, 0x46, 0xe8, 0xd4, 0xee, 0x6a, 0x75, 0x6a, 0xc3,
+	0x44, 0xe6, 0x8a, 0xf3, 0x81, 0x33, 0x99, 0x78, 0xa3, 0x9e, 0x67, 0x9b, 0x0e, 0xf7, 0xe1, 0x34,
+	0xd0, 0x0d, 0x9e, 0xdf, 0x9b, 0x68, 0x44, 0x18, 0x1c, 0x4f, 0x1d, 0x34, 0x1b, 0xf4, 0xc1, 0x01,
+	0x65, 0x25, 0x87, 0xdf, 0xd9, 0xc8, 0x11, 0xa3, 0x34, 0x48, 0x1c, 0x80, 0xd9, 0x2c, 0x1e, 0xaa,
+	0x47, 0xf4, 0x3b, 0xf2, 0x75, 0x4f, 0xc5, 0xba, 0x29, 0x5b, 0x2f, 0x45, 0x1c, 0xac, 0x73, 0xd5,
+	0xad, 0x2d, 0xbd, 0x62, 0xf0, 0x85, 0x41, 0x60, 0x63, 0x6d, 0x40, 0x3c, 0xb9, 0xb8, 0xc3, 0x54,
+	0x0e, 0x24, 0xdb, 0x53, 0xba, 0x35, 0x34, 0x48, 0xc5, 0xd1, 0x66, 0xd2, 0xae, 0xad, 0x53, 0x09,
+	0x26, 0xfe, 0x34, 0x99, 0xf7, 0xe9, 0xc8, 0x79, 0x37, 0x69, 0x47, 0x60, 0xd2, 0xf2, 0xe3, 0xc6,
+	0x4e, 0xa7, 0xf0, 0x57, 0x5d, 0x68, 0x88, 0xc8, 0x8a, 0x69, 0x34, 0x8a, 0xca, 0xab, 0x28, 0xbe,
+	0x55, 0x35, 0x4c, 0xae, 0x53, 0xd1, 0x02, 0xb2, 0x50, 0x31, 0x98, 0xaa, 0xde, 0x00, 0xc8, 0x80,
+	0x42, 0x51, 0x4c, 0xf9, 0x21, 0x92, 0x9d, 0x2d, 0x08, 0xb8, 0x34, 0x6e, 0xd1, 0xca, 0xe6, 0x36,
+	0x08, 0x5c, 0xec, 0x00, 0x1d, 0x5d, 0x25, 0xe0, 0x0b, 0x14, 0xfa, 0x3a, 0x01, 0x0e, 0x9a, 0x4a,
+	0xa7, 0xa1, 0x0f, 0xc2, 0xd5, 0xc4, 0x1a, 0xe8, 0x04, 0x78, 0xdd, 0x54, 0x76, 0x3c, 0x91, 0xbb,
+	0x82, 0x45, 0x7b, 0x84, 0xb8, 0xc7, 0xbf, 0xa8, 0xb8, 0x5b, 0x28, 0xe7, 0x30, 0x9d, 0xd2, 0x7c,
+	0xcb, 0xd6, 0x4d, 0x2d, 0xdb, 0x2b, 0xfb, 0xea, 0x33, 0xf6, 0x29, 0xb0, 0x6c, 0xc7, 0x0f, 0xb6,
+	0x6c, 0xdf, 0x7f, 0x93, 0x18, 0xb7, 0x0c, 0x57, 0x4d, 0xcf, 0xc6, 0x65, 0x9c, 0x50, 0x83, 0x51,
+	0x78, 0x22, 0xa1, 0x4c, 0xf3, 0x6e, 0x24, 0x38, 0x18, 0xd7, 0xd8, 0xd3, 0xcd, 0x11, 0x7e, 0x94,
+	0xc6, 0x63, 0x81, 0x26, 0xbf, 0x82, 0x7a, 0x1c, 0xba, 0xd1, 0x74, 0x07, 0x22, 0x9c, 0xbe, 0x48,
+	0x31, 0xc0, 0x1c, 0xa9, 0xf0, 0x6f, 0x31, 0x34, 0xe6, 0x6d, 0xf7, 0x1d, 0xe8, 0x01, 0x83, 0xb9,
+	0xe4, 0x67, 0x64, 0xe4, 0x1b, 0x28, 0xb9, 0x06, 0x41, 0x9c, 0x41, 0xd8, 0x22, 0x51, 0xb6, 0xcc,
+	0xb6, 0x6f, 0xf0, 0x13, 0x2a, 0x41, 0x05, 0x5e, 0x24, 0x28, 0x8d, 0x25, 0x83, 0x90, 0x23, 0x32,
+	0x54, 0x26, 0xe4, 0x62, 0x1d, 0x93, 0x23, 0xe2, 0x58, 0x26, 0xe4, 0x28, 0x0d, 0x20, 0x37, 0x8b,
+	0xb2, 0x8f, 0x40, 0x51, 0x41, 0x59, 0x4d, 0x6d, 0x9b, 0x4d, 0x9e, 0x1f, 0x4b, 0x44, 0x68, 0xec,
+	0x98, 0x72, 0x0c, 0x67, 0x04, 0x00, 0x5f, 0x1c, 0xc1, 0x59, 0xb7, 0xec, 0xad, 0x10, 0x4e, 0xbc,
+	0x01, 0x47, 0x00, 0x08, 0x9c, 0x73, 0x44, 0x26, 0x19, 0x13, 0x98, 0x6c, 0xe4, 0x38, 0x28, 0x31,
+	0xd3, 0x2a, 0x5b, 0x63, 0xcf, 0x1a, 0x5d, 0x62, 0xe1, 0xd3, 0x1e, 0x94, 0x6d, 0xe4, 0xa8, 0x7c,
+	0x13, 0x75, 0x59, 0x86, 0x38, 0xa1, 0x9f, 0x6d, 0xdc, 0xa2, 0x03, 0x36, 0x20, 0x22, 0xab, 0x43,
+	0x28, 0xc9, 0x1a, 0xca, 0x70, 0x02, 0xde, 0x22, 0x98, 0x06, 0xe6, 0x23, 0x6c, 0x13, 0x27, 0x1b,
+	0x36, 0xb0, 0xd7, 0xab, 0x58, 0xbf, 0x5b, 0x5c, 0xe6, 0x7d, 0x38, 0xcd, 0x51, 0xc4, 0x8c, 0x2d,
+	0x34, 0x20, 0x06, 0xa8, 0x3d, 0xda, 0x0b, 0x71, 0x37, 0x62, 0x90, 0x95, 0xd7, 0xdf, 0x10, 0x83,
+	0x1c, 0x0d, 0x0c, 0x92, 0xe3, 0x83, 0xf8, 0xdd, 0x38, 0xc7, 0xb1, 0x56, 0x1e, 0xed, 0x89, 0xa1,
+	0x20, 0xd8, 0xf0, 0xbc, 0x3f, 0xad, 0x56, 0x86, 0x11, 0x81, 0xcf, 0x6c, 0x4b, 0xf2, 0x8c, 0xcf,
+	0xaf, 0x12, 0x05, 0xf3, 0xbc, 0xbf, 0x15, 0x00, 0x21, 0x0a, 0xb6, 0x1e, 0x6a, 0x30, 0xe4, 0x63,
+	0xa8, 0xa7, 0xf6, 0x08, 0x22, 0x09, 0x62, 0xa6, 0xbb, 0x00, 0x59, 0x98, 0x48, 0x84, 0x79, 0xbb,
+	0x7c, 0x06, 0x65, 0x9d, 0x7a, 0xad, 0x56, 0xb5, 0x5d, 0x47, 0x2b, 0x81, 0x5b, 0xe3, 0x68, 0x6b,
+	0x34, 0x8d, 0x91, 0xc4, 0x69, 0xd1, 0x3e, 0x47, 0x9a, 0xd5, 0x08, 0xc8, 0x12, 0x4d, 0x5b, 0x34,
+	0x42, 0xce, 0xc9, 0x26, 0x1a, 0x14, 0x07, 0xe6, 0x96, 0x5e, 0xd2, 0x1c, 0xd3, 0x75, 0xc9, 0x49,
+	0xcf, 0x33, 0x58, 0x63, 0x11, 0xdb, 0xb1, 0xca, 0x41, 0xd4, 0x61, 0x58, 0x96, 0x3c, 0xcf, 0x90,
+	0x03, 0xed, 0x58, 0xe6, 0x04, 0x6f, 0xe8, 0x25, 0xd1, 0x46, 0x3c, 0x48, 0xe2, 0xf1, 0xfa, 0x6e,
+	0x32, 0x49, 0x6d, 0xc4, 0x21, 0x48, 0xb7, 0x02, 0x31, 0x20, 0x01, 0x02, 0xf7, 0xd5, 0x07, 0x42,
+	0x1c, 0x48, 0xdf, 0x0d, 0x01, 0x79, 0x4b, 0x23, 0x47, 0x07, 0x4d, 0x50, 0x80, 0x2f, 0x2a, 0x1a,
+	0xc9, 0x59, 0x23, 0x9f, 0x47, 0xb2, 0x6d, 0xc2, 0x5a, 0x18, 0x88, 0x56, 0xa9, 0x56, 0x4a, 0x70,
+	0xb4, 0xf6, 0x51, 0xc8, 0x2c, 0xeb, 0x21, 0x70, 0xcb, 0xb4, 0x1d, 0x78, 0x20, 0xa6, 0x4c, 0x8e,
+	0xe3, 0x2d, 0xdd, 0xa5, 0x07, 0x5a, 0x7f, 0x74, 0x78, 0x74, 0x83, 0x25, 0x63, 0x57, 0xf4, 0xbd,
+	0x72, 0x55, 0x37, 0x16, 0x3d, 0x78, 0xb5, 0x2f, 0x28, 0xea, 0xe0, 0xf5, 0x33, 0x8a, 0x3e, 0x00,
+	0x73, 0x8d, 0x0b, 0xff, 0x38, 0x8c, 0x7a, 0x03, 0xdc, 0x02, 0x3f, 0x31, 0xc3, 0xf7, 0x92, 0x06,
+	0x97, 0xd5, 0xba, 0xcb, 0xf5, 0x6c, 0xb4, 0x29, 0xbe, 0x9c, 0xe7, 0xe9, 0x70, 0x35, 0xfe, 0x33,
+	0x92, 0x38, 0xec, 0xa7, 0x78, 0xea, 0x2d, 0x86, 0x25, 0xdf, 0x45, 0x43, 0x7e, 0xc0, 0x15, 0xf4,
+	0x61, 0x98, 0x65, 0x6d, 0xf2, 0x61, 0x56, 0x78, 0x48, 0xc5, 0xfc, 0x12, 0x16, 0x67, 0x0d, 0xd4,
+	0x42, 0x8d, 0xcc, 0x59, 0x79, 0x70, 0x50, 0xbe, 0xa0, 0xab, 0xed, 0x18, 0xae, 0x45, 0xc2, 0xe0,
+	0x6e, 0x74, 0x2a, 0x83, 0x1d, 0x89, 0xe3, 0x4d, 0x3c, 0xb8, 0xbd, 0x54, 0x71, 0x9f, 0xbf, 0xc4,
+	0x02, 0xd2, 0x60, 0x90, 0xd5, 0x9c, 0xe6, 0xc0, 0x11, 0x99, 0x88, 0xd1, 0xce, 0xa8, 0x36, 0x65,
+	0x29, 0xbc, 0xcd, 0x2a, 0x79, 0x9b, 0xd5, 0xdd, 0xc9, 0x66, 0xcd, 0x89, 0xcd, 0xfa, 0x56, 0x30,
+	0xcd, 0xd7, 0xc3, 0x67, 0x15, 0x9d, 0xe6, 0x63, 0xdc, 0xf3, 0x33, 0x7c, 0x77, 0x5a, 0x64, 0xf8,
+	0x12, 0x07, 0xac, 0xed, 0xe2, 0x2c, 0x5b, 0xdb, 0x41, 0xf9, 0xbf, 0xef, 0x44, 0xe7, 0xff, 0x92,
+	0x6d, 0x6f, 0x70, 0x73, 0xea, 0xef, 0x7a, 0x63, 0xea, 0x2f, 0xd5, 0x19, 0xff, 0xc3, 0x89, 0xc1,
+	0x45, 0x94, 0x5f, 0x87, 0x98, 0xa8, 0x6a, 0x83, 0x99, 0xa5, 0x3a, 0xec, 0x11, 0xb6, 0x40, 0xb9,
+	0x11, 0x18, 0xcd, 0xb8, 0x67, 0x34, 0xdf, 0xc2, 0x0a, 0x87, 0x5d, 0xa1, 0xa0, 0x8b, 0x3e, 0xa4,
+	0xbc, 0xdc, 0x94, 0x60, 0xec, 0x6d, 0x91, 0x09, 0x69, 0x4e, 0x30, 0xb2, 0x95, 0x86, 0x73, 0x8b,
+	0x25, 0x34, 0xe4, 0x59, 0xa4, 0x8b, 0xb3, 0xda, 0x9a, 0xc5, 0x2f, 0x2b, 0xa8, 0xbd, 0x39, 0x30,
+	0x4f, 0xa4, 0x0e, 0x91, 0x53, 0x66, 0x95, 0x23, 0x5f, 0x9c, 0x55, 0x2d, 0x7a, 0xa5, 0x81, 0x73,
+	0x4e, 0x63, 0x93, 0x7c, 0x05, 0x25, 0xea, 0x8e, 0xa9, 0xe9, 0x86, 0xcd, 0x0d, 0xd3, 0x41, 0x64,
+	0x11, 0x39, 0xd8, 0x6f, 0x3b, 0x66, 0x71, 0x1e, 0xe3, 0x1e, 0x40, 0x2b, 0x1a, 0x36, 0x78, 0x9d,
+	0x24, 0xa9, 0x0d, 0x46, 0xde, 0xde, 0x00, 0xa3, 0x99, 0xe6, 0xe6, 0xbd, 0x91, 0xc6, 0x22, 0x18,
+	0x35, 0x9e, 0xee, 0xa1, 0x71, 0x34, 0x50, 0xb8, 0x41, 0x31, 0x70, 0x0a, 0xb0, 0xd9, 0xa3, 0xfc,
+	0x6d, 0xd4, 0xc7, 0xad, 0x2b, 0x5b, 0x67, 0xe6, 0xd0, 0x7c, 0x18, 0x62, 0xf0, 0x74, 0x25, 0x77,
+	0xd1, 0x08, 0x84, 0x8d, 0x6e, 0xdd, 0x69, 0x4e, 0xc5, 0x66, 0xdb, 0xd3, 0xa5, 0x21, 0x86, 0xdf,
+	0x98, 0x7d, 0xbd, 0x83, 0x14, 0x4e, 0xb8, 0x39, 0xfb, 0x9a, 0x3b, 0x5c, 0x39, 0xf0, 0x30, 0xc3,
+	0x6e, 0x4a, 0xb6, 0xbe, 0x8e, 0xc0, 0x98, 0x3b, 0x96, 0x6d, 0x1a, 0x9a, 0xaf, 0xb3, 0x72, 0x1b,
+	0x3a, 0x9b, 0xe1, 0x68, 0x58, 0xa8, 0xee, 0x03, 0x34, 0x1e, 0xa2, 0xd4, 0xa8, 0xc2, 0x03, 0x6d,
+	0xcc, 0x52, 0x09, 0x10, 0x0d, 0x2b, 0xf0, 0x5b, 0x68, 0xcc, 0xa7, 0xde, 0xac, 0xc8, 0x83, 0x6d,
+	0x2b, 0xf2, 0x88, 0x37, 0x44, 0x83, 0x3e, 0xdf, 0x27, 0xd1, 0xb5, 0x3f, 0x82, 0xaf, 0xd7, 0x43,
+	0x9d, 0xe9, 0xf5, 0x80, 0x3f, 0x80, 0xaf, 0xde, 0x0f, 0xd1, 0xb0, 0x20, 0xde, 0xa0, 0x9e, 0xc3,
+	0x1d, 0xaa, 0xa7, 0x20, 0x7f, 0x23, 0xa8, 0xa5, 0x7f, 0x21, 0xa1, 0x09, 0x41, 0xbf, 0x45, 0x22,
+	0x76, 0xa4, 0xc3, 0x44, 0xec, 0x04, 0x68, 0x48, 0x7e, 0x9e, 0xd1, 0x8c, 0xca, 0xc7, 0xe6, 0xf9,
+	0x78, 0xc5, 0x88, 0xb4, 0x6c, 0xd4, 0x74, 0x1a, 0xf2, 0xb3, 0x4a, 0x87, 0xf9, 0xd9, 0xe6, 0xe9,
+	0x84, 0xd3, 0xb4, 0xe1, 0xe9, 0x84, 0xb3, 0xb5, 0x9b, 0xe8, 0xb8, 0x98, 0x4d, 0xeb, 0xb3, 0x7e,
+	0xac, 0x6d, 0x09, 0x12, 0x62, 0xbe, 0x12, 0x79, 0xe4, 0xaf, 0xfb, 0x82, 0x1a, 0x75, 0xf4, 0x8f,
+	0x77, 0x26, 0x4c, 0x4a, 0xc3, 0x58, 0xbe, 0x44, 0xe9, 0x48, 0xf4, 0x69, 0x4d, 0x9e, 0xc0, 0xd1,
+	0xce, 0x06, 0x11, 0xa2, 0xa9, 0x36, 0x38, 0x04, 0xab, 0xfc, 0x96, 0xaf, 0xbc, 0x51, 0xb5, 0x2d,
+	0xf7, 0xd1, 0x96, 0x32, 0xd1, 0x82, 0xee, 0xaa, 0x6b, 0xc3, 0xfc, 0x18, 0xdd, 0x2c, 0x6c, 0x54,
+	0x1f, 0xd9, 0x21, 0x81, 0x85, 0xc9, 0xd5, 0xa4, 0xf7, 0x4b, 0xfe, 0xa9, 0x84, 0x94, 0x10, 0x55,
+	0xad, 0xe6, 0x85, 0xbe, 0xca, 0x24, 0xcd, 0xb3, 0xbc, 0x70, 0x80, 0x63, 0x3e, 0x5d, 0x0c, 0x10,
+	0xf3, 0x83, 0xe6, 0x85, 0x8a, 0x6b, 0xef, 0xa9, 0x79, 0x18, 0x7b, 0x38, 0x38, 0x76, 0x20, 0xaa,
+	0x1e, 0xd6, 0x23, 0x11, 0xe5, 0x79, 0xd4, 0xef, 0xa9, 0x3d, 0x35, 0x7e, 0xc7, 0xda, 0xcb, 0xb4,
+	0xf7, 0x09, 0x35, 0xa7, 0x09, 0xf7, 0x25, 0x34, 0x76, 0xc0, 0xc4, 0xe4, 0x2c, 0xea, 0xda, 0x34,
+	0x59, 0xda, 0x3d, 0x85, 0xc9, 0xa3, 0x3c, 0x88, 0xba, 0xd9, 0x3d, 0x01, 0x71, 0x60, 0x63, 0x98,
+	0xfd, 0x78, 0x29, 0xf6, 0xa2, 0x54, 0xf8, 0xe4, 0x28, 0x4a, 0x92, 0x05, 0x83, 0xbd, 0x36, 0xe5,
+	0x7b, 0x48, 0x2e, 0xd5, 0x6d, 0xdb, 0x24, 0x16, 0xdf, 0x67, 0x13, 0xf3, 0xa1, 0x8f, 0x1e, 0x78,
+	0x87, 0xd4, 0xe8, 0xb2, 0x73, 0x32, 0x81, 0x95, 0xdf, 0x23, 0x91, 0x01, 0x97, 0x54, 0x9f, 0x76,
+	0xec, 0x0b, 0xd0, 0x16, 0x42, 0xea, 0xd3, 0x56, 0x51, 0x1f, 0xcf, 0xd9, 0x50, 0xd7, 0x90, 0xc7,
+	0xa6, 0x43, 0x8d, 0x54, 0x59, 0x44, 0xe7, 0xa7, 0x9c, 0x7a, 0x19, 0x12, 0x6d, 0x8e, 0x8a, 0xa3,
+	0xe3, 0x5f, 0x6a, 0x1c, 0xfd, 0x10, 0xe5, 0xbd, 0x32, 0x08, 0xcb, 0xde, 0x02, 0x3e, 0x78, 0x57,
+	0x1e, 0xba, 0xf0, 0x7d, 0x0f, 0x2a, 0x73, 0x88, 0xd3, 0x12, 0x87, 0x11, 0x51, 0x2e, 0x41, 0x49,
+	0x88, 0x42, 0x84, 0x22, 0xb9, 0x24, 0x57, 0x28, 0x79, 0x92, 0x7b, 0xe6, 0x67, 0xb7, 0x57, 0xe7,
+	0xc1, 0xca, 0x32, 0x06, 0x48, 0xff, 0xbc, 0xb9, 0xbd, 0x4a, 0x7b, 0x79, 0xc1, 0x47, 0xcb, 0x50,
+	0x27, 0xf1, 0x94, 0xa1, 0x8e, 0x89, 0xc6, 0x6b, 0x66, 0x85, 0x66, 0x63, 0xa3, 0x2a, 0x30, 0xb8,
+	0x33, 0xdc, 0x5e, 0x01, 0x06, 0x27, 0x14, 0xd1, 0x27, 0x2f, 0xa0, 0x2c, 0xaf, 0xf3, 0x00, 0xbf,
+	0x08, 0x2c, 0xb0, 0x63, 0x8a, 0xda, 0x8e, 0x7c, 0xeb, 0xdc, 0x2c, 0xce, 0x30, 0x1c, 0x2c, 0x50,
+	0x08, 0x19, 0x31, 0x5b, 0x9e, 0x8d, 0x65, 0x6e, 0xf0, 0x21, 0x64, 0x38, 0x0e, 0xcf, 0xb9, 0x3a,
+	0xe0, 0xf8, 0xcb, 0x7c, 0x36, 0x34, 0x58, 0xd6, 0x4b, 0x25, 0xb3, 0xe6, 0x72, 0x9f, 0xf8, 0x44,
+	0x94, 0x9d, 0x21, 0x6a, 0x37, 0x4d, 0xe2, 0xe7, 0x22, 0x05, 0xc5, 0x7c, 0x31, 0x7e, 0x0b, 0x89,
+	0x51, 0xc4, 0xcc, 0x82, 0xc9, 0x62, 0xee, 0x11, 0x9f, 0x3c, 0x90, 0x28, 0x9f, 0x17, 0x96, 0x39,
+	0x85, 0x40, 0x9b, 0xfc, 0x1c, 0x89, 0x7d, 0xb4, 0x1d, 0x38, 0x8e, 0xaa, 0x3b, 0x8e, 0xa6, 0x6f,
+	0xeb, 0x56, 0x99, 0xa4, 0x5e, 0xa9, 0x4b, 0x9c, 0xc4, 0xb2, 0xbd, 0x7b, 0x97, 0x75, 0x15, 0x45,
+	0x0f, 0xd8, 0xae, 0xb4, 0x6d, 0x96, 0x4c, 0x2a, 0x52, 0xac, 0x88, 0x26, 0x4d, 0x39, 0xd4, 0xa4,
+	0xbd, 0x2c, 0xb7, 0xce, 0xa3, 0x7b, 0xdc, 0xcf, 0x90, 0x58, 0xa3, 0x23, 0x5f, 0x45, 0x59, 0x4e,
+	0xc5, 0x2f, 0xc6, 0xc9, 0x50, 0x3a, 0x4d, 0x46, 0x50, 0x6c, 0xb2, 0xa0, 0x94, 0x61, 0x88, 0x5e,
+	0xf5, 0x8d, 0x5c, 0x46, 0x05, 0x56, 0xad, 0xc4, 0xee, 0x52, 0xe0, 0x6c, 0xb5, 0x5c, 0x8b, 0x78,
+	0x31, 0x21, 0xd5, 0xca, 0xb6, 0xa9, 0x5a, 0x13, 0xb4, 0xc0, 0x89, 0x91, 0x5a, 0x12, 0x94, 0x02,
+	0x1a, 0xf6, 0x1e, 0xf8, 0x19, 0xb6, 0xf9, 0xd8, 0x2c, 0xb9, 0xdc, 0xd1, 0x68, 0x38, 0xd4, 0x41,
+	0xf2, 0x72, 0xb0, 0x90, 0x43, 0xaf, 0x07, 0xa7, 0xf6, 0xd5, 0xbe, 0x27, 0x52, 0x2a, 0x9b, 0x29,
+	0x78, 0xb6, 0x23, 0x8f, 0x39, 0xdd, 0xc6, 0x32, 0x1e, 0xd3, 0xc1, 0x79, 0x31, 0x66, 0xb1, 0xa1,
+	0xa0, 0x07, 0xc4, 0x76, 0x0b, 0x1d, 0x0d, 0xcd, 0x28, 0x5c, 0xdb, 0x03, 0x13, 0x92, 0x61, 0x42,
+	0xfd, 0xea, 0xb3, 0xfb, 0x6a, 0xef, 0x13, 0x29, 0x09, 0x23, 0x8a, 0x0a, 0x9d, 0xd1, 0xc0, 0x80,
+	0xc1, 0xda, 0x1e, 0x18, 0x6f, 0x34, 0x30, 0x5e, 0xb8, 0x4b, 0x2e, 0x82, 0xcc, 0x88, 0xe1, 0x82,
+	0x01, 0xe3, 0x00, 0x0d, 0x18, 0xd3, 0x6c, 0x94, 0x82, 0xe7, 0x9a, 0x0a, 0xd8, 0x60, 0xc4, 0x08,
+	0xdb, 0xcf, 0xcc, 0x54, 0x60, 0x83, 0x06, 0xdb, 0xdc, 0xa0, 0x34, 0x35, 0x60, 0xfe, 0x86, 0x54,
+	0x91, 0x37, 0xd7, 0xc0, 0x5e, 0xd8, 0x7a, 0x65, 0x03, 0xe6, 0x34, 0x44, 0x65, 0xea, 0x52, 0x4b,
+	0xfd, 0x10, 0x0c, 0x10, 0x2c, 0xc5, 0x14, 0x8d, 0x1e, 0xa0, 0xb4, 0x56, 0x24, 0xa2, 0x13, 0xc2,
+	0x5d, 0x66, 0x63, 0x09, 0xab, 0xc9, 0x85, 0xc3, 0x86, 0xe9, 0xdb, 0xd8, 0x61, 0x7a, 0x45, 0x41,
+	0x2f, 0xf6, 0xc9, 0x8d, 0x29, 0xf0, 0x75, 0x8e, 0x42, 0x30, 0x3b, 0xcb, 0x8c, 0x2f, 0x70, 0x34,
+	0xd8, 0x08, 0x41, 0xcc, 0x20, 0xe5, 0xc1, 0x77, 0xeb, 0x7a, 0x19, 0x6c, 0xa6, 0xa7, 0x57, 0x23,
+	0x74, 0xee, 0xe7, 0x5a, 0xce, 0x9d, 0xe9, 0xd2, 0xcd, 0x35, 0x92, 0xba, 0xa7, 0xe6, 0x10, 0xcb,
+	0xa4, 0xe1, 0x3b, 0x8c, 0x8c, 0xd0, 0xb4, 0x35, 0x34, 0x1c, 0xa2, 0xee, 0xeb, 0x9b, 0x42, 0xe9,
+	0x9f, 0x6f, 0x49, 0x5f, 0xf0, 0x38, 0x38, 0xc2, 0x60, 0x60, 0x04, 0x4f, 0x03, 0xf3, 0x3f, 0x8e,
+	0xa1, 0xde, 0xa0, 0x55, 0xb9, 0x8d, 0xbc, 0x3b, 0x7a, 0x3f, 0x01, 0xda, 0xc3, 0xf7, 0xb7, 0x51,
+	0x2b, 0xae, 0x7b, 0xf9, 0xcf, 0xf0, 0x09, 0x9f, 0x15, 0x24, 0xbc, 0xcc, 0xde, 0xb7, 0x51, 0x12,
+	0x8c, 0x15, 0x0b, 0x17, 0x13, 0xed, 0x56, 0x72, 0x25, 0x6c, 0xd6, 0x24, 0xbf, 0x8c, 0x12, 0xa5,
+	0x75, 0x88, 0x50, 0x1c, 0x51, 0x4d, 0x38, 0xdc, 0xe4, 0x19, 0x2c, 0x92, 0x7b, 0x63, 0x16, 0xec,
+	0xb3, 0x67, 0xdc, 0x53, 0x5a, 0x27, 0xdf, 0xac, 0xc2, 0x2f, 0x78, 0x0b, 0x0f, 0x7f, 0xe3, 0xd9,
+	0x6e, 0xf8, 0xdb, 0x9d, 0xed, 0x81, 0xbf, 0xa9, 0x2c, 0x82, 0xbf, 0x28, 0xdb, 0x9b, 0xff, 0xbb,
+	0x2e, 0x84, 0x02, 0x86, 0xfb, 0x04, 0x4a, 0xd4, 0x58, 0x66, 0x93, 0x7a, 0x50, 0x7d, 0xd4, 0x07,
+	0xfe, 0x5e, 0x3c, 0x9b, 0x53, 0x8e, 0x63, 0xd1, 0x03, 0x36, 0x35, 0x21, 0x0c, 0x7a, 0xac, 0x7d,
+	0x83, 0xae, 0xc6, 0x29, 0x9f, 0x04, 0xaa, 0xfc, 0x4a, 0xfb, 0xb5, 0xa1, 0x61, 0x4e, 0xb3, 0xba,
+	0x50, 0x92, 0x8a, 0xab, 0xda, 0xc4, 0x1f, 0xa5, 0x47, 0x34, 0xb9, 0x9f, 0x88, 0xd3, 0xbc, 0xf9,
+	0xc4, 0xbe, 0x9a, 0x7a, 0x22, 0xf5, 0x14, 0x48, 0xe6, 0xdd, 0xa0, 0x77, 0xb1, 0x3e, 0xd8, 0xd2,
+	0xbc, 0x83, 0xd3, 0x01, 0xb4, 0x25, 0xc3, 0x09, 0xd5, 0xbf, 0x76, 0x7f, 0xc9, 0xf5, 0xaf, 0x0f,
+	0x51, 0x0f, 0xa0, 0x91, 0x0b, 0x81, 0x1e, 0x4a, 0x79, 0xf1, 0x8b, 0xde, 0xaa, 0x83, 0x85, 0x5f,
+	0x9a, 0x87, 0xc5, 0x74, 0xd3, 0x07, 0xdc, 0x0d, 0x28, 0x4b, 0x46, 0xfe, 0x1f, 0x24, 0xd4, 0x1f,
+	0xd2, 0xf6, 0x56, 0x95, 0x25, 0xd2, 0x57, 0x54, 0x59, 0x12, 0x7b, 0xca, 0xca, 0x92, 0xfc, 0x3d,
+	0x94, 0x6e, 0x30, 0x57, 0xaf, 0xa3, 0x1e, 0x6e, 0x0c, 0xa5, 0xe8, 0x1b, 0x65, 0x5f, 0xe1, 0x83,
+	0x88, 0x81, 0x3a, 0x30, 0x8e, 0x9f, 0xb7, 0xd1, 0xd8, 0x01, 0xf6, 0x32, 0x18, 0x70, 0xc4, 0x59,
+	0xc0, 0xf1, 0x4a, 0x30, 0xe0, 0xe8, 0x9d, 0x7d, 0xa6, 0xbd, 0x91, 0x9d, 0x40, 0x64, 0x92, 0xff,
+	0x24, 0x86, 0x72, 0x4d, 0x86, 0x4e, 0x1e, 0x40, 0xdd, 0x2c, 0x53, 0x26, 0x51, 0x9f, 0x36, 0xbe,
+	0x4e, 0xf2, 0x60, 0x0b, 0xa8, 0x97, 0xb8, 0x06, 0xd6, 0x36, 0x2b, 0x19, 0x8e, 0x75, 0x50, 0x32,
+	0x8c, 0x04, 0x22, 0x9c, 0x27, 0x37, 0x51, 0xe6, 0x29, 0xeb, 0x88, 0xfa, 0x8d, 0xd0, 0x56, 0x8f,
+	0xa2, 0x2e, 0xa7, 0x62, 0xd3, 0x38, 0x22, 0xa6, 0x26, 0x40, 0xe6, 0xba, 0x56, 0x97, 0x31, 0x26,
+	0x6d, 0xf2, 0x38, 0x8a, 0xdb, 0xa0, 0x95, 0x54, 0x4d, 0x62, 0x6a, 0x12, 0xfa, 0xe2, 0x78, 0x75,
+	0x75, 0x09, 0xd3, 0x56, 0x72, 0x33, 0x43, 0xb2, 0x31, 0x3b, 0xfa, 0x1e, 0x4b, 0xc0, 0x71, 0x0f,
+	0xbe, 0x8f, 0x37, 0xd2, 0xbc, 0x1a, 0xf0, 0x38, 0xa1, 0x5b, 0x36, 0x2d, 0xa3, 0x4b, 0x1c, 0x96,
+	0xed, 0xa3, 0x0b, 0xa6, 0x19, 0x3f, 0x81, 0x93, 0xff, 0x44, 0x42, 0x03, 0x11, 0x86, 0x9e, 0x30,
+	0x93, 0x96, 0xf5, 0x92, 0x7c, 0x8e, 0x2e, 0x6e, 0x50, 0xda, 0x64, 0xa6, 0x40, 0x04, 0x66, 0x8e,
+	0xa3, 0x94, 0x17, 0xe9, 0xd0, 0x1d, 0x49, 0x62, 0xbf, 0x41, 0x2e, 0xa0, 0x3e, 0x50, 0xcc, 0x4a,
+	0x75, 0xa7, 0x6c, 0x1a, 0x1b, 0x26, 0x2b, 0x21, 0x4a, 0xe2, 0x50, 0x5b, 0x70, 0x7d, 0xf1, 0xce,
+	0xd7, 0xc7, 0xef, 0x88, 0xfe, 0x45, 0x0a, 0x5c, 0x6b, 0x17, 0xeb, 0x60, 0x29, 0x2a, 0x2e, 0x8f,
+	0x21, 0xe6, 0x48, 0x0d, 0xc3, 0x94, 0x10, 0x54, 0x76, 0xa7, 0x3d, 0xb2, 0xaf, 0x0e, 0xda, 0xf2,
+	0x6c, 0xf6, 0xcd, 0xfb, 0xc5, 0xa9, 0x7b, 0xe4, 0xce, 0xf9, 0xed, 0x99, 0xf3, 0x17, 0x67, 0xdf,
+	0x39, 0xc9, 0x05, 0x53, 0xbe, 0x82, 0x10, 0x7d, 0x31, 0x03, 0xdc, 0x9f, 0xea, 0x56, 0x1b, 0x82,
+	0xc6, 0x1c, 0x97, 0x14, 0xc5, 0x59, 0x04, 0x14, 0x38, 0x8b, 0x92, 0x8c, 0x80, 0x5b, 0xe5, 0xe6,
+	0xfa, 0x70, 0xf4, 0x04, 0xc5, 0xb8, 0x55, 0x2d, 0xfc, 0xf9, 0x31, 0x94, 0xf2, 0x16, 0x03, 0xea,
+	0x1d, 0xb8, 0x4a, 0x3e, 0xd9, 0xf2, 0x2a, 0xb9, 0x8d, 0x3b, 0xe4, 0x39, 0x84, 0x4a, 0xb6, 0xa9,
+	0xbb, 0x9d, 0xab, 0x4f, 0x8a, 0xe3, 0xc1, 0x86, 0x03, 0x91, 0x7a, 0xcd, 0x10, 0x44, 0xba, 0x3a,
+	0x21, 0xc2, 0xf1, 0x80, 0xc8, 0x18, 0x8a, 0x57, 0x74, 0xbe, 0xe1, 0xde, 0x3d, 0xfc, 0x2c, 0xa6,
+	0x8d, 0xf2, 0x39, 0x04, 0x11, 0xbb, 0x53, 0xb2, 0xad, 0x1a, 0x2d, 0x06, 0x63, 0x17, 0xf0, 0x44,
+	0xf9, 0xec, 0x2e, 0xe5, 0xe3, 0x0c, 0x0e, 0x76, 0xca, 0xef, 0x4a, 0x08, 0xe9, 0xae, 0x6b, 0x5b,
+	0x6b, 0x75, 0xd7, 0x14, 0x25, 0x35, 0x67, 0x5b, 0x32, 0x69, 0xba, 0xe8, 0xc1, 0xb2, 0xe4, 0xce,
+	0xe5, 0x7d, 0x75, 0xf6, 0xe7, 0xd2, 0x85, 0x2c, 0x2a, 0xb4, 0x55, 0x94, 0x70, 0x8e, 0xcc, 0xe1,
+	0x23, 0xd0, 0x00, 0x7f, 0x4c, 0xf0, 0xee, 0x7a, 0x79, 0x26, 0x81, 0x1e, 0xa9, 0x89, 0xce, 0xaf,
+	0xfc, 0xd3, 0xa4, 0x96, 0x5e, 0xb4, 0xc3, 0x79, 0x8b, 0xb6, 0x05, 0x0c, 0xa9, 0xf4, 0x92, 0x69,
+	0xe5, 0x47, 0xc9, 0xd4, 0x80, 0xb9, 0xeb, 0x56, 0x99, 0x16, 0xac, 0x24, 0x29, 0x4f, 0xc6, 0xfc,
+	0xcb, 0xf2, 0xec, 0x2a, 0x03, 0x5a, 0x61, 0x30, 0x70, 0xe0, 0x65, 0x9d, 0x70, 0x8b, 0x21, 0xff,
+	0x93, 0x84, 0x86, 0x45, 0x08, 0x45, 0xab, 0x41, 0x6c, 0x7a, 0x84, 0x83, 0xd7, 0x40, 0x6f, 0x99,
+	0x52, 0xea, 0x5f, 0x4a, 0xfb, 0xea, 0x4f, 0x24, 0xfb, 0x87, 0xd2, 0xec, 0x9f, 0x4a, 0x6f, 0xc2,
+	0xfa, 0x09, 0x0b, 0x60, 0xf9, 0x5c, 0x45, 0xbe, 0x1f, 0x78, 0xf6, 0x1f, 0x1f, 0x4c, 0x3d, 0x3c,
+	0x17, 0xe8, 0x38, 0xfb, 0x60, 0xfa, 0xec, 0x39, 0x82, 0x07, 0xbf, 0x39, 0xe7, 0xbe, 0x1f, 0x78,
+	0xf6, 0x1f, 0x29, 0x9e, 0xdf, 0x71, 0x16, 0x70, 0x5e, 0xba, 0xcf, 0x35, 0xf1, 0xf2, 0x3b, 0x67,
+	0xaf, 0x90, 0x2a, 0x1b, 0x3c, 0xc8, 0xa7, 0x4b, 0x4b, 0x5a, 0xec, 0x22, 0x9b, 0x2c, 0xb8, 0x1f,
+	0x4a, 0xc3, 0x32, 0x36, 0xcd, 0x4d, 0x0d, 0x82, 0x57, 0xb3, 0xac, 0x5c, 0xa0, 0x0b, 0x39, 0xce,
+	0x84, 0xe5, 0x5d, 0x92, 0x36, 0x1c, 0x5a, 0x0e, 0xd2, 0xb8, 0xb6, 0x70, 0xed, 0x3a, 0x01, 0xc4,
+	0x43, 0x21, 0xd2, 0xd7, 0xcc, 0x4d, 0xda, 0x2c, 0xff, 0xab, 0x84, 0xf2, 0xc1, 0x3c, 0x46, 0x03,
+	0x9f, 0xd0, 0x37, 0x93, 0x4f, 0x4a, 0x60, 0xca, 0x61, 0x5e, 0xad, 0xa3, 0xf1, 0x88, 0xe5, 0xf8,
+	0xfc, 0x7a, 0x8e, 0x2e, 0xe8, 0x54, 0x80, 0x5f, 0xa3, 0xc5, 0x46, 0x5a, 0x1e, 0xcf, 0x46, 0x9b,
+	0x86, 0xf1, 0xf8, 0x86, 0xd1, 0x50, 0xc4, 0x38, 0x20, 0xa9, 0x33, 0x74, 0x80, 0x09, 0x26, 0xa9,
+	0x06, 0xad, 0x87, 0x6e, 0x24, 0x02, 0xc2, 0x3a, 0xd0, 0x44, 0x19, 0xe4, 0x15, 0x7c, 0xb5, 0x01,
+	0x9a, 0x0b, 0x69, 0xd8, 0x84, 0xde, 0x6f, 0xe6, 0x26, 0xe4, 0xc8, 0x5c, 0xc3, 0xdc, 0x77, 0x51,
+	0xaa, 0x5c, 0x65, 0xab, 0x22, 0xb5, 0x14, 0x5d, 0x51, 0x97, 0x13, 0xbe, 0x6d, 0xba, 0x2e, 0x40,
+	0x99, 0x69, 0x3a, 0xbf, 0xaf, 0x9e, 0xfd, 0xb9, 0x74, 0xba, 0x3d, 0xc3, 0x84, 0xfd, 0x81, 0xe4,
+	0x19, 0x88, 0x48, 0xd8, 0xdb, 0x6d, 0xca, 0x2c, 0x35, 0x46, 0x23, 0xcd, 0xd9, 0x3d, 0xda, 0x8d,
+	0x05, 0x5c, 0x64, 0x9d, 0x4c, 0x7f, 0xdb, 0x75, 0x32, 0xe9, 0xc8, 0x3a, 0x99, 0x88, 0x4c, 0x6b,
+	0xe6, 0xeb, 0xa8, 0x58, 0xca, 0x7e, 0x5d, 0x15, 0x4b, 0xb9, 0xce, 0x2b, 0x96, 0x9a, 0x8a, 0x7a,
+	0xe4, 0x76, 0x8a, 0x7a, 0x06, 0xda, 0x29, 0xea, 0x19, 0x6c, 0xbb, 0xa8, 0x67, 0xa8, 0x45, 0x51,
+	0xcf, 0x65, 0x94, 0xb2, 0xab, 0x55, 0x57, 0xa3, 0x31, 0x26, 0xbb, 0x41, 0x54, 0x9a, 0xc2, 0x6f,
+	0x00, 0x20, 0x01, 0x26, 0x4e, 0xda, 0xfc, 0x49, 0x7e, 0xc3, 0x8b, 0xd8, 0x46, 0x68, 0xc4, 0xa6,
+	0x7e, 0x69, 0xd1, 0x1a, 0x78, 0xea, 0x7d, 0xa1, 0x12, 0x2b, 0xe5, 0xf0, 0x12, 0x2b, 0x92, 0xf5,
+	0x0a, 0x56, 0x0b, 0xe1, 0xde, 0xad, 0x40, 0x51, 0xd5, 0x1c, 0x4a, 0x51, 0x82, 0x24, 0x26, 0xe1,
+	0xa5, 0x2d, 0x4a, 0xab, 0x98, 0x45, 0xed, 0x23, 0x25, 0xec, 0xe2, 0x17, 0x4e, 0x12, 0x3a, 0xf4,
+	0xfa, 0xe4, 0x0d, 0x94, 0x13, 0xa9, 0x5a, 0x9f, 0xd8, 0xf9, 0x43, 0x88, 0x0d, 0x10, 0xf9, 0x58,
+	0x61, 0x68, 0x1e, 0x4d, 0x91, 0x58, 0xbe, 0x21, 0x48, 0xcf, 0x90, 0xf2, 0x50, 0x1a, 0xc5, 0x2b,
+	0xf9, 0x68, 0xd5, 0xe5, 0x41, 0x3e, 0x16, 0x70, 0xf2, 0xab, 0x48, 0x50, 0xd1, 0x04, 0xea, 0xd8,
+	0xc1, 0xa8, 0x69, 0x0e, 0x2f, 0x5e, 0x67, 0x3d, 0x89, 0xd2, 0xde, 0x95, 0x02, 0x15, 0x11, 0x7a,
+	0x9f, 0x08, 0x61, 0x08, 0xbf, 0x48, 0xa0, 0xe2, 0x21, 0x9f, 0x46, 0x99, 0xba, 0x63, 0x1a, 0x3e,
+	0x94, 0xa3, 0x1c, 0x25, 0x59, 0x47, 0xdc, 0x4f, 0x9a, 0x05, 0x18, 0x79, 0x25, 0x32, 0x43, 0xa9,
+	0xf9, 0x12, 0x47, 0x6f, 0xf8, 0xf8, 0xeb, 0xa2, 0x9e, 0xb8, 0xc9, 0x2f, 0x70, 0x38, 0xfb, 0x31,
+	0x2f, 0x3e, 0x78, 0x4e, 0x99, 0xa4, 0xb9, 0x35, 0x7a, 0xd7, 0x47, 0x72, 0x6b, 0xf8, 0x2a, 0x0d,
+	0x80, 0x9e, 0x63, 0x13, 0xc1, 0x8f, 0xd9, 0xaf, 0x66, 0xc4, 0x19, 0x7a, 0xbb, 0xd6, 0x8c, 0x38,
+	0x13, 0x42, 0x9c, 0x91, 0xdf, 0x44, 0x63, 0x8d, 0x57, 0x27, 0xc1, 0x70, 0xf2, 0x78, 0x27, 0x57,
+	0x33, 0xde, 0xfd, 0x0a, 0xf6, 0xe3, 0x4a, 0x88, 0xa8, 0x58, 0x56, 0x96, 0x49, 0x44, 0xa1, 0x85,
+	0x1d, 0x22, 0x20, 0x4c, 0x26, 0xfc, 0x80, 0x12, 0xd5, 0xbc, 0x56, 0xf9, 0x3e, 0x92, 0xd7, 0x68,
+	0xfd, 0xdb, 0x1e, 0xb9, 0xa8, 0x21, 0x99, 0x70, 0x7d, 0xc3, 0x54, 0x4e, 0x1c, 0x5e, 0x7e, 0x92,
+	0xd9, 0x57, 0xfb, 0x10, 0x3a, 0x7a, 0xe4, 0xc8, 0xbb, 0x57, 0xa6, 0x8e, 0xc0, 0x3f, 0x9c, 0xe3,
+	0x74, 0x56, 0x3c, 0x32, 0xf2, 0x33, 0x10, 0xfb, 0x8a, 0xc4, 0x1d, 0x2f, 0x6c, 0x39, 0x09, 0x94,
+	0xbb, 0x71, 0x5a, 0x34, 0xf3, 0x8a, 0x95, 0xc3, 0x5e, 0xac, 0x3d, 0xf3, 0xa5, 0xbc, 0x58, 0x0b,
+	0xc1, 0x0d, 0x0a, 0x14, 0x10, 0x9e, 0xed, 0xac, 0x80, 0x10, 0x07, 0x70, 0xe5, 0x35, 0x94, 0x06,
+	0xa6, 0x6c, 0x5b, 0x44, 0xa4, 0x99, 0xeb, 0x71, 0x8e, 0xda, 0xe7, 0x97, 0x3b, 0xaa, 0xea, 0xee,
+	0x5f, 0xf1, 0x69, 0x80, 0x1d, 0xea, 0x0f, 0x90, 0x5c, 0x22, 0x69, 0xbc, 0x9c, 0xd7, 0x40, 0x2b,
+	0xc8, 0x75, 0x57, 0x57, 0x9e, 0xe5, 0xda, 0x16, 0x71, 0x7f, 0x5d, 0x2f, 0xb9, 0x38, 0x1b, 0xc4,
+	0x20, 0x09, 0x05, 0x12, 0x32, 0x6f, 0xd5, 0xcb, 0x24, 0x3c, 0x75, 0x5c, 0x65, 0x8a, 0x85, 0xcc,
+	0x5e, 0x83, 0xbc, 0x81, 0x46, 0xe1, 0x5c, 0xb5, 0xb6, 0x34, 0x3d, 0x14, 0xc5, 0x82, 0xac, 0x1b,
+	0xa6, 0x32, 0x7d, 0x48, 0x70, 0xd1, 0x1c, 0xf9, 0xe2, 0x11, 0x4a, 0x2d, 0x22, 0x24, 0x9e, 0x46,
+	0x03, 0xce, 0xa6, 0x55, 0xd3, 0x78, 0x8e, 0x52, 0x2b, 0xd9, 0x7b, 0x35, 0x88, 0x56, 0x2f, 0xd2,
+	0x09, 0xe5, 0x48, 0x17, 0x67, 0xf8, 0x1c, 0xed, 0x00, 0xb9, 0x1c, 0x8f, 0x80, 0xd7, 0xaa, 0x70,
+	0xee, 0xda, 0x16, 0xcc, 0xed, 0xd2, 0xa1, 0x35, 0x4d, 0xa3, 0x4d, 0x44, 0x6f, 0x72, 0x64, 0xa2,
+	0xd4, 0x15, 0x73, 0x37, 0x64, 0x0d, 0x2e, 0xfb, 0x4a, 0xbd, 0x0c, 0x5d, 0xbe, 0x35, 0x20, 0x80,
+	0x41, 0x6b, 0x10, 0x46, 0x9c, 0x51, 0x9e, 0x8f, 0x44, 0x9c, 0x09, 0x21, 0xce, 0xe4, 0x5f, 0x41,
+	0x99, 0x86, 0x60, 0xf0, 0xb0, 0x0b, 0xf5, 0x54, 0x30, 0x6d, 0x75, 0x07, 0xa5, 0xc3, 0xfe, 0x5a,
+	0x04, 0xf6, 0x74, 0x38, 0x3b, 0xd6, 0x74, 0x38, 0x08, 0x02, 0x01, 0xba, 0x57, 0xe3, 0xc9, 0x53,
+	0xd9, 0xd3, 0xf0, 0xf7, 0x74, 0xf6, 0x19, 0xf8, 0xfb, 0x4c, 0xf6, 0x4c, 0x01, 0x54, 0xc4, 0xdb,
+	0x5f, 0x47, 0x7e, 0x09, 0xf5, 0xfa, 0xff, 0x0f, 0x85, 0xc8, 0xf8, 0x8d, 0xb6, 0x14, 0x08, 0x8c,
+	0x4c, 0x0f, 0xb7, 0x60, 0xa0, 0xe1, 0x39, 0x1a, 0xc7, 0xfb, 0xdd, 0x3c, 0xb3, 0x7c, 0x15, 0x21,
+	0x9f, 0xaa, 0x57, 0x4d, 0xdb, 0x8a, 0x68, 0x44, 0x7e, 0x21, 0xe5, 0x0d, 0x53, 0xf8, 0x05, 0x84,
+	0x99, 0xb7, 0x69, 0xa4, 0xff, 0x55, 0x0e, 0x43, 0x52, 0x34, 0xfe, 0x7f, 0x66, 0xd1, 0x32, 0x99,
+	0xb1, 0x48, 0x40, 0x6e, 0x00, 0x04, 0xcf, 0xa5, 0xa7, 0xd6, 0x45, 0x43, 0xe1, 0x6f, 0x21, 0xbc,
+	0x78, 0xcd, 0x74, 0x9b, 0x26, 0xf9, 0x00, 0xa5, 0xfd, 0x49, 0x6a, 0x4f, 0x9f, 0x7a, 0xe9, 0x33,
+	0x7d, 0x38, 0xe7, 0xe9, 0xa7, 0xfd, 0x3f, 0x12, 0x3a, 0x15, 0x9c, 0x76, 0x60, 0x70, 0xb0, 0x8c,
+	0x0b, 0xb7, 0x97, 0x1c, 0xb1, 0x90, 0xe0, 0x6b, 0x70, 0xd2, 0xd7, 0xf0, 0x1a, 0x5c, 0xec, 0xab,
+	0x79, 0x0d, 0xee, 0x49, 0x17, 0x1a, 0x22, 0x57, 0x34, 0xbe, 0x12, 0x88, 0x05, 0xea, 0x28, 0x13,
+	0x3c, 0x9c, 0xfc, 0xad, 0x3a, 0x7d, 0xc0, 0xb1, 0x74, 0xf0, 0x66, 0xa5, 0xf5, 0x20, 0xe4, 0xd3,
+	0x6f, 0x97, 0xfc, 0xbe, 0x84, 0xba, 0xab, 0xb6, 0x61, 0xda, 0xfc, 0x3d, 0x95, 0x1f, 0x43, 0xd8,
+	0xfa, 0x67, 0x92, 0xfd, 0x03, 0x09, 0x03, 0x98, 0x27, 0x63, 0x18, 0x4d, 0xf9, 0xcf, 0xde, 0xae,
+	0xe1, 0xd4, 0x94, 0xf7, 0x28, 0xb8, 0x8c, 0x93, 0x53, 0xe2, 0x89, 0x66, 0xcb, 0x70, 0xf7, 0x14,
+	0xfd, 0x0a, 0x66, 0xc5, 0x70, 0xdf, 0x54, 0xf0, 0x57, 0x20, 0xe9, 0x87, 0x7b, 0xa7, 0x02, 0x3f,
+	0xd8, 0xc4, 0xe4, 0x09, 0xd4, 0xcd, 0xfe, 0x9b, 0x06, 0xfa, 0xff, 0x84, 0x50, 0xaf, 0xe4, 0x5c,
+	0x97, 0xf2, 0x79, 0x02, 0xb3, 0x66, 0x59, 0x46, 0xf1, 0x1a, 0x71, 0x41, 0xd8, 0xff, 0x0f, 0x42,
+	0x9f, 0x0b, 0x7f, 0x0d, 0xca, 0xb3, 0x1a, 0xa1, 0x3c, 0x8b, 0x9d, 0x69, 0x78, 0xf8, 0x9a, 0xea,
+	0xcb, 0xd4, 0xee, 0xbf, 0x97, 0xc8, 0x5d, 0x06, 0x44, 0x0e, 0xc5, 0x8a, 0xf1, 0xff, 0x50, 0xcb,
+	0xff, 0x59, 0x42, 0x39, 0x6f, 0xe4, 0x5b, 0xe6, 0x16, 0xc4, 0x9c, 0xe0, 0x1a, 0x7e, 0x53, 0xb8,
+	0x2b, 0x9f, 0x41, 0x10, 0x56, 0xd5, 0x68, 0x45, 0x11, 0x39, 0xf3, 0x42, 0xaf, 0x60, 0x81, 0x30,
+	0xf3, 0x3e, 0x08, 0x0f, 0x0b, 0x1f, 0x4a, 0x68, 0xa4, 0x69, 0x21, 0xcc, 0x85, 0xf3, 0xb2, 0xc0,
+	0x52, 0x18, 0x3d, 0x32, 0x0b, 0x1c, 0x0b, 0x66, 0x81, 0x3f, 0x92, 0xc2, 0x59, 0xe0, 0x5b, 0x28,
+	0x43, 0x33, 0xa3, 0x70, 0xbe, 0x9b, 0x15, 0x87, 0x66, 0x5b, 0xba, 0xe8, 0xcd, 0xe6, 0xb3, 0xfb,
+	0xea, 0x99, 0x27, 0xd2, 0xa9, 0xac, 0xa1, 0x48, 0x85, 0x49, 0xfb, 0xe8, 0xec, 0x18, 0xc9, 0x14,
+	0x3d, 0x98, 0x16, 0x9e, 0xdf, 0xdb, 0x33, 0xe7, 0x67, 0x9e, 0x7f, 0xe7, 0x2c, 0x7c, 0x91, 0xdc,
+	0x7f, 0x9a, 0xd0, 0x58, 0xf0, 0x48, 0x14, 0xfe, 0x57, 0x42, 0x4a, 0x8b, 0xa9, 0x3b, 0xf2, 0x3b,
+	0x28, 0xc1, 0x9c, 0x4f, 0x71, 0x06, 0x5f, 0x6e, 0xb9, 0x0f, 0x0d, 0xa8, 0xd3, 0xfc, 0xfb, 0x8b,
+	0x64, 0x79, 0xc4, 0x98, 0xf9, 0x12, 0xea, 0x0b, 0x92, 0x89, 0x70, 0x3e, 0x0e, 0xbb, 0x9a, 0x6b,
+	0x31, 0xbd, 0x60, 0xd1, 0xe0, 0x0f, 0x25, 0x34, 0x39, 0x57, 0xad, 0x80, 0x8f, 0xe6, 0x36, 0x41,
+	0x0b, 0x3d, 0x5a, 0x41, 0x29, 0x36, 0x27, 0xff, 0x85, 0xc1, 0x4e, 0xde, 0x10, 0x67, 0x83, 0x92,
+	0x37, 0xc4, 0x19, 0x15, 0x70, 0xb2, 0xc1, 0xdc, 0x50, 0xbf, 0x9a, 0x1e, 0x27, 0x98, 0x3e, 0x9f,
+	0x03, 0xc1, 0xf7, 0xe3, 0x26, 0x39, 0x87, 0xfa, 0x57, 0x6e, 0xde, 0x5d, 0xc0, 0xda, 0xed, 0xe5,
+	0x6b, 0xcb, 0x37, 0xef, 0x2e, 0x67, 0x8f, 0xf8, 0x4d, 0x6a, 0xf1, 0xd6, 0xad, 0x05, 0xfc, 0x46,
+	0x56, 0x02, 0x3a, 0x69, 0xd6, 0xb4, 0xf0, 0xc7, 0xd0, 0xb2, 0x5c, 0xbc, 0x9e, 0x8d, 0xa9, 0xbf,
+	0x90, 0x3e, 0xfa, 0xed, 0x84, 0xf4, 0x31, 0x7c, 0x7e, 0xfd, 0xdb, 0x89, 0x23, 0xbf, 0x81, 0xcf,
+	0xe7, 0xf0, 0xf9, 0x3d, 0x7c, 0xfe, 0x00, 0x6d, 0xef, 0x7e, 0x36, 0x21, 0xfd, 0xe8, 0xb3, 0x89,
+	0x23, 0x1f, 0xc0, 0xf7, 0x2f, 0xe1, 0xfb, 0x43, 0xf8, 0xfc, 0x0a, 0x3e, 0x1f, 0xc1, 0xef, 0x8f,
+	0xe1, 0xf3, 0x6b, 0x78, 0xfe, 0x0d, 0x7c, 0x7f, 0x0e, 0xdf, 0xbf, 0x87, 0xef, 0x3f, 0xc0, 0xf7,
+	0xbb, 0xbf, 0x9b, 0x38, 0xf2, 0xa3, 0xdf, 0x4d, 0x48, 0xef, 0xc1, 0xf7, 0xcf, 0xe0, 0xfb, 0x7d,
+	0xf8, 0xfe, 0x00, 0x3e, 0xbf, 0x84, 0xe7, 0x0f, 0xe1, 0xf3, 0x2b, 0xf8, 0xdc, 0xbb, 0xd0, 0xc1,
+	0x59, 0xe8, 0x56, 0x6a, 0x6b, 0x6b, 0x3d, 0x54, 0x09, 0x2f, 0xfe, 0x1f, 0xbf, 0x79, 0x04, 0xf5,
+	0x86, 0x4c, 0x00, 0x00,
 }
 
 func (x PowerState) String() string {
@@ -3252,6 +3906,9 @@ func (this *MACParameters) Equal(that interface{}) bool {
 	if !this.PingSlotDataRateIndexValue.Equal(that1.PingSlotDataRateIndexValue) {
 		return false
 	}
+	if !this.Relay.Equal(that1.Relay) {
+		return false
+	}
 	return true
 }
 func (this *MACParameters_Channel) Equal(that interface{}) bool {
@@ -3290,14 +3947,14 @@ func (this *MACParameters_Channel) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
+func (this *RelayUplinkForwardingRule) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceVersionIdentifiers)
+	that1, ok := that.(*RelayUplinkForwardingRule)
 	if !ok {
-		that2, ok := that.(EndDeviceVersionIdentifiers)
+		that2, ok := that.(RelayUplinkForwardingRule)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3309,31 +3966,25 @@ func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BrandID != that1.BrandID {
-		return false
-	}
-	if this.ModelID != that1.ModelID {
-		return false
-	}
-	if this.HardwareVersion != that1.HardwareVersion {
+	if !this.Limits.Equal(that1.Limits) {
 		return false
 	}
-	if this.FirmwareVersion != that1.FirmwareVersion {
+	if this.LastWFCnt != that1.LastWFCnt {
 		return false
 	}
-	if this.BandID != that1.BandID {
+	if this.DeviceID != that1.DeviceID {
 		return false
 	}
 	return true
 }
-func (this *EndDeviceVersion) Equal(that interface{}) bool {
+func (this *RelayJoinRequestFilter) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceVersion)
+	that1, ok := that.(*RelayJoinRequestFilter)
 	if !ok {
-		that2, ok := that.(EndDeviceVersion)
+		that2, ok := that.(RelayJoinRequestFilter)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3345,60 +3996,74 @@ func (this *EndDeviceVersion) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceVersionIdentifiers.Equal(&that1.EndDeviceVersionIdentifiers) {
-		return false
-	}
-	if this.LoRaWANVersion != that1.LoRaWANVersion {
+	if this.Action != that1.Action {
 		return false
 	}
-	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
 		return false
 	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
+	if !this.DevEUI.Equal(that1.DevEUI) {
 		return false
 	}
-	if len(this.Photos) != len(that1.Photos) {
-		return false
+	return true
+}
+func (this *ServingRelayParameters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.Photos {
-		if this.Photos[i] != that1.Photos[i] {
+
+	that1, ok := that.(*ServingRelayParameters)
+	if !ok {
+		that2, ok := that.(ServingRelayParameters)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.SupportsClassB != that1.SupportsClassB {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.SupportsClassC != that1.SupportsClassC {
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
 		return false
 	}
-	if !this.DefaultMACSettings.Equal(that1.DefaultMACSettings) {
+	if this.DefaultChannelIndex != that1.DefaultChannelIndex {
 		return false
 	}
-	if this.MinFrequency != that1.MinFrequency {
+	if this.CADPeriodicity != that1.CADPeriodicity {
 		return false
 	}
-	if this.MaxFrequency != that1.MaxFrequency {
+	if len(this.UplinkForwardingRules) != len(that1.UplinkForwardingRules) {
 		return false
 	}
-	if this.SupportsJoin != that1.SupportsJoin {
-		return false
+	for i := range this.UplinkForwardingRules {
+		if !this.UplinkForwardingRules[i].Equal(that1.UplinkForwardingRules[i]) {
+			return false
+		}
 	}
-	if this.ResetsJoinNonces != that1.ResetsJoinNonces {
+	if !this.Limits.Equal(that1.Limits) {
 		return false
 	}
-	if !this.DefaultFormatters.Equal(&that1.DefaultFormatters) {
+	if len(this.JoinRequestFilters) != len(that1.JoinRequestFilters) {
 		return false
 	}
+	for i := range this.JoinRequestFilters {
+		if !this.JoinRequestFilters[i].Equal(that1.JoinRequestFilters[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *MACSettings) Equal(that interface{}) bool {
+func (this *ServedRelayParameters) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACSettings)
+	that1, ok := that.(*ServedRelayParameters)
 	if !ok {
-		that2, ok := that.(MACSettings)
+		that2, ok := that.(ServedRelayParameters)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3410,53 +4075,217 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClassBTimeout != nil && that1.ClassBTimeout != nil {
-		if *this.ClassBTimeout != *that1.ClassBTimeout {
-			return false
-		}
-	} else if this.ClassBTimeout != nil {
-		return false
-	} else if that1.ClassBTimeout != nil {
+	if this.Mode != that1.Mode {
 		return false
 	}
-	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
+	if this.SmartEnableLevel != that1.SmartEnableLevel {
 		return false
 	}
-	if !this.PingSlotDataRateIndex.Equal(that1.PingSlotDataRateIndex) {
+	if this.Backoff != that1.Backoff {
 		return false
 	}
-	if !this.PingSlotFrequency.Equal(that1.PingSlotFrequency) {
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
 		return false
 	}
-	if !this.BeaconFrequency.Equal(that1.BeaconFrequency) {
+	if this.ServingDeviceID != that1.ServingDeviceID {
 		return false
 	}
-	if this.ClassCTimeout != nil && that1.ClassCTimeout != nil {
-		if *this.ClassCTimeout != *that1.ClassCTimeout {
+	return true
+}
+func (this *RelayParameters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayParameters)
+	if !ok {
+		that2, ok := that.(RelayParameters)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if this.ClassCTimeout != nil {
-		return false
-	} else if that1.ClassCTimeout != nil {
-		return false
-	}
-	if !this.Rx1Delay.Equal(that1.Rx1Delay) {
-		return false
 	}
-	if !this.Rx1DataRateOffset.Equal(that1.Rx1DataRateOffset) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.Rx2DataRateIndex.Equal(that1.Rx2DataRateIndex) {
+	if !this.Serving.Equal(that1.Serving) {
 		return false
 	}
-	if !this.Rx2Frequency.Equal(that1.Rx2Frequency) {
+	if !this.Served.Equal(that1.Served) {
 		return false
 	}
-	if len(this.FactoryPresetFrequencies) != len(that1.FactoryPresetFrequencies) {
-		return false
+	return true
+}
+func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.FactoryPresetFrequencies {
-		if this.FactoryPresetFrequencies[i] != that1.FactoryPresetFrequencies[i] {
+
+	that1, ok := that.(*EndDeviceVersionIdentifiers)
+	if !ok {
+		that2, ok := that.(EndDeviceVersionIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BrandID != that1.BrandID {
+		return false
+	}
+	if this.ModelID != that1.ModelID {
+		return false
+	}
+	if this.HardwareVersion != that1.HardwareVersion {
+		return false
+	}
+	if this.FirmwareVersion != that1.FirmwareVersion {
+		return false
+	}
+	if this.BandID != that1.BandID {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceVersion)
+	if !ok {
+		that2, ok := that.(EndDeviceVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceVersionIdentifiers.Equal(&that1.EndDeviceVersionIdentifiers) {
+		return false
+	}
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
+		return false
+	}
+	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if len(this.Photos) != len(that1.Photos) {
+		return false
+	}
+	for i := range this.Photos {
+		if this.Photos[i] != that1.Photos[i] {
+			return false
+		}
+	}
+	if this.SupportsClassB != that1.SupportsClassB {
+		return false
+	}
+	if this.SupportsClassC != that1.SupportsClassC {
+		return false
+	}
+	if !this.DefaultMACSettings.Equal(that1.DefaultMACSettings) {
+		return false
+	}
+	if this.MinFrequency != that1.MinFrequency {
+		return false
+	}
+	if this.MaxFrequency != that1.MaxFrequency {
+		return false
+	}
+	if this.SupportsJoin != that1.SupportsJoin {
+		return false
+	}
+	if this.ResetsJoinNonces != that1.ResetsJoinNonces {
+		return false
+	}
+	if !this.DefaultFormatters.Equal(&that1.DefaultFormatters) {
+		return false
+	}
+	return true
+}
+func (this *MACSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACSettings)
+	if !ok {
+		that2, ok := that.(MACSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ClassBTimeout != nil && that1.ClassBTimeout != nil {
+		if *this.ClassBTimeout != *that1.ClassBTimeout {
+			return false
+		}
+	} else if this.ClassBTimeout != nil {
+		return false
+	} else if that1.ClassBTimeout != nil {
+		return false
+	}
+	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
+		return false
+	}
+	if !this.PingSlotDataRateIndex.Equal(that1.PingSlotDataRateIndex) {
+		return false
+	}
+	if !this.PingSlotFrequency.Equal(that1.PingSlotFrequency) {
+		return false
+	}
+	if !this.BeaconFrequency.Equal(that1.BeaconFrequency) {
+		return false
+	}
+	if this.ClassCTimeout != nil && that1.ClassCTimeout != nil {
+		if *this.ClassCTimeout != *that1.ClassCTimeout {
+			return false
+		}
+	} else if this.ClassCTimeout != nil {
+		return false
+	} else if that1.ClassCTimeout != nil {
+		return false
+	}
+	if !this.Rx1Delay.Equal(that1.Rx1Delay) {
+		return false
+	}
+	if !this.Rx1DataRateOffset.Equal(that1.Rx1DataRateOffset) {
+		return false
+	}
+	if !this.Rx2DataRateIndex.Equal(that1.Rx2DataRateIndex) {
+		return false
+	}
+	if !this.Rx2Frequency.Equal(that1.Rx2Frequency) {
+		return false
+	}
+	if len(this.FactoryPresetFrequencies) != len(that1.FactoryPresetFrequencies) {
+		return false
+	}
+	for i := range this.FactoryPresetFrequencies {
+		if this.FactoryPresetFrequencies[i] != that1.FactoryPresetFrequencies[i] {
 			return false
 		}
 	}
@@ -3517,6 +4346,20 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if !this.DesiredBeaconFrequency.Equal(that1.DesiredBeaconFrequency) {
 		return false
 	}
+	if !this.ADRAlgorithm.Equal(that1.ADRAlgorithm) {
+		return false
+	}
+	if len(this.ADRAlgorithmParameters) != len(that1.ADRAlgorithmParameters) {
+		return false
+	}
+	for i := range this.ADRAlgorithmParameters {
+		if this.ADRAlgorithmParameters[i] != that1.ADRAlgorithmParameters[i] {
+			return false
+		}
+	}
+	if !this.DesiredRelay.Equal(that1.DesiredRelay) {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -3656,6 +4499,22 @@ func (this *MACState) Equal(that interface{}) bool {
 	if this.LastADRChangeFCntUp != that1.LastADRChangeFCntUp {
 		return false
 	}
+	if len(this.LinkQualityUplinks) != len(that1.LinkQualityUplinks) {
+		return false
+	}
+	for i := range this.LinkQualityUplinks {
+		if !this.LinkQualityUplinks[i].Equal(that1.LinkQualityUplinks[i]) {
+			return false
+		}
+	}
+	if len(this.LinkQualityDownlinks) != len(that1.LinkQualityDownlinks) {
+		return false
+	}
+	for i := range this.LinkQualityDownlinks {
+		if !this.LinkQualityDownlinks[i].Equal(that1.LinkQualityDownlinks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *MACState_JoinRequest) Equal(that interface{}) bool {
@@ -3788,6 +4647,81 @@ func (this *MACState_DataRateRanges) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MACState_UplinkObservation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACState_UplinkObservation)
+	if !ok {
+		that2, ok := that.(MACState_UplinkObservation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if !this.ReceivedAt.Equal(that1.ReceivedAt) {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.SNR != that1.SNR {
+		return false
+	}
+	if this.RSSI != that1.RSSI {
+		return false
+	}
+	if this.GatewayCount != that1.GatewayCount {
+		return false
+	}
+	if this.Airtime != that1.Airtime {
+		return false
+	}
+	return true
+}
+func (this *MACState_DownlinkObservation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACState_DownlinkObservation)
+	if !ok {
+		that2, ok := that.(MACState_DownlinkObservation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TransmitAt.Equal(that1.TransmitAt) {
+		return false
+	}
+	if this.Confirmed != that1.Confirmed {
+		return false
+	}
+	if this.Acknowledged != that1.Acknowledged {
+		return false
+	}
+	if this.Airtime != that1.Airtime {
+		return false
+	}
+	return true
+}
 func (this *EndDeviceAuthenticationCode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -4020,6 +4954,12 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if !this.SkipPayloadCryptoOverride.Equal(that1.SkipPayloadCryptoOverride) {
 		return false
 	}
+	if this.NextRJCount0 != that1.NextRJCount0 {
+		return false
+	}
+	if this.NextRJCount1 != that1.NextRJCount1 {
+		return false
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -4472,6 +5412,20 @@ func (m *MACParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Relay != nil {
+		{
+			size, err := m.Relay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.PingSlotDataRateIndexValue != nil {
 		{
 			size, err := m.PingSlotDataRateIndexValue.MarshalToSizedBuffer(dAtA[:i])
@@ -4701,6 +5655,290 @@ func (m *MACParameters_Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayUplinkForwardingRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayUplinkForwardingRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayUplinkForwardingRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceID) > 0 {
+		i -= len(m.DeviceID)
+		copy(dAtA[i:], m.DeviceID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.DeviceID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastWFCnt != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastWFCnt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayJoinRequestFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayJoinRequestFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayJoinRequestFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Action != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServingRelayParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServingRelayParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServingRelayParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JoinRequestFilters) > 0 {
+		for iNdEx := len(m.JoinRequestFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JoinRequestFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UplinkForwardingRules) > 0 {
+		for iNdEx := len(m.UplinkForwardingRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UplinkForwardingRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CADPeriodicity != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.CADPeriodicity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DefaultChannelIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DefaultChannelIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServedRelayParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServedRelayParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServedRelayParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServingDeviceID) > 0 {
+		i -= len(m.ServingDeviceID)
+		copy(dAtA[i:], m.ServingDeviceID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ServingDeviceID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Backoff != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SmartEnableLevel != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.SmartEnableLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mode != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Served != nil {
+		{
+			size, err := m.Served.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Serving != nil {
+		{
+			size, err := m.Serving.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EndDeviceVersionIdentifiers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4910,9 +6148,9 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.DesiredBeaconFrequency != nil {
+	if m.DesiredRelay != nil {
 		{
-			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredRelay.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4920,13 +6158,33 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x82
 	}
-	if m.DesiredPingSlotFrequency != nil {
+	if len(m.ADRAlgorithmParameters) > 0 {
+		for k := range m.ADRAlgorithmParameters {
+			v := m.ADRAlgorithmParameters[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(v)))
+			i--
+			dAtA[i] = 0x15
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.ADRAlgorithm != nil {
 		{
-			size, err := m.DesiredPingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRAlgorithm.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4936,11 +6194,39 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xf2
 	}
-	if m.DesiredPingSlotDataRateIndex != nil {
+	if m.DesiredBeaconFrequency != nil {
 		{
-			size, err := m.DesiredPingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.DesiredPingSlotFrequency != nil {
+		{
+			size, err := m.DesiredPingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.DesiredPingSlotDataRateIndex != nil {
+		{
+			size, err := m.DesiredPingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5079,12 +6365,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.StatusTimePeriodicity != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
-		if err23 != nil {
-			return 0, err23
+		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n23
-		i = encodeVarintEndDevice(dAtA, i, uint64(n23))
+		i -= n32
+		i = encodeVarintEndDevice(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5151,20 +6437,20 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if len(m.FactoryPresetFrequencies) > 0 {
-		dAtA30 := make([]byte, len(m.FactoryPresetFrequencies)*10)
-		var j29 int
+		dAtA39 := make([]byte, len(m.FactoryPresetFrequencies)*10)
+		var j38 int
 		for _, num := range m.FactoryPresetFrequencies {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(num&0x7f | 0x80)
+				dAtA39[j38] = uint8(num&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j38++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA39[j38] = uint8(num)
+			j38++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j29))
+		i -= j38
+		copy(dAtA[i:], dAtA39[:j38])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j38))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5217,12 +6503,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 	}
 	if m.ClassCTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n35
-		i = encodeVarintEndDevice(dAtA, i, uint64(n35))
+		i -= n44
+		i = encodeVarintEndDevice(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -5263,12 +6549,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.ClassBTimeout != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
-		if err39 != nil {
-			return 0, err39
+		n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n39
-		i = encodeVarintEndDevice(dAtA, i, uint64(n39))
+		i -= n48
+		i = encodeVarintEndDevice(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5295,6 +6581,38 @@ func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LinkQualityDownlinks) > 0 {
+		for iNdEx := len(m.LinkQualityDownlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkQualityDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.LinkQualityUplinks) > 0 {
+		for iNdEx := len(m.LinkQualityUplinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkQualityUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if m.LastADRChangeFCntUp != 0 {
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastADRChangeFCntUp))
 		i--
@@ -5329,84 +6647,84 @@ func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastDownlinkAt != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkAt):])
-		if err41 != nil {
-			return 0, err41
+		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkAt):])
+		if err50 != nil {
+			return 0, err50
 		}
-		i -= n41
-		i = encodeVarintEndDevice(dAtA, i, uint64(n41))
+		i -= n50
+		i = encodeVarintEndDevice(dAtA, i, uint64(n50))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xa2
 	}
 	if len(m.RejectedFrequencies) > 0 {
-		dAtA43 := make([]byte, len(m.RejectedFrequencies)*10)
-		var j42 int
+		dAtA52 := make([]byte, len(m.RejectedFrequencies)*10)
+		var j51 int
 		for _, num := range m.RejectedFrequencies {
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(num&0x7f | 0x80)
+				dAtA52[j51] = uint8(num&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j51++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA52[j51] = uint8(num)
+			j51++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j42))
+		i -= j51
+		copy(dAtA[i:], dAtA52[:j51])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j51))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x9a
 	}
 	if len(m.RejectedADRTxPowerIndexes) > 0 {
-		dAtA45 := make([]byte, len(m.RejectedADRTxPowerIndexes)*10)
-		var j44 int
+		dAtA54 := make([]byte, len(m.RejectedADRTxPowerIndexes)*10)
+		var j53 int
 		for _, num := range m.RejectedADRTxPowerIndexes {
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j53++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j44))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x92
 	}
 	if len(m.RejectedADRDataRateIndexes) > 0 {
-		dAtA47 := make([]byte, len(m.RejectedADRDataRateIndexes)*10)
-		var j46 int
+		dAtA56 := make([]byte, len(m.RejectedADRDataRateIndexes)*10)
+		var j55 int
 		for _, num := range m.RejectedADRDataRateIndexes {
 			for num >= 1<<7 {
-				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j46++
+				j55++
 			}
-			dAtA47[j46] = uint8(num)
-			j46++
+			dAtA56[j55] = uint8(num)
+			j55++
 		}
-		i -= j46
-		copy(dAtA[i:], dAtA47[:j46])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j46))
+		i -= j55
+		copy(dAtA[i:], dAtA56[:j55])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j55))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x8a
 	}
 	if m.LastNetworkInitiatedDownlinkAt != nil {
-		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
-		if err48 != nil {
-			return 0, err48
+		n57, err57 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
+		if err57 != nil {
+			return 0, err57
 		}
-		i -= n48
-		i = encodeVarintEndDevice(dAtA, i, uint64(n48))
+		i -= n57
+		i = encodeVarintEndDevice(dAtA, i, uint64(n57))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5532,12 +6850,12 @@ func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if m.LastConfirmedDownlinkAt != nil {
-		n53, err53 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
-		if err53 != nil {
-			return 0, err53
+		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
+		if err62 != nil {
+			return 0, err62
 		}
-		i -= n53
-		i = encodeVarintEndDevice(dAtA, i, uint64(n53))
+		i -= n62
+		i = encodeVarintEndDevice(dAtA, i, uint64(n62))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -5773,6 +7091,131 @@ func (m *MACState_DataRateRanges) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MACState_UplinkObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACState_UplinkObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACState_UplinkObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n69, err69 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Airtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Airtime):])
+	if err69 != nil {
+		return 0, err69
+	}
+	i -= n69
+	i = encodeVarintEndDevice(dAtA, i, uint64(n69))
+	i--
+	dAtA[i] = 0x3a
+	if m.GatewayCount != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.GatewayCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RSSI != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.RSSI)))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.SNR != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.SNR)))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err70 != nil {
+		return 0, err70
+	}
+	i -= n70
+	i = encodeVarintEndDevice(dAtA, i, uint64(n70))
+	i--
+	dAtA[i] = 0x12
+	if m.FCnt != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.FCnt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MACState_DownlinkObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACState_DownlinkObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACState_DownlinkObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n71, err71 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Airtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Airtime):])
+	if err71 != nil {
+		return 0, err71
+	}
+	i -= n71
+	i = encodeVarintEndDevice(dAtA, i, uint64(n71))
+	i--
+	dAtA[i] = 0x22
+	if m.Acknowledged {
+		i--
+		if m.Acknowledged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Confirmed {
+		i--
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n72, err72 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TransmitAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TransmitAt):])
+	if err72 != nil {
+		return 0, err72
+	}
+	i -= n72
+	i = encodeVarintEndDevice(dAtA, i, uint64(n72))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5794,22 +7237,22 @@ func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.ValidTo != nil {
-		n60, err60 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
-		if err60 != nil {
-			return 0, err60
+		n73, err73 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
+		if err73 != nil {
+			return 0, err73
 		}
-		i -= n60
-		i = encodeVarintEndDevice(dAtA, i, uint64(n60))
+		i -= n73
+		i = encodeVarintEndDevice(dAtA, i, uint64(n73))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.ValidFrom != nil {
-		n61, err61 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
-		if err61 != nil {
-			return 0, err61
+		n74, err74 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
+		if err74 != nil {
+			return 0, err74
 		}
-		i -= n61
-		i = encodeVarintEndDevice(dAtA, i, uint64(n61))
+		i -= n74
+		i = encodeVarintEndDevice(dAtA, i, uint64(n74))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5843,6 +7286,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextRJCount1 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.NextRJCount1))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.NextRJCount0 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.NextRJCount0))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.SkipPayloadCryptoOverride != nil {
 		{
 			size, err := m.SkipPayloadCryptoOverride.MarshalToSizedBuffer(dAtA[:i])
@@ -6032,12 +7489,12 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x90
 	}
 	if m.LastDevStatusReceivedAt != nil {
-		n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
-		if err69 != nil {
-			return 0, err69
+		n82, err82 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
+		if err82 != nil {
+			return 0, err82
 		}
-		i -= n69
-		i = encodeVarintEndDevice(dAtA, i, uint64(n69))
+		i -= n82
+		i = encodeVarintEndDevice(dAtA, i, uint64(n82))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -6065,20 +7522,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xf0
 	}
 	if len(m.UsedDevNonces) > 0 {
-		dAtA71 := make([]byte, len(m.UsedDevNonces)*10)
-		var j70 int
+		dAtA84 := make([]byte, len(m.UsedDevNonces)*10)
+		var j83 int
 		for _, num := range m.UsedDevNonces {
 			for num >= 1<<7 {
-				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j70++
+				j83++
 			}
-			dAtA71[j70] = uint8(num)
-			j70++
+			dAtA84[j83] = uint8(num)
+			j83++
 		}
-		i -= j70
-		copy(dAtA[i:], dAtA71[:j70])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j70))
+		i -= j83
+		copy(dAtA[i:], dAtA84[:j83])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j83))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -6353,20 +7810,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n79, err79 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err79 != nil {
-		return 0, err79
+	n92, err92 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err92 != nil {
+		return 0, err92
 	}
-	i -= n79
-	i = encodeVarintEndDevice(dAtA, i, uint64(n79))
+	i -= n92
+	i = encodeVarintEndDevice(dAtA, i, uint64(n92))
 	i--
 	dAtA[i] = 0x1a
-	n80, err80 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err80 != nil {
-		return 0, err80
+	n93, err93 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err93 != nil {
+		return 0, err93
 	}
-	i -= n80
-	i = encodeVarintEndDevice(dAtA, i, uint64(n80))
+	i -= n93
+	i = encodeVarintEndDevice(dAtA, i, uint64(n93))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -6944,6 +8401,86 @@ func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
 	return this
 }
 
+func NewPopulatedRelayUplinkForwardingRule(r randyEndDevice, easy bool) *RelayUplinkForwardingRule {
+	this := &RelayUplinkForwardingRule{}
+	if r.Intn(5) != 0 {
+		this.Limits = NewPopulatedRelayForwardLimits(r, easy)
+	}
+	this.LastWFCnt = r.Uint32()
+	this.DeviceID = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRelayJoinRequestFilter(r randyEndDevice, easy bool) *RelayJoinRequestFilter {
+	this := &RelayJoinRequestFilter{}
+	this.Action = RelayFilterListAction([]int32{0, 1, 2}[r.Intn(3)])
+	v5 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v5
+	v6 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v6
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedServingRelayParameters(r randyEndDevice, easy bool) *ServingRelayParameters {
+	this := &ServingRelayParameters{}
+	if r.Intn(5) != 0 {
+		this.SecondChannel = NewPopulatedRelaySecondChannel(r, easy)
+	}
+	this.DefaultChannelIndex = r.Uint32()
+	this.CADPeriodicity = RelayCADPeriodicity([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.UplinkForwardingRules = make([]*RelayUplinkForwardingRule, v7)
+		for i := 0; i < v7; i++ {
+			this.UplinkForwardingRules[i] = NewPopulatedRelayUplinkForwardingRule(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.Limits = NewPopulatedMACCommand_RelayConfigureFwdLimitReq(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.JoinRequestFilters = make([]*RelayJoinRequestFilter, v8)
+		for i := 0; i < v8; i++ {
+			this.JoinRequestFilters[i] = NewPopulatedRelayJoinRequestFilter(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedServedRelayParameters(r randyEndDevice, easy bool) *ServedRelayParameters {
+	this := &ServedRelayParameters{}
+	this.Mode = RelayEndDeviceMode([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.SmartEnableLevel = RelaySmartEnableLevel([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.Backoff = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.SecondChannel = NewPopulatedRelaySecondChannel(r, easy)
+	}
+	this.ServingDeviceID = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRelayParameters(r randyEndDevice, easy bool) *RelayParameters {
+	this := &RelayParameters{}
+	if r.Intn(5) != 0 {
+		this.Serving = NewPopulatedServingRelayParameters(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Served = NewPopulatedServedRelayParameters(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndDeviceVersionIdentifiers(r randyEndDevice, easy bool) *EndDeviceVersionIdentifiers {
 	this := &EndDeviceVersionIdentifiers{}
 	this.BrandID = randStringEndDevice(r)
@@ -6985,9 +8522,9 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(5) != 0 {
 		this.Rx2Frequency = types.NewPopulatedUInt64Value(r, easy)
 	}
-	v5 := r.Intn(10)
-	this.FactoryPresetFrequencies = make([]uint64, v5)
-	for i := 0; i < v5; i++ {
+	v9 := r.Intn(10)
+	this.FactoryPresetFrequencies = make([]uint64, v9)
+	for i := 0; i < v9; i++ {
 		this.FactoryPresetFrequencies[i] = uint64(r.Uint32())
 	}
 	if r.Intn(5) != 0 {
@@ -7044,6 +8581,23 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(5) != 0 {
 		this.DesiredBeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.ADRAlgorithm = types.NewPopulatedStringValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(10)
+		this.ADRAlgorithmParameters = make(map[string]float32)
+		for i := 0; i < v10; i++ {
+			v11 := randStringEndDevice(r)
+			this.ADRAlgorithmParameters[v11] = float32(r.Float32())
+			if r.Intn(2) == 0 {
+				this.ADRAlgorithmParameters[v11] *= -1
+			}
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.DesiredRelay = NewPopulatedRelayParameters(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7051,8 +8605,8 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 
 func NewPopulatedMACState_JoinRequest(r randyEndDevice, easy bool) *MACState_JoinRequest {
 	this := &MACState_JoinRequest{}
-	v6 := NewPopulatedDLSettings(r, easy)
-	this.DownlinkSettings = *v6
+	v12 := NewPopulatedDLSettings(r, easy)
+	this.DownlinkSettings = *v12
 	this.RxDelay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
 	if r.Intn(5) != 0 {
 		this.CFList = NewPopulatedCFList(r, easy)
@@ -7064,24 +8618,24 @@ func NewPopulatedMACState_JoinRequest(r randyEndDevice, easy bool) *MACState_Joi
 
 func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
 	this := &MACState_JoinAccept{}
-	v7 := r.Intn(100)
-	this.Payload = make([]byte, v7)
-	for i := 0; i < v7; i++ {
+	v13 := r.Intn(100)
+	this.Payload = make([]byte, v13)
+	for i := 0; i < v13; i++ {
 		this.Payload[i] = byte(r.Intn(256))
 	}
-	v8 := NewPopulatedMACState_JoinRequest(r, easy)
-	this.Request = *v8
-	v9 := NewPopulatedSessionKeys(r, easy)
-	this.Keys = *v9
-	v10 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v10)
-	for i := 0; i < v10; i++ {
+	v14 := NewPopulatedMACState_JoinRequest(r, easy)
+	this.Request = *v14
+	v15 := NewPopulatedSessionKeys(r, easy)
+	this.Keys = *v15
+	v16 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v16)
+	for i := 0; i < v16; i++ {
 		this.CorrelationIDs[i] = randStringEndDevice(r)
 	}
-	v11 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
-	this.DevAddr = *v11
-	v12 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
-	this.NetID = *v12
+	v17 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v17
+	v18 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
+	this.NetID = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7099,9 +8653,9 @@ func NewPopulatedMACState_DataRateRange(r randyEndDevice, easy bool) *MACState_D
 func NewPopulatedMACState_DataRateRanges(r randyEndDevice, easy bool) *MACState_DataRateRanges {
 	this := &MACState_DataRateRanges{}
 	if r.Intn(5) != 0 {
-		v13 := r.Intn(5)
-		this.Ranges = make([]*MACState_DataRateRange, v13)
-		for i := 0; i < v13; i++ {
+		v19 := r.Intn(5)
+		this.Ranges = make([]*MACState_DataRateRange, v19)
+		for i := 0; i < v19; i++ {
 			this.Ranges[i] = NewPopulatedMACState_DataRateRange(r, easy)
 		}
 	}
@@ -7110,6 +8664,41 @@ func NewPopulatedMACState_DataRateRanges(r randyEndDevice, easy bool) *MACState_
 	return this
 }
 
+func NewPopulatedMACState_UplinkObservation(r randyEndDevice, easy bool) *MACState_UplinkObservation {
+	this := &MACState_UplinkObservation{}
+	this.FCnt = r.Uint32()
+	v20 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v20
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.SNR = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.SNR *= -1
+	}
+	this.RSSI = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.RSSI *= -1
+	}
+	this.GatewayCount = r.Uint32()
+	v21 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Airtime = *v21
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMACState_DownlinkObservation(r randyEndDevice, easy bool) *MACState_DownlinkObservation {
+	this := &MACState_DownlinkObservation{}
+	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.TransmitAt = *v22
+	this.Confirmed = bool(r.Intn(2) == 0)
+	this.Acknowledged = bool(r.Intn(2) == 0)
+	v23 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Airtime = *v23
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndDeviceAuthenticationCode(r randyEndDevice, easy bool) *EndDeviceAuthenticationCode {
 	this := &EndDeviceAuthenticationCode{}
 	this.Value = randStringEndDevice(r)
@@ -7126,18 +8715,18 @@ func NewPopulatedEndDeviceAuthenticationCode(r randyEndDevice, easy bool) *EndDe
 
 func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 	this := &EndDevice{}
-	v14 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v14
-	v15 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v15
-	v16 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v16
+	v24 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v24
+	v25 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v25
+	v26 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v26
 	this.Name = randStringEndDevice(r)
 	this.Description = randStringEndDevice(r)
 	if r.Intn(5) != 0 {
-		v17 := r.Intn(10)
+		v27 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v17; i++ {
+		for i := 0; i < v27; i++ {
 			this.Attributes[randStringEndDevice(r)] = randStringEndDevice(r)
 		}
 	}
@@ -7149,9 +8738,9 @@ func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 	this.ApplicationServerAddress = randStringEndDevice(r)
 	this.JoinServerAddress = randStringEndDevice(r)
 	if r.Intn(5) != 0 {
-		v18 := r.Intn(10)
+		v28 := r.Intn(10)
 		this.Locations = make(map[string]*Location)
-		for i := 0; i < v18; i++ {
+		for i := 0; i < v28; i++ {
 			this.Locations[randStringEndDevice(r)] = NewPopulatedLocation(r, easy)
 		}
 	}
@@ -7181,9 +8770,9 @@ func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 		this.PendingSession = NewPopulatedSession(r, easy)
 	}
 	this.LastDevNonce = r.Uint32()
-	v19 := r.Intn(10)
-	this.UsedDevNonces = make([]uint32, v19)
-	for i := 0; i < v19; i++ {
+	v29 := r.Intn(10)
+	this.UsedDevNonces = make([]uint32, v29)
+	for i := 0; i < v29; i++ {
 		this.UsedDevNonces[i] = r.Uint32()
 	}
 	this.LastJoinNonce = r.Uint32()
@@ -7201,9 +8790,9 @@ func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 		this.DownlinkMargin *= -1
 	}
 	if r.Intn(5) != 0 {
-		v20 := r.Intn(5)
-		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v20)
-		for i := 0; i < v20; i++ {
+		v30 := r.Intn(5)
+		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v30)
+		for i := 0; i < v30; i++ {
 			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -7231,6 +8820,8 @@ func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 	if r.Intn(5) != 0 {
 		this.SkipPayloadCryptoOverride = types.NewPopulatedBoolValue(r, easy)
 	}
+	this.NextRJCount0 = r.Uint32()
+	this.NextRJCount1 = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7239,9 +8830,9 @@ func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 	this := &EndDevices{}
 	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.EndDevices = make([]*EndDevice, v21)
-		for i := 0; i < v21; i++ {
+		v31 := r.Intn(5)
+		this.EndDevices = make([]*EndDevice, v31)
+		for i := 0; i < v31; i++ {
 			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
 		}
 	}
@@ -7252,8 +8843,8 @@ func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 
 func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
 	this := &CreateEndDeviceRequest{}
-	v22 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v22
+	v32 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v32
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7261,10 +8852,10 @@ func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndD
 
 func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
 	this := &UpdateEndDeviceRequest{}
-	v23 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v23
-	v24 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v24
+	v33 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v33
+	v34 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v34
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7272,10 +8863,10 @@ func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndD
 
 func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
 	this := &GetEndDeviceRequest{}
-	v25 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v25
-	v26 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v26
+	v35 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v35
+	v36 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v36
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7283,10 +8874,10 @@ func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceR
 
 func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
 	this := &GetEndDeviceIdentifiersForEUIsRequest{}
-	v27 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v27
-	v28 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v28
+	v37 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v37
+	v38 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v38
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7294,10 +8885,10 @@ func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bo
 
 func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
 	this := &ListEndDevicesRequest{}
-	v29 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v29
-	v30 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v30
+	v39 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v39
+	v40 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v40
 	this.Order = randStringEndDevice(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -7308,10 +8899,10 @@ func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevi
 
 func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
 	this := &SetEndDeviceRequest{}
-	v31 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v31
-	v32 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v32
+	v41 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v41
+	v42 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v42
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7319,10 +8910,10 @@ func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceR
 
 func NewPopulatedResetAndGetEndDeviceRequest(r randyEndDevice, easy bool) *ResetAndGetEndDeviceRequest {
 	this := &ResetAndGetEndDeviceRequest{}
-	v33 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v33
-	v34 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v34
+	v43 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v43
+	v44 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v44
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7330,10 +8921,10 @@ func NewPopulatedResetAndGetEndDeviceRequest(r randyEndDevice, easy bool) *Reset
 
 func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
 	this := &EndDeviceTemplate{}
-	v35 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v35
-	v36 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v36
+	v45 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v45
+	v46 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v46
 	this.MappingKey = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -7344,9 +8935,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 	this := &EndDeviceTemplateFormat{}
 	this.Name = randStringEndDevice(r)
 	this.Description = randStringEndDevice(r)
-	v37 := r.Intn(10)
-	this.FileExtensions = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v47 := r.Intn(10)
+	this.FileExtensions = make([]string, v47)
+	for i := 0; i < v47; i++ {
 		this.FileExtensions[i] = randStringEndDevice(r)
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -7357,9 +8948,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
 	this := &EndDeviceTemplateFormats{}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v48 := r.Intn(10)
 		this.Formats = make(map[string]*EndDeviceTemplateFormat)
-		for i := 0; i < v38; i++ {
+		for i := 0; i < v48; i++ {
 			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
 		}
 	}
@@ -7371,9 +8962,9 @@ func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDevic
 func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
 	this := &ConvertEndDeviceTemplateRequest{}
 	this.FormatID = randStringEndDevice(r)
-	v39 := r.Intn(100)
-	this.Data = make([]byte, v39)
-	for i := 0; i < v39; i++ {
+	v49 := r.Intn(100)
+	this.Data = make([]byte, v49)
+	for i := 0; i < v49; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -7400,9 +8991,9 @@ func randUTF8RuneEndDevice(r randyEndDevice) rune {
 	return rune(ru + 61)
 }
 func randStringEndDevice(r randyEndDevice) string {
-	v40 := r.Intn(100)
-	tmps := make([]rune, v40)
-	for i := 0; i < v40; i++ {
+	v50 := r.Intn(100)
+	tmps := make([]rune, v50)
+	for i := 0; i < v50; i++ {
 		tmps[i] = randUTF8RuneEndDevice(r)
 	}
 	return string(tmps)
@@ -7424,11 +9015,11 @@ func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v41 := r.Int63()
+		v51 := r.Int63()
 		if r.Intn(2) == 0 {
-			v41 *= -1
+			v51 *= -1
 		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v41))
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v51))
 	case 1:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -7566,6 +9157,10 @@ func (m *MACParameters) Size() (n int) {
 		l = m.PingSlotDataRateIndexValue.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.Relay != nil {
+		l = m.Relay.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -7593,47 +9188,161 @@ func (m *MACParameters_Channel) Size() (n int) {
 	return n
 }
 
-func (m *EndDeviceVersionIdentifiers) Size() (n int) {
+func (m *RelayUplinkForwardingRule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BrandID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.ModelID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.HardwareVersion)
-	if l > 0 {
+	if m.Limits != nil {
+		l = m.Limits.Size()
 		n += 1 + l + sovEndDevice(uint64(l))
 	}
-	l = len(m.FirmwareVersion)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.LastWFCnt != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastWFCnt))
 	}
-	l = len(m.BandID)
+	l = len(m.DeviceID)
 	if l > 0 {
 		n += 1 + l + sovEndDevice(uint64(l))
 	}
 	return n
 }
 
-func (m *EndDeviceVersion) Size() (n int) {
+func (m *RelayJoinRequestFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.EndDeviceVersionIdentifiers.Size()
+	if m.Action != 0 {
+		n += 1 + sovEndDevice(uint64(m.Action))
+	}
+	l = m.JoinEUI.Size()
 	n += 1 + l + sovEndDevice(uint64(l))
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *ServingRelayParameters) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.LoRaWANPHYVersion != 0 {
+	var l int
+	_ = l
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.DefaultChannelIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DefaultChannelIndex))
+	}
+	if m.CADPeriodicity != 0 {
+		n += 1 + sovEndDevice(uint64(m.CADPeriodicity))
+	}
+	if len(m.UplinkForwardingRules) > 0 {
+		for _, e := range m.UplinkForwardingRules {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.JoinRequestFilters) > 0 {
+		for _, e := range m.JoinRequestFilters {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServedRelayParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovEndDevice(uint64(m.Mode))
+	}
+	if m.SmartEnableLevel != 0 {
+		n += 1 + sovEndDevice(uint64(m.SmartEnableLevel))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovEndDevice(uint64(m.Backoff))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ServingDeviceID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Serving != nil {
+		l = m.Serving.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Served != nil {
+		l = m.Served.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceVersionIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrandID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ModelID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.HardwareVersion)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.FirmwareVersion)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.BandID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceVersionIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LoRaWANPHYVersion != 0 {
 		n += 1 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
 	}
 	l = len(m.FrequencyPlanID)
@@ -7794,6 +9503,22 @@ func (m *MACSettings) Size() (n int) {
 		l = m.DesiredBeaconFrequency.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.ADRAlgorithm != nil {
+		l = m.ADRAlgorithm.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.ADRAlgorithmParameters) > 0 {
+		for k, v := range m.ADRAlgorithmParameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 2 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	if m.DesiredRelay != nil {
+		l = m.DesiredRelay.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -7908,6 +9633,18 @@ func (m *MACState) Size() (n int) {
 	if m.LastADRChangeFCntUp != 0 {
 		n += 2 + sovEndDevice(uint64(m.LastADRChangeFCntUp))
 	}
+	if len(m.LinkQualityUplinks) > 0 {
+		for _, e := range m.LinkQualityUplinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.LinkQualityDownlinks) > 0 {
+		for _, e := range m.LinkQualityDownlinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7986,6 +9723,53 @@ func (m *MACState_DataRateRanges) Size() (n int) {
 	return n
 }
 
+func (m *MACState_UplinkObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FCnt != 0 {
+		n += 1 + sovEndDevice(uint64(m.FCnt))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.DataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DataRateIndex))
+	}
+	if m.SNR != 0 {
+		n += 5
+	}
+	if m.RSSI != 0 {
+		n += 5
+	}
+	if m.GatewayCount != 0 {
+		n += 1 + sovEndDevice(uint64(m.GatewayCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Airtime)
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *MACState_DownlinkObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TransmitAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.Confirmed {
+		n += 2
+	}
+	if m.Acknowledged {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Airtime)
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
 func (m *EndDeviceAuthenticationCode) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8205,6 +9989,12 @@ func (m *EndDevice) Size() (n int) {
 		l = m.SkipPayloadCryptoOverride.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.NextRJCount0 != 0 {
+		n += 2 + sovEndDevice(uint64(m.NextRJCount0))
+	}
+	if m.NextRJCount1 != 0 {
+		n += 2 + sovEndDevice(uint64(m.NextRJCount1))
+	}
 	return n
 }
 
@@ -8461,6 +10251,7 @@ func (this *MACParameters) String() string {
 		`ADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
 		`ADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
 		`PingSlotDataRateIndexValue:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndexValue), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`Relay:` + strings.Replace(this.Relay.String(), "RelayParameters", "RelayParameters", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8479,6 +10270,80 @@ func (this *MACParameters_Channel) String() string {
 	}, "")
 	return s
 }
+func (this *RelayUplinkForwardingRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayUplinkForwardingRule{`,
+		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`LastWFCnt:` + fmt.Sprintf("%v", this.LastWFCnt) + `,`,
+		`DeviceID:` + fmt.Sprintf("%v", this.DeviceID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayJoinRequestFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayJoinRequestFilter{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServingRelayParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkForwardingRules := "[]*RelayUplinkForwardingRule{"
+	for _, f := range this.UplinkForwardingRules {
+		repeatedStringForUplinkForwardingRules += strings.Replace(f.String(), "RelayUplinkForwardingRule", "RelayUplinkForwardingRule", 1) + ","
+	}
+	repeatedStringForUplinkForwardingRules += "}"
+	repeatedStringForJoinRequestFilters := "[]*RelayJoinRequestFilter{"
+	for _, f := range this.JoinRequestFilters {
+		repeatedStringForJoinRequestFilters += strings.Replace(f.String(), "RelayJoinRequestFilter", "RelayJoinRequestFilter", 1) + ","
+	}
+	repeatedStringForJoinRequestFilters += "}"
+	s := strings.Join([]string{`&ServingRelayParameters{`,
+		`SecondChannel:` + strings.Replace(fmt.Sprintf("%v", this.SecondChannel), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`DefaultChannelIndex:` + fmt.Sprintf("%v", this.DefaultChannelIndex) + `,`,
+		`CADPeriodicity:` + fmt.Sprintf("%v", this.CADPeriodicity) + `,`,
+		`UplinkForwardingRules:` + repeatedStringForUplinkForwardingRules + `,`,
+		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "MACCommand_RelayConfigureFwdLimitReq", "MACCommand_RelayConfigureFwdLimitReq", 1) + `,`,
+		`JoinRequestFilters:` + repeatedStringForJoinRequestFilters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServedRelayParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServedRelayParameters{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`SmartEnableLevel:` + fmt.Sprintf("%v", this.SmartEnableLevel) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`SecondChannel:` + strings.Replace(fmt.Sprintf("%v", this.SecondChannel), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`ServingDeviceID:` + fmt.Sprintf("%v", this.ServingDeviceID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayParameters{`,
+		`Serving:` + strings.Replace(this.Serving.String(), "ServingRelayParameters", "ServingRelayParameters", 1) + `,`,
+		`Served:` + strings.Replace(this.Served.String(), "ServedRelayParameters", "ServedRelayParameters", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EndDeviceVersionIdentifiers) String() string {
 	if this == nil {
 		return "nil"
@@ -8519,6 +10384,16 @@ func (this *MACSettings) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForADRAlgorithmParameters := make([]string, 0, len(this.ADRAlgorithmParameters))
+	for k := range this.ADRAlgorithmParameters {
+		keysForADRAlgorithmParameters = append(keysForADRAlgorithmParameters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForADRAlgorithmParameters)
+	mapStringForADRAlgorithmParameters := "map[string]float32{"
+	for _, k := range keysForADRAlgorithmParameters {
+		mapStringForADRAlgorithmParameters += fmt.Sprintf("%v: %v,", k, this.ADRAlgorithmParameters[k])
+	}
+	mapStringForADRAlgorithmParameters += "}"
 	s := strings.Join([]string{`&MACSettings{`,
 		`ClassBTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassBTimeout), "Duration", "types.Duration", 1) + `,`,
 		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
@@ -8548,6 +10423,9 @@ func (this *MACSettings) String() string {
 		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
 		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
 		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`ADRAlgorithm:` + strings.Replace(fmt.Sprintf("%v", this.ADRAlgorithm), "StringValue", "types.StringValue", 1) + `,`,
+		`ADRAlgorithmParameters:` + mapStringForADRAlgorithmParameters + `,`,
+		`DesiredRelay:` + strings.Replace(this.DesiredRelay.String(), "RelayParameters", "RelayParameters", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8576,6 +10454,16 @@ func (this *MACState) String() string {
 		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
 	}
 	repeatedStringForRecentDownlinks += "}"
+	repeatedStringForLinkQualityUplinks := "[]*MACState_UplinkObservation{"
+	for _, f := range this.LinkQualityUplinks {
+		repeatedStringForLinkQualityUplinks += strings.Replace(fmt.Sprintf("%v", f), "MACState_UplinkObservation", "MACState_UplinkObservation", 1) + ","
+	}
+	repeatedStringForLinkQualityUplinks += "}"
+	repeatedStringForLinkQualityDownlinks := "[]*MACState_DownlinkObservation{"
+	for _, f := range this.LinkQualityDownlinks {
+		repeatedStringForLinkQualityDownlinks += strings.Replace(fmt.Sprintf("%v", f), "MACState_DownlinkObservation", "MACState_DownlinkObservation", 1) + ","
+	}
+	repeatedStringForLinkQualityDownlinks += "}"
 	keysForRejectedDataRateRanges := make([]uint64, 0, len(this.RejectedDataRateRanges))
 	for k := range this.RejectedDataRateRanges {
 		keysForRejectedDataRateRanges = append(keysForRejectedDataRateRanges, k)
@@ -8609,6 +10497,8 @@ func (this *MACState) String() string {
 		`LastDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`RejectedDataRateRanges:` + mapStringForRejectedDataRateRanges + `,`,
 		`LastADRChangeFCntUp:` + fmt.Sprintf("%v", this.LastADRChangeFCntUp) + `,`,
+		`LinkQualityUplinks:` + repeatedStringForLinkQualityUplinks + `,`,
+		`LinkQualityDownlinks:` + repeatedStringForLinkQualityDownlinks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8666,6 +10556,35 @@ func (this *MACState_DataRateRanges) String() string {
 	}, "")
 	return s
 }
+func (this *MACState_UplinkObservation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACState_UplinkObservation{`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`ReceivedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReceivedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`SNR:` + fmt.Sprintf("%v", this.SNR) + `,`,
+		`RSSI:` + fmt.Sprintf("%v", this.RSSI) + `,`,
+		`GatewayCount:` + fmt.Sprintf("%v", this.GatewayCount) + `,`,
+		`Airtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Airtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState_DownlinkObservation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACState_DownlinkObservation{`,
+		`TransmitAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TransmitAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Confirmed:` + fmt.Sprintf("%v", this.Confirmed) + `,`,
+		`Acknowledged:` + fmt.Sprintf("%v", this.Acknowledged) + `,`,
+		`Airtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Airtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EndDeviceAuthenticationCode) String() string {
 	if this == nil {
 		return "nil"
@@ -8757,6 +10676,8 @@ func (this *EndDevice) String() string {
 		`Picture:` + strings.Replace(fmt.Sprintf("%v", this.Picture), "Picture", "Picture", 1) + `,`,
 		`SkipPayloadCrypto:` + fmt.Sprintf("%v", this.SkipPayloadCrypto) + `,`,
 		`SkipPayloadCryptoOverride:` + strings.Replace(fmt.Sprintf("%v", this.SkipPayloadCryptoOverride), "BoolValue", "types.BoolValue", 1) + `,`,
+		`NextRJCount0:` + fmt.Sprintf("%v", this.NextRJCount0) + `,`,
+		`NextRJCount1:` + fmt.Sprintf("%v", this.NextRJCount1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9719,6 +11640,42 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Relay == nil {
+				m.Relay = &RelayParameters{}
+			}
+			if err := m.Relay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -9892,7 +11849,7 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
+func (m *RelayUplinkForwardingRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9915,17 +11872,17 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayUplinkForwardingRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayUplinkForwardingRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9935,29 +11892,33 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrandID = string(dAtA[iNdEx:postIndex])
+			if m.Limits == nil {
+				m.Limits = &RelayForwardLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWFCnt", wireType)
 			}
-			var stringLen uint64
+			m.LastWFCnt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9967,27 +11928,14 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastWFCnt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ModelID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardwareVersion", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10015,13 +11963,85 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HardwareVersion = string(dAtA[iNdEx:postIndex])
+			m.DeviceID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayJoinRequestFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayJoinRequestFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayJoinRequestFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RelayFilterListAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirmwareVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10031,29 +12051,30 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirmwareVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BandID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10063,23 +12084,24 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BandID = string(dAtA[iNdEx:postIndex])
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10105,7 +12127,7 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
+func (m *ServingRelayParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10128,15 +12150,15 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServingRelayParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServingRelayParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceVersionIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10163,15 +12185,18 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelIndex", wireType)
 			}
-			m.LoRaWANVersion = 0
+			m.DefaultChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10181,16 +12206,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				m.DefaultChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CADPeriodicity", wireType)
 			}
-			m.LoRaWANPHYVersion = 0
+			m.CADPeriodicity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10200,16 +12225,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				m.CADPeriodicity |= RelayCADPeriodicity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkForwardingRules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10219,29 +12244,31 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			m.UplinkForwardingRules = append(m.UplinkForwardingRules, &RelayUplinkForwardingRule{})
+			if err := m.UplinkForwardingRules[len(m.UplinkForwardingRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10251,67 +12278,31 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupportsClassB = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
+			if m.Limits == nil {
+				m.Limits = &MACCommand_RelayConfigureFwdLimitReq{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SupportsClassC = bool(v != 0)
-		case 8:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMACSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestFilters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10338,18 +12329,69 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultMACSettings == nil {
-				m.DefaultMACSettings = &MACSettings{}
-			}
-			if err := m.DefaultMACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JoinRequestFilters = append(m.JoinRequestFilters, &RelayJoinRequestFilter{})
+			if err := m.JoinRequestFilters[len(m.JoinRequestFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServedRelayParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServedRelayParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServedRelayParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.MinFrequency = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10359,16 +12401,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinFrequency |= uint64(b&0x7F) << shift
+				m.Mode |= RelayEndDeviceMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartEnableLevel", wireType)
 			}
-			m.MaxFrequency = 0
+			m.SmartEnableLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10378,16 +12420,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxFrequency |= uint64(b&0x7F) << shift
+				m.SmartEnableLevel |= RelaySmartEnableLevel(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var v int
+			m.Backoff = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10397,17 +12439,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Backoff |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsJoin = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10417,17 +12458,33 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ResetsJoinNonces = bool(v != 0)
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServingDeviceID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10437,24 +12494,23 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServingDeviceID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10480,7 +12536,7 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACSettings) Unmarshal(dAtA []byte) error {
+func (m *RelayParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10503,15 +12559,15 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MACSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassBTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Serving", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10538,16 +12594,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassBTimeout == nil {
-				m.ClassBTimeout = new(time.Duration)
+			if m.Serving == nil {
+				m.Serving = &ServingRelayParameters{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassBTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Serving.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Served", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10574,54 +12630,71 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotPeriodicity == nil {
-				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
+			if m.Served == nil {
+				m.Served = &ServedRelayParameters{}
 			}
-			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Served.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotDataRateIndex == nil {
-				m.PingSlotDataRateIndex = &DataRateIndexValue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			if err := m.PingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10631,33 +12704,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotFrequency == nil {
-				m.PingSlotFrequency = &types.UInt64Value{}
-			}
-			if err := m.PingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BrandID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassCTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10667,33 +12736,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassCTimeout == nil {
-				m.ClassCTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassCTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModelID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10703,33 +12768,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx1Delay == nil {
-				m.Rx1Delay = &RxDelayValue{}
-			}
-			if err := m.Rx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HardwareVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirmwareVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10739,33 +12800,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx1DataRateOffset == nil {
-				m.Rx1DataRateOffset = &types.UInt32Value{}
-			}
-			if err := m.Rx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FirmwareVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BandID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10775,143 +12832,80 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx2DataRateIndex == nil {
-				m.Rx2DataRateIndex = &DataRateIndexValue{}
-			}
-			if err := m.Rx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BandID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx2Frequency == nil {
-				m.Rx2Frequency = &types.UInt64Value{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			if err := m.Rx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.FactoryPresetFrequencies) == 0 {
-					m.FactoryPresetFrequencies = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field FactoryPresetFrequencies", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceVersionIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10938,18 +12932,15 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDutyCycle == nil {
-				m.MaxDutyCycle = &AggregatedDutyCycleValue{}
-			}
-			if err := m.MaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supports32BitFCnt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
 			}
-			var msglen int
+			m.LoRaWANVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10959,33 +12950,35 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Supports32BitFCnt == nil {
-				m.Supports32BitFCnt = &types.BoolValue{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
 			}
-			if err := m.Supports32BitFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LoRaWANPHYVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseADR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10995,33 +12988,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UseADR == nil {
-				m.UseADR = &types.BoolValue{}
-			}
-			if err := m.UseADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRMargin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11031,33 +13020,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRMargin == nil {
-				m.ADRMargin = &types.FloatValue{}
-			}
-			if err := m.ADRMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11067,31 +13052,35 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResetsFCnt == nil {
-				m.ResetsFCnt = &types.BoolValue{}
+			m.SupportsClassB = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
 			}
-			if err := m.ResetsFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 16:
+			m.SupportsClassC = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTimePeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMACSettings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11118,18 +13107,18 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTimePeriodicity == nil {
-				m.StatusTimePeriodicity = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusTimePeriodicity, dAtA[iNdEx:postIndex]); err != nil {
+			if m.DefaultMACSettings == nil {
+				m.DefaultMACSettings = &MACSettings{}
+			}
+			if err := m.DefaultMACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusCountPeriodicity", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
 			}
-			var msglen int
+			m.MinFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11139,33 +13128,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StatusCountPeriodicity == nil {
-				m.StatusCountPeriodicity = &types.UInt32Value{}
-			}
-			if err := m.StatusCountPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1Delay", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
 			}
-			var msglen int
+			m.MaxFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11175,31 +13147,54 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.DesiredRx1Delay == nil {
-				m.DesiredRx1Delay = &RxDelayValue{}
+			m.SupportsJoin = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
 			}
-			if err := m.DesiredRx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 19:
+			m.ResetsJoinNonces = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11226,16 +13221,66 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx1DataRateOffset == nil {
-				m.DesiredRx1DataRateOffset = &types.UInt32Value{}
-			}
-			if err := m.DesiredRx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassBTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11262,16 +13307,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx2DataRateIndex == nil {
-				m.DesiredRx2DataRateIndex = &DataRateIndexValue{}
+			if m.ClassBTimeout == nil {
+				m.ClassBTimeout = new(time.Duration)
 			}
-			if err := m.DesiredRx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassBTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11298,16 +13343,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx2Frequency == nil {
-				m.DesiredRx2Frequency = &types.UInt64Value{}
+			if m.PingSlotPeriodicity == nil {
+				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
 			}
-			if err := m.DesiredRx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11334,16 +13379,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredMaxDutyCycle == nil {
-				m.DesiredMaxDutyCycle = &AggregatedDutyCycleValue{}
+			if m.PingSlotDataRateIndex == nil {
+				m.PingSlotDataRateIndex = &DataRateIndexValue{}
 			}
-			if err := m.DesiredMaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckLimitExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11370,16 +13415,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredADRAckLimitExponent == nil {
-				m.DesiredADRAckLimitExponent = &ADRAckLimitExponentValue{}
+			if m.PingSlotFrequency == nil {
+				m.PingSlotFrequency = &types.UInt64Value{}
 			}
-			if err := m.DesiredADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckDelayExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassCTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11406,16 +13451,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredADRAckDelayExponent == nil {
-				m.DesiredADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			if m.ClassCTimeout == nil {
+				m.ClassCTimeout = new(time.Duration)
 			}
-			if err := m.DesiredADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassCTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11442,16 +13487,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeaconFrequency == nil {
-				m.BeaconFrequency = &types.UInt64Value{}
+			if m.Rx1Delay == nil {
+				m.Rx1Delay = &RxDelayValue{}
 			}
-			if err := m.BeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11478,16 +13523,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredPingSlotDataRateIndex == nil {
-				m.DesiredPingSlotDataRateIndex = &DataRateIndexValue{}
+			if m.Rx1DataRateOffset == nil {
+				m.Rx1DataRateOffset = &types.UInt32Value{}
 			}
-			if err := m.DesiredPingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11514,16 +13559,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredPingSlotFrequency == nil {
-				m.DesiredPingSlotFrequency = &types.UInt64Value{}
+			if m.Rx2DataRateIndex == nil {
+				m.Rx2DataRateIndex = &DataRateIndexValue{}
 			}
-			if err := m.DesiredPingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredBeaconFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11550,69 +13595,92 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredBeaconFrequency == nil {
-				m.DesiredBeaconFrequency = &types.UInt64Value{}
+			if m.Rx2Frequency == nil {
+				m.Rx2Frequency = &types.UInt64Value{}
 			}
-			if err := m.DesiredBeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FactoryPresetFrequencies) == 0 {
+					m.FactoryPresetFrequencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryPresetFrequencies", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11639,13 +13707,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxDutyCycle == nil {
+				m.MaxDutyCycle = &AggregatedDutyCycleValue{}
+			}
+			if err := m.MaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supports32BitFCnt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11672,51 +13743,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DesiredParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Supports32BitFCnt == nil {
+				m.Supports32BitFCnt = &types.BoolValue{}
+			}
+			if err := m.Supports32BitFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceClass", wireType)
-			}
-			m.DeviceClass = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeviceClass |= Class(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
-			}
-			m.LoRaWANVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastConfirmedDownlinkAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseADR", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11743,35 +13779,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastConfirmedDownlinkAt == nil {
-				m.LastConfirmedDownlinkAt = new(time.Time)
+			if m.UseADR == nil {
+				m.UseADR = &types.BoolValue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastConfirmedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UseADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusFCntUp", wireType)
-			}
-			m.LastDevStatusFCntUp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastDevStatusFCntUp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRMargin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11798,16 +13815,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotPeriodicity == nil {
-				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
+			if m.ADRMargin == nil {
+				m.ADRMargin = &types.FloatValue{}
 			}
-			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ADRMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11834,16 +13851,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingApplicationDownlink == nil {
-				m.PendingApplicationDownlink = &ApplicationDownlink{}
+			if m.ResetsFCnt == nil {
+				m.ResetsFCnt = &types.BoolValue{}
 			}
-			if err := m.PendingApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResetsFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTimePeriodicity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11870,14 +13887,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedResponses = append(m.QueuedResponses, &MACCommand{})
-			if err := m.QueuedResponses[len(m.QueuedResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StatusTimePeriodicity == nil {
+				m.StatusTimePeriodicity = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusTimePeriodicity, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCountPeriodicity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11904,14 +13923,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingRequests = append(m.PendingRequests, &MACCommand{})
-			if err := m.PendingRequests[len(m.PendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StatusCountPeriodicity == nil {
+				m.StatusCountPeriodicity = &types.UInt32Value{}
+			}
+			if err := m.StatusCountPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJoinAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1Delay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11938,16 +13959,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedJoinAccept == nil {
-				m.QueuedJoinAccept = &MACState_JoinAccept{}
+			if m.DesiredRx1Delay == nil {
+				m.DesiredRx1Delay = &RxDelayValue{}
 			}
-			if err := m.QueuedJoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredRx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingJoinRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1DataRateOffset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11974,18 +13995,18 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingJoinRequest == nil {
-				m.PendingJoinRequest = &MACState_JoinRequest{}
-			}
-			if err := m.PendingJoinRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DesiredRx1DataRateOffset == nil {
+				m.DesiredRx1DataRateOffset = &types.UInt32Value{}
+			}
+			if err := m.DesiredRx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxWindowsAvailable", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2DataRateIndex", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11995,15 +14016,31 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RxWindowsAvailable = bool(v != 0)
-		case 14:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredRx2DataRateIndex == nil {
+				m.DesiredRx2DataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.DesiredRx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2Frequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12030,14 +14067,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
-			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DesiredRx2Frequency == nil {
+				m.DesiredRx2Frequency = &types.UInt64Value{}
+			}
+			if err := m.DesiredRx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDutyCycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12064,14 +14103,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
-			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DesiredMaxDutyCycle == nil {
+				m.DesiredMaxDutyCycle = &AggregatedDutyCycleValue{}
+			}
+			if err := m.DesiredMaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastNetworkInitiatedDownlinkAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckLimitExponent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12098,178 +14139,266 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastNetworkInitiatedDownlinkAt == nil {
-				m.LastNetworkInitiatedDownlinkAt = new(time.Time)
+			if m.DesiredADRAckLimitExponent == nil {
+				m.DesiredADRAckLimitExponent = &ADRAckLimitExponentValue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastNetworkInitiatedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType == 0 {
-				var v DataRateIndex
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= DataRateIndex(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RejectedADRDataRateIndexes = append(m.RejectedADRDataRateIndexes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckDelayExponent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.RejectedADRDataRateIndexes) == 0 {
-					m.RejectedADRDataRateIndexes = make([]DataRateIndex, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v DataRateIndex
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= DataRateIndex(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RejectedADRDataRateIndexes = append(m.RejectedADRDataRateIndexes, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedADRDataRateIndexes", wireType)
 			}
-		case 18:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredADRAckDelayExponent == nil {
+				m.DesiredADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			}
+			if err := m.DesiredADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				m.RejectedADRTxPowerIndexes = append(m.RejectedADRTxPowerIndexes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeaconFrequency == nil {
+				m.BeaconFrequency = &types.UInt64Value{}
+			}
+			if err := m.BeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotDataRateIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RejectedADRTxPowerIndexes) == 0 {
-					m.RejectedADRTxPowerIndexes = make([]uint32, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RejectedADRTxPowerIndexes = append(m.RejectedADRTxPowerIndexes, v)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredPingSlotDataRateIndex == nil {
+				m.DesiredPingSlotDataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.DesiredPingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotFrequency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedADRTxPowerIndexes", wireType)
 			}
-		case 19:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredPingSlotFrequency == nil {
+				m.DesiredPingSlotFrequency = &types.UInt64Value{}
+			}
+			if err := m.DesiredPingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredBeaconFrequency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				m.RejectedFrequencies = append(m.RejectedFrequencies, v)
-			} else if wireType == 2 {
-				var packedLen int
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredBeaconFrequency == nil {
+				m.DesiredBeaconFrequency = &types.UInt64Value{}
+			}
+			if err := m.DesiredBeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAlgorithm == nil {
+				m.ADRAlgorithm = &types.StringValue{}
+			}
+			if err := m.ADRAlgorithm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithmParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAlgorithmParameters == nil {
+				m.ADRAlgorithmParameters = make(map[string]float32)
+			}
+			var mapkey string
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowEndDevice
@@ -12279,34 +14408,14 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RejectedFrequencies) == 0 {
-					m.RejectedFrequencies = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowEndDevice
@@ -12316,19 +14425,1356 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.RejectedFrequencies = append(m.RejectedFrequencies, v)
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEndDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedFrequencies", wireType)
 			}
-		case 20:
+			m.ADRAlgorithmParameters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredRelay == nil {
+				m.DesiredRelay = &RelayParameters{}
+			}
+			if err := m.DesiredRelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DesiredParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceClass", wireType)
+			}
+			m.DeviceClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviceClass |= Class(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			}
+			m.LoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConfirmedDownlinkAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastConfirmedDownlinkAt == nil {
+				m.LastConfirmedDownlinkAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastConfirmedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusFCntUp", wireType)
+			}
+			m.LastDevStatusFCntUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDevStatusFCntUp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PingSlotPeriodicity == nil {
+				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
+			}
+			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingApplicationDownlink == nil {
+				m.PendingApplicationDownlink = &ApplicationDownlink{}
+			}
+			if err := m.PendingApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedResponses = append(m.QueuedResponses, &MACCommand{})
+			if err := m.QueuedResponses[len(m.QueuedResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRequests = append(m.PendingRequests, &MACCommand{})
+			if err := m.PendingRequests[len(m.PendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJoinAccept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedJoinAccept == nil {
+				m.QueuedJoinAccept = &MACState_JoinAccept{}
+			}
+			if err := m.QueuedJoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingJoinRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingJoinRequest == nil {
+				m.PendingJoinRequest = &MACState_JoinRequest{}
+			}
+			if err := m.PendingJoinRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindowsAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RxWindowsAvailable = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
+			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
+			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNetworkInitiatedDownlinkAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastNetworkInitiatedDownlinkAt == nil {
+				m.LastNetworkInitiatedDownlinkAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastNetworkInitiatedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType == 0 {
+				var v DataRateIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= DataRateIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RejectedADRDataRateIndexes = append(m.RejectedADRDataRateIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RejectedADRDataRateIndexes) == 0 {
+					m.RejectedADRDataRateIndexes = make([]DataRateIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v DataRateIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= DataRateIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RejectedADRDataRateIndexes = append(m.RejectedADRDataRateIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedADRDataRateIndexes", wireType)
+			}
+		case 18:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RejectedADRTxPowerIndexes = append(m.RejectedADRTxPowerIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RejectedADRTxPowerIndexes) == 0 {
+					m.RejectedADRTxPowerIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RejectedADRTxPowerIndexes = append(m.RejectedADRTxPowerIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedADRTxPowerIndexes", wireType)
+			}
+		case 19:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RejectedFrequencies = append(m.RejectedFrequencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RejectedFrequencies) == 0 {
+					m.RejectedFrequencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RejectedFrequencies = append(m.RejectedFrequencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedFrequencies", wireType)
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDownlinkAt == nil {
+				m.LastDownlinkAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedDataRateRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RejectedDataRateRanges == nil {
+				m.RejectedDataRateRanges = make(map[uint64]*MACState_DataRateRanges)
+			}
+			var mapkey uint64
+			var mapvalue *MACState_DataRateRanges
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MACState_DataRateRanges{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEndDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RejectedDataRateRanges[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastADRChangeFCntUp", wireType)
+			}
+			m.LastADRChangeFCntUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastADRChangeFCntUp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkQualityUplinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkQualityUplinks = append(m.LinkQualityUplinks, &MACState_UplinkObservation{})
+			if err := m.LinkQualityUplinks[len(m.LinkQualityUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkQualityDownlinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkQualityDownlinks = append(m.LinkQualityDownlinks, &MACState_DownlinkObservation{})
+			if err := m.LinkQualityDownlinks[len(m.LinkQualityDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DownlinkSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+			}
+			m.RxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxDelay |= RxDelay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CFList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CFList == nil {
+				m.CFList = &CFList{}
+			}
+			if err := m.CFList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAccept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12355,18 +15801,15 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDownlinkAt == nil {
-				m.LastDownlinkAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Keys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedDataRateRanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12376,112 +15819,62 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RejectedDataRateRanges == nil {
-				m.RejectedDataRateRanges = make(map[uint64]*MACState_DataRateRanges)
+			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
 			}
-			var mapkey uint64
-			var mapvalue *MACState_DataRateRanges
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &MACState_DataRateRanges{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEndDevice(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RejectedDataRateRanges[mapkey] = mapvalue
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastADRChangeFCntUp", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
 			}
-			m.LastADRChangeFCntUp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12491,11 +15884,25 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastADRChangeFCntUp |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -12520,7 +15927,7 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
+func (m *MACState_DataRateRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12543,17 +15950,17 @@ func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRateRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRateRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSettings", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
 			}
-			var msglen int
+			m.MinDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12563,30 +15970,16 @@ func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DownlinkSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
 			}
-			m.RxDelay = 0
+			m.MaxDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12596,14 +15989,67 @@ func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RxDelay |= RxDelay(b&0x7F) << shift
+				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState_DataRateRanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRateRanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRateRanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CFList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12630,10 +16076,8 @@ func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CFList == nil {
-				m.CFList = &CFList{}
-			}
-			if err := m.CFList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ranges = append(m.Ranges, &MACState_DataRateRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12661,7 +16105,7 @@ func (m *MACState_JoinRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
+func (m *MACState_UplinkObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12684,17 +16128,17 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinAccept: wiretype end group for non-group")
+			return fmt.Errorf("proto: UplinkObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UplinkObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
 			}
-			var byteLen int
+			m.FCnt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12704,29 +16148,14 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.FCnt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12753,15 +16182,15 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			var msglen int
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12771,62 +16200,38 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Keys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationIDs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEndDevice
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.SNR = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSI", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.RSSI = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCount", wireType)
 			}
-			var byteLen int
+			m.GatewayCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12836,30 +16241,16 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GatewayCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Airtime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12869,22 +16260,22 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Airtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12912,7 +16303,7 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACState_DataRateRange) Unmarshal(dAtA []byte) error {
+func (m *MACState_DownlinkObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12935,17 +16326,17 @@ func (m *MACState_DataRateRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataRateRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: DownlinkObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRateRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DownlinkObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmitAt", wireType)
 			}
-			m.MinDataRateIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12955,16 +16346,30 @@ func (m *MACState_DataRateRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TransmitAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
 			}
-			m.MaxDataRateIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12974,67 +16379,35 @@ func (m *MACState_DataRateRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACState_DataRateRanges) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Confirmed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataRateRanges: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRateRanges: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Acknowledged = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Airtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13061,8 +16434,7 @@ func (m *MACState_DataRateRanges) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ranges = append(m.Ranges, &MACState_DataRateRange{})
-			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Airtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14942,6 +18314,44 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRJCount0", wireType)
+			}
+			m.NextRJCount0 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRJCount0 |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRJCount1", wireType)
+			}
+			m.NextRJCount1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRJCount1 |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
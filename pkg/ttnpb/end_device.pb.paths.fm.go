@@ -61,6 +61,39 @@ var MACParametersFieldPathsNested = []string{
 	"ping_slot_frequency",
 	"rejoin_count_periodicity",
 	"rejoin_time_periodicity",
+	"relay",
+	"relay.served",
+	"relay.served.backoff",
+	"relay.served.mode",
+	"relay.served.second_channel",
+	"relay.served.second_channel.ack_offset",
+	"relay.served.second_channel.data_rate_index",
+	"relay.served.second_channel.frequency",
+	"relay.served.serving_device_id",
+	"relay.served.smart_enable_level",
+	"relay.serving",
+	"relay.serving.cad_periodicity",
+	"relay.serving.default_channel_index",
+	"relay.serving.join_request_filters",
+	"relay.serving.limits",
+	"relay.serving.limits.global_uplink_limits",
+	"relay.serving.limits.global_uplink_limits.bucket_size",
+	"relay.serving.limits.global_uplink_limits.reload_rate",
+	"relay.serving.limits.join_request_limits",
+	"relay.serving.limits.join_request_limits.bucket_size",
+	"relay.serving.limits.join_request_limits.reload_rate",
+	"relay.serving.limits.notify_limits",
+	"relay.serving.limits.notify_limits.bucket_size",
+	"relay.serving.limits.notify_limits.reload_rate",
+	"relay.serving.limits.overall_limits",
+	"relay.serving.limits.overall_limits.bucket_size",
+	"relay.serving.limits.overall_limits.reload_rate",
+	"relay.serving.limits.reset_limit_counter",
+	"relay.serving.second_channel",
+	"relay.serving.second_channel.ack_offset",
+	"relay.serving.second_channel.data_rate_index",
+	"relay.serving.second_channel.frequency",
+	"relay.serving.uplink_forwarding_rules",
 	"rx1_data_rate_offset",
 	"rx1_delay",
 	"rx2_data_rate_index",
@@ -86,12 +119,127 @@ var MACParametersFieldPathsTopLevel = []string{
 	"ping_slot_frequency",
 	"rejoin_count_periodicity",
 	"rejoin_time_periodicity",
+	"relay",
 	"rx1_data_rate_offset",
 	"rx1_delay",
 	"rx2_data_rate_index",
 	"rx2_frequency",
 	"uplink_dwell_time",
 }
+var RelayUplinkForwardingRuleFieldPathsNested = []string{
+	"device_id",
+	"last_w_f_cnt",
+	"limits",
+	"limits.bucket_size",
+	"limits.reload_rate",
+}
+
+var RelayUplinkForwardingRuleFieldPathsTopLevel = []string{
+	"device_id",
+	"last_w_f_cnt",
+	"limits",
+}
+var RelayJoinRequestFilterFieldPathsNested = []string{
+	"action",
+	"dev_eui",
+	"join_eui",
+}
+
+var RelayJoinRequestFilterFieldPathsTopLevel = []string{
+	"action",
+	"dev_eui",
+	"join_eui",
+}
+var ServingRelayParametersFieldPathsNested = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"join_request_filters",
+	"limits",
+	"limits.global_uplink_limits",
+	"limits.global_uplink_limits.bucket_size",
+	"limits.global_uplink_limits.reload_rate",
+	"limits.join_request_limits",
+	"limits.join_request_limits.bucket_size",
+	"limits.join_request_limits.reload_rate",
+	"limits.notify_limits",
+	"limits.notify_limits.bucket_size",
+	"limits.notify_limits.reload_rate",
+	"limits.overall_limits",
+	"limits.overall_limits.bucket_size",
+	"limits.overall_limits.reload_rate",
+	"limits.reset_limit_counter",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+	"uplink_forwarding_rules",
+}
+
+var ServingRelayParametersFieldPathsTopLevel = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"join_request_filters",
+	"limits",
+	"second_channel",
+	"uplink_forwarding_rules",
+}
+var ServedRelayParametersFieldPathsNested = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+	"serving_device_id",
+	"smart_enable_level",
+}
+
+var ServedRelayParametersFieldPathsTopLevel = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"serving_device_id",
+	"smart_enable_level",
+}
+var RelayParametersFieldPathsNested = []string{
+	"served",
+	"served.backoff",
+	"served.mode",
+	"served.second_channel",
+	"served.second_channel.ack_offset",
+	"served.second_channel.data_rate_index",
+	"served.second_channel.frequency",
+	"served.serving_device_id",
+	"served.smart_enable_level",
+	"serving",
+	"serving.cad_periodicity",
+	"serving.default_channel_index",
+	"serving.join_request_filters",
+	"serving.limits",
+	"serving.limits.global_uplink_limits",
+	"serving.limits.global_uplink_limits.bucket_size",
+	"serving.limits.global_uplink_limits.reload_rate",
+	"serving.limits.join_request_limits",
+	"serving.limits.join_request_limits.bucket_size",
+	"serving.limits.join_request_limits.reload_rate",
+	"serving.limits.notify_limits",
+	"serving.limits.notify_limits.bucket_size",
+	"serving.limits.notify_limits.reload_rate",
+	"serving.limits.overall_limits",
+	"serving.limits.overall_limits.bucket_size",
+	"serving.limits.overall_limits.reload_rate",
+	"serving.limits.reset_limit_counter",
+	"serving.second_channel",
+	"serving.second_channel.ack_offset",
+	"serving.second_channel.data_rate_index",
+	"serving.second_channel.frequency",
+	"serving.uplink_forwarding_rules",
+}
+
+var RelayParametersFieldPathsTopLevel = []string{
+	"served",
+	"serving",
+}
 var EndDeviceVersionIdentifiersFieldPathsNested = []string{
 	"band_id",
 	"brand_id",
@@ -114,6 +262,8 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
+	"default_mac_settings.adr_algorithm",
+	"default_mac_settings.adr_algorithm_parameters",
 	"default_mac_settings.adr_margin",
 	"default_mac_settings.beacon_frequency",
 	"default_mac_settings.class_b_timeout",
@@ -128,6 +278,39 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.desired_ping_slot_data_rate_index",
 	"default_mac_settings.desired_ping_slot_data_rate_index.value",
 	"default_mac_settings.desired_ping_slot_frequency",
+	"default_mac_settings.desired_relay",
+	"default_mac_settings.desired_relay.served",
+	"default_mac_settings.desired_relay.served.backoff",
+	"default_mac_settings.desired_relay.served.mode",
+	"default_mac_settings.desired_relay.served.second_channel",
+	"default_mac_settings.desired_relay.served.second_channel.ack_offset",
+	"default_mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"default_mac_settings.desired_relay.served.second_channel.frequency",
+	"default_mac_settings.desired_relay.served.serving_device_id",
+	"default_mac_settings.desired_relay.served.smart_enable_level",
+	"default_mac_settings.desired_relay.serving",
+	"default_mac_settings.desired_relay.serving.cad_periodicity",
+	"default_mac_settings.desired_relay.serving.default_channel_index",
+	"default_mac_settings.desired_relay.serving.join_request_filters",
+	"default_mac_settings.desired_relay.serving.limits",
+	"default_mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"default_mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"default_mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"default_mac_settings.desired_relay.serving.limits.join_request_limits",
+	"default_mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"default_mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"default_mac_settings.desired_relay.serving.limits.notify_limits",
+	"default_mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"default_mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"default_mac_settings.desired_relay.serving.limits.overall_limits",
+	"default_mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"default_mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"default_mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"default_mac_settings.desired_relay.serving.second_channel",
+	"default_mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"default_mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"default_mac_settings.desired_relay.serving.second_channel.frequency",
+	"default_mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"default_mac_settings.desired_rx1_data_rate_offset",
 	"default_mac_settings.desired_rx1_delay",
 	"default_mac_settings.desired_rx1_delay.value",
@@ -187,6 +370,8 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 	"supports_join",
 }
 var MACSettingsFieldPathsNested = []string{
+	"adr_algorithm",
+	"adr_algorithm_parameters",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -201,6 +386,39 @@ var MACSettingsFieldPathsNested = []string{
 	"desired_ping_slot_data_rate_index",
 	"desired_ping_slot_data_rate_index.value",
 	"desired_ping_slot_frequency",
+	"desired_relay",
+	"desired_relay.served",
+	"desired_relay.served.backoff",
+	"desired_relay.served.mode",
+	"desired_relay.served.second_channel",
+	"desired_relay.served.second_channel.ack_offset",
+	"desired_relay.served.second_channel.data_rate_index",
+	"desired_relay.served.second_channel.frequency",
+	"desired_relay.served.serving_device_id",
+	"desired_relay.served.smart_enable_level",
+	"desired_relay.serving",
+	"desired_relay.serving.cad_periodicity",
+	"desired_relay.serving.default_channel_index",
+	"desired_relay.serving.join_request_filters",
+	"desired_relay.serving.limits",
+	"desired_relay.serving.limits.global_uplink_limits",
+	"desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"desired_relay.serving.limits.join_request_limits",
+	"desired_relay.serving.limits.join_request_limits.bucket_size",
+	"desired_relay.serving.limits.join_request_limits.reload_rate",
+	"desired_relay.serving.limits.notify_limits",
+	"desired_relay.serving.limits.notify_limits.bucket_size",
+	"desired_relay.serving.limits.notify_limits.reload_rate",
+	"desired_relay.serving.limits.overall_limits",
+	"desired_relay.serving.limits.overall_limits.bucket_size",
+	"desired_relay.serving.limits.overall_limits.reload_rate",
+	"desired_relay.serving.limits.reset_limit_counter",
+	"desired_relay.serving.second_channel",
+	"desired_relay.serving.second_channel.ack_offset",
+	"desired_relay.serving.second_channel.data_rate_index",
+	"desired_relay.serving.second_channel.frequency",
+	"desired_relay.serving.uplink_forwarding_rules",
 	"desired_rx1_data_rate_offset",
 	"desired_rx1_delay",
 	"desired_rx1_delay.value",
@@ -229,6 +447,8 @@ var MACSettingsFieldPathsNested = []string{
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr_algorithm",
+	"adr_algorithm_parameters",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -239,6 +459,7 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"desired_max_duty_cycle",
 	"desired_ping_slot_data_rate_index",
 	"desired_ping_slot_frequency",
+	"desired_relay",
 	"desired_rx1_data_rate_offset",
 	"desired_rx1_delay",
 	"desired_rx2_data_rate_index",
@@ -280,6 +501,39 @@ var MACStateFieldPathsNested = []string{
 	"current_parameters.ping_slot_frequency",
 	"current_parameters.rejoin_count_periodicity",
 	"current_parameters.rejoin_time_periodicity",
+	"current_parameters.relay",
+	"current_parameters.relay.served",
+	"current_parameters.relay.served.backoff",
+	"current_parameters.relay.served.mode",
+	"current_parameters.relay.served.second_channel",
+	"current_parameters.relay.served.second_channel.ack_offset",
+	"current_parameters.relay.served.second_channel.data_rate_index",
+	"current_parameters.relay.served.second_channel.frequency",
+	"current_parameters.relay.served.serving_device_id",
+	"current_parameters.relay.served.smart_enable_level",
+	"current_parameters.relay.serving",
+	"current_parameters.relay.serving.cad_periodicity",
+	"current_parameters.relay.serving.default_channel_index",
+	"current_parameters.relay.serving.join_request_filters",
+	"current_parameters.relay.serving.limits",
+	"current_parameters.relay.serving.limits.global_uplink_limits",
+	"current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"current_parameters.relay.serving.limits.join_request_limits",
+	"current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"current_parameters.relay.serving.limits.notify_limits",
+	"current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"current_parameters.relay.serving.limits.overall_limits",
+	"current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"current_parameters.relay.serving.limits.reset_limit_counter",
+	"current_parameters.relay.serving.second_channel",
+	"current_parameters.relay.serving.second_channel.ack_offset",
+	"current_parameters.relay.serving.second_channel.data_rate_index",
+	"current_parameters.relay.serving.second_channel.frequency",
+	"current_parameters.relay.serving.uplink_forwarding_rules",
 	"current_parameters.rx1_data_rate_offset",
 	"current_parameters.rx1_delay",
 	"current_parameters.rx2_data_rate_index",
@@ -306,6 +560,39 @@ var MACStateFieldPathsNested = []string{
 	"desired_parameters.ping_slot_frequency",
 	"desired_parameters.rejoin_count_periodicity",
 	"desired_parameters.rejoin_time_periodicity",
+	"desired_parameters.relay",
+	"desired_parameters.relay.served",
+	"desired_parameters.relay.served.backoff",
+	"desired_parameters.relay.served.mode",
+	"desired_parameters.relay.served.second_channel",
+	"desired_parameters.relay.served.second_channel.ack_offset",
+	"desired_parameters.relay.served.second_channel.data_rate_index",
+	"desired_parameters.relay.served.second_channel.frequency",
+	"desired_parameters.relay.served.serving_device_id",
+	"desired_parameters.relay.served.smart_enable_level",
+	"desired_parameters.relay.serving",
+	"desired_parameters.relay.serving.cad_periodicity",
+	"desired_parameters.relay.serving.default_channel_index",
+	"desired_parameters.relay.serving.join_request_filters",
+	"desired_parameters.relay.serving.limits",
+	"desired_parameters.relay.serving.limits.global_uplink_limits",
+	"desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"desired_parameters.relay.serving.limits.join_request_limits",
+	"desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"desired_parameters.relay.serving.limits.notify_limits",
+	"desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"desired_parameters.relay.serving.limits.overall_limits",
+	"desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"desired_parameters.relay.serving.limits.reset_limit_counter",
+	"desired_parameters.relay.serving.second_channel",
+	"desired_parameters.relay.serving.second_channel.ack_offset",
+	"desired_parameters.relay.serving.second_channel.data_rate_index",
+	"desired_parameters.relay.serving.second_channel.frequency",
+	"desired_parameters.relay.serving.uplink_forwarding_rules",
 	"desired_parameters.rx1_data_rate_offset",
 	"desired_parameters.rx1_delay",
 	"desired_parameters.rx2_data_rate_index",
@@ -317,6 +604,8 @@ var MACStateFieldPathsNested = []string{
 	"last_dev_status_f_cnt_up",
 	"last_downlink_at",
 	"last_network_initiated_downlink_at",
+	"link_quality_downlinks",
+	"link_quality_uplinks",
 	"lorawan_version",
 	"pending_application_downlink",
 	"pending_application_downlink.class_b_c",
@@ -396,6 +685,8 @@ var MACStateFieldPathsTopLevel = []string{
 	"last_dev_status_f_cnt_up",
 	"last_downlink_at",
 	"last_network_initiated_downlink_at",
+	"link_quality_downlinks",
+	"link_quality_uplinks",
 	"lorawan_version",
 	"pending_application_downlink",
 	"pending_join_request",
@@ -458,6 +749,8 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
+	"mac_settings.adr_algorithm_parameters",
 	"mac_settings.adr_margin",
 	"mac_settings.beacon_frequency",
 	"mac_settings.class_b_timeout",
@@ -472,6 +765,39 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.desired_ping_slot_data_rate_index",
 	"mac_settings.desired_ping_slot_data_rate_index.value",
 	"mac_settings.desired_ping_slot_frequency",
+	"mac_settings.desired_relay",
+	"mac_settings.desired_relay.served",
+	"mac_settings.desired_relay.served.backoff",
+	"mac_settings.desired_relay.served.mode",
+	"mac_settings.desired_relay.served.second_channel",
+	"mac_settings.desired_relay.served.second_channel.ack_offset",
+	"mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"mac_settings.desired_relay.served.second_channel.frequency",
+	"mac_settings.desired_relay.served.serving_device_id",
+	"mac_settings.desired_relay.served.smart_enable_level",
+	"mac_settings.desired_relay.serving",
+	"mac_settings.desired_relay.serving.cad_periodicity",
+	"mac_settings.desired_relay.serving.default_channel_index",
+	"mac_settings.desired_relay.serving.join_request_filters",
+	"mac_settings.desired_relay.serving.limits",
+	"mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.join_request_limits",
+	"mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.notify_limits",
+	"mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.overall_limits",
+	"mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"mac_settings.desired_relay.serving.second_channel",
+	"mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"mac_settings.desired_relay.serving.second_channel.frequency",
+	"mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"mac_settings.desired_rx1_data_rate_offset",
 	"mac_settings.desired_rx1_delay",
 	"mac_settings.desired_rx1_delay.value",
@@ -519,6 +845,39 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.current_parameters.ping_slot_frequency",
 	"mac_state.current_parameters.rejoin_count_periodicity",
 	"mac_state.current_parameters.rejoin_time_periodicity",
+	"mac_state.current_parameters.relay",
+	"mac_state.current_parameters.relay.served",
+	"mac_state.current_parameters.relay.served.backoff",
+	"mac_state.current_parameters.relay.served.mode",
+	"mac_state.current_parameters.relay.served.second_channel",
+	"mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.served.second_channel.frequency",
+	"mac_state.current_parameters.relay.served.serving_device_id",
+	"mac_state.current_parameters.relay.served.smart_enable_level",
+	"mac_state.current_parameters.relay.serving",
+	"mac_state.current_parameters.relay.serving.cad_periodicity",
+	"mac_state.current_parameters.relay.serving.default_channel_index",
+	"mac_state.current_parameters.relay.serving.join_request_filters",
+	"mac_state.current_parameters.relay.serving.limits",
+	"mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"mac_state.current_parameters.relay.serving.second_channel",
+	"mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"mac_state.current_parameters.rx1_data_rate_offset",
 	"mac_state.current_parameters.rx1_delay",
 	"mac_state.current_parameters.rx2_data_rate_index",
@@ -545,6 +904,39 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.desired_parameters.ping_slot_frequency",
 	"mac_state.desired_parameters.rejoin_count_periodicity",
 	"mac_state.desired_parameters.rejoin_time_periodicity",
+	"mac_state.desired_parameters.relay",
+	"mac_state.desired_parameters.relay.served",
+	"mac_state.desired_parameters.relay.served.backoff",
+	"mac_state.desired_parameters.relay.served.mode",
+	"mac_state.desired_parameters.relay.served.second_channel",
+	"mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"mac_state.desired_parameters.relay.served.serving_device_id",
+	"mac_state.desired_parameters.relay.served.smart_enable_level",
+	"mac_state.desired_parameters.relay.serving",
+	"mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"mac_state.desired_parameters.relay.serving.default_channel_index",
+	"mac_state.desired_parameters.relay.serving.join_request_filters",
+	"mac_state.desired_parameters.relay.serving.limits",
+	"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"mac_state.desired_parameters.relay.serving.second_channel",
+	"mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"mac_state.desired_parameters.rx1_data_rate_offset",
 	"mac_state.desired_parameters.rx1_delay",
 	"mac_state.desired_parameters.rx2_data_rate_index",
@@ -556,6 +948,8 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.last_dev_status_f_cnt_up",
 	"mac_state.last_downlink_at",
 	"mac_state.last_network_initiated_downlink_at",
+	"mac_state.link_quality_downlinks",
+	"mac_state.link_quality_uplinks",
 	"mac_state.lorawan_version",
 	"mac_state.pending_application_downlink",
 	"mac_state.pending_application_downlink.class_b_c",
@@ -631,6 +1025,8 @@ var EndDeviceFieldPathsNested = []string{
 	"net_id",
 	"network_server_address",
 	"network_server_kek_label",
+	"next_rj_count_0",
+	"next_rj_count_1",
 	"pending_mac_state",
 	"pending_mac_state.current_parameters",
 	"pending_mac_state.current_parameters.adr_ack_delay",
@@ -653,6 +1049,39 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.current_parameters.ping_slot_frequency",
 	"pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"pending_mac_state.current_parameters.relay",
+	"pending_mac_state.current_parameters.relay.served",
+	"pending_mac_state.current_parameters.relay.served.backoff",
+	"pending_mac_state.current_parameters.relay.served.mode",
+	"pending_mac_state.current_parameters.relay.served.second_channel",
+	"pending_mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.served.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.served.serving_device_id",
+	"pending_mac_state.current_parameters.relay.served.smart_enable_level",
+	"pending_mac_state.current_parameters.relay.serving",
+	"pending_mac_state.current_parameters.relay.serving.cad_periodicity",
+	"pending_mac_state.current_parameters.relay.serving.default_channel_index",
+	"pending_mac_state.current_parameters.relay.serving.join_request_filters",
+	"pending_mac_state.current_parameters.relay.serving.limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"pending_mac_state.current_parameters.relay.serving.second_channel",
+	"pending_mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"pending_mac_state.current_parameters.rx1_delay",
 	"pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -679,6 +1108,39 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.desired_parameters.ping_slot_frequency",
 	"pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"pending_mac_state.desired_parameters.relay",
+	"pending_mac_state.desired_parameters.relay.served",
+	"pending_mac_state.desired_parameters.relay.served.backoff",
+	"pending_mac_state.desired_parameters.relay.served.mode",
+	"pending_mac_state.desired_parameters.relay.served.second_channel",
+	"pending_mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.served.serving_device_id",
+	"pending_mac_state.desired_parameters.relay.served.smart_enable_level",
+	"pending_mac_state.desired_parameters.relay.serving",
+	"pending_mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"pending_mac_state.desired_parameters.relay.serving.default_channel_index",
+	"pending_mac_state.desired_parameters.relay.serving.join_request_filters",
+	"pending_mac_state.desired_parameters.relay.serving.limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"pending_mac_state.desired_parameters.rx1_delay",
 	"pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -690,6 +1152,8 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.last_dev_status_f_cnt_up",
 	"pending_mac_state.last_downlink_at",
 	"pending_mac_state.last_network_initiated_downlink_at",
+	"pending_mac_state.link_quality_downlinks",
+	"pending_mac_state.link_quality_uplinks",
 	"pending_mac_state.lorawan_version",
 	"pending_mac_state.pending_application_downlink",
 	"pending_mac_state.pending_application_downlink.class_b_c",
@@ -877,6 +1341,8 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"net_id",
 	"network_server_address",
 	"network_server_kek_label",
+	"next_rj_count_0",
+	"next_rj_count_1",
 	"pending_mac_state",
 	"pending_session",
 	"picture",
@@ -941,6 +1407,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm_parameters",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -955,6 +1423,39 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.served",
+	"end_device.mac_settings.desired_relay.served.backoff",
+	"end_device.mac_settings.desired_relay.served.mode",
+	"end_device.mac_settings.desired_relay.served.second_channel",
+	"end_device.mac_settings.desired_relay.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.served.smart_enable_level",
+	"end_device.mac_settings.desired_relay.serving",
+	"end_device.mac_settings.desired_relay.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.serving.limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"end_device.mac_settings.desired_relay.serving.second_channel",
+	"end_device.mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_delay",
 	"end_device.mac_settings.desired_rx1_delay.value",
@@ -1002,6 +1503,39 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.served",
+	"end_device.mac_state.current_parameters.relay.served.backoff",
+	"end_device.mac_state.current_parameters.relay.served.mode",
+	"end_device.mac_state.current_parameters.relay.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.serving",
+	"end_device.mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.serving.limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -1028,6 +1562,39 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.served",
+	"end_device.mac_state.desired_parameters.relay.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.served.mode",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.serving",
+	"end_device.mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.serving.limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -1039,6 +1606,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.link_quality_downlinks",
+	"end_device.mac_state.link_quality_uplinks",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -1114,6 +1683,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.net_id",
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
+	"end_device.next_rj_count_0",
+	"end_device.next_rj_count_1",
 	"end_device.pending_mac_state",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
@@ -1136,6 +1707,39 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.served",
+	"end_device.pending_mac_state.current_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.serving",
+	"end_device.pending_mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -1162,6 +1766,39 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.served",
+	"end_device.pending_mac_state.desired_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -1173,6 +1810,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.link_quality_downlinks",
+	"end_device.pending_mac_state.link_quality_uplinks",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -1369,6 +2008,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm_parameters",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -1383,6 +2024,39 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.served",
+	"end_device.mac_settings.desired_relay.served.backoff",
+	"end_device.mac_settings.desired_relay.served.mode",
+	"end_device.mac_settings.desired_relay.served.second_channel",
+	"end_device.mac_settings.desired_relay.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.served.smart_enable_level",
+	"end_device.mac_settings.desired_relay.serving",
+	"end_device.mac_settings.desired_relay.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.serving.limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"end_device.mac_settings.desired_relay.serving.second_channel",
+	"end_device.mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_delay",
 	"end_device.mac_settings.desired_rx1_delay.value",
@@ -1430,6 +2104,39 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.served",
+	"end_device.mac_state.current_parameters.relay.served.backoff",
+	"end_device.mac_state.current_parameters.relay.served.mode",
+	"end_device.mac_state.current_parameters.relay.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.serving",
+	"end_device.mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.serving.limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -1456,6 +2163,39 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.served",
+	"end_device.mac_state.desired_parameters.relay.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.served.mode",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.serving",
+	"end_device.mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.serving.limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -1467,6 +2207,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.link_quality_downlinks",
+	"end_device.mac_state.link_quality_uplinks",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -1542,6 +2284,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.net_id",
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
+	"end_device.next_rj_count_0",
+	"end_device.next_rj_count_1",
 	"end_device.pending_mac_state",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
@@ -1564,6 +2308,39 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.served",
+	"end_device.pending_mac_state.current_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.serving",
+	"end_device.pending_mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -1590,6 +2367,39 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.served",
+	"end_device.pending_mac_state.desired_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -1601,6 +2411,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.link_quality_downlinks",
+	"end_device.pending_mac_state.link_quality_uplinks",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -1839,6 +2651,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm_parameters",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -1853,6 +2667,39 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.served",
+	"end_device.mac_settings.desired_relay.served.backoff",
+	"end_device.mac_settings.desired_relay.served.mode",
+	"end_device.mac_settings.desired_relay.served.second_channel",
+	"end_device.mac_settings.desired_relay.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.served.smart_enable_level",
+	"end_device.mac_settings.desired_relay.serving",
+	"end_device.mac_settings.desired_relay.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.serving.limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"end_device.mac_settings.desired_relay.serving.second_channel",
+	"end_device.mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_delay",
 	"end_device.mac_settings.desired_rx1_delay.value",
@@ -1900,6 +2747,39 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.served",
+	"end_device.mac_state.current_parameters.relay.served.backoff",
+	"end_device.mac_state.current_parameters.relay.served.mode",
+	"end_device.mac_state.current_parameters.relay.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.serving",
+	"end_device.mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.serving.limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -1926,6 +2806,39 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.served",
+	"end_device.mac_state.desired_parameters.relay.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.served.mode",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.serving",
+	"end_device.mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.serving.limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -1937,6 +2850,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.link_quality_downlinks",
+	"end_device.mac_state.link_quality_uplinks",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -2012,6 +2927,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.net_id",
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
+	"end_device.next_rj_count_0",
+	"end_device.next_rj_count_1",
 	"end_device.pending_mac_state",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
@@ -2034,6 +2951,39 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.served",
+	"end_device.pending_mac_state.current_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.serving",
+	"end_device.pending_mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -2060,6 +3010,39 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.served",
+	"end_device.pending_mac_state.desired_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -2071,6 +3054,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.link_quality_downlinks",
+	"end_device.pending_mac_state.link_quality_uplinks",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -2284,6 +3269,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm_parameters",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -2298,6 +3285,39 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.served",
+	"end_device.mac_settings.desired_relay.served.backoff",
+	"end_device.mac_settings.desired_relay.served.mode",
+	"end_device.mac_settings.desired_relay.served.second_channel",
+	"end_device.mac_settings.desired_relay.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.served.smart_enable_level",
+	"end_device.mac_settings.desired_relay.serving",
+	"end_device.mac_settings.desired_relay.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.serving.limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"end_device.mac_settings.desired_relay.serving.second_channel",
+	"end_device.mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_delay",
 	"end_device.mac_settings.desired_rx1_delay.value",
@@ -2345,6 +3365,39 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.served",
+	"end_device.mac_state.current_parameters.relay.served.backoff",
+	"end_device.mac_state.current_parameters.relay.served.mode",
+	"end_device.mac_state.current_parameters.relay.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.serving",
+	"end_device.mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.serving.limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -2371,6 +3424,39 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.served",
+	"end_device.mac_state.desired_parameters.relay.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.served.mode",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.serving",
+	"end_device.mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.serving.limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -2382,6 +3468,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.link_quality_downlinks",
+	"end_device.mac_state.link_quality_uplinks",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -2457,6 +3545,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.net_id",
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
+	"end_device.next_rj_count_0",
+	"end_device.next_rj_count_1",
 	"end_device.pending_mac_state",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
@@ -2479,6 +3569,39 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.served",
+	"end_device.pending_mac_state.current_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.serving",
+	"end_device.pending_mac_state.current_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -2505,6 +3628,39 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.served",
+	"end_device.pending_mac_state.desired_parameters.relay.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.served.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -2516,6 +3672,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.link_quality_downlinks",
+	"end_device.pending_mac_state.link_quality_uplinks",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -2797,3 +3955,35 @@ var MACState_DataRateRangesFieldPathsNested = []string{
 var MACState_DataRateRangesFieldPathsTopLevel = []string{
 	"ranges",
 }
+var MACState_UplinkObservationFieldPathsNested = []string{
+	"airtime",
+	"data_rate_index",
+	"f_cnt",
+	"gateway_count",
+	"received_at",
+	"rssi",
+	"snr",
+}
+
+var MACState_UplinkObservationFieldPathsTopLevel = []string{
+	"airtime",
+	"data_rate_index",
+	"f_cnt",
+	"gateway_count",
+	"received_at",
+	"rssi",
+	"snr",
+}
+var MACState_DownlinkObservationFieldPathsNested = []string{
+	"acknowledged",
+	"airtime",
+	"confirmed",
+	"transmit_at",
+}
+
+var MACState_DownlinkObservationFieldPathsTopLevel = []string{
+	"acknowledged",
+	"airtime",
+	"confirmed",
+	"transmit_at",
+}
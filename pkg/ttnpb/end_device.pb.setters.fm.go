@@ -373,6 +373,364 @@ func (dst *MACParameters) SetFields(src *MACParameters, paths ...string) error {
 					dst.PingSlotDataRateIndexValue = nil
 				}
 			}
+		case "relay":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayParameters
+				if (src == nil || src.Relay == nil) && dst.Relay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Relay
+				}
+				if dst.Relay != nil {
+					newDst = dst.Relay
+				} else {
+					newDst = &RelayParameters{}
+					dst.Relay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Relay = src.Relay
+				} else {
+					dst.Relay = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayUplinkForwardingRule) SetFields(src *RelayUplinkForwardingRule, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.Limits == nil) && dst.Limits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Limits
+				}
+				if dst.Limits != nil {
+					newDst = dst.Limits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.Limits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Limits = src.Limits
+				} else {
+					dst.Limits = nil
+				}
+			}
+		case "last_w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastWFCnt = src.LastWFCnt
+			} else {
+				var zero uint32
+				dst.LastWFCnt = zero
+			}
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceID = src.DeviceID
+			} else {
+				var zero string
+				dst.DeviceID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayJoinRequestFilter) SetFields(src *RelayJoinRequestFilter, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero RelayFilterListAction
+				dst.Action = zero
+			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.JoinEUI = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.DevEUI = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServingRelayParameters) SetFields(src *ServingRelayParameters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+		case "default_channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndex = src.DefaultChannelIndex
+			} else {
+				var zero uint32
+				dst.DefaultChannelIndex = zero
+			}
+		case "cad_periodicity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CADPeriodicity = src.CADPeriodicity
+			} else {
+				var zero RelayCADPeriodicity
+				dst.CADPeriodicity = zero
+			}
+		case "uplink_forwarding_rules":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_forwarding_rules' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkForwardingRules = src.UplinkForwardingRules
+			} else {
+				dst.UplinkForwardingRules = nil
+			}
+		case "limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_RelayConfigureFwdLimitReq
+				if (src == nil || src.Limits == nil) && dst.Limits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Limits
+				}
+				if dst.Limits != nil {
+					newDst = dst.Limits
+				} else {
+					newDst = &MACCommand_RelayConfigureFwdLimitReq{}
+					dst.Limits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Limits = src.Limits
+				} else {
+					dst.Limits = nil
+				}
+			}
+		case "join_request_filters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_request_filters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinRequestFilters = src.JoinRequestFilters
+			} else {
+				dst.JoinRequestFilters = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServedRelayParameters) SetFields(src *ServedRelayParameters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "mode":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mode' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mode = src.Mode
+			} else {
+				var zero RelayEndDeviceMode
+				dst.Mode = zero
+			}
+		case "smart_enable_level":
+			if len(subs) > 0 {
+				return fmt.Errorf("'smart_enable_level' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SmartEnableLevel = src.SmartEnableLevel
+			} else {
+				var zero RelaySmartEnableLevel
+				dst.SmartEnableLevel = zero
+			}
+		case "backoff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'backoff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Backoff = src.Backoff
+			} else {
+				var zero uint32
+				dst.Backoff = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+		case "serving_device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'serving_device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServingDeviceID = src.ServingDeviceID
+			} else {
+				var zero string
+				dst.ServingDeviceID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayParameters) SetFields(src *RelayParameters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "serving":
+			if len(subs) > 0 {
+				var newDst, newSrc *ServingRelayParameters
+				if (src == nil || src.Serving == nil) && dst.Serving == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Serving
+				}
+				if dst.Serving != nil {
+					newDst = dst.Serving
+				} else {
+					newDst = &ServingRelayParameters{}
+					dst.Serving = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Serving = src.Serving
+				} else {
+					dst.Serving = nil
+				}
+			}
+		case "served":
+			if len(subs) > 0 {
+				var newDst, newSrc *ServedRelayParameters
+				if (src == nil || src.Served == nil) && dst.Served == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Served
+				}
+				if dst.Served != nil {
+					newDst = dst.Served
+				} else {
+					newDst = &ServedRelayParameters{}
+					dst.Served = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Served = src.Served
+				} else {
+					dst.Served = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1044,6 +1402,49 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 			} else {
 				dst.DesiredBeaconFrequency = nil
 			}
+		case "adr_algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADRAlgorithm = src.ADRAlgorithm
+			} else {
+				dst.ADRAlgorithm = nil
+			}
+		case "adr_algorithm_parameters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_algorithm_parameters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADRAlgorithmParameters = src.ADRAlgorithmParameters
+			} else {
+				dst.ADRAlgorithmParameters = nil
+			}
+		case "desired_relay":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayParameters
+				if (src == nil || src.DesiredRelay == nil) && dst.DesiredRelay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DesiredRelay
+				}
+				if dst.DesiredRelay != nil {
+					newDst = dst.DesiredRelay
+				} else {
+					newDst = &RelayParameters{}
+					dst.DesiredRelay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DesiredRelay = src.DesiredRelay
+				} else {
+					dst.DesiredRelay = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1340,6 +1741,24 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 				var zero uint32
 				dst.LastADRChangeFCntUp = zero
 			}
+		case "link_quality_uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'link_quality_uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LinkQualityUplinks = src.LinkQualityUplinks
+			} else {
+				dst.LinkQualityUplinks = nil
+			}
+		case "link_quality_downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'link_quality_downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LinkQualityDownlinks = src.LinkQualityDownlinks
+			} else {
+				dst.LinkQualityDownlinks = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2029,6 +2448,26 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 			} else {
 				dst.SkipPayloadCryptoOverride = nil
 			}
+		case "next_rj_count_0":
+			if len(subs) > 0 {
+				return fmt.Errorf("'next_rj_count_0' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NextRJCount0 = src.NextRJCount0
+			} else {
+				var zero uint32
+				dst.NextRJCount0 = zero
+			}
+		case "next_rj_count_1":
+			if len(subs) > 0 {
+				return fmt.Errorf("'next_rj_count_1' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NextRJCount1 = src.NextRJCount1
+			} else {
+				var zero uint32
+				dst.NextRJCount1 = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2741,3 +3180,135 @@ func (dst *MACState_DataRateRanges) SetFields(src *MACState_DataRateRanges, path
 	}
 	return nil
 }
+
+func (dst *MACState_UplinkObservation) SetFields(src *MACState_UplinkObservation, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedAt = src.ReceivedAt
+			} else {
+				var zero time.Time
+				dst.ReceivedAt = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SNR = src.SNR
+			} else {
+				var zero float32
+				dst.SNR = zero
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RSSI = src.RSSI
+			} else {
+				var zero float32
+				dst.RSSI = zero
+			}
+		case "gateway_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayCount = src.GatewayCount
+			} else {
+				var zero uint32
+				dst.GatewayCount = zero
+			}
+		case "airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Airtime = src.Airtime
+			} else {
+				var zero time.Duration
+				dst.Airtime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACState_DownlinkObservation) SetFields(src *MACState_DownlinkObservation, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "transmit_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'transmit_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TransmitAt = src.TransmitAt
+			} else {
+				var zero time.Time
+				dst.TransmitAt = zero
+			}
+		case "confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Confirmed = src.Confirmed
+			} else {
+				var zero bool
+				dst.Confirmed = zero
+			}
+		case "acknowledged":
+			if len(subs) > 0 {
+				return fmt.Errorf("'acknowledged' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Acknowledged = src.Acknowledged
+			} else {
+				var zero bool
+				dst.Acknowledged = zero
+			}
+		case "airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Airtime = src.Airtime
+			} else {
+				var zero time.Duration
+				dst.Airtime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -380,8 +380,643 @@ func (m *MACParameters) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "relay":
+
+			if v, ok := interface{}(m.GetRelay()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACParametersValidationError{
+						field:  "relay",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACParametersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACParametersValidationError is the validation error returned by
+// MACParameters.ValidateFields if the designated constraints aren't met.
+type MACParametersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACParametersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACParametersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACParametersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACParametersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACParametersValidationError) ErrorName() string { return "MACParametersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MACParametersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACParameters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACParametersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACParametersValidationError{}
+
+// ValidateFields checks the field values on RelayUplinkForwardingRule with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelayUplinkForwardingRule) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayUplinkForwardingRuleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "limits":
+
+			if v, ok := interface{}(m.GetLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayUplinkForwardingRuleValidationError{
+						field:  "limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_w_f_cnt":
+			// no validation rules for LastWFCnt
+		case "device_id":
+
+			if utf8.RuneCountInString(m.GetDeviceID()) > 36 {
+				return RelayUplinkForwardingRuleValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_RelayUplinkForwardingRule_DeviceID_Pattern.MatchString(m.GetDeviceID()) {
+				return RelayUplinkForwardingRuleValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return RelayUplinkForwardingRuleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayUplinkForwardingRuleValidationError is the validation error returned by
+// RelayUplinkForwardingRule.ValidateFields if the designated constraints aren't
+// met.
+type RelayUplinkForwardingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayUplinkForwardingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayUplinkForwardingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayUplinkForwardingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayUplinkForwardingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayUplinkForwardingRuleValidationError) ErrorName() string {
+	return "RelayUplinkForwardingRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayUplinkForwardingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayUplinkForwardingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayUplinkForwardingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayUplinkForwardingRuleValidationError{}
+
+var _RelayUplinkForwardingRule_DeviceID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on RelayJoinRequestFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelayJoinRequestFilter) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayJoinRequestFilterFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "action":
+
+			if _, ok := RelayFilterListAction_name[int32(m.GetAction())]; !ok {
+				return RelayJoinRequestFilterValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "join_eui":
+			// no validation rules for JoinEUI
+		case "dev_eui":
+			// no validation rules for DevEUI
+		default:
+			return RelayJoinRequestFilterValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayJoinRequestFilterValidationError is the validation error returned by
+// RelayJoinRequestFilter.ValidateFields if the designated constraints aren't
+// met.
+type RelayJoinRequestFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayJoinRequestFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayJoinRequestFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayJoinRequestFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayJoinRequestFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayJoinRequestFilterValidationError) ErrorName() string {
+	return "RelayJoinRequestFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayJoinRequestFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayJoinRequestFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayJoinRequestFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayJoinRequestFilterValidationError{}
+
+// ValidateFields checks the field values on ServingRelayParameters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ServingRelayParameters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServingRelayParametersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelayParametersValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "default_channel_index":
+
+			if m.GetDefaultChannelIndex() > 3 {
+				return ServingRelayParametersValidationError{
+					field:  "default_channel_index",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "cad_periodicity":
+
+			if _, ok := RelayCADPeriodicity_name[int32(m.GetCADPeriodicity())]; !ok {
+				return ServingRelayParametersValidationError{
+					field:  "cad_periodicity",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "uplink_forwarding_rules":
+
+			if len(m.GetUplinkForwardingRules()) > 16 {
+				return ServingRelayParametersValidationError{
+					field:  "uplink_forwarding_rules",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetUplinkForwardingRules() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ServingRelayParametersValidationError{
+							field:  fmt.Sprintf("uplink_forwarding_rules[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "limits":
+
+			if v, ok := interface{}(m.GetLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelayParametersValidationError{
+						field:  "limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "join_request_filters":
+
+			if len(m.GetJoinRequestFilters()) > 16 {
+				return ServingRelayParametersValidationError{
+					field:  "join_request_filters",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetJoinRequestFilters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ServingRelayParametersValidationError{
+							field:  fmt.Sprintf("join_request_filters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ServingRelayParametersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServingRelayParametersValidationError is the validation error returned by
+// ServingRelayParameters.ValidateFields if the designated constraints aren't
+// met.
+type ServingRelayParametersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServingRelayParametersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServingRelayParametersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServingRelayParametersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServingRelayParametersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServingRelayParametersValidationError) ErrorName() string {
+	return "ServingRelayParametersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServingRelayParametersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServingRelayParameters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServingRelayParametersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServingRelayParametersValidationError{}
+
+// ValidateFields checks the field values on ServedRelayParameters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ServedRelayParameters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServedRelayParametersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mode":
+
+			if _, ok := RelayEndDeviceMode_name[int32(m.GetMode())]; !ok {
+				return ServedRelayParametersValidationError{
+					field:  "mode",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "smart_enable_level":
+
+			if _, ok := RelaySmartEnableLevel_name[int32(m.GetSmartEnableLevel())]; !ok {
+				return ServedRelayParametersValidationError{
+					field:  "smart_enable_level",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "backoff":
+
+			if m.GetBackoff() > 63 {
+				return ServedRelayParametersValidationError{
+					field:  "backoff",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServedRelayParametersValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "serving_device_id":
+
+			if utf8.RuneCountInString(m.GetServingDeviceID()) > 36 {
+				return ServedRelayParametersValidationError{
+					field:  "serving_device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ServedRelayParameters_ServingDeviceID_Pattern.MatchString(m.GetServingDeviceID()) {
+				return ServedRelayParametersValidationError{
+					field:  "serving_device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
+		default:
+			return ServedRelayParametersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServedRelayParametersValidationError is the validation error returned by
+// ServedRelayParameters.ValidateFields if the designated constraints aren't
+// met.
+type ServedRelayParametersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServedRelayParametersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServedRelayParametersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServedRelayParametersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServedRelayParametersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServedRelayParametersValidationError) ErrorName() string {
+	return "ServedRelayParametersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServedRelayParametersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServedRelayParameters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServedRelayParametersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServedRelayParametersValidationError{}
+
+var _ServedRelayParameters_ServingDeviceID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
+// ValidateFields checks the field values on RelayParameters with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *RelayParameters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayParametersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "serving":
+
+			if v, ok := interface{}(m.GetServing()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayParametersValidationError{
+						field:  "serving",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "served":
+
+			if v, ok := interface{}(m.GetServed()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayParametersValidationError{
+						field:  "served",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
-			return MACParametersValidationError{
+			return RelayParametersValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -390,9 +1025,9 @@ func (m *MACParameters) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACParametersValidationError is the validation error returned by
-// MACParameters.ValidateFields if the designated constraints aren't met.
-type MACParametersValidationError struct {
+// RelayParametersValidationError is the validation error returned by
+// RelayParameters.ValidateFields if the designated constraints aren't met.
+type RelayParametersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -400,22 +1035,24 @@ type MACParametersValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACParametersValidationError) Field() string { return e.field }
+func (e RelayParametersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACParametersValidationError) Reason() string { return e.reason }
+func (e RelayParametersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACParametersValidationError) Cause() error { return e.cause }
+func (e RelayParametersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACParametersValidationError) Key() bool { return e.key }
+func (e RelayParametersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACParametersValidationError) ErrorName() string { return "MACParametersValidationError" }
+func (e RelayParametersValidationError) ErrorName() string {
+	return "RelayParametersValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MACParametersValidationError) Error() string {
+func (e RelayParametersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -427,14 +1064,14 @@ func (e MACParametersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACParameters.%s: %s%s",
+		"invalid %sRelayParameters.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACParametersValidationError{}
+var _ error = RelayParametersValidationError{}
 
 var _ interface {
 	Field() string
@@ -442,7 +1079,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACParametersValidationError{}
+} = RelayParametersValidationError{}
 
 // ValidateFields checks the field values on EndDeviceVersionIdentifiers with
 // the rules defined in the proto definition for this message. If any rules
@@ -1107,6 +1744,32 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 
 			}
 
+		case "adr_algorithm":
+
+			if v, ok := interface{}(m.GetADRAlgorithm()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr_algorithm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "adr_algorithm_parameters":
+			// no validation rules for ADRAlgorithmParameters
+		case "desired_relay":
+
+			if v, ok := interface{}(m.GetDesiredRelay()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "desired_relay",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1461,6 +2124,40 @@ func (m *MACState) ValidateFields(paths ...string) error {
 
 		case "last_adr_change_f_cnt_up":
 			// no validation rules for LastADRChangeFCntUp
+		case "link_quality_uplinks":
+
+			for idx, item := range m.GetLinkQualityUplinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACStateValidationError{
+							field:  fmt.Sprintf("link_quality_uplinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "link_quality_downlinks":
+
+			for idx, item := range m.GetLinkQualityDownlinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACStateValidationError{
+							field:  fmt.Sprintf("link_quality_downlinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return MACStateValidationError{
 				field:  name,
@@ -2108,6 +2805,10 @@ func (m *EndDevice) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "next_rj_count_0":
+			// no validation rules for NextRJCount0
+		case "next_rj_count_1":
+			// no validation rules for NextRJCount1
 		default:
 			return EndDeviceValidationError{
 				field:  name,
@@ -4053,3 +4754,236 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MACState_DataRateRangesValidationError{}
+
+// ValidateFields checks the field values on MACState_UplinkObservation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACState_UplinkObservation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACState_UplinkObservationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "received_at":
+
+			if v, ok := interface{}(&m.ReceivedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_UplinkObservationValidationError{
+						field:  "received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACState_UplinkObservationValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "snr":
+			// no validation rules for SNR
+		case "rssi":
+			// no validation rules for RSSI
+		case "gateway_count":
+			// no validation rules for GatewayCount
+		case "airtime":
+
+			if v, ok := interface{}(&m.Airtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_UplinkObservationValidationError{
+						field:  "airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACState_UplinkObservationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACState_UplinkObservationValidationError is the validation error returned by
+// MACState_UplinkObservation.ValidateFields if the designated constraints
+// aren't met.
+type MACState_UplinkObservationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACState_UplinkObservationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACState_UplinkObservationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACState_UplinkObservationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACState_UplinkObservationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACState_UplinkObservationValidationError) ErrorName() string {
+	return "MACState_UplinkObservationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACState_UplinkObservationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACState_UplinkObservation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACState_UplinkObservationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACState_UplinkObservationValidationError{}
+
+// ValidateFields checks the field values on MACState_DownlinkObservation with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACState_DownlinkObservation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACState_DownlinkObservationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "transmit_at":
+
+			if v, ok := interface{}(&m.TransmitAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_DownlinkObservationValidationError{
+						field:  "transmit_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "confirmed":
+			// no validation rules for Confirmed
+		case "acknowledged":
+			// no validation rules for Acknowledged
+		case "airtime":
+
+			if v, ok := interface{}(&m.Airtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_DownlinkObservationValidationError{
+						field:  "airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACState_DownlinkObservationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACState_DownlinkObservationValidationError is the validation error returned
+// by MACState_DownlinkObservation.ValidateFields if the designated constraints
+// aren't met.
+type MACState_DownlinkObservationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACState_DownlinkObservationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACState_DownlinkObservationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACState_DownlinkObservationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACState_DownlinkObservationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACState_DownlinkObservationValidationError) ErrorName() string {
+	return "MACState_DownlinkObservationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACState_DownlinkObservationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACState_DownlinkObservation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACState_DownlinkObservationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACState_DownlinkObservationValidationError{}
@@ -89,6 +89,8 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
+	"mac_settings.adr_algorithm_parameters",
 	"mac_settings.adr_margin",
 	"mac_settings.beacon_frequency",
 	"mac_settings.class_b_timeout",
@@ -103,6 +105,39 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_settings.desired_ping_slot_data_rate_index",
 	"mac_settings.desired_ping_slot_data_rate_index.value",
 	"mac_settings.desired_ping_slot_frequency",
+	"mac_settings.desired_relay",
+	"mac_settings.desired_relay.served",
+	"mac_settings.desired_relay.served.backoff",
+	"mac_settings.desired_relay.served.mode",
+	"mac_settings.desired_relay.served.second_channel",
+	"mac_settings.desired_relay.served.second_channel.ack_offset",
+	"mac_settings.desired_relay.served.second_channel.data_rate_index",
+	"mac_settings.desired_relay.served.second_channel.frequency",
+	"mac_settings.desired_relay.served.serving_device_id",
+	"mac_settings.desired_relay.served.smart_enable_level",
+	"mac_settings.desired_relay.serving",
+	"mac_settings.desired_relay.serving.cad_periodicity",
+	"mac_settings.desired_relay.serving.default_channel_index",
+	"mac_settings.desired_relay.serving.join_request_filters",
+	"mac_settings.desired_relay.serving.limits",
+	"mac_settings.desired_relay.serving.limits.global_uplink_limits",
+	"mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.join_request_limits",
+	"mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.notify_limits",
+	"mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.overall_limits",
+	"mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+	"mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+	"mac_settings.desired_relay.serving.limits.reset_limit_counter",
+	"mac_settings.desired_relay.serving.second_channel",
+	"mac_settings.desired_relay.serving.second_channel.ack_offset",
+	"mac_settings.desired_relay.serving.second_channel.data_rate_index",
+	"mac_settings.desired_relay.serving.second_channel.frequency",
+	"mac_settings.desired_relay.serving.uplink_forwarding_rules",
 	"mac_settings.desired_rx1_data_rate_offset",
 	"mac_settings.desired_rx1_delay",
 	"mac_settings.desired_rx1_delay.value",
@@ -147,6 +182,39 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.current_parameters.ping_slot_frequency",
 	"mac_state.current_parameters.rejoin_count_periodicity",
 	"mac_state.current_parameters.rejoin_time_periodicity",
+	"mac_state.current_parameters.relay",
+	"mac_state.current_parameters.relay.served",
+	"mac_state.current_parameters.relay.served.backoff",
+	"mac_state.current_parameters.relay.served.mode",
+	"mac_state.current_parameters.relay.served.second_channel",
+	"mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.served.second_channel.frequency",
+	"mac_state.current_parameters.relay.served.serving_device_id",
+	"mac_state.current_parameters.relay.served.smart_enable_level",
+	"mac_state.current_parameters.relay.serving",
+	"mac_state.current_parameters.relay.serving.cad_periodicity",
+	"mac_state.current_parameters.relay.serving.default_channel_index",
+	"mac_state.current_parameters.relay.serving.join_request_filters",
+	"mac_state.current_parameters.relay.serving.limits",
+	"mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"mac_state.current_parameters.relay.serving.second_channel",
+	"mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"mac_state.current_parameters.rx1_data_rate_offset",
 	"mac_state.current_parameters.rx1_delay",
 	"mac_state.current_parameters.rx2_data_rate_index",
@@ -170,6 +238,39 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.desired_parameters.ping_slot_frequency",
 	"mac_state.desired_parameters.rejoin_count_periodicity",
 	"mac_state.desired_parameters.rejoin_time_periodicity",
+	"mac_state.desired_parameters.relay",
+	"mac_state.desired_parameters.relay.served",
+	"mac_state.desired_parameters.relay.served.backoff",
+	"mac_state.desired_parameters.relay.served.mode",
+	"mac_state.desired_parameters.relay.served.second_channel",
+	"mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"mac_state.desired_parameters.relay.served.serving_device_id",
+	"mac_state.desired_parameters.relay.served.smart_enable_level",
+	"mac_state.desired_parameters.relay.serving",
+	"mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"mac_state.desired_parameters.relay.serving.default_channel_index",
+	"mac_state.desired_parameters.relay.serving.join_request_filters",
+	"mac_state.desired_parameters.relay.serving.limits",
+	"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"mac_state.desired_parameters.relay.serving.second_channel",
+	"mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"mac_state.desired_parameters.rx1_data_rate_offset",
 	"mac_state.desired_parameters.rx1_delay",
 	"mac_state.desired_parameters.rx2_data_rate_index",
@@ -181,6 +282,8 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.last_dev_status_f_cnt_up",
 	"mac_state.last_downlink_at",
 	"mac_state.last_network_initiated_downlink_at",
+	"mac_state.link_quality_downlinks",
+	"mac_state.link_quality_uplinks",
 	"mac_state.lorawan_version",
 	"mac_state.pending_application_downlink",
 	"mac_state.pending_application_downlink.class_b_c",
@@ -226,6 +329,39 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"pending_mac_state.current_parameters.ping_slot_frequency",
 	"pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"pending_mac_state.current_parameters.relay",
+	"pending_mac_state.current_parameters.relay.served",
+	"pending_mac_state.current_parameters.relay.served.backoff",
+	"pending_mac_state.current_parameters.relay.served.mode",
+	"pending_mac_state.current_parameters.relay.served.second_channel",
+	"pending_mac_state.current_parameters.relay.served.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.served.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.served.serving_device_id",
+	"pending_mac_state.current_parameters.relay.served.smart_enable_level",
+	"pending_mac_state.current_parameters.relay.serving",
+	"pending_mac_state.current_parameters.relay.serving.cad_periodicity",
+	"pending_mac_state.current_parameters.relay.serving.default_channel_index",
+	"pending_mac_state.current_parameters.relay.serving.join_request_filters",
+	"pending_mac_state.current_parameters.relay.serving.limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.join_request_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.notify_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.overall_limits",
+	"pending_mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"pending_mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"pending_mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+	"pending_mac_state.current_parameters.relay.serving.second_channel",
+	"pending_mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.serving.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 	"pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"pending_mac_state.current_parameters.rx1_delay",
 	"pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -249,6 +385,39 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"pending_mac_state.desired_parameters.ping_slot_frequency",
 	"pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"pending_mac_state.desired_parameters.relay",
+	"pending_mac_state.desired_parameters.relay.served",
+	"pending_mac_state.desired_parameters.relay.served.backoff",
+	"pending_mac_state.desired_parameters.relay.served.mode",
+	"pending_mac_state.desired_parameters.relay.served.second_channel",
+	"pending_mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.served.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.served.serving_device_id",
+	"pending_mac_state.desired_parameters.relay.served.smart_enable_level",
+	"pending_mac_state.desired_parameters.relay.serving",
+	"pending_mac_state.desired_parameters.relay.serving.cad_periodicity",
+	"pending_mac_state.desired_parameters.relay.serving.default_channel_index",
+	"pending_mac_state.desired_parameters.relay.serving.join_request_filters",
+	"pending_mac_state.desired_parameters.relay.serving.limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.notify_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.overall_limits",
+	"pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+	"pending_mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+	"pending_mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.serving.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 	"pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"pending_mac_state.desired_parameters.rx1_delay",
 	"pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -260,6 +429,8 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"pending_mac_state.last_dev_status_f_cnt_up",
 	"pending_mac_state.last_downlink_at",
 	"pending_mac_state.last_network_initiated_downlink_at",
+	"pending_mac_state.link_quality_downlinks",
+	"pending_mac_state.link_quality_uplinks",
 	"pending_mac_state.lorawan_version",
 	"pending_mac_state.pending_join_request",
 	"pending_mac_state.pending_join_request.cf_list",
@@ -492,6 +663,8 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
+			"next_rj_count_0",
+			"next_rj_count_1",
 			"provisioner_id",
 			"provisioning_data",
 			"resets_join_nonces",
@@ -527,6 +700,8 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
+			"next_rj_count_0",
+			"next_rj_count_1",
 			"provisioner_id",
 			"provisioning_data",
 			"resets_join_nonces",
@@ -554,6 +729,8 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"lorawan_phy_version",
 			"lorawan_version",
 			"mac_settings",
+			"mac_settings.adr_algorithm",
+			"mac_settings.adr_algorithm_parameters",
 			"mac_settings.adr_margin",
 			"mac_settings.beacon_frequency",
 			"mac_settings.class_b_timeout",
@@ -568,6 +745,39 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_settings.desired_ping_slot_data_rate_index",
 			"mac_settings.desired_ping_slot_data_rate_index.value",
 			"mac_settings.desired_ping_slot_frequency",
+			"mac_settings.desired_relay",
+			"mac_settings.desired_relay.served",
+			"mac_settings.desired_relay.served.backoff",
+			"mac_settings.desired_relay.served.mode",
+			"mac_settings.desired_relay.served.second_channel",
+			"mac_settings.desired_relay.served.second_channel.ack_offset",
+			"mac_settings.desired_relay.served.second_channel.data_rate_index",
+			"mac_settings.desired_relay.served.second_channel.frequency",
+			"mac_settings.desired_relay.served.serving_device_id",
+			"mac_settings.desired_relay.served.smart_enable_level",
+			"mac_settings.desired_relay.serving",
+			"mac_settings.desired_relay.serving.cad_periodicity",
+			"mac_settings.desired_relay.serving.default_channel_index",
+			"mac_settings.desired_relay.serving.join_request_filters",
+			"mac_settings.desired_relay.serving.limits",
+			"mac_settings.desired_relay.serving.limits.global_uplink_limits",
+			"mac_settings.desired_relay.serving.limits.global_uplink_limits.bucket_size",
+			"mac_settings.desired_relay.serving.limits.global_uplink_limits.reload_rate",
+			"mac_settings.desired_relay.serving.limits.join_request_limits",
+			"mac_settings.desired_relay.serving.limits.join_request_limits.bucket_size",
+			"mac_settings.desired_relay.serving.limits.join_request_limits.reload_rate",
+			"mac_settings.desired_relay.serving.limits.notify_limits",
+			"mac_settings.desired_relay.serving.limits.notify_limits.bucket_size",
+			"mac_settings.desired_relay.serving.limits.notify_limits.reload_rate",
+			"mac_settings.desired_relay.serving.limits.overall_limits",
+			"mac_settings.desired_relay.serving.limits.overall_limits.bucket_size",
+			"mac_settings.desired_relay.serving.limits.overall_limits.reload_rate",
+			"mac_settings.desired_relay.serving.limits.reset_limit_counter",
+			"mac_settings.desired_relay.serving.second_channel",
+			"mac_settings.desired_relay.serving.second_channel.ack_offset",
+			"mac_settings.desired_relay.serving.second_channel.data_rate_index",
+			"mac_settings.desired_relay.serving.second_channel.frequency",
+			"mac_settings.desired_relay.serving.uplink_forwarding_rules",
 			"mac_settings.desired_rx1_data_rate_offset",
 			"mac_settings.desired_rx1_delay",
 			"mac_settings.desired_rx1_delay.value",
@@ -608,6 +818,39 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_state.current_parameters.ping_slot_frequency",
 			"mac_state.current_parameters.rejoin_count_periodicity",
 			"mac_state.current_parameters.rejoin_time_periodicity",
+			"mac_state.current_parameters.relay",
+			"mac_state.current_parameters.relay.served",
+			"mac_state.current_parameters.relay.served.backoff",
+			"mac_state.current_parameters.relay.served.mode",
+			"mac_state.current_parameters.relay.served.second_channel",
+			"mac_state.current_parameters.relay.served.second_channel.ack_offset",
+			"mac_state.current_parameters.relay.served.second_channel.data_rate_index",
+			"mac_state.current_parameters.relay.served.second_channel.frequency",
+			"mac_state.current_parameters.relay.served.serving_device_id",
+			"mac_state.current_parameters.relay.served.smart_enable_level",
+			"mac_state.current_parameters.relay.serving",
+			"mac_state.current_parameters.relay.serving.cad_periodicity",
+			"mac_state.current_parameters.relay.serving.default_channel_index",
+			"mac_state.current_parameters.relay.serving.join_request_filters",
+			"mac_state.current_parameters.relay.serving.limits",
+			"mac_state.current_parameters.relay.serving.limits.global_uplink_limits",
+			"mac_state.current_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+			"mac_state.current_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+			"mac_state.current_parameters.relay.serving.limits.join_request_limits",
+			"mac_state.current_parameters.relay.serving.limits.join_request_limits.bucket_size",
+			"mac_state.current_parameters.relay.serving.limits.join_request_limits.reload_rate",
+			"mac_state.current_parameters.relay.serving.limits.notify_limits",
+			"mac_state.current_parameters.relay.serving.limits.notify_limits.bucket_size",
+			"mac_state.current_parameters.relay.serving.limits.notify_limits.reload_rate",
+			"mac_state.current_parameters.relay.serving.limits.overall_limits",
+			"mac_state.current_parameters.relay.serving.limits.overall_limits.bucket_size",
+			"mac_state.current_parameters.relay.serving.limits.overall_limits.reload_rate",
+			"mac_state.current_parameters.relay.serving.limits.reset_limit_counter",
+			"mac_state.current_parameters.relay.serving.second_channel",
+			"mac_state.current_parameters.relay.serving.second_channel.ack_offset",
+			"mac_state.current_parameters.relay.serving.second_channel.data_rate_index",
+			"mac_state.current_parameters.relay.serving.second_channel.frequency",
+			"mac_state.current_parameters.relay.serving.uplink_forwarding_rules",
 			"mac_state.current_parameters.rx1_data_rate_offset",
 			"mac_state.current_parameters.rx1_delay",
 			"mac_state.current_parameters.rx2_data_rate_index",
@@ -628,6 +871,39 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_state.desired_parameters.ping_slot_frequency",
 			"mac_state.desired_parameters.rejoin_count_periodicity",
 			"mac_state.desired_parameters.rejoin_time_periodicity",
+			"mac_state.desired_parameters.relay",
+			"mac_state.desired_parameters.relay.served",
+			"mac_state.desired_parameters.relay.served.backoff",
+			"mac_state.desired_parameters.relay.served.mode",
+			"mac_state.desired_parameters.relay.served.second_channel",
+			"mac_state.desired_parameters.relay.served.second_channel.ack_offset",
+			"mac_state.desired_parameters.relay.served.second_channel.data_rate_index",
+			"mac_state.desired_parameters.relay.served.second_channel.frequency",
+			"mac_state.desired_parameters.relay.served.serving_device_id",
+			"mac_state.desired_parameters.relay.served.smart_enable_level",
+			"mac_state.desired_parameters.relay.serving",
+			"mac_state.desired_parameters.relay.serving.cad_periodicity",
+			"mac_state.desired_parameters.relay.serving.default_channel_index",
+			"mac_state.desired_parameters.relay.serving.join_request_filters",
+			"mac_state.desired_parameters.relay.serving.limits",
+			"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits",
+			"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.bucket_size",
+			"mac_state.desired_parameters.relay.serving.limits.global_uplink_limits.reload_rate",
+			"mac_state.desired_parameters.relay.serving.limits.join_request_limits",
+			"mac_state.desired_parameters.relay.serving.limits.join_request_limits.bucket_size",
+			"mac_state.desired_parameters.relay.serving.limits.join_request_limits.reload_rate",
+			"mac_state.desired_parameters.relay.serving.limits.notify_limits",
+			"mac_state.desired_parameters.relay.serving.limits.notify_limits.bucket_size",
+			"mac_state.desired_parameters.relay.serving.limits.notify_limits.reload_rate",
+			"mac_state.desired_parameters.relay.serving.limits.overall_limits",
+			"mac_state.desired_parameters.relay.serving.limits.overall_limits.bucket_size",
+			"mac_state.desired_parameters.relay.serving.limits.overall_limits.reload_rate",
+			"mac_state.desired_parameters.relay.serving.limits.reset_limit_counter",
+			"mac_state.desired_parameters.relay.serving.second_channel",
+			"mac_state.desired_parameters.relay.serving.second_channel.ack_offset",
+			"mac_state.desired_parameters.relay.serving.second_channel.data_rate_index",
+			"mac_state.desired_parameters.relay.serving.second_channel.frequency",
+			"mac_state.desired_parameters.relay.serving.uplink_forwarding_rules",
 			"mac_state.desired_parameters.rx1_data_rate_offset",
 			"mac_state.desired_parameters.rx1_delay",
 			"mac_state.desired_parameters.rx2_data_rate_index",
@@ -703,6 +979,11 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		Set: true,
 	},
 
+	// Alert Rules:
+	"/ttn.lorawan.v3.AlertRuleRegistry/Get":    {All: AlertRuleFieldPathsNested, Allowed: AlertRuleFieldPathsNested},
+	"/ttn.lorawan.v3.AlertRuleRegistry/List":   {All: AlertRuleFieldPathsNested, Allowed: AlertRuleFieldPathsNested},
+	"/ttn.lorawan.v3.AlertRuleRegistry/Update": {All: AlertRuleFieldPathsNested, Allowed: AlertRuleFieldPathsNested, Set: true},
+
 	// Organizations:
 	"/ttn.lorawan.v3.OrganizationRegistry/Get":                 {All: OrganizationFieldPathsNested, Allowed: OrganizationFieldPathsNested},
 	"/ttn.lorawan.v3.OrganizationRegistry/List":                {All: OrganizationFieldPathsNested, Allowed: OrganizationFieldPathsNested},
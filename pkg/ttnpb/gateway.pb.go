@@ -502,6 +502,183 @@ func (m *GatewayClaimAuthenticationCode) GetValidTo() *time.Time {
 	return nil
 }
 
+// GatewayBeaconing defines the Class B beaconing settings of a gateway.
+type GatewayBeaconing struct {
+	// Enable the transmission of Class B beacons by the Gateway Server.
+	// Beacons are only transmitted when the gateway is synchronized with GPS time.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// Index of the antenna of which the location is broadcast in the gateway specific field of the beacon.
+	AntennaIndex         uint32   `protobuf:"varint,2,opt,name=antenna_index,json=antennaIndex,proto3" json:"antenna_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayBeaconing) Reset()      { *m = GatewayBeaconing{} }
+func (*GatewayBeaconing) ProtoMessage() {}
+func (*GatewayBeaconing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{6}
+}
+func (m *GatewayBeaconing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayBeaconing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayBeaconing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayBeaconing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayBeaconing.Merge(m, src)
+}
+func (m *GatewayBeaconing) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayBeaconing) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayBeaconing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayBeaconing proto.InternalMessageInfo
+
+func (m *GatewayBeaconing) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *GatewayBeaconing) GetAntennaIndex() uint32 {
+	if m != nil {
+		return m.AntennaIndex
+	}
+	return 0
+}
+
+// GatewayUplinkFilters defines the filters that the Gateway Server applies to uplink messages of a gateway
+// before they are forwarded to the Network Server and Packet Broker.
+type GatewayUplinkFilters struct {
+	// Only uplink messages that match the allow rule are forwarded.
+	// The allow rule only applies to uplink messages for which the rule defines identifiers of the same kind:
+	// if there are no JoinEUI prefixes, all join-requests are allowed.
+	Allow *GatewayUplinkFilters_Rule `protobuf:"bytes,1,opt,name=allow,proto3" json:"allow,omitempty"`
+	// Uplink messages that match the deny rule are filtered.
+	Deny *GatewayUplinkFilters_Rule `protobuf:"bytes,2,opt,name=deny,proto3" json:"deny,omitempty"`
+	// Minimum SNR (dB) of LoRa uplink messages. The best SNR of all antennas is used.
+	MinSNR *types.FloatValue `protobuf:"bytes,3,opt,name=min_snr,json=minSnr,proto3" json:"min_snr,omitempty"`
+	// Minimum channel RSSI (dBm) of uplink messages. The best RSSI of all antennas is used.
+	MinRSSI              *types.FloatValue `protobuf:"bytes,4,opt,name=min_rssi,json=minRssi,proto3" json:"min_rssi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GatewayUplinkFilters) Reset()      { *m = GatewayUplinkFilters{} }
+func (*GatewayUplinkFilters) ProtoMessage() {}
+func (*GatewayUplinkFilters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{7}
+}
+func (m *GatewayUplinkFilters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayUplinkFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayUplinkFilters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayUplinkFilters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilters.Merge(m, src)
+}
+func (m *GatewayUplinkFilters) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayUplinkFilters) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilters proto.InternalMessageInfo
+
+func (m *GatewayUplinkFilters) GetAllow() *GatewayUplinkFilters_Rule {
+	if m != nil {
+		return m.Allow
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetDeny() *GatewayUplinkFilters_Rule {
+	if m != nil {
+		return m.Deny
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetMinSNR() *types.FloatValue {
+	if m != nil {
+		return m.MinSNR
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetMinRSSI() *types.FloatValue {
+	if m != nil {
+		return m.MinRSSI
+	}
+	return nil
+}
+
+type GatewayUplinkFilters_Rule struct {
+	// DevAddr prefixes of data uplink messages.
+	DevAddrPrefixes []go_thethings_network_lorawan_stack_v3_pkg_types.DevAddrPrefix `protobuf:"bytes,1,rep,name=dev_addr_prefixes,json=devAddrPrefixes,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddrPrefix" json:"dev_addr_prefixes"`
+	// NetIDs of data uplink messages (by DevAddr) and rejoin-requests of type 0 and 2.
+	NetIDs []go_thethings_network_lorawan_stack_v3_pkg_types.NetID `protobuf:"bytes,2,rep,name=net_ids,json=netIds,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"net_ids"`
+	// JoinEUI prefixes of join-requests and rejoin-requests of type 1.
+	JoinEUIPrefixes      []go_thethings_network_lorawan_stack_v3_pkg_types.EUI64Prefix `protobuf:"bytes,3,rep,name=join_eui_prefixes,json=joinEuiPrefixes,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64Prefix" json:"join_eui_prefixes"`
+	XXX_NoUnkeyedLiteral struct{}                                                      `json:"-"`
+	XXX_sizecache        int32                                                         `json:"-"`
+}
+
+func (m *GatewayUplinkFilters_Rule) Reset()      { *m = GatewayUplinkFilters_Rule{} }
+func (*GatewayUplinkFilters_Rule) ProtoMessage() {}
+func (*GatewayUplinkFilters_Rule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{7, 0}
+}
+func (m *GatewayUplinkFilters_Rule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayUplinkFilters_Rule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayUplinkFilters_Rule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayUplinkFilters_Rule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilters_Rule.Merge(m, src)
+}
+func (m *GatewayUplinkFilters_Rule) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayUplinkFilters_Rule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilters_Rule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilters_Rule proto.InternalMessageInfo
+
 // Gateway is the message that defines a gateway on the network.
 type Gateway struct {
 	GatewayIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
@@ -561,15 +738,20 @@ type Gateway struct {
 	// CUPS Key for LoRa Basics Station CUPS redirection.
 	// If redirecting to another instance of TTS, use the CUPS API Key for the gateway on the target instance.
 	// Requires the RIGHT_GATEWAY_READ_SECRETS for reading and RIGHT_GATEWAY_WRITE_SECRETS for updating this value.
-	TargetCUPSKey        *Secret  `protobuf:"bytes,25,opt,name=target_cups_key,json=targetCupsKey,proto3" json:"target_cups_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TargetCUPSKey *Secret `protobuf:"bytes,25,opt,name=target_cups_key,json=targetCupsKey,proto3" json:"target_cups_key,omitempty"`
+	// Class B beaconing settings of the gateway.
+	// The Gateway Server transmits beacons on behalf of gateways that cannot emit beacons themselves.
+	Beaconing *GatewayBeaconing `protobuf:"bytes,26,opt,name=beaconing,proto3" json:"beaconing,omitempty"`
+	// Filters of uplink messages received by the gateway.
+	UplinkFilters        *GatewayUplinkFilters `protobuf:"bytes,27,opt,name=uplink_filters,json=uplinkFilters,proto3" json:"uplink_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *Gateway) Reset()      { *m = Gateway{} }
 func (*Gateway) ProtoMessage() {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{6}
+	return fileDescriptor_1df6bae1ac946b39, []int{8}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,6 +941,20 @@ func (m *Gateway) GetTargetCUPSKey() *Secret {
 	return nil
 }
 
+func (m *Gateway) GetBeaconing() *GatewayBeaconing {
+	if m != nil {
+		return m.Beaconing
+	}
+	return nil
+}
+
+func (m *Gateway) GetUplinkFilters() *GatewayUplinkFilters {
+	if m != nil {
+		return m.UplinkFilters
+	}
+	return nil
+}
+
 type Gateways struct {
 	Gateways             []*Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -768,7 +964,7 @@ type Gateways struct {
 func (m *Gateways) Reset()      { *m = Gateways{} }
 func (*Gateways) ProtoMessage() {}
 func (*Gateways) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{7}
+	return fileDescriptor_1df6bae1ac946b39, []int{9}
 }
 func (m *Gateways) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +1011,7 @@ type GetGatewayRequest struct {
 func (m *GetGatewayRequest) Reset()      { *m = GetGatewayRequest{} }
 func (*GetGatewayRequest) ProtoMessage() {}
 func (*GetGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{8}
+	return fileDescriptor_1df6bae1ac946b39, []int{10}
 }
 func (m *GetGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,7 +1056,7 @@ type GetGatewayIdentifiersForEUIRequest struct {
 func (m *GetGatewayIdentifiersForEUIRequest) Reset()      { *m = GetGatewayIdentifiersForEUIRequest{} }
 func (*GetGatewayIdentifiersForEUIRequest) ProtoMessage() {}
 func (*GetGatewayIdentifiersForEUIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{9}
+	return fileDescriptor_1df6bae1ac946b39, []int{11}
 }
 func (m *GetGatewayIdentifiersForEUIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +1106,7 @@ type ListGatewaysRequest struct {
 func (m *ListGatewaysRequest) Reset()      { *m = ListGatewaysRequest{} }
 func (*ListGatewaysRequest) ProtoMessage() {}
 func (*ListGatewaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{10}
+	return fileDescriptor_1df6bae1ac946b39, []int{12}
 }
 func (m *ListGatewaysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1181,7 @@ type CreateGatewayRequest struct {
 func (m *CreateGatewayRequest) Reset()      { *m = CreateGatewayRequest{} }
 func (*CreateGatewayRequest) ProtoMessage() {}
 func (*CreateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{11}
+	return fileDescriptor_1df6bae1ac946b39, []int{13}
 }
 func (m *CreateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1228,7 @@ type UpdateGatewayRequest struct {
 func (m *UpdateGatewayRequest) Reset()      { *m = UpdateGatewayRequest{} }
 func (*UpdateGatewayRequest) ProtoMessage() {}
 func (*UpdateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{12}
+	return fileDescriptor_1df6bae1ac946b39, []int{14}
 }
 func (m *UpdateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1277,7 @@ type ListGatewayAPIKeysRequest struct {
 func (m *ListGatewayAPIKeysRequest) Reset()      { *m = ListGatewayAPIKeysRequest{} }
 func (*ListGatewayAPIKeysRequest) ProtoMessage() {}
 func (*ListGatewayAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{13}
+	return fileDescriptor_1df6bae1ac946b39, []int{15}
 }
 func (m *ListGatewayAPIKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,7 +1331,7 @@ type GetGatewayAPIKeyRequest struct {
 func (m *GetGatewayAPIKeyRequest) Reset()      { *m = GetGatewayAPIKeyRequest{} }
 func (*GetGatewayAPIKeyRequest) ProtoMessage() {}
 func (*GetGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{14}
+	return fileDescriptor_1df6bae1ac946b39, []int{16}
 }
 func (m *GetGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1378,7 @@ type CreateGatewayAPIKeyRequest struct {
 func (m *CreateGatewayAPIKeyRequest) Reset()      { *m = CreateGatewayAPIKeyRequest{} }
 func (*CreateGatewayAPIKeyRequest) ProtoMessage() {}
 func (*CreateGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{15}
+	return fileDescriptor_1df6bae1ac946b39, []int{17}
 }
 func (m *CreateGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1235,7 +1431,7 @@ type UpdateGatewayAPIKeyRequest struct {
 func (m *UpdateGatewayAPIKeyRequest) Reset()      { *m = UpdateGatewayAPIKeyRequest{} }
 func (*UpdateGatewayAPIKeyRequest) ProtoMessage() {}
 func (*UpdateGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{16}
+	return fileDescriptor_1df6bae1ac946b39, []int{18}
 }
 func (m *UpdateGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1277,7 +1473,7 @@ type ListGatewayCollaboratorsRequest struct {
 func (m *ListGatewayCollaboratorsRequest) Reset()      { *m = ListGatewayCollaboratorsRequest{} }
 func (*ListGatewayCollaboratorsRequest) ProtoMessage() {}
 func (*ListGatewayCollaboratorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{17}
+	return fileDescriptor_1df6bae1ac946b39, []int{19}
 }
 func (m *ListGatewayCollaboratorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,7 +1526,7 @@ type GetGatewayCollaboratorRequest struct {
 func (m *GetGatewayCollaboratorRequest) Reset()      { *m = GetGatewayCollaboratorRequest{} }
 func (*GetGatewayCollaboratorRequest) ProtoMessage() {}
 func (*GetGatewayCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{18}
+	return fileDescriptor_1df6bae1ac946b39, []int{20}
 }
 func (m *GetGatewayCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1369,7 +1565,7 @@ type SetGatewayCollaboratorRequest struct {
 func (m *SetGatewayCollaboratorRequest) Reset()      { *m = SetGatewayCollaboratorRequest{} }
 func (*SetGatewayCollaboratorRequest) ProtoMessage() {}
 func (*SetGatewayCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{19}
+	return fileDescriptor_1df6bae1ac946b39, []int{21}
 }
 func (m *SetGatewayCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,7 +1615,7 @@ type GatewayAntenna struct {
 func (m *GatewayAntenna) Reset()      { *m = GatewayAntenna{} }
 func (*GatewayAntenna) ProtoMessage() {}
 func (*GatewayAntenna) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{20}
+	return fileDescriptor_1df6bae1ac946b39, []int{22}
 }
 func (m *GatewayAntenna) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1702,7 @@ type GatewayStatus struct {
 func (m *GatewayStatus) Reset()      { *m = GatewayStatus{} }
 func (*GatewayStatus) ProtoMessage() {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{21}
+	return fileDescriptor_1df6bae1ac946b39, []int{23}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,15 +1792,17 @@ type GatewayConnectionStats struct {
 	DownlinkCount          uint64                                 `protobuf:"varint,8,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
 	RoundTripTimes         *GatewayConnectionStats_RoundTripTimes `protobuf:"bytes,9,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
 	// Statistics for each sub band.
-	SubBands             []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	SubBands []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	// Number of uplink messages that were not forwarded because of the uplink filters of the gateway.
+	UplinkFilteredCount  uint64   `protobuf:"varint,11,opt,name=uplink_filtered_count,json=uplinkFilteredCount,proto3" json:"uplink_filtered_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GatewayConnectionStats) Reset()      { *m = GatewayConnectionStats{} }
 func (*GatewayConnectionStats) ProtoMessage() {}
 func (*GatewayConnectionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22}
+	return fileDescriptor_1df6bae1ac946b39, []int{24}
 }
 func (m *GatewayConnectionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1703,6 +1901,13 @@ func (m *GatewayConnectionStats) GetSubBands() []*GatewayConnectionStats_SubBand
 	return nil
 }
 
+func (m *GatewayConnectionStats) GetUplinkFilteredCount() uint64 {
+	if m != nil {
+		return m.UplinkFilteredCount
+	}
+	return 0
+}
+
 type GatewayConnectionStats_RoundTripTimes struct {
 	Min                  time.Duration `protobuf:"bytes,1,opt,name=min,proto3,stdduration" json:"min"`
 	Max                  time.Duration `protobuf:"bytes,2,opt,name=max,proto3,stdduration" json:"max"`
@@ -1715,7 +1920,7 @@ type GatewayConnectionStats_RoundTripTimes struct {
 func (m *GatewayConnectionStats_RoundTripTimes) Reset()      { *m = GatewayConnectionStats_RoundTripTimes{} }
 func (*GatewayConnectionStats_RoundTripTimes) ProtoMessage() {}
 func (*GatewayConnectionStats_RoundTripTimes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22, 0}
+	return fileDescriptor_1df6bae1ac946b39, []int{24, 0}
 }
 func (m *GatewayConnectionStats_RoundTripTimes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1784,7 +1989,7 @@ type GatewayConnectionStats_SubBand struct {
 func (m *GatewayConnectionStats_SubBand) Reset()      { *m = GatewayConnectionStats_SubBand{} }
 func (*GatewayConnectionStats_SubBand) ProtoMessage() {}
 func (*GatewayConnectionStats_SubBand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22, 1}
+	return fileDescriptor_1df6bae1ac946b39, []int{24, 1}
 }
 func (m *GatewayConnectionStats_SubBand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1856,6 +2061,12 @@ func init() {
 	golang_proto.RegisterType((*GatewayVersion)(nil), "ttn.lorawan.v3.GatewayVersion")
 	proto.RegisterType((*GatewayClaimAuthenticationCode)(nil), "ttn.lorawan.v3.GatewayClaimAuthenticationCode")
 	golang_proto.RegisterType((*GatewayClaimAuthenticationCode)(nil), "ttn.lorawan.v3.GatewayClaimAuthenticationCode")
+	proto.RegisterType((*GatewayBeaconing)(nil), "ttn.lorawan.v3.GatewayBeaconing")
+	golang_proto.RegisterType((*GatewayBeaconing)(nil), "ttn.lorawan.v3.GatewayBeaconing")
+	proto.RegisterType((*GatewayUplinkFilters)(nil), "ttn.lorawan.v3.GatewayUplinkFilters")
+	golang_proto.RegisterType((*GatewayUplinkFilters)(nil), "ttn.lorawan.v3.GatewayUplinkFilters")
+	proto.RegisterType((*GatewayUplinkFilters_Rule)(nil), "ttn.lorawan.v3.GatewayUplinkFilters.Rule")
+	golang_proto.RegisterType((*GatewayUplinkFilters_Rule)(nil), "ttn.lorawan.v3.GatewayUplinkFilters.Rule")
 	proto.RegisterType((*Gateway)(nil), "ttn.lorawan.v3.Gateway")
 	golang_proto.RegisterType((*Gateway)(nil), "ttn.lorawan.v3.Gateway")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.Gateway.AttributesEntry")
@@ -1910,191 +2121,212 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xd5, 0x1c, 0x52, 0x3f, 0xd4, 0x50, 0x3f, 0xf4, 0x44, 0x91, 0x57, 0xb2, 0xbd, 0x54, 0x18, 0xe5,
-	0x8b, 0xec, 0xcf, 0xa4, 0xbe, 0xd0, 0xf1, 0x87, 0xef, 0x73, 0xe2, 0x3a, 0x5c, 0x2a, 0x4e, 0x05,
-	0xcb, 0xb1, 0xb3, 0xb2, 0x12, 0x34, 0x76, 0xbc, 0x18, 0xee, 0x0e, 0xa9, 0x8d, 0x96, 0xbb, 0xec,
-	0xee, 0xac, 0x6c, 0x26, 0x4e, 0x10, 0x14, 0x29, 0x1a, 0xe4, 0x50, 0x04, 0xee, 0x25, 0x08, 0x7a,
-	0x48, 0x0f, 0x2d, 0x82, 0xb6, 0x40, 0x8d, 0x1e, 0x8a, 0x1c, 0x7a, 0xc8, 0xa1, 0x2d, 0x7c, 0x2a,
-	0x7c, 0x0a, 0x82, 0x16, 0x50, 0x23, 0xea, 0x92, 0xde, 0x82, 0xf6, 0x12, 0xe8, 0x54, 0xcc, 0xec,
-	0xec, 0x72, 0x49, 0xfd, 0x54, 0xaa, 0xe3, 0xb4, 0x27, 0xee, 0xcc, 0xbc, 0xff, 0xf7, 0xe6, 0xbd,
-	0x79, 0x8f, 0x30, 0x67, 0x39, 0x2e, 0xbe, 0x81, 0xed, 0x82, 0x47, 0xb1, 0xbe, 0x3a, 0x87, 0x9b,
-	0xe6, 0x5c, 0x1d, 0x53, 0x72, 0x03, 0xb7, 0x8a, 0x4d, 0xd7, 0xa1, 0x0e, 0x1a, 0xa5, 0xd4, 0x2e,
-	0x0a, 0xa0, 0xe2, 0xda, 0xa9, 0xa9, 0x72, 0xdd, 0xa4, 0x2b, 0x7e, 0xb5, 0xa8, 0x3b, 0x8d, 0x39,
-	0x62, 0xaf, 0x39, 0xad, 0xa6, 0xeb, 0xdc, 0x6c, 0xcd, 0x71, 0x60, 0xbd, 0x50, 0x27, 0x76, 0x61,
-	0x0d, 0x5b, 0xa6, 0x81, 0x29, 0x99, 0xdb, 0xf6, 0x11, 0x90, 0x9c, 0x2a, 0xc4, 0x48, 0xd4, 0x9d,
-	0xba, 0x13, 0x20, 0x57, 0xfd, 0x1a, 0x5f, 0xf1, 0x05, 0xff, 0x12, 0xe0, 0x72, 0xdd, 0x71, 0xea,
-	0x16, 0xe9, 0x40, 0x19, 0xbe, 0x8b, 0xa9, 0xe9, 0xd8, 0xe2, 0x7c, 0xba, 0xf7, 0xbc, 0x66, 0x12,
-	0xcb, 0xd0, 0x1a, 0xd8, 0x5b, 0x15, 0x10, 0x47, 0x7b, 0x21, 0x3c, 0xea, 0xfa, 0x3a, 0x15, 0xa7,
-	0xb9, 0xde, 0x53, 0x6a, 0x36, 0x88, 0x47, 0x71, 0xa3, 0x29, 0x00, 0x66, 0xb6, 0xdb, 0x48, 0x77,
-	0x6c, 0x8a, 0x75, 0xaa, 0x99, 0x76, 0x2d, 0x14, 0xf3, 0xd8, 0x76, 0x28, 0x62, 0xfb, 0x0d, 0x4f,
-	0x1c, 0x3f, 0xba, 0xfd, 0xd8, 0x34, 0x88, 0x4d, 0xcd, 0x9a, 0x49, 0xdc, 0x10, 0x68, 0x7a, 0x3b,
-	0x50, 0x83, 0x50, 0x6c, 0x60, 0x8a, 0x43, 0x63, 0x6c, 0x87, 0x70, 0xcd, 0xfa, 0x0a, 0x0d, 0x29,
-	0xec, 0xe0, 0x4f, 0x8f, 0xe8, 0x2e, 0x09, 0x01, 0xf2, 0xab, 0x70, 0xf8, 0xb9, 0xc0, 0xc1, 0x8a,
-	0x8b, 0x6d, 0x03, 0x4d, 0xc0, 0xa4, 0x69, 0x48, 0x60, 0x1a, 0xcc, 0x0e, 0x29, 0x03, 0xed, 0xf5,
-	0x5c, 0x72, 0x61, 0x5e, 0x4d, 0x9a, 0x06, 0x42, 0xb0, 0xcf, 0xc6, 0x0d, 0x22, 0x25, 0xd9, 0x89,
-	0xca, 0xbf, 0xd1, 0x24, 0x4c, 0xf9, 0xae, 0x25, 0xa5, 0x38, 0xf0, 0x60, 0x7b, 0x3d, 0x97, 0x5a,
-	0x56, 0x17, 0x55, 0xb6, 0x87, 0xc6, 0x61, 0xbf, 0xe5, 0xd4, 0x1d, 0x4f, 0xea, 0x9b, 0x4e, 0xcd,
-	0x0e, 0xa9, 0xc1, 0x22, 0x7f, 0x07, 0x44, 0xdc, 0x2e, 0x3a, 0x06, 0xb1, 0xd0, 0x45, 0x98, 0xae,
-	0x32, 0xb6, 0x5a, 0xc4, 0xb3, 0xb4, 0xa5, 0xcc, 0xb8, 0x79, 0x69, 0xa6, 0x24, 0x5f, 0xbf, 0x8a,
-	0x0b, 0xaf, 0xfd, 0x4f, 0xe1, 0xff, 0x5f, 0x99, 0x3d, 0x77, 0xe6, 0x6a, 0xe1, 0x95, 0x73, 0xe1,
-	0xf2, 0xf8, 0xeb, 0xa5, 0x93, 0x6f, 0xcc, 0xb4, 0xd7, 0x73, 0x83, 0x5c, 0xe2, 0x85, 0x79, 0x75,
-	0x90, 0xd3, 0x58, 0x30, 0xd0, 0x59, 0x2e, 0x3c, 0x17, 0x51, 0x29, 0xec, 0x9f, 0x50, 0xaf, 0x8e,
-	0xa9, 0x8e, 0x8e, 0xf9, 0x9f, 0x24, 0xe1, 0xa4, 0x10, 0xf9, 0x45, 0xe2, 0x7a, 0xa6, 0x63, 0x2f,
-	0x74, 0xdc, 0xf4, 0x75, 0xcb, 0x7f, 0x11, 0xa6, 0x1b, 0xcc, 0x2e, 0x5a, 0xa4, 0xc5, 0x41, 0xc8,
-	0x71, 0x93, 0x32, 0x72, 0x9c, 0xc6, 0x82, 0x81, 0x4a, 0x30, 0xbb, 0x82, 0x5d, 0xe3, 0x06, 0x76,
-	0x89, 0xb6, 0x16, 0x08, 0x1f, 0x3a, 0x6b, 0x4b, 0xe9, 0x73, 0x93, 0xd2, 0xb4, 0x3a, 0x16, 0x02,
-	0x08, 0xe5, 0x18, 0x4e, 0xcd, 0x74, 0x1b, 0x5d, 0x38, 0x7d, 0x3d, 0x38, 0x21, 0x80, 0xc0, 0xc9,
-	0xff, 0x2d, 0x19, 0xb9, 0x55, 0xc5, 0x86, 0xe9, 0xa0, 0x09, 0x38, 0x40, 0x6c, 0x5c, 0xb5, 0x08,
-	0x37, 0x4a, 0x5a, 0x15, 0x2b, 0x74, 0x04, 0x0e, 0xe9, 0x2b, 0x66, 0x53, 0xa3, 0xad, 0x66, 0x18,
-	0x49, 0x69, 0xb6, 0x71, 0xa5, 0xd5, 0x24, 0xe8, 0x28, 0x1c, 0xaa, 0xb9, 0xe4, 0xbb, 0x3e, 0xb1,
-	0xf5, 0x16, 0x17, 0xb3, 0x4f, 0xed, 0x6c, 0xa0, 0x39, 0x98, 0x71, 0x3d, 0xcf, 0xd4, 0x9c, 0x5a,
-	0xcd, 0x23, 0x94, 0x8b, 0x94, 0x54, 0x46, 0xdb, 0xeb, 0x39, 0xa8, 0x2e, 0x2d, 0x2d, 0x5c, 0xe2,
-	0xbb, 0x2a, 0x64, 0x20, 0xc1, 0x37, 0x7a, 0x09, 0x66, 0xe9, 0x4d, 0x4d, 0x77, 0xec, 0x9a, 0x59,
-	0x17, 0x09, 0x42, 0xea, 0x9f, 0x06, 0xb3, 0x99, 0xd2, 0xc9, 0x62, 0x77, 0x0e, 0x2b, 0xc6, 0x65,
-	0x2f, 0x5e, 0xb9, 0x59, 0x89, 0xe3, 0xa8, 0x63, 0xb4, 0x7b, 0x63, 0xea, 0x6d, 0x00, 0xc7, 0x7a,
-	0x80, 0xd0, 0xa3, 0x70, 0xa4, 0x61, 0xda, 0x5a, 0x47, 0x7e, 0xc0, 0xe5, 0x1f, 0x6e, 0x98, 0xf6,
-	0xf9, 0x48, 0x05, 0x06, 0x84, 0x6f, 0xc6, 0x80, 0x92, 0x02, 0x08, 0xdf, 0xec, 0x00, 0x3d, 0x0e,
-	0xc7, 0x6c, 0x87, 0xea, 0x2b, 0x5a, 0xaf, 0x2d, 0x46, 0xf9, 0x76, 0x04, 0x98, 0xff, 0x14, 0xc0,
-	0xd1, 0xee, 0xc0, 0x44, 0x17, 0x61, 0xca, 0x34, 0x3c, 0xce, 0x3b, 0x53, 0x3a, 0xbe, 0x8b, 0x96,
-	0xdb, 0xa3, 0x58, 0xc9, 0x6e, 0x29, 0xfd, 0xef, 0x82, 0x64, 0x16, 0xdc, 0x5d, 0xcf, 0x25, 0xee,
-	0xad, 0xe7, 0x80, 0xca, 0xe8, 0x30, 0x2f, 0x36, 0x57, 0x1c, 0xea, 0x78, 0x52, 0x92, 0x5f, 0x62,
-	0xb1, 0x42, 0x4f, 0xc2, 0x01, 0x97, 0x99, 0xca, 0x93, 0x52, 0xd3, 0xa9, 0xd9, 0x4c, 0xe9, 0xe8,
-	0x5e, 0xf6, 0x54, 0x05, 0x2c, 0x7a, 0x04, 0x0e, 0xeb, 0x96, 0xa3, 0xaf, 0x6a, 0x9e, 0xe3, 0xbb,
-	0x3a, 0x91, 0x06, 0xa7, 0xc1, 0xec, 0x88, 0x9a, 0xe1, 0x7b, 0x4b, 0x7c, 0xeb, 0x4c, 0xdf, 0xc7,
-	0x1f, 0xe6, 0x12, 0xf9, 0x3f, 0x02, 0x28, 0x0b, 0x0a, 0x15, 0x0b, 0x9b, 0x8d, 0xb2, 0x4f, 0x57,
-	0x98, 0xac, 0x3a, 0x37, 0x75, 0xc5, 0x31, 0x08, 0x2a, 0xc2, 0x81, 0x20, 0x8b, 0x09, 0x5d, 0x27,
-	0x7a, 0x25, 0x58, 0xe2, 0xa7, 0xaa, 0x80, 0x42, 0xe7, 0x20, 0xe4, 0x35, 0x47, 0xab, 0xb9, 0x4e,
-	0x83, 0x9b, 0x3d, 0x53, 0x9a, 0x2a, 0x06, 0x79, 0xbe, 0x18, 0xe6, 0xf9, 0xe2, 0x95, 0x30, 0xcf,
-	0x2b, 0x7d, 0xef, 0xfd, 0x25, 0x07, 0xd4, 0x21, 0x8e, 0x73, 0xde, 0x75, 0x1a, 0xe8, 0x29, 0x98,
-	0x0e, 0x08, 0x50, 0x87, 0xbb, 0x63, 0x3f, 0xe8, 0x83, 0x1c, 0xe3, 0x8a, 0x93, 0xff, 0xd1, 0x18,
-	0x1c, 0x14, 0x0a, 0xa1, 0xf3, 0x71, 0x17, 0xe5, 0x77, 0x31, 0xdc, 0x3e, 0x7c, 0x53, 0x81, 0x50,
-	0x77, 0x09, 0xa6, 0xc4, 0xd0, 0x30, 0xdd, 0x87, 0x46, 0x69, 0x86, 0x1e, 0x68, 0x25, 0xf0, 0xca,
-	0x94, 0x11, 0xf1, 0x9b, 0x46, 0x48, 0x24, 0x75, 0x10, 0x22, 0x02, 0xaf, 0x4c, 0xd1, 0x11, 0x91,
-	0x34, 0xbb, 0x92, 0x44, 0x49, 0x54, 0x88, 0x13, 0x30, 0x63, 0x10, 0x4f, 0x77, 0xcd, 0x66, 0x74,
-	0xff, 0x86, 0x94, 0xf4, 0x96, 0xd2, 0xef, 0xa6, 0xa4, 0x7b, 0x63, 0x6a, 0xfc, 0x10, 0xbd, 0x09,
-	0x21, 0xa6, 0xd4, 0x35, 0xab, 0x3e, 0x25, 0x9e, 0x34, 0xc0, 0x43, 0xeb, 0xf1, 0x5d, 0x2c, 0x54,
-	0x2c, 0x47, 0x90, 0xcf, 0xda, 0xd4, 0x6d, 0x29, 0xa7, 0xb7, 0x94, 0xd2, 0x07, 0x60, 0x2e, 0x0b,
-	0xf3, 0xfb, 0x4a, 0x97, 0x27, 0x98, 0x00, 0x77, 0x81, 0x1a, 0xe3, 0x88, 0xbe, 0x0d, 0x87, 0xe3,
-	0x65, 0x5c, 0x1a, 0xe4, 0x12, 0x1c, 0xe9, 0x95, 0xa0, 0x12, 0xc0, 0x2c, 0xd8, 0x35, 0x87, 0x6b,
-	0x72, 0x1b, 0x24, 0xb3, 0x50, 0xcd, 0xe8, 0x9d, 0x6d, 0x74, 0x0d, 0x66, 0x44, 0xea, 0xd4, 0x98,
-	0xb3, 0xd3, 0xf7, 0x7f, 0x1f, 0xe1, 0x5a, 0x08, 0xe5, 0xa1, 0xdf, 0x03, 0x38, 0x21, 0xde, 0x64,
-	0x9a, 0x47, 0xdc, 0x35, 0xe2, 0x6a, 0xd8, 0x30, 0x5c, 0xe2, 0x79, 0xd2, 0x10, 0xb7, 0xef, 0x0f,
-	0xc1, 0x96, 0xf2, 0x2e, 0x70, 0x7f, 0x00, 0x4a, 0x6f, 0x83, 0xeb, 0xb3, 0xe7, 0xce, 0x30, 0x0b,
-	0xe0, 0xc2, 0x6b, 0xe5, 0xc2, 0xcb, 0xcc, 0x00, 0xb7, 0x62, 0xdf, 0x9d, 0xcf, 0x6b, 0x85, 0x57,
-	0x4e, 0xc4, 0x0e, 0x8e, 0x5f, 0x2b, 0x1e, 0x3f, 0xc1, 0xf0, 0xca, 0x85, 0x97, 0x85, 0xe1, 0x6e,
-	0xc5, 0xbe, 0x3b, 0x9f, 0x1c, 0xaf, 0x73, 0x70, 0x7c, 0xf6, 0xdc, 0x99, 0x33, 0x57, 0xd9, 0xd7,
-	0xeb, 0x4f, 0x9c, 0x3c, 0xfd, 0xc6, 0xf1, 0x73, 0x33, 0xb7, 0xae, 0xcf, 0xa8, 0xe3, 0x42, 0xdc,
-	0x25, 0x2e, 0x6d, 0x39, 0x10, 0x16, 0xe5, 0x60, 0x06, 0xfb, 0xd4, 0xd1, 0x82, 0x50, 0x92, 0x20,
-	0xaf, 0x14, 0x90, 0x6d, 0x2d, 0xf3, 0x1d, 0x34, 0x07, 0x47, 0x83, 0x33, 0x4d, 0x5f, 0xc1, 0xb6,
-	0x4d, 0x2c, 0x29, 0x13, 0x8f, 0x9f, 0xb7, 0x80, 0x3a, 0x12, 0x9c, 0x57, 0x82, 0x63, 0x74, 0x1e,
-	0x1e, 0x8a, 0xb2, 0xa6, 0xd6, 0xb4, 0x30, 0x33, 0xbf, 0x34, 0xcc, 0x71, 0xa6, 0x82, 0xb8, 0x7c,
-	0xa6, 0xbd, 0x9e, 0x1b, 0x8b, 0x72, 0xe8, 0x65, 0x0b, 0xdb, 0x0b, 0xf3, 0xea, 0x58, 0xad, 0x6b,
-	0xc3, 0x40, 0x97, 0x21, 0xda, 0x46, 0xc7, 0x93, 0xc6, 0x59, 0x12, 0x54, 0xf2, 0x5b, 0x4a, 0xe6,
-	0x36, 0x48, 0x67, 0xd3, 0xf9, 0x90, 0x5e, 0xb6, 0x87, 0x9e, 0xa7, 0x66, 0x7b, 0x08, 0xb2, 0xd8,
-	0x4a, 0x63, 0x9b, 0x12, 0xdb, 0xc6, 0x9e, 0x34, 0xc2, 0xe3, 0x4a, 0xde, 0x25, 0x1c, 0xca, 0x01,
-	0x98, 0x32, 0x2c, 0x42, 0x8b, 0xdf, 0x39, 0x35, 0xc2, 0x66, 0x45, 0xc4, 0xa3, 0x98, 0xfa, 0x9e,
-	0xd6, 0xf4, 0xab, 0x96, 0xa9, 0x4b, 0xa3, 0xdc, 0x6e, 0xc3, 0xc1, 0xe6, 0x65, 0xbe, 0xc7, 0x8a,
-	0x88, 0xe5, 0x04, 0xf9, 0x32, 0x04, 0x1b, 0xe3, 0x60, 0xa3, 0xe1, 0xb6, 0x00, 0x7c, 0x12, 0x4e,
-	0x78, 0xfa, 0x0a, 0x31, 0x7c, 0x8b, 0x68, 0x86, 0x73, 0xc3, 0xb6, 0x4c, 0x7b, 0x55, 0xb3, 0x98,
-	0x3b, 0xb2, 0x1c, 0x7e, 0x3c, 0x3c, 0x9d, 0x17, 0x87, 0x8b, 0xcc, 0x31, 0x27, 0x21, 0x22, 0x76,
-	0xcd, 0x71, 0x75, 0xa2, 0x19, 0x3e, 0x6d, 0x69, 0x7a, 0x4b, 0xb7, 0x88, 0x74, 0x88, 0x63, 0x64,
-	0xc5, 0xc9, 0xbc, 0x4f, 0x5b, 0x15, 0xb6, 0x8f, 0x5e, 0x85, 0x52, 0x44, 0xba, 0x89, 0xe9, 0x0a,
-	0xab, 0xc9, 0x1e, 0x75, 0xb1, 0x69, 0x53, 0x09, 0x4d, 0x83, 0xd9, 0xd1, 0xd2, 0x7f, 0xf5, 0xda,
-	0x22, 0xe4, 0x76, 0x19, 0xd3, 0x95, 0x4a, 0x04, 0xcd, 0x1d, 0xff, 0x3d, 0x76, 0x2f, 0xd4, 0x09,
-	0x63, 0x47, 0x08, 0xf4, 0x9d, 0x98, 0x3e, 0xd8, 0x6e, 0xb1, 0x97, 0xbb, 0x66, 0x10, 0x0b, 0xb7,
-	0xa4, 0x87, 0xf8, 0x25, 0x9c, 0xdc, 0x96, 0xdd, 0xe6, 0x45, 0x09, 0xe7, 0xc9, 0x0d, 0xbc, 0xcf,
-	0x92, 0x5b, 0xa4, 0x74, 0x39, 0xa0, 0x30, 0xcf, 0x08, 0xa0, 0xb3, 0xf0, 0x88, 0x88, 0xc6, 0xc8,
-	0xb4, 0xac, 0x9a, 0x68, 0x81, 0xe1, 0xa5, 0x87, 0xb9, 0xf6, 0x52, 0x00, 0xb2, 0x28, 0x20, 0x58,
-	0xed, 0x58, 0xe2, 0xe7, 0xe8, 0x79, 0x38, 0x6a, 0x55, 0x3d, 0xcd, 0xb2, 0x3d, 0x4d, 0x94, 0xae,
-	0x89, 0xbd, 0x4a, 0x97, 0x92, 0x6d, 0xaf, 0xe7, 0x86, 0x17, 0x95, 0xa5, 0xc5, 0xe7, 0x97, 0x44,
-	0x31, 0x1b, 0xb6, 0xaa, 0xde, 0xa2, 0xed, 0x05, 0x2b, 0xf4, 0x2a, 0x9c, 0xd4, 0x59, 0x75, 0xd4,
-	0x70, 0x57, 0x79, 0xd4, 0x74, 0xc7, 0x20, 0xd2, 0x61, 0x4e, 0xba, 0xb8, 0x4b, 0x88, 0xed, 0x52,
-	0x55, 0xd5, 0xc3, 0xfa, 0x2e, 0xe5, 0xb6, 0x0c, 0xc7, 0x28, 0x76, 0xeb, 0x84, 0x6a, 0xba, 0xdf,
-	0xf4, 0x34, 0xdf, 0x35, 0x25, 0x89, 0xdf, 0xaa, 0x49, 0x7e, 0x13, 0xdf, 0x01, 0xa0, 0xbd, 0x9e,
-	0x1b, 0xb9, 0xc2, 0x41, 0x2a, 0xcb, 0x97, 0x97, 0x96, 0xd5, 0x05, 0x75, 0x24, 0xc0, 0xa8, 0xf8,
-	0x4d, 0x6f, 0xd9, 0x35, 0xd1, 0x0b, 0xdd, 0x24, 0x56, 0x49, 0x4b, 0x9a, 0xdc, 0x53, 0xff, 0x43,
-	0xdd, 0x24, 0x2f, 0x90, 0x56, 0x9c, 0xe4, 0x05, 0xd2, 0x9a, 0x3a, 0x0b, 0xc7, 0x7a, 0xaa, 0x00,
-	0xca, 0xc2, 0x14, 0xa3, 0xcc, 0x5f, 0xe2, 0x2a, 0xfb, 0x64, 0x7d, 0xc8, 0x1a, 0xb6, 0xfc, 0xf0,
-	0xb5, 0x19, 0x2c, 0xce, 0x24, 0xff, 0x0f, 0xe4, 0xcf, 0xc1, 0xb4, 0xb0, 0x87, 0x87, 0x4e, 0xc1,
-	0xb4, 0x48, 0x51, 0xac, 0x34, 0xb3, 0xeb, 0x79, 0x78, 0xb7, 0x37, 0x4d, 0x04, 0x98, 0xff, 0x05,
-	0x80, 0x87, 0x9e, 0x23, 0x34, 0x3c, 0x60, 0x37, 0xde, 0xa3, 0x68, 0x19, 0x66, 0xc2, 0xe4, 0x7c,
-	0xbf, 0x85, 0x1e, 0xd6, 0x43, 0x28, 0x8f, 0xbd, 0x60, 0x3a, 0x6d, 0xec, 0xae, 0xf5, 0xfe, 0x3c,
-	0x03, 0xb9, 0x88, 0xbd, 0x55, 0xa5, 0x8f, 0xa7, 0x8d, 0xa1, 0x5a, 0xb8, 0x91, 0xbf, 0x05, 0xf3,
-	0x1d, 0x61, 0x63, 0x7c, 0xcf, 0x3b, 0xee, 0xb3, 0xcb, 0x0b, 0xa1, 0xf4, 0x2f, 0xc2, 0x14, 0xf1,
-	0x4d, 0x2e, 0xf5, 0xb0, 0x32, 0xcf, 0x68, 0xfc, 0x69, 0x3d, 0x77, 0xba, 0xee, 0x14, 0xe9, 0x0a,
-	0xa1, 0x2b, 0xa6, 0x5d, 0xf7, 0x8a, 0x36, 0xa1, 0x37, 0x1c, 0x77, 0x75, 0xae, 0xbb, 0xb1, 0x5c,
-	0x3b, 0x35, 0xd7, 0x5c, 0xad, 0xcf, 0xb1, 0x87, 0xbd, 0x57, 0x7c, 0x76, 0x79, 0xe1, 0x7f, 0x9f,
-	0x64, 0xed, 0x20, 0xa3, 0xcc, 0x08, 0xe6, 0x3f, 0x4d, 0xc2, 0x87, 0x16, 0x4d, 0x2f, 0xe4, 0xef,
-	0x85, 0xfc, 0x5e, 0x60, 0x35, 0xd7, 0xb2, 0x70, 0xd5, 0x71, 0x31, 0x75, 0x5c, 0x61, 0xae, 0x42,
-	0xaf, 0xb9, 0x2e, 0xb9, 0x75, 0x6c, 0x9b, 0xaf, 0xf1, 0x88, 0xbc, 0xe4, 0x2e, 0x7b, 0xc4, 0x8d,
-	0x69, 0xa0, 0x76, 0x91, 0xb8, 0x6f, 0x4b, 0xa1, 0x1b, 0xb0, 0xdf, 0x71, 0x0d, 0xe2, 0x8a, 0x56,
-	0x09, 0x6f, 0x29, 0xd7, 0xdd, 0x6b, 0x6a, 0x22, 0x72, 0x87, 0x66, 0x1a, 0x6a, 0xa6, 0x10, 0x5f,
-	0x84, 0xdf, 0xc4, 0x37, 0xd5, 0xe1, 0x42, 0x7c, 0xc5, 0x5f, 0x41, 0x6a, 0x7f, 0x81, 0xff, 0xc4,
-	0x5e, 0x6c, 0x6a, 0xa6, 0x10, 0x5b, 0x04, 0xfc, 0x90, 0x0c, 0xfb, 0x2d, 0xb3, 0x61, 0x06, 0xcd,
-	0xcd, 0x08, 0xcf, 0x76, 0x27, 0x52, 0xd2, 0x17, 0x83, 0x6a, 0xb0, 0xcd, 0xda, 0xd3, 0x26, 0xae,
-	0x13, 0xfe, 0x8a, 0x1a, 0x51, 0xf9, 0x77, 0xfe, 0xb7, 0x00, 0x8e, 0x57, 0x38, 0xa5, 0x9e, 0x38,
-	0xac, 0xc0, 0x41, 0x21, 0x88, 0x30, 0xea, 0x6e, 0x11, 0xbd, 0x43, 0xe0, 0x85, 0x98, 0x48, 0xeb,
-	0x71, 0x4f, 0xf2, 0x5f, 0x70, 0x0f, 0xaf, 0x64, 0x11, 0xfd, 0x6e, 0x67, 0xe5, 0x7f, 0x0c, 0xe0,
-	0x78, 0x50, 0xed, 0x1f, 0x84, 0xf8, 0xf7, 0x7d, 0x69, 0x7e, 0x06, 0xe0, 0x64, 0x2c, 0x6c, 0xcb,
-	0x97, 0x17, 0x2e, 0x90, 0x4e, 0xf0, 0x3e, 0xa0, 0xab, 0x1e, 0x85, 0x41, 0x72, 0xef, 0x30, 0x48,
-	0xc5, 0xc2, 0xe0, 0x36, 0x80, 0x87, 0x3b, 0xd7, 0x3b, 0x90, 0xf3, 0x01, 0x8b, 0x39, 0x0d, 0x07,
-	0x56, 0x49, 0xab, 0x33, 0xa9, 0x18, 0x6a, 0xaf, 0xe7, 0xfa, 0x2f, 0x90, 0xd6, 0xc2, 0xbc, 0xda,
-	0xbf, 0x4a, 0x5a, 0x0b, 0x06, 0x6b, 0xe4, 0xa6, 0xba, 0x62, 0xf3, 0x1b, 0x91, 0xeb, 0x48, 0x7c,
-	0x50, 0xd5, 0xdb, 0x8f, 0x3c, 0x0d, 0x07, 0x82, 0xf1, 0x18, 0x6f, 0x5d, 0x47, 0x4b, 0x0f, 0xf7,
-	0xb2, 0x53, 0xd9, 0xa9, 0x32, 0xb2, 0xa5, 0xc0, 0xdb, 0x60, 0x30, 0x2f, 0x5e, 0x1b, 0x02, 0x27,
-	0xff, 0x1b, 0x00, 0xa7, 0xba, 0xa2, 0xf5, 0x1b, 0x51, 0xa8, 0x0c, 0x07, 0x71, 0xd3, 0xe4, 0x25,
-	0x33, 0xb9, 0x73, 0xc9, 0x0c, 0xc4, 0xd8, 0x81, 0xcc, 0x00, 0x6e, 0x9a, 0x17, 0x48, 0x2b, 0xff,
-	0x4b, 0x00, 0x73, 0xb1, 0x38, 0xae, 0xc4, 0xae, 0xe0, 0x7f, 0x62, 0x34, 0xff, 0x19, 0xc0, 0x63,
-	0x9d, 0x68, 0x8e, 0x4b, 0xfb, 0x80, 0x85, 0xd5, 0xbf, 0x8e, 0x7c, 0xb7, 0x9d, 0x45, 0x77, 0xce,
-	0xfb, 0x03, 0x80, 0xc7, 0x96, 0xfe, 0x1d, 0xda, 0x3d, 0xbf, 0xa3, 0x76, 0x47, 0xb7, 0x37, 0xb8,
-	0x1d, 0x98, 0x3d, 0x93, 0xf7, 0xaf, 0x92, 0xd1, 0x04, 0x4a, 0x74, 0x2d, 0xcc, 0x9b, 0x75, 0x6c,
-	0xda, 0x5c, 0xe4, 0xa4, 0xca, 0xbf, 0x91, 0x02, 0xd3, 0xe1, 0x8b, 0x59, 0xb0, 0x94, 0x7a, 0x59,
-	0x86, 0xef, 0xe5, 0x1e, 0x76, 0x11, 0x1e, 0xfa, 0x3e, 0xe8, 0x1a, 0x0e, 0x04, 0x73, 0xa7, 0xe2,
-	0xde, 0x2d, 0xd4, 0x03, 0x98, 0x11, 0xdc, 0xef, 0x9b, 0xf3, 0x4e, 0x3f, 0x1c, 0x11, 0x42, 0x8a,
-	0xb6, 0xe0, 0x19, 0xd8, 0xc7, 0x5a, 0x0c, 0xe1, 0xe3, 0xbd, 0x86, 0x2f, 0xcc, 0xb7, 0xbf, 0x06,
-	0xc9, 0x34, 0x88, 0x86, 0x30, 0x1c, 0x13, 0x95, 0xe1, 0x50, 0xd5, 0x71, 0xa8, 0xc6, 0xc9, 0x1c,
-	0x64, 0x10, 0x94, 0x66, 0x68, 0xec, 0x00, 0xbd, 0x09, 0xd3, 0x62, 0xbe, 0x10, 0x9a, 0xf6, 0xbf,
-	0x77, 0x31, 0x6d, 0x20, 0x75, 0x51, 0xcc, 0x2c, 0xb6, 0xd9, 0xf5, 0x31, 0xf7, 0x51, 0x69, 0xa6,
-	0x94, 0xeb, 0xb2, 0xab, 0xb6, 0xdd, 0xb0, 0xc1, 0x18, 0x39, 0xe2, 0x89, 0x2e, 0xc1, 0x43, 0xa2,
-	0xbf, 0x8d, 0x7a, 0xab, 0xe0, 0x8f, 0x83, 0xbd, 0x42, 0x25, 0x9c, 0xbd, 0xa4, 0xd5, 0xac, 0x40,
-	0x0e, 0x8f, 0x3c, 0x34, 0x03, 0x93, 0x66, 0x53, 0xea, 0xe7, 0x0d, 0xfb, 0xb8, 0x68, 0xd8, 0x21,
-	0x6b, 0xd8, 0x9b, 0x80, 0x8f, 0xfb, 0x2f, 0xab, 0x49, 0xb3, 0x89, 0x7c, 0x38, 0xd8, 0x20, 0xd4,
-	0x35, 0xf5, 0x70, 0xda, 0x74, 0x62, 0x6f, 0xad, 0x2f, 0x06, 0xc0, 0x81, 0xd2, 0x73, 0x5b, 0xca,
-	0xc9, 0x0f, 0xc0, 0xf1, 0x7d, 0x2b, 0xad, 0x86, 0xbc, 0x58, 0xb3, 0x81, 0x8d, 0x35, 0x6c, 0xeb,
-	0xc4, 0x90, 0x74, 0xf1, 0xb6, 0xe9, 0xf5, 0xd7, 0x12, 0xff, 0x43, 0x4a, 0x8d, 0x00, 0xa7, 0x9e,
-	0x82, 0x23, 0x5d, 0x46, 0x3f, 0x48, 0xd8, 0x4d, 0x9d, 0x81, 0xc3, 0x71, 0xd9, 0xff, 0x19, 0x6e,
-	0x32, 0x1e, 0xb2, 0x7f, 0x4f, 0xc3, 0x89, 0x28, 0x55, 0xd9, 0x36, 0xd1, 0x99, 0x85, 0x99, 0x41,
-	0x3c, 0x54, 0xe1, 0x03, 0x33, 0xb6, 0x15, 0x0c, 0x10, 0xc1, 0x3e, 0x07, 0xa3, 0x99, 0x08, 0xab,
-	0x4c, 0xd1, 0x14, 0x4c, 0x07, 0xff, 0x15, 0x3a, 0x56, 0xf8, 0x8f, 0x40, 0xb8, 0x46, 0x2f, 0xc1,
-	0xc3, 0x16, 0xf6, 0xa8, 0x68, 0xb1, 0x35, 0x97, 0xe8, 0xc4, 0x5c, 0xdb, 0xef, 0xb0, 0x32, 0xe0,
-	0x35, 0xce, 0x08, 0x04, 0xfe, 0x53, 0x05, 0x7a, 0x99, 0xa2, 0x6f, 0xc1, 0x4c, 0x8c, 0x30, 0x7f,
-	0x6f, 0x67, 0x4a, 0xc7, 0xf6, 0xf4, 0xbe, 0x0a, 0x3b, 0x94, 0x22, 0xc1, 0xfc, 0x26, 0x9f, 0x6a,
-	0xc4, 0x05, 0xeb, 0x3f, 0x88, 0x60, 0xcb, 0x1c, 0x3f, 0x26, 0xd8, 0x23, 0x70, 0x58, 0xd0, 0xd4,
-	0x1d, 0xdf, 0xa6, 0xd2, 0x00, 0x1f, 0xfd, 0x67, 0x82, 0xbd, 0x0a, 0xdb, 0x42, 0x57, 0xe1, 0x24,
-	0xe7, 0x1d, 0xcd, 0x54, 0xe2, 0xdc, 0x07, 0xf7, 0xc9, 0x7d, 0x82, 0x91, 0x08, 0xa7, 0x2c, 0x31,
-	0xfe, 0x8f, 0xc1, 0xd1, 0x88, 0x6e, 0x20, 0x41, 0x9a, 0x4b, 0x30, 0x12, 0xee, 0x06, 0x32, 0x68,
-	0x30, 0xeb, 0x3a, 0xbe, 0x6d, 0x68, 0xd4, 0x35, 0x9b, 0x3c, 0xf3, 0x04, 0xb3, 0xc7, 0x4c, 0xe9,
-	0xf4, 0x6e, 0x33, 0x87, 0xee, 0xd8, 0x29, 0xaa, 0x0c, 0xfd, 0x8a, 0x6b, 0x36, 0xb9, 0x64, 0xea,
-	0xa8, 0xdb, 0xb5, 0x46, 0x17, 0xe0, 0x90, 0xe7, 0x57, 0xb5, 0x2a, 0xb6, 0x0d, 0x4f, 0x82, 0x7b,
-	0x66, 0xfb, 0x5e, 0xca, 0x4b, 0x7e, 0x55, 0xc1, 0xb6, 0xa1, 0xa6, 0xbd, 0xe0, 0xc3, 0x9b, 0xfa,
-	0x2b, 0x80, 0xa3, 0xdd, 0xfc, 0xd0, 0x59, 0x98, 0x6a, 0x88, 0x32, 0xb5, 0xe7, 0x50, 0x88, 0x25,
-	0xdd, 0x9f, 0x87, 0x49, 0x97, 0x0f, 0x87, 0x18, 0x1e, 0x47, 0xc7, 0x37, 0x45, 0xb6, 0x3d, 0x20,
-	0x3a, 0xbe, 0x89, 0x2a, 0x70, 0xa0, 0x41, 0x0c, 0x13, 0xdb, 0x22, 0x8c, 0x0f, 0x44, 0x41, 0xa0,
-	0xb2, 0x2b, 0x1b, 0x78, 0x88, 0x77, 0x8b, 0x6a, 0xb0, 0x98, 0xfa, 0x1d, 0x80, 0x83, 0xc2, 0x02,
-	0x5f, 0xe3, 0x9f, 0x52, 0x4f, 0xc3, 0xa9, 0x28, 0x2c, 0x7c, 0x6a, 0x5a, 0xe2, 0xed, 0xa3, 0x05,
-	0x2f, 0xbb, 0x14, 0xcf, 0x19, 0xd1, 0x90, 0x6f, 0xb9, 0x03, 0xb0, 0xc8, 0x9f, 0x78, 0x4f, 0xc0,
-	0xf1, 0x9d, 0xb0, 0x83, 0xff, 0xf0, 0xd4, 0x87, 0x76, 0xc0, 0x53, 0x7e, 0x0a, 0xee, 0x6e, 0xc8,
-	0xe0, 0xde, 0x86, 0x0c, 0x3e, 0xdb, 0x90, 0x13, 0x9f, 0x6f, 0xc8, 0x89, 0x2f, 0x36, 0xe4, 0xc4,
-	0x97, 0x1b, 0x72, 0xe2, 0xab, 0x0d, 0x19, 0xbc, 0xd5, 0x96, 0xc1, 0x3b, 0x6d, 0x39, 0xf1, 0x51,
-	0x5b, 0x06, 0x77, 0xda, 0x72, 0xe2, 0xe3, 0xb6, 0x9c, 0xf8, 0xa4, 0x2d, 0x27, 0xee, 0xb6, 0x65,
-	0x70, 0xaf, 0x2d, 0x83, 0xcf, 0xda, 0x72, 0xe2, 0xf3, 0xb6, 0x0c, 0xbe, 0x68, 0xcb, 0x89, 0x2f,
-	0xdb, 0x32, 0xf8, 0xaa, 0x2d, 0x27, 0xde, 0xda, 0x94, 0x13, 0xef, 0x6c, 0xca, 0xe0, 0xbd, 0x4d,
-	0x39, 0xf1, 0xfe, 0xa6, 0x0c, 0x3e, 0xdc, 0x94, 0x13, 0x1f, 0x6d, 0xca, 0x89, 0x3b, 0x9b, 0x32,
-	0xf8, 0x78, 0x53, 0x06, 0x9f, 0x6c, 0xca, 0xe0, 0xe5, 0xb9, 0x03, 0x4c, 0x3a, 0xa8, 0xdd, 0xac,
-	0x56, 0x07, 0xb8, 0xc7, 0x4e, 0xfd, 0x23, 0x00, 0x00, 0xff, 0xff, 0x33, 0xfc, 0x16, 0x30, 0x37,
-	0x21, 0x00, 0x00,
+	// 3269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x5d, 0x6c, 0x1b, 0xc9,
+	0x79, 0x5c, 0x52, 0x12, 0xa9, 0x21, 0x25, 0xd1, 0x73, 0x3a, 0x79, 0x45, 0xdf, 0x2d, 0x15, 0x9e,
+	0xd2, 0xc8, 0xae, 0x49, 0x35, 0xbc, 0xbb, 0xa2, 0xf5, 0xc5, 0xd1, 0x71, 0x29, 0xcb, 0x65, 0x2d,
+	0xdb, 0xca, 0xc8, 0x72, 0xda, 0xb3, 0xcf, 0xdb, 0xe1, 0xee, 0x90, 0xda, 0xd3, 0x72, 0x97, 0xd9,
+	0x9d, 0x95, 0xc4, 0x8b, 0x13, 0x18, 0x41, 0x8a, 0x1e, 0xf2, 0x50, 0x04, 0x7e, 0x0a, 0x82, 0x3e,
+	0x5c, 0x1f, 0xda, 0x06, 0x6d, 0x81, 0x1a, 0x7d, 0x28, 0xee, 0xa1, 0x0f, 0x79, 0x68, 0x0b, 0x3f,
+	0x15, 0x7e, 0x0a, 0x82, 0x16, 0x50, 0xcf, 0xd4, 0xcb, 0xf5, 0x2d, 0xe8, 0x53, 0xa0, 0xa7, 0x62,
+	0x66, 0x67, 0xc9, 0x25, 0xf5, 0x73, 0xd2, 0xf9, 0x9c, 0xf6, 0x69, 0x67, 0xe6, 0xfb, 0xff, 0xe6,
+	0x9b, 0x6f, 0xbe, 0xf9, 0x16, 0xe4, 0x2d, 0xc7, 0xc5, 0x3b, 0xd8, 0x2e, 0x7a, 0x14, 0xeb, 0x5b,
+	0x8b, 0xb8, 0x6d, 0x2e, 0x36, 0x31, 0x25, 0x3b, 0xb8, 0x53, 0x6a, 0xbb, 0x0e, 0x75, 0xe0, 0x24,
+	0xa5, 0x76, 0x49, 0x20, 0x95, 0xb6, 0xdf, 0xcc, 0x55, 0x9a, 0x26, 0xdd, 0xf4, 0xeb, 0x25, 0xdd,
+	0x69, 0x2d, 0x12, 0x7b, 0xdb, 0xe9, 0xb4, 0x5d, 0x67, 0xb7, 0xb3, 0xc8, 0x91, 0xf5, 0x62, 0x93,
+	0xd8, 0xc5, 0x6d, 0x6c, 0x99, 0x06, 0xa6, 0x64, 0xf1, 0xd0, 0x20, 0x60, 0x99, 0x2b, 0x46, 0x58,
+	0x34, 0x9d, 0xa6, 0x13, 0x10, 0xd7, 0xfd, 0x06, 0x9f, 0xf1, 0x09, 0x1f, 0x09, 0x74, 0xa5, 0xe9,
+	0x38, 0x4d, 0x8b, 0xf4, 0xb1, 0x0c, 0xdf, 0xc5, 0xd4, 0x74, 0x6c, 0x01, 0x9f, 0x1b, 0x86, 0x37,
+	0x4c, 0x62, 0x19, 0x5a, 0x0b, 0x7b, 0x5b, 0x02, 0xe3, 0xb5, 0x61, 0x0c, 0x8f, 0xba, 0xbe, 0x4e,
+	0x05, 0x34, 0x3f, 0x0c, 0xa5, 0x66, 0x8b, 0x78, 0x14, 0xb7, 0xda, 0xc7, 0x29, 0xb0, 0xe3, 0xe2,
+	0x76, 0x9b, 0xb8, 0x9e, 0x80, 0xcf, 0x1f, 0xf6, 0xa1, 0xee, 0xd8, 0x14, 0xeb, 0x54, 0x33, 0xed,
+	0x46, 0x68, 0xc6, 0xeb, 0x87, 0xb1, 0x88, 0xed, 0xb7, 0x42, 0x26, 0x6f, 0x1c, 0x06, 0x9b, 0x06,
+	0xb1, 0xa9, 0xd9, 0x30, 0xfb, 0x92, 0xe6, 0x0e, 0x23, 0xb5, 0x08, 0xc5, 0x06, 0xa6, 0x38, 0xd4,
+	0xf5, 0x30, 0x86, 0x6b, 0x36, 0x37, 0x69, 0xc8, 0xe1, 0x88, 0xfd, 0xf6, 0x88, 0xee, 0x92, 0x10,
+	0xa1, 0xb0, 0x05, 0x32, 0xd7, 0x83, 0x00, 0x50, 0x5d, 0x6c, 0x1b, 0x70, 0x06, 0xc4, 0x4d, 0x43,
+	0x96, 0xe6, 0xa4, 0x85, 0x71, 0x75, 0xac, 0xbb, 0x97, 0x8f, 0xd7, 0x96, 0x51, 0xdc, 0x34, 0x20,
+	0x04, 0x23, 0x36, 0x6e, 0x11, 0x39, 0xce, 0x20, 0x88, 0x8f, 0xe1, 0x2c, 0x48, 0xf8, 0xae, 0x25,
+	0x27, 0x38, 0x72, 0xb2, 0xbb, 0x97, 0x4f, 0x6c, 0xa0, 0x55, 0xc4, 0xd6, 0xe0, 0x34, 0x18, 0xb5,
+	0x9c, 0xa6, 0xe3, 0xc9, 0x23, 0x73, 0x89, 0x85, 0x71, 0x14, 0x4c, 0x0a, 0x4f, 0xa4, 0x9e, 0xb4,
+	0x9b, 0x8e, 0x41, 0x2c, 0x78, 0x13, 0xa4, 0xea, 0x4c, 0xac, 0xd6, 0x93, 0x59, 0x3e, 0x50, 0xe7,
+	0xdd, 0x82, 0x3c, 0x5f, 0x56, 0x1e, 0xdc, 0xc3, 0xc5, 0x0f, 0x7f, 0xa7, 0xf8, 0xfb, 0xef, 0x2f,
+	0x2c, 0x5d, 0xb9, 0x57, 0x7c, 0x7f, 0x29, 0x9c, 0x5e, 0xfc, 0x6e, 0xf9, 0xf2, 0xf7, 0xe6, 0xbb,
+	0x7b, 0xf9, 0x24, 0xd7, 0xb8, 0xb6, 0x8c, 0x92, 0x9c, 0x47, 0xcd, 0x80, 0x57, 0xb9, 0xf2, 0x5c,
+	0x45, 0xb5, 0x78, 0x7a, 0x46, 0xc3, 0x36, 0x26, 0xfa, 0x36, 0x16, 0xfe, 0x32, 0x0e, 0x66, 0x85,
+	0xca, 0x77, 0x89, 0xeb, 0x99, 0x8e, 0x5d, 0xeb, 0x6f, 0xd3, 0x97, 0xad, 0xff, 0x4d, 0x90, 0x6a,
+	0x31, 0xbf, 0x68, 0x3d, 0x2b, 0xce, 0xc2, 0x8e, 0xbb, 0x94, 0xb1, 0xe3, 0x3c, 0x6a, 0x06, 0x2c,
+	0x83, 0xec, 0x26, 0x76, 0x8d, 0x1d, 0xec, 0x12, 0x6d, 0x3b, 0x50, 0x3e, 0xdc, 0xac, 0x03, 0x75,
+	0xc4, 0x8d, 0xcb, 0x73, 0x68, 0x2a, 0x44, 0x10, 0xc6, 0x31, 0x9a, 0x86, 0xe9, 0xb6, 0x06, 0x68,
+	0x46, 0x86, 0x68, 0x42, 0x04, 0x41, 0x53, 0xf8, 0x9f, 0x78, 0x6f, 0x5b, 0x11, 0x36, 0x4c, 0x07,
+	0xce, 0x80, 0x31, 0x62, 0xe3, 0xba, 0x45, 0xb8, 0x53, 0x52, 0x48, 0xcc, 0xe0, 0x05, 0x30, 0xae,
+	0x6f, 0x9a, 0x6d, 0x8d, 0x76, 0xda, 0x61, 0x24, 0xa5, 0xd8, 0xc2, 0x9d, 0x4e, 0x9b, 0xc0, 0xd7,
+	0xc0, 0x78, 0xc3, 0x25, 0xdf, 0xf1, 0x89, 0xad, 0x77, 0xb8, 0x9a, 0x23, 0xa8, 0xbf, 0x00, 0x17,
+	0x41, 0xda, 0xf5, 0x3c, 0x53, 0x73, 0x1a, 0x0d, 0x8f, 0x50, 0xae, 0x52, 0x5c, 0x9d, 0xec, 0xee,
+	0xe5, 0x01, 0x5a, 0x5f, 0xaf, 0xdd, 0xe6, 0xab, 0x08, 0x30, 0x94, 0x60, 0x0c, 0xbf, 0x0d, 0xb2,
+	0x74, 0x57, 0xd3, 0x1d, 0xbb, 0x61, 0x36, 0x45, 0x02, 0x91, 0x47, 0xe7, 0xa4, 0x85, 0x74, 0xf9,
+	0x72, 0x69, 0x30, 0xc7, 0x95, 0xa2, 0xba, 0x97, 0xee, 0xec, 0x56, 0xa3, 0x34, 0x68, 0x8a, 0x0e,
+	0x2e, 0xe4, 0x7e, 0x28, 0x81, 0xa9, 0x21, 0x24, 0xf8, 0x06, 0x98, 0x68, 0x99, 0xb6, 0xd6, 0xd7,
+	0x5f, 0xe2, 0xfa, 0x67, 0x5a, 0xa6, 0xbd, 0xd2, 0x33, 0x81, 0x21, 0xe1, 0xdd, 0x08, 0x52, 0x5c,
+	0x20, 0xe1, 0xdd, 0x3e, 0xd2, 0xd7, 0xc0, 0x94, 0xed, 0x50, 0x7d, 0x53, 0x1b, 0xf6, 0xc5, 0x24,
+	0x5f, 0xee, 0x21, 0x16, 0x7e, 0x21, 0x81, 0xc9, 0xc1, 0xc0, 0x84, 0x37, 0x41, 0xc2, 0x34, 0x3c,
+	0x2e, 0x3b, 0x5d, 0xbe, 0x78, 0x8c, 0x95, 0x87, 0xa3, 0x58, 0xcd, 0x1e, 0xa8, 0xa3, 0x3f, 0x92,
+	0xe2, 0x59, 0xe9, 0xe9, 0x5e, 0x3e, 0xf6, 0x6c, 0x2f, 0x2f, 0x21, 0xc6, 0x87, 0xed, 0x62, 0x7b,
+	0xd3, 0xa1, 0x8e, 0x27, 0xc7, 0xf9, 0x21, 0x16, 0x33, 0xf8, 0x16, 0x18, 0x73, 0x99, 0xab, 0x3c,
+	0x39, 0x31, 0x97, 0x58, 0x48, 0x97, 0x5f, 0x3b, 0xc9, 0x9f, 0x48, 0xe0, 0xc2, 0xaf, 0x80, 0x8c,
+	0x6e, 0x39, 0xfa, 0x96, 0xe6, 0x39, 0xbe, 0xab, 0x13, 0x39, 0x39, 0x27, 0x2d, 0x4c, 0xa0, 0x34,
+	0x5f, 0x5b, 0xe7, 0x4b, 0x57, 0x46, 0x3e, 0xf9, 0x38, 0x1f, 0x2b, 0xfc, 0xbb, 0x04, 0x14, 0xc1,
+	0xa1, 0x6a, 0x61, 0xb3, 0x55, 0xf1, 0xe9, 0x26, 0xd3, 0x55, 0xe7, 0xae, 0xae, 0x3a, 0x06, 0x81,
+	0x25, 0x30, 0x16, 0x64, 0x31, 0x61, 0xeb, 0xcc, 0xb0, 0x06, 0xeb, 0x1c, 0x8a, 0x04, 0x16, 0x5c,
+	0x02, 0x80, 0xdf, 0x49, 0x5a, 0xc3, 0x75, 0x5a, 0xdc, 0xed, 0xe9, 0x72, 0xae, 0x14, 0xa4, 0xf9,
+	0x52, 0x98, 0xe6, 0x4b, 0x77, 0xc2, 0x7b, 0x40, 0x1d, 0xf9, 0xf1, 0x7f, 0xe5, 0x25, 0x34, 0xce,
+	0x69, 0x56, 0x5c, 0xa7, 0x05, 0xdf, 0x01, 0xa9, 0x80, 0x01, 0x75, 0xe4, 0xc4, 0x29, 0xc9, 0x93,
+	0x9c, 0xe2, 0x8e, 0x53, 0xf8, 0x23, 0x90, 0x0d, 0x53, 0x2c, 0xc1, 0xba, 0x63, 0x9b, 0x76, 0xf3,
+	0xd8, 0x13, 0x72, 0x19, 0x4c, 0x60, 0x9b, 0x12, 0xdb, 0xc6, 0x9a, 0x69, 0x1b, 0x64, 0x97, 0x2b,
+	0x3b, 0xc1, 0xcf, 0xde, 0xa5, 0xb8, 0x1c, 0x47, 0x19, 0x01, 0xad, 0x31, 0x60, 0xe1, 0xd1, 0x28,
+	0x98, 0x16, 0xac, 0x37, 0xda, 0x96, 0x69, 0x6f, 0xad, 0x98, 0x16, 0x65, 0x79, 0x69, 0x09, 0x8c,
+	0x62, 0xcb, 0x72, 0x76, 0x3e, 0x27, 0x16, 0x06, 0x88, 0x4a, 0xc8, 0xb7, 0x08, 0x0a, 0xe8, 0xe0,
+	0x55, 0x30, 0x62, 0x10, 0xbb, 0x23, 0xc7, 0xcf, 0x4a, 0xcf, 0xc9, 0xe0, 0xbb, 0x20, 0xc9, 0xce,
+	0x83, 0x67, 0xbb, 0xc2, 0x5d, 0x17, 0x0e, 0xb9, 0x6b, 0xc5, 0x72, 0x30, 0xbd, 0x8b, 0x2d, 0x9f,
+	0xa8, 0xa0, 0xbb, 0x97, 0x1f, 0xbb, 0x69, 0xda, 0xeb, 0xb7, 0x10, 0x1a, 0x6b, 0x99, 0xf6, 0xba,
+	0xed, 0xc2, 0x2a, 0x48, 0x31, 0x0e, 0xec, 0x40, 0xcb, 0x23, 0x9f, 0xcf, 0x22, 0xcd, 0x13, 0xa0,
+	0x69, 0xb3, 0x64, 0x80, 0x98, 0x6c, 0xe4, 0x79, 0x66, 0xee, 0xd3, 0x38, 0x18, 0x61, 0x5a, 0xc1,
+	0xef, 0x80, 0x73, 0x06, 0xd9, 0xd6, 0xb0, 0x61, 0xb8, 0x5a, 0xdb, 0x25, 0x0d, 0x73, 0x97, 0xb0,
+	0x73, 0x92, 0x58, 0xc8, 0xa8, 0xd7, 0x58, 0xd0, 0xff, 0xc7, 0x5e, 0xfe, 0x6a, 0xd3, 0x29, 0xd1,
+	0x4d, 0x42, 0x37, 0x4d, 0xbb, 0xe9, 0x95, 0x6c, 0x42, 0x77, 0x1c, 0x77, 0x6b, 0x71, 0xf0, 0xfa,
+	0xdc, 0x7e, 0x73, 0xb1, 0xbd, 0xd5, 0x5c, 0x64, 0xe9, 0xcb, 0x2b, 0x2d, 0x93, 0xed, 0x8a, 0x61,
+	0xb8, 0x6b, 0x9c, 0x1d, 0x9a, 0x32, 0xa2, 0x53, 0xe2, 0xc1, 0x3f, 0x01, 0x49, 0x9b, 0x50, 0xcd,
+	0x34, 0x82, 0xe3, 0x93, 0x51, 0xaf, 0x0b, 0x41, 0x6f, 0x9f, 0x55, 0xd0, 0x2d, 0x42, 0x6b, 0xcb,
+	0xcc, 0x45, 0x7c, 0xe0, 0xa1, 0x31, 0x9b, 0xd0, 0x9a, 0xe1, 0xc1, 0x1f, 0x48, 0xe0, 0xdc, 0x07,
+	0x8e, 0x69, 0x6b, 0xc4, 0x37, 0xfb, 0x56, 0x25, 0xb8, 0xb0, 0xbb, 0x42, 0xd8, 0x3b, 0x67, 0x15,
+	0x76, 0x6d, 0xa3, 0xf6, 0xbb, 0x6f, 0x05, 0x46, 0x74, 0xf7, 0xf2, 0x53, 0x7f, 0xe8, 0x98, 0xf6,
+	0xb5, 0x8d, 0x5a, 0x68, 0x15, 0x9a, 0x62, 0x02, 0xaf, 0xf9, 0x66, 0xb8, 0x50, 0xf8, 0x38, 0x0b,
+	0x92, 0x22, 0x1a, 0xe0, 0x4a, 0x34, 0xff, 0x14, 0x8e, 0x89, 0x99, 0x53, 0x24, 0x9e, 0x2a, 0x00,
+	0xba, 0x4b, 0x30, 0x25, 0x86, 0x86, 0xe9, 0x29, 0x8e, 0x6b, 0x8a, 0x91, 0x07, 0x47, 0x56, 0xd0,
+	0x55, 0x28, 0x63, 0xe2, 0xb7, 0x8d, 0x90, 0x49, 0xe2, 0x2c, 0x4c, 0x04, 0x5d, 0x85, 0xc2, 0x0b,
+	0xa2, 0x22, 0x18, 0xb8, 0x01, 0xcb, 0xa2, 0xfc, 0xb9, 0x04, 0xd2, 0x06, 0xf1, 0x74, 0xd7, 0x6c,
+	0xf7, 0x2e, 0x97, 0x71, 0x35, 0x75, 0xa0, 0x8e, 0xba, 0x09, 0xf9, 0xd9, 0x14, 0x8a, 0x02, 0xe1,
+	0xf7, 0x01, 0xc0, 0x94, 0xba, 0x66, 0xdd, 0xa7, 0xc4, 0x93, 0xc7, 0x78, 0xde, 0xfc, 0xda, 0x31,
+	0x1e, 0x2a, 0x55, 0x7a, 0x98, 0xd7, 0x6c, 0xea, 0x76, 0xd4, 0xb7, 0x0f, 0xd4, 0xf2, 0x4f, 0xa5,
+	0xc5, 0x2c, 0x28, 0x9c, 0xaa, 0x16, 0xb8, 0xc4, 0x14, 0x78, 0x2a, 0xa1, 0x88, 0x44, 0xf8, 0x07,
+	0x20, 0x13, 0xad, 0x51, 0xe5, 0x24, 0xd7, 0xe0, 0xc2, 0xb0, 0x06, 0xd5, 0x00, 0xa7, 0x66, 0x37,
+	0x1c, 0x6e, 0xc9, 0x63, 0x29, 0x9e, 0x05, 0x28, 0xad, 0xf7, 0x97, 0xe1, 0x7d, 0x90, 0x16, 0x75,
+	0x01, 0x8f, 0xed, 0xd4, 0x8b, 0x5f, 0x36, 0x60, 0x3b, 0xc4, 0xf2, 0xe0, 0xbf, 0x4a, 0x60, 0x46,
+	0x3c, 0x48, 0x34, 0x8f, 0xb8, 0xdb, 0xc4, 0xe5, 0x87, 0x96, 0x78, 0x9e, 0x3c, 0xce, 0xfd, 0xfb,
+	0xe7, 0xd2, 0x81, 0xfa, 0x23, 0xc9, 0xfd, 0x33, 0xa9, 0xfc, 0x43, 0xe9, 0xc1, 0xc2, 0xd2, 0x15,
+	0xe6, 0x01, 0x5c, 0xfc, 0xb0, 0x52, 0x7c, 0x8f, 0x39, 0xe0, 0x61, 0x64, 0xdc, 0x1f, 0xde, 0x2f,
+	0xbe, 0x7f, 0x29, 0x02, 0xb8, 0x78, 0xbf, 0x74, 0xf1, 0x12, 0xa3, 0xab, 0x14, 0xdf, 0x13, 0x8e,
+	0x7b, 0x18, 0x19, 0xf7, 0x87, 0x9c, 0xae, 0x0f, 0xb8, 0xb8, 0xb0, 0x74, 0xe5, 0xca, 0x3d, 0x36,
+	0xfa, 0xee, 0xd7, 0x2f, 0xbf, 0xfd, 0xbd, 0x8b, 0x4b, 0xf3, 0x0f, 0x1f, 0xcc, 0xa3, 0x69, 0xa1,
+	0xee, 0x3a, 0xd7, 0xb6, 0x12, 0x28, 0x0b, 0xf3, 0x20, 0x8d, 0x7d, 0xea, 0x68, 0x41, 0x28, 0xc9,
+	0x80, 0x27, 0x79, 0xc0, 0x96, 0x36, 0xf8, 0x0a, 0x5c, 0x04, 0x93, 0x01, 0x4c, 0xd3, 0x37, 0xb1,
+	0x6d, 0x13, 0x4b, 0x4e, 0x47, 0xe3, 0xe7, 0x91, 0x84, 0x26, 0x02, 0x78, 0x35, 0x00, 0xc3, 0x15,
+	0x70, 0xae, 0x57, 0x12, 0x68, 0x6d, 0x0b, 0x33, 0xf7, 0xcb, 0x19, 0x4e, 0x93, 0x0b, 0xe2, 0xf2,
+	0x5d, 0x76, 0x60, 0x7b, 0x05, 0xc2, 0x9a, 0x85, 0xed, 0xda, 0x32, 0x9a, 0x6a, 0x0c, 0x2c, 0x18,
+	0x70, 0x0d, 0xc0, 0x43, 0x7c, 0x3c, 0x79, 0x9a, 0xdd, 0xf0, 0x6a, 0xe1, 0x40, 0x4d, 0x3f, 0x96,
+	0x52, 0xd9, 0x54, 0x21, 0xe4, 0x97, 0x1d, 0xe2, 0xe7, 0xa1, 0xec, 0x10, 0x43, 0x16, 0x5b, 0x29,
+	0x71, 0x2b, 0x79, 0xf2, 0x04, 0x8f, 0x2b, 0xe5, 0x98, 0x70, 0xa8, 0x04, 0x68, 0x6a, 0x46, 0x84,
+	0x16, 0x3f, 0x73, 0xa8, 0x47, 0xcd, 0x2a, 0x24, 0x8f, 0x62, 0xea, 0x7b, 0x5a, 0xdb, 0xaf, 0x5b,
+	0xa6, 0x2e, 0x4f, 0x72, 0xbf, 0x65, 0x82, 0xc5, 0x35, 0xbe, 0xc6, 0x2a, 0x24, 0xcb, 0x09, 0x8a,
+	0x81, 0x10, 0x6d, 0x8a, 0xa3, 0x4d, 0x86, 0xcb, 0x02, 0xf1, 0x2d, 0x30, 0xe3, 0xe9, 0x9b, 0xc4,
+	0xf0, 0x2d, 0xa2, 0x19, 0xce, 0x8e, 0xcd, 0xee, 0x2a, 0xcd, 0x62, 0xdb, 0x91, 0xe5, 0xf8, 0xd3,
+	0x21, 0x74, 0x59, 0x00, 0x57, 0xd9, 0xc6, 0x5c, 0x06, 0x90, 0xd8, 0x0d, 0xc7, 0xd5, 0x89, 0x66,
+	0xf8, 0xb4, 0xa3, 0xe9, 0x1d, 0xdd, 0x22, 0xf2, 0x39, 0x4e, 0x91, 0x15, 0x90, 0x65, 0x9f, 0x76,
+	0xaa, 0x6c, 0x1d, 0x7e, 0x00, 0xe4, 0x1e, 0xeb, 0x36, 0xa6, 0x9b, 0xac, 0xe0, 0xf4, 0xa8, 0x8b,
+	0x4d, 0x9b, 0xca, 0x70, 0x4e, 0x5a, 0x98, 0x2c, 0xff, 0xd6, 0xb0, 0x2f, 0x42, 0x69, 0x6b, 0x98,
+	0x6e, 0x56, 0x7b, 0xd8, 0x7c, 0xe3, 0x7f, 0xc0, 0xce, 0x05, 0x9a, 0x31, 0x8e, 0xc4, 0x80, 0x7f,
+	0x1c, 0xb1, 0x07, 0xdb, 0x1d, 0xf6, 0x6c, 0xd5, 0x0c, 0x62, 0xe1, 0x8e, 0xfc, 0x0a, 0x3f, 0x84,
+	0xb3, 0x87, 0xb2, 0xdb, 0xb2, 0xa8, 0x4f, 0x79, 0x72, 0x93, 0x7e, 0xc2, 0x92, 0x5b, 0xcf, 0xe8,
+	0x4a, 0xc0, 0x61, 0x99, 0x31, 0x80, 0x57, 0xc1, 0x05, 0x11, 0x8d, 0x3d, 0xd7, 0xb2, 0x52, 0x49,
+	0x0b, 0x1c, 0x2f, 0xbf, 0xca, 0xad, 0x97, 0x03, 0x94, 0x55, 0x81, 0xc1, 0x0a, 0xa3, 0x75, 0x0e,
+	0x87, 0xb7, 0xc0, 0xa4, 0x55, 0xf7, 0x34, 0xcb, 0xf6, 0x34, 0x51, 0x97, 0xcd, 0x9c, 0x54, 0x97,
+	0xa9, 0xd9, 0xee, 0x5e, 0x3e, 0xb3, 0xaa, 0xae, 0xaf, 0xde, 0x5a, 0x0f, 0x56, 0x50, 0xc6, 0xaa,
+	0x7b, 0xab, 0xb6, 0x17, 0xcc, 0xe0, 0x07, 0x60, 0x56, 0x67, 0xa5, 0x9f, 0x86, 0x07, 0x6a, 0x3f,
+	0x4d, 0x77, 0x0c, 0x22, 0x9f, 0xe7, 0xac, 0x4b, 0xc7, 0x84, 0xd8, 0x31, 0x25, 0x23, 0x3a, 0xaf,
+	0x1f, 0x0d, 0x80, 0x15, 0x30, 0x45, 0xb1, 0xdb, 0x24, 0x54, 0xd3, 0xfd, 0xb6, 0xa7, 0xf9, 0xae,
+	0x29, 0xcb, 0xfc, 0x54, 0xcd, 0xf2, 0x93, 0xf8, 0x91, 0x24, 0x75, 0xf7, 0xf2, 0x13, 0x77, 0x38,
+	0x4a, 0x75, 0x63, 0x6d, 0x7d, 0x03, 0xd5, 0xd0, 0x44, 0x40, 0x51, 0xf5, 0xdb, 0xde, 0x86, 0x6b,
+	0xc2, 0x6f, 0x0d, 0xb2, 0xd8, 0x22, 0x1d, 0x79, 0xf6, 0x44, 0xfb, 0xcf, 0x0d, 0xb2, 0xbc, 0x41,
+	0x3a, 0x51, 0x96, 0x37, 0x48, 0x07, 0x7e, 0x13, 0x8c, 0xd7, 0xc3, 0x62, 0x51, 0xce, 0x71, 0x66,
+	0x73, 0xc7, 0x58, 0xdc, 0x2b, 0x2a, 0x51, 0x9f, 0x04, 0xde, 0x60, 0xe9, 0x85, 0x47, 0x65, 0x23,
+	0xa8, 0xce, 0xe4, 0x0b, 0x9c, 0xc9, 0xfc, 0x69, 0x2a, 0x39, 0x96, 0x7a, 0x22, 0xd3, 0xdc, 0x55,
+	0x30, 0x35, 0x74, 0x25, 0xc1, 0x2c, 0x48, 0x30, 0x33, 0xf9, 0x9b, 0x17, 0xb1, 0x21, 0x7b, 0xf1,
+	0x6f, 0xb3, 0x52, 0x4c, 0xbc, 0xeb, 0x82, 0xc9, 0x95, 0xf8, 0xef, 0x49, 0x85, 0x25, 0x90, 0x12,
+	0x52, 0x3c, 0xf8, 0x26, 0x48, 0x89, 0x7c, 0x19, 0xd4, 0x5f, 0xe9, 0xf2, 0xf9, 0xe3, 0x5e, 0x0f,
+	0x3d, 0xc4, 0xc2, 0xdf, 0x49, 0xe0, 0xdc, 0x75, 0x42, 0x43, 0x00, 0x4b, 0x3f, 0x1e, 0x85, 0x1b,
+	0x20, 0x1d, 0xde, 0x14, 0x2f, 0x5a, 0x75, 0x80, 0x66, 0x88, 0xc5, 0x4a, 0x67, 0xd0, 0x6f, 0x28,
+	0x1d, 0x5b, 0x7c, 0xac, 0x30, 0x94, 0x9b, 0xd8, 0xdb, 0x52, 0x47, 0x78, 0x0e, 0x1b, 0x6f, 0x84,
+	0x0b, 0x85, 0x87, 0xa0, 0xd0, 0x57, 0x36, 0x22, 0x77, 0xc5, 0x71, 0xaf, 0x6d, 0xd4, 0x42, 0xed,
+	0xef, 0x82, 0x04, 0xf1, 0x4d, 0xae, 0x75, 0x46, 0x5d, 0xfe, 0xa2, 0xa5, 0x21, 0xaf, 0xd6, 0x58,
+	0xe3, 0x85, 0x71, 0x66, 0x0c, 0x0b, 0xbf, 0x88, 0x83, 0x57, 0x56, 0x4d, 0x2f, 0x94, 0xef, 0x85,
+	0xf2, 0xbe, 0xc5, 0x0a, 0x00, 0xcb, 0xc2, 0x75, 0xc7, 0xc5, 0xd4, 0x71, 0x85, 0xbb, 0x8a, 0xc3,
+	0xee, 0xba, 0xed, 0x36, 0xb1, 0x6d, 0x7e, 0xc8, 0x8f, 0xc7, 0x6d, 0x77, 0xc3, 0x23, 0x6e, 0xc4,
+	0x02, 0x34, 0xc0, 0xe2, 0x85, 0x3d, 0x05, 0x77, 0xc0, 0xa8, 0xe3, 0x1a, 0xc4, 0x15, 0x4d, 0x09,
+	0x7c, 0xa0, 0x3e, 0x70, 0xef, 0xa3, 0x58, 0x6f, 0x3b, 0x34, 0xd3, 0x40, 0xe9, 0x62, 0x74, 0x12,
+	0x8e, 0x89, 0x6f, 0xa2, 0x4c, 0x31, 0x3a, 0xe3, 0x25, 0x19, 0x1a, 0x2d, 0xf2, 0x4f, 0xa4, 0x7c,
+	0x44, 0xe9, 0x62, 0x64, 0x12, 0xc8, 0x83, 0x0a, 0x18, 0xb5, 0xcc, 0x96, 0x19, 0xb4, 0x11, 0x26,
+	0x78, 0xea, 0xbd, 0x94, 0x90, 0x3f, 0x4b, 0xa2, 0x60, 0x99, 0x35, 0x82, 0xda, 0xb8, 0x49, 0x78,
+	0x49, 0x37, 0x81, 0xf8, 0xb8, 0xf0, 0xcf, 0x12, 0x98, 0xae, 0x72, 0x4e, 0x43, 0x71, 0x58, 0x05,
+	0x49, 0xa1, 0x88, 0x70, 0xea, 0x71, 0x11, 0x7d, 0x44, 0xe0, 0x85, 0x94, 0x50, 0x1b, 0xda, 0x9e,
+	0xf8, 0x17, 0xd8, 0x1e, 0x35, 0x13, 0xe5, 0x3f, 0xb8, 0x59, 0x85, 0xbf, 0x90, 0xc0, 0x74, 0x50,
+	0x7a, 0xbc, 0x0c, 0xf5, 0x5f, 0xf8, 0xd0, 0xfc, 0xb5, 0x04, 0x66, 0x23, 0x61, 0x5b, 0x59, 0xab,
+	0xdd, 0x20, 0x1d, 0xef, 0x25, 0x1f, 0xf5, 0x5e, 0x18, 0xc4, 0x4f, 0x0e, 0x83, 0x44, 0x24, 0x0c,
+	0x1e, 0x4b, 0xe0, 0xfc, 0x75, 0x32, 0xa8, 0xe7, 0x4b, 0x56, 0x73, 0x0e, 0x8c, 0x6d, 0x91, 0x4e,
+	0xbf, 0x27, 0x38, 0xde, 0xdd, 0xcb, 0x8f, 0xde, 0x20, 0x9d, 0xda, 0x32, 0x1a, 0xdd, 0x22, 0x9d,
+	0x9a, 0xc1, 0x5a, 0x26, 0xb9, 0x81, 0xd8, 0xfc, 0x8d, 0xe8, 0x75, 0x21, 0xda, 0x12, 0x1e, 0x7e,
+	0x1c, 0x7d, 0x03, 0x8c, 0x05, 0x8d, 0x68, 0xfe, 0x20, 0x9d, 0x2c, 0xbf, 0x3a, 0x2c, 0x0e, 0x31,
+	0xa8, 0x3a, 0x71, 0xa0, 0x82, 0xc7, 0x52, 0xb2, 0x20, 0x4a, 0x1f, 0x41, 0x53, 0xf8, 0x27, 0x09,
+	0xe4, 0x06, 0xa2, 0xf5, 0x37, 0x62, 0x50, 0x05, 0x24, 0x71, 0xdb, 0xe4, 0xf7, 0x77, 0xfc, 0xe8,
+	0xfb, 0x3b, 0x50, 0xe3, 0x08, 0x36, 0x63, 0xb8, 0x6d, 0xde, 0x20, 0x9d, 0xc2, 0xdf, 0x4b, 0x20,
+	0x1f, 0x89, 0xe3, 0x6a, 0xe4, 0x08, 0xfe, 0x7f, 0x8c, 0xe6, 0xff, 0x94, 0xc0, 0xeb, 0xd7, 0xc9,
+	0x51, 0xda, 0xbe, 0x64, 0x65, 0xf5, 0x2f, 0x23, 0xdf, 0x1d, 0x16, 0x31, 0x98, 0xf3, 0xfe, 0x4d,
+	0x02, 0xaf, 0xaf, 0xff, 0x5f, 0x58, 0x77, 0xeb, 0x48, 0xeb, 0x5e, 0x3b, 0xfc, 0xda, 0xee, 0xe3,
+	0x9c, 0x98, 0xbc, 0xff, 0x21, 0x0e, 0x26, 0x07, 0x9f, 0x50, 0x6c, 0x37, 0x9b, 0xd8, 0xb4, 0xb9,
+	0xca, 0x71, 0xc4, 0xc7, 0x50, 0x05, 0xa9, 0xb0, 0x7c, 0x17, 0x22, 0xe5, 0x61, 0x91, 0x61, 0xf1,
+	0x3e, 0x24, 0xae, 0x47, 0x07, 0xff, 0x54, 0x1a, 0xe8, 0x54, 0x04, 0x1d, 0xde, 0xd2, 0xc9, 0xef,
+	0xb9, 0x97, 0xd0, 0xb0, 0x78, 0xd1, 0x9a, 0xf3, 0xc9, 0x28, 0x98, 0x10, 0x4a, 0x8a, 0x37, 0xca,
+	0xbb, 0x60, 0x84, 0xbd, 0x77, 0x64, 0xe9, 0x98, 0xcb, 0xa9, 0xdf, 0x09, 0x62, 0x7b, 0xfb, 0x8f,
+	0x52, 0x3c, 0x25, 0xf5, 0x3a, 0x42, 0x9c, 0x12, 0x56, 0xc0, 0x78, 0xdd, 0x71, 0xa8, 0xc6, 0xd9,
+	0x9c, 0xa5, 0x2b, 0x95, 0x62, 0x64, 0x0c, 0x00, 0xbf, 0x0f, 0x52, 0xa2, 0xd9, 0x11, 0xba, 0xf6,
+	0xb7, 0x8f, 0x71, 0x6d, 0xa0, 0x75, 0x49, 0x34, 0x50, 0x0e, 0xf9, 0xf5, 0xab, 0xee, 0x1b, 0xf2,
+	0x7c, 0x39, 0x3f, 0xe0, 0x57, 0xed, 0xb0, 0x63, 0x83, 0x1f, 0x36, 0x3d, 0x99, 0xf0, 0x36, 0x38,
+	0x17, 0xb6, 0x97, 0xc3, 0x1d, 0x0f, 0x7e, 0xd1, 0x9d, 0x14, 0x2a, 0x61, 0x23, 0x28, 0x85, 0xb2,
+	0x82, 0x38, 0x04, 0x79, 0x70, 0x1e, 0xc4, 0xcd, 0xb6, 0x3c, 0xca, 0xbb, 0x07, 0xd3, 0xa2, 0x7b,
+	0x00, 0x58, 0xf7, 0xa0, 0x2d, 0xf1, 0x1f, 0x6b, 0x6b, 0x28, 0x6e, 0xb6, 0xa1, 0x0f, 0x92, 0x2d,
+	0x42, 0x5d, 0x53, 0x0f, 0x5b, 0x5f, 0x97, 0x4e, 0xb6, 0xfa, 0x66, 0x80, 0x1c, 0x18, 0xbd, 0x78,
+	0xa0, 0x5e, 0xfe, 0xa9, 0x74, 0xf1, 0xd4, 0x46, 0xa3, 0x50, 0x16, 0x7b, 0x6c, 0x60, 0x63, 0x1b,
+	0xdb, 0x3a, 0x31, 0x64, 0x5d, 0xd4, 0x36, 0xc3, 0xfb, 0xb5, 0xce, 0x7f, 0x0d, 0xa3, 0x1e, 0x62,
+	0xee, 0x1d, 0x30, 0x31, 0xe0, 0xf4, 0xb3, 0x84, 0x5d, 0xee, 0x0a, 0xc8, 0x44, 0x75, 0xff, 0x3c,
+	0xda, 0x78, 0x34, 0x64, 0xff, 0x66, 0x1c, 0xcc, 0xf4, 0x52, 0x95, 0x6d, 0x13, 0x9d, 0x79, 0x98,
+	0x39, 0x84, 0x35, 0x44, 0x33, 0x7a, 0xb0, 0x14, 0x74, 0x33, 0xa5, 0x53, 0xfe, 0x82, 0x48, 0xf7,
+	0xa8, 0x2a, 0x14, 0xe6, 0x40, 0x8a, 0x23, 0xea, 0x8e, 0x15, 0xfe, 0x7b, 0x0b, 0xe7, 0xf0, 0xdb,
+	0xe0, 0xbc, 0x85, 0x3d, 0x2a, 0xde, 0xfb, 0x9a, 0x4b, 0x74, 0x62, 0x6e, 0x9f, 0xb6, 0x73, 0x1a,
+	0xc8, 0x9a, 0x66, 0x0c, 0x82, 0xfd, 0x43, 0x82, 0xbc, 0x42, 0xe1, 0x37, 0x41, 0x3a, 0xc2, 0x58,
+	0x74, 0xf2, 0x5f, 0x3f, 0x71, 0xf7, 0x11, 0xe8, 0x73, 0xea, 0x29, 0x26, 0x1e, 0xb3, 0x51, 0xc5,
+	0x46, 0xcf, 0xa2, 0x58, 0xf0, 0xbe, 0x8d, 0x28, 0xf6, 0x15, 0x90, 0x11, 0x3c, 0x75, 0xc7, 0xb7,
+	0xa9, 0x3c, 0xc6, 0x7f, 0xb2, 0xa5, 0x83, 0xb5, 0x2a, 0x5b, 0x82, 0xf7, 0xc0, 0x2c, 0x97, 0xdd,
+	0x6b, 0xf0, 0x44, 0xa5, 0x27, 0x4f, 0x29, 0x7d, 0x86, 0xb1, 0x08, 0x5b, 0x3e, 0x11, 0xf9, 0x5f,
+	0x05, 0x93, 0x3d, 0xbe, 0x81, 0x06, 0x29, 0xae, 0xc1, 0x44, 0xb8, 0x1a, 0xe8, 0xa0, 0x81, 0xac,
+	0xeb, 0xf8, 0xb6, 0xa1, 0x51, 0x97, 0xfd, 0x37, 0x65, 0xcc, 0x79, 0x23, 0x34, 0x5d, 0x7e, 0xfb,
+	0xb8, 0x06, 0xc8, 0x60, 0xec, 0x94, 0x10, 0x23, 0xbf, 0xe3, 0x9a, 0x6d, 0xae, 0x19, 0x9a, 0x74,
+	0x07, 0xe6, 0xf0, 0x06, 0x18, 0xf7, 0xfc, 0xba, 0x56, 0xc7, 0xb6, 0xe1, 0xc9, 0xe0, 0xc4, 0x6c,
+	0x3f, 0xcc, 0x79, 0xdd, 0xaf, 0xab, 0xd8, 0x36, 0x50, 0xca, 0x0b, 0x06, 0x1e, 0x2c, 0x83, 0x57,
+	0x07, 0xba, 0x0e, 0xc4, 0x10, 0xb6, 0xa5, 0xb9, 0x6d, 0xaf, 0x44, 0xdb, 0x0a, 0xc4, 0xe0, 0x16,
+	0xe6, 0xfe, 0x5b, 0x02, 0x93, 0x83, 0x3a, 0xc2, 0xab, 0x20, 0xd1, 0x12, 0x57, 0xdb, 0x89, 0x5d,
+	0x2d, 0x96, 0xa8, 0xff, 0x36, 0x4c, 0xd4, 0xbc, 0xbb, 0xc5, 0xe8, 0x38, 0x39, 0xde, 0x95, 0xe3,
+	0x5f, 0x84, 0x1c, 0xef, 0xc2, 0x2a, 0x18, 0x6b, 0x11, 0xc3, 0xc4, 0xb6, 0x9c, 0x38, 0x3b, 0x07,
+	0x41, 0xca, 0x8e, 0x79, 0x60, 0x39, 0x7f, 0x61, 0xa2, 0x60, 0x92, 0xfb, 0x17, 0x09, 0x24, 0x85,
+	0xd7, 0xbe, 0xc4, 0x5f, 0xc6, 0xdf, 0x00, 0xb9, 0x5e, 0x28, 0xf9, 0xd4, 0xb4, 0x44, 0xbd, 0xa4,
+	0x05, 0xd5, 0x60, 0x82, 0xe7, 0x99, 0x5e, 0x97, 0x72, 0xa3, 0x8f, 0xb0, 0xca, 0xe0, 0xf0, 0xeb,
+	0x60, 0xfa, 0x28, 0xea, 0xe0, 0x0f, 0x3b, 0x7a, 0xe5, 0x08, 0x3a, 0xf5, 0xaf, 0xa4, 0xa7, 0xcf,
+	0x15, 0xe9, 0xd9, 0x73, 0x45, 0xfa, 0xe5, 0x73, 0x25, 0xf6, 0xe9, 0x73, 0x25, 0xf6, 0xd9, 0x73,
+	0x25, 0xf6, 0xab, 0xe7, 0x4a, 0xec, 0xd7, 0xcf, 0x15, 0xe9, 0x51, 0x57, 0x91, 0x3e, 0xea, 0x2a,
+	0xb1, 0x9f, 0x75, 0x15, 0xe9, 0x49, 0x57, 0x89, 0x7d, 0xd2, 0x55, 0x62, 0x3f, 0xef, 0x2a, 0xb1,
+	0xa7, 0x5d, 0x45, 0x7a, 0xd6, 0x55, 0xa4, 0x5f, 0x76, 0x95, 0xd8, 0xa7, 0x5d, 0x45, 0xfa, 0xac,
+	0xab, 0xc4, 0x7e, 0xd5, 0x55, 0xa4, 0x5f, 0x77, 0x95, 0xd8, 0xa3, 0x7d, 0x25, 0xf6, 0xd1, 0xbe,
+	0x22, 0xfd, 0x78, 0x5f, 0x89, 0xfd, 0x64, 0x5f, 0x91, 0x3e, 0xde, 0x57, 0x62, 0x3f, 0xdb, 0x57,
+	0x62, 0x4f, 0xf6, 0x15, 0xe9, 0x93, 0x7d, 0x45, 0xfa, 0xf9, 0xbe, 0x22, 0xbd, 0xb7, 0x78, 0x86,
+	0xee, 0x08, 0xb5, 0xdb, 0xf5, 0xfa, 0x18, 0xdf, 0xb1, 0x37, 0xff, 0x77, 0x00, 0x43, 0x4f, 0xc1,
+	0xe7, 0xf5, 0x24, 0x00, 0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2345,6 +2577,111 @@ func (this *GatewayClaimAuthenticationCode) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GatewayBeaconing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayBeaconing)
+	if !ok {
+		that2, ok := that.(GatewayBeaconing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enable != that1.Enable {
+		return false
+	}
+	if this.AntennaIndex != that1.AntennaIndex {
+		return false
+	}
+	return true
+}
+func (this *GatewayUplinkFilters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUplinkFilters)
+	if !ok {
+		that2, ok := that.(GatewayUplinkFilters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Allow.Equal(that1.Allow) {
+		return false
+	}
+	if !this.Deny.Equal(that1.Deny) {
+		return false
+	}
+	if !this.MinSNR.Equal(that1.MinSNR) {
+		return false
+	}
+	if !this.MinRSSI.Equal(that1.MinRSSI) {
+		return false
+	}
+	return true
+}
+func (this *GatewayUplinkFilters_Rule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUplinkFilters_Rule)
+	if !ok {
+		that2, ok := that.(GatewayUplinkFilters_Rule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DevAddrPrefixes) != len(that1.DevAddrPrefixes) {
+		return false
+	}
+	for i := range this.DevAddrPrefixes {
+		if !this.DevAddrPrefixes[i].Equal(that1.DevAddrPrefixes[i]) {
+			return false
+		}
+	}
+	if len(this.NetIDs) != len(that1.NetIDs) {
+		return false
+	}
+	for i := range this.NetIDs {
+		if !this.NetIDs[i].Equal(that1.NetIDs[i]) {
+			return false
+		}
+	}
+	if len(this.JoinEUIPrefixes) != len(that1.JoinEUIPrefixes) {
+		return false
+	}
+	for i := range this.JoinEUIPrefixes {
+		if !this.JoinEUIPrefixes[i].Equal(that1.JoinEUIPrefixes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *Gateway) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2465,6 +2802,12 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if !this.TargetCUPSKey.Equal(that1.TargetCUPSKey) {
 		return false
 	}
+	if !this.Beaconing.Equal(that1.Beaconing) {
+		return false
+	}
+	if !this.UplinkFilters.Equal(that1.UplinkFilters) {
+		return false
+	}
 	return true
 }
 func (this *Gateways) Equal(that interface{}) bool {
@@ -3007,6 +3350,9 @@ func (this *GatewayConnectionStats) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.UplinkFilteredCount != that1.UplinkFilteredCount {
+		return false
+	}
 	return true
 }
 func (this *GatewayConnectionStats_RoundTripTimes) Equal(that interface{}) bool {
@@ -3440,7 +3786,7 @@ func (m *GatewayClaimAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *Gateway) Marshal() (dAtA []byte, err error) {
+func (m *GatewayBeaconing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3450,31 +3796,233 @@ func (m *Gateway) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+func (m *GatewayBeaconing) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GatewayBeaconing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TargetCUPSKey != nil {
-		{
-			size, err := m.TargetCUPSKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGateway(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.AntennaIndex != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.AntennaIndex))
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x10
 	}
-	if len(m.TargetCUPSURI) > 0 {
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayUplinkFilters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUplinkFilters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUplinkFilters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinRSSI != nil {
+		{
+			size, err := m.MinRSSI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinSNR != nil {
+		{
+			size, err := m.MinSNR.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Deny != nil {
+		{
+			size, err := m.Deny.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allow != nil {
+		{
+			size, err := m.Allow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayUplinkFilters_Rule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUplinkFilters_Rule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUplinkFilters_Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JoinEUIPrefixes) > 0 {
+		for iNdEx := len(m.JoinEUIPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.JoinEUIPrefixes[iNdEx].Size()
+				i -= size
+				if _, err := m.JoinEUIPrefixes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NetIDs) > 0 {
+		for iNdEx := len(m.NetIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NetIDs[iNdEx].Size()
+				i -= size
+				if _, err := m.NetIDs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DevAddrPrefixes) > 0 {
+		for iNdEx := len(m.DevAddrPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DevAddrPrefixes[iNdEx].Size()
+				i -= size
+				if _, err := m.DevAddrPrefixes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UplinkFilters != nil {
+		{
+			size, err := m.UplinkFilters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.Beaconing != nil {
+		{
+			size, err := m.Beaconing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.TargetCUPSKey != nil {
+		{
+			size, err := m.TargetCUPSKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.TargetCUPSURI) > 0 {
 		i -= len(m.TargetCUPSURI)
 		copy(dAtA[i:], m.TargetCUPSURI)
 		i = encodeVarintGateway(dAtA, i, uint64(len(m.TargetCUPSURI)))
@@ -3535,12 +4083,12 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.ScheduleAnytimeDelay != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleAnytimeDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleAnytimeDelay):])
-		if err9 != nil {
-			return 0, err9
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleAnytimeDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleAnytimeDelay):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n9
-		i = encodeVarintGateway(dAtA, i, uint64(n9))
+		i -= n15
+		i = encodeVarintGateway(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -3699,20 +4247,20 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err11 != nil {
-		return 0, err11
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n11
-	i = encodeVarintGateway(dAtA, i, uint64(n11))
+	i -= n17
+	i = encodeVarintGateway(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x1a
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err12 != nil {
-		return 0, err12
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n12
-	i = encodeVarintGateway(dAtA, i, uint64(n12))
+	i -= n18
+	i = encodeVarintGateway(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -4093,20 +4641,20 @@ func (m *CreateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA25 := make([]byte, len(m.Rights)*10)
-		var j24 int
+		dAtA31 := make([]byte, len(m.Rights)*10)
+		var j30 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j24++
+				j30++
 			}
-			dAtA25[j24] = uint8(num)
-			j24++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintGateway(dAtA, i, uint64(j24))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintGateway(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4454,20 +5002,20 @@ func (m *GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
-	if err36 != nil {
-		return 0, err36
+	n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
+	if err42 != nil {
+		return 0, err42
 	}
-	i -= n36
-	i = encodeVarintGateway(dAtA, i, uint64(n36))
+	i -= n42
+	i = encodeVarintGateway(dAtA, i, uint64(n42))
 	i--
 	dAtA[i] = 0x12
-	n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err37 != nil {
-		return 0, err37
+	n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err43 != nil {
+		return 0, err43
 	}
-	i -= n37
-	i = encodeVarintGateway(dAtA, i, uint64(n37))
+	i -= n43
+	i = encodeVarintGateway(dAtA, i, uint64(n43))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4493,6 +5041,11 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.UplinkFilteredCount != 0 {
+		i = encodeVarintGateway(dAtA, i, m.UplinkFilteredCount)
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.SubBands) > 0 {
 		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4525,12 +5078,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x40
 	}
 	if m.LastDownlinkReceivedAt != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
-		if err39 != nil {
-			return 0, err39
+		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n39
-		i = encodeVarintGateway(dAtA, i, uint64(n39))
+		i -= n45
+		i = encodeVarintGateway(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4540,12 +5093,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x30
 	}
 	if m.LastUplinkReceivedAt != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
-		if err40 != nil {
-			return 0, err40
+		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
+		if err46 != nil {
+			return 0, err46
 		}
-		i -= n40
-		i = encodeVarintGateway(dAtA, i, uint64(n40))
+		i -= n46
+		i = encodeVarintGateway(dAtA, i, uint64(n46))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4562,12 +5115,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x22
 	}
 	if m.LastStatusReceivedAt != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
-		if err42 != nil {
-			return 0, err42
+		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n42
-		i = encodeVarintGateway(dAtA, i, uint64(n42))
+		i -= n48
+		i = encodeVarintGateway(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4579,12 +5132,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x12
 	}
 	if m.ConnectedAt != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
-		if err43 != nil {
-			return 0, err43
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n43
-		i = encodeVarintGateway(dAtA, i, uint64(n43))
+		i -= n49
+		i = encodeVarintGateway(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4616,28 +5169,28 @@ func (m *GatewayConnectionStats_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte
 		i--
 		dAtA[i] = 0x20
 	}
-	n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
-	if err44 != nil {
-		return 0, err44
+	n50, err50 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err50 != nil {
+		return 0, err50
 	}
-	i -= n44
-	i = encodeVarintGateway(dAtA, i, uint64(n44))
+	i -= n50
+	i = encodeVarintGateway(dAtA, i, uint64(n50))
 	i--
 	dAtA[i] = 0x1a
-	n45, err45 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
-	if err45 != nil {
-		return 0, err45
+	n51, err51 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err51 != nil {
+		return 0, err51
 	}
-	i -= n45
-	i = encodeVarintGateway(dAtA, i, uint64(n45))
+	i -= n51
+	i = encodeVarintGateway(dAtA, i, uint64(n51))
 	i--
 	dAtA[i] = 0x12
-	n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
-	if err46 != nil {
-		return 0, err46
+	n52, err52 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err52 != nil {
+		return 0, err52
 	}
-	i -= n46
-	i = encodeVarintGateway(dAtA, i, uint64(n46))
+	i -= n52
+	i = encodeVarintGateway(dAtA, i, uint64(n52))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4778,42 +5331,95 @@ func NewPopulatedGatewayClaimAuthenticationCode(r randyGateway, easy bool) *Gate
 	return this
 }
 
+func NewPopulatedGatewayBeaconing(r randyGateway, easy bool) *GatewayBeaconing {
+	this := &GatewayBeaconing{}
+	this.Enable = bool(r.Intn(2) == 0)
+	this.AntennaIndex = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayUplinkFilters(r randyGateway, easy bool) *GatewayUplinkFilters {
+	this := &GatewayUplinkFilters{}
+	if r.Intn(5) != 0 {
+		this.Allow = NewPopulatedGatewayUplinkFilters_Rule(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Deny = NewPopulatedGatewayUplinkFilters_Rule(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.MinSNR = types.NewPopulatedFloatValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.MinRSSI = types.NewPopulatedFloatValue(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayUplinkFilters_Rule(r randyGateway, easy bool) *GatewayUplinkFilters_Rule {
+	this := &GatewayUplinkFilters_Rule{}
+	v2 := r.Intn(10)
+	this.DevAddrPrefixes = make([]go_thethings_network_lorawan_stack_v3_pkg_types.DevAddrPrefix, v2)
+	for i := 0; i < v2; i++ {
+		v3 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddrPrefix(r)
+		this.DevAddrPrefixes[i] = *v3
+	}
+	v4 := r.Intn(10)
+	this.NetIDs = make([]go_thethings_network_lorawan_stack_v3_pkg_types.NetID, v4)
+	for i := 0; i < v4; i++ {
+		v5 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
+		this.NetIDs[i] = *v5
+	}
+	v6 := r.Intn(10)
+	this.JoinEUIPrefixes = make([]go_thethings_network_lorawan_stack_v3_pkg_types.EUI64Prefix, v6)
+	for i := 0; i < v6; i++ {
+		v7 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64Prefix(r)
+		this.JoinEUIPrefixes[i] = *v7
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	this := &Gateway{}
-	v2 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v3
-	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v4
+	v8 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v8
+	v9 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v9
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v10
 	this.Name = randStringGateway(r)
 	this.Description = randStringGateway(r)
 	if r.Intn(5) != 0 {
-		v5 := r.Intn(10)
+		v11 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v5; i++ {
+		for i := 0; i < v11; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.ContactInfo = make([]*ContactInfo, v6)
-		for i := 0; i < v6; i++ {
+		v12 := r.Intn(5)
+		this.ContactInfo = make([]*ContactInfo, v12)
+		for i := 0; i < v12; i++ {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
-	v7 := NewPopulatedGatewayVersionIdentifiers(r, easy)
-	this.GatewayVersionIdentifiers = *v7
+	v13 := NewPopulatedGatewayVersionIdentifiers(r, easy)
+	this.GatewayVersionIdentifiers = *v13
 	this.GatewayServerAddress = randStringGateway(r)
 	this.AutoUpdate = bool(r.Intn(2) == 0)
 	this.UpdateChannel = randStringGateway(r)
 	this.FrequencyPlanID = randStringGateway(r)
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Antennas = make([]GatewayAntenna, v8)
-		for i := 0; i < v8; i++ {
-			v9 := NewPopulatedGatewayAntenna(r, easy)
-			this.Antennas[i] = *v9
+		v14 := r.Intn(5)
+		this.Antennas = make([]GatewayAntenna, v14)
+		for i := 0; i < v14; i++ {
+			v15 := NewPopulatedGatewayAntenna(r, easy)
+			this.Antennas[i] = *v15
 		}
 	}
 	this.StatusPublic = bool(r.Intn(2) == 0)
@@ -4824,9 +5430,9 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	if r.Intn(5) != 0 {
 		this.ScheduleAnytimeDelay = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	v10 := r.Intn(10)
-	this.FrequencyPlanIDs = make([]string, v10)
-	for i := 0; i < v10; i++ {
+	v16 := r.Intn(10)
+	this.FrequencyPlanIDs = make([]string, v16)
+	for i := 0; i < v16; i++ {
 		this.FrequencyPlanIDs[i] = randStringGateway(r)
 	}
 	this.UpdateLocationFromStatus = bool(r.Intn(2) == 0)
@@ -4840,6 +5446,12 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	if r.Intn(5) != 0 {
 		this.TargetCUPSKey = NewPopulatedSecret(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.Beaconing = NewPopulatedGatewayBeaconing(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.UplinkFilters = NewPopulatedGatewayUplinkFilters(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4848,9 +5460,9 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 	this := &Gateways{}
 	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Gateways = make([]*Gateway, v11)
-		for i := 0; i < v11; i++ {
+		v17 := r.Intn(5)
+		this.Gateways = make([]*Gateway, v17)
+		for i := 0; i < v17; i++ {
 			this.Gateways[i] = NewPopulatedGateway(r, easy)
 		}
 	}
@@ -4861,10 +5473,10 @@ func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 
 func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest {
 	this := &GetGatewayRequest{}
-	v12 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v18 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4872,8 +5484,8 @@ func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest
 
 func NewPopulatedGetGatewayIdentifiersForEUIRequest(r randyGateway, easy bool) *GetGatewayIdentifiersForEUIRequest {
 	this := &GetGatewayIdentifiersForEUIRequest{}
-	v14 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.EUI = *v14
+	v20 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.EUI = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4884,8 +5496,8 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 	if r.Intn(5) != 0 {
 		this.Collaborator = NewPopulatedOrganizationOrUserIdentifiers(r, easy)
 	}
-	v15 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v15
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	this.Order = randStringGateway(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -4896,10 +5508,10 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 
 func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayRequest {
 	this := &CreateGatewayRequest{}
-	v16 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v16
-	v17 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.Collaborator = *v17
+	v22 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v22
+	v23 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.Collaborator = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4907,10 +5519,10 @@ func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayR
 
 func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayRequest {
 	this := &UpdateGatewayRequest{}
-	v18 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v18
-	v19 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v19
+	v24 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v24
+	v25 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4918,8 +5530,8 @@ func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayR
 
 func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatewayAPIKeysRequest {
 	this := &ListGatewayAPIKeysRequest{}
-	v20 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v20
+	v26 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v26
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4929,8 +5541,8 @@ func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatew
 
 func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayAPIKeyRequest {
 	this := &GetGatewayAPIKeyRequest{}
-	v21 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v21
+	v27 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v27
 	this.KeyID = randStringGateway(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4939,13 +5551,13 @@ func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayA
 
 func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGatewayAPIKeyRequest {
 	this := &CreateGatewayAPIKeyRequest{}
-	v22 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v22
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
 	this.Name = randStringGateway(r)
-	v23 := r.Intn(10)
-	this.Rights = make([]Right, v23)
-	for i := 0; i < v23; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+	v29 := r.Intn(10)
+	this.Rights = make([]Right, v29)
+	for i := 0; i < v29; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4954,10 +5566,10 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 
 func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGatewayAPIKeyRequest {
 	this := &UpdateGatewayAPIKeyRequest{}
-	v24 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v24
-	v25 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v25
+	v30 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v30
+	v31 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v31
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4965,8 +5577,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v26 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v26
+	v32 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v32
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4976,10 +5588,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v27 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v27
-	v28 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v28
+	v33 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v33
+	v34 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v34
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4987,10 +5599,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v29 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v29
-	v30 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v30
+	v35 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v35
+	v36 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v36
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5002,12 +5614,12 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 	if r.Intn(2) == 0 {
 		this.Gain *= -1
 	}
-	v31 := NewPopulatedLocation(r, easy)
-	this.Location = *v31
+	v37 := NewPopulatedLocation(r, easy)
+	this.Location = *v37
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(10)
+		v38 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v32; i++ {
+		for i := 0; i < v38; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -5018,37 +5630,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v33
-	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v34
+	v39 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v39
+	v40 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v40
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(10)
+		v41 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v35; i++ {
+		for i := 0; i < v41; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v36)
-		for i := 0; i < v36; i++ {
+		v42 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v42)
+		for i := 0; i < v42; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v37 := r.Intn(10)
-	this.IP = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v43 := r.Intn(10)
+	this.IP = make([]string, v43)
+	for i := 0; i < v43; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v44 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v38; i++ {
-			v39 := randStringGateway(r)
-			this.Metrics[v39] = float32(r.Float32())
+		for i := 0; i < v44; i++ {
+			v45 := randStringGateway(r)
+			this.Metrics[v45] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v39] *= -1
+				this.Metrics[v45] *= -1
 			}
 		}
 	}
@@ -5084,12 +5696,13 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 		this.RoundTripTimes = NewPopulatedGatewayConnectionStats_RoundTripTimes(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v40 := r.Intn(5)
-		this.SubBands = make([]*GatewayConnectionStats_SubBand, v40)
-		for i := 0; i < v40; i++ {
+		v46 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v46)
+		for i := 0; i < v46; i++ {
 			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
 		}
 	}
+	this.UplinkFilteredCount = uint64(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5097,12 +5710,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v41
-	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v42
-	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v43
+	v47 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Min = *v47
+	v48 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v48
+	v49 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v49
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -5145,9 +5758,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v44 := r.Intn(100)
-	tmps := make([]rune, v44)
-	for i := 0; i < v44; i++ {
+	v50 := r.Intn(100)
+	tmps := make([]rune, v50)
+	for i := 0; i < v50; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -5169,11 +5782,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v45 := r.Int63()
+		v51 := r.Int63()
 		if r.Intn(2) == 0 {
-			v45 *= -1
+			v51 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v45))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v51))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -5362,28 +5975,95 @@ func (m *GatewayClaimAuthenticationCode) Size() (n int) {
 	return n
 }
 
-func (m *Gateway) Size() (n int) {
+func (m *GatewayBeaconing) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.GatewayIdentifiers.Size()
-	n += 1 + l + sovGateway(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovGateway(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovGateway(uint64(l))
-	l = len(m.Name)
-	if l > 0 {
+	if m.Enable {
+		n += 2
+	}
+	if m.AntennaIndex != 0 {
+		n += 1 + sovGateway(uint64(m.AntennaIndex))
+	}
+	return n
+}
+
+func (m *GatewayUplinkFilters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allow != nil {
+		l = m.Allow.Size()
 		n += 1 + l + sovGateway(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
+	if m.Deny != nil {
+		l = m.Deny.Size()
 		n += 1 + l + sovGateway(uint64(l))
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
+	if m.MinSNR != nil {
+		l = m.MinSNR.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.MinRSSI != nil {
+		l = m.MinRSSI.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayUplinkFilters_Rule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DevAddrPrefixes) > 0 {
+		for _, e := range m.DevAddrPrefixes {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if len(m.NetIDs) > 0 {
+		for _, e := range m.NetIDs {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if len(m.JoinEUIPrefixes) > 0 {
+		for _, e := range m.JoinEUIPrefixes {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Gateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovGateway(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovGateway(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovGateway(uint64(len(k))) + 1 + len(v) + sovGateway(uint64(len(v)))
@@ -5463,6 +6143,14 @@ func (m *Gateway) Size() (n int) {
 		l = m.TargetCUPSKey.Size()
 		n += 2 + l + sovGateway(uint64(l))
 	}
+	if m.Beaconing != nil {
+		l = m.Beaconing.Size()
+		n += 2 + l + sovGateway(uint64(l))
+	}
+	if m.UplinkFilters != nil {
+		l = m.UplinkFilters.Size()
+		n += 2 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -5779,6 +6467,9 @@ func (m *GatewayConnectionStats) Size() (n int) {
 			n += 1 + l + sovGateway(uint64(l))
 		}
 	}
+	if m.UplinkFilteredCount != 0 {
+		n += 1 + sovGateway(m.UplinkFilteredCount)
+	}
 	return n
 }
 
@@ -5921,6 +6612,42 @@ func (this *GatewayClaimAuthenticationCode) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayBeaconing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayBeaconing{`,
+		`Enable:` + fmt.Sprintf("%v", this.Enable) + `,`,
+		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayUplinkFilters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayUplinkFilters{`,
+		`Allow:` + strings.Replace(fmt.Sprintf("%v", this.Allow), "GatewayUplinkFilters_Rule", "GatewayUplinkFilters_Rule", 1) + `,`,
+		`Deny:` + strings.Replace(fmt.Sprintf("%v", this.Deny), "GatewayUplinkFilters_Rule", "GatewayUplinkFilters_Rule", 1) + `,`,
+		`MinSNR:` + strings.Replace(fmt.Sprintf("%v", this.MinSNR), "FloatValue", "types.FloatValue", 1) + `,`,
+		`MinRSSI:` + strings.Replace(fmt.Sprintf("%v", this.MinRSSI), "FloatValue", "types.FloatValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayUplinkFilters_Rule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayUplinkFilters_Rule{`,
+		`DevAddrPrefixes:` + fmt.Sprintf("%v", this.DevAddrPrefixes) + `,`,
+		`NetIDs:` + fmt.Sprintf("%v", this.NetIDs) + `,`,
+		`JoinEUIPrefixes:` + fmt.Sprintf("%v", this.JoinEUIPrefixes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Gateway) String() string {
 	if this == nil {
 		return "nil"
@@ -5971,6 +6698,8 @@ func (this *Gateway) String() string {
 		`ClaimAuthenticationCode:` + strings.Replace(this.ClaimAuthenticationCode.String(), "GatewayClaimAuthenticationCode", "GatewayClaimAuthenticationCode", 1) + `,`,
 		`TargetCUPSURI:` + fmt.Sprintf("%v", this.TargetCUPSURI) + `,`,
 		`TargetCUPSKey:` + strings.Replace(fmt.Sprintf("%v", this.TargetCUPSKey), "Secret", "Secret", 1) + `,`,
+		`Beaconing:` + strings.Replace(this.Beaconing.String(), "GatewayBeaconing", "GatewayBeaconing", 1) + `,`,
+		`UplinkFilters:` + strings.Replace(this.UplinkFilters.String(), "GatewayUplinkFilters", "GatewayUplinkFilters", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6210,6 +6939,7 @@ func (this *GatewayConnectionStats) String() string {
 		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
 		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayConnectionStats_RoundTripTimes", "GatewayConnectionStats_RoundTripTimes", 1) + `,`,
 		`SubBands:` + repeatedStringForSubBands + `,`,
+		`UplinkFilteredCount:` + fmt.Sprintf("%v", this.UplinkFilteredCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6822,29 +7552,465 @@ func (m *GatewayRadio) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChipType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSIOffset", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.RSSIOffset = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxConfiguration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxConfiguration == nil {
+				m.TxConfiguration = &GatewayRadio_TxConfiguration{}
+			}
+			if err := m.TxConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayRadio_TxConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+			}
+			m.MinFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+			}
+			m.MaxFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotchFrequency", wireType)
+			}
+			m.NotchFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotchFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayVersionIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Radios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Radios = append(m.Radios, &GatewayRadio{})
+			if err := m.Radios[len(m.Radios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSource", wireType)
+			}
+			m.ClockSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSource |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChipType = string(dAtA[iNdEx:postIndex])
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
 			}
-			m.Frequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -6854,25 +8020,31 @@ func (m *GatewayRadio) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RSSIOffset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
-			iNdEx += 4
-			m.RSSIOffset = float32(math.Float32frombits(v))
-		case 5:
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxConfiguration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6899,10 +8071,10 @@ func (m *GatewayRadio) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxConfiguration == nil {
-				m.TxConfiguration = &GatewayRadio_TxConfiguration{}
+			if m.ValidTo == nil {
+				m.ValidTo = new(time.Time)
 			}
-			if err := m.TxConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6930,7 +8102,7 @@ func (m *GatewayRadio) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayRadio_TxConfiguration) Unmarshal(dAtA []byte) error {
+func (m *GatewayBeaconing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6953,17 +8125,17 @@ func (m *GatewayRadio_TxConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayBeaconing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayBeaconing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
-			m.MinFrequency = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -6973,35 +8145,17 @@ func (m *GatewayRadio_TxConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinFrequency |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enable = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
-			}
-			m.MaxFrequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGateway
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxFrequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotchFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
 			}
-			m.NotchFrequency = 0
+			m.AntennaIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7011,7 +8165,7 @@ func (m *GatewayRadio_TxConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NotchFrequency |= uint64(b&0x7F) << shift
+				m.AntennaIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7040,7 +8194,7 @@ func (m *GatewayRadio_TxConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
+func (m *GatewayUplinkFilters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7063,15 +8217,15 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayUplinkFilters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayUplinkFilters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayVersionIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7098,15 +8252,18 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GatewayVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Allow == nil {
+				m.Allow = &GatewayUplinkFilters_Rule{}
+			}
+			if err := m.Allow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7116,27 +8273,31 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
+			if m.Deny == nil {
+				m.Deny = &GatewayUplinkFilters_Rule{}
+			}
+			if err := m.Deny.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Radios", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSNR", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7163,16 +8324,18 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Radios = append(m.Radios, &GatewayRadio{})
-			if err := m.Radios[len(m.Radios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MinSNR == nil {
+				m.MinSNR = &types.FloatValue{}
+			}
+			if err := m.MinSNR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClockSource", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRSSI", wireType)
 			}
-			m.ClockSource = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7182,11 +8345,28 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClockSource |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinRSSI == nil {
+				m.MinRSSI = &types.FloatValue{}
+			}
+			if err := m.MinRSSI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -7211,7 +8391,7 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
+func (m *GatewayUplinkFilters_Rule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7234,17 +8414,17 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddrPrefixes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7254,33 +8434,32 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
-			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.DevAddrPrefix
+			m.DevAddrPrefixes = append(m.DevAddrPrefixes, v)
+			if err := m.DevAddrPrefixes[len(m.DevAddrPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetIDs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7290,33 +8469,32 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidFrom == nil {
-				m.ValidFrom = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.NetID
+			m.NetIDs = append(m.NetIDs, v)
+			if err := m.NetIDs[len(m.NetIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUIPrefixes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7326,25 +8504,24 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidTo == nil {
-				m.ValidTo = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.EUI64Prefix
+			m.JoinEUIPrefixes = append(m.JoinEUIPrefixes, v)
+			if err := m.JoinEUIPrefixes[len(m.JoinEUIPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8235,6 +9412,78 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beaconing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Beaconing == nil {
+				m.Beaconing = &GatewayBeaconing{}
+			}
+			if err := m.Beaconing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkFilters == nil {
+				m.UplinkFilters = &GatewayUplinkFilters{}
+			}
+			if err := m.UplinkFilters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -10918,6 +12167,25 @@ func (m *GatewayConnectionStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFilteredCount", wireType)
+			}
+			m.UplinkFilteredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkFilteredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
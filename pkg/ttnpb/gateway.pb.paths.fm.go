@@ -87,10 +87,41 @@ var GatewayClaimAuthenticationCodeFieldPathsTopLevel = []string{
 	"valid_from",
 	"valid_to",
 }
+var GatewayBeaconingFieldPathsNested = []string{
+	"antenna_index",
+	"enable",
+}
+
+var GatewayBeaconingFieldPathsTopLevel = []string{
+	"antenna_index",
+	"enable",
+}
+var GatewayUplinkFiltersFieldPathsNested = []string{
+	"allow",
+	"allow.dev_addr_prefixes",
+	"allow.join_eui_prefixes",
+	"allow.net_ids",
+	"deny",
+	"deny.dev_addr_prefixes",
+	"deny.join_eui_prefixes",
+	"deny.net_ids",
+	"min_rssi",
+	"min_snr",
+}
+
+var GatewayUplinkFiltersFieldPathsTopLevel = []string{
+	"allow",
+	"deny",
+	"min_rssi",
+	"min_snr",
+}
 var GatewayFieldPathsNested = []string{
 	"antennas",
 	"attributes",
 	"auto_update",
+	"beaconing",
+	"beaconing.antenna_index",
+	"beaconing.enable",
 	"claim_authentication_code",
 	"claim_authentication_code.secret",
 	"claim_authentication_code.secret.key_id",
@@ -123,6 +154,17 @@ var GatewayFieldPathsNested = []string{
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
+	"uplink_filters",
+	"uplink_filters.allow",
+	"uplink_filters.allow.dev_addr_prefixes",
+	"uplink_filters.allow.join_eui_prefixes",
+	"uplink_filters.allow.net_ids",
+	"uplink_filters.deny",
+	"uplink_filters.deny.dev_addr_prefixes",
+	"uplink_filters.deny.join_eui_prefixes",
+	"uplink_filters.deny.net_ids",
+	"uplink_filters.min_rssi",
+	"uplink_filters.min_snr",
 	"version_ids",
 	"version_ids.brand_id",
 	"version_ids.firmware_version",
@@ -134,6 +176,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"antennas",
 	"attributes",
 	"auto_update",
+	"beaconing",
 	"claim_authentication_code",
 	"contact_info",
 	"created_at",
@@ -155,6 +198,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
+	"uplink_filters",
 	"version_ids",
 }
 var GatewaysFieldPathsNested = []string{
@@ -215,6 +259,9 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.antennas",
 	"gateway.attributes",
 	"gateway.auto_update",
+	"gateway.beaconing",
+	"gateway.beaconing.antenna_index",
+	"gateway.beaconing.enable",
 	"gateway.claim_authentication_code",
 	"gateway.claim_authentication_code.secret",
 	"gateway.claim_authentication_code.secret.key_id",
@@ -247,6 +294,17 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
+	"gateway.uplink_filters.allow",
+	"gateway.uplink_filters.allow.dev_addr_prefixes",
+	"gateway.uplink_filters.allow.join_eui_prefixes",
+	"gateway.uplink_filters.allow.net_ids",
+	"gateway.uplink_filters.deny",
+	"gateway.uplink_filters.deny.dev_addr_prefixes",
+	"gateway.uplink_filters.deny.join_eui_prefixes",
+	"gateway.uplink_filters.deny.net_ids",
+	"gateway.uplink_filters.min_rssi",
+	"gateway.uplink_filters.min_snr",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -264,6 +322,9 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.antennas",
 	"gateway.attributes",
 	"gateway.auto_update",
+	"gateway.beaconing",
+	"gateway.beaconing.antenna_index",
+	"gateway.beaconing.enable",
 	"gateway.claim_authentication_code",
 	"gateway.claim_authentication_code.secret",
 	"gateway.claim_authentication_code.secret.key_id",
@@ -296,6 +357,17 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
+	"gateway.uplink_filters.allow",
+	"gateway.uplink_filters.allow.dev_addr_prefixes",
+	"gateway.uplink_filters.allow.join_eui_prefixes",
+	"gateway.uplink_filters.allow.net_ids",
+	"gateway.uplink_filters.deny",
+	"gateway.uplink_filters.deny.dev_addr_prefixes",
+	"gateway.uplink_filters.deny.join_eui_prefixes",
+	"gateway.uplink_filters.deny.net_ids",
+	"gateway.uplink_filters.min_rssi",
+	"gateway.uplink_filters.min_snr",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -465,6 +537,7 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 	"round_trip_times.min",
 	"sub_bands",
 	"uplink_count",
+	"uplink_filtered_count",
 }
 
 var GatewayConnectionStatsFieldPathsTopLevel = []string{
@@ -478,6 +551,7 @@ var GatewayConnectionStatsFieldPathsTopLevel = []string{
 	"round_trip_times",
 	"sub_bands",
 	"uplink_count",
+	"uplink_filtered_count",
 }
 var GatewayRadio_TxConfigurationFieldPathsNested = []string{
 	"max_frequency",
@@ -490,6 +564,17 @@ var GatewayRadio_TxConfigurationFieldPathsTopLevel = []string{
 	"min_frequency",
 	"notch_frequency",
 }
+var GatewayUplinkFilters_RuleFieldPathsNested = []string{
+	"dev_addr_prefixes",
+	"join_eui_prefixes",
+	"net_ids",
+}
+
+var GatewayUplinkFilters_RuleFieldPathsTopLevel = []string{
+	"dev_addr_prefixes",
+	"join_eui_prefixes",
+	"net_ids",
+}
 var GatewayConnectionStats_RoundTripTimesFieldPathsNested = []string{
 	"count",
 	"max",
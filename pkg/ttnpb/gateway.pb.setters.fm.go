@@ -339,6 +339,116 @@ func (dst *GatewayClaimAuthenticationCode) SetFields(src *GatewayClaimAuthentica
 	return nil
 }
 
+func (dst *GatewayBeaconing) SetFields(src *GatewayBeaconing, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "enable":
+			if len(subs) > 0 {
+				return fmt.Errorf("'enable' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Enable = src.Enable
+			} else {
+				var zero bool
+				dst.Enable = zero
+			}
+		case "antenna_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AntennaIndex = src.AntennaIndex
+			} else {
+				var zero uint32
+				dst.AntennaIndex = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayUplinkFilters) SetFields(src *GatewayUplinkFilters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "allow":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayUplinkFilters_Rule
+				if (src == nil || src.Allow == nil) && dst.Allow == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Allow
+				}
+				if dst.Allow != nil {
+					newDst = dst.Allow
+				} else {
+					newDst = &GatewayUplinkFilters_Rule{}
+					dst.Allow = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Allow = src.Allow
+				} else {
+					dst.Allow = nil
+				}
+			}
+		case "deny":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayUplinkFilters_Rule
+				if (src == nil || src.Deny == nil) && dst.Deny == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Deny
+				}
+				if dst.Deny != nil {
+					newDst = dst.Deny
+				} else {
+					newDst = &GatewayUplinkFilters_Rule{}
+					dst.Deny = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Deny = src.Deny
+				} else {
+					dst.Deny = nil
+				}
+			}
+		case "min_snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinSNR = src.MinSNR
+			} else {
+				dst.MinSNR = nil
+			}
+		case "min_rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinRSSI = src.MinRSSI
+			} else {
+				dst.MinRSSI = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -648,6 +758,56 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 					dst.TargetCUPSKey = nil
 				}
 			}
+		case "beaconing":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayBeaconing
+				if (src == nil || src.Beaconing == nil) && dst.Beaconing == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Beaconing
+				}
+				if dst.Beaconing != nil {
+					newDst = dst.Beaconing
+				} else {
+					newDst = &GatewayBeaconing{}
+					dst.Beaconing = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Beaconing = src.Beaconing
+				} else {
+					dst.Beaconing = nil
+				}
+			}
+		case "uplink_filters":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayUplinkFilters
+				if (src == nil || src.UplinkFilters == nil) && dst.UplinkFilters == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UplinkFilters
+				}
+				if dst.UplinkFilters != nil {
+					newDst = dst.UplinkFilters
+				} else {
+					newDst = &GatewayUplinkFilters{}
+					dst.UplinkFilters = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UplinkFilters = src.UplinkFilters
+				} else {
+					dst.UplinkFilters = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1476,6 +1636,16 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 			} else {
 				dst.SubBands = nil
 			}
+		case "uplink_filtered_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_filtered_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkFilteredCount = src.UplinkFilteredCount
+			} else {
+				var zero uint64
+				dst.UplinkFilteredCount = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1525,6 +1695,44 @@ func (dst *GatewayRadio_TxConfiguration) SetFields(src *GatewayRadio_TxConfigura
 	return nil
 }
 
+func (dst *GatewayUplinkFilters_Rule) SetFields(src *GatewayUplinkFilters_Rule, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "dev_addr_prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr_prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddrPrefixes = src.DevAddrPrefixes
+			} else {
+				dst.DevAddrPrefixes = nil
+			}
+		case "net_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'net_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetIDs = src.NetIDs
+			} else {
+				dst.NetIDs = nil
+			}
+		case "join_eui_prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui_prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUIPrefixes = src.JoinEUIPrefixes
+			} else {
+				dst.JoinEUIPrefixes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *GatewayConnectionStats_RoundTripTimes) SetFields(src *GatewayConnectionStats_RoundTripTimes, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -704,6 +704,227 @@ var _ interface {
 	ErrorName() string
 } = GatewayClaimAuthenticationCodeValidationError{}
 
+// ValidateFields checks the field values on GatewayBeaconing with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GatewayBeaconing) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayBeaconingFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "enable":
+			// no validation rules for Enable
+		case "antenna_index":
+
+			if m.GetAntennaIndex() > 2 {
+				return GatewayBeaconingValidationError{
+					field:  "antenna_index",
+					reason: "value must be less than or equal to 2",
+				}
+			}
+
+		default:
+			return GatewayBeaconingValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayBeaconingValidationError is the validation error returned by
+// GatewayBeaconing.ValidateFields if the designated constraints aren't met.
+type GatewayBeaconingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayBeaconingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayBeaconingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayBeaconingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayBeaconingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayBeaconingValidationError) ErrorName() string {
+	return "GatewayBeaconingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayBeaconingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayBeaconing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayBeaconingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayBeaconingValidationError{}
+
+// ValidateFields checks the field values on GatewayUplinkFilters with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GatewayUplinkFilters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFiltersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "allow":
+
+			if v, ok := interface{}(m.GetAllow()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayUplinkFiltersValidationError{
+						field:  "allow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "deny":
+
+			if v, ok := interface{}(m.GetDeny()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayUplinkFiltersValidationError{
+						field:  "deny",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "min_snr":
+
+			if v, ok := interface{}(m.GetMinSNR()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayUplinkFiltersValidationError{
+						field:  "min_snr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "min_rssi":
+
+			if v, ok := interface{}(m.GetMinRSSI()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayUplinkFiltersValidationError{
+						field:  "min_rssi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayUplinkFiltersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFiltersValidationError is the validation error returned by
+// GatewayUplinkFilters.ValidateFields if the designated constraints aren't met.
+type GatewayUplinkFiltersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFiltersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFiltersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFiltersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFiltersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFiltersValidationError) ErrorName() string {
+	return "GatewayUplinkFiltersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFiltersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFiltersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFiltersValidationError{}
+
 // ValidateFields checks the field values on Gateway with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -1000,6 +1221,30 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "beaconing":
+
+			if v, ok := interface{}(m.GetBeaconing()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "beaconing",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_filters":
+
+			if v, ok := interface{}(m.GetUplinkFilters()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "uplink_filters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return GatewayValidationError{
 				field:  name,
@@ -2898,6 +3143,8 @@ func (m *GatewayConnectionStats) ValidateFields(paths ...string) error {
 
 			}
 
+		case "uplink_filtered_count":
+			// no validation rules for UplinkFilteredCount
 		default:
 			return GatewayConnectionStatsValidationError{
 				field:  name,
@@ -3052,6 +3299,94 @@ var _ interface {
 	ErrorName() string
 } = GatewayRadio_TxConfigurationValidationError{}
 
+// ValidateFields checks the field values on GatewayUplinkFilters_Rule with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayUplinkFilters_Rule) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFilters_RuleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "dev_addr_prefixes":
+			// no validation rules for DevAddrPrefixes
+		case "net_ids":
+			// no validation rules for NetIDs
+		case "join_eui_prefixes":
+			// no validation rules for JoinEUIPrefixes
+		default:
+			return GatewayUplinkFilters_RuleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFilters_RuleValidationError is the validation error returned by
+// GatewayUplinkFilters_Rule.ValidateFields if the designated constraints aren't
+// met.
+type GatewayUplinkFilters_RuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFilters_RuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFilters_RuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFilters_RuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFilters_RuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFilters_RuleValidationError) ErrorName() string {
+	return "GatewayUplinkFilters_RuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFilters_RuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilters_Rule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFilters_RuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFilters_RuleValidationError{}
+
 // ValidateFields checks the field values on
 // GatewayConnectionStats_RoundTripTimes with the rules defined in the proto
 // definition for this message. If any rules are violated, an error is returned.
@@ -4,12 +4,15 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -38,6 +41,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type CaptureFormat int32
+
+const (
+	// pcapng with the LoRa PHY frames on an interface with the LoRaTap link type, and the raw frontend frames on an
+	// interface with the USER0 link type.
+	CAPTURE_FORMAT_PCAPNG CaptureFormat = 0
+	// PCAP with the LoRa PHY frames with the LoRaTap link type.
+	CAPTURE_FORMAT_PCAP CaptureFormat = 1
+	// Newline-delimited JSON with the raw frontend frames and the decoded messages.
+	CAPTURE_FORMAT_NDJSON CaptureFormat = 2
+)
+
+var CaptureFormat_name = map[int32]string{
+	0: "CAPTURE_FORMAT_PCAPNG",
+	1: "CAPTURE_FORMAT_PCAP",
+	2: "CAPTURE_FORMAT_NDJSON",
+}
+
+var CaptureFormat_value = map[string]int32{
+	"CAPTURE_FORMAT_PCAPNG": 0,
+	"CAPTURE_FORMAT_PCAP":   1,
+	"CAPTURE_FORMAT_NDJSON": 2,
+}
+
+func (CaptureFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{0}
+}
+
 // GatewayUp may contain zero or more uplink messages and/or a status message for the gateway.
 type GatewayUp struct {
 	// Uplink messages received by the gateway.
@@ -242,1007 +273,7434 @@ func (m *ScheduleDownlinkErrorDetails) GetPathErrors() []*ErrorDetails {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
-	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+type ExecuteGatewayCommandRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// The command to execute on the gateway.
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// The arguments of the command.
+	Arguments            []string `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+func (m *ExecuteGatewayCommandRequest) Reset()      { *m = ExecuteGatewayCommandRequest{} }
+func (*ExecuteGatewayCommandRequest) ProtoMessage() {}
+func (*ExecuteGatewayCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+func (m *ExecuteGatewayCommandRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x68, 0x24, 0x45,
-	0x14, 0xad, 0x4a, 0x8c, 0x68, 0x05, 0xb3, 0xb1, 0x40, 0x9d, 0xcc, 0x66, 0x2b, 0x61, 0x44, 0x09,
-	0xe2, 0x74, 0x87, 0x09, 0x88, 0x17, 0x0f, 0x49, 0x66, 0x1d, 0x22, 0x46, 0x70, 0x92, 0x08, 0x0a,
-	0x21, 0x54, 0xa6, 0x2b, 0x35, 0xcd, 0x74, 0xaa, 0xda, 0xae, 0x9a, 0x99, 0x0c, 0x22, 0x2c, 0x9e,
-	0xf6, 0x28, 0x78, 0x70, 0xc1, 0x8b, 0x08, 0xc2, 0xe2, 0x69, 0x8f, 0x7b, 0xdc, 0x63, 0x8e, 0x0b,
-	0x5e, 0xf6, 0xe4, 0xee, 0x74, 0x7b, 0xc8, 0x71, 0x8f, 0x8b, 0x27, 0xe9, 0x9a, 0xea, 0x64, 0xa6,
-	0x27, 0xad, 0x5e, 0xbc, 0x75, 0xf5, 0x7f, 0xff, 0xfd, 0xf7, 0x5f, 0xfd, 0x5f, 0xe8, 0x9d, 0x40,
-	0x46, 0xb4, 0x4f, 0x45, 0x55, 0x69, 0xda, 0xea, 0xb8, 0x34, 0xf4, 0x5d, 0x4e, 0x35, 0xeb, 0xd3,
-	0x81, 0x62, 0x51, 0x8f, 0x45, 0x4e, 0x18, 0x49, 0x2d, 0xf1, 0x82, 0xd6, 0xc2, 0xb1, 0x50, 0xa7,
-	0xb7, 0x51, 0xde, 0xe4, 0xbe, 0x6e, 0x77, 0x8f, 0x9d, 0x96, 0x3c, 0x75, 0x99, 0xe8, 0xc9, 0x41,
-	0x18, 0xc9, 0xb3, 0x81, 0x6b, 0xc0, 0xad, 0x2a, 0x67, 0xa2, 0xda, 0xa3, 0x81, 0xef, 0x51, 0xcd,
-	0xdc, 0xa9, 0x8f, 0x11, 0x65, 0xb9, 0x3a, 0x46, 0xc1, 0x25, 0x97, 0xa3, 0xe4, 0xe3, 0xee, 0x89,
-	0x39, 0x99, 0x83, 0xf9, 0xb2, 0xf0, 0x65, 0x2e, 0x25, 0x0f, 0x98, 0x51, 0x48, 0x85, 0x90, 0x9a,
-	0x6a, 0x5f, 0x0a, 0x65, 0xa3, 0xc4, 0x46, 0x2f, 0x39, 0xbc, 0x6e, 0x64, 0x00, 0x36, 0x7e, 0x33,
-	0x1f, 0x67, 0xa7, 0xa1, 0x1e, 0xd8, 0xe0, 0xad, 0x69, 0x0f, 0x58, 0x14, 0x49, 0xdb, 0x7b, 0x79,
-	0xa5, 0xd0, 0x22, 0x0b, 0x78, 0x7b, 0x1a, 0xe0, 0x7b, 0x4c, 0x68, 0xff, 0xc4, 0x67, 0x51, 0xa6,
-	0x70, 0x75, 0x1a, 0x74, 0xca, 0x94, 0xa2, 0x9c, 0x65, 0x88, 0xe5, 0x6b, 0x10, 0x5f, 0x6b, 0x5d,
-	0x9c, 0x1f, 0x31, 0xee, 0x4b, 0x41, 0x83, 0x11, 0xa2, 0x72, 0x01, 0xd1, 0xab, 0x8d, 0x91, 0xb0,
-	0x83, 0x10, 0x7f, 0x8c, 0x6e, 0x74, 0xc3, 0xc0, 0x17, 0x9d, 0xa3, 0xac, 0x4c, 0x09, 0xae, 0xce,
-	0xae, 0xcd, 0xd7, 0x6e, 0x39, 0x93, 0x77, 0xe9, 0x1c, 0x18, 0xd8, 0xee, 0x08, 0xd5, 0x5c, 0xe8,
-	0x8e, 0x1f, 0x15, 0xae, 0xa3, 0x05, 0xdb, 0xed, 0x91, 0xd2, 0x54, 0x77, 0x55, 0x69, 0x66, 0x15,
-	0x5e, 0x47, 0x63, 0x4b, 0xef, 0x19, 0x50, 0xf3, 0x35, 0x3e, 0x7e, 0xc4, 0xbb, 0xe8, 0x75, 0x7d,
-	0x76, 0x44, 0x5b, 0x1d, 0x21, 0xfb, 0x01, 0xf3, 0xf8, 0x29, 0x13, 0xba, 0x34, 0x6b, 0x88, 0x56,
-	0xf3, 0x44, 0xfb, 0x67, 0x9b, 0x13, 0xb8, 0xe6, 0xa2, 0xce, 0xfd, 0xa9, 0x7c, 0x89, 0xe6, 0x6d,
-	0xb9, 0xba, 0xec, 0x0b, 0xfc, 0x09, 0x5a, 0xf4, 0x64, 0x5f, 0x8c, 0x77, 0x5b, 0x82, 0x86, 0x7c,
-	0x25, 0x4f, 0x5e, 0xb7, 0xb8, 0xac, 0xdd, 0x1b, 0xde, 0xe4, 0x8f, 0xca, 0x21, 0x2a, 0xed, 0xb5,
-	0xda, 0xcc, 0xeb, 0x06, 0x2c, 0xc3, 0x36, 0x99, 0x0a, 0xa5, 0x50, 0x0c, 0x6f, 0xa2, 0x39, 0x8f,
-	0x05, 0x74, 0x60, 0xc9, 0x97, 0x9c, 0xd1, 0x54, 0x39, 0xd9, 0x54, 0x39, 0x75, 0x3b, 0x75, 0x5b,
-	0x8b, 0x7f, 0x6d, 0xcd, 0xfd, 0x06, 0x67, 0x5e, 0x81, 0xe7, 0x7f, 0xac, 0x80, 0x7b, 0x4f, 0x57,
-	0x60, 0x73, 0x94, 0x59, 0x39, 0x44, 0xcb, 0x79, 0xfa, 0xdb, 0xe9, 0xac, 0xd5, 0x99, 0xa6, 0x7e,
-	0xa0, 0xf0, 0x47, 0x68, 0x3e, 0xa4, 0xba, 0x7d, 0x64, 0x06, 0x30, 0xbb, 0xb2, 0xe5, 0x7c, 0x17,
-	0xe3, 0x29, 0x4d, 0x94, 0x26, 0x98, 0x3f, 0xaa, 0xf6, 0x74, 0x16, 0xcd, 0x35, 0x74, 0xbf, 0xa1,
-	0xf0, 0x0e, 0x9a, 0xff, 0xd4, 0x17, 0x1d, 0x6b, 0x13, 0x5e, 0x2a, 0xb8, 0xae, 0x83, 0xb0, 0x7c,
-	0xb3, 0x20, 0x94, 0xea, 0x5b, 0x83, 0xeb, 0x10, 0xef, 0xa1, 0x37, 0x1a, 0x4c, 0x6f, 0x4b, 0xd1,
-	0x62, 0x42, 0x47, 0x54, 0xcb, 0x68, 0x5b, 0x8a, 0x13, 0x9f, 0xe3, 0x37, 0xa7, 0x0c, 0xb8, 0x9d,
-	0xae, 0x55, 0xb9, 0x92, 0x67, 0xbc, 0x26, 0xf7, 0x47, 0x68, 0x58, 0x77, 0x3f, 0xdf, 0xdf, 0xdf,
-	0x96, 0x42, 0xb0, 0x56, 0xea, 0xdb, 0x8e, 0x38, 0x91, 0xb8, 0x52, 0xa0, 0x67, 0xe7, 0x6a, 0xa7,
-	0xa6, 0x2b, 0x4c, 0xf3, 0x54, 0x3e, 0xf8, 0xee, 0xf7, 0x3f, 0x7f, 0x98, 0x59, 0xc7, 0x8e, 0xcb,
-	0xd5, 0xe5, 0xa3, 0xe6, 0x7e, 0x93, 0x4d, 0xb3, 0xef, 0x7d, 0x6b, 0xf6, 0xab, 0xda, 0xba, 0x4c,
-	0xab, 0xfa, 0x69, 0xfd, 0x9f, 0x20, 0x7a, 0xcb, 0x2a, 0xfb, 0xa2, 0xf6, 0x3f, 0x69, 0xfb, 0xd0,
-	0x68, 0xab, 0xe1, 0xf5, 0x7f, 0xd6, 0xd6, 0xab, 0xe5, 0xd5, 0xd5, 0x18, 0x7a, 0xe9, 0x33, 0xd5,
-	0x50, 0xf8, 0x10, 0x2d, 0xe6, 0x07, 0x09, 0xff, 0xdb, 0xb4, 0x97, 0xd7, 0xf2, 0x80, 0xa2, 0x51,
-	0xaf, 0xfd, 0x02, 0xd1, 0x4c, 0x43, 0xa5, 0x5e, 0x2c, 0x35, 0x98, 0xb6, 0x5d, 0x5e, 0x35, 0x91,
-	0xae, 0xb5, 0xfa, 0x4f, 0x6e, 0xbc, 0x5b, 0x80, 0xc9, 0x71, 0x55, 0x6a, 0xc6, 0x91, 0xf7, 0xf1,
-	0x7b, 0xc5, 0x8e, 0x5c, 0x59, 0xe1, 0xa6, 0x4f, 0x91, 0xda, 0xfa, 0x15, 0x9e, 0x0f, 0x09, 0x7c,
-	0x3c, 0x24, 0xf0, 0xc9, 0x90, 0x80, 0x67, 0x43, 0x02, 0x2e, 0x86, 0x04, 0x3c, 0x1f, 0x12, 0xf0,
-	0x62, 0x48, 0xe0, 0x9d, 0x98, 0xc0, 0xbb, 0x31, 0x01, 0xf7, 0x63, 0x02, 0x1f, 0xc4, 0x04, 0x3c,
-	0x8c, 0x09, 0x78, 0x14, 0x13, 0x70, 0x1e, 0x13, 0xf8, 0x38, 0x26, 0xf0, 0x49, 0x4c, 0xc0, 0xb3,
-	0x98, 0xc0, 0x8b, 0x98, 0x80, 0xe7, 0x31, 0x81, 0x2f, 0x62, 0x02, 0xee, 0x24, 0x04, 0xdc, 0x4d,
-	0x08, 0xfc, 0x3e, 0x21, 0xe0, 0x5e, 0x42, 0xe0, 0xcf, 0x09, 0x01, 0xf7, 0x13, 0x02, 0x1e, 0x24,
-	0x04, 0x3e, 0x4c, 0x08, 0x7c, 0x94, 0x10, 0xf8, 0x95, 0xcb, 0xa5, 0xa3, 0xdb, 0x4c, 0xb7, 0x7d,
-	0xc1, 0x95, 0x23, 0x98, 0xee, 0xcb, 0xa8, 0xe3, 0x4e, 0xbe, 0xcf, 0xbd, 0x0d, 0x37, 0xec, 0x70,
-	0x57, 0x6b, 0x11, 0x1e, 0x1f, 0xbf, 0x6c, 0xf6, 0x63, 0xe3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x90, 0xb7, 0x04, 0xec, 0x6d, 0x07, 0x00, 0x00,
+func (m *ExecuteGatewayCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteGatewayCommandRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteGatewayCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteGatewayCommandRequest.Merge(m, src)
+}
+func (m *ExecuteGatewayCommandRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteGatewayCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteGatewayCommandRequest.DiscardUnknown(m)
 }
 
-func (this *GatewayUp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_ExecuteGatewayCommandRequest proto.InternalMessageInfo
 
-	that1, ok := that.(*GatewayUp)
-	if !ok {
-		that2, ok := that.(GatewayUp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
-		return false
-	}
-	for i := range this.UplinkMessages {
-		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
-			return false
-		}
-	}
-	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
-		return false
-	}
-	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
-		return false
+func (m *ExecuteGatewayCommandRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
 	}
-	return true
+	return ""
 }
-func (this *GatewayDown) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GatewayDown)
-	if !ok {
-		that2, ok := that.(GatewayDown)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
-		return false
+func (m *ExecuteGatewayCommandRequest) GetArguments() []string {
+	if m != nil {
+		return m.Arguments
 	}
-	return true
+	return nil
 }
-func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkResponse)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GatewayRemoteShellRequest struct {
+	// The identifiers of the gateway to open the remote shell session on.
+	// This field must be set in the first message of the stream only.
+	GatewayIDs *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// The user that runs the remote shell on the gateway.
+	// This field is only used in the first message of the stream.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The terminal type of the remote shell, for example `xterm`.
+	// This field is only used in the first message of the stream.
+	Term string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	// The input to write to the remote shell.
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayRemoteShellRequest) Reset()      { *m = GatewayRemoteShellRequest{} }
+func (*GatewayRemoteShellRequest) ProtoMessage() {}
+func (*GatewayRemoteShellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5}
+}
+func (m *GatewayRemoteShellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayRemoteShellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayRemoteShellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Delay != that1.Delay {
-		return false
-	}
-	return true
 }
-func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GatewayRemoteShellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellRequest.Merge(m, src)
+}
+func (m *GatewayRemoteShellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayRemoteShellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayRemoteShellRequest proto.InternalMessageInfo
+
+func (m *GatewayRemoteShellRequest) GetGatewayIDs() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIDs
 	}
+	return nil
+}
 
-	that1, ok := that.(*ScheduleDownlinkErrorDetails)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkErrorDetails)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *GatewayRemoteShellRequest) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *GatewayRemoteShellRequest) GetTerm() string {
+	if m != nil {
+		return m.Term
 	}
-	if len(this.PathErrors) != len(that1.PathErrors) {
-		return false
+	return ""
+}
+
+func (m *GatewayRemoteShellRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	for i := range this.PathErrors {
-		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
-			return false
+	return nil
+}
+
+type GatewayRemoteShellResponse struct {
+	// The output of the remote shell.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayRemoteShellResponse) Reset()      { *m = GatewayRemoteShellResponse{} }
+func (*GatewayRemoteShellResponse) ProtoMessage() {}
+func (*GatewayRemoteShellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{6}
+}
+func (m *GatewayRemoteShellResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayRemoteShellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayRemoteShellResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
+}
+func (m *GatewayRemoteShellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellResponse.Merge(m, src)
+}
+func (m *GatewayRemoteShellResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayRemoteShellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GatewayRemoteShellResponse proto.InternalMessageInfo
 
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+func (m *GatewayRemoteShellResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-type gtwGsClient struct {
-	cc *grpc.ClientConn
+// Connection stats of a gateway in a time bucket.
+type GatewayConnectionStatsBucket struct {
+	// Start of the time bucket.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// Duration of the time bucket.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// Number of uplink messages received in the time bucket.
+	UplinkCount uint64 `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of downlink messages sent in the time bucket.
+	DownlinkCount uint64 `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Number of transmission acknowledgments received in the time bucket.
+	TxAcknowledgmentCount uint64 `protobuf:"varint,5,opt,name=tx_acknowledgment_count,json=txAcknowledgmentCount,proto3" json:"tx_acknowledgment_count,omitempty"`
+	// Number of transmission acknowledgments with a failure result received in the time bucket.
+	TxAcknowledgmentFailureCount uint64 `protobuf:"varint,6,opt,name=tx_acknowledgment_failure_count,json=txAcknowledgmentFailureCount,proto3" json:"tx_acknowledgment_failure_count,omitempty"`
+	// Round-trip times measured in the time bucket.
+	RoundTripTimes *GatewayConnectionStatsBucket_RoundTripTimes `protobuf:"bytes,7,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
+	// Utilization of each sub band at the end of the time bucket.
+	// For merged time buckets, this is the peak utilization of the merged time buckets.
+	SubBands             []*GatewayConnectionStats_SubBand `protobuf:"bytes,8,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
-	return &gtwGsClient{cc}
+func (m *GatewayConnectionStatsBucket) Reset()      { *m = GatewayConnectionStatsBucket{} }
+func (*GatewayConnectionStatsBucket) ProtoMessage() {}
+func (*GatewayConnectionStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7}
 }
-
-func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &gtwGsLinkGatewayClient{stream}
-	return x, nil
 }
-
-type GtwGs_LinkGatewayClient interface {
-	Send(*GatewayUp) error
-	Recv() (*GatewayDown, error)
-	grpc.ClientStream
+func (m *GatewayConnectionStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsBucket.Merge(m, src)
 }
-
-type gtwGsLinkGatewayClient struct {
-	grpc.ClientStream
+func (m *GatewayConnectionStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsBucket.DiscardUnknown(m)
 }
 
-func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
-	return x.ClientStream.SendMsg(m)
+var xxx_messageInfo_GatewayConnectionStatsBucket proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsBucket) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
 }
 
-func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
-	m := new(GatewayDown)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsBucket) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return m, nil
+	return 0
 }
 
-func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
-	out := new(ConcentratorConfig)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsBucket) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsBucket) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsBucket) GetTxAcknowledgmentCount() uint64 {
+	if m != nil {
+		return m.TxAcknowledgmentCount
 	}
-	return out, nil
+	return 0
 }
 
-// GtwGsServer is the server API for GtwGs service.
-type GtwGsServer interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(GtwGs_LinkGatewayServer) error
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+func (m *GatewayConnectionStatsBucket) GetTxAcknowledgmentFailureCount() uint64 {
+	if m != nil {
+		return m.TxAcknowledgmentFailureCount
+	}
+	return 0
 }
 
-// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGtwGsServer struct {
+func (m *GatewayConnectionStatsBucket) GetRoundTripTimes() *GatewayConnectionStatsBucket_RoundTripTimes {
+	if m != nil {
+		return m.RoundTripTimes
+	}
+	return nil
 }
 
-func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
-	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+func (m *GatewayConnectionStatsBucket) GetSubBands() []*GatewayConnectionStats_SubBand {
+	if m != nil {
+		return m.SubBands
+	}
+	return nil
 }
-func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+
+type GatewayConnectionStatsBucket_RoundTripTimes struct {
+	Min                  time.Duration `protobuf:"bytes,1,opt,name=min,proto3,stdduration" json:"min"`
+	Max                  time.Duration `protobuf:"bytes,2,opt,name=max,proto3,stdduration" json:"max"`
+	Median               time.Duration `protobuf:"bytes,3,opt,name=median,proto3,stdduration" json:"median"`
+	Percentile90         time.Duration `protobuf:"bytes,4,opt,name=percentile_90,json=percentile90,proto3,stdduration" json:"percentile_90"`
+	Count                uint32        `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) Reset() {
+	*m = GatewayConnectionStatsBucket_RoundTripTimes{}
 }
-func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+func (*GatewayConnectionStatsBucket_RoundTripTimes) ProtoMessage() {}
+func (*GatewayConnectionStatsBucket_RoundTripTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7, 0}
 }
-
-func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
-	s.RegisterService(&_GtwGs_serviceDesc, srv)
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStatsBucket_RoundTripTimes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type GtwGs_LinkGatewayServer interface {
-	Send(*GatewayDown) error
-	Recv() (*GatewayUp, error)
-	grpc.ServerStream
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsBucket_RoundTripTimes.Merge(m, src)
 }
-
-type gtwGsLinkGatewayServer struct {
-	grpc.ServerStream
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsBucket_RoundTripTimes.DiscardUnknown(m)
 }
 
-func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
-	m := new(GatewayUp)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayConnectionStatsBucket_RoundTripTimes proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) GetMin() time.Duration {
+	if m != nil {
+		return m.Min
 	}
-	return m, nil
+	return 0
 }
 
-func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) GetMax() time.Duration {
+	if m != nil {
+		return m.Max
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) GetMedian() time.Duration {
+	if m != nil {
+		return m.Median
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) GetPercentile90() time.Duration {
+	if m != nil {
+		return m.Percentile90
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _GtwGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GtwGs",
-	HandlerType: (*GtwGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetConcentratorConfig",
-			Handler:    _GtwGs_GetConcentratorConfig_Handler,
-		},
-		{
-			MethodName: "GetMQTTConnectionInfo",
-			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
-		},
-		{
-			MethodName: "GetMQTTV2ConnectionInfo",
-			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "LinkGateway",
-			Handler:       _GtwGs_LinkGateway_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-// NsGsClient is the client API for NsGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsGsClient interface {
-	// Instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+type GetGatewayConnectionStatsHistoryRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Only return time buckets that start at or after this time.
+	After *time.Time `protobuf:"bytes,2,opt,name=after,proto3,stdtime" json:"after,omitempty"`
+	// Only return time buckets that start before this time.
+	Before *time.Time `protobuf:"bytes,3,opt,name=before,proto3,stdtime" json:"before,omitempty"`
+	// Duration of the returned time buckets. The stored time buckets are merged into time buckets of this duration.
+	// If the duration is shorter than the duration of the stored time buckets, the stored time buckets are returned.
+	BucketDuration       *time.Duration `protobuf:"bytes,4,opt,name=bucket_duration,json=bucketDuration,proto3,stdduration" json:"bucket_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-type nsGsClient struct {
-	cc *grpc.ClientConn
+func (m *GetGatewayConnectionStatsHistoryRequest) Reset() {
+	*m = GetGatewayConnectionStatsHistoryRequest{}
 }
-
-func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
-	return &nsGsClient{cc}
+func (*GetGatewayConnectionStatsHistoryRequest) ProtoMessage() {}
+func (*GetGatewayConnectionStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8}
 }
-
-func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
-	out := new(ScheduleDownlinkResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// NsGsServer is the server API for NsGs service.
-type NsGsServer interface {
-	// Instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Merge(m, src)
 }
-
-// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
-type UnimplementedNsGsServer struct {
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.DiscardUnknown(m)
 }
 
-func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
-	s.RegisterService(&_NsGs_serviceDesc, srv)
-}
+var xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest proto.InternalMessageInfo
 
-func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+func (m *GetGatewayConnectionStatsHistoryRequest) GetAfter() *time.Time {
+	if m != nil {
+		return m.After
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _NsGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsGs",
-	HandlerType: (*NsGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ScheduleDownlink",
-			Handler:    _NsGs_ScheduleDownlink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GetGatewayConnectionStatsHistoryRequest) GetBefore() *time.Time {
+	if m != nil {
+		return m.Before
+	}
+	return nil
 }
 
-// GsClient is the client API for Gs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsClient interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+func (m *GetGatewayConnectionStatsHistoryRequest) GetBucketDuration() *time.Duration {
+	if m != nil {
+		return m.BucketDuration
+	}
+	return nil
 }
 
-type gsClient struct {
-	cc *grpc.ClientConn
+// Historical connection stats of a gateway.
+type GatewayConnectionStatsHistory struct {
+	// The time buckets, ordered by start time.
+	Buckets              []*GatewayConnectionStatsBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func NewGsClient(cc *grpc.ClientConn) GsClient {
-	return &gsClient{cc}
+func (m *GatewayConnectionStatsHistory) Reset()      { *m = GatewayConnectionStatsHistory{} }
+func (*GatewayConnectionStatsHistory) ProtoMessage() {}
+func (*GatewayConnectionStatsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{9}
+}
+func (m *GatewayConnectionStatsHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStatsHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayConnectionStatsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsHistory.Merge(m, src)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStatsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsHistory.DiscardUnknown(m)
 }
 
-func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
-	out := new(GatewayConnectionStats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayConnectionStatsHistory proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsHistory) GetBuckets() []*GatewayConnectionStatsBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return out, nil
+	return nil
 }
 
-// GsServer is the server API for Gs service.
-type GsServer interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+type StartGatewayCaptureRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// The maximum duration of the capture. The default is 10 minutes, and the maximum is 1 hour.
+	Duration *time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
+	// The maximum number of packets to capture. The default is 1000.
+	MaxPackets           uint32   `protobuf:"varint,3,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGsServer struct {
+func (m *StartGatewayCaptureRequest) Reset()      { *m = StartGatewayCaptureRequest{} }
+func (*StartGatewayCaptureRequest) ProtoMessage() {}
+func (*StartGatewayCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{10}
+}
+func (m *StartGatewayCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartGatewayCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartGatewayCaptureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartGatewayCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartGatewayCaptureRequest.Merge(m, src)
+}
+func (m *StartGatewayCaptureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartGatewayCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartGatewayCaptureRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+var xxx_messageInfo_StartGatewayCaptureRequest proto.InternalMessageInfo
+
+func (m *StartGatewayCaptureRequest) GetDuration() *time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
 }
 
-func RegisterGsServer(s *grpc.Server, srv GsServer) {
-	s.RegisterService(&_Gs_serviceDesc, srv)
+func (m *StartGatewayCaptureRequest) GetMaxPackets() uint32 {
+	if m != nil {
+		return m.MaxPackets
+	}
+	return 0
 }
 
-func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
+// Status of a gateway traffic capture.
+type GatewayCapture struct {
+	// Time when the capture started.
+	StartedAt time.Time `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	// Time when the capture ends, unless it is stopped earlier.
+	EndsAt time.Time `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3,stdtime" json:"ends_at"`
+	// Time when the capture stopped. This field is not set while the capture is active.
+	StoppedAt *time.Time `protobuf:"bytes,3,opt,name=stopped_at,json=stoppedAt,proto3,stdtime" json:"stopped_at,omitempty"`
+	// Number of captured packets.
+	PacketCount uint32 `protobuf:"varint,4,opt,name=packet_count,json=packetCount,proto3" json:"packet_count,omitempty"`
+	// Maximum number of packets to capture.
+	MaxPackets           uint32   `protobuf:"varint,5,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayCapture) Reset()      { *m = GatewayCapture{} }
+func (*GatewayCapture) ProtoMessage() {}
+func (*GatewayCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{11}
+}
+func (m *GatewayCapture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayCapture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+}
+func (m *GatewayCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayCapture.Merge(m, src)
+}
+func (m *GatewayCapture) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayCapture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayCapture proto.InternalMessageInfo
+
+func (m *GatewayCapture) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+	return time.Time{}
+}
+
+func (m *GatewayCapture) GetEndsAt() time.Time {
+	if m != nil {
+		return m.EndsAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	return time.Time{}
+}
+
+func (m *GatewayCapture) GetStoppedAt() *time.Time {
+	if m != nil {
+		return m.StoppedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Gs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.Gs",
-	HandlerType: (*GsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetGatewayConnectionStats",
-			Handler:    _Gs_GetGatewayConnectionStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayCapture) GetPacketCount() uint32 {
+	if m != nil {
+		return m.PacketCount
+	}
+	return 0
 }
 
-func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GatewayCapture) GetMaxPackets() uint32 {
+	if m != nil {
+		return m.MaxPackets
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DownloadGatewayCaptureRequest struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	Format               CaptureFormat `protobuf:"varint,2,opt,name=format,proto3,enum=ttn.lorawan.v3.CaptureFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxAcknowledgment != nil {
-		{
-			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GatewayStatus != nil {
-		{
-			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UplinkMessages) > 0 {
-		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DownloadGatewayCaptureRequest) Reset()      { *m = DownloadGatewayCaptureRequest{} }
+func (*DownloadGatewayCaptureRequest) ProtoMessage() {}
+func (*DownloadGatewayCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{12}
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownloadGatewayCaptureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadGatewayCaptureRequest.Merge(m, src)
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownloadGatewayCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadGatewayCaptureRequest.DiscardUnknown(m)
 }
 
-func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DownloadGatewayCaptureRequest proto.InternalMessageInfo
+
+func (m *DownloadGatewayCaptureRequest) GetFormat() CaptureFormat {
+	if m != nil {
+		return m.Format
 	}
-	return dAtA[:n], nil
+	return CAPTURE_FORMAT_PCAPNG
 }
 
-func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GatewayCaptureFile struct {
+	Capture *GatewayCapture `protobuf:"bytes,1,opt,name=capture,proto3" json:"capture,omitempty"`
+	Format  CaptureFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=ttn.lorawan.v3.CaptureFormat" json:"format,omitempty"`
+	// The contents of the capture file.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		{
-			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+func (m *GatewayCaptureFile) Reset()      { *m = GatewayCaptureFile{} }
+func (*GatewayCaptureFile) ProtoMessage() {}
+func (*GatewayCaptureFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{13}
+}
+func (m *GatewayCaptureFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayCaptureFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayCaptureFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GatewayCaptureFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayCaptureFile.Merge(m, src)
+}
+func (m *GatewayCaptureFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayCaptureFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayCaptureFile.DiscardUnknown(m)
 }
 
-func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayCaptureFile proto.InternalMessageInfo
+
+func (m *GatewayCaptureFile) GetCapture() *GatewayCapture {
+	if m != nil {
+		return m.Capture
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GatewayCaptureFile) GetFormat() CaptureFormat {
+	if m != nil {
+		return m.Format
+	}
+	return CAPTURE_FORMAT_PCAPNG
 }
 
-func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
-	if err4 != nil {
-		return 0, err4
+func (m *GatewayCaptureFile) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	i -= n4
-	i = encodeVarintGatewayserver(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type GetGatewaySpectrumStatsRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// The duration of the sliding window over which the stats are aggregated.
+	// The default and maximum is the window configured in the Gateway Server.
+	Window               *time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetGatewaySpectrumStatsRequest) Reset()      { *m = GetGatewaySpectrumStatsRequest{} }
+func (*GetGatewaySpectrumStatsRequest) ProtoMessage() {}
+func (*GetGatewaySpectrumStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{14}
 }
-
-func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GetGatewaySpectrumStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewaySpectrumStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewaySpectrumStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetGatewaySpectrumStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewaySpectrumStatsRequest.Merge(m, src)
+}
+func (m *GetGatewaySpectrumStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewaySpectrumStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewaySpectrumStatsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGatewayserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_GetGatewaySpectrumStatsRequest proto.InternalMessageInfo
+
+func (m *GetGatewaySpectrumStatsRequest) GetWindow() *time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
-	this := &GatewayUp{}
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(5)
-		this.UplinkMessages = make([]*UplinkMessage, v1)
-		for i := 0; i < v1; i++ {
-			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+
+// Spectrum and channel occupancy stats of a gateway, aggregated over a sliding window.
+type GatewaySpectrumStats struct {
+	// Start of the window.
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// End of the window.
+	WindowEnd time.Time `protobuf:"bytes,2,opt,name=window_end,json=windowEnd,proto3,stdtime" json:"window_end"`
+	// The channels on which messages were received or scheduled, ordered by frequency.
+	Channels             []*GatewaySpectrumStats_Channel `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GatewaySpectrumStats) Reset()      { *m = GatewaySpectrumStats{} }
+func (*GatewaySpectrumStats) ProtoMessage() {}
+func (*GatewaySpectrumStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{15}
+}
+func (m *GatewaySpectrumStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewaySpectrumStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewaySpectrumStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if r.Intn(5) != 0 {
-		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+}
+func (m *GatewaySpectrumStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewaySpectrumStats.Merge(m, src)
+}
+func (m *GatewaySpectrumStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewaySpectrumStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewaySpectrumStats.DiscardUnknown(m)
 }
 
-func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
-	this := &GatewayDown{}
-	if r.Intn(5) != 0 {
-		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+var xxx_messageInfo_GatewaySpectrumStats proto.InternalMessageInfo
+
+func (m *GatewaySpectrumStats) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
 	}
-	return this
+	return time.Time{}
 }
 
-func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
-	this := &ScheduleDownlinkResponse{}
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Delay = *v2
-	if !easy && r.Intn(10) != 0 {
+func (m *GatewaySpectrumStats) GetWindowEnd() time.Time {
+	if m != nil {
+		return m.WindowEnd
 	}
-	return this
+	return time.Time{}
 }
 
-func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
-	this := &ScheduleDownlinkErrorDetails{}
-	if r.Intn(5) == 0 {
-		v3 := r.Intn(5)
-		this.PathErrors = make([]*ErrorDetails, v3)
-		for i := 0; i < v3; i++ {
-			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *GatewaySpectrumStats) GetChannels() []*GatewaySpectrumStats_Channel {
+	if m != nil {
+		return m.Channels
 	}
-	return this
+	return nil
 }
 
-type randyGatewayserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+type GatewaySpectrumStats_HistogramBin struct {
+	// Lower bound of the bin (inclusive).
+	Lower float32 `protobuf:"fixed32,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	// Upper bound of the bin (exclusive).
+	Upper                float32  `protobuf:"fixed32,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
+func (m *GatewaySpectrumStats_HistogramBin) Reset()      { *m = GatewaySpectrumStats_HistogramBin{} }
+func (*GatewaySpectrumStats_HistogramBin) ProtoMessage() {}
+func (*GatewaySpectrumStats_HistogramBin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{15, 0}
 }
-func randStringGatewayserver(r randyGatewayserver) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
-		tmps[i] = randUTF8RuneGatewayserver(r)
-	}
-	return string(tmps)
+func (m *GatewaySpectrumStats_HistogramBin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *GatewaySpectrumStats_HistogramBin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewaySpectrumStats_HistogramBin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+		return b[:n], nil
 	}
-	return dAtA
 }
-func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		v5 := r.Int63()
-		if r.Intn(2) == 0 {
-			v5 *= -1
-		}
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v5))
-	case 1:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
+func (m *GatewaySpectrumStats_HistogramBin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewaySpectrumStats_HistogramBin.Merge(m, src)
 }
-func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+func (m *GatewaySpectrumStats_HistogramBin) XXX_Size() int {
+	return m.Size()
 }
-func (m *GatewayUp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UplinkMessages) > 0 {
-		for _, e := range m.UplinkMessages {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
-		}
-	}
-	if m.GatewayStatus != nil {
-		l = m.GatewayStatus.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
-	}
-	if m.TxAcknowledgment != nil {
-		l = m.TxAcknowledgment.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
-	}
-	return n
+func (m *GatewaySpectrumStats_HistogramBin) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewaySpectrumStats_HistogramBin.DiscardUnknown(m)
 }
 
-func (m *GatewayDown) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		l = m.DownlinkMessage.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+var xxx_messageInfo_GatewaySpectrumStats_HistogramBin proto.InternalMessageInfo
+
+func (m *GatewaySpectrumStats_HistogramBin) GetLower() float32 {
+	if m != nil {
+		return m.Lower
 	}
-	return n
+	return 0
 }
 
-func (m *ScheduleDownlinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GatewaySpectrumStats_HistogramBin) GetUpper() float32 {
+	if m != nil {
+		return m.Upper
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
-	n += 1 + l + sovGatewayserver(uint64(l))
-	return n
+	return 0
 }
 
-func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for _, e := range m.PathErrors {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
-		}
+func (m *GatewaySpectrumStats_HistogramBin) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return n
+	return 0
 }
 
-func sovGatewayserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type GatewaySpectrumStats_DataRateStats struct {
+	DataRate DataRate `protobuf:"bytes,1,opt,name=data_rate,json=dataRate,proto3" json:"data_rate"`
+	// Number of uplink messages with this data rate.
+	UplinkCount uint32 `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Total airtime of the uplink messages with this data rate.
+	UplinkAirtime        time.Duration `protobuf:"bytes,3,opt,name=uplink_airtime,json=uplinkAirtime,proto3,stdduration" json:"uplink_airtime"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func sozGatewayserver(x uint64) (n int) {
-	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+
+func (m *GatewaySpectrumStats_DataRateStats) Reset()      { *m = GatewaySpectrumStats_DataRateStats{} }
+func (*GatewaySpectrumStats_DataRateStats) ProtoMessage() {}
+func (*GatewaySpectrumStats_DataRateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{15, 1}
 }
-func (this *GatewayUp) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
-	for _, f := range this.UplinkMessages {
-		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
-	}
-	repeatedStringForUplinkMessages += "}"
-	s := strings.Join([]string{`&GatewayUp{`,
-		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
-		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
-		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *GatewaySpectrumStats_DataRateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *GatewayDown) String() string {
-	if this == nil {
-		return "nil"
+func (m *GatewaySpectrumStats_DataRateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewaySpectrumStats_DataRateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&GatewayDown{`,
-		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *ScheduleDownlinkResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *GatewaySpectrumStats_DataRateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewaySpectrumStats_DataRateStats.Merge(m, src)
+}
+func (m *GatewaySpectrumStats_DataRateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewaySpectrumStats_DataRateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewaySpectrumStats_DataRateStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewaySpectrumStats_DataRateStats proto.InternalMessageInfo
+
+func (m *GatewaySpectrumStats_DataRateStats) GetDataRate() DataRate {
+	if m != nil {
+		return m.DataRate
 	}
-	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
-		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return DataRate{}
 }
-func (this *ScheduleDownlinkErrorDetails) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GatewaySpectrumStats_DataRateStats) GetUplinkCount() uint32 {
+	if m != nil {
+		return m.UplinkCount
 	}
-	repeatedStringForPathErrors := "[]*ErrorDetails{"
-	for _, f := range this.PathErrors {
-		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	return 0
+}
+
+func (m *GatewaySpectrumStats_DataRateStats) GetUplinkAirtime() time.Duration {
+	if m != nil {
+		return m.UplinkAirtime
 	}
-	repeatedStringForPathErrors += "}"
-	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
-		`PathErrors:` + repeatedStringForPathErrors + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func valueToStringGatewayserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+type GatewaySpectrumStats_Channel struct {
+	// Frequency (Hz).
+	Frequency uint64 `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Number of uplink messages received on the channel.
+	UplinkCount uint32 `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of downlink messages scheduled on the channel.
+	DownlinkCount uint32 `protobuf:"varint,3,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Total airtime of the uplink messages.
+	UplinkAirtime time.Duration `protobuf:"bytes,4,opt,name=uplink_airtime,json=uplinkAirtime,proto3,stdduration" json:"uplink_airtime"`
+	// Total airtime of the downlink messages.
+	DownlinkAirtime time.Duration `protobuf:"bytes,5,opt,name=downlink_airtime,json=downlinkAirtime,proto3,stdduration" json:"downlink_airtime"`
+	// Fraction of the window that the channel was occupied by uplink and downlink messages.
+	Utilization float32 `protobuf:"fixed32,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// Number of uplink messages that overlapped in time with another uplink message with the same data rate.
+	OverlappingUplinkCount uint32 `protobuf:"varint,7,opt,name=overlapping_uplink_count,json=overlappingUplinkCount,proto3" json:"overlapping_uplink_count,omitempty"`
+	// Estimated probability that an uplink message collides with another uplink message on the channel,
+	// following the pure ALOHA model with the uplink utilization of the channel as offered load.
+	CollisionProbability float32 `protobuf:"fixed32,8,opt,name=collision_probability,json=collisionProbability,proto3" json:"collision_probability,omitempty"`
+	// Uplink messages per data rate, ordered by data rate.
+	DataRates []*GatewaySpectrumStats_DataRateStats `protobuf:"bytes,9,rep,name=data_rates,json=dataRates,proto3" json:"data_rates,omitempty"`
+	// Histogram of the RSSI (dBm) of uplink messages in bins of 10 dB.
+	RSSI []*GatewaySpectrumStats_HistogramBin `protobuf:"bytes,10,rep,name=rssi,proto3" json:"rssi,omitempty"`
+	// Histogram of the SNR (dB) of LoRa uplink messages in bins of 2.5 dB.
+	SNR []*GatewaySpectrumStats_HistogramBin `protobuf:"bytes,11,rep,name=snr,proto3" json:"snr,omitempty"`
+	// Number of uplink messages of foreign networks.
+	// These are data uplink messages with a DevAddr that is not routed to the cluster, and frames that are not
+	// LoRaWAN messages.
+	ForeignUplinkCount   uint32   `protobuf:"varint,12,opt,name=foreign_uplink_count,json=foreignUplinkCount,proto3" json:"foreign_uplink_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewaySpectrumStats_Channel) Reset()      { *m = GatewaySpectrumStats_Channel{} }
+func (*GatewaySpectrumStats_Channel) ProtoMessage() {}
+func (*GatewaySpectrumStats_Channel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{15, 2}
+}
+func (m *GatewaySpectrumStats_Channel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewaySpectrumStats_Channel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewaySpectrumStats_Channel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+func (m *GatewaySpectrumStats_Channel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewaySpectrumStats_Channel.Merge(m, src)
+}
+func (m *GatewaySpectrumStats_Channel) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewaySpectrumStats_Channel) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewaySpectrumStats_Channel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewaySpectrumStats_Channel proto.InternalMessageInfo
+
+func (m *GatewaySpectrumStats_Channel) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetUplinkCount() uint32 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetDownlinkCount() uint32 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetUplinkAirtime() time.Duration {
+	if m != nil {
+		return m.UplinkAirtime
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetDownlinkAirtime() time.Duration {
+	if m != nil {
+		return m.DownlinkAirtime
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetUtilization() float32 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetOverlappingUplinkCount() uint32 {
+	if m != nil {
+		return m.OverlappingUplinkCount
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetCollisionProbability() float32 {
+	if m != nil {
+		return m.CollisionProbability
+	}
+	return 0
+}
+
+func (m *GatewaySpectrumStats_Channel) GetDataRates() []*GatewaySpectrumStats_DataRateStats {
+	if m != nil {
+		return m.DataRates
+	}
+	return nil
+}
+
+func (m *GatewaySpectrumStats_Channel) GetRSSI() []*GatewaySpectrumStats_HistogramBin {
+	if m != nil {
+		return m.RSSI
+	}
+	return nil
+}
+
+func (m *GatewaySpectrumStats_Channel) GetSNR() []*GatewaySpectrumStats_HistogramBin {
+	if m != nil {
+		return m.SNR
+	}
+	return nil
+}
+
+func (m *GatewaySpectrumStats_Channel) GetForeignUplinkCount() uint32 {
+	if m != nil {
+		return m.ForeignUplinkCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.CaptureFormat", CaptureFormat_name, CaptureFormat_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.CaptureFormat", CaptureFormat_name, CaptureFormat_value)
+	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	proto.RegisterType((*ExecuteGatewayCommandRequest)(nil), "ttn.lorawan.v3.ExecuteGatewayCommandRequest")
+	golang_proto.RegisterType((*ExecuteGatewayCommandRequest)(nil), "ttn.lorawan.v3.ExecuteGatewayCommandRequest")
+	proto.RegisterType((*GatewayRemoteShellRequest)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest")
+	golang_proto.RegisterType((*GatewayRemoteShellRequest)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest")
+	proto.RegisterType((*GatewayRemoteShellResponse)(nil), "ttn.lorawan.v3.GatewayRemoteShellResponse")
+	golang_proto.RegisterType((*GatewayRemoteShellResponse)(nil), "ttn.lorawan.v3.GatewayRemoteShellResponse")
+	proto.RegisterType((*GatewayConnectionStatsBucket)(nil), "ttn.lorawan.v3.GatewayConnectionStatsBucket")
+	golang_proto.RegisterType((*GatewayConnectionStatsBucket)(nil), "ttn.lorawan.v3.GatewayConnectionStatsBucket")
+	proto.RegisterType((*GatewayConnectionStatsBucket_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStatsBucket.RoundTripTimes")
+	golang_proto.RegisterType((*GatewayConnectionStatsBucket_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStatsBucket.RoundTripTimes")
+	proto.RegisterType((*GetGatewayConnectionStatsHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionStatsHistoryRequest")
+	golang_proto.RegisterType((*GetGatewayConnectionStatsHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionStatsHistoryRequest")
+	proto.RegisterType((*GatewayConnectionStatsHistory)(nil), "ttn.lorawan.v3.GatewayConnectionStatsHistory")
+	golang_proto.RegisterType((*GatewayConnectionStatsHistory)(nil), "ttn.lorawan.v3.GatewayConnectionStatsHistory")
+	proto.RegisterType((*StartGatewayCaptureRequest)(nil), "ttn.lorawan.v3.StartGatewayCaptureRequest")
+	golang_proto.RegisterType((*StartGatewayCaptureRequest)(nil), "ttn.lorawan.v3.StartGatewayCaptureRequest")
+	proto.RegisterType((*GatewayCapture)(nil), "ttn.lorawan.v3.GatewayCapture")
+	golang_proto.RegisterType((*GatewayCapture)(nil), "ttn.lorawan.v3.GatewayCapture")
+	proto.RegisterType((*DownloadGatewayCaptureRequest)(nil), "ttn.lorawan.v3.DownloadGatewayCaptureRequest")
+	golang_proto.RegisterType((*DownloadGatewayCaptureRequest)(nil), "ttn.lorawan.v3.DownloadGatewayCaptureRequest")
+	proto.RegisterType((*GatewayCaptureFile)(nil), "ttn.lorawan.v3.GatewayCaptureFile")
+	golang_proto.RegisterType((*GatewayCaptureFile)(nil), "ttn.lorawan.v3.GatewayCaptureFile")
+	proto.RegisterType((*GetGatewaySpectrumStatsRequest)(nil), "ttn.lorawan.v3.GetGatewaySpectrumStatsRequest")
+	golang_proto.RegisterType((*GetGatewaySpectrumStatsRequest)(nil), "ttn.lorawan.v3.GetGatewaySpectrumStatsRequest")
+	proto.RegisterType((*GatewaySpectrumStats)(nil), "ttn.lorawan.v3.GatewaySpectrumStats")
+	golang_proto.RegisterType((*GatewaySpectrumStats)(nil), "ttn.lorawan.v3.GatewaySpectrumStats")
+	proto.RegisterType((*GatewaySpectrumStats_HistogramBin)(nil), "ttn.lorawan.v3.GatewaySpectrumStats.HistogramBin")
+	golang_proto.RegisterType((*GatewaySpectrumStats_HistogramBin)(nil), "ttn.lorawan.v3.GatewaySpectrumStats.HistogramBin")
+	proto.RegisterType((*GatewaySpectrumStats_DataRateStats)(nil), "ttn.lorawan.v3.GatewaySpectrumStats.DataRateStats")
+	golang_proto.RegisterType((*GatewaySpectrumStats_DataRateStats)(nil), "ttn.lorawan.v3.GatewaySpectrumStats.DataRateStats")
+	proto.RegisterType((*GatewaySpectrumStats_Channel)(nil), "ttn.lorawan.v3.GatewaySpectrumStats.Channel")
+	golang_proto.RegisterType((*GatewaySpectrumStats_Channel)(nil), "ttn.lorawan.v3.GatewaySpectrumStats.Channel")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+}
+
+var fileDescriptor_62b07a36420f2d6d = []byte{
+	// 2321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0xf0, 0x47, 0x22, 0x1f, 0x25, 0x59, 0x9d, 0xd8, 0xc9, 0x9a, 0x91, 0x57, 0xea, 0x36,
+	0x49, 0x15, 0x23, 0x22, 0x55, 0xb9, 0x75, 0xec, 0xba, 0x4e, 0x2c, 0xea, 0xcf, 0x76, 0x63, 0x59,
+	0x59, 0x4a, 0x05, 0x5a, 0xc0, 0x60, 0x47, 0xe4, 0x88, 0x5a, 0x88, 0xdc, 0xdd, 0xec, 0x0c, 0xf5,
+	0x93, 0xa2, 0x80, 0x91, 0x5e, 0x8c, 0x9e, 0x8c, 0xf6, 0x50, 0x07, 0xb9, 0x14, 0x28, 0x0a, 0x04,
+	0x2d, 0x0a, 0x18, 0xbd, 0x34, 0x40, 0x7b, 0x08, 0xda, 0x8b, 0xd1, 0x5e, 0x8c, 0xf6, 0x12, 0xa0,
+	0x80, 0x12, 0x51, 0x3d, 0xf8, 0x98, 0x53, 0x11, 0xf8, 0x54, 0xec, 0xcc, 0x2c, 0xff, 0x29, 0x51,
+	0x06, 0xdc, 0x1b, 0x77, 0xe6, 0xfb, 0xde, 0xbc, 0xf7, 0xe6, 0xbd, 0x37, 0x6f, 0x86, 0xf0, 0x6a,
+	0xd9, 0xf1, 0xc8, 0x0e, 0xb1, 0xa7, 0x18, 0x27, 0x85, 0xad, 0x0c, 0x71, 0xad, 0x4c, 0x89, 0x70,
+	0xba, 0x43, 0xf6, 0x18, 0xf5, 0xb6, 0xa9, 0x97, 0x76, 0x3d, 0x87, 0x3b, 0x78, 0x84, 0x73, 0x3b,
+	0xad, 0xa0, 0xe9, 0xed, 0x0b, 0xa9, 0xd9, 0x92, 0xc5, 0x37, 0xab, 0xeb, 0xe9, 0x82, 0x53, 0xc9,
+	0x50, 0x7b, 0xdb, 0xd9, 0x73, 0x3d, 0x67, 0x77, 0x2f, 0x23, 0xc0, 0x85, 0xa9, 0x12, 0xb5, 0xa7,
+	0xb6, 0x49, 0xd9, 0x2a, 0x12, 0x4e, 0x33, 0x1d, 0x3f, 0xa4, 0xc8, 0xd4, 0x54, 0x93, 0x88, 0x92,
+	0x53, 0x72, 0x24, 0x79, 0xbd, 0xba, 0x21, 0xbe, 0xc4, 0x87, 0xf8, 0xa5, 0xe0, 0x63, 0x25, 0xc7,
+	0x29, 0x95, 0xa9, 0xd0, 0x90, 0xd8, 0xb6, 0xc3, 0x09, 0xb7, 0x1c, 0x9b, 0xa9, 0x59, 0x5d, 0xcd,
+	0xd6, 0x65, 0x14, 0xab, 0x9e, 0x00, 0xa8, 0xf9, 0x97, 0xdb, 0xe7, 0x69, 0xc5, 0xe5, 0x7b, 0x6a,
+	0x72, 0xbc, 0x7d, 0x92, 0x5b, 0x15, 0xca, 0x38, 0xa9, 0xb8, 0x0a, 0x70, 0xae, 0xd3, 0x49, 0xd4,
+	0xf3, 0x1c, 0x2f, 0xe0, 0xf7, 0xf4, 0xa1, 0x02, 0x7c, 0xa3, 0x13, 0x60, 0x15, 0xa9, 0xcd, 0xad,
+	0x0d, 0x8b, 0x7a, 0xac, 0xb7, 0x94, 0xc0, 0xe1, 0x12, 0x30, 0xd1, 0x09, 0xa8, 0x50, 0xc6, 0x48,
+	0x89, 0x06, 0x22, 0xc6, 0xba, 0x20, 0xde, 0xe3, 0xbc, 0x37, 0xdf, 0xa3, 0x25, 0xcb, 0xb1, 0x49,
+	0x59, 0x22, 0x8c, 0x27, 0x08, 0x12, 0x4b, 0x52, 0xf3, 0x35, 0x17, 0x2f, 0xc2, 0xa9, 0xaa, 0x5b,
+	0xb6, 0xec, 0xad, 0x7c, 0xb0, 0x8c, 0x86, 0x26, 0x22, 0x93, 0xc9, 0x99, 0x73, 0xe9, 0xd6, 0x68,
+	0x48, 0xaf, 0x09, 0xd8, 0x2d, 0x89, 0x32, 0x47, 0xaa, 0xcd, 0x9f, 0x0c, 0xcf, 0xc3, 0x88, 0x72,
+	0x47, 0x9e, 0x71, 0xc2, 0xab, 0x4c, 0x0b, 0x4f, 0xa0, 0x6e, 0x62, 0xd4, 0xd2, 0x39, 0x01, 0x32,
+	0x87, 0x4b, 0xcd, 0x9f, 0xf8, 0x16, 0x7c, 0x8d, 0xef, 0xe6, 0x49, 0x61, 0xcb, 0x76, 0x76, 0xca,
+	0xb4, 0x58, 0xaa, 0x50, 0x9b, 0x6b, 0x11, 0x21, 0x68, 0xa2, 0x5d, 0xd0, 0xea, 0xee, 0x6c, 0x0b,
+	0xce, 0x1c, 0xe5, 0x6d, 0x23, 0xc6, 0x0f, 0x21, 0xa9, 0x96, 0x9b, 0x77, 0x76, 0x6c, 0x7c, 0x13,
+	0x46, 0x8b, 0xce, 0x8e, 0xdd, 0x6c, 0xad, 0x86, 0x84, 0xf0, 0xf1, 0x76, 0xe1, 0xf3, 0x0a, 0x17,
+	0x98, 0x7b, 0xaa, 0xd8, 0x3a, 0x60, 0xdc, 0x01, 0x2d, 0x57, 0xd8, 0xa4, 0xc5, 0x6a, 0x99, 0x06,
+	0x58, 0x93, 0x32, 0xd7, 0xb1, 0x19, 0xc5, 0xb3, 0x10, 0x2b, 0xd2, 0x32, 0xd9, 0x53, 0xc2, 0xcf,
+	0xa6, 0x65, 0xe8, 0xa5, 0x83, 0xd0, 0x4b, 0xcf, 0xab, 0xb8, 0xcd, 0x8e, 0x3e, 0xcd, 0xc6, 0x7e,
+	0x87, 0xc2, 0x71, 0xf4, 0x68, 0x7f, 0x3c, 0xf4, 0xe0, 0xf3, 0x71, 0x64, 0x4a, 0xa6, 0x71, 0x07,
+	0xc6, 0xda, 0xc5, 0x2f, 0xf8, 0xc1, 0x38, 0x4f, 0x39, 0xb1, 0xca, 0x0c, 0x5f, 0x85, 0xa4, 0x4b,
+	0xf8, 0x66, 0x5e, 0x44, 0x68, 0xb0, 0x65, 0x63, 0xed, 0x56, 0x34, 0x53, 0x4c, 0xf0, 0x09, 0x62,
+	0x84, 0x19, 0x7f, 0x47, 0x30, 0xb6, 0xb0, 0x4b, 0x0b, 0x55, 0x4e, 0x95, 0x83, 0xe6, 0x9c, 0x4a,
+	0x85, 0xd8, 0x45, 0x93, 0xbe, 0x57, 0xa5, 0x8c, 0xe3, 0x35, 0x48, 0x06, 0xdb, 0x69, 0x15, 0x99,
+	0x32, 0xc4, 0xe8, 0xb1, 0x97, 0x37, 0x1a, 0x61, 0x2e, 0x2c, 0xfa, 0x39, 0x0a, 0x8f, 0x0a, 0x8b,
+	0x1e, 0xef, 0x8f, 0x23, 0x13, 0x4a, 0x01, 0x8a, 0xe1, 0x57, 0x60, 0xb0, 0x20, 0x17, 0x12, 0xe1,
+	0x91, 0xc8, 0xc2, 0xd3, 0xec, 0xa0, 0x17, 0x1b, 0x45, 0xda, 0xdd, 0xb8, 0x19, 0x4c, 0xe1, 0x29,
+	0x48, 0x10, 0xaf, 0x54, 0xf5, 0xb7, 0x90, 0x69, 0x91, 0x89, 0xc8, 0x64, 0x22, 0x7b, 0xea, 0x69,
+	0x76, 0xe8, 0x17, 0x28, 0x31, 0x7a, 0xcd, 0x88, 0x79, 0x11, 0x1f, 0xdc, 0x40, 0x18, 0x8f, 0x10,
+	0x9c, 0x55, 0x9a, 0x98, 0xb4, 0xe2, 0x70, 0x9a, 0xdb, 0xa4, 0xe5, 0x72, 0x60, 0x49, 0xee, 0x59,
+	0x2d, 0x19, 0xa9, 0xed, 0x8f, 0x43, 0x30, 0x3e, 0xcf, 0x5a, 0xec, 0x78, 0x19, 0xa2, 0x55, 0x46,
+	0x3d, 0x65, 0xc4, 0xe0, 0xd3, 0x6c, 0xd4, 0x0b, 0x6b, 0xd7, 0x4c, 0x31, 0xe8, 0x4f, 0x72, 0xea,
+	0x55, 0xb4, 0x48, 0xdb, 0xa4, 0x3f, 0x88, 0xc7, 0x20, 0x5a, 0x24, 0x9c, 0x68, 0xd1, 0x09, 0x34,
+	0x39, 0x94, 0x8d, 0x3f, 0xcd, 0xc6, 0xde, 0x8f, 0x68, 0x77, 0x27, 0x4c, 0x31, 0x6a, 0x4c, 0x43,
+	0xaa, 0x9b, 0x25, 0x2a, 0xae, 0xb0, 0xe2, 0xfa, 0x36, 0x0c, 0x29, 0xc6, 0x7f, 0x07, 0x60, 0xac,
+	0xbe, 0x85, 0xb6, 0x4d, 0x0b, 0x7e, 0x5c, 0xf9, 0xd9, 0xc4, 0xb2, 0xd5, 0xc2, 0x16, 0xe5, 0x38,
+	0x0b, 0x31, 0xc6, 0x89, 0xc7, 0x95, 0xe5, 0xa9, 0x8e, 0x60, 0x5c, 0x0d, 0xea, 0xa0, 0xd8, 0xbb,
+	0x3f, 0x06, 0xd1, 0x78, 0x5f, 0x44, 0xa3, 0xa0, 0xe2, 0x25, 0x88, 0x07, 0xa5, 0x56, 0x0b, 0x9f,
+	0x3c, 0xa6, 0xeb, 0x64, 0xfc, 0x75, 0x18, 0x52, 0xd5, 0xa6, 0xe0, 0x54, 0x55, 0x6a, 0x47, 0xcd,
+	0xa4, 0x1c, 0x9b, 0xf3, 0x87, 0xf0, 0xab, 0x30, 0x52, 0x4f, 0x52, 0x09, 0x8a, 0x0a, 0xd0, 0x70,
+	0x30, 0x2a, 0x61, 0x17, 0xe1, 0xa5, 0x8e, 0x4a, 0xa1, 0xf0, 0x31, 0x81, 0x3f, 0xd3, 0x5e, 0x0d,
+	0x24, 0x6f, 0x01, 0xc6, 0x3b, 0x79, 0x1b, 0xc4, 0x2a, 0x57, 0x3d, 0xaa, 0xf8, 0x03, 0x82, 0x3f,
+	0xd6, 0xce, 0x5f, 0x94, 0x20, 0x29, 0x86, 0xc2, 0xa8, 0xe7, 0x54, 0xed, 0x62, 0x9e, 0x7b, 0x96,
+	0x9b, 0x17, 0x47, 0x89, 0x36, 0x28, 0x3c, 0x73, 0xa5, 0x47, 0x68, 0x75, 0xdd, 0x9d, 0xb4, 0xe9,
+	0x0b, 0x59, 0xf5, 0x2c, 0x57, 0xec, 0x82, 0x39, 0xe2, 0xb5, 0x7c, 0xe3, 0xef, 0x43, 0x82, 0x55,
+	0xd7, 0xf3, 0xeb, 0xc4, 0x2e, 0x32, 0x2d, 0x2e, 0x92, 0x3c, 0xdd, 0x9f, 0xfc, 0x74, 0xae, 0xba,
+	0x9e, 0xf5, 0x13, 0x3a, 0xce, 0xe4, 0x0f, 0x96, 0xfa, 0x47, 0x18, 0x46, 0x5a, 0xd7, 0xc3, 0x57,
+	0x21, 0x52, 0xb1, 0xec, 0x67, 0xa9, 0x53, 0x3e, 0x4f, 0xd0, 0xc9, 0xee, 0xb3, 0x84, 0x84, 0xcf,
+	0xc3, 0x73, 0x30, 0x50, 0xa1, 0x45, 0x8b, 0xd8, 0x5a, 0xe4, 0xe4, 0x12, 0x14, 0x15, 0xff, 0x18,
+	0x86, 0x5d, 0xea, 0x15, 0xfc, 0xbc, 0x2d, 0xd3, 0xfc, 0xe5, 0x69, 0x2d, 0x7a, 0x9c, 0xac, 0x89,
+	0x66, 0x59, 0xb5, 0xfd, 0xf1, 0xa1, 0x95, 0x3a, 0xff, 0xf2, 0xb4, 0x90, 0x3d, 0xe4, 0x36, 0x8d,
+	0xe0, 0xd3, 0x10, 0x6b, 0x04, 0xd6, 0xb0, 0x29, 0x3f, 0x8c, 0xbf, 0x84, 0xe1, 0x9b, 0x4b, 0x94,
+	0x77, 0xf7, 0xfe, 0x75, 0x8b, 0x71, 0xc7, 0xdb, 0x7b, 0xce, 0xd5, 0xf4, 0x22, 0xc4, 0xc8, 0x06,
+	0x57, 0x65, 0xe8, 0xe8, 0xd4, 0x8e, 0xca, 0x74, 0x16, 0x70, 0x7c, 0x09, 0x06, 0xd6, 0xe9, 0x86,
+	0xe3, 0x51, 0x2d, 0xd2, 0x27, 0x51, 0xe1, 0xf1, 0x75, 0x38, 0xb5, 0x2e, 0x02, 0x37, 0x5f, 0xaf,
+	0x07, 0xc7, 0xba, 0x3b, 0x2a, 0x5c, 0x3a, 0x22, 0x79, 0xc1, 0xa8, 0x51, 0x82, 0x73, 0x47, 0xba,
+	0x0e, 0x2f, 0xc2, 0xa0, 0xa4, 0x04, 0xa7, 0xdb, 0x1b, 0x27, 0x49, 0x2c, 0x33, 0x20, 0x1b, 0xff,
+	0x46, 0x90, 0xca, 0xf9, 0x55, 0x2c, 0x80, 0x13, 0x97, 0x57, 0x3d, 0xfa, 0x9c, 0xb7, 0xe6, 0xca,
+	0x49, 0x2a, 0x66, 0xb4, 0xad, 0x4a, 0xbe, 0x0e, 0xc9, 0x0a, 0xd9, 0xcd, 0xbb, 0x44, 0x9a, 0xef,
+	0x6f, 0xd2, 0xb0, 0x38, 0x2a, 0xce, 0x47, 0xb4, 0xfb, 0xcb, 0x26, 0x54, 0xc8, 0xee, 0x8a, 0x9c,
+	0x33, 0x3e, 0x0c, 0xc3, 0x48, 0xab, 0x61, 0x78, 0x0e, 0x40, 0x54, 0x6d, 0x5a, 0xcc, 0x93, 0x7e,
+	0xaa, 0x7e, 0xbc, 0x5e, 0xed, 0x13, 0x8a, 0x37, 0xcb, 0xf1, 0x55, 0x18, 0xa4, 0x76, 0x91, 0xf9,
+	0x12, 0xc2, 0x27, 0x90, 0x30, 0xe0, 0x93, 0x66, 0x39, 0x7e, 0xdb, 0xd7, 0xc1, 0x71, 0x5d, 0xa9,
+	0x43, 0xbf, 0x51, 0x96, 0x50, 0x9c, 0x59, 0xee, 0x1f, 0x14, 0xd2, 0xfc, 0xa6, 0x33, 0x60, 0xd8,
+	0x4c, 0xca, 0x31, 0x59, 0x82, 0xc7, 0x5b, 0xbd, 0x24, 0x93, 0xb3, 0xd9, 0x37, 0x7f, 0x42, 0x70,
+	0x4e, 0x34, 0x4f, 0x0e, 0x29, 0xfe, 0x5f, 0x37, 0xff, 0x6d, 0x18, 0xd8, 0x70, 0xbc, 0x8a, 0xf2,
+	0xdd, 0x48, 0x67, 0x0f, 0xac, 0xd4, 0x58, 0x14, 0x20, 0xb1, 0xb3, 0x1f, 0xf8, 0xc2, 0x4c, 0x45,
+	0x33, 0x3e, 0x44, 0x80, 0x5b, 0x35, 0x5e, 0xb4, 0xca, 0x14, 0x5f, 0x82, 0xc1, 0x82, 0xfc, 0x54,
+	0xaa, 0xea, 0xbd, 0x52, 0x42, 0x99, 0x19, 0xc0, 0xf1, 0x77, 0x4e, 0xa4, 0x51, 0xa0, 0x47, 0xbd,
+	0xe1, 0x88, 0x34, 0x35, 0x1c, 0x0f, 0x11, 0xe8, 0x8d, 0xba, 0x97, 0x73, 0x69, 0x81, 0x7b, 0xd5,
+	0x8a, 0x48, 0xbd, 0xe7, 0xec, 0xd6, 0x37, 0x61, 0x60, 0xc7, 0xb2, 0x8b, 0xce, 0x4e, 0xbf, 0x19,
+	0xa5, 0xe0, 0xc6, 0xdf, 0x12, 0x70, 0xba, 0x9b, 0xbe, 0x78, 0x09, 0x86, 0x24, 0x24, 0xdf, 0x6f,
+	0x8b, 0xd4, 0x08, 0xf5, 0xa4, 0x64, 0x8a, 0xd2, 0xe2, 0xe7, 0x9c, 0x12, 0x44, 0x55, 0x6b, 0xdb,
+	0x77, 0xce, 0x49, 0xde, 0x82, 0x5d, 0xc4, 0xd7, 0x21, 0x5e, 0xd8, 0x24, 0xb6, 0x4d, 0xcb, 0xb2,
+	0xeb, 0xed, 0x5d, 0xf2, 0x5a, 0xac, 0x48, 0xcf, 0x49, 0x92, 0x59, 0x67, 0xa7, 0x56, 0x60, 0x48,
+	0x94, 0xd1, 0x92, 0x47, 0x2a, 0x59, 0xcb, 0xf6, 0x4f, 0xb0, 0xb2, 0xb3, 0x43, 0x3d, 0x61, 0x60,
+	0xd8, 0x94, 0x1f, 0xfe, 0x68, 0xd5, 0x75, 0xd5, 0xf1, 0x11, 0x36, 0xe5, 0x47, 0xe3, 0xb4, 0x8b,
+	0x34, 0x9d, 0x76, 0xa9, 0x3f, 0x23, 0x18, 0x9e, 0x27, 0x9c, 0x98, 0x84, 0x53, 0xe9, 0xbb, 0x2b,
+	0x90, 0xf0, 0xe3, 0x21, 0xef, 0x11, 0x1e, 0x84, 0xa3, 0xd6, 0x71, 0x8b, 0x52, 0x8c, 0x6c, 0xd4,
+	0xb7, 0xd7, 0x8c, 0x17, 0xd5, 0x77, 0x47, 0x1f, 0x18, 0x96, 0xe9, 0xdd, 0xdc, 0x07, 0xde, 0x04,
+	0x75, 0xc5, 0xcc, 0x13, 0xcb, 0xf3, 0x1b, 0xac, 0xe3, 0x9b, 0x84, 0x78, 0xbd, 0x39, 0x18, 0x96,
+	0xd4, 0x59, 0xc9, 0x4c, 0xfd, 0x35, 0x06, 0x83, 0xca, 0x4b, 0x78, 0x0c, 0x12, 0x1b, 0x9e, 0x1f,
+	0xa8, 0x76, 0x41, 0x5e, 0xd0, 0xa2, 0x66, 0x63, 0xa0, 0x1f, 0xc5, 0x3a, 0x1b, 0x54, 0xe9, 0xa9,
+	0xb6, 0x06, 0xb5, 0x53, 0xff, 0xe8, 0xb3, 0xea, 0x8f, 0x97, 0x9b, 0x2e, 0xae, 0x81, 0xb4, 0x58,
+	0xff, 0xd2, 0xea, 0x97, 0xd7, 0x40, 0xde, 0x04, 0x24, 0xab, 0xdc, 0x2a, 0x5b, 0xef, 0xcb, 0x03,
+	0x6a, 0x40, 0xec, 0x7f, 0xf3, 0x10, 0xbe, 0x04, 0x9a, 0xb3, 0x4d, 0xbd, 0x32, 0x71, 0x5d, 0xcb,
+	0x2e, 0xe5, 0x5b, 0x7c, 0x32, 0x28, 0xcc, 0x7d, 0xb1, 0x69, 0x7e, 0xad, 0xc9, 0x3d, 0x17, 0xe0,
+	0x4c, 0xc1, 0x29, 0x97, 0x2d, 0x66, 0x39, 0x76, 0xde, 0xf5, 0x9c, 0x75, 0xb2, 0x6e, 0x95, 0x2d,
+	0xbe, 0xa7, 0xc5, 0xc5, 0x2a, 0xa7, 0xeb, 0x93, 0x2b, 0x8d, 0x39, 0xfc, 0x2e, 0x40, 0x3d, 0x98,
+	0x98, 0x96, 0x10, 0xc1, 0x3f, 0xd3, 0x57, 0xf0, 0xb7, 0x04, 0xa5, 0x99, 0x08, 0x22, 0x8c, 0xe1,
+	0xdb, 0x10, 0xf5, 0x18, 0xb3, 0x34, 0x10, 0xc2, 0xbe, 0xd5, 0x97, 0xb0, 0xe6, 0xa4, 0xc9, 0xc6,
+	0x6b, 0xfb, 0xe3, 0x51, 0x33, 0x97, 0xbb, 0x61, 0x0a, 0x41, 0xf8, 0x1d, 0x88, 0x30, 0xdb, 0xd3,
+	0x92, 0xcf, 0x2a, 0x6f, 0xb0, 0xb6, 0x3f, 0x1e, 0xc9, 0x2d, 0x9b, 0xa6, 0x2f, 0x06, 0x4f, 0xc3,
+	0x69, 0xbf, 0xa3, 0xb2, 0x4a, 0x76, 0xab, 0x73, 0x87, 0x84, 0x73, 0xb1, 0x9a, 0x6b, 0x72, 0xec,
+	0xf9, 0x22, 0x0c, 0xb7, 0x54, 0x69, 0x7c, 0x16, 0xce, 0xcc, 0xcd, 0xae, 0xac, 0xae, 0x99, 0x0b,
+	0xf9, 0xc5, 0xdb, 0xe6, 0xad, 0xd9, 0xd5, 0xfc, 0xca, 0xdc, 0xec, 0xca, 0xf2, 0xd2, 0x68, 0x08,
+	0xbf, 0x04, 0x2f, 0x74, 0x99, 0x1a, 0x45, 0x5d, 0x38, 0xcb, 0xf3, 0x37, 0x73, 0xb7, 0x97, 0x47,
+	0xc3, 0xa9, 0xe8, 0xbd, 0xdf, 0xe8, 0xa1, 0x99, 0xcf, 0x23, 0x10, 0x5b, 0xe2, 0x3b, 0x4b, 0x0c,
+	0xdf, 0x80, 0xe4, 0x3b, 0x96, 0xbd, 0xa5, 0x0c, 0xc3, 0x67, 0x7b, 0x58, 0xbc, 0xe6, 0xa6, 0x5e,
+	0xee, 0x31, 0xe5, 0x1f, 0xbe, 0x93, 0x68, 0x1a, 0xe1, 0x1c, 0x9c, 0x59, 0xf2, 0x8f, 0x6d, 0xdb,
+	0x6f, 0xaa, 0x3d, 0xc2, 0x1d, 0x6f, 0xce, 0xb1, 0x37, 0xac, 0x12, 0x7e, 0xb1, 0x23, 0x7c, 0x17,
+	0xfc, 0x27, 0xbb, 0x54, 0xc7, 0x61, 0xd1, 0x85, 0xfb, 0x2b, 0x24, 0xa4, 0xde, 0x7a, 0x77, 0x75,
+	0xb5, 0xd1, 0x02, 0xde, 0xb0, 0x37, 0x1c, 0xdc, 0xc7, 0x51, 0xd3, 0xb9, 0x42, 0xa7, 0x1c, 0xe3,
+	0xe2, 0x07, 0xff, 0xfa, 0xcf, 0x2f, 0xc3, 0xd3, 0x38, 0x9d, 0x29, 0xb1, 0xfa, 0x83, 0x69, 0xe6,
+	0x27, 0x8d, 0xb3, 0xed, 0xa7, 0xe2, 0xe5, 0x6d, 0xaa, 0x50, 0xa7, 0x4d, 0x59, 0xfe, 0xfa, 0x1f,
+	0x21, 0x78, 0x49, 0x69, 0xf6, 0x83, 0x99, 0xe7, 0xa4, 0xdb, 0x25, 0xa1, 0xdb, 0x0c, 0x9e, 0x3e,
+	0x5a, 0xb7, 0xed, 0x99, 0x76, 0xed, 0x66, 0x28, 0x44, 0x97, 0xd9, 0x12, 0xc3, 0x77, 0x60, 0xb4,
+	0xfd, 0x89, 0x09, 0x1f, 0xf7, 0x0e, 0x96, 0x9a, 0x6c, 0x07, 0xf4, 0x7a, 0x04, 0x9b, 0xf9, 0x19,
+	0x40, 0x78, 0x89, 0xf9, 0xbe, 0x38, 0xdb, 0xf3, 0x9a, 0xd4, 0x97, 0x37, 0x5e, 0xeb, 0xaf, 0xef,
+	0x37, 0x66, 0x84, 0x47, 0xde, 0xc0, 0xe7, 0x7b, 0x7b, 0xa4, 0xe1, 0x8a, 0x0c, 0x13, 0xeb, 0x3f,
+	0x40, 0x70, 0xa6, 0xeb, 0x3b, 0x18, 0xee, 0x38, 0x7a, 0x8f, 0x7a, 0x2e, 0x4b, 0xf5, 0x88, 0x63,
+	0xe3, 0xb2, 0xd0, 0xe9, 0x82, 0xd1, 0x33, 0x82, 0x58, 0xba, 0x55, 0x3f, 0x21, 0x95, 0x7d, 0x17,
+	0x9d, 0xc7, 0x9b, 0x90, 0x6c, 0x7a, 0x03, 0xc2, 0xaf, 0xf7, 0xf0, 0x42, 0xe7, 0x8b, 0x57, 0xea,
+	0x7c, 0x3f, 0x50, 0xb9, 0x4b, 0x22, 0x3b, 0xff, 0x89, 0x60, 0xe2, 0xb8, 0x9b, 0x2c, 0x7e, 0xb3,
+	0x43, 0x68, 0x7f, 0x77, 0xdf, 0xd4, 0x54, 0x7f, 0xdb, 0xa7, 0x58, 0xc6, 0xa2, 0xf0, 0xd8, 0x35,
+	0xfc, 0x56, 0xbf, 0x1e, 0x6b, 0xdd, 0xd1, 0xcc, 0xa6, 0xd2, 0xf7, 0x23, 0x04, 0x2f, 0x74, 0xb9,
+	0xf6, 0xe1, 0x0e, 0xe7, 0xf4, 0xbe, 0x1b, 0xa6, 0x8e, 0x69, 0xaf, 0x83, 0x1c, 0x34, 0xa6, 0xfa,
+	0xd4, 0x55, 0xd2, 0xfc, 0xcd, 0xbd, 0x87, 0x00, 0xe7, 0xb8, 0xe3, 0xb6, 0x29, 0xd7, 0x4f, 0x3a,
+	0x1c, 0xa7, 0x54, 0x5a, 0x28, 0x35, 0x69, 0xbc, 0x76, 0x44, 0x1a, 0x48, 0x68, 0xc6, 0xbf, 0x6e,
+	0xe1, 0xdf, 0x23, 0x78, 0xb1, 0xfb, 0x2d, 0x09, 0x4f, 0x75, 0xad, 0x06, 0xbd, 0x6e, 0x53, 0x29,
+	0xe3, 0x68, 0xcd, 0xfc, 0x2b, 0x8c, 0xf1, 0x96, 0xd0, 0xee, 0x12, 0xbe, 0x78, 0x22, 0x97, 0x65,
+	0x8a, 0x6a, 0x61, 0xfc, 0x07, 0x59, 0x5a, 0xbb, 0x76, 0xf3, 0xe9, 0xde, 0x21, 0xda, 0xed, 0x9a,
+	0x92, 0x7a, 0xa5, 0x9f, 0x33, 0xdc, 0xf8, 0x9e, 0xd0, 0xf8, 0x22, 0xfe, 0x76, 0x7f, 0x1a, 0x33,
+	0x45, 0x96, 0xe1, 0x98, 0xfd, 0x2d, 0x7a, 0x74, 0xa0, 0xa3, 0xc7, 0x07, 0x3a, 0xfa, 0xec, 0x40,
+	0x0f, 0x7d, 0x71, 0xa0, 0x87, 0x9e, 0x1c, 0xe8, 0xa1, 0x2f, 0x0f, 0xf4, 0xd0, 0x57, 0x07, 0x3a,
+	0xba, 0x5b, 0xd3, 0xd1, 0xbd, 0x9a, 0x1e, 0xfa, 0xb8, 0xa6, 0xa3, 0x87, 0x35, 0x3d, 0xf4, 0x49,
+	0x4d, 0x0f, 0x7d, 0x5a, 0xd3, 0x43, 0x8f, 0x6a, 0x3a, 0x7a, 0x5c, 0xd3, 0xd1, 0x67, 0x35, 0x3d,
+	0xf4, 0x45, 0x4d, 0x47, 0x4f, 0x6a, 0x7a, 0xe8, 0xcb, 0x9a, 0x8e, 0xbe, 0xaa, 0xe9, 0xa1, 0xbb,
+	0x87, 0x7a, 0xe8, 0xde, 0xa1, 0x8e, 0xee, 0x1f, 0xea, 0xa1, 0x07, 0x87, 0x3a, 0xfa, 0xf5, 0xa1,
+	0x1e, 0xfa, 0xf8, 0x50, 0x0f, 0x3d, 0x3c, 0xd4, 0xd1, 0x27, 0x87, 0x3a, 0xfa, 0xf4, 0x50, 0x47,
+	0x3f, 0xca, 0x94, 0x9c, 0x34, 0xdf, 0xa4, 0x7c, 0xd3, 0xb2, 0x4b, 0x2c, 0x6d, 0x53, 0xbe, 0xe3,
+	0x78, 0x5b, 0x99, 0xd6, 0xbf, 0x86, 0xb6, 0x2f, 0x64, 0xdc, 0xad, 0x52, 0x86, 0x73, 0xdb, 0x5d,
+	0x5f, 0x1f, 0x10, 0x85, 0xeb, 0xc2, 0xff, 0x06, 0x00, 0xe3, 0xee, 0xa8, 0xd3, 0x2a, 0x1c, 0x00,
+	0x00,
+}
+
+func (x CaptureFormat) String() string {
+	s, ok := CaptureFormat_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *GatewayUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUp)
+	if !ok {
+		that2, ok := that.(GatewayUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
+		return false
+	}
+	for i := range this.UplinkMessages {
+		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
+			return false
+		}
+	}
+	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
+		return false
+	}
+	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
+		return false
+	}
+	return true
+}
+func (this *GatewayDown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayDown)
+	if !ok {
+		that2, ok := that.(GatewayDown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
+}
+func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleDownlinkResponse)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delay != that1.Delay {
+		return false
+	}
+	return true
+}
+func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleDownlinkErrorDetails)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkErrorDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PathErrors) != len(that1.PathErrors) {
+		return false
+	}
+	for i := range this.PathErrors {
+		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExecuteGatewayCommandRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecuteGatewayCommandRequest)
+	if !ok {
+		that2, ok := that.(ExecuteGatewayCommandRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Command != that1.Command {
+		return false
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if this.Arguments[i] != that1.Arguments[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayRemoteShellRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayRemoteShellRequest)
+	if !ok {
+		that2, ok := that.(GatewayRemoteShellRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIDs.Equal(that1.GatewayIDs) {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Term != that1.Term {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *GatewayRemoteShellResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayRemoteShellResponse)
+	if !ok {
+		that2, ok := that.(GatewayRemoteShellResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionStatsBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStatsBucket)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStatsBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if this.TxAcknowledgmentCount != that1.TxAcknowledgmentCount {
+		return false
+	}
+	if this.TxAcknowledgmentFailureCount != that1.TxAcknowledgmentFailureCount {
+		return false
+	}
+	if !this.RoundTripTimes.Equal(that1.RoundTripTimes) {
+		return false
+	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayConnectionStatsBucket_RoundTripTimes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStatsBucket_RoundTripTimes)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStatsBucket_RoundTripTimes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Median != that1.Median {
+		return false
+	}
+	if this.Percentile90 != that1.Percentile90 {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *GetGatewayConnectionStatsHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetGatewayConnectionStatsHistoryRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayConnectionStatsHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if that1.After == nil {
+		if this.After != nil {
+			return false
+		}
+	} else if !this.After.Equal(*that1.After) {
+		return false
+	}
+	if that1.Before == nil {
+		if this.Before != nil {
+			return false
+		}
+	} else if !this.Before.Equal(*that1.Before) {
+		return false
+	}
+	if this.BucketDuration != nil && that1.BucketDuration != nil {
+		if *this.BucketDuration != *that1.BucketDuration {
+			return false
+		}
+	} else if this.BucketDuration != nil {
+		return false
+	} else if that1.BucketDuration != nil {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionStatsHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStatsHistory)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStatsHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(that1.Buckets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StartGatewayCaptureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartGatewayCaptureRequest)
+	if !ok {
+		that2, ok := that.(StartGatewayCaptureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return false
+		}
+	} else if this.Duration != nil {
+		return false
+	} else if that1.Duration != nil {
+		return false
+	}
+	if this.MaxPackets != that1.MaxPackets {
+		return false
+	}
+	return true
+}
+func (this *GatewayCapture) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayCapture)
+	if !ok {
+		that2, ok := that.(GatewayCapture)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StartedAt.Equal(that1.StartedAt) {
+		return false
+	}
+	if !this.EndsAt.Equal(that1.EndsAt) {
+		return false
+	}
+	if that1.StoppedAt == nil {
+		if this.StoppedAt != nil {
+			return false
+		}
+	} else if !this.StoppedAt.Equal(*that1.StoppedAt) {
+		return false
+	}
+	if this.PacketCount != that1.PacketCount {
+		return false
+	}
+	if this.MaxPackets != that1.MaxPackets {
+		return false
+	}
+	return true
+}
+func (this *DownloadGatewayCaptureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownloadGatewayCaptureRequest)
+	if !ok {
+		that2, ok := that.(DownloadGatewayCaptureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	return true
+}
+func (this *GatewayCaptureFile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayCaptureFile)
+	if !ok {
+		that2, ok := that.(GatewayCaptureFile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Capture.Equal(that1.Capture) {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *GetGatewaySpectrumStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetGatewaySpectrumStatsRequest)
+	if !ok {
+		that2, ok := that.(GetGatewaySpectrumStatsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Window != nil && that1.Window != nil {
+		if *this.Window != *that1.Window {
+			return false
+		}
+	} else if this.Window != nil {
+		return false
+	} else if that1.Window != nil {
+		return false
+	}
+	return true
+}
+func (this *GatewaySpectrumStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewaySpectrumStats)
+	if !ok {
+		that2, ok := that.(GatewaySpectrumStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if !this.WindowEnd.Equal(that1.WindowEnd) {
+		return false
+	}
+	if len(this.Channels) != len(that1.Channels) {
+		return false
+	}
+	for i := range this.Channels {
+		if !this.Channels[i].Equal(that1.Channels[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewaySpectrumStats_HistogramBin) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewaySpectrumStats_HistogramBin)
+	if !ok {
+		that2, ok := that.(GatewaySpectrumStats_HistogramBin)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Lower != that1.Lower {
+		return false
+	}
+	if this.Upper != that1.Upper {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *GatewaySpectrumStats_DataRateStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewaySpectrumStats_DataRateStats)
+	if !ok {
+		that2, ok := that.(GatewaySpectrumStats_DataRateStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DataRate.Equal(&that1.DataRate) {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.UplinkAirtime != that1.UplinkAirtime {
+		return false
+	}
+	return true
+}
+func (this *GatewaySpectrumStats_Channel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewaySpectrumStats_Channel)
+	if !ok {
+		that2, ok := that.(GatewaySpectrumStats_Channel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if this.UplinkAirtime != that1.UplinkAirtime {
+		return false
+	}
+	if this.DownlinkAirtime != that1.DownlinkAirtime {
+		return false
+	}
+	if this.Utilization != that1.Utilization {
+		return false
+	}
+	if this.OverlappingUplinkCount != that1.OverlappingUplinkCount {
+		return false
+	}
+	if this.CollisionProbability != that1.CollisionProbability {
+		return false
+	}
+	if len(this.DataRates) != len(that1.DataRates) {
+		return false
+	}
+	for i := range this.DataRates {
+		if !this.DataRates[i].Equal(that1.DataRates[i]) {
+			return false
+		}
+	}
+	if len(this.RSSI) != len(that1.RSSI) {
+		return false
+	}
+	for i := range this.RSSI {
+		if !this.RSSI[i].Equal(that1.RSSI[i]) {
+			return false
+		}
+	}
+	if len(this.SNR) != len(that1.SNR) {
+		return false
+	}
+	for i := range this.SNR {
+		if !this.SNR[i].Equal(that1.SNR[i]) {
+			return false
+		}
+	}
+	if this.ForeignUplinkCount != that1.ForeignUplinkCount {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+}
+
+type gtwGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
+	return &gtwGsClient{cc}
+}
+
+func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gtwGsLinkGatewayClient{stream}
+	return x, nil
+}
+
+type GtwGs_LinkGatewayClient interface {
+	Send(*GatewayUp) error
+	Recv() (*GatewayDown, error)
+	grpc.ClientStream
+}
+
+type gtwGsLinkGatewayClient struct {
+	grpc.ClientStream
+}
+
+func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
+	m := new(GatewayDown)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
+	out := new(ConcentratorConfig)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GtwGsServer is the server API for GtwGs service.
+type GtwGsServer interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(GtwGs_LinkGatewayServer) error
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+}
+
+// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGtwGsServer struct {
+}
+
+func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
+	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+}
+func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+}
+
+func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
+	s.RegisterService(&_GtwGs_serviceDesc, srv)
+}
+
+func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+}
+
+type GtwGs_LinkGatewayServer interface {
+	Send(*GatewayDown) error
+	Recv() (*GatewayUp, error)
+	grpc.ServerStream
+}
+
+type gtwGsLinkGatewayServer struct {
+	grpc.ServerStream
+}
+
+func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
+	m := new(GatewayUp)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GtwGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GtwGs",
+	HandlerType: (*GtwGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConcentratorConfig",
+			Handler:    _GtwGs_GetConcentratorConfig_Handler,
+		},
+		{
+			MethodName: "GetMQTTConnectionInfo",
+			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
+		},
+		{
+			MethodName: "GetMQTTV2ConnectionInfo",
+			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LinkGateway",
+			Handler:       _GtwGs_LinkGateway_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// NsGsClient is the client API for NsGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsGsClient interface {
+	// Instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+}
+
+type nsGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
+	return &nsGsClient{cc}
+}
+
+func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
+	out := new(ScheduleDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsGsServer is the server API for NsGs service.
+type NsGsServer interface {
+	// Instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+}
+
+// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
+type UnimplementedNsGsServer struct {
+}
+
+func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+}
+
+func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
+	s.RegisterService(&_NsGs_serviceDesc, srv)
+}
+
+func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsGs",
+	HandlerType: (*NsGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleDownlink",
+			Handler:    _NsGs_ScheduleDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// GsClient is the client API for Gs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsClient interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// Execute a command on the gateway. The gateway must be connected and support remote commands.
+	// The command runs asynchronously on the gateway; its output is not returned.
+	ExecuteGatewayCommand(ctx context.Context, in *ExecuteGatewayCommandRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Open a remote shell session on the gateway. The gateway must be connected and support remote shells.
+	// The first message of the stream must contain the gateway identifiers.
+	// The remote shell session is closed when the stream is closed.
+	RemoteShell(ctx context.Context, opts ...grpc.CallOption) (Gs_RemoteShellClient, error)
+	// Get the historical connection stats of the gateway.
+	// The Gateway Server only keeps historical connection stats if enabled in the configuration.
+	GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error)
+	// Start capturing the traffic of the gateway. The gateway must be connected.
+	// The capture records the raw frontend frames and the decoded messages until it is stopped, until the duration
+	// passed or until the maximum number of packets is captured. Starting a capture replaces the previous capture.
+	StartGatewayCapture(ctx context.Context, in *StartGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCapture, error)
+	// Stop capturing the traffic of the gateway.
+	StopGatewayCapture(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayCapture, error)
+	// Download the captured traffic of the gateway in the given format.
+	// Captures are available for download until one hour after the end of the capture duration.
+	DownloadGatewayCapture(ctx context.Context, in *DownloadGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCaptureFile, error)
+	// Get the spectrum and channel occupancy stats of the gateway, aggregated per channel over a sliding window.
+	// The gateway must be connected. The stats are not persisted between reconnects.
+	GetGatewaySpectrumStats(ctx context.Context, in *GetGatewaySpectrumStatsRequest, opts ...grpc.CallOption) (*GatewaySpectrumStats, error)
+}
+
+type gsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsClient(cc *grpc.ClientConn) GsClient {
+	return &gsClient{cc}
+}
+
+func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
+	out := new(GatewayConnectionStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) ExecuteGatewayCommand(ctx context.Context, in *ExecuteGatewayCommandRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/ExecuteGatewayCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) RemoteShell(ctx context.Context, opts ...grpc.CallOption) (Gs_RemoteShellClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gs_serviceDesc.Streams[0], "/ttn.lorawan.v3.Gs/RemoteShell", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsRemoteShellClient{stream}
+	return x, nil
+}
+
+type Gs_RemoteShellClient interface {
+	Send(*GatewayRemoteShellRequest) error
+	Recv() (*GatewayRemoteShellResponse, error)
+	grpc.ClientStream
+}
+
+type gsRemoteShellClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsRemoteShellClient) Send(m *GatewayRemoteShellRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gsRemoteShellClient) Recv() (*GatewayRemoteShellResponse, error) {
+	m := new(GatewayRemoteShellResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gsClient) GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error) {
+	out := new(GatewayConnectionStatsHistory)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) StartGatewayCapture(ctx context.Context, in *StartGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCapture, error) {
+	out := new(GatewayCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/StartGatewayCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) StopGatewayCapture(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayCapture, error) {
+	out := new(GatewayCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/StopGatewayCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) DownloadGatewayCapture(ctx context.Context, in *DownloadGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCaptureFile, error) {
+	out := new(GatewayCaptureFile)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/DownloadGatewayCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GetGatewaySpectrumStats(ctx context.Context, in *GetGatewaySpectrumStatsRequest, opts ...grpc.CallOption) (*GatewaySpectrumStats, error) {
+	out := new(GatewaySpectrumStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewaySpectrumStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsServer is the server API for Gs service.
+type GsServer interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// Execute a command on the gateway. The gateway must be connected and support remote commands.
+	// The command runs asynchronously on the gateway; its output is not returned.
+	ExecuteGatewayCommand(context.Context, *ExecuteGatewayCommandRequest) (*types.Empty, error)
+	// Open a remote shell session on the gateway. The gateway must be connected and support remote shells.
+	// The first message of the stream must contain the gateway identifiers.
+	// The remote shell session is closed when the stream is closed.
+	RemoteShell(Gs_RemoteShellServer) error
+	// Get the historical connection stats of the gateway.
+	// The Gateway Server only keeps historical connection stats if enabled in the configuration.
+	GetGatewayConnectionStatsHistory(context.Context, *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error)
+	// Start capturing the traffic of the gateway. The gateway must be connected.
+	// The capture records the raw frontend frames and the decoded messages until it is stopped, until the duration
+	// passed or until the maximum number of packets is captured. Starting a capture replaces the previous capture.
+	StartGatewayCapture(context.Context, *StartGatewayCaptureRequest) (*GatewayCapture, error)
+	// Stop capturing the traffic of the gateway.
+	StopGatewayCapture(context.Context, *GatewayIdentifiers) (*GatewayCapture, error)
+	// Download the captured traffic of the gateway in the given format.
+	// Captures are available for download until one hour after the end of the capture duration.
+	DownloadGatewayCapture(context.Context, *DownloadGatewayCaptureRequest) (*GatewayCaptureFile, error)
+	// Get the spectrum and channel occupancy stats of the gateway, aggregated per channel over a sliding window.
+	// The gateway must be connected. The stats are not persisted between reconnects.
+	GetGatewaySpectrumStats(context.Context, *GetGatewaySpectrumStatsRequest) (*GatewaySpectrumStats, error)
+}
+
+// UnimplementedGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGsServer struct {
+}
+
+func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+}
+func (*UnimplementedGsServer) ExecuteGatewayCommand(ctx context.Context, req *ExecuteGatewayCommandRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGatewayCommand not implemented")
+}
+func (*UnimplementedGsServer) RemoteShell(srv Gs_RemoteShellServer) error {
+	return status.Errorf(codes.Unimplemented, "method RemoteShell not implemented")
+}
+func (*UnimplementedGsServer) GetGatewayConnectionStatsHistory(ctx context.Context, req *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStatsHistory not implemented")
+}
+func (*UnimplementedGsServer) StartGatewayCapture(ctx context.Context, req *StartGatewayCaptureRequest) (*GatewayCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGatewayCapture not implemented")
+}
+func (*UnimplementedGsServer) StopGatewayCapture(ctx context.Context, req *GatewayIdentifiers) (*GatewayCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopGatewayCapture not implemented")
+}
+func (*UnimplementedGsServer) DownloadGatewayCapture(ctx context.Context, req *DownloadGatewayCaptureRequest) (*GatewayCaptureFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadGatewayCapture not implemented")
+}
+func (*UnimplementedGsServer) GetGatewaySpectrumStats(ctx context.Context, req *GetGatewaySpectrumStatsRequest) (*GatewaySpectrumStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewaySpectrumStats not implemented")
+}
+
+func RegisterGsServer(s *grpc.Server, srv GsServer) {
+	s.RegisterService(&_Gs_serviceDesc, srv)
+}
+
+func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_ExecuteGatewayCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteGatewayCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).ExecuteGatewayCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/ExecuteGatewayCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).ExecuteGatewayCommand(ctx, req.(*ExecuteGatewayCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_RemoteShell_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GsServer).RemoteShell(&gsRemoteShellServer{stream})
+}
+
+type Gs_RemoteShellServer interface {
+	Send(*GatewayRemoteShellResponse) error
+	Recv() (*GatewayRemoteShellRequest, error)
+	grpc.ServerStream
+}
+
+type gsRemoteShellServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsRemoteShellServer) Send(m *GatewayRemoteShellResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gsRemoteShellServer) Recv() (*GatewayRemoteShellRequest, error) {
+	m := new(GatewayRemoteShellRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Gs_GetGatewayConnectionStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConnectionStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, req.(*GetGatewayConnectionStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_StartGatewayCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGatewayCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).StartGatewayCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/StartGatewayCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).StartGatewayCapture(ctx, req.(*StartGatewayCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_StopGatewayCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).StopGatewayCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/StopGatewayCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).StopGatewayCapture(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_DownloadGatewayCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadGatewayCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).DownloadGatewayCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/DownloadGatewayCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).DownloadGatewayCapture(ctx, req.(*DownloadGatewayCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GetGatewaySpectrumStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewaySpectrumStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewaySpectrumStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewaySpectrumStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewaySpectrumStats(ctx, req.(*GetGatewaySpectrumStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Gs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Gs",
+	HandlerType: (*GsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGatewayConnectionStats",
+			Handler:    _Gs_GetGatewayConnectionStats_Handler,
+		},
+		{
+			MethodName: "ExecuteGatewayCommand",
+			Handler:    _Gs_ExecuteGatewayCommand_Handler,
+		},
+		{
+			MethodName: "GetGatewayConnectionStatsHistory",
+			Handler:    _Gs_GetGatewayConnectionStatsHistory_Handler,
+		},
+		{
+			MethodName: "StartGatewayCapture",
+			Handler:    _Gs_StartGatewayCapture_Handler,
+		},
+		{
+			MethodName: "StopGatewayCapture",
+			Handler:    _Gs_StopGatewayCapture_Handler,
+		},
+		{
+			MethodName: "DownloadGatewayCapture",
+			Handler:    _Gs_DownloadGatewayCapture_Handler,
+		},
+		{
+			MethodName: "GetGatewaySpectrumStats",
+			Handler:    _Gs_GetGatewaySpectrumStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RemoteShell",
+			Handler:       _Gs_RemoteShell_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxAcknowledgment != nil {
+		{
+			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayStatus != nil {
+		{
+			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UplinkMessages) > 0 {
+		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteGatewayCommandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteGatewayCommandRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteGatewayCommandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arguments[iNdEx])
+			copy(dAtA[i:], m.Arguments[iNdEx])
+			i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Arguments[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Command) > 0 {
+		i -= len(m.Command)
+		copy(dAtA[i:], m.Command)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Command)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayRemoteShellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayRemoteShellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayRemoteShellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GatewayIDs != nil {
+		{
+			size, err := m.GatewayIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayRemoteShellResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayRemoteShellResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayRemoteShellResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubBands) > 0 {
+		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RoundTripTimes != nil {
+		{
+			size, err := m.RoundTripTimes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxAcknowledgmentFailureCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.TxAcknowledgmentFailureCount)
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TxAcknowledgmentCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.TxAcknowledgmentCount)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DownlinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.DownlinkCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.UplinkCount)
+		i--
+		dAtA[i] = 0x18
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Percentile90, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Percentile90):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketDuration != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.BucketDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.BucketDuration):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Before != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Before, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Before):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.After != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.After, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.After):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionStatsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStatsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStatsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartGatewayCaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartGatewayCaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartGatewayCaptureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPackets != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Duration != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayCapture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayCapture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayCapture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPackets != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PacketCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.PacketCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StoppedAt != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StoppedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StoppedAt):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x12
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DownloadGatewayCaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownloadGatewayCaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownloadGatewayCaptureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayCaptureFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayCaptureFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayCaptureFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Format != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Capture != nil {
+		{
+			size, err := m.Capture.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGatewaySpectrumStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewaySpectrumStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewaySpectrumStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Window):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewaySpectrumStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewaySpectrumStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewaySpectrumStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowEnd):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x12
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewaySpectrumStats_HistogramBin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewaySpectrumStats_HistogramBin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewaySpectrumStats_HistogramBin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Upper != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Upper)))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Lower != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Lower)))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewaySpectrumStats_DataRateStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewaySpectrumStats_DataRateStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewaySpectrumStats_DataRateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n29, err29 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x1a
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.UplinkCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DataRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewaySpectrumStats_Channel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewaySpectrumStats_Channel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewaySpectrumStats_Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForeignUplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.ForeignUplinkCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.SNR) > 0 {
+		for iNdEx := len(m.SNR) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SNR[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RSSI) > 0 {
+		for iNdEx := len(m.RSSI) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RSSI[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DataRates) > 0 {
+		for iNdEx := len(m.DataRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CollisionProbability != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.CollisionProbability)))
+		i--
+		dAtA[i] = 0x45
+	}
+	if m.OverlappingUplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.OverlappingUplinkCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Utilization != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Utilization)))
+		i--
+		dAtA[i] = 0x35
+	}
+	n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DownlinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x2a
+	n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x22
+	if m.DownlinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.DownlinkCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.UplinkCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGatewayserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
+	this := &GatewayUp{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.UplinkMessages = make([]*UplinkMessage, v1)
+		for i := 0; i < v1; i++ {
+			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
+	this := &GatewayDown{}
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
+	this := &ScheduleDownlinkResponse{}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Delay = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
+	this := &ScheduleDownlinkErrorDetails{}
+	if r.Intn(5) == 0 {
+		v3 := r.Intn(5)
+		this.PathErrors = make([]*ErrorDetails, v3)
+		for i := 0; i < v3; i++ {
+			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedExecuteGatewayCommandRequest(r randyGatewayserver, easy bool) *ExecuteGatewayCommandRequest {
+	this := &ExecuteGatewayCommandRequest{}
+	v4 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v4
+	this.Command = randStringGatewayserver(r)
+	v5 := r.Intn(10)
+	this.Arguments = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.Arguments[i] = randStringGatewayserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayRemoteShellRequest(r randyGatewayserver, easy bool) *GatewayRemoteShellRequest {
+	this := &GatewayRemoteShellRequest{}
+	if r.Intn(5) != 0 {
+		this.GatewayIDs = NewPopulatedGatewayIdentifiers(r, easy)
+	}
+	this.User = randStringGatewayserver(r)
+	this.Term = randStringGatewayserver(r)
+	v6 := r.Intn(100)
+	this.Data = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayRemoteShellResponse(r randyGatewayserver, easy bool) *GatewayRemoteShellResponse {
+	this := &GatewayRemoteShellResponse{}
+	v7 := r.Intn(100)
+	this.Data = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionStatsBucket(r randyGatewayserver, easy bool) *GatewayConnectionStatsBucket {
+	this := &GatewayConnectionStatsBucket{}
+	v8 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Start = *v8
+	v9 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Duration = *v9
+	this.UplinkCount = uint64(r.Uint32())
+	this.DownlinkCount = uint64(r.Uint32())
+	this.TxAcknowledgmentCount = uint64(r.Uint32())
+	this.TxAcknowledgmentFailureCount = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.RoundTripTimes = NewPopulatedGatewayConnectionStatsBucket_RoundTripTimes(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v10)
+		for i := 0; i < v10; i++ {
+			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionStatsBucket_RoundTripTimes(r randyGatewayserver, easy bool) *GatewayConnectionStatsBucket_RoundTripTimes {
+	this := &GatewayConnectionStatsBucket_RoundTripTimes{}
+	v11 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Min = *v11
+	v12 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v12
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v13
+	v14 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Percentile90 = *v14
+	this.Count = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetGatewayConnectionStatsHistoryRequest(r randyGatewayserver, easy bool) *GetGatewayConnectionStatsHistoryRequest {
+	this := &GetGatewayConnectionStatsHistoryRequest{}
+	v15 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v15
+	if r.Intn(5) != 0 {
+		this.After = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Before = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.BucketDuration = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionStatsHistory(r randyGatewayserver, easy bool) *GatewayConnectionStatsHistory {
+	this := &GatewayConnectionStatsHistory{}
+	if r.Intn(5) != 0 {
+		v16 := r.Intn(5)
+		this.Buckets = make([]*GatewayConnectionStatsBucket, v16)
+		for i := 0; i < v16; i++ {
+			this.Buckets[i] = NewPopulatedGatewayConnectionStatsBucket(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStartGatewayCaptureRequest(r randyGatewayserver, easy bool) *StartGatewayCaptureRequest {
+	this := &StartGatewayCaptureRequest{}
+	v17 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v17
+	if r.Intn(5) != 0 {
+		this.Duration = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	this.MaxPackets = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayCapture(r randyGatewayserver, easy bool) *GatewayCapture {
+	this := &GatewayCapture{}
+	v18 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v18
+	v19 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.EndsAt = *v19
+	if r.Intn(5) != 0 {
+		this.StoppedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.PacketCount = r.Uint32()
+	this.MaxPackets = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownloadGatewayCaptureRequest(r randyGatewayserver, easy bool) *DownloadGatewayCaptureRequest {
+	this := &DownloadGatewayCaptureRequest{}
+	v20 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v20
+	this.Format = CaptureFormat([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayCaptureFile(r randyGatewayserver, easy bool) *GatewayCaptureFile {
+	this := &GatewayCaptureFile{}
+	if r.Intn(5) != 0 {
+		this.Capture = NewPopulatedGatewayCapture(r, easy)
+	}
+	this.Format = CaptureFormat([]int32{0, 1, 2}[r.Intn(3)])
+	v21 := r.Intn(100)
+	this.Data = make([]byte, v21)
+	for i := 0; i < v21; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetGatewaySpectrumStatsRequest(r randyGatewayserver, easy bool) *GetGatewaySpectrumStatsRequest {
+	this := &GetGatewaySpectrumStatsRequest{}
+	v22 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v22
+	if r.Intn(5) != 0 {
+		this.Window = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewaySpectrumStats(r randyGatewayserver, easy bool) *GatewaySpectrumStats {
+	this := &GatewaySpectrumStats{}
+	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.WindowStart = *v23
+	v24 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.WindowEnd = *v24
+	if r.Intn(5) != 0 {
+		v25 := r.Intn(5)
+		this.Channels = make([]*GatewaySpectrumStats_Channel, v25)
+		for i := 0; i < v25; i++ {
+			this.Channels[i] = NewPopulatedGatewaySpectrumStats_Channel(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewaySpectrumStats_HistogramBin(r randyGatewayserver, easy bool) *GatewaySpectrumStats_HistogramBin {
+	this := &GatewaySpectrumStats_HistogramBin{}
+	this.Lower = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lower *= -1
+	}
+	this.Upper = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Upper *= -1
+	}
+	this.Count = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewaySpectrumStats_DataRateStats(r randyGatewayserver, easy bool) *GatewaySpectrumStats_DataRateStats {
+	this := &GatewaySpectrumStats_DataRateStats{}
+	v26 := NewPopulatedDataRate(r, easy)
+	this.DataRate = *v26
+	this.UplinkCount = r.Uint32()
+	v27 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.UplinkAirtime = *v27
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewaySpectrumStats_Channel(r randyGatewayserver, easy bool) *GatewaySpectrumStats_Channel {
+	this := &GatewaySpectrumStats_Channel{}
+	this.Frequency = uint64(r.Uint32())
+	this.UplinkCount = r.Uint32()
+	this.DownlinkCount = r.Uint32()
+	v28 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.UplinkAirtime = *v28
+	v29 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.DownlinkAirtime = *v29
+	this.Utilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Utilization *= -1
+	}
+	this.OverlappingUplinkCount = r.Uint32()
+	this.CollisionProbability = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.CollisionProbability *= -1
+	}
+	if r.Intn(5) != 0 {
+		v30 := r.Intn(5)
+		this.DataRates = make([]*GatewaySpectrumStats_DataRateStats, v30)
+		for i := 0; i < v30; i++ {
+			this.DataRates[i] = NewPopulatedGatewaySpectrumStats_DataRateStats(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v31 := r.Intn(5)
+		this.RSSI = make([]*GatewaySpectrumStats_HistogramBin, v31)
+		for i := 0; i < v31; i++ {
+			this.RSSI[i] = NewPopulatedGatewaySpectrumStats_HistogramBin(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v32 := r.Intn(5)
+		this.SNR = make([]*GatewaySpectrumStats_HistogramBin, v32)
+		for i := 0; i < v32; i++ {
+			this.SNR[i] = NewPopulatedGatewaySpectrumStats_HistogramBin(r, easy)
+		}
+	}
+	this.ForeignUplinkCount = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyGatewayserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringGatewayserver(r randyGatewayserver) string {
+	v33 := r.Intn(100)
+	tmps := make([]rune, v33)
+	for i := 0; i < v33; i++ {
+		tmps[i] = randUTF8RuneGatewayserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		v34 := r.Int63()
+		if r.Intn(2) == 0 {
+			v34 *= -1
+		}
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v34))
+	case 1:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GatewayUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UplinkMessages) > 0 {
+		for _, e := range m.UplinkMessages {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.GatewayStatus != nil {
+		l = m.GatewayStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.TxAcknowledgment != nil {
+		l = m.TxAcknowledgment.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for _, e := range m.PathErrors {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteGatewayCommandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, s := range m.Arguments {
+			l = len(s)
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayRemoteShellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GatewayIDs != nil {
+		l = m.GatewayIDs.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayRemoteShellResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayConnectionStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(m.UplinkCount)
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(m.DownlinkCount)
+	}
+	if m.TxAcknowledgmentCount != 0 {
+		n += 1 + sovGatewayserver(m.TxAcknowledgmentCount)
+	}
+	if m.TxAcknowledgmentFailureCount != 0 {
+		n += 1 + sovGatewayserver(m.TxAcknowledgmentFailureCount)
+	}
+	if m.RoundTripTimes != nil {
+		l = m.RoundTripTimes.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Percentile90)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.After != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.After)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Before != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Before)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.BucketDuration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.BucketDuration)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayConnectionStatsHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StartGatewayCaptureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Duration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.MaxPackets != 0 {
+		n += 1 + sovGatewayserver(uint64(m.MaxPackets))
+	}
+	return n
+}
+
+func (m *GatewayCapture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.StoppedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StoppedAt)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.PacketCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.PacketCount))
+	}
+	if m.MaxPackets != 0 {
+		n += 1 + sovGatewayserver(uint64(m.MaxPackets))
+	}
+	return n
+}
+
+func (m *DownloadGatewayCaptureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Format != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *GatewayCaptureFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capture != nil {
+		l = m.Capture.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Format))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GetGatewaySpectrumStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Window != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Window)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewaySpectrumStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowEnd)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewaySpectrumStats_HistogramBin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lower != 0 {
+		n += 5
+	}
+	if m.Upper != 0 {
+		n += 5
+	}
+	if m.Count != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *GatewaySpectrumStats_DataRateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DataRate.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.UplinkCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *GatewaySpectrumStats_Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovGatewayserver(m.Frequency)
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.UplinkCount))
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.DownlinkCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Utilization != 0 {
+		n += 5
+	}
+	if m.OverlappingUplinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.OverlappingUplinkCount))
+	}
+	if m.CollisionProbability != 0 {
+		n += 5
+	}
+	if len(m.DataRates) > 0 {
+		for _, e := range m.DataRates {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if len(m.RSSI) > 0 {
+		for _, e := range m.RSSI {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if len(m.SNR) > 0 {
+		for _, e := range m.SNR {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.ForeignUplinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.ForeignUplinkCount))
+	}
+	return n
+}
+
+func sovGatewayserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGatewayserver(x uint64) (n int) {
+	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GatewayUp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
+	for _, f := range this.UplinkMessages {
+		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForUplinkMessages += "}"
+	s := strings.Join([]string{`&GatewayUp{`,
+		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayDown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayDown{`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
+		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkErrorDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPathErrors := "[]*ErrorDetails{"
+	for _, f := range this.PathErrors {
+		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	}
+	repeatedStringForPathErrors += "}"
+	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
+		`PathErrors:` + repeatedStringForPathErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecuteGatewayCommandRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecuteGatewayCommandRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayRemoteShellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayRemoteShellRequest{`,
+		`GatewayIDs:` + strings.Replace(fmt.Sprintf("%v", this.GatewayIDs), "GatewayIdentifiers", "GatewayIdentifiers", 1) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayRemoteShellResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayRemoteShellResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStatsBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubBands := "[]*GatewayConnectionStats_SubBand{"
+	for _, f := range this.SubBands {
+		repeatedStringForSubBands += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionStats_SubBand", "GatewayConnectionStats_SubBand", 1) + ","
+	}
+	repeatedStringForSubBands += "}"
+	s := strings.Join([]string{`&GatewayConnectionStatsBucket{`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`TxAcknowledgmentCount:` + fmt.Sprintf("%v", this.TxAcknowledgmentCount) + `,`,
+		`TxAcknowledgmentFailureCount:` + fmt.Sprintf("%v", this.TxAcknowledgmentFailureCount) + `,`,
+		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayConnectionStatsBucket_RoundTripTimes", "GatewayConnectionStatsBucket_RoundTripTimes", 1) + `,`,
+		`SubBands:` + repeatedStringForSubBands + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStatsBucket_RoundTripTimes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayConnectionStatsBucket_RoundTripTimes{`,
+		`Min:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Min), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Max:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Max), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Median:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Percentile90:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Percentile90), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayConnectionStatsHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewayConnectionStatsHistoryRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Timestamp", "types.Timestamp", 1) + `,`,
+		`BucketDuration:` + strings.Replace(fmt.Sprintf("%v", this.BucketDuration), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStatsHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBuckets := "[]*GatewayConnectionStatsBucket{"
+	for _, f := range this.Buckets {
+		repeatedStringForBuckets += strings.Replace(f.String(), "GatewayConnectionStatsBucket", "GatewayConnectionStatsBucket", 1) + ","
+	}
+	repeatedStringForBuckets += "}"
+	s := strings.Join([]string{`&GatewayConnectionStatsHistory{`,
+		`Buckets:` + repeatedStringForBuckets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartGatewayCaptureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartGatewayCaptureRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1) + `,`,
+		`MaxPackets:` + fmt.Sprintf("%v", this.MaxPackets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayCapture) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayCapture{`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`EndsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndsAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`StoppedAt:` + strings.Replace(fmt.Sprintf("%v", this.StoppedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PacketCount:` + fmt.Sprintf("%v", this.PacketCount) + `,`,
+		`MaxPackets:` + fmt.Sprintf("%v", this.MaxPackets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownloadGatewayCaptureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownloadGatewayCaptureRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayCaptureFile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayCaptureFile{`,
+		`Capture:` + strings.Replace(this.Capture.String(), "GatewayCapture", "GatewayCapture", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewaySpectrumStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewaySpectrumStatsRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Window:` + strings.Replace(fmt.Sprintf("%v", this.Window), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewaySpectrumStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChannels := "[]*GatewaySpectrumStats_Channel{"
+	for _, f := range this.Channels {
+		repeatedStringForChannels += strings.Replace(fmt.Sprintf("%v", f), "GatewaySpectrumStats_Channel", "GatewaySpectrumStats_Channel", 1) + ","
+	}
+	repeatedStringForChannels += "}"
+	s := strings.Join([]string{`&GatewaySpectrumStats{`,
+		`WindowStart:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WindowStart), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`WindowEnd:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WindowEnd), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Channels:` + repeatedStringForChannels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewaySpectrumStats_HistogramBin) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewaySpectrumStats_HistogramBin{`,
+		`Lower:` + fmt.Sprintf("%v", this.Lower) + `,`,
+		`Upper:` + fmt.Sprintf("%v", this.Upper) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewaySpectrumStats_DataRateStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewaySpectrumStats_DataRateStats{`,
+		`DataRate:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DataRate), "DataRate", "DataRate", 1), `&`, ``, 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`UplinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UplinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewaySpectrumStats_Channel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDataRates := "[]*GatewaySpectrumStats_DataRateStats{"
+	for _, f := range this.DataRates {
+		repeatedStringForDataRates += strings.Replace(fmt.Sprintf("%v", f), "GatewaySpectrumStats_DataRateStats", "GatewaySpectrumStats_DataRateStats", 1) + ","
+	}
+	repeatedStringForDataRates += "}"
+	repeatedStringForRSSI := "[]*GatewaySpectrumStats_HistogramBin{"
+	for _, f := range this.RSSI {
+		repeatedStringForRSSI += strings.Replace(fmt.Sprintf("%v", f), "GatewaySpectrumStats_HistogramBin", "GatewaySpectrumStats_HistogramBin", 1) + ","
+	}
+	repeatedStringForRSSI += "}"
+	repeatedStringForSNR := "[]*GatewaySpectrumStats_HistogramBin{"
+	for _, f := range this.SNR {
+		repeatedStringForSNR += strings.Replace(fmt.Sprintf("%v", f), "GatewaySpectrumStats_HistogramBin", "GatewaySpectrumStats_HistogramBin", 1) + ","
+	}
+	repeatedStringForSNR += "}"
+	s := strings.Join([]string{`&GatewaySpectrumStats_Channel{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`UplinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UplinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`DownlinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DownlinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Utilization:` + fmt.Sprintf("%v", this.Utilization) + `,`,
+		`OverlappingUplinkCount:` + fmt.Sprintf("%v", this.OverlappingUplinkCount) + `,`,
+		`CollisionProbability:` + fmt.Sprintf("%v", this.CollisionProbability) + `,`,
+		`DataRates:` + repeatedStringForDataRates + `,`,
+		`RSSI:` + repeatedStringForRSSI + `,`,
+		`SNR:` + repeatedStringForSNR + `,`,
+		`ForeignUplinkCount:` + fmt.Sprintf("%v", this.ForeignUplinkCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGatewayserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
+			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteGatewayCommandRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteGatewayCommandRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteGatewayCommandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayRemoteShellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayRemoteShellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayRemoteShellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayIDs == nil {
+				m.GatewayIDs = &GatewayIdentifiers{}
+			}
+			if err := m.GatewayIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayRemoteShellResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayRemoteShellResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayRemoteShellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionStatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayConnectionStatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayConnectionStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgmentCount", wireType)
+			}
+			m.TxAcknowledgmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxAcknowledgmentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgmentFailureCount", wireType)
+			}
+			m.TxAcknowledgmentFailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxAcknowledgmentFailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundTripTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoundTripTimes == nil {
+				m.RoundTripTimes = &GatewayConnectionStatsBucket_RoundTripTimes{}
+			}
+			if err := m.RoundTripTimes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubBands = append(m.SubBands, &GatewayConnectionStats_SubBand{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundTripTimes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundTripTimes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Min, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Max, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Median, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile90", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Percentile90, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGatewayConnectionStatsHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGatewayConnectionStatsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.After, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Before, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketDuration == nil {
+				m.BucketDuration = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.BucketDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionStatsHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayConnectionStatsHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayConnectionStatsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &GatewayConnectionStatsBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartGatewayCaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartGatewayCaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartGatewayCaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayCapture) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayCapture: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayCapture: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoppedAt == nil {
+				m.StoppedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StoppedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCount", wireType)
+			}
+			m.PacketCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownloadGatewayCaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownloadGatewayCaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownloadGatewayCaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= CaptureFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayCaptureFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayCaptureFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayCaptureFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capture", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capture == nil {
+				m.Capture = &GatewayCapture{}
+			}
+			if err := m.Capture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= CaptureFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGatewaySpectrumStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGatewaySpectrumStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGatewaySpectrumStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewaySpectrumStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewaySpectrumStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewaySpectrumStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &GatewaySpectrumStats_Channel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewaySpectrumStats_HistogramBin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistogramBin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistogramBin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Lower = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Upper = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewaySpectrumStats_DataRateStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1265,15 +7723,15 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRateStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRateStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1300,16 +7758,15 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DataRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
 			}
-			var msglen int
+			m.UplinkCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1319,31 +7776,14 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UplinkCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
-			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1370,10 +7810,7 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
-			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1401,7 +7838,7 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+func (m *GatewaySpectrumStats_Channel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,15 +7861,72 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1459,69 +7953,87 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
-			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtime", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DownlinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Utilization = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlappingUplinkCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OverlappingUplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlappingUplinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollisionProbability", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.CollisionProbability = float32(math.Float32frombits(v))
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1548,66 +8060,48 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			m.DataRates = append(m.DataRates, &GatewaySpectrumStats_DataRateStats{})
+			if err := m.DataRates[len(m.DataRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSI", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGatewayserver
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RSSI = append(m.RSSI, &GatewaySpectrumStats_HistogramBin{})
+			if err := m.RSSI[len(m.RSSI)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1634,11 +8128,30 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
-			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SNR = append(m.SNR, &GatewaySpectrumStats_HistogramBin{})
+			if err := m.SNR[len(m.SNR)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignUplinkCount", wireType)
+			}
+			m.ForeignUplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForeignUplinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGatewayserver(dAtA[iNdEx:])
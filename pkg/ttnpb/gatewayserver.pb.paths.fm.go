@@ -128,3 +128,235 @@ var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
 var ScheduleDownlinkErrorDetailsFieldPathsTopLevel = []string{
 	"path_errors",
 }
+var ExecuteGatewayCommandRequestFieldPathsNested = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var ExecuteGatewayCommandRequestFieldPathsTopLevel = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+}
+var GatewayRemoteShellRequestFieldPathsNested = []string{
+	"data",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"term",
+	"user",
+}
+
+var GatewayRemoteShellRequestFieldPathsTopLevel = []string{
+	"data",
+	"gateway_ids",
+	"term",
+	"user",
+}
+var GatewayRemoteShellResponseFieldPathsNested = []string{
+	"data",
+}
+
+var GatewayRemoteShellResponseFieldPathsTopLevel = []string{
+	"data",
+}
+var GatewayConnectionStatsBucketFieldPathsNested = []string{
+	"downlink_count",
+	"duration",
+	"round_trip_times",
+	"round_trip_times.count",
+	"round_trip_times.max",
+	"round_trip_times.median",
+	"round_trip_times.min",
+	"round_trip_times.percentile_90",
+	"start",
+	"sub_bands",
+	"tx_acknowledgment_count",
+	"tx_acknowledgment_failure_count",
+	"uplink_count",
+}
+
+var GatewayConnectionStatsBucketFieldPathsTopLevel = []string{
+	"downlink_count",
+	"duration",
+	"round_trip_times",
+	"start",
+	"sub_bands",
+	"tx_acknowledgment_count",
+	"tx_acknowledgment_failure_count",
+	"uplink_count",
+}
+var GetGatewayConnectionStatsHistoryRequestFieldPathsNested = []string{
+	"after",
+	"before",
+	"bucket_duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var GetGatewayConnectionStatsHistoryRequestFieldPathsTopLevel = []string{
+	"after",
+	"before",
+	"bucket_duration",
+	"gateway_ids",
+}
+var GatewayConnectionStatsHistoryFieldPathsNested = []string{
+	"buckets",
+}
+
+var GatewayConnectionStatsHistoryFieldPathsTopLevel = []string{
+	"buckets",
+}
+var StartGatewayCaptureRequestFieldPathsNested = []string{
+	"duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"max_packets",
+}
+
+var StartGatewayCaptureRequestFieldPathsTopLevel = []string{
+	"duration",
+	"gateway_ids",
+	"max_packets",
+}
+var GatewayCaptureFieldPathsNested = []string{
+	"ends_at",
+	"max_packets",
+	"packet_count",
+	"started_at",
+	"stopped_at",
+}
+
+var GatewayCaptureFieldPathsTopLevel = []string{
+	"ends_at",
+	"max_packets",
+	"packet_count",
+	"started_at",
+	"stopped_at",
+}
+var DownloadGatewayCaptureRequestFieldPathsNested = []string{
+	"format",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var DownloadGatewayCaptureRequestFieldPathsTopLevel = []string{
+	"format",
+	"gateway_ids",
+}
+var GatewayCaptureFileFieldPathsNested = []string{
+	"capture",
+	"capture.ends_at",
+	"capture.max_packets",
+	"capture.packet_count",
+	"capture.started_at",
+	"capture.stopped_at",
+	"data",
+	"format",
+}
+
+var GatewayCaptureFileFieldPathsTopLevel = []string{
+	"capture",
+	"data",
+	"format",
+}
+var GetGatewaySpectrumStatsRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"window",
+}
+
+var GetGatewaySpectrumStatsRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"window",
+}
+var GatewaySpectrumStatsFieldPathsNested = []string{
+	"channels",
+	"window_end",
+	"window_start",
+}
+
+var GatewaySpectrumStatsFieldPathsTopLevel = []string{
+	"channels",
+	"window_end",
+	"window_start",
+}
+var GatewayConnectionStatsBucket_RoundTripTimesFieldPathsNested = []string{
+	"count",
+	"max",
+	"median",
+	"min",
+	"percentile_90",
+}
+
+var GatewayConnectionStatsBucket_RoundTripTimesFieldPathsTopLevel = []string{
+	"count",
+	"max",
+	"median",
+	"min",
+	"percentile_90",
+}
+var GatewaySpectrumStats_HistogramBinFieldPathsNested = []string{
+	"count",
+	"lower",
+	"upper",
+}
+
+var GatewaySpectrumStats_HistogramBinFieldPathsTopLevel = []string{
+	"count",
+	"lower",
+	"upper",
+}
+var GatewaySpectrumStats_DataRateStatsFieldPathsNested = []string{
+	"data_rate",
+	"data_rate.modulation",
+	"data_rate.modulation.fsk",
+	"data_rate.modulation.fsk.bit_rate",
+	"data_rate.modulation.lora",
+	"data_rate.modulation.lora.bandwidth",
+	"data_rate.modulation.lora.spreading_factor",
+	"uplink_airtime",
+	"uplink_count",
+}
+
+var GatewaySpectrumStats_DataRateStatsFieldPathsTopLevel = []string{
+	"data_rate",
+	"uplink_airtime",
+	"uplink_count",
+}
+var GatewaySpectrumStats_ChannelFieldPathsNested = []string{
+	"collision_probability",
+	"data_rates",
+	"downlink_airtime",
+	"downlink_count",
+	"foreign_uplink_count",
+	"frequency",
+	"overlapping_uplink_count",
+	"rssi",
+	"snr",
+	"uplink_airtime",
+	"uplink_count",
+	"utilization",
+}
+
+var GatewaySpectrumStats_ChannelFieldPathsTopLevel = []string{
+	"collision_probability",
+	"data_rates",
+	"downlink_airtime",
+	"downlink_count",
+	"foreign_uplink_count",
+	"frequency",
+	"overlapping_uplink_count",
+	"rssi",
+	"snr",
+	"uplink_airtime",
+	"uplink_count",
+	"utilization",
+}
@@ -153,3 +153,876 @@ func (dst *ScheduleDownlinkErrorDetails) SetFields(src *ScheduleDownlinkErrorDet
 	}
 	return nil
 }
+
+func (dst *ExecuteGatewayCommandRequest) SetFields(src *ExecuteGatewayCommandRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "command":
+			if len(subs) > 0 {
+				return fmt.Errorf("'command' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Command = src.Command
+			} else {
+				var zero string
+				dst.Command = zero
+			}
+		case "arguments":
+			if len(subs) > 0 {
+				return fmt.Errorf("'arguments' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Arguments = src.Arguments
+			} else {
+				dst.Arguments = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellRequest) SetFields(src *GatewayRemoteShellRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIDs == nil) && dst.GatewayIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIDs
+				}
+				if dst.GatewayIDs != nil {
+					newDst = dst.GatewayIDs
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIDs = src.GatewayIDs
+				} else {
+					dst.GatewayIDs = nil
+				}
+			}
+		case "user":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.User = src.User
+			} else {
+				var zero string
+				dst.User = zero
+			}
+		case "term":
+			if len(subs) > 0 {
+				return fmt.Errorf("'term' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Term = src.Term
+			} else {
+				var zero string
+				dst.Term = zero
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellResponse) SetFields(src *GatewayRemoteShellResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsBucket) SetFields(src *GatewayConnectionStatsBucket, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Start = src.Start
+			} else {
+				var zero time.Time
+				dst.Start = zero
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				var zero time.Duration
+				dst.Duration = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "tx_acknowledgment_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_acknowledgment_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxAcknowledgmentCount = src.TxAcknowledgmentCount
+			} else {
+				var zero uint64
+				dst.TxAcknowledgmentCount = zero
+			}
+		case "tx_acknowledgment_failure_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_acknowledgment_failure_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxAcknowledgmentFailureCount = src.TxAcknowledgmentFailureCount
+			} else {
+				var zero uint64
+				dst.TxAcknowledgmentFailureCount = zero
+			}
+		case "round_trip_times":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStatsBucket_RoundTripTimes
+				if (src == nil || src.RoundTripTimes == nil) && dst.RoundTripTimes == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimes
+				}
+				if dst.RoundTripTimes != nil {
+					newDst = dst.RoundTripTimes
+				} else {
+					newDst = &GatewayConnectionStatsBucket_RoundTripTimes{}
+					dst.RoundTripTimes = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimes = src.RoundTripTimes
+				} else {
+					dst.RoundTripTimes = nil
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayConnectionStatsHistoryRequest) SetFields(src *GetGatewayConnectionStatsHistoryRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.After = src.After
+			} else {
+				dst.After = nil
+			}
+		case "before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Before = src.Before
+			} else {
+				dst.Before = nil
+			}
+		case "bucket_duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'bucket_duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BucketDuration = src.BucketDuration
+			} else {
+				dst.BucketDuration = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsHistory) SetFields(src *GatewayConnectionStatsHistory, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "buckets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'buckets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Buckets = src.Buckets
+			} else {
+				dst.Buckets = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StartGatewayCaptureRequest) SetFields(src *StartGatewayCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+		case "max_packets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_packets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxPackets = src.MaxPackets
+			} else {
+				var zero uint32
+				dst.MaxPackets = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayCapture) SetFields(src *GatewayCapture, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				var zero time.Time
+				dst.StartedAt = zero
+			}
+		case "ends_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ends_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndsAt = src.EndsAt
+			} else {
+				var zero time.Time
+				dst.EndsAt = zero
+			}
+		case "stopped_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stopped_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StoppedAt = src.StoppedAt
+			} else {
+				dst.StoppedAt = nil
+			}
+		case "packet_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'packet_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PacketCount = src.PacketCount
+			} else {
+				var zero uint32
+				dst.PacketCount = zero
+			}
+		case "max_packets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_packets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxPackets = src.MaxPackets
+			} else {
+				var zero uint32
+				dst.MaxPackets = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownloadGatewayCaptureRequest) SetFields(src *DownloadGatewayCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero CaptureFormat
+				dst.Format = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayCaptureFile) SetFields(src *GatewayCaptureFile, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "capture":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayCapture
+				if (src == nil || src.Capture == nil) && dst.Capture == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Capture
+				}
+				if dst.Capture != nil {
+					newDst = dst.Capture
+				} else {
+					newDst = &GatewayCapture{}
+					dst.Capture = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Capture = src.Capture
+				} else {
+					dst.Capture = nil
+				}
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero CaptureFormat
+				dst.Format = zero
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewaySpectrumStatsRequest) SetFields(src *GetGatewaySpectrumStatsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Window = src.Window
+			} else {
+				dst.Window = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySpectrumStats) SetFields(src *GatewaySpectrumStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "window_start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window_start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WindowStart = src.WindowStart
+			} else {
+				var zero time.Time
+				dst.WindowStart = zero
+			}
+		case "window_end":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window_end' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WindowEnd = src.WindowEnd
+			} else {
+				var zero time.Time
+				dst.WindowEnd = zero
+			}
+		case "channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Channels = src.Channels
+			} else {
+				dst.Channels = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsBucket_RoundTripTimes) SetFields(src *GatewayConnectionStatsBucket_RoundTripTimes, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero time.Duration
+				dst.Min = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero time.Duration
+				dst.Max = zero
+			}
+		case "median":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Median = src.Median
+			} else {
+				var zero time.Duration
+				dst.Median = zero
+			}
+		case "percentile_90":
+			if len(subs) > 0 {
+				return fmt.Errorf("'percentile_90' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Percentile90 = src.Percentile90
+			} else {
+				var zero time.Duration
+				dst.Percentile90 = zero
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySpectrumStats_HistogramBin) SetFields(src *GatewaySpectrumStats_HistogramBin, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "lower":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lower' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Lower = src.Lower
+			} else {
+				var zero float32
+				dst.Lower = zero
+			}
+		case "upper":
+			if len(subs) > 0 {
+				return fmt.Errorf("'upper' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Upper = src.Upper
+			} else {
+				var zero float32
+				dst.Upper = zero
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySpectrumStats_DataRateStats) SetFields(src *GatewaySpectrumStats_DataRateStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data_rate":
+			if len(subs) > 0 {
+				var newDst, newSrc *DataRate
+				if src != nil {
+					newSrc = &src.DataRate
+				}
+				newDst = &dst.DataRate
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DataRate = src.DataRate
+				} else {
+					var zero DataRate
+					dst.DataRate = zero
+				}
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+		case "uplink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtime = src.UplinkAirtime
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySpectrumStats_Channel) SetFields(src *GatewaySpectrumStats_Channel, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint32
+				dst.DownlinkCount = zero
+			}
+		case "uplink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtime = src.UplinkAirtime
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtime = zero
+			}
+		case "downlink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkAirtime = src.DownlinkAirtime
+			} else {
+				var zero time.Duration
+				dst.DownlinkAirtime = zero
+			}
+		case "utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Utilization = src.Utilization
+			} else {
+				var zero float32
+				dst.Utilization = zero
+			}
+		case "overlapping_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'overlapping_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OverlappingUplinkCount = src.OverlappingUplinkCount
+			} else {
+				var zero uint32
+				dst.OverlappingUplinkCount = zero
+			}
+		case "collision_probability":
+			if len(subs) > 0 {
+				return fmt.Errorf("'collision_probability' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CollisionProbability = src.CollisionProbability
+			} else {
+				var zero float32
+				dst.CollisionProbability = zero
+			}
+		case "data_rates":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rates' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRates = src.DataRates
+			} else {
+				dst.DataRates = nil
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RSSI = src.RSSI
+			} else {
+				dst.RSSI = nil
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SNR = src.SNR
+			} else {
+				dst.SNR = nil
+			}
+		case "foreign_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'foreign_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ForeignUplinkCount = src.ForeignUplinkCount
+			} else {
+				var zero uint32
+				dst.ForeignUplinkCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
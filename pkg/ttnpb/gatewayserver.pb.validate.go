@@ -428,3 +428,1808 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ScheduleDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on ExecuteGatewayCommandRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExecuteGatewayCommandRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExecuteGatewayCommandRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExecuteGatewayCommandRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "command":
+
+			if l := utf8.RuneCountInString(m.GetCommand()); l < 1 || l > 1024 {
+				return ExecuteGatewayCommandRequestValidationError{
+					field:  "command",
+					reason: "value length must be between 1 and 1024 runes, inclusive",
+				}
+			}
+
+		case "arguments":
+
+			if len(m.GetArguments()) > 64 {
+				return ExecuteGatewayCommandRequestValidationError{
+					field:  "arguments",
+					reason: "value must contain no more than 64 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetArguments() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 1024 {
+					return ExecuteGatewayCommandRequestValidationError{
+						field:  fmt.Sprintf("arguments[%v]", idx),
+						reason: "value length must be at most 1024 runes",
+					}
+				}
+
+			}
+
+		default:
+			return ExecuteGatewayCommandRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExecuteGatewayCommandRequestValidationError is the validation error returned
+// by ExecuteGatewayCommandRequest.ValidateFields if the designated constraints
+// aren't met.
+type ExecuteGatewayCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteGatewayCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteGatewayCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteGatewayCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteGatewayCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteGatewayCommandRequestValidationError) ErrorName() string {
+	return "ExecuteGatewayCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteGatewayCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteGatewayCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteGatewayCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteGatewayCommandRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(m.GetGatewayIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayRemoteShellRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "user":
+
+			if utf8.RuneCountInString(m.GetUser()) > 64 {
+				return GatewayRemoteShellRequestValidationError{
+					field:  "user",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "term":
+
+			if utf8.RuneCountInString(m.GetTerm()) > 64 {
+				return GatewayRemoteShellRequestValidationError{
+					field:  "term",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "data":
+
+			if len(m.GetData()) > 4096 {
+				return GatewayRemoteShellRequestValidationError{
+					field:  "data",
+					reason: "value length must be at most 4096 bytes",
+				}
+			}
+
+		default:
+			return GatewayRemoteShellRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellRequestValidationError is the validation error returned by
+// GatewayRemoteShellRequest.ValidateFields if the designated constraints aren't
+// met.
+type GatewayRemoteShellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellRequestValidationError) ErrorName() string {
+	return "GatewayRemoteShellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayRemoteShellResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellResponseValidationError is the validation error returned by
+// GatewayRemoteShellResponse.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellResponseValidationError) ErrorName() string {
+	return "GatewayRemoteShellResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsBucket with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionStatsBucket) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsBucketFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start":
+
+		case "duration":
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "tx_acknowledgment_count":
+			// no validation rules for TxAcknowledgmentCount
+		case "tx_acknowledgment_failure_count":
+			// no validation rules for TxAcknowledgmentFailureCount
+		case "round_trip_times":
+
+			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsBucketValidationError{
+						field:  "round_trip_times",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsBucketValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionStatsBucketValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsBucketValidationError is the validation error returned
+// by GatewayConnectionStatsBucket.ValidateFields if the designated constraints
+// aren't met.
+type GatewayConnectionStatsBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsBucketValidationError) ErrorName() string {
+	return "GatewayConnectionStatsBucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsBucketValidationError{}
+
+// ValidateFields checks the field values on
+// GetGatewayConnectionStatsHistoryRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetGatewayConnectionStatsHistoryRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConnectionStatsHistoryRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "after":
+
+			if v, ok := interface{}(m.GetAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "before":
+
+			if v, ok := interface{}(m.GetBefore()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "bucket_duration":
+
+			if v, ok := interface{}(m.GetBucketDuration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "bucket_duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetGatewayConnectionStatsHistoryRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConnectionStatsHistoryRequestValidationError is the validation
+// error returned by GetGatewayConnectionStatsHistoryRequest.ValidateFields if
+// the designated constraints aren't met.
+type GetGatewayConnectionStatsHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) ErrorName() string {
+	return "GetGatewayConnectionStatsHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConnectionStatsHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsHistory with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionStatsHistory) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsHistoryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "buckets":
+
+			for idx, item := range m.GetBuckets() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsHistoryValidationError{
+							field:  fmt.Sprintf("buckets[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionStatsHistoryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsHistoryValidationError is the validation error returned
+// by GatewayConnectionStatsHistory.ValidateFields if the designated constraints
+// aren't met.
+type GatewayConnectionStatsHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsHistoryValidationError) ErrorName() string {
+	return "GatewayConnectionStatsHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsHistoryValidationError{}
+
+// ValidateFields checks the field values on StartGatewayCaptureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StartGatewayCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StartGatewayCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartGatewayCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+			if v, ok := interface{}(m.GetDuration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartGatewayCaptureRequestValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max_packets":
+
+			if m.GetMaxPackets() > 10000 {
+				return StartGatewayCaptureRequestValidationError{
+					field:  "max_packets",
+					reason: "value must be less than or equal to 10000",
+				}
+			}
+
+		default:
+			return StartGatewayCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StartGatewayCaptureRequestValidationError is the validation error returned by
+// StartGatewayCaptureRequest.ValidateFields if the designated constraints
+// aren't met.
+type StartGatewayCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartGatewayCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartGatewayCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartGatewayCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartGatewayCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartGatewayCaptureRequestValidationError) ErrorName() string {
+	return "StartGatewayCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartGatewayCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartGatewayCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartGatewayCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartGatewayCaptureRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayCapture with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GatewayCapture) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayCaptureFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "started_at":
+
+			if v, ok := interface{}(&m.StartedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "ends_at":
+
+			if v, ok := interface{}(&m.EndsAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureValidationError{
+						field:  "ends_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "stopped_at":
+
+			if v, ok := interface{}(m.GetStoppedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureValidationError{
+						field:  "stopped_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "packet_count":
+			// no validation rules for PacketCount
+		case "max_packets":
+			// no validation rules for MaxPackets
+		default:
+			return GatewayCaptureValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayCaptureValidationError is the validation error returned by
+// GatewayCapture.ValidateFields if the designated constraints aren't met.
+type GatewayCaptureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayCaptureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayCaptureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayCaptureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayCaptureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayCaptureValidationError) ErrorName() string {
+	return "GatewayCaptureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayCaptureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayCapture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayCaptureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayCaptureValidationError{}
+
+// ValidateFields checks the field values on DownloadGatewayCaptureRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownloadGatewayCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownloadGatewayCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownloadGatewayCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "format":
+
+			if _, ok := CaptureFormat_name[int32(m.GetFormat())]; !ok {
+				return DownloadGatewayCaptureRequestValidationError{
+					field:  "format",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return DownloadGatewayCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownloadGatewayCaptureRequestValidationError is the validation error returned
+// by DownloadGatewayCaptureRequest.ValidateFields if the designated constraints
+// aren't met.
+type DownloadGatewayCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadGatewayCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadGatewayCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadGatewayCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadGatewayCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadGatewayCaptureRequestValidationError) ErrorName() string {
+	return "DownloadGatewayCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadGatewayCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadGatewayCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadGatewayCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadGatewayCaptureRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayCaptureFile with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GatewayCaptureFile) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayCaptureFileFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "capture":
+
+			if v, ok := interface{}(m.GetCapture()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureFileValidationError{
+						field:  "capture",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "format":
+			// no validation rules for Format
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayCaptureFileValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayCaptureFileValidationError is the validation error returned by
+// GatewayCaptureFile.ValidateFields if the designated constraints aren't met.
+type GatewayCaptureFileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayCaptureFileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayCaptureFileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayCaptureFileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayCaptureFileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayCaptureFileValidationError) ErrorName() string {
+	return "GatewayCaptureFileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayCaptureFileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayCaptureFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayCaptureFileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayCaptureFileValidationError{}
+
+// ValidateFields checks the field values on GetGatewaySpectrumStatsRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetGatewaySpectrumStatsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewaySpectrumStatsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewaySpectrumStatsRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "window":
+
+			if v, ok := interface{}(m.GetWindow()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewaySpectrumStatsRequestValidationError{
+						field:  "window",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetGatewaySpectrumStatsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewaySpectrumStatsRequestValidationError is the validation error
+// returned by GetGatewaySpectrumStatsRequest.ValidateFields if the designated
+// constraints aren't met.
+type GetGatewaySpectrumStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewaySpectrumStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewaySpectrumStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewaySpectrumStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewaySpectrumStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewaySpectrumStatsRequestValidationError) ErrorName() string {
+	return "GetGatewaySpectrumStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewaySpectrumStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewaySpectrumStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewaySpectrumStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewaySpectrumStatsRequestValidationError{}
+
+// ValidateFields checks the field values on GatewaySpectrumStats with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *GatewaySpectrumStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySpectrumStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "window_start":
+
+			if v, ok := interface{}(&m.WindowStart).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySpectrumStatsValidationError{
+						field:  "window_start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "window_end":
+
+			if v, ok := interface{}(&m.WindowEnd).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySpectrumStatsValidationError{
+						field:  "window_end",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "channels":
+
+			for idx, item := range m.GetChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySpectrumStatsValidationError{
+							field:  fmt.Sprintf("channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewaySpectrumStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySpectrumStatsValidationError is the validation error returned by
+// GatewaySpectrumStats.ValidateFields if the designated constraints aren't met.
+type GatewaySpectrumStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySpectrumStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySpectrumStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySpectrumStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySpectrumStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySpectrumStatsValidationError) ErrorName() string {
+	return "GatewaySpectrumStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySpectrumStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySpectrumStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySpectrumStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySpectrumStatsValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionStatsBucket_RoundTripTimes with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is
+// returned.
+func (m *GatewayConnectionStatsBucket_RoundTripTimes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsBucket_RoundTripTimesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+
+		case "max":
+
+		case "median":
+
+		case "percentile_90":
+
+		case "count":
+			// no validation rules for Count
+		default:
+			return GatewayConnectionStatsBucket_RoundTripTimesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsBucket_RoundTripTimesValidationError is the validation
+// error returned by GatewayConnectionStatsBucket_RoundTripTimes.ValidateFields
+// if the designated constraints aren't met.
+type GatewayConnectionStatsBucket_RoundTripTimesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsBucket_RoundTripTimesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsBucket_RoundTripTimesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsBucket_RoundTripTimesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsBucket_RoundTripTimesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsBucket_RoundTripTimesValidationError) ErrorName() string {
+	return "GatewayConnectionStatsBucket_RoundTripTimesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsBucket_RoundTripTimesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsBucket_RoundTripTimes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsBucket_RoundTripTimesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsBucket_RoundTripTimesValidationError{}
+
+// ValidateFields checks the field values on GatewaySpectrumStats_HistogramBin
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewaySpectrumStats_HistogramBin) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySpectrumStats_HistogramBinFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "lower":
+			// no validation rules for Lower
+		case "upper":
+			// no validation rules for Upper
+		case "count":
+			// no validation rules for Count
+		default:
+			return GatewaySpectrumStats_HistogramBinValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySpectrumStats_HistogramBinValidationError is the validation error
+// returned by GatewaySpectrumStats_HistogramBin.ValidateFields if the
+// designated constraints aren't met.
+type GatewaySpectrumStats_HistogramBinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySpectrumStats_HistogramBinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySpectrumStats_HistogramBinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySpectrumStats_HistogramBinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySpectrumStats_HistogramBinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySpectrumStats_HistogramBinValidationError) ErrorName() string {
+	return "GatewaySpectrumStats_HistogramBinValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySpectrumStats_HistogramBinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySpectrumStats_HistogramBin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySpectrumStats_HistogramBinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySpectrumStats_HistogramBinValidationError{}
+
+// ValidateFields checks the field values on GatewaySpectrumStats_DataRateStats
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewaySpectrumStats_DataRateStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySpectrumStats_DataRateStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data_rate":
+
+			if v, ok := interface{}(&m.DataRate).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySpectrumStats_DataRateStatsValidationError{
+						field:  "data_rate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "uplink_airtime":
+
+			if v, ok := interface{}(&m.UplinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySpectrumStats_DataRateStatsValidationError{
+						field:  "uplink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewaySpectrumStats_DataRateStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySpectrumStats_DataRateStatsValidationError is the validation error
+// returned by GatewaySpectrumStats_DataRateStats.ValidateFields if the
+// designated constraints aren't met.
+type GatewaySpectrumStats_DataRateStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySpectrumStats_DataRateStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySpectrumStats_DataRateStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySpectrumStats_DataRateStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySpectrumStats_DataRateStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySpectrumStats_DataRateStatsValidationError) ErrorName() string {
+	return "GatewaySpectrumStats_DataRateStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySpectrumStats_DataRateStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySpectrumStats_DataRateStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySpectrumStats_DataRateStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySpectrumStats_DataRateStatsValidationError{}
+
+// ValidateFields checks the field values on GatewaySpectrumStats_Channel with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewaySpectrumStats_Channel) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySpectrumStats_ChannelFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency":
+			// no validation rules for Frequency
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "uplink_airtime":
+
+			if v, ok := interface{}(&m.UplinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySpectrumStats_ChannelValidationError{
+						field:  "uplink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_airtime":
+
+			if v, ok := interface{}(&m.DownlinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySpectrumStats_ChannelValidationError{
+						field:  "downlink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "utilization":
+			// no validation rules for Utilization
+		case "overlapping_uplink_count":
+			// no validation rules for OverlappingUplinkCount
+		case "collision_probability":
+			// no validation rules for CollisionProbability
+		case "data_rates":
+
+			for idx, item := range m.GetDataRates() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySpectrumStats_ChannelValidationError{
+							field:  fmt.Sprintf("data_rates[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "rssi":
+
+			for idx, item := range m.GetRSSI() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySpectrumStats_ChannelValidationError{
+							field:  fmt.Sprintf("rssi[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "snr":
+
+			for idx, item := range m.GetSNR() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySpectrumStats_ChannelValidationError{
+							field:  fmt.Sprintf("snr[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "foreign_uplink_count":
+			// no validation rules for ForeignUplinkCount
+		default:
+			return GatewaySpectrumStats_ChannelValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySpectrumStats_ChannelValidationError is the validation error returned
+// by GatewaySpectrumStats_Channel.ValidateFields if the designated constraints
+// aren't met.
+type GatewaySpectrumStats_ChannelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySpectrumStats_ChannelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySpectrumStats_ChannelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySpectrumStats_ChannelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySpectrumStats_ChannelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySpectrumStats_ChannelValidationError) ErrorName() string {
+	return "GatewaySpectrumStats_ChannelValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySpectrumStats_ChannelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySpectrumStats_Channel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySpectrumStats_ChannelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySpectrumStats_ChannelValidationError{}
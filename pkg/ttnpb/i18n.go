@@ -81,6 +81,13 @@ func init() {
 	defineEnum(CID_BEACON_TIMING, "beacon timing")
 	defineEnum(CID_BEACON_FREQ, "beacon frequency")
 	defineEnum(CID_DEVICE_MODE, "device mode")
+	defineEnum(CID_RELAY_CONF, "relay configuration")
+	defineEnum(CID_RELAY_END_DEVICE_CONF, "relay end device configuration")
+	defineEnum(CID_RELAY_FILTER_LIST, "relay join-request filter list")
+	defineEnum(CID_RELAY_UPDATE_UPLINK_LIST, "relay uplink forwarding list")
+	defineEnum(CID_RELAY_CTRL_UPLINK_LIST, "relay uplink forwarding list control")
+	defineEnum(CID_RELAY_CONFIGURE_FWD_LIMIT, "relay forwarding limits")
+	defineEnum(CID_RELAY_NOTIFY_NEW_END_DEVICE, "relay new end device notification")
 
 	defineEnum(SOURCE_UNKNOWN, "unknown location source")
 	defineEnum(SOURCE_GPS, "determined by GPS")
@@ -143,6 +150,7 @@ func init() {
 	defineEnum(RIGHT_GATEWAY_LOCATION_READ, "view gateway location")
 	defineEnum(RIGHT_GATEWAY_WRITE_SECRETS, "store secrets for a gateway")
 	defineEnum(RIGHT_GATEWAY_READ_SECRETS, "retrieve secrets associated with a gateway")
+	defineEnum(RIGHT_GATEWAY_REMOTE_COMMANDS, "execute commands on a gateway and open remote shell sessions")
 	defineEnum(RIGHT_GATEWAY_ALL, "all gateway rights")
 
 	defineEnum(RIGHT_ORGANIZATION_INFO, "view organization information")
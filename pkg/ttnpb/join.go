@@ -48,6 +48,8 @@ func (v *JoinRequest) FieldIsZero(p string) bool {
 		return v.DownlinkSettings.FieldIsZero("rx1_dr_offset")
 	case "downlink_settings.rx2_dr":
 		return v.DownlinkSettings.FieldIsZero("rx2_dr")
+	case "join_eui":
+		return v.JoinEUI == nil
 	case "net_id":
 		return v.NetID == types.NetID{}
 	case "payload":
@@ -36,6 +36,7 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type JoinRequest struct {
+	// Raw payload of the join-request or rejoin-request.
 	RawPayload         []byte                                                  `protobuf:"bytes,1,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
 	Payload            *Message                                                `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	DevAddr            go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
@@ -47,9 +48,12 @@ type JoinRequest struct {
 	CFList         *CFList  `protobuf:"bytes,8,opt,name=cf_list,json=cfList,proto3" json:"cf_list,omitempty"`
 	CorrelationIDs []string `protobuf:"bytes,10,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
 	// Consumed airtime for the transmission of the join request. Calculated by Network Server using the RawPayload size and the transmission settings.
-	ConsumedAirtime      *time.Duration `protobuf:"bytes,11,opt,name=consumed_airtime,json=consumedAirtime,proto3,stdduration" json:"consumed_airtime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	ConsumedAirtime *time.Duration `protobuf:"bytes,11,opt,name=consumed_airtime,json=consumedAirtime,proto3,stdduration" json:"consumed_airtime,omitempty"`
+	// JoinEUI of the end device.
+	// Set by Network Server for rejoin-requests of type 0 and 2, which do not contain the JoinEUI.
+	JoinEUI              *go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,12,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                               `json:"-"`
+	XXX_sizecache        int32                                                  `json:"-"`
 }
 
 func (m *JoinRequest) Reset()      { *m = JoinRequest{} }
@@ -215,59 +219,61 @@ func init() {
 }
 
 var fileDescriptor_dd69b88666e72e14 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3d, 0x6c, 0xdb, 0x46,
-	0x18, 0xbd, 0x93, 0xf5, 0xe7, 0x93, 0xe1, 0x28, 0x44, 0x91, 0xb0, 0x6e, 0x71, 0x74, 0x3d, 0x09,
-	0x05, 0x4c, 0xa2, 0x31, 0x8a, 0x02, 0x6d, 0x81, 0xc0, 0xb4, 0x90, 0xc2, 0x69, 0x12, 0x04, 0x34,
-	0xda, 0x21, 0x40, 0x41, 0x9c, 0x79, 0x67, 0x9a, 0x15, 0xcd, 0x53, 0x79, 0x27, 0xc9, 0xca, 0x14,
-	0x64, 0x0a, 0x3a, 0x15, 0x1d, 0x8a, 0x8c, 0x59, 0x0a, 0x64, 0xcc, 0xe8, 0x31, 0xa3, 0x47, 0x8f,
-	0x41, 0x07, 0x35, 0x3a, 0x2e, 0x19, 0x33, 0x06, 0x9a, 0x0a, 0x1d, 0xa9, 0xca, 0x8e, 0x3c, 0x34,
-	0x9d, 0xf8, 0xf1, 0xbe, 0xf7, 0x3d, 0xbc, 0xef, 0xdd, 0x3d, 0xf4, 0x69, 0xcc, 0x53, 0x32, 0x20,
-	0xc9, 0xa6, 0x90, 0x24, 0xe8, 0x38, 0xa4, 0x1b, 0x39, 0x3f, 0xf3, 0x28, 0xb1, 0xbb, 0x29, 0x97,
-	0xdc, 0x58, 0x95, 0x32, 0xb1, 0x0b, 0x84, 0xdd, 0xdf, 0x5a, 0xdb, 0x0e, 0x23, 0x79, 0xd8, 0xdb,
-	0xb7, 0x03, 0x7e, 0xe4, 0xb0, 0xa4, 0xcf, 0x87, 0xdd, 0x94, 0x1f, 0x0f, 0x1d, 0x0d, 0x0e, 0x36,
-	0x43, 0x96, 0x6c, 0xf6, 0x49, 0x1c, 0x51, 0x22, 0x99, 0xb3, 0x50, 0xe4, 0x94, 0x6b, 0x9b, 0xe7,
-	0x28, 0x42, 0x1e, 0xf2, 0x7c, 0x78, 0xbf, 0x77, 0xa0, 0xff, 0xf4, 0x8f, 0xae, 0x0a, 0x38, 0x0e,
-	0x39, 0x0f, 0x63, 0x36, 0x47, 0xd1, 0x5e, 0x4a, 0x64, 0xc4, 0x0b, 0x85, 0x6b, 0x97, 0xe8, 0xef,
-	0xb0, 0xa1, 0x28, 0xba, 0xd6, 0x62, 0x77, 0xb6, 0x8d, 0x06, 0x6c, 0x3c, 0xae, 0xa2, 0xc6, 0x6d,
-	0x1e, 0x25, 0x1e, 0xfb, 0xa5, 0xc7, 0x84, 0x34, 0x5a, 0xa8, 0x91, 0x92, 0x81, 0xdf, 0x25, 0xc3,
-	0x98, 0x13, 0x6a, 0xc2, 0x75, 0xd8, 0x5a, 0x71, 0x6b, 0x13, 0xb7, 0xfc, 0xb0, 0x74, 0x78, 0xdd,
-	0x43, 0x29, 0x19, 0xdc, 0xcf, 0x5b, 0xc6, 0x17, 0xa8, 0x36, 0x43, 0x95, 0xd6, 0x61, 0xab, 0x71,
-	0xe3, 0xba, 0x7d, 0xd1, 0x2c, 0xfb, 0x2e, 0x13, 0x82, 0x84, 0xcc, 0x9b, 0xe1, 0x8c, 0x07, 0xa8,
-	0x4e, 0x59, 0xdf, 0x27, 0x94, 0xa6, 0xe6, 0x92, 0x66, 0xbe, 0x79, 0x3a, 0xb2, 0xc0, 0x5f, 0x23,
-	0xeb, 0xab, 0x90, 0xdb, 0xf2, 0x90, 0xc9, 0xc3, 0x28, 0x09, 0x85, 0x9d, 0x30, 0x39, 0xe0, 0x69,
-	0xc7, 0xb9, 0x28, 0xbe, 0xbf, 0xe5, 0x74, 0x3b, 0xa1, 0x23, 0x87, 0x5d, 0x26, 0xec, 0x36, 0xeb,
-	0x6f, 0x53, 0x9a, 0x7a, 0x35, 0x9a, 0x17, 0x06, 0x45, 0x1f, 0x09, 0x16, 0xb3, 0x40, 0x32, 0xea,
-	0x1f, 0x91, 0xc0, 0xef, 0xb3, 0x54, 0x44, 0x3c, 0x31, 0xcb, 0xeb, 0xb0, 0xb5, 0x7a, 0x63, 0x6d,
-	0x41, 0xdb, 0xf6, 0xce, 0x8f, 0x39, 0xc2, 0xbd, 0xa6, 0x46, 0x96, 0xb1, 0x57, 0xcc, 0xce, 0xcf,
-	0x3d, 0x63, 0xc6, 0x77, 0x97, 0x04, 0xc5, 0x99, 0xf1, 0x13, 0xaa, 0x26, 0x4c, 0xfa, 0x11, 0x35,
-	0x2b, 0x5a, 0xff, 0xad, 0x42, 0xff, 0x97, 0x1f, 0xaa, 0xff, 0x1e, 0x93, 0xbb, 0x6d, 0x35, 0xb2,
-	0x2a, 0xba, 0xf0, 0x2a, 0x09, 0x93, 0xbb, 0xd4, 0xf8, 0x01, 0x5d, 0xa5, 0x7c, 0x90, 0xc4, 0x51,
-	0xd2, 0xf1, 0x05, 0x93, 0x72, 0xca, 0x66, 0x56, 0xb5, 0xbb, 0x0b, 0x1b, 0xb4, 0xef, 0xec, 0x15,
-	0x08, 0x77, 0x65, 0xe2, 0x56, 0x7e, 0x85, 0xa5, 0x26, 0x9c, 0xaa, 0xf1, 0x9a, 0x33, 0x8a, 0x59,
-	0xdf, 0xf8, 0x16, 0xd5, 0xd3, 0x63, 0x9f, 0xb2, 0x98, 0x0c, 0xcd, 0x9a, 0xf6, 0x63, 0xe1, 0xae,
-	0xbc, 0xe3, 0xf6, 0xb4, 0xed, 0xd6, 0x27, 0x6e, 0xe5, 0xf1, 0x94, 0xca, 0xab, 0xa5, 0xf9, 0x91,
-	0xf1, 0x0d, 0xaa, 0x05, 0x07, 0x7e, 0x1c, 0x09, 0x69, 0xd6, 0xb5, 0x94, 0x6b, 0xef, 0x0f, 0xef,
-	0xdc, 0xba, 0x13, 0x09, 0xe9, 0x22, 0x35, 0xb2, 0xaa, 0x79, 0xed, 0x55, 0x83, 0x83, 0xe9, 0xd7,
-	0xf8, 0x0e, 0x5d, 0x09, 0x78, 0x9a, 0xb2, 0x58, 0xbf, 0x59, 0x3f, 0xa2, 0xc2, 0x44, 0xeb, 0x4b,
-	0xad, 0x65, 0x17, 0x4f, 0xdc, 0xe5, 0xdf, 0x61, 0x75, 0xa3, 0x9c, 0x96, 0x4c, 0xaa, 0x46, 0xd6,
-	0xea, 0xce, 0x1c, 0xb6, 0xdb, 0x16, 0xde, 0xea, 0xb9, 0xb1, 0x5d, 0x2a, 0x8c, 0x7b, 0xa8, 0x19,
-	0xf0, 0x44, 0xf4, 0x8e, 0x18, 0xf5, 0x49, 0x94, 0xca, 0xe8, 0x88, 0x99, 0x0d, 0x2d, 0xe7, 0x63,
-	0x3b, 0x8f, 0x88, 0x3d, 0x8b, 0x88, 0xdd, 0x2e, 0x22, 0xe2, 0xd6, 0x4f, 0x47, 0x16, 0x7c, 0xfa,
-	0xb7, 0x05, 0xbd, 0x2b, 0xb3, 0xe1, 0xed, 0x7c, 0xf6, 0xeb, 0xf2, 0xc9, 0x33, 0x0b, 0xdc, 0x2e,
-	0xd7, 0x97, 0x9b, 0x68, 0xe3, 0x8f, 0x12, 0x5a, 0xc9, 0x43, 0x20, 0xba, 0x3c, 0x11, 0xcc, 0xf8,
-	0xfc, 0xb2, 0x14, 0x2c, 0x4f, 0xdc, 0xea, 0xc3, 0x72, 0xf3, 0xaa, 0xf9, 0xd9, 0x85, 0x1c, 0xdc,
-	0x47, 0x2b, 0x82, 0x89, 0xe9, 0xeb, 0xf0, 0xa7, 0xc1, 0x2b, 0xc2, 0xf0, 0xc9, 0xfb, 0x1e, 0xed,
-	0xe5, 0x98, 0xef, 0xd9, 0x50, 0xb8, 0xcd, 0xf3, 0xf7, 0x75, 0x36, 0xb2, 0xa0, 0xd7, 0x10, 0xf3,
-	0xb6, 0x71, 0x13, 0xd5, 0xe3, 0xe8, 0x80, 0xe9, 0x15, 0x97, 0xfe, 0xcb, 0x8a, 0x40, 0xaf, 0xf8,
-	0xef, 0xd0, 0x65, 0xa6, 0x97, 0xff, 0x8f, 0xe9, 0xee, 0x9f, 0xf0, 0x74, 0x8c, 0xe1, 0xd9, 0x18,
-	0xc3, 0x57, 0x63, 0x0c, 0x5e, 0x8f, 0x31, 0x78, 0x33, 0xc6, 0xe0, 0xed, 0x18, 0x83, 0x77, 0x63,
-	0x0c, 0x1f, 0x29, 0x0c, 0x9f, 0x28, 0x0c, 0x9e, 0x2b, 0x0c, 0x5f, 0x28, 0x0c, 0x4e, 0x14, 0x06,
-	0x2f, 0x15, 0x06, 0xa7, 0x0a, 0xc3, 0x33, 0x85, 0xe1, 0x2b, 0x85, 0xc1, 0x6b, 0x85, 0xe1, 0x1b,
-	0x85, 0xc1, 0x5b, 0x85, 0xe1, 0x3b, 0x85, 0xc1, 0xa3, 0x0c, 0x83, 0x27, 0x19, 0x86, 0xbf, 0x65,
-	0x18, 0x3c, 0xcd, 0x30, 0x7c, 0x96, 0x61, 0xf0, 0x3c, 0xc3, 0xe0, 0x45, 0x86, 0xe1, 0x49, 0x86,
-	0xe1, 0xcb, 0x0c, 0xc3, 0x07, 0xce, 0x07, 0xa4, 0x49, 0x26, 0xdd, 0xfd, 0xfd, 0xaa, 0xf6, 0x65,
-	0xeb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89, 0x9f, 0xcb, 0x41, 0xcd, 0x05, 0x00, 0x00,
+	// 859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3d, 0x68, 0x1c, 0x47,
+	0x18, 0x9d, 0x91, 0xee, 0x4f, 0x73, 0x87, 0x7c, 0xde, 0x04, 0x67, 0xa3, 0x84, 0x59, 0x45, 0x95,
+	0x08, 0x68, 0x97, 0x58, 0xf9, 0x81, 0x24, 0x60, 0xb4, 0x3a, 0x39, 0x9c, 0x63, 0x1b, 0xb3, 0x42,
+	0x29, 0x0c, 0x61, 0x59, 0xed, 0x8c, 0x56, 0x93, 0x5b, 0xed, 0x5c, 0x76, 0xe6, 0xee, 0x74, 0xa9,
+	0x4c, 0x2a, 0x93, 0x2a, 0xa4, 0x08, 0x2e, 0xdd, 0x04, 0x5c, 0xba, 0x54, 0xe9, 0x52, 0xa5, 0x4a,
+	0x93, 0x62, 0xe3, 0x9b, 0x25, 0xe0, 0xd2, 0xa5, 0x51, 0x15, 0xf6, 0xe7, 0x22, 0xc9, 0x67, 0x42,
+	0xec, 0x6a, 0xbf, 0x9d, 0xef, 0x7d, 0x8f, 0x37, 0xdf, 0x9b, 0x87, 0x3e, 0x0c, 0x79, 0xec, 0x8d,
+	0xbc, 0x68, 0x4d, 0x48, 0xcf, 0xef, 0x59, 0x5e, 0x9f, 0x59, 0x3f, 0x70, 0x16, 0x99, 0xfd, 0x98,
+	0x4b, 0xae, 0x2d, 0x4a, 0x19, 0x99, 0x25, 0xc2, 0x1c, 0xae, 0x2f, 0x6d, 0x04, 0x4c, 0xee, 0x0f,
+	0x76, 0x4d, 0x9f, 0x1f, 0x58, 0x34, 0x1a, 0xf2, 0x71, 0x3f, 0xe6, 0x87, 0x63, 0x2b, 0x07, 0xfb,
+	0x6b, 0x01, 0x8d, 0xd6, 0x86, 0x5e, 0xc8, 0x88, 0x27, 0xa9, 0x35, 0x53, 0x14, 0x94, 0x4b, 0x6b,
+	0xe7, 0x28, 0x02, 0x1e, 0xf0, 0x62, 0x78, 0x77, 0xb0, 0x97, 0xff, 0xe5, 0x3f, 0x79, 0x55, 0xc2,
+	0x71, 0xc0, 0x79, 0x10, 0xd2, 0x33, 0x14, 0x19, 0xc4, 0x9e, 0x64, 0xbc, 0x54, 0xb8, 0xf4, 0x1a,
+	0xfd, 0x3d, 0x3a, 0x16, 0x65, 0xd7, 0x98, 0xed, 0x4e, 0x6f, 0x93, 0x03, 0x56, 0xfe, 0xae, 0xa1,
+	0xe6, 0x0d, 0xce, 0x22, 0x87, 0xfe, 0x38, 0xa0, 0x42, 0x6a, 0x1f, 0xa3, 0x66, 0xec, 0x8d, 0xdc,
+	0xbe, 0x37, 0x0e, 0xb9, 0x47, 0x74, 0xb8, 0x0c, 0x57, 0x5b, 0xf6, 0xc2, 0xa9, 0x5d, 0xfb, 0xa9,
+	0xd2, 0x7e, 0x47, 0xd7, 0x1d, 0x14, 0x7b, 0xa3, 0x3b, 0x45, 0x53, 0xfb, 0x04, 0xd5, 0xa7, 0xb8,
+	0xb9, 0x65, 0xb8, 0xda, 0xbc, 0xfa, 0x9e, 0x79, 0x71, 0x5d, 0xe6, 0x2d, 0x2a, 0x84, 0x17, 0x50,
+	0x67, 0x8a, 0xd3, 0xee, 0xa2, 0x06, 0xa1, 0x43, 0xd7, 0x23, 0x24, 0xd6, 0xe7, 0x73, 0xee, 0x6b,
+	0xc7, 0x89, 0x01, 0xfe, 0x4c, 0x8c, 0x2f, 0x02, 0x6e, 0xca, 0x7d, 0x2a, 0xf7, 0x59, 0x14, 0x08,
+	0x33, 0xa2, 0x72, 0xc4, 0xe3, 0x9e, 0x75, 0x51, 0xfe, 0x70, 0xdd, 0xea, 0xf7, 0x02, 0x4b, 0x8e,
+	0xfb, 0x54, 0x98, 0x1d, 0x3a, 0xdc, 0x20, 0x24, 0x76, 0xea, 0xa4, 0x28, 0x34, 0x82, 0xde, 0x15,
+	0x34, 0xa4, 0xbe, 0xa4, 0xc4, 0x3d, 0xf0, 0x7c, 0x77, 0x48, 0x63, 0xc1, 0x78, 0xa4, 0x57, 0x96,
+	0xe1, 0xea, 0xe2, 0xd5, 0xa5, 0x19, 0x6d, 0x1b, 0x9b, 0xdf, 0x15, 0x08, 0xfb, 0x8a, 0x4a, 0x0c,
+	0x6d, 0xbb, 0x9c, 0x3d, 0x3b, 0x77, 0xb4, 0x29, 0xdf, 0x2d, 0xcf, 0x2f, 0xcf, 0xb4, 0xef, 0x51,
+	0x2d, 0xa2, 0xd2, 0x65, 0x44, 0xaf, 0xe6, 0xfa, 0xaf, 0x97, 0xfa, 0x3f, 0x7b, 0x53, 0xfd, 0xb7,
+	0xa9, 0xec, 0x76, 0x54, 0x62, 0x54, 0xf3, 0xc2, 0xa9, 0x46, 0x54, 0x76, 0x89, 0xb6, 0x83, 0x2e,
+	0x13, 0x3e, 0x8a, 0x42, 0x16, 0xf5, 0x5c, 0x41, 0xa5, 0xcc, 0xd8, 0xf4, 0x5a, 0xbe, 0xdd, 0x99,
+	0x1b, 0x74, 0x6e, 0x6e, 0x97, 0x08, 0xbb, 0x75, 0x6a, 0x57, 0x7f, 0x81, 0x73, 0x6d, 0x98, 0xa9,
+	0x71, 0xda, 0x53, 0x8a, 0x69, 0x5f, 0xfb, 0x1a, 0x35, 0xe2, 0x43, 0x97, 0xd0, 0xd0, 0x1b, 0xeb,
+	0xf5, 0x7c, 0x1f, 0x33, 0x5e, 0x39, 0x87, 0x9d, 0xac, 0x6d, 0x37, 0x4e, 0xed, 0xea, 0xcf, 0x19,
+	0x95, 0x53, 0x8f, 0x8b, 0x23, 0xed, 0x2b, 0x54, 0xf7, 0xf7, 0xdc, 0x90, 0x09, 0xa9, 0x37, 0x72,
+	0x29, 0x57, 0x5e, 0x1d, 0xde, 0xbc, 0x7e, 0x93, 0x09, 0x69, 0x23, 0x95, 0x18, 0xb5, 0xa2, 0x76,
+	0x6a, 0xfe, 0x5e, 0xf6, 0xd5, 0xbe, 0x41, 0x97, 0x7c, 0x1e, 0xc7, 0x34, 0xcc, 0x5f, 0xad, 0xcb,
+	0x88, 0xd0, 0xd1, 0xf2, 0xfc, 0xea, 0x82, 0x8d, 0x4f, 0xed, 0x85, 0xdf, 0x60, 0x6d, 0xa5, 0x12,
+	0xcf, 0xe9, 0x44, 0x25, 0xc6, 0xe2, 0xe6, 0x19, 0xac, 0xdb, 0x11, 0xce, 0xe2, 0xb9, 0xb1, 0x2e,
+	0x11, 0xda, 0x6d, 0xd4, 0xf6, 0x79, 0x24, 0x06, 0x07, 0x94, 0xb8, 0x1e, 0x8b, 0x25, 0x3b, 0xa0,
+	0x7a, 0x33, 0x97, 0xf3, 0xbe, 0x59, 0x84, 0xc4, 0x9c, 0x86, 0xc4, 0xec, 0x94, 0x21, 0xb1, 0x1b,
+	0xc7, 0x89, 0x01, 0x1f, 0xfc, 0x65, 0x40, 0xe7, 0xd2, 0x74, 0x78, 0xa3, 0x98, 0xd5, 0x5c, 0xd4,
+	0xc8, 0x92, 0xee, 0xd2, 0x01, 0xd3, 0x5b, 0xb9, 0x97, 0x9d, 0xb7, 0xf1, 0x71, 0x6b, 0xa7, 0xfb,
+	0xf9, 0xa7, 0x2a, 0x31, 0xea, 0x59, 0x8c, 0xb6, 0x76, 0xba, 0x4e, 0x3d, 0x63, 0xdd, 0x1a, 0xb0,
+	0x2f, 0x2b, 0x47, 0x0f, 0x0d, 0x70, 0xa3, 0xd2, 0x58, 0x68, 0xa3, 0x95, 0xdf, 0xe7, 0x50, 0xab,
+	0xc8, 0x99, 0xe8, 0xf3, 0x48, 0xd0, 0xff, 0x0c, 0xda, 0x65, 0xfd, 0xa3, 0x0b, 0x41, 0xbb, 0x83,
+	0x5a, 0x82, 0x8a, 0xec, 0xf9, 0xb9, 0x59, 0xb6, 0xcb, 0xb4, 0x7d, 0xf0, 0xaa, 0x09, 0xdb, 0x05,
+	0xe6, 0x5b, 0x3a, 0x16, 0x76, 0xfb, 0xfc, 0x83, 0x38, 0x49, 0x0c, 0xe8, 0x34, 0xc5, 0x59, 0x5b,
+	0xbb, 0x86, 0x1a, 0x21, 0xdb, 0xa3, 0xf9, 0x0e, 0xe7, 0xff, 0xcf, 0x0e, 0x41, 0xbe, 0xc3, 0x7f,
+	0x87, 0x5e, 0xe7, 0x6a, 0xe5, 0x6d, 0x5c, 0xb5, 0xff, 0x80, 0xc7, 0x13, 0x0c, 0x4f, 0x26, 0x18,
+	0x3e, 0x9d, 0x60, 0xf0, 0x6c, 0x82, 0xc1, 0xf3, 0x09, 0x06, 0x2f, 0x26, 0x18, 0xbc, 0x9c, 0x60,
+	0x78, 0x4f, 0x61, 0x78, 0x5f, 0x61, 0xf0, 0x48, 0x61, 0xf8, 0x58, 0x61, 0x70, 0xa4, 0x30, 0x78,
+	0xa2, 0x30, 0x38, 0x56, 0x18, 0x9e, 0x28, 0x0c, 0x9f, 0x2a, 0x0c, 0x9e, 0x29, 0x0c, 0x9f, 0x2b,
+	0x0c, 0x5e, 0x28, 0x0c, 0x5f, 0x2a, 0x0c, 0xee, 0xa5, 0x18, 0xdc, 0x4f, 0x31, 0xfc, 0x35, 0xc5,
+	0xe0, 0x41, 0x8a, 0xe1, 0xc3, 0x14, 0x83, 0x47, 0x29, 0x06, 0x8f, 0x53, 0x0c, 0x8f, 0x52, 0x0c,
+	0x9f, 0xa4, 0x18, 0xde, 0xb5, 0xde, 0xc0, 0x66, 0x19, 0xf5, 0x77, 0x77, 0x6b, 0xf9, 0x5e, 0xd6,
+	0xff, 0x19, 0x00, 0xc9, 0x8a, 0x46, 0x4c, 0x30, 0x06, 0x00, 0x00,
 }
 
 func (this *JoinRequest) Equal(that interface{}) bool {
@@ -330,6 +336,13 @@ func (this *JoinRequest) Equal(that interface{}) bool {
 	} else if that1.ConsumedAirtime != nil {
 		return false
 	}
+	if that1.JoinEUI == nil {
+		if this.JoinEUI != nil {
+			return false
+		}
+	} else if !this.JoinEUI.Equal(*that1.JoinEUI) {
+		return false
+	}
 	return true
 }
 func (this *JoinResponse) Equal(that interface{}) bool {
@@ -390,6 +403,18 @@ func (m *JoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JoinEUI != nil {
+		{
+			size := m.JoinEUI.Size()
+			i -= size
+			if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintJoin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.ConsumedAirtime != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ConsumedAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ConsumedAirtime):])
 		if err1 != nil {
@@ -684,6 +709,10 @@ func (m *JoinRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ConsumedAirtime)
 		n += 1 + l + sovJoin(uint64(l))
 	}
+	if m.JoinEUI != nil {
+		l = m.JoinEUI.Size()
+		n += 1 + l + sovJoin(uint64(l))
+	}
 	return n
 }
 
@@ -731,6 +760,7 @@ func (this *JoinRequest) String() string {
 		`CFList:` + strings.Replace(fmt.Sprintf("%v", this.CFList), "CFList", "CFList", 1) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`ConsumedAirtime:` + strings.Replace(fmt.Sprintf("%v", this.ConsumedAirtime), "Duration", "types.Duration", 1) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1096,6 +1126,41 @@ func (m *JoinRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJoin(dAtA[iNdEx:])
@@ -52,10 +52,10 @@ func (m *JoinRequest) ValidateFields(paths ...string) error {
 		switch name {
 		case "raw_payload":
 
-			if len(m.GetRawPayload()) != 23 {
+			if l := len(m.GetRawPayload()); l < 19 || l > 24 {
 				return JoinRequestValidationError{
 					field:  "raw_payload",
-					reason: "value length must be 23 bytes",
+					reason: "value length must be between 19 and 24 bytes, inclusive",
 				}
 			}
 
@@ -136,6 +136,8 @@ func (m *JoinRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "join_eui":
+			// no validation rules for JoinEUI
 		default:
 			return JoinRequestValidationError{
 				field:  name,
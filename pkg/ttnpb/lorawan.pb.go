@@ -340,27 +340,34 @@ func (TxSchedulePriority) EnumDescriptor() ([]byte, []int) {
 type MACCommandIdentifier int32
 
 const (
-	CID_RFU_0              MACCommandIdentifier = 0
-	CID_RESET              MACCommandIdentifier = 1
-	CID_LINK_CHECK         MACCommandIdentifier = 2
-	CID_LINK_ADR           MACCommandIdentifier = 3
-	CID_DUTY_CYCLE         MACCommandIdentifier = 4
-	CID_RX_PARAM_SETUP     MACCommandIdentifier = 5
-	CID_DEV_STATUS         MACCommandIdentifier = 6
-	CID_NEW_CHANNEL        MACCommandIdentifier = 7
-	CID_RX_TIMING_SETUP    MACCommandIdentifier = 8
-	CID_TX_PARAM_SETUP     MACCommandIdentifier = 9
-	CID_DL_CHANNEL         MACCommandIdentifier = 10
-	CID_REKEY              MACCommandIdentifier = 11
-	CID_ADR_PARAM_SETUP    MACCommandIdentifier = 12
-	CID_DEVICE_TIME        MACCommandIdentifier = 13
-	CID_FORCE_REJOIN       MACCommandIdentifier = 14
-	CID_REJOIN_PARAM_SETUP MACCommandIdentifier = 15
-	CID_PING_SLOT_INFO     MACCommandIdentifier = 16
-	CID_PING_SLOT_CHANNEL  MACCommandIdentifier = 17
-	CID_BEACON_TIMING      MACCommandIdentifier = 18
-	CID_BEACON_FREQ        MACCommandIdentifier = 19
-	CID_DEVICE_MODE        MACCommandIdentifier = 32
+	CID_RFU_0                       MACCommandIdentifier = 0
+	CID_RESET                       MACCommandIdentifier = 1
+	CID_LINK_CHECK                  MACCommandIdentifier = 2
+	CID_LINK_ADR                    MACCommandIdentifier = 3
+	CID_DUTY_CYCLE                  MACCommandIdentifier = 4
+	CID_RX_PARAM_SETUP              MACCommandIdentifier = 5
+	CID_DEV_STATUS                  MACCommandIdentifier = 6
+	CID_NEW_CHANNEL                 MACCommandIdentifier = 7
+	CID_RX_TIMING_SETUP             MACCommandIdentifier = 8
+	CID_TX_PARAM_SETUP              MACCommandIdentifier = 9
+	CID_DL_CHANNEL                  MACCommandIdentifier = 10
+	CID_REKEY                       MACCommandIdentifier = 11
+	CID_ADR_PARAM_SETUP             MACCommandIdentifier = 12
+	CID_DEVICE_TIME                 MACCommandIdentifier = 13
+	CID_FORCE_REJOIN                MACCommandIdentifier = 14
+	CID_REJOIN_PARAM_SETUP          MACCommandIdentifier = 15
+	CID_PING_SLOT_INFO              MACCommandIdentifier = 16
+	CID_PING_SLOT_CHANNEL           MACCommandIdentifier = 17
+	CID_BEACON_TIMING               MACCommandIdentifier = 18
+	CID_BEACON_FREQ                 MACCommandIdentifier = 19
+	CID_DEVICE_MODE                 MACCommandIdentifier = 32
+	CID_RELAY_CONF                  MACCommandIdentifier = 64
+	CID_RELAY_END_DEVICE_CONF       MACCommandIdentifier = 65
+	CID_RELAY_FILTER_LIST           MACCommandIdentifier = 66
+	CID_RELAY_UPDATE_UPLINK_LIST    MACCommandIdentifier = 67
+	CID_RELAY_CTRL_UPLINK_LIST      MACCommandIdentifier = 68
+	CID_RELAY_CONFIGURE_FWD_LIMIT   MACCommandIdentifier = 69
+	CID_RELAY_NOTIFY_NEW_END_DEVICE MACCommandIdentifier = 70
 )
 
 var MACCommandIdentifier_name = map[int32]string{
@@ -385,30 +392,44 @@ var MACCommandIdentifier_name = map[int32]string{
 	18: "CID_BEACON_TIMING",
 	19: "CID_BEACON_FREQ",
 	32: "CID_DEVICE_MODE",
+	64: "CID_RELAY_CONF",
+	65: "CID_RELAY_END_DEVICE_CONF",
+	66: "CID_RELAY_FILTER_LIST",
+	67: "CID_RELAY_UPDATE_UPLINK_LIST",
+	68: "CID_RELAY_CTRL_UPLINK_LIST",
+	69: "CID_RELAY_CONFIGURE_FWD_LIMIT",
+	70: "CID_RELAY_NOTIFY_NEW_END_DEVICE",
 }
 
 var MACCommandIdentifier_value = map[string]int32{
-	"CID_RFU_0":              0,
-	"CID_RESET":              1,
-	"CID_LINK_CHECK":         2,
-	"CID_LINK_ADR":           3,
-	"CID_DUTY_CYCLE":         4,
-	"CID_RX_PARAM_SETUP":     5,
-	"CID_DEV_STATUS":         6,
-	"CID_NEW_CHANNEL":        7,
-	"CID_RX_TIMING_SETUP":    8,
-	"CID_TX_PARAM_SETUP":     9,
-	"CID_DL_CHANNEL":         10,
-	"CID_REKEY":              11,
-	"CID_ADR_PARAM_SETUP":    12,
-	"CID_DEVICE_TIME":        13,
-	"CID_FORCE_REJOIN":       14,
-	"CID_REJOIN_PARAM_SETUP": 15,
-	"CID_PING_SLOT_INFO":     16,
-	"CID_PING_SLOT_CHANNEL":  17,
-	"CID_BEACON_TIMING":      18,
-	"CID_BEACON_FREQ":        19,
-	"CID_DEVICE_MODE":        32,
+	"CID_RFU_0":                       0,
+	"CID_RESET":                       1,
+	"CID_LINK_CHECK":                  2,
+	"CID_LINK_ADR":                    3,
+	"CID_DUTY_CYCLE":                  4,
+	"CID_RX_PARAM_SETUP":              5,
+	"CID_DEV_STATUS":                  6,
+	"CID_NEW_CHANNEL":                 7,
+	"CID_RX_TIMING_SETUP":             8,
+	"CID_TX_PARAM_SETUP":              9,
+	"CID_DL_CHANNEL":                  10,
+	"CID_REKEY":                       11,
+	"CID_ADR_PARAM_SETUP":             12,
+	"CID_DEVICE_TIME":                 13,
+	"CID_FORCE_REJOIN":                14,
+	"CID_REJOIN_PARAM_SETUP":          15,
+	"CID_PING_SLOT_INFO":              16,
+	"CID_PING_SLOT_CHANNEL":           17,
+	"CID_BEACON_TIMING":               18,
+	"CID_BEACON_FREQ":                 19,
+	"CID_DEVICE_MODE":                 32,
+	"CID_RELAY_CONF":                  64,
+	"CID_RELAY_END_DEVICE_CONF":       65,
+	"CID_RELAY_FILTER_LIST":           66,
+	"CID_RELAY_UPDATE_UPLINK_LIST":    67,
+	"CID_RELAY_CTRL_UPLINK_LIST":      68,
+	"CID_RELAY_CONFIGURE_FWD_LIMIT":   69,
+	"CID_RELAY_NOTIFY_NEW_END_DEVICE": 70,
 }
 
 func (MACCommandIdentifier) EnumDescriptor() ([]byte, []int) {
@@ -997,6 +1018,225 @@ func (Minor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2084d1d5a227b67e, []int{19}
 }
 
+type RelayCADPeriodicity int32
+
+const (
+	RELAY_CAD_PERIODICITY_1_SECOND         RelayCADPeriodicity = 0
+	RELAY_CAD_PERIODICITY_500_MILLISECONDS RelayCADPeriodicity = 1
+	RELAY_CAD_PERIODICITY_250_MILLISECONDS RelayCADPeriodicity = 2
+	RELAY_CAD_PERIODICITY_100_MILLISECONDS RelayCADPeriodicity = 3
+	RELAY_CAD_PERIODICITY_50_MILLISECONDS  RelayCADPeriodicity = 4
+	RELAY_CAD_PERIODICITY_20_MILLISECONDS  RelayCADPeriodicity = 5
+)
+
+var RelayCADPeriodicity_name = map[int32]string{
+	0: "RELAY_CAD_PERIODICITY_1_SECOND",
+	1: "RELAY_CAD_PERIODICITY_500_MILLISECONDS",
+	2: "RELAY_CAD_PERIODICITY_250_MILLISECONDS",
+	3: "RELAY_CAD_PERIODICITY_100_MILLISECONDS",
+	4: "RELAY_CAD_PERIODICITY_50_MILLISECONDS",
+	5: "RELAY_CAD_PERIODICITY_20_MILLISECONDS",
+}
+
+var RelayCADPeriodicity_value = map[string]int32{
+	"RELAY_CAD_PERIODICITY_1_SECOND":         0,
+	"RELAY_CAD_PERIODICITY_500_MILLISECONDS": 1,
+	"RELAY_CAD_PERIODICITY_250_MILLISECONDS": 2,
+	"RELAY_CAD_PERIODICITY_100_MILLISECONDS": 3,
+	"RELAY_CAD_PERIODICITY_50_MILLISECONDS":  4,
+	"RELAY_CAD_PERIODICITY_20_MILLISECONDS":  5,
+}
+
+func (RelayCADPeriodicity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
+}
+
+type RelaySecondChAckOffset int32
+
+const (
+	RELAY_SECOND_CH_ACK_OFFSET_0    RelaySecondChAckOffset = 0
+	RELAY_SECOND_CH_ACK_OFFSET_200  RelaySecondChAckOffset = 1
+	RELAY_SECOND_CH_ACK_OFFSET_400  RelaySecondChAckOffset = 2
+	RELAY_SECOND_CH_ACK_OFFSET_800  RelaySecondChAckOffset = 3
+	RELAY_SECOND_CH_ACK_OFFSET_1600 RelaySecondChAckOffset = 4
+	RELAY_SECOND_CH_ACK_OFFSET_3200 RelaySecondChAckOffset = 5
+)
+
+var RelaySecondChAckOffset_name = map[int32]string{
+	0: "RELAY_SECOND_CH_ACK_OFFSET_0",
+	1: "RELAY_SECOND_CH_ACK_OFFSET_200",
+	2: "RELAY_SECOND_CH_ACK_OFFSET_400",
+	3: "RELAY_SECOND_CH_ACK_OFFSET_800",
+	4: "RELAY_SECOND_CH_ACK_OFFSET_1600",
+	5: "RELAY_SECOND_CH_ACK_OFFSET_3200",
+}
+
+var RelaySecondChAckOffset_value = map[string]int32{
+	"RELAY_SECOND_CH_ACK_OFFSET_0":    0,
+	"RELAY_SECOND_CH_ACK_OFFSET_200":  1,
+	"RELAY_SECOND_CH_ACK_OFFSET_400":  2,
+	"RELAY_SECOND_CH_ACK_OFFSET_800":  3,
+	"RELAY_SECOND_CH_ACK_OFFSET_1600": 4,
+	"RELAY_SECOND_CH_ACK_OFFSET_3200": 5,
+}
+
+func (RelaySecondChAckOffset) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{21}
+}
+
+type RelayEndDeviceMode int32
+
+const (
+	RELAY_END_DEVICE_MODE_DISABLED              RelayEndDeviceMode = 0
+	RELAY_END_DEVICE_MODE_ALWAYS                RelayEndDeviceMode = 1
+	RELAY_END_DEVICE_MODE_DYNAMIC               RelayEndDeviceMode = 2
+	RELAY_END_DEVICE_MODE_END_DEVICE_CONTROLLED RelayEndDeviceMode = 3
+)
+
+var RelayEndDeviceMode_name = map[int32]string{
+	0: "RELAY_END_DEVICE_MODE_DISABLED",
+	1: "RELAY_END_DEVICE_MODE_ALWAYS",
+	2: "RELAY_END_DEVICE_MODE_DYNAMIC",
+	3: "RELAY_END_DEVICE_MODE_END_DEVICE_CONTROLLED",
+}
+
+var RelayEndDeviceMode_value = map[string]int32{
+	"RELAY_END_DEVICE_MODE_DISABLED":              0,
+	"RELAY_END_DEVICE_MODE_ALWAYS":                1,
+	"RELAY_END_DEVICE_MODE_DYNAMIC":               2,
+	"RELAY_END_DEVICE_MODE_END_DEVICE_CONTROLLED": 3,
+}
+
+func (RelayEndDeviceMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
+}
+
+type RelaySmartEnableLevel int32
+
+const (
+	RELAY_SMART_ENABLE_LEVEL_8  RelaySmartEnableLevel = 0
+	RELAY_SMART_ENABLE_LEVEL_16 RelaySmartEnableLevel = 1
+	RELAY_SMART_ENABLE_LEVEL_32 RelaySmartEnableLevel = 2
+	RELAY_SMART_ENABLE_LEVEL_64 RelaySmartEnableLevel = 3
+)
+
+var RelaySmartEnableLevel_name = map[int32]string{
+	0: "RELAY_SMART_ENABLE_LEVEL_8",
+	1: "RELAY_SMART_ENABLE_LEVEL_16",
+	2: "RELAY_SMART_ENABLE_LEVEL_32",
+	3: "RELAY_SMART_ENABLE_LEVEL_64",
+}
+
+var RelaySmartEnableLevel_value = map[string]int32{
+	"RELAY_SMART_ENABLE_LEVEL_8":  0,
+	"RELAY_SMART_ENABLE_LEVEL_16": 1,
+	"RELAY_SMART_ENABLE_LEVEL_32": 2,
+	"RELAY_SMART_ENABLE_LEVEL_64": 3,
+}
+
+func (RelaySmartEnableLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
+}
+
+type RelayFilterListAction int32
+
+const (
+	RELAY_FILTER_LIST_ACTION_NO_RULE RelayFilterListAction = 0
+	RELAY_FILTER_LIST_ACTION_FORWARD RelayFilterListAction = 1
+	RELAY_FILTER_LIST_ACTION_FILTER  RelayFilterListAction = 2
+)
+
+var RelayFilterListAction_name = map[int32]string{
+	0: "RELAY_FILTER_LIST_ACTION_NO_RULE",
+	1: "RELAY_FILTER_LIST_ACTION_FORWARD",
+	2: "RELAY_FILTER_LIST_ACTION_FILTER",
+}
+
+var RelayFilterListAction_value = map[string]int32{
+	"RELAY_FILTER_LIST_ACTION_NO_RULE": 0,
+	"RELAY_FILTER_LIST_ACTION_FORWARD": 1,
+	"RELAY_FILTER_LIST_ACTION_FILTER":  2,
+}
+
+func (RelayFilterListAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
+}
+
+type RelayCtrlUplinkListAction int32
+
+const (
+	RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT              RelayCtrlUplinkListAction = 0
+	RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE RelayCtrlUplinkListAction = 1
+)
+
+var RelayCtrlUplinkListAction_name = map[int32]string{
+	0: "RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT",
+	1: "RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE",
+}
+
+var RelayCtrlUplinkListAction_value = map[string]int32{
+	"RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT":              0,
+	"RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE": 1,
+}
+
+func (RelayCtrlUplinkListAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
+}
+
+type RelayLimitBucketSize int32
+
+const (
+	RELAY_LIMIT_BUCKET_SIZE_1  RelayLimitBucketSize = 0
+	RELAY_LIMIT_BUCKET_SIZE_2  RelayLimitBucketSize = 1
+	RELAY_LIMIT_BUCKET_SIZE_4  RelayLimitBucketSize = 2
+	RELAY_LIMIT_BUCKET_SIZE_12 RelayLimitBucketSize = 3
+)
+
+var RelayLimitBucketSize_name = map[int32]string{
+	0: "RELAY_LIMIT_BUCKET_SIZE_1",
+	1: "RELAY_LIMIT_BUCKET_SIZE_2",
+	2: "RELAY_LIMIT_BUCKET_SIZE_4",
+	3: "RELAY_LIMIT_BUCKET_SIZE_12",
+}
+
+var RelayLimitBucketSize_value = map[string]int32{
+	"RELAY_LIMIT_BUCKET_SIZE_1":  0,
+	"RELAY_LIMIT_BUCKET_SIZE_2":  1,
+	"RELAY_LIMIT_BUCKET_SIZE_4":  2,
+	"RELAY_LIMIT_BUCKET_SIZE_12": 3,
+}
+
+func (RelayLimitBucketSize) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{26}
+}
+
+type RelayResetLimitCounter int32
+
+const (
+	RELAY_RESET_LIMIT_COUNTER_ZERO        RelayResetLimitCounter = 0
+	RELAY_RESET_LIMIT_COUNTER_RELOAD_RATE RelayResetLimitCounter = 1
+	RELAY_RESET_LIMIT_COUNTER_MAX_VALUE   RelayResetLimitCounter = 2
+	RELAY_RESET_LIMIT_COUNTER_NO_RESET    RelayResetLimitCounter = 3
+)
+
+var RelayResetLimitCounter_name = map[int32]string{
+	0: "RELAY_RESET_LIMIT_COUNTER_ZERO",
+	1: "RELAY_RESET_LIMIT_COUNTER_RELOAD_RATE",
+	2: "RELAY_RESET_LIMIT_COUNTER_MAX_VALUE",
+	3: "RELAY_RESET_LIMIT_COUNTER_NO_RESET",
+}
+
+var RelayResetLimitCounter_value = map[string]int32{
+	"RELAY_RESET_LIMIT_COUNTER_ZERO":        0,
+	"RELAY_RESET_LIMIT_COUNTER_RELOAD_RATE": 1,
+	"RELAY_RESET_LIMIT_COUNTER_MAX_VALUE":   2,
+	"RELAY_RESET_LIMIT_COUNTER_NO_RESET":    3,
+}
+
+func (RelayResetLimitCounter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{27}
+}
+
 type Message struct {
 	MHDR `protobuf:"bytes,1,opt,name=m_hdr,json=mHdr,proto3,embedded=m_hdr" json:"m_hdr"`
 	MIC  []byte `protobuf:"bytes,2,opt,name=mic,proto3" json:"mic,omitempty"`
@@ -2419,6 +2659,19 @@ type MACCommand struct {
 	//	*MACCommand_BeaconFreqAns_
 	//	*MACCommand_DeviceModeInd_
 	//	*MACCommand_DeviceModeConf_
+	//	*MACCommand_RelayConfReq_
+	//	*MACCommand_RelayConfAns_
+	//	*MACCommand_RelayEndDeviceConfReq_
+	//	*MACCommand_RelayEndDeviceConfAns_
+	//	*MACCommand_RelayFilterListReq_
+	//	*MACCommand_RelayFilterListAns_
+	//	*MACCommand_RelayUpdateUplinkListReq_
+	//	*MACCommand_RelayUpdateUplinkListAns_
+	//	*MACCommand_RelayConfigureFwdLimitReq_
+	//	*MACCommand_RelayConfigureFwdLimitAns_
+	//	*MACCommand_RelayNotifyNewEndDeviceReq_
+	//	*MACCommand_RelayCtrlUplinkListReq_
+	//	*MACCommand_RelayCtrlUplinkListAns_
 	Payload              isMACCommand_Payload `protobuf_oneof:"payload"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2556,38 +2809,90 @@ type MACCommand_DeviceModeInd_ struct {
 type MACCommand_DeviceModeConf_ struct {
 	DeviceModeConf *MACCommand_DeviceModeConf `protobuf:"bytes,32,opt,name=device_mode_conf,json=deviceModeConf,proto3,oneof" json:"device_mode_conf,omitempty"`
 }
-
-func (*MACCommand_RawPayload) isMACCommand_Payload()           {}
-func (*MACCommand_ResetInd_) isMACCommand_Payload()            {}
-func (*MACCommand_ResetConf_) isMACCommand_Payload()           {}
-func (*MACCommand_LinkCheckAns_) isMACCommand_Payload()        {}
-func (*MACCommand_LinkADRReq_) isMACCommand_Payload()          {}
-func (*MACCommand_LinkADRAns_) isMACCommand_Payload()          {}
-func (*MACCommand_DutyCycleReq_) isMACCommand_Payload()        {}
-func (*MACCommand_RxParamSetupReq_) isMACCommand_Payload()     {}
-func (*MACCommand_RxParamSetupAns_) isMACCommand_Payload()     {}
-func (*MACCommand_DevStatusAns_) isMACCommand_Payload()        {}
-func (*MACCommand_NewChannelReq_) isMACCommand_Payload()       {}
-func (*MACCommand_NewChannelAns_) isMACCommand_Payload()       {}
-func (*MACCommand_DLChannelReq_) isMACCommand_Payload()        {}
-func (*MACCommand_DLChannelAns_) isMACCommand_Payload()        {}
-func (*MACCommand_RxTimingSetupReq_) isMACCommand_Payload()    {}
-func (*MACCommand_TxParamSetupReq_) isMACCommand_Payload()     {}
-func (*MACCommand_RekeyInd_) isMACCommand_Payload()            {}
-func (*MACCommand_RekeyConf_) isMACCommand_Payload()           {}
-func (*MACCommand_ADRParamSetupReq_) isMACCommand_Payload()    {}
-func (*MACCommand_DeviceTimeAns_) isMACCommand_Payload()       {}
-func (*MACCommand_ForceRejoinReq_) isMACCommand_Payload()      {}
-func (*MACCommand_RejoinParamSetupReq_) isMACCommand_Payload() {}
-func (*MACCommand_RejoinParamSetupAns_) isMACCommand_Payload() {}
-func (*MACCommand_PingSlotInfoReq_) isMACCommand_Payload()     {}
-func (*MACCommand_PingSlotChannelReq_) isMACCommand_Payload()  {}
-func (*MACCommand_PingSlotChannelAns_) isMACCommand_Payload()  {}
-func (*MACCommand_BeaconTimingAns_) isMACCommand_Payload()     {}
-func (*MACCommand_BeaconFreqReq_) isMACCommand_Payload()       {}
-func (*MACCommand_BeaconFreqAns_) isMACCommand_Payload()       {}
-func (*MACCommand_DeviceModeInd_) isMACCommand_Payload()       {}
-func (*MACCommand_DeviceModeConf_) isMACCommand_Payload()      {}
+type MACCommand_RelayConfReq_ struct {
+	RelayConfReq *MACCommand_RelayConfReq `protobuf:"bytes,33,opt,name=relay_conf_req,json=relayConfReq,proto3,oneof" json:"relay_conf_req,omitempty"`
+}
+type MACCommand_RelayConfAns_ struct {
+	RelayConfAns *MACCommand_RelayConfAns `protobuf:"bytes,34,opt,name=relay_conf_ans,json=relayConfAns,proto3,oneof" json:"relay_conf_ans,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfReq_ struct {
+	RelayEndDeviceConfReq *MACCommand_RelayEndDeviceConfReq `protobuf:"bytes,35,opt,name=relay_end_device_conf_req,json=relayEndDeviceConfReq,proto3,oneof" json:"relay_end_device_conf_req,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfAns_ struct {
+	RelayEndDeviceConfAns *MACCommand_RelayEndDeviceConfAns `protobuf:"bytes,36,opt,name=relay_end_device_conf_ans,json=relayEndDeviceConfAns,proto3,oneof" json:"relay_end_device_conf_ans,omitempty"`
+}
+type MACCommand_RelayFilterListReq_ struct {
+	RelayFilterListReq *MACCommand_RelayFilterListReq `protobuf:"bytes,37,opt,name=relay_filter_list_req,json=relayFilterListReq,proto3,oneof" json:"relay_filter_list_req,omitempty"`
+}
+type MACCommand_RelayFilterListAns_ struct {
+	RelayFilterListAns *MACCommand_RelayFilterListAns `protobuf:"bytes,38,opt,name=relay_filter_list_ans,json=relayFilterListAns,proto3,oneof" json:"relay_filter_list_ans,omitempty"`
+}
+type MACCommand_RelayUpdateUplinkListReq_ struct {
+	RelayUpdateUplinkListReq *MACCommand_RelayUpdateUplinkListReq `protobuf:"bytes,39,opt,name=relay_update_uplink_list_req,json=relayUpdateUplinkListReq,proto3,oneof" json:"relay_update_uplink_list_req,omitempty"`
+}
+type MACCommand_RelayUpdateUplinkListAns_ struct {
+	RelayUpdateUplinkListAns *MACCommand_RelayUpdateUplinkListAns `protobuf:"bytes,40,opt,name=relay_update_uplink_list_ans,json=relayUpdateUplinkListAns,proto3,oneof" json:"relay_update_uplink_list_ans,omitempty"`
+}
+type MACCommand_RelayConfigureFwdLimitReq_ struct {
+	RelayConfigureFwdLimitReq *MACCommand_RelayConfigureFwdLimitReq `protobuf:"bytes,41,opt,name=relay_configure_fwd_limit_req,json=relayConfigureFwdLimitReq,proto3,oneof" json:"relay_configure_fwd_limit_req,omitempty"`
+}
+type MACCommand_RelayConfigureFwdLimitAns_ struct {
+	RelayConfigureFwdLimitAns *MACCommand_RelayConfigureFwdLimitAns `protobuf:"bytes,42,opt,name=relay_configure_fwd_limit_ans,json=relayConfigureFwdLimitAns,proto3,oneof" json:"relay_configure_fwd_limit_ans,omitempty"`
+}
+type MACCommand_RelayNotifyNewEndDeviceReq_ struct {
+	RelayNotifyNewEndDeviceReq *MACCommand_RelayNotifyNewEndDeviceReq `protobuf:"bytes,43,opt,name=relay_notify_new_end_device_req,json=relayNotifyNewEndDeviceReq,proto3,oneof" json:"relay_notify_new_end_device_req,omitempty"`
+}
+type MACCommand_RelayCtrlUplinkListReq_ struct {
+	RelayCtrlUplinkListReq *MACCommand_RelayCtrlUplinkListReq `protobuf:"bytes,44,opt,name=relay_ctrl_uplink_list_req,json=relayCtrlUplinkListReq,proto3,oneof" json:"relay_ctrl_uplink_list_req,omitempty"`
+}
+type MACCommand_RelayCtrlUplinkListAns_ struct {
+	RelayCtrlUplinkListAns *MACCommand_RelayCtrlUplinkListAns `protobuf:"bytes,45,opt,name=relay_ctrl_uplink_list_ans,json=relayCtrlUplinkListAns,proto3,oneof" json:"relay_ctrl_uplink_list_ans,omitempty"`
+}
+
+func (*MACCommand_RawPayload) isMACCommand_Payload()                  {}
+func (*MACCommand_ResetInd_) isMACCommand_Payload()                   {}
+func (*MACCommand_ResetConf_) isMACCommand_Payload()                  {}
+func (*MACCommand_LinkCheckAns_) isMACCommand_Payload()               {}
+func (*MACCommand_LinkADRReq_) isMACCommand_Payload()                 {}
+func (*MACCommand_LinkADRAns_) isMACCommand_Payload()                 {}
+func (*MACCommand_DutyCycleReq_) isMACCommand_Payload()               {}
+func (*MACCommand_RxParamSetupReq_) isMACCommand_Payload()            {}
+func (*MACCommand_RxParamSetupAns_) isMACCommand_Payload()            {}
+func (*MACCommand_DevStatusAns_) isMACCommand_Payload()               {}
+func (*MACCommand_NewChannelReq_) isMACCommand_Payload()              {}
+func (*MACCommand_NewChannelAns_) isMACCommand_Payload()              {}
+func (*MACCommand_DLChannelReq_) isMACCommand_Payload()               {}
+func (*MACCommand_DLChannelAns_) isMACCommand_Payload()               {}
+func (*MACCommand_RxTimingSetupReq_) isMACCommand_Payload()           {}
+func (*MACCommand_TxParamSetupReq_) isMACCommand_Payload()            {}
+func (*MACCommand_RekeyInd_) isMACCommand_Payload()                   {}
+func (*MACCommand_RekeyConf_) isMACCommand_Payload()                  {}
+func (*MACCommand_ADRParamSetupReq_) isMACCommand_Payload()           {}
+func (*MACCommand_DeviceTimeAns_) isMACCommand_Payload()              {}
+func (*MACCommand_ForceRejoinReq_) isMACCommand_Payload()             {}
+func (*MACCommand_RejoinParamSetupReq_) isMACCommand_Payload()        {}
+func (*MACCommand_RejoinParamSetupAns_) isMACCommand_Payload()        {}
+func (*MACCommand_PingSlotInfoReq_) isMACCommand_Payload()            {}
+func (*MACCommand_PingSlotChannelReq_) isMACCommand_Payload()         {}
+func (*MACCommand_PingSlotChannelAns_) isMACCommand_Payload()         {}
+func (*MACCommand_BeaconTimingAns_) isMACCommand_Payload()            {}
+func (*MACCommand_BeaconFreqReq_) isMACCommand_Payload()              {}
+func (*MACCommand_BeaconFreqAns_) isMACCommand_Payload()              {}
+func (*MACCommand_DeviceModeInd_) isMACCommand_Payload()              {}
+func (*MACCommand_DeviceModeConf_) isMACCommand_Payload()             {}
+func (*MACCommand_RelayConfReq_) isMACCommand_Payload()               {}
+func (*MACCommand_RelayConfAns_) isMACCommand_Payload()               {}
+func (*MACCommand_RelayEndDeviceConfReq_) isMACCommand_Payload()      {}
+func (*MACCommand_RelayEndDeviceConfAns_) isMACCommand_Payload()      {}
+func (*MACCommand_RelayFilterListReq_) isMACCommand_Payload()         {}
+func (*MACCommand_RelayFilterListAns_) isMACCommand_Payload()         {}
+func (*MACCommand_RelayUpdateUplinkListReq_) isMACCommand_Payload()   {}
+func (*MACCommand_RelayUpdateUplinkListAns_) isMACCommand_Payload()   {}
+func (*MACCommand_RelayConfigureFwdLimitReq_) isMACCommand_Payload()  {}
+func (*MACCommand_RelayConfigureFwdLimitAns_) isMACCommand_Payload()  {}
+func (*MACCommand_RelayNotifyNewEndDeviceReq_) isMACCommand_Payload() {}
+func (*MACCommand_RelayCtrlUplinkListReq_) isMACCommand_Payload()     {}
+func (*MACCommand_RelayCtrlUplinkListAns_) isMACCommand_Payload()     {}
 
 func (m *MACCommand) GetPayload() isMACCommand_Payload {
 	if m != nil {
@@ -2820,6 +3125,97 @@ func (m *MACCommand) GetDeviceModeConf() *MACCommand_DeviceModeConf {
 	return nil
 }
 
+func (m *MACCommand) GetRelayConfReq() *MACCommand_RelayConfReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfReq_); ok {
+		return x.RelayConfReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayConfAns() *MACCommand_RelayConfAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfAns_); ok {
+		return x.RelayConfAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayEndDeviceConfReq() *MACCommand_RelayEndDeviceConfReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayEndDeviceConfReq_); ok {
+		return x.RelayEndDeviceConfReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayEndDeviceConfAns() *MACCommand_RelayEndDeviceConfAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayEndDeviceConfAns_); ok {
+		return x.RelayEndDeviceConfAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayFilterListReq() *MACCommand_RelayFilterListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayFilterListReq_); ok {
+		return x.RelayFilterListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayFilterListAns() *MACCommand_RelayFilterListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayFilterListAns_); ok {
+		return x.RelayFilterListAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayUpdateUplinkListReq() *MACCommand_RelayUpdateUplinkListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayUpdateUplinkListReq_); ok {
+		return x.RelayUpdateUplinkListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayUpdateUplinkListAns() *MACCommand_RelayUpdateUplinkListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayUpdateUplinkListAns_); ok {
+		return x.RelayUpdateUplinkListAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayConfigureFwdLimitReq() *MACCommand_RelayConfigureFwdLimitReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfigureFwdLimitReq_); ok {
+		return x.RelayConfigureFwdLimitReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayConfigureFwdLimitAns() *MACCommand_RelayConfigureFwdLimitAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfigureFwdLimitAns_); ok {
+		return x.RelayConfigureFwdLimitAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayNotifyNewEndDeviceReq() *MACCommand_RelayNotifyNewEndDeviceReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayNotifyNewEndDeviceReq_); ok {
+		return x.RelayNotifyNewEndDeviceReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayCtrlUplinkListReq() *MACCommand_RelayCtrlUplinkListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayCtrlUplinkListReq_); ok {
+		return x.RelayCtrlUplinkListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayCtrlUplinkListAns() *MACCommand_RelayCtrlUplinkListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayCtrlUplinkListAns_); ok {
+		return x.RelayCtrlUplinkListAns
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MACCommand) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2854,6 +3250,19 @@ func (*MACCommand) XXX_OneofWrappers() []interface{} {
 		(*MACCommand_BeaconFreqAns_)(nil),
 		(*MACCommand_DeviceModeInd_)(nil),
 		(*MACCommand_DeviceModeConf_)(nil),
+		(*MACCommand_RelayConfReq_)(nil),
+		(*MACCommand_RelayConfAns_)(nil),
+		(*MACCommand_RelayEndDeviceConfReq_)(nil),
+		(*MACCommand_RelayEndDeviceConfAns_)(nil),
+		(*MACCommand_RelayFilterListReq_)(nil),
+		(*MACCommand_RelayFilterListAns_)(nil),
+		(*MACCommand_RelayUpdateUplinkListReq_)(nil),
+		(*MACCommand_RelayUpdateUplinkListAns_)(nil),
+		(*MACCommand_RelayConfigureFwdLimitReq_)(nil),
+		(*MACCommand_RelayConfigureFwdLimitAns_)(nil),
+		(*MACCommand_RelayNotifyNewEndDeviceReq_)(nil),
+		(*MACCommand_RelayCtrlUplinkListReq_)(nil),
+		(*MACCommand_RelayCtrlUplinkListAns_)(nil),
 	}
 }
 
@@ -4444,23 +4853,28 @@ func (m *MACCommand_DeviceModeConf) GetClass() Class {
 	return CLASS_A
 }
 
-type DataRateIndexValue struct {
-	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type MACCommand_RelayConfReq struct {
+	// Enable the relay. If false, the relay is disabled and the other fields are ignored.
+	Enable              bool                `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	CADPeriodicity      RelayCADPeriodicity `protobuf:"varint,2,opt,name=cad_periodicity,json=cadPeriodicity,proto3,enum=ttn.lorawan.v3.RelayCADPeriodicity" json:"cad_periodicity,omitempty"`
+	DefaultChannelIndex uint32              `protobuf:"varint,3,opt,name=default_channel_index,json=defaultChannelIndex,proto3" json:"default_channel_index,omitempty"`
+	// Second wake on radio channel. If not set, the second channel is disabled.
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,4,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
-func (*DataRateIndexValue) ProtoMessage() {}
-func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{19}
+func (m *MACCommand_RelayConfReq) Reset()      { *m = MACCommand_RelayConfReq{} }
+func (*MACCommand_RelayConfReq) ProtoMessage() {}
+func (*MACCommand_RelayConfReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 30}
 }
-func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
+func (m *MACCommand_RelayConfReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayConfReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataRateIndexValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayConfReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4470,42 +4884,68 @@ func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataRateIndexValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRateIndexValue.Merge(m, src)
+func (m *MACCommand_RelayConfReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfReq.Merge(m, src)
 }
-func (m *DataRateIndexValue) XXX_Size() int {
+func (m *MACCommand_RelayConfReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataRateIndexValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRateIndexValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRateIndexValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfReq proto.InternalMessageInfo
 
-func (m *DataRateIndexValue) GetValue() DataRateIndex {
+func (m *MACCommand_RelayConfReq) GetEnable() bool {
 	if m != nil {
-		return m.Value
+		return m.Enable
 	}
-	return DATA_RATE_0
+	return false
 }
 
-type PingSlotPeriodValue struct {
-	Value                PingSlotPeriod `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.PingSlotPeriod" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *MACCommand_RelayConfReq) GetCADPeriodicity() RelayCADPeriodicity {
+	if m != nil {
+		return m.CADPeriodicity
+	}
+	return RELAY_CAD_PERIODICITY_1_SECOND
 }
 
-func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
-func (*PingSlotPeriodValue) ProtoMessage() {}
-func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{20}
+func (m *MACCommand_RelayConfReq) GetDefaultChannelIndex() uint32 {
+	if m != nil {
+		return m.DefaultChannelIndex
+	}
+	return 0
 }
-func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayConfReq) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+type MACCommand_RelayConfAns struct {
+	SecondChannelFrequencyAck     bool     `protobuf:"varint,1,opt,name=second_channel_frequency_ack,json=secondChannelFrequencyAck,proto3" json:"second_channel_frequency_ack,omitempty"`
+	SecondChannelAckOffsetAck     bool     `protobuf:"varint,2,opt,name=second_channel_ack_offset_ack,json=secondChannelAckOffsetAck,proto3" json:"second_channel_ack_offset_ack,omitempty"`
+	SecondChannelDataRateIndexAck bool     `protobuf:"varint,3,opt,name=second_channel_data_rate_index_ack,json=secondChannelDataRateIndexAck,proto3" json:"second_channel_data_rate_index_ack,omitempty"`
+	SecondChannelIndexAck         bool     `protobuf:"varint,4,opt,name=second_channel_index_ack,json=secondChannelIndexAck,proto3" json:"second_channel_index_ack,omitempty"`
+	DefaultChannelIndexAck        bool     `protobuf:"varint,5,opt,name=default_channel_index_ack,json=defaultChannelIndexAck,proto3" json:"default_channel_index_ack,omitempty"`
+	CADPeriodicityAck             bool     `protobuf:"varint,6,opt,name=cad_periodicity_ack,json=cadPeriodicityAck,proto3" json:"cad_periodicity_ack,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayConfAns) Reset()      { *m = MACCommand_RelayConfAns{} }
+func (*MACCommand_RelayConfAns) ProtoMessage() {}
+func (*MACCommand_RelayConfAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 31}
+}
+func (m *MACCommand_RelayConfAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayConfAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PingSlotPeriodValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayConfAns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4515,42 +4955,82 @@ func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *PingSlotPeriodValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingSlotPeriodValue.Merge(m, src)
+func (m *MACCommand_RelayConfAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfAns.Merge(m, src)
 }
-func (m *PingSlotPeriodValue) XXX_Size() int {
+func (m *MACCommand_RelayConfAns) XXX_Size() int {
 	return m.Size()
 }
-func (m *PingSlotPeriodValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingSlotPeriodValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingSlotPeriodValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfAns proto.InternalMessageInfo
 
-func (m *PingSlotPeriodValue) GetValue() PingSlotPeriod {
+func (m *MACCommand_RelayConfAns) GetSecondChannelFrequencyAck() bool {
 	if m != nil {
-		return m.Value
+		return m.SecondChannelFrequencyAck
 	}
-	return PING_EVERY_1S
+	return false
 }
 
-type AggregatedDutyCycleValue struct {
-	Value                AggregatedDutyCycle `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.AggregatedDutyCycle" json:"value,omitempty"`
+func (m *MACCommand_RelayConfAns) GetSecondChannelAckOffsetAck() bool {
+	if m != nil {
+		return m.SecondChannelAckOffsetAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfAns) GetSecondChannelDataRateIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelDataRateIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfAns) GetSecondChannelIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfAns) GetDefaultChannelIndexAck() bool {
+	if m != nil {
+		return m.DefaultChannelIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfAns) GetCADPeriodicityAck() bool {
+	if m != nil {
+		return m.CADPeriodicityAck
+	}
+	return false
+}
+
+type MACCommand_RelayEndDeviceConfReq struct {
+	Mode             RelayEndDeviceMode    `protobuf:"varint,1,opt,name=mode,proto3,enum=ttn.lorawan.v3.RelayEndDeviceMode" json:"mode,omitempty"`
+	SmartEnableLevel RelaySmartEnableLevel `protobuf:"varint,2,opt,name=smart_enable_level,json=smartEnableLevel,proto3,enum=ttn.lorawan.v3.RelaySmartEnableLevel" json:"smart_enable_level,omitempty"`
+	// Number of wake on radio frames to transmit without acknowledgement before the end device stops using the relay.
+	Backoff uint32 `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Second wake on radio channel. If not set, the second channel is disabled.
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,4,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
-func (*AggregatedDutyCycleValue) ProtoMessage() {}
-func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{21}
+func (m *MACCommand_RelayEndDeviceConfReq) Reset()      { *m = MACCommand_RelayEndDeviceConfReq{} }
+func (*MACCommand_RelayEndDeviceConfReq) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 32}
 }
-func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregatedDutyCycleValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4560,42 +5040,66 @@ func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *AggregatedDutyCycleValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregatedDutyCycleValue.Merge(m, src)
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Merge(m, src)
 }
-func (m *AggregatedDutyCycleValue) XXX_Size() int {
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregatedDutyCycleValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregatedDutyCycleValue.DiscardUnknown(m)
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregatedDutyCycleValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfReq proto.InternalMessageInfo
 
-func (m *AggregatedDutyCycleValue) GetValue() AggregatedDutyCycle {
+func (m *MACCommand_RelayEndDeviceConfReq) GetMode() RelayEndDeviceMode {
 	if m != nil {
-		return m.Value
+		return m.Mode
 	}
-	return DUTY_CYCLE_1
+	return RELAY_END_DEVICE_MODE_DISABLED
 }
 
-type RxDelayValue struct {
-	Value                RxDelay  `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.RxDelay" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MACCommand_RelayEndDeviceConfReq) GetSmartEnableLevel() RelaySmartEnableLevel {
+	if m != nil {
+		return m.SmartEnableLevel
+	}
+	return RELAY_SMART_ENABLE_LEVEL_8
 }
 
-func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
-func (*RxDelayValue) ProtoMessage() {}
-func (*RxDelayValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{22}
-}
-func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *MACCommand_RelayEndDeviceConfReq) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
 }
-func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *MACCommand_RelayEndDeviceConfReq) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+type MACCommand_RelayEndDeviceConfAns struct {
+	SecondChannelFrequencyAck     bool     `protobuf:"varint,1,opt,name=second_channel_frequency_ack,json=secondChannelFrequencyAck,proto3" json:"second_channel_frequency_ack,omitempty"`
+	SecondChannelAckOffsetAck     bool     `protobuf:"varint,2,opt,name=second_channel_ack_offset_ack,json=secondChannelAckOffsetAck,proto3" json:"second_channel_ack_offset_ack,omitempty"`
+	SecondChannelDataRateIndexAck bool     `protobuf:"varint,3,opt,name=second_channel_data_rate_index_ack,json=secondChannelDataRateIndexAck,proto3" json:"second_channel_data_rate_index_ack,omitempty"`
+	BackoffAck                    bool     `protobuf:"varint,4,opt,name=backoff_ack,json=backoffAck,proto3" json:"backoff_ack,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) Reset()      { *m = MACCommand_RelayEndDeviceConfAns{} }
+func (*MACCommand_RelayEndDeviceConfAns) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 33}
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RxDelayValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4605,42 +5109,66 @@ func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RxDelayValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RxDelayValue.Merge(m, src)
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Merge(m, src)
 }
-func (m *RxDelayValue) XXX_Size() int {
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Size() int {
 	return m.Size()
 }
-func (m *RxDelayValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_RxDelayValue.DiscardUnknown(m)
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RxDelayValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfAns proto.InternalMessageInfo
 
-func (m *RxDelayValue) GetValue() RxDelay {
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelFrequencyAck() bool {
 	if m != nil {
-		return m.Value
+		return m.SecondChannelFrequencyAck
 	}
-	return RX_DELAY_0
+	return false
 }
 
-type ADRAckLimitExponentValue struct {
-	Value                ADRAckLimitExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckLimitExponent" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelAckOffsetAck() bool {
+	if m != nil {
+		return m.SecondChannelAckOffsetAck
+	}
+	return false
 }
 
-func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
-func (*ADRAckLimitExponentValue) ProtoMessage() {}
-func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{23}
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelDataRateIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelDataRateIndexAck
+	}
+	return false
 }
-func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayEndDeviceConfAns) GetBackoffAck() bool {
+	if m != nil {
+		return m.BackoffAck
+	}
+	return false
+}
+
+type MACCommand_RelayFilterListReq struct {
+	Index                uint32                                                `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Action               RelayFilterListAction                                 `protobuf:"varint,2,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayFilterListAction" json:"action,omitempty"`
+	JoinEUI              go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,3,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui"`
+	DevEUI               go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,4,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"dev_eui"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *MACCommand_RelayFilterListReq) Reset()      { *m = MACCommand_RelayFilterListReq{} }
+func (*MACCommand_RelayFilterListReq) ProtoMessage() {}
+func (*MACCommand_RelayFilterListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 34}
+}
+func (m *MACCommand_RelayFilterListReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayFilterListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ADRAckLimitExponentValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayFilterListReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4650,42 +5178,51 @@ func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ADRAckLimitExponentValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ADRAckLimitExponentValue.Merge(m, src)
+func (m *MACCommand_RelayFilterListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayFilterListReq.Merge(m, src)
 }
-func (m *ADRAckLimitExponentValue) XXX_Size() int {
+func (m *MACCommand_RelayFilterListReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ADRAckLimitExponentValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ADRAckLimitExponentValue.DiscardUnknown(m)
+func (m *MACCommand_RelayFilterListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayFilterListReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ADRAckLimitExponentValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayFilterListReq proto.InternalMessageInfo
 
-func (m *ADRAckLimitExponentValue) GetValue() ADRAckLimitExponent {
+func (m *MACCommand_RelayFilterListReq) GetIndex() uint32 {
 	if m != nil {
-		return m.Value
+		return m.Index
 	}
-	return ADR_ACK_LIMIT_1
+	return 0
 }
 
-type ADRAckDelayExponentValue struct {
-	Value                ADRAckDelayExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckDelayExponent" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *MACCommand_RelayFilterListReq) GetAction() RelayFilterListAction {
+	if m != nil {
+		return m.Action
+	}
+	return RELAY_FILTER_LIST_ACTION_NO_RULE
 }
 
-func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
-func (*ADRAckDelayExponentValue) ProtoMessage() {}
-func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{24}
+type MACCommand_RelayFilterListAns struct {
+	IndexAck             bool     `protobuf:"varint,1,opt,name=index_ack,json=indexAck,proto3" json:"index_ack,omitempty"`
+	ActionAck            bool     `protobuf:"varint,2,opt,name=action_ack,json=actionAck,proto3" json:"action_ack,omitempty"`
+	CombinedRulesAck     bool     `protobuf:"varint,3,opt,name=combined_rules_ack,json=combinedRulesAck,proto3" json:"combined_rules_ack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayFilterListAns) Reset()      { *m = MACCommand_RelayFilterListAns{} }
+func (*MACCommand_RelayFilterListAns) ProtoMessage() {}
+func (*MACCommand_RelayFilterListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 35}
+}
+func (m *MACCommand_RelayFilterListAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayFilterListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ADRAckDelayExponentValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayFilterListAns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4695,1281 +5232,1647 @@ func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ADRAckDelayExponentValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ADRAckDelayExponentValue.Merge(m, src)
+func (m *MACCommand_RelayFilterListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayFilterListAns.Merge(m, src)
 }
-func (m *ADRAckDelayExponentValue) XXX_Size() int {
+func (m *MACCommand_RelayFilterListAns) XXX_Size() int {
 	return m.Size()
 }
-func (m *ADRAckDelayExponentValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ADRAckDelayExponentValue.DiscardUnknown(m)
+func (m *MACCommand_RelayFilterListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayFilterListAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ADRAckDelayExponentValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayFilterListAns proto.InternalMessageInfo
 
-func (m *ADRAckDelayExponentValue) GetValue() ADRAckDelayExponent {
+func (m *MACCommand_RelayFilterListAns) GetIndexAck() bool {
 	if m != nil {
-		return m.Value
+		return m.IndexAck
 	}
-	return ADR_ACK_DELAY_1
+	return false
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
-	proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
-	proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinType", RejoinType_name, RejoinType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinType", RejoinType_name, RejoinType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
-	proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
-	proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
-	proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
-	proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
-	proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
-	proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
-	golang_proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
-	proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
-	golang_proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
-	proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
-	golang_proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
-	proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
-	golang_proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
-	proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
-	golang_proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
-	proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
-	golang_proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
-	proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
-	golang_proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
-	proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
-	golang_proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
-	proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
-	golang_proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
-	proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
-	golang_proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
-	proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
-	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
-	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
-	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
-	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
-	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
-	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
-	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
-	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
-	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
-	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
-	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
-	proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
-	golang_proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
-	proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
-	golang_proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
-	proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
-	golang_proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
-	proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
-	golang_proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
-	proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
-	golang_proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
-	proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
-	golang_proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
-	proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
-	golang_proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
-	proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
-	golang_proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
-	proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
-	golang_proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
-	proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
-	golang_proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
-	proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
-	proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
-	golang_proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
-	proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
-	golang_proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
-	proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
-	golang_proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
-	proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
-	golang_proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
-	proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
-	golang_proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
-	proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
-	golang_proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
-	proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
-	golang_proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
-	proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
-	proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
-	golang_proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
-	proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
-	golang_proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
-	proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
-	proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
-	golang_proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
-	proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
-	golang_proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
-	proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
-	proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
-	golang_proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
-	proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
-	golang_proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
-	proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
-	golang_proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
-	proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
-	golang_proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
-	proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
-	golang_proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
-	proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
-	golang_proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
-	proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
-	golang_proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
-	proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
-	golang_proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
-	proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
-	golang_proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
-	proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
-	golang_proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
-	proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
-	golang_proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
-	proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
-	golang_proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
-	proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
-	golang_proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
-	proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
-	golang_proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
-	proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
-	golang_proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+func (m *MACCommand_RelayFilterListAns) GetActionAck() bool {
+	if m != nil {
+		return m.ActionAck
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e) }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e)
+func (m *MACCommand_RelayFilterListAns) GetCombinedRulesAck() bool {
+	if m != nil {
+		return m.CombinedRulesAck
+	}
+	return false
 }
 
-var fileDescriptor_2084d1d5a227b67e = []byte{
-	// 5618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7b, 0x4d, 0x70, 0x1b, 0xc9,
-	0x75, 0x3f, 0x06, 0x1f, 0x04, 0xf8, 0x00, 0x12, 0xc3, 0xa6, 0x56, 0xa2, 0xe0, 0x35, 0x28, 0x53,
-	0xfe, 0x97, 0x65, 0xee, 0x5f, 0x94, 0x08, 0x7e, 0x88, 0xb2, 0x1d, 0x7b, 0xf1, 0x45, 0x11, 0x2b,
-	0x7e, 0x79, 0x40, 0x4a, 0x2b, 0xc7, 0xae, 0xf1, 0x10, 0x33, 0xa0, 0xb0, 0x04, 0x06, 0xd8, 0xc1,
-	0x90, 0x22, 0x9d, 0x8b, 0x63, 0x5f, 0xb6, 0x92, 0x4a, 0xc5, 0xe5, 0xaa, 0x7c, 0xf8, 0x12, 0xbb,
-	0x2a, 0x4e, 0xc5, 0x55, 0x39, 0xc4, 0x49, 0x2e, 0x3e, 0xe4, 0xe0, 0x43, 0x0e, 0x4e, 0x25, 0x87,
-	0xcd, 0xcd, 0x49, 0x55, 0x94, 0x15, 0x75, 0xf1, 0x29, 0xe5, 0xa3, 0x4b, 0x87, 0x6c, 0xea, 0x75,
-	0xf7, 0x60, 0xba, 0x67, 0x20, 0x91, 0xdc, 0x5d, 0x47, 0x17, 0x4d, 0xff, 0xba, 0xfb, 0xbd, 0xd7,
-	0xef, 0xbd, 0x7e, 0xaf, 0x5f, 0x37, 0x08, 0xd3, 0xed, 0xae, 0x63, 0x3c, 0x31, 0xec, 0x9b, 0x7d,
-	0xd7, 0x68, 0x1c, 0xdc, 0x32, 0x7a, 0xad, 0x5b, 0x1c, 0x99, 0xeb, 0x39, 0x5d, 0xb7, 0x4b, 0xc6,
-	0x5d, 0xd7, 0x9e, 0xf3, 0xa0, 0xa3, 0x85, 0x5c, 0x71, 0xbf, 0xe5, 0x3e, 0x3e, 0xdc, 0x9b, 0x6b,
-	0x74, 0x3b, 0xb7, 0x2c, 0xfb, 0xa8, 0x7b, 0xd2, 0x73, 0xba, 0xc7, 0x27, 0xb7, 0xe8, 0xe0, 0xc6,
-	0xcd, 0x7d, 0xcb, 0xbe, 0x79, 0x64, 0xb4, 0x5b, 0xa6, 0xe1, 0x5a, 0xb7, 0x42, 0x1f, 0x8c, 0x64,
-	0xee, 0xa6, 0x40, 0x62, 0xbf, 0xbb, 0xdf, 0x65, 0x93, 0xf7, 0x0e, 0x9b, 0xb4, 0x45, 0x1b, 0xf4,
-	0x8b, 0x0f, 0x7f, 0x7d, 0xbf, 0xdb, 0xdd, 0x6f, 0x5b, 0xfe, 0xa8, 0xbe, 0xeb, 0x1c, 0x36, 0x5c,
-	0xde, 0x3b, 0x1d, 0xec, 0x75, 0x5b, 0x1d, 0xab, 0xef, 0x1a, 0x9d, 0x1e, 0x1f, 0x70, 0x3d, 0xbc,
-	0xc2, 0x96, 0x69, 0xd9, 0x6e, 0xab, 0xd9, 0xb2, 0x9c, 0x3e, 0x1b, 0x34, 0xf3, 0x41, 0x0c, 0x92,
-	0x1b, 0x56, 0xbf, 0x6f, 0xec, 0x5b, 0xe4, 0x8b, 0x90, 0xe8, 0xe8, 0x8f, 0x4d, 0x67, 0x4a, 0xb9,
-	0xa6, 0xdc, 0x48, 0x17, 0x2e, 0xcd, 0xc9, 0x1a, 0x98, 0xdb, 0x58, 0xab, 0x68, 0x25, 0xf5, 0x45,
-	0x29, 0xf1, 0x07, 0x4a, 0x54, 0x55, 0x7e, 0xf1, 0x74, 0x3a, 0xf2, 0xfe, 0xd3, 0x69, 0x45, 0x8b,
-	0x77, 0xd6, 0x4c, 0x87, 0xcc, 0x40, 0xac, 0xd3, 0x6a, 0x4c, 0x45, 0xaf, 0x29, 0x37, 0x32, 0x38,
-	0x68, 0xe4, 0x5b, 0x71, 0x35, 0x32, 0x15, 0x3f, 0x7d, 0x3a, 0x1d, 0xdb, 0xa8, 0x95, 0x35, 0xec,
-	0x24, 0x1b, 0x90, 0xee, 0x18, 0x0d, 0xbd, 0x67, 0x9c, 0xb4, 0xbb, 0x86, 0x39, 0x15, 0xa3, 0x6c,
-	0x72, 0x21, 0x36, 0xc5, 0xf2, 0x36, 0x1b, 0x51, 0x1a, 0x3f, 0x7d, 0x3a, 0x0d, 0x7e, 0x7b, 0x2d,
-	0xa2, 0x41, 0xc7, 0x68, 0xf0, 0x16, 0x79, 0x00, 0x97, 0xde, 0xe9, 0xb6, 0x6c, 0xdd, 0xb1, 0xde,
-	0x3d, 0xb4, 0xfa, 0xee, 0x80, 0x6e, 0x9c, 0xd2, 0x9d, 0x09, 0xd2, 0x7d, 0xab, 0xdb, 0xb2, 0x35,
-	0x36, 0xd4, 0xa7, 0x47, 0xde, 0x09, 0xa1, 0xa4, 0x0e, 0x93, 0x94, 0xae, 0xd1, 0x68, 0x58, 0x3d,
-	0x9f, 0x6c, 0x82, 0x92, 0xfd, 0xcc, 0x30, 0xb2, 0x45, 0x3a, 0xd2, 0xa7, 0x3a, 0xf1, 0x4e, 0x10,
-	0x24, 0x5f, 0x87, 0xcb, 0x8e, 0x35, 0x54, 0xdc, 0x11, 0x4a, 0xf7, 0xb3, 0x41, 0xba, 0x9a, 0xf5,
-	0xce, 0x30, 0x81, 0x2f, 0x39, 0x43, 0xf0, 0x2f, 0xc4, 0x7f, 0xf6, 0xa3, 0xe9, 0x48, 0x69, 0x1c,
-	0x92, 0x1e, 0xbb, 0xd8, 0x6f, 0x4a, 0xca, 0x5b, 0xf1, 0x54, 0x52, 0x4d, 0xcd, 0x1c, 0x42, 0x1c,
-	0x2d, 0x47, 0x96, 0x61, 0xa4, 0xa3, 0xbb, 0x27, 0x3d, 0x8b, 0xda, 0x77, 0xbc, 0xf0, 0x5a, 0x48,
-	0xf1, 0x3b, 0x27, 0x3d, 0xab, 0x94, 0x7a, 0x51, 0x4a, 0x7c, 0x07, 0x0d, 0xac, 0x25, 0x3a, 0x08,
-	0x90, 0x25, 0x48, 0x74, 0x8c, 0x77, 0xba, 0x0e, 0xb5, 0xed, 0xb0, 0x69, 0xd8, 0x29, 0x4d, 0x43,
-	0x60, 0xe6, 0xf7, 0xa3, 0x20, 0x98, 0x0e, 0x9d, 0xab, 0xf9, 0x2a, 0xe7, 0x5a, 0x7d, 0x89, 0x73,
-	0x35, 0xd1, 0xb9, 0xa6, 0x61, 0xa4, 0xa9, 0xf7, 0xba, 0x8e, 0x4b, 0x65, 0x18, 0xa3, 0xcc, 0x66,
-	0x63, 0x53, 0x1f, 0x2a, 0x5a, 0xa2, 0xb9, 0xdd, 0x75, 0x5c, 0x72, 0x0b, 0xd2, 0x4d, 0xa7, 0x23,
-	0x79, 0x56, 0x86, 0x79, 0xcf, 0xaa, 0xb6, 0xc1, 0x45, 0xd0, 0xa0, 0xe9, 0x74, 0x3c, 0x71, 0xde,
-	0x84, 0xac, 0x69, 0x35, 0xba, 0xa6, 0x65, 0x06, 0xdc, 0xe6, 0xca, 0x1c, 0xdb, 0x57, 0x73, 0xde,
-	0xbe, 0x9a, 0xab, 0xd3, 0x5d, 0xa7, 0x8d, 0xf3, 0xf1, 0x1e, 0x85, 0xd7, 0x01, 0x9a, 0x87, 0xed,
-	0xb6, 0xde, 0xd4, 0x1b, 0xb6, 0x4b, 0x9d, 0x63, 0x4c, 0x4b, 0x21, 0xb2, 0x5a, 0xb6, 0x5d, 0x66,
-	0x90, 0x99, 0x5f, 0x29, 0x10, 0xc7, 0x85, 0x91, 0xaf, 0x41, 0xca, 0xb4, 0x8e, 0x74, 0xc3, 0xe4,
-	0x0a, 0xc8, 0x94, 0xbe, 0x82, 0x4b, 0xfc, 0x8f, 0xa7, 0xd3, 0x77, 0xf6, 0xbb, 0x73, 0xee, 0x63,
-	0xcb, 0x7d, 0xdc, 0xb2, 0xf7, 0xfb, 0x73, 0xb6, 0xe5, 0x3e, 0xe9, 0x3a, 0x07, 0xb7, 0xe4, 0xad,
-	0x7b, 0xb4, 0x70, 0xab, 0x77, 0xb0, 0x7f, 0x0b, 0x6d, 0xd7, 0x9f, 0xab, 0x58, 0x47, 0x45, 0xd3,
-	0x74, 0xb4, 0xa4, 0xc9, 0x3e, 0xc8, 0x97, 0x51, 0x39, 0x0d, 0xd7, 0x69, 0x53, 0xe5, 0xa4, 0xc3,
-	0x06, 0x5a, 0x2d, 0xbb, 0x4e, 0x7b, 0x88, 0x6e, 0x13, 0x4d, 0xec, 0x20, 0x79, 0xb4, 0x0c, 0xae,
-	0x21, 0x46, 0x75, 0x3b, 0xfa, 0xa2, 0x34, 0x32, 0x1b, 0x9f, 0xfa, 0xf0, 0xc3, 0x98, 0x16, 0x6f,
-	0x96, 0x6d, 0x97, 0xe4, 0x91, 0x7e, 0xb7, 0xe7, 0xf6, 0xa9, 0x86, 0x32, 0xa5, 0xe4, 0x8b, 0x52,
-	0xfc, 0x5b, 0xd1, 0xa9, 0xac, 0x96, 0x68, 0x6e, 0xf5, 0xdc, 0x3e, 0x5f, 0xea, 0x0f, 0x14, 0x48,
-	0x50, 0x46, 0xe4, 0x2a, 0xc4, 0x0c, 0xbe, 0xcc, 0x54, 0x29, 0x89, 0x01, 0xa0, 0x58, 0xd1, 0x34,
-	0xc4, 0xc8, 0x4d, 0x48, 0x1b, 0xa6, 0xa3, 0x1b, 0x8d, 0x03, 0xdc, 0x05, 0x54, 0xde, 0x54, 0x69,
-	0xec, 0xf4, 0xe9, 0xf4, 0x68, 0xb1, 0xa2, 0x15, 0x1b, 0x07, 0x9a, 0xf5, 0xae, 0x36, 0x6a, 0x98,
-	0x0e, 0xfb, 0x24, 0x2a, 0xc4, 0x8c, 0xc6, 0x01, 0x95, 0x2b, 0xa5, 0xe1, 0x27, 0xf9, 0x14, 0x8c,
-	0x36, 0xf5, 0x9e, 0x65, 0x9b, 0x2d, 0x7b, 0x9f, 0x8a, 0x93, 0xd2, 0x52, 0xcd, 0x6d, 0xd6, 0x26,
-	0x57, 0x20, 0xd9, 0x68, 0x1b, 0xfd, 0xbe, 0xbe, 0x47, 0xcd, 0x91, 0xd2, 0x46, 0x68, 0xb3, 0x34,
-	0xf3, 0xf3, 0x28, 0x90, 0xf0, 0xee, 0x27, 0x0d, 0x48, 0xd1, 0x0d, 0x69, 0x1d, 0xb6, 0xb8, 0x51,
-	0xd6, 0xb8, 0x51, 0x96, 0x2e, 0x6a, 0x94, 0xea, 0x6e, 0x6d, 0x79, 0xf1, 0xf4, 0xe9, 0x74, 0x12,
-	0xd9, 0x54, 0x77, 0x6b, 0x5a, 0x12, 0x29, 0x57, 0x0f, 0x5b, 0xe4, 0x9b, 0x80, 0x86, 0xa2, 0x3c,
-	0x58, 0x6c, 0xbc, 0xf7, 0x71, 0x79, 0x8c, 0x54, 0xac, 0x23, 0x64, 0x31, 0x62, 0x5a, 0x47, 0xc8,
-	0xe1, 0x1b, 0x30, 0x8a, 0x1c, 0xec, 0xae, 0xdd, 0xb0, 0xb8, 0xe7, 0xbf, 0xc9, 0x79, 0xac, 0x7c,
-	0x04, 0xe7, 0xda, 0x44, 0x3a, 0x1a, 0xba, 0x2b, 0xfd, 0xe2, 0xe6, 0xfd, 0x49, 0x0c, 0x2e, 0x0d,
-	0x8b, 0x48, 0xa4, 0x0a, 0x69, 0x1e, 0xd7, 0x84, 0xd0, 0x92, 0x1b, 0x1e, 0xcc, 0x02, 0xf1, 0x05,
-	0x9c, 0x01, 0x4a, 0xbe, 0x01, 0x23, 0xb6, 0xe5, 0xea, 0x2d, 0x93, 0x6b, 0x69, 0xf5, 0xa3, 0x6a,
-	0x69, 0xd3, 0x72, 0x6b, 0x95, 0xd3, 0xa7, 0xd3, 0x09, 0xfa, 0xa1, 0x25, 0x6c, 0xcb, 0xad, 0xc9,
-	0xa6, 0x8e, 0xfd, 0x1f, 0x98, 0x3a, 0xfe, 0xdb, 0x31, 0xf5, 0xa7, 0x81, 0xeb, 0x4c, 0x88, 0x39,
-	0xa3, 0x0c, 0xf1, 0x83, 0xce, 0x5f, 0xc4, 0x61, 0x22, 0x94, 0x94, 0xc8, 0xeb, 0x30, 0x6a, 0xd9,
-	0x0d, 0xe7, 0xa4, 0xe7, 0x5a, 0x26, 0xf3, 0x76, 0xcd, 0x07, 0xc8, 0x37, 0x01, 0x28, 0x59, 0xe6,
-	0x44, 0xcc, 0x04, 0x45, 0x2e, 0xfd, 0xdd, 0x8b, 0x4a, 0x8f, 0xcc, 0x99, 0x17, 0x8d, 0xbe, 0xe3,
-	0x7d, 0x0a, 0x06, 0x8e, 0xfd, 0x36, 0x0c, 0x2c, 0x06, 0xd8, 0xf8, 0x27, 0x1c, 0x60, 0x37, 0x20,
-	0x6d, 0xb6, 0xf5, 0xbe, 0xe5, 0xba, 0x48, 0x82, 0x9f, 0x03, 0x42, 0x2e, 0x5e, 0x59, 0xaf, 0xf3,
-	0x11, 0x43, 0x42, 0x2d, 0x98, 0x6d, 0xaf, 0x97, 0x7c, 0x09, 0x52, 0xce, 0xb1, 0x6e, 0x5a, 0x6d,
-	0xe3, 0x84, 0xe6, 0xfe, 0xf1, 0xc2, 0x95, 0xd0, 0x76, 0x39, 0xae, 0x60, 0xb7, 0xb0, 0x57, 0x92,
-	0x0e, 0x83, 0xc8, 0x17, 0x21, 0xd9, 0x68, 0xea, 0xed, 0x56, 0xdf, 0x9d, 0x4a, 0x52, 0x41, 0x2e,
-	0x07, 0x27, 0x97, 0x57, 0xd7, 0x5b, 0x7d, 0xb7, 0x04, 0xe8, 0x3f, 0xec, 0x5b, 0x1b, 0x69, 0x34,
-	0xf1, 0x7f, 0xee, 0x20, 0xff, 0xa8, 0x00, 0xf8, 0xd2, 0x92, 0x2f, 0xc0, 0x98, 0x73, 0x3c, 0xaf,
-	0x9b, 0x8e, 0xde, 0x6d, 0x36, 0xfb, 0x96, 0x4b, 0xbd, 0x63, 0xac, 0x74, 0xf9, 0x45, 0x29, 0x3e,
-	0x1b, 0x9d, 0xc2, 0x00, 0x9e, 0xd6, 0x8e, 0xe7, 0x2b, 0xda, 0x16, 0xed, 0xd5, 0xd2, 0xce, 0xf1,
-	0x7c, 0xc5, 0x61, 0x0d, 0x72, 0x0f, 0x46, 0x9c, 0xe3, 0x82, 0x6e, 0x7a, 0x87, 0x83, 0x4f, 0x87,
-	0xb4, 0x62, 0xb8, 0x86, 0x66, 0xb8, 0x56, 0xcd, 0x36, 0xad, 0xe3, 0xd2, 0x84, 0xb7, 0x1e, 0xb4,
-	0x9f, 0x76, 0x5c, 0xa8, 0x68, 0x5a, 0xc2, 0x39, 0x2e, 0x54, 0x1c, 0x72, 0x1d, 0x92, 0xdd, 0x9e,
-	0xab, 0xdb, 0xd6, 0x3e, 0x0b, 0xf7, 0x4c, 0xfc, 0xad, 0x9e, 0xbb, 0x69, 0xed, 0x6b, 0x23, 0x5d,
-	0xfa, 0x3f, 0x17, 0xff, 0x09, 0xf0, 0x65, 0x91, 0x15, 0x88, 0xbf, 0x2a, 0xe8, 0xb0, 0x51, 0x81,
-	0xa0, 0x43, 0x67, 0x10, 0x02, 0xf1, 0x26, 0xcb, 0x40, 0xb1, 0x1b, 0x63, 0x1a, 0xfd, 0x26, 0x57,
-	0x21, 0xd5, 0x78, 0xac, 0x77, 0x8c, 0xfe, 0x41, 0x7f, 0x2a, 0x76, 0x2d, 0x76, 0x23, 0xa5, 0x25,
-	0x1b, 0x8f, 0x37, 0xb0, 0xc9, 0x19, 0x3f, 0x84, 0xcc, 0x7a, 0x57, 0x33, 0xbc, 0x25, 0xe1, 0x96,
-	0xda, 0x33, 0x6c, 0xf3, 0x49, 0xcb, 0x74, 0x1f, 0x33, 0xa5, 0x69, 0x3e, 0x40, 0x3e, 0x0f, 0x6a,
-	0xbf, 0xe7, 0x58, 0x06, 0xa6, 0x26, 0xbd, 0x69, 0x34, 0x5c, 0x7e, 0x82, 0x1a, 0xd3, 0xb2, 0x03,
-	0x7c, 0x95, 0xc2, 0x33, 0x37, 0x20, 0xbd, 0x5a, 0xbf, 0x3f, 0xa0, 0x7b, 0x15, 0x52, 0x7b, 0x2d,
-	0x57, 0x77, 0x0c, 0xd7, 0xe2, 0x64, 0x93, 0x7b, 0x2d, 0x17, 0xbb, 0x66, 0xfe, 0x44, 0x81, 0xd4,
-	0x60, 0xdc, 0x97, 0x20, 0x8e, 0xab, 0xe5, 0x27, 0xaa, 0xd7, 0x83, 0xcb, 0x17, 0x65, 0x2d, 0xa5,
-	0x4e, 0x9f, 0x4e, 0xc7, 0x11, 0x59, 0x8b, 0x68, 0x74, 0x16, 0x59, 0x81, 0x58, 0xb3, 0x7f, 0xc0,
-	0xcf, 0x0c, 0x9f, 0x0a, 0x9d, 0x19, 0x7c, 0x79, 0x58, 0x0e, 0x5f, 0xad, 0xdf, 0x5f, 0x8b, 0x68,
-	0x38, 0xa5, 0x34, 0x01, 0xd0, 0xe9, 0x9a, 0x87, 0x6d, 0xc3, 0x6d, 0x75, 0x6d, 0x7a, 0xd2, 0x9c,
-	0xf9, 0xeb, 0x38, 0xc0, 0xce, 0xf1, 0xc0, 0xa5, 0xca, 0x30, 0x6a, 0x1a, 0xae, 0xe1, 0x2f, 0x21,
-	0x5d, 0x98, 0x7a, 0x99, 0x67, 0x94, 0x32, 0xe2, 0x6e, 0xd1, 0x52, 0xa6, 0xb7, 0xbc, 0x2d, 0xc8,
-	0x0e, 0x88, 0xe8, 0x2d, 0xf4, 0x9f, 0xf3, 0x39, 0x99, 0x6f, 0xeb, 0x31, 0x53, 0xec, 0x20, 0xd3,
-	0x90, 0x6e, 0x74, 0xa9, 0x39, 0xa8, 0x5c, 0xe8, 0x67, 0xa3, 0x1a, 0x30, 0xc8, 0x33, 0x68, 0x93,
-	0x9e, 0xce, 0xed, 0xc6, 0x09, 0x8d, 0x22, 0x71, 0xcd, 0x07, 0xc8, 0xff, 0x07, 0xb0, 0x6c, 0x63,
-	0xaf, 0x6d, 0xe9, 0x0d, 0xa7, 0xc1, 0x4e, 0x18, 0xec, 0xec, 0x52, 0xa5, 0x68, 0x59, 0x2b, 0x63,
-	0x44, 0xa5, 0x9f, 0x4e, 0x03, 0x69, 0x0d, 0x0a, 0x32, 0xba, 0xcd, 0xc7, 0x34, 0x1f, 0x20, 0x8b,
-	0x10, 0xc7, 0x06, 0xdf, 0xc2, 0xb9, 0xd0, 0x99, 0x73, 0xc7, 0x1b, 0x59, 0x8a, 0x7f, 0xef, 0xbf,
-	0xf0, 0x18, 0x8c, 0xa3, 0xc9, 0x57, 0x20, 0x65, 0x76, 0x9f, 0xd8, 0xed, 0x96, 0x7d, 0x30, 0x95,
-	0xa2, 0x33, 0xaf, 0x07, 0x55, 0xe1, 0x1b, 0x61, 0xae, 0xc2, 0x87, 0x6a, 0x83, 0x49, 0xb9, 0xdf,
-	0x83, 0x94, 0x87, 0x92, 0xeb, 0x30, 0x66, 0xd8, 0xae, 0x65, 0xdb, 0x06, 0x57, 0x2e, 0x73, 0xb5,
-	0x0c, 0x07, 0x99, 0xca, 0xae, 0x42, 0xca, 0x3d, 0xd6, 0x7b, 0xdd, 0x27, 0x16, 0x73, 0xde, 0xa8,
-	0x96, 0x74, 0x8f, 0xb7, 0xb1, 0x49, 0x6e, 0xc1, 0x64, 0xcb, 0x3e, 0xb2, 0x1c, 0x57, 0xef, 0x75,
-	0xdb, 0x86, 0xd3, 0xfa, 0x16, 0x75, 0x07, 0x7e, 0x58, 0x23, 0xac, 0x6b, 0x5b, 0xe8, 0xe1, 0x9b,
-	0xe8, 0xcf, 0x14, 0xb8, 0x7a, 0xcf, 0x70, 0xad, 0x27, 0xc6, 0x49, 0x91, 0x73, 0xf2, 0x8b, 0x52,
-	0xb2, 0x0b, 0xe9, 0x7d, 0xd6, 0xa9, 0xb7, 0xcc, 0x3e, 0x77, 0x9d, 0x50, 0x25, 0xc7, 0xe7, 0x0b,
-	0x13, 0x87, 0x85, 0xdc, 0x7d, 0x6f, 0x54, 0x3f, 0xbc, 0xd6, 0x68, 0x78, 0xad, 0x33, 0xff, 0xad,
-	0x40, 0x7a, 0xb7, 0x87, 0xba, 0xd9, 0xe9, 0x1e, 0x58, 0x36, 0xd9, 0x80, 0x98, 0x2f, 0xc3, 0xe7,
-	0x5f, 0x22, 0x43, 0x78, 0x0d, 0x43, 0x44, 0x41, 0x3a, 0xb2, 0x43, 0x44, 0x83, 0x0e, 0x51, 0x85,
-	0x74, 0xdf, 0x72, 0x8e, 0x2c, 0x47, 0xa7, 0x7e, 0x11, 0x3b, 0xd3, 0x2f, 0x52, 0x48, 0x9d, 0xfa,
-	0x06, 0xb0, 0x89, 0xd8, 0x45, 0xde, 0x80, 0x89, 0x06, 0xa6, 0x5b, 0xdb, 0x75, 0x0c, 0xb7, 0xcb,
-	0x89, 0xa1, 0x27, 0xc7, 0x34, 0x55, 0xec, 0xc0, 0xc1, 0x33, 0xdf, 0x55, 0x20, 0xe3, 0xb9, 0xc3,
-	0xb6, 0xe1, 0x3e, 0x26, 0xd7, 0x21, 0x73, 0x48, 0x15, 0xa0, 0xbb, 0xa8, 0x01, 0x76, 0x4c, 0x58,
-	0x8b, 0x68, 0xe9, 0x43, 0x41, 0x2d, 0x45, 0x48, 0x34, 0x5b, 0xc7, 0x96, 0xc9, 0x23, 0xc7, 0xf9,
-	0x15, 0xb3, 0x16, 0xd1, 0xd8, 0xcc, 0x52, 0x1a, 0xe2, 0x3d, 0xe4, 0x47, 0x43, 0xc7, 0xbf, 0x24,
-	0x60, 0x74, 0xe7, 0x98, 0x9f, 0x2a, 0xc9, 0x1b, 0x90, 0xa0, 0x87, 0xf6, 0x97, 0xd5, 0xa8, 0x65,
-	0xec, 0xd4, 0xd8, 0x18, 0x52, 0x86, 0x71, 0xcf, 0xb5, 0x75, 0x24, 0xd8, 0xa7, 0xf1, 0x7c, 0x48,
-	0x28, 0x14, 0x57, 0xa9, 0x8d, 0x99, 0x42, 0xab, 0x4f, 0xbe, 0x0c, 0xa3, 0x34, 0xfd, 0xd1, 0x7c,
-	0x1c, 0x3b, 0x6f, 0x3e, 0x4e, 0x61, 0x16, 0xa4, 0x09, 0xf9, 0x01, 0x4c, 0xd2, 0xf9, 0x81, 0x50,
-	0x15, 0xbf, 0x58, 0xa8, 0x52, 0x91, 0x9e, 0x14, 0xad, 0xae, 0xb3, 0xb4, 0xec, 0x07, 0xa4, 0x04,
-	0x0d, 0x48, 0x19, 0xe7, 0x78, 0x7e, 0x75, 0x10, 0x93, 0x28, 0xf3, 0x42, 0x88, 0xf9, 0xc8, 0x85,
-	0x99, 0x17, 0x86, 0x30, 0x2f, 0x08, 0xcc, 0x93, 0x1e, 0xf3, 0x82, 0xcf, 0x7c, 0x0d, 0x52, 0x3d,
-	0xa7, 0xd5, 0x75, 0x5a, 0xee, 0x09, 0x0d, 0x47, 0xe3, 0xe1, 0x9d, 0xba, 0x73, 0x5c, 0x6f, 0x3c,
-	0xb6, 0xcc, 0xc3, 0xb6, 0xb5, 0xcd, 0x47, 0x8a, 0x3a, 0xf4, 0x66, 0x93, 0x2a, 0x8c, 0x19, 0x7b,
-	0xfd, 0x6e, 0xfb, 0xd0, 0xb5, 0x98, 0xcb, 0x8e, 0x9e, 0x33, 0x2e, 0x66, 0xbc, 0x69, 0xd4, 0xfb,
-	0x57, 0x61, 0x62, 0x20, 0xb1, 0xde, 0x6b, 0x1b, 0x36, 0x1e, 0x37, 0x01, 0xc3, 0x7c, 0x29, 0xf7,
-	0xa2, 0x14, 0x77, 0xa2, 0x53, 0x6f, 0x9e, 0x3e, 0x9d, 0xce, 0x0e, 0x56, 0xb0, 0xdd, 0x36, 0xec,
-	0x5a, 0x45, 0xcb, 0x36, 0x25, 0xc0, 0x24, 0x0b, 0x90, 0x32, 0xcc, 0x23, 0xc3, 0x6e, 0x58, 0xe6,
-	0x54, 0xe3, 0xd5, 0xb7, 0x02, 0x83, 0x81, 0x3c, 0xbc, 0xfd, 0xf1, 0x12, 0xbd, 0xf5, 0x28, 0x77,
-	0x3b, 0x1d, 0xc3, 0x36, 0x49, 0x0d, 0x62, 0x8d, 0x96, 0xc9, 0x9d, 0xf9, 0xb3, 0x43, 0x6e, 0xba,
-	0xf8, 0x40, 0x7f, 0x9b, 0xe0, 0x19, 0x29, 0xf9, 0x1d, 0x25, 0xae, 0x2a, 0xd7, 0x22, 0x98, 0x77,
-	0xcb, 0xb5, 0x8a, 0x86, 0x34, 0xc8, 0x67, 0x20, 0xed, 0x18, 0x4f, 0x06, 0xb7, 0x15, 0x51, 0xbe,
-	0x37, 0xc1, 0x31, 0x9e, 0x78, 0x67, 0xfc, 0x12, 0x8c, 0x3a, 0x56, 0x1f, 0x4f, 0xd9, 0xb6, 0x77,
-	0xbb, 0x76, 0xfd, 0xe5, 0x3c, 0xe7, 0x34, 0x1c, 0x5b, 0xb3, 0xcd, 0xb5, 0x88, 0x96, 0x72, 0xf8,
-	0x37, 0xa9, 0x62, 0x89, 0x81, 0x34, 0x1a, 0x5d, 0xbb, 0xc9, 0xef, 0x44, 0x3e, 0x7b, 0x16, 0x91,
-	0x72, 0xd7, 0x6e, 0xae, 0x45, 0x34, 0xc6, 0x1d, 0x1b, 0x64, 0x0b, 0xc6, 0xe9, 0xb6, 0x6c, 0x3c,
-	0xb6, 0x1a, 0x07, 0xba, 0x61, 0x7b, 0xc7, 0xe6, 0xcf, 0xbd, 0x82, 0xd4, 0x7a, 0xcb, 0x3e, 0x28,
-	0xe3, 0xf8, 0xa2, 0x8d, 0xc1, 0x22, 0xd3, 0x16, 0xda, 0xe4, 0x11, 0xd0, 0xb6, 0x6e, 0x98, 0x0e,
-	0xbd, 0x3b, 0x60, 0xb7, 0x66, 0xff, 0xef, 0x0c, 0x72, 0xc5, 0x8a, 0xa6, 0x59, 0xef, 0xb2, 0x9b,
-	0x20, 0xbf, 0x8d, 0x6a, 0x43, 0x62, 0x45, 0xd3, 0xd1, 0xac, 0x77, 0x25, 0xd2, 0x28, 0x69, 0xf2,
-	0xbc, 0xa4, 0x8b, 0x76, 0x5f, 0x22, 0xcd, 0xe4, 0xf6, 0x48, 0xa3, 0xd4, 0x5b, 0x30, 0x6e, 0x1e,
-	0xba, 0x27, 0x7a, 0xe3, 0xa4, 0xd1, 0xb6, 0xa8, 0xdc, 0xa9, 0x33, 0xd5, 0x50, 0x39, 0x74, 0x4f,
-	0xca, 0x38, 0x9e, 0x49, 0x9a, 0x31, 0x85, 0x36, 0x79, 0x04, 0xc4, 0x39, 0xd6, 0x7b, 0x86, 0x63,
-	0x74, 0xb0, 0x22, 0x39, 0xec, 0x51, 0xa2, 0x6c, 0xbb, 0xcc, 0xbe, 0xca, 0x4c, 0xc7, 0xdb, 0x38,
-	0xa7, 0x8e, 0x53, 0x18, 0xdd, 0xac, 0x23, 0x43, 0x43, 0x48, 0xa3, 0x32, 0xe0, 0x42, 0xa4, 0x99,
-	0x06, 0x24, 0xd2, 0x9e, 0x1a, 0xac, 0x23, 0xbd, 0xef, 0x1a, 0xee, 0x61, 0x9f, 0x92, 0x4d, 0x9f,
-	0xad, 0x06, 0xeb, 0xa8, 0x4e, 0xc7, 0x73, 0x6f, 0x30, 0x85, 0x36, 0xd1, 0x20, 0x6b, 0x5b, 0x4f,
-	0xf4, 0xc6, 0x63, 0xc3, 0xb6, 0xad, 0x36, 0xd5, 0x41, 0x86, 0x52, 0xbc, 0xf1, 0x0a, 0x8a, 0x9b,
-	0xd6, 0x93, 0x32, 0x9b, 0xc0, 0x34, 0x30, 0x66, 0x8b, 0x40, 0x90, 0x26, 0x4a, 0x39, 0x76, 0x01,
-	0x9a, 0x4c, 0x4c, 0x81, 0x26, 0xca, 0x69, 0xc0, 0xb8, 0xd9, 0x96, 0xc4, 0x1c, 0x3f, 0x7b, 0xe1,
-	0xeb, 0xbe, 0x50, 0x25, 0xf5, 0xf4, 0xe9, 0x74, 0x46, 0x44, 0xa8, 0x2a, 0xda, 0x82, 0xd8, 0x32,
-	0x0b, 0x94, 0x3a, 0x7b, 0x7e, 0x16, 0xe8, 0xc1, 0x32, 0x0b, 0x4f, 0xdb, 0x6d, 0x61, 0x15, 0x5f,
-	0xc7, 0x2c, 0x83, 0x81, 0x19, 0xcf, 0xce, 0xbe, 0xd7, 0xa9, 0x94, 0xcf, 0x1b, 0xaf, 0x74, 0x8d,
-	0x1d, 0x3a, 0x49, 0x70, 0x3b, 0xd5, 0x09, 0x60, 0xe8, 0x77, 0x6e, 0xd8, 0xa5, 0x27, 0xce, 0xf4,
-	0xbb, 0x9d, 0xb0, 0x4b, 0xbb, 0x01, 0x97, 0xa6, 0x01, 0xf1, 0xc0, 0x3a, 0xa1, 0x01, 0x91, 0x9c,
-	0x23, 0x20, 0x1e, 0x58, 0x27, 0x83, 0x80, 0xc8, 0xbe, 0x59, 0x40, 0x44, 0x1a, 0x34, 0x20, 0x4e,
-	0x9e, 0x23, 0x20, 0x1e, 0x58, 0x27, 0x7e, 0x40, 0xe4, 0x0d, 0xe2, 0xc0, 0x24, 0xc6, 0x97, 0xe0,
-	0x32, 0x2f, 0x9d, 0xa9, 0xc3, 0x62, 0x45, 0x93, 0x16, 0x55, 0xba, 0x74, 0xfa, 0x74, 0x5a, 0x0d,
-	0xa2, 0xa8, 0x59, 0xc3, 0x74, 0xe4, 0xe5, 0x6b, 0x90, 0x35, 0xad, 0xa3, 0x56, 0x83, 0x25, 0x55,
-	0xea, 0x1b, 0xaf, 0x9d, 0xe9, 0xd1, 0x15, 0x3a, 0x03, 0xf3, 0x29, 0xf7, 0x68, 0x53, 0x04, 0xc8,
-	0x2e, 0xa8, 0xcd, 0xae, 0xd3, 0xc0, 0x60, 0xe6, 0xbd, 0x66, 0x4c, 0x5d, 0x1e, 0x7e, 0x12, 0x14,
-	0x88, 0xae, 0xe2, 0x94, 0xc1, 0xfd, 0xe1, 0x5a, 0x44, 0x1b, 0x6f, 0x4a, 0x08, 0xb1, 0x06, 0xcf,
-	0x23, 0x41, 0x0d, 0x5d, 0xa1, 0xc4, 0xe7, 0x5e, 0xa9, 0x71, 0x9c, 0x18, 0x54, 0xc7, 0xa4, 0x13,
-	0x86, 0x5f, 0xc2, 0x06, 0x15, 0x33, 0x75, 0x61, 0x36, 0x4c, 0x3d, 0x21, 0x36, 0x2c, 0x59, 0x91,
-	0x1e, 0xdd, 0x2b, 0xed, 0x2e, 0x26, 0xe3, 0x66, 0x97, 0xae, 0xe4, 0xea, 0x99, 0x2e, 0xbd, 0x8d,
-	0xfb, 0xa2, 0xdd, 0x75, 0x6b, 0x76, 0xb3, 0xcb, 0x5d, 0xba, 0x27, 0x43, 0x64, 0x0f, 0x5e, 0xf3,
-	0x49, 0x8b, 0x81, 0x25, 0x47, 0xa9, 0xdf, 0x3c, 0x07, 0x75, 0x29, 0x98, 0x90, 0x5e, 0x08, 0x1d,
-	0xce, 0x03, 0x95, 0xf4, 0xa9, 0x8b, 0xf2, 0x60, 0x3a, 0x0a, 0xf2, 0x40, 0x15, 0xbd, 0x0d, 0x13,
-	0x7b, 0x96, 0xd1, 0xe8, 0xda, 0x5e, 0x5c, 0x41, 0xfa, 0xaf, 0x9f, 0xa9, 0xa1, 0x12, 0x9d, 0xc3,
-	0x22, 0x08, 0x4f, 0x36, 0x7b, 0x32, 0x84, 0x5e, 0xcf, 0x29, 0xe3, 0xb9, 0x8e, 0xea, 0xe6, 0xd3,
-	0x67, 0x7a, 0x3d, 0xa3, 0x8b, 0x27, 0x43, 0x9e, 0x1b, 0xf6, 0x44, 0x20, 0x48, 0x13, 0x65, 0xcd,
-	0x5f, 0x80, 0x26, 0xdf, 0x49, 0x7b, 0x22, 0x20, 0xec, 0xce, 0x4e, 0xd7, 0xa4, 0x27, 0xf7, 0xa9,
-	0xe9, 0x73, 0xee, 0xce, 0x8d, 0xae, 0x69, 0xb1, 0x38, 0xc5, 0x77, 0x27, 0x07, 0x70, 0x77, 0x8a,
-	0x34, 0x69, 0xc8, 0xba, 0x76, 0xe6, 0xee, 0xf4, 0x89, 0xf2, 0xb8, 0x35, 0x6e, 0x4a, 0x48, 0xee,
-	0x6d, 0x48, 0x79, 0x87, 0x45, 0xb2, 0x0a, 0x63, 0x9d, 0x96, 0xdd, 0x75, 0xf4, 0x23, 0xcb, 0xe9,
-	0x63, 0xc5, 0xff, 0xb2, 0xd7, 0x44, 0x1c, 0x54, 0x02, 0xef, 0x34, 0x3b, 0xa5, 0x68, 0x19, 0x3a,
-	0xef, 0x01, 0x9b, 0xc6, 0xce, 0xcb, 0xb9, 0x47, 0x30, 0x3a, 0x38, 0x41, 0x7e, 0xc2, 0xa4, 0x2d,
-	0xc8, 0x88, 0x27, 0x4a, 0x72, 0x0d, 0x46, 0x3a, 0x86, 0xb3, 0xdf, 0xb2, 0xf9, 0x05, 0x27, 0x7f,
-	0x44, 0xfc, 0x1f, 0x45, 0xe3, 0x38, 0xb9, 0x09, 0x63, 0xde, 0xed, 0x43, 0xa3, 0x7b, 0x68, 0x87,
-	0x5f, 0x1b, 0x33, 0xbc, 0xbb, 0x8c, 0xbd, 0x9c, 0xcd, 0x8f, 0xa3, 0x20, 0x1c, 0x2d, 0x87, 0xdd,
-	0x5a, 0x29, 0x1f, 0xeb, 0xd6, 0xea, 0x26, 0x8c, 0x7b, 0x57, 0x30, 0xe2, 0xe5, 0x05, 0x7d, 0x86,
-	0x9b, 0x8d, 0x4e, 0x65, 0xb5, 0x0c, 0xbf, 0x91, 0x61, 0xc3, 0xdf, 0x80, 0x8c, 0xb7, 0x63, 0x3b,
-	0x46, 0xff, 0x80, 0xdd, 0x64, 0x52, 0xea, 0xdf, 0x57, 0xa2, 0xaa, 0xaa, 0xa5, 0x79, 0xef, 0x86,
-	0xd1, 0x3f, 0x20, 0x77, 0xe1, 0x92, 0x38, 0x18, 0xfd, 0xc5, 0x75, 0xba, 0x6d, 0xf6, 0xb2, 0xe0,
-	0x71, 0x48, 0x6a, 0x44, 0x98, 0x53, 0x66, 0x43, 0xc8, 0x0c, 0xa4, 0xec, 0x3d, 0xdd, 0x75, 0x70,
-	0x2b, 0x8c, 0xc8, 0x02, 0x25, 0xed, 0xbd, 0x1d, 0xc4, 0x99, 0x82, 0xde, 0x8a, 0xa7, 0xe2, 0x6a,
-	0x22, 0xf7, 0x7d, 0x05, 0x84, 0x63, 0x32, 0xb9, 0x01, 0xaa, 0xc4, 0xd9, 0x68, 0x1c, 0xb0, 0x17,
-	0x43, 0x6d, 0x5c, 0x60, 0x56, 0x6c, 0x1c, 0x90, 0x9b, 0x30, 0x19, 0x50, 0x28, 0x1d, 0x4c, 0xdf,
-	0x0e, 0x35, 0x55, 0xd2, 0x15, 0x0e, 0x7f, 0x83, 0x9d, 0x26, 0x7c, 0x75, 0xe9, 0xfe, 0x13, 0x62,
-	0x56, 0xd4, 0x54, 0xb1, 0x71, 0x90, 0x6b, 0x40, 0x46, 0x3c, 0x6d, 0x93, 0x3a, 0x8c, 0x77, 0x8c,
-	0x63, 0xdd, 0x3f, 0xb2, 0x73, 0xdb, 0x85, 0x0e, 0x0d, 0xc5, 0xfd, 0x7d, 0xc7, 0x42, 0x67, 0x30,
-	0x07, 0xf3, 0x05, 0x0b, 0x66, 0x3a, 0xc6, 0xf1, 0x00, 0xcf, 0xfd, 0xbb, 0x02, 0xd9, 0xc0, 0xf1,
-	0xfb, 0x65, 0x75, 0xbb, 0xf2, 0x71, 0xeb, 0xf6, 0x15, 0xb8, 0x24, 0x5f, 0x46, 0xf0, 0x2b, 0xfd,
-	0xa8, 0x6c, 0xd0, 0x09, 0xe1, 0xb6, 0x81, 0xdf, 0xe4, 0xcf, 0x05, 0x2b, 0x7e, 0x54, 0x59, 0x9c,
-	0xbe, 0x06, 0x17, 0xe2, 0x37, 0x7e, 0xf4, 0x47, 0x23, 0x72, 0xf1, 0xcf, 0x9d, 0xff, 0x6f, 0x02,
-	0x6b, 0x43, 0xd3, 0x2e, 0xc2, 0x95, 0x21, 0x6b, 0x13, 0x2c, 0x3c, 0x19, 0x14, 0x1b, 0xed, 0xb6,
-	0x0c, 0x53, 0xc3, 0x24, 0x17, 0x6c, 0x7d, 0x29, 0x24, 0x34, 0xce, 0x9b, 0x85, 0x09, 0x49, 0x6e,
-	0xd1, 0xdc, 0xa2, 0xc0, 0x68, 0x6e, 0x13, 0x32, 0x62, 0x55, 0x41, 0x66, 0x20, 0xb9, 0x67, 0xb8,
-	0xae, 0xe5, 0x9c, 0xc8, 0x21, 0xe1, 0x43, 0x45, 0xf3, 0x3a, 0xc8, 0xec, 0x20, 0x6a, 0xa0, 0x14,
-	0x89, 0x12, 0x79, 0x51, 0xca, 0xe6, 0xc6, 0xa6, 0xa6, 0x6f, 0x7c, 0xf0, 0x21, 0xff, 0x37, 0x88,
-	0x1f, 0x5c, 0x27, 0x3f, 0x8c, 0xc2, 0x98, 0x54, 0x6a, 0x60, 0x5c, 0xf1, 0x9c, 0x5d, 0xb8, 0x6a,
-	0x15, 0xe3, 0x0a, 0xef, 0x66, 0x46, 0xfc, 0xbc, 0x78, 0x0d, 0x1d, 0xa5, 0x66, 0x48, 0xbf, 0x28,
-	0xa5, 0x0a, 0x23, 0x53, 0x11, 0x6a, 0x08, 0xe1, 0x4e, 0xfa, 0x01, 0x4c, 0x76, 0x5a, 0x76, 0xc8,
-	0x8f, 0x62, 0x17, 0xf4, 0xa3, 0x4e, 0xcb, 0x96, 0xfd, 0x08, 0xe9, 0xe2, 0x46, 0xf8, 0x98, 0x97,
-	0x5a, 0xb8, 0x0f, 0xc4, 0x3e, 0xae, 0xa1, 0xb7, 0x45, 0x05, 0xa1, 0x21, 0xae, 0xc3, 0x98, 0x6c,
-	0x40, 0xe6, 0x28, 0x99, 0xa6, 0x60, 0x3d, 0x32, 0x03, 0x63, 0xbe, 0x3c, 0xbe, 0x5b, 0xa4, 0xbd,
-	0x10, 0x80, 0x16, 0x6e, 0x83, 0x54, 0x2c, 0x5d, 0x54, 0xf3, 0x9f, 0x0b, 0x6b, 0x5e, 0xd8, 0x00,
-	0x7e, 0x1f, 0x5f, 0x87, 0x0e, 0x52, 0xdd, 0x84, 0xbe, 0x28, 0x71, 0x13, 0x96, 0x92, 0x15, 0xf9,
-	0xe0, 0x6a, 0x42, 0x4b, 0x8e, 0x86, 0x97, 0x9c, 0xbb, 0x0f, 0x6a, 0xb0, 0x84, 0x22, 0x77, 0x20,
-	0xc1, 0xee, 0x2a, 0x95, 0xf3, 0xde, 0x55, 0xb2, 0xf1, 0xb9, 0x7f, 0x56, 0x20, 0x1b, 0xa8, 0x99,
-	0xc8, 0xd7, 0x58, 0xc0, 0xb3, 0x5a, 0x4e, 0x4f, 0x0a, 0x41, 0xe1, 0xd7, 0x4d, 0x7a, 0x20, 0xa8,
-	0xd6, 0xb4, 0xed, 0xd2, 0x94, 0xf0, 0x88, 0x97, 0xd9, 0x30, 0x8e, 0x11, 0xa4, 0xcb, 0xa2, 0x71,
-	0xaf, 0xda, 0x72, 0x7a, 0x4c, 0x99, 0xb3, 0x30, 0xc1, 0x6f, 0x93, 0xcd, 0x27, 0x56, 0xbb, 0xcd,
-	0x2e, 0xf6, 0xd8, 0x2a, 0xb3, 0xac, 0xa3, 0x82, 0x38, 0xbd, 0xb9, 0x9b, 0x83, 0xc9, 0xc1, 0x4d,
-	0xae, 0x30, 0x9a, 0xed, 0xe3, 0x09, 0xaf, 0x6b, 0x30, 0x3e, 0xb7, 0x8d, 0x07, 0x12, 0x5e, 0xa0,
-	0x55, 0x2e, 0x74, 0x6a, 0x10, 0xa3, 0xb4, 0x70, 0x66, 0xc8, 0x7d, 0x15, 0x0f, 0x22, 0x5e, 0xb1,
-	0xf6, 0xc9, 0x90, 0x7c, 0x2f, 0x0a, 0xa1, 0x3a, 0x8d, 0x9c, 0xc0, 0x65, 0xef, 0x27, 0x30, 0xed,
-	0x56, 0xa7, 0xe5, 0xea, 0xd6, 0x71, 0xaf, 0x6b, 0x5b, 0xb6, 0xfb, 0xd2, 0x54, 0x43, 0x7f, 0x19,
-	0xb3, 0x8e, 0x63, 0xab, 0x7c, 0x68, 0x69, 0x5a, 0x30, 0xc1, 0xe4, 0x90, 0x01, 0xda, 0x24, 0xfb,
-	0x11, 0x8d, 0x04, 0x8a, 0xac, 0xa9, 0x47, 0xf8, 0xac, 0xa3, 0xaf, 0x62, 0x4d, 0xdd, 0xe9, 0x55,
-	0xac, 0xa5, 0x01, 0x1e, 0x6b, 0x09, 0xcc, 0x7d, 0x15, 0xc6, 0xa4, 0xba, 0x92, 0xbc, 0x79, 0xee,
-	0x07, 0x30, 0xf5, 0x45, 0x29, 0xf1, 0xf7, 0x4a, 0x34, 0xa5, 0x0c, 0x1e, 0x3c, 0xe8, 0xcc, 0xdc,
-	0xdf, 0x45, 0x61, 0x5c, 0x2e, 0x2b, 0x3f, 0xa9, 0xdf, 0xa2, 0x7c, 0xe2, 0x0f, 0x8f, 0x37, 0x20,
-	0x8d, 0xbb, 0xcc, 0xb1, 0x5c, 0xa7, 0x65, 0xf5, 0xf9, 0xef, 0xac, 0x06, 0xc9, 0x18, 0x3a, 0xc6,
-	0xb1, 0xc6, 0xba, 0xc8, 0x43, 0xc8, 0xf6, 0x2c, 0xa7, 0xd5, 0x35, 0x7d, 0xdb, 0xc4, 0x87, 0xdf,
-	0x1d, 0xf3, 0x6a, 0x94, 0x0e, 0x1e, 0x18, 0xc7, 0x97, 0x60, 0xbc, 0x27, 0xf5, 0xf0, 0x80, 0xf5,
-	0xaf, 0x0a, 0x4c, 0x0e, 0xa9, 0x96, 0xc9, 0xef, 0x02, 0x41, 0x01, 0xe9, 0xa1, 0xf7, 0x4c, 0x87,
-	0x64, 0x04, 0xe8, 0x11, 0x78, 0x08, 0x63, 0x8c, 0xf9, 0x52, 0x1f, 0x56, 0x7a, 0x48, 0x9c, 0x5e,
-	0x41, 0x04, 0x3c, 0x6e, 0xe6, 0x25, 0xb6, 0x69, 0x75, 0xac, 0x21, 0xa4, 0xb3, 0x1d, 0xe3, 0x58,
-	0xec, 0xca, 0xad, 0x85, 0x57, 0x83, 0xbe, 0x35, 0x0f, 0xaf, 0x85, 0x18, 0x0a, 0xa1, 0x98, 0x04,
-	0xc8, 0x60, 0xa0, 0xad, 0x43, 0x36, 0x50, 0x7b, 0x93, 0x37, 0x61, 0x84, 0xe9, 0x90, 0xeb, 0x21,
-	0x1f, 0x94, 0xd5, 0x9b, 0xc0, 0x6c, 0x20, 0xc8, 0xc9, 0xe7, 0xe5, 0xfe, 0x54, 0x01, 0x12, 0xae,
-	0xb9, 0xe5, 0xf4, 0xae, 0xbc, 0x32, 0xbd, 0x7f, 0xd2, 0x9e, 0xc8, 0xdd, 0xe0, 0x71, 0x48, 0xae,
-	0x73, 0x27, 0xe1, 0x8b, 0x9d, 0xc6, 0x73, 0xfb, 0x90, 0x0d, 0x54, 0xec, 0x64, 0x5a, 0xcc, 0x5f,
-	0xd2, 0xcf, 0x0d, 0x19, 0x1e, 0xce, 0xd9, 0xd1, 0x57, 0xe5, 0x6c, 0xbe, 0xa4, 0x37, 0x61, 0x4c,
-	0x2a, 0xe1, 0x2f, 0xa0, 0x65, 0x4e, 0x61, 0x51, 0xa4, 0x70, 0x5e, 0x7d, 0xe4, 0x56, 0xbd, 0xc0,
-	0xe6, 0x55, 0xe0, 0x4b, 0xe7, 0x79, 0xc0, 0x14, 0x93, 0x33, 0x1d, 0x9d, 0xbb, 0x07, 0xe3, 0x72,
-	0x15, 0xfe, 0x11, 0x09, 0xf1, 0x5f, 0x02, 0x8f, 0x42, 0x92, 0x3f, 0x14, 0xcd, 0xd4, 0x81, 0x48,
-	0xce, 0xf1, 0xc0, 0x68, 0x1f, 0x5a, 0xe4, 0x77, 0x20, 0x71, 0x84, 0x1f, 0x17, 0x2d, 0x39, 0xd8,
-	0xac, 0x99, 0x5d, 0x98, 0x94, 0xdd, 0x9f, 0x51, 0xfd, 0xb2, 0x4c, 0xf5, 0xfc, 0x5b, 0x86, 0x93,
-	0xd5, 0x61, 0x6a, 0x48, 0x65, 0xc5, 0x68, 0x97, 0x65, 0xda, 0x17, 0x2c, 0xc9, 0x38, 0x83, 0x7b,
-	0x90, 0xe1, 0xe7, 0x23, 0x46, 0xf4, 0x8e, 0x4c, 0xf4, 0x3c, 0x87, 0x29, 0x5f, 0xd2, 0x70, 0xde,
-	0x3d, 0xa7, 0xa4, 0x43, 0x32, 0xfa, 0xcb, 0x19, 0x48, 0x89, 0xf4, 0x22, 0x0c, 0xe4, 0xbc, 0x1d,
-	0x64, 0x30, 0xfb, 0x43, 0x05, 0x12, 0xf4, 0x17, 0xdf, 0x44, 0x85, 0xcc, 0x5b, 0x5b, 0xb5, 0x4d,
-	0x5d, 0xab, 0x7e, 0x75, 0xb7, 0x5a, 0xdf, 0x51, 0x23, 0x24, 0x0b, 0x69, 0x8a, 0x14, 0xcb, 0xe5,
-	0xea, 0xf6, 0x8e, 0xaa, 0x10, 0x02, 0xe3, 0xbb, 0x9b, 0xe5, 0xad, 0xcd, 0xd5, 0x9a, 0xb6, 0x51,
-	0xad, 0xe8, 0xbb, 0xdb, 0x6a, 0x94, 0x5c, 0x02, 0x55, 0xc4, 0x2a, 0x5b, 0x0f, 0x37, 0xd5, 0x18,
-	0x12, 0x93, 0xc6, 0xc5, 0x71, 0x6e, 0x60, 0x54, 0x02, 0x31, 0xad, 0x2a, 0x31, 0x1d, 0x41, 0xa6,
-	0xdb, 0xda, 0xd6, 0xb6, 0x56, 0xab, 0xee, 0x14, 0xb5, 0x47, 0x6a, 0x72, 0xf6, 0x0a, 0x24, 0xe8,
-	0x6f, 0xcb, 0xc9, 0x38, 0xc0, 0xfa, 0x96, 0x56, 0x7c, 0x58, 0xdc, 0xd4, 0xb5, 0x79, 0x35, 0x32,
-	0xfb, 0x5d, 0x85, 0x3e, 0xb2, 0xf2, 0x73, 0x16, 0x4e, 0xdc, 0x28, 0x96, 0xf5, 0xdd, 0xcd, 0xfb,
-	0x9b, 0x48, 0x3d, 0x42, 0x32, 0x90, 0x42, 0xe0, 0xc1, 0xbc, 0x7e, 0x5b, 0x55, 0x70, 0xb6, 0xd7,
-	0xd2, 0xe7, 0xd5, 0xa8, 0xd4, 0x2e, 0xa8, 0x31, 0x61, 0xf4, 0xbc, 0x1a, 0x97, 0x7a, 0x17, 0xd4,
-	0x84, 0xd4, 0x5e, 0x54, 0x47, 0x72, 0xa9, 0xf7, 0xfe, 0x32, 0x1f, 0xf9, 0xe9, 0x8f, 0xf3, 0x91,
-	0xd9, 0xbf, 0x55, 0x00, 0xb6, 0xd7, 0x1e, 0x09, 0x52, 0x6c, 0xaf, 0x3d, 0x92, 0xa5, 0x40, 0xc0,
-	0x97, 0xc2, 0x6b, 0x51, 0x29, 0x2e, 0x81, 0x3a, 0x68, 0x17, 0x74, 0xad, 0xfa, 0x40, 0x2f, 0xaa,
-	0xb1, 0x21, 0x68, 0x89, 0x69, 0x90, 0xa3, 0xf3, 0x7c, 0x64, 0x22, 0x84, 0x95, 0xd4, 0x11, 0x69,
-	0xf6, 0x02, 0x1f, 0x99, 0x14, 0x25, 0x8e, 0xc2, 0x98, 0x5c, 0xe7, 0x65, 0x21, 0x5d, 0x29, 0xee,
-	0x14, 0x75, 0xad, 0xb8, 0x53, 0xd5, 0x6f, 0x33, 0xcb, 0xfb, 0xc0, 0xbc, 0xaa, 0xc8, 0x40, 0x41,
-	0x8d, 0xca, 0xc0, 0x82, 0x1a, 0x93, 0x81, 0x45, 0x35, 0x2e, 0x03, 0x4b, 0x6a, 0x42, 0x06, 0x96,
-	0x99, 0xa9, 0x7d, 0xe0, 0x8e, 0x9a, 0x94, 0x81, 0x15, 0x35, 0x25, 0x03, 0x77, 0xd5, 0x51, 0xf4,
-	0x2b, 0x41, 0xb0, 0xdb, 0x2a, 0x04, 0x90, 0x79, 0x35, 0x1d, 0x40, 0x0a, 0x6a, 0x26, 0x80, 0x2c,
-	0xa8, 0x63, 0x01, 0x64, 0x51, 0x1d, 0x0f, 0x20, 0x4b, 0x6a, 0x56, 0xd0, 0xd8, 0x6d, 0x00, 0xff,
-	0xb8, 0x48, 0xd2, 0x90, 0x2c, 0x6f, 0x6d, 0xee, 0x54, 0xdf, 0xc6, 0x3d, 0x92, 0x86, 0x64, 0xbd,
-	0x5a, 0xaf, 0xd7, 0xb6, 0x36, 0x55, 0x85, 0xa4, 0x20, 0x7e, 0xbf, 0xfa, 0xa8, 0xae, 0x46, 0x71,
-	0x86, 0xff, 0xbb, 0x43, 0x5c, 0xc6, 0x2a, 0xf5, 0xf0, 0xcd, 0x72, 0xad, 0x5a, 0x57, 0x23, 0x64,
-	0x02, 0xc6, 0xca, 0x6b, 0xc5, 0xcd, 0xcd, 0xea, 0xba, 0xbe, 0x51, 0xac, 0xdf, 0xaf, 0xab, 0xca,
-	0xec, 0x22, 0x24, 0x68, 0x2c, 0xa7, 0xe4, 0xd7, 0x8b, 0xf5, 0xba, 0x5e, 0x64, 0xe4, 0x59, 0xa3,
-	0xa4, 0x2a, 0x7e, 0xa3, 0xac, 0x46, 0x73, 0x71, 0x94, 0x6e, 0xb6, 0x07, 0x24, 0xfc, 0xe3, 0x0a,
-	0x02, 0x30, 0xb2, 0xbe, 0xf5, 0x90, 0x6d, 0xe2, 0x24, 0xc4, 0xd6, 0xb7, 0x1e, 0xaa, 0x0a, 0x2e,
-	0xb0, 0x54, 0x5d, 0xdf, 0x7a, 0xa8, 0x6f, 0x6e, 0x69, 0x1b, 0xc5, 0x75, 0x35, 0x8a, 0xc3, 0xf8,
-	0x37, 0xdd, 0xb0, 0xc5, 0xd2, 0xd6, 0x83, 0xaa, 0xd7, 0x1b, 0xc7, 0xc5, 0xac, 0xd5, 0xee, 0xad,
-	0xa9, 0x09, 0xe4, 0x8b, 0x5f, 0x74, 0x7f, 0xce, 0xfe, 0x67, 0x0c, 0x2e, 0x0d, 0xfb, 0xc5, 0x02,
-	0x19, 0x83, 0xd1, 0x72, 0xad, 0xa2, 0x6b, 0xab, 0xbb, 0xd4, 0x85, 0xbc, 0x66, 0xb5, 0x5e, 0xe5,
-	0xa1, 0x03, 0x9b, 0xeb, 0xb5, 0xcd, 0xfb, 0x7a, 0x79, 0xad, 0x5a, 0xbe, 0xaf, 0x46, 0x69, 0x90,
-	0xf0, 0xb0, 0x62, 0x45, 0x53, 0x63, 0xde, 0xa8, 0xca, 0xee, 0xce, 0x23, 0xbd, 0xfc, 0xa8, 0xbc,
-	0x5e, 0x55, 0xe3, 0xe4, 0x32, 0x10, 0x4a, 0xe8, 0x6d, 0x7d, 0xbb, 0xa8, 0x15, 0x37, 0xf4, 0x7a,
-	0x75, 0x67, 0x77, 0x9b, 0xb9, 0x3e, 0x1d, 0x5b, 0x7d, 0xa0, 0xd7, 0x77, 0x8a, 0x3b, 0xbb, 0x75,
-	0x75, 0x84, 0x4c, 0x42, 0x16, 0xb1, 0xcd, 0xea, 0x43, 0x9d, 0xeb, 0x57, 0x4d, 0x92, 0x2b, 0x30,
-	0xc9, 0x09, 0xec, 0xd4, 0x36, 0x6a, 0x9b, 0xf7, 0x38, 0x85, 0x94, 0x47, 0x79, 0x47, 0xa6, 0x3c,
-	0x3a, 0xa0, 0xbc, 0x3e, 0x20, 0x02, 0xfe, 0x72, 0xee, 0x57, 0x1f, 0xa9, 0x69, 0x8f, 0x66, 0xb1,
-	0xa2, 0x49, 0x73, 0x33, 0x9e, 0x04, 0x95, 0xea, 0x83, 0x5a, 0xb9, 0x8a, 0x0c, 0xab, 0xea, 0x18,
-	0xee, 0x48, 0x04, 0x57, 0xb7, 0xb4, 0x72, 0x55, 0x67, 0x11, 0x4f, 0x1d, 0x27, 0x39, 0xb8, 0xcc,
-	0x48, 0xd2, 0x08, 0x28, 0x92, 0xc9, 0x7a, 0xa2, 0x6d, 0x53, 0x71, 0xd7, 0xb7, 0x76, 0xf4, 0xda,
-	0xe6, 0xea, 0x96, 0xaa, 0x92, 0xab, 0xf0, 0x9a, 0x8c, 0x7b, 0x12, 0x4e, 0x90, 0xd7, 0x60, 0x02,
-	0xbb, 0x4a, 0xd5, 0x62, 0x79, 0x6b, 0x93, 0x2f, 0x55, 0x25, 0x9e, 0x40, 0x1c, 0x46, 0x37, 0x54,
-	0x27, 0x03, 0x52, 0x6e, 0x6c, 0x55, 0xaa, 0xea, 0x35, 0xee, 0x51, 0xbf, 0x8c, 0xc2, 0xe4, 0x90,
-	0x24, 0x4a, 0xf7, 0xc7, 0xc0, 0x2c, 0xfa, 0xbc, 0x1a, 0x09, 0x20, 0x05, 0xe6, 0x62, 0x02, 0xb2,
-	0xc8, 0x4c, 0x2c, 0x20, 0x2b, 0x6a, 0x0c, 0x5d, 0x5f, 0xa4, 0xb3, 0xac, 0xc6, 0x03, 0xd0, 0x42,
-	0x41, 0x4d, 0x04, 0xa0, 0xe5, 0x45, 0x75, 0x04, 0xad, 0x22, 0x4e, 0x2c, 0xac, 0xa8, 0xc9, 0x00,
-	0x56, 0x58, 0x5a, 0x56, 0x53, 0x01, 0x6c, 0x69, 0xbe, 0xa0, 0x8e, 0xe2, 0x7a, 0xc5, 0xb9, 0xb7,
-	0x0b, 0x8b, 0x2a, 0x04, 0xc0, 0xc2, 0xed, 0xc5, 0x15, 0x35, 0x1d, 0x00, 0x17, 0x6f, 0xdf, 0x5d,
-	0x66, 0x46, 0x15, 0x57, 0x31, 0x7f, 0xb7, 0xc0, 0x8c, 0x2a, 0x2d, 0x64, 0x61, 0x05, 0xc3, 0x88,
-	0x8c, 0x2e, 0x14, 0xee, 0x2c, 0xaf, 0xa8, 0x59, 0xae, 0xda, 0x7f, 0x50, 0x60, 0x5c, 0x3e, 0xfb,
-	0xe0, 0x3a, 0xa9, 0x2d, 0xab, 0x0f, 0xaa, 0xda, 0x23, 0x7d, 0x9e, 0xc7, 0x06, 0x01, 0x2a, 0xd4,
-	0x55, 0x25, 0x00, 0x2d, 0xd6, 0xd5, 0x68, 0x00, 0x5a, 0xa9, 0xb3, 0xcd, 0x23, 0xd2, 0x5a, 0xae,
-	0xf3, 0x9c, 0xe1, 0x63, 0x0b, 0x85, 0x3a, 0xcf, 0x19, 0x3e, 0xb6, 0xbc, 0xc8, 0x37, 0x8e, 0x38,
-	0xb7, 0xb0, 0x52, 0x57, 0x93, 0x5c, 0xea, 0x3f, 0x8c, 0x79, 0xf5, 0x95, 0x5c, 0xd0, 0x4d, 0x42,
-	0x96, 0xbb, 0x6e, 0x79, 0x6b, 0x77, 0x73, 0x07, 0x4d, 0x19, 0x09, 0x81, 0x0b, 0xe8, 0x16, 0x41,
-	0x70, 0x79, 0x91, 0x65, 0x3e, 0x79, 0x7a, 0x61, 0x85, 0x65, 0x3e, 0x09, 0x45, 0x93, 0xc6, 0x43,
-	0x28, 0x1a, 0x35, 0x81, 0x0e, 0x2f, 0x53, 0x40, 0xb3, 0x8e, 0x84, 0x60, 0x6a, 0xd8, 0x64, 0x08,
-	0xa6, 0xa6, 0x4d, 0x85, 0x60, 0x6a, 0xdc, 0x51, 0xdc, 0x7f, 0x81, 0xc5, 0xa1, 0x79, 0x21, 0x84,
-	0x33, 0x03, 0xa7, 0x43, 0xf8, 0xf2, 0xd2, 0xd2, 0x02, 0x7a, 0xce, 0x15, 0x98, 0x94, 0xe9, 0x2c,
-	0xcc, 0xdf, 0xbe, 0x83, 0xde, 0x13, 0xec, 0x28, 0x2c, 0x17, 0xe6, 0x17, 0xd1, 0x81, 0x82, 0x1d,
-	0x4b, 0x85, 0xc5, 0xc2, 0x8a, 0xef, 0x43, 0xef, 0x47, 0x81, 0x84, 0xcb, 0x63, 0x74, 0x07, 0x3e,
-	0x0b, 0x43, 0x0e, 0x0d, 0xc0, 0x01, 0x68, 0x9e, 0xf9, 0x91, 0x08, 0x15, 0x98, 0x1f, 0x89, 0xd0,
-	0x02, 0xdb, 0xa1, 0x22, 0xb4, 0xc8, 0x76, 0xa8, 0x08, 0x2d, 0xb1, 0x1d, 0x2a, 0x42, 0x98, 0xcf,
-	0x03, 0x10, 0x66, 0xf4, 0x00, 0x84, 0x39, 0x3d, 0x00, 0xdd, 0x65, 0x01, 0x57, 0x12, 0x15, 0xf3,
-	0x7a, 0x10, 0xc3, 0xcc, 0x1e, 0xc4, 0x30, 0xb7, 0x07, 0x31, 0xcc, 0xee, 0x41, 0x0c, 0xf5, 0x1a,
-	0xc4, 0x96, 0x06, 0x2a, 0xfd, 0x27, 0xc5, 0xfb, 0xa3, 0x26, 0xf9, 0x1e, 0x45, 0xf0, 0xdb, 0xed,
-	0xaa, 0x56, 0xdb, 0xaa, 0x50, 0xb5, 0x86, 0xc0, 0x79, 0xc9, 0xc3, 0x39, 0x88, 0xaa, 0x0d, 0x81,
-	0xa8, 0xdc, 0x10, 0x88, 0xea, 0x0d, 0x81, 0xa8, 0xe0, 0x10, 0xb8, 0xcc, 0xf6, 0xa9, 0x0c, 0xde,
-	0x19, 0xec, 0xd3, 0x7f, 0x8b, 0x02, 0xf8, 0xf7, 0xb3, 0x34, 0x82, 0xb2, 0xf0, 0x8e, 0x4d, 0x7d,
-	0x45, 0x8d, 0xd0, 0xc8, 0x28, 0x40, 0xf3, 0xb7, 0x59, 0x5e, 0x96, 0x30, 0x14, 0x3c, 0x88, 0x2d,
-	0xb0, 0xe0, 0x22, 0x61, 0x8b, 0x2c, 0xb8, 0x48, 0xd8, 0x32, 0x0b, 0x2e, 0x12, 0xb6, 0xc2, 0x23,
-	0xb7, 0x80, 0x15, 0x6e, 0xf3, 0xc8, 0x2d, 0x62, 0xf3, 0x3c, 0x72, 0x8b, 0xd8, 0x22, 0x73, 0x0d,
-	0x09, 0x5b, 0x66, 0xae, 0x21, 0x61, 0x77, 0x98, 0x6b, 0x48, 0xd8, 0x5d, 0xe6, 0x1a, 0x22, 0xb6,
-	0x70, 0x9b, 0xb9, 0x86, 0x84, 0x2d, 0x30, 0xd7, 0x90, 0xb0, 0xe5, 0x81, 0x6b, 0xbc, 0x17, 0x83,
-	0x61, 0x17, 0xab, 0x68, 0x06, 0x4c, 0xfd, 0xc5, 0xf2, 0x7d, 0x7d, 0xbd, 0xb6, 0x51, 0xdb, 0xa1,
-	0xf9, 0x30, 0x04, 0xf2, 0xd8, 0x27, 0x83, 0x8b, 0xcc, 0x33, 0x64, 0x90, 0x87, 0xbe, 0x00, 0x4d,
-	0x1e, 0xfa, 0x64, 0x94, 0xa6, 0xc7, 0x10, 0xba, 0xcc, 0x23, 0x5f, 0x80, 0x42, 0x81, 0x47, 0xbe,
-	0x80, 0x5c, 0x4b, 0x3c, 0xf2, 0xc9, 0x30, 0x4b, 0x95, 0x97, 0x81, 0x04, 0x88, 0xb0, 0x6c, 0x19,
-	0xc2, 0x79, 0xc2, 0x0c, 0xe1, 0x3c, 0x67, 0x86, 0x70, 0x9e, 0x36, 0xaf, 0x50, 0x8d, 0x4a, 0xcb,
-	0x64, 0x99, 0x33, 0xd4, 0x21, 0x27, 0x4f, 0xdf, 0x14, 0x52, 0x45, 0x2b, 0xea, 0xb2, 0x52, 0x5d,
-	0x2f, 0x3e, 0x0a, 0x9a, 0x82, 0x81, 0x01, 0x53, 0x30, 0x30, 0x60, 0x0a, 0x06, 0x06, 0x4c, 0xc1,
-	0x69, 0x06, 0x4c, 0xc1, 0xd0, 0xa0, 0x29, 0x18, 0x1a, 0x34, 0x05, 0xa7, 0x10, 0x34, 0x05, 0x97,
-	0x2b, 0x68, 0x0a, 0x06, 0x87, 0x4c, 0xc1, 0x89, 0x84, 0x4c, 0xc1, 0xa9, 0x84, 0x4c, 0xc1, 0x17,
-	0x18, 0x32, 0x05, 0x5f, 0x63, 0xc8, 0x14, 0xde, 0x32, 0x43, 0xa6, 0xf0, 0x56, 0x2a, 0x9a, 0xe2,
-	0x07, 0x51, 0x48, 0xf2, 0x2b, 0x11, 0x2c, 0x68, 0xb5, 0xb7, 0xf9, 0x28, 0x0c, 0x8f, 0x62, 0x7b,
-	0x9e, 0x15, 0xbc, 0x83, 0x76, 0x81, 0x95, 0xdd, 0x83, 0x36, 0xc6, 0x15, 0xb1, 0xbd, 0xc8, 0x0a,
-	0xef, 0x41, 0x7b, 0x89, 0x15, 0xde, 0x83, 0x36, 0x06, 0x40, 0xb1, 0x8d, 0x09, 0x46, 0x6c, 0x63,
-	0x76, 0x11, 0xdb, 0x98, 0x5a, 0xb2, 0x90, 0xf6, 0xe5, 0xc1, 0xbc, 0x22, 0x01, 0x98, 0x54, 0x24,
-	0x00, 0x33, 0x8a, 0x04, 0x60, 0x3a, 0x91, 0x00, 0xd4, 0x8f, 0x04, 0xc8, 0xa5, 0xe2, 0x0f, 0xa3,
-	0x90, 0xa0, 0xef, 0x41, 0xf4, 0x3e, 0xa2, 0xb6, 0xb9, 0xa5, 0x0d, 0x2a, 0xa2, 0x34, 0x24, 0x19,
-	0xc0, 0x0b, 0x6a, 0xbf, 0x97, 0x17, 0xd4, 0x3e, 0xc0, 0x0b, 0x6a, 0x1f, 0xe0, 0x05, 0xb5, 0x0f,
-	0xf0, 0x82, 0xda, 0x07, 0x78, 0x41, 0xed, 0x03, 0xbc, 0xa0, 0xf6, 0x01, 0x5e, 0x50, 0xfb, 0x00,
-	0x2f, 0xa8, 0x7d, 0xc0, 0x2b, 0xa8, 0x05, 0x84, 0x17, 0xd4, 0x02, 0xc2, 0x0b, 0x6a, 0x01, 0xe1,
-	0x05, 0xb5, 0x80, 0xf0, 0x82, 0x5a, 0x40, 0x06, 0xe9, 0xb6, 0xf4, 0x57, 0xca, 0x2f, 0x9e, 0xe5,
-	0x95, 0xf7, 0x9f, 0xe5, 0x95, 0x5f, 0x3e, 0xcb, 0x47, 0x3e, 0x78, 0x96, 0x8f, 0xfc, 0xea, 0x59,
-	0x3e, 0xf2, 0xeb, 0x67, 0xf9, 0xc8, 0x6f, 0x9e, 0xe5, 0x95, 0x6f, 0x9f, 0xe6, 0x95, 0xf7, 0x4e,
-	0xf3, 0x91, 0x9f, 0x9c, 0xe6, 0x95, 0x9f, 0x9e, 0xe6, 0x23, 0x3f, 0x3b, 0xcd, 0x47, 0x7e, 0x7e,
-	0x9a, 0x8f, 0xfc, 0xe2, 0x34, 0xaf, 0xbc, 0x7f, 0x9a, 0x57, 0x7e, 0x79, 0x9a, 0x8f, 0x7c, 0x70,
-	0x9a, 0x57, 0x7e, 0x75, 0x9a, 0x8f, 0xfc, 0xfa, 0x34, 0xaf, 0xfc, 0xe6, 0x34, 0x1f, 0xf9, 0xf6,
-	0xf3, 0x7c, 0xe4, 0xbd, 0xe7, 0x79, 0xe5, 0x7b, 0xcf, 0xf3, 0x91, 0x3f, 0x7f, 0x9e, 0x57, 0x7e,
-	0xf4, 0x3c, 0x1f, 0xf9, 0xc9, 0xf3, 0x7c, 0xe4, 0xa7, 0xcf, 0xf3, 0xca, 0xcf, 0x9e, 0xe7, 0x95,
-	0x9f, 0x3f, 0xcf, 0x2b, 0x5f, 0xbb, 0x75, 0x81, 0x3f, 0x00, 0x75, 0xed, 0xde, 0xde, 0xde, 0x08,
-	0x7d, 0x85, 0x5a, 0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x79, 0x38, 0xfa, 0x23, 0x44,
-	0x00, 0x00,
+type MACCommand_RelayUpdateUplinkListReq struct {
+	RuleIndex uint32 `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"`
+	// Uplink forwarding limits. The reload rate must be lower than 64.
+	ForwardLimits *RelayForwardLimits                                     `protobuf:"bytes,2,opt,name=forward_limits,json=forwardLimits,proto3" json:"forward_limits,omitempty"`
+	DevAddr       go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	// Wake on radio frame counter of the served end device.
+	WFCnt                uint32                                                     `protobuf:"varint,4,opt,name=w_f_cnt,json=wFCnt,proto3" json:"w_f_cnt,omitempty"`
+	RootWorSKey          *go_thethings_network_lorawan_stack_v3_pkg_types.AES128Key `protobuf:"bytes,5,opt,name=root_wor_s_key,json=rootWorSKey,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.AES128Key" json:"root_wor_s_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                                   `json:"-"`
+	XXX_sizecache        int32                                                      `json:"-"`
 }
 
-func (x MType) String() string {
-	s, ok := MType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListReq) Reset()      { *m = MACCommand_RelayUpdateUplinkListReq{} }
+func (*MACCommand_RelayUpdateUplinkListReq) ProtoMessage() {}
+func (*MACCommand_RelayUpdateUplinkListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 36}
 }
-func (x Major) String() string {
-	s, ok := Major_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (x RejoinType) String() string {
-	s, ok := RejoinType_name[int32(x)]
-	if ok {
-		return s
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x CFListType) String() string {
-	s, ok := CFListType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Merge(m, src)
 }
-func (x Class) String() string {
-	s, ok := Class_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Size() int {
+	return m.Size()
 }
-func (x TxSchedulePriority) String() string {
-	s, ok := TxSchedulePriority_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.DiscardUnknown(m)
 }
-func (x MACCommandIdentifier) String() string {
-	s, ok := MACCommandIdentifier_name[int32(x)]
-	if ok {
-		return s
+
+var xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayUpdateUplinkListReq) GetRuleIndex() uint32 {
+	if m != nil {
+		return m.RuleIndex
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x AggregatedDutyCycle) String() string {
-	s, ok := AggregatedDutyCycle_name[int32(x)]
-	if ok {
-		return s
+
+func (m *MACCommand_RelayUpdateUplinkListReq) GetForwardLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.ForwardLimits
 	}
-	return strconv.Itoa(int(x))
+	return nil
 }
-func (x PingSlotPeriod) String() string {
-	s, ok := PingSlotPeriod_name[int32(x)]
-	if ok {
-		return s
+
+func (m *MACCommand_RelayUpdateUplinkListReq) GetWFCnt() uint32 {
+	if m != nil {
+		return m.WFCnt
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x RejoinCountExponent) String() string {
-	s, ok := RejoinCountExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+type MACCommand_RelayUpdateUplinkListAns struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (x RejoinTimeExponent) String() string {
-	s, ok := RejoinTimeExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+func (m *MACCommand_RelayUpdateUplinkListAns) Reset()      { *m = MACCommand_RelayUpdateUplinkListAns{} }
+func (*MACCommand_RelayUpdateUplinkListAns) ProtoMessage() {}
+func (*MACCommand_RelayUpdateUplinkListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 37}
 }
-func (x RejoinPeriodExponent) String() string {
-	s, ok := RejoinPeriodExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListAns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (x DeviceEIRP) String() string {
-	s, ok := DeviceEIRP_name[int32(x)]
-	if ok {
-		return s
+func (m *MACCommand_RelayUpdateUplinkListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayUpdateUplinkListAns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x ADRAckLimitExponent) String() string {
-	s, ok := ADRAckLimitExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MACCommand_RelayUpdateUplinkListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListAns.Merge(m, src)
 }
-func (x ADRAckDelayExponent) String() string {
-	s, ok := ADRAckDelayExponent_name[int32(x)]
-	if ok {
-		return s
+func (m *MACCommand_RelayUpdateUplinkListAns) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayUpdateUplinkListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListAns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayUpdateUplinkListAns proto.InternalMessageInfo
+
+type MACCommand_RelayCtrlUplinkListReq struct {
+	RuleIndex            uint32                    `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"`
+	Action               RelayCtrlUplinkListAction `protobuf:"varint,2,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayCtrlUplinkListAction" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *MACCommand_RelayCtrlUplinkListReq) Reset()      { *m = MACCommand_RelayCtrlUplinkListReq{} }
+func (*MACCommand_RelayCtrlUplinkListReq) ProtoMessage() {}
+func (*MACCommand_RelayCtrlUplinkListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 38}
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x Minor) String() string {
-	s, ok := Minor_name[int32(x)]
-	if ok {
-		return s
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Merge(m, src)
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayCtrlUplinkListReq) GetRuleIndex() uint32 {
+	if m != nil {
+		return m.RuleIndex
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (this *Message) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MACCommand_RelayCtrlUplinkListReq) GetAction() RelayCtrlUplinkListAction {
+	if m != nil {
+		return m.Action
 	}
+	return RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT
+}
 
-	that1, ok := that.(*Message)
-	if !ok {
-		that2, ok := that.(Message)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MACCommand_RelayCtrlUplinkListAns struct {
+	RuleIndexAck bool `protobuf:"varint,1,opt,name=rule_index_ack,json=ruleIndexAck,proto3" json:"rule_index_ack,omitempty"`
+	// Wake on radio frame counter of the end device served by the rule.
+	WFCnt                uint32   `protobuf:"varint,2,opt,name=w_f_cnt,json=wFCnt,proto3" json:"w_f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns) Reset()      { *m = MACCommand_RelayCtrlUplinkListAns{} }
+func (*MACCommand_RelayCtrlUplinkListAns) ProtoMessage() {}
+func (*MACCommand_RelayCtrlUplinkListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 39}
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.MHDR.Equal(&that1.MHDR) {
-		return false
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Merge(m, src)
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns proto.InternalMessageInfo
+
+func (m *MACCommand_RelayCtrlUplinkListAns) GetRuleIndexAck() bool {
+	if m != nil {
+		return m.RuleIndexAck
 	}
-	if !bytes.Equal(this.MIC, that1.MIC) {
-		return false
+	return false
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns) GetWFCnt() uint32 {
+	if m != nil {
+		return m.WFCnt
 	}
-	if that1.Payload == nil {
-		if this.Payload != nil {
-			return false
+	return 0
+}
+
+type MACCommand_RelayConfigureFwdLimitReq struct {
+	ResetLimitCounter    RelayResetLimitCounter `protobuf:"varint,1,opt,name=reset_limit_counter,json=resetLimitCounter,proto3,enum=ttn.lorawan.v3.RelayResetLimitCounter" json:"reset_limit_counter,omitempty"`
+	JoinRequestLimits    *RelayForwardLimits    `protobuf:"bytes,2,opt,name=join_request_limits,json=joinRequestLimits,proto3" json:"join_request_limits,omitempty"`
+	NotifyLimits         *RelayForwardLimits    `protobuf:"bytes,3,opt,name=notify_limits,json=notifyLimits,proto3" json:"notify_limits,omitempty"`
+	GlobalUplinkLimits   *RelayForwardLimits    `protobuf:"bytes,4,opt,name=global_uplink_limits,json=globalUplinkLimits,proto3" json:"global_uplink_limits,omitempty"`
+	OverallLimits        *RelayForwardLimits    `protobuf:"bytes,5,opt,name=overall_limits,json=overallLimits,proto3" json:"overall_limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) Reset()      { *m = MACCommand_RelayConfigureFwdLimitReq{} }
+func (*MACCommand_RelayConfigureFwdLimitReq) ProtoMessage() {}
+func (*MACCommand_RelayConfigureFwdLimitReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 40}
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Payload == nil {
-		return false
-	} else if !this.Payload.Equal(that1.Payload) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Message_MACPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq.Merge(m, src)
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Message_MACPayload)
-	if !ok {
-		that2, ok := that.(Message_MACPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetResetLimitCounter() RelayResetLimitCounter {
+	if m != nil {
+		return m.ResetLimitCounter
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return RELAY_RESET_LIMIT_COUNTER_ZERO
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetJoinRequestLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.JoinRequestLimits
 	}
-	if !this.MACPayload.Equal(that1.MACPayload) {
-		return false
+	return nil
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetNotifyLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.NotifyLimits
 	}
-	return true
+	return nil
 }
-func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetGlobalUplinkLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.GlobalUplinkLimits
 	}
+	return nil
+}
 
-	that1, ok := that.(*Message_JoinRequestPayload)
-	if !ok {
-		that2, ok := that.(Message_JoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.JoinRequestPayload.Equal(that1.JoinRequestPayload) {
-		return false
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetOverallLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.OverallLimits
 	}
-	return true
+	return nil
 }
-func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Message_JoinAcceptPayload)
-	if !ok {
-		that2, ok := that.(Message_JoinAcceptPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.JoinAcceptPayload.Equal(that1.JoinAcceptPayload) {
-		return false
-	}
-	return true
+type MACCommand_RelayConfigureFwdLimitAns struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Message_RejoinRequestPayload)
-	if !ok {
-		that2, ok := that.(Message_RejoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MACCommand_RelayConfigureFwdLimitAns) Reset()      { *m = MACCommand_RelayConfigureFwdLimitAns{} }
+func (*MACCommand_RelayConfigureFwdLimitAns) ProtoMessage() {}
+func (*MACCommand_RelayConfigureFwdLimitAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 41}
+}
+func (m *MACCommand_RelayConfigureFwdLimitAns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayConfigureFwdLimitAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayConfigureFwdLimitAns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.RejoinRequestPayload.Equal(that1.RejoinRequestPayload) {
-		return false
-	}
-	return true
 }
-func (this *MHDR) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MACCommand_RelayConfigureFwdLimitAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfigureFwdLimitAns.Merge(m, src)
+}
+func (m *MACCommand_RelayConfigureFwdLimitAns) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayConfigureFwdLimitAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfigureFwdLimitAns.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MHDR)
-	if !ok {
-		that2, ok := that.(MHDR)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MACCommand_RelayConfigureFwdLimitAns proto.InternalMessageInfo
+
+type MACCommand_RelayNotifyNewEndDeviceReq struct {
+	DevAddr go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	// Signal-to-noise ratio of the uplink received by the relay (dB).
+	SNR int32 `protobuf:"varint,2,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Received signal strength indicator of the uplink received by the relay (dBm).
+	RSSI                 int32    `protobuf:"varint,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Reset()      { *m = MACCommand_RelayNotifyNewEndDeviceReq{} }
+func (*MACCommand_RelayNotifyNewEndDeviceReq) ProtoMessage() {}
+func (*MACCommand_RelayNotifyNewEndDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 42}
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MType != that1.MType {
-		return false
-	}
-	if this.Major != that1.Major {
-		return false
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Merge(m, src)
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) GetSNR() int32 {
+	if m != nil {
+		return m.SNR
 	}
-	return true
+	return 0
 }
-func (this *MACPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) GetRSSI() int32 {
+	if m != nil {
+		return m.RSSI
 	}
+	return 0
+}
 
-	that1, ok := that.(*MACPayload)
-	if !ok {
-		that2, ok := that.(MACPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RelaySecondChannel struct {
+	AckOffset     RelaySecondChAckOffset `protobuf:"varint,1,opt,name=ack_offset,json=ackOffset,proto3,enum=ttn.lorawan.v3.RelaySecondChAckOffset" json:"ack_offset,omitempty"`
+	DataRateIndex DataRateIndex          `protobuf:"varint,2,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// Frequency (Hz).
+	Frequency            uint64   `protobuf:"varint,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelaySecondChannel) Reset()      { *m = RelaySecondChannel{} }
+func (*RelaySecondChannel) ProtoMessage() {}
+func (*RelaySecondChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19}
+}
+func (m *RelaySecondChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelaySecondChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelaySecondChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FHDR.Equal(&that1.FHDR) {
-		return false
-	}
-	if this.FPort != that1.FPort {
-		return false
-	}
-	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
-		return false
-	}
-	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
-		return false
+}
+func (m *RelaySecondChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySecondChannel.Merge(m, src)
+}
+func (m *RelaySecondChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelaySecondChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySecondChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelaySecondChannel proto.InternalMessageInfo
+
+func (m *RelaySecondChannel) GetAckOffset() RelaySecondChAckOffset {
+	if m != nil {
+		return m.AckOffset
 	}
-	if this.FullFCnt != that1.FullFCnt {
-		return false
+	return RELAY_SECOND_CH_ACK_OFFSET_0
+}
+
+func (m *RelaySecondChannel) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
 	}
-	return true
+	return DATA_RATE_0
 }
-func (this *FHDR) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RelaySecondChannel) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
 	}
+	return 0
+}
 
-	that1, ok := that.(*FHDR)
-	if !ok {
-		that2, ok := that.(FHDR)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RelayForwardLimits struct {
+	BucketSize RelayLimitBucketSize `protobuf:"varint,1,opt,name=bucket_size,json=bucketSize,proto3,enum=ttn.lorawan.v3.RelayLimitBucketSize" json:"bucket_size,omitempty"`
+	// Number of tokens reloaded every hour. The highest value disables the limit.
+	ReloadRate           uint32   `protobuf:"varint,2,opt,name=reload_rate,json=reloadRate,proto3" json:"reload_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelayForwardLimits) Reset()      { *m = RelayForwardLimits{} }
+func (*RelayForwardLimits) ProtoMessage() {}
+func (*RelayForwardLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
+}
+func (m *RelayForwardLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayForwardLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayForwardLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
-	}
-	if !this.FCtrl.Equal(&that1.FCtrl) {
-		return false
-	}
-	if this.FCnt != that1.FCnt {
-		return false
-	}
-	if !bytes.Equal(this.FOpts, that1.FOpts) {
-		return false
-	}
-	return true
 }
-func (this *FCtrl) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RelayForwardLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayForwardLimits.Merge(m, src)
+}
+func (m *RelayForwardLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayForwardLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayForwardLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayForwardLimits proto.InternalMessageInfo
+
+func (m *RelayForwardLimits) GetBucketSize() RelayLimitBucketSize {
+	if m != nil {
+		return m.BucketSize
 	}
+	return RELAY_LIMIT_BUCKET_SIZE_1
+}
 
-	that1, ok := that.(*FCtrl)
-	if !ok {
-		that2, ok := that.(FCtrl)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RelayForwardLimits) GetReloadRate() uint32 {
+	if m != nil {
+		return m.ReloadRate
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ADR != that1.ADR {
-		return false
-	}
-	if this.ADRAckReq != that1.ADRAckReq {
-		return false
-	}
-	if this.Ack != that1.Ack {
-		return false
-	}
-	if this.FPending != that1.FPending {
-		return false
-	}
-	if this.ClassB != that1.ClassB {
-		return false
-	}
-	return true
+	return 0
 }
-func (this *JoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*JoinRequestPayload)
-	if !ok {
-		that2, ok := that.(JoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.JoinEUI.Equal(that1.JoinEUI) {
-		return false
-	}
-	if !this.DevEUI.Equal(that1.DevEUI) {
-		return false
-	}
-	if !this.DevNonce.Equal(that1.DevNonce) {
-		return false
-	}
-	return true
+type DataRateIndexValue struct {
+	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (this *RejoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*RejoinRequestPayload)
-	if !ok {
-		that2, ok := that.(RejoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
+func (*DataRateIndexValue) ProtoMessage() {}
+func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{21}
+}
+func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRateIndexValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RejoinType != that1.RejoinType {
-		return false
-	}
-	if !this.NetID.Equal(that1.NetID) {
-		return false
-	}
-	if !this.JoinEUI.Equal(that1.JoinEUI) {
-		return false
-	}
-	if !this.DevEUI.Equal(that1.DevEUI) {
-		return false
-	}
-	if this.RejoinCnt != that1.RejoinCnt {
-		return false
-	}
-	return true
 }
-func (this *JoinAcceptPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataRateIndexValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRateIndexValue.Merge(m, src)
+}
+func (m *DataRateIndexValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRateIndexValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRateIndexValue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*JoinAcceptPayload)
-	if !ok {
-		that2, ok := that.(JoinAcceptPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Encrypted, that1.Encrypted) {
-		return false
-	}
-	if !this.JoinNonce.Equal(that1.JoinNonce) {
-		return false
-	}
-	if !this.NetID.Equal(that1.NetID) {
-		return false
-	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
-	}
-	if !this.DLSettings.Equal(&that1.DLSettings) {
-		return false
-	}
-	if this.RxDelay != that1.RxDelay {
-		return false
-	}
-	if !this.CFList.Equal(that1.CFList) {
-		return false
+var xxx_messageInfo_DataRateIndexValue proto.InternalMessageInfo
+
+func (m *DataRateIndexValue) GetValue() DataRateIndex {
+	if m != nil {
+		return m.Value
 	}
-	return true
+	return DATA_RATE_0
 }
-func (this *DLSettings) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DLSettings)
-	if !ok {
-		that2, ok := that.(DLSettings)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PingSlotPeriodValue struct {
+	Value                PingSlotPeriod `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.PingSlotPeriod" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
+func (*PingSlotPeriodValue) ProtoMessage() {}
+func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
+}
+func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PingSlotPeriodValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Rx1DROffset != that1.Rx1DROffset {
-		return false
-	}
-	if this.Rx2DR != that1.Rx2DR {
-		return false
-	}
-	if this.OptNeg != that1.OptNeg {
-		return false
-	}
-	return true
 }
-func (this *CFList) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PingSlotPeriodValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingSlotPeriodValue.Merge(m, src)
+}
+func (m *PingSlotPeriodValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *PingSlotPeriodValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingSlotPeriodValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingSlotPeriodValue proto.InternalMessageInfo
+
+func (m *PingSlotPeriodValue) GetValue() PingSlotPeriod {
+	if m != nil {
+		return m.Value
 	}
+	return PING_EVERY_1S
+}
 
-	that1, ok := that.(*CFList)
-	if !ok {
-		that2, ok := that.(CFList)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AggregatedDutyCycleValue struct {
+	Value                AggregatedDutyCycle `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.AggregatedDutyCycle" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
+func (*AggregatedDutyCycleValue) ProtoMessage() {}
+func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
+}
+func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedDutyCycleValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if len(this.Freq) != len(that1.Freq) {
-		return false
-	}
-	for i := range this.Freq {
-		if this.Freq[i] != that1.Freq[i] {
-			return false
-		}
-	}
-	if len(this.ChMasks) != len(that1.ChMasks) {
-		return false
-	}
-	for i := range this.ChMasks {
-		if this.ChMasks[i] != that1.ChMasks[i] {
-			return false
-		}
-	}
-	return true
 }
-func (this *LoRaDataRate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *AggregatedDutyCycleValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedDutyCycleValue.Merge(m, src)
+}
+func (m *AggregatedDutyCycleValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedDutyCycleValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedDutyCycleValue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*LoRaDataRate)
-	if !ok {
-		that2, ok := that.(LoRaDataRate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Bandwidth != that1.Bandwidth {
-		return false
-	}
-	if this.SpreadingFactor != that1.SpreadingFactor {
-		return false
+var xxx_messageInfo_AggregatedDutyCycleValue proto.InternalMessageInfo
+
+func (m *AggregatedDutyCycleValue) GetValue() AggregatedDutyCycle {
+	if m != nil {
+		return m.Value
 	}
-	return true
+	return DUTY_CYCLE_1
 }
-func (this *FSKDataRate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*FSKDataRate)
-	if !ok {
-		that2, ok := that.(FSKDataRate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BitRate != that1.BitRate {
-		return false
-	}
-	return true
+type RxDelayValue struct {
+	Value                RxDelay  `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.RxDelay" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *DataRate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DataRate)
-	if !ok {
-		that2, ok := that.(DataRate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Modulation == nil {
-		if this.Modulation != nil {
-			return false
+func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
+func (*RxDelayValue) ProtoMessage() {}
+func (*RxDelayValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
+}
+func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RxDelayValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Modulation == nil {
-		return false
-	} else if !this.Modulation.Equal(that1.Modulation) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DataRate_LoRa) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RxDelayValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RxDelayValue.Merge(m, src)
+}
+func (m *RxDelayValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RxDelayValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RxDelayValue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DataRate_LoRa)
-	if !ok {
-		that2, ok := that.(DataRate_LoRa)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.LoRa.Equal(that1.LoRa) {
-		return false
+var xxx_messageInfo_RxDelayValue proto.InternalMessageInfo
+
+func (m *RxDelayValue) GetValue() RxDelay {
+	if m != nil {
+		return m.Value
 	}
-	return true
+	return RX_DELAY_0
 }
-func (this *DataRate_FSK) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DataRate_FSK)
-	if !ok {
-		that2, ok := that.(DataRate_FSK)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ADRAckLimitExponentValue struct {
+	Value                ADRAckLimitExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckLimitExponent" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
+func (*ADRAckLimitExponentValue) ProtoMessage() {}
+func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
+}
+func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRAckLimitExponentValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FSK.Equal(that1.FSK) {
-		return false
-	}
-	return true
 }
-func (this *TxSettings) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ADRAckLimitExponentValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAckLimitExponentValue.Merge(m, src)
+}
+func (m *ADRAckLimitExponentValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRAckLimitExponentValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAckLimitExponentValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAckLimitExponentValue proto.InternalMessageInfo
+
+func (m *ADRAckLimitExponentValue) GetValue() ADRAckLimitExponent {
+	if m != nil {
+		return m.Value
 	}
+	return ADR_ACK_LIMIT_1
+}
 
-	that1, ok := that.(*TxSettings)
-	if !ok {
-		that2, ok := that.(TxSettings)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ADRAckDelayExponentValue struct {
+	Value                ADRAckDelayExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckDelayExponent" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
+func (*ADRAckDelayExponentValue) ProtoMessage() {}
+func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{26}
+}
+func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRAckDelayExponentValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DataRate.Equal(&that1.DataRate) {
-		return false
+}
+func (m *ADRAckDelayExponentValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAckDelayExponentValue.Merge(m, src)
+}
+func (m *ADRAckDelayExponentValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRAckDelayExponentValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAckDelayExponentValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAckDelayExponentValue proto.InternalMessageInfo
+
+func (m *ADRAckDelayExponentValue) GetValue() ADRAckDelayExponent {
+	if m != nil {
+		return m.Value
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
+	return ADR_ACK_DELAY_1
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinType", RejoinType_name, RejoinType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinType", RejoinType_name, RejoinType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
+	proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
+	proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
+	proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayCADPeriodicity", RelayCADPeriodicity_name, RelayCADPeriodicity_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayCADPeriodicity", RelayCADPeriodicity_name, RelayCADPeriodicity_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelaySecondChAckOffset", RelaySecondChAckOffset_name, RelaySecondChAckOffset_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelaySecondChAckOffset", RelaySecondChAckOffset_name, RelaySecondChAckOffset_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayEndDeviceMode", RelayEndDeviceMode_name, RelayEndDeviceMode_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayEndDeviceMode", RelayEndDeviceMode_name, RelayEndDeviceMode_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelaySmartEnableLevel", RelaySmartEnableLevel_name, RelaySmartEnableLevel_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelaySmartEnableLevel", RelaySmartEnableLevel_name, RelaySmartEnableLevel_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayFilterListAction", RelayFilterListAction_name, RelayFilterListAction_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayFilterListAction", RelayFilterListAction_name, RelayFilterListAction_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayCtrlUplinkListAction", RelayCtrlUplinkListAction_name, RelayCtrlUplinkListAction_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayCtrlUplinkListAction", RelayCtrlUplinkListAction_name, RelayCtrlUplinkListAction_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayLimitBucketSize", RelayLimitBucketSize_name, RelayLimitBucketSize_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayLimitBucketSize", RelayLimitBucketSize_name, RelayLimitBucketSize_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayResetLimitCounter", RelayResetLimitCounter_name, RelayResetLimitCounter_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayResetLimitCounter", RelayResetLimitCounter_name, RelayResetLimitCounter_value)
+	proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
+	golang_proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
+	proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
+	golang_proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
+	proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
+	golang_proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
+	proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
+	golang_proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
+	proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
+	golang_proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
+	proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
+	golang_proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
+	proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
+	golang_proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
+	proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
+	golang_proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
+	proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
+	golang_proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
+	proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
+	golang_proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
+	proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
+	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
+	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
+	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
+	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
+	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
+	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
+	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
+	proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
+	golang_proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
+	proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
+	golang_proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
+	proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
+	golang_proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
+	proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
+	golang_proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
+	proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
+	golang_proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
+	proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
+	golang_proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
+	proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
+	golang_proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
+	proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
+	golang_proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
+	proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
+	golang_proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
+	proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
+	golang_proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
+	proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
+	proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
+	golang_proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
+	proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
+	golang_proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
+	proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
+	golang_proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
+	proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
+	golang_proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
+	proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
+	golang_proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
+	proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
+	golang_proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
+	proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
+	golang_proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
+	proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
+	proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
+	golang_proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
+	proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
+	golang_proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
+	proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
+	proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
+	golang_proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
+	proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
+	golang_proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
+	proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
+	proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
+	golang_proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
+	proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
+	golang_proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
+	proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
+	golang_proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
+	proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
+	golang_proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
+	proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
+	golang_proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
+	proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
+	golang_proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
+	proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
+	golang_proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
+	proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
+	golang_proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
+	proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
+	golang_proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
+	proto.RegisterType((*MACCommand_RelayConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq")
+	golang_proto.RegisterType((*MACCommand_RelayConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq")
+	proto.RegisterType((*MACCommand_RelayConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfAns")
+	golang_proto.RegisterType((*MACCommand_RelayConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfAns")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfAns")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfAns")
+	proto.RegisterType((*MACCommand_RelayFilterListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListReq")
+	golang_proto.RegisterType((*MACCommand_RelayFilterListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListReq")
+	proto.RegisterType((*MACCommand_RelayFilterListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListAns")
+	golang_proto.RegisterType((*MACCommand_RelayFilterListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListAns")
+	proto.RegisterType((*MACCommand_RelayUpdateUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListReq")
+	golang_proto.RegisterType((*MACCommand_RelayUpdateUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListReq")
+	proto.RegisterType((*MACCommand_RelayUpdateUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListAns")
+	golang_proto.RegisterType((*MACCommand_RelayUpdateUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListAns")
+	proto.RegisterType((*MACCommand_RelayCtrlUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListReq")
+	golang_proto.RegisterType((*MACCommand_RelayCtrlUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListReq")
+	proto.RegisterType((*MACCommand_RelayCtrlUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListAns")
+	golang_proto.RegisterType((*MACCommand_RelayCtrlUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListAns")
+	proto.RegisterType((*MACCommand_RelayConfigureFwdLimitReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfigureFwdLimitReq")
+	golang_proto.RegisterType((*MACCommand_RelayConfigureFwdLimitReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfigureFwdLimitReq")
+	proto.RegisterType((*MACCommand_RelayConfigureFwdLimitAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfigureFwdLimitAns")
+	golang_proto.RegisterType((*MACCommand_RelayConfigureFwdLimitAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfigureFwdLimitAns")
+	proto.RegisterType((*MACCommand_RelayNotifyNewEndDeviceReq)(nil), "ttn.lorawan.v3.MACCommand.RelayNotifyNewEndDeviceReq")
+	golang_proto.RegisterType((*MACCommand_RelayNotifyNewEndDeviceReq)(nil), "ttn.lorawan.v3.MACCommand.RelayNotifyNewEndDeviceReq")
+	proto.RegisterType((*RelaySecondChannel)(nil), "ttn.lorawan.v3.RelaySecondChannel")
+	golang_proto.RegisterType((*RelaySecondChannel)(nil), "ttn.lorawan.v3.RelaySecondChannel")
+	proto.RegisterType((*RelayForwardLimits)(nil), "ttn.lorawan.v3.RelayForwardLimits")
+	golang_proto.RegisterType((*RelayForwardLimits)(nil), "ttn.lorawan.v3.RelayForwardLimits")
+	proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
+	golang_proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
+	proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
+	golang_proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
+	proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
+	golang_proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
+	proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
+	golang_proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
+	proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
+	golang_proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
+	proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+	golang_proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+}
+
+func init() { proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e) }
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e)
+}
+
+var fileDescriptor_2084d1d5a227b67e = []byte{
+	// 7300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0x4d, 0x6c, 0x23, 0x49,
+	0x96, 0x1e, 0x93, 0x3f, 0x22, 0xf5, 0x48, 0x49, 0xa1, 0x50, 0xfd, 0xb0, 0xd8, 0x5d, 0x54, 0xb5,
+	0xaa, 0xa7, 0xa7, 0xba, 0x7a, 0xaa, 0x4a, 0xa2, 0x7e, 0x4a, 0xbd, 0xb3, 0x9e, 0x69, 0xfe, 0xa9,
+	0xc4, 0x29, 0x89, 0xd4, 0x24, 0xa9, 0xaa, 0xae, 0xf6, 0x0e, 0x72, 0x52, 0xcc, 0xa4, 0x8a, 0x2d,
+	0x2a, 0xc9, 0x49, 0xa6, 0xfe, 0xda, 0x86, 0xbd, 0xde, 0x05, 0x8c, 0x86, 0x8d, 0x5d, 0xaf, 0x17,
+	0xb0, 0x77, 0xf7, 0xe2, 0x19, 0xc0, 0x6b, 0x7b, 0x00, 0x1f, 0x3c, 0xfe, 0x03, 0x06, 0xb0, 0x61,
+	0x0c, 0x60, 0x1f, 0xc6, 0xb0, 0x0f, 0xe3, 0xdb, 0xd8, 0x87, 0xda, 0x6e, 0xd5, 0x65, 0x0e, 0x86,
+	0xb1, 0xf6, 0xc1, 0x18, 0xd4, 0x61, 0xdb, 0x78, 0x11, 0x91, 0xcc, 0x3f, 0x52, 0x3f, 0x3d, 0x35,
+	0x3e, 0x6c, 0x5d, 0x8a, 0xf1, 0xc5, 0x8b, 0xf7, 0x5e, 0xbc, 0xf7, 0xe2, 0x45, 0xe4, 0x8b, 0x4c,
+	0xc1, 0x6c, 0xa7, 0x6b, 0xaa, 0x47, 0xaa, 0x71, 0xaf, 0x6f, 0xa9, 0xcd, 0xbd, 0x07, 0x6a, 0xaf,
+	0xfd, 0x40, 0x20, 0xf7, 0x7b, 0x66, 0xd7, 0xea, 0xd2, 0x49, 0xcb, 0x32, 0xee, 0xdb, 0xd0, 0xe1,
+	0x62, 0x26, 0xbf, 0xdb, 0xb6, 0x9e, 0x1f, 0xec, 0xdc, 0x6f, 0x76, 0xf7, 0x1f, 0xe8, 0xc6, 0x61,
+	0xf7, 0xa4, 0x67, 0x76, 0x8f, 0x4f, 0x1e, 0x30, 0xe2, 0xe6, 0xbd, 0x5d, 0xdd, 0xb8, 0x77, 0xa8,
+	0x76, 0xda, 0x9a, 0x6a, 0xe9, 0x0f, 0x02, 0x3f, 0x38, 0xcb, 0xcc, 0x3d, 0x17, 0x8b, 0xdd, 0xee,
+	0x6e, 0x97, 0x0f, 0xde, 0x39, 0x68, 0xb1, 0x16, 0x6b, 0xb0, 0x5f, 0x82, 0xfc, 0xcd, 0xdd, 0x6e,
+	0x77, 0xb7, 0xa3, 0x3b, 0x54, 0x7d, 0xcb, 0x3c, 0x68, 0x5a, 0xa2, 0x77, 0xd6, 0xdf, 0x6b, 0xb5,
+	0xf7, 0xf5, 0xbe, 0xa5, 0xee, 0xf7, 0x04, 0xc1, 0xed, 0xe0, 0x0c, 0xdb, 0x9a, 0x6e, 0x58, 0xed,
+	0x56, 0x5b, 0x37, 0xfb, 0x9c, 0x68, 0xee, 0xb3, 0x08, 0xc4, 0x37, 0xf5, 0x7e, 0x5f, 0xdd, 0xd5,
+	0xe9, 0xd7, 0x21, 0xb6, 0xaf, 0x3c, 0xd7, 0xcc, 0xb4, 0x74, 0x4b, 0xba, 0x93, 0xcc, 0x5d, 0xb9,
+	0xef, 0xb5, 0xc0, 0xfd, 0xcd, 0xf5, 0x92, 0x5c, 0x20, 0xaf, 0x0a, 0xb1, 0xbf, 0x23, 0x85, 0x89,
+	0xf4, 0xd3, 0x17, 0xb3, 0xa1, 0x9f, 0xbd, 0x98, 0x95, 0xe4, 0xe8, 0xfe, 0xba, 0x66, 0xd2, 0x39,
+	0x88, 0xec, 0xb7, 0x9b, 0xe9, 0xf0, 0x2d, 0xe9, 0x4e, 0x0a, 0x89, 0xc6, 0x3e, 0x89, 0x92, 0x50,
+	0x3a, 0x7a, 0xfa, 0x62, 0x36, 0xb2, 0x59, 0x29, 0xca, 0xd8, 0x49, 0x37, 0x21, 0xb9, 0xaf, 0x36,
+	0x95, 0x9e, 0x7a, 0xd2, 0xe9, 0xaa, 0x5a, 0x3a, 0xc2, 0xc4, 0x64, 0x02, 0x62, 0xf2, 0xc5, 0x2d,
+	0x4e, 0x51, 0x98, 0x3c, 0x7d, 0x31, 0x0b, 0x4e, 0x7b, 0x3d, 0x24, 0xc3, 0xbe, 0xda, 0x14, 0x2d,
+	0xfa, 0x04, 0xae, 0x7c, 0xdc, 0x6d, 0x1b, 0x8a, 0xa9, 0x7f, 0xef, 0x40, 0xef, 0x5b, 0x03, 0xbe,
+	0x51, 0xc6, 0x77, 0xce, 0xcf, 0xf7, 0x5b, 0xdd, 0xb6, 0x21, 0x73, 0x52, 0x87, 0x1f, 0xfd, 0x38,
+	0x80, 0xd2, 0x3a, 0xcc, 0x30, 0xbe, 0x6a, 0xb3, 0xa9, 0xf7, 0x1c, 0xb6, 0x31, 0xc6, 0xf6, 0xad,
+	0x61, 0x6c, 0xf3, 0x8c, 0xd2, 0xe1, 0x3a, 0xfd, 0xb1, 0x1f, 0xa4, 0xbf, 0x05, 0xd7, 0x4c, 0x7d,
+	0xa8, 0xba, 0x63, 0x8c, 0xef, 0xdb, 0x7e, 0xbe, 0xb2, 0xfe, 0xf1, 0x30, 0x85, 0xaf, 0x98, 0x43,
+	0xf0, 0xdf, 0x88, 0xfe, 0xf8, 0x07, 0xb3, 0xa1, 0xc2, 0x24, 0xc4, 0x6d, 0x71, 0x91, 0x5f, 0x16,
+	0xa4, 0x6f, 0x45, 0x13, 0x71, 0x92, 0x98, 0x3b, 0x80, 0x28, 0x7a, 0x8e, 0xae, 0xc0, 0xd8, 0xbe,
+	0x62, 0x9d, 0xf4, 0x74, 0xe6, 0xdf, 0xc9, 0xdc, 0xd5, 0x80, 0xe1, 0x1b, 0x27, 0x3d, 0xbd, 0x90,
+	0x78, 0x55, 0x88, 0xfd, 0x0e, 0x3a, 0x58, 0x8e, 0xed, 0x23, 0x40, 0x97, 0x21, 0xb6, 0xaf, 0x7e,
+	0xdc, 0x35, 0xd3, 0xe1, 0x11, 0xc3, 0xb0, 0xd3, 0x33, 0x0c, 0x81, 0xb9, 0xbf, 0x15, 0x06, 0x97,
+	0xeb, 0x30, 0xb8, 0x5a, 0x67, 0x05, 0xd7, 0xda, 0x88, 0xe0, 0x6a, 0x61, 0x70, 0xcd, 0xc2, 0x58,
+	0x4b, 0xe9, 0x75, 0x4d, 0x8b, 0xe9, 0x30, 0xc1, 0x84, 0xdd, 0x8d, 0xa4, 0xbf, 0x90, 0xe4, 0x58,
+	0x6b, 0xab, 0x6b, 0x5a, 0xf4, 0x01, 0x24, 0x5b, 0xe6, 0xbe, 0x27, 0xb2, 0x52, 0x3c, 0x7a, 0xd6,
+	0xe4, 0x4d, 0xa1, 0x82, 0x0c, 0x2d, 0x73, 0xdf, 0x56, 0xe7, 0x03, 0x98, 0xd2, 0xf4, 0x66, 0x57,
+	0xd3, 0x35, 0x5f, 0xd8, 0x5c, 0xbf, 0xcf, 0xd7, 0xd5, 0x7d, 0x7b, 0x5d, 0xdd, 0xaf, 0xb3, 0x55,
+	0x27, 0x4f, 0x0a, 0x7a, 0x9b, 0xc3, 0x9b, 0x00, 0xad, 0x83, 0x4e, 0x47, 0x69, 0x29, 0x4d, 0xc3,
+	0x62, 0xc1, 0x31, 0x21, 0x27, 0x10, 0x59, 0x2b, 0x1a, 0x16, 0x77, 0xc8, 0xdc, 0x2f, 0x24, 0x88,
+	0xe2, 0xc4, 0xe8, 0x47, 0x90, 0xd0, 0xf4, 0x43, 0x45, 0xd5, 0x84, 0x01, 0x52, 0x85, 0x6f, 0xe2,
+	0x14, 0xff, 0xc7, 0x8b, 0xd9, 0x87, 0xbb, 0xdd, 0xfb, 0xd6, 0x73, 0xdd, 0x7a, 0xde, 0x36, 0x76,
+	0xfb, 0xf7, 0x0d, 0xdd, 0x3a, 0xea, 0x9a, 0x7b, 0x0f, 0xbc, 0x4b, 0xf7, 0x70, 0xf1, 0x41, 0x6f,
+	0x6f, 0xf7, 0x01, 0xfa, 0xae, 0x7f, 0xbf, 0xa4, 0x1f, 0xe6, 0x35, 0xcd, 0x94, 0xe3, 0x1a, 0xff,
+	0x41, 0xbf, 0x81, 0xc6, 0x69, 0x5a, 0x66, 0x87, 0x19, 0x27, 0x19, 0x74, 0xd0, 0x5a, 0xd1, 0x32,
+	0x3b, 0x43, 0x6c, 0x1b, 0x6b, 0x61, 0x07, 0xcd, 0x42, 0x8c, 0xcf, 0x21, 0xc2, 0x6c, 0x3b, 0xfe,
+	0xaa, 0x30, 0x76, 0x37, 0x9a, 0xfe, 0xe2, 0x8b, 0x88, 0x1c, 0x6d, 0x15, 0x0d, 0x8b, 0x66, 0x91,
+	0x7f, 0xb7, 0x67, 0xf5, 0x99, 0x85, 0x52, 0x85, 0xf8, 0xab, 0x42, 0xf4, 0x93, 0x70, 0x7a, 0x4a,
+	0x8e, 0xb5, 0x6a, 0x3d, 0xab, 0x2f, 0xa6, 0xfa, 0x27, 0x12, 0xc4, 0x98, 0x20, 0x7a, 0x03, 0x22,
+	0xaa, 0x98, 0x66, 0xa2, 0x10, 0xc7, 0x04, 0x90, 0x2f, 0xc9, 0x32, 0x62, 0xf4, 0x1e, 0x24, 0x55,
+	0xcd, 0x54, 0xd4, 0xe6, 0x1e, 0xae, 0x02, 0xa6, 0x6f, 0xa2, 0x30, 0x71, 0xfa, 0x62, 0x76, 0x3c,
+	0x5f, 0x92, 0xf3, 0xcd, 0x3d, 0x59, 0xff, 0x9e, 0x3c, 0xae, 0x6a, 0x26, 0xff, 0x49, 0x09, 0x44,
+	0xd4, 0xe6, 0x1e, 0xd3, 0x2b, 0x21, 0xe3, 0x4f, 0xfa, 0x06, 0x8c, 0xb7, 0x94, 0x9e, 0x6e, 0x68,
+	0x6d, 0x63, 0x97, 0xa9, 0x93, 0x90, 0x13, 0xad, 0x2d, 0xde, 0xa6, 0xd7, 0x21, 0xde, 0xec, 0xa8,
+	0xfd, 0xbe, 0xb2, 0xc3, 0xdc, 0x91, 0x90, 0xc7, 0x58, 0xb3, 0x30, 0xf7, 0x93, 0x30, 0xd0, 0xe0,
+	0xea, 0xa7, 0x4d, 0x48, 0xb0, 0x05, 0xa9, 0x1f, 0xb4, 0x85, 0x53, 0xd6, 0x85, 0x53, 0x96, 0x2f,
+	0xeb, 0x94, 0xf2, 0x76, 0x65, 0x65, 0xe9, 0xf4, 0xc5, 0x6c, 0x1c, 0xc5, 0x94, 0xb7, 0x2b, 0x72,
+	0x1c, 0x39, 0x97, 0x0f, 0xda, 0xf4, 0xbb, 0x80, 0x8e, 0x62, 0x32, 0x78, 0x6e, 0x7c, 0xf4, 0xab,
+	0xca, 0x18, 0x2b, 0xe9, 0x87, 0x28, 0x62, 0x4c, 0xd3, 0x0f, 0x51, 0xc2, 0x77, 0x60, 0x1c, 0x25,
+	0x18, 0x5d, 0xa3, 0xa9, 0x8b, 0xc8, 0xff, 0x40, 0xc8, 0x58, 0xfd, 0x12, 0xc1, 0x55, 0x45, 0x3e,
+	0x72, 0x42, 0x13, 0xbf, 0x84, 0x7b, 0x7f, 0x18, 0x81, 0x2b, 0xc3, 0x32, 0x12, 0x2d, 0x43, 0x52,
+	0xe4, 0x35, 0x57, 0x6a, 0xc9, 0x0c, 0x4f, 0x66, 0xbe, 0xfc, 0x02, 0xe6, 0x00, 0xa5, 0xdf, 0x81,
+	0x31, 0x43, 0xb7, 0x94, 0xb6, 0x26, 0xac, 0xb4, 0xf6, 0x65, 0xad, 0x54, 0xd5, 0xad, 0x4a, 0xe9,
+	0xf4, 0xc5, 0x6c, 0x8c, 0xfd, 0x90, 0x63, 0x86, 0x6e, 0x55, 0xbc, 0xae, 0x8e, 0xfc, 0x7f, 0x70,
+	0x75, 0xf4, 0xd7, 0xe3, 0xea, 0x9b, 0x20, 0x6c, 0xe6, 0xca, 0x39, 0xe3, 0x1c, 0x71, 0x92, 0xce,
+	0x3f, 0x8a, 0xc2, 0x74, 0x60, 0x53, 0xa2, 0x6f, 0xc2, 0xb8, 0x6e, 0x34, 0xcd, 0x93, 0x9e, 0xa5,
+	0x6b, 0x3c, 0xda, 0x65, 0x07, 0xa0, 0xdf, 0x05, 0x60, 0x6c, 0x79, 0x10, 0x71, 0x17, 0xe4, 0x85,
+	0xf6, 0xef, 0x5f, 0x56, 0x7b, 0x14, 0xce, 0xa3, 0x68, 0xfc, 0x63, 0xfb, 0xa7, 0xcb, 0xc1, 0x91,
+	0x5f, 0x87, 0x83, 0xdd, 0x09, 0x36, 0xfa, 0x9a, 0x13, 0xec, 0x26, 0x24, 0xb5, 0x8e, 0xd2, 0xd7,
+	0x2d, 0x0b, 0x59, 0x88, 0x73, 0x40, 0x20, 0xc4, 0x4b, 0x1b, 0x75, 0x41, 0x31, 0x24, 0xd5, 0x82,
+	0xd6, 0xb1, 0x7b, 0xe9, 0x6f, 0x42, 0xc2, 0x3c, 0x56, 0x34, 0xbd, 0xa3, 0x9e, 0xb0, 0xbd, 0x7f,
+	0x32, 0x77, 0x3d, 0xb0, 0x5c, 0x8e, 0x4b, 0xd8, 0xed, 0x5a, 0x2b, 0x71, 0x93, 0x43, 0xf4, 0xeb,
+	0x10, 0x6f, 0xb6, 0x94, 0x4e, 0xbb, 0x6f, 0xa5, 0xe3, 0x4c, 0x91, 0x6b, 0xfe, 0xc1, 0xc5, 0xb5,
+	0x8d, 0x76, 0xdf, 0x2a, 0x00, 0xc6, 0x0f, 0xff, 0x2d, 0x8f, 0x35, 0x5b, 0xf8, 0xbf, 0x08, 0x90,
+	0x7f, 0x2f, 0x01, 0x38, 0xda, 0xd2, 0xdf, 0x80, 0x09, 0xf3, 0x78, 0x41, 0xd1, 0x4c, 0xa5, 0xdb,
+	0x6a, 0xf5, 0x75, 0x8b, 0x45, 0xc7, 0x44, 0xe1, 0xda, 0xab, 0x42, 0xf4, 0x6e, 0x38, 0x8d, 0x09,
+	0x3c, 0x29, 0x1f, 0x2f, 0x94, 0xe4, 0x1a, 0xeb, 0x95, 0x93, 0xe6, 0xf1, 0x42, 0xc9, 0xe4, 0x0d,
+	0xfa, 0x08, 0xc6, 0xcc, 0xe3, 0x9c, 0xa2, 0xd9, 0x87, 0x83, 0x9b, 0x01, 0xab, 0xa8, 0x96, 0x2a,
+	0xab, 0x96, 0x5e, 0x31, 0x34, 0xfd, 0xb8, 0x30, 0x6d, 0xcf, 0x07, 0xfd, 0x27, 0x1f, 0xe7, 0x4a,
+	0xb2, 0x1c, 0x33, 0x8f, 0x73, 0x25, 0x93, 0xde, 0x86, 0x78, 0xb7, 0x67, 0x29, 0x86, 0xbe, 0xcb,
+	0xd3, 0x3d, 0x57, 0xbf, 0xd6, 0xb3, 0xaa, 0xfa, 0xae, 0x3c, 0xd6, 0x65, 0xff, 0x0b, 0xf5, 0x8f,
+	0x40, 0x4c, 0x8b, 0xae, 0x42, 0xf4, 0xac, 0xa4, 0xc3, 0xa9, 0x7c, 0x49, 0x87, 0x8d, 0xa0, 0x14,
+	0xa2, 0x2d, 0xbe, 0x03, 0x45, 0xee, 0x4c, 0xc8, 0xec, 0x37, 0xbd, 0x01, 0x89, 0xe6, 0x73, 0x65,
+	0x5f, 0xed, 0xef, 0xf5, 0xd3, 0x91, 0x5b, 0x91, 0x3b, 0x09, 0x39, 0xde, 0x7c, 0xbe, 0x89, 0x4d,
+	0x21, 0xf8, 0x29, 0xa4, 0x36, 0xba, 0xb2, 0x6a, 0x4f, 0x09, 0x97, 0xd4, 0x8e, 0x6a, 0x68, 0x47,
+	0x6d, 0xcd, 0x7a, 0xce, 0x8d, 0x26, 0x3b, 0x00, 0x7d, 0x17, 0x48, 0xbf, 0x67, 0xea, 0x2a, 0x6e,
+	0x4d, 0x4a, 0x4b, 0x6d, 0x5a, 0xe2, 0x04, 0x35, 0x21, 0x4f, 0x0d, 0xf0, 0x35, 0x06, 0xcf, 0xdd,
+	0x81, 0xe4, 0x5a, 0xfd, 0xf1, 0x80, 0xef, 0x0d, 0x48, 0xec, 0xb4, 0x2d, 0xc5, 0x54, 0x2d, 0x5d,
+	0xb0, 0x8d, 0xef, 0xb4, 0x2d, 0xec, 0x9a, 0xfb, 0x07, 0x12, 0x24, 0x06, 0x74, 0xbf, 0x09, 0x51,
+	0x9c, 0xad, 0x38, 0x51, 0xbd, 0xe9, 0x9f, 0xbe, 0x5b, 0xd7, 0x42, 0xe2, 0xf4, 0xc5, 0x6c, 0x14,
+	0x91, 0xf5, 0x90, 0xcc, 0x46, 0xd1, 0x55, 0x88, 0xb4, 0xfa, 0x7b, 0xe2, 0xcc, 0xf0, 0x46, 0xe0,
+	0xcc, 0xe0, 0xe8, 0xc3, 0xf7, 0xf0, 0xb5, 0xfa, 0xe3, 0xf5, 0x90, 0x8c, 0x43, 0x0a, 0xd3, 0x00,
+	0xfb, 0x5d, 0xed, 0xa0, 0xa3, 0x5a, 0xed, 0xae, 0xc1, 0x4e, 0x9a, 0x73, 0xff, 0x2c, 0x0a, 0xd0,
+	0x38, 0x1e, 0x84, 0x54, 0x11, 0xc6, 0x35, 0xd5, 0x52, 0x9d, 0x29, 0x24, 0x73, 0xe9, 0x51, 0x91,
+	0x51, 0x48, 0xb9, 0x57, 0x8b, 0x9c, 0xd0, 0xec, 0xe9, 0xd5, 0x60, 0x6a, 0xc0, 0x44, 0x69, 0x63,
+	0xfc, 0x5c, 0x2c, 0xc8, 0x1c, 0x5f, 0x4f, 0x68, 0xee, 0x0e, 0x3a, 0x0b, 0xc9, 0x66, 0x97, 0xb9,
+	0x83, 0xe9, 0x85, 0x71, 0x36, 0x2e, 0x03, 0x87, 0x6c, 0x87, 0xb6, 0xd8, 0xe9, 0xdc, 0x68, 0x9e,
+	0xb0, 0x2c, 0x12, 0x95, 0x1d, 0x80, 0x7e, 0x0d, 0x40, 0x37, 0xd4, 0x9d, 0x8e, 0xae, 0x34, 0xcd,
+	0x66, 0x3a, 0xe6, 0x9c, 0x5d, 0xca, 0x0c, 0x2d, 0xca, 0x45, 0xcc, 0xa8, 0xec, 0xa7, 0xd9, 0x44,
+	0x5e, 0x83, 0x07, 0x32, 0xb6, 0xcc, 0x27, 0x64, 0x07, 0xa0, 0x4b, 0x10, 0xc5, 0x86, 0x58, 0xc2,
+	0x99, 0xc0, 0x99, 0xb3, 0x61, 0x53, 0x16, 0xa2, 0x7f, 0xf0, 0x67, 0x78, 0x0c, 0x46, 0x6a, 0xfa,
+	0x4d, 0x48, 0x68, 0xdd, 0x23, 0xa3, 0xd3, 0x36, 0xf6, 0xd2, 0x09, 0x36, 0xf2, 0xb6, 0xdf, 0x14,
+	0x8e, 0x13, 0xee, 0x97, 0x04, 0xa9, 0x3c, 0x18, 0x94, 0xf9, 0x6b, 0x90, 0xb0, 0x51, 0x7a, 0x1b,
+	0x26, 0x54, 0xc3, 0xd2, 0x0d, 0x43, 0x15, 0xc6, 0xe5, 0xa1, 0x96, 0x12, 0x20, 0x37, 0xd9, 0x0d,
+	0x48, 0x58, 0xc7, 0x4a, 0xaf, 0x7b, 0xa4, 0xf3, 0xe0, 0x0d, 0xcb, 0x71, 0xeb, 0x78, 0x0b, 0x9b,
+	0xf4, 0x01, 0xcc, 0xb4, 0x8d, 0x43, 0xdd, 0xb4, 0x94, 0x5e, 0xb7, 0xa3, 0x9a, 0xed, 0x4f, 0x58,
+	0x38, 0x88, 0xc3, 0x1a, 0xe5, 0x5d, 0x5b, 0xae, 0x1e, 0xb1, 0x88, 0xfe, 0x48, 0x82, 0x1b, 0x8f,
+	0x54, 0x4b, 0x3f, 0x52, 0x4f, 0xf2, 0x42, 0x92, 0xf3, 0x50, 0x4a, 0xb7, 0x21, 0xb9, 0xcb, 0x3b,
+	0x95, 0xb6, 0xd6, 0x4f, 0x4b, 0xc3, 0x9f, 0xe4, 0xc4, 0x78, 0xd7, 0xc0, 0x61, 0x29, 0x77, 0xd7,
+	0xa6, 0xea, 0x07, 0xe7, 0x1a, 0x0e, 0xce, 0x75, 0xee, 0x7f, 0x49, 0x90, 0xdc, 0xee, 0xa1, 0x6d,
+	0x1a, 0xdd, 0x3d, 0xdd, 0xa0, 0x9b, 0x10, 0x71, 0x74, 0x78, 0x77, 0x84, 0x0e, 0xc1, 0x39, 0x0c,
+	0x51, 0x05, 0xf9, 0x78, 0x03, 0x22, 0xec, 0x0f, 0x88, 0x32, 0x24, 0xfb, 0xba, 0x79, 0xa8, 0x9b,
+	0x0a, 0x8b, 0x8b, 0xc8, 0xb9, 0x71, 0x91, 0x40, 0xee, 0x2c, 0x36, 0x80, 0x0f, 0xc4, 0x2e, 0xfa,
+	0x1e, 0x4c, 0x37, 0x71, 0xbb, 0x35, 0x2c, 0x53, 0xb5, 0xba, 0x82, 0x19, 0x46, 0x72, 0x44, 0x26,
+	0xee, 0x0e, 0x24, 0x9e, 0xfb, 0x5d, 0x09, 0x52, 0x76, 0x38, 0x6c, 0xa9, 0xd6, 0x73, 0x7a, 0x1b,
+	0x52, 0x07, 0xcc, 0x00, 0x8a, 0x85, 0x16, 0xe0, 0xc7, 0x84, 0xf5, 0x90, 0x9c, 0x3c, 0x70, 0x99,
+	0x25, 0x0f, 0xb1, 0x56, 0xfb, 0x58, 0xd7, 0xd2, 0xe1, 0x4b, 0x1a, 0x66, 0x3d, 0x24, 0xf3, 0x91,
+	0x85, 0x24, 0x44, 0x7b, 0x28, 0x8f, 0xa5, 0x8e, 0xff, 0x12, 0x83, 0xf1, 0xc6, 0xb1, 0x38, 0x55,
+	0xd2, 0xf7, 0x20, 0xc6, 0x0e, 0xed, 0xa3, 0x9e, 0x51, 0x8b, 0xd8, 0x29, 0x73, 0x1a, 0x5a, 0x84,
+	0x49, 0x3b, 0xb4, 0x15, 0x64, 0xd8, 0x67, 0xf9, 0x7c, 0x48, 0x2a, 0x74, 0xcf, 0x52, 0x9e, 0xd0,
+	0x5c, 0xad, 0x3e, 0xfd, 0x06, 0x8c, 0xb3, 0xed, 0x8f, 0xed, 0xc7, 0x91, 0x8b, 0xee, 0xc7, 0x09,
+	0xdc, 0x05, 0x11, 0xa3, 0x4f, 0x60, 0x86, 0x8d, 0xf7, 0xa5, 0xaa, 0xe8, 0xe5, 0x52, 0x15, 0x41,
+	0x7e, 0xee, 0x3e, 0x8c, 0x59, 0xe4, 0xeb, 0x24, 0xa4, 0x18, 0x4b, 0x48, 0x29, 0xf3, 0x78, 0x61,
+	0xcd, 0xc6, 0xb8, 0xf0, 0x5c, 0x40, 0xf8, 0xd8, 0xa5, 0x85, 0xe7, 0x86, 0x08, 0xcf, 0xb9, 0x84,
+	0xc7, 0x6d, 0xe1, 0x39, 0x47, 0xf8, 0x3a, 0x24, 0x7a, 0x66, 0xbb, 0x6b, 0xb6, 0xad, 0x13, 0x96,
+	0x8e, 0x26, 0x83, 0x2b, 0xb5, 0x71, 0x5c, 0x6f, 0x3e, 0xd7, 0xb5, 0x83, 0x8e, 0xbe, 0x25, 0x28,
+	0xdd, 0x36, 0xb4, 0x47, 0xd3, 0x32, 0x4c, 0xa8, 0x3b, 0xfd, 0x6e, 0xe7, 0xc0, 0xd2, 0x79, 0xc8,
+	0x8e, 0x5f, 0x30, 0x2f, 0xa6, 0xec, 0x61, 0xd8, 0x41, 0xd7, 0x60, 0x7a, 0xa0, 0xb1, 0xd2, 0xeb,
+	0xa8, 0x06, 0x1e, 0x37, 0x01, 0xd3, 0x7c, 0x21, 0xf3, 0xaa, 0x10, 0x35, 0xc3, 0xe9, 0x0f, 0x4e,
+	0x5f, 0xcc, 0x4e, 0x0d, 0x66, 0xb0, 0xd5, 0x51, 0x8d, 0x4a, 0x49, 0x9e, 0x6a, 0x79, 0x00, 0x8d,
+	0x2e, 0x42, 0x42, 0xd5, 0x0e, 0x55, 0xa3, 0xa9, 0x6b, 0xe9, 0xe6, 0xd9, 0x55, 0x81, 0x01, 0xa1,
+	0x48, 0x6f, 0x7f, 0xff, 0x43, 0x56, 0xf5, 0x28, 0x76, 0xf7, 0xf7, 0x55, 0x43, 0xa3, 0x15, 0x88,
+	0x34, 0xdb, 0x9a, 0x08, 0xe6, 0xb7, 0x87, 0x54, 0xba, 0x04, 0xa1, 0xb3, 0x4c, 0xf0, 0x8c, 0x14,
+	0xff, 0x1d, 0x29, 0x4a, 0xa4, 0x5b, 0x21, 0xdc, 0x77, 0x8b, 0x95, 0x92, 0x8c, 0x3c, 0xe8, 0x5b,
+	0x90, 0x34, 0xd5, 0xa3, 0x41, 0xb5, 0x22, 0x2c, 0xd6, 0x26, 0x98, 0xea, 0x91, 0x7d, 0xc6, 0x2f,
+	0xc0, 0xb8, 0xa9, 0xf7, 0xf1, 0x94, 0x6d, 0xd8, 0xd5, 0xb5, 0xdb, 0xa3, 0x65, 0xde, 0x97, 0x91,
+	0xb6, 0x62, 0x60, 0x55, 0x29, 0x61, 0x8a, 0xdf, 0xb4, 0x0c, 0xc0, 0x79, 0x34, 0xbb, 0x46, 0x4b,
+	0xd4, 0x44, 0xde, 0x3e, 0x8f, 0x49, 0xb1, 0x6b, 0xb4, 0xd6, 0x43, 0xf2, 0xb8, 0x69, 0x37, 0x68,
+	0x0d, 0x26, 0xd9, 0xb2, 0x6c, 0x3e, 0xd7, 0x9b, 0x7b, 0x8a, 0x6a, 0xd8, 0xc7, 0xe6, 0xaf, 0x9e,
+	0xc1, 0x6a, 0xa3, 0x6d, 0xec, 0x15, 0x91, 0x3e, 0x6f, 0x60, 0xb2, 0x48, 0x75, 0x5c, 0x6d, 0xfa,
+	0x0c, 0x58, 0x5b, 0xc1, 0xfa, 0x01, 0x9e, 0xdc, 0x78, 0xd5, 0xec, 0x2b, 0xe7, 0xb0, 0xc3, 0xca,
+	0x83, 0xfe, 0x3d, 0x5e, 0x09, 0x72, 0xda, 0x68, 0x36, 0x64, 0x96, 0xd7, 0x4c, 0x2c, 0x33, 0xb8,
+	0x59, 0xa3, 0xa6, 0xf1, 0x8b, 0xb2, 0xce, 0x1b, 0x7d, 0x0f, 0x6b, 0xae, 0xb7, 0xcd, 0x1a, 0xb5,
+	0xae, 0xc1, 0xa4, 0x76, 0x60, 0x9d, 0x28, 0xcd, 0x93, 0x66, 0x47, 0x67, 0x7a, 0x27, 0xce, 0x35,
+	0x43, 0xe9, 0xc0, 0x3a, 0x29, 0x22, 0x3d, 0xd7, 0x34, 0xa5, 0xb9, 0xda, 0xf4, 0x19, 0x50, 0xf3,
+	0x58, 0xe9, 0xa9, 0xa6, 0xba, 0x8f, 0x4f, 0x24, 0x07, 0x3d, 0xc6, 0x94, 0x2f, 0x97, 0xbb, 0x67,
+	0xb9, 0xe9, 0x78, 0x0b, 0xc7, 0xd4, 0x71, 0x08, 0xe7, 0x3b, 0x65, 0x7a, 0xa1, 0x21, 0xac, 0xd1,
+	0x18, 0x70, 0x29, 0xd6, 0xdc, 0x02, 0x1e, 0xd6, 0xb6, 0x19, 0xf4, 0x43, 0xa5, 0x6f, 0xa9, 0xd6,
+	0x41, 0x9f, 0xb1, 0x4d, 0x9e, 0x6f, 0x06, 0xfd, 0xb0, 0xce, 0xe8, 0x45, 0x34, 0x68, 0xae, 0x36,
+	0x95, 0x61, 0xca, 0xd0, 0x8f, 0x94, 0xe6, 0x73, 0xd5, 0x30, 0xf4, 0x0e, 0xb3, 0x41, 0x8a, 0x71,
+	0xbc, 0x73, 0x06, 0xc7, 0xaa, 0x7e, 0x54, 0xe4, 0x03, 0xb8, 0x05, 0x26, 0x0c, 0x37, 0xe0, 0xe7,
+	0x89, 0x5a, 0x4e, 0x5c, 0x82, 0x27, 0x57, 0xd3, 0xc5, 0x13, 0xf5, 0x54, 0x61, 0x52, 0xeb, 0x78,
+	0xd4, 0x9c, 0x3c, 0x7f, 0xe2, 0x1b, 0x8e, 0x52, 0x05, 0x72, 0xfa, 0x62, 0x36, 0xe5, 0x46, 0x98,
+	0x29, 0x3a, 0x2e, 0xb5, 0xbd, 0x22, 0x50, 0xeb, 0xa9, 0x8b, 0x8b, 0xc0, 0x08, 0xf6, 0x8a, 0xb0,
+	0xad, 0xdd, 0x71, 0xcd, 0xe2, 0xb7, 0x70, 0x97, 0xc1, 0xc4, 0x8c, 0x67, 0x67, 0x27, 0xea, 0x08,
+	0x93, 0xf3, 0xde, 0x99, 0xa1, 0xd1, 0x60, 0x83, 0x5c, 0x61, 0x47, 0x4c, 0x1f, 0x86, 0x71, 0x67,
+	0x05, 0x43, 0x7a, 0xfa, 0xdc, 0xb8, 0x6b, 0x04, 0x43, 0xda, 0xf2, 0x42, 0x3c, 0x21, 0xee, 0xe9,
+	0x27, 0x2c, 0x21, 0xd2, 0x0b, 0x24, 0xc4, 0x3d, 0xfd, 0x64, 0x90, 0x10, 0xf9, 0x6f, 0x9e, 0x10,
+	0x91, 0x07, 0x4b, 0x88, 0x33, 0x17, 0x48, 0x88, 0x7b, 0xfa, 0x89, 0x93, 0x10, 0x45, 0x83, 0x9a,
+	0x30, 0x83, 0xf9, 0xc5, 0x3f, 0xcd, 0x2b, 0xe7, 0xda, 0x30, 0x5f, 0x92, 0x3d, 0x93, 0x2a, 0x5c,
+	0x39, 0x7d, 0x31, 0x4b, 0xfc, 0x28, 0x5a, 0x56, 0xd5, 0x4c, 0xef, 0xf4, 0x65, 0x2c, 0x72, 0x1f,
+	0xb6, 0x9b, 0x7c, 0x53, 0x65, 0xb1, 0x71, 0xf5, 0xdc, 0x88, 0x2e, 0xb1, 0x11, 0xb8, 0x9f, 0x8a,
+	0x88, 0xd6, 0xdc, 0x00, 0xdd, 0x06, 0xd2, 0xea, 0x9a, 0x4d, 0x4c, 0x66, 0xf6, 0x6d, 0x46, 0xfa,
+	0xda, 0xf0, 0x93, 0xa0, 0x8b, 0xe9, 0x1a, 0x0e, 0x19, 0xd4, 0x0f, 0xd7, 0x43, 0xf2, 0x64, 0xcb,
+	0x83, 0x50, 0x7d, 0x70, 0x3d, 0xe2, 0xb7, 0xd0, 0x75, 0xc6, 0xfc, 0xfe, 0x99, 0x16, 0xc7, 0x81,
+	0x7e, 0x73, 0xcc, 0x98, 0x41, 0x78, 0x84, 0x18, 0x34, 0x4c, 0xfa, 0xd2, 0x62, 0xb8, 0x79, 0x02,
+	0x62, 0xf8, 0x66, 0x45, 0x7b, 0x6c, 0xad, 0x74, 0xba, 0xb8, 0x19, 0xb7, 0xba, 0x6c, 0x26, 0x37,
+	0xce, 0x0d, 0xe9, 0x2d, 0x5c, 0x17, 0x9d, 0xae, 0x55, 0x31, 0x5a, 0x5d, 0x11, 0xd2, 0x3d, 0x2f,
+	0x44, 0x77, 0xe0, 0xaa, 0xc3, 0xda, 0x9d, 0x58, 0x32, 0x8c, 0xfb, 0xbd, 0x0b, 0x70, 0xf7, 0x24,
+	0x13, 0xda, 0x0b, 0xa0, 0xc3, 0x65, 0xa0, 0x91, 0xde, 0xb8, 0xac, 0x0c, 0x6e, 0x23, 0xbf, 0x0c,
+	0x34, 0xd1, 0x87, 0x30, 0xbd, 0xa3, 0xab, 0xcd, 0xae, 0x61, 0xe7, 0x15, 0xe4, 0xff, 0xe6, 0xb9,
+	0x16, 0x2a, 0xb0, 0x31, 0x3c, 0x83, 0x88, 0xcd, 0x66, 0xc7, 0x0b, 0x61, 0xd4, 0x0b, 0xce, 0x78,
+	0xae, 0x63, 0xb6, 0xb9, 0x79, 0x6e, 0xd4, 0x73, 0xbe, 0x78, 0x32, 0x14, 0x7b, 0xc3, 0x8e, 0x1b,
+	0xf0, 0xf3, 0x44, 0x5d, 0xb3, 0x97, 0xe0, 0x29, 0x56, 0xd2, 0x8e, 0x1b, 0x70, 0xad, 0xce, 0xfd,
+	0xae, 0xc6, 0x4e, 0xee, 0xe9, 0xd9, 0x0b, 0xae, 0xce, 0xcd, 0xae, 0xa6, 0xf3, 0x3c, 0x35, 0xa1,
+	0xb9, 0x01, 0x5c, 0x9d, 0x6e, 0x9e, 0x2c, 0x65, 0xdd, 0x3a, 0x77, 0x75, 0x3a, 0x4c, 0x45, 0xde,
+	0x9a, 0xd4, 0x3c, 0x08, 0xee, 0xdf, 0x26, 0x3e, 0xec, 0x30, 0x86, 0xcc, 0xa2, 0x6f, 0x9d, 0xbb,
+	0xc7, 0xc8, 0x38, 0x00, 0x47, 0x8b, 0x4d, 0xcb, 0x74, 0xb5, 0x7d, 0x0c, 0xd1, 0x9c, 0x73, 0x17,
+	0x67, 0x28, 0xb6, 0x28, 0xd3, 0xd5, 0xa6, 0x1d, 0xb8, 0xc1, 0x19, 0xea, 0x86, 0xa6, 0x08, 0x13,
+	0x0c, 0x94, 0xbd, 0xcd, 0x78, 0xcf, 0x9f, 0xc7, 0xbb, 0x6c, 0x68, 0xdc, 0x12, 0x8e, 0xd6, 0x57,
+	0xcd, 0x61, 0x1d, 0xa3, 0xa5, 0xe1, 0x4c, 0xde, 0xfe, 0x12, 0xd2, 0xf8, 0x94, 0x86, 0x48, 0xc3,
+	0xb9, 0xed, 0x00, 0xef, 0x50, 0x5a, 0xed, 0x8e, 0xa5, 0x9b, 0xac, 0xf8, 0xcb, 0xe6, 0xf5, 0x95,
+	0x73, 0x97, 0x23, 0x93, 0xb4, 0xc6, 0x86, 0xb1, 0x42, 0x30, 0x5f, 0xf2, 0x66, 0x00, 0x1d, 0x2e,
+	0x03, 0x67, 0xf3, 0xce, 0x65, 0x65, 0x88, 0x25, 0x6f, 0x06, 0x50, 0x7a, 0x00, 0x6f, 0x72, 0x19,
+	0x07, 0x3d, 0x7c, 0x29, 0x42, 0x11, 0xb5, 0x86, 0xc1, 0x74, 0xbe, 0xca, 0x44, 0x2d, 0x9e, 0x27,
+	0x6a, 0x9b, 0x8d, 0xe6, 0x95, 0x1a, 0x67, 0x52, 0x69, 0x73, 0x44, 0xdf, 0x99, 0x62, 0x71, 0x86,
+	0x77, 0xbe, 0x9c, 0x58, 0x3e, 0xcf, 0xe1, 0x62, 0x71, 0xb6, 0xc7, 0x70, 0xd3, 0x09, 0xf1, 0xf6,
+	0xee, 0x81, 0xa9, 0x2b, 0xad, 0x23, 0x4d, 0xe9, 0xb4, 0xf7, 0xdb, 0x7c, 0xba, 0xef, 0x32, 0xb9,
+	0x4b, 0x17, 0x89, 0x78, 0x36, 0x7c, 0xed, 0x48, 0xdb, 0xc0, 0xc1, 0x7c, 0xbe, 0x37, 0xcc, 0x51,
+	0x9d, 0x67, 0x4b, 0xc6, 0x19, 0xdf, 0xfd, 0x92, 0x92, 0xf9, 0x94, 0x47, 0x48, 0xc6, 0x39, 0xff,
+	0x75, 0x98, 0xe5, 0x92, 0x8d, 0xae, 0xd5, 0x6e, 0x9d, 0x28, 0x78, 0x9e, 0x76, 0x2d, 0x11, 0x9c,
+	0xf5, 0x7b, 0x4c, 0xf6, 0xf2, 0x79, 0xb2, 0xab, 0x8c, 0x41, 0x55, 0x3f, 0x1a, 0xac, 0x06, 0x3e,
+	0xed, 0x8c, 0x39, 0xb2, 0x97, 0x76, 0x21, 0x23, 0xe6, 0x6d, 0x99, 0x9d, 0x40, 0x74, 0x7d, 0x8d,
+	0x09, 0x5e, 0x38, 0x77, 0xd2, 0x96, 0xd9, 0xf1, 0xc7, 0xd6, 0x35, 0x73, 0x68, 0xcf, 0x19, 0x02,
+	0xd1, 0xca, 0xf7, 0xbe, 0x8c, 0x40, 0x6e, 0xe2, 0x61, 0x02, 0xf3, 0x46, 0x3f, 0xf3, 0x21, 0x24,
+	0xec, 0x87, 0x76, 0xba, 0x06, 0x13, 0xfb, 0x6d, 0xa3, 0x6b, 0x2a, 0x87, 0xba, 0xd9, 0xc7, 0xca,
+	0xeb, 0xa8, 0xb7, 0x3a, 0x90, 0xa8, 0x00, 0x76, 0x55, 0x21, 0x2d, 0xc9, 0x29, 0x36, 0xee, 0x09,
+	0x1f, 0xc6, 0xeb, 0x16, 0x99, 0x67, 0x30, 0x3e, 0x78, 0x92, 0x7f, 0xcd, 0xac, 0x75, 0x48, 0xb9,
+	0x9f, 0xec, 0xe9, 0x2d, 0x18, 0xdb, 0x57, 0xcd, 0xdd, 0xb6, 0x21, 0x2e, 0x9a, 0xc4, 0xcb, 0x1c,
+	0x7f, 0x21, 0xc9, 0x02, 0xa7, 0xf7, 0x60, 0xc2, 0xae, 0x02, 0x37, 0xbb, 0x07, 0x46, 0xf0, 0xad,
+	0x8f, 0x94, 0xe8, 0x2e, 0x62, 0xaf, 0x10, 0xf3, 0xa7, 0x61, 0x70, 0x3d, 0xe2, 0x0f, 0xbb, 0x3d,
+	0x90, 0x7e, 0xa5, 0xdb, 0x83, 0x7b, 0x30, 0x69, 0x97, 0xc2, 0xdd, 0x45, 0x64, 0xf6, 0x3a, 0xc4,
+	0x5d, 0x7c, 0x1d, 0x22, 0x25, 0x2a, 0xe3, 0x9c, 0xfc, 0x3d, 0x48, 0xd9, 0x27, 0x27, 0xbc, 0x52,
+	0xe2, 0x37, 0x4a, 0x8c, 0xfb, 0x1f, 0x4a, 0x61, 0x42, 0xe4, 0xa4, 0xe8, 0xc5, 0x0b, 0x26, 0xfa,
+	0x3e, 0x5c, 0x71, 0x13, 0xe3, 0xc2, 0xb5, 0xcc, 0x6e, 0x27, 0x1d, 0x73, 0x4b, 0x88, 0xcb, 0xd4,
+	0x35, 0xa6, 0xc8, 0x49, 0xe8, 0x1c, 0x24, 0x8c, 0x1d, 0xc5, 0x32, 0x31, 0xe2, 0xc6, 0xbc, 0x0a,
+	0xc5, 0x8d, 0x9d, 0x06, 0xe2, 0xdc, 0x40, 0xdf, 0x8a, 0x26, 0xa2, 0x24, 0x96, 0xf9, 0x43, 0x09,
+	0x5c, 0xe5, 0x0a, 0x7a, 0x07, 0x88, 0x47, 0x32, 0xbe, 0x6f, 0xc1, 0xde, 0xdc, 0x90, 0x27, 0x5d,
+	0xc2, 0xf2, 0xcd, 0x3d, 0x7a, 0x0f, 0x66, 0x7c, 0x06, 0x65, 0xc4, 0xec, 0x1d, 0x0e, 0x99, 0x78,
+	0x6c, 0x85, 0xe4, 0xef, 0xf1, 0xa7, 0x3a, 0xc7, 0x5c, 0x8a, 0xf3, 0x2a, 0xc7, 0x94, 0xdb, 0x52,
+	0xf9, 0xe6, 0x5e, 0xa6, 0x09, 0x29, 0x77, 0xd5, 0x83, 0xd6, 0x61, 0x72, 0x5f, 0x3d, 0x56, 0x9c,
+	0xd2, 0x89, 0xf0, 0x5d, 0xe0, 0xe1, 0x2d, 0xbf, 0xbb, 0x6b, 0xea, 0x18, 0x0c, 0xda, 0x60, 0xbc,
+	0xcb, 0x83, 0xa9, 0x7d, 0xf5, 0x78, 0x80, 0x67, 0xfe, 0xbb, 0x04, 0x53, 0xbe, 0x32, 0xc8, 0xa8,
+	0xfa, 0xa9, 0xf4, 0xab, 0xd6, 0x4f, 0x57, 0xe1, 0x8a, 0xb7, 0x28, 0x2c, 0xae, 0x56, 0xc3, 0x5e,
+	0x87, 0x4e, 0xbb, 0xaa, 0xbe, 0xe2, 0x46, 0xf5, 0xbe, 0xbf, 0xf2, 0x8a, 0x26, 0x8b, 0xb2, 0xb7,
+	0x72, 0x72, 0xd1, 0x3b, 0x3f, 0xf8, 0xbd, 0x31, 0x6f, 0x11, 0x56, 0x04, 0xff, 0x3f, 0xf7, 0xcd,
+	0x0d, 0x5d, 0xbb, 0x04, 0xd7, 0x87, 0xcc, 0xcd, 0xe5, 0xe1, 0x19, 0xbf, 0xda, 0xe8, 0xb7, 0x15,
+	0x48, 0x0f, 0xd3, 0xdc, 0xe5, 0xeb, 0x2b, 0x01, 0xa5, 0x71, 0xdc, 0x5d, 0x98, 0xf6, 0xe8, 0xed,
+	0x76, 0xb7, 0x5b, 0x61, 0x74, 0xb7, 0x06, 0x29, 0x77, 0x75, 0x87, 0xce, 0x41, 0x7c, 0x47, 0xb5,
+	0x2c, 0xdd, 0x3c, 0xf1, 0xa6, 0x84, 0x2f, 0x24, 0xd9, 0xee, 0xa0, 0x77, 0x07, 0x59, 0x03, 0xb5,
+	0x88, 0x15, 0xe8, 0xab, 0xc2, 0x54, 0x66, 0x22, 0x3d, 0x7b, 0xe7, 0xb3, 0x2f, 0xc4, 0xbf, 0x41,
+	0xfe, 0x10, 0x36, 0xf9, 0x7e, 0x18, 0x26, 0x3c, 0x25, 0x1f, 0xcc, 0x2b, 0x76, 0xb0, 0xbb, 0xae,
+	0xbc, 0xdc, 0x79, 0x45, 0x74, 0x73, 0x27, 0xbe, 0xeb, 0xbe, 0x0e, 0x0c, 0x33, 0x37, 0x24, 0x5f,
+	0x15, 0x12, 0xb9, 0xb1, 0x74, 0x88, 0x39, 0xc2, 0xe9, 0xc5, 0x38, 0xda, 0x6f, 0x1b, 0x81, 0x38,
+	0x8a, 0x5c, 0x32, 0x8e, 0xf6, 0xdb, 0x86, 0xa7, 0x8f, 0xf1, 0x55, 0x8f, 0x03, 0x7c, 0x2f, 0x7b,
+	0xb9, 0x80, 0xeb, 0xc0, 0xdd, 0x27, 0x2c, 0xf4, 0xa1, 0xdb, 0x40, 0xe8, 0x88, 0xdb, 0x30, 0xe1,
+	0x75, 0x20, 0x0f, 0x94, 0x54, 0xcb, 0xe5, 0x3d, 0x3a, 0x07, 0x13, 0x8e, 0x3e, 0x4e, 0x58, 0x24,
+	0xed, 0x14, 0x80, 0x1e, 0xee, 0x80, 0xa7, 0x68, 0x75, 0x59, 0xcb, 0x7f, 0x35, 0x68, 0x79, 0xd7,
+	0x02, 0x70, 0xfa, 0xc4, 0x3c, 0x14, 0xf0, 0xd4, 0xaf, 0x30, 0x16, 0x3d, 0xd2, 0x5c, 0x53, 0x99,
+	0x72, 0xcb, 0xc1, 0xd9, 0x04, 0xa6, 0x1c, 0x0e, 0x4e, 0x39, 0xf3, 0x18, 0x88, 0xbf, 0x94, 0x45,
+	0x1f, 0x42, 0x8c, 0xdf, 0x19, 0x49, 0x17, 0xbd, 0x33, 0xe2, 0xf4, 0x99, 0xff, 0x2c, 0xc1, 0x94,
+	0xaf, 0x76, 0x45, 0x3f, 0xe2, 0x09, 0x4f, 0x6f, 0x9b, 0x3d, 0x4f, 0x0a, 0x0a, 0xbe, 0x65, 0xc2,
+	0x4e, 0x3b, 0xe5, 0x8a, 0xbc, 0x55, 0x48, 0xbb, 0x5e, 0xa6, 0x48, 0x6d, 0xaa, 0xc7, 0x08, 0xb2,
+	0x69, 0xb1, 0xbc, 0x57, 0x6e, 0x9b, 0x3d, 0xd6, 0x42, 0x6b, 0x88, 0xa3, 0x89, 0x76, 0xa4, 0x77,
+	0x3a, 0xfc, 0x82, 0x85, 0xcf, 0x72, 0x8a, 0x77, 0x94, 0x10, 0x67, 0x37, 0x28, 0xf7, 0x61, 0x66,
+	0x70, 0xa3, 0xe6, 0xa2, 0xe6, 0xeb, 0x78, 0xda, 0xee, 0x1a, 0xd0, 0x67, 0xb6, 0xf0, 0x40, 0x22,
+	0x0a, 0x65, 0xa5, 0x4b, 0x9d, 0x1a, 0xdc, 0x59, 0xda, 0x75, 0x66, 0xc8, 0x7c, 0x1b, 0x0f, 0x22,
+	0x76, 0xd1, 0xec, 0xf5, 0xb0, 0xfc, 0x34, 0x0c, 0x81, 0x7a, 0x19, 0x3d, 0x81, 0x6b, 0xf6, 0xab,
+	0x88, 0xfc, 0x60, 0xac, 0x1f, 0xf7, 0xba, 0x86, 0x6e, 0x58, 0x23, 0xb7, 0x1a, 0xf6, 0x86, 0x22,
+	0x3b, 0xea, 0x96, 0x05, 0x69, 0x61, 0xd6, 0xe5, 0x82, 0x99, 0x21, 0x04, 0xf2, 0x0c, 0x7f, 0x99,
+	0xd1, 0x03, 0xba, 0x45, 0x6b, 0xfc, 0x29, 0xd2, 0x16, 0x1d, 0x3e, 0x4b, 0x34, 0x0b, 0xa7, 0xb3,
+	0x44, 0x7b, 0x08, 0x6c, 0xd1, 0x1e, 0x30, 0xf3, 0x6d, 0x98, 0xf0, 0xd4, 0xf7, 0xe8, 0x07, 0x17,
+	0x7e, 0x11, 0x01, 0xaf, 0xb5, 0xff, 0x95, 0x14, 0x4e, 0x48, 0x83, 0x8b, 0x67, 0x36, 0x32, 0xf3,
+	0x2f, 0xc3, 0x30, 0xe9, 0x2d, 0xef, 0xbd, 0xae, 0x77, 0x02, 0x5f, 0xfb, 0x0b, 0x20, 0x77, 0xf0,
+	0xfd, 0xf3, 0x63, 0xc5, 0xd4, 0x2d, 0xb3, 0xad, 0xf7, 0xd3, 0x11, 0xef, 0x66, 0x0c, 0xfb, 0xea,
+	0xb1, 0xcc, 0xbb, 0xe8, 0x53, 0x98, 0xea, 0xe9, 0x66, 0xbb, 0xab, 0x39, 0xbe, 0x89, 0x0e, 0xbf,
+	0xc3, 0x13, 0x55, 0x41, 0x46, 0x3c, 0x70, 0x8e, 0xa3, 0xc1, 0x64, 0xcf, 0xd3, 0x23, 0x12, 0xd6,
+	0x7f, 0x95, 0x60, 0x66, 0x48, 0xd5, 0x92, 0xfe, 0x55, 0xa0, 0xa8, 0x20, 0x3b, 0xf4, 0x9e, 0x1b,
+	0x90, 0x9c, 0x01, 0x3b, 0x02, 0x0f, 0x11, 0x8c, 0x39, 0xdf, 0xd3, 0x87, 0x15, 0x37, 0x64, 0xce,
+	0x4a, 0xc1, 0xbe, 0x88, 0x9b, 0x1b, 0xe1, 0x9b, 0xf6, 0xbe, 0x3e, 0x84, 0xf5, 0xd4, 0xbe, 0x7a,
+	0xec, 0xee, 0xca, 0xac, 0x07, 0x67, 0x83, 0xb1, 0xb5, 0x00, 0x57, 0x03, 0x02, 0x5d, 0xa9, 0x98,
+	0xfa, 0xd8, 0x60, 0xa2, 0xad, 0xc3, 0x94, 0xaf, 0x06, 0x4a, 0x3f, 0x80, 0x31, 0x6e, 0x43, 0x61,
+	0x87, 0xac, 0x5f, 0x57, 0x7b, 0x00, 0xf7, 0x81, 0x4b, 0x4f, 0x31, 0x2e, 0xf3, 0x0f, 0x25, 0xa0,
+	0xc1, 0xda, 0xa7, 0x77, 0x7b, 0x97, 0xce, 0xdc, 0xde, 0x5f, 0x77, 0x24, 0x8a, 0x30, 0x78, 0x1e,
+	0xd0, 0xeb, 0xc2, 0x9b, 0xf0, 0xe5, 0x4e, 0xe3, 0x99, 0x5d, 0x98, 0xf2, 0x55, 0x4e, 0xe9, 0xac,
+	0x7b, 0xff, 0xf2, 0xbc, 0xf6, 0xcd, 0xf1, 0xe0, 0x9e, 0x1d, 0x3e, 0x6b, 0xcf, 0x16, 0x53, 0xfa,
+	0x00, 0x26, 0x3c, 0xa5, 0xd4, 0x4b, 0x58, 0x59, 0x70, 0x58, 0x72, 0x73, 0xb8, 0xa8, 0x3d, 0x32,
+	0x6b, 0x76, 0x62, 0xb3, 0x2b, 0xa1, 0xcb, 0x17, 0x79, 0x91, 0xc4, 0xbd, 0x39, 0x33, 0xea, 0xcc,
+	0x23, 0x98, 0xf4, 0x56, 0x43, 0xbf, 0x2c, 0xa3, 0x3f, 0x0e, 0x43, 0xca, 0x5d, 0x02, 0xa5, 0xd7,
+	0x60, 0x8c, 0xbf, 0x1d, 0x26, 0xf4, 0x17, 0x2d, 0xfa, 0x1c, 0xa6, 0x9a, 0xaa, 0xa6, 0xf0, 0x58,
+	0x6d, 0x37, 0xf1, 0x65, 0x8a, 0xf0, 0xa8, 0x05, 0x8f, 0xec, 0xf2, 0xa5, 0x2d, 0x87, 0xb4, 0x90,
+	0xb1, 0xe5, 0x9e, 0xbe, 0x98, 0x9d, 0xf4, 0xf6, 0xc9, 0x93, 0x4d, 0x55, 0x73, 0xb5, 0xe9, 0xd7,
+	0xe1, 0xaa, 0xa6, 0xb7, 0xd4, 0x83, 0x8e, 0xa5, 0x78, 0x1d, 0xeb, 0x49, 0x84, 0x11, 0x79, 0x46,
+	0x50, 0x15, 0xdd, 0x47, 0xb2, 0x0a, 0x4c, 0xf6, 0xf5, 0x66, 0xd7, 0xd0, 0xec, 0xb1, 0xa3, 0x3e,
+	0xb3, 0x61, 0x5a, 0xd6, 0x19, 0xa9, 0xbd, 0xd8, 0x26, 0xfa, 0xee, 0x66, 0xe6, 0x8f, 0x22, 0x2e,
+	0xd3, 0xa0, 0x87, 0xbf, 0x09, 0x6f, 0x7a, 0x79, 0x2b, 0xc3, 0x1c, 0x7e, 0xc3, 0xc3, 0xc5, 0xfd,
+	0x40, 0x41, 0x3f, 0x80, 0x9b, 0x3e, 0x06, 0xb8, 0xb9, 0x06, 0x9e, 0x5c, 0xbc, 0x1c, 0xf2, 0xcd,
+	0x3d, 0xe7, 0xf1, 0xa5, 0x02, 0x73, 0x3e, 0x0e, 0xc3, 0x96, 0x17, 0x3f, 0x07, 0xdd, 0xf4, 0xb0,
+	0x09, 0x3c, 0x41, 0x3d, 0x84, 0xb4, 0x8f, 0x95, 0xc3, 0x80, 0x7f, 0xb2, 0x70, 0xd5, 0xc3, 0x60,
+	0x30, 0xf0, 0x7d, 0xb8, 0x31, 0xd4, 0x3f, 0x6c, 0x24, 0xff, 0xa2, 0xe1, 0xda, 0x10, 0xd7, 0xe0,
+	0xd0, 0x32, 0xcc, 0xf8, 0x82, 0x88, 0x0d, 0xc2, 0x82, 0x40, 0xa2, 0x70, 0xf5, 0xf4, 0xc5, 0xec,
+	0xb4, 0x37, 0x36, 0xf0, 0x03, 0x8b, 0x69, 0x6f, 0x78, 0xe0, 0x2a, 0xfa, 0xa7, 0x61, 0xb8, 0x3a,
+	0xb4, 0x14, 0x8e, 0xe7, 0x04, 0xbc, 0x51, 0x10, 0x8b, 0x60, 0xb8, 0xd3, 0x07, 0x83, 0x70, 0xed,
+	0xb8, 0x5f, 0xb9, 0xc5, 0x91, 0xf4, 0x3b, 0x40, 0xfb, 0xfb, 0xaa, 0x69, 0x29, 0xe2, 0x25, 0xca,
+	0x8e, 0x7e, 0xa8, 0x77, 0x44, 0xa8, 0x7f, 0x65, 0x78, 0x10, 0x21, 0x39, 0x7f, 0xb9, 0x72, 0x03,
+	0x89, 0xdd, 0xbb, 0x5b, 0xdf, 0xd7, 0x47, 0xdf, 0xc2, 0x67, 0xc8, 0xe6, 0x5e, 0xb7, 0xd5, 0xf2,
+	0x86, 0xf3, 0x37, 0x65, 0x1b, 0x7f, 0x9d, 0x21, 0xfc, 0xb7, 0x87, 0x1a, 0xea, 0x2f, 0x5f, 0x2c,
+	0xcf, 0x42, 0x52, 0x58, 0xcf, 0x15, 0xbe, 0x20, 0x20, 0x8c, 0x98, 0xff, 0x18, 0x06, 0x1a, 0xbc,
+	0x64, 0xa0, 0x37, 0x21, 0xe6, 0x7e, 0xce, 0x1b, 0x94, 0xa4, 0x38, 0x8a, 0xaf, 0x8d, 0xab, 0x4d,
+	0xf6, 0xba, 0xe8, 0x59, 0xfe, 0x77, 0x58, 0xe6, 0x19, 0xb1, 0x7b, 0x6b, 0xe7, 0xc3, 0xff, 0x92,
+	0x7c, 0xd7, 0x91, 0xf9, 0x1b, 0x01, 0x23, 0x62, 0x28, 0xbd, 0x01, 0xe3, 0xfe, 0xc7, 0xd7, 0x44,
+	0xdb, 0xf6, 0xcc, 0x4d, 0x00, 0x6e, 0x03, 0x57, 0x4c, 0x8c, 0x73, 0x04, 0xbb, 0xbf, 0x06, 0xb4,
+	0xd9, 0xdd, 0xdf, 0x69, 0x1b, 0xba, 0xa6, 0x98, 0x07, 0x1d, 0xbd, 0xef, 0xf2, 0x39, 0xb1, 0x7b,
+	0x64, 0xec, 0x40, 0x2f, 0x7e, 0x11, 0x86, 0xf4, 0xa8, 0xbb, 0x15, 0xfa, 0x0e, 0x00, 0x72, 0x50,
+	0x86, 0x3a, 0x74, 0x1c, 0xbb, 0x06, 0x3b, 0x44, 0xab, 0x6b, 0x1e, 0xa9, 0xa6, 0xb8, 0x6a, 0xe8,
+	0xa7, 0xc3, 0x67, 0x2c, 0xaf, 0x35, 0x4e, 0xca, 0x9e, 0x8c, 0xfa, 0xf2, 0x44, 0xcb, 0xdd, 0xf4,
+	0x7c, 0xcd, 0x11, 0x79, 0xcd, 0x5f, 0x73, 0xbc, 0x05, 0xf1, 0x23, 0xf1, 0xd1, 0x5e, 0x94, 0x9f,
+	0x7c, 0xf0, 0x63, 0x84, 0xa7, 0xf8, 0xd5, 0x9e, 0x1c, 0x3b, 0xc2, 0xff, 0xe8, 0xf7, 0x60, 0xd2,
+	0xec, 0x76, 0x2d, 0xe5, 0xa8, 0x6b, 0x2a, 0x7d, 0x65, 0x4f, 0xe7, 0xef, 0x5e, 0xa6, 0x0a, 0x1b,
+	0x5f, 0xe6, 0x6b, 0x98, 0x7c, 0xb9, 0xbe, 0x90, 0x5b, 0x7d, 0xac, 0x9f, 0xb0, 0x0f, 0x29, 0xba,
+	0x5d, 0xeb, 0x69, 0xd7, 0xac, 0x3f, 0xd6, 0x4f, 0xe4, 0xa4, 0xe9, 0x34, 0x32, 0x99, 0x11, 0x0e,
+	0xc0, 0xaa, 0xff, 0xef, 0x49, 0x70, 0x6d, 0xf8, 0xdd, 0xc4, 0x85, 0x7d, 0xf3, 0xd8, 0xb7, 0xe0,
+	0xde, 0x1d, 0x7e, 0xb6, 0xf0, 0xf0, 0x1f, 0xb5, 0xe8, 0x32, 0xea, 0x50, 0x75, 0x30, 0x62, 0xdf,
+	0x86, 0x49, 0x47, 0x1d, 0xf7, 0x59, 0x6d, 0xa0, 0x09, 0xc6, 0xa6, 0xcb, 0x03, 0xe1, 0xe1, 0x1e,
+	0xc8, 0xfc, 0xbb, 0x08, 0xdc, 0x18, 0x79, 0xfb, 0x45, 0xbf, 0x0b, 0x33, 0xfc, 0x1d, 0x45, 0xfe,
+	0xe4, 0xce, 0x1e, 0x97, 0x74, 0x53, 0xec, 0x4d, 0xef, 0x0c, 0x9d, 0x1a, 0xbb, 0xdc, 0x60, 0x0c,
+	0x8a, 0x9c, 0xda, 0x35, 0xaf, 0x69, 0xd3, 0xdf, 0x49, 0x65, 0xf1, 0x09, 0xb0, 0xfd, 0xad, 0xee,
+	0xa5, 0x03, 0x7a, 0xda, 0xf5, 0xa9, 0x1c, 0x87, 0xe8, 0x23, 0x98, 0x10, 0xd7, 0x62, 0x82, 0x5b,
+	0xe4, 0xc2, 0xdc, 0x52, 0x7c, 0xa0, 0x60, 0xd4, 0x80, 0x2b, 0xbb, 0x9d, 0xee, 0x8e, 0xea, 0xba,
+	0x72, 0x62, 0xfc, 0xa2, 0x17, 0xe6, 0x47, 0xf9, 0x78, 0xdb, 0x79, 0x8c, 0x6b, 0x05, 0x26, 0xbb,
+	0x87, 0xba, 0xa9, 0x76, 0x3a, 0x36, 0xbf, 0xd8, 0xc5, 0x97, 0xaf, 0x18, 0xc9, 0x9b, 0x99, 0x37,
+	0x46, 0x39, 0x0f, 0xa3, 0xf9, 0x85, 0x04, 0x99, 0xd1, 0x57, 0x7c, 0xbf, 0xd6, 0x2f, 0x65, 0xdf,
+	0x83, 0x48, 0xdf, 0x30, 0x45, 0x01, 0xf9, 0x06, 0x2f, 0x20, 0x27, 0xef, 0xfc, 0xcf, 0x41, 0x01,
+	0x19, 0xdf, 0xb7, 0xad, 0x57, 0x65, 0x19, 0xa9, 0xe8, 0x43, 0x88, 0x9a, 0xfd, 0x3e, 0xdf, 0x56,
+	0x62, 0x85, 0xdb, 0xaf, 0x0a, 0xe9, 0xcc, 0xb5, 0xf4, 0xff, 0x1e, 0xd0, 0xde, 0xf9, 0x3f, 0x7f,
+	0xe1, 0x1a, 0x16, 0x95, 0xeb, 0xf5, 0x8a, 0xcc, 0x06, 0x88, 0x6f, 0xb1, 0xc7, 0x21, 0x2e, 0x5e,
+	0xd5, 0x9d, 0xfb, 0x33, 0x09, 0x68, 0xf0, 0x48, 0x41, 0x6b, 0x00, 0xce, 0xce, 0x7e, 0x66, 0xf0,
+	0xda, 0xe3, 0x06, 0xbb, 0xbc, 0x2b, 0x78, 0xc7, 0x55, 0x1b, 0x7c, 0xfd, 0xf5, 0x12, 0x4f, 0x19,
+	0x36, 0x32, 0xba, 0x0c, 0x3b, 0xf7, 0xf7, 0xec, 0x19, 0x7a, 0xc2, 0x82, 0xd6, 0x20, 0xb9, 0x73,
+	0xd0, 0xdc, 0xd3, 0x2d, 0xa5, 0xdf, 0xfe, 0x44, 0x1f, 0xf5, 0x16, 0x34, 0x1b, 0xc8, 0x46, 0x14,
+	0x18, 0x71, 0xbd, 0xfd, 0x89, 0xa7, 0x22, 0xb4, 0x33, 0x40, 0xb1, 0x80, 0x63, 0xea, 0x68, 0x53,
+	0x36, 0x47, 0xef, 0x6d, 0xca, 0xdf, 0x94, 0x81, 0xf7, 0xb1, 0x0f, 0xa5, 0xea, 0x40, 0x3d, 0x93,
+	0x7c, 0xa2, 0x76, 0x0e, 0x74, 0xfa, 0x57, 0x20, 0x76, 0x88, 0x3f, 0x2e, 0x7b, 0xc1, 0xc3, 0x47,
+	0xcd, 0x6d, 0xc3, 0x8c, 0xb7, 0xd8, 0xc0, 0xb9, 0x7e, 0xc3, 0xcb, 0xf5, 0xe2, 0x05, 0x0a, 0xc1,
+	0x56, 0x81, 0xf4, 0x90, 0x7b, 0x2c, 0xce, 0xbb, 0xe8, 0xe5, 0x7d, 0xc9, 0x0b, 0x30, 0x21, 0xe0,
+	0x11, 0xa4, 0x44, 0x35, 0x9a, 0x33, 0x7d, 0xe8, 0x65, 0x7a, 0x91, 0xd2, 0xb5, 0xa3, 0x69, 0xb0,
+	0xca, 0x79, 0x41, 0x4d, 0x83, 0x03, 0xcf, 0x10, 0xe0, 0x29, 0x5b, 0x5e, 0x46, 0x80, 0x67, 0x60,
+	0x40, 0xc0, 0xdd, 0xef, 0x4b, 0x10, 0x63, 0x7f, 0xe7, 0x80, 0x12, 0x48, 0x7d, 0xab, 0x56, 0xa9,
+	0x2a, 0x72, 0xf9, 0xdb, 0xdb, 0xe5, 0x7a, 0x83, 0x84, 0xe8, 0x14, 0x24, 0x19, 0x92, 0x2f, 0x16,
+	0xcb, 0x5b, 0x0d, 0x22, 0x51, 0x0a, 0x93, 0xdb, 0xd5, 0x62, 0xad, 0xba, 0x56, 0x91, 0x37, 0xcb,
+	0x25, 0x65, 0x7b, 0x8b, 0x84, 0xe9, 0x15, 0x20, 0x6e, 0xac, 0x54, 0x7b, 0x5a, 0x25, 0x11, 0x64,
+	0xe6, 0xa1, 0x8b, 0xe2, 0x58, 0x1f, 0x55, 0x0c, 0x31, 0xb9, 0xec, 0x11, 0x3a, 0x86, 0x42, 0xb7,
+	0xe4, 0xda, 0x96, 0x5c, 0x29, 0x37, 0xf2, 0xf2, 0x33, 0x12, 0xbf, 0x7b, 0x1d, 0x62, 0xec, 0x2f,
+	0x2a, 0xd0, 0x49, 0x80, 0x8d, 0x9a, 0x9c, 0x7f, 0x9a, 0xaf, 0x2a, 0xf2, 0x02, 0x09, 0xdd, 0xfd,
+	0x5d, 0x89, 0x7d, 0x5a, 0x20, 0xaa, 0xda, 0x38, 0x70, 0x33, 0x5f, 0x54, 0xb6, 0xab, 0x8f, 0xab,
+	0xc8, 0x3d, 0x44, 0x53, 0x90, 0x40, 0xe0, 0xc9, 0x82, 0x32, 0x4f, 0x24, 0x1c, 0x6d, 0xb7, 0x94,
+	0x05, 0x12, 0xf6, 0xb4, 0x73, 0x24, 0xe2, 0xa2, 0x5e, 0x20, 0x51, 0x4f, 0xef, 0x22, 0x89, 0x79,
+	0xda, 0x4b, 0x64, 0x2c, 0x93, 0xf8, 0xf4, 0x1f, 0x67, 0x43, 0x3f, 0xfa, 0xd3, 0x6c, 0xe8, 0xee,
+	0xbf, 0x90, 0x00, 0xb6, 0xd6, 0x9f, 0xb9, 0xb4, 0xd8, 0x5a, 0x7f, 0xe6, 0xd5, 0x02, 0x01, 0x47,
+	0x0b, 0xbb, 0xc5, 0xb4, 0xb8, 0x02, 0x64, 0xd0, 0xce, 0x29, 0x72, 0xf9, 0x89, 0x92, 0x27, 0x91,
+	0x21, 0x68, 0x81, 0x5b, 0x50, 0xa0, 0x0b, 0x82, 0x32, 0x16, 0xc0, 0x0a, 0x64, 0xcc, 0x33, 0x7a,
+	0x51, 0x50, 0xc6, 0xdd, 0x1a, 0x87, 0x61, 0xc2, 0x7b, 0xab, 0x36, 0x05, 0xc9, 0x52, 0xbe, 0x91,
+	0x57, 0xe4, 0x7c, 0xa3, 0xac, 0xcc, 0x93, 0x90, 0x17, 0x58, 0x20, 0x92, 0x17, 0xc8, 0x91, 0xb0,
+	0x17, 0x58, 0x24, 0x11, 0x2f, 0xb0, 0x44, 0xa2, 0x5e, 0x60, 0x99, 0xc4, 0xbc, 0xc0, 0x0a, 0x19,
+	0xf3, 0x02, 0x0f, 0x49, 0xdc, 0x0b, 0xac, 0x92, 0x84, 0x17, 0x78, 0x9f, 0x8c, 0x63, 0x5c, 0xb9,
+	0x14, 0x9b, 0x27, 0xe0, 0x43, 0x16, 0x48, 0xd2, 0x87, 0xe4, 0x48, 0xca, 0x87, 0x2c, 0x92, 0x09,
+	0x1f, 0xb2, 0x44, 0x26, 0x7d, 0xc8, 0x32, 0x99, 0x72, 0x59, 0x6c, 0x1e, 0xc0, 0x29, 0xce, 0xd3,
+	0x24, 0xc4, 0x8b, 0xb5, 0x6a, 0xa3, 0xfc, 0x21, 0xae, 0x91, 0x24, 0xc4, 0xeb, 0xe5, 0x7a, 0xbd,
+	0x52, 0xab, 0x12, 0x89, 0x26, 0x20, 0xfa, 0xb8, 0xfc, 0xac, 0x4e, 0xc2, 0x38, 0xc2, 0xf9, 0xda,
+	0x16, 0xa7, 0xb1, 0xc6, 0x22, 0xbc, 0x5a, 0xac, 0x94, 0xeb, 0x24, 0x44, 0xa7, 0x61, 0xa2, 0xb8,
+	0x9e, 0xaf, 0x56, 0xcb, 0x1b, 0xca, 0x66, 0xbe, 0xfe, 0xb8, 0x4e, 0xa4, 0xbb, 0x4b, 0x10, 0x63,
+	0x95, 0x33, 0xc6, 0x7e, 0x23, 0x5f, 0xaf, 0x2b, 0x79, 0x12, 0x72, 0x1a, 0x05, 0x22, 0x39, 0x8d,
+	0x22, 0x09, 0x67, 0xa2, 0xa8, 0xdd, 0xdd, 0x1e, 0xd0, 0xe0, 0x27, 0x45, 0x14, 0x60, 0x6c, 0xa3,
+	0xf6, 0x94, 0x2f, 0xe2, 0x38, 0x44, 0x36, 0x6a, 0x4f, 0x89, 0x84, 0x13, 0x2c, 0x94, 0x37, 0x6a,
+	0x4f, 0x95, 0x6a, 0x4d, 0xde, 0xcc, 0x6f, 0x90, 0x30, 0x92, 0x89, 0xdf, 0x6c, 0xc1, 0xe6, 0x0b,
+	0xb5, 0x27, 0x65, 0xbb, 0x37, 0x8a, 0x93, 0x59, 0xaf, 0x3c, 0x5a, 0x27, 0x31, 0x94, 0x8b, 0xbf,
+	0xd8, 0xfa, 0xbc, 0xfb, 0x6f, 0x63, 0x70, 0x65, 0xd8, 0x77, 0x3a, 0x74, 0x02, 0xc6, 0x8b, 0x95,
+	0x92, 0x22, 0xaf, 0x6d, 0xb3, 0x10, 0xb2, 0x9b, 0xe5, 0x7a, 0x59, 0xa4, 0x0e, 0x6c, 0x6e, 0x54,
+	0xaa, 0x8f, 0x95, 0xe2, 0x7a, 0xb9, 0xf8, 0x98, 0x84, 0x59, 0x92, 0xb0, 0xb1, 0x7c, 0x49, 0x26,
+	0x11, 0x9b, 0xaa, 0xb4, 0xdd, 0x78, 0xa6, 0x14, 0x9f, 0x15, 0x37, 0xca, 0x24, 0x4a, 0xaf, 0x01,
+	0x65, 0x8c, 0x3e, 0x54, 0xb6, 0xf2, 0x72, 0x7e, 0x53, 0xa9, 0x97, 0x1b, 0xdb, 0x5b, 0x3c, 0xf4,
+	0x19, 0x6d, 0xf9, 0x89, 0x52, 0x6f, 0xe4, 0x1b, 0xdb, 0x75, 0x32, 0x46, 0x67, 0x60, 0x0a, 0xb1,
+	0x6a, 0xf9, 0xa9, 0x22, 0xec, 0x4b, 0xe2, 0xf4, 0x3a, 0xcc, 0x08, 0x06, 0x8d, 0xca, 0x66, 0xa5,
+	0xfa, 0x48, 0x70, 0x48, 0xd8, 0x9c, 0x1b, 0x5e, 0xce, 0xe3, 0x03, 0xce, 0x1b, 0x03, 0x26, 0xe0,
+	0x4c, 0xe7, 0x71, 0xf9, 0x19, 0x49, 0xda, 0x3c, 0xf3, 0x25, 0xd9, 0x33, 0x36, 0x65, 0x6b, 0x50,
+	0x2a, 0x3f, 0xa9, 0x14, 0xcb, 0x28, 0xb0, 0x4c, 0x26, 0x70, 0x45, 0x22, 0xb8, 0x56, 0x93, 0x8b,
+	0x65, 0x85, 0x67, 0x3c, 0x32, 0x49, 0x33, 0x70, 0x8d, 0xb3, 0xc4, 0xb6, 0x87, 0xcd, 0x94, 0xad,
+	0xda, 0x16, 0x53, 0x77, 0xa3, 0xd6, 0x50, 0x2a, 0xd5, 0xb5, 0x1a, 0x21, 0xf4, 0x06, 0x5c, 0xf5,
+	0xe2, 0xb6, 0x86, 0xd3, 0xf4, 0x2a, 0x4c, 0x63, 0x57, 0xa1, 0x9c, 0x2f, 0xd6, 0xaa, 0x62, 0xaa,
+	0x84, 0xda, 0x0a, 0x09, 0x18, 0xc3, 0x90, 0xcc, 0xf8, 0xb4, 0xdc, 0xac, 0x95, 0xca, 0xe4, 0x96,
+	0x3d, 0x6d, 0xb9, 0xbc, 0x91, 0x7f, 0xa6, 0x60, 0xae, 0x26, 0x1f, 0xd0, 0x9b, 0x70, 0xc3, 0xc1,
+	0xca, 0xd5, 0xc1, 0x10, 0xd6, 0x9d, 0xb7, 0xd5, 0xe1, 0xdd, 0x6b, 0x95, 0x8d, 0x46, 0x59, 0x56,
+	0x36, 0x2a, 0xf5, 0x06, 0x29, 0xd0, 0x5b, 0xf0, 0xa6, 0xd3, 0xb5, 0xbd, 0x55, 0xc2, 0x65, 0xb5,
+	0xbd, 0xc5, 0x7c, 0xcd, 0x28, 0x8a, 0x34, 0x0b, 0x19, 0x97, 0xbc, 0x86, 0xbc, 0xe1, 0xe9, 0x2f,
+	0xd1, 0xb7, 0xe0, 0xa6, 0x57, 0x9f, 0xca, 0xa3, 0x6d, 0xb9, 0xac, 0xac, 0x3d, 0xc5, 0x90, 0xd9,
+	0xac, 0x34, 0x48, 0x99, 0xde, 0x86, 0x59, 0x87, 0xa4, 0x5a, 0x6b, 0x54, 0xd6, 0x9e, 0x31, 0xe7,
+	0x3b, 0x9a, 0x92, 0x35, 0xb1, 0x52, 0x7e, 0x1e, 0x86, 0x99, 0x21, 0x87, 0x03, 0xb6, 0xee, 0x07,
+	0xe1, 0xa6, 0x2c, 0x90, 0x90, 0x0f, 0xc9, 0x11, 0xc9, 0x87, 0x2c, 0x91, 0xb0, 0x0f, 0x59, 0x25,
+	0x11, 0x5c, 0xd2, 0x6e, 0x3e, 0x2b, 0x24, 0xea, 0x83, 0x16, 0x73, 0x24, 0xe6, 0x83, 0x56, 0x96,
+	0xc8, 0x18, 0x9a, 0xdd, 0x3d, 0x30, 0xb7, 0x4a, 0xe2, 0x3e, 0x2c, 0xb7, 0xbc, 0x42, 0x12, 0x3e,
+	0x6c, 0x79, 0x21, 0x47, 0xc6, 0xd1, 0x8f, 0xee, 0xb1, 0xf3, 0xb9, 0x25, 0x02, 0x3e, 0x30, 0x37,
+	0xbf, 0xb4, 0x4a, 0x92, 0x3e, 0x70, 0x69, 0xfe, 0xfd, 0x15, 0x92, 0xf2, 0x81, 0xab, 0x0b, 0xef,
+	0xe7, 0x78, 0xb0, 0x7a, 0x26, 0xb2, 0xb8, 0x8a, 0xe9, 0xd1, 0x8b, 0x2e, 0xe6, 0x1e, 0xae, 0xac,
+	0x92, 0x29, 0x61, 0xda, 0x7f, 0x2d, 0xc1, 0xa4, 0xf7, 0x4c, 0x87, 0xf3, 0x64, 0x31, 0x5a, 0x7e,
+	0x52, 0x96, 0x9f, 0x29, 0x0b, 0x22, 0xe7, 0xb9, 0xa0, 0x5c, 0x9d, 0x48, 0x3e, 0x68, 0xa9, 0x4e,
+	0xc2, 0x3e, 0x68, 0xb5, 0xce, 0x93, 0x82, 0x9b, 0xd7, 0x4a, 0x9d, 0x44, 0x7d, 0xd8, 0x62, 0xae,
+	0x4e, 0x62, 0x3e, 0x6c, 0x65, 0x49, 0x24, 0x04, 0xf7, 0xd8, 0xdc, 0x6a, 0x9d, 0xc4, 0x85, 0xd6,
+	0x7f, 0x37, 0x62, 0xdf, 0xd2, 0x79, 0xaf, 0x05, 0x67, 0x60, 0x4a, 0x2c, 0xc9, 0x62, 0x6d, 0xbb,
+	0xda, 0x40, 0x57, 0x86, 0x02, 0xe0, 0x22, 0x86, 0x85, 0x1f, 0x5c, 0x59, 0xe2, 0x3b, 0xba, 0x77,
+	0x78, 0x6e, 0x95, 0x44, 0x02, 0x28, 0xba, 0x34, 0x1a, 0x40, 0xd1, 0xa9, 0x31, 0x5c, 0xc8, 0x5e,
+	0x0e, 0xe8, 0xd6, 0xb1, 0x00, 0xcc, 0x1c, 0x1b, 0x0f, 0xc0, 0xcc, 0xb5, 0x89, 0x00, 0xcc, 0x9c,
+	0x3b, 0x8e, 0x79, 0xc5, 0x37, 0x39, 0x74, 0x2f, 0x04, 0x70, 0xee, 0xe0, 0x64, 0x00, 0x5f, 0x59,
+	0x5e, 0x5e, 0xc4, 0xc8, 0xb9, 0x0e, 0x33, 0x5e, 0x3e, 0x8b, 0x0b, 0xf3, 0x0f, 0x31, 0x7a, 0xfc,
+	0x1d, 0xb9, 0x95, 0xdc, 0xc2, 0x12, 0x06, 0x90, 0xbf, 0x63, 0x39, 0xb7, 0x94, 0x5b, 0x75, 0x62,
+	0xe8, 0x67, 0xac, 0x6e, 0xea, 0xbf, 0x64, 0xc5, 0x70, 0x10, 0xa3, 0x30, 0x95, 0xb2, 0x8d, 0xc5,
+	0x07, 0x2d, 0x10, 0xc9, 0x0f, 0xe5, 0x48, 0xd8, 0x0f, 0x2d, 0x92, 0x88, 0x1f, 0x5a, 0x22, 0x51,
+	0x3f, 0xb4, 0x4c, 0x62, 0x7e, 0x08, 0xcf, 0x29, 0x3e, 0x08, 0x4f, 0x2a, 0x3e, 0x08, 0xcf, 0x2a,
+	0x3e, 0xe8, 0x7d, 0xbe, 0x91, 0x78, 0x54, 0xc5, 0xf3, 0x8a, 0x1f, 0xc3, 0x13, 0x8b, 0x1f, 0xc3,
+	0x33, 0x8b, 0x1f, 0xc3, 0x53, 0x8b, 0x1f, 0x43, 0xbb, 0xfa, 0xb1, 0xe5, 0x81, 0x49, 0xff, 0x93,
+	0x64, 0xff, 0x89, 0x22, 0xef, 0x6d, 0xbc, 0x2b, 0x6e, 0xb7, 0xca, 0x72, 0xa5, 0x56, 0x62, 0x66,
+	0x0d, 0x80, 0x0b, 0x44, 0x0a, 0x82, 0x68, 0xda, 0x00, 0x88, 0xc6, 0x0d, 0x80, 0x68, 0xde, 0x00,
+	0x88, 0x06, 0x0e, 0x80, 0x2b, 0x64, 0x2c, 0x08, 0x3e, 0x1c, 0xac, 0xd3, 0xff, 0x16, 0x06, 0x70,
+	0xde, 0xf2, 0x61, 0x19, 0x94, 0xef, 0x41, 0xd8, 0x54, 0x56, 0x49, 0x88, 0x65, 0x46, 0x17, 0xb4,
+	0x30, 0x4f, 0xa4, 0x00, 0x86, 0x8a, 0xfb, 0xb1, 0x45, 0x12, 0x09, 0x60, 0x4b, 0x24, 0x1a, 0xc0,
+	0x56, 0x48, 0x2c, 0x80, 0xad, 0x92, 0x31, 0x3f, 0x96, 0x9b, 0x27, 0xf1, 0x00, 0xb6, 0x40, 0x12,
+	0x01, 0x6c, 0x89, 0x8c, 0x07, 0xb0, 0x15, 0x02, 0x01, 0xec, 0x21, 0x49, 0x06, 0xb0, 0xf7, 0x49,
+	0xca, 0x8f, 0x2d, 0xce, 0x93, 0x89, 0x00, 0xb6, 0x48, 0x26, 0x03, 0xd8, 0xca, 0x20, 0x34, 0x3e,
+	0x8d, 0xc0, 0xb0, 0xd7, 0x73, 0xd0, 0x0d, 0x78, 0xa4, 0xc9, 0x17, 0x1f, 0xf3, 0x2d, 0x96, 0xed,
+	0x87, 0x01, 0x50, 0xe4, 0x3e, 0x2f, 0xb8, 0x44, 0xc2, 0x41, 0x50, 0xa4, 0x3e, 0x1f, 0x4f, 0x91,
+	0xfa, 0xbc, 0x28, 0xdb, 0x1e, 0x03, 0xe8, 0x8a, 0xc8, 0x7c, 0x3e, 0x0e, 0x39, 0x91, 0xf9, 0x7c,
+	0x7a, 0x2d, 0x8b, 0xcc, 0xe7, 0x85, 0xf9, 0x56, 0x79, 0x0d, 0xa8, 0x8f, 0x09, 0xdf, 0x2d, 0x03,
+	0xb8, 0xd8, 0x30, 0x03, 0xb8, 0xd8, 0x33, 0x03, 0xb8, 0xd8, 0x36, 0xaf, 0xc3, 0x8c, 0x17, 0xb7,
+	0x77, 0xce, 0x40, 0x87, 0x77, 0xf3, 0x74, 0x5c, 0xe1, 0x79, 0x52, 0x77, 0xdb, 0xb2, 0xc4, 0x8e,
+	0x37, 0x3e, 0x57, 0x70, 0xd0, 0xe7, 0x0a, 0x0e, 0xfa, 0x5c, 0xc1, 0x41, 0x9f, 0x2b, 0x04, 0x4f,
+	0x9f, 0x2b, 0x38, 0xea, 0x77, 0x05, 0x47, 0xfd, 0xae, 0x10, 0x1c, 0xfc, 0xae, 0x10, 0x7a, 0xf9,
+	0x5d, 0xc1, 0xe1, 0x80, 0x2b, 0x04, 0x93, 0x80, 0x2b, 0x04, 0x97, 0x80, 0x2b, 0xc4, 0x04, 0x03,
+	0xae, 0x10, 0x73, 0x0c, 0xb8, 0xc2, 0x9e, 0x66, 0xc0, 0x15, 0xf6, 0x4c, 0xdd, 0xae, 0xf8, 0x93,
+	0x30, 0xc4, 0x45, 0xa9, 0x07, 0x1f, 0xd4, 0xe5, 0x0f, 0x05, 0x15, 0xa6, 0x47, 0x77, 0x7b, 0x81,
+	0x48, 0x9e, 0x76, 0x8e, 0x84, 0x3d, 0x6d, 0xcc, 0x2b, 0xee, 0xf6, 0x12, 0x89, 0x7a, 0xda, 0xcb,
+	0x24, 0xe6, 0x69, 0x63, 0x02, 0x74, 0xb7, 0x71, 0x83, 0x71, 0xb7, 0x71, 0x77, 0x71, 0xb7, 0x71,
+	0x6b, 0x99, 0x82, 0xa4, 0xa3, 0x0f, 0xee, 0x2b, 0x1e, 0x00, 0x37, 0x15, 0x0f, 0x80, 0x3b, 0x8a,
+	0x07, 0xc0, 0xed, 0xc4, 0x03, 0xa0, 0x7d, 0x3c, 0x80, 0xf7, 0x11, 0xf8, 0xfb, 0x61, 0x88, 0xb1,
+	0xb7, 0x0a, 0x91, 0x68, 0xb3, 0x52, 0xad, 0xc9, 0x83, 0x27, 0xbd, 0x24, 0xc4, 0x39, 0x20, 0x0a,
+	0x05, 0x4e, 0xaf, 0x28, 0x14, 0x38, 0x80, 0x28, 0x14, 0x38, 0x80, 0x28, 0x14, 0x38, 0x80, 0x28,
+	0x14, 0x38, 0x80, 0x28, 0x14, 0x38, 0x80, 0x28, 0x14, 0x38, 0x80, 0x28, 0x14, 0x38, 0x80, 0x28,
+	0x14, 0x38, 0x80, 0x5d, 0x28, 0x70, 0x21, 0xa2, 0x50, 0xe0, 0x42, 0x44, 0xa1, 0xc0, 0x85, 0x88,
+	0x42, 0x81, 0x0b, 0x11, 0x85, 0x02, 0x17, 0xb2, 0xec, 0x8e, 0x9e, 0x99, 0x21, 0x6f, 0xa4, 0xd0,
+	0x39, 0xc8, 0x8a, 0x47, 0x98, 0x7c, 0x49, 0xec, 0x6e, 0x95, 0x62, 0xa5, 0xf1, 0x4c, 0x59, 0x50,
+	0xea, 0xe5, 0x62, 0xad, 0x5a, 0x22, 0x21, 0x7a, 0x17, 0xde, 0x19, 0x4e, 0xb3, 0x3c, 0x3f, 0xaf,
+	0x6c, 0x56, 0x36, 0x36, 0x2a, 0x9c, 0x14, 0x0f, 0xcd, 0x23, 0x69, 0x73, 0xcb, 0x3e, 0xda, 0xf0,
+	0x68, 0xda, 0x05, 0x3f, 0xdf, 0x08, 0x7d, 0x17, 0xbe, 0x32, 0x4a, 0x07, 0x2f, 0x69, 0x74, 0x34,
+	0x69, 0xce, 0x47, 0x1a, 0x13, 0xb6, 0xf9, 0xbf, 0xf6, 0x8d, 0x5d, 0xa0, 0x72, 0x8f, 0xcf, 0x89,
+	0x9c, 0x17, 0x1f, 0xa3, 0x14, 0xd7, 0xd9, 0xfa, 0xac, 0xad, 0xad, 0xd5, 0xcb, 0x0d, 0x16, 0x5f,
+	0x03, 0x03, 0x0e, 0xa5, 0xc8, 0xcd, 0xe3, 0x76, 0x7f, 0x36, 0xcd, 0xd2, 0xfc, 0x3c, 0x09, 0x9f,
+	0x43, 0xb3, 0x3a, 0x3f, 0x4f, 0x22, 0xf8, 0x40, 0x79, 0x06, 0xcd, 0xc2, 0xca, 0xfc, 0x3c, 0x89,
+	0x9e, 0x43, 0xb4, 0x88, 0x1a, 0xd9, 0x13, 0xff, 0x37, 0xf6, 0x45, 0x80, 0xe7, 0x5d, 0x10, 0x47,
+	0x95, 0x72, 0xd5, 0xf3, 0x14, 0xae, 0x94, 0x2a, 0xf5, 0x7c, 0x61, 0xa3, 0x8c, 0x31, 0x31, 0x30,
+	0x8c, 0x9f, 0x26, 0xbf, 0xf1, 0x34, 0xff, 0x0c, 0x23, 0xe1, 0x2d, 0xb8, 0x39, 0x82, 0xcb, 0xb3,
+	0x6a, 0x7e, 0xb3, 0x52, 0x24, 0x61, 0xfa, 0x00, 0xde, 0x1b, 0x4e, 0xe2, 0x7d, 0x96, 0x6f, 0xc8,
+	0xb5, 0x0d, 0x94, 0x1a, 0x11, 0x6a, 0xff, 0x40, 0x82, 0xab, 0x43, 0x5f, 0x39, 0xc1, 0x87, 0x76,
+	0x31, 0xf7, 0xcd, 0xbc, 0xdc, 0x50, 0xca, 0x55, 0x54, 0x57, 0xd9, 0x28, 0x3f, 0x29, 0x6f, 0xb0,
+	0xb3, 0xd8, 0x2c, 0xbc, 0x31, 0xb2, 0x7f, 0x61, 0x85, 0x48, 0x67, 0x12, 0x2c, 0x62, 0xc2, 0x38,
+	0x8b, 0x60, 0x65, 0x69, 0xa0, 0xe2, 0xef, 0xdb, 0x2a, 0xfa, 0xdf, 0x8a, 0xa0, 0x6f, 0xc3, 0xad,
+	0x40, 0x41, 0x42, 0xc9, 0x17, 0x1b, 0x95, 0x5a, 0x55, 0xa9, 0xd6, 0x14, 0x79, 0x7b, 0xa3, 0x4c,
+	0x42, 0x67, 0x52, 0xad, 0xd5, 0xe4, 0xa7, 0x79, 0xb9, 0x44, 0x24, 0xc7, 0xd5, 0xc3, 0xa8, 0x18,
+	0x34, 0x28, 0xc5, 0xfd, 0xbe, 0x04, 0x37, 0x86, 0x5d, 0x03, 0x73, 0xa5, 0xee, 0xc3, 0xdd, 0xe1,
+	0x85, 0x0e, 0x9b, 0x9b, 0x5c, 0xce, 0x97, 0x94, 0xa7, 0xca, 0x9a, 0x52, 0xac, 0x62, 0xd9, 0xee,
+	0xeb, 0xf0, 0xf0, 0x3c, 0xfa, 0x4d, 0x2c, 0xd7, 0x35, 0xe4, 0xed, 0x7a, 0xa3, 0x5c, 0x72, 0x57,
+	0x3c, 0x24, 0x3b, 0x21, 0xb1, 0xf3, 0x7f, 0xf0, 0x2e, 0x09, 0x8b, 0x3a, 0x9c, 0x37, 0x3f, 0x8f,
+	0x14, 0xb6, 0x8b, 0x8f, 0xcb, 0x0d, 0xa5, 0x5e, 0xf9, 0x88, 0xd7, 0x3f, 0xce, 0xe8, 0xc6, 0xe3,
+	0xc6, 0x19, 0xdd, 0x78, 0xf0, 0x18, 0x04, 0xc8, 0x10, 0xe6, 0xb9, 0x81, 0xf7, 0xfe, 0x83, 0x9d,
+	0x10, 0x02, 0xf7, 0xd0, 0xce, 0xda, 0x60, 0xa5, 0x43, 0xc1, 0x86, 0x3d, 0x33, 0x96, 0x65, 0xe5,
+	0xa3, 0xb2, 0x5c, 0x23, 0x21, 0x27, 0x01, 0x0d, 0xa3, 0x91, 0xcb, 0x1b, 0xb5, 0x7c, 0x89, 0x15,
+	0x72, 0x89, 0x44, 0xbf, 0x0a, 0xb7, 0x47, 0x93, 0x6e, 0xe6, 0x3f, 0x54, 0x9e, 0xe4, 0x37, 0xb6,
+	0xcb, 0x24, 0x4c, 0xdf, 0x81, 0xb9, 0xd1, 0x84, 0xd5, 0x1a, 0x87, 0xed, 0x09, 0x14, 0xfe, 0x89,
+	0xf4, 0xd3, 0xcf, 0xb3, 0xd2, 0xcf, 0x3e, 0xcf, 0x4a, 0x3f, 0xff, 0x3c, 0x1b, 0xfa, 0xec, 0xf3,
+	0x6c, 0xe8, 0x17, 0x9f, 0x67, 0x43, 0x7f, 0xfe, 0x79, 0x36, 0xf4, 0xcb, 0xcf, 0xb3, 0xd2, 0x6f,
+	0x9f, 0x66, 0xa5, 0x4f, 0x4f, 0xb3, 0xa1, 0x1f, 0x9e, 0x66, 0xa5, 0x1f, 0x9d, 0x66, 0x43, 0x3f,
+	0x3e, 0xcd, 0x86, 0x7e, 0x72, 0x9a, 0x0d, 0xfd, 0xf4, 0x34, 0x2b, 0xfd, 0xec, 0x34, 0x2b, 0xfd,
+	0xfc, 0x34, 0x1b, 0xfa, 0xec, 0x34, 0x2b, 0xfd, 0xe2, 0x34, 0x1b, 0xfa, 0xf3, 0xd3, 0xac, 0xf4,
+	0xcb, 0xd3, 0x6c, 0xe8, 0xb7, 0x5f, 0x66, 0x43, 0x9f, 0xbe, 0xcc, 0x4a, 0x7f, 0xf0, 0x32, 0x1b,
+	0xfa, 0xe3, 0x97, 0x59, 0xe9, 0x07, 0x2f, 0xb3, 0xa1, 0x1f, 0xbe, 0xcc, 0x86, 0x7e, 0xf4, 0x32,
+	0x2b, 0xfd, 0xf8, 0x65, 0x56, 0xfa, 0xc9, 0xcb, 0xac, 0xf4, 0xd1, 0x83, 0x4b, 0xdc, 0xf9, 0x5a,
+	0x46, 0x6f, 0x67, 0x67, 0x8c, 0xbd, 0xb9, 0xbe, 0xf8, 0xff, 0x06, 0x00, 0x4c, 0x15, 0xdc, 0x4c,
+	0xdf, 0x5d, 0x00, 0x00,
+}
+
+func (x MType) String() string {
+	s, ok := MType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.CodingRate != that1.CodingRate {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x Major) String() string {
+	s, ok := Major_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Frequency != that1.Frequency {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x RejoinType) String() string {
+	s, ok := RejoinType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.EnableCRC != that1.EnableCRC {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x CFListType) String() string {
+	s, ok := CFListType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Timestamp != that1.Timestamp {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x Class) String() string {
+	s, ok := Class_name[int32(x)]
+	if ok {
+		return s
 	}
-	if that1.Time == nil {
-		if this.Time != nil {
-			return false
-		}
-	} else if !this.Time.Equal(*that1.Time) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x TxSchedulePriority) String() string {
+	s, ok := TxSchedulePriority_name[int32(x)]
+	if ok {
+		return s
 	}
-	if !this.Downlink.Equal(that1.Downlink) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x MACCommandIdentifier) String() string {
+	s, ok := MACCommandIdentifier_name[int32(x)]
+	if ok {
+		return s
 	}
-	return true
+	return strconv.Itoa(int(x))
 }
-func (this *TxSettings_Downlink) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (x AggregatedDutyCycle) String() string {
+	s, ok := AggregatedDutyCycle_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x PingSlotPeriod) String() string {
+	s, ok := PingSlotPeriod_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinCountExponent) String() string {
+	s, ok := RejoinCountExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinTimeExponent) String() string {
+	s, ok := RejoinTimeExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinPeriodExponent) String() string {
+	s, ok := RejoinPeriodExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x DeviceEIRP) String() string {
+	s, ok := DeviceEIRP_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ADRAckLimitExponent) String() string {
+	s, ok := ADRAckLimitExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ADRAckDelayExponent) String() string {
+	s, ok := ADRAckDelayExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Minor) String() string {
+	s, ok := Minor_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayCADPeriodicity) String() string {
+	s, ok := RelayCADPeriodicity_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelaySecondChAckOffset) String() string {
+	s, ok := RelaySecondChAckOffset_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayEndDeviceMode) String() string {
+	s, ok := RelayEndDeviceMode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelaySmartEnableLevel) String() string {
+	s, ok := RelaySmartEnableLevel_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayFilterListAction) String() string {
+	s, ok := RelayFilterListAction_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayCtrlUplinkListAction) String() string {
+	s, ok := RelayCtrlUplinkListAction_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayLimitBucketSize) String() string {
+	s, ok := RelayLimitBucketSize_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayResetLimitCounter) String() string {
+	s, ok := RelayResetLimitCounter_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
-	that1, ok := that.(*TxSettings_Downlink)
+	that1, ok := that.(*Message)
 	if !ok {
-		that2, ok := that.(TxSettings_Downlink)
+		that2, ok := that.(Message)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5981,25 +6884,31 @@ func (this *TxSettings_Downlink) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.AntennaIndex != that1.AntennaIndex {
+	if !this.MHDR.Equal(&that1.MHDR) {
 		return false
 	}
-	if this.TxPower != that1.TxPower {
+	if !bytes.Equal(this.MIC, that1.MIC) {
 		return false
 	}
-	if this.InvertPolarization != that1.InvertPolarization {
+	if that1.Payload == nil {
+		if this.Payload != nil {
+			return false
+		}
+	} else if this.Payload == nil {
+		return false
+	} else if !this.Payload.Equal(that1.Payload) {
 		return false
 	}
 	return true
 }
-func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
+func (this *Message_MACPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GatewayAntennaIdentifiers)
+	that1, ok := that.(*Message_MACPayload)
 	if !ok {
-		that2, ok := that.(GatewayAntennaIdentifiers)
+		that2, ok := that.(Message_MACPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6011,22 +6920,19 @@ func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
-		return false
-	}
-	if this.AntennaIndex != that1.AntennaIndex {
+	if !this.MACPayload.Equal(that1.MACPayload) {
 		return false
 	}
 	return true
 }
-func (this *UplinkToken) Equal(that interface{}) bool {
+func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UplinkToken)
+	that1, ok := that.(*Message_JoinRequestPayload)
 	if !ok {
-		that2, ok := that.(UplinkToken)
+		that2, ok := that.(Message_JoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6038,28 +6944,19 @@ func (this *UplinkToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.GatewayAntennaIdentifiers.Equal(&that1.GatewayAntennaIdentifiers) {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	if !this.ServerTime.Equal(that1.ServerTime) {
-		return false
-	}
-	if this.ConcentratorTime != that1.ConcentratorTime {
+	if !this.JoinRequestPayload.Equal(that1.JoinRequestPayload) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath) Equal(that interface{}) bool {
+func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath)
+	that1, ok := that.(*Message_JoinAcceptPayload)
 	if !ok {
-		that2, ok := that.(DownlinkPath)
+		that2, ok := that.(Message_JoinAcceptPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6071,25 +6968,19 @@ func (this *DownlinkPath) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Path == nil {
-		if this.Path != nil {
-			return false
-		}
-	} else if this.Path == nil {
-		return false
-	} else if !this.Path.Equal(that1.Path) {
+	if !this.JoinAcceptPayload.Equal(that1.JoinAcceptPayload) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
+func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath_UplinkToken)
+	that1, ok := that.(*Message_RejoinRequestPayload)
 	if !ok {
-		that2, ok := that.(DownlinkPath_UplinkToken)
+		that2, ok := that.(Message_RejoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6101,19 +6992,19 @@ func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
+	if !this.RejoinRequestPayload.Equal(that1.RejoinRequestPayload) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
+func (this *MHDR) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath_Fixed)
+	that1, ok := that.(*MHDR)
 	if !ok {
-		that2, ok := that.(DownlinkPath_Fixed)
+		that2, ok := that.(MHDR)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6125,19 +7016,22 @@ func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Fixed.Equal(that1.Fixed) {
+	if this.MType != that1.MType {
+		return false
+	}
+	if this.Major != that1.Major {
 		return false
 	}
 	return true
 }
-func (this *TxRequest) Equal(that interface{}) bool {
+func (this *MACPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TxRequest)
+	that1, ok := that.(*MACPayload)
 	if !ok {
-		that2, ok := that.(TxRequest)
+		that2, ok := that.(MACPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6149,58 +7043,31 @@ func (this *TxRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
-		return false
-	}
-	if len(this.DownlinkPaths) != len(that1.DownlinkPaths) {
-		return false
-	}
-	for i := range this.DownlinkPaths {
-		if !this.DownlinkPaths[i].Equal(that1.DownlinkPaths[i]) {
-			return false
-		}
-	}
-	if this.Rx1Delay != that1.Rx1Delay {
-		return false
-	}
-	if this.Rx1DataRateIndex != that1.Rx1DataRateIndex {
-		return false
-	}
-	if this.Rx1Frequency != that1.Rx1Frequency {
-		return false
-	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
+	if !this.FHDR.Equal(&that1.FHDR) {
 		return false
 	}
-	if this.Priority != that1.Priority {
+	if this.FPort != that1.FPort {
 		return false
 	}
-	if that1.AbsoluteTime == nil {
-		if this.AbsoluteTime != nil {
-			return false
-		}
-	} else if !this.AbsoluteTime.Equal(*that1.AbsoluteTime) {
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
 		return false
 	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
 		return false
 	}
-	if !this.Advanced.Equal(that1.Advanced) {
+	if this.FullFCnt != that1.FullFCnt {
 		return false
 	}
 	return true
 }
-func (this *MACCommand) Equal(that interface{}) bool {
+func (this *FHDR) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand)
+	that1, ok := that.(*FHDR)
 	if !ok {
-		that2, ok := that.(MACCommand)
+		that2, ok := that.(FHDR)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6212,28 +7079,28 @@ func (this *MACCommand) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CID != that1.CID {
+	if !this.DevAddr.Equal(that1.DevAddr) {
 		return false
 	}
-	if that1.Payload == nil {
-		if this.Payload != nil {
-			return false
-		}
-	} else if this.Payload == nil {
+	if !this.FCtrl.Equal(&that1.FCtrl) {
 		return false
-	} else if !this.Payload.Equal(that1.Payload) {
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if !bytes.Equal(this.FOpts, that1.FOpts) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
+func (this *FCtrl) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RawPayload)
+	that1, ok := that.(*FCtrl)
 	if !ok {
-		that2, ok := that.(MACCommand_RawPayload)
+		that2, ok := that.(FCtrl)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6245,43 +7112,31 @@ func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
+	if this.ADR != that1.ADR {
 		return false
 	}
-	return true
-}
-func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MACCommand_ResetInd_)
-	if !ok {
-		that2, ok := that.(MACCommand_ResetInd_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.ADRAckReq != that1.ADRAckReq {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.Ack != that1.Ack {
 		return false
 	}
-	if !this.ResetInd.Equal(that1.ResetInd) {
+	if this.FPending != that1.FPending {
+		return false
+	}
+	if this.ClassB != that1.ClassB {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
+func (this *JoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetConf_)
+	that1, ok := that.(*JoinRequestPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetConf_)
+		that2, ok := that.(JoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6293,19 +7148,25 @@ func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ResetConf.Equal(that1.ResetConf) {
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	if !this.DevNonce.Equal(that1.DevNonce) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
+func (this *RejoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkCheckAns_)
+	that1, ok := that.(*RejoinRequestPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkCheckAns_)
+		that2, ok := that.(RejoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6317,19 +7178,31 @@ func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkCheckAns.Equal(that1.LinkCheckAns) {
+	if this.RejoinType != that1.RejoinType {
+		return false
+	}
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	if this.RejoinCnt != that1.RejoinCnt {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRReq_) Equal(that interface{}) bool {
+func (this *JoinAcceptPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRReq_)
+	that1, ok := that.(*JoinAcceptPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRReq_)
+		that2, ok := that.(JoinAcceptPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6341,19 +7214,37 @@ func (this *MACCommand_LinkADRReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkADRReq.Equal(that1.LinkADRReq) {
+	if !bytes.Equal(this.Encrypted, that1.Encrypted) {
+		return false
+	}
+	if !this.JoinNonce.Equal(that1.JoinNonce) {
+		return false
+	}
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if !this.DLSettings.Equal(&that1.DLSettings) {
+		return false
+	}
+	if this.RxDelay != that1.RxDelay {
+		return false
+	}
+	if !this.CFList.Equal(that1.CFList) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRAns_) Equal(that interface{}) bool {
+func (this *DLSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRAns_)
+	that1, ok := that.(*DLSettings)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRAns_)
+		that2, ok := that.(DLSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6365,19 +7256,25 @@ func (this *MACCommand_LinkADRAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkADRAns.Equal(that1.LinkADRAns) {
+	if this.Rx1DROffset != that1.Rx1DROffset {
+		return false
+	}
+	if this.Rx2DR != that1.Rx2DR {
+		return false
+	}
+	if this.OptNeg != that1.OptNeg {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
+func (this *CFList) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DutyCycleReq_)
+	that1, ok := that.(*CFList)
 	if !ok {
-		that2, ok := that.(MACCommand_DutyCycleReq_)
+		that2, ok := that.(CFList)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6389,19 +7286,35 @@ func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DutyCycleReq.Equal(that1.DutyCycleReq) {
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.Freq) != len(that1.Freq) {
+		return false
+	}
+	for i := range this.Freq {
+		if this.Freq[i] != that1.Freq[i] {
+			return false
+		}
+	}
+	if len(this.ChMasks) != len(that1.ChMasks) {
 		return false
 	}
+	for i := range this.ChMasks {
+		if this.ChMasks[i] != that1.ChMasks[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
+func (this *LoRaDataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupReq_)
+	that1, ok := that.(*LoRaDataRate)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupReq_)
+		that2, ok := that.(LoRaDataRate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6413,19 +7326,22 @@ func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxParamSetupReq.Equal(that1.RxParamSetupReq) {
+	if this.Bandwidth != that1.Bandwidth {
+		return false
+	}
+	if this.SpreadingFactor != that1.SpreadingFactor {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
+func (this *FSKDataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupAns_)
+	that1, ok := that.(*FSKDataRate)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupAns_)
+		that2, ok := that.(FSKDataRate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6437,19 +7353,19 @@ func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxParamSetupAns.Equal(that1.RxParamSetupAns) {
+	if this.BitRate != that1.BitRate {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
+func (this *DataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DevStatusAns_)
+	that1, ok := that.(*DataRate)
 	if !ok {
-		that2, ok := that.(MACCommand_DevStatusAns_)
+		that2, ok := that.(DataRate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6461,19 +7377,25 @@ func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DevStatusAns.Equal(that1.DevStatusAns) {
+	if that1.Modulation == nil {
+		if this.Modulation != nil {
+			return false
+		}
+	} else if this.Modulation == nil {
+		return false
+	} else if !this.Modulation.Equal(that1.Modulation) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
+func (this *DataRate_LoRa) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelReq_)
+	that1, ok := that.(*DataRate_LoRa)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelReq_)
+		that2, ok := that.(DataRate_LoRa)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6485,19 +7407,19 @@ func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewChannelReq.Equal(that1.NewChannelReq) {
+	if !this.LoRa.Equal(that1.LoRa) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
+func (this *DataRate_FSK) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelAns_)
+	that1, ok := that.(*DataRate_FSK)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelAns_)
+		that2, ok := that.(DataRate_FSK)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6509,19 +7431,19 @@ func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewChannelAns.Equal(that1.NewChannelAns) {
+	if !this.FSK.Equal(that1.FSK) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelReq_) Equal(that interface{}) bool {
+func (this *TxSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelReq_)
+	that1, ok := that.(*TxSettings)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelReq_)
+		that2, ok := that.(TxSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6533,19 +7455,44 @@ func (this *MACCommand_DLChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DLChannelReq.Equal(that1.DLChannelReq) {
+	if !this.DataRate.Equal(&that1.DataRate) {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.CodingRate != that1.CodingRate {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.EnableCRC != that1.EnableCRC {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelAns_) Equal(that interface{}) bool {
+func (this *TxSettings_Downlink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelAns_)
+	that1, ok := that.(*TxSettings_Downlink)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelAns_)
+		that2, ok := that.(TxSettings_Downlink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6557,19 +7504,25 @@ func (this *MACCommand_DLChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DLChannelAns.Equal(that1.DLChannelAns) {
+	if this.AntennaIndex != that1.AntennaIndex {
+		return false
+	}
+	if this.TxPower != that1.TxPower {
+		return false
+	}
+	if this.InvertPolarization != that1.InvertPolarization {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
+func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxTimingSetupReq_)
+	that1, ok := that.(*GatewayAntennaIdentifiers)
 	if !ok {
-		that2, ok := that.(MACCommand_RxTimingSetupReq_)
+		that2, ok := that.(GatewayAntennaIdentifiers)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6581,19 +7534,22 @@ func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxTimingSetupReq.Equal(that1.RxTimingSetupReq) {
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.AntennaIndex != that1.AntennaIndex {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
+func (this *UplinkToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_TxParamSetupReq_)
+	that1, ok := that.(*UplinkToken)
 	if !ok {
-		that2, ok := that.(MACCommand_TxParamSetupReq_)
+		that2, ok := that.(UplinkToken)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6605,19 +7561,28 @@ func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TxParamSetupReq.Equal(that1.TxParamSetupReq) {
+	if !this.GatewayAntennaIdentifiers.Equal(&that1.GatewayAntennaIdentifiers) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !this.ServerTime.Equal(that1.ServerTime) {
+		return false
+	}
+	if this.ConcentratorTime != that1.ConcentratorTime {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
+func (this *DownlinkPath) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyInd_)
+	that1, ok := that.(*DownlinkPath)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyInd_)
+		that2, ok := that.(DownlinkPath)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6629,19 +7594,25 @@ func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RekeyInd.Equal(that1.RekeyInd) {
+	if that1.Path == nil {
+		if this.Path != nil {
+			return false
+		}
+	} else if this.Path == nil {
+		return false
+	} else if !this.Path.Equal(that1.Path) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
+func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyConf_)
+	that1, ok := that.(*DownlinkPath_UplinkToken)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyConf_)
+		that2, ok := that.(DownlinkPath_UplinkToken)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6653,19 +7624,19 @@ func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RekeyConf.Equal(that1.RekeyConf) {
+	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ADRParamSetupReq_) Equal(that interface{}) bool {
+func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ADRParamSetupReq_)
+	that1, ok := that.(*DownlinkPath_Fixed)
 	if !ok {
-		that2, ok := that.(MACCommand_ADRParamSetupReq_)
+		that2, ok := that.(DownlinkPath_Fixed)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6677,19 +7648,19 @@ func (this *MACCommand_ADRParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ADRParamSetupReq.Equal(that1.ADRParamSetupReq) {
+	if !this.Fixed.Equal(that1.Fixed) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
+func (this *TxRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceTimeAns_)
+	that1, ok := that.(*TxRequest)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceTimeAns_)
+		that2, ok := that.(TxRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6701,19 +7672,58 @@ func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceTimeAns.Equal(that1.DeviceTimeAns) {
+	if this.Class != that1.Class {
+		return false
+	}
+	if len(this.DownlinkPaths) != len(that1.DownlinkPaths) {
+		return false
+	}
+	for i := range this.DownlinkPaths {
+		if !this.DownlinkPaths[i].Equal(that1.DownlinkPaths[i]) {
+			return false
+		}
+	}
+	if this.Rx1Delay != that1.Rx1Delay {
+		return false
+	}
+	if this.Rx1DataRateIndex != that1.Rx1DataRateIndex {
+		return false
+	}
+	if this.Rx1Frequency != that1.Rx1Frequency {
+		return false
+	}
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if that1.AbsoluteTime == nil {
+		if this.AbsoluteTime != nil {
+			return false
+		}
+	} else if !this.AbsoluteTime.Equal(*that1.AbsoluteTime) {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
+func (this *MACCommand) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ForceRejoinReq_)
+	that1, ok := that.(*MACCommand)
 	if !ok {
-		that2, ok := that.(MACCommand_ForceRejoinReq_)
+		that2, ok := that.(MACCommand)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6725,19 +7735,28 @@ func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ForceRejoinReq.Equal(that1.ForceRejoinReq) {
+	if this.CID != that1.CID {
+		return false
+	}
+	if that1.Payload == nil {
+		if this.Payload != nil {
+			return false
+		}
+	} else if this.Payload == nil {
+		return false
+	} else if !this.Payload.Equal(that1.Payload) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
+func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupReq_)
+	that1, ok := that.(*MACCommand_RawPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupReq_)
+		that2, ok := that.(MACCommand_RawPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6749,19 +7768,19 @@ func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinParamSetupReq.Equal(that1.RejoinParamSetupReq) {
+	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
+func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupAns_)
+	that1, ok := that.(*MACCommand_ResetInd_)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupAns_)
+		that2, ok := that.(MACCommand_ResetInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6773,19 +7792,19 @@ func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinParamSetupAns.Equal(that1.RejoinParamSetupAns) {
+	if !this.ResetInd.Equal(that1.ResetInd) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
+func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotInfoReq_)
+	that1, ok := that.(*MACCommand_ResetConf_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotInfoReq_)
+		that2, ok := that.(MACCommand_ResetConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6797,19 +7816,19 @@ func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotInfoReq.Equal(that1.PingSlotInfoReq) {
+	if !this.ResetConf.Equal(that1.ResetConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelReq_)
+	that1, ok := that.(*MACCommand_LinkCheckAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelReq_)
+		that2, ok := that.(MACCommand_LinkCheckAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6821,19 +7840,19 @@ func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotChannelReq.Equal(that1.PingSlotChannelReq) {
+	if !this.LinkCheckAns.Equal(that1.LinkCheckAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelAns_)
+	that1, ok := that.(*MACCommand_LinkADRReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelAns_)
+		that2, ok := that.(MACCommand_LinkADRReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6845,19 +7864,19 @@ func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotChannelAns.Equal(that1.PingSlotChannelAns) {
+	if !this.LinkADRReq.Equal(that1.LinkADRReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconTimingAns_)
+	that1, ok := that.(*MACCommand_LinkADRAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconTimingAns_)
+		that2, ok := that.(MACCommand_LinkADRAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6869,19 +7888,19 @@ func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconTimingAns.Equal(that1.BeaconTimingAns) {
+	if !this.LinkADRAns.Equal(that1.LinkADRAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
+func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqReq_)
+	that1, ok := that.(*MACCommand_DutyCycleReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqReq_)
+		that2, ok := that.(MACCommand_DutyCycleReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6893,19 +7912,19 @@ func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconFreqReq.Equal(that1.BeaconFreqReq) {
+	if !this.DutyCycleReq.Equal(that1.DutyCycleReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqAns_)
+	that1, ok := that.(*MACCommand_RxParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqAns_)
+		that2, ok := that.(MACCommand_RxParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6917,19 +7936,19 @@ func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconFreqAns.Equal(that1.BeaconFreqAns) {
+	if !this.RxParamSetupReq.Equal(that1.RxParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeInd_)
+	that1, ok := that.(*MACCommand_RxParamSetupAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeInd_)
+		that2, ok := that.(MACCommand_RxParamSetupAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6941,19 +7960,19 @@ func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceModeInd.Equal(that1.DeviceModeInd) {
+	if !this.RxParamSetupAns.Equal(that1.RxParamSetupAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
+func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeConf_)
+	that1, ok := that.(*MACCommand_DevStatusAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeConf_)
+		that2, ok := that.(MACCommand_DevStatusAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6965,19 +7984,19 @@ func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceModeConf.Equal(that1.DeviceModeConf) {
+	if !this.DevStatusAns.Equal(that1.DevStatusAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetInd)
+	that1, ok := that.(*MACCommand_NewChannelReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetInd)
+		that2, ok := that.(MACCommand_NewChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6989,19 +8008,19 @@ func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.NewChannelReq.Equal(that1.NewChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetConf)
+	that1, ok := that.(*MACCommand_NewChannelAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetConf)
+		that2, ok := that.(MACCommand_NewChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7013,19 +8032,19 @@ func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.NewChannelAns.Equal(that1.NewChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
+func (this *MACCommand_DLChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkCheckAns)
+	that1, ok := that.(*MACCommand_DLChannelReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkCheckAns)
+		that2, ok := that.(MACCommand_DLChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7037,22 +8056,19 @@ func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Margin != that1.Margin {
-		return false
-	}
-	if this.GatewayCount != that1.GatewayCount {
+	if !this.DLChannelReq.Equal(that1.DLChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
+func (this *MACCommand_DLChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRReq)
+	that1, ok := that.(*MACCommand_DLChannelAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRReq)
+		that2, ok := that.(MACCommand_DLChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7064,36 +8080,19 @@ func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
-	}
-	if this.TxPowerIndex != that1.TxPowerIndex {
-		return false
-	}
-	if len(this.ChannelMask) != len(that1.ChannelMask) {
-		return false
-	}
-	for i := range this.ChannelMask {
-		if this.ChannelMask[i] != that1.ChannelMask[i] {
-			return false
-		}
-	}
-	if this.ChannelMaskControl != that1.ChannelMaskControl {
-		return false
-	}
-	if this.NbTrans != that1.NbTrans {
+	if !this.DLChannelAns.Equal(that1.DLChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
+func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRAns)
+	that1, ok := that.(*MACCommand_RxTimingSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRAns)
+		that2, ok := that.(MACCommand_RxTimingSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7105,25 +8104,19 @@ func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelMaskAck != that1.ChannelMaskAck {
-		return false
-	}
-	if this.DataRateIndexAck != that1.DataRateIndexAck {
-		return false
-	}
-	if this.TxPowerIndexAck != that1.TxPowerIndexAck {
+	if !this.RxTimingSetupReq.Equal(that1.RxTimingSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
+func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DutyCycleReq)
+	that1, ok := that.(*MACCommand_TxParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DutyCycleReq)
+		that2, ok := that.(MACCommand_TxParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7135,19 +8128,19 @@ func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxDutyCycle != that1.MaxDutyCycle {
+	if !this.TxParamSetupReq.Equal(that1.TxParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupReq)
+	that1, ok := that.(*MACCommand_RekeyInd_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupReq)
+		that2, ok := that.(MACCommand_RekeyInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7159,25 +8152,19 @@ func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
+	if !this.RekeyInd.Equal(that1.RekeyInd) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupAns)
+	that1, ok := that.(*MACCommand_RekeyConf_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupAns)
+		that2, ok := that.(MACCommand_RekeyConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7189,25 +8176,19 @@ func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx2DataRateIndexAck != that1.Rx2DataRateIndexAck {
-		return false
-	}
-	if this.Rx1DataRateOffsetAck != that1.Rx1DataRateOffsetAck {
-		return false
-	}
-	if this.Rx2FrequencyAck != that1.Rx2FrequencyAck {
+	if !this.RekeyConf.Equal(that1.RekeyConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
+func (this *MACCommand_ADRParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DevStatusAns)
+	that1, ok := that.(*MACCommand_ADRParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DevStatusAns)
+		that2, ok := that.(MACCommand_ADRParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7219,22 +8200,19 @@ func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Battery != that1.Battery {
-		return false
-	}
-	if this.Margin != that1.Margin {
+	if !this.ADRParamSetupReq.Equal(that1.ADRParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelReq)
+	that1, ok := that.(*MACCommand_DeviceTimeAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelReq)
+		that2, ok := that.(MACCommand_DeviceTimeAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7246,28 +8224,19 @@ func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndex != that1.ChannelIndex {
-		return false
-	}
-	if this.Frequency != that1.Frequency {
-		return false
-	}
-	if this.MinDataRateIndex != that1.MinDataRateIndex {
-		return false
-	}
-	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+	if !this.DeviceTimeAns.Equal(that1.DeviceTimeAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelAns)
+	that1, ok := that.(*MACCommand_ForceRejoinReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelAns)
+		that2, ok := that.(MACCommand_ForceRejoinReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7279,22 +8248,19 @@ func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
-		return false
-	}
-	if this.DataRateAck != that1.DataRateAck {
+	if !this.ForceRejoinReq.Equal(that1.ForceRejoinReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelReq)
+	that1, ok := that.(*MACCommand_RejoinParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelReq)
+		that2, ok := that.(MACCommand_RejoinParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7306,22 +8272,19 @@ func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndex != that1.ChannelIndex {
-		return false
-	}
-	if this.Frequency != that1.Frequency {
+	if !this.RejoinParamSetupReq.Equal(that1.RejoinParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelAns)
+	that1, ok := that.(*MACCommand_RejoinParamSetupAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelAns)
+		that2, ok := that.(MACCommand_RejoinParamSetupAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7333,22 +8296,19 @@ func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndexAck != that1.ChannelIndexAck {
-		return false
-	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if !this.RejoinParamSetupAns.Equal(that1.RejoinParamSetupAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxTimingSetupReq)
+	that1, ok := that.(*MACCommand_PingSlotInfoReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxTimingSetupReq)
+		that2, ok := that.(MACCommand_PingSlotInfoReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7360,19 +8320,19 @@ func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Delay != that1.Delay {
+	if !this.PingSlotInfoReq.Equal(that1.PingSlotInfoReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_TxParamSetupReq)
+	that1, ok := that.(*MACCommand_PingSlotChannelReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_TxParamSetupReq)
+		that2, ok := that.(MACCommand_PingSlotChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7384,25 +8344,19 @@ func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxEIRPIndex != that1.MaxEIRPIndex {
-		return false
-	}
-	if this.UplinkDwellTime != that1.UplinkDwellTime {
-		return false
-	}
-	if this.DownlinkDwellTime != that1.DownlinkDwellTime {
+	if !this.PingSlotChannelReq.Equal(that1.PingSlotChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyInd)
+	that1, ok := that.(*MACCommand_PingSlotChannelAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyInd)
+		that2, ok := that.(MACCommand_PingSlotChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7414,19 +8368,19 @@ func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.PingSlotChannelAns.Equal(that1.PingSlotChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyConf)
+	that1, ok := that.(*MACCommand_BeaconTimingAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyConf)
+		that2, ok := that.(MACCommand_BeaconTimingAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7438,19 +8392,19 @@ func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.BeaconTimingAns.Equal(that1.BeaconTimingAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ADRParamSetupReq)
+	that1, ok := that.(*MACCommand_BeaconFreqReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_ADRParamSetupReq)
+		that2, ok := that.(MACCommand_BeaconFreqReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7462,22 +8416,19 @@ func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ADRAckLimitExponent != that1.ADRAckLimitExponent {
-		return false
-	}
-	if this.ADRAckDelayExponent != that1.ADRAckDelayExponent {
+	if !this.BeaconFreqReq.Equal(that1.BeaconFreqReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceTimeAns)
+	that1, ok := that.(*MACCommand_BeaconFreqAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceTimeAns)
+		that2, ok := that.(MACCommand_BeaconFreqAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7489,19 +8440,19 @@ func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Time.Equal(that1.Time) {
+	if !this.BeaconFreqAns.Equal(that1.BeaconFreqAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ForceRejoinReq)
+	that1, ok := that.(*MACCommand_DeviceModeInd_)
 	if !ok {
-		that2, ok := that.(MACCommand_ForceRejoinReq)
+		that2, ok := that.(MACCommand_DeviceModeInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7513,28 +8464,43 @@ func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RejoinType != that1.RejoinType {
+	if !this.DeviceModeInd.Equal(that1.DeviceModeInd) {
 		return false
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
+	return true
+}
+func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.MaxRetries != that1.MaxRetries {
+
+	that1, ok := that.(*MACCommand_DeviceModeConf_)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceModeConf_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.PeriodExponent != that1.PeriodExponent {
+	if !this.DeviceModeConf.Equal(that1.DeviceModeConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupReq)
+	that1, ok := that.(*MACCommand_RelayConfReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupReq)
+		that2, ok := that.(MACCommand_RelayConfReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7546,22 +8512,19 @@ func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxCountExponent != that1.MaxCountExponent {
-		return false
-	}
-	if this.MaxTimeExponent != that1.MaxTimeExponent {
+	if !this.RelayConfReq.Equal(that1.RelayConfReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupAns)
+	that1, ok := that.(*MACCommand_RelayConfAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupAns)
+		that2, ok := that.(MACCommand_RelayConfAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7573,19 +8536,19 @@ func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxTimeExponentAck != that1.MaxTimeExponentAck {
+	if !this.RelayConfAns.Equal(that1.RelayConfAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotInfoReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotInfoReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7597,19 +8560,19 @@ func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Period != that1.Period {
+	if !this.RelayEndDeviceConfReq.Equal(that1.RelayEndDeviceConfReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7621,22 +8584,19 @@ func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
-		return false
-	}
-	if this.DataRateIndex != that1.DataRateIndex {
+	if !this.RelayEndDeviceConfAns.Equal(that1.RelayEndDeviceConfAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelAns)
+	that1, ok := that.(*MACCommand_RelayFilterListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelAns)
+		that2, ok := that.(MACCommand_RelayFilterListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7648,22 +8608,19 @@ func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
-		return false
-	}
-	if this.DataRateIndexAck != that1.DataRateIndexAck {
+	if !this.RelayFilterListReq.Equal(that1.RelayFilterListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconTimingAns)
+	that1, ok := that.(*MACCommand_RelayFilterListAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconTimingAns)
+		that2, ok := that.(MACCommand_RelayFilterListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7675,22 +8632,19 @@ func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Delay != that1.Delay {
-		return false
-	}
-	if this.ChannelIndex != that1.ChannelIndex {
+	if !this.RelayFilterListAns.Equal(that1.RelayFilterListAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayUpdateUplinkListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqReq)
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqReq)
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7702,19 +8656,19 @@ func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
+	if !this.RelayUpdateUplinkListReq.Equal(that1.RelayUpdateUplinkListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayUpdateUplinkListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqAns)
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqAns)
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7726,19 +8680,19 @@ func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if !this.RelayUpdateUplinkListAns.Equal(that1.RelayUpdateUplinkListAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfigureFwdLimitReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeInd)
+	that1, ok := that.(*MACCommand_RelayConfigureFwdLimitReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeInd)
+		that2, ok := that.(MACCommand_RelayConfigureFwdLimitReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7750,19 +8704,19 @@ func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
+	if !this.RelayConfigureFwdLimitReq.Equal(that1.RelayConfigureFwdLimitReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfigureFwdLimitAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeConf)
+	that1, ok := that.(*MACCommand_RelayConfigureFwdLimitAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeConf)
+		that2, ok := that.(MACCommand_RelayConfigureFwdLimitAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7774,19 +8728,19 @@ func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
+	if !this.RelayConfigureFwdLimitAns.Equal(that1.RelayConfigureFwdLimitAns) {
 		return false
 	}
 	return true
 }
-func (this *DataRateIndexValue) Equal(that interface{}) bool {
+func (this *MACCommand_RelayNotifyNewEndDeviceReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRateIndexValue)
+	that1, ok := that.(*MACCommand_RelayNotifyNewEndDeviceReq_)
 	if !ok {
-		that2, ok := that.(DataRateIndexValue)
+		that2, ok := that.(MACCommand_RelayNotifyNewEndDeviceReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7798,19 +8752,19 @@ func (this *DataRateIndexValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if !this.RelayNotifyNewEndDeviceReq.Equal(that1.RelayNotifyNewEndDeviceReq) {
 		return false
 	}
 	return true
 }
-func (this *PingSlotPeriodValue) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PingSlotPeriodValue)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListReq_)
 	if !ok {
-		that2, ok := that.(PingSlotPeriodValue)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7822,19 +8776,19 @@ func (this *PingSlotPeriodValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if !this.RelayCtrlUplinkListReq.Equal(that1.RelayCtrlUplinkListReq) {
 		return false
 	}
 	return true
 }
-func (this *AggregatedDutyCycleValue) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AggregatedDutyCycleValue)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListAns_)
 	if !ok {
-		that2, ok := that.(AggregatedDutyCycleValue)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7846,19 +8800,19 @@ func (this *AggregatedDutyCycleValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if !this.RelayCtrlUplinkListAns.Equal(that1.RelayCtrlUplinkListAns) {
 		return false
 	}
 	return true
 }
-func (this *RxDelayValue) Equal(that interface{}) bool {
+func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RxDelayValue)
+	that1, ok := that.(*MACCommand_ResetInd)
 	if !ok {
-		that2, ok := that.(RxDelayValue)
+		that2, ok := that.(MACCommand_ResetInd)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7870,19 +8824,19 @@ func (this *RxDelayValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *ADRAckLimitExponentValue) Equal(that interface{}) bool {
+func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ADRAckLimitExponentValue)
+	that1, ok := that.(*MACCommand_ResetConf)
 	if !ok {
-		that2, ok := that.(ADRAckLimitExponentValue)
+		that2, ok := that.(MACCommand_ResetConf)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7894,19 +8848,19 @@ func (this *ADRAckLimitExponentValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *ADRAckDelayExponentValue) Equal(that interface{}) bool {
+func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ADRAckDelayExponentValue)
+	that1, ok := that.(*MACCommand_LinkCheckAns)
 	if !ok {
-		that2, ok := that.(ADRAckDelayExponentValue)
+		that2, ok := that.(MACCommand_LinkCheckAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7918,1673 +8872,1411 @@ func (this *ADRAckDelayExponentValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.GatewayCount != that1.GatewayCount {
 		return false
 	}
 	return true
 }
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_LinkADRReq)
+	if !ok {
+		that2, ok := that.(MACCommand_LinkADRReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.MIC) > 0 {
-		i -= len(m.MIC)
-		copy(dAtA[i:], m.MIC)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.MIC)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.MHDR.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.TxPowerIndex != that1.TxPowerIndex {
+		return false
+	}
+	if len(this.ChannelMask) != len(that1.ChannelMask) {
+		return false
+	}
+	for i := range this.ChannelMask {
+		if this.ChannelMask[i] != that1.ChannelMask[i] {
+			return false
 		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_MACPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ChannelMaskControl != that1.ChannelMaskControl {
+		return false
+	}
+	if this.NbTrans != that1.NbTrans {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MACPayload != nil {
-		{
-			size, err := m.MACPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_LinkADRAns)
+	if !ok {
+		that2, ok := that.(MACCommand_LinkADRAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelMaskAck != that1.ChannelMaskAck {
+		return false
+	}
+	if this.DataRateIndexAck != that1.DataRateIndexAck {
+		return false
+	}
+	if this.TxPowerIndexAck != that1.TxPowerIndexAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JoinRequestPayload != nil {
-		{
-			size, err := m.JoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DutyCycleReq)
+	if !ok {
+		that2, ok := that.(MACCommand_DutyCycleReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxDutyCycle != that1.MaxDutyCycle {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JoinAcceptPayload != nil {
-		{
-			size, err := m.JoinAcceptPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RxParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RxParamSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
+		return false
+	}
+	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RejoinRequestPayload != nil {
-		{
-			size, err := m.RejoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RxParamSetupAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RxParamSetupAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MHDR) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MHDR) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Major != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Major))
-		i--
-		dAtA[i] = 0x10
+	if this.Rx2DataRateIndexAck != that1.Rx2DataRateIndexAck {
+		return false
 	}
-	if m.MType != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MType))
-		i--
-		dAtA[i] = 0x8
+	if this.Rx1DataRateOffsetAck != that1.Rx1DataRateOffsetAck {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Rx2FrequencyAck != that1.Rx2FrequencyAck {
+		return false
+	}
+	return true
 }
-
-func (m *MACPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FullFCnt != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.FullFCnt))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.DecodedPayload != nil {
-		{
-			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DevStatusAns)
+	if !ok {
+		that2, ok := that.(MACCommand_DevStatusAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.FRMPayload) > 0 {
-		i -= len(m.FRMPayload)
-		copy(dAtA[i:], m.FRMPayload)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FRMPayload)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.FPort != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.FPort))
-		i--
-		dAtA[i] = 0x10
+	if this.Battery != that1.Battery {
+		return false
 	}
-	{
-		size, err := m.FHDR.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Margin != that1.Margin {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *FHDR) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FHDR) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *FHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FOpts) > 0 {
-		i -= len(m.FOpts)
-		copy(dAtA[i:], m.FOpts)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FOpts)))
-		i--
-		dAtA[i] = 0x22
+	that1, ok := that.(*MACCommand_NewChannelReq)
+	if !ok {
+		that2, ok := that.(MACCommand_NewChannelReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.FCnt != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.FCnt))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.FCtrl.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Frequency != that1.Frequency {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *FCtrl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MinDataRateIndex != that1.MinDataRateIndex {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FCtrl) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FCtrl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClassB {
-		i--
-		if m.ClassB {
-			dAtA[i] = 1
+	that1, ok := that.(*MACCommand_NewChannelAns)
+	if !ok {
+		that2, ok := that.(MACCommand_NewChannelAns)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.FPending {
-		i--
-		if m.FPending {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Ack {
-		i--
-		if m.Ack {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
 	}
-	if m.ADRAckReq {
-		i--
-		if m.ADRAckReq {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if this.DataRateAck != that1.DataRateAck {
+		return false
 	}
-	if m.ADR {
-		i--
-		if m.ADR {
-			dAtA[i] = 1
+	return true
+}
+func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACCommand_DLChannelReq)
+	if !ok {
+		that2, ok := that.(MACCommand_DLChannelReq)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JoinRequestPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MACCommand_DLChannelAns)
+	if !ok {
+		that2, ok := that.(MACCommand_DLChannelAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelIndexAck != that1.ChannelIndexAck {
+		return false
+	}
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.DevNonce.Size()
-		i -= size
-		if _, err := m.DevNonce.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MACCommand_RxTimingSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RxTimingSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.DevEUI.Size()
-		i -= size
-		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.JoinEUI.Size()
-		i -= size
-		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Delay != that1.Delay {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RejoinRequestPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RejoinCnt != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinCnt))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.DevEUI.Size()
-		i -= size
-		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MACCommand_TxParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_TxParamSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.JoinEUI.Size()
-		i -= size
-		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.NetID.Size()
-		i -= size
-		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.MaxEIRPIndex != that1.MaxEIRPIndex {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.RejoinType != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
-		i--
-		dAtA[i] = 0x8
+	if this.UplinkDwellTime != that1.UplinkDwellTime {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JoinAcceptPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DownlinkDwellTime != that1.DownlinkDwellTime {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CFList != nil {
-		{
-			size, err := m.CFList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RekeyInd)
+	if !ok {
+		that2, ok := that.(MACCommand_RekeyInd)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if m.RxDelay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RxDelay))
-		i--
-		dAtA[i] = 0x30
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.DLSettings.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.MinorVersion != that1.MinorVersion {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	return true
+}
+func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.NetID.Size()
-		i -= size
-		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+
+	that1, ok := that.(*MACCommand_RekeyConf)
+	if !ok {
+		that2, ok := that.(MACCommand_RekeyConf)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.JoinNonce.Size()
-		i -= size
-		if _, err := m.JoinNonce.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Encrypted) > 0 {
-		i -= len(m.Encrypted)
-		copy(dAtA[i:], m.Encrypted)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.Encrypted)))
-		i--
-		dAtA[i] = 0xa
+	if this.MinorVersion != that1.MinorVersion {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DLSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *DLSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DLSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OptNeg {
-		i--
-		if m.OptNeg {
-			dAtA[i] = 1
+	that1, ok := that.(*MACCommand_ADRParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_ADRParamSetupReq)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.Rx2DR != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DR))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Rx1DROffset != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DROffset))
-		i--
-		dAtA[i] = 0x8
+	if this.ADRAckLimitExponent != that1.ADRAckLimitExponent {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CFList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ADRAckDelayExponent != that1.ADRAckDelayExponent {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CFList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CFList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChMasks) > 0 {
-		for iNdEx := len(m.ChMasks) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ChMasks[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+	that1, ok := that.(*MACCommand_DeviceTimeAns)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceTimeAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChMasks)))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Freq) > 0 {
-		dAtA12 := make([]byte, len(m.Freq)*10)
-		var j11 int
-		for _, num := range m.Freq {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintLorawan(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Type != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if !this.Time.Equal(that1.Time) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *LoRaDataRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LoRaDataRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *LoRaDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpreadingFactor != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.SpreadingFactor))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*MACCommand_ForceRejoinReq)
+	if !ok {
+		that2, ok := that.(MACCommand_ForceRejoinReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Bandwidth != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Bandwidth))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FSKDataRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.RejoinType != that1.RejoinType {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FSKDataRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FSKDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitRate != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.BitRate))
-		i--
-		dAtA[i] = 0x8
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DataRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxRetries != that1.MaxRetries {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.PeriodExponent != that1.PeriodExponent {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Modulation != nil {
-		{
-			size := m.Modulation.Size()
-			i -= size
-			if _, err := m.Modulation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_RejoinParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RejoinParamSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DataRate_LoRa) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxCountExponent != that1.MaxCountExponent {
+		return false
+	}
+	if this.MaxTimeExponent != that1.MaxTimeExponent {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DataRate_LoRa) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LoRa != nil {
-		{
-			size, err := m.LoRa.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RejoinParamSetupAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RejoinParamSetupAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-func (m *DataRate_FSK) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTimeExponentAck != that1.MaxTimeExponentAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DataRate_FSK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FSK != nil {
-		{
-			size, err := m.FSK.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_PingSlotInfoReq)
+	if !ok {
+		that2, ok := that.(MACCommand_PingSlotInfoReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *TxSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TxSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Period != that1.Period {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TxSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Downlink != nil {
-		{
-			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_PingSlotChannelReq)
+	if !ok {
+		that2, ok := that.(MACCommand_PingSlotChannelReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.Time != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintLorawan(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x3a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
+	if this.Frequency != that1.Frequency {
+		return false
 	}
-	if m.EnableCRC {
-		i--
-		if m.EnableCRC {
-			dAtA[i] = 1
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	return true
+}
+func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACCommand_PingSlotChannelAns)
+	if !ok {
+		that2, ok := that.(MACCommand_PingSlotChannelAns)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.CodingRate) > 0 {
-		i -= len(m.CodingRate)
-		copy(dAtA[i:], m.CodingRate)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.CodingRate)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
-		i--
-		dAtA[i] = 0x10
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
 	}
-	{
-		size, err := m.DataRate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.DataRateIndexAck != that1.DataRateIndexAck {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *TxSettings_Downlink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TxSettings_Downlink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *TxSettings_Downlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvertPolarization {
-		i--
-		if m.InvertPolarization {
-			dAtA[i] = 1
+	that1, ok := that.(*MACCommand_BeaconTimingAns)
+	if !ok {
+		that2, ok := that.(MACCommand_BeaconTimingAns)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.TxPower != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.TxPower)))
-		i--
-		dAtA[i] = 0x15
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AntennaIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
-		i--
-		dAtA[i] = 0x8
+	if this.Delay != that1.Delay {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GatewayAntennaIdentifiers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MACCommand_BeaconFreqReq)
+	if !ok {
+		that2, ok := that.(MACCommand_BeaconFreqReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GatewayAntennaIdentifiers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GatewayAntennaIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AntennaIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Frequency != that1.Frequency {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *UplinkToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UplinkToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConcentratorTime != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ConcentratorTime))
-		i--
-		dAtA[i] = 0x20
-	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ServerTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime):])
-	if err19 != nil {
-		return 0, err19
+	that1, ok := that.(*MACCommand_BeaconFreqAns)
+	if !ok {
+		that2, ok := that.(MACCommand_BeaconFreqAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i -= n19
-	i = encodeVarintLorawan(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0x1a
-	if m.Timestamp != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.GatewayAntennaIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DownlinkPath) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DownlinkPath) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DownlinkPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Path != nil {
-		{
-			size := m.Path.Size()
-			i -= size
-			if _, err := m.Path.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_DeviceModeInd)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceModeInd)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DownlinkPath_UplinkToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DownlinkPath_UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UplinkToken != nil {
-		i -= len(m.UplinkToken)
-		copy(dAtA[i:], m.UplinkToken)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.UplinkToken)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *DownlinkPath_Fixed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Class != that1.Class {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DownlinkPath_Fixed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Fixed != nil {
-		{
-			size, err := m.Fixed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DeviceModeConf)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceModeConf)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *TxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Class != that1.Class {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayConfReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Advanced != nil {
-		{
-			size, err := m.Advanced.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayConfReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
 	}
-	if len(m.FrequencyPlanID) > 0 {
-		i -= len(m.FrequencyPlanID)
-		copy(dAtA[i:], m.FrequencyPlanID)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FrequencyPlanID)))
-		i--
-		dAtA[i] = 0x52
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AbsoluteTime != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
-		if err23 != nil {
-			return 0, err23
-		}
-		i -= n23
-		i = encodeVarintLorawan(dAtA, i, uint64(n23))
-		i--
-		dAtA[i] = 0x4a
+	if this.Enable != that1.Enable {
+		return false
 	}
-	if m.Priority != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Priority))
-		i--
-		dAtA[i] = 0x40
+	if this.CADPeriodicity != that1.CADPeriodicity {
+		return false
 	}
-	if m.Rx2Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
-		i--
-		dAtA[i] = 0x38
+	if this.DefaultChannelIndex != that1.DefaultChannelIndex {
+		return false
 	}
-	if m.Rx2DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
-		i--
-		dAtA[i] = 0x30
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
 	}
-	if m.Rx1Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Rx1Frequency)
-		i--
-		dAtA[i] = 0x28
+	return true
+}
+func (this *MACCommand_RelayConfAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Rx1DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateIndex))
-		i--
-		dAtA[i] = 0x20
+
+	that1, ok := that.(*MACCommand_RelayConfAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Rx1Delay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1Delay))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.DownlinkPaths) > 0 {
-		for iNdEx := len(m.DownlinkPaths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DownlinkPaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLorawan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if this.SecondChannelFrequencyAck != that1.SecondChannelFrequencyAck {
+		return false
 	}
-	if m.Class != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
-		i--
-		dAtA[i] = 0x8
+	if this.SecondChannelAckOffsetAck != that1.SecondChannelAckOffsetAck {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SecondChannelDataRateIndexAck != that1.SecondChannelDataRateIndexAck {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SecondChannelIndexAck != that1.SecondChannelIndexAck {
+		return false
+	}
+	if this.DefaultChannelIndexAck != that1.DefaultChannelIndexAck {
+		return false
+	}
+	if this.CADPeriodicityAck != that1.CADPeriodicityAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayEndDeviceConfReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.CID != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.CID))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_RawPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_RawPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RawPayload != nil {
-		i -= len(m.RawPayload)
-		copy(dAtA[i:], m.RawPayload)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.RawPayload)))
-		i--
-		dAtA[i] = 0x12
+	if this.Mode != that1.Mode {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_ResetInd_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SmartEnableLevel != that1.SmartEnableLevel {
+		return false
+	}
+	if this.Backoff != that1.Backoff {
+		return false
+	}
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayEndDeviceConfAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_ResetInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResetInd != nil {
-		{
-			size, err := m.ResetInd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayEndDeviceConfAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_ResetConf_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SecondChannelFrequencyAck != that1.SecondChannelFrequencyAck {
+		return false
+	}
+	if this.SecondChannelAckOffsetAck != that1.SecondChannelAckOffsetAck {
+		return false
+	}
+	if this.SecondChannelDataRateIndexAck != that1.SecondChannelDataRateIndexAck {
+		return false
+	}
+	if this.BackoffAck != that1.BackoffAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayFilterListReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_ResetConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResetConf != nil {
-		{
-			size, err := m.ResetConf.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayFilterListReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayFilterListReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_LinkCheckAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayFilterListAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_LinkCheckAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LinkCheckAns != nil {
-		{
-			size, err := m.LinkCheckAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayFilterListAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayFilterListAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_LinkADRReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IndexAck != that1.IndexAck {
+		return false
+	}
+	if this.ActionAck != that1.ActionAck {
+		return false
+	}
+	if this.CombinedRulesAck != that1.CombinedRulesAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayUpdateUplinkListReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_LinkADRReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LinkADRReq != nil {
-		{
-			size, err := m.LinkADRReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_LinkADRAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_LinkADRAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LinkADRAns != nil {
-		{
-			size, err := m.LinkADRAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RuleIndex != that1.RuleIndex {
+		return false
+	}
+	if !this.ForwardLimits.Equal(that1.ForwardLimits) {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if this.WFCnt != that1.WFCnt {
+		return false
+	}
+	if that1.RootWorSKey == nil {
+		if this.RootWorSKey != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
+	} else if !this.RootWorSKey.Equal(*that1.RootWorSKey) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DutyCycleReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACCommand_RelayUpdateUplinkListAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_DutyCycleReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DutyCycleReq != nil {
-		{
-			size, err := m.DutyCycleReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayCtrlUplinkListReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RxParamSetupReq != nil {
-		{
-			size, err := m.RxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RxParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RuleIndex != that1.RuleIndex {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayCtrlUplinkListAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RxParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RxParamSetupAns != nil {
-		{
-			size, err := m.RxParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DevStatusAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RuleIndexAck != that1.RuleIndexAck {
+		return false
+	}
+	if this.WFCnt != that1.WFCnt {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayConfigureFwdLimitReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_DevStatusAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DevStatusAns != nil {
-		{
-			size, err := m.DevStatusAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayConfigureFwdLimitReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfigureFwdLimitReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_NewChannelReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ResetLimitCounter != that1.ResetLimitCounter {
+		return false
+	}
+	if !this.JoinRequestLimits.Equal(that1.JoinRequestLimits) {
+		return false
+	}
+	if !this.NotifyLimits.Equal(that1.NotifyLimits) {
+		return false
+	}
+	if !this.GlobalUplinkLimits.Equal(that1.GlobalUplinkLimits) {
+		return false
+	}
+	if !this.OverallLimits.Equal(that1.OverallLimits) {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayConfigureFwdLimitAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_NewChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewChannelReq != nil {
-		{
-			size, err := m.NewChannelReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayConfigureFwdLimitAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfigureFwdLimitAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x62
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_NewChannelAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayNotifyNewEndDeviceReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_NewChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewChannelAns != nil {
-		{
-			size, err := m.NewChannelAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayNotifyNewEndDeviceReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayNotifyNewEndDeviceReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x6a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DLChannelReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if this.SNR != that1.SNR {
+		return false
+	}
+	if this.RSSI != that1.RSSI {
+		return false
+	}
+	return true
 }
+func (this *RelaySecondChannel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_DLChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DLChannelReq != nil {
-		{
-			size, err := m.DLChannelReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*RelaySecondChannel)
+	if !ok {
+		that2, ok := that.(RelaySecondChannel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x72
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DLChannelAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AckOffset != that1.AckOffset {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	return true
 }
+func (this *RelayForwardLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_DLChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DLChannelAns != nil {
-		{
-			size, err := m.DLChannelAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*RelayForwardLimits)
+	if !ok {
+		that2, ok := that.(RelayForwardLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x7a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RxTimingSetupReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BucketSize != that1.BucketSize {
+		return false
+	}
+	if this.ReloadRate != that1.ReloadRate {
+		return false
+	}
+	return true
 }
+func (this *DataRateIndexValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RxTimingSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RxTimingSetupReq != nil {
-		{
-			size, err := m.RxTimingSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*DataRateIndexValue)
+	if !ok {
+		that2, ok := that.(DataRateIndexValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_TxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *PingSlotPeriodValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_TxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.TxParamSetupReq != nil {
-		{
-			size, err := m.TxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*PingSlotPeriodValue)
+	if !ok {
+		that2, ok := that.(PingSlotPeriodValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RekeyInd_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *AggregatedDutyCycleValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RekeyInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RekeyInd != nil {
-		{
-			size, err := m.RekeyInd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*AggregatedDutyCycleValue)
+	if !ok {
+		that2, ok := that.(AggregatedDutyCycleValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RekeyConf_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *RxDelayValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RekeyConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RekeyConf != nil {
-		{
-			size, err := m.RekeyConf.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*RxDelayValue)
+	if !ok {
+		that2, ok := that.(RxDelayValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
-func (m *MACCommand_ADRParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ADRAckLimitExponentValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ADRAckLimitExponentValue)
+	if !ok {
+		that2, ok := that.(ADRAckLimitExponentValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *ADRAckDelayExponentValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_ADRParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ADRParamSetupReq != nil {
-		{
-			size, err := m.ADRParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*ADRAckDelayExponentValue)
+	if !ok {
+		that2, ok := that.(ADRAckDelayExponentValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
-func (m *MACCommand_DeviceTimeAns_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceTimeAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DeviceTimeAns != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
 		{
-			size, err := m.DeviceTimeAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
+	}
+	if len(m.MIC) > 0 {
+		i -= len(m.MIC)
+		copy(dAtA[i:], m.MIC)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.MIC)))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MHDR.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ForceRejoinReq_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Message_MACPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ForceRejoinReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ForceRejoinReq != nil {
+	if m.MACPayload != nil {
 		{
-			size, err := m.ForceRejoinReq.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MACPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9592,22 +10284,20 @@ func (m *MACCommand_ForceRejoinReq_) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RejoinParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.RejoinParamSetupReq != nil {
+	if m.JoinRequestPayload != nil {
 		{
-			size, err := m.RejoinParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9615,22 +10305,20 @@ func (m *MACCommand_RejoinParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RejoinParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.RejoinParamSetupAns != nil {
+	if m.JoinAcceptPayload != nil {
 		{
-			size, err := m.RejoinParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JoinAcceptPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9638,22 +10326,20 @@ func (m *MACCommand_RejoinParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_PingSlotInfoReq_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotInfoReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PingSlotInfoReq != nil {
+	if m.RejoinRequestPayload != nil {
 		{
-			size, err := m.PingSlotInfoReq.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RejoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9661,45 +10347,71 @@ func (m *MACCommand_PingSlotInfoReq_) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_PingSlotChannelReq_) MarshalTo(dAtA []byte) (int, error) {
+func (m *MHDR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MHDR) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PingSlotChannelReq != nil {
-		{
-			size, err := m.PingSlotChannelReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.Major != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Major))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x10
+	}
+	if m.MType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MType))
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_PingSlotChannelAns_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *MACPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PingSlotChannelAns != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.FullFCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FullFCnt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DecodedPayload != nil {
 		{
-			size, err := m.PingSlotChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9707,128 +10419,162 @@ func (m *MACCommand_PingSlotChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x22
+	}
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FRMPayload)))
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0x1a
 	}
+	if m.FPort != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FHDR.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_BeaconTimingAns_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *FHDR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FHDR) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconTimingAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.BeaconTimingAns != nil {
-		{
-			size, err := m.BeaconTimingAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if len(m.FOpts) > 0 {
+		i -= len(m.FOpts)
+		copy(dAtA[i:], m.FOpts)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FOpts)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x22
+	}
+	if m.FCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FCnt))
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FCtrl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_BeaconFreqReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACCommand_BeaconFreqReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BeaconFreqReq != nil {
-		{
-			size, err := m.BeaconFreqReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+func (m *FCtrl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *MACCommand_BeaconFreqAns_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *FCtrl) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconFreqAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FCtrl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.BeaconFreqAns != nil {
-		{
-			size, err := m.BeaconFreqAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.ClassB {
 		i--
-		dAtA[i] = 0x1
+		if m.ClassB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xf2
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DeviceModeInd_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_DeviceModeInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DeviceModeInd != nil {
-		{
-			size, err := m.DeviceModeInd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if m.FPending {
+		i--
+		if m.FPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x20
+	}
+	if m.Ack {
 		i--
-		dAtA[i] = 0xfa
+		if m.Ack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DeviceModeConf_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_DeviceModeConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DeviceModeConf != nil {
-		{
-			size, err := m.DeviceModeConf.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if m.ADRAckReq {
+		i--
+		if m.ADRAckReq {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x10
+	}
+	if m.ADR {
 		i--
-		dAtA[i] = 0x82
+		if m.ADR {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ResetInd) Marshal() (dAtA []byte, err error) {
+
+func (m *JoinRequestPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9838,25 +10584,50 @@ func (m *MACCommand_ResetInd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_ResetInd) MarshalTo(dAtA []byte) (int, error) {
+func (m *JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ResetInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.DevNonce.Size()
+		i -= size
+		if _, err := m.DevNonce.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_ResetConf) Marshal() (dAtA []byte, err error) {
+func (m *RejoinRequestPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9866,25 +10637,60 @@ func (m *MACCommand_ResetConf) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_ResetConf) MarshalTo(dAtA []byte) (int, error) {
+func (m *RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ResetConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+	if m.RejoinCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinCnt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetID.Size()
+		i -= size
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RejoinType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkCheckAns) Marshal() (dAtA []byte, err error) {
+func (m *JoinAcceptPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9894,86 +10700,84 @@ func (m *MACCommand_LinkCheckAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_LinkCheckAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_LinkCheckAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GatewayCount != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.GatewayCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Margin != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+	if m.CFList != nil {
+		{
+			size, err := m.CFList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_LinkADRReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x3a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_LinkADRReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_LinkADRReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NbTrans != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.NbTrans))
+	if m.RxDelay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RxDelay))
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.ChannelMaskControl != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelMaskControl))
-		i--
-		dAtA[i] = 0x28
+	{
+		size, err := m.DLSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if len(m.ChannelMask) > 0 {
-		for iNdEx := len(m.ChannelMask) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ChannelMask[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChannelMask)))
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if m.TxPowerIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.TxPowerIndex))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetID.Size()
+		i -= size
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.JoinNonce.Size()
+		i -= size
+		if _, err := m.JoinNonce.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Encrypted) > 0 {
+		i -= len(m.Encrypted)
+		copy(dAtA[i:], m.Encrypted)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.Encrypted)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkADRAns) Marshal() (dAtA []byte, err error) {
+func (m *DLSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9983,19 +10787,19 @@ func (m *MACCommand_LinkADRAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_LinkADRAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *DLSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_LinkADRAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DLSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxPowerIndexAck {
+	if m.OptNeg {
 		i--
-		if m.TxPowerIndexAck {
+		if m.OptNeg {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -10003,30 +10807,20 @@ func (m *MACCommand_LinkADRAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.DataRateIndexAck {
-		i--
-		if m.DataRateIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Rx2DR != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DR))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ChannelMaskAck {
-		i--
-		if m.ChannelMaskAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Rx1DROffset != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DROffset))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DutyCycleReq) Marshal() (dAtA []byte, err error) {
+func (m *CFList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10036,25 +10830,56 @@ func (m *MACCommand_DutyCycleReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DutyCycleReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *CFList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DutyCycleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CFList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDutyCycle != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDutyCycle))
+	if len(m.ChMasks) > 0 {
+		for iNdEx := len(m.ChMasks) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ChMasks[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChMasks)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Freq) > 0 {
+		dAtA12 := make([]byte, len(m.Freq)*10)
+		var j11 int
+		for _, num := range m.Freq {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintLorawan(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxParamSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *LoRaDataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10064,35 +10889,30 @@ func (m *MACCommand_RxParamSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *LoRaDataRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LoRaDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Rx2Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Rx1DataRateOffset != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateOffset))
+	if m.SpreadingFactor != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SpreadingFactor))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Rx2DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
+	if m.Bandwidth != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Bandwidth))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxParamSetupAns) Marshal() (dAtA []byte, err error) {
+func (m *FSKDataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10102,50 +10922,25 @@ func (m *MACCommand_RxParamSetupAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *FSKDataRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FSKDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Rx2FrequencyAck {
-		i--
-		if m.Rx2FrequencyAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Rx1DataRateOffsetAck {
-		i--
-		if m.Rx1DataRateOffsetAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Rx2DataRateIndexAck {
-		i--
-		if m.Rx2DataRateIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.BitRate != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.BitRate))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DevStatusAns) Marshal() (dAtA []byte, err error) {
+func (m *DataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10155,73 +10950,71 @@ func (m *MACCommand_DevStatusAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DevStatusAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DevStatusAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Margin != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Battery != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Battery))
-		i--
-		dAtA[i] = 0x8
+	if m.Modulation != nil {
+		{
+			size := m.Modulation.Size()
+			i -= size
+			if _, err := m.Modulation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_NewChannelReq) Marshal() (dAtA []byte, err error) {
+func (m *DataRate_LoRa) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataRate_LoRa) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LoRa != nil {
+		{
+			size, err := m.LoRa.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DataRate_FSK) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataRate_FSK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDataRateIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MinDataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinDataRateIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChannelIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+	if m.FSK != nil {
+		{
+			size, err := m.FSK.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_NewChannelAns) Marshal() (dAtA []byte, err error) {
+func (m *TxSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10231,73 +11024,84 @@ func (m *MACCommand_NewChannelAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_NewChannelAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DataRateAck {
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		if m.DataRateAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x42
+	}
+	if m.Time != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err16 != nil {
+			return 0, err16
 		}
+		i -= n16
+		i = encodeVarintLorawan(dAtA, i, uint64(n16))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x3a
 	}
-	if m.FrequencyAck {
+	if m.Timestamp != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
 		i--
-		if m.FrequencyAck {
+		dAtA[i] = 0x30
+	}
+	if m.EnableCRC {
+		i--
+		if m.EnableCRC {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_DLChannelReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_DLChannelReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_DLChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	if m.Frequency != 0 {
 		i = encodeVarintLorawan(dAtA, i, m.Frequency)
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.ChannelIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+	if len(m.CodingRate) > 0 {
+		i -= len(m.CodingRate)
+		copy(dAtA[i:], m.CodingRate)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.CodingRate)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DataRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DLChannelAns) Marshal() (dAtA []byte, err error) {
+func (m *TxSettings_Downlink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10307,40 +11111,41 @@ func (m *MACCommand_DLChannelAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DLChannelAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxSettings_Downlink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DLChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxSettings_Downlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FrequencyAck {
+	if m.InvertPolarization {
 		i--
-		if m.FrequencyAck {
+		if m.InvertPolarization {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.ChannelIndexAck {
+	if m.TxPower != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.TxPower)))
 		i--
-		if m.ChannelIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x15
+	}
+	if m.AntennaIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxTimingSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *GatewayAntennaIdentifiers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10350,25 +11155,35 @@ func (m *MACCommand_RxTimingSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxTimingSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *GatewayAntennaIdentifiers) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxTimingSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GatewayAntennaIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Delay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+	if m.AntennaIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_TxParamSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *UplinkToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10378,45 +11193,48 @@ func (m *MACCommand_TxParamSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_TxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *UplinkToken) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_TxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DownlinkDwellTime {
-		i--
-		if m.DownlinkDwellTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.ConcentratorTime != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ConcentratorTime))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if m.UplinkDwellTime {
-		i--
-		if m.UplinkDwellTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ServerTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintLorawan(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	if m.Timestamp != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.MaxEIRPIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxEIRPIndex))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.GatewayAntennaIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RekeyInd) Marshal() (dAtA []byte, err error) {
+func (m *DownlinkPath) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10426,86 +11244,66 @@ func (m *MACCommand_RekeyInd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RekeyInd) MarshalTo(dAtA []byte) (int, error) {
+func (m *DownlinkPath) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RekeyInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DownlinkPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_RekeyConf) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Path != nil {
+		{
+			size := m.Path.Size()
+			i -= size
+			if _, err := m.Path.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return dAtA[:n], nil
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RekeyConf) MarshalTo(dAtA []byte) (int, error) {
+func (m *DownlinkPath_UplinkToken) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RekeyConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DownlinkPath_UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+	if m.UplinkToken != nil {
+		i -= len(m.UplinkToken)
+		copy(dAtA[i:], m.UplinkToken)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.UplinkToken)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_ADRParamSetupReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_ADRParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *DownlinkPath_Fixed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ADRParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DownlinkPath_Fixed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ADRAckDelayExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckDelayExponent))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ADRAckLimitExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckLimitExponent))
+	if m.Fixed != nil {
+		{
+			size, err := m.Fixed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_DeviceTimeAns) Marshal() (dAtA []byte, err error) {
+func (m *TxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10515,71 +11313,100 @@ func (m *MACCommand_DeviceTimeAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DeviceTimeAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceTimeAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err54 != nil {
-		return 0, err54
+	if m.Advanced != nil {
+		{
+			size, err := m.Advanced.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	i -= n54
-	i = encodeVarintLorawan(dAtA, i, uint64(n54))
-	i--
-	dAtA[i] = 0x3a
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_ForceRejoinReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_ForceRejoinReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_ForceRejoinReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PeriodExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.PeriodExponent))
+	if m.AbsoluteTime != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintLorawan(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Rx2Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Rx2DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Rx1Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Rx1Frequency)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rx1DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateIndex))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.MaxRetries != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxRetries))
+	if m.Rx1Delay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1Delay))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
-		i--
-		dAtA[i] = 0x10
+	if len(m.DownlinkPaths) > 0 {
+		for iNdEx := len(m.DownlinkPaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DownlinkPaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLorawan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.RejoinType != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
+	if m.Class != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *MACCommand) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10589,3342 +11416,9340 @@ func (m *MACCommand_RejoinParamSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RejoinParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxTimeExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxTimeExponent))
-		i--
-		dAtA[i] = 0x10
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.MaxCountExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxCountExponent))
+	if m.CID != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.CID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupAns) Marshal() (dAtA []byte, err error) {
+func (m *MACCommand_RawPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RawPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RawPayload != nil {
+		i -= len(m.RawPayload)
+		copy(dAtA[i:], m.RawPayload)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.RawPayload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_ResetInd_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxTimeExponentAck {
-		i--
-		if m.MaxTimeExponentAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ResetInd != nil {
+		{
+			size, err := m.ResetInd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_PingSlotInfoReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_PingSlotInfoReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetConf_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Period != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Period))
+	if m.ResetConf != nil {
+		{
+			size, err := m.ResetConf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_PingSlotChannelReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_PingSlotChannelReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkCheckAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkCheckAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+	if m.LinkCheckAns != nil {
+		{
+			size, err := m.LinkCheckAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_PingSlotChannelAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_PingSlotChannelAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataRateIndexAck {
-		i--
-		if m.DataRateIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FrequencyAck {
-		i--
-		if m.FrequencyAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.LinkADRReq != nil {
+		{
+			size, err := m.LinkADRReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_BeaconTimingAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_BeaconTimingAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconTimingAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Delay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+	if m.LinkADRAns != nil {
+		{
+			size, err := m.LinkADRAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_BeaconFreqReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_BeaconFreqReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DutyCycleReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconFreqReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DutyCycleReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+	if m.DutyCycleReq != nil {
+		{
+			size, err := m.DutyCycleReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_BeaconFreqAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_BeaconFreqAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconFreqAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		i--
-		if m.FrequencyAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.RxParamSetupReq != nil {
+		{
+			size, err := m.RxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x4a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_DeviceModeInd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_DeviceModeInd) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Class != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+	if m.RxParamSetupAns != nil {
+		{
+			size, err := m.RxParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x52
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_DeviceModeConf) Marshal() (dAtA []byte, err error) {
+func (m *MACCommand_DevStatusAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeConf) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DevStatusAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DevStatusAns != nil {
+		{
+			size, err := m.DevStatusAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_NewChannelReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Class != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+	if m.NewChannelReq != nil {
+		{
+			size, err := m.NewChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x62
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *DataRateIndexValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DataRateIndexValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataRateIndexValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.NewChannelAns != nil {
+		{
+			size, err := m.NewChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x6a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *PingSlotPeriodValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PingSlotPeriodValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingSlotPeriodValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.DLChannelReq != nil {
+		{
+			size, err := m.DLChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x72
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *AggregatedDutyCycleValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AggregatedDutyCycleValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregatedDutyCycleValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.DLChannelAns != nil {
+		{
+			size, err := m.DLChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x7a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *RxDelayValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RxDelayValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RxTimingSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RxDelayValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RxTimingSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.RxTimingSetupReq != nil {
+		{
+			size, err := m.RxTimingSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ADRAckLimitExponentValue) Marshal() (dAtA []byte, err error) {
+func (m *MACCommand_TxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ADRAckLimitExponentValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_TxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TxParamSetupReq != nil {
+		{
+			size, err := m.TxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RekeyInd_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ADRAckLimitExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RekeyInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.RekeyInd != nil {
+		{
+			size, err := m.RekeyInd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ADRAckDelayExponentValue) Marshal() (dAtA []byte, err error) {
+func (m *MACCommand_RekeyConf_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ADRAckDelayExponentValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RekeyConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RekeyConf != nil {
+		{
+			size, err := m.RekeyConf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_ADRParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ADRAckDelayExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_ADRParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.ADRParamSetupReq != nil {
+		{
+			size, err := m.ADRParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MACCommand_DeviceTimeAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func encodeVarintLorawan(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLorawan(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedMHDR(r randyLorawan, easy bool) *MHDR {
-	this := &MHDR{}
-	this.MType = MType([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	this.Major = Major([]int32{0}[r.Intn(1)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_DeviceTimeAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeviceTimeAns != nil {
+		{
+			size, err := m.DeviceTimeAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedFCtrl(r randyLorawan, easy bool) *FCtrl {
-	this := &FCtrl{}
-	this.ADR = bool(r.Intn(2) == 0)
-	this.ADRAckReq = bool(r.Intn(2) == 0)
-	this.Ack = bool(r.Intn(2) == 0)
-	this.FPending = bool(r.Intn(2) == 0)
-	this.ClassB = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_ForceRejoinReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedLoRaDataRate(r randyLorawan, easy bool) *LoRaDataRate {
-	this := &LoRaDataRate{}
-	this.Bandwidth = r.Uint32()
-	this.SpreadingFactor = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_ForceRejoinReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ForceRejoinReq != nil {
+		{
+			size, err := m.ForceRejoinReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedFSKDataRate(r randyLorawan, easy bool) *FSKDataRate {
-	this := &FSKDataRate{}
-	this.BitRate = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RejoinParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDataRate(r randyLorawan, easy bool) *DataRate {
-	this := &DataRate{}
-	oneofNumber_Modulation := []int32{1, 2}[r.Intn(2)]
-	switch oneofNumber_Modulation {
-	case 1:
-		this.Modulation = NewPopulatedDataRate_LoRa(r, easy)
-	case 2:
-		this.Modulation = NewPopulatedDataRate_FSK(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RejoinParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RejoinParamSetupReq != nil {
+		{
+			size, err := m.RejoinParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	return this
-}
-
-func NewPopulatedDataRate_LoRa(r randyLorawan, easy bool) *DataRate_LoRa {
-	this := &DataRate_LoRa{}
-	this.LoRa = NewPopulatedLoRaDataRate(r, easy)
-	return this
-}
-func NewPopulatedDataRate_FSK(r randyLorawan, easy bool) *DataRate_FSK {
-	this := &DataRate_FSK{}
-	this.FSK = NewPopulatedFSKDataRate(r, easy)
-	return this
+	return len(dAtA) - i, nil
 }
-func NewPopulatedTxSettings_Downlink(r randyLorawan, easy bool) *TxSettings_Downlink {
-	this := &TxSettings_Downlink{}
-	this.AntennaIndex = r.Uint32()
-	this.TxPower = float32(r.Float32())
-	if r.Intn(2) == 0 {
-		this.TxPower *= -1
-	}
-	this.InvertPolarization = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RejoinParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedGatewayAntennaIdentifiers(r randyLorawan, easy bool) *GatewayAntennaIdentifiers {
-	this := &GatewayAntennaIdentifiers{}
-	v1 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v1
-	this.AntennaIndex = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RejoinParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RejoinParamSetupAns != nil {
+		{
+			size, err := m.RejoinParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedUplinkToken(r randyLorawan, easy bool) *UplinkToken {
-	this := &UplinkToken{}
-	v2 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
-	this.GatewayAntennaIdentifiers = *v2
-	this.Timestamp = r.Uint32()
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ServerTime = *v3
-	this.ConcentratorTime = r.Int63()
-	if r.Intn(2) == 0 {
-		this.ConcentratorTime *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_PingSlotInfoReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDownlinkPath(r randyLorawan, easy bool) *DownlinkPath {
-	this := &DownlinkPath{}
-	oneofNumber_Path := []int32{1, 2}[r.Intn(2)]
-	switch oneofNumber_Path {
-	case 1:
-		this.Path = NewPopulatedDownlinkPath_UplinkToken(r, easy)
-	case 2:
-		this.Path = NewPopulatedDownlinkPath_Fixed(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_PingSlotInfoReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PingSlotInfoReq != nil {
+		{
+			size, err := m.PingSlotInfoReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_PingSlotChannelReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDownlinkPath_UplinkToken(r randyLorawan, easy bool) *DownlinkPath_UplinkToken {
-	this := &DownlinkPath_UplinkToken{}
-	v4 := r.Intn(100)
-	this.UplinkToken = make([]byte, v4)
-	for i := 0; i < v4; i++ {
-		this.UplinkToken[i] = byte(r.Intn(256))
+func (m *MACCommand_PingSlotChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PingSlotChannelReq != nil {
+		{
+			size, err := m.PingSlotChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	return this
-}
-func NewPopulatedDownlinkPath_Fixed(r randyLorawan, easy bool) *DownlinkPath_Fixed {
-	this := &DownlinkPath_Fixed{}
-	this.Fixed = NewPopulatedGatewayAntennaIdentifiers(r, easy)
-	return this
+	return len(dAtA) - i, nil
 }
-func NewPopulatedMACCommand_LinkADRAns(r randyLorawan, easy bool) *MACCommand_LinkADRAns {
-	this := &MACCommand_LinkADRAns{}
-	this.ChannelMaskAck = bool(r.Intn(2) == 0)
-	this.DataRateIndexAck = bool(r.Intn(2) == 0)
-	this.TxPowerIndexAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_PingSlotChannelAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_DutyCycleReq(r randyLorawan, easy bool) *MACCommand_DutyCycleReq {
-	this := &MACCommand_DutyCycleReq{}
-	this.MaxDutyCycle = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_PingSlotChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PingSlotChannelAns != nil {
+		{
+			size, err := m.PingSlotChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_RxParamSetupAns(r randyLorawan, easy bool) *MACCommand_RxParamSetupAns {
-	this := &MACCommand_RxParamSetupAns{}
-	this.Rx2DataRateIndexAck = bool(r.Intn(2) == 0)
-	this.Rx1DataRateOffsetAck = bool(r.Intn(2) == 0)
-	this.Rx2FrequencyAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_BeaconTimingAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_NewChannelAns(r randyLorawan, easy bool) *MACCommand_NewChannelAns {
-	this := &MACCommand_NewChannelAns{}
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	this.DataRateAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_BeaconTimingAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconTimingAns != nil {
+		{
+			size, err := m.BeaconTimingAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_DLChannelAns(r randyLorawan, easy bool) *MACCommand_DLChannelAns {
-	this := &MACCommand_DLChannelAns{}
-	this.ChannelIndexAck = bool(r.Intn(2) == 0)
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_BeaconFreqReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_RxTimingSetupReq(r randyLorawan, easy bool) *MACCommand_RxTimingSetupReq {
-	this := &MACCommand_RxTimingSetupReq{}
-	this.Delay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_BeaconFreqReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconFreqReq != nil {
+		{
+			size, err := m.BeaconFreqReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_TxParamSetupReq(r randyLorawan, easy bool) *MACCommand_TxParamSetupReq {
-	this := &MACCommand_TxParamSetupReq{}
-	this.MaxEIRPIndex = DeviceEIRP([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.UplinkDwellTime = bool(r.Intn(2) == 0)
-	this.DownlinkDwellTime = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_BeaconFreqAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_RekeyInd(r randyLorawan, easy bool) *MACCommand_RekeyInd {
-	this := &MACCommand_RekeyInd{}
-	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_BeaconFreqAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconFreqAns != nil {
+		{
+			size, err := m.BeaconFreqAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_RekeyConf(r randyLorawan, easy bool) *MACCommand_RekeyConf {
-	this := &MACCommand_RekeyConf{}
-	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_DeviceModeInd_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_ADRParamSetupReq(r randyLorawan, easy bool) *MACCommand_ADRParamSetupReq {
-	this := &MACCommand_ADRParamSetupReq{}
-	this.ADRAckLimitExponent = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.ADRAckDelayExponent = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_DeviceModeInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeviceModeInd != nil {
+		{
+			size, err := m.DeviceModeInd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_DeviceTimeAns(r randyLorawan, easy bool) *MACCommand_DeviceTimeAns {
-	this := &MACCommand_DeviceTimeAns{}
-	v5 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v5
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_DeviceModeConf_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_RejoinParamSetupReq(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupReq {
-	this := &MACCommand_RejoinParamSetupReq{}
-	this.MaxCountExponent = RejoinCountExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.MaxTimeExponent = RejoinTimeExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_DeviceModeConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeviceModeConf != nil {
+		{
+			size, err := m.DeviceModeConf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_RejoinParamSetupAns(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupAns {
-	this := &MACCommand_RejoinParamSetupAns{}
-	this.MaxTimeExponentAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayConfReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_PingSlotInfoReq(r randyLorawan, easy bool) *MACCommand_PingSlotInfoReq {
-	this := &MACCommand_PingSlotInfoReq{}
-	this.Period = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayConfReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfReq != nil {
+		{
+			size, err := m.RelayConfReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_PingSlotChannelAns(r randyLorawan, easy bool) *MACCommand_PingSlotChannelAns {
-	this := &MACCommand_PingSlotChannelAns{}
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	this.DataRateIndexAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayConfAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_BeaconFreqAns(r randyLorawan, easy bool) *MACCommand_BeaconFreqAns {
-	this := &MACCommand_BeaconFreqAns{}
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayConfAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfAns != nil {
+		{
+			size, err := m.RelayConfAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_DeviceModeInd(r randyLorawan, easy bool) *MACCommand_DeviceModeInd {
-	this := &MACCommand_DeviceModeInd{}
-	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayEndDeviceConfReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_DeviceModeConf(r randyLorawan, easy bool) *MACCommand_DeviceModeConf {
-	this := &MACCommand_DeviceModeConf{}
-	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayEndDeviceConfReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayEndDeviceConfReq != nil {
+		{
+			size, err := m.RelayEndDeviceConfReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedDataRateIndexValue(r randyLorawan, easy bool) *DataRateIndexValue {
-	this := &DataRateIndexValue{}
-	this.Value = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayEndDeviceConfAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedPingSlotPeriodValue(r randyLorawan, easy bool) *PingSlotPeriodValue {
-	this := &PingSlotPeriodValue{}
-	this.Value = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayEndDeviceConfAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayEndDeviceConfAns != nil {
+		{
+			size, err := m.RelayEndDeviceConfAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayFilterListReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedAggregatedDutyCycleValue(r randyLorawan, easy bool) *AggregatedDutyCycleValue {
-	this := &AggregatedDutyCycleValue{}
-	this.Value = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayFilterListReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayFilterListReq != nil {
+		{
+			size, err := m.RelayFilterListReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayFilterListAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedRxDelayValue(r randyLorawan, easy bool) *RxDelayValue {
-	this := &RxDelayValue{}
-	this.Value = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayFilterListAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayFilterListAns != nil {
+		{
+			size, err := m.RelayFilterListAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayUpdateUplinkListReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedADRAckLimitExponentValue(r randyLorawan, easy bool) *ADRAckLimitExponentValue {
-	this := &ADRAckLimitExponentValue{}
-	this.Value = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayUpdateUplinkListReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayUpdateUplinkListReq != nil {
+		{
+			size, err := m.RelayUpdateUplinkListReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayUpdateUplinkListAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedADRAckDelayExponentValue(r randyLorawan, easy bool) *ADRAckDelayExponentValue {
-	this := &ADRAckDelayExponentValue{}
-	this.Value = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayUpdateUplinkListAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayUpdateUplinkListAns != nil {
+		{
+			size, err := m.RelayUpdateUplinkListAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-type randyLorawan interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *MACCommand_RelayConfigureFwdLimitReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneLorawan(r randyLorawan) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func (m *MACCommand_RelayConfigureFwdLimitReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfigureFwdLimitReq != nil {
+		{
+			size, err := m.RelayConfigureFwdLimitReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
 	}
-	return rune(ru + 61)
+	return len(dAtA) - i, nil
 }
-func randStringLorawan(r randyLorawan) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
-		tmps[i] = randUTF8RuneLorawan(r)
-	}
-	return string(tmps)
+func (m *MACCommand_RelayConfigureFwdLimitAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func randUnrecognizedLorawan(r randyLorawan, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+
+func (m *MACCommand_RelayConfigureFwdLimitAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfigureFwdLimitAns != nil {
+		{
+			size, err := m.RelayConfigureFwdLimitAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldLorawan(dAtA, r, fieldNumber, wire)
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
 	}
-	return dAtA
+	return len(dAtA) - i, nil
 }
-func randFieldLorawan(dAtA []byte, r randyLorawan, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		v7 := r.Int63()
-		if r.Intn(2) == 0 {
-			v7 *= -1
-		}
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(v7))
-	case 1:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+func (m *MACCommand_RelayNotifyNewEndDeviceReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayNotifyNewEndDeviceReq != nil {
+		{
+			size, err := m.RelayNotifyNewEndDeviceReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
-	default:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
 	}
-	return dAtA
+	return len(dAtA) - i, nil
 }
-func encodeVarintPopulateLorawan(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+func (m *MACCommand_RelayCtrlUplinkListReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RelayCtrlUplinkListReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayCtrlUplinkListReq != nil {
+		{
+			size, err := m.RelayCtrlUplinkListReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
 	}
-	var l int
-	_ = l
-	l = m.MHDR.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = len(m.MIC)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayCtrlUplinkListAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayCtrlUplinkListAns != nil {
+		{
+			size, err := m.RelayCtrlUplinkListAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
 	}
-	if m.Payload != nil {
-		n += m.Payload.Size()
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_ResetInd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Message_MACPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MACPayload != nil {
-		l = m.MACPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
+func (m *MACCommand_ResetInd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_JoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_ResetInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JoinRequestPayload != nil {
-		l = m.JoinRequestPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_JoinAcceptPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JoinAcceptPayload != nil {
-		l = m.JoinAcceptPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_ResetConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Message_RejoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RejoinRequestPayload != nil {
-		l = m.RejoinRequestPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
+
+func (m *MACCommand_ResetConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MHDR) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_ResetConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MType != 0 {
-		n += 1 + sovLorawan(uint64(m.MType))
-	}
-	if m.Major != 0 {
-		n += 1 + sovLorawan(uint64(m.Major))
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FHDR.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.FPort != 0 {
-		n += 1 + sovLorawan(uint64(m.FPort))
-	}
-	l = len(m.FRMPayload)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	if m.DecodedPayload != nil {
-		l = m.DecodedPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	if m.FullFCnt != 0 {
-		n += 1 + sovLorawan(uint64(m.FullFCnt))
+func (m *MACCommand_LinkCheckAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FHDR) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_LinkCheckAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_LinkCheckAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DevAddr.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.FCtrl.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.FCnt != 0 {
-		n += 1 + sovLorawan(uint64(m.FCnt))
+	if m.GatewayCount != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.GatewayCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.FOpts)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Margin != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FCtrl) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_LinkADRReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_LinkADRReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_LinkADRReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ADR {
-		n += 2
+	if m.NbTrans != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.NbTrans))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.ADRAckReq {
-		n += 2
+	if m.ChannelMaskControl != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelMaskControl))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Ack {
-		n += 2
+	if len(m.ChannelMask) > 0 {
+		for iNdEx := len(m.ChannelMask) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ChannelMask[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChannelMask)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.FPending {
-		n += 2
+	if m.TxPowerIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.TxPowerIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ClassB {
-		n += 2
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_LinkADRAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.JoinEUI.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevEUI.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevNonce.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RejoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RejoinType != 0 {
-		n += 1 + sovLorawan(uint64(m.RejoinType))
-	}
-	l = m.NetID.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.JoinEUI.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevEUI.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.RejoinCnt != 0 {
-		n += 1 + sovLorawan(uint64(m.RejoinCnt))
-	}
-	return n
+func (m *MACCommand_LinkADRAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JoinAcceptPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_LinkADRAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Encrypted)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	l = m.JoinNonce.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.NetID.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevAddr.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DLSettings.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.RxDelay != 0 {
-		n += 1 + sovLorawan(uint64(m.RxDelay))
+	if m.TxPowerIndexAck {
+		i--
+		if m.TxPowerIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CFList != nil {
-		l = m.CFList.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndexAck {
+		i--
+		if m.DataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ChannelMaskAck {
+		i--
+		if m.ChannelMaskAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DLSettings) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_DutyCycleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_DutyCycleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DutyCycleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Rx1DROffset != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1DROffset))
-	}
-	if m.Rx2DR != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx2DR))
-	}
-	if m.OptNeg {
-		n += 2
+	if m.MaxDutyCycle != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDutyCycle))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CFList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RxParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLorawan(uint64(m.Type))
+	if m.Rx2Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Freq) > 0 {
-		l = 0
-		for _, e := range m.Freq {
-			l += sovLorawan(uint64(e))
-		}
-		n += 1 + sovLorawan(uint64(l)) + l
+	if m.Rx1DataRateOffset != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateOffset))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ChMasks) > 0 {
-		n += 1 + sovLorawan(uint64(len(m.ChMasks))) + len(m.ChMasks)*1
+	if m.Rx2DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LoRaDataRate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RxParamSetupAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RxParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RxParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Bandwidth != 0 {
-		n += 1 + sovLorawan(uint64(m.Bandwidth))
+	if m.Rx2FrequencyAck {
+		i--
+		if m.Rx2FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.SpreadingFactor != 0 {
-		n += 1 + sovLorawan(uint64(m.SpreadingFactor))
+	if m.Rx1DataRateOffsetAck {
+		i--
+		if m.Rx1DataRateOffsetAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Rx2DataRateIndexAck {
+		i--
+		if m.Rx2DataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FSKDataRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BitRate != 0 {
-		n += 1 + sovLorawan(uint64(m.BitRate))
+func (m *MACCommand_DevStatusAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Modulation != nil {
-		n += m.Modulation.Size()
-	}
-	return n
+func (m *MACCommand_DevStatusAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataRate_LoRa) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_DevStatusAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LoRa != nil {
-		l = m.LoRa.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *DataRate_FSK) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Margin != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.FSK != nil {
-		l = m.FSK.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Battery != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Battery))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *TxSettings) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_NewChannelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_NewChannelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_NewChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DataRate.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	if m.MaxDataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDataRateIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.CodingRate)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MinDataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinDataRateIndex))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
-	}
-	if m.EnableCRC {
-		n += 2
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovLorawan(uint64(m.Timestamp))
-	}
-	if m.Time != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovLorawan(uint64(l))
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Downlink != nil {
-		l = m.Downlink.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TxSettings_Downlink) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_NewChannelAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_NewChannelAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_NewChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AntennaIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.AntennaIndex))
-	}
-	if m.TxPower != 0 {
-		n += 5
+	if m.DataRateAck {
+		i--
+		if m.DataRateAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.InvertPolarization {
-		n += 2
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GatewayAntennaIdentifiers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GatewayIdentifiers.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.AntennaIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+func (m *MACCommand_DLChannelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UplinkToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_DLChannelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DLChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.GatewayAntennaIdentifiers.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovLorawan(uint64(m.Timestamp))
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x10
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime)
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.ConcentratorTime != 0 {
-		n += 1 + sovLorawan(uint64(m.ConcentratorTime))
+	if m.ChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DownlinkPath) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Path != nil {
-		n += m.Path.Size()
+func (m *MACCommand_DLChannelAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DownlinkPath_UplinkToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_DLChannelAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DLChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UplinkToken != nil {
-		l = len(m.UplinkToken)
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ChannelIndexAck {
+		i--
+		if m.ChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *DownlinkPath_Fixed) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RxTimingSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RxTimingSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RxTimingSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Fixed != nil {
-		l = m.Fixed.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Delay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *TxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_TxParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_TxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_TxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Class != 0 {
-		n += 1 + sovLorawan(uint64(m.Class))
-	}
-	if len(m.DownlinkPaths) > 0 {
-		for _, e := range m.DownlinkPaths {
-			l = e.Size()
-			n += 1 + l + sovLorawan(uint64(l))
+	if m.DownlinkDwellTime {
+		i--
+		if m.DownlinkDwellTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Rx1Delay != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1Delay))
-	}
-	if m.Rx1DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1DataRateIndex))
-	}
-	if m.Rx1Frequency != 0 {
-		n += 1 + sovLorawan(m.Rx1Frequency)
-	}
-	if m.Rx2DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
-	}
-	if m.Rx2Frequency != 0 {
-		n += 1 + sovLorawan(m.Rx2Frequency)
-	}
-	if m.Priority != 0 {
-		n += 1 + sovLorawan(uint64(m.Priority))
-	}
-	if m.AbsoluteTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime)
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	l = len(m.FrequencyPlanID)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.UplinkDwellTime {
+		i--
+		if m.UplinkDwellTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Advanced != nil {
-		l = m.Advanced.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.MaxEIRPIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxEIRPIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CID != 0 {
-		n += 1 + sovLorawan(uint64(m.CID))
-	}
-	if m.Payload != nil {
-		n += m.Payload.Size()
+func (m *MACCommand_RekeyInd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RawPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RawPayload != nil {
-		l = len(m.RawPayload)
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
+func (m *MACCommand_RekeyInd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MACCommand_ResetInd_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_RekeyInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ResetInd != nil {
-		l = m.ResetInd.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ResetConf_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RekeyConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RekeyConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RekeyConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ResetConf != nil {
-		l = m.ResetConf.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_LinkCheckAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_ADRParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_ADRParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_ADRParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LinkCheckAns != nil {
-		l = m.LinkCheckAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ADRAckDelayExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckDelayExponent))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ADRAckLimitExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckLimitExponent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_LinkADRReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_DeviceTimeAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_DeviceTimeAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DeviceTimeAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LinkADRReq != nil {
-		l = m.LinkADRReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err67 != nil {
+		return 0, err67
 	}
-	return n
+	i -= n67
+	i = encodeVarintLorawan(dAtA, i, uint64(n67))
+	i--
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_LinkADRAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_ForceRejoinReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_ForceRejoinReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_ForceRejoinReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LinkADRAns != nil {
-		l = m.LinkADRAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.PeriodExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.PeriodExponent))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-func (m *MACCommand_DutyCycleReq_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxRetries != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.DutyCycleReq != nil {
-		l = m.DutyCycleReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.RejoinType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RxParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RejoinParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RejoinParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RejoinParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RxParamSetupReq != nil {
-		l = m.RxParamSetupReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MaxTimeExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxTimeExponent))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.MaxCountExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxCountExponent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RxParamSetupAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RejoinParamSetupAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RejoinParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RejoinParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RxParamSetupAns != nil {
-		l = m.RxParamSetupAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MaxTimeExponentAck {
+		i--
+		if m.MaxTimeExponentAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DevStatusAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_PingSlotInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_PingSlotInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_PingSlotInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DevStatusAns != nil {
-		l = m.DevStatusAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Period != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_NewChannelReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_PingSlotChannelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_PingSlotChannelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_PingSlotChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewChannelReq != nil {
-		l = m.NewChannelReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_NewChannelAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_PingSlotChannelAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_PingSlotChannelAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_PingSlotChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewChannelAns != nil {
-		l = m.NewChannelAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndexAck {
+		i--
+		if m.DataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DLChannelReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_BeaconTimingAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_BeaconTimingAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_BeaconTimingAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DLChannelReq != nil {
-		l = m.DLChannelReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Delay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DLChannelAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_BeaconFreqReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_BeaconFreqReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_BeaconFreqReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DLChannelAns != nil {
-		l = m.DLChannelAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RxTimingSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_BeaconFreqAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_BeaconFreqAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_BeaconFreqAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RxTimingSetupReq != nil {
-		l = m.RxTimingSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_TxParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxParamSetupReq != nil {
-		l = m.TxParamSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_DeviceModeInd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_RekeyInd_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_DeviceModeInd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DeviceModeInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RekeyInd != nil {
-		l = m.RekeyInd.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.Class != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RekeyConf_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_DeviceModeConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_DeviceModeConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DeviceModeConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RekeyConf != nil {
-		l = m.RekeyConf.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.Class != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ADRParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RelayConfReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayConfReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayConfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ADRParamSetupReq != nil {
-		l = m.ADRParamSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-func (m *MACCommand_DeviceTimeAns_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DefaultChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DefaultChannelIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.DeviceTimeAns != nil {
-		l = m.DeviceTimeAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.CADPeriodicity != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.CADPeriodicity))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ForceRejoinReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RelayConfAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayConfAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayConfAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ForceRejoinReq != nil {
-		l = m.ForceRejoinReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.CADPeriodicityAck {
+		i--
+		if m.CADPeriodicityAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-func (m *MACCommand_RejoinParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DefaultChannelIndexAck {
+		i--
+		if m.DefaultChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.RejoinParamSetupReq != nil {
-		l = m.RejoinParamSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannelIndexAck {
+		i--
+		if m.SecondChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-func (m *MACCommand_RejoinParamSetupAns_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SecondChannelDataRateIndexAck {
+		i--
+		if m.SecondChannelDataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.RejoinParamSetupAns != nil {
-		l = m.RejoinParamSetupAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannelAckOffsetAck {
+		i--
+		if m.SecondChannelAckOffsetAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.SecondChannelFrequencyAck {
+		i--
+		if m.SecondChannelFrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_PingSlotInfoReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RelayEndDeviceConfReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PingSlotInfoReq != nil {
-		l = m.PingSlotInfoReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-func (m *MACCommand_PingSlotChannelReq_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Backoff != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.PingSlotChannelReq != nil {
-		l = m.PingSlotChannelReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SmartEnableLevel != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SmartEnableLevel))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-func (m *MACCommand_PingSlotChannelAns_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PingSlotChannelAns != nil {
-		l = m.PingSlotChannelAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *MACCommand_BeaconTimingAns_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BeaconTimingAns != nil {
-		l = m.BeaconTimingAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.Mode != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_BeaconFreqReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BeaconFreqReq != nil {
-		l = m.BeaconFreqReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_RelayEndDeviceConfAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_BeaconFreqAns_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BeaconFreqAns != nil {
-		l = m.BeaconFreqAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
+
+func (m *MACCommand_RelayEndDeviceConfAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MACCommand_DeviceModeInd_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_RelayEndDeviceConfAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DeviceModeInd != nil {
-		l = m.DeviceModeInd.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *MACCommand_DeviceModeConf_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BackoffAck {
+		i--
+		if m.BackoffAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.DeviceModeConf != nil {
-		l = m.DeviceModeConf.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannelDataRateIndexAck {
+		i--
+		if m.SecondChannelDataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *MACCommand_ResetInd) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SecondChannelAckOffsetAck {
+		i--
+		if m.SecondChannelAckOffsetAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	if m.SecondChannelFrequencyAck {
+		i--
+		if m.SecondChannelFrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_ResetConf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
+func (m *MACCommand_RelayFilterListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_LinkCheckAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Margin != 0 {
-		n += 1 + sovLorawan(uint64(m.Margin))
-	}
-	if m.GatewayCount != 0 {
-		n += 1 + sovLorawan(uint64(m.GatewayCount))
-	}
-	return n
+func (m *MACCommand_RelayFilterListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_LinkADRReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayFilterListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
-	}
-	if m.TxPowerIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.TxPowerIndex))
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if len(m.ChannelMask) > 0 {
-		n += 1 + sovLorawan(uint64(len(m.ChannelMask))) + len(m.ChannelMask)*1
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if m.ChannelMaskControl != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelMaskControl))
+	i--
+	dAtA[i] = 0x1a
+	if m.Action != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.NbTrans != 0 {
-		n += 1 + sovLorawan(uint64(m.NbTrans))
+	if m.Index != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkADRAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChannelMaskAck {
-		n += 2
-	}
-	if m.DataRateIndexAck {
-		n += 2
-	}
-	if m.TxPowerIndexAck {
-		n += 2
+func (m *MACCommand_RelayFilterListAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DutyCycleReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxDutyCycle != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxDutyCycle))
-	}
-	return n
+func (m *MACCommand_RelayFilterListAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayFilterListAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Rx2DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
+	if m.CombinedRulesAck {
+		i--
+		if m.CombinedRulesAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Rx1DataRateOffset != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1DataRateOffset))
+	if m.ActionAck {
+		i--
+		if m.ActionAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Rx2Frequency != 0 {
-		n += 1 + sovLorawan(m.Rx2Frequency)
+	if m.IndexAck {
+		i--
+		if m.IndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxParamSetupAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Rx2DataRateIndexAck {
-		n += 2
-	}
-	if m.Rx1DataRateOffsetAck {
-		n += 2
-	}
-	if m.Rx2FrequencyAck {
-		n += 2
+func (m *MACCommand_RelayUpdateUplinkListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DevStatusAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Battery != 0 {
-		n += 1 + sovLorawan(uint64(m.Battery))
-	}
-	if m.Margin != 0 {
-		n += 1 + sovLorawan(uint64(m.Margin))
-	}
-	return n
+func (m *MACCommand_RelayUpdateUplinkListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayUpdateUplinkListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChannelIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	if m.RootWorSKey != nil {
+		{
+			size := m.RootWorSKey.Size()
+			i -= size
+			if _, err := m.RootWorSKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
+	if m.WFCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.WFCnt))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MinDataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.MinDataRateIndex))
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if m.MaxDataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxDataRateIndex))
+	i--
+	dAtA[i] = 0x1a
+	if m.ForwardLimits != nil {
+		{
+			size, err := m.ForwardLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.RuleIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RuleIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_NewChannelAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		n += 2
-	}
-	if m.DataRateAck {
-		n += 2
+func (m *MACCommand_RelayUpdateUplinkListAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DLChannelReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChannelIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelIndex))
-	}
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
-	}
-	return n
+func (m *MACCommand_RelayUpdateUplinkListAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DLChannelAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayUpdateUplinkListAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChannelIndexAck {
-		n += 2
-	}
-	if m.FrequencyAck {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxTimingSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Delay != 0 {
-		n += 1 + sovLorawan(uint64(m.Delay))
+func (m *MACCommand_RelayCtrlUplinkListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_TxParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayCtrlUplinkListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayCtrlUplinkListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxEIRPIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxEIRPIndex))
-	}
-	if m.UplinkDwellTime {
-		n += 2
+	if m.Action != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DownlinkDwellTime {
-		n += 2
+	if m.RuleIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RuleIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RekeyInd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
+func (m *MACCommand_RelayCtrlUplinkListAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RekeyConf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
-	}
-	return n
+func (m *MACCommand_RelayCtrlUplinkListAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ADRParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayCtrlUplinkListAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ADRAckLimitExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.ADRAckLimitExponent))
+	if m.WFCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.WFCnt))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ADRAckDelayExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.ADRAckDelayExponent))
+	if m.RuleIndexAck {
+		i--
+		if m.RuleIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DeviceTimeAns) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayConfigureFwdLimitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovLorawan(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_ForceRejoinReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RejoinType != 0 {
-		n += 1 + sovLorawan(uint64(m.RejoinType))
-	}
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
-	}
-	if m.MaxRetries != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxRetries))
-	}
-	if m.PeriodExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.PeriodExponent))
-	}
-	return n
+func (m *MACCommand_RelayConfigureFwdLimitReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayConfigureFwdLimitReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxCountExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxCountExponent))
+	if m.OverallLimits != nil {
+		{
+			size, err := m.OverallLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.MaxTimeExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxTimeExponent))
+	if m.GlobalUplinkLimits != nil {
+		{
+			size, err := m.GlobalUplinkLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.NotifyLimits != nil {
+		{
+			size, err := m.NotifyLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JoinRequestLimits != nil {
+		{
+			size, err := m.JoinRequestLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResetLimitCounter != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ResetLimitCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupAns) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayConfigureFwdLimitAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxTimeExponentAck {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_PingSlotInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Period != 0 {
-		n += 1 + sovLorawan(uint64(m.Period))
+	if m.RSSI != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RSSI))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.SNR != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SNR))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_PingSlotChannelReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelaySecondChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelaySecondChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelaySecondChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.AckOffset != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AckOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_PingSlotChannelAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		n += 2
-	}
-	if m.DataRateIndexAck {
-		n += 2
+func (m *RelayForwardLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_BeaconTimingAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RelayForwardLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayForwardLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Delay != 0 {
-		n += 1 + sovLorawan(uint64(m.Delay))
+	if m.ReloadRate != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ReloadRate))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ChannelIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	if m.BucketSize != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.BucketSize))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_BeaconFreqReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
+func (m *DataRateIndexValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_BeaconFreqAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		n += 2
-	}
-	return n
+func (m *DataRateIndexValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeInd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DataRateIndexValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Class != 0 {
-		n += 1 + sovLorawan(uint64(m.Class))
+	if m.Value != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DeviceModeConf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Class != 0 {
-		n += 1 + sovLorawan(uint64(m.Class))
+func (m *PingSlotPeriodValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataRateIndexValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PingSlotPeriodValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingSlotPeriodValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PingSlotPeriodValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+func (m *AggregatedDutyCycleValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregatedDutyCycleValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregatedDutyCycleValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedDutyCycleValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RxDelayValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RxDelayValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RxDelayValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RxDelayValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ADRAckLimitExponentValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ADRAckLimitExponentValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRAckLimitExponentValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRAckLimitExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ADRAckDelayExponentValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ADRAckDelayExponentValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRAckDelayExponentValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRAckDelayExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovLorawan(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLorawan(x uint64) (n int) {
-	return sovLorawan((x << 1) ^ uint64((int64(x) >> 63)))
+func encodeVarintLorawan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLorawan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (this *Message) String() string {
-	if this == nil {
-		return "nil"
+func NewPopulatedMHDR(r randyLorawan, easy bool) *MHDR {
+	this := &MHDR{}
+	this.MType = MType([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	this.Major = Major([]int32{0}[r.Intn(1)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message{`,
-		`MHDR:` + strings.Replace(strings.Replace(this.MHDR.String(), "MHDR", "MHDR", 1), `&`, ``, 1) + `,`,
-		`MIC:` + fmt.Sprintf("%v", this.MIC) + `,`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_MACPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedFCtrl(r randyLorawan, easy bool) *FCtrl {
+	this := &FCtrl{}
+	this.ADR = bool(r.Intn(2) == 0)
+	this.ADRAckReq = bool(r.Intn(2) == 0)
+	this.Ack = bool(r.Intn(2) == 0)
+	this.FPending = bool(r.Intn(2) == 0)
+	this.ClassB = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message_MACPayload{`,
-		`MACPayload:` + strings.Replace(fmt.Sprintf("%v", this.MACPayload), "MACPayload", "MACPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_JoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedLoRaDataRate(r randyLorawan, easy bool) *LoRaDataRate {
+	this := &LoRaDataRate{}
+	this.Bandwidth = r.Uint32()
+	this.SpreadingFactor = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message_JoinRequestPayload{`,
-		`JoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinRequestPayload), "JoinRequestPayload", "JoinRequestPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_JoinAcceptPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedFSKDataRate(r randyLorawan, easy bool) *FSKDataRate {
+	this := &FSKDataRate{}
+	this.BitRate = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message_JoinAcceptPayload{`,
-		`JoinAcceptPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinAcceptPayload), "JoinAcceptPayload", "JoinAcceptPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_RejoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDataRate(r randyLorawan, easy bool) *DataRate {
+	this := &DataRate{}
+	oneofNumber_Modulation := []int32{1, 2}[r.Intn(2)]
+	switch oneofNumber_Modulation {
+	case 1:
+		this.Modulation = NewPopulatedDataRate_LoRa(r, easy)
+	case 2:
+		this.Modulation = NewPopulatedDataRate_FSK(r, easy)
 	}
-	s := strings.Join([]string{`&Message_RejoinRequestPayload{`,
-		`RejoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.RejoinRequestPayload), "RejoinRequestPayload", "RejoinRequestPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *MHDR) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDataRate_LoRa(r randyLorawan, easy bool) *DataRate_LoRa {
+	this := &DataRate_LoRa{}
+	this.LoRa = NewPopulatedLoRaDataRate(r, easy)
+	return this
+}
+func NewPopulatedDataRate_FSK(r randyLorawan, easy bool) *DataRate_FSK {
+	this := &DataRate_FSK{}
+	this.FSK = NewPopulatedFSKDataRate(r, easy)
+	return this
+}
+func NewPopulatedTxSettings_Downlink(r randyLorawan, easy bool) *TxSettings_Downlink {
+	this := &TxSettings_Downlink{}
+	this.AntennaIndex = r.Uint32()
+	this.TxPower = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.TxPower *= -1
 	}
-	s := strings.Join([]string{`&MHDR{`,
-		`MType:` + fmt.Sprintf("%v", this.MType) + `,`,
-		`Major:` + fmt.Sprintf("%v", this.Major) + `,`,
-		`}`,
-	}, "")
-	return s
+	this.InvertPolarization = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *MACPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedGatewayAntennaIdentifiers(r randyLorawan, easy bool) *GatewayAntennaIdentifiers {
+	this := &GatewayAntennaIdentifiers{}
+	v1 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v1
+	this.AntennaIndex = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACPayload{`,
-		`FHDR:` + strings.Replace(strings.Replace(this.FHDR.String(), "FHDR", "FHDR", 1), `&`, ``, 1) + `,`,
-		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
-		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
-		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
-		`FullFCnt:` + fmt.Sprintf("%v", this.FullFCnt) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *FHDR) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedUplinkToken(r randyLorawan, easy bool) *UplinkToken {
+	this := &UplinkToken{}
+	v2 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
+	this.GatewayAntennaIdentifiers = *v2
+	this.Timestamp = r.Uint32()
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ServerTime = *v3
+	this.ConcentratorTime = r.Int63()
+	if r.Intn(2) == 0 {
+		this.ConcentratorTime *= -1
 	}
-	s := strings.Join([]string{`&FHDR{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`FCtrl:` + strings.Replace(strings.Replace(this.FCtrl.String(), "FCtrl", "FCtrl", 1), `&`, ``, 1) + `,`,
-		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
-		`FOpts:` + fmt.Sprintf("%v", this.FOpts) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FCtrl) String() string {
-	if this == nil {
-		return "nil"
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&FCtrl{`,
-		`ADR:` + fmt.Sprintf("%v", this.ADR) + `,`,
-		`ADRAckReq:` + fmt.Sprintf("%v", this.ADRAckReq) + `,`,
-		`Ack:` + fmt.Sprintf("%v", this.Ack) + `,`,
-		`FPending:` + fmt.Sprintf("%v", this.FPending) + `,`,
-		`ClassB:` + fmt.Sprintf("%v", this.ClassB) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *JoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDownlinkPath(r randyLorawan, easy bool) *DownlinkPath {
+	this := &DownlinkPath{}
+	oneofNumber_Path := []int32{1, 2}[r.Intn(2)]
+	switch oneofNumber_Path {
+	case 1:
+		this.Path = NewPopulatedDownlinkPath_UplinkToken(r, easy)
+	case 2:
+		this.Path = NewPopulatedDownlinkPath_Fixed(r, easy)
 	}
-	s := strings.Join([]string{`&JoinRequestPayload{`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
-		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RejoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&RejoinRequestPayload{`,
-		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
-		`RejoinCnt:` + fmt.Sprintf("%v", this.RejoinCnt) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *JoinAcceptPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDownlinkPath_UplinkToken(r randyLorawan, easy bool) *DownlinkPath_UplinkToken {
+	this := &DownlinkPath_UplinkToken{}
+	v4 := r.Intn(100)
+	this.UplinkToken = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.UplinkToken[i] = byte(r.Intn(256))
 	}
-	s := strings.Join([]string{`&JoinAcceptPayload{`,
-		`Encrypted:` + fmt.Sprintf("%v", this.Encrypted) + `,`,
-		`JoinNonce:` + fmt.Sprintf("%v", this.JoinNonce) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`DLSettings:` + strings.Replace(strings.Replace(this.DLSettings.String(), "DLSettings", "DLSettings", 1), `&`, ``, 1) + `,`,
-		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
-		`CFList:` + strings.Replace(this.CFList.String(), "CFList", "CFList", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DLSettings) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DLSettings{`,
-		`Rx1DROffset:` + fmt.Sprintf("%v", this.Rx1DROffset) + `,`,
-		`Rx2DR:` + fmt.Sprintf("%v", this.Rx2DR) + `,`,
-		`OptNeg:` + fmt.Sprintf("%v", this.OptNeg) + `,`,
-		`}`,
-	}, "")
-	return s
+func NewPopulatedDownlinkPath_Fixed(r randyLorawan, easy bool) *DownlinkPath_Fixed {
+	this := &DownlinkPath_Fixed{}
+	this.Fixed = NewPopulatedGatewayAntennaIdentifiers(r, easy)
+	return this
 }
-func (this *CFList) String() string {
-	if this == nil {
-		return "nil"
+func NewPopulatedMACCommand_LinkADRAns(r randyLorawan, easy bool) *MACCommand_LinkADRAns {
+	this := &MACCommand_LinkADRAns{}
+	this.ChannelMaskAck = bool(r.Intn(2) == 0)
+	this.DataRateIndexAck = bool(r.Intn(2) == 0)
+	this.TxPowerIndexAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&CFList{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Freq:` + fmt.Sprintf("%v", this.Freq) + `,`,
-		`ChMasks:` + fmt.Sprintf("%v", this.ChMasks) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *LoRaDataRate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DutyCycleReq(r randyLorawan, easy bool) *MACCommand_DutyCycleReq {
+	this := &MACCommand_DutyCycleReq{}
+	this.MaxDutyCycle = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&LoRaDataRate{`,
-		`Bandwidth:` + fmt.Sprintf("%v", this.Bandwidth) + `,`,
-		`SpreadingFactor:` + fmt.Sprintf("%v", this.SpreadingFactor) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *FSKDataRate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RxParamSetupAns(r randyLorawan, easy bool) *MACCommand_RxParamSetupAns {
+	this := &MACCommand_RxParamSetupAns{}
+	this.Rx2DataRateIndexAck = bool(r.Intn(2) == 0)
+	this.Rx1DataRateOffsetAck = bool(r.Intn(2) == 0)
+	this.Rx2FrequencyAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&FSKDataRate{`,
-		`BitRate:` + fmt.Sprintf("%v", this.BitRate) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DataRate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_NewChannelAns(r randyLorawan, easy bool) *MACCommand_NewChannelAns {
+	this := &MACCommand_NewChannelAns{}
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	this.DataRateAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DataRate{`,
-		`Modulation:` + fmt.Sprintf("%v", this.Modulation) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DataRate_LoRa) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DLChannelAns(r randyLorawan, easy bool) *MACCommand_DLChannelAns {
+	this := &MACCommand_DLChannelAns{}
+	this.ChannelIndexAck = bool(r.Intn(2) == 0)
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DataRate_LoRa{`,
-		`LoRa:` + strings.Replace(fmt.Sprintf("%v", this.LoRa), "LoRaDataRate", "LoRaDataRate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DataRate_FSK) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RxTimingSetupReq(r randyLorawan, easy bool) *MACCommand_RxTimingSetupReq {
+	this := &MACCommand_RxTimingSetupReq{}
+	this.Delay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DataRate_FSK{`,
-		`FSK:` + strings.Replace(fmt.Sprintf("%v", this.FSK), "FSKDataRate", "FSKDataRate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *TxSettings) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_TxParamSetupReq(r randyLorawan, easy bool) *MACCommand_TxParamSetupReq {
+	this := &MACCommand_TxParamSetupReq{}
+	this.MaxEIRPIndex = DeviceEIRP([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.UplinkDwellTime = bool(r.Intn(2) == 0)
+	this.DownlinkDwellTime = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&TxSettings{`,
-		`DataRate:` + strings.Replace(strings.Replace(this.DataRate.String(), "DataRate", "DataRate", 1), `&`, ``, 1) + `,`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`EnableCRC:` + fmt.Sprintf("%v", this.EnableCRC) + `,`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "TxSettings_Downlink", "TxSettings_Downlink", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *TxSettings_Downlink) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RekeyInd(r randyLorawan, easy bool) *MACCommand_RekeyInd {
+	this := &MACCommand_RekeyInd{}
+	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&TxSettings_Downlink{`,
-		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
-		`TxPower:` + fmt.Sprintf("%v", this.TxPower) + `,`,
-		`InvertPolarization:` + fmt.Sprintf("%v", this.InvertPolarization) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *GatewayAntennaIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RekeyConf(r randyLorawan, easy bool) *MACCommand_RekeyConf {
+	this := &MACCommand_RekeyConf{}
+	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&GatewayAntennaIdentifiers{`,
-		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
-		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *UplinkToken) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_ADRParamSetupReq(r randyLorawan, easy bool) *MACCommand_ADRParamSetupReq {
+	this := &MACCommand_ADRParamSetupReq{}
+	this.ADRAckLimitExponent = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.ADRAckDelayExponent = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&UplinkToken{`,
-		`GatewayAntennaIdentifiers:` + strings.Replace(strings.Replace(this.GatewayAntennaIdentifiers.String(), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1), `&`, ``, 1) + `,`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`ServerTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServerTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ConcentratorTime:` + fmt.Sprintf("%v", this.ConcentratorTime) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DownlinkPath) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DeviceTimeAns(r randyLorawan, easy bool) *MACCommand_DeviceTimeAns {
+	this := &MACCommand_DeviceTimeAns{}
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v5
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DownlinkPath{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DownlinkPath_UplinkToken) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RejoinParamSetupReq(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupReq {
+	this := &MACCommand_RejoinParamSetupReq{}
+	this.MaxCountExponent = RejoinCountExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.MaxTimeExponent = RejoinTimeExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DownlinkPath_UplinkToken{`,
-		`UplinkToken:` + fmt.Sprintf("%v", this.UplinkToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DownlinkPath_Fixed) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RejoinParamSetupAns(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupAns {
+	this := &MACCommand_RejoinParamSetupAns{}
+	this.MaxTimeExponentAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DownlinkPath_Fixed{`,
-		`Fixed:` + strings.Replace(fmt.Sprintf("%v", this.Fixed), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *TxRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_PingSlotInfoReq(r randyLorawan, easy bool) *MACCommand_PingSlotInfoReq {
+	this := &MACCommand_PingSlotInfoReq{}
+	this.Period = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForDownlinkPaths := "[]*DownlinkPath{"
-	for _, f := range this.DownlinkPaths {
-		repeatedStringForDownlinkPaths += strings.Replace(f.String(), "DownlinkPath", "DownlinkPath", 1) + ","
+	return this
+}
+
+func NewPopulatedMACCommand_PingSlotChannelAns(r randyLorawan, easy bool) *MACCommand_PingSlotChannelAns {
+	this := &MACCommand_PingSlotChannelAns{}
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	this.DataRateIndexAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForDownlinkPaths += "}"
-	s := strings.Join([]string{`&TxRequest{`,
-		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
-		`DownlinkPaths:` + repeatedStringForDownlinkPaths + `,`,
-		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
-		`Rx1DataRateIndex:` + fmt.Sprintf("%v", this.Rx1DataRateIndex) + `,`,
-		`Rx1Frequency:` + fmt.Sprintf("%v", this.Rx1Frequency) + `,`,
-		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
-		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
-		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
-		`AbsoluteTime:` + strings.Replace(fmt.Sprintf("%v", this.AbsoluteTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
-		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_BeaconFreqAns(r randyLorawan, easy bool) *MACCommand_BeaconFreqAns {
+	this := &MACCommand_BeaconFreqAns{}
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand{`,
-		`CID:` + fmt.Sprintf("%v", this.CID) + `,`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RawPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DeviceModeInd(r randyLorawan, easy bool) *MACCommand_DeviceModeInd {
+	this := &MACCommand_DeviceModeInd{}
+	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RawPayload{`,
-		`RawPayload:` + fmt.Sprintf("%v", this.RawPayload) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_ResetInd_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DeviceModeConf(r randyLorawan, easy bool) *MACCommand_DeviceModeConf {
+	this := &MACCommand_DeviceModeConf{}
+	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_ResetInd_{`,
-		`ResetInd:` + strings.Replace(fmt.Sprintf("%v", this.ResetInd), "MACCommand_ResetInd", "MACCommand_ResetInd", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_ResetConf_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayConfReq(r randyLorawan, easy bool) *MACCommand_RelayConfReq {
+	this := &MACCommand_RelayConfReq{}
+	this.Enable = bool(r.Intn(2) == 0)
+	this.CADPeriodicity = RelayCADPeriodicity([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.DefaultChannelIndex = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.SecondChannel = NewPopulatedRelaySecondChannel(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_ResetConf_{`,
-		`ResetConf:` + strings.Replace(fmt.Sprintf("%v", this.ResetConf), "MACCommand_ResetConf", "MACCommand_ResetConf", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *MACCommand_LinkCheckAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayConfAns(r randyLorawan, easy bool) *MACCommand_RelayConfAns {
+	this := &MACCommand_RelayConfAns{}
+	this.SecondChannelFrequencyAck = bool(r.Intn(2) == 0)
+	this.SecondChannelAckOffsetAck = bool(r.Intn(2) == 0)
+	this.SecondChannelDataRateIndexAck = bool(r.Intn(2) == 0)
+	this.SecondChannelIndexAck = bool(r.Intn(2) == 0)
+	this.DefaultChannelIndexAck = bool(r.Intn(2) == 0)
+	this.CADPeriodicityAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_LinkCheckAns_{`,
-		`LinkCheckAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkCheckAns), "MACCommand_LinkCheckAns", "MACCommand_LinkCheckAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_LinkADRReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayEndDeviceConfReq(r randyLorawan, easy bool) *MACCommand_RelayEndDeviceConfReq {
+	this := &MACCommand_RelayEndDeviceConfReq{}
+	this.Mode = RelayEndDeviceMode([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.SmartEnableLevel = RelaySmartEnableLevel([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.Backoff = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.SecondChannel = NewPopulatedRelaySecondChannel(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRReq_{`,
-		`LinkADRReq:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRReq), "MACCommand_LinkADRReq", "MACCommand_LinkADRReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *MACCommand_LinkADRAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayEndDeviceConfAns(r randyLorawan, easy bool) *MACCommand_RelayEndDeviceConfAns {
+	this := &MACCommand_RelayEndDeviceConfAns{}
+	this.SecondChannelFrequencyAck = bool(r.Intn(2) == 0)
+	this.SecondChannelAckOffsetAck = bool(r.Intn(2) == 0)
+	this.SecondChannelDataRateIndexAck = bool(r.Intn(2) == 0)
+	this.BackoffAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRAns_{`,
-		`LinkADRAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRAns), "MACCommand_LinkADRAns", "MACCommand_LinkADRAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DutyCycleReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayFilterListReq(r randyLorawan, easy bool) *MACCommand_RelayFilterListReq {
+	this := &MACCommand_RelayFilterListReq{}
+	this.Index = r.Uint32()
+	this.Action = RelayFilterListAction([]int32{0, 1, 2}[r.Intn(3)])
+	v6 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v6
+	v7 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v7
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_DutyCycleReq_{`,
-		`DutyCycleReq:` + strings.Replace(fmt.Sprintf("%v", this.DutyCycleReq), "MACCommand_DutyCycleReq", "MACCommand_DutyCycleReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RxParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayFilterListAns(r randyLorawan, easy bool) *MACCommand_RelayFilterListAns {
+	this := &MACCommand_RelayFilterListAns{}
+	this.IndexAck = bool(r.Intn(2) == 0)
+	this.ActionAck = bool(r.Intn(2) == 0)
+	this.CombinedRulesAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupReq_{`,
-		`RxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupReq), "MACCommand_RxParamSetupReq", "MACCommand_RxParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RxParamSetupAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayUpdateUplinkListReq(r randyLorawan, easy bool) *MACCommand_RelayUpdateUplinkListReq {
+	this := &MACCommand_RelayUpdateUplinkListReq{}
+	this.RuleIndex = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.ForwardLimits = NewPopulatedRelayForwardLimits(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupAns_{`,
-		`RxParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupAns), "MACCommand_RxParamSetupAns", "MACCommand_RxParamSetupAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	v8 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v8
+	this.WFCnt = r.Uint32()
+	this.RootWorSKey = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedAES128Key(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *MACCommand_DevStatusAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayUpdateUplinkListAns(r randyLorawan, easy bool) *MACCommand_RelayUpdateUplinkListAns {
+	this := &MACCommand_RelayUpdateUplinkListAns{}
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_DevStatusAns_{`,
-		`DevStatusAns:` + strings.Replace(fmt.Sprintf("%v", this.DevStatusAns), "MACCommand_DevStatusAns", "MACCommand_DevStatusAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_NewChannelReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayCtrlUplinkListReq(r randyLorawan, easy bool) *MACCommand_RelayCtrlUplinkListReq {
+	this := &MACCommand_RelayCtrlUplinkListReq{}
+	this.RuleIndex = r.Uint32()
+	this.Action = RelayCtrlUplinkListAction([]int32{0, 1}[r.Intn(2)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelReq_{`,
-		`NewChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelReq), "MACCommand_NewChannelReq", "MACCommand_NewChannelReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_NewChannelAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayCtrlUplinkListAns(r randyLorawan, easy bool) *MACCommand_RelayCtrlUplinkListAns {
+	this := &MACCommand_RelayCtrlUplinkListAns{}
+	this.RuleIndexAck = bool(r.Intn(2) == 0)
+	this.WFCnt = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelAns_{`,
-		`NewChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelAns), "MACCommand_NewChannelAns", "MACCommand_NewChannelAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DLChannelReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayConfigureFwdLimitReq(r randyLorawan, easy bool) *MACCommand_RelayConfigureFwdLimitReq {
+	this := &MACCommand_RelayConfigureFwdLimitReq{}
+	this.ResetLimitCounter = RelayResetLimitCounter([]int32{0, 1, 2, 3}[r.Intn(4)])
+	if r.Intn(5) != 0 {
+		this.JoinRequestLimits = NewPopulatedRelayForwardLimits(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelReq_{`,
-		`DLChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelReq), "MACCommand_DLChannelReq", "MACCommand_DLChannelReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DLChannelAns_) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.NotifyLimits = NewPopulatedRelayForwardLimits(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelAns_{`,
-		`DLChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelAns), "MACCommand_DLChannelAns", "MACCommand_DLChannelAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxTimingSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.GlobalUplinkLimits = NewPopulatedRelayForwardLimits(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq_{`,
-		`RxTimingSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxTimingSetupReq), "MACCommand_RxTimingSetupReq", "MACCommand_RxTimingSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_TxParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.OverallLimits = NewPopulatedRelayForwardLimits(r, easy)
 	}
-	s := strings.Join([]string{`&MACCommand_TxParamSetupReq_{`,
-		`TxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.TxParamSetupReq), "MACCommand_TxParamSetupReq", "MACCommand_TxParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RekeyInd_) String() string {
-	if this == nil {
-		return "nil"
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyInd_{`,
-		`RekeyInd:` + strings.Replace(fmt.Sprintf("%v", this.RekeyInd), "MACCommand_RekeyInd", "MACCommand_RekeyInd", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RekeyConf_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayConfigureFwdLimitAns(r randyLorawan, easy bool) *MACCommand_RelayConfigureFwdLimitAns {
+	this := &MACCommand_RelayConfigureFwdLimitAns{}
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyConf_{`,
-		`RekeyConf:` + strings.Replace(fmt.Sprintf("%v", this.RekeyConf), "MACCommand_RekeyConf", "MACCommand_RekeyConf", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_ADRParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayNotifyNewEndDeviceReq(r randyLorawan, easy bool) *MACCommand_RelayNotifyNewEndDeviceReq {
+	this := &MACCommand_RelayNotifyNewEndDeviceReq{}
+	v9 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v9
+	this.SNR = r.Int31()
+	if r.Intn(2) == 0 {
+		this.SNR *= -1
 	}
-	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq_{`,
-		`ADRParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.ADRParamSetupReq), "MACCommand_ADRParamSetupReq", "MACCommand_ADRParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DeviceTimeAns_) String() string {
-	if this == nil {
-		return "nil"
+	this.RSSI = r.Int31()
+	if r.Intn(2) == 0 {
+		this.RSSI *= -1
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceTimeAns_{`,
-		`DeviceTimeAns:` + strings.Replace(fmt.Sprintf("%v", this.DeviceTimeAns), "MACCommand_DeviceTimeAns", "MACCommand_DeviceTimeAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_ForceRejoinReq_) String() string {
-	if this == nil {
-		return "nil"
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_ForceRejoinReq_{`,
-		`ForceRejoinReq:` + strings.Replace(fmt.Sprintf("%v", this.ForceRejoinReq), "MACCommand_ForceRejoinReq", "MACCommand_ForceRejoinReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RejoinParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedRelaySecondChannel(r randyLorawan, easy bool) *RelaySecondChannel {
+	this := &RelaySecondChannel{}
+	this.AckOffset = RelaySecondChAckOffset([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.Frequency = uint64(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq_{`,
-		`RejoinParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupReq), "MACCommand_RejoinParamSetupReq", "MACCommand_RejoinParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RejoinParamSetupAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedRelayForwardLimits(r randyLorawan, easy bool) *RelayForwardLimits {
+	this := &RelayForwardLimits{}
+	this.BucketSize = RelayLimitBucketSize([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.ReloadRate = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns_{`,
-		`RejoinParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupAns), "MACCommand_RejoinParamSetupAns", "MACCommand_RejoinParamSetupAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_PingSlotInfoReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDataRateIndexValue(r randyLorawan, easy bool) *DataRateIndexValue {
+	this := &DataRateIndexValue{}
+	this.Value = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq_{`,
-		`PingSlotInfoReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotInfoReq), "MACCommand_PingSlotInfoReq", "MACCommand_PingSlotInfoReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_PingSlotChannelReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedPingSlotPeriodValue(r randyLorawan, easy bool) *PingSlotPeriodValue {
+	this := &PingSlotPeriodValue{}
+	this.Value = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq_{`,
-		`PingSlotChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelReq), "MACCommand_PingSlotChannelReq", "MACCommand_PingSlotChannelReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_PingSlotChannelAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedAggregatedDutyCycleValue(r randyLorawan, easy bool) *AggregatedDutyCycleValue {
+	this := &AggregatedDutyCycleValue{}
+	this.Value = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns_{`,
-		`PingSlotChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelAns), "MACCommand_PingSlotChannelAns", "MACCommand_PingSlotChannelAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_BeaconTimingAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedRxDelayValue(r randyLorawan, easy bool) *RxDelayValue {
+	this := &RxDelayValue{}
+	this.Value = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconTimingAns_{`,
-		`BeaconTimingAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconTimingAns), "MACCommand_BeaconTimingAns", "MACCommand_BeaconTimingAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_BeaconFreqReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRAckLimitExponentValue(r randyLorawan, easy bool) *ADRAckLimitExponentValue {
+	this := &ADRAckLimitExponentValue{}
+	this.Value = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqReq_{`,
-		`BeaconFreqReq:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqReq), "MACCommand_BeaconFreqReq", "MACCommand_BeaconFreqReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_BeaconFreqAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRAckDelayExponentValue(r randyLorawan, easy bool) *ADRAckDelayExponentValue {
+	this := &ADRAckDelayExponentValue{}
+	this.Value = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqAns_{`,
-		`BeaconFreqAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqAns), "MACCommand_BeaconFreqAns", "MACCommand_BeaconFreqAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DeviceModeInd_) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeInd_{`,
-		`DeviceModeInd:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeInd), "MACCommand_DeviceModeInd", "MACCommand_DeviceModeInd", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+type randyLorawan interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
 }
-func (this *MACCommand_DeviceModeConf_) String() string {
-	if this == nil {
-		return "nil"
+
+func randUTF8RuneLorawan(r randyLorawan) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeConf_{`,
-		`DeviceModeConf:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeConf), "MACCommand_DeviceModeConf", "MACCommand_DeviceModeConf", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return rune(ru + 61)
 }
-func (this *MACCommand_ResetInd) String() string {
-	if this == nil {
-		return "nil"
+func randStringLorawan(r randyLorawan) string {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
+		tmps[i] = randUTF8RuneLorawan(r)
 	}
-	s := strings.Join([]string{`&MACCommand_ResetInd{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return string(tmps)
 }
-func (this *MACCommand_ResetConf) String() string {
-	if this == nil {
-		return "nil"
+func randUnrecognizedLorawan(r randyLorawan, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldLorawan(dAtA, r, fieldNumber, wire)
 	}
-	s := strings.Join([]string{`&MACCommand_ResetConf{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA
 }
-func (this *MACCommand_LinkCheckAns) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MACCommand_LinkCheckAns{`,
-		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
-		`GatewayCount:` + fmt.Sprintf("%v", this.GatewayCount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_LinkADRReq) String() string {
-	if this == nil {
-		return "nil"
+func randFieldLorawan(dAtA []byte, r randyLorawan, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		v11 := r.Int63()
+		if r.Intn(2) == 0 {
+			v11 *= -1
+		}
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(v11))
+	case 1:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRReq{`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
-		`ChannelMask:` + fmt.Sprintf("%v", this.ChannelMask) + `,`,
-		`ChannelMaskControl:` + fmt.Sprintf("%v", this.ChannelMaskControl) + `,`,
-		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA
 }
-func (this *MACCommand_LinkADRAns) String() string {
-	if this == nil {
-		return "nil"
+func encodeVarintPopulateLorawan(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRAns{`,
-		`ChannelMaskAck:` + fmt.Sprintf("%v", this.ChannelMaskAck) + `,`,
-		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
-		`TxPowerIndexAck:` + fmt.Sprintf("%v", this.TxPowerIndexAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
 }
-func (this *MACCommand_DutyCycleReq) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DutyCycleReq{`,
-		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.MHDR.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = len(m.MIC)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupReq{`,
-		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
-		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
-		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxParamSetupAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.Payload != nil {
+		n += m.Payload.Size()
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupAns{`,
-		`Rx2DataRateIndexAck:` + fmt.Sprintf("%v", this.Rx2DataRateIndexAck) + `,`,
-		`Rx1DataRateOffsetAck:` + fmt.Sprintf("%v", this.Rx1DataRateOffsetAck) + `,`,
-		`Rx2FrequencyAck:` + fmt.Sprintf("%v", this.Rx2FrequencyAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_DevStatusAns) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Message_MACPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DevStatusAns{`,
-		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
-		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_NewChannelReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.MACPayload != nil {
+		l = m.MACPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelReq{`,
-		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
-		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_NewChannelAns) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message_JoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelAns{`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`DataRateAck:` + fmt.Sprintf("%v", this.DataRateAck) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DLChannelReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.JoinRequestPayload != nil {
+		l = m.JoinRequestPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelReq{`,
-		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_DLChannelAns) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message_JoinAcceptPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelAns{`,
-		`ChannelIndexAck:` + fmt.Sprintf("%v", this.ChannelIndexAck) + `,`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxTimingSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.JoinAcceptPayload != nil {
+		l = m.JoinAcceptPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq{`,
-		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_TxParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message_RejoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_TxParamSetupReq{`,
-		`MaxEIRPIndex:` + fmt.Sprintf("%v", this.MaxEIRPIndex) + `,`,
-		`UplinkDwellTime:` + fmt.Sprintf("%v", this.UplinkDwellTime) + `,`,
-		`DownlinkDwellTime:` + fmt.Sprintf("%v", this.DownlinkDwellTime) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RekeyInd) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.RejoinRequestPayload != nil {
+		l = m.RejoinRequestPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyInd{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_RekeyConf) String() string {
-	if this == nil {
-		return "nil"
+func (m *MHDR) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyConf{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_ADRParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.MType != 0 {
+		n += 1 + sovLorawan(uint64(m.MType))
 	}
-	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq{`,
-		`ADRAckLimitExponent:` + fmt.Sprintf("%v", this.ADRAckLimitExponent) + `,`,
-		`ADRAckDelayExponent:` + fmt.Sprintf("%v", this.ADRAckDelayExponent) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DeviceTimeAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.Major != 0 {
+		n += 1 + sovLorawan(uint64(m.Major))
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceTimeAns{`,
-		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_ForceRejoinReq) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MACCommand_ForceRejoinReq{`,
-		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
-		`PeriodExponent:` + fmt.Sprintf("%v", this.PeriodExponent) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RejoinParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MACPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq{`,
-		`MaxCountExponent:` + fmt.Sprintf("%v", this.MaxCountExponent) + `,`,
-		`MaxTimeExponent:` + fmt.Sprintf("%v", this.MaxTimeExponent) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RejoinParamSetupAns) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.FHDR.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.FPort != 0 {
+		n += 1 + sovLorawan(uint64(m.FPort))
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns{`,
-		`MaxTimeExponentAck:` + fmt.Sprintf("%v", this.MaxTimeExponentAck) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotInfoReq) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq{`,
-		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotChannelReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq{`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotChannelAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.FullFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.FullFCnt))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns{`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_BeaconTimingAns) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FHDR) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconTimingAns{`,
-		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
-		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_BeaconFreqReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.FCtrl.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.FCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.FCnt))
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqReq{`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_BeaconFreqAns) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.FOpts)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqAns{`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_DeviceModeInd) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FCtrl) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeInd{`,
-		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DeviceModeConf) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ADR {
+		n += 2
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeConf{`,
-		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DataRateIndexValue) String() string {
-	if this == nil {
-		return "nil"
+	if m.ADRAckReq {
+		n += 2
 	}
-	s := strings.Join([]string{`&DataRateIndexValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PingSlotPeriodValue) String() string {
-	if this == nil {
-		return "nil"
+	if m.Ack {
+		n += 2
 	}
-	s := strings.Join([]string{`&PingSlotPeriodValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AggregatedDutyCycleValue) String() string {
-	if this == nil {
-		return "nil"
+	if m.FPending {
+		n += 2
 	}
-	s := strings.Join([]string{`&AggregatedDutyCycleValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RxDelayValue) String() string {
-	if this == nil {
-		return "nil"
+	if m.ClassB {
+		n += 2
 	}
-	s := strings.Join([]string{`&RxDelayValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ADRAckLimitExponentValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ADRAckLimitExponentValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevNonce.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	return n
 }
-func (this *ADRAckDelayExponentValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RejoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ADRAckDelayExponentValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringLorawan(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	var l int
+	_ = l
+	if m.RejoinType != 0 {
+		n += 1 + sovLorawan(uint64(m.RejoinType))
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	l = m.NetID.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.RejoinCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.RejoinCnt))
+	}
+	return n
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+
+func (m *JoinAcceptPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encrypted)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	l = m.JoinNonce.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.NetID.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DLSettings.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.RxDelay != 0 {
+		n += 1 + sovLorawan(uint64(m.RxDelay))
+	}
+	if m.CFList != nil {
+		l = m.CFList.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *DLSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rx1DROffset != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1DROffset))
+	}
+	if m.Rx2DR != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx2DR))
+	}
+	if m.OptNeg {
+		n += 2
+	}
+	return n
+}
+
+func (m *CFList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLorawan(uint64(m.Type))
+	}
+	if len(m.Freq) > 0 {
+		l = 0
+		for _, e := range m.Freq {
+			l += sovLorawan(uint64(e))
+		}
+		n += 1 + sovLorawan(uint64(l)) + l
+	}
+	if len(m.ChMasks) > 0 {
+		n += 1 + sovLorawan(uint64(len(m.ChMasks))) + len(m.ChMasks)*1
+	}
+	return n
+}
+
+func (m *LoRaDataRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bandwidth != 0 {
+		n += 1 + sovLorawan(uint64(m.Bandwidth))
+	}
+	if m.SpreadingFactor != 0 {
+		n += 1 + sovLorawan(uint64(m.SpreadingFactor))
+	}
+	return n
+}
+
+func (m *FSKDataRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitRate != 0 {
+		n += 1 + sovLorawan(uint64(m.BitRate))
+	}
+	return n
+}
+
+func (m *DataRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Modulation != nil {
+		n += m.Modulation.Size()
+	}
+	return n
+}
+
+func (m *DataRate_LoRa) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LoRa != nil {
+		l = m.LoRa.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *DataRate_FSK) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FSK != nil {
+		l = m.FSK.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *TxSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DataRate.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	l = len(m.CodingRate)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.EnableCRC {
+		n += 2
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovLorawan(uint64(m.Timestamp))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *TxSettings_Downlink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AntennaIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+	}
+	if m.TxPower != 0 {
+		n += 5
+	}
+	if m.InvertPolarization {
+		n += 2
+	}
+	return n
+}
+
+func (m *GatewayAntennaIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.AntennaIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+	}
+	return n
+}
+
+func (m *UplinkToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayAntennaIdentifiers.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovLorawan(uint64(m.Timestamp))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime)
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.ConcentratorTime != 0 {
+		n += 1 + sovLorawan(uint64(m.ConcentratorTime))
+	}
+	return n
+}
+
+func (m *DownlinkPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Path != nil {
+		n += m.Path.Size()
+	}
+	return n
+}
+
+func (m *DownlinkPath_UplinkToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UplinkToken != nil {
+		l = len(m.UplinkToken)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *DownlinkPath_Fixed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fixed != nil {
+		l = m.Fixed.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *TxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Class != 0 {
+		n += 1 + sovLorawan(uint64(m.Class))
+	}
+	if len(m.DownlinkPaths) > 0 {
+		for _, e := range m.DownlinkPaths {
+			l = e.Size()
+			n += 1 + l + sovLorawan(uint64(l))
+		}
+	}
+	if m.Rx1Delay != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1Delay))
+	}
+	if m.Rx1DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1DataRateIndex))
+	}
+	if m.Rx1Frequency != 0 {
+		n += 1 + sovLorawan(m.Rx1Frequency)
+	}
+	if m.Rx2DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovLorawan(m.Rx2Frequency)
+	}
+	if m.Priority != 0 {
+		n += 1 + sovLorawan(uint64(m.Priority))
+	}
+	if m.AbsoluteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.Advanced != nil {
+		l = m.Advanced.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CID != 0 {
+		n += 1 + sovLorawan(uint64(m.CID))
+	}
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	return n
+}
+
+func (m *MACCommand_RawPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawPayload != nil {
+		l = len(m.RawPayload)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ResetInd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetInd != nil {
+		l = m.ResetInd.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ResetConf_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetConf != nil {
+		l = m.ResetConf.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_LinkCheckAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkCheckAns != nil {
+		l = m.LinkCheckAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_LinkADRReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkADRReq != nil {
+		l = m.LinkADRReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_LinkADRAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkADRAns != nil {
+		l = m.LinkADRAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DutyCycleReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DutyCycleReq != nil {
+		l = m.DutyCycleReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RxParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxParamSetupReq != nil {
+		l = m.RxParamSetupReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RxParamSetupAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxParamSetupAns != nil {
+		l = m.RxParamSetupAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DevStatusAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevStatusAns != nil {
+		l = m.DevStatusAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_NewChannelReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewChannelReq != nil {
+		l = m.NewChannelReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_NewChannelAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewChannelAns != nil {
+		l = m.NewChannelAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DLChannelReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DLChannelReq != nil {
+		l = m.DLChannelReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DLChannelAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DLChannelAns != nil {
+		l = m.DLChannelAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RxTimingSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxTimingSetupReq != nil {
+		l = m.RxTimingSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_TxParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxParamSetupReq != nil {
+		l = m.TxParamSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RekeyInd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RekeyInd != nil {
+		l = m.RekeyInd.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RekeyConf_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RekeyConf != nil {
+		l = m.RekeyConf.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ADRParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ADRParamSetupReq != nil {
+		l = m.ADRParamSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DeviceTimeAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceTimeAns != nil {
+		l = m.DeviceTimeAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ForceRejoinReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForceRejoinReq != nil {
+		l = m.ForceRejoinReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RejoinParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejoinParamSetupReq != nil {
+		l = m.RejoinParamSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RejoinParamSetupAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejoinParamSetupAns != nil {
+		l = m.RejoinParamSetupAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_PingSlotInfoReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingSlotInfoReq != nil {
+		l = m.PingSlotInfoReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_PingSlotChannelReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingSlotChannelReq != nil {
+		l = m.PingSlotChannelReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_PingSlotChannelAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingSlotChannelAns != nil {
+		l = m.PingSlotChannelAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_BeaconTimingAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconTimingAns != nil {
+		l = m.BeaconTimingAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_BeaconFreqReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconFreqReq != nil {
+		l = m.BeaconFreqReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_BeaconFreqAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconFreqAns != nil {
+		l = m.BeaconFreqAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DeviceModeInd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceModeInd != nil {
+		l = m.DeviceModeInd.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DeviceModeConf_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceModeConf != nil {
+		l = m.DeviceModeConf.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfReq != nil {
+		l = m.RelayConfReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfAns != nil {
+		l = m.RelayConfAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayEndDeviceConfReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayEndDeviceConfReq != nil {
+		l = m.RelayEndDeviceConfReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayEndDeviceConfAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayEndDeviceConfAns != nil {
+		l = m.RelayEndDeviceConfAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayFilterListReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayFilterListReq != nil {
+		l = m.RelayFilterListReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayFilterListAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayFilterListAns != nil {
+		l = m.RelayFilterListAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayUpdateUplinkListReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayUpdateUplinkListReq != nil {
+		l = m.RelayUpdateUplinkListReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayUpdateUplinkListAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayUpdateUplinkListAns != nil {
+		l = m.RelayUpdateUplinkListAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfigureFwdLimitReq != nil {
+		l = m.RelayConfigureFwdLimitReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfigureFwdLimitAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfigureFwdLimitAns != nil {
+		l = m.RelayConfigureFwdLimitAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayNotifyNewEndDeviceReq != nil {
+		l = m.RelayNotifyNewEndDeviceReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayCtrlUplinkListReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayCtrlUplinkListReq != nil {
+		l = m.RelayCtrlUplinkListReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayCtrlUplinkListAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayCtrlUplinkListAns != nil {
+		l = m.RelayCtrlUplinkListAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ResetInd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_ResetConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_LinkCheckAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Margin != 0 {
+		n += 1 + sovLorawan(uint64(m.Margin))
+	}
+	if m.GatewayCount != 0 {
+		n += 1 + sovLorawan(uint64(m.GatewayCount))
+	}
+	return n
+}
+
+func (m *MACCommand_LinkADRReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	if m.TxPowerIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.TxPowerIndex))
+	}
+	if len(m.ChannelMask) > 0 {
+		n += 1 + sovLorawan(uint64(len(m.ChannelMask))) + len(m.ChannelMask)*1
+	}
+	if m.ChannelMaskControl != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelMaskControl))
+	}
+	if m.NbTrans != 0 {
+		n += 1 + sovLorawan(uint64(m.NbTrans))
+	}
+	return n
+}
+
+func (m *MACCommand_LinkADRAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelMaskAck {
+		n += 2
+	}
+	if m.DataRateIndexAck {
+		n += 2
+	}
+	if m.TxPowerIndexAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DutyCycleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDutyCycle != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxDutyCycle))
+	}
+	return n
+}
+
+func (m *MACCommand_RxParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rx2DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
+	}
+	if m.Rx1DataRateOffset != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1DataRateOffset))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovLorawan(m.Rx2Frequency)
+	}
+	return n
+}
+
+func (m *MACCommand_RxParamSetupAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rx2DataRateIndexAck {
+		n += 2
+	}
+	if m.Rx1DataRateOffsetAck {
+		n += 2
+	}
+	if m.Rx2FrequencyAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DevStatusAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Battery != 0 {
+		n += 1 + sovLorawan(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovLorawan(uint64(m.Margin))
+	}
+	return n
+}
+
+func (m *MACCommand_NewChannelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.MinDataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.MinDataRateIndex))
+	}
+	if m.MaxDataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxDataRateIndex))
+	}
+	return n
+}
+
+func (m *MACCommand_NewChannelAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrequencyAck {
+		n += 2
+	}
+	if m.DataRateAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DLChannelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	return n
+}
+
+func (m *MACCommand_DLChannelAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelIndexAck {
+		n += 2
+	}
+	if m.FrequencyAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RxTimingSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delay != 0 {
+		n += 1 + sovLorawan(uint64(m.Delay))
+	}
+	return n
+}
+
+func (m *MACCommand_TxParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxEIRPIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxEIRPIndex))
+	}
+	if m.UplinkDwellTime {
+		n += 2
+	}
+	if m.DownlinkDwellTime {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RekeyInd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_RekeyConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_ADRParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ADRAckLimitExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.ADRAckLimitExponent))
+	}
+	if m.ADRAckDelayExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.ADRAckDelayExponent))
+	}
+	return n
+}
+
+func (m *MACCommand_DeviceTimeAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLorawan(uint64(l))
+	return n
+}
+
+func (m *MACCommand_ForceRejoinReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejoinType != 0 {
+		n += 1 + sovLorawan(uint64(m.RejoinType))
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxRetries))
+	}
+	if m.PeriodExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.PeriodExponent))
+	}
+	return n
+}
+
+func (m *MACCommand_RejoinParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCountExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxCountExponent))
+	}
+	if m.MaxTimeExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxTimeExponent))
+	}
+	return n
+}
+
+func (m *MACCommand_RejoinParamSetupAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTimeExponentAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_PingSlotInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovLorawan(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *MACCommand_PingSlotChannelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	return n
+}
+
+func (m *MACCommand_PingSlotChannelAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrequencyAck {
+		n += 2
+	}
+	if m.DataRateIndexAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_BeaconTimingAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delay != 0 {
+		n += 1 + sovLorawan(uint64(m.Delay))
+	}
+	if m.ChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	}
+	return n
+}
+
+func (m *MACCommand_BeaconFreqReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	return n
+}
+
+func (m *MACCommand_BeaconFreqAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrequencyAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DeviceModeInd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Class != 0 {
+		n += 1 + sovLorawan(uint64(m.Class))
+	}
+	return n
+}
+
+func (m *MACCommand_DeviceModeConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Class != 0 {
+		n += 1 + sovLorawan(uint64(m.Class))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	if m.CADPeriodicity != 0 {
+		n += 1 + sovLorawan(uint64(m.CADPeriodicity))
+	}
+	if m.DefaultChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DefaultChannelIndex))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecondChannelFrequencyAck {
+		n += 2
+	}
+	if m.SecondChannelAckOffsetAck {
+		n += 2
+	}
+	if m.SecondChannelDataRateIndexAck {
+		n += 2
+	}
+	if m.SecondChannelIndexAck {
+		n += 2
+	}
+	if m.DefaultChannelIndexAck {
+		n += 2
+	}
+	if m.CADPeriodicityAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovLorawan(uint64(m.Mode))
+	}
+	if m.SmartEnableLevel != 0 {
+		n += 1 + sovLorawan(uint64(m.SmartEnableLevel))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovLorawan(uint64(m.Backoff))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecondChannelFrequencyAck {
+		n += 2
+	}
+	if m.SecondChannelAckOffsetAck {
+		n += 2
+	}
+	if m.SecondChannelDataRateIndexAck {
+		n += 2
+	}
+	if m.BackoffAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RelayFilterListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovLorawan(uint64(m.Index))
+	}
+	if m.Action != 0 {
+		n += 1 + sovLorawan(uint64(m.Action))
+	}
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	return n
+}
+
+func (m *MACCommand_RelayFilterListAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexAck {
+		n += 2
+	}
+	if m.ActionAck {
+		n += 2
+	}
+	if m.CombinedRulesAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RelayUpdateUplinkListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RuleIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.RuleIndex))
+	}
+	if m.ForwardLimits != nil {
+		l = m.ForwardLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.WFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.WFCnt))
+	}
+	if m.RootWorSKey != nil {
+		l = m.RootWorSKey.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayUpdateUplinkListAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MACCommand_RelayCtrlUplinkListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RuleIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.RuleIndex))
+	}
+	if m.Action != 0 {
+		n += 1 + sovLorawan(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RuleIndexAck {
+		n += 2
+	}
+	if m.WFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.WFCnt))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetLimitCounter != 0 {
+		n += 1 + sovLorawan(uint64(m.ResetLimitCounter))
+	}
+	if m.JoinRequestLimits != nil {
+		l = m.JoinRequestLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.NotifyLimits != nil {
+		l = m.NotifyLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.GlobalUplinkLimits != nil {
+		l = m.GlobalUplinkLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.OverallLimits != nil {
+		l = m.OverallLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.SNR != 0 {
+		n += 1 + sovLorawan(uint64(m.SNR))
+	}
+	if m.RSSI != 0 {
+		n += 1 + sovLorawan(uint64(m.RSSI))
+	}
+	return n
+}
+
+func (m *RelaySecondChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckOffset != 0 {
+		n += 1 + sovLorawan(uint64(m.AckOffset))
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	return n
+}
+
+func (m *RelayForwardLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketSize != 0 {
+		n += 1 + sovLorawan(uint64(m.BucketSize))
+	}
+	if m.ReloadRate != 0 {
+		n += 1 + sovLorawan(uint64(m.ReloadRate))
+	}
+	return n
+}
+
+func (m *DataRateIndexValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *PingSlotPeriodValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AggregatedDutyCycleValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *RxDelayValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ADRAckLimitExponentValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ADRAckDelayExponentValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func sovLorawan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLorawan(x uint64) (n int) {
+	return sovLorawan((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *Message) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message{`,
+		`MHDR:` + strings.Replace(strings.Replace(this.MHDR.String(), "MHDR", "MHDR", 1), `&`, ``, 1) + `,`,
+		`MIC:` + fmt.Sprintf("%v", this.MIC) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_MACPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_MACPayload{`,
+		`MACPayload:` + strings.Replace(fmt.Sprintf("%v", this.MACPayload), "MACPayload", "MACPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_JoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_JoinRequestPayload{`,
+		`JoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinRequestPayload), "JoinRequestPayload", "JoinRequestPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_JoinAcceptPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_JoinAcceptPayload{`,
+		`JoinAcceptPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinAcceptPayload), "JoinAcceptPayload", "JoinAcceptPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_RejoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_RejoinRequestPayload{`,
+		`RejoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.RejoinRequestPayload), "RejoinRequestPayload", "RejoinRequestPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MHDR) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MHDR{`,
+		`MType:` + fmt.Sprintf("%v", this.MType) + `,`,
+		`Major:` + fmt.Sprintf("%v", this.Major) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACPayload{`,
+		`FHDR:` + strings.Replace(strings.Replace(this.FHDR.String(), "FHDR", "FHDR", 1), `&`, ``, 1) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`FullFCnt:` + fmt.Sprintf("%v", this.FullFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FHDR) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FHDR{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`FCtrl:` + strings.Replace(strings.Replace(this.FCtrl.String(), "FCtrl", "FCtrl", 1), `&`, ``, 1) + `,`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`FOpts:` + fmt.Sprintf("%v", this.FOpts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FCtrl) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FCtrl{`,
+		`ADR:` + fmt.Sprintf("%v", this.ADR) + `,`,
+		`ADRAckReq:` + fmt.Sprintf("%v", this.ADRAckReq) + `,`,
+		`Ack:` + fmt.Sprintf("%v", this.Ack) + `,`,
+		`FPending:` + fmt.Sprintf("%v", this.FPending) + `,`,
+		`ClassB:` + fmt.Sprintf("%v", this.ClassB) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinRequestPayload{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RejoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RejoinRequestPayload{`,
+		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`RejoinCnt:` + fmt.Sprintf("%v", this.RejoinCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinAcceptPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinAcceptPayload{`,
+		`Encrypted:` + fmt.Sprintf("%v", this.Encrypted) + `,`,
+		`JoinNonce:` + fmt.Sprintf("%v", this.JoinNonce) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`DLSettings:` + strings.Replace(strings.Replace(this.DLSettings.String(), "DLSettings", "DLSettings", 1), `&`, ``, 1) + `,`,
+		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
+		`CFList:` + strings.Replace(this.CFList.String(), "CFList", "CFList", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DLSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DLSettings{`,
+		`Rx1DROffset:` + fmt.Sprintf("%v", this.Rx1DROffset) + `,`,
+		`Rx2DR:` + fmt.Sprintf("%v", this.Rx2DR) + `,`,
+		`OptNeg:` + fmt.Sprintf("%v", this.OptNeg) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CFList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CFList{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Freq:` + fmt.Sprintf("%v", this.Freq) + `,`,
+		`ChMasks:` + fmt.Sprintf("%v", this.ChMasks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoRaDataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoRaDataRate{`,
+		`Bandwidth:` + fmt.Sprintf("%v", this.Bandwidth) + `,`,
+		`SpreadingFactor:` + fmt.Sprintf("%v", this.SpreadingFactor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FSKDataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FSKDataRate{`,
+		`BitRate:` + fmt.Sprintf("%v", this.BitRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate{`,
+		`Modulation:` + fmt.Sprintf("%v", this.Modulation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRate_LoRa) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate_LoRa{`,
+		`LoRa:` + strings.Replace(fmt.Sprintf("%v", this.LoRa), "LoRaDataRate", "LoRaDataRate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRate_FSK) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate_FSK{`,
+		`FSK:` + strings.Replace(fmt.Sprintf("%v", this.FSK), "FSKDataRate", "FSKDataRate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxSettings{`,
+		`DataRate:` + strings.Replace(strings.Replace(this.DataRate.String(), "DataRate", "DataRate", 1), `&`, ``, 1) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`EnableCRC:` + fmt.Sprintf("%v", this.EnableCRC) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "TxSettings_Downlink", "TxSettings_Downlink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxSettings_Downlink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxSettings_Downlink{`,
+		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
+		`TxPower:` + fmt.Sprintf("%v", this.TxPower) + `,`,
+		`InvertPolarization:` + fmt.Sprintf("%v", this.InvertPolarization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayAntennaIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAntennaIdentifiers{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UplinkToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UplinkToken{`,
+		`GatewayAntennaIdentifiers:` + strings.Replace(strings.Replace(this.GatewayAntennaIdentifiers.String(), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ServerTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServerTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ConcentratorTime:` + fmt.Sprintf("%v", this.ConcentratorTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPath) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPath{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPath_UplinkToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPath_UplinkToken{`,
+		`UplinkToken:` + fmt.Sprintf("%v", this.UplinkToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPath_Fixed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPath_Fixed{`,
+		`Fixed:` + strings.Replace(fmt.Sprintf("%v", this.Fixed), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDownlinkPaths := "[]*DownlinkPath{"
+	for _, f := range this.DownlinkPaths {
+		repeatedStringForDownlinkPaths += strings.Replace(f.String(), "DownlinkPath", "DownlinkPath", 1) + ","
+	}
+	repeatedStringForDownlinkPaths += "}"
+	s := strings.Join([]string{`&TxRequest{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`DownlinkPaths:` + repeatedStringForDownlinkPaths + `,`,
+		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
+		`Rx1DataRateIndex:` + fmt.Sprintf("%v", this.Rx1DataRateIndex) + `,`,
+		`Rx1Frequency:` + fmt.Sprintf("%v", this.Rx1Frequency) + `,`,
+		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`AbsoluteTime:` + strings.Replace(fmt.Sprintf("%v", this.AbsoluteTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand{`,
+		`CID:` + fmt.Sprintf("%v", this.CID) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RawPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RawPayload{`,
+		`RawPayload:` + fmt.Sprintf("%v", this.RawPayload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetInd_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetInd_{`,
+		`ResetInd:` + strings.Replace(fmt.Sprintf("%v", this.ResetInd), "MACCommand_ResetInd", "MACCommand_ResetInd", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetConf_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetConf_{`,
+		`ResetConf:` + strings.Replace(fmt.Sprintf("%v", this.ResetConf), "MACCommand_ResetConf", "MACCommand_ResetConf", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkCheckAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkCheckAns_{`,
+		`LinkCheckAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkCheckAns), "MACCommand_LinkCheckAns", "MACCommand_LinkCheckAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRReq_{`,
+		`LinkADRReq:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRReq), "MACCommand_LinkADRReq", "MACCommand_LinkADRReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRAns_{`,
+		`LinkADRAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRAns), "MACCommand_LinkADRAns", "MACCommand_LinkADRAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DutyCycleReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DutyCycleReq_{`,
+		`DutyCycleReq:` + strings.Replace(fmt.Sprintf("%v", this.DutyCycleReq), "MACCommand_DutyCycleReq", "MACCommand_DutyCycleReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupReq_{`,
+		`RxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupReq), "MACCommand_RxParamSetupReq", "MACCommand_RxParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupAns_{`,
+		`RxParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupAns), "MACCommand_RxParamSetupAns", "MACCommand_RxParamSetupAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DevStatusAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DevStatusAns_{`,
+		`DevStatusAns:` + strings.Replace(fmt.Sprintf("%v", this.DevStatusAns), "MACCommand_DevStatusAns", "MACCommand_DevStatusAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelReq_{`,
+		`NewChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelReq), "MACCommand_NewChannelReq", "MACCommand_NewChannelReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelAns_{`,
+		`NewChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelAns), "MACCommand_NewChannelAns", "MACCommand_NewChannelAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelReq_{`,
+		`DLChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelReq), "MACCommand_DLChannelReq", "MACCommand_DLChannelReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelAns_{`,
+		`DLChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelAns), "MACCommand_DLChannelAns", "MACCommand_DLChannelAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxTimingSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq_{`,
+		`RxTimingSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxTimingSetupReq), "MACCommand_RxTimingSetupReq", "MACCommand_RxTimingSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_TxParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_TxParamSetupReq_{`,
+		`TxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.TxParamSetupReq), "MACCommand_TxParamSetupReq", "MACCommand_TxParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyInd_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyInd_{`,
+		`RekeyInd:` + strings.Replace(fmt.Sprintf("%v", this.RekeyInd), "MACCommand_RekeyInd", "MACCommand_RekeyInd", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyConf_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyConf_{`,
+		`RekeyConf:` + strings.Replace(fmt.Sprintf("%v", this.RekeyConf), "MACCommand_RekeyConf", "MACCommand_RekeyConf", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ADRParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq_{`,
+		`ADRParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.ADRParamSetupReq), "MACCommand_ADRParamSetupReq", "MACCommand_ADRParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceTimeAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceTimeAns_{`,
+		`DeviceTimeAns:` + strings.Replace(fmt.Sprintf("%v", this.DeviceTimeAns), "MACCommand_DeviceTimeAns", "MACCommand_DeviceTimeAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ForceRejoinReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ForceRejoinReq_{`,
+		`ForceRejoinReq:` + strings.Replace(fmt.Sprintf("%v", this.ForceRejoinReq), "MACCommand_ForceRejoinReq", "MACCommand_ForceRejoinReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq_{`,
+		`RejoinParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupReq), "MACCommand_RejoinParamSetupReq", "MACCommand_RejoinParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns_{`,
+		`RejoinParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupAns), "MACCommand_RejoinParamSetupAns", "MACCommand_RejoinParamSetupAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotInfoReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq_{`,
+		`PingSlotInfoReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotInfoReq), "MACCommand_PingSlotInfoReq", "MACCommand_PingSlotInfoReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq_{`,
+		`PingSlotChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelReq), "MACCommand_PingSlotChannelReq", "MACCommand_PingSlotChannelReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns_{`,
+		`PingSlotChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelAns), "MACCommand_PingSlotChannelAns", "MACCommand_PingSlotChannelAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconTimingAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconTimingAns_{`,
+		`BeaconTimingAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconTimingAns), "MACCommand_BeaconTimingAns", "MACCommand_BeaconTimingAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqReq_{`,
+		`BeaconFreqReq:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqReq), "MACCommand_BeaconFreqReq", "MACCommand_BeaconFreqReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqAns_{`,
+		`BeaconFreqAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqAns), "MACCommand_BeaconFreqAns", "MACCommand_BeaconFreqAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeInd_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeInd_{`,
+		`DeviceModeInd:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeInd), "MACCommand_DeviceModeInd", "MACCommand_DeviceModeInd", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeConf_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeConf_{`,
+		`DeviceModeConf:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeConf), "MACCommand_DeviceModeConf", "MACCommand_DeviceModeConf", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq_{`,
+		`RelayConfReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfReq), "MACCommand_RelayConfReq", "MACCommand_RelayConfReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfAns_{`,
+		`RelayConfAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfAns), "MACCommand_RelayConfAns", "MACCommand_RelayConfAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_{`,
+		`RelayEndDeviceConfReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayEndDeviceConfReq), "MACCommand_RelayEndDeviceConfReq", "MACCommand_RelayEndDeviceConfReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfAns_{`,
+		`RelayEndDeviceConfAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayEndDeviceConfAns), "MACCommand_RelayEndDeviceConfAns", "MACCommand_RelayEndDeviceConfAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListReq_{`,
+		`RelayFilterListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayFilterListReq), "MACCommand_RelayFilterListReq", "MACCommand_RelayFilterListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListAns_{`,
+		`RelayFilterListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayFilterListAns), "MACCommand_RelayFilterListAns", "MACCommand_RelayFilterListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListReq_{`,
+		`RelayUpdateUplinkListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayUpdateUplinkListReq), "MACCommand_RelayUpdateUplinkListReq", "MACCommand_RelayUpdateUplinkListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListAns_{`,
+		`RelayUpdateUplinkListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayUpdateUplinkListAns), "MACCommand_RelayUpdateUplinkListAns", "MACCommand_RelayUpdateUplinkListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfigureFwdLimitReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfigureFwdLimitReq_{`,
+		`RelayConfigureFwdLimitReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfigureFwdLimitReq), "MACCommand_RelayConfigureFwdLimitReq", "MACCommand_RelayConfigureFwdLimitReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfigureFwdLimitAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfigureFwdLimitAns_{`,
+		`RelayConfigureFwdLimitAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfigureFwdLimitAns), "MACCommand_RelayConfigureFwdLimitAns", "MACCommand_RelayConfigureFwdLimitAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayNotifyNewEndDeviceReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayNotifyNewEndDeviceReq_{`,
+		`RelayNotifyNewEndDeviceReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayNotifyNewEndDeviceReq), "MACCommand_RelayNotifyNewEndDeviceReq", "MACCommand_RelayNotifyNewEndDeviceReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListReq_{`,
+		`RelayCtrlUplinkListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayCtrlUplinkListReq), "MACCommand_RelayCtrlUplinkListReq", "MACCommand_RelayCtrlUplinkListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListAns_{`,
+		`RelayCtrlUplinkListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayCtrlUplinkListAns), "MACCommand_RelayCtrlUplinkListAns", "MACCommand_RelayCtrlUplinkListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetInd) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetInd{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetConf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetConf{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkCheckAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkCheckAns{`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`GatewayCount:` + fmt.Sprintf("%v", this.GatewayCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRReq{`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
+		`ChannelMask:` + fmt.Sprintf("%v", this.ChannelMask) + `,`,
+		`ChannelMaskControl:` + fmt.Sprintf("%v", this.ChannelMaskControl) + `,`,
+		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRAns{`,
+		`ChannelMaskAck:` + fmt.Sprintf("%v", this.ChannelMaskAck) + `,`,
+		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
+		`TxPowerIndexAck:` + fmt.Sprintf("%v", this.TxPowerIndexAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DutyCycleReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DutyCycleReq{`,
+		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupReq{`,
+		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
+		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupAns{`,
+		`Rx2DataRateIndexAck:` + fmt.Sprintf("%v", this.Rx2DataRateIndexAck) + `,`,
+		`Rx1DataRateOffsetAck:` + fmt.Sprintf("%v", this.Rx1DataRateOffsetAck) + `,`,
+		`Rx2FrequencyAck:` + fmt.Sprintf("%v", this.Rx2FrequencyAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DevStatusAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DevStatusAns{`,
+		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelReq{`,
+		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
+		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelAns{`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`DataRateAck:` + fmt.Sprintf("%v", this.DataRateAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelReq{`,
+		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelAns{`,
+		`ChannelIndexAck:` + fmt.Sprintf("%v", this.ChannelIndexAck) + `,`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxTimingSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq{`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_TxParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_TxParamSetupReq{`,
+		`MaxEIRPIndex:` + fmt.Sprintf("%v", this.MaxEIRPIndex) + `,`,
+		`UplinkDwellTime:` + fmt.Sprintf("%v", this.UplinkDwellTime) + `,`,
+		`DownlinkDwellTime:` + fmt.Sprintf("%v", this.DownlinkDwellTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyInd) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyInd{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyConf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyConf{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ADRParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq{`,
+		`ADRAckLimitExponent:` + fmt.Sprintf("%v", this.ADRAckLimitExponent) + `,`,
+		`ADRAckDelayExponent:` + fmt.Sprintf("%v", this.ADRAckDelayExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceTimeAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceTimeAns{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ForceRejoinReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ForceRejoinReq{`,
+		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
+		`PeriodExponent:` + fmt.Sprintf("%v", this.PeriodExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq{`,
+		`MaxCountExponent:` + fmt.Sprintf("%v", this.MaxCountExponent) + `,`,
+		`MaxTimeExponent:` + fmt.Sprintf("%v", this.MaxTimeExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns{`,
+		`MaxTimeExponentAck:` + fmt.Sprintf("%v", this.MaxTimeExponentAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotInfoReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq{`,
+		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns{`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconTimingAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconTimingAns{`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqReq{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqAns{`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeInd) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeInd{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeConf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeConf{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq{`,
+		`Enable:` + fmt.Sprintf("%v", this.Enable) + `,`,
+		`CADPeriodicity:` + fmt.Sprintf("%v", this.CADPeriodicity) + `,`,
+		`DefaultChannelIndex:` + fmt.Sprintf("%v", this.DefaultChannelIndex) + `,`,
+		`SecondChannel:` + strings.Replace(this.SecondChannel.String(), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfAns{`,
+		`SecondChannelFrequencyAck:` + fmt.Sprintf("%v", this.SecondChannelFrequencyAck) + `,`,
+		`SecondChannelAckOffsetAck:` + fmt.Sprintf("%v", this.SecondChannelAckOffsetAck) + `,`,
+		`SecondChannelDataRateIndexAck:` + fmt.Sprintf("%v", this.SecondChannelDataRateIndexAck) + `,`,
+		`SecondChannelIndexAck:` + fmt.Sprintf("%v", this.SecondChannelIndexAck) + `,`,
+		`DefaultChannelIndexAck:` + fmt.Sprintf("%v", this.DefaultChannelIndexAck) + `,`,
+		`CADPeriodicityAck:` + fmt.Sprintf("%v", this.CADPeriodicityAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`SmartEnableLevel:` + fmt.Sprintf("%v", this.SmartEnableLevel) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`SecondChannel:` + strings.Replace(this.SecondChannel.String(), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfAns{`,
+		`SecondChannelFrequencyAck:` + fmt.Sprintf("%v", this.SecondChannelFrequencyAck) + `,`,
+		`SecondChannelAckOffsetAck:` + fmt.Sprintf("%v", this.SecondChannelAckOffsetAck) + `,`,
+		`SecondChannelDataRateIndexAck:` + fmt.Sprintf("%v", this.SecondChannelDataRateIndexAck) + `,`,
+		`BackoffAck:` + fmt.Sprintf("%v", this.BackoffAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListReq{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListAns{`,
+		`IndexAck:` + fmt.Sprintf("%v", this.IndexAck) + `,`,
+		`ActionAck:` + fmt.Sprintf("%v", this.ActionAck) + `,`,
+		`CombinedRulesAck:` + fmt.Sprintf("%v", this.CombinedRulesAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListReq{`,
+		`RuleIndex:` + fmt.Sprintf("%v", this.RuleIndex) + `,`,
+		`ForwardLimits:` + strings.Replace(this.ForwardLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`WFCnt:` + fmt.Sprintf("%v", this.WFCnt) + `,`,
+		`RootWorSKey:` + fmt.Sprintf("%v", this.RootWorSKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListAns{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListReq{`,
+		`RuleIndex:` + fmt.Sprintf("%v", this.RuleIndex) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListAns{`,
+		`RuleIndexAck:` + fmt.Sprintf("%v", this.RuleIndexAck) + `,`,
+		`WFCnt:` + fmt.Sprintf("%v", this.WFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfigureFwdLimitReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfigureFwdLimitReq{`,
+		`ResetLimitCounter:` + fmt.Sprintf("%v", this.ResetLimitCounter) + `,`,
+		`JoinRequestLimits:` + strings.Replace(this.JoinRequestLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`NotifyLimits:` + strings.Replace(this.NotifyLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`GlobalUplinkLimits:` + strings.Replace(this.GlobalUplinkLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`OverallLimits:` + strings.Replace(this.OverallLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfigureFwdLimitAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfigureFwdLimitAns{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayNotifyNewEndDeviceReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayNotifyNewEndDeviceReq{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`SNR:` + fmt.Sprintf("%v", this.SNR) + `,`,
+		`RSSI:` + fmt.Sprintf("%v", this.RSSI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelaySecondChannel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelaySecondChannel{`,
+		`AckOffset:` + fmt.Sprintf("%v", this.AckOffset) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayForwardLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayForwardLimits{`,
+		`BucketSize:` + fmt.Sprintf("%v", this.BucketSize) + `,`,
+		`ReloadRate:` + fmt.Sprintf("%v", this.ReloadRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRateIndexValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRateIndexValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PingSlotPeriodValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingSlotPeriodValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregatedDutyCycleValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregatedDutyCycleValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RxDelayValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RxDelayValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRAckLimitExponentValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRAckLimitExponentValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRAckDelayExponentValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRAckDelayExponentValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLorawan(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MHDR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MIC", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MIC = append(m.MIC[:0], dAtA[iNdEx:postIndex]...)
+			if m.MIC == nil {
+				m.MIC = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MACPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_MACPayload{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JoinRequestPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_JoinRequestPayload{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAcceptPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JoinAcceptPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_JoinAcceptPayload{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinRequestPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RejoinRequestPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_RejoinRequestPayload{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MHDR) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MHDR: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MType", wireType)
+			}
+			m.MType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MType |= MType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+			}
+			m.Major = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Major |= Major(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FHDR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
+			}
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullFCnt", wireType)
+			}
+			m.FullFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FullFCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FHDR) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FHDR: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCtrl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FCtrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FOpts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FOpts = append(m.FOpts[:0], dAtA[iNdEx:postIndex]...)
+			if m.FOpts == nil {
+				m.FOpts = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FCtrl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FCtrl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FCtrl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADR", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ADR = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckReq", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ADRAckReq = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ack = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FPending = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassB", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClassB = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRequestPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejoinRequestPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
+			}
+			m.RejoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinType |= RejoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCnt", wireType)
+			}
+			m.RejoinCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAcceptPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAcceptPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encrypted = append(m.Encrypted[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encrypted == nil {
+				m.Encrypted = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DLSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DLSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+			}
+			m.RxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxDelay |= RxDelay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CFList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CFList == nil {
+				m.CFList = &CFList{}
+			}
+			if err := m.CFList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DLSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DLSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DLSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DROffset", wireType)
+			}
+			m.Rx1DROffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DROffset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DR", wireType)
+			}
+			m.Rx2DR = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2DR |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptNeg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptNeg = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CFList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CFList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CFList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CFListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Freq = append(m.Freq, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Freq) == 0 {
+					m.Freq = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLorawan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Freq = append(m.Freq, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freq", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChMasks = append(m.ChMasks, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ChMasks) == 0 {
+					m.ChMasks = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLorawan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChMasks = append(m.ChMasks, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChMasks", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoRaDataRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoRaDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bandwidth", wireType)
+			}
+			m.Bandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bandwidth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadingFactor", wireType)
+			}
+			m.SpreadingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadingFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FSKDataRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FSKDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitRate", wireType)
+			}
+			m.BitRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRa", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LoRaDataRate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Modulation = &DataRate_LoRa{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FSK", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FSKDataRate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Modulation = &DataRate_FSK{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DataRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodingRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCRC", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCRC = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &TxSettings_Downlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Downlink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Downlink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
+			}
+			m.AntennaIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntennaIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPower", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.TxPower = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvertPolarization", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvertPolarization = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayAntennaIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayAntennaIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
+			}
+			m.AntennaIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntennaIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13947,15 +20772,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: UplinkToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UplinkToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MHDR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAntennaIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13982,15 +20807,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayAntennaIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MIC", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var byteLen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14000,29 +20825,14 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Timestamp |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MIC = append(m.MIC[:0], dAtA[iNdEx:postIndex]...)
-			if m.MIC == nil {
-				m.MIC = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MACPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14049,17 +20859,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ServerTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &Message_MACPayload{v}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestPayload", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratorTime", wireType)
 			}
-			var msglen int
+			m.ConcentratorTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14069,32 +20877,69 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConcentratorTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JoinRequestPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &Message_JoinRequestPayload{v}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinAcceptPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14104,30 +20949,28 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JoinAcceptPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &Message_JoinAcceptPayload{v}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Path = &DownlinkPath_UplinkToken{v}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinRequestPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14154,11 +20997,11 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RejoinRequestPayload{}
+			v := &GatewayAntennaIdentifiers{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &Message_RejoinRequestPayload{v}
+			m.Path = &DownlinkPath_Fixed{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14184,7 +21027,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MHDR) Unmarshal(dAtA []byte) error {
+func (m *TxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14207,17 +21050,17 @@ func (m *MHDR) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MHDR: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			m.MType = 0
+			m.Class = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14227,16 +21070,16 @@ func (m *MHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MType |= MType(b&0x7F) << shift
+				m.Class |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPaths", wireType)
 			}
-			m.Major = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14246,69 +21089,31 @@ func (m *MHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Major |= Major(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACPayload) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DownlinkPaths = append(m.DownlinkPaths, &DownlinkPath{})
+			if err := m.DownlinkPaths[len(m.DownlinkPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACPayload: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FHDR", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
 			}
-			var msglen int
+			m.Rx1Delay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14318,30 +21123,35 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx1Delay |= RxDelay(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateIndex", wireType)
 			}
-			if err := m.FHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Rx1DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Frequency", wireType)
 			}
-			m.FPort = 0
+			m.Rx1Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14351,16 +21161,16 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FPort |= uint32(b&0x7F) << shift
+				m.Rx1Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
 			}
-			var byteLen int
+			m.Rx2DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14370,31 +21180,16 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
-			if m.FRMPayload == nil {
-				m.FRMPayload = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
 			}
-			var msglen int
+			m.Rx2Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14404,33 +21199,16 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx2Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DecodedPayload == nil {
-				m.DecodedPayload = &types.Struct{}
-			}
-			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullFCnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.FullFCnt = 0
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14440,69 +21218,16 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FullFCnt |= uint32(b&0x7F) << shift
+				m.Priority |= TxSchedulePriority(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FHDR) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FHDR: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FHDR: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14512,30 +21237,33 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AbsoluteTime == nil {
+				m.AbsoluteTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AbsoluteTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCtrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14545,49 +21273,29 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FCtrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
-			}
-			m.FCnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FCnt |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FOpts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14597,24 +21305,26 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FOpts = append(m.FOpts[:0], dAtA[iNdEx:postIndex]...)
-			if m.FOpts == nil {
-				m.FOpts = []byte{}
+			if m.Advanced == nil {
+				m.Advanced = &types.Struct{}
+			}
+			if err := m.Advanced.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -14641,7 +21351,7 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FCtrl) Unmarshal(dAtA []byte) error {
+func (m *MACCommand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14664,17 +21374,17 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FCtrl: wiretype end group for non-group")
+			return fmt.Errorf("proto: MACCommand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FCtrl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MACCommand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
 			}
-			var v int
+			m.CID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14684,17 +21394,16 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CID |= MACCommandIdentifier(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ADR = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckReq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPayload", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14704,57 +21413,30 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ADRAckReq = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.Ack = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FPending", wireType)
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.FPending = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassB", wireType)
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Payload = &MACCommand_RawPayload{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetInd", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14764,70 +21446,32 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClassB = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_ResetInd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JoinRequestPayload: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_ResetInd_{v}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetConf", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14837,30 +21481,32 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_ResetConf{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_ResetConf_{v}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkCheckAns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14870,30 +21516,32 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_LinkCheckAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_LinkCheckAns_{v}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRReq", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14903,83 +21551,67 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_LinkADRReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_LinkADRReq_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRAns", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_LinkADRAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RejoinRequestPayload: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RejoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
+			m.Payload = &MACCommand_LinkADRAns_{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycleReq", wireType)
 			}
-			m.RejoinType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14989,16 +21621,32 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinType |= RejoinType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_DutyCycleReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DutyCycleReq_{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupReq", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15008,30 +21656,32 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RxParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RxParamSetupReq_{v}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupAns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15041,30 +21691,32 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RxParamSetupAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RxParamSetupAns_{v}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevStatusAns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15074,30 +21726,32 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_DevStatusAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_DevStatusAns_{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCnt", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelReq", wireType)
 			}
-			m.RejoinCnt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15107,69 +21761,32 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinCnt |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_NewChannelReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JoinAcceptPayload: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinAcceptPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_NewChannelReq_{v}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelAns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15179,31 +21796,32 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Encrypted = append(m.Encrypted[:0], dAtA[iNdEx:postIndex]...)
-			if m.Encrypted == nil {
-				m.Encrypted = []byte{}
+			v := &MACCommand_NewChannelAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Payload = &MACCommand_NewChannelAns_{v}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelReq", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15213,30 +21831,32 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_DLChannelReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_DLChannelReq_{v}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelAns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15246,30 +21866,32 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_DLChannelAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_DLChannelAns_{v}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RxTimingSetupReq", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15279,28 +21901,30 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RxTimingSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RxTimingSetupReq_{v}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxParamSetupReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15327,15 +21951,17 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DLSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_TxParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_TxParamSetupReq_{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RekeyInd", wireType)
 			}
-			m.RxDelay = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15345,14 +21971,30 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RxDelay |= RxDelay(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RekeyInd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RekeyInd_{v}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CFList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RekeyConf", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15379,71 +22021,17 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CFList == nil {
-				m.CFList = &CFList{}
-			}
-			if err := m.CFList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RekeyConf{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RekeyConf_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DLSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DLSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DLSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DROffset", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRParamSetupReq", wireType)
 			}
-			m.Rx1DROffset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15453,16 +22041,32 @@ func (m *DLSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1DROffset |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DR", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_ADRParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_ADRParamSetupReq_{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceTimeAns", wireType)
 			}
-			m.Rx2DR = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15472,16 +22076,32 @@ func (m *DLSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2DR |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptNeg", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_DeviceTimeAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DeviceTimeAns_{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRejoinReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15491,70 +22111,32 @@ func (m *DLSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OptNeg = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_ForceRejoinReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			m.Payload = &MACCommand_ForceRejoinReq_{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupReq", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15564,215 +22146,67 @@ func (m *CFList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= CFListType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Freq = append(m.Freq, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Freq) == 0 {
-					m.Freq = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLorawan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Freq = append(m.Freq, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freq", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ChMasks = append(m.ChMasks, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ChMasks) == 0 {
-					m.ChMasks = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLorawan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ChMasks = append(m.ChMasks, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChMasks", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RejoinParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
+			m.Payload = &MACCommand_RejoinParamSetupReq_{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupAns", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_RejoinParamSetupAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoRaDataRate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoRaDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bandwidth", wireType)
+			m.Payload = &MACCommand_RejoinParamSetupAns_{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotInfoReq", wireType)
 			}
-			m.Bandwidth = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15782,16 +22216,32 @@ func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Bandwidth |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadingFactor", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.SpreadingFactor = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_PingSlotInfoReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_PingSlotInfoReq_{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15801,69 +22251,32 @@ func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpreadingFactor |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_PingSlotChannelReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FSKDataRate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FSKDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitRate", wireType)
+			m.Payload = &MACCommand_PingSlotChannelReq_{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelAns", wireType)
 			}
-			m.BitRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15873,67 +22286,65 @@ func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitRate |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataRate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
+			v := &MACCommand_PingSlotChannelAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Payload = &MACCommand_PingSlotChannelAns_{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconTimingAns", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataRate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_BeaconTimingAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_BeaconTimingAns_{v}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRa", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15960,15 +22371,15 @@ func (m *DataRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoRaDataRate{}
+			v := &MACCommand_BeaconFreqReq{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Modulation = &DataRate_LoRa{v}
+			m.Payload = &MACCommand_BeaconFreqReq_{v}
 			iNdEx = postIndex
-		case 2:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FSK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15995,68 +22406,15 @@ func (m *DataRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &FSKDataRate{}
+			v := &MACCommand_BeaconFreqAns{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Modulation = &DataRate_FSK{v}
+			m.Payload = &MACCommand_BeaconFreqAns_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeInd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16083,15 +22441,17 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DataRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_DeviceModeInd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_DeviceModeInd_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeConf", wireType)
 			}
-			m.DataRateIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16101,16 +22461,32 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_DeviceModeConf{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DeviceModeConf_{v}
+			iNdEx = postIndex
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfReq", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16120,29 +22496,32 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodingRate = string(dAtA[iNdEx:postIndex])
+			v := &MACCommand_RelayConfReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayConfReq_{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfAns", wireType)
 			}
-			m.Frequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16152,53 +22531,30 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCRC", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.EnableCRC = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			v := &MACCommand_RelayConfAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			m.Payload = &MACCommand_RelayConfAns_{v}
+			iNdEx = postIndex
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayEndDeviceConfReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16225,16 +22581,15 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayEndDeviceConfReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayEndDeviceConfReq_{v}
 			iNdEx = postIndex
-		case 8:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayEndDeviceConfAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16261,71 +22616,52 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downlink == nil {
-				m.Downlink = &TxSettings_Downlink{}
-			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayEndDeviceConfAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayEndDeviceConfAns_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayFilterListReq", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Downlink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Downlink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
-			}
-			m.AntennaIndex = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RelayFilterListReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayFilterListReq_{v}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayFilterListAns", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16335,27 +22671,32 @@ func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AntennaIndex |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPower", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
-			iNdEx += 4
-			m.TxPower = float32(math.Float32frombits(v))
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvertPolarization", wireType)
+			v := &MACCommand_RelayFilterListAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v int
+			m.Payload = &MACCommand_RelayFilterListAns_{v}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayUpdateUplinkListReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16365,68 +22706,30 @@ func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InvertPolarization = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_RelayUpdateUplinkListReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayAntennaIdentifiers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayAntennaIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_RelayUpdateUplinkListReq_{v}
+			iNdEx = postIndex
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayUpdateUplinkListAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16453,15 +22756,17 @@ func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayUpdateUplinkListAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayUpdateUplinkListAns_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfigureFwdLimitReq", wireType)
 			}
-			m.AntennaIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16471,67 +22776,30 @@ func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AntennaIndex |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UplinkToken) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_RelayConfigureFwdLimitReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UplinkToken: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UplinkToken: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_RelayConfigureFwdLimitReq_{v}
+			iNdEx = postIndex
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAntennaIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfigureFwdLimitAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16558,15 +22826,17 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GatewayAntennaIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayConfigureFwdLimitAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayConfigureFwdLimitAns_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayNotifyNewEndDeviceReq", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16576,14 +22846,30 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RelayNotifyNewEndDeviceReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayNotifyNewEndDeviceReq_{v}
+			iNdEx = postIndex
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCtrlUplinkListReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16610,15 +22896,17 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ServerTime, dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayCtrlUplinkListReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayCtrlUplinkListReq_{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratorTime", wireType)
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCtrlUplinkListAns", wireType)
 			}
-			m.ConcentratorTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16628,11 +22916,27 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratorTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RelayCtrlUplinkListAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayCtrlUplinkListAns_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -16657,7 +22961,7 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16680,50 +22984,17 @@ func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DownlinkPath: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetInd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DownlinkPath: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetInd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Path = &DownlinkPath_UplinkToken{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fixed", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
 			}
-			var msglen int
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16733,27 +23004,11 @@ func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &GatewayAntennaIdentifiers{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Path = &DownlinkPath_Fixed{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -16778,7 +23033,7 @@ func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxRequest) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16801,17 +23056,17 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetConf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetConf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
 			}
-			m.Class = 0
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16821,200 +23076,69 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Class |= Class(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPaths", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownlinkPaths = append(m.DownlinkPaths, &DownlinkPath{})
-			if err := m.DownlinkPaths[len(m.DownlinkPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
-			}
-			m.Rx1Delay = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx1Delay |= RxDelay(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateIndex", wireType)
-			}
-			m.Rx1DataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx1DataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Frequency", wireType)
-			}
-			m.Rx1Frequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx1Frequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
-			}
-			m.Rx2DataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
-			}
-			m.Rx2Frequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx2Frequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			m.Priority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Priority |= TxSchedulePriority(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AbsoluteTime == nil {
-				m.AbsoluteTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AbsoluteTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkCheckAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkCheckAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			var stringLen uint64
+			m.Margin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17024,29 +23148,16 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Margin |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCount", wireType)
 			}
-			var msglen int
+			m.GatewayCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17056,28 +23167,11 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GatewayCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Advanced == nil {
-				m.Advanced = &types.Struct{}
-			}
-			if err := m.Advanced.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -17102,7 +23196,7 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17125,17 +23219,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MACCommand: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkADRReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACCommand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkADRReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			m.CID = 0
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17145,16 +23239,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CID |= MACCommandIdentifier(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawPayload", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
 			}
-			var byteLen int
+			m.TxPowerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17164,30 +23258,105 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TxPowerIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChannelMask = append(m.ChannelMask, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ChannelMask) == 0 {
+					m.ChannelMask = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLorawan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChannelMask = append(m.ChannelMask, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMask", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskControl", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChannelMaskControl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelMaskControl |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Payload = &MACCommand_RawPayload{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetInd", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
 			}
-			var msglen int
+			m.NbTrans = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17197,32 +23366,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NbTrans |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_ResetInd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_ResetInd_{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetConf", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkADRAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkADRAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskAck", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17232,32 +23438,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_ResetConf{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_ResetConf_{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkCheckAns", wireType)
+			m.ChannelMaskAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17267,32 +23458,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_LinkCheckAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_LinkCheckAns_{v}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRReq", wireType)
+			m.DataRateIndexAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndexAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17302,32 +23478,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.TxPowerIndexAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_LinkADRReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_LinkADRReq_{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutyCycleReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutyCycleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+			}
+			m.MaxDutyCycle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17337,32 +23551,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_LinkADRAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_LinkADRAns_{v}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycleReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RxParamSetupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			}
+			m.Rx2DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17372,32 +23623,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DutyCycleReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_DutyCycleReq_{v}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupReq", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
 			}
-			var msglen int
+			m.Rx1DataRateOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17407,32 +23642,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx1DataRateOffset |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RxParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RxParamSetupReq_{v}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupAns", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
 			}
-			var msglen int
+			m.Rx2Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17442,32 +23661,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx2Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_RxParamSetupAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Payload = &MACCommand_RxParamSetupAns_{v}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevStatusAns", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RxParamSetupAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RxParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndexAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17477,32 +23733,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DevStatusAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_DevStatusAns_{v}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelReq", wireType)
+			m.Rx2DataRateIndexAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffsetAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17512,32 +23753,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_NewChannelReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_NewChannelReq_{v}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelAns", wireType)
+			m.Rx1DataRateOffsetAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2FrequencyAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17547,32 +23773,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Rx2FrequencyAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_NewChannelAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_NewChannelAns_{v}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevStatusAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevStatusAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17582,32 +23846,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Battery |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DLChannelReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_DLChannelReq_{v}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelAns", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			var msglen int
+			m.Margin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17617,32 +23865,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Margin |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_DLChannelAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_DLChannelAns_{v}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxTimingSetupReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewChannelReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+			}
+			m.ChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17652,32 +23937,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RxTimingSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RxTimingSetupReq_{v}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxParamSetupReq", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var msglen int
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17687,32 +23956,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_TxParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_TxParamSetupReq_{v}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RekeyInd", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
 			}
-			var msglen int
+			m.MinDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17722,32 +23975,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RekeyInd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RekeyInd_{v}
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RekeyConf", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
 			}
-			var msglen int
+			m.MaxDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17757,32 +23994,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_RekeyConf{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_RekeyConf_{v}
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRParamSetupReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewChannelAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17792,32 +24066,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_ADRParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_ADRParamSetupReq_{v}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceTimeAns", wireType)
+			m.FrequencyAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17827,32 +24086,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.DataRateAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_DeviceTimeAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_DeviceTimeAns_{v}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceRejoinReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DLChannelReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DLChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+			}
+			m.ChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17862,32 +24159,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_ForceRejoinReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_ForceRejoinReq_{v}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupReq", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var msglen int
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17897,32 +24178,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_RejoinParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Payload = &MACCommand_RejoinParamSetupReq_{v}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupAns", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DLChannelAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DLChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndexAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17932,32 +24250,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RejoinParamSetupAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RejoinParamSetupAns_{v}
-			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotInfoReq", wireType)
+			m.ChannelIndexAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17967,32 +24270,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.FrequencyAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_PingSlotInfoReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_PingSlotInfoReq_{v}
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RxTimingSetupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RxTimingSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18002,32 +24343,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Delay |= RxDelay(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_PingSlotChannelReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_PingSlotChannelReq_{v}
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxParamSetupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRPIndex", wireType)
+			}
+			m.MaxEIRPIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18037,32 +24415,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxEIRPIndex |= DeviceEIRP(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_PingSlotChannelAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_PingSlotChannelAns_{v}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconTimingAns", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18072,32 +24434,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_BeaconTimingAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_BeaconTimingAns_{v}
-			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqReq", wireType)
+			m.UplinkDwellTime = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18107,32 +24454,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.DownlinkDwellTime = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_BeaconFreqReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_BeaconFreqReq_{v}
-			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RekeyInd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RekeyInd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+			}
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18142,32 +24527,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_BeaconFreqAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_BeaconFreqAns_{v}
-			iNdEx = postIndex
-		case 31:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeInd", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RekeyConf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RekeyConf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+			}
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18177,32 +24599,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_DeviceModeInd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_DeviceModeInd_{v}
-			iNdEx = postIndex
-		case 32:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeConf", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ADRParamSetupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ADRParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+			}
+			m.ADRAckLimitExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18212,27 +24671,30 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ADRAckLimitExponent |= ADRAckLimitExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
 			}
-			v := &MACCommand_DeviceModeConf{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ADRAckDelayExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRAckDelayExponent |= ADRAckDelayExponent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Payload = &MACCommand_DeviceModeConf_{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18257,7 +24719,7 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18280,17 +24742,17 @@ func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetInd: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceTimeAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetInd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceTimeAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.MinorVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18300,11 +24762,25 @@ func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18329,7 +24805,7 @@ func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18352,17 +24828,17 @@ func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetConf: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForceRejoinReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetConf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForceRejoinReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
 			}
-			m.MinorVersion = 0
+			m.RejoinType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18372,7 +24848,64 @@ func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				m.RejoinType |= RejoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodExponent", wireType)
+			}
+			m.PeriodExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodExponent |= RejoinPeriodExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18401,7 +24934,7 @@ func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18424,17 +24957,17 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkCheckAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RejoinParamSetupReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkCheckAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RejoinParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCountExponent", wireType)
 			}
-			m.Margin = 0
+			m.MaxCountExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18444,16 +24977,16 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Margin |= uint32(b&0x7F) << shift
+				m.MaxCountExponent |= RejoinCountExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponent", wireType)
 			}
-			m.GatewayCount = 0
+			m.MaxTimeExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18463,7 +24996,7 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GatewayCount |= uint32(b&0x7F) << shift
+				m.MaxTimeExponent |= RejoinTimeExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18492,7 +25025,7 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18515,17 +25048,17 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkADRReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RejoinParamSetupAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkADRReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RejoinParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponentAck", wireType)
 			}
-			m.DataRateIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18535,124 +25068,70 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
+			m.MaxTimeExponentAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TxPowerIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxPowerIndex |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ChannelMask = append(m.ChannelMask, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ChannelMask) == 0 {
-					m.ChannelMask = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLorawan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ChannelMask = append(m.ChannelMask, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMask", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskControl", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ChannelMaskControl = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelMaskControl |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingSlotInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingSlotInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			m.NbTrans = 0
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18662,7 +25141,7 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbTrans |= uint32(b&0x7F) << shift
+				m.Period |= PingSlotPeriod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18691,7 +25170,7 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18714,17 +25193,17 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkADRAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingSlotChannelReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkADRAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingSlotChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var v int
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18734,37 +25213,16 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChannelMaskAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DataRateIndexAck = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndexAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			var v int
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18774,12 +25232,11 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TxPowerIndexAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18804,7 +25261,7 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18827,17 +25284,17 @@ func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DutyCycleReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingSlotChannelAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DutyCycleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingSlotChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
 			}
-			m.MaxDutyCycle = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18847,11 +25304,32 @@ func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FrequencyAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DataRateIndexAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18876,7 +25354,7 @@ func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18899,17 +25377,17 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RxParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconTimingAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconTimingAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			m.Rx2DataRateIndex = 0
+			m.Delay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18919,16 +25397,16 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				m.Delay |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
 			}
-			m.Rx1DataRateOffset = 0
+			m.ChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18938,16 +25416,69 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1DataRateOffset |= uint32(b&0x7F) << shift
+				m.ChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconFreqReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconFreqReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			m.Rx2Frequency = 0
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18957,7 +25488,7 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2Frequency |= uint64(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18986,7 +25517,7 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19009,15 +25540,15 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RxParamSetupAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconFreqAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconFreqAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndexAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19034,32 +25565,65 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Rx2DataRateIndexAck = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffsetAck", wireType)
+			m.FrequencyAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.Rx1DataRateOffsetAck = bool(v != 0)
-		case 3:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceModeInd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceModeInd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			var v int
+			m.Class = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19069,12 +25633,11 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Class |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Rx2FrequencyAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19099,7 +25662,7 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19122,36 +25685,17 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DevStatusAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceModeConf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DevStatusAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceModeConf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
-			}
-			m.Battery = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Battery |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			m.Margin = 0
+			m.Class = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19161,7 +25705,7 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Margin |= int32(b&0x7F) << shift
+				m.Class |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19190,7 +25734,7 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19213,17 +25757,17 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewChannelReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
-			m.ChannelIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19233,16 +25777,17 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelIndex |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enable = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CADPeriodicity", wireType)
 			}
-			m.Frequency = 0
+			m.CADPeriodicity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19252,16 +25797,16 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				m.CADPeriodicity |= RelayCADPeriodicity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelIndex", wireType)
 			}
-			m.MinDataRateIndex = 0
+			m.DefaultChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19271,16 +25816,16 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				m.DefaultChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
 			}
-			m.MaxDataRateIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19290,11 +25835,28 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19319,7 +25881,7 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19342,15 +25904,15 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewChannelAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelFrequencyAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19367,10 +25929,10 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.FrequencyAck = bool(v != 0)
+			m.SecondChannelFrequencyAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelAckOffsetAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19387,7 +25949,87 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DataRateAck = bool(v != 0)
+			m.SecondChannelAckOffsetAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelDataRateIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondChannelDataRateIndexAck = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondChannelIndexAck = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultChannelIndexAck = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CADPeriodicityAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CADPeriodicityAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19412,7 +26054,7 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayEndDeviceConfReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19435,17 +26077,17 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DLChannelReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayEndDeviceConfReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DLChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayEndDeviceConfReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.ChannelIndex = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19455,16 +26097,16 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelIndex |= uint32(b&0x7F) << shift
+				m.Mode |= RelayEndDeviceMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartEnableLevel", wireType)
 			}
-			m.Frequency = 0
+			m.SmartEnableLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19474,11 +26116,66 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				m.SmartEnableLevel |= RelaySmartEnableLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19503,7 +26200,7 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayEndDeviceConfAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19526,15 +26223,15 @@ func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DLChannelAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayEndDeviceConfAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DLChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayEndDeviceConfAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndexAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelFrequencyAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19551,10 +26248,10 @@ func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ChannelIndexAck = bool(v != 0)
+			m.SecondChannelFrequencyAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelAckOffsetAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19571,65 +26268,32 @@ func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.FrequencyAck = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.SecondChannelAckOffsetAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelDataRateIndexAck", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RxTimingSetupReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxTimingSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SecondChannelDataRateIndexAck = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffAck", wireType)
 			}
-			m.Delay = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19639,11 +26303,12 @@ func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delay |= RxDelay(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BackoffAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19668,7 +26333,7 @@ func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayFilterListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19691,17 +26356,17 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayFilterListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayFilterListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRPIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.MaxEIRPIndex = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19711,16 +26376,16 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEIRPIndex |= DeviceEIRP(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var v int
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19730,17 +26395,16 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Action |= RelayFilterListAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UplinkDwellTime = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19750,12 +26414,58 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DownlinkDwellTime = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19780,7 +26490,7 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayFilterListAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19803,17 +26513,17 @@ func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RekeyInd: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayFilterListAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RekeyInd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayFilterListAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAck", wireType)
 			}
-			m.MinorVersion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19823,11 +26533,52 @@ func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActionAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedRulesAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CombinedRulesAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19852,7 +26603,7 @@ func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayUpdateUplinkListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19875,17 +26626,17 @@ func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RekeyConf: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayUpdateUplinkListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RekeyConf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayUpdateUplinkListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleIndex", wireType)
 			}
-			m.MinorVersion = 0
+			m.RuleIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19895,69 +26646,85 @@ func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				m.RuleIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardLimits", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
+			if m.ForwardLimits == nil {
+				m.ForwardLimits = &RelayForwardLimits{}
 			}
-			if iNdEx >= l {
+			if err := m.ForwardLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ADRParamSetupReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ADRParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WFCnt", wireType)
 			}
-			m.ADRAckLimitExponent = 0
+			m.WFCnt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19967,16 +26734,16 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckLimitExponent |= ADRAckLimitExponent(b&0x7F) << shift
+				m.WFCnt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootWorSKey", wireType)
 			}
-			m.ADRAckDelayExponent = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19986,11 +26753,27 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckDelayExponent |= ADRAckDelayExponent(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.AES128Key
+			m.RootWorSKey = &v
+			if err := m.RootWorSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20015,7 +26798,7 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayUpdateUplinkListAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20038,45 +26821,12 @@ func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceTimeAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayUpdateUplinkListAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceTimeAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayUpdateUplinkListAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20101,7 +26851,7 @@ func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayCtrlUplinkListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20124,17 +26874,17 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForceRejoinReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayCtrlUplinkListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceRejoinReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayCtrlUplinkListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleIndex", wireType)
 			}
-			m.RejoinType = 0
+			m.RuleIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20144,54 +26894,16 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinType |= RejoinType(b&0x7F) << shift
+				m.RuleIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
-			}
-			m.DataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
-			}
-			m.MaxRetries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxRetries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.PeriodExponent = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20201,7 +26913,7 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodExponent |= RejoinPeriodExponent(b&0x7F) << shift
+				m.Action |= RelayCtrlUplinkListAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20230,7 +26942,7 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayCtrlUplinkListAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20253,17 +26965,17 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RejoinParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayCtrlUplinkListAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RejoinParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayCtrlUplinkListAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCountExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleIndexAck", wireType)
 			}
-			m.MaxCountExponent = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20273,16 +26985,17 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCountExponent |= RejoinCountExponent(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.RuleIndexAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WFCnt", wireType)
 			}
-			m.MaxTimeExponent = 0
+			m.WFCnt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20292,7 +27005,7 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTimeExponent |= RejoinTimeExponent(b&0x7F) << shift
+				m.WFCnt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20321,7 +27034,7 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfigureFwdLimitReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20344,17 +27057,17 @@ func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RejoinParamSetupAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfigureFwdLimitReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RejoinParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfigureFwdLimitReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponentAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetLimitCounter", wireType)
 			}
-			var v int
+			m.ResetLimitCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20364,70 +27077,52 @@ func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ResetLimitCounter |= RelayResetLimitCounter(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MaxTimeExponentAck = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestLimits", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.JoinRequestLimits == nil {
+				m.JoinRequestLimits = &RelayForwardLimits{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.JoinRequestLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotInfoReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifyLimits", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20437,69 +27132,33 @@ func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= PingSlotPeriod(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NotifyLimits == nil {
+				m.NotifyLimits = &RelayForwardLimits{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NotifyLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotChannelReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalUplinkLimits", wireType)
 			}
-			m.Frequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20509,16 +27168,33 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalUplinkLimits == nil {
+				m.GlobalUplinkLimits = &RelayForwardLimits{}
+			}
+			if err := m.GlobalUplinkLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DataRateIndex = 0
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverallLimits", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20528,11 +27204,28 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OverallLimits == nil {
+				m.OverallLimits = &RelayForwardLimits{}
+			}
+			if err := m.OverallLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20557,7 +27250,7 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfigureFwdLimitAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20580,52 +27273,12 @@ func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotChannelAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfigureFwdLimitAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfigureFwdLimitAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FrequencyAck = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DataRateIndexAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20650,7 +27303,7 @@ func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20673,17 +27326,17 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconTimingAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayNotifyNewEndDeviceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconTimingAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayNotifyNewEndDeviceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
 			}
-			m.Delay = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20693,16 +27346,30 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delay |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
-			m.ChannelIndex = 0
+			m.SNR = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20712,69 +27379,16 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelIndex |= uint32(b&0x7F) << shift
+				m.SNR |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconFreqReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconFreqReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSI", wireType)
 			}
-			m.Frequency = 0
+			m.RSSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20784,7 +27398,7 @@ func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				m.RSSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20813,7 +27427,7 @@ func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
+func (m *RelaySecondChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20836,17 +27450,17 @@ func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconFreqAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelaySecondChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconFreqAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelaySecondChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckOffset", wireType)
 			}
-			var v int
+			m.AckOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20856,70 +27470,35 @@ func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AckOffset |= RelaySecondChAckOffset(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FrequencyAck = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceModeInd: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceModeInd: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			m.Class = 0
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20929,7 +27508,7 @@ func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Class |= Class(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20958,7 +27537,7 @@ func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
+func (m *RelayForwardLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20981,17 +27560,17 @@ func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceModeConf: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayForwardLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceModeConf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayForwardLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
 			}
-			m.Class = 0
+			m.BucketSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21001,7 +27580,26 @@ func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Class |= Class(b&0x7F) << shift
+				m.BucketSize |= RelayLimitBucketSize(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloadRate", wireType)
+			}
+			m.ReloadRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReloadRate |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2299,10 +2299,873 @@ func (dst *MACCommand) SetFields(src *MACCommand, paths ...string) error {
 							dst.Payload = nil
 						}
 					}
+				case "relay_conf_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfReq_).RelayConfReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfReq_).RelayConfReq
+						} else {
+							newDst = &MACCommand_RelayConfReq{}
+							dst.Payload = &MACCommand_RelayConfReq_{RelayConfReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_conf_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfAns_).RelayConfAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfAns_).RelayConfAns
+						} else {
+							newDst = &MACCommand_RelayConfAns{}
+							dst.Payload = &MACCommand_RelayConfAns_{RelayConfAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_end_device_conf_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayEndDeviceConfReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayEndDeviceConfReq_).RelayEndDeviceConfReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayEndDeviceConfReq_).RelayEndDeviceConfReq
+						} else {
+							newDst = &MACCommand_RelayEndDeviceConfReq{}
+							dst.Payload = &MACCommand_RelayEndDeviceConfReq_{RelayEndDeviceConfReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_end_device_conf_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayEndDeviceConfAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayEndDeviceConfAns_).RelayEndDeviceConfAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayEndDeviceConfAns_).RelayEndDeviceConfAns
+						} else {
+							newDst = &MACCommand_RelayEndDeviceConfAns{}
+							dst.Payload = &MACCommand_RelayEndDeviceConfAns_{RelayEndDeviceConfAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_filter_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayFilterListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayFilterListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayFilterListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayFilterListReq_).RelayFilterListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayFilterListReq_).RelayFilterListReq
+						} else {
+							newDst = &MACCommand_RelayFilterListReq{}
+							dst.Payload = &MACCommand_RelayFilterListReq_{RelayFilterListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_filter_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayFilterListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayFilterListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayFilterListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayFilterListAns_).RelayFilterListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayFilterListAns_).RelayFilterListAns
+						} else {
+							newDst = &MACCommand_RelayFilterListAns{}
+							dst.Payload = &MACCommand_RelayFilterListAns_{RelayFilterListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_update_uplink_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayUpdateUplinkListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayUpdateUplinkListReq_).RelayUpdateUplinkListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayUpdateUplinkListReq_).RelayUpdateUplinkListReq
+						} else {
+							newDst = &MACCommand_RelayUpdateUplinkListReq{}
+							dst.Payload = &MACCommand_RelayUpdateUplinkListReq_{RelayUpdateUplinkListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_update_uplink_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayUpdateUplinkListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayUpdateUplinkListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayUpdateUplinkListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayUpdateUplinkListAns_).RelayUpdateUplinkListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayUpdateUplinkListAns_).RelayUpdateUplinkListAns
+						} else {
+							newDst = &MACCommand_RelayUpdateUplinkListAns{}
+							dst.Payload = &MACCommand_RelayUpdateUplinkListAns_{RelayUpdateUplinkListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_configure_fwd_limit_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfigureFwdLimitReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_configure_fwd_limit_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfigureFwdLimitReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_configure_fwd_limit_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfigureFwdLimitReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfigureFwdLimitReq_).RelayConfigureFwdLimitReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfigureFwdLimitReq_).RelayConfigureFwdLimitReq
+						} else {
+							newDst = &MACCommand_RelayConfigureFwdLimitReq{}
+							dst.Payload = &MACCommand_RelayConfigureFwdLimitReq_{RelayConfigureFwdLimitReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_configure_fwd_limit_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfigureFwdLimitAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_configure_fwd_limit_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfigureFwdLimitAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_configure_fwd_limit_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfigureFwdLimitAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfigureFwdLimitAns_).RelayConfigureFwdLimitAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfigureFwdLimitAns_).RelayConfigureFwdLimitAns
+						} else {
+							newDst = &MACCommand_RelayConfigureFwdLimitAns{}
+							dst.Payload = &MACCommand_RelayConfigureFwdLimitAns_{RelayConfigureFwdLimitAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_notify_new_end_device_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_notify_new_end_device_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_notify_new_end_device_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayNotifyNewEndDeviceReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_).RelayNotifyNewEndDeviceReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_).RelayNotifyNewEndDeviceReq
+						} else {
+							newDst = &MACCommand_RelayNotifyNewEndDeviceReq{}
+							dst.Payload = &MACCommand_RelayNotifyNewEndDeviceReq_{RelayNotifyNewEndDeviceReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_ctrl_uplink_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayCtrlUplinkListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayCtrlUplinkListReq_).RelayCtrlUplinkListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayCtrlUplinkListReq_).RelayCtrlUplinkListReq
+						} else {
+							newDst = &MACCommand_RelayCtrlUplinkListReq{}
+							dst.Payload = &MACCommand_RelayCtrlUplinkListReq_{RelayCtrlUplinkListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_ctrl_uplink_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayCtrlUplinkListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayCtrlUplinkListAns_).RelayCtrlUplinkListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayCtrlUplinkListAns_).RelayCtrlUplinkListAns
+						} else {
+							newDst = &MACCommand_RelayCtrlUplinkListAns{}
+							dst.Payload = &MACCommand_RelayCtrlUplinkListAns_{RelayCtrlUplinkListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelaySecondChannel) SetFields(src *RelaySecondChannel, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ack_offset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ack_offset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AckOffset = src.AckOffset
+			} else {
+				var zero RelaySecondChAckOffset
+				dst.AckOffset = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayForwardLimits) SetFields(src *RelayForwardLimits, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "bucket_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'bucket_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BucketSize = src.BucketSize
+			} else {
+				var zero RelayLimitBucketSize
+				dst.BucketSize = zero
+			}
+		case "reload_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reload_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReloadRate = src.ReloadRate
+			} else {
+				var zero uint32
+				dst.ReloadRate = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DataRateIndexValue) SetFields(src *DataRateIndexValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero DataRateIndex
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PingSlotPeriodValue) SetFields(src *PingSlotPeriodValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero PingSlotPeriod
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AggregatedDutyCycleValue) SetFields(src *AggregatedDutyCycleValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero AggregatedDutyCycle
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RxDelayValue) SetFields(src *RxDelayValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero RxDelay
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRAckLimitExponentValue) SetFields(src *ADRAckLimitExponentValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero ADRAckLimitExponent
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRAckDelayExponentValue) SetFields(src *ADRAckDelayExponentValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero ADRAckDelayExponent
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "antenna_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AntennaIndex = src.AntennaIndex
+			} else {
+				var zero uint32
+				dst.AntennaIndex = zero
+			}
+		case "tx_power":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPower = src.TxPower
+			} else {
+				var zero float32
+				dst.TxPower = zero
+			}
+		case "invert_polarization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'invert_polarization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.InvertPolarization = src.InvertPolarization
+			} else {
+				var zero bool
+				dst.InvertPolarization = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_ResetInd) SetFields(src *MACCommand_ResetInd, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "minor_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinorVersion = src.MinorVersion
+			} else {
+				var zero Minor
+				dst.MinorVersion = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_ResetConf) SetFields(src *MACCommand_ResetConf, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "minor_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinorVersion = src.MinorVersion
+			} else {
+				var zero Minor
+				dst.MinorVersion = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_LinkCheckAns) SetFields(src *MACCommand_LinkCheckAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				var zero uint32
+				dst.Margin = zero
+			}
+		case "gateway_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayCount = src.GatewayCount
+			} else {
+				var zero uint32
+				dst.GatewayCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_LinkADRReq) SetFields(src *MACCommand_LinkADRReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndex = src.TxPowerIndex
+			} else {
+				var zero uint32
+				dst.TxPowerIndex = zero
+			}
+		case "channel_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMask = src.ChannelMask
+			} else {
+				dst.ChannelMask = nil
+			}
+		case "channel_mask_control":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask_control' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMaskControl = src.ChannelMaskControl
+			} else {
+				var zero uint32
+				dst.ChannelMaskControl = zero
+			}
+		case "nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NbTrans = src.NbTrans
+			} else {
+				var zero uint32
+				dst.NbTrans = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_LinkADRAns) SetFields(src *MACCommand_LinkADRAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channel_mask_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMaskAck = src.ChannelMaskAck
+			} else {
+				var zero bool
+				dst.ChannelMaskAck = zero
+			}
+		case "data_rate_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndexAck = src.DataRateIndexAck
+			} else {
+				var zero bool
+				dst.DataRateIndexAck = zero
+			}
+		case "tx_power_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndexAck = src.TxPowerIndexAck
+			} else {
+				var zero bool
+				dst.TxPowerIndexAck = zero
+			}
 
-				default:
-					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
-				}
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_DutyCycleReq) SetFields(src *MACCommand_DutyCycleReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "max_duty_cycle":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_duty_cycle' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDutyCycle = src.MaxDutyCycle
+			} else {
+				var zero AggregatedDutyCycle
+				dst.MaxDutyCycle = zero
 			}
 
 		default:
@@ -2312,18 +3175,38 @@ func (dst *MACCommand) SetFields(src *MACCommand, paths ...string) error {
 	return nil
 }
 
-func (dst *DataRateIndexValue) SetFields(src *DataRateIndexValue, paths ...string) error {
+func (dst *MACCommand_RxParamSetupReq) SetFields(src *MACCommand_RxParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "rx2_data_rate_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rx2_data_rate_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Rx2DataRateIndex = src.Rx2DataRateIndex
 			} else {
 				var zero DataRateIndex
-				dst.Value = zero
+				dst.Rx2DataRateIndex = zero
+			}
+		case "rx1_data_rate_offset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx1_data_rate_offset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx1DataRateOffset = src.Rx1DataRateOffset
+			} else {
+				var zero uint32
+				dst.Rx1DataRateOffset = zero
+			}
+		case "rx2_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx2_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx2Frequency = src.Rx2Frequency
+			} else {
+				var zero uint64
+				dst.Rx2Frequency = zero
 			}
 
 		default:
@@ -2333,18 +3216,38 @@ func (dst *DataRateIndexValue) SetFields(src *DataRateIndexValue, paths ...strin
 	return nil
 }
 
-func (dst *PingSlotPeriodValue) SetFields(src *PingSlotPeriodValue, paths ...string) error {
+func (dst *MACCommand_RxParamSetupAns) SetFields(src *MACCommand_RxParamSetupAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "rx2_data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rx2_data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Rx2DataRateIndexAck = src.Rx2DataRateIndexAck
 			} else {
-				var zero PingSlotPeriod
-				dst.Value = zero
+				var zero bool
+				dst.Rx2DataRateIndexAck = zero
+			}
+		case "rx1_data_rate_offset_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx1_data_rate_offset_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx1DataRateOffsetAck = src.Rx1DataRateOffsetAck
+			} else {
+				var zero bool
+				dst.Rx1DataRateOffsetAck = zero
+			}
+		case "rx2_frequency_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx2_frequency_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx2FrequencyAck = src.Rx2FrequencyAck
+			} else {
+				var zero bool
+				dst.Rx2FrequencyAck = zero
 			}
 
 		default:
@@ -2354,18 +3257,28 @@ func (dst *PingSlotPeriodValue) SetFields(src *PingSlotPeriodValue, paths ...str
 	return nil
 }
 
-func (dst *AggregatedDutyCycleValue) SetFields(src *AggregatedDutyCycleValue, paths ...string) error {
+func (dst *MACCommand_DevStatusAns) SetFields(src *MACCommand_DevStatusAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "battery":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'battery' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Battery = src.Battery
 			} else {
-				var zero AggregatedDutyCycle
-				dst.Value = zero
+				var zero uint32
+				dst.Battery = zero
+			}
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				var zero int32
+				dst.Margin = zero
 			}
 
 		default:
@@ -2375,18 +3288,48 @@ func (dst *AggregatedDutyCycleValue) SetFields(src *AggregatedDutyCycleValue, pa
 	return nil
 }
 
-func (dst *RxDelayValue) SetFields(src *RxDelayValue, paths ...string) error {
+func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "channel_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.ChannelIndex = src.ChannelIndex
 			} else {
-				var zero RxDelay
-				dst.Value = zero
+				var zero uint32
+				dst.ChannelIndex = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "min_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinDataRateIndex = src.MinDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.MinDataRateIndex = zero
+			}
+		case "max_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDataRateIndex = src.MaxDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.MaxDataRateIndex = zero
 			}
 
 		default:
@@ -2396,18 +3339,28 @@ func (dst *RxDelayValue) SetFields(src *RxDelayValue, paths ...string) error {
 	return nil
 }
 
-func (dst *ADRAckLimitExponentValue) SetFields(src *ADRAckLimitExponentValue, paths ...string) error {
+func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "frequency_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.FrequencyAck = src.FrequencyAck
 			} else {
-				var zero ADRAckLimitExponent
-				dst.Value = zero
+				var zero bool
+				dst.FrequencyAck = zero
+			}
+		case "data_rate_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateAck = src.DataRateAck
+			} else {
+				var zero bool
+				dst.DataRateAck = zero
 			}
 
 		default:
@@ -2417,18 +3370,80 @@ func (dst *ADRAckLimitExponentValue) SetFields(src *ADRAckLimitExponentValue, pa
 	return nil
 }
 
-func (dst *ADRAckDelayExponentValue) SetFields(src *ADRAckDelayExponentValue, paths ...string) error {
+func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelIndex = src.ChannelIndex
+			} else {
+				var zero uint32
+				dst.ChannelIndex = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_DLChannelAns) SetFields(src *MACCommand_DLChannelAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelIndexAck = src.ChannelIndexAck
+			} else {
+				var zero bool
+				dst.ChannelIndexAck = zero
+			}
+		case "frequency_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyAck = src.FrequencyAck
+			} else {
+				var zero bool
+				dst.FrequencyAck = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RxTimingSetupReq) SetFields(src *MACCommand_RxTimingSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "delay":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Delay = src.Delay
 			} else {
-				var zero ADRAckDelayExponent
-				dst.Value = zero
+				var zero RxDelay
+				dst.Delay = zero
 			}
 
 		default:
@@ -2438,38 +3453,38 @@ func (dst *ADRAckDelayExponentValue) SetFields(src *ADRAckDelayExponentValue, pa
 	return nil
 }
 
-func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...string) error {
+func (dst *MACCommand_TxParamSetupReq) SetFields(src *MACCommand_TxParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "antenna_index":
+		case "max_eirp_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_eirp_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.AntennaIndex = src.AntennaIndex
+				dst.MaxEIRPIndex = src.MaxEIRPIndex
 			} else {
-				var zero uint32
-				dst.AntennaIndex = zero
+				var zero DeviceEIRP
+				dst.MaxEIRPIndex = zero
 			}
-		case "tx_power":
+		case "uplink_dwell_time":
 			if len(subs) > 0 {
-				return fmt.Errorf("'tx_power' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'uplink_dwell_time' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.TxPower = src.TxPower
+				dst.UplinkDwellTime = src.UplinkDwellTime
 			} else {
-				var zero float32
-				dst.TxPower = zero
+				var zero bool
+				dst.UplinkDwellTime = zero
 			}
-		case "invert_polarization":
+		case "downlink_dwell_time":
 			if len(subs) > 0 {
-				return fmt.Errorf("'invert_polarization' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'downlink_dwell_time' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.InvertPolarization = src.InvertPolarization
+				dst.DownlinkDwellTime = src.DownlinkDwellTime
 			} else {
 				var zero bool
-				dst.InvertPolarization = zero
+				dst.DownlinkDwellTime = zero
 			}
 
 		default:
@@ -2479,7 +3494,7 @@ func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...str
 	return nil
 }
 
-func (dst *MACCommand_ResetInd) SetFields(src *MACCommand_ResetInd, paths ...string) error {
+func (dst *MACCommand_RekeyInd) SetFields(src *MACCommand_RekeyInd, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
 		case "minor_version":
@@ -2500,7 +3515,7 @@ func (dst *MACCommand_ResetInd) SetFields(src *MACCommand_ResetInd, paths ...str
 	return nil
 }
 
-func (dst *MACCommand_ResetConf) SetFields(src *MACCommand_ResetConf, paths ...string) error {
+func (dst *MACCommand_RekeyConf) SetFields(src *MACCommand_RekeyConf, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
 		case "minor_version":
@@ -2521,88 +3536,28 @@ func (dst *MACCommand_ResetConf) SetFields(src *MACCommand_ResetConf, paths ...s
 	return nil
 }
 
-func (dst *MACCommand_LinkCheckAns) SetFields(src *MACCommand_LinkCheckAns, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "margin":
-			if len(subs) > 0 {
-				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Margin = src.Margin
-			} else {
-				var zero uint32
-				dst.Margin = zero
-			}
-		case "gateway_count":
-			if len(subs) > 0 {
-				return fmt.Errorf("'gateway_count' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.GatewayCount = src.GatewayCount
-			} else {
-				var zero uint32
-				dst.GatewayCount = zero
-			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_LinkADRReq) SetFields(src *MACCommand_LinkADRReq, paths ...string) error {
+func (dst *MACCommand_ADRParamSetupReq) SetFields(src *MACCommand_ADRParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "data_rate_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.DataRateIndex = src.DataRateIndex
-			} else {
-				var zero DataRateIndex
-				dst.DataRateIndex = zero
-			}
-		case "tx_power_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.TxPowerIndex = src.TxPowerIndex
-			} else {
-				var zero uint32
-				dst.TxPowerIndex = zero
-			}
-		case "channel_mask":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_mask' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelMask = src.ChannelMask
-			} else {
-				dst.ChannelMask = nil
-			}
-		case "channel_mask_control":
+		case "adr_ack_limit_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'channel_mask_control' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'adr_ack_limit_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ChannelMaskControl = src.ChannelMaskControl
+				dst.ADRAckLimitExponent = src.ADRAckLimitExponent
 			} else {
-				var zero uint32
-				dst.ChannelMaskControl = zero
+				var zero ADRAckLimitExponent
+				dst.ADRAckLimitExponent = zero
 			}
-		case "nb_trans":
+		case "adr_ack_delay_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'adr_ack_delay_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.NbTrans = src.NbTrans
+				dst.ADRAckDelayExponent = src.ADRAckDelayExponent
 			} else {
-				var zero uint32
-				dst.NbTrans = zero
+				var zero ADRAckDelayExponent
+				dst.ADRAckDelayExponent = zero
 			}
 
 		default:
@@ -2612,38 +3567,18 @@ func (dst *MACCommand_LinkADRReq) SetFields(src *MACCommand_LinkADRReq, paths ..
 	return nil
 }
 
-func (dst *MACCommand_LinkADRAns) SetFields(src *MACCommand_LinkADRAns, paths ...string) error {
+func (dst *MACCommand_DeviceTimeAns) SetFields(src *MACCommand_DeviceTimeAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "channel_mask_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_mask_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelMaskAck = src.ChannelMaskAck
-			} else {
-				var zero bool
-				dst.ChannelMaskAck = zero
-			}
-		case "data_rate_index_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.DataRateIndexAck = src.DataRateIndexAck
-			} else {
-				var zero bool
-				dst.DataRateIndexAck = zero
-			}
-		case "tx_power_index_ack":
+		case "time":
 			if len(subs) > 0 {
-				return fmt.Errorf("'tx_power_index_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.TxPowerIndexAck = src.TxPowerIndexAck
+				dst.Time = src.Time
 			} else {
-				var zero bool
-				dst.TxPowerIndexAck = zero
+				var zero time.Time
+				dst.Time = zero
 			}
 
 		default:
@@ -2653,59 +3588,48 @@ func (dst *MACCommand_LinkADRAns) SetFields(src *MACCommand_LinkADRAns, paths ..
 	return nil
 }
 
-func (dst *MACCommand_DutyCycleReq) SetFields(src *MACCommand_DutyCycleReq, paths ...string) error {
+func (dst *MACCommand_ForceRejoinReq) SetFields(src *MACCommand_ForceRejoinReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "max_duty_cycle":
+		case "rejoin_type":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_duty_cycle' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rejoin_type' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxDutyCycle = src.MaxDutyCycle
+				dst.RejoinType = src.RejoinType
 			} else {
-				var zero AggregatedDutyCycle
-				dst.MaxDutyCycle = zero
+				var zero RejoinType
+				dst.RejoinType = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_RxParamSetupReq) SetFields(src *MACCommand_RxParamSetupReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "rx2_data_rate_index":
+		case "data_rate_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_data_rate_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx2DataRateIndex = src.Rx2DataRateIndex
+				dst.DataRateIndex = src.DataRateIndex
 			} else {
 				var zero DataRateIndex
-				dst.Rx2DataRateIndex = zero
+				dst.DataRateIndex = zero
 			}
-		case "rx1_data_rate_offset":
+		case "max_retries":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx1_data_rate_offset' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_retries' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx1DataRateOffset = src.Rx1DataRateOffset
+				dst.MaxRetries = src.MaxRetries
 			} else {
 				var zero uint32
-				dst.Rx1DataRateOffset = zero
+				dst.MaxRetries = zero
 			}
-		case "rx2_frequency":
+		case "period_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_frequency' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'period_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx2Frequency = src.Rx2Frequency
+				dst.PeriodExponent = src.PeriodExponent
 			} else {
-				var zero uint64
-				dst.Rx2Frequency = zero
+				var zero RejoinPeriodExponent
+				dst.PeriodExponent = zero
 			}
 
 		default:
@@ -2715,38 +3639,28 @@ func (dst *MACCommand_RxParamSetupReq) SetFields(src *MACCommand_RxParamSetupReq
 	return nil
 }
 
-func (dst *MACCommand_RxParamSetupAns) SetFields(src *MACCommand_RxParamSetupAns, paths ...string) error {
+func (dst *MACCommand_RejoinParamSetupReq) SetFields(src *MACCommand_RejoinParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "rx2_data_rate_index_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_data_rate_index_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Rx2DataRateIndexAck = src.Rx2DataRateIndexAck
-			} else {
-				var zero bool
-				dst.Rx2DataRateIndexAck = zero
-			}
-		case "rx1_data_rate_offset_ack":
+		case "max_count_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx1_data_rate_offset_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_count_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx1DataRateOffsetAck = src.Rx1DataRateOffsetAck
+				dst.MaxCountExponent = src.MaxCountExponent
 			} else {
-				var zero bool
-				dst.Rx1DataRateOffsetAck = zero
+				var zero RejoinCountExponent
+				dst.MaxCountExponent = zero
 			}
-		case "rx2_frequency_ack":
+		case "max_time_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_frequency_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_time_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx2FrequencyAck = src.Rx2FrequencyAck
+				dst.MaxTimeExponent = src.MaxTimeExponent
 			} else {
-				var zero bool
-				dst.Rx2FrequencyAck = zero
+				var zero RejoinTimeExponent
+				dst.MaxTimeExponent = zero
 			}
 
 		default:
@@ -2756,28 +3670,18 @@ func (dst *MACCommand_RxParamSetupAns) SetFields(src *MACCommand_RxParamSetupAns
 	return nil
 }
 
-func (dst *MACCommand_DevStatusAns) SetFields(src *MACCommand_DevStatusAns, paths ...string) error {
+func (dst *MACCommand_RejoinParamSetupAns) SetFields(src *MACCommand_RejoinParamSetupAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "battery":
-			if len(subs) > 0 {
-				return fmt.Errorf("'battery' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Battery = src.Battery
-			} else {
-				var zero uint32
-				dst.Battery = zero
-			}
-		case "margin":
+		case "max_time_exponent_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_time_exponent_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Margin = src.Margin
+				dst.MaxTimeExponentAck = src.MaxTimeExponentAck
 			} else {
-				var zero int32
-				dst.Margin = zero
+				var zero bool
+				dst.MaxTimeExponentAck = zero
 			}
 
 		default:
@@ -2787,19 +3691,30 @@ func (dst *MACCommand_DevStatusAns) SetFields(src *MACCommand_DevStatusAns, path
 	return nil
 }
 
-func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, paths ...string) error {
+func (dst *MACCommand_PingSlotInfoReq) SetFields(src *MACCommand_PingSlotInfoReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "channel_index":
+		case "period":
 			if len(subs) > 0 {
-				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'period' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ChannelIndex = src.ChannelIndex
+				dst.Period = src.Period
 			} else {
-				var zero uint32
-				dst.ChannelIndex = zero
+				var zero PingSlotPeriod
+				dst.Period = zero
 			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_PingSlotChannelReq) SetFields(src *MACCommand_PingSlotChannelReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
 		case "frequency":
 			if len(subs) > 0 {
 				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
@@ -2810,25 +3725,15 @@ func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, pa
 				var zero uint64
 				dst.Frequency = zero
 			}
-		case "min_data_rate_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'min_data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.MinDataRateIndex = src.MinDataRateIndex
-			} else {
-				var zero DataRateIndex
-				dst.MinDataRateIndex = zero
-			}
-		case "max_data_rate_index":
+		case "data_rate_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_data_rate_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxDataRateIndex = src.MaxDataRateIndex
+				dst.DataRateIndex = src.DataRateIndex
 			} else {
 				var zero DataRateIndex
-				dst.MaxDataRateIndex = zero
+				dst.DataRateIndex = zero
 			}
 
 		default:
@@ -2838,7 +3743,7 @@ func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, pa
 	return nil
 }
 
-func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, paths ...string) error {
+func (dst *MACCommand_PingSlotChannelAns) SetFields(src *MACCommand_PingSlotChannelAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
 		case "frequency_ack":
@@ -2851,15 +3756,15 @@ func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, pa
 				var zero bool
 				dst.FrequencyAck = zero
 			}
-		case "data_rate_ack":
+		case "data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateAck = src.DataRateAck
+				dst.DataRateIndexAck = src.DataRateIndexAck
 			} else {
 				var zero bool
-				dst.DataRateAck = zero
+				dst.DataRateIndexAck = zero
 			}
 
 		default:
@@ -2869,9 +3774,19 @@ func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, pa
 	return nil
 }
 
-func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, paths ...string) error {
+func (dst *MACCommand_BeaconTimingAns) SetFields(src *MACCommand_BeaconTimingAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
+		case "delay":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Delay = src.Delay
+			} else {
+				var zero uint32
+				dst.Delay = zero
+			}
 		case "channel_index":
 			if len(subs) > 0 {
 				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
@@ -2882,6 +3797,17 @@ func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, path
 				var zero uint32
 				dst.ChannelIndex = zero
 			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_BeaconFreqReq) SetFields(src *MACCommand_BeaconFreqReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
 		case "frequency":
 			if len(subs) > 0 {
 				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
@@ -2900,19 +3826,9 @@ func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, path
 	return nil
 }
 
-func (dst *MACCommand_DLChannelAns) SetFields(src *MACCommand_DLChannelAns, paths ...string) error {
+func (dst *MACCommand_BeaconFreqAns) SetFields(src *MACCommand_BeaconFreqAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "channel_index_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_index_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelIndexAck = src.ChannelIndexAck
-			} else {
-				var zero bool
-				dst.ChannelIndexAck = zero
-			}
 		case "frequency_ack":
 			if len(subs) > 0 {
 				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
@@ -2931,18 +3847,18 @@ func (dst *MACCommand_DLChannelAns) SetFields(src *MACCommand_DLChannelAns, path
 	return nil
 }
 
-func (dst *MACCommand_RxTimingSetupReq) SetFields(src *MACCommand_RxTimingSetupReq, paths ...string) error {
+func (dst *MACCommand_DeviceModeInd) SetFields(src *MACCommand_DeviceModeInd, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "delay":
+		case "class":
 			if len(subs) > 0 {
-				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Delay = src.Delay
+				dst.Class = src.Class
 			} else {
-				var zero RxDelay
-				dst.Delay = zero
+				var zero Class
+				dst.Class = zero
 			}
 
 		default:
@@ -2952,59 +3868,84 @@ func (dst *MACCommand_RxTimingSetupReq) SetFields(src *MACCommand_RxTimingSetupR
 	return nil
 }
 
-func (dst *MACCommand_TxParamSetupReq) SetFields(src *MACCommand_TxParamSetupReq, paths ...string) error {
+func (dst *MACCommand_DeviceModeConf) SetFields(src *MACCommand_DeviceModeConf, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "max_eirp_index":
+		case "class":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_eirp_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxEIRPIndex = src.MaxEIRPIndex
+				dst.Class = src.Class
 			} else {
-				var zero DeviceEIRP
-				dst.MaxEIRPIndex = zero
+				var zero Class
+				dst.Class = zero
 			}
-		case "uplink_dwell_time":
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayConfReq) SetFields(src *MACCommand_RelayConfReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "enable":
 			if len(subs) > 0 {
-				return fmt.Errorf("'uplink_dwell_time' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'enable' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.UplinkDwellTime = src.UplinkDwellTime
+				dst.Enable = src.Enable
 			} else {
 				var zero bool
-				dst.UplinkDwellTime = zero
+				dst.Enable = zero
 			}
-		case "downlink_dwell_time":
+		case "cad_periodicity":
 			if len(subs) > 0 {
-				return fmt.Errorf("'downlink_dwell_time' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'cad_periodicity' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DownlinkDwellTime = src.DownlinkDwellTime
+				dst.CADPeriodicity = src.CADPeriodicity
 			} else {
-				var zero bool
-				dst.DownlinkDwellTime = zero
+				var zero RelayCADPeriodicity
+				dst.CADPeriodicity = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_RekeyInd) SetFields(src *MACCommand_RekeyInd, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "minor_version":
+		case "default_channel_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'default_channel_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MinorVersion = src.MinorVersion
+				dst.DefaultChannelIndex = src.DefaultChannelIndex
 			} else {
-				var zero Minor
-				dst.MinorVersion = zero
+				var zero uint32
+				dst.DefaultChannelIndex = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
 			}
 
 		default:
@@ -3014,70 +3955,68 @@ func (dst *MACCommand_RekeyInd) SetFields(src *MACCommand_RekeyInd, paths ...str
 	return nil
 }
 
-func (dst *MACCommand_RekeyConf) SetFields(src *MACCommand_RekeyConf, paths ...string) error {
+func (dst *MACCommand_RelayConfAns) SetFields(src *MACCommand_RelayConfAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "minor_version":
+		case "second_channel_frequency_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_frequency_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MinorVersion = src.MinorVersion
+				dst.SecondChannelFrequencyAck = src.SecondChannelFrequencyAck
 			} else {
-				var zero Minor
-				dst.MinorVersion = zero
+				var zero bool
+				dst.SecondChannelFrequencyAck = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_ADRParamSetupReq) SetFields(src *MACCommand_ADRParamSetupReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "adr_ack_limit_exponent":
+		case "second_channel_ack_offset_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'adr_ack_limit_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_ack_offset_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ADRAckLimitExponent = src.ADRAckLimitExponent
+				dst.SecondChannelAckOffsetAck = src.SecondChannelAckOffsetAck
 			} else {
-				var zero ADRAckLimitExponent
-				dst.ADRAckLimitExponent = zero
+				var zero bool
+				dst.SecondChannelAckOffsetAck = zero
 			}
-		case "adr_ack_delay_exponent":
+		case "second_channel_data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'adr_ack_delay_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ADRAckDelayExponent = src.ADRAckDelayExponent
+				dst.SecondChannelDataRateIndexAck = src.SecondChannelDataRateIndexAck
 			} else {
-				var zero ADRAckDelayExponent
-				dst.ADRAckDelayExponent = zero
+				var zero bool
+				dst.SecondChannelDataRateIndexAck = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_DeviceTimeAns) SetFields(src *MACCommand_DeviceTimeAns, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "time":
+		case "second_channel_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Time = src.Time
+				dst.SecondChannelIndexAck = src.SecondChannelIndexAck
 			} else {
-				var zero time.Time
-				dst.Time = zero
+				var zero bool
+				dst.SecondChannelIndexAck = zero
+			}
+		case "default_channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndexAck = src.DefaultChannelIndexAck
+			} else {
+				var zero bool
+				dst.DefaultChannelIndexAck = zero
+			}
+		case "cad_periodicity_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CADPeriodicityAck = src.CADPeriodicityAck
+			} else {
+				var zero bool
+				dst.CADPeriodicityAck = zero
 			}
 
 		default:
@@ -3087,48 +4026,63 @@ func (dst *MACCommand_DeviceTimeAns) SetFields(src *MACCommand_DeviceTimeAns, pa
 	return nil
 }
 
-func (dst *MACCommand_ForceRejoinReq) SetFields(src *MACCommand_ForceRejoinReq, paths ...string) error {
+func (dst *MACCommand_RelayEndDeviceConfReq) SetFields(src *MACCommand_RelayEndDeviceConfReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "rejoin_type":
+		case "mode":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rejoin_type' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'mode' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.RejoinType = src.RejoinType
+				dst.Mode = src.Mode
 			} else {
-				var zero RejoinType
-				dst.RejoinType = zero
+				var zero RelayEndDeviceMode
+				dst.Mode = zero
 			}
-		case "data_rate_index":
+		case "smart_enable_level":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'smart_enable_level' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndex = src.DataRateIndex
+				dst.SmartEnableLevel = src.SmartEnableLevel
 			} else {
-				var zero DataRateIndex
-				dst.DataRateIndex = zero
+				var zero RelaySmartEnableLevel
+				dst.SmartEnableLevel = zero
 			}
-		case "max_retries":
+		case "backoff":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_retries' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'backoff' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxRetries = src.MaxRetries
+				dst.Backoff = src.Backoff
 			} else {
 				var zero uint32
-				dst.MaxRetries = zero
+				dst.Backoff = zero
 			}
-		case "period_exponent":
+		case "second_channel":
 			if len(subs) > 0 {
-				return fmt.Errorf("'period_exponent' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.PeriodExponent = src.PeriodExponent
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				var zero RejoinPeriodExponent
-				dst.PeriodExponent = zero
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
 			}
 
 		default:
@@ -3138,70 +4092,48 @@ func (dst *MACCommand_ForceRejoinReq) SetFields(src *MACCommand_ForceRejoinReq,
 	return nil
 }
 
-func (dst *MACCommand_RejoinParamSetupReq) SetFields(src *MACCommand_RejoinParamSetupReq, paths ...string) error {
+func (dst *MACCommand_RelayEndDeviceConfAns) SetFields(src *MACCommand_RelayEndDeviceConfAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "max_count_exponent":
+		case "second_channel_frequency_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_count_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_frequency_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxCountExponent = src.MaxCountExponent
+				dst.SecondChannelFrequencyAck = src.SecondChannelFrequencyAck
 			} else {
-				var zero RejoinCountExponent
-				dst.MaxCountExponent = zero
+				var zero bool
+				dst.SecondChannelFrequencyAck = zero
 			}
-		case "max_time_exponent":
+		case "second_channel_ack_offset_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_time_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_ack_offset_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxTimeExponent = src.MaxTimeExponent
+				dst.SecondChannelAckOffsetAck = src.SecondChannelAckOffsetAck
 			} else {
-				var zero RejoinTimeExponent
-				dst.MaxTimeExponent = zero
+				var zero bool
+				dst.SecondChannelAckOffsetAck = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_RejoinParamSetupAns) SetFields(src *MACCommand_RejoinParamSetupAns, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "max_time_exponent_ack":
+		case "second_channel_data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_time_exponent_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxTimeExponentAck = src.MaxTimeExponentAck
+				dst.SecondChannelDataRateIndexAck = src.SecondChannelDataRateIndexAck
 			} else {
 				var zero bool
-				dst.MaxTimeExponentAck = zero
+				dst.SecondChannelDataRateIndexAck = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_PingSlotInfoReq) SetFields(src *MACCommand_PingSlotInfoReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "period":
+		case "backoff_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'period' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'backoff_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Period = src.Period
+				dst.BackoffAck = src.BackoffAck
 			} else {
-				var zero PingSlotPeriod
-				dst.Period = zero
+				var zero bool
+				dst.BackoffAck = zero
 			}
 
 		default:
@@ -3211,28 +4143,48 @@ func (dst *MACCommand_PingSlotInfoReq) SetFields(src *MACCommand_PingSlotInfoReq
 	return nil
 }
 
-func (dst *MACCommand_PingSlotChannelReq) SetFields(src *MACCommand_PingSlotChannelReq, paths ...string) error {
+func (dst *MACCommand_RelayFilterListReq) SetFields(src *MACCommand_RelayFilterListReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency":
+		case "index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Frequency = src.Frequency
+				dst.Index = src.Index
 			} else {
-				var zero uint64
-				dst.Frequency = zero
+				var zero uint32
+				dst.Index = zero
 			}
-		case "data_rate_index":
+		case "action":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndex = src.DataRateIndex
+				dst.Action = src.Action
 			} else {
-				var zero DataRateIndex
-				dst.DataRateIndex = zero
+				var zero RelayFilterListAction
+				dst.Action = zero
+			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.JoinEUI = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.DevEUI = zero
 			}
 
 		default:
@@ -3242,28 +4194,38 @@ func (dst *MACCommand_PingSlotChannelReq) SetFields(src *MACCommand_PingSlotChan
 	return nil
 }
 
-func (dst *MACCommand_PingSlotChannelAns) SetFields(src *MACCommand_PingSlotChannelAns, paths ...string) error {
+func (dst *MACCommand_RelayFilterListAns) SetFields(src *MACCommand_RelayFilterListAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency_ack":
+		case "index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.FrequencyAck = src.FrequencyAck
+				dst.IndexAck = src.IndexAck
 			} else {
 				var zero bool
-				dst.FrequencyAck = zero
+				dst.IndexAck = zero
 			}
-		case "data_rate_index_ack":
+		case "action_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'action_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndexAck = src.DataRateIndexAck
+				dst.ActionAck = src.ActionAck
 			} else {
 				var zero bool
-				dst.DataRateIndexAck = zero
+				dst.ActionAck = zero
+			}
+		case "combined_rules_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'combined_rules_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CombinedRulesAck = src.CombinedRulesAck
+			} else {
+				var zero bool
+				dst.CombinedRulesAck = zero
 			}
 
 		default:
@@ -3273,28 +4235,72 @@ func (dst *MACCommand_PingSlotChannelAns) SetFields(src *MACCommand_PingSlotChan
 	return nil
 }
 
-func (dst *MACCommand_BeaconTimingAns) SetFields(src *MACCommand_BeaconTimingAns, paths ...string) error {
+func (dst *MACCommand_RelayUpdateUplinkListReq) SetFields(src *MACCommand_RelayUpdateUplinkListReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "delay":
+		case "rule_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Delay = src.Delay
+				dst.RuleIndex = src.RuleIndex
 			} else {
 				var zero uint32
-				dst.Delay = zero
+				dst.RuleIndex = zero
 			}
-		case "channel_index":
+		case "forward_limits":
 			if len(subs) > 0 {
-				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.ForwardLimits == nil) && dst.ForwardLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForwardLimits
+				}
+				if dst.ForwardLimits != nil {
+					newDst = dst.ForwardLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.ForwardLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForwardLimits = src.ForwardLimits
+				} else {
+					dst.ForwardLimits = nil
+				}
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ChannelIndex = src.ChannelIndex
+				dst.DevAddr = src.DevAddr
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+				dst.DevAddr = zero
+			}
+		case "w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WFCnt = src.WFCnt
 			} else {
 				var zero uint32
-				dst.ChannelIndex = zero
+				dst.WFCnt = zero
+			}
+		case "root_wor_s_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'root_wor_s_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RootWorSKey = src.RootWorSKey
+			} else {
+				dst.RootWorSKey = nil
 			}
 
 		default:
@@ -3304,18 +4310,38 @@ func (dst *MACCommand_BeaconTimingAns) SetFields(src *MACCommand_BeaconTimingAns
 	return nil
 }
 
-func (dst *MACCommand_BeaconFreqReq) SetFields(src *MACCommand_BeaconFreqReq, paths ...string) error {
+func (dst *MACCommand_RelayUpdateUplinkListAns) SetFields(src *MACCommand_RelayUpdateUplinkListAns, paths ...string) error {
+	if len(paths) != 0 {
+		return fmt.Errorf("message MACCommand_RelayUpdateUplinkListAns has no fields, but paths %s were specified", paths)
+	}
+	if src != nil {
+		*dst = *src
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayCtrlUplinkListReq) SetFields(src *MACCommand_RelayCtrlUplinkListReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency":
+		case "rule_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Frequency = src.Frequency
+				dst.RuleIndex = src.RuleIndex
 			} else {
-				var zero uint64
-				dst.Frequency = zero
+				var zero uint32
+				dst.RuleIndex = zero
+			}
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero RelayCtrlUplinkListAction
+				dst.Action = zero
 			}
 
 		default:
@@ -3325,18 +4351,28 @@ func (dst *MACCommand_BeaconFreqReq) SetFields(src *MACCommand_BeaconFreqReq, pa
 	return nil
 }
 
-func (dst *MACCommand_BeaconFreqAns) SetFields(src *MACCommand_BeaconFreqAns, paths ...string) error {
+func (dst *MACCommand_RelayCtrlUplinkListAns) SetFields(src *MACCommand_RelayCtrlUplinkListAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency_ack":
+		case "rule_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.FrequencyAck = src.FrequencyAck
+				dst.RuleIndexAck = src.RuleIndexAck
 			} else {
 				var zero bool
-				dst.FrequencyAck = zero
+				dst.RuleIndexAck = zero
+			}
+		case "w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WFCnt = src.WFCnt
+			} else {
+				var zero uint32
+				dst.WFCnt = zero
 			}
 
 		default:
@@ -3346,18 +4382,118 @@ func (dst *MACCommand_BeaconFreqAns) SetFields(src *MACCommand_BeaconFreqAns, pa
 	return nil
 }
 
-func (dst *MACCommand_DeviceModeInd) SetFields(src *MACCommand_DeviceModeInd, paths ...string) error {
+func (dst *MACCommand_RelayConfigureFwdLimitReq) SetFields(src *MACCommand_RelayConfigureFwdLimitReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "class":
+		case "reset_limit_counter":
 			if len(subs) > 0 {
-				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'reset_limit_counter' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Class = src.Class
+				dst.ResetLimitCounter = src.ResetLimitCounter
 			} else {
-				var zero Class
-				dst.Class = zero
+				var zero RelayResetLimitCounter
+				dst.ResetLimitCounter = zero
+			}
+		case "join_request_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.JoinRequestLimits == nil) && dst.JoinRequestLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.JoinRequestLimits
+				}
+				if dst.JoinRequestLimits != nil {
+					newDst = dst.JoinRequestLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.JoinRequestLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.JoinRequestLimits = src.JoinRequestLimits
+				} else {
+					dst.JoinRequestLimits = nil
+				}
+			}
+		case "notify_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.NotifyLimits == nil) && dst.NotifyLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.NotifyLimits
+				}
+				if dst.NotifyLimits != nil {
+					newDst = dst.NotifyLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.NotifyLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.NotifyLimits = src.NotifyLimits
+				} else {
+					dst.NotifyLimits = nil
+				}
+			}
+		case "global_uplink_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.GlobalUplinkLimits == nil) && dst.GlobalUplinkLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GlobalUplinkLimits
+				}
+				if dst.GlobalUplinkLimits != nil {
+					newDst = dst.GlobalUplinkLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.GlobalUplinkLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GlobalUplinkLimits = src.GlobalUplinkLimits
+				} else {
+					dst.GlobalUplinkLimits = nil
+				}
+			}
+		case "overall_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.OverallLimits == nil) && dst.OverallLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OverallLimits
+				}
+				if dst.OverallLimits != nil {
+					newDst = dst.OverallLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.OverallLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OverallLimits = src.OverallLimits
+				} else {
+					dst.OverallLimits = nil
+				}
 			}
 
 		default:
@@ -3367,18 +4503,48 @@ func (dst *MACCommand_DeviceModeInd) SetFields(src *MACCommand_DeviceModeInd, pa
 	return nil
 }
 
-func (dst *MACCommand_DeviceModeConf) SetFields(src *MACCommand_DeviceModeConf, paths ...string) error {
+func (dst *MACCommand_RelayConfigureFwdLimitAns) SetFields(src *MACCommand_RelayConfigureFwdLimitAns, paths ...string) error {
+	if len(paths) != 0 {
+		return fmt.Errorf("message MACCommand_RelayConfigureFwdLimitAns has no fields, but paths %s were specified", paths)
+	}
+	if src != nil {
+		*dst = *src
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayNotifyNewEndDeviceReq) SetFields(src *MACCommand_RelayNotifyNewEndDeviceReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "class":
+		case "dev_addr":
 			if len(subs) > 0 {
-				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Class = src.Class
+				dst.DevAddr = src.DevAddr
 			} else {
-				var zero Class
-				dst.Class = zero
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+				dst.DevAddr = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SNR = src.SNR
+			} else {
+				var zero int32
+				dst.SNR = zero
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RSSI = src.RSSI
+			} else {
+				var zero int32
+				dst.RSSI = zero
 			}
 
 		default:
@@ -2075,7 +2075,7 @@ func (m *MACCommand) ValidateFields(paths ...string) error {
 		case "payload":
 			if len(subs) == 0 {
 				subs = []string{
-					"raw_payload", "reset_ind", "reset_conf", "link_check_ans", "link_adr_req", "link_adr_ans", "duty_cycle_req", "rx_param_setup_req", "rx_param_setup_ans", "dev_status_ans", "new_channel_req", "new_channel_ans", "dl_channel_req", "dl_channel_ans", "rx_timing_setup_req", "tx_param_setup_req", "rekey_ind", "rekey_conf", "adr_param_setup_req", "device_time_ans", "force_rejoin_req", "rejoin_param_setup_req", "rejoin_param_setup_ans", "ping_slot_info_req", "ping_slot_channel_req", "ping_slot_channel_ans", "beacon_timing_ans", "beacon_freq_req", "beacon_freq_ans", "device_mode_ind", "device_mode_conf",
+					"raw_payload", "reset_ind", "reset_conf", "link_check_ans", "link_adr_req", "link_adr_ans", "duty_cycle_req", "rx_param_setup_req", "rx_param_setup_ans", "dev_status_ans", "new_channel_req", "new_channel_ans", "dl_channel_req", "dl_channel_ans", "rx_timing_setup_req", "tx_param_setup_req", "rekey_ind", "rekey_conf", "adr_param_setup_req", "device_time_ans", "force_rejoin_req", "rejoin_param_setup_req", "rejoin_param_setup_ans", "ping_slot_info_req", "ping_slot_channel_req", "ping_slot_channel_ans", "beacon_timing_ans", "beacon_freq_req", "beacon_freq_ans", "device_mode_ind", "device_mode_conf", "relay_conf_req", "relay_conf_ans", "relay_end_device_conf_req", "relay_end_device_conf_ans", "relay_filter_list_req", "relay_filter_list_ans", "relay_update_uplink_list_req", "relay_update_uplink_list_ans", "relay_configure_fwd_limit_req", "relay_configure_fwd_limit_ans", "relay_notify_new_end_device_req",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -2567,6 +2567,214 @@ func (m *MACCommand) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "relay_conf_req":
+					w, ok := m.Payload.(*MACCommand_RelayConfReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_conf_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_conf_ans":
+					w, ok := m.Payload.(*MACCommand_RelayConfAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_conf_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_end_device_conf_req":
+					w, ok := m.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayEndDeviceConfReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_end_device_conf_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_end_device_conf_ans":
+					w, ok := m.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayEndDeviceConfAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_end_device_conf_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_filter_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayFilterListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayFilterListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_filter_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_filter_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayFilterListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayFilterListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_filter_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_update_uplink_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayUpdateUplinkListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_update_uplink_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_update_uplink_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayUpdateUplinkListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayUpdateUplinkListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_update_uplink_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_configure_fwd_limit_req":
+					w, ok := m.Payload.(*MACCommand_RelayConfigureFwdLimitReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfigureFwdLimitReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_configure_fwd_limit_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_configure_fwd_limit_ans":
+					w, ok := m.Payload.(*MACCommand_RelayConfigureFwdLimitAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfigureFwdLimitAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_configure_fwd_limit_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_notify_new_end_device_req":
+					w, ok := m.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayNotifyNewEndDeviceReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_notify_new_end_device_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_ctrl_uplink_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayCtrlUplinkListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_ctrl_uplink_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_ctrl_uplink_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayCtrlUplinkListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_ctrl_uplink_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
@@ -2637,32 +2845,50 @@ var _MACCommand_CID_NotInLookup = map[MACCommandIdentifier]struct{}{
 	0: {},
 }
 
-// ValidateFields checks the field values on DataRateIndexValue with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DataRateIndexValue) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on RelaySecondChannel with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *RelaySecondChannel) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DataRateIndexValueFieldPathsNested
+		paths = RelaySecondChannelFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "ack_offset":
 
-			if _, ok := DataRateIndex_name[int32(m.GetValue())]; !ok {
-				return DataRateIndexValueValidationError{
-					field:  "value",
+			if _, ok := RelaySecondChAckOffset_name[int32(m.GetAckOffset())]; !ok {
+				return RelaySecondChannelValidationError{
+					field:  "ack_offset",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return RelaySecondChannelValidationError{
+					field:  "data_rate_index",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
+		case "frequency":
+
+			if m.GetFrequency() < 100000 {
+				return RelaySecondChannelValidationError{
+					field:  "frequency",
+					reason: "value must be greater than or equal to 100000",
+				}
+			}
+
 		default:
-			return DataRateIndexValueValidationError{
+			return RelaySecondChannelValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2671,9 +2897,9 @@ func (m *DataRateIndexValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DataRateIndexValueValidationError is the validation error returned by
-// DataRateIndexValue.ValidateFields if the designated constraints aren't met.
-type DataRateIndexValueValidationError struct {
+// RelaySecondChannelValidationError is the validation error returned by
+// RelaySecondChannel.ValidateFields if the designated constraints aren't met.
+type RelaySecondChannelValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2681,24 +2907,24 @@ type DataRateIndexValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataRateIndexValueValidationError) Field() string { return e.field }
+func (e RelaySecondChannelValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataRateIndexValueValidationError) Reason() string { return e.reason }
+func (e RelaySecondChannelValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataRateIndexValueValidationError) Cause() error { return e.cause }
+func (e RelaySecondChannelValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataRateIndexValueValidationError) Key() bool { return e.key }
+func (e RelaySecondChannelValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataRateIndexValueValidationError) ErrorName() string {
-	return "DataRateIndexValueValidationError"
+func (e RelaySecondChannelValidationError) ErrorName() string {
+	return "RelaySecondChannelValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataRateIndexValueValidationError) Error() string {
+func (e RelaySecondChannelValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2710,14 +2936,14 @@ func (e DataRateIndexValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataRateIndexValue.%s: %s%s",
+		"invalid %sRelaySecondChannel.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataRateIndexValueValidationError{}
+var _ error = RelaySecondChannelValidationError{}
 
 var _ interface {
 	Field() string
@@ -2725,34 +2951,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataRateIndexValueValidationError{}
+} = RelaySecondChannelValidationError{}
 
-// ValidateFields checks the field values on PingSlotPeriodValue with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PingSlotPeriodValue) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on RelayForwardLimits with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *RelayForwardLimits) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = PingSlotPeriodValueFieldPathsNested
+		paths = RelayForwardLimitsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "bucket_size":
 
-			if _, ok := PingSlotPeriod_name[int32(m.GetValue())]; !ok {
-				return PingSlotPeriodValueValidationError{
-					field:  "value",
+			if _, ok := RelayLimitBucketSize_name[int32(m.GetBucketSize())]; !ok {
+				return RelayForwardLimitsValidationError{
+					field:  "bucket_size",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
+		case "reload_rate":
+
+			if m.GetReloadRate() > 127 {
+				return RelayForwardLimitsValidationError{
+					field:  "reload_rate",
+					reason: "value must be less than or equal to 127",
+				}
+			}
+
 		default:
-			return PingSlotPeriodValueValidationError{
+			return RelayForwardLimitsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2761,9 +2996,9 @@ func (m *PingSlotPeriodValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// PingSlotPeriodValueValidationError is the validation error returned by
-// PingSlotPeriodValue.ValidateFields if the designated constraints aren't met.
-type PingSlotPeriodValueValidationError struct {
+// RelayForwardLimitsValidationError is the validation error returned by
+// RelayForwardLimits.ValidateFields if the designated constraints aren't met.
+type RelayForwardLimitsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2771,24 +3006,1453 @@ type PingSlotPeriodValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e PingSlotPeriodValueValidationError) Field() string { return e.field }
+func (e RelayForwardLimitsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PingSlotPeriodValueValidationError) Reason() string { return e.reason }
+func (e RelayForwardLimitsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PingSlotPeriodValueValidationError) Cause() error { return e.cause }
+func (e RelayForwardLimitsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PingSlotPeriodValueValidationError) Key() bool { return e.key }
+func (e RelayForwardLimitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayForwardLimitsValidationError) ErrorName() string {
+	return "RelayForwardLimitsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayForwardLimitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayForwardLimits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayForwardLimitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayForwardLimitsValidationError{}
+
+// ValidateFields checks the field values on DataRateIndexValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DataRateIndexValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DataRateIndexValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := DataRateIndex_name[int32(m.GetValue())]; !ok {
+				return DataRateIndexValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return DataRateIndexValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DataRateIndexValueValidationError is the validation error returned by
+// DataRateIndexValue.ValidateFields if the designated constraints aren't met.
+type DataRateIndexValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataRateIndexValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataRateIndexValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataRateIndexValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataRateIndexValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataRateIndexValueValidationError) ErrorName() string {
+	return "DataRateIndexValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataRateIndexValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataRateIndexValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataRateIndexValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataRateIndexValueValidationError{}
+
+// ValidateFields checks the field values on PingSlotPeriodValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PingSlotPeriodValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PingSlotPeriodValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := PingSlotPeriod_name[int32(m.GetValue())]; !ok {
+				return PingSlotPeriodValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return PingSlotPeriodValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PingSlotPeriodValueValidationError is the validation error returned by
+// PingSlotPeriodValue.ValidateFields if the designated constraints aren't met.
+type PingSlotPeriodValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PingSlotPeriodValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PingSlotPeriodValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PingSlotPeriodValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PingSlotPeriodValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PingSlotPeriodValueValidationError) ErrorName() string {
+	return "PingSlotPeriodValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PingSlotPeriodValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPingSlotPeriodValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PingSlotPeriodValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PingSlotPeriodValueValidationError{}
+
+// ValidateFields checks the field values on AggregatedDutyCycleValue with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AggregatedDutyCycleValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = AggregatedDutyCycleValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := AggregatedDutyCycle_name[int32(m.GetValue())]; !ok {
+				return AggregatedDutyCycleValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return AggregatedDutyCycleValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// AggregatedDutyCycleValueValidationError is the validation error returned by
+// AggregatedDutyCycleValue.ValidateFields if the designated constraints
+// aren't met.
+type AggregatedDutyCycleValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregatedDutyCycleValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregatedDutyCycleValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregatedDutyCycleValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregatedDutyCycleValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregatedDutyCycleValueValidationError) ErrorName() string {
+	return "AggregatedDutyCycleValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AggregatedDutyCycleValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregatedDutyCycleValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregatedDutyCycleValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregatedDutyCycleValueValidationError{}
+
+// ValidateFields checks the field values on RxDelayValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RxDelayValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RxDelayValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := RxDelay_name[int32(m.GetValue())]; !ok {
+				return RxDelayValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return RxDelayValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RxDelayValueValidationError is the validation error returned by
+// RxDelayValue.ValidateFields if the designated constraints aren't met.
+type RxDelayValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RxDelayValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RxDelayValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RxDelayValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RxDelayValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RxDelayValueValidationError) ErrorName() string { return "RxDelayValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RxDelayValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRxDelayValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RxDelayValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RxDelayValueValidationError{}
+
+// ValidateFields checks the field values on ADRAckLimitExponentValue with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRAckLimitExponentValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := ADRAckLimitExponent_name[int32(m.GetValue())]; !ok {
+				return ADRAckLimitExponentValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return ADRAckLimitExponentValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRAckLimitExponentValueValidationError is the validation error returned by
+// ADRAckLimitExponentValue.ValidateFields if the designated constraints
+// aren't met.
+type ADRAckLimitExponentValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRAckLimitExponentValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRAckLimitExponentValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRAckLimitExponentValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRAckLimitExponentValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRAckLimitExponentValueValidationError) ErrorName() string {
+	return "ADRAckLimitExponentValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRAckLimitExponentValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRAckLimitExponentValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRAckLimitExponentValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRAckLimitExponentValueValidationError{}
+
+// ValidateFields checks the field values on ADRAckDelayExponentValue with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRAckDelayExponentValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := ADRAckDelayExponent_name[int32(m.GetValue())]; !ok {
+				return ADRAckDelayExponentValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return ADRAckDelayExponentValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRAckDelayExponentValueValidationError is the validation error returned by
+// ADRAckDelayExponentValue.ValidateFields if the designated constraints
+// aren't met.
+type ADRAckDelayExponentValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRAckDelayExponentValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRAckDelayExponentValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRAckDelayExponentValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRAckDelayExponentValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRAckDelayExponentValueValidationError) ErrorName() string {
+	return "ADRAckDelayExponentValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRAckDelayExponentValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRAckDelayExponentValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRAckDelayExponentValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRAckDelayExponentValueValidationError{}
+
+// ValidateFields checks the field values on TxSettings_Downlink with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TxSettings_DownlinkFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "antenna_index":
+			// no validation rules for AntennaIndex
+		case "tx_power":
+			// no validation rules for TxPower
+		case "invert_polarization":
+			// no validation rules for InvertPolarization
+		default:
+			return TxSettings_DownlinkValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TxSettings_DownlinkValidationError is the validation error returned by
+// TxSettings_Downlink.ValidateFields if the designated constraints aren't met.
+type TxSettings_DownlinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxSettings_DownlinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxSettings_DownlinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxSettings_DownlinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxSettings_DownlinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxSettings_DownlinkValidationError) ErrorName() string {
+	return "TxSettings_DownlinkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxSettings_DownlinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxSettings_Downlink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxSettings_DownlinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxSettings_DownlinkValidationError{}
+
+// ValidateFields checks the field values on MACCommand_ResetInd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_ResetIndFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := _MACCommand_ResetInd_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
+				return MACCommand_ResetIndValidationError{
+					field:  "minor_version",
+					reason: "value must be in list [1]",
+				}
+			}
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_ResetIndValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_ResetIndValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ResetIndValidationError is the validation error returned by
+// MACCommand_ResetInd.ValidateFields if the designated constraints aren't met.
+type MACCommand_ResetIndValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ResetIndValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ResetIndValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ResetIndValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ResetIndValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ResetIndValidationError) ErrorName() string {
+	return "MACCommand_ResetIndValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_ResetIndValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_ResetInd.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_ResetIndValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_ResetIndValidationError{}
+
+var _MACCommand_ResetInd_MinorVersion_InLookup = map[Minor]struct{}{
+	1: {},
+}
+
+// ValidateFields checks the field values on MACCommand_ResetConf with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_ResetConfFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := _MACCommand_ResetConf_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
+				return MACCommand_ResetConfValidationError{
+					field:  "minor_version",
+					reason: "value must be in list [1]",
+				}
+			}
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_ResetConfValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_ResetConfValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ResetConfValidationError is the validation error returned by
+// MACCommand_ResetConf.ValidateFields if the designated constraints aren't met.
+type MACCommand_ResetConfValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ResetConfValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ResetConfValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ResetConfValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ResetConfValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ResetConfValidationError) ErrorName() string {
+	return "MACCommand_ResetConfValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_ResetConfValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_ResetConf.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_ResetConfValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_ResetConfValidationError{}
+
+var _MACCommand_ResetConf_MinorVersion_InLookup = map[Minor]struct{}{
+	1: {},
+}
+
+// ValidateFields checks the field values on MACCommand_LinkCheckAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_LinkCheckAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_LinkCheckAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "margin":
+
+			if m.GetMargin() > 254 {
+				return MACCommand_LinkCheckAnsValidationError{
+					field:  "margin",
+					reason: "value must be less than or equal to 254",
+				}
+			}
+
+		case "gateway_count":
+
+			if m.GetGatewayCount() > 255 {
+				return MACCommand_LinkCheckAnsValidationError{
+					field:  "gateway_count",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		default:
+			return MACCommand_LinkCheckAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_LinkCheckAnsValidationError is the validation error returned by
+// MACCommand_LinkCheckAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_LinkCheckAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_LinkCheckAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_LinkCheckAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_LinkCheckAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_LinkCheckAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_LinkCheckAnsValidationError) ErrorName() string {
+	return "MACCommand_LinkCheckAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_LinkCheckAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_LinkCheckAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_LinkCheckAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_LinkCheckAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_LinkADRReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_LinkADRReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_LinkADRReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "tx_power_index":
+
+			if m.GetTxPowerIndex() > 15 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "tx_power_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "channel_mask":
+
+			if len(m.GetChannelMask()) > 16 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "channel_mask",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+		case "channel_mask_control":
+
+			if m.GetChannelMaskControl() > 7 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "channel_mask_control",
+					reason: "value must be less than or equal to 7",
+				}
+			}
+
+		case "nb_trans":
+
+			if m.GetNbTrans() > 15 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "nb_trans",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		default:
+			return MACCommand_LinkADRReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_LinkADRReqValidationError is the validation error returned by
+// MACCommand_LinkADRReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_LinkADRReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_LinkADRReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_LinkADRReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_LinkADRReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_LinkADRReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_LinkADRReqValidationError) ErrorName() string {
+	return "MACCommand_LinkADRReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_LinkADRReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_LinkADRReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_LinkADRReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_LinkADRReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_LinkADRAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_LinkADRAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_LinkADRAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel_mask_ack":
+			// no validation rules for ChannelMaskAck
+		case "data_rate_index_ack":
+			// no validation rules for DataRateIndexAck
+		case "tx_power_index_ack":
+			// no validation rules for TxPowerIndexAck
+		default:
+			return MACCommand_LinkADRAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_LinkADRAnsValidationError is the validation error returned by
+// MACCommand_LinkADRAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_LinkADRAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_LinkADRAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_LinkADRAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_LinkADRAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_LinkADRAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_LinkADRAnsValidationError) ErrorName() string {
+	return "MACCommand_LinkADRAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_LinkADRAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_LinkADRAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_LinkADRAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_LinkADRAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_DutyCycleReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DutyCycleReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_DutyCycleReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_duty_cycle":
+
+			if _, ok := AggregatedDutyCycle_name[int32(m.GetMaxDutyCycle())]; !ok {
+				return MACCommand_DutyCycleReqValidationError{
+					field:  "max_duty_cycle",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_DutyCycleReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_DutyCycleReqValidationError is the validation error returned by
+// MACCommand_DutyCycleReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_DutyCycleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_DutyCycleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_DutyCycleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_DutyCycleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_DutyCycleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_DutyCycleReqValidationError) ErrorName() string {
+	return "MACCommand_DutyCycleReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_DutyCycleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_DutyCycleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_DutyCycleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_DutyCycleReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RxParamSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RxParamSetupReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RxParamSetupReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rx2_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetRx2DataRateIndex())]; !ok {
+				return MACCommand_RxParamSetupReqValidationError{
+					field:  "rx2_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "rx1_data_rate_offset":
+
+			if m.GetRx1DataRateOffset() > 7 {
+				return MACCommand_RxParamSetupReqValidationError{
+					field:  "rx1_data_rate_offset",
+					reason: "value must be less than or equal to 7",
+				}
+			}
+
+		case "rx2_frequency":
+
+			if m.GetRx2Frequency() < 100000 {
+				return MACCommand_RxParamSetupReqValidationError{
+					field:  "rx2_frequency",
+					reason: "value must be greater than or equal to 100000",
+				}
+			}
+
+		default:
+			return MACCommand_RxParamSetupReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RxParamSetupReqValidationError is the validation error returned
+// by MACCommand_RxParamSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RxParamSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RxParamSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RxParamSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RxParamSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RxParamSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RxParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_RxParamSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RxParamSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RxParamSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RxParamSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RxParamSetupReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RxParamSetupAns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RxParamSetupAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RxParamSetupAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rx2_data_rate_index_ack":
+			// no validation rules for Rx2DataRateIndexAck
+		case "rx1_data_rate_offset_ack":
+			// no validation rules for Rx1DataRateOffsetAck
+		case "rx2_frequency_ack":
+			// no validation rules for Rx2FrequencyAck
+		default:
+			return MACCommand_RxParamSetupAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RxParamSetupAnsValidationError is the validation error returned
+// by MACCommand_RxParamSetupAns.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RxParamSetupAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RxParamSetupAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RxParamSetupAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RxParamSetupAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RxParamSetupAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PingSlotPeriodValueValidationError) ErrorName() string {
-	return "PingSlotPeriodValueValidationError"
+func (e MACCommand_RxParamSetupAnsValidationError) ErrorName() string {
+	return "MACCommand_RxParamSetupAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PingSlotPeriodValueValidationError) Error() string {
+func (e MACCommand_RxParamSetupAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2800,14 +4464,14 @@ func (e PingSlotPeriodValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPingSlotPeriodValue.%s: %s%s",
+		"invalid %sMACCommand_RxParamSetupAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PingSlotPeriodValueValidationError{}
+var _ error = MACCommand_RxParamSetupAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2815,34 +4479,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PingSlotPeriodValueValidationError{}
+} = MACCommand_RxParamSetupAnsValidationError{}
 
-// ValidateFields checks the field values on AggregatedDutyCycleValue with the
+// ValidateFields checks the field values on MACCommand_DevStatusAns with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *AggregatedDutyCycleValue) ValidateFields(paths ...string) error {
+func (m *MACCommand_DevStatusAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = AggregatedDutyCycleValueFieldPathsNested
+		paths = MACCommand_DevStatusAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "battery":
 
-			if _, ok := AggregatedDutyCycle_name[int32(m.GetValue())]; !ok {
-				return AggregatedDutyCycleValueValidationError{
-					field:  "value",
-					reason: "value must be one of the defined enum values",
+			if m.GetBattery() > 255 {
+				return MACCommand_DevStatusAnsValidationError{
+					field:  "battery",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "margin":
+
+			if val := m.GetMargin(); val < -32 || val > 31 {
+				return MACCommand_DevStatusAnsValidationError{
+					field:  "margin",
+					reason: "value must be inside range [-32, 31]",
 				}
 			}
 
 		default:
-			return AggregatedDutyCycleValueValidationError{
+			return MACCommand_DevStatusAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2851,10 +4524,9 @@ func (m *AggregatedDutyCycleValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// AggregatedDutyCycleValueValidationError is the validation error returned by
-// AggregatedDutyCycleValue.ValidateFields if the designated constraints
-// aren't met.
-type AggregatedDutyCycleValueValidationError struct {
+// MACCommand_DevStatusAnsValidationError is the validation error returned by
+// MACCommand_DevStatusAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_DevStatusAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2862,24 +4534,24 @@ type AggregatedDutyCycleValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e AggregatedDutyCycleValueValidationError) Field() string { return e.field }
+func (e MACCommand_DevStatusAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AggregatedDutyCycleValueValidationError) Reason() string { return e.reason }
+func (e MACCommand_DevStatusAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AggregatedDutyCycleValueValidationError) Cause() error { return e.cause }
+func (e MACCommand_DevStatusAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AggregatedDutyCycleValueValidationError) Key() bool { return e.key }
+func (e MACCommand_DevStatusAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AggregatedDutyCycleValueValidationError) ErrorName() string {
-	return "AggregatedDutyCycleValueValidationError"
+func (e MACCommand_DevStatusAnsValidationError) ErrorName() string {
+	return "MACCommand_DevStatusAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AggregatedDutyCycleValueValidationError) Error() string {
+func (e MACCommand_DevStatusAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2891,14 +4563,14 @@ func (e AggregatedDutyCycleValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAggregatedDutyCycleValue.%s: %s%s",
+		"invalid %sMACCommand_DevStatusAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AggregatedDutyCycleValueValidationError{}
+var _ error = MACCommand_DevStatusAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2906,34 +4578,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AggregatedDutyCycleValueValidationError{}
+} = MACCommand_DevStatusAnsValidationError{}
 
-// ValidateFields checks the field values on RxDelayValue with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on MACCommand_NewChannelReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RxDelayValue) ValidateFields(paths ...string) error {
+func (m *MACCommand_NewChannelReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = RxDelayValueFieldPathsNested
+		paths = MACCommand_NewChannelReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "channel_index":
 
-			if _, ok := RxDelay_name[int32(m.GetValue())]; !ok {
-				return RxDelayValueValidationError{
-					field:  "value",
+			if m.GetChannelIndex() > 255 {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "channel_index",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "frequency":
+
+			if val := m.GetFrequency(); val > 0 && val < 100000 {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "frequency",
+					reason: "value must be outside range (0, 100000)",
+				}
+			}
+
+		case "min_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetMinDataRateIndex())]; !ok {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "min_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "max_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetMaxDataRateIndex())]; !ok {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "max_data_rate_index",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return RxDelayValueValidationError{
+			return MACCommand_NewChannelReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2942,9 +4641,10 @@ func (m *RxDelayValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// RxDelayValueValidationError is the validation error returned by
-// RxDelayValue.ValidateFields if the designated constraints aren't met.
-type RxDelayValueValidationError struct {
+// MACCommand_NewChannelReqValidationError is the validation error returned by
+// MACCommand_NewChannelReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_NewChannelReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2952,22 +4652,24 @@ type RxDelayValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e RxDelayValueValidationError) Field() string { return e.field }
+func (e MACCommand_NewChannelReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RxDelayValueValidationError) Reason() string { return e.reason }
+func (e MACCommand_NewChannelReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RxDelayValueValidationError) Cause() error { return e.cause }
+func (e MACCommand_NewChannelReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RxDelayValueValidationError) Key() bool { return e.key }
+func (e MACCommand_NewChannelReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RxDelayValueValidationError) ErrorName() string { return "RxDelayValueValidationError" }
+func (e MACCommand_NewChannelReqValidationError) ErrorName() string {
+	return "MACCommand_NewChannelReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RxDelayValueValidationError) Error() string {
+func (e MACCommand_NewChannelReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2979,14 +4681,14 @@ func (e RxDelayValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRxDelayValue.%s: %s%s",
+		"invalid %sMACCommand_NewChannelReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RxDelayValueValidationError{}
+var _ error = MACCommand_NewChannelReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2994,34 +4696,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RxDelayValueValidationError{}
+} = MACCommand_NewChannelReqValidationError{}
 
-// ValidateFields checks the field values on ADRAckLimitExponentValue with the
+// ValidateFields checks the field values on MACCommand_NewChannelAns with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
+func (m *MACCommand_NewChannelAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ADRAckLimitExponentValueFieldPathsNested
+		paths = MACCommand_NewChannelAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
-
-			if _, ok := ADRAckLimitExponent_name[int32(m.GetValue())]; !ok {
-				return ADRAckLimitExponentValueValidationError{
-					field:  "value",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
+		case "data_rate_ack":
+			// no validation rules for DataRateAck
 		default:
-			return ADRAckLimitExponentValueValidationError{
+			return MACCommand_NewChannelAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3030,10 +4727,10 @@ func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ADRAckLimitExponentValueValidationError is the validation error returned by
-// ADRAckLimitExponentValue.ValidateFields if the designated constraints
+// MACCommand_NewChannelAnsValidationError is the validation error returned by
+// MACCommand_NewChannelAns.ValidateFields if the designated constraints
 // aren't met.
-type ADRAckLimitExponentValueValidationError struct {
+type MACCommand_NewChannelAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3041,24 +4738,24 @@ type ADRAckLimitExponentValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e ADRAckLimitExponentValueValidationError) Field() string { return e.field }
+func (e MACCommand_NewChannelAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ADRAckLimitExponentValueValidationError) Reason() string { return e.reason }
+func (e MACCommand_NewChannelAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ADRAckLimitExponentValueValidationError) Cause() error { return e.cause }
+func (e MACCommand_NewChannelAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ADRAckLimitExponentValueValidationError) Key() bool { return e.key }
+func (e MACCommand_NewChannelAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ADRAckLimitExponentValueValidationError) ErrorName() string {
-	return "ADRAckLimitExponentValueValidationError"
+func (e MACCommand_NewChannelAnsValidationError) ErrorName() string {
+	return "MACCommand_NewChannelAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ADRAckLimitExponentValueValidationError) Error() string {
+func (e MACCommand_NewChannelAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3070,14 +4767,14 @@ func (e ADRAckLimitExponentValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sADRAckLimitExponentValue.%s: %s%s",
+		"invalid %sMACCommand_NewChannelAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ADRAckLimitExponentValueValidationError{}
+var _ error = MACCommand_NewChannelAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3085,34 +4782,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ADRAckLimitExponentValueValidationError{}
+} = MACCommand_NewChannelAnsValidationError{}
 
-// ValidateFields checks the field values on ADRAckDelayExponentValue with the
+// ValidateFields checks the field values on MACCommand_DLChannelReq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
+func (m *MACCommand_DLChannelReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ADRAckDelayExponentValueFieldPathsNested
+		paths = MACCommand_DLChannelReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "channel_index":
 
-			if _, ok := ADRAckDelayExponent_name[int32(m.GetValue())]; !ok {
-				return ADRAckDelayExponentValueValidationError{
-					field:  "value",
-					reason: "value must be one of the defined enum values",
+			if m.GetChannelIndex() > 255 {
+				return MACCommand_DLChannelReqValidationError{
+					field:  "channel_index",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "frequency":
+
+			if m.GetFrequency() < 100000 {
+				return MACCommand_DLChannelReqValidationError{
+					field:  "frequency",
+					reason: "value must be greater than or equal to 100000",
 				}
 			}
 
 		default:
-			return ADRAckDelayExponentValueValidationError{
+			return MACCommand_DLChannelReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3121,10 +4827,9 @@ func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ADRAckDelayExponentValueValidationError is the validation error returned by
-// ADRAckDelayExponentValue.ValidateFields if the designated constraints
-// aren't met.
-type ADRAckDelayExponentValueValidationError struct {
+// MACCommand_DLChannelReqValidationError is the validation error returned by
+// MACCommand_DLChannelReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_DLChannelReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3132,24 +4837,24 @@ type ADRAckDelayExponentValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e ADRAckDelayExponentValueValidationError) Field() string { return e.field }
+func (e MACCommand_DLChannelReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ADRAckDelayExponentValueValidationError) Reason() string { return e.reason }
+func (e MACCommand_DLChannelReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ADRAckDelayExponentValueValidationError) Cause() error { return e.cause }
+func (e MACCommand_DLChannelReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ADRAckDelayExponentValueValidationError) Key() bool { return e.key }
+func (e MACCommand_DLChannelReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ADRAckDelayExponentValueValidationError) ErrorName() string {
-	return "ADRAckDelayExponentValueValidationError"
+func (e MACCommand_DLChannelReqValidationError) ErrorName() string {
+	return "MACCommand_DLChannelReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ADRAckDelayExponentValueValidationError) Error() string {
+func (e MACCommand_DLChannelReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3161,14 +4866,14 @@ func (e ADRAckDelayExponentValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sADRAckDelayExponentValue.%s: %s%s",
+		"invalid %sMACCommand_DLChannelReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ADRAckDelayExponentValueValidationError{}
+var _ error = MACCommand_DLChannelReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3176,31 +4881,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ADRAckDelayExponentValueValidationError{}
+} = MACCommand_DLChannelReqValidationError{}
 
-// ValidateFields checks the field values on TxSettings_Downlink with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on MACCommand_DLChannelAns with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
+func (m *MACCommand_DLChannelAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = TxSettings_DownlinkFieldPathsNested
+		paths = MACCommand_DLChannelAnsFieldPathsNested
 	}
 
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		_ = subs
-		switch name {
-		case "antenna_index":
-			// no validation rules for AntennaIndex
-		case "tx_power":
-			// no validation rules for TxPower
-		case "invert_polarization":
-			// no validation rules for InvertPolarization
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel_index_ack":
+			// no validation rules for ChannelIndexAck
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
 		default:
-			return TxSettings_DownlinkValidationError{
+			return MACCommand_DLChannelAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3209,9 +4912,9 @@ func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// TxSettings_DownlinkValidationError is the validation error returned by
-// TxSettings_Downlink.ValidateFields if the designated constraints aren't met.
-type TxSettings_DownlinkValidationError struct {
+// MACCommand_DLChannelAnsValidationError is the validation error returned by
+// MACCommand_DLChannelAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_DLChannelAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3219,24 +4922,24 @@ type TxSettings_DownlinkValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxSettings_DownlinkValidationError) Field() string { return e.field }
+func (e MACCommand_DLChannelAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxSettings_DownlinkValidationError) Reason() string { return e.reason }
+func (e MACCommand_DLChannelAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxSettings_DownlinkValidationError) Cause() error { return e.cause }
+func (e MACCommand_DLChannelAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxSettings_DownlinkValidationError) Key() bool { return e.key }
+func (e MACCommand_DLChannelAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxSettings_DownlinkValidationError) ErrorName() string {
-	return "TxSettings_DownlinkValidationError"
+func (e MACCommand_DLChannelAnsValidationError) ErrorName() string {
+	return "MACCommand_DLChannelAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TxSettings_DownlinkValidationError) Error() string {
+func (e MACCommand_DLChannelAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3248,14 +4951,14 @@ func (e TxSettings_DownlinkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxSettings_Downlink.%s: %s%s",
+		"invalid %sMACCommand_DLChannelAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxSettings_DownlinkValidationError{}
+var _ error = MACCommand_DLChannelAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3263,41 +4966,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxSettings_DownlinkValidationError{}
+} = MACCommand_DLChannelAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ResetInd with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RxTimingSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ResetIndFieldPathsNested
+		paths = MACCommand_RxTimingSetupReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
-
-			if _, ok := _MACCommand_ResetInd_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
-				return MACCommand_ResetIndValidationError{
-					field:  "minor_version",
-					reason: "value must be in list [1]",
-				}
-			}
+		case "delay":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_ResetIndValidationError{
-					field:  "minor_version",
+			if _, ok := RxDelay_name[int32(m.GetDelay())]; !ok {
+				return MACCommand_RxTimingSetupReqValidationError{
+					field:  "delay",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_ResetIndValidationError{
+			return MACCommand_RxTimingSetupReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3306,9 +5002,10 @@ func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ResetIndValidationError is the validation error returned by
-// MACCommand_ResetInd.ValidateFields if the designated constraints aren't met.
-type MACCommand_ResetIndValidationError struct {
+// MACCommand_RxTimingSetupReqValidationError is the validation error returned
+// by MACCommand_RxTimingSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RxTimingSetupReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3316,24 +5013,24 @@ type MACCommand_ResetIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ResetIndValidationError) Field() string { return e.field }
+func (e MACCommand_RxTimingSetupReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ResetIndValidationError) Reason() string { return e.reason }
+func (e MACCommand_RxTimingSetupReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ResetIndValidationError) Cause() error { return e.cause }
+func (e MACCommand_RxTimingSetupReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ResetIndValidationError) Key() bool { return e.key }
+func (e MACCommand_RxTimingSetupReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ResetIndValidationError) ErrorName() string {
-	return "MACCommand_ResetIndValidationError"
+func (e MACCommand_RxTimingSetupReqValidationError) ErrorName() string {
+	return "MACCommand_RxTimingSetupReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ResetIndValidationError) Error() string {
+func (e MACCommand_RxTimingSetupReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3345,14 +5042,14 @@ func (e MACCommand_ResetIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ResetInd.%s: %s%s",
+		"invalid %sMACCommand_RxTimingSetupReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ResetIndValidationError{}
+var _ error = MACCommand_RxTimingSetupReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3360,45 +5057,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ResetIndValidationError{}
-
-var _MACCommand_ResetInd_MinorVersion_InLookup = map[Minor]struct{}{
-	1: {},
-}
+} = MACCommand_RxTimingSetupReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ResetConf with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_TxParamSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_TxParamSetupReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ResetConfFieldPathsNested
+		paths = MACCommand_TxParamSetupReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
-
-			if _, ok := _MACCommand_ResetConf_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
-				return MACCommand_ResetConfValidationError{
-					field:  "minor_version",
-					reason: "value must be in list [1]",
-				}
-			}
+		case "max_eirp_index":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_ResetConfValidationError{
-					field:  "minor_version",
+			if _, ok := DeviceEIRP_name[int32(m.GetMaxEIRPIndex())]; !ok {
+				return MACCommand_TxParamSetupReqValidationError{
+					field:  "max_eirp_index",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
+		case "uplink_dwell_time":
+			// no validation rules for UplinkDwellTime
+		case "downlink_dwell_time":
+			// no validation rules for DownlinkDwellTime
 		default:
-			return MACCommand_ResetConfValidationError{
+			return MACCommand_TxParamSetupReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3407,9 +5097,10 @@ func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ResetConfValidationError is the validation error returned by
-// MACCommand_ResetConf.ValidateFields if the designated constraints aren't met.
-type MACCommand_ResetConfValidationError struct {
+// MACCommand_TxParamSetupReqValidationError is the validation error returned
+// by MACCommand_TxParamSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_TxParamSetupReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3417,24 +5108,24 @@ type MACCommand_ResetConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ResetConfValidationError) Field() string { return e.field }
+func (e MACCommand_TxParamSetupReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ResetConfValidationError) Reason() string { return e.reason }
+func (e MACCommand_TxParamSetupReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ResetConfValidationError) Cause() error { return e.cause }
+func (e MACCommand_TxParamSetupReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ResetConfValidationError) Key() bool { return e.key }
+func (e MACCommand_TxParamSetupReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ResetConfValidationError) ErrorName() string {
-	return "MACCommand_ResetConfValidationError"
+func (e MACCommand_TxParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_TxParamSetupReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ResetConfValidationError) Error() string {
+func (e MACCommand_TxParamSetupReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3446,14 +5137,14 @@ func (e MACCommand_ResetConfValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ResetConf.%s: %s%s",
+		"invalid %sMACCommand_TxParamSetupReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ResetConfValidationError{}
+var _ error = MACCommand_TxParamSetupReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3461,47 +5152,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ResetConfValidationError{}
-
-var _MACCommand_ResetConf_MinorVersion_InLookup = map[Minor]struct{}{
-	1: {},
-}
+} = MACCommand_TxParamSetupReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_LinkCheckAns with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on MACCommand_RekeyInd with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_LinkCheckAns) ValidateFields(paths ...string) error {
+func (m *MACCommand_RekeyInd) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_LinkCheckAnsFieldPathsNested
+		paths = MACCommand_RekeyIndFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "margin":
-
-			if m.GetMargin() > 254 {
-				return MACCommand_LinkCheckAnsValidationError{
-					field:  "margin",
-					reason: "value must be less than or equal to 254",
-				}
-			}
-
-		case "gateway_count":
+		case "minor_version":
 
-			if m.GetGatewayCount() > 255 {
-				return MACCommand_LinkCheckAnsValidationError{
-					field:  "gateway_count",
-					reason: "value must be less than or equal to 255",
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_RekeyIndValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_LinkCheckAnsValidationError{
+			return MACCommand_RekeyIndValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3510,9 +5188,9 @@ func (m *MACCommand_LinkCheckAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_LinkCheckAnsValidationError is the validation error returned by
-// MACCommand_LinkCheckAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_LinkCheckAnsValidationError struct {
+// MACCommand_RekeyIndValidationError is the validation error returned by
+// MACCommand_RekeyInd.ValidateFields if the designated constraints aren't met.
+type MACCommand_RekeyIndValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3520,24 +5198,24 @@ type MACCommand_LinkCheckAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_LinkCheckAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RekeyIndValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_LinkCheckAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RekeyIndValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_LinkCheckAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RekeyIndValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_LinkCheckAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RekeyIndValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_LinkCheckAnsValidationError) ErrorName() string {
-	return "MACCommand_LinkCheckAnsValidationError"
+func (e MACCommand_RekeyIndValidationError) ErrorName() string {
+	return "MACCommand_RekeyIndValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_LinkCheckAnsValidationError) Error() string {
+func (e MACCommand_RekeyIndValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3549,14 +5227,14 @@ func (e MACCommand_LinkCheckAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_LinkCheckAns.%s: %s%s",
+		"invalid %sMACCommand_RekeyInd.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_LinkCheckAnsValidationError{}
+var _ error = MACCommand_RekeyIndValidationError{}
 
 var _ interface {
 	Field() string
@@ -3564,70 +5242,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_LinkCheckAnsValidationError{}
+} = MACCommand_RekeyIndValidationError{}
 
-// ValidateFields checks the field values on MACCommand_LinkADRReq with the
+// ValidateFields checks the field values on MACCommand_RekeyConf with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_LinkADRReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_RekeyConf) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_LinkADRReqFieldPathsNested
-	}
-
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		_ = subs
-		switch name {
-		case "data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "data_rate_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "tx_power_index":
-
-			if m.GetTxPowerIndex() > 15 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "tx_power_index",
-					reason: "value must be less than or equal to 15",
-				}
-			}
-
-		case "channel_mask":
-
-			if len(m.GetChannelMask()) > 16 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "channel_mask",
-					reason: "value must contain no more than 16 item(s)",
-				}
-			}
-
-		case "channel_mask_control":
-
-			if m.GetChannelMaskControl() > 7 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "channel_mask_control",
-					reason: "value must be less than or equal to 7",
-				}
-			}
+		paths = MACCommand_RekeyConfFieldPathsNested
+	}
 
-		case "nb_trans":
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
 
-			if m.GetNbTrans() > 15 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "nb_trans",
-					reason: "value must be less than or equal to 15",
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_RekeyConfValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_LinkADRReqValidationError{
+			return MACCommand_RekeyConfValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3636,9 +5278,9 @@ func (m *MACCommand_LinkADRReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_LinkADRReqValidationError is the validation error returned by
-// MACCommand_LinkADRReq.ValidateFields if the designated constraints aren't met.
-type MACCommand_LinkADRReqValidationError struct {
+// MACCommand_RekeyConfValidationError is the validation error returned by
+// MACCommand_RekeyConf.ValidateFields if the designated constraints aren't met.
+type MACCommand_RekeyConfValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3646,24 +5288,24 @@ type MACCommand_LinkADRReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_LinkADRReqValidationError) Field() string { return e.field }
+func (e MACCommand_RekeyConfValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_LinkADRReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RekeyConfValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_LinkADRReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RekeyConfValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_LinkADRReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RekeyConfValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_LinkADRReqValidationError) ErrorName() string {
-	return "MACCommand_LinkADRReqValidationError"
+func (e MACCommand_RekeyConfValidationError) ErrorName() string {
+	return "MACCommand_RekeyConfValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_LinkADRReqValidationError) Error() string {
+func (e MACCommand_RekeyConfValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3675,14 +5317,14 @@ func (e MACCommand_LinkADRReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_LinkADRReq.%s: %s%s",
+		"invalid %sMACCommand_RekeyConf.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_LinkADRReqValidationError{}
+var _ error = MACCommand_RekeyConfValidationError{}
 
 var _ interface {
 	Field() string
@@ -3690,31 +5332,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_LinkADRReqValidationError{}
+} = MACCommand_RekeyConfValidationError{}
 
-// ValidateFields checks the field values on MACCommand_LinkADRAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_LinkADRAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_ADRParamSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_ADRParamSetupReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_LinkADRAnsFieldPathsNested
+		paths = MACCommand_ADRParamSetupReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_mask_ack":
-			// no validation rules for ChannelMaskAck
-		case "data_rate_index_ack":
-			// no validation rules for DataRateIndexAck
-		case "tx_power_index_ack":
-			// no validation rules for TxPowerIndexAck
+		case "adr_ack_limit_exponent":
+
+			if _, ok := ADRAckLimitExponent_name[int32(m.GetADRAckLimitExponent())]; !ok {
+				return MACCommand_ADRParamSetupReqValidationError{
+					field:  "adr_ack_limit_exponent",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "adr_ack_delay_exponent":
+
+			if _, ok := ADRAckDelayExponent_name[int32(m.GetADRAckDelayExponent())]; !ok {
+				return MACCommand_ADRParamSetupReqValidationError{
+					field:  "adr_ack_delay_exponent",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return MACCommand_LinkADRAnsValidationError{
+			return MACCommand_ADRParamSetupReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3723,9 +5377,10 @@ func (m *MACCommand_LinkADRAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_LinkADRAnsValidationError is the validation error returned by
-// MACCommand_LinkADRAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_LinkADRAnsValidationError struct {
+// MACCommand_ADRParamSetupReqValidationError is the validation error returned
+// by MACCommand_ADRParamSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_ADRParamSetupReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3733,24 +5388,24 @@ type MACCommand_LinkADRAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_LinkADRAnsValidationError) Field() string { return e.field }
+func (e MACCommand_ADRParamSetupReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_LinkADRAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_ADRParamSetupReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_LinkADRAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_ADRParamSetupReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_LinkADRAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_ADRParamSetupReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_LinkADRAnsValidationError) ErrorName() string {
-	return "MACCommand_LinkADRAnsValidationError"
+func (e MACCommand_ADRParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_ADRParamSetupReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_LinkADRAnsValidationError) Error() string {
+func (e MACCommand_ADRParamSetupReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3762,14 +5417,14 @@ func (e MACCommand_LinkADRAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_LinkADRAns.%s: %s%s",
+		"invalid %sMACCommand_ADRParamSetupReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_LinkADRAnsValidationError{}
+var _ error = MACCommand_ADRParamSetupReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3777,34 +5432,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_LinkADRAnsValidationError{}
+} = MACCommand_ADRParamSetupReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DutyCycleReq with the
+// ValidateFields checks the field values on MACCommand_DeviceTimeAns with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_DutyCycleReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_DeviceTimeAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DutyCycleReqFieldPathsNested
+		paths = MACCommand_DeviceTimeAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_duty_cycle":
-
-			if _, ok := AggregatedDutyCycle_name[int32(m.GetMaxDutyCycle())]; !ok {
-				return MACCommand_DutyCycleReqValidationError{
-					field:  "max_duty_cycle",
-					reason: "value must be one of the defined enum values",
-				}
-			}
+		case "time":
 
 		default:
-			return MACCommand_DutyCycleReqValidationError{
+			return MACCommand_DeviceTimeAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3813,9 +5461,10 @@ func (m *MACCommand_DutyCycleReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DutyCycleReqValidationError is the validation error returned by
-// MACCommand_DutyCycleReq.ValidateFields if the designated constraints aren't met.
-type MACCommand_DutyCycleReqValidationError struct {
+// MACCommand_DeviceTimeAnsValidationError is the validation error returned by
+// MACCommand_DeviceTimeAns.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_DeviceTimeAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3823,24 +5472,24 @@ type MACCommand_DutyCycleReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DutyCycleReqValidationError) Field() string { return e.field }
+func (e MACCommand_DeviceTimeAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DutyCycleReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_DeviceTimeAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DutyCycleReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_DeviceTimeAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DutyCycleReqValidationError) Key() bool { return e.key }
+func (e MACCommand_DeviceTimeAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DutyCycleReqValidationError) ErrorName() string {
-	return "MACCommand_DutyCycleReqValidationError"
+func (e MACCommand_DeviceTimeAnsValidationError) ErrorName() string {
+	return "MACCommand_DeviceTimeAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DutyCycleReqValidationError) Error() string {
+func (e MACCommand_DeviceTimeAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3852,14 +5501,14 @@ func (e MACCommand_DutyCycleReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DutyCycleReq.%s: %s%s",
+		"invalid %sMACCommand_DeviceTimeAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DutyCycleReqValidationError{}
+var _ error = MACCommand_DeviceTimeAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3867,52 +5516,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DutyCycleReqValidationError{}
+} = MACCommand_DeviceTimeAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RxParamSetupReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_RxParamSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_ForceRejoinReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ForceRejoinReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RxParamSetupReqFieldPathsNested
+		paths = MACCommand_ForceRejoinReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "rx2_data_rate_index":
+		case "rejoin_type":
 
-			if _, ok := DataRateIndex_name[int32(m.GetRx2DataRateIndex())]; !ok {
-				return MACCommand_RxParamSetupReqValidationError{
-					field:  "rx2_data_rate_index",
+			if _, ok := RejoinType_name[int32(m.GetRejoinType())]; !ok {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "rejoin_type",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "rx1_data_rate_offset":
+		case "data_rate_index":
 
-			if m.GetRx1DataRateOffset() > 7 {
-				return MACCommand_RxParamSetupReqValidationError{
-					field:  "rx1_data_rate_offset",
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "max_retries":
+
+			if m.GetMaxRetries() > 7 {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "max_retries",
 					reason: "value must be less than or equal to 7",
 				}
 			}
 
-		case "rx2_frequency":
+		case "period_exponent":
 
-			if m.GetRx2Frequency() < 100000 {
-				return MACCommand_RxParamSetupReqValidationError{
-					field:  "rx2_frequency",
-					reason: "value must be greater than or equal to 100000",
+			if _, ok := RejoinPeriodExponent_name[int32(m.GetPeriodExponent())]; !ok {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "period_exponent",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_RxParamSetupReqValidationError{
+			return MACCommand_ForceRejoinReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3921,10 +5579,10 @@ func (m *MACCommand_RxParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RxParamSetupReqValidationError is the validation error returned
-// by MACCommand_RxParamSetupReq.ValidateFields if the designated constraints
+// MACCommand_ForceRejoinReqValidationError is the validation error returned by
+// MACCommand_ForceRejoinReq.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_RxParamSetupReqValidationError struct {
+type MACCommand_ForceRejoinReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3932,24 +5590,24 @@ type MACCommand_RxParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RxParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_ForceRejoinReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RxParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_ForceRejoinReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RxParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_ForceRejoinReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RxParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_ForceRejoinReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RxParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_RxParamSetupReqValidationError"
+func (e MACCommand_ForceRejoinReqValidationError) ErrorName() string {
+	return "MACCommand_ForceRejoinReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RxParamSetupReqValidationError) Error() string {
+func (e MACCommand_ForceRejoinReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3961,14 +5619,14 @@ func (e MACCommand_RxParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RxParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_ForceRejoinReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RxParamSetupReqValidationError{}
+var _ error = MACCommand_ForceRejoinReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3976,31 +5634,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RxParamSetupReqValidationError{}
+} = MACCommand_ForceRejoinReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RxParamSetupAns with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_RxParamSetupAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RejoinParamSetupReq
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RejoinParamSetupReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RxParamSetupAnsFieldPathsNested
+		paths = MACCommand_RejoinParamSetupReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "rx2_data_rate_index_ack":
-			// no validation rules for Rx2DataRateIndexAck
-		case "rx1_data_rate_offset_ack":
-			// no validation rules for Rx1DataRateOffsetAck
-		case "rx2_frequency_ack":
-			// no validation rules for Rx2FrequencyAck
+		case "max_count_exponent":
+
+			if _, ok := RejoinCountExponent_name[int32(m.GetMaxCountExponent())]; !ok {
+				return MACCommand_RejoinParamSetupReqValidationError{
+					field:  "max_count_exponent",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "max_time_exponent":
+
+			if _, ok := RejoinTimeExponent_name[int32(m.GetMaxTimeExponent())]; !ok {
+				return MACCommand_RejoinParamSetupReqValidationError{
+					field:  "max_time_exponent",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return MACCommand_RxParamSetupAnsValidationError{
+			return MACCommand_RejoinParamSetupReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4009,10 +5679,10 @@ func (m *MACCommand_RxParamSetupAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RxParamSetupAnsValidationError is the validation error returned
-// by MACCommand_RxParamSetupAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_RxParamSetupAnsValidationError struct {
+// MACCommand_RejoinParamSetupReqValidationError is the validation error
+// returned by MACCommand_RejoinParamSetupReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RejoinParamSetupReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4020,24 +5690,24 @@ type MACCommand_RxParamSetupAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RxParamSetupAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RejoinParamSetupReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RxParamSetupAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RejoinParamSetupReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RxParamSetupAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RejoinParamSetupReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RxParamSetupAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RejoinParamSetupReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RxParamSetupAnsValidationError) ErrorName() string {
-	return "MACCommand_RxParamSetupAnsValidationError"
+func (e MACCommand_RejoinParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_RejoinParamSetupReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RxParamSetupAnsValidationError) Error() string {
+func (e MACCommand_RejoinParamSetupReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4049,14 +5719,14 @@ func (e MACCommand_RxParamSetupAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RxParamSetupAns.%s: %s%s",
+		"invalid %sMACCommand_RejoinParamSetupReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RxParamSetupAnsValidationError{}
+var _ error = MACCommand_RejoinParamSetupReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4064,43 +5734,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RxParamSetupAnsValidationError{}
+} = MACCommand_RejoinParamSetupReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DevStatusAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DevStatusAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RejoinParamSetupAns
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RejoinParamSetupAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DevStatusAnsFieldPathsNested
+		paths = MACCommand_RejoinParamSetupAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "battery":
-
-			if m.GetBattery() > 255 {
-				return MACCommand_DevStatusAnsValidationError{
-					field:  "battery",
-					reason: "value must be less than or equal to 255",
-				}
-			}
-
-		case "margin":
-
-			if val := m.GetMargin(); val < -32 || val > 31 {
-				return MACCommand_DevStatusAnsValidationError{
-					field:  "margin",
-					reason: "value must be inside range [-32, 31]",
-				}
-			}
-
+		case "max_time_exponent_ack":
+			// no validation rules for MaxTimeExponentAck
 		default:
-			return MACCommand_DevStatusAnsValidationError{
+			return MACCommand_RejoinParamSetupAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4109,9 +5763,10 @@ func (m *MACCommand_DevStatusAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DevStatusAnsValidationError is the validation error returned by
-// MACCommand_DevStatusAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_DevStatusAnsValidationError struct {
+// MACCommand_RejoinParamSetupAnsValidationError is the validation error
+// returned by MACCommand_RejoinParamSetupAns.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RejoinParamSetupAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4119,24 +5774,24 @@ type MACCommand_DevStatusAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DevStatusAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DevStatusAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DevStatusAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DevStatusAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DevStatusAnsValidationError) ErrorName() string {
-	return "MACCommand_DevStatusAnsValidationError"
+func (e MACCommand_RejoinParamSetupAnsValidationError) ErrorName() string {
+	return "MACCommand_RejoinParamSetupAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DevStatusAnsValidationError) Error() string {
+func (e MACCommand_RejoinParamSetupAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4148,14 +5803,14 @@ func (e MACCommand_DevStatusAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DevStatusAns.%s: %s%s",
+		"invalid %sMACCommand_RejoinParamSetupAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DevStatusAnsValidationError{}
+var _ error = MACCommand_RejoinParamSetupAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4163,61 +5818,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DevStatusAnsValidationError{}
+} = MACCommand_RejoinParamSetupAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_NewChannelReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_NewChannelReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_PingSlotInfoReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_PingSlotInfoReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_NewChannelReqFieldPathsNested
+		paths = MACCommand_PingSlotInfoReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_index":
-
-			if m.GetChannelIndex() > 255 {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "channel_index",
-					reason: "value must be less than or equal to 255",
-				}
-			}
-
-		case "frequency":
-
-			if val := m.GetFrequency(); val > 0 && val < 100000 {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "frequency",
-					reason: "value must be outside range (0, 100000)",
-				}
-			}
-
-		case "min_data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetMinDataRateIndex())]; !ok {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "min_data_rate_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "max_data_rate_index":
+		case "period":
 
-			if _, ok := DataRateIndex_name[int32(m.GetMaxDataRateIndex())]; !ok {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "max_data_rate_index",
+			if _, ok := PingSlotPeriod_name[int32(m.GetPeriod())]; !ok {
+				return MACCommand_PingSlotInfoReqValidationError{
+					field:  "period",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_NewChannelReqValidationError{
+			return MACCommand_PingSlotInfoReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4226,10 +5854,10 @@ func (m *MACCommand_NewChannelReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_NewChannelReqValidationError is the validation error returned by
-// MACCommand_NewChannelReq.ValidateFields if the designated constraints
+// MACCommand_PingSlotInfoReqValidationError is the validation error returned
+// by MACCommand_PingSlotInfoReq.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_NewChannelReqValidationError struct {
+type MACCommand_PingSlotInfoReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4237,24 +5865,24 @@ type MACCommand_NewChannelReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_NewChannelReqValidationError) Field() string { return e.field }
+func (e MACCommand_PingSlotInfoReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_NewChannelReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_PingSlotInfoReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_NewChannelReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_PingSlotInfoReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_NewChannelReqValidationError) Key() bool { return e.key }
+func (e MACCommand_PingSlotInfoReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_NewChannelReqValidationError) ErrorName() string {
-	return "MACCommand_NewChannelReqValidationError"
+func (e MACCommand_PingSlotInfoReqValidationError) ErrorName() string {
+	return "MACCommand_PingSlotInfoReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_NewChannelReqValidationError) Error() string {
+func (e MACCommand_PingSlotInfoReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4266,14 +5894,14 @@ func (e MACCommand_NewChannelReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_NewChannelReq.%s: %s%s",
+		"invalid %sMACCommand_PingSlotInfoReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_NewChannelReqValidationError{}
+var _ error = MACCommand_PingSlotInfoReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4281,29 +5909,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_NewChannelReqValidationError{}
+} = MACCommand_PingSlotInfoReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_NewChannelAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_NewChannelAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_PingSlotChannelReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_PingSlotChannelReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_NewChannelAnsFieldPathsNested
+		paths = MACCommand_PingSlotChannelReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
-		case "data_rate_ack":
-			// no validation rules for DataRateAck
+		case "frequency":
+
+			if val := m.GetFrequency(); val > 0 && val < 100000 {
+				return MACCommand_PingSlotChannelReqValidationError{
+					field:  "frequency",
+					reason: "value must be outside range (0, 100000)",
+				}
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACCommand_PingSlotChannelReqValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return MACCommand_NewChannelAnsValidationError{
+			return MACCommand_PingSlotChannelReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4312,10 +5954,10 @@ func (m *MACCommand_NewChannelAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_NewChannelAnsValidationError is the validation error returned by
-// MACCommand_NewChannelAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_NewChannelAnsValidationError struct {
+// MACCommand_PingSlotChannelReqValidationError is the validation error
+// returned by MACCommand_PingSlotChannelReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_PingSlotChannelReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4323,24 +5965,24 @@ type MACCommand_NewChannelAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_NewChannelAnsValidationError) Field() string { return e.field }
+func (e MACCommand_PingSlotChannelReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_NewChannelAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_PingSlotChannelReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_NewChannelAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_PingSlotChannelReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_NewChannelAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_PingSlotChannelReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_NewChannelAnsValidationError) ErrorName() string {
-	return "MACCommand_NewChannelAnsValidationError"
+func (e MACCommand_PingSlotChannelReqValidationError) ErrorName() string {
+	return "MACCommand_PingSlotChannelReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_NewChannelAnsValidationError) Error() string {
+func (e MACCommand_PingSlotChannelReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4352,14 +5994,14 @@ func (e MACCommand_NewChannelAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_NewChannelAns.%s: %s%s",
+		"invalid %sMACCommand_PingSlotChannelReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_NewChannelAnsValidationError{}
+var _ error = MACCommand_PingSlotChannelReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4367,43 +6009,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_NewChannelAnsValidationError{}
+} = MACCommand_PingSlotChannelReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DLChannelReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DLChannelReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_PingSlotChannelAns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_PingSlotChannelAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DLChannelReqFieldPathsNested
-	}
-
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		_ = subs
-		switch name {
-		case "channel_index":
-
-			if m.GetChannelIndex() > 255 {
-				return MACCommand_DLChannelReqValidationError{
-					field:  "channel_index",
-					reason: "value must be less than or equal to 255",
-				}
-			}
-
-		case "frequency":
-
-			if m.GetFrequency() < 100000 {
-				return MACCommand_DLChannelReqValidationError{
-					field:  "frequency",
-					reason: "value must be greater than or equal to 100000",
-				}
-			}
+		paths = MACCommand_PingSlotChannelAnsFieldPathsNested
+	}
 
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
+		case "data_rate_index_ack":
+			// no validation rules for DataRateIndexAck
 		default:
-			return MACCommand_DLChannelReqValidationError{
+			return MACCommand_PingSlotChannelAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4412,9 +6040,10 @@ func (m *MACCommand_DLChannelReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DLChannelReqValidationError is the validation error returned by
-// MACCommand_DLChannelReq.ValidateFields if the designated constraints aren't met.
-type MACCommand_DLChannelReqValidationError struct {
+// MACCommand_PingSlotChannelAnsValidationError is the validation error
+// returned by MACCommand_PingSlotChannelAns.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_PingSlotChannelAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4422,24 +6051,24 @@ type MACCommand_DLChannelReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DLChannelReqValidationError) Field() string { return e.field }
+func (e MACCommand_PingSlotChannelAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DLChannelReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_PingSlotChannelAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DLChannelReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_PingSlotChannelAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DLChannelReqValidationError) Key() bool { return e.key }
+func (e MACCommand_PingSlotChannelAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DLChannelReqValidationError) ErrorName() string {
-	return "MACCommand_DLChannelReqValidationError"
+func (e MACCommand_PingSlotChannelAnsValidationError) ErrorName() string {
+	return "MACCommand_PingSlotChannelAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DLChannelReqValidationError) Error() string {
+func (e MACCommand_PingSlotChannelAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4451,14 +6080,14 @@ func (e MACCommand_DLChannelReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DLChannelReq.%s: %s%s",
+		"invalid %sMACCommand_PingSlotChannelAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DLChannelReqValidationError{}
+var _ error = MACCommand_PingSlotChannelAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4466,29 +6095,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DLChannelReqValidationError{}
+} = MACCommand_PingSlotChannelAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DLChannelAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DLChannelAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_BeaconTimingAns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_BeaconTimingAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DLChannelAnsFieldPathsNested
+		paths = MACCommand_BeaconTimingAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_index_ack":
-			// no validation rules for ChannelIndexAck
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
+		case "delay":
+
+			if m.GetDelay() > 65535 {
+				return MACCommand_BeaconTimingAnsValidationError{
+					field:  "delay",
+					reason: "value must be less than or equal to 65535",
+				}
+			}
+
+		case "channel_index":
+
+			if m.GetChannelIndex() > 255 {
+				return MACCommand_BeaconTimingAnsValidationError{
+					field:  "channel_index",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
 		default:
-			return MACCommand_DLChannelAnsValidationError{
+			return MACCommand_BeaconTimingAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4497,9 +6140,10 @@ func (m *MACCommand_DLChannelAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DLChannelAnsValidationError is the validation error returned by
-// MACCommand_DLChannelAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_DLChannelAnsValidationError struct {
+// MACCommand_BeaconTimingAnsValidationError is the validation error returned
+// by MACCommand_BeaconTimingAns.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_BeaconTimingAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4507,24 +6151,24 @@ type MACCommand_DLChannelAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DLChannelAnsValidationError) Field() string { return e.field }
+func (e MACCommand_BeaconTimingAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DLChannelAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_BeaconTimingAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DLChannelAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_BeaconTimingAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DLChannelAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_BeaconTimingAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DLChannelAnsValidationError) ErrorName() string {
-	return "MACCommand_DLChannelAnsValidationError"
+func (e MACCommand_BeaconTimingAnsValidationError) ErrorName() string {
+	return "MACCommand_BeaconTimingAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DLChannelAnsValidationError) Error() string {
+func (e MACCommand_BeaconTimingAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4536,14 +6180,14 @@ func (e MACCommand_DLChannelAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DLChannelAns.%s: %s%s",
+		"invalid %sMACCommand_BeaconTimingAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DLChannelAnsValidationError{}
+var _ error = MACCommand_BeaconTimingAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4551,34 +6195,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DLChannelAnsValidationError{}
+} = MACCommand_BeaconTimingAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RxTimingSetupReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_BeaconFreqReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_BeaconFreqReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RxTimingSetupReqFieldPathsNested
+		paths = MACCommand_BeaconFreqReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "delay":
+		case "frequency":
 
-			if _, ok := RxDelay_name[int32(m.GetDelay())]; !ok {
-				return MACCommand_RxTimingSetupReqValidationError{
-					field:  "delay",
-					reason: "value must be one of the defined enum values",
+			if val := m.GetFrequency(); val > 0 && val < 100000 {
+				return MACCommand_BeaconFreqReqValidationError{
+					field:  "frequency",
+					reason: "value must be outside range (0, 100000)",
 				}
 			}
 
 		default:
-			return MACCommand_RxTimingSetupReqValidationError{
+			return MACCommand_BeaconFreqReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4587,10 +6231,10 @@ func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RxTimingSetupReqValidationError is the validation error returned
-// by MACCommand_RxTimingSetupReq.ValidateFields if the designated constraints
+// MACCommand_BeaconFreqReqValidationError is the validation error returned by
+// MACCommand_BeaconFreqReq.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_RxTimingSetupReqValidationError struct {
+type MACCommand_BeaconFreqReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4598,24 +6242,24 @@ type MACCommand_RxTimingSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RxTimingSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_BeaconFreqReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RxTimingSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_BeaconFreqReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RxTimingSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_BeaconFreqReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RxTimingSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_BeaconFreqReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RxTimingSetupReqValidationError) ErrorName() string {
-	return "MACCommand_RxTimingSetupReqValidationError"
+func (e MACCommand_BeaconFreqReqValidationError) ErrorName() string {
+	return "MACCommand_BeaconFreqReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RxTimingSetupReqValidationError) Error() string {
+func (e MACCommand_BeaconFreqReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4627,14 +6271,14 @@ func (e MACCommand_RxTimingSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RxTimingSetupReq.%s: %s%s",
+		"invalid %sMACCommand_BeaconFreqReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RxTimingSetupReqValidationError{}
+var _ error = MACCommand_BeaconFreqReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4642,38 +6286,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RxTimingSetupReqValidationError{}
+} = MACCommand_BeaconFreqReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_TxParamSetupReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_TxParamSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_BeaconFreqAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_BeaconFreqAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_TxParamSetupReqFieldPathsNested
+		paths = MACCommand_BeaconFreqAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_eirp_index":
-
-			if _, ok := DeviceEIRP_name[int32(m.GetMaxEIRPIndex())]; !ok {
-				return MACCommand_TxParamSetupReqValidationError{
-					field:  "max_eirp_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "uplink_dwell_time":
-			// no validation rules for UplinkDwellTime
-		case "downlink_dwell_time":
-			// no validation rules for DownlinkDwellTime
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
 		default:
-			return MACCommand_TxParamSetupReqValidationError{
+			return MACCommand_BeaconFreqAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4682,10 +6315,10 @@ func (m *MACCommand_TxParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_TxParamSetupReqValidationError is the validation error returned
-// by MACCommand_TxParamSetupReq.ValidateFields if the designated constraints
+// MACCommand_BeaconFreqAnsValidationError is the validation error returned by
+// MACCommand_BeaconFreqAns.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_TxParamSetupReqValidationError struct {
+type MACCommand_BeaconFreqAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4693,24 +6326,24 @@ type MACCommand_TxParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_TxParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_BeaconFreqAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_TxParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_BeaconFreqAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_TxParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_BeaconFreqAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_TxParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_BeaconFreqAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_TxParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_TxParamSetupReqValidationError"
+func (e MACCommand_BeaconFreqAnsValidationError) ErrorName() string {
+	return "MACCommand_BeaconFreqAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_TxParamSetupReqValidationError) Error() string {
+func (e MACCommand_BeaconFreqAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4722,14 +6355,14 @@ func (e MACCommand_TxParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_TxParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_BeaconFreqAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_TxParamSetupReqValidationError{}
+var _ error = MACCommand_BeaconFreqAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4737,34 +6370,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_TxParamSetupReqValidationError{}
+} = MACCommand_BeaconFreqAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RekeyInd with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on MACCommand_DeviceModeInd with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_RekeyInd) ValidateFields(paths ...string) error {
+func (m *MACCommand_DeviceModeInd) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RekeyIndFieldPathsNested
+		paths = MACCommand_DeviceModeIndFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
+		case "class":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_RekeyIndValidationError{
-					field:  "minor_version",
+			if _, ok := Class_name[int32(m.GetClass())]; !ok {
+				return MACCommand_DeviceModeIndValidationError{
+					field:  "class",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_RekeyIndValidationError{
+			return MACCommand_DeviceModeIndValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4773,9 +6406,10 @@ func (m *MACCommand_RekeyInd) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RekeyIndValidationError is the validation error returned by
-// MACCommand_RekeyInd.ValidateFields if the designated constraints aren't met.
-type MACCommand_RekeyIndValidationError struct {
+// MACCommand_DeviceModeIndValidationError is the validation error returned by
+// MACCommand_DeviceModeInd.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_DeviceModeIndValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4783,24 +6417,24 @@ type MACCommand_RekeyIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RekeyIndValidationError) Field() string { return e.field }
+func (e MACCommand_DeviceModeIndValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RekeyIndValidationError) Reason() string { return e.reason }
+func (e MACCommand_DeviceModeIndValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RekeyIndValidationError) Cause() error { return e.cause }
+func (e MACCommand_DeviceModeIndValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RekeyIndValidationError) Key() bool { return e.key }
+func (e MACCommand_DeviceModeIndValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RekeyIndValidationError) ErrorName() string {
-	return "MACCommand_RekeyIndValidationError"
+func (e MACCommand_DeviceModeIndValidationError) ErrorName() string {
+	return "MACCommand_DeviceModeIndValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RekeyIndValidationError) Error() string {
+func (e MACCommand_DeviceModeIndValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4812,14 +6446,14 @@ func (e MACCommand_RekeyIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RekeyInd.%s: %s%s",
+		"invalid %sMACCommand_DeviceModeInd.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RekeyIndValidationError{}
+var _ error = MACCommand_DeviceModeIndValidationError{}
 
 var _ interface {
 	Field() string
@@ -4827,34 +6461,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RekeyIndValidationError{}
+} = MACCommand_DeviceModeIndValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RekeyConf with the
+// ValidateFields checks the field values on MACCommand_DeviceModeConf with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_RekeyConf) ValidateFields(paths ...string) error {
+func (m *MACCommand_DeviceModeConf) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RekeyConfFieldPathsNested
+		paths = MACCommand_DeviceModeConfFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
+		case "class":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_RekeyConfValidationError{
-					field:  "minor_version",
+			if _, ok := Class_name[int32(m.GetClass())]; !ok {
+				return MACCommand_DeviceModeConfValidationError{
+					field:  "class",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_RekeyConfValidationError{
+			return MACCommand_DeviceModeConfValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4863,9 +6497,10 @@ func (m *MACCommand_RekeyConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RekeyConfValidationError is the validation error returned by
-// MACCommand_RekeyConf.ValidateFields if the designated constraints aren't met.
-type MACCommand_RekeyConfValidationError struct {
+// MACCommand_DeviceModeConfValidationError is the validation error returned by
+// MACCommand_DeviceModeConf.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_DeviceModeConfValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4873,24 +6508,24 @@ type MACCommand_RekeyConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RekeyConfValidationError) Field() string { return e.field }
+func (e MACCommand_DeviceModeConfValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RekeyConfValidationError) Reason() string { return e.reason }
+func (e MACCommand_DeviceModeConfValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RekeyConfValidationError) Cause() error { return e.cause }
+func (e MACCommand_DeviceModeConfValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RekeyConfValidationError) Key() bool { return e.key }
+func (e MACCommand_DeviceModeConfValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RekeyConfValidationError) ErrorName() string {
-	return "MACCommand_RekeyConfValidationError"
+func (e MACCommand_DeviceModeConfValidationError) ErrorName() string {
+	return "MACCommand_DeviceModeConfValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RekeyConfValidationError) Error() string {
+func (e MACCommand_DeviceModeConfValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4902,14 +6537,14 @@ func (e MACCommand_RekeyConfValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RekeyConf.%s: %s%s",
+		"invalid %sMACCommand_DeviceModeConf.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RekeyConfValidationError{}
+var _ error = MACCommand_DeviceModeConfValidationError{}
 
 var _ interface {
 	Field() string
@@ -4917,43 +6552,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RekeyConfValidationError{}
+} = MACCommand_DeviceModeConfValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ADRParamSetupReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_ADRParamSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayConfReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RelayConfReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ADRParamSetupReqFieldPathsNested
+		paths = MACCommand_RelayConfReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "adr_ack_limit_exponent":
+		case "enable":
+			// no validation rules for Enable
+		case "cad_periodicity":
 
-			if _, ok := ADRAckLimitExponent_name[int32(m.GetADRAckLimitExponent())]; !ok {
-				return MACCommand_ADRParamSetupReqValidationError{
-					field:  "adr_ack_limit_exponent",
+			if _, ok := RelayCADPeriodicity_name[int32(m.GetCADPeriodicity())]; !ok {
+				return MACCommand_RelayConfReqValidationError{
+					field:  "cad_periodicity",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "adr_ack_delay_exponent":
+		case "default_channel_index":
 
-			if _, ok := ADRAckDelayExponent_name[int32(m.GetADRAckDelayExponent())]; !ok {
-				return MACCommand_ADRParamSetupReqValidationError{
-					field:  "adr_ack_delay_exponent",
-					reason: "value must be one of the defined enum values",
+			if m.GetDefaultChannelIndex() > 3 {
+				return MACCommand_RelayConfReqValidationError{
+					field:  "default_channel_index",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfReqValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
 		default:
-			return MACCommand_ADRParamSetupReqValidationError{
+			return MACCommand_RelayConfReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4962,10 +6611,10 @@ func (m *MACCommand_ADRParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ADRParamSetupReqValidationError is the validation error returned
-// by MACCommand_ADRParamSetupReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_ADRParamSetupReqValidationError struct {
+// MACCommand_RelayConfReqValidationError is the validation error returned by
+// MACCommand_RelayConfReq.ValidateFields if the designated constraints aren't
+// met.
+type MACCommand_RelayConfReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4973,24 +6622,24 @@ type MACCommand_ADRParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ADRParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ADRParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ADRParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ADRParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ADRParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_ADRParamSetupReqValidationError"
+func (e MACCommand_RelayConfReqValidationError) ErrorName() string {
+	return "MACCommand_RelayConfReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ADRParamSetupReqValidationError) Error() string {
+func (e MACCommand_RelayConfReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5002,14 +6651,14 @@ func (e MACCommand_ADRParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ADRParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_RelayConfReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ADRParamSetupReqValidationError{}
+var _ error = MACCommand_RelayConfReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5017,27 +6666,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ADRParamSetupReqValidationError{}
+} = MACCommand_RelayConfReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DeviceTimeAns with the
+// ValidateFields checks the field values on MACCommand_RelayConfAns with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_DeviceTimeAns) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayConfAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DeviceTimeAnsFieldPathsNested
+		paths = MACCommand_RelayConfAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "time":
-
+		case "second_channel_frequency_ack":
+			// no validation rules for SecondChannelFrequencyAck
+		case "second_channel_ack_offset_ack":
+			// no validation rules for SecondChannelAckOffsetAck
+		case "second_channel_data_rate_index_ack":
+			// no validation rules for SecondChannelDataRateIndexAck
+		case "second_channel_index_ack":
+			// no validation rules for SecondChannelIndexAck
+		case "default_channel_index_ack":
+			// no validation rules for DefaultChannelIndexAck
+		case "cad_periodicity_ack":
+			// no validation rules for CADPeriodicityAck
 		default:
-			return MACCommand_DeviceTimeAnsValidationError{
+			return MACCommand_RelayConfAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5046,10 +6705,10 @@ func (m *MACCommand_DeviceTimeAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DeviceTimeAnsValidationError is the validation error returned by
-// MACCommand_DeviceTimeAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_DeviceTimeAnsValidationError struct {
+// MACCommand_RelayConfAnsValidationError is the validation error returned by
+// MACCommand_RelayConfAns.ValidateFields if the designated constraints aren't
+// met.
+type MACCommand_RelayConfAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5057,24 +6716,24 @@ type MACCommand_DeviceTimeAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DeviceTimeAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DeviceTimeAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DeviceTimeAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DeviceTimeAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DeviceTimeAnsValidationError) ErrorName() string {
-	return "MACCommand_DeviceTimeAnsValidationError"
+func (e MACCommand_RelayConfAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayConfAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DeviceTimeAnsValidationError) Error() string {
+func (e MACCommand_RelayConfAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5086,14 +6745,14 @@ func (e MACCommand_DeviceTimeAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DeviceTimeAns.%s: %s%s",
+		"invalid %sMACCommand_RelayConfAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DeviceTimeAnsValidationError{}
+var _ error = MACCommand_RelayConfAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5101,61 +6760,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DeviceTimeAnsValidationError{}
+} = MACCommand_RelayConfAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ForceRejoinReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_ForceRejoinReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayEndDeviceConfReq
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RelayEndDeviceConfReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ForceRejoinReqFieldPathsNested
+		paths = MACCommand_RelayEndDeviceConfReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "rejoin_type":
+		case "mode":
 
-			if _, ok := RejoinType_name[int32(m.GetRejoinType())]; !ok {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "rejoin_type",
+			if _, ok := RelayEndDeviceMode_name[int32(m.GetMode())]; !ok {
+				return MACCommand_RelayEndDeviceConfReqValidationError{
+					field:  "mode",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "data_rate_index":
+		case "smart_enable_level":
 
-			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "data_rate_index",
+			if _, ok := RelaySmartEnableLevel_name[int32(m.GetSmartEnableLevel())]; !ok {
+				return MACCommand_RelayEndDeviceConfReqValidationError{
+					field:  "smart_enable_level",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "max_retries":
+		case "backoff":
 
-			if m.GetMaxRetries() > 7 {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "max_retries",
-					reason: "value must be less than or equal to 7",
+			if m.GetBackoff() > 63 {
+				return MACCommand_RelayEndDeviceConfReqValidationError{
+					field:  "backoff",
+					reason: "value must be less than or equal to 63",
 				}
 			}
 
-		case "period_exponent":
+		case "second_channel":
 
-			if _, ok := RejoinPeriodExponent_name[int32(m.GetPeriodExponent())]; !ok {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "period_exponent",
-					reason: "value must be one of the defined enum values",
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayEndDeviceConfReqValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
 		default:
-			return MACCommand_ForceRejoinReqValidationError{
+			return MACCommand_RelayEndDeviceConfReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5164,10 +6826,10 @@ func (m *MACCommand_ForceRejoinReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ForceRejoinReqValidationError is the validation error returned by
-// MACCommand_ForceRejoinReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_ForceRejoinReqValidationError struct {
+// MACCommand_RelayEndDeviceConfReqValidationError is the validation error
+// returned by MACCommand_RelayEndDeviceConfReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RelayEndDeviceConfReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5175,24 +6837,24 @@ type MACCommand_ForceRejoinReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ForceRejoinReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ForceRejoinReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ForceRejoinReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ForceRejoinReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ForceRejoinReqValidationError) ErrorName() string {
-	return "MACCommand_ForceRejoinReqValidationError"
+func (e MACCommand_RelayEndDeviceConfReqValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ForceRejoinReqValidationError) Error() string {
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5204,14 +6866,14 @@ func (e MACCommand_ForceRejoinReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ForceRejoinReq.%s: %s%s",
+		"invalid %sMACCommand_RelayEndDeviceConfReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ForceRejoinReqValidationError{}
+var _ error = MACCommand_RelayEndDeviceConfReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5219,43 +6881,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ForceRejoinReqValidationError{}
+} = MACCommand_RelayEndDeviceConfReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RejoinParamSetupReq
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, an error is returned.
-func (m *MACCommand_RejoinParamSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayEndDeviceConfAns
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RelayEndDeviceConfAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RejoinParamSetupReqFieldPathsNested
+		paths = MACCommand_RelayEndDeviceConfAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_count_exponent":
-
-			if _, ok := RejoinCountExponent_name[int32(m.GetMaxCountExponent())]; !ok {
-				return MACCommand_RejoinParamSetupReqValidationError{
-					field:  "max_count_exponent",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "max_time_exponent":
-
-			if _, ok := RejoinTimeExponent_name[int32(m.GetMaxTimeExponent())]; !ok {
-				return MACCommand_RejoinParamSetupReqValidationError{
-					field:  "max_time_exponent",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "second_channel_frequency_ack":
+			// no validation rules for SecondChannelFrequencyAck
+		case "second_channel_ack_offset_ack":
+			// no validation rules for SecondChannelAckOffsetAck
+		case "second_channel_data_rate_index_ack":
+			// no validation rules for SecondChannelDataRateIndexAck
+		case "backoff_ack":
+			// no validation rules for BackoffAck
 		default:
-			return MACCommand_RejoinParamSetupReqValidationError{
+			return MACCommand_RelayEndDeviceConfAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5264,10 +6916,10 @@ func (m *MACCommand_RejoinParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RejoinParamSetupReqValidationError is the validation error
-// returned by MACCommand_RejoinParamSetupReq.ValidateFields if the designated
+// MACCommand_RelayEndDeviceConfAnsValidationError is the validation error
+// returned by MACCommand_RelayEndDeviceConfAns.ValidateFields if the designated
 // constraints aren't met.
-type MACCommand_RejoinParamSetupReqValidationError struct {
+type MACCommand_RelayEndDeviceConfAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5275,24 +6927,24 @@ type MACCommand_RejoinParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RejoinParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_RejoinParamSetupReqValidationError"
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RejoinParamSetupReqValidationError) Error() string {
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5304,14 +6956,14 @@ func (e MACCommand_RejoinParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RejoinParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_RelayEndDeviceConfAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RejoinParamSetupReqValidationError{}
+var _ error = MACCommand_RelayEndDeviceConfAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5319,27 +6971,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RejoinParamSetupReqValidationError{}
+} = MACCommand_RelayEndDeviceConfAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RejoinParamSetupAns
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, an error is returned.
-func (m *MACCommand_RejoinParamSetupAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayFilterListReq with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RelayFilterListReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RejoinParamSetupAnsFieldPathsNested
+		paths = MACCommand_RelayFilterListReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_time_exponent_ack":
-			// no validation rules for MaxTimeExponentAck
+		case "index":
+
+			if m.GetIndex() > 15 {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "action":
+
+			if _, ok := RelayFilterListAction_name[int32(m.GetAction())]; !ok {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "join_eui":
+			// no validation rules for JoinEUI
+		case "dev_eui":
+			// no validation rules for DevEUI
 		default:
-			return MACCommand_RejoinParamSetupAnsValidationError{
+			return MACCommand_RelayFilterListReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5348,10 +7020,10 @@ func (m *MACCommand_RejoinParamSetupAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RejoinParamSetupAnsValidationError is the validation error
-// returned by MACCommand_RejoinParamSetupAns.ValidateFields if the designated
-// constraints aren't met.
-type MACCommand_RejoinParamSetupAnsValidationError struct {
+// MACCommand_RelayFilterListReqValidationError is the validation error returned
+// by MACCommand_RelayFilterListReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RelayFilterListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5359,24 +7031,24 @@ type MACCommand_RejoinParamSetupAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayFilterListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayFilterListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayFilterListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayFilterListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RejoinParamSetupAnsValidationError) ErrorName() string {
-	return "MACCommand_RejoinParamSetupAnsValidationError"
+func (e MACCommand_RelayFilterListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayFilterListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RejoinParamSetupAnsValidationError) Error() string {
+func (e MACCommand_RelayFilterListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5388,14 +7060,14 @@ func (e MACCommand_RejoinParamSetupAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RejoinParamSetupAns.%s: %s%s",
+		"invalid %sMACCommand_RelayFilterListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RejoinParamSetupAnsValidationError{}
+var _ error = MACCommand_RelayFilterListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5403,34 +7075,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RejoinParamSetupAnsValidationError{}
+} = MACCommand_RelayFilterListReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_PingSlotInfoReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_PingSlotInfoReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayFilterListAns with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RelayFilterListAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_PingSlotInfoReqFieldPathsNested
+		paths = MACCommand_RelayFilterListAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "period":
-
-			if _, ok := PingSlotPeriod_name[int32(m.GetPeriod())]; !ok {
-				return MACCommand_PingSlotInfoReqValidationError{
-					field:  "period",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "index_ack":
+			// no validation rules for IndexAck
+		case "action_ack":
+			// no validation rules for ActionAck
+		case "combined_rules_ack":
+			// no validation rules for CombinedRulesAck
 		default:
-			return MACCommand_PingSlotInfoReqValidationError{
+			return MACCommand_RelayFilterListAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5439,10 +7108,10 @@ func (m *MACCommand_PingSlotInfoReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_PingSlotInfoReqValidationError is the validation error returned
-// by MACCommand_PingSlotInfoReq.ValidateFields if the designated constraints
+// MACCommand_RelayFilterListAnsValidationError is the validation error returned
+// by MACCommand_RelayFilterListAns.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_PingSlotInfoReqValidationError struct {
+type MACCommand_RelayFilterListAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5450,24 +7119,24 @@ type MACCommand_PingSlotInfoReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_PingSlotInfoReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayFilterListAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_PingSlotInfoReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayFilterListAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_PingSlotInfoReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayFilterListAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_PingSlotInfoReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayFilterListAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_PingSlotInfoReqValidationError) ErrorName() string {
-	return "MACCommand_PingSlotInfoReqValidationError"
+func (e MACCommand_RelayFilterListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayFilterListAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_PingSlotInfoReqValidationError) Error() string {
+func (e MACCommand_RelayFilterListAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5479,14 +7148,14 @@ func (e MACCommand_PingSlotInfoReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_PingSlotInfoReq.%s: %s%s",
+		"invalid %sMACCommand_RelayFilterListAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_PingSlotInfoReqValidationError{}
+var _ error = MACCommand_RelayFilterListAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5494,43 +7163,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_PingSlotInfoReqValidationError{}
+} = MACCommand_RelayFilterListAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_PingSlotChannelReq with
-// the rules defined in the proto definition for this message. If any rules
+// ValidateFields checks the field values on MACCommand_RelayUpdateUplinkListReq
+// with the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_PingSlotChannelReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayUpdateUplinkListReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_PingSlotChannelReqFieldPathsNested
+		paths = MACCommand_RelayUpdateUplinkListReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency":
+		case "rule_index":
 
-			if val := m.GetFrequency(); val > 0 && val < 100000 {
-				return MACCommand_PingSlotChannelReqValidationError{
-					field:  "frequency",
-					reason: "value must be outside range (0, 100000)",
+			if m.GetRuleIndex() > 15 {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "rule_index",
+					reason: "value must be less than or equal to 15",
 				}
 			}
-
-		case "data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
-				return MACCommand_PingSlotChannelReqValidationError{
-					field:  "data_rate_index",
-					reason: "value must be one of the defined enum values",
+
+		case "forward_limits":
+
+			if v, ok := interface{}(m.GetForwardLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayUpdateUplinkListReqValidationError{
+						field:  "forward_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "w_f_cnt":
+			// no validation rules for WFCnt
+		case "root_wor_s_key":
+			// no validation rules for RootWorSKey
 		default:
-			return MACCommand_PingSlotChannelReqValidationError{
+			return MACCommand_RelayUpdateUplinkListReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5539,10 +7217,10 @@ func (m *MACCommand_PingSlotChannelReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_PingSlotChannelReqValidationError is the validation error
-// returned by MACCommand_PingSlotChannelReq.ValidateFields if the designated
-// constraints aren't met.
-type MACCommand_PingSlotChannelReqValidationError struct {
+// MACCommand_RelayUpdateUplinkListReqValidationError is the validation error
+// returned by MACCommand_RelayUpdateUplinkListReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayUpdateUplinkListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5550,24 +7228,24 @@ type MACCommand_PingSlotChannelReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_PingSlotChannelReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_PingSlotChannelReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_PingSlotChannelReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_PingSlotChannelReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_PingSlotChannelReqValidationError) ErrorName() string {
-	return "MACCommand_PingSlotChannelReqValidationError"
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayUpdateUplinkListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_PingSlotChannelReqValidationError) Error() string {
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5579,14 +7257,14 @@ func (e MACCommand_PingSlotChannelReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_PingSlotChannelReq.%s: %s%s",
+		"invalid %sMACCommand_RelayUpdateUplinkListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_PingSlotChannelReqValidationError{}
+var _ error = MACCommand_RelayUpdateUplinkListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5594,41 +7272,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_PingSlotChannelReqValidationError{}
+} = MACCommand_RelayUpdateUplinkListReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_PingSlotChannelAns with
-// the rules defined in the proto definition for this message. If any rules
+// ValidateFields checks the field values on MACCommand_RelayUpdateUplinkListAns
+// with the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_PingSlotChannelAns) ValidateFields(paths ...string) error {
-	if m == nil {
-		return nil
-	}
-
-	if len(paths) == 0 {
-		paths = MACCommand_PingSlotChannelAnsFieldPathsNested
-	}
-
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		_ = subs
-		switch name {
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
-		case "data_rate_index_ack":
-			// no validation rules for DataRateIndexAck
-		default:
-			return MACCommand_PingSlotChannelAnsValidationError{
-				field:  name,
-				reason: "invalid field path",
-			}
-		}
+func (m *MACCommand_RelayUpdateUplinkListAns) ValidateFields(paths ...string) error {
+	if len(paths) > 0 {
+		return fmt.Errorf("message MACCommand_RelayUpdateUplinkListAns has no fields, but paths %s were specified", paths)
 	}
 	return nil
 }
 
-// MACCommand_PingSlotChannelAnsValidationError is the validation error
-// returned by MACCommand_PingSlotChannelAns.ValidateFields if the designated
-// constraints aren't met.
-type MACCommand_PingSlotChannelAnsValidationError struct {
+// MACCommand_RelayUpdateUplinkListAnsValidationError is the validation error
+// returned by MACCommand_RelayUpdateUplinkListAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayUpdateUplinkListAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5636,24 +7295,24 @@ type MACCommand_PingSlotChannelAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayUpdateUplinkListAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayUpdateUplinkListAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayUpdateUplinkListAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayUpdateUplinkListAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_PingSlotChannelAnsValidationError) ErrorName() string {
-	return "MACCommand_PingSlotChannelAnsValidationError"
+func (e MACCommand_RelayUpdateUplinkListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayUpdateUplinkListAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_PingSlotChannelAnsValidationError) Error() string {
+func (e MACCommand_RelayUpdateUplinkListAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5665,14 +7324,14 @@ func (e MACCommand_PingSlotChannelAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_PingSlotChannelAns.%s: %s%s",
+		"invalid %sMACCommand_RelayUpdateUplinkListAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_PingSlotChannelAnsValidationError{}
+var _ error = MACCommand_RelayUpdateUplinkListAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5680,43 +7339,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_PingSlotChannelAnsValidationError{}
+} = MACCommand_RelayUpdateUplinkListAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_BeaconTimingAns with
-// the rules defined in the proto definition for this message. If any rules
+// ValidateFields checks the field values on MACCommand_RelayCtrlUplinkListReq
+// with the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_BeaconTimingAns) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayCtrlUplinkListReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_BeaconTimingAnsFieldPathsNested
+		paths = MACCommand_RelayCtrlUplinkListReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "delay":
+		case "rule_index":
 
-			if m.GetDelay() > 65535 {
-				return MACCommand_BeaconTimingAnsValidationError{
-					field:  "delay",
-					reason: "value must be less than or equal to 65535",
+			if m.GetRuleIndex() > 15 {
+				return MACCommand_RelayCtrlUplinkListReqValidationError{
+					field:  "rule_index",
+					reason: "value must be less than or equal to 15",
 				}
 			}
 
-		case "channel_index":
+		case "action":
 
-			if m.GetChannelIndex() > 255 {
-				return MACCommand_BeaconTimingAnsValidationError{
-					field:  "channel_index",
-					reason: "value must be less than or equal to 255",
+			if _, ok := RelayCtrlUplinkListAction_name[int32(m.GetAction())]; !ok {
+				return MACCommand_RelayCtrlUplinkListReqValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_BeaconTimingAnsValidationError{
+			return MACCommand_RelayCtrlUplinkListReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5725,10 +7384,10 @@ func (m *MACCommand_BeaconTimingAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_BeaconTimingAnsValidationError is the validation error returned
-// by MACCommand_BeaconTimingAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_BeaconTimingAnsValidationError struct {
+// MACCommand_RelayCtrlUplinkListReqValidationError is the validation error
+// returned by MACCommand_RelayCtrlUplinkListReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayCtrlUplinkListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5736,24 +7395,24 @@ type MACCommand_BeaconTimingAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_BeaconTimingAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_BeaconTimingAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_BeaconTimingAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_BeaconTimingAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_BeaconTimingAnsValidationError) ErrorName() string {
-	return "MACCommand_BeaconTimingAnsValidationError"
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayCtrlUplinkListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_BeaconTimingAnsValidationError) Error() string {
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5765,14 +7424,14 @@ func (e MACCommand_BeaconTimingAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_BeaconTimingAns.%s: %s%s",
+		"invalid %sMACCommand_RelayCtrlUplinkListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_BeaconTimingAnsValidationError{}
+var _ error = MACCommand_RelayCtrlUplinkListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5780,34 +7439,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_BeaconTimingAnsValidationError{}
+} = MACCommand_RelayCtrlUplinkListReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_BeaconFreqReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_BeaconFreqReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayCtrlUplinkListAns
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RelayCtrlUplinkListAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_BeaconFreqReqFieldPathsNested
+		paths = MACCommand_RelayCtrlUplinkListAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency":
-
-			if val := m.GetFrequency(); val > 0 && val < 100000 {
-				return MACCommand_BeaconFreqReqValidationError{
-					field:  "frequency",
-					reason: "value must be outside range (0, 100000)",
-				}
-			}
-
+		case "rule_index_ack":
+			// no validation rules for RuleIndexAck
+		case "w_f_cnt":
+			// no validation rules for WFCnt
 		default:
-			return MACCommand_BeaconFreqReqValidationError{
+			return MACCommand_RelayCtrlUplinkListAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5816,10 +7470,10 @@ func (m *MACCommand_BeaconFreqReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_BeaconFreqReqValidationError is the validation error returned by
-// MACCommand_BeaconFreqReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_BeaconFreqReqValidationError struct {
+// MACCommand_RelayCtrlUplinkListAnsValidationError is the validation error
+// returned by MACCommand_RelayCtrlUplinkListAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayCtrlUplinkListAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5827,24 +7481,24 @@ type MACCommand_BeaconFreqReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_BeaconFreqReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_BeaconFreqReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_BeaconFreqReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_BeaconFreqReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_BeaconFreqReqValidationError) ErrorName() string {
-	return "MACCommand_BeaconFreqReqValidationError"
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayCtrlUplinkListAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_BeaconFreqReqValidationError) Error() string {
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5856,14 +7510,14 @@ func (e MACCommand_BeaconFreqReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_BeaconFreqReq.%s: %s%s",
+		"invalid %sMACCommand_RelayCtrlUplinkListAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_BeaconFreqReqValidationError{}
+var _ error = MACCommand_RelayCtrlUplinkListAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5871,27 +7525,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_BeaconFreqReqValidationError{}
+} = MACCommand_RelayCtrlUplinkListAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_BeaconFreqAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_BeaconFreqAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on
+// MACCommand_RelayConfigureFwdLimitReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayConfigureFwdLimitReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_BeaconFreqAnsFieldPathsNested
+		paths = MACCommand_RelayConfigureFwdLimitReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
+		case "reset_limit_counter":
+
+			if _, ok := RelayResetLimitCounter_name[int32(m.GetResetLimitCounter())]; !ok {
+				return MACCommand_RelayConfigureFwdLimitReqValidationError{
+					field:  "reset_limit_counter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "join_request_limits":
+
+			if v, ok := interface{}(m.GetJoinRequestLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "join_request_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "notify_limits":
+
+			if v, ok := interface{}(m.GetNotifyLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "notify_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "global_uplink_limits":
+
+			if v, ok := interface{}(m.GetGlobalUplinkLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "global_uplink_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "overall_limits":
+
+			if v, ok := interface{}(m.GetOverallLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "overall_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
-			return MACCommand_BeaconFreqAnsValidationError{
+			return MACCommand_RelayConfigureFwdLimitReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5900,10 +7609,10 @@ func (m *MACCommand_BeaconFreqAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_BeaconFreqAnsValidationError is the validation error returned by
-// MACCommand_BeaconFreqAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_BeaconFreqAnsValidationError struct {
+// MACCommand_RelayConfigureFwdLimitReqValidationError is the validation error
+// returned by MACCommand_RelayConfigureFwdLimitReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayConfigureFwdLimitReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5911,24 +7620,24 @@ type MACCommand_BeaconFreqAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_BeaconFreqAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_BeaconFreqAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_BeaconFreqAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_BeaconFreqAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_BeaconFreqAnsValidationError) ErrorName() string {
-	return "MACCommand_BeaconFreqAnsValidationError"
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) ErrorName() string {
+	return "MACCommand_RelayConfigureFwdLimitReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_BeaconFreqAnsValidationError) Error() string {
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5940,14 +7649,14 @@ func (e MACCommand_BeaconFreqAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_BeaconFreqAns.%s: %s%s",
+		"invalid %sMACCommand_RelayConfigureFwdLimitReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_BeaconFreqAnsValidationError{}
+var _ error = MACCommand_RelayConfigureFwdLimitReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5955,46 +7664,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_BeaconFreqAnsValidationError{}
-
-// ValidateFields checks the field values on MACCommand_DeviceModeInd with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DeviceModeInd) ValidateFields(paths ...string) error {
-	if m == nil {
-		return nil
-	}
-
-	if len(paths) == 0 {
-		paths = MACCommand_DeviceModeIndFieldPathsNested
-	}
-
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		_ = subs
-		switch name {
-		case "class":
-
-			if _, ok := Class_name[int32(m.GetClass())]; !ok {
-				return MACCommand_DeviceModeIndValidationError{
-					field:  "class",
-					reason: "value must be one of the defined enum values",
-				}
-			}
+} = MACCommand_RelayConfigureFwdLimitReqValidationError{}
 
-		default:
-			return MACCommand_DeviceModeIndValidationError{
-				field:  name,
-				reason: "invalid field path",
-			}
-		}
+// ValidateFields checks the field values on
+// MACCommand_RelayConfigureFwdLimitAns with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayConfigureFwdLimitAns) ValidateFields(paths ...string) error {
+	if len(paths) > 0 {
+		return fmt.Errorf("message MACCommand_RelayConfigureFwdLimitAns has no fields, but paths %s were specified", paths)
 	}
 	return nil
 }
 
-// MACCommand_DeviceModeIndValidationError is the validation error returned by
-// MACCommand_DeviceModeInd.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_DeviceModeIndValidationError struct {
+// MACCommand_RelayConfigureFwdLimitAnsValidationError is the validation error
+// returned by MACCommand_RelayConfigureFwdLimitAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayConfigureFwdLimitAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6002,24 +7687,24 @@ type MACCommand_DeviceModeIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DeviceModeIndValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfigureFwdLimitAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DeviceModeIndValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfigureFwdLimitAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DeviceModeIndValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfigureFwdLimitAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DeviceModeIndValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfigureFwdLimitAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DeviceModeIndValidationError) ErrorName() string {
-	return "MACCommand_DeviceModeIndValidationError"
+func (e MACCommand_RelayConfigureFwdLimitAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayConfigureFwdLimitAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DeviceModeIndValidationError) Error() string {
+func (e MACCommand_RelayConfigureFwdLimitAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6031,14 +7716,14 @@ func (e MACCommand_DeviceModeIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DeviceModeInd.%s: %s%s",
+		"invalid %sMACCommand_RelayConfigureFwdLimitAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DeviceModeIndValidationError{}
+var _ error = MACCommand_RelayConfigureFwdLimitAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6046,34 +7731,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DeviceModeIndValidationError{}
+} = MACCommand_RelayConfigureFwdLimitAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DeviceModeConf with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DeviceModeConf) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on
+// MACCommand_RelayNotifyNewEndDeviceReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DeviceModeConfFieldPathsNested
+		paths = MACCommand_RelayNotifyNewEndDeviceReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "class":
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "snr":
 
-			if _, ok := Class_name[int32(m.GetClass())]; !ok {
-				return MACCommand_DeviceModeConfValidationError{
-					field:  "class",
-					reason: "value must be one of the defined enum values",
+			if val := m.GetSNR(); val < -20 || val > 11 {
+				return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+					field:  "snr",
+					reason: "value must be inside range [-20, 11]",
+				}
+			}
+
+		case "rssi":
+
+			if val := m.GetRSSI(); val < -142 || val > -15 {
+				return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+					field:  "rssi",
+					reason: "value must be inside range [-142, -15]",
 				}
 			}
 
 		default:
-			return MACCommand_DeviceModeConfValidationError{
+			return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6082,10 +7778,10 @@ func (m *MACCommand_DeviceModeConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DeviceModeConfValidationError is the validation error returned by
-// MACCommand_DeviceModeConf.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_DeviceModeConfValidationError struct {
+// MACCommand_RelayNotifyNewEndDeviceReqValidationError is the validation error
+// returned by MACCommand_RelayNotifyNewEndDeviceReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayNotifyNewEndDeviceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6093,24 +7789,24 @@ type MACCommand_DeviceModeConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DeviceModeConfValidationError) Field() string { return e.field }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DeviceModeConfValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DeviceModeConfValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DeviceModeConfValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DeviceModeConfValidationError) ErrorName() string {
-	return "MACCommand_DeviceModeConfValidationError"
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) ErrorName() string {
+	return "MACCommand_RelayNotifyNewEndDeviceReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DeviceModeConfValidationError) Error() string {
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6122,14 +7818,14 @@ func (e MACCommand_DeviceModeConfValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DeviceModeConf.%s: %s%s",
+		"invalid %sMACCommand_RelayNotifyNewEndDeviceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DeviceModeConfValidationError{}
+var _ error = MACCommand_RelayNotifyNewEndDeviceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6137,4 +7833,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DeviceModeConfValidationError{}
+} = MACCommand_RelayNotifyNewEndDeviceReqValidationError{}
@@ -474,9 +474,18 @@ type ApplicationUplink struct {
 	// Consumed airtime for the transmission of the uplink message. Calculated by Network Server using the RawPayload size and the transmission settings.
 	ConsumedAirtime *time.Duration `protobuf:"bytes,13,opt,name=consumed_airtime,json=consumedAirtime,proto3,stdduration" json:"consumed_airtime,omitempty"`
 	// End device location metadata, set by the Application Server while handling the message.
-	Locations            map[string]*Location `protobuf:"bytes,14,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Locations map[string]*Location `protobuf:"bytes,14,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The decoded frame payload, normalized to the common measurement schema.
+	// Each item is a measurement, with fields such as `time`, `air.temperature` (°C), `air.relativeHumidity` (%),
+	// `battery.voltage` (V) and `location.latitude` (degrees).
+	// This field is set by the message processor if it supports normalization, either with a normalizeUplink function
+	// in the JavaScript payload formatter, or with the normalized payload mapping of the Device Repository.
+	NormalizedPayload []*types.Struct `protobuf:"bytes,15,rep,name=normalized_payload,json=normalizedPayload,proto3" json:"normalized_payload,omitempty"`
+	// Warnings generated by the message processor while normalizing the decoded_payload.
+	// If the normalization failed, this contains the error and normalized_payload is empty.
+	NormalizedPayloadWarnings []string `protobuf:"bytes,16,rep,name=normalized_payload_warnings,json=normalizedPayloadWarnings,proto3" json:"normalized_payload_warnings,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *ApplicationUplink) Reset()      { *m = ApplicationUplink{} }
@@ -609,6 +618,20 @@ func (m *ApplicationUplink) GetLocations() map[string]*Location {
 	return nil
 }
 
+func (m *ApplicationUplink) GetNormalizedPayload() []*types.Struct {
+	if m != nil {
+		return m.NormalizedPayload
+	}
+	return nil
+}
+
+func (m *ApplicationUplink) GetNormalizedPayloadWarnings() []string {
+	if m != nil {
+		return m.NormalizedPayloadWarnings
+	}
+	return nil
+}
+
 type ApplicationLocation struct {
 	Service              string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 	Location             `protobuf:"bytes,2,opt,name=location,proto3,embedded=location" json:"location"`
@@ -1520,155 +1543,157 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x2b, 0xd5,
-	0xf5, 0x9f, 0xeb, 0x6f, 0x1f, 0x7f, 0x64, 0xb8, 0x84, 0xf7, 0x37, 0xf9, 0x3f, 0xc6, 0xa9, 0x79,
-	0x94, 0xf0, 0x68, 0x6c, 0x9a, 0x57, 0x54, 0xfa, 0xaa, 0x16, 0x3c, 0xb6, 0xf3, 0xe2, 0x97, 0xc4,
-	0xf6, 0xbb, 0xf6, 0x03, 0x5e, 0x29, 0x1d, 0x4d, 0x3c, 0x37, 0x7e, 0x43, 0xec, 0x19, 0x33, 0x33,
-	0xce, 0x07, 0x55, 0x25, 0xc4, 0x0a, 0x51, 0xb5, 0x42, 0x2c, 0x2a, 0x54, 0xa9, 0x15, 0x9b, 0x4a,
-	0x2c, 0xba, 0x60, 0x89, 0xd4, 0x0d, 0x52, 0x17, 0x7d, 0xab, 0x8a, 0x25, 0xaa, 0xd4, 0x94, 0x38,
-	0x1b, 0x96, 0x2c, 0x51, 0x36, 0x54, 0x73, 0x67, 0xc6, 0x1e, 0x3b, 0x26, 0x2f, 0x2f, 0xb4, 0xbb,
-	0xee, 0x3c, 0xf7, 0x9c, 0xf3, 0xbb, 0xe7, 0xde, 0xf3, 0xf1, 0x3b, 0x37, 0x81, 0xc5, 0xae, 0x6e,
-	0xc8, 0x7b, 0xb2, 0xb6, 0x6c, 0x5a, 0x72, 0x7b, 0xa7, 0x20, 0xf7, 0xd5, 0x42, 0x8f, 0x9a, 0xa6,
-	0xdc, 0xa1, 0x66, 0xbe, 0x6f, 0xe8, 0x96, 0x8e, 0xd3, 0x96, 0xa5, 0xe5, 0x5d, 0xad, 0xfc, 0xee,
-	0xb5, 0x85, 0x62, 0x47, 0xb5, 0xee, 0x0e, 0xb6, 0xf2, 0x6d, 0xbd, 0x57, 0xa0, 0xda, 0xae, 0x7e,
-	0xd0, 0x37, 0xf4, 0xfd, 0x83, 0x02, 0x53, 0x6e, 0x2f, 0x77, 0xa8, 0xb6, 0xbc, 0x2b, 0x77, 0x55,
-	0x45, 0xb6, 0x68, 0xe1, 0xd4, 0x0f, 0x07, 0x72, 0x61, 0xd9, 0x07, 0xd1, 0xd1, 0x3b, 0xba, 0x63,
-	0xbc, 0x35, 0xd8, 0x66, 0x5f, 0xec, 0x83, 0xfd, 0x72, 0xd5, 0x2f, 0x77, 0x74, 0xbd, 0xd3, 0xa5,
-	0x63, 0x2d, 0xd3, 0x32, 0x06, 0x6d, 0xcb, 0x95, 0x66, 0xa7, 0xa5, 0x96, 0xda, 0xa3, 0xa6, 0x25,
-	0xf7, 0xfa, 0xae, 0x82, 0x30, 0xad, 0xa0, 0x0c, 0x0c, 0xd9, 0x52, 0x75, 0xcd, 0x95, 0x3f, 0x76,
-	0xfa, 0x0a, 0xa8, 0x61, 0xe8, 0x86, 0x2b, 0x7e, 0xfc, 0xb4, 0x58, 0x55, 0xa8, 0x66, 0xa9, 0xdb,
-	0x2a, 0x35, 0x4c, 0xcf, 0xc5, 0xd3, 0x4a, 0x3b, 0xf4, 0xc0, 0x93, 0x66, 0x4f, 0x4b, 0xbd, 0x0b,
-	0x75, 0x14, 0x66, 0x46, 0xc1, 0x92, 0x15, 0xd9, 0x92, 0x1d, 0x8d, 0xdc, 0x6f, 0x43, 0x90, 0xba,
-	0xdd, 0xef, 0xaa, 0xda, 0xce, 0xa6, 0x13, 0x1e, 0x9c, 0x85, 0x84, 0x21, 0xef, 0x49, 0x7d, 0xf9,
-	0xa0, 0xab, 0xcb, 0x4a, 0x06, 0x2d, 0xa2, 0xa5, 0x24, 0x01, 0x43, 0xde, 0x6b, 0x38, 0x2b, 0xf8,
-	0xfb, 0x10, 0xf5, 0x84, 0x81, 0x45, 0xb4, 0x94, 0x58, 0xf9, 0xbf, 0xfc, 0x64, 0x28, 0xf3, 0x2e,
-	0x14, 0xf1, 0xf4, 0x70, 0x19, 0x62, 0x26, 0xb5, 0x2c, 0x55, 0xeb, 0x98, 0x99, 0x10, 0xb3, 0x59,
-	0x98, 0xb6, 0x69, 0xed, 0x37, 0x5d, 0x0d, 0x31, 0x79, 0x22, 0x86, 0xdf, 0x41, 0x01, 0x1e, 0xdd,
-	0x3b, 0xcc, 0x72, 0x64, 0x64, 0x89, 0x7f, 0x0c, 0x09, 0x63, 0x5f, 0xf2, 0x0e, 0x90, 0x09, 0x2f,
-	0x06, 0x67, 0x01, 0x91, 0xfd, 0x4d, 0x57, 0x83, 0x80, 0x31, 0xfa, 0x8d, 0x2b, 0x90, 0x30, 0x68,
-	0x9b, 0xaa, 0xbb, 0x54, 0x91, 0x64, 0x2b, 0x13, 0x71, 0xbd, 0x70, 0x62, 0x98, 0xf7, 0x62, 0x98,
-	0x6f, 0x79, 0x41, 0x16, 0x63, 0xf6, 0xee, 0xef, 0xfe, 0x2b, 0x8b, 0x08, 0x78, 0x86, 0x45, 0x0b,
-	0xdf, 0x80, 0xb9, 0xb6, 0x6e, 0x18, 0xb4, 0xcb, 0x22, 0x2d, 0xa9, 0x8a, 0x99, 0x89, 0x2e, 0x06,
-	0x97, 0xe2, 0xa2, 0x70, 0x22, 0xc6, 0xdf, 0x43, 0x91, 0x5c, 0xc8, 0x08, 0x64, 0x94, 0xe1, 0x61,
-	0x36, 0x5d, 0x1a, 0xab, 0x55, 0xcb, 0x26, 0x49, 0xfb, 0xcc, 0xaa, 0x8a, 0x89, 0xaf, 0xc3, 0xbc,
-	0x42, 0x77, 0xd5, 0x36, 0x95, 0xda, 0x77, 0x65, 0x4d, 0xa3, 0x5d, 0x49, 0xd5, 0x14, 0xba, 0x9f,
-	0x89, 0x2f, 0xa2, 0xa5, 0x94, 0x18, 0x3b, 0x11, 0xc3, 0x57, 0x83, 0x99, 0xaf, 0x11, 0xc1, 0x8e,
-	0x56, 0xc9, 0x51, 0xaa, 0xda, 0x3a, 0xb8, 0x06, 0x7c, 0x5b, 0xd7, 0xcc, 0x41, 0xcf, 0x3e, 0x8b,
-	0x6a, 0xd8, 0x89, 0x99, 0x01, 0x76, 0xa0, 0x47, 0x4f, 0x1d, 0xa8, 0xec, 0x26, 0x25, 0x3b, 0x0f,
-	0x7a, 0xdf, 0x3e, 0xcf, 0x9c, 0x67, 0x5c, 0x74, 0x6c, 0xaf, 0x87, 0x3e, 0xfe, 0x20, 0xcb, 0xdd,
-	0x0c, 0xc5, 0x62, 0x7c, 0x3c, 0xf7, 0xbb, 0x20, 0xcc, 0x95, 0xf5, 0x3d, 0xed, 0xbf, 0x9d, 0x12,
-	0x3f, 0x87, 0x34, 0xd5, 0x14, 0xc9, 0xbd, 0x03, 0xfb, 0x1e, 0x83, 0xcc, 0xf2, 0xca, 0xb4, 0x65,
-	0x45, 0x53, 0xca, 0x4c, 0xa9, 0x3a, 0xae, 0x0e, 0x91, 0x1f, 0x1e, 0x66, 0x93, 0x63, 0x49, 0xd9,
-	0x24, 0x49, 0x3a, 0xd6, 0x33, 0xf1, 0xb3, 0x10, 0x35, 0xe8, 0xeb, 0x03, 0x6a, 0x5a, 0x6e, 0xbe,
-	0x3d, 0x7a, 0x3a, 0xdf, 0x88, 0xa3, 0xb0, 0xc6, 0x11, 0x4f, 0x17, 0x5f, 0x87, 0xb8, 0xd9, 0xbe,
-	0x4b, 0x95, 0x41, 0x97, 0x2a, 0x99, 0xf0, 0xfd, 0x12, 0x75, 0x8d, 0x23, 0x63, 0xf5, 0x59, 0x99,
-	0x11, 0xb9, 0x48, 0x66, 0x38, 0xd1, 0x10, 0xe7, 0xc6, 0x25, 0x83, 0x83, 0x5f, 0x89, 0x28, 0xf7,
-	0xb7, 0x00, 0xf0, 0xad, 0xfd, 0x62, 0x7b, 0x47, 0xd3, 0xf7, 0xba, 0x54, 0xe9, 0xf4, 0xa8, 0x36,
-	0x33, 0x1d, 0xd1, 0x85, 0xd2, 0xb1, 0x0a, 0x11, 0x83, 0x9a, 0x83, 0xae, 0xc5, 0x02, 0x98, 0x5e,
-	0x79, 0xf2, 0xf4, 0xb1, 0x27, 0xb7, 0xce, 0x13, 0xa6, 0xce, 0x32, 0xf5, 0x2d, 0xbb, 0x58, 0x89,
-	0x0b, 0x90, 0xfb, 0x23, 0x82, 0x88, 0x23, 0xc4, 0x09, 0x88, 0x36, 0x6f, 0x97, 0x4a, 0x95, 0x66,
-	0x93, 0xe7, 0xf0, 0x43, 0x90, 0xba, 0x5d, 0x5b, 0xaf, 0xd5, 0x5f, 0xaa, 0x49, 0x15, 0x42, 0xea,
-	0x84, 0x47, 0x38, 0x09, 0xb1, 0x56, 0xbd, 0x2e, 0x6d, 0x14, 0x5b, 0x15, 0x3e, 0x80, 0x53, 0x10,
-	0xb7, 0xbf, 0x2a, 0x45, 0xb2, 0x71, 0x87, 0x0f, 0xe2, 0x79, 0xe0, 0x4b, 0xf5, 0x8d, 0x8d, 0x6a,
-	0xb3, 0x5a, 0xaf, 0x49, 0x8d, 0x62, 0x69, 0xbd, 0xd2, 0xe2, 0x43, 0x93, 0xab, 0x62, 0xa5, 0x58,
-	0xaa, 0xd7, 0xf8, 0xb0, 0xbd, 0x51, 0xeb, 0x65, 0x69, 0x95, 0x54, 0x6e, 0xf1, 0x11, 0x86, 0xfa,
-	0xb2, 0xd4, 0xa8, 0xbf, 0x54, 0x21, 0x7c, 0x14, 0xf3, 0x90, 0xbc, 0xd1, 0x68, 0x4a, 0xb7, 0x6b,
-	0x1b, 0xf5, 0xd2, 0x7a, 0xa5, 0xcc, 0xc7, 0x72, 0x6f, 0x21, 0x98, 0xbf, 0x21, 0x5b, 0x74, 0x4f,
-	0x3e, 0x98, 0x6c, 0x7d, 0x15, 0x88, 0xba, 0x24, 0xc5, 0x72, 0x3c, 0xb1, 0xf2, 0xd8, 0xf4, 0x2d,
-	0x4c, 0xe8, 0x8f, 0x1b, 0xd5, 0xa7, 0x87, 0x59, 0x44, 0x3c, 0x5b, 0xfc, 0x38, 0x44, 0xb7, 0x64,
-	0x4d, 0x91, 0x54, 0xa7, 0x1a, 0xe2, 0x22, 0x0c, 0x0f, 0xb3, 0x11, 0x51, 0xd6, 0x94, 0x6a, 0x99,
-	0x44, 0x6c, 0x51, 0x55, 0xc9, 0xbd, 0x1d, 0x85, 0x87, 0x8a, 0xfd, 0x7e, 0x57, 0x6d, 0xb3, 0x18,
-	0x38, 0xc0, 0xf8, 0xa7, 0x90, 0x36, 0xa9, 0x69, 0xda, 0xb1, 0xdc, 0xa1, 0x07, 0x36, 0x02, 0x2b,
-	0x36, 0x31, 0x73, 0x22, 0x86, 0xdf, 0x08, 0x66, 0xde, 0x64, 0x79, 0xdf, 0x74, 0x34, 0xd6, 0xe9,
-	0x41, 0xb5, 0x4c, 0x92, 0xe6, 0xf8, 0x4b, 0xc1, 0x57, 0x20, 0xb2, 0x2d, 0xf5, 0x75, 0xc3, 0x09,
-	0x63, 0x4a, 0x4c, 0x9d, 0x88, 0x70, 0x35, 0x96, 0xf9, 0x1a, 0x2d, 0xa1, 0xe7, 0x3e, 0x47, 0x24,
-	0xbc, 0xdd, 0xd0, 0x0d, 0x0b, 0x3f, 0x0c, 0xe1, 0x6d, 0xa9, 0xad, 0x59, 0xac, 0xe4, 0x52, 0x24,
-	0xb4, 0x5d, 0xd2, 0x2c, 0x5c, 0x80, 0xc4, 0xb6, 0xd1, 0x1b, 0x15, 0x79, 0x88, 0xed, 0x9b, 0x1e,
-	0x1e, 0x66, 0x61, 0x95, 0x6c, 0xba, 0x85, 0x4e, 0x60, 0xdb, 0xe8, 0x79, 0x45, 0xff, 0x02, 0xcc,
-	0x29, 0xb4, 0xad, 0x2b, 0x54, 0x19, 0x19, 0x85, 0xdd, 0xe2, 0x9f, 0x6e, 0x42, 0x4d, 0x46, 0xac,
-	0x24, 0xed, 0xea, 0x7b, 0x08, 0xcf, 0x41, 0x66, 0x0a, 0x41, 0xda, 0x93, 0x0d, 0x8d, 0xd1, 0x44,
-	0xd2, 0x4e, 0x63, 0x72, 0x69, 0xd2, 0xe2, 0x25, 0x57, 0xca, 0xba, 0xb9, 0x8f, 0x0a, 0x22, 0xf7,
-	0xa3, 0x02, 0x96, 0xa6, 0xef, 0xa1, 0x40, 0x0c, 0x4d, 0x90, 0x82, 0x9f, 0x97, 0xa2, 0x17, 0xe6,
-	0xa5, 0x29, 0x6a, 0x89, 0x5d, 0x90, 0x5a, 0x7e, 0x08, 0x71, 0xb9, 0xdf, 0x97, 0x4c, 0x3b, 0xf2,
-	0x8c, 0x06, 0x12, 0x2b, 0xff, 0x3f, 0xed, 0xcd, 0x3a, 0x3d, 0xa8, 0x68, 0xbb, 0xb4, 0xab, 0xf7,
-	0x29, 0x89, 0xca, 0xfd, 0x7e, 0x73, 0x9d, 0x1e, 0xe0, 0x25, 0x78, 0xa8, 0x2b, 0x9b, 0x96, 0x24,
-	0x4b, 0x2c, 0xaa, 0x92, 0xa2, 0xef, 0x69, 0x8c, 0x0f, 0x52, 0x24, 0x65, 0x0b, 0x8a, 0xab, 0x25,
-	0xcd, 0xb2, 0x7b, 0x3a, 0xbe, 0x0c, 0xf1, 0xb6, 0xae, 0x6d, 0xab, 0x46, 0x8f, 0x2a, 0x99, 0xc4,
-	0x22, 0x5a, 0x8a, 0x91, 0xf1, 0xc2, 0x4c, 0x5a, 0x49, 0x5d, 0x9c, 0x56, 0x70, 0x0d, 0xe2, 0x5d,
-	0xdd, 0x49, 0x6f, 0x33, 0x93, 0x66, 0x21, 0x7a, 0x66, 0xfa, 0x40, 0xa7, 0x4a, 0x20, 0xbf, 0xe1,
-	0x99, 0x54, 0x34, 0xcb, 0x38, 0x20, 0x63, 0x88, 0x85, 0x17, 0x21, 0x3d, 0x29, 0xc4, 0x3c, 0x04,
-	0xed, 0xcb, 0xb2, 0x6b, 0x24, 0x4e, 0xec, 0x9f, 0x38, 0x0f, 0xe1, 0x5d, 0xb9, 0x3b, 0xa0, 0x2e,
-	0x0f, 0x65, 0xa6, 0xf7, 0xf3, 0x00, 0x88, 0xa3, 0x76, 0x3d, 0xf0, 0x1c, 0xca, 0xfd, 0x35, 0x00,
-	0x0f, 0xfb, 0xfc, 0xf0, 0x54, 0x70, 0x06, 0xa2, 0x26, 0x35, 0x6c, 0x4a, 0x71, 0x77, 0xf0, 0x3e,
-	0xf1, 0x2a, 0xc4, 0x3c, 0xb7, 0xee, 0xb7, 0x91, 0xc8, 0xfb, 0xb3, 0x86, 0x35, 0x8a, 0x91, 0x2d,
-	0x7e, 0x07, 0x01, 0xc8, 0x96, 0x65, 0xa8, 0x5b, 0x03, 0x8b, 0xda, 0x0c, 0x68, 0xdf, 0xd1, 0xb5,
-	0x33, 0xee, 0xc8, 0x43, 0xcd, 0x17, 0x47, 0x56, 0xec, 0x26, 0xc4, 0x67, 0x4f, 0xc4, 0x95, 0xdf,
-	0xa3, 0x02, 0x0f, 0xb9, 0x2b, 0x46, 0x2e, 0x73, 0x65, 0x45, 0xf8, 0xc5, 0x2b, 0xf2, 0xf2, 0x1b,
-	0xcf, 0x2c, 0xff, 0xe8, 0xd5, 0xa5, 0xe7, 0xaf, 0xbf, 0xb2, 0xfc, 0xea, 0xf3, 0xde, 0xe7, 0x53,
-	0xbf, 0x5c, 0xf9, 0xde, 0xaf, 0xae, 0x5c, 0x0d, 0x1b, 0xc1, 0xcc, 0x3d, 0x44, 0x7c, 0xbb, 0x2f,
-	0xfc, 0x04, 0xe6, 0xa6, 0x50, 0x67, 0xdc, 0xef, 0xbc, 0xff, 0x7e, 0xe3, 0xfe, 0x5b, 0xfc, 0x47,
-	0x00, 0x1e, 0xf1, 0x79, 0x7a, 0x53, 0x57, 0xb5, 0x62, 0xbb, 0x4d, 0xfb, 0xd6, 0xb7, 0x6e, 0x6a,
-	0x13, 0x85, 0x11, 0x78, 0x80, 0xc2, 0x78, 0x19, 0x1e, 0x51, 0x35, 0xef, 0x8d, 0xa0, 0xb0, 0xba,
-	0xb0, 0x53, 0xcc, 0xbb, 0xe8, 0xc7, 0xcf, 0xb8, 0x68, 0x6f, 0x04, 0x22, 0xf3, 0x3e, 0x04, 0x6f,
-	0xd1, 0xc4, 0x4f, 0xc2, 0x5c, 0x9f, 0x6a, 0x8a, 0xaa, 0x75, 0x24, 0xd7, 0x55, 0xd6, 0x30, 0x63,
-	0x24, 0xed, 0x2e, 0xbb, 0xc7, 0xf9, 0x0f, 0xf5, 0x86, 0xdc, 0x3f, 0xc3, 0x13, 0x29, 0xea, 0x39,
-	0xf2, 0x3f, 0xbe, 0x18, 0xf1, 0x05, 0x9c, 0xc9, 0x17, 0x13, 0x8d, 0x2f, 0x32, 0xdd, 0xf8, 0x6e,
-	0x40, 0xbc, 0xdd, 0x95, 0x4d, 0x53, 0xda, 0x92, 0xda, 0x2e, 0x0f, 0x3c, 0x7d, 0x8e, 0xdc, 0xc8,
-	0x97, 0x6c, 0x23, 0xb1, 0x44, 0xa2, 0x6d, 0xe7, 0x07, 0x5e, 0x83, 0x58, 0xdf, 0x50, 0x75, 0x43,
-	0xb5, 0x0e, 0x58, 0xa8, 0xd3, 0x2b, 0xb9, 0x19, 0x7c, 0xe2, 0x8e, 0x8c, 0x0d, 0x57, 0xd3, 0x37,
-	0x42, 0x8d, 0xac, 0x67, 0x0d, 0x76, 0xf1, 0x8b, 0x0c, 0x76, 0x0b, 0x7f, 0x40, 0x10, 0x75, 0xfd,
-	0xc4, 0xeb, 0x10, 0xeb, 0x38, 0x73, 0x8f, 0xf3, 0x6a, 0x49, 0xac, 0x3c, 0x35, 0xed, 0x9e, 0x3b,
-	0x17, 0x15, 0x35, 0x8b, 0x6a, 0x9a, 0xec, 0x1f, 0xb9, 0x43, 0x0e, 0xeb, 0x79, 0x00, 0xb8, 0x02,
-	0x29, 0x79, 0xcb, 0xd4, 0xbb, 0x03, 0x8b, 0x4a, 0x8c, 0x2a, 0xee, 0x9f, 0xdb, 0x21, 0x96, 0xd7,
-	0x49, 0xcf, 0xac, 0x35, 0x7a, 0x7b, 0xe4, 0xee, 0xc0, 0xfc, 0x8c, 0x0b, 0x36, 0x71, 0x11, 0xe2,
-	0xe3, 0xaa, 0x45, 0xe7, 0xaf, 0xda, 0xb1, 0x55, 0xee, 0x23, 0x04, 0x8f, 0xce, 0x50, 0x59, 0x95,
-	0x55, 0x7b, 0x6a, 0xbf, 0x05, 0x31, 0x4f, 0xd5, 0x9d, 0xf9, 0xce, 0x83, 0x3f, 0xab, 0xa9, 0x7b,
-	0x30, 0xf8, 0x05, 0x08, 0xb3, 0x77, 0xbe, 0xdb, 0xaa, 0x2e, 0x9f, 0x7a, 0xd0, 0xd8, 0xc2, 0x32,
-	0xb5, 0x64, 0xb5, 0x3b, 0x3d, 0x53, 0x38, 0x86, 0xb9, 0x5f, 0x23, 0xc8, 0xfa, 0x76, 0xad, 0xce,
-	0xea, 0x40, 0xdf, 0xfe, 0x66, 0xf0, 0x13, 0x30, 0xc7, 0xe6, 0x06, 0xdf, 0xd4, 0xc0, 0xba, 0x00,
-	0x49, 0xda, 0xcb, 0xde, 0xd0, 0x90, 0x93, 0xe0, 0x92, 0x0f, 0xa8, 0xe9, 0x50, 0x60, 0xd9, 0x1e,
-	0x9f, 0xbe, 0x99, 0x20, 0x9f, 0x86, 0x10, 0x1b, 0xcc, 0x02, 0x67, 0x17, 0x38, 0x53, 0xca, 0xfd,
-	0x3d, 0x06, 0xa9, 0x89, 0x39, 0x60, 0xc6, 0xe3, 0x10, 0x3d, 0xc8, 0xe3, 0xf0, 0x54, 0x70, 0x26,
-	0x1f, 0x87, 0x33, 0x6a, 0x2b, 0x70, 0xa1, 0x47, 0x53, 0x71, 0xb2, 0xb9, 0x27, 0xcf, 0x59, 0x00,
-	0xfe, 0xa1, 0xef, 0x26, 0xa4, 0x07, 0x6c, 0xee, 0x91, 0xbc, 0x97, 0x87, 0xf3, 0x0c, 0xfe, 0xce,
-	0x7d, 0x07, 0xa5, 0x35, 0x8e, 0xa4, 0x06, 0x13, 0xcf, 0x97, 0x35, 0x48, 0xbc, 0xa6, 0xab, 0x9a,
-	0x24, 0x33, 0xda, 0x75, 0x1f, 0xbe, 0x4f, 0x9c, 0x01, 0x34, 0xe6, 0xe8, 0x35, 0x8e, 0xc0, 0x6b,
-	0x63, 0xc6, 0x5e, 0x83, 0xa4, 0x97, 0x26, 0x92, 0xdc, 0xde, 0x71, 0xfb, 0xf4, 0x79, 0xf2, 0x6b,
-	0x8d, 0x23, 0x09, 0xcf, 0xb4, 0xd8, 0xde, 0xc1, 0x37, 0x21, 0x35, 0x42, 0xd2, 0x6c, 0xa8, 0xc8,
-	0x83, 0x40, 0x8d, 0xbc, 0xa8, 0xc9, 0x53, 0x58, 0x26, 0xd5, 0x2c, 0xb7, 0x55, 0x3f, 0x28, 0x56,
-	0xd3, 0x7e, 0x38, 0xb7, 0x60, 0x6e, 0x84, 0xb5, 0xcd, 0x5a, 0x81, 0xdb, 0xbf, 0x9e, 0x3a, 0x07,
-	0x9a, 0xd3, 0x3b, 0xd6, 0x38, 0x92, 0x56, 0x26, 0xbb, 0x49, 0xcd, 0x87, 0xfa, 0xfa, 0x80, 0x0e,
-	0xa8, 0xe2, 0x0e, 0xf2, 0xe7, 0xf4, 0x71, 0x84, 0x77, 0x8b, 0x19, 0x63, 0x1d, 0x16, 0x26, 0xf1,
-	0x24, 0xdf, 0x34, 0xe2, 0xfe, 0xc9, 0xa7, 0x70, 0x06, 0xf4, 0xac, 0xce, 0xb1, 0xc6, 0x91, 0xcc,
-	0xc4, 0x36, 0x3e, 0x25, 0xfb, 0x00, 0xde, 0x70, 0x2a, 0x99, 0x7a, 0x77, 0xd7, 0x7d, 0x26, 0x9c,
-	0x7d, 0x00, 0x6f, 0x28, 0xb5, 0x0f, 0xe0, 0x59, 0x37, 0x99, 0x31, 0x5e, 0x87, 0xa4, 0xdb, 0x12,
-	0x24, 0xd6, 0x0f, 0x9c, 0xe7, 0xc4, 0x77, 0xcf, 0x00, 0xf3, 0xf5, 0x17, 0x3b, 0x97, 0x4c, 0x5f,
-	0xbb, 0xb9, 0x0c, 0x71, 0x53, 0xed, 0x0d, 0xba, 0xec, 0xf0, 0x69, 0x87, 0xc4, 0x47, 0x0b, 0x62,
-	0x1c, 0x02, 0x83, 0xbe, 0xf3, 0xa7, 0x92, 0x3f, 0x07, 0x20, 0xe3, 0x16, 0x85, 0x3b, 0x08, 0xac,
-	0xea, 0x46, 0x4f, 0xb6, 0x2c, 0x6a, 0x98, 0x78, 0x13, 0x92, 0x83, 0xbe, 0xb4, 0xed, 0x2d, 0xb0,
-	0xce, 0x92, 0x5e, 0x59, 0x9c, 0x76, 0x69, 0xda, 0xd0, 0xc7, 0xd2, 0x89, 0x41, 0x7f, 0xb4, 0x8c,
-	0x7f, 0x00, 0x97, 0xfc, 0x70, 0x52, 0x5f, 0x36, 0xe4, 0x1e, 0xb5, 0x81, 0x9d, 0x09, 0x79, 0xde,
-	0xa7, 0xdc, 0xf0, 0x64, 0xf8, 0x16, 0xb0, 0x50, 0xfb, 0xdc, 0x08, 0x3e, 0xb0, 0x1b, 0xac, 0x18,
-	0xc6, 0x8e, 0xd8, 0xc3, 0xd1, 0x04, 0xa4, 0xcf, 0x95, 0x10, 0x73, 0xe5, 0xd2, 0x84, 0xc1, 0xc8,
-	0x99, 0xdc, 0x5f, 0x10, 0xcc, 0x97, 0xfd, 0x19, 0xe1, 0xfe, 0x65, 0x0c, 0xb7, 0xbe, 0x55, 0x1b,
-	0x8e, 0x7d, 0x43, 0xfb, 0xdd, 0xf4, 0x33, 0x57, 0xe0, 0xdc, 0xcc, 0x25, 0xc2, 0x89, 0x18, 0x7d,
-	0x0f, 0x85, 0xf8, 0x0f, 0x7e, 0x13, 0xf1, 0xb1, 0xd8, 0xd5, 0x77, 0x11, 0xf0, 0xd3, 0xb7, 0x84,
-	0x31, 0xa4, 0x57, 0xeb, 0x64, 0xb3, 0xd8, 0x6a, 0x55, 0x88, 0x54, 0xab, 0xd7, 0x2a, 0x3c, 0x87,
-	0x33, 0x30, 0x3f, 0x5e, 0x23, 0x95, 0x46, 0xbd, 0x59, 0x6d, 0xd5, 0xc9, 0x1d, 0x1e, 0xe1, 0x05,
-	0xb8, 0x34, 0x96, 0xdc, 0x20, 0x8d, 0x92, 0xd4, 0xac, 0x90, 0x17, 0xab, 0xa5, 0x0a, 0x1f, 0x98,
-	0xb4, 0xba, 0x59, 0x7c, 0xb1, 0xd8, 0x2c, 0x91, 0x6a, 0xa3, 0xc5, 0x07, 0x27, 0x25, 0xa5, 0xe2,
-	0x9d, 0x4a, 0xad, 0x56, 0xd9, 0x68, 0x34, 0xf8, 0x90, 0xf8, 0x27, 0x74, 0xef, 0x48, 0x40, 0x9f,
-	0x1e, 0x09, 0xe8, 0xb3, 0x23, 0x81, 0xfb, 0xfc, 0x48, 0xe0, 0xbe, 0x38, 0x12, 0xb8, 0x2f, 0x8f,
-	0x04, 0xee, 0xab, 0x23, 0x01, 0xbd, 0x39, 0x14, 0xd0, 0xdb, 0x43, 0x81, 0xfb, 0x70, 0x28, 0xa0,
-	0x8f, 0x86, 0x02, 0xf7, 0xf1, 0x50, 0xe0, 0x3e, 0x19, 0x0a, 0xdc, 0xbd, 0xa1, 0x80, 0x3e, 0x1d,
-	0x0a, 0xe8, 0xb3, 0xa1, 0xc0, 0x7d, 0x3e, 0x14, 0xd0, 0x17, 0x43, 0x81, 0xfb, 0x72, 0x28, 0xa0,
-	0xaf, 0x86, 0x02, 0xf7, 0xe6, 0xb1, 0xc0, 0xbd, 0x7d, 0x2c, 0xa0, 0x77, 0x8f, 0x05, 0xee, 0xfd,
-	0x63, 0x01, 0x7d, 0x70, 0x2c, 0x70, 0x1f, 0x1e, 0x0b, 0xdc, 0x47, 0xc7, 0x02, 0xfa, 0xf8, 0x58,
-	0x40, 0x9f, 0x1c, 0x0b, 0xe8, 0x67, 0x85, 0x8e, 0x9e, 0xb7, 0xee, 0x52, 0xeb, 0xae, 0x3d, 0xf2,
-	0xe6, 0x35, 0x6a, 0xed, 0xe9, 0xc6, 0x4e, 0x61, 0xf2, 0x5f, 0x00, 0xbb, 0xd7, 0x0a, 0xfd, 0x9d,
-	0x4e, 0xc1, 0xb2, 0xb4, 0xfe, 0xd6, 0x56, 0x84, 0x51, 0xd4, 0xb5, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x28, 0xd3, 0x67, 0x07, 0xad, 0x19, 0x00, 0x00,
+	// 2396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xdf, 0xe1, 0x37, 0x87, 0x1f, 0x5a, 0x4f, 0x14, 0xff, 0xd7, 0x4a, 0xb2, 0xd4, 0x9f, 0x71,
+	0x1a, 0xc5, 0xa9, 0xc8, 0x54, 0x6e, 0xd0, 0xd4, 0x45, 0x93, 0x70, 0x49, 0xca, 0xa2, 0x25, 0x91,
+	0xf4, 0x90, 0x4e, 0xe2, 0xa6, 0xe9, 0x62, 0xc5, 0x1d, 0xd1, 0x1b, 0x91, 0xbb, 0xcc, 0xee, 0x52,
+	0x1f, 0x29, 0x0a, 0x18, 0x39, 0x05, 0x29, 0x5a, 0x18, 0x3e, 0x14, 0x41, 0x81, 0x16, 0xbe, 0x14,
+	0xc8, 0xa1, 0x07, 0x1f, 0x0d, 0xf4, 0x12, 0xa0, 0x87, 0xfa, 0x54, 0xf8, 0x18, 0x14, 0xa8, 0x6a,
+	0x51, 0x97, 0x1c, 0x73, 0x34, 0x74, 0x49, 0xb1, 0xb3, 0xbb, 0xe4, 0x2e, 0xc9, 0xc8, 0xb2, 0xdc,
+	0xde, 0x7a, 0xdb, 0x9d, 0x79, 0xef, 0xb7, 0x6f, 0xe6, 0x7d, 0xfc, 0xde, 0x23, 0xe1, 0x7c, 0x47,
+	0xd3, 0xa5, 0x1d, 0x49, 0x5d, 0x34, 0x4c, 0xa9, 0xb5, 0x95, 0x97, 0x7a, 0x4a, 0xbe, 0x4b, 0x0c,
+	0x43, 0x6a, 0x13, 0x23, 0xd7, 0xd3, 0x35, 0x53, 0x43, 0x69, 0xd3, 0x54, 0x73, 0x8e, 0x54, 0x6e,
+	0xfb, 0xe2, 0x5c, 0xa1, 0xad, 0x98, 0x37, 0xfa, 0x1b, 0xb9, 0x96, 0xd6, 0xcd, 0x13, 0x75, 0x5b,
+	0xdb, 0xeb, 0xe9, 0xda, 0xee, 0x5e, 0x9e, 0x0a, 0xb7, 0x16, 0xdb, 0x44, 0x5d, 0xdc, 0x96, 0x3a,
+	0x8a, 0x2c, 0x99, 0x24, 0x3f, 0xf1, 0x60, 0x43, 0xce, 0x2d, 0x7a, 0x20, 0xda, 0x5a, 0x5b, 0xb3,
+	0x95, 0x37, 0xfa, 0x9b, 0xf4, 0x8d, 0xbe, 0xd0, 0x27, 0x47, 0xfc, 0xf9, 0xb6, 0xa6, 0xb5, 0x3b,
+	0x64, 0x24, 0x65, 0x98, 0x7a, 0xbf, 0x65, 0x3a, 0xbb, 0x99, 0xf1, 0x5d, 0x53, 0xe9, 0x12, 0xc3,
+	0x94, 0xba, 0x3d, 0x47, 0x80, 0x1f, 0x17, 0x90, 0xfb, 0xba, 0x64, 0x2a, 0x9a, 0xea, 0xec, 0xbf,
+	0x30, 0x79, 0x05, 0x44, 0xd7, 0x35, 0xdd, 0xd9, 0x7e, 0x71, 0x72, 0x5b, 0x91, 0x89, 0x6a, 0x2a,
+	0x9b, 0x0a, 0xd1, 0x0d, 0xd7, 0xc4, 0x49, 0xa1, 0x2d, 0xb2, 0xe7, 0xee, 0x66, 0x26, 0x77, 0xdd,
+	0x0b, 0xb5, 0x05, 0xa6, 0x7a, 0xc1, 0x94, 0x64, 0xc9, 0x94, 0x6c, 0x89, 0xec, 0x6f, 0x43, 0x30,
+	0x75, 0xad, 0xd7, 0x51, 0xd4, 0xad, 0x75, 0xdb, 0x3d, 0x28, 0x03, 0x13, 0xba, 0xb4, 0x23, 0xf6,
+	0xa4, 0xbd, 0x8e, 0x26, 0xc9, 0x1c, 0x98, 0x07, 0x0b, 0x49, 0x0c, 0x75, 0x69, 0xa7, 0x6e, 0xaf,
+	0xa0, 0x1f, 0xc0, 0xa8, 0xbb, 0x19, 0x98, 0x07, 0x0b, 0x89, 0xa5, 0xff, 0xcb, 0xf9, 0x5d, 0x99,
+	0x73, 0xa0, 0xb0, 0x2b, 0x87, 0x4a, 0x30, 0x66, 0x10, 0xd3, 0x54, 0xd4, 0xb6, 0xc1, 0x85, 0xa8,
+	0xce, 0xdc, 0xb8, 0x4e, 0x73, 0xb7, 0xe1, 0x48, 0x08, 0xc9, 0x23, 0x21, 0xfc, 0x19, 0x08, 0xb0,
+	0xe0, 0xfe, 0x7e, 0x86, 0xc1, 0x43, 0x4d, 0xf4, 0x13, 0x98, 0xd0, 0x77, 0x45, 0xf7, 0x00, 0x5c,
+	0x78, 0x3e, 0x38, 0x0d, 0x08, 0xef, 0xae, 0x3b, 0x12, 0x18, 0xea, 0xc3, 0x67, 0x54, 0x86, 0x09,
+	0x9d, 0xb4, 0x88, 0xb2, 0x4d, 0x64, 0x51, 0x32, 0xb9, 0x88, 0x63, 0x85, 0xed, 0xc3, 0x9c, 0xeb,
+	0xc3, 0x5c, 0xd3, 0x75, 0xb2, 0x10, 0xb3, 0xbe, 0x7e, 0xeb, 0x5f, 0x19, 0x80, 0xa1, 0xab, 0x58,
+	0x30, 0xd1, 0x65, 0x38, 0xd3, 0xd2, 0x74, 0x9d, 0x74, 0xa8, 0xa7, 0x45, 0x45, 0x36, 0xb8, 0xe8,
+	0x7c, 0x70, 0x21, 0x2e, 0xf0, 0x47, 0x42, 0xfc, 0x36, 0x88, 0x64, 0x43, 0x7a, 0x80, 0x93, 0x07,
+	0xfb, 0x99, 0x74, 0x71, 0x24, 0x56, 0x29, 0x19, 0x38, 0xed, 0x51, 0xab, 0xc8, 0x06, 0xba, 0x04,
+	0x67, 0x65, 0xb2, 0xad, 0xb4, 0x88, 0xd8, 0xba, 0x21, 0xa9, 0x2a, 0xe9, 0x88, 0x8a, 0x2a, 0x93,
+	0x5d, 0x2e, 0x3e, 0x0f, 0x16, 0x52, 0x42, 0xec, 0x48, 0x08, 0x5f, 0x08, 0x72, 0xdf, 0x02, 0x8c,
+	0x6c, 0xa9, 0xa2, 0x2d, 0x54, 0xb1, 0x64, 0x50, 0x15, 0xb2, 0x2d, 0x4d, 0x35, 0xfa, 0x5d, 0xeb,
+	0x2c, 0x8a, 0x6e, 0x05, 0x26, 0x07, 0xe9, 0x81, 0xce, 0x4d, 0x1c, 0xa8, 0xe4, 0x04, 0x25, 0x3d,
+	0x0f, 0xf8, 0xdc, 0x3a, 0xcf, 0x8c, 0xab, 0x5c, 0xb0, 0x75, 0x2f, 0x85, 0xee, 0xdd, 0xc9, 0x30,
+	0x57, 0x42, 0xb1, 0x18, 0x1b, 0xcf, 0xfe, 0x2e, 0x08, 0x67, 0x4a, 0xda, 0x8e, 0xfa, 0xdf, 0x0e,
+	0x89, 0x9f, 0xc3, 0x34, 0x51, 0x65, 0xd1, 0xb9, 0x03, 0xeb, 0x1e, 0x83, 0x54, 0xf3, 0xfc, 0xb8,
+	0x66, 0x59, 0x95, 0x4b, 0x54, 0xa8, 0x32, 0xca, 0x0e, 0x81, 0x1d, 0xec, 0x67, 0x92, 0xa3, 0x9d,
+	0x92, 0x81, 0x93, 0x64, 0x24, 0x67, 0xa0, 0xd7, 0x61, 0x54, 0x27, 0x1f, 0xf5, 0x89, 0x61, 0x3a,
+	0xf1, 0x76, 0x6e, 0x32, 0xde, 0xb0, 0x2d, 0xb0, 0xc2, 0x60, 0x57, 0x16, 0x5d, 0x82, 0x71, 0xa3,
+	0x75, 0x83, 0xc8, 0xfd, 0x0e, 0x91, 0xb9, 0xf0, 0xe3, 0x02, 0x75, 0x85, 0xc1, 0x23, 0xf1, 0x69,
+	0x91, 0x11, 0x39, 0x4d, 0x64, 0xd8, 0xde, 0x10, 0x66, 0x46, 0x29, 0x83, 0x82, 0x8f, 0x04, 0x90,
+	0xfd, 0x5b, 0x00, 0xb2, 0xcd, 0xdd, 0x42, 0x6b, 0x4b, 0xd5, 0x76, 0x3a, 0x44, 0x6e, 0x77, 0x89,
+	0x3a, 0x35, 0x1c, 0xc1, 0xa9, 0xc2, 0xb1, 0x02, 0x23, 0x3a, 0x31, 0xfa, 0x1d, 0x93, 0x3a, 0x30,
+	0xbd, 0xf4, 0xf2, 0xe4, 0xb1, 0xfd, 0x9f, 0xce, 0x61, 0x2a, 0x4e, 0x23, 0xf5, 0x13, 0x2b, 0x59,
+	0xb1, 0x03, 0x90, 0xfd, 0x23, 0x80, 0x11, 0x7b, 0x13, 0x25, 0x60, 0xb4, 0x71, 0xad, 0x58, 0x2c,
+	0x37, 0x1a, 0x2c, 0x83, 0xce, 0xc0, 0xd4, 0xb5, 0xea, 0x6a, 0xb5, 0xf6, 0x6e, 0x55, 0x2c, 0x63,
+	0x5c, 0xc3, 0x2c, 0x40, 0x49, 0x18, 0x6b, 0xd6, 0x6a, 0xe2, 0x5a, 0xa1, 0x59, 0x66, 0x03, 0x28,
+	0x05, 0xe3, 0xd6, 0x5b, 0xb9, 0x80, 0xd7, 0xae, 0xb3, 0x41, 0x34, 0x0b, 0xd9, 0x62, 0x6d, 0x6d,
+	0xad, 0xd2, 0xa8, 0xd4, 0xaa, 0x62, 0xbd, 0x50, 0x5c, 0x2d, 0x37, 0xd9, 0x90, 0x7f, 0x55, 0x28,
+	0x17, 0x8a, 0xb5, 0x2a, 0x1b, 0xb6, 0x3e, 0xd4, 0x7c, 0x4f, 0x5c, 0xc6, 0xe5, 0xab, 0x6c, 0x84,
+	0xa2, 0xbe, 0x27, 0xd6, 0x6b, 0xef, 0x96, 0x31, 0x1b, 0x45, 0x2c, 0x4c, 0x5e, 0xae, 0x37, 0xc4,
+	0x6b, 0xd5, 0xb5, 0x5a, 0x71, 0xb5, 0x5c, 0x62, 0x63, 0xd9, 0x4f, 0x00, 0x9c, 0xbd, 0x2c, 0x99,
+	0x64, 0x47, 0xda, 0xf3, 0x97, 0xbe, 0x32, 0x8c, 0x3a, 0x24, 0x45, 0x63, 0x3c, 0xb1, 0xf4, 0xc2,
+	0xf8, 0x2d, 0xf8, 0xe4, 0x47, 0x85, 0xea, 0xc1, 0x7e, 0x06, 0x60, 0x57, 0x17, 0xbd, 0x08, 0xa3,
+	0x1b, 0x92, 0x2a, 0x8b, 0x8a, 0x9d, 0x0d, 0x71, 0x01, 0x0e, 0xf6, 0x33, 0x11, 0x41, 0x52, 0xe5,
+	0x4a, 0x09, 0x47, 0xac, 0xad, 0x8a, 0x9c, 0xbd, 0x15, 0x83, 0x67, 0x0a, 0xbd, 0x5e, 0x47, 0x69,
+	0x51, 0x1f, 0xd8, 0xc0, 0xe8, 0x4d, 0x98, 0x36, 0x88, 0x61, 0x58, 0xbe, 0xdc, 0x22, 0x7b, 0x16,
+	0x02, 0x4d, 0x36, 0x81, 0x3b, 0x12, 0xc2, 0x1f, 0x07, 0xb9, 0x9b, 0x34, 0xee, 0x1b, 0xb6, 0xc4,
+	0x2a, 0xd9, 0xab, 0x94, 0x70, 0xd2, 0x18, 0xbd, 0xc9, 0xe8, 0x3c, 0x8c, 0x6c, 0x8a, 0x3d, 0x4d,
+	0xb7, 0xdd, 0x98, 0x12, 0x52, 0x47, 0x02, 0xbc, 0x10, 0xe3, 0xbe, 0x05, 0x0b, 0xe0, 0x8d, 0x87,
+	0x00, 0x87, 0x37, 0xeb, 0x9a, 0x6e, 0xa2, 0x67, 0x60, 0x78, 0x53, 0x6c, 0xa9, 0x26, 0x4d, 0xb9,
+	0x14, 0x0e, 0x6d, 0x16, 0x55, 0x13, 0xe5, 0x61, 0x62, 0x53, 0xef, 0x0e, 0x93, 0x3c, 0x44, 0xbf,
+	0x9b, 0x1e, 0xec, 0x67, 0xe0, 0x32, 0x5e, 0x77, 0x12, 0x1d, 0xc3, 0x4d, 0xbd, 0xeb, 0x3c, 0xa3,
+	0xb7, 0xe1, 0x8c, 0x4c, 0x5a, 0x9a, 0x4c, 0xe4, 0xa1, 0x52, 0xd8, 0x49, 0xfe, 0xf1, 0x22, 0xd4,
+	0xa0, 0xc4, 0x8a, 0xd3, 0x8e, 0xbc, 0x8b, 0xf0, 0x06, 0xe4, 0xc6, 0x10, 0xc4, 0x1d, 0x49, 0x57,
+	0x29, 0x4d, 0x24, 0xad, 0x30, 0xc6, 0x67, 0xfd, 0x1a, 0xef, 0x3a, 0xbb, 0xa8, 0xec, 0xa7, 0x82,
+	0xc8, 0xe3, 0xa8, 0x80, 0x86, 0xe9, 0x6d, 0x10, 0x88, 0x01, 0x1f, 0x29, 0x78, 0x79, 0x29, 0x7a,
+	0x6a, 0x5e, 0x1a, 0xa3, 0x96, 0xd8, 0x29, 0xa9, 0xe5, 0x47, 0x30, 0x2e, 0xf5, 0x7a, 0xa2, 0x61,
+	0x79, 0x9e, 0xd2, 0x40, 0x62, 0xe9, 0xb9, 0x71, 0x6b, 0x56, 0xc9, 0x5e, 0x59, 0xdd, 0x26, 0x1d,
+	0xad, 0x47, 0x70, 0x54, 0xea, 0xf5, 0x1a, 0xab, 0x64, 0x0f, 0x2d, 0xc0, 0x33, 0x1d, 0xc9, 0x30,
+	0x45, 0x49, 0xa4, 0x5e, 0x15, 0x65, 0x6d, 0x47, 0xa5, 0x7c, 0x90, 0xc2, 0x29, 0x6b, 0xa3, 0xb0,
+	0x5c, 0x54, 0x4d, 0xab, 0xa6, 0xa3, 0xe7, 0x61, 0xbc, 0xa5, 0xa9, 0x9b, 0x8a, 0xde, 0x25, 0x32,
+	0x97, 0x98, 0x07, 0x0b, 0x31, 0x3c, 0x5a, 0x98, 0x4a, 0x2b, 0xa9, 0xd3, 0xd3, 0x0a, 0xaa, 0xc2,
+	0x78, 0x47, 0xb3, 0xc3, 0xdb, 0xe0, 0xd2, 0xd4, 0x45, 0xaf, 0x8d, 0x1f, 0x68, 0x22, 0x05, 0x72,
+	0x6b, 0xae, 0x4a, 0x59, 0x35, 0xf5, 0x3d, 0x3c, 0x82, 0x40, 0xcb, 0x10, 0xa9, 0x9a, 0xde, 0x95,
+	0x3a, 0xca, 0xc7, 0x9e, 0x98, 0x9b, 0x99, 0x0f, 0x1e, 0x17, 0x73, 0x67, 0x46, 0x2a, 0x6e, 0xd8,
+	0xbd, 0x09, 0x9f, 0x9b, 0xc4, 0x19, 0x45, 0x1e, 0x4b, 0x23, 0xef, 0xdc, 0x84, 0x9e, 0x1b, 0x7c,
+	0x73, 0xef, 0xc0, 0xb4, 0xdf, 0x48, 0xc4, 0xc2, 0xa0, 0xe5, 0x34, 0x2b, 0x57, 0xe3, 0xd8, 0x7a,
+	0x44, 0x39, 0x18, 0xde, 0x96, 0x3a, 0x7d, 0xe2, 0xf0, 0x21, 0x37, 0x7e, 0x6e, 0x17, 0x00, 0xdb,
+	0x62, 0x97, 0x02, 0x6f, 0x80, 0xec, 0x5f, 0x03, 0xf0, 0x19, 0xcf, 0x7d, 0xb8, 0x22, 0x88, 0x83,
+	0x51, 0x83, 0xe8, 0x16, 0xb5, 0x39, 0x5f, 0x70, 0x5f, 0xd1, 0x32, 0x8c, 0xb9, 0xd7, 0xf3, 0xb8,
+	0x0f, 0x09, 0xac, 0x37, 0x7a, 0x69, 0xc1, 0x1a, 0xea, 0xa2, 0xcf, 0x00, 0x84, 0x92, 0x69, 0xea,
+	0xca, 0x46, 0xdf, 0x24, 0x16, 0x13, 0x5b, 0x57, 0x7a, 0xf1, 0x18, 0x5f, 0xb9, 0xa8, 0xb9, 0xc2,
+	0x50, 0x8b, 0xde, 0x84, 0xf0, 0xfa, 0x91, 0xb0, 0xf4, 0x7b, 0x90, 0x67, 0x61, 0xf6, 0xbc, 0x9e,
+	0xe5, 0xce, 0x2f, 0xf1, 0xbf, 0x78, 0x5f, 0x5a, 0xfc, 0xf8, 0xb5, 0xc5, 0x1f, 0x7f, 0xb0, 0xf0,
+	0xd6, 0xa5, 0xf7, 0x17, 0x3f, 0x78, 0xcb, 0x7d, 0x7d, 0xe5, 0x97, 0x4b, 0xdf, 0xff, 0xd5, 0xf9,
+	0x0b, 0x61, 0x3d, 0xc8, 0xdd, 0x07, 0xd8, 0xf3, 0xf5, 0xb9, 0x9f, 0xc2, 0x99, 0x31, 0xd4, 0x29,
+	0xf7, 0x3b, 0xeb, 0xbd, 0xdf, 0xb8, 0xf7, 0x16, 0xff, 0x11, 0x80, 0xcf, 0x7a, 0x2c, 0xbd, 0xa2,
+	0x29, 0x6a, 0xa1, 0xd5, 0x22, 0x3d, 0xf3, 0xa9, 0x8b, 0xab, 0x2f, 0x41, 0x03, 0x4f, 0x90, 0xa0,
+	0xef, 0xc1, 0x67, 0x15, 0xd5, 0x9d, 0x55, 0x64, 0x9a, 0x9f, 0x56, 0xa8, 0xbb, 0x17, 0xfd, 0xe2,
+	0x31, 0x17, 0xed, 0xb6, 0x62, 0x78, 0xd6, 0x83, 0xe0, 0x2e, 0x1a, 0xe8, 0x65, 0x38, 0xd3, 0x23,
+	0xaa, 0xac, 0xa8, 0x6d, 0xd1, 0x31, 0x95, 0x16, 0xee, 0x18, 0x4e, 0x3b, 0xcb, 0xce, 0x71, 0xfe,
+	0x43, 0x35, 0x2a, 0xfb, 0xcf, 0xb0, 0x2f, 0x44, 0x5d, 0x43, 0xfe, 0xc7, 0x5b, 0xc3, 0xea, 0x01,
+	0x8f, 0xe5, 0x2d, 0x5f, 0x01, 0x8e, 0x8c, 0x17, 0xe0, 0xcb, 0x30, 0xde, 0xea, 0x48, 0x86, 0x21,
+	0x6e, 0x88, 0x2d, 0x87, 0x8f, 0x5e, 0x3d, 0x41, 0x6c, 0xe4, 0x8a, 0x96, 0x92, 0x50, 0xc4, 0xd1,
+	0x96, 0xfd, 0x80, 0x56, 0x60, 0xac, 0xa7, 0x2b, 0x9a, 0xae, 0x98, 0x7b, 0xd4, 0xd5, 0xe9, 0xa5,
+	0xec, 0x14, 0x5e, 0x73, 0x5a, 0xd7, 0xba, 0x23, 0xe9, 0x69, 0xe5, 0x86, 0xda, 0xd3, 0x1a, 0xcc,
+	0xf8, 0x69, 0x1a, 0xcc, 0xb9, 0x3f, 0x00, 0x18, 0x75, 0xec, 0x44, 0xab, 0x30, 0xd6, 0xb6, 0xfb,
+	0x2f, 0x7b, 0x7a, 0x4a, 0x2c, 0xbd, 0x32, 0x6e, 0x9e, 0xd3, 0x9f, 0x15, 0x54, 0x93, 0xa8, 0xaa,
+	0xe4, 0x6d, 0xfd, 0x43, 0x36, 0xfb, 0xba, 0x00, 0xa8, 0x0c, 0x53, 0xd2, 0x86, 0xa1, 0x75, 0xfa,
+	0x26, 0x11, 0x29, 0x65, 0x3d, 0x3e, 0xb6, 0x43, 0x34, 0xae, 0x93, 0xae, 0x5a, 0x73, 0x38, 0x03,
+	0x65, 0xaf, 0xc3, 0xd9, 0x29, 0x17, 0x6c, 0xa0, 0x02, 0x8c, 0x8f, 0xb2, 0x16, 0x9c, 0x3c, 0x6b,
+	0x47, 0x5a, 0xd9, 0xbb, 0x00, 0x9e, 0x9b, 0x22, 0xb2, 0x2c, 0x29, 0xd6, 0xf4, 0x70, 0x15, 0xc6,
+	0x5c, 0x51, 0xa7, 0xf7, 0x3c, 0x09, 0xfe, 0xb4, 0xa2, 0xee, 0xc2, 0xa0, 0xb7, 0x61, 0x98, 0xfe,
+	0xde, 0xe0, 0x94, 0xaa, 0xe7, 0x27, 0x06, 0x2b, 0x6b, 0xb3, 0x44, 0x4c, 0x49, 0xe9, 0x8c, 0xf7,
+	0x36, 0xb6, 0x62, 0xf6, 0xd7, 0x00, 0x66, 0x3c, 0x5f, 0xad, 0x4c, 0xab, 0x40, 0x4f, 0x7f, 0x33,
+	0xe8, 0x25, 0x38, 0x43, 0xfb, 0x17, 0x4f, 0xf7, 0x42, 0xab, 0x00, 0x4e, 0x5a, 0xcb, 0x6e, 0xf3,
+	0x92, 0x15, 0xe1, 0x59, 0x0f, 0x50, 0xc3, 0xa6, 0xc0, 0x92, 0xd5, 0xc6, 0x7d, 0x37, 0x41, 0xbe,
+	0x0a, 0x43, 0xb4, 0x41, 0x0c, 0x1c, 0x9f, 0xe0, 0x54, 0x28, 0xfb, 0xf7, 0x18, 0x4c, 0xf9, 0xfa,
+	0x91, 0x29, 0x43, 0x2a, 0x78, 0x92, 0x21, 0x75, 0xc2, 0x39, 0xfe, 0x21, 0x75, 0x4a, 0x6e, 0x05,
+	0x4e, 0x35, 0xbc, 0x15, 0xfc, 0xc5, 0x3d, 0x79, 0xc2, 0x04, 0xf0, 0x36, 0x9f, 0x57, 0x60, 0xba,
+	0x4f, 0xfb, 0x2f, 0xd1, 0x9d, 0x80, 0xec, 0x71, 0xfc, 0xff, 0x1f, 0xdb, 0xb0, 0xad, 0x30, 0x38,
+	0xd5, 0xf7, 0x8d, 0x51, 0x2b, 0x30, 0xf1, 0xa1, 0xa6, 0xa8, 0xa2, 0x44, 0x69, 0xd7, 0x19, 0xc0,
+	0x5f, 0x3a, 0x06, 0x68, 0xc4, 0xd1, 0x2b, 0x0c, 0x86, 0x1f, 0x0e, 0xdf, 0xd0, 0x0a, 0x4c, 0xba,
+	0x61, 0x22, 0x4a, 0xad, 0x2d, 0xa7, 0x4e, 0x9f, 0x24, 0xbe, 0x56, 0x18, 0x9c, 0x70, 0x55, 0x0b,
+	0xad, 0x2d, 0x74, 0x05, 0xa6, 0x86, 0x48, 0xaa, 0x05, 0x15, 0x79, 0x12, 0xa8, 0xa1, 0x15, 0x55,
+	0x69, 0x0c, 0xcb, 0x20, 0xaa, 0xc9, 0x45, 0x4f, 0x85, 0xd5, 0xb0, 0x06, 0xf8, 0x26, 0x9c, 0x19,
+	0x62, 0x6d, 0xd2, 0x52, 0xe0, 0xd4, 0xaf, 0x57, 0x4e, 0x80, 0x66, 0xd7, 0x8e, 0x15, 0x06, 0xa7,
+	0x65, 0xdf, 0x0a, 0xaa, 0x7a, 0x50, 0x3f, 0xea, 0x93, 0x3e, 0x91, 0xb9, 0xf8, 0x93, 0xd8, 0x38,
+	0xc4, 0xbb, 0x4a, 0x95, 0x91, 0x06, 0xe7, 0xfc, 0x78, 0xa2, 0xa7, 0x1b, 0x71, 0x7e, 0x7a, 0xca,
+	0x1f, 0x03, 0x3d, 0xad, 0x72, 0xac, 0x30, 0x98, 0xf3, 0x7d, 0xc6, 0x23, 0x64, 0x1d, 0xc0, 0x6d,
+	0x4e, 0x45, 0x43, 0xeb, 0x6c, 0x3b, 0xe3, 0xca, 0xf1, 0x07, 0x70, 0x9b, 0x52, 0xeb, 0x00, 0xae,
+	0x76, 0x83, 0x2a, 0xa3, 0x55, 0x98, 0x74, 0x4a, 0x82, 0x48, 0xeb, 0x81, 0x3d, 0xd6, 0x7c, 0xef,
+	0x18, 0x30, 0x4f, 0x7d, 0xb1, 0x62, 0xc9, 0x18, 0xbd, 0x5a, 0x24, 0x6e, 0x28, 0xdd, 0x7e, 0x87,
+	0x1e, 0x3e, 0x6d, 0x93, 0xf8, 0x70, 0x41, 0x88, 0xc3, 0x40, 0xbf, 0x67, 0xff, 0x64, 0xf3, 0xe7,
+	0x00, 0xe4, 0x9c, 0xa4, 0x70, 0x1a, 0x81, 0x65, 0x6b, 0xa8, 0x30, 0x4d, 0xa2, 0x1b, 0x68, 0x1d,
+	0x26, 0xfb, 0x3d, 0x71, 0xd3, 0x5d, 0xa0, 0x95, 0x25, 0xbd, 0x34, 0x3f, 0x6e, 0xd2, 0xb8, 0xa2,
+	0x87, 0xa5, 0x13, 0xfd, 0xde, 0x70, 0x19, 0xfd, 0x10, 0x9e, 0xf5, 0xc2, 0x89, 0x3d, 0x49, 0x97,
+	0xba, 0xc4, 0x02, 0xb6, 0x3b, 0xe4, 0x59, 0x8f, 0x70, 0xdd, 0xdd, 0x43, 0x57, 0x21, 0x75, 0xb5,
+	0xc7, 0x8c, 0xe0, 0x13, 0x9b, 0x41, 0x93, 0x61, 0x64, 0x88, 0xd5, 0x1c, 0xf9, 0x20, 0x3d, 0xa6,
+	0x84, 0xa8, 0x29, 0x67, 0x7d, 0x0a, 0x43, 0x63, 0xb2, 0x7f, 0x01, 0x70, 0xb6, 0xe4, 0x8d, 0x08,
+	0xe7, 0x17, 0x3a, 0xd4, 0x7c, 0xaa, 0x32, 0x1c, 0xfb, 0x8e, 0xf2, 0xbb, 0xee, 0x65, 0xae, 0xc0,
+	0x89, 0x99, 0x4b, 0x80, 0x47, 0x42, 0xf4, 0x36, 0x08, 0xb1, 0x77, 0x7e, 0x13, 0xf1, 0xb0, 0xd8,
+	0x85, 0x5b, 0x00, 0xb2, 0xe3, 0xb7, 0x84, 0x10, 0x4c, 0x2f, 0xd7, 0xf0, 0x7a, 0xa1, 0xd9, 0x2c,
+	0x63, 0xb1, 0x5a, 0xab, 0x96, 0x59, 0x06, 0x71, 0x70, 0x76, 0xb4, 0x86, 0xcb, 0xf5, 0x5a, 0xa3,
+	0xd2, 0xac, 0xe1, 0xeb, 0x2c, 0x40, 0x73, 0xf0, 0xec, 0x68, 0xe7, 0x32, 0xae, 0x17, 0xc5, 0x46,
+	0x19, 0xbf, 0x53, 0x29, 0x5a, 0x3f, 0x8e, 0xf9, 0xb4, 0xae, 0x14, 0xde, 0x29, 0x34, 0x8a, 0xb8,
+	0x52, 0x6f, 0xb2, 0x41, 0xff, 0x4e, 0xb1, 0x70, 0xbd, 0x5c, 0xad, 0x96, 0xd7, 0xea, 0x75, 0x36,
+	0x24, 0xfc, 0x09, 0xdc, 0x3f, 0xe0, 0xc1, 0x83, 0x03, 0x1e, 0x7c, 0x75, 0xc0, 0x33, 0x0f, 0x0f,
+	0x78, 0xe6, 0xeb, 0x03, 0x9e, 0xf9, 0xe6, 0x80, 0x67, 0x1e, 0x1d, 0xf0, 0xe0, 0xe6, 0x80, 0x07,
+	0x9f, 0x0e, 0x78, 0xe6, 0x8b, 0x01, 0x0f, 0xee, 0x0e, 0x78, 0xe6, 0xde, 0x80, 0x67, 0xbe, 0x1c,
+	0xf0, 0xcc, 0xfd, 0x01, 0x0f, 0x1e, 0x0c, 0x78, 0xf0, 0xd5, 0x80, 0x67, 0x1e, 0x0e, 0x78, 0xf0,
+	0xf5, 0x80, 0x67, 0xbe, 0x19, 0xf0, 0xe0, 0xd1, 0x80, 0x67, 0x6e, 0x1e, 0xf2, 0xcc, 0xa7, 0x87,
+	0x3c, 0xb8, 0x75, 0xc8, 0x33, 0x9f, 0x1f, 0xf2, 0xe0, 0xce, 0x21, 0xcf, 0x7c, 0x71, 0xc8, 0x33,
+	0x77, 0x0f, 0x79, 0x70, 0xef, 0x90, 0x07, 0x5f, 0x1e, 0xf2, 0xe0, 0x67, 0xf9, 0xb6, 0x96, 0x33,
+	0x6f, 0x10, 0xf3, 0x86, 0xd5, 0xf2, 0xe6, 0x54, 0x62, 0xee, 0x68, 0xfa, 0x56, 0xde, 0xff, 0x57,
+	0xc4, 0xf6, 0xc5, 0x7c, 0x6f, 0xab, 0x9d, 0x37, 0x4d, 0xb5, 0xb7, 0xb1, 0x11, 0xa1, 0x14, 0x75,
+	0xf1, 0xdf, 0x03, 0x00, 0xc2, 0xcd, 0x4e, 0x3a, 0x35, 0x1a, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -1982,6 +2007,22 @@ func (this *ApplicationUplink) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.NormalizedPayload) != len(that1.NormalizedPayload) {
+		return false
+	}
+	for i := range this.NormalizedPayload {
+		if !this.NormalizedPayload[i].Equal(that1.NormalizedPayload[i]) {
+			return false
+		}
+	}
+	if len(this.NormalizedPayloadWarnings) != len(that1.NormalizedPayloadWarnings) {
+		return false
+	}
+	for i := range this.NormalizedPayloadWarnings {
+		if this.NormalizedPayloadWarnings[i] != that1.NormalizedPayloadWarnings[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ApplicationLocation) Equal(that interface{}) bool {
@@ -2939,6 +2980,31 @@ func (m *ApplicationUplink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NormalizedPayloadWarnings) > 0 {
+		for iNdEx := len(m.NormalizedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NormalizedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.NormalizedPayloadWarnings[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.NormalizedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.NormalizedPayload) > 0 {
+		for iNdEx := len(m.NormalizedPayload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizedPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.Locations) > 0 {
 		for k := range m.Locations {
 			v := m.Locations[k]
@@ -3988,6 +4054,18 @@ func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplin
 			this.Locations[randStringMessages(r)] = NewPopulatedLocation(r, easy)
 		}
 	}
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.NormalizedPayload = make([]*types.Struct, v9)
+		for i := 0; i < v9; i++ {
+			this.NormalizedPayload[i] = types.NewPopulatedStruct(r, easy)
+		}
+	}
+	v10 := r.Intn(10)
+	this.NormalizedPayloadWarnings = make([]string, v10)
+	for i := 0; i < v10; i++ {
+		this.NormalizedPayloadWarnings[i] = randStringMessages(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3996,12 +4074,12 @@ func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplin
 func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLocation {
 	this := &ApplicationLocation{}
 	this.Service = randStringMessages(r)
-	v9 := NewPopulatedLocation(r, easy)
-	this.Location = *v9
+	v11 := NewPopulatedLocation(r, easy)
+	this.Location = *v11
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(10)
+		v12 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v10; i++ {
+		for i := 0; i < v12; i++ {
 			this.Attributes[randStringMessages(r)] = randStringMessages(r)
 		}
 	}
@@ -4012,24 +4090,24 @@ func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLoc
 
 func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJoinAccept {
 	this := &ApplicationJoinAccept{}
-	v11 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v11)
-	for i := 0; i < v11; i++ {
+	v13 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v13)
+	for i := 0; i < v13; i++ {
 		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
 		this.AppSKey = NewPopulatedKeyEnvelope(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(5)
-		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v12)
-		for i := 0; i < v12; i++ {
+		v14 := r.Intn(5)
+		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v14)
+		for i := 0; i < v14; i++ {
 			this.InvalidatedDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
 	this.PendingSession = bool(r.Intn(2) == 0)
-	v13 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ReceivedAt = *v13
+	v15 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v15
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4038,11 +4116,11 @@ func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJ
 func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *ApplicationDownlink_ClassBC {
 	this := &ApplicationDownlink_ClassBC{}
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.Gateways = make([]GatewayAntennaIdentifiers, v14)
-		for i := 0; i < v14; i++ {
-			v15 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
-			this.Gateways[i] = *v15
+		v16 := r.Intn(5)
+		this.Gateways = make([]GatewayAntennaIdentifiers, v16)
+		for i := 0; i < v16; i++ {
+			v17 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
+			this.Gateways[i] = *v17
 		}
 	}
 	if r.Intn(5) != 0 {
@@ -4056,9 +4134,9 @@ func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *Applic
 func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDownlinks {
 	this := &ApplicationDownlinks{}
 	if r.Intn(5) != 0 {
-		v16 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v16)
-		for i := 0; i < v16; i++ {
+		v18 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v18)
+		for i := 0; i < v18; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -4069,10 +4147,10 @@ func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDo
 
 func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *ApplicationDownlinkFailed {
 	this := &ApplicationDownlinkFailed{}
-	v17 := NewPopulatedApplicationDownlink(r, easy)
-	this.ApplicationDownlink = *v17
-	v18 := NewPopulatedErrorDetails(r, easy)
-	this.Error = *v18
+	v19 := NewPopulatedApplicationDownlink(r, easy)
+	this.ApplicationDownlink = *v19
+	v20 := NewPopulatedErrorDetails(r, easy)
+	this.Error = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4081,9 +4159,9 @@ func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *Applicat
 func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *ApplicationInvalidatedDownlinks {
 	this := &ApplicationInvalidatedDownlinks{}
 	if r.Intn(5) != 0 {
-		v19 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v19)
-		for i := 0; i < v19; i++ {
+		v21 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v21)
+		for i := 0; i < v21; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -4106,11 +4184,11 @@ func NewPopulatedApplicationServiceData(r randyMessages, easy bool) *Application
 
 func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 	this := &ApplicationUp{}
-	v20 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v20
-	v21 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v21)
-	for i := 0; i < v21; i++ {
+	v22 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v22
+	v23 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v23)
+	for i := 0; i < v23; i++ {
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	oneofNumber_Up := []int32{3, 4, 5, 6, 7, 8, 9, 10, 11, 13}[r.Intn(10)]
@@ -4208,12 +4286,12 @@ func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePa
 
 func NewPopulatedDownlinkQueueRequest(r randyMessages, easy bool) *DownlinkQueueRequest {
 	this := &DownlinkQueueRequest{}
-	v22 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v22
+	v24 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v24
 	if r.Intn(5) != 0 {
-		v23 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v23)
-		for i := 0; i < v23; i++ {
+		v25 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v25)
+		for i := 0; i < v25; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -4241,9 +4319,9 @@ func randUTF8RuneMessages(r randyMessages) rune {
 	return rune(ru + 61)
 }
 func randStringMessages(r randyMessages) string {
-	v24 := r.Intn(100)
-	tmps := make([]rune, v24)
-	for i := 0; i < v24; i++ {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
 		tmps[i] = randUTF8RuneMessages(r)
 	}
 	return string(tmps)
@@ -4265,11 +4343,11 @@ func randFieldMessages(dAtA []byte, r randyMessages, fieldNumber int, wire int)
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
-		v25 := r.Int63()
+		v27 := r.Int63()
 		if r.Intn(2) == 0 {
-			v25 *= -1
+			v27 *= -1
 		}
-		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v25))
+		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v27))
 	case 1:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -4490,6 +4568,18 @@ func (m *ApplicationUplink) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessages(uint64(mapEntrySize))
 		}
 	}
+	if len(m.NormalizedPayload) > 0 {
+		for _, e := range m.NormalizedPayload {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.NormalizedPayloadWarnings) > 0 {
+		for _, s := range m.NormalizedPayloadWarnings {
+			l = len(s)
+			n += 2 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4955,6 +5045,11 @@ func (this *ApplicationUplink) String() string {
 		repeatedStringForRxMetadata += strings.Replace(fmt.Sprintf("%v", f), "RxMetadata", "RxMetadata", 1) + ","
 	}
 	repeatedStringForRxMetadata += "}"
+	repeatedStringForNormalizedPayload := "[]*Struct{"
+	for _, f := range this.NormalizedPayload {
+		repeatedStringForNormalizedPayload += strings.Replace(fmt.Sprintf("%v", f), "Struct", "types.Struct", 1) + ","
+	}
+	repeatedStringForNormalizedPayload += "}"
 	keysForLocations := make([]string, 0, len(this.Locations))
 	for k := range this.Locations {
 		keysForLocations = append(keysForLocations, k)
@@ -4980,6 +5075,8 @@ func (this *ApplicationUplink) String() string {
 		`DecodedPayloadWarnings:` + fmt.Sprintf("%v", this.DecodedPayloadWarnings) + `,`,
 		`ConsumedAirtime:` + strings.Replace(fmt.Sprintf("%v", this.ConsumedAirtime), "Duration", "types.Duration", 1) + `,`,
 		`Locations:` + mapStringForLocations + `,`,
+		`NormalizedPayload:` + repeatedStringForNormalizedPayload + `,`,
+		`NormalizedPayloadWarnings:` + fmt.Sprintf("%v", this.NormalizedPayloadWarnings) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6603,6 +6700,72 @@ func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
 			}
 			m.Locations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedPayload = append(m.NormalizedPayload, &types.Struct{})
+			if err := m.NormalizedPayload[len(m.NormalizedPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedPayloadWarnings = append(m.NormalizedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -280,6 +280,8 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"frm_payload",
 	"last_a_f_cnt_down",
 	"locations",
+	"normalized_payload",
+	"normalized_payload_warnings",
 	"received_at",
 	"rx_metadata",
 	"session_key_id",
@@ -314,6 +316,8 @@ var ApplicationUplinkFieldPathsTopLevel = []string{
 	"frm_payload",
 	"last_a_f_cnt_down",
 	"locations",
+	"normalized_payload",
+	"normalized_payload_warnings",
 	"received_at",
 	"rx_metadata",
 	"session_key_id",
@@ -569,6 +573,8 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_message.frm_payload",
 	"up.uplink_message.last_a_f_cnt_down",
 	"up.uplink_message.locations",
+	"up.uplink_message.normalized_payload",
+	"up.uplink_message.normalized_payload_warnings",
 	"up.uplink_message.received_at",
 	"up.uplink_message.rx_metadata",
 	"up.uplink_message.session_key_id",
@@ -88,11 +88,33 @@ func (LocationSource) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e1123b3e8fd87092, []int{0}
 }
 
+type RelayWORChannel int32
+
+const (
+	RELAY_WOR_CHANNEL_DEFAULT   RelayWORChannel = 0
+	RELAY_WOR_CHANNEL_SECONDARY RelayWORChannel = 1
+)
+
+var RelayWORChannel_name = map[int32]string{
+	0: "RELAY_WOR_CHANNEL_DEFAULT",
+	1: "RELAY_WOR_CHANNEL_SECONDARY",
+}
+
+var RelayWORChannel_value = map[string]int32{
+	"RELAY_WOR_CHANNEL_DEFAULT":   0,
+	"RELAY_WOR_CHANNEL_SECONDARY": 1,
+}
+
+func (RelayWORChannel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1123b3e8fd87092, []int{1}
+}
+
 // Contains metadata for a received message. Each antenna that receives
 // a message corresponds to one RxMetadata.
 type RxMetadata struct {
 	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	PacketBroker       *PacketBrokerMetadata `protobuf:"bytes,18,opt,name=packet_broker,json=packetBroker,proto3" json:"packet_broker,omitempty"`
+	Relay              *RelayMetadata        `protobuf:"bytes,19,opt,name=relay,proto3" json:"relay,omitempty"`
 	AntennaIndex       uint32                `protobuf:"varint,2,opt,name=antenna_index,json=antennaIndex,proto3" json:"antenna_index,omitempty"`
 	Time               *time.Time            `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time,omitempty"`
 	// Gateway concentrator timestamp when the Rx finished (microseconds).
@@ -123,6 +145,8 @@ type RxMetadata struct {
 	UplinkToken []byte `protobuf:"bytes,15,opt,name=uplink_token,json=uplinkToken,proto3" json:"uplink_token,omitempty"`
 	// Index of the gateway channel that received the message.
 	ChannelIndex uint32 `protobuf:"varint,17,opt,name=channel_index,json=channelIndex,proto3" json:"channel_index,omitempty"`
+	// Frequency plan ID of the gateway that received the message; injected by the Gateway Server.
+	FrequencyPlanID string `protobuf:"bytes,20,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
 	// Advanced metadata fields
 	// - can be used for advanced information or experimental features that are not yet formally defined in the API
 	// - field names are written in snake_case
@@ -170,6 +194,13 @@ func (m *RxMetadata) GetPacketBroker() *PacketBrokerMetadata {
 	return nil
 }
 
+func (m *RxMetadata) GetRelay() *RelayMetadata {
+	if m != nil {
+		return m.Relay
+	}
+	return nil
+}
+
 func (m *RxMetadata) GetAntennaIndex() uint32 {
 	if m != nil {
 		return m.AntennaIndex
@@ -282,6 +313,13 @@ func (m *RxMetadata) GetChannelIndex() uint32 {
 	return 0
 }
 
+func (m *RxMetadata) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
+	}
+	return ""
+}
+
 func (m *RxMetadata) GetAdvanced() *types.Struct {
 	if m != nil {
 		return m.Advanced
@@ -371,6 +409,61 @@ func (m *Location) GetSource() LocationSource {
 	return SOURCE_UNKNOWN
 }
 
+type RelayMetadata struct {
+	// End device identifiers of the relay.
+	DeviceID string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Wake on radio channel on which the relay received the uplink.
+	WORChannel           RelayWORChannel `protobuf:"varint,2,opt,name=wor_channel,json=worChannel,proto3,enum=ttn.lorawan.v3.RelayWORChannel" json:"wor_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RelayMetadata) Reset()      { *m = RelayMetadata{} }
+func (*RelayMetadata) ProtoMessage() {}
+func (*RelayMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1123b3e8fd87092, []int{2}
+}
+func (m *RelayMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayMetadata.Merge(m, src)
+}
+func (m *RelayMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayMetadata proto.InternalMessageInfo
+
+func (m *RelayMetadata) GetDeviceID() string {
+	if m != nil {
+		return m.DeviceID
+	}
+	return ""
+}
+
+func (m *RelayMetadata) GetWORChannel() RelayWORChannel {
+	if m != nil {
+		return m.WORChannel
+	}
+	return RELAY_WOR_CHANNEL_DEFAULT
+}
+
 type PacketBrokerMetadata struct {
 	// Message identifier generated by Packet Broker Router.
 	MessageID string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -396,7 +489,7 @@ type PacketBrokerMetadata struct {
 func (m *PacketBrokerMetadata) Reset()      { *m = PacketBrokerMetadata{} }
 func (*PacketBrokerMetadata) ProtoMessage() {}
 func (*PacketBrokerMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1123b3e8fd87092, []int{2}
+	return fileDescriptor_e1123b3e8fd87092, []int{3}
 }
 func (m *PacketBrokerMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +578,7 @@ type PacketBrokerRouteHop struct {
 func (m *PacketBrokerRouteHop) Reset()      { *m = PacketBrokerRouteHop{} }
 func (*PacketBrokerRouteHop) ProtoMessage() {}
 func (*PacketBrokerRouteHop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1123b3e8fd87092, []int{3}
+	return fileDescriptor_e1123b3e8fd87092, []int{4}
 }
 func (m *PacketBrokerRouteHop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,10 +645,14 @@ func (m *PacketBrokerRouteHop) GetReceiverAgent() string {
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.LocationSource", LocationSource_name, LocationSource_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.LocationSource", LocationSource_name, LocationSource_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayWORChannel", RelayWORChannel_name, RelayWORChannel_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayWORChannel", RelayWORChannel_name, RelayWORChannel_value)
 	proto.RegisterType((*RxMetadata)(nil), "ttn.lorawan.v3.RxMetadata")
 	golang_proto.RegisterType((*RxMetadata)(nil), "ttn.lorawan.v3.RxMetadata")
 	proto.RegisterType((*Location)(nil), "ttn.lorawan.v3.Location")
 	golang_proto.RegisterType((*Location)(nil), "ttn.lorawan.v3.Location")
+	proto.RegisterType((*RelayMetadata)(nil), "ttn.lorawan.v3.RelayMetadata")
+	golang_proto.RegisterType((*RelayMetadata)(nil), "ttn.lorawan.v3.RelayMetadata")
 	proto.RegisterType((*PacketBrokerMetadata)(nil), "ttn.lorawan.v3.PacketBrokerMetadata")
 	golang_proto.RegisterType((*PacketBrokerMetadata)(nil), "ttn.lorawan.v3.PacketBrokerMetadata")
 	proto.RegisterType((*PacketBrokerRouteHop)(nil), "ttn.lorawan.v3.PacketBrokerRouteHop")
@@ -568,101 +665,114 @@ func init() {
 }
 
 var fileDescriptor_e1123b3e8fd87092 = []byte{
-	// 1500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3d, 0x8c, 0xdb, 0xc8,
-	0x19, 0xe5, 0xac, 0xb4, 0x6b, 0x69, 0xf4, 0x63, 0x79, 0x76, 0xed, 0xa5, 0xd7, 0xce, 0x50, 0xd9,
-	0x4b, 0x02, 0xdd, 0x21, 0x2b, 0x01, 0xf6, 0x1d, 0x70, 0x48, 0x75, 0xab, 0xd5, 0xda, 0x26, 0x6e,
-	0x2d, 0xf9, 0x46, 0xeb, 0x3b, 0x24, 0x0d, 0x31, 0x4b, 0x8e, 0x24, 0x46, 0xd2, 0x90, 0x21, 0x47,
-	0xbb, 0x56, 0x67, 0xa4, 0x32, 0x52, 0x5d, 0xba, 0x94, 0x07, 0x04, 0x01, 0xae, 0x48, 0x71, 0xa5,
-	0x4b, 0x97, 0x2e, 0xdd, 0xe5, 0x90, 0x42, 0xb9, 0xa5, 0x9a, 0x2b, 0x9d, 0xce, 0x70, 0x93, 0x80,
-	0x43, 0x52, 0xbf, 0x76, 0x82, 0x20, 0xaa, 0x38, 0xef, 0x7b, 0xef, 0x0d, 0xf9, 0x66, 0xbe, 0x19,
-	0xc1, 0xf2, 0xc0, 0xf1, 0xe8, 0x05, 0xe5, 0x07, 0xbe, 0xa0, 0x66, 0xbf, 0x46, 0x5d, 0xbb, 0x36,
-	0x64, 0x82, 0x5a, 0x54, 0xd0, 0xaa, 0xeb, 0x39, 0xc2, 0x41, 0x45, 0x21, 0x78, 0x35, 0x66, 0x55,
-	0xcf, 0xef, 0xee, 0x1d, 0x76, 0x6d, 0xd1, 0x1b, 0x9d, 0x55, 0x4d, 0x67, 0x58, 0x63, 0xfc, 0xdc,
-	0x19, 0xbb, 0x9e, 0xf3, 0x64, 0x5c, 0x93, 0x64, 0xf3, 0xa0, 0xcb, 0xf8, 0xc1, 0x39, 0x1d, 0xd8,
-	0x16, 0x15, 0xac, 0xb6, 0xf6, 0x10, 0x59, 0xee, 0x1d, 0x2c, 0x58, 0x74, 0x9d, 0xae, 0x13, 0x89,
-	0xcf, 0x46, 0x1d, 0x39, 0x92, 0x03, 0xf9, 0x14, 0xd3, 0x6f, 0x77, 0x1d, 0xa7, 0x3b, 0x60, 0x73,
-	0x96, 0x2f, 0xbc, 0x91, 0x29, 0xe2, 0xaa, 0xb6, 0x5a, 0x15, 0xf6, 0x90, 0xf9, 0x82, 0x0e, 0xdd,
-	0x98, 0x80, 0x57, 0x09, 0x17, 0x1e, 0x75, 0x5d, 0xe6, 0xf9, 0x71, 0xfd, 0x27, 0xeb, 0x11, 0x30,
-	0x3e, 0x1a, 0x26, 0xe5, 0x0f, 0xd6, 0xcb, 0xb6, 0xc5, 0xb8, 0xb0, 0x3b, 0xf6, 0xcc, 0x63, 0xff,
-	0x6f, 0x19, 0x08, 0xc9, 0x93, 0x87, 0x71, 0x72, 0xe8, 0x31, 0xcc, 0x75, 0xa9, 0x60, 0x17, 0x74,
-	0x6c, 0xd8, 0x96, 0xaf, 0x82, 0x32, 0xa8, 0xe4, 0xee, 0xec, 0x57, 0x97, 0x93, 0xac, 0xde, 0x8f,
-	0x28, 0xfa, 0xdc, 0xad, 0x5e, 0x7a, 0x5b, 0xdf, 0xfc, 0x03, 0xd8, 0x28, 0x81, 0x97, 0x13, 0x4d,
-	0x79, 0x35, 0xd1, 0x00, 0x81, 0xdd, 0x84, 0xe5, 0x23, 0x1d, 0x16, 0x5c, 0x6a, 0xf6, 0x99, 0x30,
-	0xce, 0x3c, 0xa7, 0xcf, 0x3c, 0x15, 0x49, 0xe3, 0x9f, 0xad, 0x1a, 0x3f, 0x92, 0xa4, 0xba, 0xe4,
-	0x24, 0xef, 0x44, 0xf2, 0xee, 0x02, 0x8a, 0x3e, 0x80, 0x05, 0xca, 0x05, 0xe3, 0x9c, 0x1a, 0x36,
-	0xb7, 0xd8, 0x13, 0x75, 0xa3, 0x0c, 0x2a, 0x05, 0x92, 0x8f, 0x41, 0x3d, 0xc4, 0xd0, 0xc7, 0x30,
-	0x1d, 0x86, 0xa9, 0xa6, 0xe4, 0x34, 0x7b, 0xd5, 0x28, 0xc8, 0x6a, 0x12, 0x64, 0xf5, 0x34, 0x49,
-	0xba, 0x9e, 0xfe, 0xfa, 0x1f, 0x1a, 0x20, 0x92, 0x8d, 0x6e, 0xc3, 0xec, 0x6c, 0x09, 0xd4, 0xb4,
-	0xb4, 0x9d, 0x03, 0xe8, 0xe7, 0xb0, 0xd8, 0xb1, 0x39, 0x33, 0xe6, 0x94, 0xcd, 0x32, 0xa8, 0xa4,
-	0x49, 0x21, 0x44, 0x67, 0x86, 0xe8, 0x53, 0xa8, 0x32, 0x6e, 0x7a, 0x63, 0x57, 0x30, 0xcb, 0x58,
-	0x11, 0x6c, 0x95, 0x41, 0x25, 0x4f, 0x6e, 0xcc, 0xea, 0xf7, 0x96, 0x94, 0x0c, 0x6a, 0xef, 0x53,
-	0x1a, 0x7d, 0x16, 0x2e, 0x88, 0x7a, 0xa5, 0x0c, 0x2a, 0xd9, 0xba, 0x16, 0x4c, 0xb4, 0x5b, 0xc7,
-	0xef, 0x34, 0xf9, 0x9c, 0x8d, 0xf5, 0x06, 0xb9, 0xc5, 0xde, 0x5b, 0xb4, 0xd0, 0x6d, 0x98, 0xf6,
-	0x7c, 0xdf, 0x56, 0x33, 0x65, 0x50, 0xd9, 0xa8, 0x67, 0x82, 0x89, 0x96, 0x26, 0xed, 0xb6, 0x4e,
-	0x24, 0x8a, 0x4e, 0x60, 0xce, 0xb7, 0xbb, 0x9c, 0x0e, 0x0c, 0x49, 0x2a, 0xc9, 0x00, 0x6f, 0xad,
-	0x05, 0x78, 0x6f, 0xe0, 0x50, 0xf1, 0x25, 0x1d, 0x8c, 0x58, 0xbd, 0x18, 0x4c, 0x34, 0xd8, 0x96,
-	0x1a, 0xe9, 0x03, 0x23, 0x3d, 0x09, 0xdd, 0xee, 0xc0, 0xbc, 0xd9, 0xa3, 0x9c, 0xb3, 0xd8, 0x2e,
-	0x2b, 0xe7, 0xbc, 0x1a, 0x4c, 0xb4, 0xdc, 0x51, 0x84, 0x4b, 0x49, 0x2e, 0x26, 0x49, 0xcd, 0x17,
-	0x70, 0x37, 0xe4, 0x1a, 0xbe, 0xa0, 0xdc, 0xa2, 0x9e, 0x65, 0x58, 0xec, 0xdc, 0xa6, 0xc2, 0x76,
-	0xb8, 0x0a, 0xa5, 0xfc, 0x66, 0x30, 0xd1, 0xae, 0x87, 0xba, 0x76, 0xcc, 0x68, 0x24, 0x04, 0x72,
-	0x3d, 0x54, 0xae, 0xc1, 0xe8, 0x26, 0x4c, 0xf9, 0xdc, 0x53, 0x73, 0x52, 0x7e, 0x25, 0x98, 0x68,
-	0xa9, 0x76, 0x93, 0x90, 0x10, 0x43, 0x1f, 0xc2, 0x52, 0xc7, 0x63, 0xbf, 0x1b, 0x31, 0x6e, 0x8e,
-	0x0d, 0xa7, 0xd3, 0xf1, 0x99, 0x50, 0xf3, 0x65, 0x50, 0x49, 0x91, 0xab, 0x33, 0xbc, 0x25, 0x61,
-	0xf4, 0x31, 0xcc, 0x0c, 0x1c, 0x33, 0x7a, 0x93, 0x82, 0xcc, 0x45, 0x5d, 0xdd, 0xbf, 0x27, 0x71,
-	0x9d, 0xcc, 0x98, 0xe8, 0xb7, 0x50, 0xb5, 0x9c, 0x0b, 0x3e, 0xb0, 0x79, 0xdf, 0x70, 0xa9, 0xe8,
-	0x19, 0xa6, 0xc3, 0x7d, 0xe1, 0x51, 0x9b, 0x0b, 0xb5, 0x58, 0x06, 0x95, 0xe2, 0x9d, 0x5f, 0xac,
-	0xba, 0x34, 0x62, 0xfe, 0x23, 0x2a, 0x7a, 0x47, 0x33, 0x76, 0x3d, 0xf3, 0xb6, 0xbe, 0xf9, 0xfb,
-	0xb0, 0xc5, 0xc8, 0x0d, 0xeb, 0x9d, 0x0c, 0xf4, 0x53, 0x98, 0x1f, 0xb9, 0x72, 0x26, 0xe1, 0xf4,
-	0x19, 0x57, 0xaf, 0xca, 0xfd, 0x96, 0x8b, 0xb0, 0xd3, 0x10, 0x42, 0x07, 0xb0, 0x90, 0xac, 0x48,
-	0xd4, 0x3e, 0xd7, 0xc2, 0x7d, 0x2e, 0xbd, 0x3f, 0x4a, 0xa9, 0xff, 0x02, 0x24, 0x59, 0xb0, 0xa8,
-	0x91, 0xee, 0xc2, 0x0c, 0xb5, 0xce, 0x29, 0x37, 0x99, 0xa5, 0x9a, 0xf2, 0x9b, 0x77, 0xd7, 0xf6,
-	0x42, 0x5b, 0x1e, 0x6a, 0x64, 0x46, 0xfc, 0x55, 0xfa, 0xf9, 0x37, 0x9a, 0xb2, 0xff, 0x1a, 0xc0,
-	0x4c, 0x92, 0x47, 0xe8, 0x33, 0xa0, 0xc2, 0x16, 0x23, 0x8b, 0xc9, 0x43, 0x05, 0xd4, 0x77, 0xdf,
-	0xd6, 0x77, 0x10, 0xba, 0xa9, 0x84, 0xbf, 0xa7, 0x5f, 0x7e, 0xf6, 0x61, 0xfc, 0xf0, 0x82, 0xcc,
-	0x88, 0xe8, 0x13, 0x98, 0x1d, 0x38, 0xbc, 0x1b, 0xa9, 0x36, 0xd6, 0x55, 0x9d, 0x44, 0xd5, 0x79,
-	0x41, 0xe6, 0x4c, 0xb4, 0x07, 0x33, 0x74, 0x10, 0xcf, 0x15, 0x1e, 0x00, 0x9b, 0x64, 0x36, 0x96,
-	0x35, 0xd3, 0x1c, 0x79, 0xd4, 0x1c, 0xcb, 0x0e, 0x0f, 0x6b, 0xf1, 0x18, 0x7d, 0x06, 0xb7, 0x7c,
-	0x67, 0xe4, 0x99, 0x4c, 0x36, 0x76, 0xf1, 0x0e, 0x7e, 0xdf, 0xea, 0xb6, 0x25, 0x6b, 0x61, 0x3d,
-	0x62, 0xdd, 0xfe, 0x5f, 0x37, 0xe1, 0xce, 0xbb, 0x8e, 0x30, 0xf4, 0x4b, 0x08, 0x87, 0xcc, 0xf7,
-	0x69, 0x97, 0x85, 0x5d, 0x0c, 0x64, 0x17, 0x17, 0x82, 0x89, 0x96, 0x7d, 0x18, 0xa1, 0x7a, 0x83,
-	0x64, 0x63, 0x82, 0x6e, 0xa1, 0x31, 0x2c, 0x75, 0x1c, 0xef, 0x82, 0x7a, 0x16, 0xf3, 0x0c, 0xce,
-	0x44, 0xa8, 0x09, 0x3f, 0x3f, 0x5f, 0x6f, 0x85, 0xa7, 0xeb, 0xdf, 0x27, 0xda, 0x27, 0x5d, 0xa7,
-	0x2a, 0x7a, 0x4c, 0xf4, 0x6c, 0xde, 0xf5, 0xab, 0x9c, 0x89, 0x0b, 0xc7, 0xeb, 0xd7, 0x96, 0xcf,
-	0xfb, 0xf3, 0xbb, 0x35, 0xb7, 0xdf, 0xad, 0x89, 0xb1, 0xcb, 0xfc, 0x6a, 0x93, 0x09, 0xbd, 0x11,
-	0x4c, 0xb4, 0xe2, 0xbd, 0xc4, 0x58, 0x22, 0xa4, 0xd8, 0x59, 0x1c, 0x5b, 0xe8, 0x18, 0x6e, 0xcf,
-	0xa7, 0x16, 0x8c, 0x53, 0x2e, 0x67, 0x4f, 0xc9, 0x37, 0xbe, 0x1e, 0x4c, 0xb4, 0x6b, 0x33, 0x83,
-	0x53, 0x59, 0xd5, 0x1b, 0xe4, 0x5a, 0x67, 0x05, 0xb2, 0xd0, 0x03, 0xb8, 0x33, 0xb7, 0x31, 0x07,
-	0x23, 0x5f, 0x30, 0x2f, 0xf4, 0x49, 0x4b, 0x9f, 0x1b, 0xc1, 0x44, 0x43, 0x33, 0x9f, 0xa3, 0xa8,
-	0xac, 0x37, 0x08, 0xea, 0xac, 0x62, 0x16, 0x7a, 0x0a, 0xe0, 0x76, 0xcf, 0x19, 0x32, 0x23, 0xfe,
-	0xc8, 0x24, 0x8f, 0x4d, 0x99, 0xc7, 0x17, 0xff, 0x6f, 0x1e, 0xa5, 0x07, 0xce, 0x90, 0x35, 0x23,
-	0x7e, 0x94, 0x48, 0xa9, 0xb7, 0x8c, 0x58, 0xe8, 0x04, 0xde, 0x58, 0x7a, 0x83, 0x79, 0x2c, 0x5b,
-	0xf2, 0x73, 0x76, 0x83, 0x89, 0xb6, 0xbd, 0xe0, 0x33, 0x0b, 0x66, 0xbb, 0xb7, 0x06, 0x5a, 0xa8,
-	0x05, 0x77, 0x97, 0xdc, 0x16, 0xd2, 0xc9, 0x48, 0x3b, 0x35, 0x98, 0x68, 0x3b, 0x0b, 0x76, 0xf3,
-	0x7c, 0x76, 0x7a, 0xeb, 0xa8, 0x85, 0x3e, 0x85, 0xe9, 0x9e, 0xe3, 0xfa, 0xea, 0x95, 0x72, 0xea,
-	0xbf, 0x5d, 0xa9, 0xc4, 0x19, 0x09, 0xf6, 0xc0, 0x71, 0x89, 0x54, 0xec, 0xff, 0x13, 0x2c, 0x6f,
-	0xd7, 0xa4, 0x8c, 0x8e, 0x61, 0xce, 0x63, 0x26, 0xb3, 0xcf, 0x99, 0x65, 0x50, 0x11, 0xff, 0x0b,
-	0xf8, 0x4f, 0xb7, 0x68, 0x26, 0x5c, 0x07, 0x79, 0x93, 0xc2, 0x44, 0x78, 0x28, 0x90, 0x06, 0x73,
-	0x3e, 0xe3, 0x72, 0x13, 0xd3, 0x61, 0xd4, 0xc1, 0x59, 0x02, 0x23, 0xa8, 0x49, 0x87, 0x2c, 0xbc,
-	0x52, 0x63, 0x02, 0xb5, 0x2c, 0x8f, 0xf9, 0x7e, 0xb4, 0xd1, 0x48, 0x21, 0x42, 0x0f, 0x23, 0x30,
-	0xbc, 0xf2, 0x63, 0xd7, 0xd8, 0x49, 0x6e, 0x23, 0x92, 0x4f, 0xc0, 0xc4, 0x6b, 0x46, 0xa2, 0x5d,
-	0xc6, 0x85, 0xdc, 0x22, 0x59, 0x32, 0x93, 0x1e, 0x86, 0xe0, 0x47, 0x7f, 0xdc, 0x80, 0xc5, 0xe5,
-	0x3e, 0x46, 0x08, 0x16, 0xdb, 0xad, 0xc7, 0xe4, 0xe8, 0xd8, 0x78, 0xdc, 0xfc, 0xbc, 0xd9, 0xfa,
-	0xaa, 0x59, 0x52, 0x50, 0x11, 0xc2, 0x18, 0xbb, 0xff, 0xa8, 0x5d, 0x02, 0x68, 0x1b, 0x5e, 0x8d,
-	0xc7, 0xe4, 0xf8, 0xbe, 0xde, 0x3e, 0x25, 0xbf, 0x2e, 0xa5, 0xd0, 0x4d, 0x78, 0x3d, 0x06, 0xf5,
-	0x47, 0xc6, 0xfd, 0xe3, 0xd6, 0x49, 0xeb, 0xe8, 0xf0, 0x54, 0x6f, 0x35, 0x4b, 0x69, 0x54, 0x86,
-	0xb7, 0xe3, 0xd2, 0x57, 0xfa, 0x3d, 0xdd, 0x08, 0x6f, 0xab, 0x25, 0xc6, 0x26, 0xc2, 0x70, 0x2f,
-	0x66, 0xd4, 0x4f, 0xd7, 0xeb, 0x5b, 0x0b, 0x0e, 0x27, 0x2d, 0x72, 0xb8, 0xce, 0xb8, 0xb2, 0xca,
-	0x38, 0x6d, 0xb4, 0x0e, 0x97, 0x18, 0x19, 0xa4, 0xc1, 0x5b, 0x31, 0xe3, 0xa8, 0xf5, 0xb0, 0xae,
-	0x37, 0x8f, 0x1b, 0x4b, 0x84, 0xec, 0x5e, 0xfa, 0xd9, 0x9f, 0xb1, 0x52, 0xff, 0x0b, 0x78, 0x79,
-	0x89, 0xc1, 0xab, 0x4b, 0x0c, 0xbe, 0xbf, 0xc4, 0xca, 0x0f, 0x97, 0x58, 0xf9, 0xf1, 0x12, 0x2b,
-	0xaf, 0x2f, 0xb1, 0xf2, 0xe6, 0x12, 0x83, 0xa7, 0x01, 0x06, 0xcf, 0x02, 0xac, 0x7c, 0x1b, 0x60,
-	0xf0, 0x5d, 0x80, 0x95, 0xe7, 0x01, 0x56, 0x5e, 0x04, 0x58, 0x79, 0x19, 0x60, 0xf0, 0x2a, 0xc0,
-	0xe0, 0xfb, 0x00, 0x2b, 0x3f, 0x04, 0x18, 0xfc, 0x18, 0x60, 0xe5, 0x75, 0x80, 0xc1, 0x9b, 0x00,
-	0x2b, 0x4f, 0xa7, 0x58, 0x79, 0x36, 0xc5, 0xe0, 0xeb, 0x29, 0x56, 0xfe, 0x34, 0xc5, 0xe0, 0x9b,
-	0x29, 0x56, 0xbe, 0x9d, 0x62, 0xe5, 0xbb, 0x29, 0x06, 0xcf, 0xa7, 0x18, 0xbc, 0x98, 0x62, 0xf0,
-	0x9b, 0xda, 0xff, 0xd0, 0xb4, 0x82, 0xbb, 0x67, 0x67, 0x5b, 0x72, 0xe7, 0xdd, 0xfd, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xa5, 0xb8, 0x5b, 0xe7, 0xfb, 0x0b, 0x00, 0x00,
+	// 1707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6c, 0xdb, 0xd6,
+	0x1d, 0xe6, 0xb3, 0x65, 0x47, 0x7a, 0xb2, 0x65, 0xe5, 0xd9, 0xb1, 0x69, 0x27, 0x21, 0x35, 0x37,
+	0x1b, 0x9c, 0xa0, 0x96, 0x07, 0xbb, 0x05, 0xba, 0x5e, 0x1a, 0xd1, 0x92, 0x13, 0xa2, 0x8e, 0xe4,
+	0x3e, 0x39, 0xf5, 0xb2, 0xa2, 0x23, 0x9e, 0xc9, 0x27, 0x89, 0xb3, 0xf4, 0xa8, 0x91, 0x4f, 0x76,
+	0xb4, 0x61, 0x40, 0xb0, 0x53, 0xb0, 0x53, 0x77, 0xdb, 0xb1, 0xc0, 0x30, 0xa0, 0x87, 0x1d, 0x7a,
+	0x0c, 0xb0, 0x4b, 0x8e, 0x39, 0xe6, 0xb0, 0x43, 0xb1, 0x83, 0x56, 0xd3, 0x97, 0x1e, 0xb3, 0x5b,
+	0xe1, 0xcb, 0x06, 0x3e, 0x92, 0xfa, 0xeb, 0xa0, 0x18, 0xaa, 0x13, 0xdf, 0xf7, 0xfb, 0xbe, 0x8f,
+	0xd4, 0xf7, 0x7e, 0xef, 0x47, 0xc2, 0x5c, 0xd3, 0x71, 0xc9, 0x19, 0x61, 0x9b, 0x1e, 0x27, 0xe6,
+	0xc9, 0x16, 0x69, 0xdb, 0x5b, 0x2d, 0xca, 0x89, 0x45, 0x38, 0xc9, 0xb7, 0x5d, 0x87, 0x3b, 0x28,
+	0xc3, 0x39, 0xcb, 0x47, 0xac, 0xfc, 0xe9, 0xce, 0x5a, 0xa1, 0x6e, 0xf3, 0x46, 0xe7, 0x38, 0x6f,
+	0x3a, 0xad, 0x2d, 0xca, 0x4e, 0x9d, 0x6e, 0xdb, 0x75, 0x9e, 0x76, 0xb7, 0x04, 0xd9, 0xdc, 0xac,
+	0x53, 0xb6, 0x79, 0x4a, 0x9a, 0xb6, 0x45, 0x38, 0xdd, 0x9a, 0xb8, 0x08, 0x2d, 0xd7, 0x36, 0x87,
+	0x2c, 0xea, 0x4e, 0xdd, 0x09, 0xc5, 0xc7, 0x9d, 0x9a, 0x58, 0x89, 0x85, 0xb8, 0x8a, 0xe8, 0xb7,
+	0xea, 0x8e, 0x53, 0x6f, 0xd2, 0x01, 0xcb, 0xe3, 0x6e, 0xc7, 0xe4, 0x51, 0x55, 0x1d, 0xaf, 0x72,
+	0xbb, 0x45, 0x3d, 0x4e, 0x5a, 0xed, 0x88, 0xa0, 0x8c, 0x13, 0xce, 0x5c, 0xd2, 0x6e, 0x53, 0xd7,
+	0x8b, 0xea, 0xb7, 0x27, 0x23, 0xa0, 0xac, 0xd3, 0x8a, 0xcb, 0xef, 0x4c, 0x96, 0x6d, 0x8b, 0x32,
+	0x6e, 0xd7, 0xec, 0xbe, 0xc7, 0xfa, 0x3f, 0x53, 0x10, 0xe2, 0xa7, 0x8f, 0xa2, 0xe4, 0xd0, 0x63,
+	0x98, 0xae, 0x13, 0x4e, 0xcf, 0x48, 0xd7, 0xb0, 0x2d, 0x4f, 0x06, 0x39, 0xb0, 0x91, 0xde, 0x5e,
+	0xcf, 0x8f, 0x26, 0x99, 0x7f, 0x10, 0x52, 0xf4, 0x81, 0x9b, 0x96, 0xbd, 0xd4, 0x66, 0xfe, 0x04,
+	0xa6, 0xb2, 0xe0, 0x55, 0x4f, 0x95, 0x5e, 0xf7, 0x54, 0x80, 0x61, 0x3d, 0x66, 0x79, 0x48, 0x87,
+	0xf3, 0x6d, 0x62, 0x9e, 0x50, 0x6e, 0x1c, 0xbb, 0xce, 0x09, 0x75, 0x65, 0x24, 0x8c, 0xef, 0x8c,
+	0x1b, 0x1f, 0x08, 0x92, 0x26, 0x38, 0xf1, 0x33, 0xe1, 0xb9, 0xf6, 0x10, 0x8a, 0x76, 0xe0, 0x8c,
+	0x4b, 0x9b, 0xa4, 0x2b, 0x2f, 0x0a, 0x8b, 0xdb, 0xe3, 0x16, 0x38, 0x28, 0xf6, 0xb5, 0x21, 0x17,
+	0xbd, 0x03, 0xe7, 0x09, 0xe3, 0x94, 0x31, 0x62, 0xd8, 0xcc, 0xa2, 0x4f, 0xe5, 0xa9, 0x1c, 0xd8,
+	0x98, 0xc7, 0x73, 0x11, 0xa8, 0x07, 0x18, 0x7a, 0x0f, 0x26, 0x82, 0x1d, 0x90, 0xa7, 0x85, 0xf1,
+	0x5a, 0x3e, 0x4c, 0x3f, 0x1f, 0xa7, 0x9f, 0x3f, 0x8c, 0xb7, 0x47, 0x4b, 0x7c, 0xf1, 0x6f, 0x15,
+	0x60, 0xc1, 0x46, 0xb7, 0x60, 0xaa, 0xbf, 0x6f, 0x72, 0x42, 0xd8, 0x0e, 0x00, 0xf4, 0x53, 0x98,
+	0xa9, 0xd9, 0x8c, 0x1a, 0x03, 0xca, 0x4c, 0x0e, 0x6c, 0x24, 0xf0, 0x7c, 0x80, 0xf6, 0x0d, 0xd1,
+	0x07, 0x50, 0xa6, 0xcc, 0x74, 0xbb, 0x6d, 0x4e, 0x2d, 0x63, 0x4c, 0x30, 0x9b, 0x03, 0x1b, 0x73,
+	0x78, 0xb9, 0x5f, 0xdf, 0x1b, 0x51, 0x52, 0xa8, 0xbe, 0x4d, 0x69, 0x9c, 0xd0, 0x60, 0x17, 0xe5,
+	0x6b, 0x39, 0xb0, 0x91, 0xd2, 0x54, 0xbf, 0xa7, 0xde, 0x2c, 0x5d, 0x69, 0xf2, 0x31, 0xed, 0xea,
+	0x45, 0x7c, 0x93, 0xbe, 0xb5, 0x68, 0xa1, 0x5b, 0x30, 0xe1, 0x7a, 0x9e, 0x2d, 0x27, 0x73, 0x60,
+	0x63, 0x4a, 0x4b, 0xfa, 0x3d, 0x35, 0x81, 0xab, 0x55, 0x1d, 0x0b, 0x14, 0xed, 0xc3, 0xb4, 0x67,
+	0xd7, 0x19, 0x69, 0x1a, 0x82, 0x94, 0x15, 0x01, 0xde, 0x9c, 0x08, 0x70, 0xaf, 0xe9, 0x10, 0xfe,
+	0x29, 0x69, 0x76, 0xa8, 0x96, 0xf1, 0x7b, 0x2a, 0xac, 0x0a, 0x8d, 0xf0, 0x81, 0xa1, 0x1e, 0x07,
+	0x6e, 0xdb, 0x70, 0xce, 0x6c, 0x10, 0xc6, 0x68, 0x64, 0x97, 0x12, 0xf7, 0x5c, 0xf0, 0x7b, 0x6a,
+	0x7a, 0x37, 0xc4, 0x85, 0x24, 0x1d, 0x91, 0x84, 0xe6, 0x13, 0xb8, 0x12, 0x70, 0x0d, 0x8f, 0x13,
+	0x66, 0x11, 0xd7, 0x32, 0x2c, 0x7a, 0x6a, 0x13, 0x6e, 0x3b, 0x4c, 0x86, 0x42, 0xbe, 0xea, 0xf7,
+	0xd4, 0x1b, 0x81, 0xae, 0x1a, 0x31, 0x8a, 0x31, 0x01, 0xdf, 0x08, 0x94, 0x13, 0x30, 0x5a, 0x85,
+	0xd3, 0x1e, 0x73, 0xe5, 0xb4, 0x90, 0x5f, 0xf3, 0x7b, 0xea, 0x74, 0xb5, 0x8c, 0x71, 0x80, 0xa1,
+	0xbb, 0x30, 0x5b, 0x73, 0xe9, 0x6f, 0x3b, 0x94, 0x99, 0x5d, 0xc3, 0xa9, 0xd5, 0x3c, 0xca, 0xe5,
+	0xb9, 0x1c, 0xd8, 0x98, 0xc6, 0x0b, 0x7d, 0xbc, 0x22, 0x60, 0xf4, 0x1e, 0x4c, 0x36, 0x1d, 0x33,
+	0x7c, 0x92, 0x79, 0x91, 0x8b, 0x3c, 0xde, 0xb1, 0xfb, 0x51, 0x1d, 0xf7, 0x99, 0xe8, 0x37, 0x50,
+	0xb6, 0x9c, 0x33, 0xd6, 0xb4, 0xd9, 0x89, 0xd1, 0x26, 0xbc, 0x61, 0x98, 0x0e, 0xf3, 0xb8, 0x4b,
+	0x6c, 0xc6, 0xe5, 0x4c, 0x0e, 0x6c, 0x64, 0xb6, 0x7f, 0x36, 0xee, 0x52, 0x8c, 0xf8, 0x07, 0x84,
+	0x37, 0x76, 0xfb, 0x6c, 0x2d, 0x79, 0xa9, 0xcd, 0xfc, 0x31, 0x38, 0x97, 0x78, 0xd9, 0xba, 0x92,
+	0x81, 0x7e, 0x02, 0xe7, 0x3a, 0x6d, 0x71, 0x27, 0xee, 0x9c, 0x50, 0x26, 0x2f, 0x88, 0x7e, 0x4b,
+	0x87, 0xd8, 0x61, 0x00, 0xa1, 0x4d, 0x38, 0x1f, 0xef, 0x48, 0x78, 0x7c, 0xae, 0x07, 0x7d, 0x2e,
+	0xbc, 0xef, 0x4d, 0xcb, 0xff, 0x05, 0x38, 0xde, 0xb0, 0xf0, 0x20, 0xed, 0xc1, 0xeb, 0x83, 0x78,
+	0xda, 0x4d, 0xc2, 0x82, 0x2e, 0x5c, 0x12, 0x5d, 0xb8, 0x76, 0xa9, 0x25, 0xdc, 0x29, 0xf9, 0xbe,
+	0xdf, 0x53, 0x17, 0xf6, 0x62, 0xce, 0x41, 0x93, 0x30, 0xbd, 0x38, 0x94, 0x9d, 0x00, 0x2c, 0xb4,
+	0x03, 0x93, 0xc4, 0x3a, 0x25, 0xcc, 0xa4, 0x96, 0x6c, 0x8a, 0xec, 0x56, 0x26, 0x7a, 0xaa, 0x2a,
+	0x26, 0x2a, 0xee, 0x13, 0x3f, 0x4c, 0xbc, 0xf8, 0x52, 0x95, 0xd6, 0xdf, 0x00, 0x98, 0x8c, 0x73,
+	0x0d, 0x7c, 0x9a, 0x84, 0xdb, 0xbc, 0x63, 0x51, 0x31, 0xd1, 0x80, 0xb6, 0x72, 0xa9, 0x2d, 0x21,
+	0xb4, 0x2a, 0x05, 0xbf, 0x67, 0x9f, 0xde, 0xbf, 0x1b, 0x5d, 0xbc, 0xc4, 0x7d, 0x22, 0x7a, 0x1f,
+	0xa6, 0x9a, 0x0e, 0xab, 0x87, 0xaa, 0xa9, 0x49, 0x55, 0x2d, 0x56, 0xd5, 0x5e, 0xe2, 0x01, 0x13,
+	0xad, 0xc1, 0x24, 0x69, 0x46, 0xf7, 0x0a, 0x06, 0xc9, 0x0c, 0xee, 0xaf, 0x45, 0xcd, 0x34, 0x3b,
+	0x2e, 0x31, 0xbb, 0x72, 0x22, 0xaa, 0x45, 0x6b, 0x74, 0x1f, 0xce, 0x7a, 0x4e, 0xc7, 0x35, 0xa9,
+	0x18, 0x10, 0x99, 0x6d, 0xe5, 0x6d, 0x5d, 0x52, 0x15, 0xac, 0xa1, 0x7d, 0x8d, 0x74, 0xeb, 0xff,
+	0x00, 0x70, 0x7e, 0x64, 0xf8, 0xa1, 0x03, 0x98, 0x0a, 0x8e, 0x81, 0x49, 0x83, 0xfc, 0x81, 0xc8,
+	0x7f, 0xe7, 0x52, 0xbb, 0xe3, 0xae, 0xcb, 0x77, 0xb6, 0x95, 0x5f, 0x7f, 0x46, 0x36, 0x7f, 0xf7,
+	0xf3, 0xcd, 0x5f, 0x7c, 0xbe, 0xf1, 0xd1, 0x87, 0x9f, 0x6d, 0x7e, 0xfe, 0x51, 0xbc, 0xbc, 0xfb,
+	0xfb, 0xed, 0x77, 0xff, 0x70, 0xc7, 0xef, 0xa9, 0xc9, 0xa2, 0xd0, 0xea, 0x45, 0x9c, 0x0c, 0x5d,
+	0x74, 0x0b, 0xfd, 0x12, 0xa6, 0xcf, 0x1c, 0xd7, 0x88, 0x76, 0x5b, 0xc4, 0x92, 0xd9, 0x56, 0xaf,
+	0x1c, 0xc1, 0x47, 0x15, 0x1c, 0x9d, 0x56, 0x6d, 0x39, 0x7e, 0xd6, 0xe0, 0xd0, 0x0f, 0x70, 0x0c,
+	0xcf, 0x1c, 0x37, 0xba, 0x5e, 0xff, 0xfb, 0x0c, 0x5c, 0xba, 0x6a, 0xfa, 0xa3, 0x77, 0x21, 0x6c,
+	0x51, 0xcf, 0x23, 0xf5, 0xa1, 0x7f, 0x31, 0xef, 0xf7, 0xd4, 0xd4, 0xa3, 0x10, 0xd5, 0x8b, 0x38,
+	0x15, 0x11, 0x74, 0x0b, 0x75, 0x61, 0xb6, 0xe6, 0xb8, 0x67, 0xc4, 0xb5, 0xa8, 0x6b, 0x30, 0xca,
+	0x03, 0x4d, 0xf0, 0x94, 0x73, 0x5a, 0x25, 0x78, 0x31, 0xfd, 0xab, 0xa7, 0xbe, 0x5f, 0x77, 0xf2,
+	0xbc, 0x41, 0x79, 0xc3, 0x66, 0x75, 0x2f, 0xcf, 0x28, 0x3f, 0x73, 0xdc, 0x93, 0xad, 0xd1, 0x57,
+	0xe5, 0xe9, 0xce, 0x56, 0xfb, 0xa4, 0xbe, 0xc5, 0xbb, 0x6d, 0xea, 0xe5, 0xcb, 0x94, 0xeb, 0x45,
+	0xbf, 0xa7, 0x66, 0xf6, 0x62, 0x63, 0x81, 0xe0, 0x4c, 0x6d, 0x78, 0x6d, 0xa1, 0x12, 0x5c, 0x1c,
+	0xdc, 0x9a, 0x53, 0x46, 0x98, 0xb8, 0xfb, 0xb4, 0x78, 0xe2, 0x1b, 0x7e, 0x4f, 0xbd, 0xde, 0x37,
+	0x38, 0x14, 0x55, 0xbd, 0x88, 0xaf, 0xd7, 0xc6, 0x20, 0x0b, 0x3d, 0x84, 0x4b, 0x03, 0x1b, 0xb3,
+	0xd9, 0xf1, 0x38, 0x75, 0x03, 0x9f, 0x84, 0xf0, 0x59, 0xf6, 0x7b, 0x2a, 0xea, 0xfb, 0xec, 0x86,
+	0x65, 0xbd, 0x88, 0x51, 0x6d, 0x1c, 0xb3, 0xd0, 0x33, 0x00, 0x17, 0x1b, 0x4e, 0x8b, 0x1a, 0xd1,
+	0x9f, 0x8c, 0xf3, 0x98, 0x11, 0x79, 0x7c, 0xf2, 0x63, 0xf3, 0xc8, 0x3e, 0x74, 0x5a, 0xb4, 0x1c,
+	0xf2, 0xc3, 0x44, 0xb2, 0x8d, 0x51, 0xc4, 0x42, 0xfb, 0x70, 0x79, 0xe4, 0x09, 0x06, 0xb1, 0xcc,
+	0x8a, 0xbf, 0xb3, 0xe2, 0xf7, 0xd4, 0xc5, 0x21, 0x9f, 0x7e, 0x30, 0x8b, 0x8d, 0x09, 0xd0, 0x42,
+	0x15, 0xb8, 0x32, 0xe2, 0x36, 0x94, 0x4e, 0x52, 0xd8, 0xc9, 0x7e, 0x4f, 0x5d, 0x1a, 0xb2, 0x1b,
+	0xe4, 0xb3, 0xd4, 0x98, 0x44, 0x2d, 0xf4, 0x01, 0x4c, 0x34, 0x9c, 0xb6, 0x27, 0x5f, 0xcb, 0x4d,
+	0xff, 0xd0, 0xd7, 0x08, 0x76, 0x3a, 0x9c, 0x3e, 0x74, 0xda, 0x58, 0x28, 0xd6, 0xff, 0x03, 0xe0,
+	0xd2, 0x55, 0x65, 0x54, 0x82, 0x69, 0x97, 0x9a, 0xd4, 0x3e, 0xa5, 0x96, 0x41, 0xb8, 0x0c, 0x7e,
+	0xf0, 0x5b, 0x22, 0x19, 0xec, 0x83, 0xf8, 0x9e, 0x80, 0xb1, 0xb0, 0xc0, 0x91, 0x0a, 0xd3, 0x1e,
+	0x65, 0xa2, 0x89, 0x49, 0x2b, 0x9c, 0x3f, 0x29, 0x0c, 0x43, 0xa8, 0x4c, 0x5a, 0x34, 0xf8, 0xb0,
+	0x88, 0x08, 0xc4, 0xb2, 0x5c, 0xea, 0x79, 0x61, 0xa3, 0xe1, 0xf9, 0x10, 0x2d, 0x84, 0x60, 0xf0,
+	0xe1, 0x13, 0xb9, 0x46, 0x4e, 0xa2, 0x8d, 0xf0, 0x5c, 0x0c, 0xc6, 0x5e, 0x7d, 0x12, 0xa9, 0x53,
+	0xc6, 0x45, 0x8b, 0xa4, 0x70, 0x5f, 0x5a, 0x08, 0xc0, 0x7b, 0x7f, 0x9e, 0x82, 0x99, 0xd1, 0x29,
+	0x84, 0x10, 0xcc, 0x54, 0x2b, 0x8f, 0xf1, 0x6e, 0xc9, 0x78, 0x5c, 0xfe, 0xb8, 0x5c, 0x39, 0x2a,
+	0x67, 0x25, 0x94, 0x81, 0x30, 0xc2, 0x1e, 0x1c, 0x54, 0xb3, 0x00, 0x2d, 0xc2, 0x85, 0x68, 0x8d,
+	0x4b, 0x0f, 0xf4, 0xea, 0x21, 0x7e, 0x92, 0x9d, 0x46, 0xab, 0xf0, 0x46, 0x04, 0xea, 0x07, 0xc6,
+	0x83, 0x52, 0x65, 0xbf, 0xb2, 0x5b, 0x38, 0xd4, 0x2b, 0xe5, 0x6c, 0x02, 0xe5, 0xe0, 0xad, 0xa8,
+	0x74, 0xa4, 0xef, 0xe9, 0x46, 0xf0, 0xce, 0x1e, 0x61, 0xcc, 0x20, 0x05, 0xae, 0x45, 0x0c, 0xed,
+	0x70, 0xb2, 0x3e, 0x3b, 0xe4, 0xb0, 0x5f, 0xc1, 0x85, 0x49, 0xc6, 0xb5, 0x71, 0xc6, 0x61, 0xb1,
+	0x52, 0x18, 0x61, 0x24, 0x91, 0x0a, 0x6f, 0x46, 0x8c, 0xdd, 0xca, 0x23, 0x4d, 0x2f, 0x97, 0x8a,
+	0x23, 0x84, 0xd4, 0x5a, 0xe2, 0xf9, 0x5f, 0x15, 0xe9, 0xde, 0x11, 0x5c, 0x18, 0x9b, 0x76, 0xe8,
+	0x36, 0x5c, 0xc5, 0xa5, 0xfd, 0xc2, 0x13, 0xe3, 0xa8, 0x82, 0x8d, 0xdd, 0x87, 0x85, 0x72, 0xb9,
+	0xb4, 0x6f, 0x14, 0x4b, 0x7b, 0x85, 0xc7, 0xfb, 0x87, 0x59, 0x29, 0x30, 0x9e, 0x2c, 0x57, 0x4b,
+	0xbb, 0x95, 0x72, 0xb1, 0x80, 0x9f, 0x64, 0x41, 0x68, 0xac, 0xfd, 0x0d, 0xbc, 0x3a, 0x57, 0xc0,
+	0xeb, 0x73, 0x05, 0x7c, 0x73, 0xae, 0x48, 0xdf, 0x9e, 0x2b, 0xd2, 0x77, 0xe7, 0x8a, 0xf4, 0xe6,
+	0x5c, 0x91, 0xbe, 0x3f, 0x57, 0xc0, 0x33, 0x5f, 0x01, 0xcf, 0x7d, 0x45, 0xfa, 0xca, 0x57, 0xc0,
+	0xd7, 0xbe, 0x22, 0xbd, 0xf0, 0x15, 0xe9, 0xa5, 0xaf, 0x48, 0xaf, 0x7c, 0x05, 0xbc, 0xf6, 0x15,
+	0xf0, 0x8d, 0xaf, 0x48, 0xdf, 0xfa, 0x0a, 0xf8, 0xce, 0x57, 0xa4, 0x37, 0xbe, 0x02, 0xbe, 0xf7,
+	0x15, 0xe9, 0xd9, 0x85, 0x22, 0x3d, 0xbf, 0x50, 0xc0, 0x17, 0x17, 0x8a, 0xf4, 0x97, 0x0b, 0x05,
+	0x7c, 0x79, 0xa1, 0x48, 0x5f, 0x5d, 0x28, 0xd2, 0xd7, 0x17, 0x0a, 0x78, 0x71, 0xa1, 0x80, 0x97,
+	0x17, 0x0a, 0xf8, 0xd5, 0xd6, 0xff, 0x31, 0x0d, 0x38, 0x6b, 0x1f, 0x1f, 0xcf, 0x8a, 0x96, 0xde,
+	0xf9, 0xdf, 0x00, 0x41, 0x4f, 0x16, 0x85, 0x8f, 0x0d, 0x00, 0x00,
 }
 
 func (x LocationSource) String() string {
@@ -672,6 +782,13 @@ func (x LocationSource) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x RelayWORChannel) String() string {
+	s, ok := RelayWORChannel_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *RxMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -697,6 +814,9 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if !this.PacketBroker.Equal(that1.PacketBroker) {
 		return false
 	}
+	if !this.Relay.Equal(that1.Relay) {
+		return false
+	}
 	if this.AntennaIndex != that1.AntennaIndex {
 		return false
 	}
@@ -749,6 +869,9 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if this.ChannelIndex != that1.ChannelIndex {
 		return false
 	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
 	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
@@ -790,6 +913,33 @@ func (this *Location) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RelayMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayMetadata)
+	if !ok {
+		that2, ok := that.(RelayMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeviceID != that1.DeviceID {
+		return false
+	}
+	if this.WORChannel != that1.WORChannel {
+		return false
+	}
+	return true
+}
 func (this *PacketBrokerMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -910,6 +1060,29 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Relay != nil {
+		{
+			size, err := m.Relay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.PacketBroker != nil {
 		{
 			size, err := m.PacketBroker.MarshalToSizedBuffer(dAtA[:i])
@@ -1023,12 +1196,12 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.Time != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err5 != nil {
-			return 0, err5
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n5
-		i = encodeVarintMetadata(dAtA, i, uint64(n5))
+		i -= n6
+		i = encodeVarintMetadata(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1100,6 +1273,41 @@ func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WORChannel != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.WORChannel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DeviceID) > 0 {
+		i -= len(m.DeviceID)
+		copy(dAtA[i:], m.DeviceID)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.DeviceID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketBrokerMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1240,12 +1448,12 @@ func (m *PacketBrokerRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintMetadata(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintMetadata(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1286,6 +1494,15 @@ func NewPopulatedLocation(r randyMetadata, easy bool) *Location {
 	return this
 }
 
+func NewPopulatedRelayMetadata(r randyMetadata, easy bool) *RelayMetadata {
+	this := &RelayMetadata{}
+	this.DeviceID = randStringMetadata(r)
+	this.WORChannel = RelayWORChannel([]int32{0, 1}[r.Intn(2)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedPacketBrokerMetadata(r randyMetadata, easy bool) *PacketBrokerMetadata {
 	this := &PacketBrokerMetadata{}
 	this.MessageID = randStringMetadata(r)
@@ -1460,6 +1677,14 @@ func (m *RxMetadata) Size() (n int) {
 		l = m.PacketBroker.Size()
 		n += 2 + l + sovMetadata(uint64(l))
 	}
+	if m.Relay != nil {
+		l = m.Relay.Size()
+		n += 2 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 2 + l + sovMetadata(uint64(l))
+	}
 	if m.Advanced != nil {
 		l = m.Advanced.Size()
 		n += 2 + l + sovMetadata(uint64(l))
@@ -1491,6 +1716,22 @@ func (m *Location) Size() (n int) {
 	return n
 }
 
+func (m *RelayMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceID)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.WORChannel != 0 {
+		n += 1 + sovMetadata(uint64(m.WORChannel))
+	}
+	return n
+}
+
 func (m *PacketBrokerMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1586,6 +1827,8 @@ func (this *RxMetadata) String() string {
 		`SignalRSSI:` + strings.Replace(fmt.Sprintf("%v", this.SignalRSSI), "FloatValue", "types.FloatValue", 1) + `,`,
 		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
 		`PacketBroker:` + strings.Replace(this.PacketBroker.String(), "PacketBrokerMetadata", "PacketBrokerMetadata", 1) + `,`,
+		`Relay:` + strings.Replace(this.Relay.String(), "RelayMetadata", "RelayMetadata", 1) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
 		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
 		`}`,
 	}, "")
@@ -1605,6 +1848,17 @@ func (this *Location) String() string {
 	}, "")
 	return s
 }
+func (this *RelayMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayMetadata{`,
+		`DeviceID:` + fmt.Sprintf("%v", this.DeviceID) + `,`,
+		`WORChannel:` + fmt.Sprintf("%v", this.WORChannel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PacketBrokerMetadata) String() string {
 	if this == nil {
 		return "nil"
@@ -2113,6 +2367,74 @@ func (m *RxMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Relay == nil {
+				m.Relay = &RelayMetadata{}
+			}
+			if err := m.Relay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
@@ -2305,6 +2627,110 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RelayMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WORChannel", wireType)
+			}
+			m.WORChannel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WORChannel |= RelayWORChannel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PacketBrokerMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
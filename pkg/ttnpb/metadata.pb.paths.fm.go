@@ -12,6 +12,7 @@ var RxMetadataFieldPathsNested = []string{
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
 	"frequency_offset",
+	"frequency_plan_id",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -30,6 +31,9 @@ var RxMetadataFieldPathsNested = []string{
 	"packet_broker.home_network_tenant_id",
 	"packet_broker.hops",
 	"packet_broker.message_id",
+	"relay",
+	"relay.device_id",
+	"relay.wor_channel",
 	"rssi",
 	"rssi_standard_deviation",
 	"signal_rssi",
@@ -49,9 +53,11 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
 	"frequency_offset",
+	"frequency_plan_id",
 	"gateway_ids",
 	"location",
 	"packet_broker",
+	"relay",
 	"rssi",
 	"rssi_standard_deviation",
 	"signal_rssi",
@@ -75,6 +81,15 @@ var LocationFieldPathsTopLevel = []string{
 	"longitude",
 	"source",
 }
+var RelayMetadataFieldPathsNested = []string{
+	"device_id",
+	"wor_channel",
+}
+
+var RelayMetadataFieldPathsTopLevel = []string{
+	"device_id",
+	"wor_channel",
+}
 var PacketBrokerMetadataFieldPathsNested = []string{
 	"forwarder_cluster_id",
 	"forwarder_net_id",
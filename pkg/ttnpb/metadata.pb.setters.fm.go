@@ -55,6 +55,31 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 					dst.PacketBroker = nil
 				}
 			}
+		case "relay":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayMetadata
+				if (src == nil || src.Relay == nil) && dst.Relay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Relay
+				}
+				if dst.Relay != nil {
+					newDst = dst.Relay
+				} else {
+					newDst = &RelayMetadata{}
+					dst.Relay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Relay = src.Relay
+				} else {
+					dst.Relay = nil
+				}
+			}
 		case "antenna_index":
 			if len(subs) > 0 {
 				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
@@ -226,6 +251,16 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 				var zero uint32
 				dst.ChannelIndex = zero
 			}
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
 		case "advanced":
 			if len(subs) > 0 {
 				return fmt.Errorf("'advanced' has no subfields, but %s were specified", subs)
@@ -304,6 +339,37 @@ func (dst *Location) SetFields(src *Location, paths ...string) error {
 	return nil
 }
 
+func (dst *RelayMetadata) SetFields(src *RelayMetadata, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceID = src.DeviceID
+			} else {
+				var zero string
+				dst.DeviceID = zero
+			}
+		case "wor_channel":
+			if len(subs) > 0 {
+				return fmt.Errorf("'wor_channel' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WORChannel = src.WORChannel
+			} else {
+				var zero RelayWORChannel
+				dst.WORChannel = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *PacketBrokerMetadata) SetFields(src *PacketBrokerMetadata, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -5,15 +5,18 @@ package ttnpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	go_thethings_network_lorawan_stack_v3_pkg_types "go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -28,6 +31,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -73,9 +77,318 @@ func (m *GenerateDevAddrResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenerateDevAddrResponse proto.InternalMessageInfo
 
+// Link quality stats of an end device, aggregated over its recent data messages.
+type EndDeviceLinkQuality struct {
+	// Time when the first uplink message in the window was received.
+	WindowStart *time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start,omitempty"`
+	// Time when the last uplink message in the window was received.
+	WindowEnd *time.Time `protobuf:"bytes,2,opt,name=window_end,json=windowEnd,proto3,stdtime" json:"window_end,omitempty"`
+	// Number of received uplink messages, including retransmissions.
+	UplinkCount uint32 `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of uplink frames that were not received, derived from gaps in the frame counter.
+	LostUplinkCount uint32 `protobuf:"varint,4,opt,name=lost_uplink_count,json=lostUplinkCount,proto3" json:"lost_uplink_count,omitempty"`
+	// Fraction of the uplink frames that were not received.
+	PacketErrorRate float32 `protobuf:"fixed32,5,opt,name=packet_error_rate,json=packetErrorRate,proto3" json:"packet_error_rate,omitempty"`
+	// Distribution of the highest SNR (dB) of the uplink messages.
+	SNR *EndDeviceLinkQuality_Distribution `protobuf:"bytes,6,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Distribution of the highest RSSI (dBm) of the uplink messages.
+	RSSI *EndDeviceLinkQuality_Distribution `protobuf:"bytes,7,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// Distribution of the number of gateways that received the uplink messages.
+	GatewayCount *EndDeviceLinkQuality_Distribution `protobuf:"bytes,8,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
+	// Number of uplink messages per data rate, ordered by data rate index.
+	DataRates []*EndDeviceLinkQuality_DataRateCount `protobuf:"bytes,9,rep,name=data_rates,json=dataRates,proto3" json:"data_rates,omitempty"`
+	// Number of scheduled downlink messages.
+	DownlinkCount uint32 `protobuf:"varint,10,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Number of confirmed downlink messages, for which an uplink message was received afterwards.
+	ConfirmedDownlinkCount uint32 `protobuf:"varint,11,opt,name=confirmed_downlink_count,json=confirmedDownlinkCount,proto3" json:"confirmed_downlink_count,omitempty"`
+	// Number of confirmed downlink messages that were acknowledged by the device.
+	AcknowledgedDownlinkCount uint32 `protobuf:"varint,12,opt,name=acknowledged_downlink_count,json=acknowledgedDownlinkCount,proto3" json:"acknowledged_downlink_count,omitempty"`
+	// Fraction of the confirmed downlink messages that were acknowledged by the device.
+	ConfirmedDownlinkSuccessRate float32 `protobuf:"fixed32,13,opt,name=confirmed_downlink_success_rate,json=confirmedDownlinkSuccessRate,proto3" json:"confirmed_downlink_success_rate,omitempty"`
+	// Total time-on-air of the uplink messages.
+	UplinkAirtime time.Duration `protobuf:"bytes,14,opt,name=uplink_airtime,json=uplinkAirtime,proto3,stdduration" json:"uplink_airtime"`
+	// Total estimated time-on-air of the downlink messages.
+	DownlinkAirtime      time.Duration `protobuf:"bytes,15,opt,name=downlink_airtime,json=downlinkAirtime,proto3,stdduration" json:"downlink_airtime"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EndDeviceLinkQuality) Reset()      { *m = EndDeviceLinkQuality{} }
+func (*EndDeviceLinkQuality) ProtoMessage() {}
+func (*EndDeviceLinkQuality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1}
+}
+func (m *EndDeviceLinkQuality) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceLinkQuality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceLinkQuality.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceLinkQuality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceLinkQuality.Merge(m, src)
+}
+func (m *EndDeviceLinkQuality) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceLinkQuality) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceLinkQuality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceLinkQuality proto.InternalMessageInfo
+
+func (m *EndDeviceLinkQuality) GetWindowStart() *time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return nil
+}
+
+func (m *EndDeviceLinkQuality) GetWindowEnd() *time.Time {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return nil
+}
+
+func (m *EndDeviceLinkQuality) GetUplinkCount() uint32 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetLostUplinkCount() uint32 {
+	if m != nil {
+		return m.LostUplinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetPacketErrorRate() float32 {
+	if m != nil {
+		return m.PacketErrorRate
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetSNR() *EndDeviceLinkQuality_Distribution {
+	if m != nil {
+		return m.SNR
+	}
+	return nil
+}
+
+func (m *EndDeviceLinkQuality) GetRSSI() *EndDeviceLinkQuality_Distribution {
+	if m != nil {
+		return m.RSSI
+	}
+	return nil
+}
+
+func (m *EndDeviceLinkQuality) GetGatewayCount() *EndDeviceLinkQuality_Distribution {
+	if m != nil {
+		return m.GatewayCount
+	}
+	return nil
+}
+
+func (m *EndDeviceLinkQuality) GetDataRates() []*EndDeviceLinkQuality_DataRateCount {
+	if m != nil {
+		return m.DataRates
+	}
+	return nil
+}
+
+func (m *EndDeviceLinkQuality) GetDownlinkCount() uint32 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetConfirmedDownlinkCount() uint32 {
+	if m != nil {
+		return m.ConfirmedDownlinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetAcknowledgedDownlinkCount() uint32 {
+	if m != nil {
+		return m.AcknowledgedDownlinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetConfirmedDownlinkSuccessRate() float32 {
+	if m != nil {
+		return m.ConfirmedDownlinkSuccessRate
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetUplinkAirtime() time.Duration {
+	if m != nil {
+		return m.UplinkAirtime
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality) GetDownlinkAirtime() time.Duration {
+	if m != nil {
+		return m.DownlinkAirtime
+	}
+	return 0
+}
+
+type EndDeviceLinkQuality_Distribution struct {
+	Min                  float32  `protobuf:"fixed32,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float32  `protobuf:"fixed32,2,opt,name=max,proto3" json:"max,omitempty"`
+	Mean                 float32  `protobuf:"fixed32,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median               float32  `protobuf:"fixed32,4,opt,name=median,proto3" json:"median,omitempty"`
+	StandardDeviation    float32  `protobuf:"fixed32,5,opt,name=standard_deviation,json=standardDeviation,proto3" json:"standard_deviation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndDeviceLinkQuality_Distribution) Reset()      { *m = EndDeviceLinkQuality_Distribution{} }
+func (*EndDeviceLinkQuality_Distribution) ProtoMessage() {}
+func (*EndDeviceLinkQuality_Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1, 0}
+}
+func (m *EndDeviceLinkQuality_Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceLinkQuality_Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceLinkQuality_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceLinkQuality_Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceLinkQuality_Distribution.Merge(m, src)
+}
+func (m *EndDeviceLinkQuality_Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceLinkQuality_Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceLinkQuality_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceLinkQuality_Distribution proto.InternalMessageInfo
+
+func (m *EndDeviceLinkQuality_Distribution) GetMin() float32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality_Distribution) GetMax() float32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality_Distribution) GetMean() float32 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality_Distribution) GetMedian() float32 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+func (m *EndDeviceLinkQuality_Distribution) GetStandardDeviation() float32 {
+	if m != nil {
+		return m.StandardDeviation
+	}
+	return 0
+}
+
+type EndDeviceLinkQuality_DataRateCount struct {
+	DataRateIndex        DataRateIndex `protobuf:"varint,1,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	UplinkCount          uint32        `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EndDeviceLinkQuality_DataRateCount) Reset()      { *m = EndDeviceLinkQuality_DataRateCount{} }
+func (*EndDeviceLinkQuality_DataRateCount) ProtoMessage() {}
+func (*EndDeviceLinkQuality_DataRateCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1, 1}
+}
+func (m *EndDeviceLinkQuality_DataRateCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceLinkQuality_DataRateCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceLinkQuality_DataRateCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceLinkQuality_DataRateCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceLinkQuality_DataRateCount.Merge(m, src)
+}
+func (m *EndDeviceLinkQuality_DataRateCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceLinkQuality_DataRateCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceLinkQuality_DataRateCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceLinkQuality_DataRateCount proto.InternalMessageInfo
+
+func (m *EndDeviceLinkQuality_DataRateCount) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
+	}
+	return DATA_RATE_0
+}
+
+func (m *EndDeviceLinkQuality_DataRateCount) GetUplinkCount() uint32 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
 	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	proto.RegisterType((*EndDeviceLinkQuality)(nil), "ttn.lorawan.v3.EndDeviceLinkQuality")
+	golang_proto.RegisterType((*EndDeviceLinkQuality)(nil), "ttn.lorawan.v3.EndDeviceLinkQuality")
+	proto.RegisterType((*EndDeviceLinkQuality_Distribution)(nil), "ttn.lorawan.v3.EndDeviceLinkQuality.Distribution")
+	golang_proto.RegisterType((*EndDeviceLinkQuality_Distribution)(nil), "ttn.lorawan.v3.EndDeviceLinkQuality.Distribution")
+	proto.RegisterType((*EndDeviceLinkQuality_DataRateCount)(nil), "ttn.lorawan.v3.EndDeviceLinkQuality.DataRateCount")
+	golang_proto.RegisterType((*EndDeviceLinkQuality_DataRateCount)(nil), "ttn.lorawan.v3.EndDeviceLinkQuality.DataRateCount")
 }
 
 func init() {
@@ -86,54 +399,88 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x68, 0x13, 0x4d,
-	0x18, 0xc6, 0x77, 0xd2, 0xd2, 0xef, 0x63, 0x29, 0xfd, 0xf8, 0xc6, 0xe2, 0x9f, 0xa8, 0x63, 0x49,
-	0x15, 0xa5, 0xd2, 0x59, 0x48, 0x0f, 0x42, 0x3d, 0xa5, 0x24, 0xa6, 0x82, 0x29, 0x36, 0x55, 0x0f,
-	0xbd, 0x84, 0x4d, 0xf6, 0xed, 0x66, 0xc9, 0x76, 0x66, 0xbb, 0x33, 0x49, 0x09, 0x52, 0x28, 0x1e,
-	0xa4, 0xc7, 0x82, 0x08, 0x1e, 0xbd, 0x08, 0x3d, 0x16, 0x2f, 0xf6, 0x20, 0xd2, 0x63, 0x4f, 0x52,
-	0xf0, 0x52, 0x3c, 0x48, 0xb3, 0xeb, 0xa1, 0xc7, 0x1e, 0x7b, 0x94, 0x6c, 0x92, 0xa6, 0xc9, 0x36,
-	0xd2, 0xaa, 0x78, 0x9b, 0xc9, 0xfb, 0xcc, 0x33, 0xbf, 0x79, 0xde, 0x77, 0xa3, 0xde, 0xb2, 0xb9,
-	0xab, 0x2f, 0xeb, 0x6c, 0x5c, 0x48, 0xbd, 0x50, 0xd2, 0x74, 0xc7, 0xd2, 0x18, 0xc8, 0x65, 0xee,
-	0x96, 0x04, 0xb8, 0x15, 0x70, 0xa9, 0xe3, 0x72, 0xc9, 0xf1, 0x90, 0x94, 0x8c, 0x36, 0xa5, 0xb4,
-	0x32, 0x11, 0x1d, 0x37, 0x2d, 0x59, 0x2c, 0xe7, 0x69, 0x81, 0x2f, 0x6a, 0x26, 0x37, 0xb9, 0x16,
-	0xc8, 0xf2, 0xe5, 0x85, 0x60, 0x17, 0x6c, 0x82, 0x55, 0xe3, 0x78, 0xf4, 0x9a, 0xc9, 0xb9, 0x69,
-	0x43, 0x60, 0xaf, 0x33, 0xc6, 0xa5, 0x2e, 0x2d, 0xce, 0x44, 0xb3, 0x7a, 0xb5, 0x59, 0x3d, 0xf6,
-	0x80, 0x45, 0x47, 0x56, 0x9b, 0xc5, 0x58, 0x18, 0x10, 0x98, 0x91, 0x33, 0xa0, 0x62, 0x15, 0xa0,
-	0xa9, 0x19, 0x0d, 0x6b, 0x2c, 0x03, 0x98, 0xb4, 0x16, 0x2c, 0x70, 0x5b, 0xb7, 0x8c, 0x84, 0x45,
-	0x8b, 0x20, 0x84, 0x6e, 0x42, 0x53, 0x11, 0x5b, 0x52, 0x2f, 0xa5, 0x81, 0x81, 0xab, 0x4b, 0x48,
-	0x42, 0x25, 0x61, 0x18, 0x6e, 0x16, 0x84, 0xc3, 0x99, 0x00, 0xfc, 0x4c, 0xfd, 0xd7, 0x80, 0x4a,
-	0x4e, 0x37, 0x0c, 0xf7, 0x32, 0x1a, 0x41, 0x77, 0x06, 0xa7, 0xee, 0x7f, 0xfd, 0x76, 0xe3, 0x9e,
-	0xc9, 0xa9, 0x2c, 0x82, 0x2c, 0x5a, 0xcc, 0x14, 0xb4, 0x99, 0x9b, 0xd6, 0x79, 0x4f, 0x65, 0x42,
-	0x73, 0x4a, 0xa6, 0x26, 0xab, 0x0e, 0x08, 0xda, 0xb2, 0xfd, 0xc7, 0x68, 0x2c, 0xe2, 0x4c, 0x8d,
-	0xcc, 0x08, 0x5c, 0x54, 0xff, 0xeb, 0xba, 0x18, 0x5f, 0xa4, 0x8d, 0x50, 0x68, 0x2b, 0x14, 0x9a,
-	0xaa, 0x87, 0x12, 0xbd, 0x4d, 0x3b, 0x3b, 0x41, 0x7b, 0x10, 0xc7, 0x86, 0x5f, 0x7c, 0xf9, 0xfe,
-	0x2a, 0x32, 0x84, 0x07, 0x35, 0x26, 0xb4, 0x16, 0x7b, 0x7c, 0x3d, 0xa2, 0xf6, 0x27, 0xc4, 0x8c,
-	0xc0, 0x4f, 0xd4, 0xe1, 0x24, 0x5f, 0x66, 0xb6, 0xc5, 0x4a, 0xb3, 0x65, 0x28, 0x43, 0x16, 0x1c,
-	0x5b, 0x2f, 0x00, 0xbe, 0xd9, 0xed, 0xdf, 0xa5, 0x5a, 0x2a, 0x83, 0x90, 0xd1, 0x1e, 0x74, 0x78,
-	0x56, 0xfd, 0xbf, 0x43, 0xff, 0xb8, 0x2c, 0x8a, 0xbf, 0x69, 0x99, 0xeb, 0xb2, 0x7c, 0x64, 0x09,
-	0x19, 0xb6, 0x4c, 0x31, 0x23, 0x19, 0x4c, 0xc4, 0xc3, 0x76, 0xdf, 0xa3, 0x21, 0x55, 0xc2, 0x71,
-	0x6c, 0xab, 0x10, 0xcc, 0x5e, 0xcb, 0x53, 0xc4, 0x33, 0x6a, 0x7f, 0xba, 0x9e, 0x48, 0x4a, 0x1d,
-	0x9c, 0xd6, 0x99, 0x61, 0xc3, 0x53, 0xa7, 0x5e, 0xc0, 0xd7, 0xbb, 0x4f, 0x37, 0x7e, 0xcf, 0x34,
-	0x66, 0xa6, 0x17, 0x6f, 0xfc, 0xe3, 0x80, 0x7a, 0x61, 0x46, 0x1c, 0xf3, 0x64, 0xc1, 0xb4, 0x84,
-	0x74, 0xab, 0xf8, 0x3d, 0x52, 0xfb, 0xd2, 0x20, 0xf1, 0x68, 0xb8, 0x81, 0xf2, 0x84, 0xba, 0x11,
-	0xc6, 0x95, 0x9e, 0xef, 0x8b, 0x95, 0x82, 0xbe, 0x02, 0x2e, 0xd4, 0xfb, 0xaa, 0xb7, 0x1f, 0x24,
-	0xb4, 0xe7, 0xed, 0xef, 0x22, 0x67, 0x19, 0x82, 0x9e, 0x28, 0x9e, 0xb2, 0x5f, 0xd1, 0x1a, 0xd2,
-	0xf0, 0xb9, 0xe3, 0xe5, 0x0a, 0x7e, 0x19, 0x51, 0xfb, 0xe6, 0x4e, 0x83, 0x9e, 0x3b, 0x1f, 0xf4,
-	0x27, 0x14, 0x50, 0x7f, 0x40, 0xd1, 0x9f, 0x62, 0xd3, 0x5f, 0xc4, 0xa6, 0x9d, 0xd8, 0x93, 0x68,
-	0x6c, 0x3e, 0x13, 0x9b, 0xfe, 0x53, 0x37, 0x4d, 0xa2, 0x31, 0xfc, 0x19, 0xa9, 0xc3, 0x59, 0x10,
-	0x20, 0x1f, 0xe8, 0x05, 0xc9, 0xdd, 0x6a, 0x12, 0x16, 0xf4, 0xb2, 0x2d, 0x05, 0xbe, 0xdb, 0xfd,
-	0xe8, 0x40, 0x95, 0x60, 0xc6, 0x39, 0xdb, 0xca, 0x82, 0x80, 0x8a, 0xf1, 0xbf, 0xd1, 0xd6, 0xfa,
-	0x83, 0x5e, 0x23, 0x75, 0x20, 0x09, 0x36, 0x48, 0x38, 0xe3, 0xc7, 0xd4, 0x63, 0xde, 0x63, 0x99,
-	0x00, 0x3c, 0x3d, 0x96, 0x0a, 0x83, 0x9f, 0x99, 0xb4, 0x8d, 0x36, 0xf5, 0x0e, 0xed, 0xd4, 0x08,
-	0xda, 0xad, 0x11, 0xb4, 0x57, 0x23, 0xca, 0x7e, 0x8d, 0x28, 0x07, 0x35, 0xa2, 0x1c, 0xd6, 0x88,
-	0x72, 0x54, 0x23, 0x68, 0xd5, 0x23, 0x68, 0xcd, 0x23, 0xca, 0x86, 0x47, 0xd0, 0xa6, 0x47, 0x94,
-	0x2d, 0x8f, 0x28, 0xdb, 0x1e, 0x51, 0x76, 0x3c, 0x82, 0x76, 0x3d, 0x82, 0xf6, 0x3c, 0xa2, 0xec,
-	0x7b, 0x04, 0x1d, 0x78, 0x44, 0x39, 0xf4, 0x08, 0x3a, 0xf2, 0x88, 0xb2, 0xea, 0x13, 0x65, 0xcd,
-	0x27, 0x68, 0xdd, 0x27, 0xca, 0x1b, 0x9f, 0xa0, 0xb7, 0x3e, 0x51, 0x36, 0x7c, 0xa2, 0x6c, 0xfa,
-	0x04, 0x6d, 0xf9, 0x04, 0x6d, 0xfb, 0x04, 0xcd, 0x6b, 0xe7, 0xf8, 0x27, 0x97, 0xcc, 0xc9, 0xe7,
-	0x07, 0x82, 0x18, 0x26, 0x7e, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xb4, 0x9d, 0xc0, 0x40, 0x07,
-	0x00, 0x00,
+	// 1283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x71, 0x4c, 0x9a, 0x4c, 0xec, 0xa4, 0x1d, 0xa2, 0xe0, 0xba, 0x65, 0x1c, 0xdc, 0x56,
+	0x44, 0x41, 0x5d, 0x0b, 0xf7, 0x00, 0x2a, 0x12, 0x28, 0xa9, 0x4d, 0x5a, 0xd4, 0x04, 0xb2, 0x2e,
+	0x3d, 0xf4, 0x62, 0x4d, 0x76, 0x26, 0xce, 0xca, 0xf6, 0xec, 0x76, 0x67, 0x36, 0xae, 0x85, 0x2a,
+	0x55, 0x1c, 0x50, 0x2f, 0x48, 0x95, 0x10, 0x52, 0x8f, 0x5c, 0x90, 0x7a, 0x41, 0xaa, 0xb8, 0xd0,
+	0x03, 0x42, 0x3d, 0xe6, 0x84, 0x2a, 0x71, 0xa9, 0x38, 0x84, 0x7a, 0xcd, 0xa1, 0xc7, 0x1e, 0x7b,
+	0x44, 0x3b, 0xbb, 0x9b, 0xc4, 0xde, 0x04, 0xa5, 0xa1, 0xe2, 0x36, 0x33, 0xef, 0x7b, 0xdf, 0x7c,
+	0xef, 0x67, 0xde, 0x2e, 0x3c, 0xd7, 0xb2, 0x5d, 0xd2, 0x21, 0xfc, 0xbc, 0x90, 0xc4, 0x6c, 0x96,
+	0x88, 0x63, 0x95, 0x38, 0x93, 0x1d, 0xdb, 0x6d, 0x0a, 0xe6, 0x6e, 0x32, 0x57, 0x77, 0x5c, 0x5b,
+	0xda, 0x68, 0x52, 0x4a, 0xae, 0x47, 0x50, 0x7d, 0xf3, 0x42, 0xfe, 0x7c, 0xc3, 0x92, 0x1b, 0xde,
+	0x9a, 0x6e, 0xda, 0xed, 0x52, 0xc3, 0x6e, 0xd8, 0x25, 0x05, 0x5b, 0xf3, 0xd6, 0xd5, 0x4e, 0x6d,
+	0xd4, 0x2a, 0x74, 0xcf, 0x9f, 0x6e, 0xd8, 0x76, 0xa3, 0xc5, 0x14, 0x3d, 0xe1, 0xdc, 0x96, 0x44,
+	0x5a, 0x36, 0x17, 0x91, 0x15, 0x47, 0xd6, 0x1d, 0x0e, 0xea, 0xb9, 0x0a, 0x10, 0xd9, 0x4f, 0x0d,
+	0xdb, 0x59, 0xdb, 0x91, 0xdd, 0xc8, 0x58, 0x18, 0x36, 0x4a, 0xab, 0xcd, 0x84, 0x24, 0x6d, 0x27,
+	0x02, 0x14, 0x93, 0x11, 0x32, 0x4e, 0xeb, 0x94, 0x6d, 0x5a, 0x26, 0x8b, 0x30, 0x67, 0x92, 0x18,
+	0x8b, 0x32, 0x2e, 0xad, 0x75, 0x8b, 0xb9, 0xb1, 0xcc, 0x42, 0x12, 0x14, 0x67, 0x24, 0x04, 0xcc,
+	0x26, 0x01, 0x6d, 0x26, 0x04, 0x69, 0xb0, 0x88, 0xa2, 0x78, 0x13, 0xbe, 0xb5, 0xc4, 0x38, 0x73,
+	0x89, 0x64, 0x15, 0xb6, 0xb9, 0x40, 0xa9, 0x6b, 0x30, 0xe1, 0xd8, 0x5c, 0x30, 0x74, 0x1d, 0x8e,
+	0x51, 0xb6, 0x59, 0x27, 0x94, 0xba, 0x39, 0x30, 0x0b, 0xe6, 0x32, 0x8b, 0x1f, 0xfd, 0xb9, 0x5d,
+	0xf8, 0xa0, 0x61, 0xeb, 0x72, 0x83, 0xc9, 0x0d, 0x8b, 0x37, 0x84, 0x1e, 0x55, 0xa6, 0x34, 0x78,
+	0xcf, 0xe6, 0x85, 0x92, 0xd3, 0x6c, 0x94, 0x64, 0xd7, 0x61, 0x42, 0x8f, 0x69, 0x8f, 0xd1, 0x70,
+	0x51, 0x7c, 0x31, 0x0e, 0xa7, 0xab, 0x9c, 0x56, 0x54, 0xb8, 0x57, 0x2d, 0xde, 0x5c, 0xf5, 0x48,
+	0xcb, 0x92, 0x5d, 0x74, 0x09, 0x66, 0x3a, 0x16, 0xa7, 0x76, 0xa7, 0x2e, 0x24, 0x71, 0xa5, 0xba,
+	0x74, 0xa2, 0x9c, 0xd7, 0xc3, 0x7c, 0xea, 0x71, 0x3e, 0xf5, 0x6b, 0x71, 0x3e, 0x17, 0xd3, 0xf7,
+	0xfe, 0x2a, 0x00, 0x63, 0x22, 0xf4, 0xaa, 0x05, 0x4e, 0xe8, 0x13, 0x08, 0x23, 0x12, 0xc6, 0x69,
+	0x2e, 0x75, 0x48, 0x8a, 0xf1, 0xd0, 0xa7, 0xca, 0x29, 0x7a, 0x07, 0x66, 0x3c, 0xa7, 0x65, 0xf1,
+	0x66, 0xdd, 0xb4, 0x3d, 0x2e, 0x73, 0x23, 0xb3, 0x60, 0x2e, 0x6b, 0x4c, 0x84, 0x67, 0x97, 0x82,
+	0x23, 0x34, 0x0f, 0x4f, 0xb4, 0x6c, 0x21, 0xeb, 0x03, 0xb8, 0xb4, 0xc2, 0x4d, 0x05, 0x86, 0x2f,
+	0x07, 0xb1, 0x0e, 0x31, 0x9b, 0x4c, 0xd6, 0x99, 0xeb, 0xda, 0x6e, 0x3d, 0xc8, 0x74, 0xee, 0x8d,
+	0x59, 0x30, 0x97, 0x32, 0xa6, 0x42, 0x43, 0x35, 0x38, 0x37, 0x88, 0x64, 0xe8, 0x2a, 0x1c, 0x11,
+	0xdc, 0xcd, 0x8d, 0x2a, 0xd1, 0xef, 0xeb, 0x83, 0x1d, 0xae, 0xef, 0x97, 0x33, 0xbd, 0x62, 0x09,
+	0xe9, 0x5a, 0x6b, 0x5e, 0xd0, 0x9c, 0x8b, 0xc7, 0xfc, 0xed, 0xc2, 0x48, 0x6d, 0xc5, 0x30, 0x02,
+	0x1a, 0xf4, 0x39, 0x4c, 0xbb, 0x42, 0x58, 0xb9, 0x63, 0x47, 0xa5, 0x1b, 0xf3, 0xb7, 0x0b, 0x69,
+	0xa3, 0x56, 0xbb, 0x62, 0x28, 0x22, 0x74, 0x1d, 0x66, 0x1b, 0x44, 0xb2, 0x0e, 0xe9, 0x46, 0x21,
+	0x8f, 0x1d, 0x91, 0xd9, 0xc8, 0x44, 0x3c, 0x61, 0x8a, 0x56, 0x21, 0xa4, 0x44, 0x12, 0x95, 0x1a,
+	0x91, 0x1b, 0x9f, 0x1d, 0x99, 0x9b, 0x28, 0x97, 0x0f, 0x47, 0x4a, 0x24, 0x09, 0x32, 0xa7, 0x78,
+	0x8c, 0x71, 0x1a, 0x6d, 0x05, 0x3a, 0x07, 0x27, 0xa9, 0xdd, 0xe1, 0x7b, 0xca, 0x03, 0x55, 0x79,
+	0xb2, 0xf1, 0x69, 0x78, 0xf3, 0x87, 0x30, 0x67, 0xda, 0x7c, 0xdd, 0x72, 0xdb, 0x8c, 0xd6, 0x87,
+	0x1c, 0x26, 0x94, 0xc3, 0xcc, 0x8e, 0xbd, 0x32, 0xe0, 0xf9, 0x31, 0x3c, 0x45, 0xcc, 0x26, 0xb7,
+	0x3b, 0x2d, 0x46, 0x1b, 0x49, 0xe7, 0x8c, 0x72, 0x3e, 0xb9, 0x17, 0x32, 0xe8, 0x5f, 0x85, 0x85,
+	0x7d, 0x6e, 0x16, 0x9e, 0x69, 0x32, 0x21, 0xc2, 0x26, 0xc9, 0xaa, 0x26, 0x39, 0x9d, 0x10, 0x50,
+	0x0b, 0x41, 0xaa, 0x63, 0x3e, 0x83, 0x93, 0x51, 0x13, 0x12, 0xcb, 0x0d, 0xe6, 0x4c, 0x6e, 0x52,
+	0xd5, 0xe4, 0x64, 0xa2, 0xe3, 0x2b, 0xd1, 0x04, 0x5b, 0x1c, 0xdb, 0xda, 0x2e, 0x68, 0xf7, 0x83,
+	0xa6, 0xcf, 0x86, 0xae, 0x0b, 0xa1, 0x27, 0x5a, 0x81, 0xc7, 0x77, 0x84, 0xc4, 0x6c, 0x53, 0x87,
+	0x67, 0x9b, 0x8a, 0x9d, 0x23, 0xbe, 0xfc, 0xb7, 0x00, 0x66, 0xf6, 0x56, 0x1d, 0x1d, 0x87, 0x23,
+	0x6d, 0x8b, 0xab, 0x67, 0x9d, 0x32, 0x82, 0xa5, 0x3a, 0x21, 0xb7, 0x72, 0xa9, 0xe8, 0x84, 0xdc,
+	0x42, 0x08, 0xa6, 0xdb, 0x8c, 0x70, 0xf5, 0xea, 0x52, 0x86, 0x5a, 0xa3, 0x19, 0x38, 0xda, 0x66,
+	0xd4, 0x22, 0x5c, 0xbd, 0xb1, 0x94, 0x11, 0xed, 0xd0, 0x79, 0x88, 0x84, 0x24, 0x9c, 0x12, 0x37,
+	0x1c, 0x9e, 0x4a, 0x51, 0xf4, 0xb6, 0x4e, 0xc4, 0x96, 0x4a, 0x6c, 0xc8, 0x77, 0x61, 0x76, 0xa0,
+	0x5f, 0x50, 0x15, 0x4e, 0xed, 0xf4, 0x5d, 0xdd, 0xe2, 0x94, 0xdd, 0x52, 0xda, 0x26, 0xcb, 0x6f,
+	0x0f, 0x37, 0x5f, 0xec, 0x77, 0x25, 0x00, 0x19, 0x59, 0xba, 0x77, 0x9b, 0x18, 0x18, 0xa9, 0xc4,
+	0xc0, 0x28, 0x73, 0x98, 0x5a, 0x11, 0x68, 0x03, 0x4e, 0x0d, 0xcd, 0x5a, 0x34, 0x93, 0xc8, 0x6c,
+	0x35, 0xf8, 0x92, 0xe4, 0xdf, 0x1d, 0x56, 0x70, 0xc0, 0x90, 0x2e, 0x4e, 0x7f, 0xfd, 0xc7, 0xdf,
+	0xdf, 0xa5, 0x26, 0x51, 0xa6, 0xc4, 0x45, 0x29, 0x1e, 0xd7, 0xe5, 0x7b, 0x29, 0x98, 0x5e, 0x10,
+	0x2b, 0x02, 0x5d, 0x83, 0xd3, 0x71, 0xdb, 0xac, 0x7a, 0xcc, 0x63, 0x06, 0x73, 0x5a, 0xc4, 0x64,
+	0xe8, 0x6c, 0x22, 0xc2, 0x41, 0xd4, 0x4d, 0x8f, 0x09, 0x99, 0x3f, 0x40, 0x1d, 0x5a, 0x85, 0x27,
+	0x06, 0xf0, 0x5f, 0x78, 0x62, 0xe3, 0x3f, 0x52, 0xd6, 0x87, 0x28, 0xaf, 0x5a, 0x42, 0xa2, 0xb3,
+	0x07, 0x0e, 0x81, 0x2b, 0xbb, 0xdf, 0xc2, 0x7c, 0x02, 0xb5, 0xe0, 0x38, 0x2d, 0xcb, 0x54, 0x45,
+	0x8f, 0x39, 0x45, 0x79, 0x19, 0xa6, 0x97, 0x82, 0x8c, 0x54, 0x61, 0xe6, 0x32, 0xe1, 0xb4, 0xc5,
+	0xc2, 0x21, 0x8d, 0x12, 0xb5, 0x0e, 0xcf, 0x97, 0xc3, 0xcf, 0xe4, 0x41, 0x7a, 0xcb, 0xbf, 0x8e,
+	0xc2, 0x37, 0x57, 0xc4, 0x8e, 0x1e, 0x83, 0x35, 0x82, 0x4e, 0xef, 0xa2, 0x9f, 0x01, 0x1c, 0x59,
+	0x62, 0x12, 0x9d, 0x49, 0x16, 0x50, 0xee, 0x41, 0x87, 0xc9, 0x38, 0x79, 0x60, 0x7c, 0xc5, 0xa6,
+	0xaa, 0x2b, 0x43, 0x66, 0x50, 0x57, 0xb2, 0x1b, 0x90, 0x28, 0x7d, 0xb5, 0xfb, 0xaf, 0x50, 0xb7,
+	0xa8, 0xd0, 0xf7, 0x18, 0xf7, 0xd9, 0xdf, 0x2e, 0x85, 0xd0, 0xa4, 0xdf, 0xce, 0xf2, 0x36, 0xfa,
+	0x26, 0x05, 0x47, 0x6a, 0xfb, 0x89, 0xae, 0xbd, 0x9a, 0xe8, 0xdf, 0x80, 0x52, 0xfd, 0x0b, 0xc8,
+	0xff, 0xab, 0x6c, 0xfd, 0x88, 0xb2, 0xf5, 0x41, 0xd9, 0x17, 0xc1, 0xfc, 0x8d, 0xe5, 0xe2, 0xe5,
+	0xd7, 0x75, 0xd3, 0x45, 0x30, 0x8f, 0x7e, 0x07, 0x70, 0xda, 0x60, 0x82, 0xc9, 0x4f, 0x89, 0x29,
+	0x6d, 0xb7, 0x5b, 0x61, 0xeb, 0xc4, 0x6b, 0x49, 0x81, 0xde, 0x1b, 0x0e, 0x5a, 0xa1, 0x16, 0x38,
+	0x7d, 0xc5, 0xb2, 0x72, 0x95, 0xa0, 0x8d, 0xf2, 0xff, 0x51, 0xd6, 0x20, 0xa0, 0xef, 0x01, 0x1c,
+	0xad, 0xb0, 0x16, 0x93, 0xec, 0x90, 0x8f, 0xe9, 0x80, 0x7e, 0x2f, 0x2e, 0x2b, 0xe1, 0x4b, 0xf3,
+	0xd5, 0xa4, 0xf0, 0x43, 0x2b, 0xdd, 0x95, 0x56, 0xde, 0x02, 0x70, 0x66, 0x45, 0xec, 0xf7, 0x4d,
+	0x47, 0x3f, 0x45, 0x2f, 0xe8, 0x88, 0x8f, 0x7f, 0x3f, 0xce, 0xe2, 0x0d, 0xa5, 0xfe, 0x1a, 0x32,
+	0x5e, 0x8b, 0xfa, 0x92, 0x1a, 0xf6, 0x37, 0x43, 0xee, 0xc5, 0x1f, 0xc1, 0x56, 0x0f, 0x83, 0x27,
+	0x3d, 0x0c, 0x9e, 0xf6, 0xb0, 0xf6, 0xac, 0x87, 0xb5, 0xe7, 0x3d, 0xac, 0xbd, 0xe8, 0x61, 0xed,
+	0x65, 0x0f, 0x83, 0x3b, 0x3e, 0x06, 0x77, 0x7d, 0xac, 0x3d, 0xf0, 0x31, 0x78, 0xe8, 0x63, 0xed,
+	0x91, 0x8f, 0xb5, 0xc7, 0x3e, 0xd6, 0xb6, 0x7c, 0x0c, 0x9e, 0xf8, 0x18, 0x3c, 0xf5, 0xb1, 0xf6,
+	0xcc, 0xc7, 0xe0, 0xb9, 0x8f, 0xb5, 0x17, 0x3e, 0x06, 0x2f, 0x7d, 0xac, 0xdd, 0xe9, 0x63, 0xed,
+	0x6e, 0x1f, 0x83, 0x7b, 0x7d, 0xac, 0xdd, 0xef, 0x63, 0xf0, 0x43, 0x1f, 0x6b, 0x0f, 0xfa, 0x58,
+	0x7b, 0xd8, 0xc7, 0xe0, 0x51, 0x1f, 0x83, 0xc7, 0x7d, 0x0c, 0x6e, 0x94, 0x5e, 0xe1, 0x3f, 0x5c,
+	0x72, 0x67, 0x6d, 0x6d, 0x54, 0x55, 0xf4, 0xc2, 0x3f, 0x03, 0x00, 0x33, 0x93, 0xd8, 0x42, 0x60,
+	0x0d, 0x00, 0x00,
 }
 
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
@@ -164,6 +511,148 @@ func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *EndDeviceLinkQuality) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceLinkQuality)
+	if !ok {
+		that2, ok := that.(EndDeviceLinkQuality)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.WindowStart == nil {
+		if this.WindowStart != nil {
+			return false
+		}
+	} else if !this.WindowStart.Equal(*that1.WindowStart) {
+		return false
+	}
+	if that1.WindowEnd == nil {
+		if this.WindowEnd != nil {
+			return false
+		}
+	} else if !this.WindowEnd.Equal(*that1.WindowEnd) {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.LostUplinkCount != that1.LostUplinkCount {
+		return false
+	}
+	if this.PacketErrorRate != that1.PacketErrorRate {
+		return false
+	}
+	if !this.SNR.Equal(that1.SNR) {
+		return false
+	}
+	if !this.RSSI.Equal(that1.RSSI) {
+		return false
+	}
+	if !this.GatewayCount.Equal(that1.GatewayCount) {
+		return false
+	}
+	if len(this.DataRates) != len(that1.DataRates) {
+		return false
+	}
+	for i := range this.DataRates {
+		if !this.DataRates[i].Equal(that1.DataRates[i]) {
+			return false
+		}
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if this.ConfirmedDownlinkCount != that1.ConfirmedDownlinkCount {
+		return false
+	}
+	if this.AcknowledgedDownlinkCount != that1.AcknowledgedDownlinkCount {
+		return false
+	}
+	if this.ConfirmedDownlinkSuccessRate != that1.ConfirmedDownlinkSuccessRate {
+		return false
+	}
+	if this.UplinkAirtime != that1.UplinkAirtime {
+		return false
+	}
+	if this.DownlinkAirtime != that1.DownlinkAirtime {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceLinkQuality_Distribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceLinkQuality_Distribution)
+	if !ok {
+		that2, ok := that.(EndDeviceLinkQuality_Distribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Mean != that1.Mean {
+		return false
+	}
+	if this.Median != that1.Median {
+		return false
+	}
+	if this.StandardDeviation != that1.StandardDeviation {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceLinkQuality_DataRateCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceLinkQuality_DataRateCount)
+	if !ok {
+		that2, ok := that.(EndDeviceLinkQuality_DataRateCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -655,22 +1144,100 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/networkserver.proto",
 }
 
-func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// NsEndDeviceLinkQualityClient is the client API for NsEndDeviceLinkQuality service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsEndDeviceLinkQualityClient interface {
+	// Get returns the link quality stats of the device that matches the given identifiers.
+	// The stats are only available if link quality stats are enabled in the Network Server.
+	// The stats are reset when the MAC state of the device is reset.
+	Get(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceLinkQuality, error)
+}
+
+type nsEndDeviceLinkQualityClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsEndDeviceLinkQualityClient(cc *grpc.ClientConn) NsEndDeviceLinkQualityClient {
+	return &nsEndDeviceLinkQualityClient{cc}
+}
+
+func (c *nsEndDeviceLinkQualityClient) Get(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceLinkQuality, error) {
+	out := new(EndDeviceLinkQuality)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceLinkQuality/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NsEndDeviceLinkQualityServer is the server API for NsEndDeviceLinkQuality service.
+type NsEndDeviceLinkQualityServer interface {
+	// Get returns the link quality stats of the device that matches the given identifiers.
+	// The stats are only available if link quality stats are enabled in the Network Server.
+	// The stats are reset when the MAC state of the device is reset.
+	Get(context.Context, *EndDeviceIdentifiers) (*EndDeviceLinkQuality, error)
 }
 
-func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+// UnimplementedNsEndDeviceLinkQualityServer can be embedded to have forward compatible implementations.
+type UnimplementedNsEndDeviceLinkQualityServer struct {
+}
+
+func (*UnimplementedNsEndDeviceLinkQualityServer) Get(ctx context.Context, req *EndDeviceIdentifiers) (*EndDeviceLinkQuality, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+
+func RegisterNsEndDeviceLinkQualityServer(s *grpc.Server, srv NsEndDeviceLinkQualityServer) {
+	s.RegisterService(&_NsEndDeviceLinkQuality_serviceDesc, srv)
+}
+
+func _NsEndDeviceLinkQuality_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceLinkQualityServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceLinkQuality/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceLinkQualityServer).Get(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsEndDeviceLinkQuality_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsEndDeviceLinkQuality",
+	HandlerType: (*NsEndDeviceLinkQualityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _NsEndDeviceLinkQuality_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -690,6 +1257,238 @@ func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EndDeviceLinkQuality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceLinkQuality) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceLinkQuality) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DownlinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x7a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x72
+	if m.ConfirmedDownlinkSuccessRate != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.ConfirmedDownlinkSuccessRate)))
+		i--
+		dAtA[i] = 0x6d
+	}
+	if m.AcknowledgedDownlinkCount != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.AcknowledgedDownlinkCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ConfirmedDownlinkCount != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.ConfirmedDownlinkCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DownlinkCount != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.DownlinkCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DataRates) > 0 {
+		for iNdEx := len(m.DataRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.GatewayCount != nil {
+		{
+			size, err := m.GatewayCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RSSI != nil {
+		{
+			size, err := m.RSSI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SNR != nil {
+		{
+			size, err := m.SNR.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PacketErrorRate != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.PacketErrorRate)))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.LostUplinkCount != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.LostUplinkCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.UplinkCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowEnd != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WindowEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WindowEnd):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintNetworkserver(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WindowStart != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WindowStart):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintNetworkserver(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceLinkQuality_Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceLinkQuality_Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceLinkQuality_Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StandardDeviation != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.StandardDeviation)))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.Median != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Median)))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Mean != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Mean)))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Max != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Max)))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Min != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Min)))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceLinkQuality_DataRateCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceLinkQuality_DataRateCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceLinkQuality_DataRateCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UplinkCount != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.UplinkCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNetworkserver(v)
 	base := offset
@@ -709,6 +1508,88 @@ func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *Gener
 	return this
 }
 
+func NewPopulatedEndDeviceLinkQuality(r randyNetworkserver, easy bool) *EndDeviceLinkQuality {
+	this := &EndDeviceLinkQuality{}
+	if r.Intn(5) != 0 {
+		this.WindowStart = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.WindowEnd = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.UplinkCount = r.Uint32()
+	this.LostUplinkCount = r.Uint32()
+	this.PacketErrorRate = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.PacketErrorRate *= -1
+	}
+	if r.Intn(5) != 0 {
+		this.SNR = NewPopulatedEndDeviceLinkQuality_Distribution(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.RSSI = NewPopulatedEndDeviceLinkQuality_Distribution(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayCount = NewPopulatedEndDeviceLinkQuality_Distribution(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.DataRates = make([]*EndDeviceLinkQuality_DataRateCount, v1)
+		for i := 0; i < v1; i++ {
+			this.DataRates[i] = NewPopulatedEndDeviceLinkQuality_DataRateCount(r, easy)
+		}
+	}
+	this.DownlinkCount = r.Uint32()
+	this.ConfirmedDownlinkCount = r.Uint32()
+	this.AcknowledgedDownlinkCount = r.Uint32()
+	this.ConfirmedDownlinkSuccessRate = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.ConfirmedDownlinkSuccessRate *= -1
+	}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.UplinkAirtime = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.DownlinkAirtime = *v3
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceLinkQuality_Distribution(r randyNetworkserver, easy bool) *EndDeviceLinkQuality_Distribution {
+	this := &EndDeviceLinkQuality_Distribution{}
+	this.Min = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Min *= -1
+	}
+	this.Max = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Max *= -1
+	}
+	this.Mean = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Mean *= -1
+	}
+	this.Median = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Median *= -1
+	}
+	this.StandardDeviation = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.StandardDeviation *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceLinkQuality_DataRateCount(r randyNetworkserver, easy bool) *EndDeviceLinkQuality_DataRateCount {
+	this := &EndDeviceLinkQuality_DataRateCount{}
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.UplinkCount = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyNetworkserver interface {
 	Float32() float32
 	Float64() float64
@@ -728,9 +1609,9 @@ func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
 	return rune(ru + 61)
 }
 func randStringNetworkserver(r randyNetworkserver) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
 		tmps[i] = randUTF8RuneNetworkserver(r)
 	}
 	return string(tmps)
@@ -752,11 +1633,11 @@ func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int,
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		v2 := r.Int63()
+		v5 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v5 *= -1
 		}
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v5))
 	case 1:
 		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -794,6 +1675,105 @@ func (m *GenerateDevAddrResponse) Size() (n int) {
 	return n
 }
 
+func (m *EndDeviceLinkQuality) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WindowStart)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.WindowEnd != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WindowEnd)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovNetworkserver(uint64(m.UplinkCount))
+	}
+	if m.LostUplinkCount != 0 {
+		n += 1 + sovNetworkserver(uint64(m.LostUplinkCount))
+	}
+	if m.PacketErrorRate != 0 {
+		n += 5
+	}
+	if m.SNR != nil {
+		l = m.SNR.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.RSSI != nil {
+		l = m.RSSI.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.GatewayCount != nil {
+		l = m.GatewayCount.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if len(m.DataRates) > 0 {
+		for _, e := range m.DataRates {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovNetworkserver(uint64(m.DownlinkCount))
+	}
+	if m.ConfirmedDownlinkCount != 0 {
+		n += 1 + sovNetworkserver(uint64(m.ConfirmedDownlinkCount))
+	}
+	if m.AcknowledgedDownlinkCount != 0 {
+		n += 1 + sovNetworkserver(uint64(m.AcknowledgedDownlinkCount))
+	}
+	if m.ConfirmedDownlinkSuccessRate != 0 {
+		n += 5
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func (m *EndDeviceLinkQuality_Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 5
+	}
+	if m.Max != 0 {
+		n += 5
+	}
+	if m.Mean != 0 {
+		n += 5
+	}
+	if m.Median != 0 {
+		n += 5
+	}
+	if m.StandardDeviation != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *EndDeviceLinkQuality_DataRateCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataRateIndex != 0 {
+		n += 1 + sovNetworkserver(uint64(m.DataRateIndex))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovNetworkserver(uint64(m.UplinkCount))
+	}
+	return n
+}
+
 func sovNetworkserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -810,14 +1790,68 @@ func (this *GenerateDevAddrResponse) String() string {
 	}, "")
 	return s
 }
-func valueToStringNetworkserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *EndDeviceLinkQuality) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
-}
+	repeatedStringForDataRates := "[]*EndDeviceLinkQuality_DataRateCount{"
+	for _, f := range this.DataRates {
+		repeatedStringForDataRates += strings.Replace(fmt.Sprintf("%v", f), "EndDeviceLinkQuality_DataRateCount", "EndDeviceLinkQuality_DataRateCount", 1) + ","
+	}
+	repeatedStringForDataRates += "}"
+	s := strings.Join([]string{`&EndDeviceLinkQuality{`,
+		`WindowStart:` + strings.Replace(fmt.Sprintf("%v", this.WindowStart), "Timestamp", "types.Timestamp", 1) + `,`,
+		`WindowEnd:` + strings.Replace(fmt.Sprintf("%v", this.WindowEnd), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`LostUplinkCount:` + fmt.Sprintf("%v", this.LostUplinkCount) + `,`,
+		`PacketErrorRate:` + fmt.Sprintf("%v", this.PacketErrorRate) + `,`,
+		`SNR:` + strings.Replace(fmt.Sprintf("%v", this.SNR), "EndDeviceLinkQuality_Distribution", "EndDeviceLinkQuality_Distribution", 1) + `,`,
+		`RSSI:` + strings.Replace(fmt.Sprintf("%v", this.RSSI), "EndDeviceLinkQuality_Distribution", "EndDeviceLinkQuality_Distribution", 1) + `,`,
+		`GatewayCount:` + strings.Replace(fmt.Sprintf("%v", this.GatewayCount), "EndDeviceLinkQuality_Distribution", "EndDeviceLinkQuality_Distribution", 1) + `,`,
+		`DataRates:` + repeatedStringForDataRates + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`ConfirmedDownlinkCount:` + fmt.Sprintf("%v", this.ConfirmedDownlinkCount) + `,`,
+		`AcknowledgedDownlinkCount:` + fmt.Sprintf("%v", this.AcknowledgedDownlinkCount) + `,`,
+		`ConfirmedDownlinkSuccessRate:` + fmt.Sprintf("%v", this.ConfirmedDownlinkSuccessRate) + `,`,
+		`UplinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UplinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`DownlinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DownlinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceLinkQuality_Distribution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceLinkQuality_Distribution{`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Mean:` + fmt.Sprintf("%v", this.Mean) + `,`,
+		`Median:` + fmt.Sprintf("%v", this.Median) + `,`,
+		`StandardDeviation:` + fmt.Sprintf("%v", this.StandardDeviation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceLinkQuality_DataRateCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceLinkQuality_DataRateCount{`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringNetworkserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
 func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -906,6 +1940,655 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EndDeviceLinkQuality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceLinkQuality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceLinkQuality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowStart == nil {
+				m.WindowStart = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowEnd == nil {
+				m.WindowEnd = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WindowEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostUplinkCount", wireType)
+			}
+			m.LostUplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LostUplinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketErrorRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.PacketErrorRate = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SNR == nil {
+				m.SNR = &EndDeviceLinkQuality_Distribution{}
+			}
+			if err := m.SNR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RSSI == nil {
+				m.RSSI = &EndDeviceLinkQuality_Distribution{}
+			}
+			if err := m.RSSI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayCount == nil {
+				m.GatewayCount = &EndDeviceLinkQuality_Distribution{}
+			}
+			if err := m.GatewayCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRates = append(m.DataRates, &EndDeviceLinkQuality_DataRateCount{})
+			if err := m.DataRates[len(m.DataRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedDownlinkCount", wireType)
+			}
+			m.ConfirmedDownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedDownlinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedDownlinkCount", wireType)
+			}
+			m.AcknowledgedDownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgedDownlinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedDownlinkSuccessRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.ConfirmedDownlinkSuccessRate = float32(math.Float32frombits(v))
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DownlinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceLinkQuality_Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Min = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Max = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Mean = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Median = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.StandardDeviation = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceLinkQuality_DataRateCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRateCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRateCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNetworkserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
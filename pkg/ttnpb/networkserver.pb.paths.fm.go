@@ -9,3 +9,77 @@ var GenerateDevAddrResponseFieldPathsNested = []string{
 var GenerateDevAddrResponseFieldPathsTopLevel = []string{
 	"dev_addr",
 }
+var EndDeviceLinkQualityFieldPathsNested = []string{
+	"acknowledged_downlink_count",
+	"confirmed_downlink_count",
+	"confirmed_downlink_success_rate",
+	"data_rates",
+	"downlink_airtime",
+	"downlink_count",
+	"gateway_count",
+	"gateway_count.max",
+	"gateway_count.mean",
+	"gateway_count.median",
+	"gateway_count.min",
+	"gateway_count.standard_deviation",
+	"lost_uplink_count",
+	"packet_error_rate",
+	"rssi",
+	"rssi.max",
+	"rssi.mean",
+	"rssi.median",
+	"rssi.min",
+	"rssi.standard_deviation",
+	"snr",
+	"snr.max",
+	"snr.mean",
+	"snr.median",
+	"snr.min",
+	"snr.standard_deviation",
+	"uplink_airtime",
+	"uplink_count",
+	"window_end",
+	"window_start",
+}
+
+var EndDeviceLinkQualityFieldPathsTopLevel = []string{
+	"acknowledged_downlink_count",
+	"confirmed_downlink_count",
+	"confirmed_downlink_success_rate",
+	"data_rates",
+	"downlink_airtime",
+	"downlink_count",
+	"gateway_count",
+	"lost_uplink_count",
+	"packet_error_rate",
+	"rssi",
+	"snr",
+	"uplink_airtime",
+	"uplink_count",
+	"window_end",
+	"window_start",
+}
+var EndDeviceLinkQuality_DistributionFieldPathsNested = []string{
+	"max",
+	"mean",
+	"median",
+	"min",
+	"standard_deviation",
+}
+
+var EndDeviceLinkQuality_DistributionFieldPathsTopLevel = []string{
+	"max",
+	"mean",
+	"median",
+	"min",
+	"standard_deviation",
+}
+var EndDeviceLinkQuality_DataRateCountFieldPathsNested = []string{
+	"data_rate_index",
+	"uplink_count",
+}
+
+var EndDeviceLinkQuality_DataRateCountFieldPathsTopLevel = []string{
+	"data_rate_index",
+	"uplink_count",
+}
@@ -2,7 +2,10 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+)
 
 func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
@@ -23,3 +26,298 @@ func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, path
 	}
 	return nil
 }
+
+func (dst *EndDeviceLinkQuality) SetFields(src *EndDeviceLinkQuality, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "window_start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window_start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WindowStart = src.WindowStart
+			} else {
+				dst.WindowStart = nil
+			}
+		case "window_end":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window_end' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WindowEnd = src.WindowEnd
+			} else {
+				dst.WindowEnd = nil
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+		case "lost_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lost_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LostUplinkCount = src.LostUplinkCount
+			} else {
+				var zero uint32
+				dst.LostUplinkCount = zero
+			}
+		case "packet_error_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'packet_error_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PacketErrorRate = src.PacketErrorRate
+			} else {
+				var zero float32
+				dst.PacketErrorRate = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkQuality_Distribution
+				if (src == nil || src.SNR == nil) && dst.SNR == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SNR
+				}
+				if dst.SNR != nil {
+					newDst = dst.SNR
+				} else {
+					newDst = &EndDeviceLinkQuality_Distribution{}
+					dst.SNR = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SNR = src.SNR
+				} else {
+					dst.SNR = nil
+				}
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkQuality_Distribution
+				if (src == nil || src.RSSI == nil) && dst.RSSI == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RSSI
+				}
+				if dst.RSSI != nil {
+					newDst = dst.RSSI
+				} else {
+					newDst = &EndDeviceLinkQuality_Distribution{}
+					dst.RSSI = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RSSI = src.RSSI
+				} else {
+					dst.RSSI = nil
+				}
+			}
+		case "gateway_count":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkQuality_Distribution
+				if (src == nil || src.GatewayCount == nil) && dst.GatewayCount == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayCount
+				}
+				if dst.GatewayCount != nil {
+					newDst = dst.GatewayCount
+				} else {
+					newDst = &EndDeviceLinkQuality_Distribution{}
+					dst.GatewayCount = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayCount = src.GatewayCount
+				} else {
+					dst.GatewayCount = nil
+				}
+			}
+		case "data_rates":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rates' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRates = src.DataRates
+			} else {
+				dst.DataRates = nil
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint32
+				dst.DownlinkCount = zero
+			}
+		case "confirmed_downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed_downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConfirmedDownlinkCount = src.ConfirmedDownlinkCount
+			} else {
+				var zero uint32
+				dst.ConfirmedDownlinkCount = zero
+			}
+		case "acknowledged_downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'acknowledged_downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AcknowledgedDownlinkCount = src.AcknowledgedDownlinkCount
+			} else {
+				var zero uint32
+				dst.AcknowledgedDownlinkCount = zero
+			}
+		case "confirmed_downlink_success_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed_downlink_success_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConfirmedDownlinkSuccessRate = src.ConfirmedDownlinkSuccessRate
+			} else {
+				var zero float32
+				dst.ConfirmedDownlinkSuccessRate = zero
+			}
+		case "uplink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtime = src.UplinkAirtime
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtime = zero
+			}
+		case "downlink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkAirtime = src.DownlinkAirtime
+			} else {
+				var zero time.Duration
+				dst.DownlinkAirtime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkQuality_Distribution) SetFields(src *EndDeviceLinkQuality_Distribution, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero float32
+				dst.Min = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero float32
+				dst.Max = zero
+			}
+		case "mean":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mean' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mean = src.Mean
+			} else {
+				var zero float32
+				dst.Mean = zero
+			}
+		case "median":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Median = src.Median
+			} else {
+				var zero float32
+				dst.Median = zero
+			}
+		case "standard_deviation":
+			if len(subs) > 0 {
+				return fmt.Errorf("'standard_deviation' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StandardDeviation = src.StandardDeviation
+			} else {
+				var zero float32
+				dst.StandardDeviation = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkQuality_DataRateCount) SetFields(src *EndDeviceLinkQuality_DataRateCount, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
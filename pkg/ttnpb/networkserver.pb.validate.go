@@ -117,3 +117,377 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GenerateDevAddrResponseValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkQuality with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *EndDeviceLinkQuality) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkQualityFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "window_start":
+
+			if v, ok := interface{}(m.GetWindowStart()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "window_start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "window_end":
+
+			if v, ok := interface{}(m.GetWindowEnd()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "window_end",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "lost_uplink_count":
+			// no validation rules for LostUplinkCount
+		case "packet_error_rate":
+			// no validation rules for PacketErrorRate
+		case "snr":
+
+			if v, ok := interface{}(m.GetSNR()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "snr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rssi":
+
+			if v, ok := interface{}(m.GetRSSI()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "rssi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateway_count":
+
+			if v, ok := interface{}(m.GetGatewayCount()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "gateway_count",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "data_rates":
+
+			for idx, item := range m.GetDataRates() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceLinkQualityValidationError{
+							field:  fmt.Sprintf("data_rates[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "confirmed_downlink_count":
+			// no validation rules for ConfirmedDownlinkCount
+		case "acknowledged_downlink_count":
+			// no validation rules for AcknowledgedDownlinkCount
+		case "confirmed_downlink_success_rate":
+			// no validation rules for ConfirmedDownlinkSuccessRate
+		case "uplink_airtime":
+
+			if v, ok := interface{}(&m.UplinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "uplink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_airtime":
+
+			if v, ok := interface{}(&m.DownlinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkQualityValidationError{
+						field:  "downlink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceLinkQualityValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkQualityValidationError is the validation error returned by
+// EndDeviceLinkQuality.ValidateFields if the designated constraints aren't met.
+type EndDeviceLinkQualityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkQualityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkQualityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkQualityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkQualityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkQualityValidationError) ErrorName() string {
+	return "EndDeviceLinkQualityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkQualityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkQuality.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkQualityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkQualityValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkQuality_Distribution
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *EndDeviceLinkQuality_Distribution) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkQuality_DistributionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+			// no validation rules for Min
+		case "max":
+			// no validation rules for Max
+		case "mean":
+			// no validation rules for Mean
+		case "median":
+			// no validation rules for Median
+		case "standard_deviation":
+			// no validation rules for StandardDeviation
+		default:
+			return EndDeviceLinkQuality_DistributionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkQuality_DistributionValidationError is the validation error
+// returned by EndDeviceLinkQuality_Distribution.ValidateFields if the
+// designated constraints aren't met.
+type EndDeviceLinkQuality_DistributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkQuality_DistributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkQuality_DistributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkQuality_DistributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkQuality_DistributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkQuality_DistributionValidationError) ErrorName() string {
+	return "EndDeviceLinkQuality_DistributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkQuality_DistributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkQuality_Distribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkQuality_DistributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkQuality_DistributionValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkQuality_DataRateCount
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *EndDeviceLinkQuality_DataRateCount) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkQuality_DataRateCountFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data_rate_index":
+			// no validation rules for DataRateIndex
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		default:
+			return EndDeviceLinkQuality_DataRateCountValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkQuality_DataRateCountValidationError is the validation error
+// returned by EndDeviceLinkQuality_DataRateCount.ValidateFields if the
+// designated constraints aren't met.
+type EndDeviceLinkQuality_DataRateCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkQuality_DataRateCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkQuality_DataRateCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkQuality_DataRateCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkQuality_DataRateCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkQuality_DataRateCountValidationError) ErrorName() string {
+	return "EndDeviceLinkQuality_DataRateCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkQuality_DataRateCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkQuality_DataRateCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkQuality_DataRateCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkQuality_DataRateCountValidationError{}
@@ -64,6 +64,8 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm_parameters",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -126,6 +126,8 @@ const (
 	RIGHT_GATEWAY_WRITE_SECRETS Right = 57
 	// The right to retrieve secrets associated with this gateway.
 	RIGHT_GATEWAY_READ_SECRETS Right = 58
+	// The right to execute commands on the gateway and to open remote shell sessions.
+	RIGHT_GATEWAY_REMOTE_COMMANDS Right = 59
 	// The pseudo-right for all (current and future) gateway rights.
 	RIGHT_GATEWAY_ALL Right = 40
 	// The right to view organization information.
@@ -205,6 +207,7 @@ var Right_name = map[int32]string{
 	39: "RIGHT_GATEWAY_LOCATION_READ",
 	57: "RIGHT_GATEWAY_WRITE_SECRETS",
 	58: "RIGHT_GATEWAY_READ_SECRETS",
+	59: "RIGHT_GATEWAY_REMOTE_COMMANDS",
 	40: "RIGHT_GATEWAY_ALL",
 	41: "RIGHT_ORGANIZATION_INFO",
 	42: "RIGHT_ORGANIZATION_SETTINGS_BASIC",
@@ -267,6 +270,7 @@ var Right_value = map[string]int32{
 	"RIGHT_GATEWAY_LOCATION_READ":              39,
 	"RIGHT_GATEWAY_WRITE_SECRETS":              57,
 	"RIGHT_GATEWAY_READ_SECRETS":               58,
+	"RIGHT_GATEWAY_REMOTE_COMMANDS":            59,
 	"RIGHT_GATEWAY_ALL":                        40,
 	"RIGHT_ORGANIZATION_INFO":                  41,
 	"RIGHT_ORGANIZATION_SETTINGS_BASIC":        42,
@@ -614,85 +618,85 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3b, 0x6c, 0xdb, 0x56,
-	0x17, 0xe6, 0xd5, 0x3b, 0xd7, 0x91, 0x73, 0x73, 0x13, 0x3b, 0x8a, 0xec, 0x5c, 0x39, 0x72, 0x1e,
-	0xfe, 0xf3, 0x47, 0x52, 0x6b, 0xf7, 0x8d, 0xa2, 0x00, 0x29, 0xd1, 0x0a, 0x6d, 0x46, 0x52, 0x49,
-	0x3a, 0x41, 0xb2, 0x08, 0xb4, 0xcd, 0xc8, 0x84, 0x1d, 0x52, 0xa0, 0x18, 0xa7, 0xee, 0x14, 0x74,
-	0x0a, 0x0a, 0x14, 0x28, 0x3a, 0x75, 0x2c, 0x50, 0x14, 0x08, 0xd0, 0x25, 0x5b, 0xd3, 0x2d, 0xa3,
-	0x47, 0x8f, 0x99, 0x8c, 0x88, 0x5a, 0x32, 0x66, 0x0c, 0x3c, 0x15, 0x22, 0x29, 0xf3, 0x21, 0x29,
-	0xee, 0x63, 0xbb, 0xba, 0xe7, 0xbb, 0x87, 0xdf, 0x77, 0xce, 0x77, 0x0e, 0x04, 0xc9, 0x8e, 0x6e,
-	0xc8, 0x8f, 0x65, 0xad, 0xd0, 0x31, 0xe5, 0x8d, 0xed, 0x92, 0xdc, 0x56, 0x4b, 0x86, 0xda, 0xda,
-	0x32, 0x3b, 0xc5, 0xb6, 0xa1, 0x9b, 0x3a, 0x9e, 0x34, 0x4d, 0xad, 0xe8, 0x62, 0x8a, 0xbb, 0x4b,
-	0x59, 0xba, 0xa5, 0x9a, 0x5b, 0x8f, 0xd6, 0x8b, 0x1b, 0xfa, 0xc3, 0x92, 0xa2, 0xed, 0xea, 0x7b,
-	0x6d, 0x43, 0xff, 0x66, 0xaf, 0x64, 0x83, 0x37, 0x0a, 0x2d, 0x45, 0x2b, 0xec, 0xca, 0x3b, 0xea,
-	0xa6, 0x6c, 0x2a, 0xa5, 0xa1, 0x83, 0x93, 0x32, 0x5b, 0xf0, 0xa5, 0x68, 0xe9, 0x2d, 0xdd, 0x79,
-	0xbc, 0xfe, 0xe8, 0x81, 0xfd, 0xcb, 0xfe, 0x61, 0x9f, 0x5c, 0xf8, 0xfc, 0x30, 0x43, 0x75, 0x53,
-	0xd1, 0x4c, 0xf5, 0x81, 0xaa, 0x18, 0x2e, 0xcd, 0xfc, 0x32, 0x4c, 0x08, 0x36, 0x6d, 0xfc, 0x25,
-	0x4c, 0x38, 0x02, 0x32, 0x60, 0x2e, 0xba, 0x30, 0xb9, 0x38, 0x55, 0x0c, 0x2a, 0x28, 0xda, 0x38,
-	0x26, 0x7d, 0xc4, 0xc0, 0x9f, 0x40, 0x32, 0x1f, 0xff, 0x0e, 0x44, 0x10, 0x10, 0xdc, 0x37, 0xf9,
-	0x1f, 0x00, 0x4c, 0xd0, 0x0d, 0x6e, 0x55, 0xd9, 0xc3, 0xd3, 0x30, 0xa2, 0x6e, 0x66, 0xc0, 0x1c,
-	0x58, 0x38, 0xc5, 0x24, 0xac, 0xc3, 0x5c, 0x84, 0xab, 0x08, 0x11, 0x75, 0x13, 0x23, 0x18, 0xdd,
-	0x56, 0xf6, 0x32, 0x91, 0x7e, 0x40, 0xe8, 0x1f, 0xf1, 0x0c, 0x8c, 0x69, 0xf2, 0x43, 0x25, 0x13,
-	0xb5, 0xb1, 0xc9, 0x23, 0x26, 0x66, 0x44, 0x32, 0x8b, 0x82, 0x7d, 0xe9, 0xe3, 0x13, 0xfb, 0x17,
-	0x7c, 0x38, 0x98, 0x74, 0xe8, 0x74, 0xf0, 0x57, 0x30, 0x25, 0xb7, 0xd5, 0xe6, 0xb6, 0xb2, 0xe7,
-	0x48, 0x9b, 0x58, 0x9c, 0x0e, 0xa7, 0x72, 0xa0, 0xcc, 0x84, 0x75, 0x98, 0x1b, 0x3c, 0x13, 0x92,
-	0x72, 0x5b, 0xed, 0x1f, 0xf2, 0x7f, 0x00, 0x78, 0xba, 0xac, 0xef, 0xec, 0xc8, 0xeb, 0xba, 0x21,
-	0x9b, 0xba, 0x81, 0xbf, 0x86, 0x51, 0x75, 0xb3, 0x63, 0x2b, 0x9c, 0x58, 0x2c, 0x84, 0x73, 0xd5,
-	0x8d, 0x96, 0xac, 0xa9, 0xdf, 0xca, 0xa6, 0xaa, 0x6b, 0x75, 0x63, 0xad, 0xa3, 0x18, 0x9c, 0x57,
-	0x75, 0x06, 0x1d, 0x31, 0xf1, 0xef, 0xfb, 0x4c, 0xf7, 0x0f, 0x73, 0xd4, 0xc1, 0x61, 0x0e, 0x08,
-	0xfd, 0x5c, 0x3e, 0xb1, 0x91, 0x7f, 0x2e, 0x76, 0x25, 0x96, 0x8a, 0xa2, 0xd8, 0x4a, 0x2c, 0x15,
-	0x43, 0xf1, 0x95, 0x58, 0x2a, 0x8e, 0x12, 0x2b, 0xb1, 0x54, 0x02, 0x25, 0xf3, 0xbf, 0x03, 0x78,
-	0xa1, 0xaa, 0x98, 0x7e, 0xf2, 0x82, 0xd2, 0x69, 0xeb, 0x5a, 0x47, 0xc1, 0xdc, 0x7f, 0x10, 0x91,
-	0x0a, 0x92, 0x2f, 0xfc, 0x2d, 0xf2, 0x27, 0xb2, 0x15, 0x61, 0xda, 0xcf, 0xb4, 0x83, 0x19, 0x98,
-	0xde, 0xf0, 0x5f, 0xb8, 0xdd, 0x9b, 0x0d, 0xa7, 0x0f, 0xe8, 0x0b, 0x3e, 0xb9, 0xf1, 0xe7, 0x19,
-	0x18, 0xb7, 0x3f, 0x8f, 0xcf, 0xc2, 0xb4, 0x4d, 0xa0, 0xa9, 0x6a, 0xf6, 0x5c, 0x21, 0x0a, 0x9f,
-	0x83, 0x67, 0x04, 0xae, 0x7a, 0x4b, 0x6a, 0xae, 0x89, 0xac, 0xd0, 0xe4, 0x6a, 0xcb, 0x75, 0x04,
-	0xf0, 0x25, 0x78, 0xd1, 0x77, 0x29, 0xb2, 0x92, 0xc4, 0xd5, 0xaa, 0x62, 0x93, 0xa1, 0x45, 0xae,
-	0x8c, 0x22, 0x78, 0x0e, 0xce, 0x8e, 0x0a, 0xd3, 0x0d, 0xae, 0xb9, 0xca, 0xde, 0x13, 0x51, 0x14,
-	0x4f, 0xc1, 0xb3, 0x3e, 0x44, 0x85, 0xe5, 0x59, 0x89, 0x45, 0x31, 0x7c, 0x19, 0x5e, 0xf2, 0x5d,
-	0xd3, 0x6b, 0xd2, 0xad, 0xba, 0xc0, 0xdd, 0x67, 0x2b, 0xcd, 0x32, 0xcf, 0xb1, 0x35, 0x49, 0x44,
-	0xf1, 0x50, 0x6e, 0xba, 0xd1, 0xe0, 0xb9, 0x32, 0x2d, 0x71, 0xf5, 0x9a, 0xd8, 0xe4, 0x39, 0x51,
-	0x42, 0x09, 0x9c, 0x87, 0x64, 0x1c, 0xa2, 0x2c, 0xb0, 0xb4, 0xc4, 0xa2, 0x24, 0x9e, 0x85, 0x19,
-	0x1f, 0xa6, 0x4a, 0x4b, 0xec, 0x5d, 0xfa, 0x9e, 0x9b, 0x21, 0x85, 0x09, 0xcc, 0x8e, 0x8a, 0xba,
-	0xaf, 0x4f, 0xe1, 0x19, 0x78, 0xc1, 0x17, 0x77, 0xb9, 0x39, 0x8f, 0x61, 0xa8, 0x36, 0x83, 0xa0,
-	0xfb, 0x76, 0x22, 0x24, 0xb1, 0x2e, 0x54, 0xe9, 0x1a, 0x77, 0xdf, 0x2f, 0xe0, 0x34, 0x9e, 0x87,
-	0xb9, 0xb1, 0x10, 0x37, 0x4f, 0x1a, 0x63, 0x38, 0xe9, 0x57, 0xc9, 0xf3, 0x68, 0x12, 0x67, 0xe1,
-	0xb4, 0x73, 0xe7, 0x13, 0xed, 0xb4, 0xec, 0x0c, 0xbe, 0x02, 0xe7, 0x86, 0x63, 0xa1, 0xce, 0x21,
-	0x7c, 0x1d, 0xce, 0xbf, 0x07, 0x75, 0xdc, 0xc0, 0xb3, 0xf8, 0x26, 0x5c, 0x78, 0x0f, 0xb0, 0x5c,
-	0xe7, 0x79, 0x9a, 0xa9, 0x0b, 0xb4, 0x54, 0x17, 0x44, 0x84, 0x4f, 0x48, 0xdb, 0xa0, 0xcb, 0xab,
-	0x74, 0x95, 0x15, 0xd1, 0x67, 0x5e, 0x5f, 0xfc, 0x40, 0xd7, 0x1e, 0xe7, 0xbc, 0xce, 0x06, 0xa3,
-	0x77, 0xb8, 0x32, 0x2b, 0x36, 0x05, 0x96, 0xae, 0xa0, 0xf3, 0x5e, 0xf1, 0x46, 0x61, 0xee, 0x0a,
-	0x9c, 0xc4, 0xa2, 0xa9, 0xd1, 0x7c, 0xfc, 0x89, 0x1c, 0x99, 0xd3, 0x78, 0x01, 0x5e, 0x39, 0x21,
-	0x9b, 0x83, 0xbc, 0x30, 0x9a, 0x9b, 0x24, 0xd0, 0xcb, 0xcb, 0x5c, 0xd9, 0xe1, 0x96, 0xc1, 0xd7,
-	0x60, 0x7e, 0x3c, 0x66, 0xad, 0xe1, 0xd2, 0xbb, 0x38, 0xfa, 0xab, 0x03, 0x5c, 0xa5, 0x7e, 0xb7,
-	0xe6, 0x22, 0xb3, 0xa3, 0x3b, 0xce, 0x73, 0xb5, 0x55, 0x34, 0x83, 0x2f, 0xc2, 0xa9, 0xe1, 0x58,
-	0xdf, 0x28, 0xb3, 0xf8, 0x3c, 0x44, 0x4e, 0xc8, 0xb1, 0xa7, 0x7d, 0x7b, 0x09, 0x4f, 0x43, 0xec,
-	0xdc, 0xba, 0x8e, 0x77, 0xac, 0x43, 0xbc, 0x91, 0x1b, 0xdc, 0x87, 0x6c, 0x93, 0xf3, 0x8a, 0x3e,
-	0x84, 0x38, 0xb6, 0xcc, 0x9c, 0xa7, 0x6a, 0x08, 0x14, 0xb4, 0xcb, 0x65, 0x9c, 0x81, 0xe7, 0x83,
-	0x48, 0xd7, 0x01, 0x79, 0x6f, 0x32, 0x07, 0x91, 0x40, 0x85, 0xe7, 0x3d, 0x97, 0x87, 0xe3, 0xbe,
-	0xaa, 0x5d, 0x19, 0x16, 0x6a, 0x57, 0xec, 0xaa, 0x37, 0xba, 0xc7, 0x0c, 0x25, 0x5a, 0x5a, 0x73,
-	0xad, 0x75, 0x0d, 0xe7, 0xe0, 0x4c, 0xe8, 0x59, 0xdd, 0xad, 0xaa, 0x0d, 0xb8, 0x3e, 0x0c, 0x70,
-	0x1c, 0x22, 0xb2, 0x65, 0x81, 0x95, 0x44, 0xf4, 0xf9, 0x30, 0x7d, 0xdb, 0x6b, 0x83, 0xf8, 0x17,
-	0xde, 0x5a, 0x1c, 0xc4, 0xfb, 0x8d, 0x59, 0xf0, 0xf6, 0x8d, 0x7f, 0x17, 0x38, 0xdd, 0xf9, 0x1f,
-	0xbe, 0x0a, 0x2f, 0x8f, 0x08, 0x86, 0x5a, 0x74, 0xc3, 0xab, 0xfe, 0x68, 0xd8, 0x71, 0x9f, 0xfe,
-	0xef, 0x0d, 0xc7, 0x68, 0xe4, 0x6d, 0xf6, 0x36, 0xc3, 0x0a, 0x22, 0xba, 0xe9, 0x95, 0x2b, 0x00,
-	0x74, 0x7b, 0x55, 0x18, 0xf3, 0xc5, 0xe1, 0x8d, 0x5d, 0xc4, 0x37, 0xe0, 0xb5, 0x93, 0x90, 0xee,
-	0xde, 0x2b, 0x79, 0x1d, 0x0e, 0x60, 0x83, 0x1b, 0xfc, 0x03, 0x6f, 0xd2, 0x46, 0xa3, 0xdc, 0x6c,
-	0x1f, 0x7a, 0xc6, 0x0d, 0xe0, 0x02, 0x1b, 0x7d, 0x71, 0x4c, 0x85, 0x43, 0x9b, 0x7d, 0x69, 0x9c,
-	0x8a, 0x4a, 0xa5, 0x49, 0x07, 0x2d, 0x8e, 0x3e, 0xf2, 0xe6, 0x36, 0x88, 0xe5, 0x79, 0xf4, 0xb1,
-	0xe7, 0x4e, 0x91, 0xad, 0x55, 0x9a, 0x5c, 0xed, 0x0e, 0x27, 0xb1, 0x22, 0xfa, 0x04, 0xa7, 0xe1,
-	0x29, 0x77, 0x9e, 0x79, 0x1e, 0x7d, 0x9a, 0x8d, 0x3d, 0xfd, 0x95, 0x50, 0xcc, 0x6f, 0x60, 0xbf,
-	0x4b, 0xc0, 0x41, 0x97, 0x80, 0x57, 0x5d, 0x42, 0xbd, 0xee, 0x12, 0xea, 0x4d, 0x97, 0x50, 0x6f,
-	0xbb, 0x84, 0x7a, 0xd7, 0x25, 0xe0, 0x89, 0x45, 0xc0, 0x53, 0x8b, 0x50, 0xcf, 0x2c, 0x02, 0x9e,
-	0x5b, 0x84, 0x7a, 0x61, 0x11, 0xea, 0xa5, 0x45, 0xa8, 0x7d, 0x8b, 0x80, 0x03, 0x8b, 0x80, 0x57,
-	0x16, 0xa1, 0x5e, 0x5b, 0x04, 0xbc, 0xb1, 0x08, 0xf5, 0xd6, 0x22, 0xe0, 0x9d, 0x45, 0xa8, 0x27,
-	0x3d, 0x42, 0x3d, 0xed, 0x11, 0xf0, 0x63, 0x8f, 0x50, 0x3f, 0xf7, 0x08, 0xf8, 0xa5, 0x47, 0xa8,
-	0x67, 0x3d, 0x42, 0x3d, 0xef, 0x11, 0xf0, 0xa2, 0x47, 0xc0, 0xcb, 0x1e, 0x01, 0xf7, 0x4b, 0x2d,
-	0xbd, 0x68, 0x6e, 0x29, 0xe6, 0x96, 0xaa, 0xb5, 0x3a, 0x45, 0x4d, 0x31, 0x1f, 0xeb, 0xc6, 0x76,
-	0x29, 0xf8, 0x67, 0x7a, 0x77, 0xa9, 0xd4, 0xde, 0x6e, 0x95, 0x4c, 0x53, 0x6b, 0xaf, 0xaf, 0x27,
-	0xec, 0xbf, 0xd2, 0x4b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xff, 0x3d, 0xaf, 0x7b, 0x13, 0x0c,
-	0x00, 0x00,
+	// 1247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3b, 0x6c, 0xdb, 0x46,
+	0x18, 0xc7, 0x79, 0xb2, 0x2c, 0x29, 0xe7, 0xc8, 0xb9, 0x5c, 0x62, 0x47, 0x91, 0x9d, 0x93, 0x23,
+	0xe7, 0xe1, 0xa6, 0x91, 0xd4, 0xda, 0x7d, 0xb7, 0x28, 0x40, 0x4a, 0xb4, 0x42, 0x5b, 0x16, 0x55,
+	0x92, 0x4e, 0x90, 0x2c, 0x04, 0x6d, 0x33, 0x32, 0x61, 0x87, 0x14, 0x28, 0xc6, 0xa9, 0x3b, 0x05,
+	0x9d, 0x82, 0x02, 0x05, 0x8a, 0x4e, 0x1d, 0x0b, 0x14, 0x05, 0x02, 0x74, 0xc9, 0xd6, 0x8c, 0x19,
+	0x33, 0x66, 0xcc, 0x50, 0x18, 0x11, 0xb5, 0x64, 0xcc, 0x18, 0x78, 0x2a, 0x44, 0x52, 0xe6, 0x43,
+	0x52, 0xdc, 0xc7, 0x76, 0xba, 0xef, 0xf7, 0x7d, 0xfc, 0x7f, 0x8f, 0xfb, 0x20, 0x48, 0x76, 0x0d,
+	0x53, 0x79, 0xa0, 0xe8, 0x85, 0xb6, 0xa5, 0x6c, 0xee, 0x94, 0x94, 0x96, 0x56, 0x32, 0xb5, 0xe6,
+	0xb6, 0xd5, 0x2e, 0xb6, 0x4c, 0xc3, 0x32, 0xf0, 0xa4, 0x65, 0xe9, 0x45, 0x8f, 0x29, 0xee, 0x2d,
+	0x65, 0xe9, 0xa6, 0x66, 0x6d, 0xdf, 0xdf, 0x28, 0x6e, 0x1a, 0xf7, 0x4a, 0xaa, 0xbe, 0x67, 0xec,
+	0xb7, 0x4c, 0xe3, 0xdb, 0xfd, 0x92, 0x03, 0x6f, 0x16, 0x9a, 0xaa, 0x5e, 0xd8, 0x53, 0x76, 0xb5,
+	0x2d, 0xc5, 0x52, 0x4b, 0x03, 0x07, 0x37, 0x64, 0xb6, 0x10, 0x08, 0xd1, 0x34, 0x9a, 0x86, 0xeb,
+	0xbc, 0x71, 0xff, 0xae, 0xf3, 0xcb, 0xf9, 0xe1, 0x9c, 0x3c, 0x7c, 0x7e, 0x50, 0xa1, 0xb6, 0xa5,
+	0xea, 0x96, 0x76, 0x57, 0x53, 0x4d, 0x4f, 0x66, 0x7e, 0x19, 0x26, 0x04, 0x47, 0x36, 0xfe, 0x0a,
+	0x26, 0xdc, 0x04, 0x32, 0x60, 0x6e, 0x6c, 0x61, 0x72, 0x71, 0xaa, 0x18, 0xce, 0xa0, 0xe8, 0x70,
+	0x4c, 0xfa, 0x90, 0x81, 0x3f, 0x83, 0x64, 0x7e, 0xfc, 0x7b, 0x10, 0x43, 0x40, 0xf0, 0x7c, 0xf2,
+	0x3f, 0x02, 0x98, 0xa0, 0x1b, 0xdc, 0xaa, 0xba, 0x8f, 0xa7, 0x61, 0x4c, 0xdb, 0xca, 0x80, 0x39,
+	0xb0, 0x70, 0x82, 0x49, 0xd8, 0x07, 0xb9, 0x18, 0x57, 0x11, 0x62, 0xda, 0x16, 0x46, 0x70, 0x6c,
+	0x47, 0xdd, 0xcf, 0xc4, 0x7a, 0x06, 0xa1, 0x77, 0xc4, 0x33, 0x30, 0xae, 0x2b, 0xf7, 0xd4, 0xcc,
+	0x98, 0xc3, 0x26, 0x0f, 0x99, 0xb8, 0x19, 0xcb, 0x2c, 0x0a, 0xce, 0x65, 0x40, 0x4f, 0xfc, 0x3f,
+	0xe8, 0xe1, 0x60, 0xd2, 0x95, 0xd3, 0xc6, 0x5f, 0xc3, 0x94, 0xd2, 0xd2, 0xe4, 0x1d, 0x75, 0xdf,
+	0x4d, 0x6d, 0x62, 0x71, 0x3a, 0x1a, 0xca, 0x45, 0x99, 0x09, 0xfb, 0x20, 0xd7, 0x77, 0x13, 0x92,
+	0x4a, 0x4b, 0xeb, 0x1d, 0xf2, 0x7f, 0x02, 0x78, 0xb2, 0x6c, 0xec, 0xee, 0x2a, 0x1b, 0x86, 0xa9,
+	0x58, 0x86, 0x89, 0xbf, 0x81, 0x63, 0xda, 0x56, 0xdb, 0xc9, 0x70, 0x62, 0xb1, 0x10, 0x8d, 0xc5,
+	0x9b, 0x4d, 0x45, 0xd7, 0xbe, 0x53, 0x2c, 0xcd, 0xd0, 0x79, 0x73, 0xbd, 0xad, 0x9a, 0x9c, 0x5f,
+	0x75, 0x06, 0x1d, 0x32, 0xe3, 0x3f, 0xf4, 0x94, 0x3e, 0x3f, 0xc8, 0x51, 0x2f, 0x0e, 0x72, 0x40,
+	0xe8, 0xc5, 0x0a, 0x24, 0x1b, 0xfb, 0xf7, 0xc9, 0xae, 0xc4, 0x53, 0x63, 0x28, 0xbe, 0x12, 0x4f,
+	0xc5, 0xd1, 0xf8, 0x4a, 0x3c, 0x35, 0x8e, 0x12, 0x2b, 0xf1, 0x54, 0x02, 0x25, 0xf3, 0x7f, 0x00,
+	0x78, 0xae, 0xaa, 0x5a, 0x41, 0xf1, 0x82, 0xda, 0x6e, 0x19, 0x7a, 0x5b, 0xc5, 0xdc, 0xff, 0x48,
+	0x22, 0x15, 0x16, 0x5f, 0xf8, 0x47, 0xe2, 0x8f, 0x55, 0x2b, 0xc2, 0x74, 0x50, 0x69, 0x1b, 0x33,
+	0x30, 0xbd, 0x19, 0xbc, 0xf0, 0xba, 0x37, 0x1b, 0x0d, 0x1f, 0xca, 0x2f, 0xec, 0x72, 0xed, 0xaf,
+	0x53, 0x70, 0xdc, 0xf9, 0x3c, 0x3e, 0x0d, 0xd3, 0x8e, 0x00, 0x59, 0xd3, 0x9d, 0x77, 0x85, 0x28,
+	0x7c, 0x06, 0x9e, 0x12, 0xb8, 0xea, 0x0d, 0x49, 0x5e, 0x17, 0x59, 0x41, 0xe6, 0xea, 0xcb, 0x3c,
+	0x02, 0xf8, 0x02, 0x3c, 0x1f, 0xb8, 0x14, 0x59, 0x49, 0xe2, 0xea, 0x55, 0x51, 0x66, 0x68, 0x91,
+	0x2b, 0xa3, 0x18, 0x9e, 0x83, 0xb3, 0xc3, 0xcc, 0x74, 0x83, 0x93, 0x57, 0xd9, 0xdb, 0x22, 0x1a,
+	0xc3, 0x53, 0xf0, 0x74, 0x80, 0xa8, 0xb0, 0x35, 0x56, 0x62, 0x51, 0x1c, 0x5f, 0x84, 0x17, 0x02,
+	0xd7, 0xf4, 0xba, 0x74, 0x83, 0x17, 0xb8, 0x3b, 0x6c, 0x45, 0x2e, 0xd7, 0x38, 0xb6, 0x2e, 0x89,
+	0x68, 0x3c, 0x12, 0x9b, 0x6e, 0x34, 0x6a, 0x5c, 0x99, 0x96, 0x38, 0xbe, 0x2e, 0xca, 0x35, 0x4e,
+	0x94, 0x50, 0x02, 0xe7, 0x21, 0x19, 0x45, 0x94, 0x05, 0x96, 0x96, 0x58, 0x94, 0xc4, 0xb3, 0x30,
+	0x13, 0x60, 0xaa, 0xb4, 0xc4, 0xde, 0xa2, 0x6f, 0x7b, 0x11, 0x52, 0x98, 0xc0, 0xec, 0x30, 0xab,
+	0xe7, 0x7d, 0x02, 0xcf, 0xc0, 0x73, 0x01, 0xbb, 0xa7, 0xcd, 0x75, 0x86, 0x91, 0xda, 0xf4, 0x8d,
+	0x9e, 0xef, 0x44, 0x24, 0x45, 0x5e, 0xa8, 0xd2, 0x75, 0xee, 0x4e, 0x30, 0x81, 0x93, 0x78, 0x1e,
+	0xe6, 0x46, 0x22, 0x5e, 0x9c, 0x34, 0xc6, 0x70, 0x32, 0x98, 0x65, 0xad, 0x86, 0x26, 0x71, 0x16,
+	0x4e, 0xbb, 0x77, 0x81, 0xa4, 0xdd, 0x96, 0x9d, 0xc2, 0x97, 0xe0, 0xdc, 0xa0, 0x2d, 0xd2, 0x39,
+	0x84, 0xaf, 0xc2, 0xf9, 0x77, 0x50, 0x47, 0x0d, 0x3c, 0x8d, 0xaf, 0xc3, 0x85, 0x77, 0x80, 0x65,
+	0xbe, 0x56, 0xa3, 0x19, 0x5e, 0xa0, 0x25, 0x5e, 0x10, 0x11, 0x3e, 0x26, 0x6c, 0x83, 0x2e, 0xaf,
+	0xd2, 0x55, 0x56, 0x44, 0x9f, 0xf9, 0x7d, 0x09, 0x82, 0xde, 0x78, 0x9c, 0xf1, 0x3b, 0x1b, 0xb6,
+	0xde, 0xe4, 0xca, 0xac, 0x28, 0x0b, 0x2c, 0x5d, 0x41, 0x67, 0xfd, 0xe2, 0x0d, 0x63, 0x6e, 0x09,
+	0x9c, 0xc4, 0xa2, 0xa9, 0xe1, 0x7a, 0x82, 0x81, 0xdc, 0x34, 0xa7, 0xf1, 0x02, 0xbc, 0x74, 0x4c,
+	0x34, 0x97, 0x3c, 0x37, 0x5c, 0x9b, 0x24, 0xd0, 0xcb, 0xcb, 0x5c, 0xd9, 0xd5, 0x96, 0xc1, 0x57,
+	0x60, 0x7e, 0x34, 0xb3, 0xde, 0xf0, 0xe4, 0x9d, 0x1f, 0xfe, 0xd5, 0x3e, 0x57, 0xe1, 0x6f, 0xd5,
+	0x3d, 0x32, 0x3b, 0xbc, 0xe3, 0x35, 0xae, 0xbe, 0x8a, 0x66, 0xf0, 0x79, 0x38, 0x35, 0x68, 0xeb,
+	0x0d, 0xca, 0x2c, 0x3e, 0x0b, 0x91, 0x6b, 0x72, 0xc7, 0xd3, 0xb9, 0xbd, 0x80, 0xa7, 0x21, 0x76,
+	0x6f, 0xbd, 0x89, 0x77, 0x47, 0x87, 0xf8, 0x4f, 0xae, 0x7f, 0x1f, 0x19, 0x9b, 0x9c, 0x5f, 0xf4,
+	0x01, 0xe2, 0x68, 0x64, 0xe6, 0xfc, 0xac, 0x06, 0xa0, 0xf0, 0xb8, 0x5c, 0xc4, 0x19, 0x78, 0x36,
+	0x4c, 0x7a, 0x13, 0x90, 0xf7, 0x5f, 0x66, 0xdf, 0x12, 0xaa, 0xf0, 0xbc, 0x3f, 0xe5, 0x51, 0x7b,
+	0xa0, 0x6a, 0x97, 0x06, 0x13, 0x75, 0x2a, 0x76, 0xd9, 0x7f, 0xba, 0x47, 0x0a, 0x25, 0x5a, 0x5a,
+	0xf7, 0x46, 0xeb, 0x0a, 0xce, 0xc1, 0x99, 0x88, 0x1b, 0xef, 0x55, 0xd5, 0x01, 0xae, 0x0e, 0x02,
+	0xee, 0x84, 0x88, 0x6c, 0x59, 0x60, 0x25, 0x11, 0x7d, 0x3e, 0x28, 0xbf, 0xe7, 0x78, 0x64, 0xff,
+	0xc2, 0x5f, 0x0e, 0xbe, 0x7d, 0x8d, 0x97, 0x58, 0xb9, 0xcc, 0xaf, 0xad, 0xd1, 0xf5, 0x8a, 0x88,
+	0xbe, 0xf4, 0x37, 0x67, 0x1f, 0xe9, 0xf5, 0x6e, 0xc1, 0x5f, 0x49, 0xc1, 0x75, 0xe1, 0x36, 0xf0,
+	0x3d, 0x7c, 0x19, 0x5e, 0x1c, 0x62, 0x8c, 0x74, 0xf1, 0x9a, 0xdf, 0xa0, 0xe1, 0xd8, 0x51, 0x2b,
+	0xdf, 0xf7, 0xdf, 0xcf, 0x70, 0x72, 0x8d, 0x5d, 0x63, 0x58, 0x41, 0x44, 0xd7, 0xfd, 0x8a, 0x86,
+	0x40, 0xaf, 0x9d, 0x85, 0x11, 0x5f, 0x1c, 0x5c, 0xea, 0x45, 0x7c, 0x0d, 0x5e, 0x39, 0x8e, 0xf4,
+	0x56, 0x63, 0xc9, 0x1f, 0x82, 0x10, 0x1b, 0x5e, 0xf2, 0x1f, 0xf8, 0x8f, 0x71, 0x38, 0xe5, 0x45,
+	0xfb, 0xd0, 0x9f, 0xed, 0x10, 0x17, 0x5a, 0xfa, 0x8b, 0x23, 0x2a, 0x1c, 0x59, 0xfe, 0x4b, 0xa3,
+	0xb2, 0xa8, 0x54, 0x64, 0x3a, 0xfc, 0x0a, 0xd0, 0x47, 0xfe, 0xd3, 0x0e, 0xb3, 0xb5, 0x1a, 0xfa,
+	0xd8, 0x1f, 0x60, 0x91, 0xad, 0x57, 0x64, 0xae, 0x7e, 0x93, 0x93, 0x58, 0x11, 0x7d, 0x82, 0xd3,
+	0xf0, 0x84, 0x7b, 0xdf, 0xc3, 0x3e, 0xcd, 0xc6, 0x1f, 0xfd, 0x46, 0x28, 0xe6, 0x77, 0xf0, 0xbc,
+	0x43, 0xc0, 0x8b, 0x0e, 0x01, 0x2f, 0x3b, 0x84, 0x7a, 0xd5, 0x21, 0xd4, 0xeb, 0x0e, 0xa1, 0xde,
+	0x74, 0x08, 0xf5, 0xb6, 0x43, 0xc0, 0x43, 0x9b, 0x80, 0x47, 0x36, 0xa1, 0x1e, 0xdb, 0x04, 0x3c,
+	0xb1, 0x09, 0xf5, 0xd4, 0x26, 0xd4, 0x33, 0x9b, 0x50, 0xcf, 0x6d, 0x02, 0x5e, 0xd8, 0x04, 0xbc,
+	0xb4, 0x09, 0xf5, 0xca, 0x26, 0xe0, 0xb5, 0x4d, 0xa8, 0x37, 0x36, 0x01, 0x6f, 0x6d, 0x42, 0x3d,
+	0xec, 0x12, 0xea, 0x51, 0x97, 0x80, 0x9f, 0xba, 0x84, 0xfa, 0xa5, 0x4b, 0xc0, 0xaf, 0x5d, 0x42,
+	0x3d, 0xee, 0x12, 0xea, 0x49, 0x97, 0x80, 0xa7, 0x5d, 0x02, 0x9e, 0x75, 0x09, 0xb8, 0x53, 0x6a,
+	0x1a, 0x45, 0x6b, 0x5b, 0xb5, 0xb6, 0x35, 0xbd, 0xd9, 0x2e, 0xea, 0xaa, 0xf5, 0xc0, 0x30, 0x77,
+	0x4a, 0xe1, 0xff, 0xdb, 0x7b, 0x4b, 0xa5, 0xd6, 0x4e, 0xb3, 0x64, 0x59, 0x7a, 0x6b, 0x63, 0x23,
+	0xe1, 0xfc, 0xdb, 0x5e, 0xfa, 0x7b, 0x00, 0x48, 0x04, 0x11, 0x94, 0x36, 0x0c, 0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -1186,7 +1190,7 @@ func NewPopulatedRights(r randyRights, easy bool) *Rights {
 	v1 := r.Intn(10)
 	this.Rights = make([]Right, v1)
 	for i := 0; i < v1; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1201,7 +1205,7 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	v2 := r.Intn(10)
 	this.Rights = make([]Right, v2)
 	for i := 0; i < v2; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1229,7 +1233,7 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	v5 := r.Intn(10)
 	this.Rights = make([]Right, v5)
 	for i := 0; i < v5; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1243,7 +1247,7 @@ func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollabora
 	v7 := r.Intn(10)
 	this.Rights = make([]Right, v7)
 	for i := 0; i < v7; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
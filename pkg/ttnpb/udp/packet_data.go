@@ -81,6 +81,7 @@ type TxPacket struct {
 	Prea uint16       `json:"prea,omitempty"` // RF preamble size (unsigned integer)
 	Size uint16       `json:"size"`           // RF packet payload size in bytes (unsigned integer)
 	NCRC bool         `json:"ncrc,omitempty"` // If true, disable the CRC of the physical layer (optional)
+	NHdr bool         `json:"nhdr,omitempty"` // If true, disable the header of the physical layer (optional)
 	Data string       `json:"data"`           // Base64 encoded RF packet payload, padding optional
 }
 
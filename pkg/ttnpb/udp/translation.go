@@ -347,6 +347,23 @@ func ToDownlinkMessage(tx *TxPacket) (*ttnpb.DownlinkMessage, error) {
 	}, nil
 }
 
+// beaconPreambleLength is the number of preamble symbols of Class B beacons.
+const beaconPreambleLength = 10
+
+// FromBeaconMessage converts the scheduled beacon to the UDP format.
+// Beacons are transmitted with implicit header mode, without CRC and with a longer preamble than other downlink
+// messages.
+func FromBeaconMessage(msg *ttnpb.DownlinkMessage) (*TxPacket, error) {
+	tx, err := FromDownlinkMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	tx.Prea = beaconPreambleLength
+	tx.NHdr = true
+	tx.NCRC = true
+	return tx, nil
+}
+
 // FromDownlinkMessage converts to the downlink message to the UDP format.
 func FromDownlinkMessage(msg *ttnpb.DownlinkMessage) (*TxPacket, error) {
 	payload := msg.GetRawPayload()
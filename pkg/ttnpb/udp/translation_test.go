@@ -358,6 +358,41 @@ func TestFromDownlinkMessage(t *testing.T) {
 	a.So(tx.Data, should.Equal, "ffOO")
 }
 
+func TestFromBeaconMessage(t *testing.T) {
+	a := assertions.New(t)
+
+	msg := &ttnpb.DownlinkMessage{
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				Frequency: 869525000,
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 9,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Downlink: &ttnpb.TxSettings_Downlink{
+					TxPower: 27,
+				},
+				Timestamp: 1886440700,
+			},
+		},
+		RawPayload: []byte{0x00, 0x00, 0x80, 0xa7, 0x90, 0x4f, 0xf4, 0x44, 0x00, 0x7a, 0x7d, 0x4a, 0xf6, 0x7a, 0x03, 0x56, 0x7f},
+	}
+	tx, err := udp.FromBeaconMessage(msg)
+	a.So(err, should.BeNil)
+	a.So(tx.Tmst, should.Equal, 1886440700)
+	a.So(tx.Imme, should.BeFalse)
+	a.So(tx.IPol, should.BeFalse)
+	a.So(tx.NCRC, should.BeTrue)
+	a.So(tx.NHdr, should.BeTrue)
+	a.So(tx.Prea, should.Equal, 10)
+	a.So(tx.Size, should.Equal, 17)
+}
+
 func TestDownlinkRoundtrip(t *testing.T) {
 	a := assertions.New(t)
 	expected := &ttnpb.DownlinkMessage{
@@ -0,0 +1,176 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gormutil provides utilities for components that store data in an SQL database using gorm.
+package gormutil
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+// Open opens a new database connection.
+func Open(ctx context.Context, dsn string) (*gorm.DB, error) {
+	dbURI, err := url.Parse(dsn)
+	if err != nil {
+		return nil, err
+	}
+	dbName := strings.TrimPrefix(dbURI.Path, "/")
+	db, err := gorm.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Set("db:name", dbName)
+	var dbVersion string
+	err = db.Raw("SELECT version()").Row().Scan(&dbVersion)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Set("db:version", dbVersion)
+	switch {
+	case strings.Contains(dbVersion, "CockroachDB"):
+		db = db.Set("db:kind", "CockroachDB")
+	case strings.Contains(dbVersion, "PostgreSQL"):
+		db = db.Set("db:kind", "PostgreSQL")
+	}
+	SetLogger(db, log.FromContext(ctx))
+	return db, nil
+}
+
+// Initialize initializes the database.
+func Initialize(db *gorm.DB) error {
+	if dbKind, ok := db.Get("db:kind"); ok {
+		switch dbKind {
+		case "CockroachDB":
+			if dbName, ok := db.Get("db:name"); ok {
+				if err := db.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s;", dbName)).Error; err != nil {
+					return err
+				}
+			}
+		case "PostgreSQL":
+			if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pgcrypto").Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ErrTransactionRecovered is returned when a panic is caught from a SQL transaction.
+var ErrTransactionRecovered = errors.DefineInternal("transaction_recovered", "Internal Server Error")
+
+// Transact executes f in a db transaction.
+// The transaction is bound to ctx, so that it is rolled back when ctx is done.
+func Transact(ctx context.Context, db *gorm.DB, f func(db *gorm.DB) error) (err error) {
+	defer trace.StartRegion(ctx, "database transaction").End()
+	tx := db.BeginTx(ctx, nil)
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			fmt.Fprintln(os.Stderr, p)
+			os.Stderr.Write(debug.Stack())
+			if pErr, ok := p.(error); ok {
+				switch pErr {
+				case context.Canceled, context.DeadlineExceeded:
+					err = pErr
+				default:
+					err = ErrTransactionRecovered.WithCause(pErr)
+				}
+			} else {
+				err = ErrTransactionRecovered.WithAttributes("panic", p)
+			}
+		}
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit().Error
+		}
+	}()
+	SetLogger(tx, log.FromContext(ctx).WithField("namespace", "db"))
+	return f(tx)
+}
+
+// SetLogger sets the database logger.
+func SetLogger(db *gorm.DB, log log.Interface) {
+	db.SetLogger(logger{Interface: log})
+}
+
+type logger struct {
+	log.Interface
+}
+
+func isUniqueViolation(err error) bool {
+	if errors.IsAlreadyExists(err) {
+		return true
+	}
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code.Name() == "unique_violation"
+}
+
+// Print implements the gorm.logger interface.
+func (l logger) Print(v ...interface{}) {
+	if len(v) < 3 {
+		l.Error(fmt.Sprint(v...))
+		return
+	}
+	logger := l.Interface
+	if source, ok := v[1].(string); ok {
+		logger = logger.WithField("source", filepath.Base(source))
+	} else {
+		l.Error(fmt.Sprint(v...))
+		return
+	}
+	switch v[0] {
+	case "log", "error":
+		if err, ok := v[2].(error); ok {
+			if isUniqueViolation(err) {
+				return // no problem.
+			}
+			logger.WithError(err).Error("Database error")
+			return
+		}
+		logger.Error(fmt.Sprint(v[2:]...))
+		return
+	case "sql":
+		if len(v) != 6 {
+			return
+		}
+		duration, _ := v[2].(time.Duration)
+		query, _ := v[3].(string)
+		values, _ := v[4].([]interface{})
+		rows, _ := v[5].(int64)
+		logger.WithFields(log.Fields(
+			"duration", duration,
+			"query", query,
+			"values", values,
+			"rows", rows,
+		)).Debug("Run database query")
+	default:
+		l.Error(fmt.Sprint(v...))
+	}
+}
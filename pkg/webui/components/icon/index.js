@@ -22,6 +22,7 @@ import style from './icon.styl'
 // Keep these sorted alphabetically.
 const hardcoded = {
   access: 'lock',
+  alert_rules: 'notifications',
   api_keys: 'vpn_key',
   application: 'web_asset',
   collaborators: 'people',
@@ -193,6 +193,13 @@ export default {
         tts.Applications.Packages,
       ),
     },
+    alertRules: {
+      list: tts.Applications.AlertRules.getAll.bind(tts.Applications.AlertRules),
+      get: tts.Applications.AlertRules.getById.bind(tts.Applications.AlertRules),
+      create: tts.Applications.AlertRules.create.bind(tts.Applications.AlertRules),
+      update: tts.Applications.AlertRules.updateById.bind(tts.Applications.AlertRules),
+      delete: tts.Applications.AlertRules.deleteById.bind(tts.Applications.AlertRules),
+    },
   },
   devices: {
     list: tts.Applications.Devices.getAll.bind(tts.Applications.Devices),
@@ -264,6 +271,13 @@ export default {
       delete: tts.Gateways.ApiKeys.deleteById.bind(tts.Gateways.ApiKeys),
       create: tts.Gateways.ApiKeys.create.bind(tts.Gateways.ApiKeys),
     },
+    alertRules: {
+      list: tts.Gateways.AlertRules.getAll.bind(tts.Gateways.AlertRules),
+      get: tts.Gateways.AlertRules.getById.bind(tts.Gateways.AlertRules),
+      create: tts.Gateways.AlertRules.create.bind(tts.Gateways.AlertRules),
+      update: tts.Gateways.AlertRules.updateById.bind(tts.Gateways.AlertRules),
+      delete: tts.Gateways.AlertRules.deleteById.bind(tts.Gateways.AlertRules),
+    },
   },
   rights: {
     applications: tts.Applications.getRightsById.bind(tts.Applications),
@@ -0,0 +1,361 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { Component } from 'react'
+import bind from 'autobind-decorator'
+import { defineMessages } from 'react-intl'
+
+import Form from '@ttn-lw/components/form'
+import Input from '@ttn-lw/components/input'
+import Checkbox from '@ttn-lw/components/checkbox'
+import Select from '@ttn-lw/components/select'
+import UnitInput from '@ttn-lw/components/unit-input'
+import KeyValueMap from '@ttn-lw/components/key-value-map'
+import SubmitBar from '@ttn-lw/components/submit-bar'
+import SubmitButton from '@ttn-lw/components/submit-button'
+import ModalButton from '@ttn-lw/components/button/modal-button'
+
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+import PropTypes from '@ttn-lw/lib/prop-types'
+
+import {
+  conditions,
+  parentConditions,
+  blankValues,
+  getCondition,
+  mapAlertRuleToFormValues,
+  mapFormValuesToAlertRule,
+} from './mapping'
+import validationSchema from './validation-schema'
+
+const m = defineMessages({
+  ruleId: 'Alert rule ID',
+  idPlaceholder: 'my-new-alert-rule',
+  namePlaceholder: 'My new alert rule',
+  disabledDescription: 'Disabled alert rules are not evaluated',
+  condition: 'Condition',
+  gatewayOffline: 'Gateway offline',
+  uplinkRateDrop: 'Uplink rate drop',
+  txAckFailure: 'Downlink transmission failures',
+  deviceNotSeen: 'End device not seen',
+  timeout: 'Timeout',
+  timeoutDescription: 'Alert when the gateway has been disconnected for longer than this duration',
+  dropPercentage: 'Drop percentage',
+  dropPercentageDescription:
+    'Alert when the number of uplink messages in the window dropped by more than this percentage compared to the window before',
+  failureRatio: 'Failure ratio',
+  failureRatioDescription:
+    'Alert when at least this ratio (between 0 and 1) of the downlink transmissions in the window failed',
+  minAcknowledgments: 'Minimum acknowledgments',
+  minAcknowledgmentsDescription:
+    'The minimum number of transmission acknowledgments in the window before the alert rule is evaluated',
+  window: 'Window',
+  period: 'Period',
+  periods: 'Periods',
+  periodsDescription: 'Alert when an end device has not been seen for this number of periods',
+  notifications: 'Notifications',
+  notifyContacts: 'Notify contacts by email',
+  webhookUrls: 'Webhook URLs',
+  webhookUrlsDescription: 'The URLs are notified of alerts with an HTTP POST request',
+  webhookUrlAdd: 'Add webhook URL',
+  webhookUrlPlaceholder: 'https://example.com/alerts',
+  deleteAlertRule: 'Delete alert rule',
+  modalWarning:
+    'Are you sure you want to delete alert rule "{ruleId}"? Deleting an alert rule cannot be undone.',
+})
+
+const conditionOptions = {
+  [conditions.GATEWAY_OFFLINE]: {
+    value: conditions.GATEWAY_OFFLINE,
+    label: m.gatewayOffline,
+  },
+  [conditions.UPLINK_RATE_DROP]: {
+    value: conditions.UPLINK_RATE_DROP,
+    label: m.uplinkRateDrop,
+  },
+  [conditions.TX_ACK_FAILURE]: {
+    value: conditions.TX_ACK_FAILURE,
+    label: m.txAckFailure,
+  },
+  [conditions.DEVICE_NOT_SEEN]: {
+    value: conditions.DEVICE_NOT_SEEN,
+    label: m.deviceNotSeen,
+  },
+}
+
+const durationUnits = [
+  { label: sharedMessages.seconds, value: 's' },
+  { label: sharedMessages.minutes, value: 'm' },
+  { label: sharedMessages.hours, value: 'h' },
+]
+
+export default class AlertRuleForm extends Component {
+  static propTypes = {
+    initialAlertRuleValue: PropTypes.shape({
+      ids: PropTypes.shape({
+        rule_id: PropTypes.string,
+      }),
+    }),
+    onDelete: PropTypes.func,
+    onDeleteFailure: PropTypes.func,
+    onDeleteSuccess: PropTypes.func,
+    onSubmit: PropTypes.func.isRequired,
+    onSubmitFailure: PropTypes.func,
+    onSubmitSuccess: PropTypes.func,
+    parentType: PropTypes.oneOf(['application', 'gateway']).isRequired,
+    update: PropTypes.bool.isRequired,
+  }
+
+  static defaultProps = {
+    initialAlertRuleValue: undefined,
+    onSubmitSuccess: () => null,
+    onSubmitFailure: () => null,
+    onDeleteSuccess: () => null,
+    onDeleteFailure: () => null,
+    onDelete: () => null,
+  }
+
+  constructor(props) {
+    super(props)
+
+    this.form = React.createRef()
+
+    const { initialAlertRuleValue, update } = props
+
+    this.state = {
+      error: undefined,
+      condition: update ? getCondition(initialAlertRuleValue) : undefined,
+    }
+  }
+
+  @bind
+  async handleSubmit(values, { resetForm }) {
+    const { onSubmit, onSubmitSuccess, onSubmitFailure, update } = this.props
+
+    const castedValues = validationSchema.cast(values)
+    const { ids, ...patch } = mapFormValuesToAlertRule(castedValues)
+
+    await this.setState({ error: '' })
+
+    try {
+      const result = await onSubmit(update ? patch : { ids, ...patch })
+
+      resetForm({ values })
+      await onSubmitSuccess(result)
+    } catch (error) {
+      resetForm({ values })
+
+      await this.setState({ error })
+      await onSubmitFailure(error)
+    }
+  }
+
+  @bind
+  async handleDelete() {
+    const { onDelete, onDeleteSuccess, onDeleteFailure } = this.props
+    try {
+      await onDelete()
+      this.form.current.resetForm()
+      onDeleteSuccess()
+    } catch (error) {
+      await this.setState({ error })
+      onDeleteFailure()
+    }
+  }
+
+  @bind
+  handleConditionChange(condition) {
+    this.setState({ condition })
+  }
+
+  get conditionSection() {
+    const { condition } = this.state
+
+    switch (condition) {
+      case conditions.GATEWAY_OFFLINE:
+        return (
+          <Form.Field
+            title={m.timeout}
+            description={m.timeoutDescription}
+            name="gateway_offline.timeout"
+            component={UnitInput}
+            units={durationUnits}
+            required
+          />
+        )
+      case conditions.UPLINK_RATE_DROP:
+        return (
+          <>
+            <Form.Field
+              title={m.dropPercentage}
+              description={m.dropPercentageDescription}
+              name="uplink_rate_drop.drop_percentage"
+              type="number"
+              step="any"
+              min={0}
+              max={100}
+              component={Input}
+              required
+            />
+            <Form.Field
+              title={m.window}
+              name="uplink_rate_drop.window"
+              component={UnitInput}
+              units={durationUnits}
+              required
+            />
+          </>
+        )
+      case conditions.TX_ACK_FAILURE:
+        return (
+          <>
+            <Form.Field
+              title={m.failureRatio}
+              description={m.failureRatioDescription}
+              name="tx_ack_failure.failure_ratio"
+              type="number"
+              step="any"
+              min={0}
+              max={1}
+              component={Input}
+              required
+            />
+            <Form.Field
+              title={m.minAcknowledgments}
+              description={m.minAcknowledgmentsDescription}
+              name="tx_ack_failure.min_acknowledgments"
+              type="number"
+              min={0}
+              component={Input}
+            />
+            <Form.Field
+              title={m.window}
+              name="tx_ack_failure.window"
+              component={UnitInput}
+              units={durationUnits}
+              required
+            />
+          </>
+        )
+      case conditions.DEVICE_NOT_SEEN:
+        return (
+          <>
+            <Form.Field
+              title={m.period}
+              name="device_not_seen.period"
+              component={UnitInput}
+              units={durationUnits}
+              required
+            />
+            <Form.Field
+              title={m.periods}
+              description={m.periodsDescription}
+              name="device_not_seen.periods"
+              type="number"
+              min={1}
+              component={Input}
+              required
+            />
+          </>
+        )
+      default:
+        return null
+    }
+  }
+
+  render() {
+    const { update, initialAlertRuleValue, parentType } = this.props
+    const { error } = this.state
+    let initialValues = blankValues
+    if (update && initialAlertRuleValue) {
+      initialValues = mapAlertRuleToFormValues(initialAlertRuleValue)
+    }
+
+    return (
+      <Form
+        onSubmit={this.handleSubmit}
+        validationSchema={validationSchema}
+        initialValues={initialValues}
+        error={error}
+        formikRef={this.form}
+      >
+        <Form.SubTitle title={sharedMessages.generalInformation} />
+        <Form.Field
+          name="ids.rule_id"
+          title={m.ruleId}
+          placeholder={m.idPlaceholder}
+          component={Input}
+          required
+          autoFocus
+          disabled={update}
+        />
+        <Form.Field
+          name="name"
+          title={sharedMessages.name}
+          placeholder={m.namePlaceholder}
+          component={Input}
+        />
+        <Form.Field
+          name="disabled"
+          title={sharedMessages.disabled}
+          description={m.disabledDescription}
+          component={Checkbox}
+        />
+        <Form.SubTitle title={m.condition} />
+        <Form.Field
+          name="_condition"
+          title={m.condition}
+          component={Select}
+          options={parentConditions[parentType].map(condition => conditionOptions[condition])}
+          onChange={this.handleConditionChange}
+          required
+        />
+        {this.conditionSection}
+        <Form.SubTitle title={m.notifications} />
+        <Form.Field name="notify_contacts" title={m.notifyContacts} component={Checkbox} />
+        <Form.Field
+          indexAsKey
+          name="webhook_urls"
+          title={m.webhookUrls}
+          description={m.webhookUrlsDescription}
+          component={KeyValueMap}
+          addMessage={m.webhookUrlAdd}
+          valuePlaceholder={m.webhookUrlPlaceholder}
+        />
+        <SubmitBar>
+          <Form.Submit
+            component={SubmitButton}
+            message={update ? sharedMessages.saveChanges : sharedMessages.addAlertRule}
+          />
+          {update && (
+            <ModalButton
+              type="button"
+              icon="delete"
+              danger
+              naked
+              message={m.deleteAlertRule}
+              modalData={{
+                message: {
+                  values: { ruleId: initialAlertRuleValue.ids.rule_id },
+                  ...m.modalWarning,
+                },
+              }}
+              onApprove={this.handleDelete}
+            />
+          )}
+        </SubmitBar>
+      </Form>
+    )
+  }
+}
@@ -0,0 +1,83 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+export const conditions = Object.freeze({
+  GATEWAY_OFFLINE: 'gateway_offline',
+  UPLINK_RATE_DROP: 'uplink_rate_drop',
+  TX_ACK_FAILURE: 'tx_ack_failure',
+  DEVICE_NOT_SEEN: 'device_not_seen',
+})
+
+export const parentConditions = Object.freeze({
+  gateway: [conditions.GATEWAY_OFFLINE, conditions.UPLINK_RATE_DROP, conditions.TX_ACK_FAILURE],
+  application: [conditions.UPLINK_RATE_DROP, conditions.DEVICE_NOT_SEEN],
+})
+
+export const blankValues = {
+  ids: {
+    rule_id: '',
+  },
+  name: '',
+  disabled: false,
+  _condition: undefined,
+  [conditions.GATEWAY_OFFLINE]: {
+    timeout: '10m',
+  },
+  [conditions.UPLINK_RATE_DROP]: {
+    drop_percentage: 50,
+    window: '1h',
+  },
+  [conditions.TX_ACK_FAILURE]: {
+    failure_ratio: 0.5,
+    min_acknowledgments: 10,
+    window: '1h',
+  },
+  [conditions.DEVICE_NOT_SEEN]: {
+    period: '1h',
+    periods: 3,
+  },
+  notify_contacts: true,
+  webhook_urls: [],
+}
+
+export const getCondition = rule =>
+  Object.values(conditions).find(condition => Boolean(rule) && condition in rule)
+
+export const mapAlertRuleToFormValues = rule => {
+  const condition = getCondition(rule)
+  const values = {
+    ...blankValues,
+    ids: { rule_id: rule.ids.rule_id },
+    name: rule.name || '',
+    disabled: Boolean(rule.disabled),
+    notify_contacts: Boolean(rule.notify_contacts),
+    webhook_urls: rule.webhook_urls || [],
+    _condition: condition,
+  }
+
+  if (condition) {
+    values[condition] = { ...blankValues[condition], ...rule[condition] }
+  }
+
+  return values
+}
+
+export const mapFormValuesToAlertRule = values => ({
+  ids: { rule_id: values.ids.rule_id },
+  name: values.name,
+  disabled: values.disabled,
+  notify_contacts: values.notify_contacts,
+  webhook_urls: values.webhook_urls,
+  [values._condition]: values[values._condition],
+})
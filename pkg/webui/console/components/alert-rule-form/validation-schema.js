@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import Yup from '@ttn-lw/lib/yup'
+import { url as urlRegexp } from '@ttn-lw/lib/regexp'
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+
+import { id as idRegexp, delay as durationRegexp } from '@console/lib/regexp'
+
+import { conditions } from './mapping'
+
+const duration = Yup.string()
+  .matches(durationRegexp, Yup.passValues(sharedMessages.validateDelayFormat))
+  .required(sharedMessages.validateRequired)
+
+const condition = (name, shape) =>
+  Yup.object().when('_condition', {
+    is: name,
+    then: Yup.object().shape(shape),
+    otherwise: Yup.object().strip(),
+  })
+
+export default Yup.object().shape({
+  ids: Yup.object().shape({
+    rule_id: Yup.string()
+      .matches(idRegexp, Yup.passValues(sharedMessages.validateIdFormat))
+      .min(3, Yup.passValues(sharedMessages.validateTooShort))
+      .max(36, Yup.passValues(sharedMessages.validateTooLong))
+      .required(sharedMessages.validateRequired),
+  }),
+  name: Yup.string().max(50, Yup.passValues(sharedMessages.validateTooLong)),
+  disabled: Yup.boolean().default(false),
+  _condition: Yup.string()
+    .oneOf(Object.values(conditions), sharedMessages.validateRequired)
+    .required(sharedMessages.validateRequired),
+  [conditions.GATEWAY_OFFLINE]: condition(conditions.GATEWAY_OFFLINE, {
+    timeout: duration,
+  }),
+  [conditions.UPLINK_RATE_DROP]: condition(conditions.UPLINK_RATE_DROP, {
+    drop_percentage: Yup.number()
+      .moreThan(0, Yup.passValues(sharedMessages.validateNumberGte))
+      .max(100, Yup.passValues(sharedMessages.validateNumberLte))
+      .required(sharedMessages.validateRequired),
+    window: duration,
+  }),
+  [conditions.TX_ACK_FAILURE]: condition(conditions.TX_ACK_FAILURE, {
+    failure_ratio: Yup.number()
+      .moreThan(0, Yup.passValues(sharedMessages.validateNumberGte))
+      .max(1, Yup.passValues(sharedMessages.validateNumberLte))
+      .required(sharedMessages.validateRequired),
+    min_acknowledgments: Yup.number()
+      .integer(sharedMessages.validateInt32)
+      .min(0, Yup.passValues(sharedMessages.validateNumberGte))
+      .default(0),
+    window: duration,
+  }),
+  [conditions.DEVICE_NOT_SEEN]: condition(conditions.DEVICE_NOT_SEEN, {
+    period: duration,
+    periods: Yup.number()
+      .integer(sharedMessages.validateInt32)
+      .min(1, Yup.passValues(sharedMessages.validateNumberGte))
+      .required(sharedMessages.validateRequired),
+  }),
+  notify_contacts: Yup.boolean().default(false),
+  webhook_urls: Yup.array()
+    .of(Yup.string().matches(urlRegexp, Yup.passValues(sharedMessages.validateUrl)))
+    .default([]),
+})
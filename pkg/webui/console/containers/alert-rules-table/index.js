@@ -0,0 +1,119 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React from 'react'
+import { defineMessages } from 'react-intl'
+
+import FetchTable from '@ttn-lw/containers/fetch-table'
+
+import Message from '@ttn-lw/lib/components/message'
+
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+import PropTypes from '@ttn-lw/lib/prop-types'
+
+import { getAlertRulesList } from '@console/store/actions/alert-rules'
+
+import {
+  selectAlertRules,
+  selectAlertRulesTotalCount,
+  selectAlertRulesFetching,
+  selectAlertRulesError,
+} from '@console/store/selectors/alert-rules'
+
+const m = defineMessages({
+  ruleId: 'Rule ID',
+  condition: 'Condition',
+  gatewayOffline: 'Gateway offline',
+  uplinkRateDrop: 'Uplink rate drop',
+  txAckFailure: 'Downlink transmission failures',
+  deviceNotSeen: 'End device not seen',
+})
+
+const conditionMessages = {
+  gateway_offline: m.gatewayOffline,
+  uplink_rate_drop: m.uplinkRateDrop,
+  tx_ack_failure: m.txAckFailure,
+  device_not_seen: m.deviceNotSeen,
+}
+
+const headers = [
+  {
+    name: 'ids.rule_id',
+    displayName: m.ruleId,
+    width: 30,
+  },
+  {
+    name: 'name',
+    displayName: sharedMessages.name,
+    width: 30,
+  },
+  {
+    getValue(row) {
+      return Object.keys(conditionMessages).find(condition => condition in row)
+    },
+    displayName: m.condition,
+    width: 25,
+    render(condition) {
+      return condition ? <Message content={conditionMessages[condition]} /> : null
+    },
+  },
+  {
+    name: 'disabled',
+    displayName: sharedMessages.status,
+    width: 15,
+    render(disabled) {
+      return <Message content={disabled ? sharedMessages.disabled : sharedMessages.enabled} />
+    },
+  },
+]
+
+export default class AlertRulesTable extends React.Component {
+  static propTypes = {
+    entityId: PropTypes.string.isRequired,
+    pageSize: PropTypes.number.isRequired,
+    parentType: PropTypes.oneOf(['application', 'gateway']).isRequired,
+  }
+
+  constructor(props) {
+    super(props)
+
+    const { parentType, entityId } = props
+    this.getAlertRulesList = filters => getAlertRulesList(parentType, entityId, filters)
+  }
+
+  baseDataSelector(state) {
+    return {
+      rules: selectAlertRules(state),
+      totalCount: selectAlertRulesTotalCount(state),
+      fetching: selectAlertRulesFetching(state),
+      error: selectAlertRulesError(state),
+    }
+  }
+
+  render() {
+    const { pageSize } = this.props
+
+    return (
+      <FetchTable
+        entity="rules"
+        headers={headers}
+        addMessage={sharedMessages.addAlertRule}
+        pageSize={pageSize}
+        baseDataSelector={this.baseDataSelector}
+        getItemsAction={this.getAlertRulesList}
+        tableTitle={<Message content={sharedMessages.alertRules} />}
+      />
+    )
+  }
+}
@@ -74,6 +74,10 @@ export const mayViewOrEditApplicationApiKeys = {
   rightsSelector: selectApplicationRights,
   check: rights => rights.includes('RIGHT_APPLICATION_SETTINGS_API_KEYS'),
 }
+export const mayViewOrEditApplicationAlertRules = {
+  rightsSelector: selectApplicationRights,
+  check: rights => rights.includes('RIGHT_APPLICATION_SETTINGS_BASIC'),
+}
 export const mayViewApplicationDevices = {
   rightsSelector: selectApplicationRights,
   check: rights => rights.includes('RIGHT_APPLICATION_DEVICES_READ'),
@@ -132,6 +136,10 @@ export const mayViewOrEditGatewayApiKeys = {
   rightsSelector: selectGatewayRights,
   check: rights => rights.includes('RIGHT_GATEWAY_SETTINGS_API_KEYS'),
 }
+export const mayViewOrEditGatewayAlertRules = {
+  rightsSelector: selectGatewayRights,
+  check: rights => rights.includes('RIGHT_GATEWAY_SETTINGS_BASIC'),
+}
 export const mayViewOrEditGatewayCollaborators = {
   rightsSelector: selectGatewayRights,
   check: rights => rights.includes('RIGHT_GATEWAY_SETTINGS_COLLABORATORS'),
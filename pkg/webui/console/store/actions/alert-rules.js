@@ -0,0 +1,57 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import createRequestActions from '@ttn-lw/lib/store/actions/create-request-actions'
+import { createPaginationByParentRequestActions } from '@ttn-lw/lib/store/actions/pagination'
+
+export const SHARED_NAME = 'ALERT_RULES'
+
+export const GET_ALERT_RULE_BASE = 'GET_ALERT_RULE'
+export const [
+  { request: GET_ALERT_RULE, success: GET_ALERT_RULE_SUCCESS, failure: GET_ALERT_RULE_FAILURE },
+  { request: getAlertRule, success: getAlertRuleSuccess, failure: getAlertRuleFailure },
+] = createRequestActions(
+  GET_ALERT_RULE_BASE,
+  (parentType, parentId, ruleId) => ({ parentType, parentId, ruleId }),
+  (parentType, parentId, ruleId, selector) => ({ selector }),
+)
+
+export const GET_ALERT_RULES_LIST_BASE = 'GET_ALERT_RULES_LIST'
+export const [
+  {
+    request: GET_ALERT_RULES_LIST,
+    success: GET_ALERT_RULES_LIST_SUCCESS,
+    failure: GET_ALERT_RULES_LIST_FAILURE,
+  },
+  {
+    request: getAlertRulesList,
+    success: getAlertRulesListSuccess,
+    failure: getAlertRulesListFailure,
+  },
+] = createPaginationByParentRequestActions(SHARED_NAME)
+
+export const UPDATE_ALERT_RULE_BASE = 'UPDATE_ALERT_RULE'
+export const [
+  {
+    request: UPDATE_ALERT_RULE,
+    success: UPDATE_ALERT_RULE_SUCCESS,
+    failure: UPDATE_ALERT_RULE_FAILURE,
+  },
+  { request: updateAlertRule, success: updateAlertRuleSuccess, failure: updateAlertRuleFailure },
+] = createRequestActions(UPDATE_ALERT_RULE_BASE, (parentType, parentId, ruleId, patch) => ({
+  parentType,
+  parentId,
+  ruleId,
+  patch,
+}))
@@ -0,0 +1,67 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import api from '@console/api'
+
+import createRequestLogic from '@ttn-lw/lib/store/logics/create-request-logic'
+
+import * as alertRules from '@console/store/actions/alert-rules'
+
+const validParentTypes = ['application', 'gateway']
+
+const parentTypeValidator = ({ action }, allow) => {
+  if (!validParentTypes.includes(action.payload.parentType)) {
+    // Do not reject the action but throw an error, as this is an implementation
+    // error.
+    throw new Error(`Invalid parent entity type ${action.payload.parentType}`)
+  }
+  allow(action)
+}
+
+const getAlertRuleLogic = createRequestLogic({
+  type: alertRules.GET_ALERT_RULE,
+  validate: parentTypeValidator,
+  process: async ({ action }) => {
+    const {
+      payload: { parentType, parentId, ruleId },
+      meta: { selector },
+    } = action
+    return api[parentType].alertRules.get(parentId, ruleId, selector)
+  },
+})
+
+const getAlertRulesLogic = createRequestLogic({
+  type: alertRules.GET_ALERT_RULES_LIST,
+  validate: parentTypeValidator,
+  process: async ({ action }) => {
+    const {
+      parentType,
+      parentId,
+      params: { page, limit },
+    } = action.payload
+    const data = await api[parentType].alertRules.list(parentId, { limit, page })
+    return { entities: data.rules, totalCount: data.totalCount }
+  },
+})
+
+const updateAlertRuleLogic = createRequestLogic({
+  type: alertRules.UPDATE_ALERT_RULE,
+  validate: parentTypeValidator,
+  process: async ({ action }) => {
+    const { parentType, parentId, ruleId, patch } = action.payload
+    return api[parentType].alertRules.update(parentId, ruleId, patch)
+  },
+})
+
+export default [getAlertRuleLogic, getAlertRulesLogic, updateAlertRuleLogic]
@@ -28,6 +28,7 @@ import collaborators from './collaborators'
 import webhooks from './webhooks'
 import pubsubs from './pubsubs'
 import applicationPackages from './application-packages'
+import alertRules from './alert-rules'
 import is from './identity-server'
 import deviceRepository from './device-repository'
 
@@ -47,6 +48,7 @@ export default [
   ...webhooks,
   ...pubsubs,
   ...applicationPackages,
+  ...alertRules,
   ...is,
   ...deviceRepository,
 ]
@@ -0,0 +1,86 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import { getAlertRuleId } from '@ttn-lw/lib/selectors/id'
+
+import {
+  GET_ALERT_RULE,
+  GET_ALERT_RULE_SUCCESS,
+  GET_ALERT_RULES_LIST_SUCCESS,
+  UPDATE_ALERT_RULE_SUCCESS,
+} from '@console/store/actions/alert-rules'
+
+const conditions = ['gateway_offline', 'uplink_rate_drop', 'tx_ack_failure', 'device_not_seen']
+
+const defaultState = {
+  selectedAlertRule: null,
+  totalCount: undefined,
+  entities: {},
+}
+
+const alertRule = (state = {}, alertRule) => {
+  // There can only be one condition per alert rule, so drop the stored
+  // condition if the update sets another one.
+  const result = { ...state }
+  if (conditions.some(condition => condition in alertRule)) {
+    for (const condition of conditions) {
+      delete result[condition]
+    }
+  }
+
+  return { ...result, ...alertRule }
+}
+
+const alertRules = (state = defaultState, { type, payload }) => {
+  switch (type) {
+    case GET_ALERT_RULE:
+      return {
+        ...state,
+        selectedAlertRule: payload.ruleId,
+      }
+    case GET_ALERT_RULE_SUCCESS:
+      return {
+        ...state,
+        entities: {
+          ...state.entities,
+          [getAlertRuleId(payload)]: payload,
+        },
+      }
+    case GET_ALERT_RULES_LIST_SUCCESS:
+      return {
+        ...state,
+        entities: {
+          ...payload.entities.reduce((acc, rule) => {
+            acc[getAlertRuleId(rule)] = rule
+            return acc
+          }, {}),
+        },
+        totalCount: payload.totalCount,
+      }
+    case UPDATE_ALERT_RULE_SUCCESS:
+      const id = getAlertRuleId(payload)
+
+      return {
+        ...state,
+        entities: {
+          ...state.entities,
+          [id]: alertRule(state.entities[id], payload),
+        },
+      }
+    default:
+      return state
+  }
+}
+
+export default alertRules
@@ -55,6 +55,7 @@ import webhookTemplates from './webhook-templates'
 import pubsubs from './pubsubs'
 import pubsubFormats from './pubsub-formats'
 import applicationPackages from './application-packages'
+import alertRules from './alert-rules'
 import deviceTemplateFormats from './device-template-formats'
 import organizations from './organizations'
 import js from './join-server'
@@ -79,6 +80,7 @@ export default history =>
     pubsubs,
     pubsubFormats,
     applicationPackages,
+    alertRules,
     configuration,
     organizations,
     apiKeys,
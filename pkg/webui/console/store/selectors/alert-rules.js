@@ -0,0 +1,34 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import { createFetchingSelector } from '@ttn-lw/lib/store/selectors/fetching'
+import { createErrorSelector } from '@ttn-lw/lib/store/selectors/error'
+
+import { GET_ALERT_RULE_BASE, GET_ALERT_RULES_LIST_BASE } from '@console/store/actions/alert-rules'
+
+const selectAlertRulesStore = state => state.alertRules
+
+// Alert rule.
+export const selectAlertRulesEntityStore = state => selectAlertRulesStore(state).entities
+export const selectSelectedAlertRuleId = state => selectAlertRulesStore(state).selectedAlertRule
+export const selectSelectedAlertRule = state =>
+  selectAlertRulesEntityStore(state)[selectSelectedAlertRuleId(state)]
+export const selectAlertRuleError = createErrorSelector(GET_ALERT_RULE_BASE)
+export const selectAlertRuleFetching = createFetchingSelector(GET_ALERT_RULE_BASE)
+
+// Alert rules.
+export const selectAlertRules = state => Object.values(selectAlertRulesEntityStore(state))
+export const selectAlertRulesTotalCount = state => selectAlertRulesStore(state).totalCount
+export const selectAlertRulesFetching = createFetchingSelector(GET_ALERT_RULES_LIST_BASE)
+export const selectAlertRulesError = createErrorSelector(GET_ALERT_RULES_LIST_BASE)
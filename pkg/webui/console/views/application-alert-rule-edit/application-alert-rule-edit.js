@@ -0,0 +1,103 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { Component } from 'react'
+import { Container, Col, Row } from 'react-grid-system'
+import bind from 'autobind-decorator'
+import { defineMessages } from 'react-intl'
+
+import Breadcrumb from '@ttn-lw/components/breadcrumbs/breadcrumb'
+import PageTitle from '@ttn-lw/components/page-title'
+import { withBreadcrumb } from '@ttn-lw/components/breadcrumbs/context'
+import toast from '@ttn-lw/components/toast'
+
+import AlertRuleForm from '@console/components/alert-rule-form'
+
+import PropTypes from '@ttn-lw/lib/prop-types'
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+
+const m = defineMessages({
+  editAlertRule: 'Edit alert rule',
+  updateSuccess: 'Alert rule updated',
+  deleteSuccess: 'Alert rule deleted',
+})
+
+@withBreadcrumb('apps.single.alert-rules.edit', ({ appId, match }) => (
+  <Breadcrumb
+    path={`/applications/${appId}/alert-rules/${match.params.ruleId}`}
+    content={sharedMessages.edit}
+  />
+))
+export default class ApplicationAlertRuleEdit extends Component {
+  static propTypes = {
+    alertRule: PropTypes.shape({
+      ids: PropTypes.shape({
+        rule_id: PropTypes.string,
+      }),
+    }).isRequired,
+    deleteAlertRule: PropTypes.func.isRequired,
+    navigateToList: PropTypes.func.isRequired,
+    updateAlertRule: PropTypes.func.isRequired,
+  }
+
+  @bind
+  async handleSubmit(patch) {
+    const { updateAlertRule } = this.props
+
+    await updateAlertRule(patch)
+  }
+
+  @bind
+  handleSubmitSuccess() {
+    toast({
+      message: m.updateSuccess,
+      type: toast.types.SUCCESS,
+    })
+  }
+
+  @bind
+  handleDeleteSuccess() {
+    const { navigateToList } = this.props
+
+    toast({
+      message: m.deleteSuccess,
+      type: toast.types.SUCCESS,
+    })
+
+    navigateToList()
+  }
+
+  render() {
+    const { alertRule, deleteAlertRule } = this.props
+
+    return (
+      <Container>
+        <PageTitle title={m.editAlertRule} />
+        <Row>
+          <Col lg={8} md={12}>
+            <AlertRuleForm
+              update
+              parentType="application"
+              initialAlertRuleValue={alertRule}
+              onSubmit={this.handleSubmit}
+              onSubmitSuccess={this.handleSubmitSuccess}
+              onDelete={deleteAlertRule}
+              onDeleteSuccess={this.handleDeleteSuccess}
+            />
+          </Col>
+        </Row>
+      </Container>
+    )
+  }
+}
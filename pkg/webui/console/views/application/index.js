@@ -29,6 +29,7 @@ import NotFoundRoute from '@ttn-lw/lib/components/not-found-route'
 import ApplicationOverview from '@console/views/application-overview'
 import ApplicationGeneralSettings from '@console/views/application-general-settings'
 import ApplicationApiKeys from '@console/views/application-api-keys'
+import ApplicationAlertRules from '@console/views/application-alert-rules'
 import ApplicationCollaborators from '@console/views/application-collaborators'
 import ApplicationData from '@console/views/application-data'
 import ApplicationPayloadFormatters from '@console/views/application-payload-formatters'
@@ -49,6 +50,7 @@ import {
   mayCreateOrEditApplicationIntegrations,
   mayEditBasicApplicationInfo,
   mayViewOrEditApplicationApiKeys,
+  mayViewOrEditApplicationAlertRules,
   mayViewOrEditApplicationCollaborators,
   mayViewOrEditApplicationPackages,
 } from '@console/lib/feature-checks'
@@ -210,6 +212,13 @@ export default class Application extends React.Component {
               icon="api_keys"
             />
           )}
+          {mayViewOrEditApplicationAlertRules.check(rights) && (
+            <SideNavigation.Item
+              title={sharedMessages.alertRules}
+              path={`${matchedUrl}/alert-rules`}
+              icon="alert_rules"
+            />
+          )}
           {mayEditBasicApplicationInfo.check(rights) && (
             <SideNavigation.Item
               title={sharedMessages.generalSettings}
@@ -222,6 +231,7 @@ export default class Application extends React.Component {
           <Route exact path={`${path}`} component={ApplicationOverview} />
           <Route path={`${path}/general-settings`} component={ApplicationGeneralSettings} />
           <Route path={`${path}/api-keys`} component={ApplicationApiKeys} />
+          <Route path={`${path}/alert-rules`} component={ApplicationAlertRules} />
           <Route path={`${path}/devices`} component={Devices} />
           <Route path={`${path}/collaborators`} component={ApplicationCollaborators} />
           <Route path={`${path}/data`} component={ApplicationData} />
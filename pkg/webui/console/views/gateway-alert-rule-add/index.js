@@ -0,0 +1,82 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { Component } from 'react'
+import { Container, Col, Row } from 'react-grid-system'
+import bind from 'autobind-decorator'
+import { connect } from 'react-redux'
+import { push } from 'connected-react-router'
+
+import api from '@console/api'
+
+import PageTitle from '@ttn-lw/components/page-title'
+import Breadcrumb from '@ttn-lw/components/breadcrumbs/breadcrumb'
+import { withBreadcrumb } from '@ttn-lw/components/breadcrumbs/context'
+
+import AlertRuleForm from '@console/components/alert-rule-form'
+
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+import PropTypes from '@ttn-lw/lib/prop-types'
+
+import { selectSelectedGatewayId } from '@console/store/selectors/gateways'
+
+@connect(
+  state => ({
+    gtwId: selectSelectedGatewayId(state),
+  }),
+  dispatch => ({
+    navigateToList: gtwId => dispatch(push(`/gateways/${gtwId}/alert-rules`)),
+  }),
+)
+@withBreadcrumb('gateways.single.alert-rules.add', ({ gtwId }) => (
+  <Breadcrumb path={`/gateways/${gtwId}/alert-rules/add`} content={sharedMessages.add} />
+))
+export default class GatewayAlertRuleAdd extends Component {
+  static propTypes = {
+    gtwId: PropTypes.string.isRequired,
+    navigateToList: PropTypes.func.isRequired,
+  }
+
+  @bind
+  async handleSubmit(rule) {
+    const { gtwId } = this.props
+
+    await api.gateway.alertRules.create(gtwId, rule)
+  }
+
+  @bind
+  handleSubmitSuccess() {
+    const { navigateToList, gtwId } = this.props
+
+    navigateToList(gtwId)
+  }
+
+  render() {
+    return (
+      <Container>
+        <PageTitle title={sharedMessages.addAlertRule} />
+        <Row>
+          <Col lg={8} md={12}>
+            <AlertRuleForm
+              parentType="gateway"
+              update={false}
+              onSubmit={this.handleSubmit}
+              onSubmitSuccess={this.handleSubmitSuccess}
+            />
+          </Col>
+        </Row>
+      </Container>
+    )
+  }
+}
@@ -0,0 +1,63 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import { connect } from 'react-redux'
+import { replace } from 'connected-react-router'
+
+import api from '@console/api'
+
+import withRequest from '@ttn-lw/lib/components/with-request'
+
+import attachPromise from '@ttn-lw/lib/store/actions/attach-promise'
+
+import { getAlertRule, updateAlertRule } from '@console/store/actions/alert-rules'
+
+import {
+  selectSelectedAlertRule,
+  selectAlertRuleFetching,
+  selectAlertRuleError,
+} from '@console/store/selectors/alert-rules'
+import { selectSelectedGatewayId } from '@console/store/selectors/gateways'
+
+const alertRuleEntitySelector = ['name', 'disabled', 'condition', 'notify_contacts', 'webhook_urls']
+
+const mapStateToProps = state => ({
+  gtwId: selectSelectedGatewayId(state),
+  alertRule: selectSelectedAlertRule(state),
+  fetching: selectAlertRuleFetching(state),
+  error: selectAlertRuleError(state),
+})
+
+const promisifiedUpdateAlertRule = attachPromise(updateAlertRule)
+const mapDispatchToProps = (dispatch, { match }) => {
+  const { gtwId, ruleId } = match.params
+
+  return {
+    getAlertRule: () => dispatch(getAlertRule('gateway', gtwId, ruleId, alertRuleEntitySelector)),
+    navigateToList: () => dispatch(replace(`/gateways/${gtwId}/alert-rules`)),
+    updateAlertRule: patch => dispatch(promisifiedUpdateAlertRule('gateway', gtwId, ruleId, patch)),
+    deleteAlertRule: () => api.gateway.alertRules.delete(gtwId, ruleId),
+  }
+}
+
+export default AlertRuleEdit =>
+  connect(
+    mapStateToProps,
+    mapDispatchToProps,
+  )(
+    withRequest(
+      ({ getAlertRule }) => getAlertRule(),
+      ({ fetching, alertRule }) => fetching || !Boolean(alertRule),
+    )(AlertRuleEdit),
+  )
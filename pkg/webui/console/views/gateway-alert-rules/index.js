@@ -0,0 +1,63 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React from 'react'
+import { connect } from 'react-redux'
+import { Switch, Route } from 'react-router'
+
+import Breadcrumb from '@ttn-lw/components/breadcrumbs/breadcrumb'
+import { withBreadcrumb } from '@ttn-lw/components/breadcrumbs/context'
+
+import ErrorView from '@ttn-lw/lib/components/error-view'
+
+import withFeatureRequirement from '@console/lib/components/with-feature-requirement'
+
+import GatewayAlertRuleEdit from '@console/views/gateway-alert-rule-edit'
+import GatewayAlertRuleAdd from '@console/views/gateway-alert-rule-add'
+import GatewayAlertRulesList from '@console/views/gateway-alert-rules-list'
+import SubViewError from '@console/views/sub-view-error'
+
+import PropTypes from '@ttn-lw/lib/prop-types'
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+
+import { mayViewOrEditGatewayAlertRules } from '@console/lib/feature-checks'
+
+import { selectSelectedGatewayId } from '@console/store/selectors/gateways'
+
+@connect(state => ({ gtwId: selectSelectedGatewayId(state) }))
+@withFeatureRequirement(mayViewOrEditGatewayAlertRules, {
+  redirect: ({ gtwId }) => `/gateways/${gtwId}`,
+})
+@withBreadcrumb('gateways.single.alert-rules', ({ gtwId }) => (
+  <Breadcrumb path={`/gateways/${gtwId}/alert-rules`} content={sharedMessages.alertRules} />
+))
+export default class GatewayAlertRules extends React.Component {
+  static propTypes = {
+    match: PropTypes.match.isRequired,
+  }
+
+  render() {
+    const { match } = this.props
+
+    return (
+      <ErrorView ErrorComponent={SubViewError}>
+        <Switch>
+          <Route exact path={`${match.path}`} component={GatewayAlertRulesList} />
+          <Route exact path={`${match.path}/add`} component={GatewayAlertRuleAdd} />
+          <Route path={`${match.path}/:ruleId`} component={GatewayAlertRuleEdit} />
+        </Switch>
+      </ErrorView>
+    )
+  }
+}
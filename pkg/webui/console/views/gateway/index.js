@@ -31,6 +31,7 @@ import GatewayLocation from '@console/views/gateway-location'
 import GatewayData from '@console/views/gateway-data'
 import GatewayGeneralSettings from '@console/views/gateway-general-settings'
 import GatewayApiKeys from '@console/views/gateway-api-keys'
+import GatewayAlertRules from '@console/views/gateway-alert-rules'
 import GatewayOverview from '@console/views/gateway-overview'
 
 import PropTypes from '@ttn-lw/lib/prop-types'
@@ -42,6 +43,7 @@ import {
   mayViewOrEditGatewayLocation,
   mayViewOrEditGatewayCollaborators,
   mayViewOrEditGatewayApiKeys,
+  mayViewOrEditGatewayAlertRules,
   mayEditBasicGatewayInformation,
 } from '@console/lib/feature-checks'
 
@@ -181,6 +183,13 @@ export default class Gateway extends React.Component {
               icon="api_keys"
             />
           )}
+          {mayViewOrEditGatewayAlertRules.check(rights) && (
+            <SideNavigation.Item
+              title={sharedMessages.alertRules}
+              path={`${matchedUrl}/alert-rules`}
+              icon="alert_rules"
+            />
+          )}
           {mayEditBasicGatewayInformation.check(rights) && (
             <SideNavigation.Item
               title={sharedMessages.generalSettings}
@@ -192,6 +201,7 @@ export default class Gateway extends React.Component {
         <Switch>
           <Route exact path={`${match.path}`} component={GatewayOverview} />
           <Route path={`${match.path}/api-keys`} component={GatewayApiKeys} />
+          <Route path={`${match.path}/alert-rules`} component={GatewayAlertRules} />
           <Route path={`${match.path}/collaborators`} component={GatewayCollaborators} />
           <Route path={`${match.path}/location`} component={GatewayLocation} />
           <Route path={`${match.path}/data`} component={GatewayData} />
@@ -110,6 +110,10 @@ export const getPubsubId = (pubsub = {}) => {
   return getByPath(pubsub, 'ids.pub_sub_id')
 }
 
+export const getAlertRuleId = (alertRule = {}) => {
+  return getByPath(alertRule, 'ids.rule_id')
+}
+
 export const getUserId = (user = {}) => {
   return getByPath(user, 'ids.user_id')
 }
@@ -22,6 +22,7 @@ export default defineMessages({
   accuracy: 'Accuracy',
   activationMode: 'Activation mode',
   add: 'Add',
+  addAlertRule: 'Add alert rule',
   addApiKey: 'Add API key',
   addApplication: 'Add application',
   addAttributes: 'Add attributes',
@@ -36,6 +37,7 @@ export default defineMessages({
   addWebhook: 'Add webhook',
   admin: 'Admin',
   advancedSettings: 'Advanced settings',
+  alertRules: 'Alert rules',
   all: 'All',
   allAdmin: 'All (Admin)',
   altitude: 'Altitude',
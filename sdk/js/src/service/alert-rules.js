@@ -0,0 +1,104 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import Marshaler from '../util/marshaler'
+
+const remaps = [
+  ['gateway_offline', 'condition.gateway_offline'],
+  ['uplink_rate_drop', 'condition.uplink_rate_drop'],
+  ['tx_ack_failure', 'condition.tx_ack_failure'],
+  ['device_not_seen', 'condition.device_not_seen'],
+]
+
+class AlertRules {
+  constructor(registry, { parentRoutes }) {
+    this._api = registry
+    this._parentRoutes = parentRoutes
+  }
+
+  async getAll(entityId, params, selector) {
+    const entityIdRoute = this._parentRoutes.list
+    const result = await this._api.List(
+      {
+        routeParams: { [entityIdRoute]: entityId },
+      },
+      {
+        ...params,
+        ...Marshaler.selectorToFieldMask(selector),
+      },
+    )
+
+    return Marshaler.payloadListResponse('rules', result)
+  }
+
+  async getById(entityId, ruleId, selector) {
+    const entityIdRoute = this._parentRoutes.get
+    const result = await this._api.Get(
+      {
+        routeParams: { [entityIdRoute]: entityId, 'ids.rule_id': ruleId },
+      },
+      Marshaler.selectorToFieldMask(selector),
+    )
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
+  async create(entityId, rule) {
+    const entityIdRoute = this._parentRoutes.create
+    const result = await this._api.Create(
+      {
+        routeParams: { [entityIdRoute]: entityId },
+      },
+      {
+        alert_rule: rule,
+      },
+    )
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
+  async updateById(
+    entityId,
+    ruleId,
+    patch,
+    mask = Marshaler.fieldMaskFromPatch(patch, this._api.UpdateAllowedFieldMaskPaths, remaps),
+  ) {
+    const entityIdRoute = this._parentRoutes.update
+    const result = await this._api.Update(
+      {
+        routeParams: {
+          [entityIdRoute]: entityId,
+          'alert_rule.ids.rule_id': ruleId,
+        },
+      },
+      {
+        alert_rule: patch,
+        field_mask: Marshaler.fieldMask(mask),
+      },
+    )
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
+  async deleteById(entityId, ruleId) {
+    const entityIdRoute = this._parentRoutes.delete
+    const result = await this._api.Delete({
+      routeParams: { [entityIdRoute]: entityId, rule_id: ruleId },
+    })
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+}
+
+export default AlertRules
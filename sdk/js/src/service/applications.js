@@ -23,6 +23,7 @@ import Collaborators from './collaborators'
 import Webhooks from './webhooks'
 import PubSubs from './pubsubs'
 import Packages from './application-packages'
+import AlertRules from './alert-rules'
 
 const { is: IS, as: AS, ns: NS, js: JS, dtc: DTC } = STACK_COMPONENTS_MAP
 
@@ -64,6 +65,15 @@ class Applications {
     this.Webhooks = new Webhooks(api.ApplicationWebhookRegistry)
     this.PubSubs = new PubSubs(api.ApplicationPubSubRegistry)
     this.Packages = new Packages(api.ApplicationPackageRegistry)
+    this.AlertRules = new AlertRules(api.AlertRuleRegistry, {
+      parentRoutes: {
+        get: 'ids.application_ids.application_id',
+        list: 'application_ids.application_id',
+        create: 'alert_rule.ids.application_ids.application_id',
+        update: 'alert_rule.ids.application_ids.application_id',
+        delete: 'application_ids.application_id',
+      },
+    })
   }
 
   // Retrieval.
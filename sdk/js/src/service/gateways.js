@@ -17,6 +17,7 @@ import combineStreams from '../util/combine-streams'
 import { STACK_COMPONENTS_MAP } from '../util/constants'
 
 import ApiKeys from './api-keys'
+import AlertRules from './alert-rules'
 import Collaborators from './collaborators'
 
 class Gateways {
@@ -39,6 +40,15 @@ class Gateways {
         set: 'gateway_ids.gateway_id',
       },
     })
+    this.AlertRules = new AlertRules(api.AlertRuleRegistry, {
+      parentRoutes: {
+        get: 'ids.gateway_ids.gateway_id',
+        list: 'gateway_ids.gateway_id',
+        create: 'alert_rule.ids.gateway_ids.gateway_id',
+        update: 'alert_rule.ids.gateway_ids.gateway_id',
+        delete: 'gateway_ids.gateway_id',
+      },
+    })
   }
 
   _emitDefaults(paths, gateway) {
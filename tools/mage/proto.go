@@ -109,6 +109,15 @@ func (p Proto) Go(context.Context) error {
 		); err != nil {
 			return fmt.Errorf("failed to generate protos: %w", err)
 		}
+		// The vendored ChirpStack protos import each other relative to their own root.
+		if err := protoc(
+			fmt.Sprintf("-I%s/api/third_party/chirpstack", pCtx.WorkingDirectory),
+			fmt.Sprintf("--gogottn_out=%s:%s", convStr, protocOut),
+			"common/common.proto",
+			"gw/gw.proto",
+		); err != nil {
+			return fmt.Errorf("failed to generate ChirpStack protos: %w", err)
+		}
 		return nil
 	}); err != nil {
 		return err